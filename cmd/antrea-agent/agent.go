@@ -41,6 +41,7 @@ import (
 	"antrea.io/antrea/pkg/agent/controller/bgp"
 	"antrea.io/antrea/pkg/agent/controller/egress"
 	"antrea.io/antrea/pkg/agent/controller/ipseccertificate"
+	"antrea.io/antrea/pkg/agent/controller/ipsecpsk"
 	"antrea.io/antrea/pkg/agent/controller/l7flowexporter"
 	"antrea.io/antrea/pkg/agent/controller/networkpolicy"
 	"antrea.io/antrea/pkg/agent/controller/networkpolicy/l7engine"
@@ -48,9 +49,12 @@ import (
 	"antrea.io/antrea/pkg/agent/controller/serviceexternalip"
 	"antrea.io/antrea/pkg/agent/controller/traceflow"
 	"antrea.io/antrea/pkg/agent/controller/trafficcontrol"
+	"antrea.io/antrea/pkg/agent/deviceplugin"
 	"antrea.io/antrea/pkg/agent/externalnode"
+	"antrea.io/antrea/pkg/agent/featurereconfig"
 	"antrea.io/antrea/pkg/agent/flowexporter"
 	"antrea.io/antrea/pkg/agent/flowexporter/exporter"
+	"antrea.io/antrea/pkg/agent/hostport"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/ipassigner/linkmonitor"
 	"antrea.io/antrea/pkg/agent/memberlist"
@@ -60,14 +64,17 @@ import (
 	mcroute "antrea.io/antrea/pkg/agent/multicluster"
 	"antrea.io/antrea/pkg/agent/nodeip"
 	npl "antrea.io/antrea/pkg/agent/nodeportlocal"
+	nplk8s "antrea.io/antrea/pkg/agent/nodeportlocal/k8s"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/packetcapture"
+	"antrea.io/antrea/pkg/agent/profiling"
 	"antrea.io/antrea/pkg/agent/proxy"
 	proxytypes "antrea.io/antrea/pkg/agent/proxy/types"
 	"antrea.io/antrea/pkg/agent/querier"
 	"antrea.io/antrea/pkg/agent/route"
 	"antrea.io/antrea/pkg/agent/secondarynetwork"
 	"antrea.io/antrea/pkg/agent/servicecidr"
+	"antrea.io/antrea/pkg/agent/serviceprobe"
 	"antrea.io/antrea/pkg/agent/stats"
 	support "antrea.io/antrea/pkg/agent/supportbundlecollection"
 	agenttypes "antrea.io/antrea/pkg/agent/types"
@@ -87,6 +94,7 @@ import (
 	"antrea.io/antrea/pkg/util/k8s"
 	"antrea.io/antrea/pkg/util/lazy"
 	"antrea.io/antrea/pkg/util/podstore"
+	"antrea.io/antrea/pkg/util/runtime"
 	utilwait "antrea.io/antrea/pkg/util/wait"
 	"antrea.io/antrea/pkg/version"
 )
@@ -191,6 +199,7 @@ func run(o *Options) error {
 		features.DefaultFeatureGate.Enabled(features.TrafficControl),
 		l7FlowExporterEnabled,
 		enableMulticlusterGW,
+		o.config.DNSResolverRestriction.Enable && o.nodeType == config.K8sNode,
 		groupIDAllocator,
 		*o.config.EnablePrometheusMetrics,
 		o.config.PacketInRate,
@@ -221,10 +230,15 @@ func run(o *Options) error {
 		TransportIfaceCIDRs:   o.config.TransportInterfaceCIDRs,
 		IPsecConfig: config.IPsecConfig{
 			AuthenticationMode: ipsecAuthenticationMode,
+			PSKSource:          config.IPsecPSKSource(o.config.IPsec.PSKSource),
 		},
 		EnableMulticlusterGW:       enableMulticlusterGW,
 		MulticlusterEncryptionMode: multiclusterEncryptionMode,
 	}
+	if o.config.MultipathRouting.Enable {
+		networkConfig.MultipathUplinkInterfaces = o.config.MultipathRouting.UplinkInterfaces
+		networkConfig.MultipathHealthCheckInterval = o.multipathHealthCheckInterval
+	}
 
 	wireguardConfig := &config.WireGuardConfig{
 		Port: o.config.WireGuard.Port,
@@ -319,6 +333,7 @@ func run(o *Options) error {
 		stopCh,
 		o.nodeType,
 		o.config.ExternalNode.ExternalNodeNamespace,
+		&o.config.ExternalNode.Registration,
 		connectUplinkToBridge,
 		o.enableAntreaProxy,
 		l7NetworkPolicyEnabled,
@@ -330,14 +345,35 @@ func run(o *Options) error {
 	}
 	nodeConfig := agentInitializer.GetNodeConfig()
 
+	if o.config.CPUAffinity.Enable {
+		if err := configureCPUAffinity(o.config.CPUAffinity, o.ovsPMDCPUs, nodeConfig.NodeTransportInterfaceName, ovsBridgeClient, ofClient); err != nil {
+			return fmt.Errorf("error configuring CPU affinity: %v", err)
+		}
+	}
+
 	var ipsecCertController *ipseccertificate.Controller
 
 	if networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec &&
 		networkConfig.IPsecConfig.AuthenticationMode == config.IPsecAuthenticationModeCert {
-		ipsecCertController = ipseccertificate.NewIPSecCertificateController(k8sClient, ovsBridgeClient, nodeConfig.Name)
+		ipsecCertController = ipseccertificate.NewIPSecCertificateController(k8sClient, ovsBridgeClient, agentInitializer.GetIPsecClient(), nodeConfig.Name)
+	}
+
+	var ipsecPSKProvider ipsecpsk.Provider
+	if networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec &&
+		networkConfig.IPsecConfig.AuthenticationMode == config.IPsecAuthenticationModePSK &&
+		networkConfig.IPsecConfig.PSKSource != config.IPsecPSKSourceEnv {
+		ipsecPSKProvider, err = ipsecpsk.NewProvider(o.ipsecPSKConfig, k8sClient, nodeInformer.Lister(), nodeConfig.Name)
+		if err != nil {
+			return fmt.Errorf("error creating IPsec PSK provider: %w", err)
+		}
 	}
 
 	var nodeRouteController *noderoute.Controller
+	var nodeIPMonitor *agent.NodeIPMonitor
+	var gatewayMonitor *agent.GatewayMonitor
+	// gatewayConfigChecker is only set when the agent manages a host gateway interface, to avoid reporting the
+	// GatewayConfigIntact condition otherwise.
+	var gatewayConfigChecker querier.GatewayConfigChecker
 	if o.nodeType == config.K8sNode {
 		nodeRouteController = noderoute.NewNodeRouteController(
 			nodeInformer,
@@ -349,9 +385,14 @@ func run(o *Options) error {
 			networkConfig,
 			nodeConfig,
 			agentInitializer.GetWireGuardClient(),
+			agentInitializer.GetIPsecClient(),
 			ipsecCertController,
+			ipsecPSKProvider,
 			flowRestoreCompleteWait,
 		)
+		nodeIPMonitor = agentInitializer.NewNodeIPMonitor(nodeInformer, nodeRouteController)
+		gatewayMonitor = agentInitializer.NewGatewayMonitor()
+		gatewayConfigChecker = gatewayMonitor
 	}
 
 	// podUpdateChannel is a channel for receiving Pod updates from CNIServer and
@@ -432,6 +473,16 @@ func run(o *Options) error {
 			podUpdateChannel,
 		)
 	}
+	var namespaceTunnelVNIController *noderoute.NamespaceTunnelVNIController
+	if o.config.EnableNamespaceTunnelVNI && o.nodeType == config.K8sNode {
+		namespaceTunnelVNIController = noderoute.NewNamespaceTunnelVNIController(
+			ofClient,
+			ifaceStore,
+			localPodInformer.Get(),
+			namespaceInformer,
+			podUpdateChannel,
+		)
+	}
 
 	v4Enabled := networkConfig.IPv4Enabled
 	v6Enabled := networkConfig.IPv6Enabled
@@ -473,6 +524,25 @@ func run(o *Options) error {
 		}
 	}
 
+	var serviceCIDRRejecter *proxy.ServiceCIDRRejecter
+	if o.enableAntreaProxy && o.config.AntreaProxy.RejectUnallocatedClusterIPs && o.nodeType == config.K8sNode {
+		serviceCIDRRejecter = proxy.NewServiceCIDRRejecter(ofClient, serviceCIDRProvider, proxier.GetProxyProvider(), serviceInformer)
+	}
+
+	// The controller also runs when no ExternalIPPool is configured for the Node, to install the flows for the
+	// external traffic SNAT IPs of the other Nodes.
+	var externalTrafficSNATController *proxy.ExternalTrafficSNATController
+	if o.enableAntreaProxy && networkConfig.TrafficEncapMode == config.TrafficEncapModeEncap && o.nodeType == config.K8sNode {
+		externalTrafficSNATController = proxy.NewExternalTrafficSNATController(
+			nodeConfig.Name,
+			o.config.AntreaProxy.ExternalTrafficSNATIPPool,
+			ofClient,
+			k8sClient,
+			nodeInformer,
+			v4Enabled,
+			v6Enabled)
+	}
+
 	// We set flow poll interval as the time interval for rule deletion in the async
 	// rule cache, which is implemented as part of the idAllocator. This is to preserve
 	// the rule info for populating NetworkPolicy fields in the Flow Exporter even
@@ -484,10 +554,20 @@ func run(o *Options) error {
 	statusManagerEnabled := antreaPolicyEnabled
 
 	var auditLoggerOptions = &networkpolicy.AuditLoggerOptions{
-		MaxSize:    int(o.config.AuditLogging.MaxSize),
-		MaxBackups: int(*o.config.AuditLogging.MaxBackups),
-		MaxAge:     int(*o.config.AuditLogging.MaxAge),
-		Compress:   *o.config.AuditLogging.Compress,
+		MaxSize:      int(o.config.AuditLogging.MaxSize),
+		MaxBackups:   int(*o.config.AuditLogging.MaxBackups),
+		MaxAge:       int(*o.config.AuditLogging.MaxAge),
+		Compress:     *o.config.AuditLogging.Compress,
+		Format:       o.config.AuditLogging.Format,
+		SamplingRate: int(o.config.AuditLogging.SamplingRate),
+		Destination:  o.config.AuditLogging.Destination,
+		Address:      o.config.AuditLogging.Address,
+	}
+	if len(o.config.AuditLogging.RuleSamplingRates) > 0 {
+		auditLoggerOptions.RuleSamplingRates = make(map[string]int, len(o.config.AuditLogging.RuleSamplingRates))
+		for logLabel, rate := range o.config.AuditLogging.RuleSamplingRates {
+			auditLoggerOptions.RuleSamplingRates[logLabel] = int(rate)
+		}
 	}
 
 	var gwPort, tunPort uint32
@@ -504,6 +584,8 @@ func run(o *Options) error {
 	if l7NetworkPolicyEnabled || l7FlowExporterEnabled {
 		l7Reconciler = l7engine.NewReconciler(ofClient)
 	}
+	// The Pod readiness gate is only supported for Pods on K8s Nodes.
+	podReadinessGateEnabled := o.config.EnablePolicyReadinessGate && o.nodeType == config.K8sNode
 	networkPolicyController, err := networkpolicy.NewNetworkPolicyController(
 		antreaClientProvider,
 		ofClient,
@@ -532,11 +614,24 @@ func run(o *Options) error {
 		nodeConfig,
 		podNetworkWait,
 		l7Reconciler,
-		uint32(o.config.FQDNCacheMinTTL),
+		o.fqdnCacheConfig,
+		podReadinessGateEnabled,
 	)
 	if err != nil {
 		return fmt.Errorf("error creating new NetworkPolicy controller: %v", err)
 	}
+	var podReadinessGateController *networkpolicy.PodReadinessGateController
+	if podReadinessGateEnabled {
+		podReadinessGateController = networkpolicy.NewPodReadinessGateController(k8sClient, localPodInformer.Get(), podUpdateChannel, networkPolicyController)
+	}
+	var dnsResolverController *networkpolicy.DNSResolverController
+	if o.config.DNSResolverRestriction.Enable && o.nodeType == config.K8sNode {
+		dnsResolverController = networkpolicy.NewDNSResolverController(ofClient, ifaceStore, localPodInformer.Get(), podUpdateChannel, o.dnsClusterResolvers)
+	}
+	if nodeNetworkPolicyEnabled {
+		nodeTrafficClassifier := networkpolicy.NewNodeTrafficClassifier(localPodInformer.Get(), routeClient, v4Enabled, v6Enabled)
+		go nodeTrafficClassifier.Run(stopCh)
+	}
 	var l7FlowExporterController *l7flowexporter.L7FlowExporterController
 	if l7FlowExporterEnabled {
 		l7FlowExporterController = l7flowexporter.NewL7FlowExporterController(
@@ -580,6 +675,7 @@ func run(o *Options) error {
 		egressController, err = egress.NewEgressController(
 			ofClient, k8sClient, antreaClientProvider, crdClient, ifaceStore, routeClient, nodeConfig.Name, nodeConfig.NodeTransportInterfaceName,
 			memberlistCluster, egressInformer, externalIPPoolInformer, nodeInformer, podUpdateChannel, serviceCIDRProvider, o.config.Egress.MaxEgressIPsPerNode,
+			o.egressAssignmentStabilizationWindow,
 			features.DefaultFeatureGate.Enabled(features.EgressTrafficShaping),
 			features.DefaultFeatureGate.Enabled(features.EgressSeparateSubnet),
 			linkMonitor,
@@ -595,6 +691,7 @@ func run(o *Options) error {
 			memberlistCluster,
 			serviceInformer,
 			endpointsInformer,
+			externalIPPoolInformer,
 			linkMonitor,
 		)
 		if err != nil {
@@ -625,7 +722,8 @@ func run(o *Options) error {
 			o.config.DisableTXChecksumOffload,
 			networkConfig,
 			podNetworkWait,
-			flowRestoreCompleteWait)
+			flowRestoreCompleteWait,
+			afero.NewOsFs())
 
 		err = cniServer.Initialize(ovsBridgeClient, ofClient, ifaceStore, podUpdateChannel)
 		if err != nil {
@@ -697,39 +795,94 @@ func run(o *Options) error {
 		return err
 	}
 
-	var flowExporter *exporter.FlowExporter
-	if enableFlowExporter {
-		podStore := podstore.NewPodStore(localPodInformer.Get())
+	// featureReconfigurer starts the components of the features which can be enabled and disabled at runtime, by
+	// updating their feature gates in the configuration file.
+	featureReconfigurer, err := featurereconfig.NewReconfigurer(o.configFile, features.DefaultMutableFeatureGate)
+	if err != nil {
+		return fmt.Errorf("error when creating feature reconfigurer: %w", err)
+	}
+
+	// interfaceStatsCollector reports the HNS endpoint stats of the local Pods to the antrea-controller periodically,
+	// so that the traffic dropped by HNS on Windows Nodes is visible through the stats API and in the flow records.
+	var interfaceStatsCollector *stats.InterfaceStatsCollector
+	var podInterfaceStatsProvider exporter.PodInterfaceStatsProvider
+	if runtime.IsWindowsPlatform() {
+		interfaceStatsCollector = stats.NewInterfaceStatsCollector(nodeConfig.Name, antreaClientProvider, ifaceStore)
+		podInterfaceStatsProvider = interfaceStatsCollector
+	}
+
+	// The FlowExporter can be started as long as it is enabled in the configuration, when the feature gate is enabled
+	// at runtime. A new FlowExporter is created each time it is started, as a stopped FlowExporter cannot be run again,
+	// and flowExporterProvider provides the running one to the other components. Deny connections are only exported
+	// if the feature gate was enabled when the Agent started, as it determines whether the OVS flows to track them are
+	// installed.
+	var flowExporterProvider *exporter.Provider
+	if o.config.FlowExporter.Enable {
+		flowExporterProvider = exporter.NewProvider()
+		// Instantiate the informer before it is started, in case the FlowExporter is only enabled at runtime.
+		podInformer := localPodInformer.Get()
 		flowExporterOptions := &flowexporter.FlowExporterOptions{
 			FlowCollectorAddr:      o.flowCollectorAddr,
 			FlowCollectorProto:     o.flowCollectorProto,
 			ActiveFlowTimeout:      o.activeFlowTimeout,
 			IdleFlowTimeout:        o.idleFlowTimeout,
+			TimeoutRules:           o.flowExportTimeoutRules,
 			StaleConnectionTimeout: o.staleConnectionTimeout,
 			PollInterval:           o.pollInterval,
-			ConnectUplinkToBridge:  connectUplinkToBridge}
-		flowExporter, err = exporter.NewFlowExporter(
-			podStore,
-			proxier,
-			k8sClient,
-			nodeRouteController,
-			networkConfig.TrafficEncapMode,
-			nodeConfig,
-			v4Enabled,
-			v6Enabled,
-			serviceCIDRNet,
-			serviceCIDRNetv6,
-			ovsDatapathType,
-			o.enableAntreaProxy,
-			networkPolicyController,
-			flowExporterOptions,
-			egressController,
-			l7FlowExporterController,
-			l7FlowExporterEnabled)
-		if err != nil {
-			return fmt.Errorf("error when creating IPFIX flow exporter: %v", err)
+			ConnectUplinkToBridge:  connectUplinkToBridge,
+			PolicyFlowsOnly:        o.config.FlowExporter.PolicyFlowsOnly}
+		if o.config.FlowExporter.SPIFFE.Enable {
+			flowExporterOptions.SPIFFECertDir = o.config.FlowExporter.SPIFFE.CertDir
+			flowExporterOptions.SPIFFEAuthorizedServerIDs = o.config.FlowExporter.SPIFFE.AuthorizedServerIDs
+		}
+		if o.config.FlowExporter.OTLP.Enable {
+			flowExporterOptions.OTLP = &flowexporter.OTLPOptions{
+				Insecure:  o.config.FlowExporter.OTLP.Insecure,
+				PodLabels: o.config.FlowExporter.OTLP.PodLabels,
+			}
+		}
+		newFlowExporter := func() (*exporter.FlowExporter, error) {
+			return exporter.NewFlowExporter(
+				podstore.NewPodStore(podInformer),
+				proxier,
+				k8sClient,
+				nodeRouteController,
+				networkConfig.TrafficEncapMode,
+				nodeConfig,
+				v4Enabled,
+				v6Enabled,
+				serviceCIDRNet,
+				serviceCIDRNetv6,
+				ovsDatapathType,
+				o.enableAntreaProxy,
+				networkPolicyController,
+				flowExporterOptions,
+				egressController,
+				l7FlowExporterController,
+				l7FlowExporterEnabled,
+				podInterfaceStatsProvider)
+		}
+		if err := featureReconfigurer.AddHandler(features.FlowExporter, featurereconfig.Handler{
+			Start: func(stopCh <-chan struct{}) error {
+				flowExporter, err := newFlowExporter()
+				if err != nil {
+					return fmt.Errorf("error when creating IPFIX flow exporter: %w", err)
+				}
+				if enableFlowExporter {
+					networkPolicyController.SetDenyConnStore(flowExporter.GetDenyConnStore())
+				}
+				flowExporterProvider.Set(flowExporter)
+				go flowExporter.Run(stopCh)
+				return nil
+			},
+			Cleanup: func() error {
+				networkPolicyController.SetDenyConnStore(nil)
+				flowExporterProvider.Set(nil)
+				return nil
+			},
+		}); err != nil {
+			return err
 		}
-		networkPolicyController.SetDenyConnStore(flowExporter.GetDenyConnStore())
 	}
 
 	log.StartLogFileNumberMonitor(stopCh)
@@ -739,6 +892,8 @@ func run(o *Options) error {
 		go podUpdateChannel.Run(stopCh)
 		go cniServer.Run(stopCh)
 		go nodeRouteController.Run(stopCh)
+		go nodeIPMonitor.Run(stopCh)
+		go gatewayMonitor.Run(stopCh)
 	} else {
 		go externalEntityUpdateChannel.Run(stopCh)
 		go localExternalNodeInformer.Run(stopCh)
@@ -749,22 +904,51 @@ func run(o *Options) error {
 		go ipsecCertController.Run(stopCh)
 	}
 
+	if ipsecPSKProvider != nil {
+		go ipsecPSKProvider.Run(stopCh)
+	}
+
 	go antreaClientProvider.Run(ctx)
 
-	// Initialize the NPL agent.
-	if o.enableNodePortLocal {
-		nplController, err := npl.InitializeNPLAgent(
-			k8sClient,
-			serviceInformer,
-			localPodInformer.Get(),
-			o.nplStartPort,
-			o.nplEndPort,
-			nodeConfig.Name,
-		)
+	// Initialize the NPL agent when the NodePortLocal feature gate is enabled, which can happen at runtime.
+	if o.config.NodePortLocal.Enable {
+		// Instantiate the informers before they are started, in case NodePortLocal is only enabled at runtime.
+		podInformer := localPodInformer.Get()
+		serviceInformer.Informer()
+		var nplController *nplk8s.NPLController
+		if err := featureReconfigurer.AddHandler(features.NodePortLocal, featurereconfig.Handler{
+			Start: func(stopCh <-chan struct{}) error {
+				var err error
+				nplController, err = npl.InitializeNPLAgent(
+					k8sClient,
+					serviceInformer,
+					podInformer,
+					o.nplStartPort,
+					o.nplEndPort,
+					nodeConfig.Name,
+					networkConfig.IPv4Enabled,
+					networkConfig.IPv6Enabled,
+				)
+				if err != nil {
+					return fmt.Errorf("failed to start NPL agent: %w", err)
+				}
+				go nplController.Run(stopCh)
+				return nil
+			},
+			Cleanup: func() error {
+				return nplController.Cleanup()
+			},
+		}); err != nil {
+			return err
+		}
+	}
+
+	if features.DefaultFeatureGate.Enabled(features.HostPort) && o.nodeType == config.K8sNode {
+		hostPortController, err := hostport.NewController(localPodInformer.Get(), networkConfig.IPv4Enabled, networkConfig.IPv6Enabled)
 		if err != nil {
-			return fmt.Errorf("failed to start NPL agent: %v", err)
+			return fmt.Errorf("failed to create hostPort controller: %v", err)
 		}
-		go nplController.Run(stopCh)
+		go hostPortController.Run(stopCh)
 	}
 
 	// Antrea IPAM is needed by bridging mode and secondary network IPAM.
@@ -807,6 +991,19 @@ func run(o *Options) error {
 		go tcController.Run(stopCh)
 	}
 
+	var fastpathQueuePlugin *deviceplugin.Plugin
+	var fastpathQueueController *deviceplugin.Controller
+	if o.config.FastpathQueue.Enable && o.nodeType == config.K8sNode {
+		fastpathQueuePlugin = deviceplugin.NewPlugin(o.fastpathQueueConfig.Count)
+		fastpathQueueController = deviceplugin.NewController(
+			ovsBridgeClient,
+			ifaceStore,
+			localPodInformer.Get(),
+			podUpdateChannel,
+			o.fastpathQueueConfig,
+		)
+	}
+
 	//  Start the localPodInformer
 	if localPodInformer.Evaluated() {
 		go localPodInformer.Get().Run(stopCh)
@@ -823,6 +1020,11 @@ func run(o *Options) error {
 		)
 	}
 
+	var serviceProber *serviceprobe.Prober
+	if o.config.ServiceProbe.Enable && o.nodeType == config.K8sNode {
+		serviceProber = serviceprobe.NewProber(serviceInformer, o.serviceProbeConfig)
+	}
+
 	informerFactory.Start(stopCh)
 	crdInformerFactory.Start(stopCh)
 
@@ -860,8 +1062,20 @@ func run(o *Options) error {
 			klog.InfoS("AntreaProxy is ready")
 		}
 	}
+	if serviceCIDRRejecter != nil {
+		go serviceCIDRRejecter.Run(stopCh)
+	}
+	if externalTrafficSNATController != nil {
+		go externalTrafficSNATController.Run(stopCh)
+	}
 
 	go networkPolicyController.Run(stopCh)
+	if podReadinessGateController != nil {
+		go podReadinessGateController.Run(stopCh)
+	}
+	if dnsResolverController != nil {
+		go dnsResolverController.Run(stopCh)
+	}
 	if o.enableEgress {
 		go egressController.Run(stopCh)
 	}
@@ -924,6 +1138,11 @@ func run(o *Options) error {
 		return err
 	}
 	klog.InfoS("Flow restoration has completed")
+	// namespaceTunnelVNIController must be run after FlowRestoreComplete, as the flows of the existing Pods are
+	// reinstalled without any VNI when the CNIServer reconciles them.
+	if namespaceTunnelVNIController != nil {
+		go namespaceTunnelVNIController.Run(stopCh)
+	}
 	// ConnectUplinkToOVSBridge must be run immediately after FlowRestoreComplete
 	if connectUplinkToBridge {
 		// Restore network config before shutdown. ovsdbConnection must be alive when restore.
@@ -943,10 +1162,30 @@ func run(o *Options) error {
 	}
 
 	// statsCollector collects stats and reports to the antrea-controller periodically. For now it's only used for
-	// NetworkPolicy stats and Multicast stats.
-	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsCollector := stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController, mcastController)
+	// NetworkPolicy stats and Multicast stats. It runs when the NetworkPolicyStats feature gate is enabled, which can
+	// happen at runtime.
+	startStatsCollector := func(stopCh <-chan struct{}) error {
+		// nsTrafficCollector accounts the traffic of the local Pods by Namespace from the connections tracked by the
+		// FlowExporter, and the stats are reported by statsCollector.
+		var nsTrafficCollector *stats.NamespaceTrafficCollector
+		if features.DefaultFeatureGate.Enabled(features.NamespaceTrafficStats) {
+			if flowExporterProvider != nil {
+				nsTrafficCollector = stats.NewNamespaceTrafficCollector(flowExporterProvider)
+				go nsTrafficCollector.Run(stopCh)
+			} else {
+				klog.InfoS("NamespaceTrafficStats requires the FlowExporter to be enabled, Namespace traffic stats will not be collected")
+			}
+		}
+		statsCollector := stats.NewCollector(antreaClientProvider, ofClient, networkPolicyController, mcastController, nsTrafficCollector)
 		go statsCollector.Run(stopCh)
+		return nil
+	}
+	if err := featureReconfigurer.AddHandler(features.NetworkPolicyStats, featurereconfig.Handler{Start: startStatsCollector}); err != nil {
+		return err
+	}
+
+	if interfaceStatsCollector != nil {
+		go interfaceStatsCollector.Run(stopCh)
 	}
 
 	agentQuerier := querier.NewAgentQuerier(
@@ -955,6 +1194,7 @@ func run(o *Options) error {
 		ifaceStore,
 		k8sClient,
 		ofClient,
+		routeClient,
 		ovsBridgeClient,
 		proxier,
 		networkPolicyController,
@@ -963,6 +1203,7 @@ func run(o *Options) error {
 		memberlistCluster,
 		nodeInformer.Lister(),
 		bgpController,
+		gatewayConfigChecker,
 	)
 
 	if features.DefaultFeatureGate.Enabled(features.SupportBundleCollection) {
@@ -994,6 +1235,8 @@ func run(o *Options) error {
 		mcastController,
 		externalIPController,
 		bgpController,
+		flowExporterProvider,
+		o.effectiveConfig(),
 		secureServing,
 		authentication,
 		authorization,
@@ -1021,19 +1264,34 @@ func run(o *Options) error {
 	agentMonitor := monitor.NewAgentMonitor(crdClient, agentQuerier, agentAPICertData)
 	go agentMonitor.Run(stopCh)
 
+	if o.config.SelfProfiling.Enable {
+		profilingWatchdog := profiling.NewWatchdog(o.selfProfilingConfig)
+		go profilingWatchdog.Run(stopCh)
+	}
+
 	// Start PacketIn and OVS meter stats collection for Prometheus
 	go ofClient.Run(stopCh)
 
-	// Start the goroutine to periodically export IPFIX flow records.
-	if enableFlowExporter {
-		go flowExporter.Run(stopCh)
-	}
+	// Start the reconfigurable features which are enabled, including the goroutine to periodically export IPFIX flow
+	// records.
+	go featureReconfigurer.Run(stopCh)
 
 	// Start the node latency monitor if applicable.
 	if nodeLatencyMonitor != nil {
 		go nodeLatencyMonitor.Run(stopCh)
 	}
 
+	// Start the Service reachability prober if enabled.
+	if serviceProber != nil {
+		go serviceProber.Run(stopCh)
+	}
+
+	// Start the fastpath queue device plugin and the controller capping the rate of the Pods if enabled.
+	if fastpathQueuePlugin != nil {
+		go fastpathQueuePlugin.Run(stopCh)
+		go fastpathQueueController.Run(stopCh)
+	}
+
 	<-stopCh
 	klog.InfoS("Stopping Antrea Agent")
 	return nil
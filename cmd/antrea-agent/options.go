@@ -18,17 +18,29 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/component-base/featuregate"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/controller/ipsecpsk"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy"
+	"antrea.io/antrea/pkg/agent/deviceplugin"
+	"antrea.io/antrea/pkg/agent/externalnode"
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/profiling"
+	"antrea.io/antrea/pkg/agent/serviceprobe"
+	"antrea.io/antrea/pkg/agent/util/numa"
 	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/cni"
 	agentconfig "antrea.io/antrea/pkg/config/agent"
@@ -37,6 +49,7 @@ import (
 	"antrea.io/antrea/pkg/util/env"
 	"antrea.io/antrea/pkg/util/flowexport"
 	"antrea.io/antrea/pkg/util/ip"
+	"antrea.io/antrea/pkg/util/spiffe"
 	"antrea.io/antrea/pkg/util/yaml"
 )
 
@@ -61,10 +74,37 @@ const (
 	defaultAuditLogsMaxAge         = 28
 	defaultAuditLogsCompressed     = true
 	defaultPacketInRate            = 500
+	cpuAffinityAuto                = "auto"
+
+	defaultSelfProfilingCPUThreshold       = 200
+	defaultSelfProfilingMemoryThreshold    = 1024
+	defaultSelfProfilingCheckInterval      = "10s"
+	defaultSelfProfilingCPUProfileDuration = "30s"
+	defaultSelfProfilingMinCaptureInterval = "1h"
+	defaultSelfProfilingMaxProfiles        = 3
+	defaultIPsecVaultMountPath             = "secret"
+	defaultIPsecVaultRefreshInterval       = "1m"
+	defaultFlowExporterSPIFFECertDir       = "/run/spiffe/certs"
+	defaultFlowExporterOTLPPort            = "4317"
+
+	defaultServiceProbeInterval = "30s"
+	defaultServiceProbeTimeout  = "5s"
+
+	defaultFastpathQueueCount = 8
+	defaultFastpathQueueRate  = "1G"
+	defaultFastpathQueueBurst = "100M"
+
+	defaultMultipathHealthCheckInterval = "5s"
 )
 
 var defaultIGMPQueryVersions = []int{1, 2, 3}
 
+var flowExportTimeoutRuleProtocols = map[string]uint8{
+	"TCP":  ip.TCPProtocol,
+	"UDP":  ip.UDPProtocol,
+	"SCTP": ip.SCTPProtocol,
+}
+
 type Options struct {
 	// The path of configuration file.
 	configFile string
@@ -84,12 +124,35 @@ type Options struct {
 	idleFlowTimeout time.Duration
 	// Stale connection timeout to delete connections if they are not exported.
 	staleConnectionTimeout time.Duration
+	// Rules overriding the active and idle flow timeouts for some traffic classes
+	flowExportTimeoutRules []flowexporter.FlowExportTimeoutRule
 	igmpQueryInterval      time.Duration
 	igmpQueryVersions      []uint8
 	nplStartPort           int
 	nplEndPort             int
 	dnsServerOverride      string
 	nodeType               config.NodeType
+	// CPUs for OVS PMD threads, empty if they should not be configured or should be
+	// computed from the NUMA node of the transport interface ("auto").
+	ovsPMDCPUs []int
+	// Configuration of the profiling watchdog, parsed from the selfProfiling config.
+	selfProfilingConfig profiling.Config
+	// Configuration of the Service reachability prober, parsed from the serviceProbe config.
+	serviceProbeConfig serviceprobe.Config
+	// Configuration of the fastpath queue device plugin, parsed from the fastpathQueue config.
+	fastpathQueueConfig deviceplugin.Config
+	// Configuration of the FQDN cache, parsed from the fqdnCacheMinTTL, fqdnCacheStaleTTL and fqdnStaticIPs config.
+	fqdnCacheConfig networkpolicy.FQDNCacheConfig
+	// The IPs of the cluster DNS resolvers, parsed from dnsResolverRestriction.clusterResolvers.
+	dnsClusterResolvers []net.IP
+	// Configuration of the IPsec PSK provider, parsed from the ipsec config. Only used when the PSKs are read from
+	// an external secret store.
+	ipsecPSKConfig ipsecpsk.Config
+
+	// The stabilization window of Egress IP assignments, parsed from egress.assignmentStabilizationWindow.
+	egressAssignmentStabilizationWindow time.Duration
+	// The interval of the health check of the multipath routes, parsed from multipathRouting.healthCheckInterval.
+	multipathHealthCheckInterval time.Duration
 
 	// enableEgress represents whether Egress should run or not, calculated from its feature gate configuration and
 	// whether the traffic mode supports it.
@@ -98,10 +161,6 @@ type Options struct {
 	// AntreaProxy.Enable. This is used to maintain compatibility with the AntreaProxy feature gate, which was promoted
 	// to GA in v1.14.
 	enableAntreaProxy bool
-	// enableNodePortLocal indicates whether NodePortLocal should be enabled or not, based on feature gate NodePortLocal
-	// and options NodePortLocal.Enable. This is used to maintain compatibility with the NodePortLocal feature gate, which
-	// was promoted to GA in v1.14
-	enableNodePortLocal bool
 
 	defaultLoadBalancerMode config.LoadBalancerMode
 }
@@ -155,8 +214,24 @@ func (o *Options) validate(args []string) error {
 		return fmt.Errorf("nodeType %s requires feature gate ExternalNode to be enabled", o.config.NodeType)
 	}
 
-	if o.config.FQDNCacheMinTTL < 0 {
-		return fmt.Errorf("fqdnCacheMinTTL must be greater than or equal to 0")
+	if err := o.validateFQDNCacheConfig(); err != nil {
+		return err
+	}
+
+	if err := o.validateSelfProfilingConfig(); err != nil {
+		return fmt.Errorf("failed to validate selfProfiling config: %v", err)
+	}
+
+	if err := o.validateServiceProbeConfig(); err != nil {
+		return fmt.Errorf("failed to validate serviceProbe config: %v", err)
+	}
+
+	if err := o.validateFastpathQueueConfig(); err != nil {
+		return fmt.Errorf("failed to validate fastpathQueue config: %v", err)
+	}
+
+	if err := o.validateAuditLoggingConfig(); err != nil {
+		return fmt.Errorf("failed to validate auditLogging config: %v", err)
 	}
 
 	if o.config.NodeType == config.ExternalNode.String() {
@@ -211,6 +286,18 @@ func (o *Options) setDefaults() {
 		o.config.PacketInRate = defaultPacketInRate
 	}
 	o.setAuditLoggingDefaultOptions()
+	o.setSelfProfilingDefaultOptions()
+	o.setServiceProbeDefaultOptions()
+	o.setFastpathQueueDefaultOptions()
+	o.setMultipathRoutingDefaultOptions()
+}
+
+// effectiveConfig returns a copy of the configuration with the defaults applied and the state of all the agent
+// feature gates resolved. It must be called after complete.
+func (o *Options) effectiveConfig() *agentconfig.AgentConfig {
+	cfg := *o.config
+	cfg.FeatureGates = features.GetEffectiveFeatureGates(features.AgentGates)
+	return &cfg
 }
 
 func (o *Options) validateTLSOptions() error {
@@ -250,6 +337,19 @@ func (o *Options) validateAntreaProxyConfig(encapMode config.TrafficEncapModeTyp
 		}
 	}
 
+	if o.enableAntreaProxy && o.config.AntreaProxy.RejectUnallocatedClusterIPs {
+		if len(o.config.AntreaProxy.SkipServices) > 0 {
+			return fmt.Errorf("rejectUnallocatedClusterIPs cannot be enabled when skipServices is set")
+		}
+		if o.config.AntreaProxy.ServiceProxyName != "" {
+			return fmt.Errorf("rejectUnallocatedClusterIPs cannot be enabled when serviceProxyName is set")
+		}
+	}
+
+	if o.enableAntreaProxy && o.config.AntreaProxy.ExternalTrafficSNATIPPool != "" && encapMode != config.TrafficEncapModeEncap {
+		return fmt.Errorf("externalTrafficSNATIPPool requires %s mode", config.TrafficEncapModeEncap)
+	}
+
 	if o.config.AntreaProxy.ProxyAll {
 		for _, nodePortAddress := range o.config.AntreaProxy.NodePortAddresses {
 			if _, _, err := net.ParseCIDR(nodePortAddress); err != nil {
@@ -274,17 +374,112 @@ func (o *Options) validateAntreaProxyConfig(encapMode config.TrafficEncapModeTyp
 	return nil
 }
 
+func (o *Options) parseFlowExportTimeoutRules() ([]flowexporter.FlowExportTimeoutRule, error) {
+	var rules []flowexporter.FlowExportTimeoutRule
+	parseTimeout := func(value string, defaultTimeout time.Duration) (time.Duration, error) {
+		if value == "" {
+			return defaultTimeout, nil
+		}
+		timeout, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, err
+		}
+		if timeout < o.pollInterval {
+			klog.InfoS("Flow export timeout of timeout rule is smaller than FlowPollInterval, using FlowPollInterval instead", "timeout", value)
+			return o.pollInterval, nil
+		}
+		return timeout, nil
+	}
+	for i, ruleConfig := range o.config.FlowExporter.TimeoutRules {
+		var rule flowexporter.FlowExportTimeoutRule
+		if ruleConfig.Protocol != "" {
+			protocol, ok := flowExportTimeoutRuleProtocols[strings.ToUpper(ruleConfig.Protocol)]
+			if !ok {
+				return nil, fmt.Errorf("invalid protocol %s in flow export timeout rule %d, supported protocols are TCP, UDP and SCTP", ruleConfig.Protocol, i)
+			}
+			rule.Protocol = protocol
+		}
+		for _, port := range ruleConfig.DestinationPorts {
+			if port <= 0 || port > 65535 {
+				return nil, fmt.Errorf("invalid destination port %d in flow export timeout rule %d", port, i)
+			}
+			rule.DestinationPorts = append(rule.DestinationPorts, uint16(port))
+		}
+		var err error
+		if rule.ActiveFlowTimeout, err = parseTimeout(ruleConfig.ActiveFlowExportTimeout, o.activeFlowTimeout); err != nil {
+			return nil, fmt.Errorf("invalid activeFlowExportTimeout in flow export timeout rule %d: %w", i, err)
+		}
+		if rule.IdleFlowTimeout, err = parseTimeout(ruleConfig.IdleFlowExportTimeout, o.idleFlowTimeout); err != nil {
+			return nil, fmt.Errorf("invalid idleFlowExportTimeout in flow export timeout rule %d: %w", i, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// parseOTLPEndpoint parses the OTLP endpoint of the flow collector, provided as <HOST>[:<PORT>], and returns it as
+// <HOST>:<PORT>.
+func parseOTLPEndpoint(endpoint string) (string, error) {
+	if endpoint == "" {
+		return "", fmt.Errorf("endpoint must be provided when OTLP export is enabled")
+	}
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		// The port is optional, but the endpoint must then be a valid host: an IPv6 address may be provided
+		// without brackets.
+		host, port = strings.TrimSuffix(strings.TrimPrefix(endpoint, "["), "]"), defaultFlowExporterOTLPPort
+		if strings.Contains(host, ":") && net.ParseIP(host) == nil {
+			return "", fmt.Errorf("invalid OTLP endpoint %s: %w", endpoint, err)
+		}
+	}
+	if host == "" {
+		return "", fmt.Errorf("invalid OTLP endpoint %s: host is missing", endpoint)
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return "", fmt.Errorf("invalid OTLP endpoint %s: invalid port %s", endpoint, port)
+	}
+	return net.JoinHostPort(host, port), nil
+}
+
 func (o *Options) validateFlowExporterConfig() error {
-	if features.DefaultFeatureGate.Enabled(features.FlowExporter) && o.config.FlowExporter.Enable {
+	// The configuration is validated even if the feature gate is disabled, as FlowExporter can be enabled at runtime by
+	// updating the feature gate.
+	if o.config.FlowExporter.Enable {
 		if features.DefaultFeatureGate.Enabled(features.AntreaIPAM) {
 			klog.InfoS("The FlowExporter feature does not support AntreaIPAM Pods")
 		}
-		host, port, proto, err := flowexport.ParseFlowCollectorAddr(o.config.FlowExporter.FlowCollectorAddr, defaultFlowCollectorPort, defaultFlowCollectorTransport)
-		if err != nil {
-			return err
+		if o.config.FlowExporter.OTLP.Enable {
+			if o.config.FlowExporter.SPIFFE.Enable {
+				return fmt.Errorf("SPIFFE cannot be enabled when flow records are exported using OTLP")
+			}
+			endpoint, err := parseOTLPEndpoint(o.config.FlowExporter.OTLP.Endpoint)
+			if err != nil {
+				return err
+			}
+			// gRPC always runs over TCP, TLS is configured separately.
+			o.flowCollectorAddr = endpoint
+			o.flowCollectorProto = "tcp"
+		} else {
+			host, port, proto, err := flowexport.ParseFlowCollectorAddr(o.config.FlowExporter.FlowCollectorAddr, defaultFlowCollectorPort, defaultFlowCollectorTransport)
+			if err != nil {
+				return err
+			}
+			o.flowCollectorAddr = net.JoinHostPort(host, port)
+			o.flowCollectorProto = proto
+		}
+		if o.config.FlowExporter.SPIFFE.Enable {
+			if proto != "tls" {
+				return fmt.Errorf("SPIFFE can only be enabled when the flow collector protocol is tls")
+			}
+			if len(o.config.FlowExporter.SPIFFE.AuthorizedServerIDs) == 0 {
+				return fmt.Errorf("authorizedServerIDs must be provided when SPIFFE is enabled")
+			}
+			for _, id := range o.config.FlowExporter.SPIFFE.AuthorizedServerIDs {
+				if err := spiffe.ValidateID(id); err != nil {
+					return err
+				}
+			}
 		}
-		o.flowCollectorAddr = net.JoinHostPort(host, port)
-		o.flowCollectorProto = proto
 
 		// Parse the given flowPollInterval config
 		if o.config.FlowExporter.FlowPollInterval != "" {
@@ -316,12 +511,16 @@ func (o *Options) validateFlowExporterConfig() error {
 				klog.Warningf("IdleFlowExportTimeout must be greater than or equal to FlowPollInterval")
 			}
 		}
-		if (o.activeFlowTimeout > defaultStaleConnectionTimeout) || (o.idleFlowTimeout > defaultStaleConnectionTimeout) {
-			if o.activeFlowTimeout > o.idleFlowTimeout {
-				o.staleConnectionTimeout = 2 * o.activeFlowTimeout
-			} else {
-				o.staleConnectionTimeout = 2 * o.idleFlowTimeout
-			}
+		o.flowExportTimeoutRules, err = o.parseFlowExportTimeoutRules()
+		if err != nil {
+			return err
+		}
+		maxFlowTimeout := max(o.activeFlowTimeout, o.idleFlowTimeout)
+		for _, rule := range o.flowExportTimeoutRules {
+			maxFlowTimeout = max(maxFlowTimeout, rule.ActiveFlowTimeout, rule.IdleFlowTimeout)
+		}
+		if maxFlowTimeout > defaultStaleConnectionTimeout {
+			o.staleConnectionTimeout = 2 * maxFlowTimeout
 		} else {
 			o.staleConnectionTimeout = defaultStaleConnectionTimeout
 		}
@@ -443,8 +642,20 @@ func (o *Options) setK8sNodeDefaultOptions() {
 	if o.config.IPsec.AuthenticationMode == "" {
 		o.config.IPsec.AuthenticationMode = config.IPsecAuthenticationModePSK.String()
 	}
+	if o.config.IPsec.PSKSource == "" {
+		o.config.IPsec.PSKSource = string(config.IPsecPSKSourceEnv)
+	}
+	if o.config.IPsec.PSKZoneLabel == "" {
+		o.config.IPsec.PSKZoneLabel = ipsecpsk.DefaultZoneLabel
+	}
+	if o.config.IPsec.Vault.MountPath == "" {
+		o.config.IPsec.Vault.MountPath = defaultIPsecVaultMountPath
+	}
+	if o.config.IPsec.Vault.RefreshInterval == "" {
+		o.config.IPsec.Vault.RefreshInterval = defaultIPsecVaultRefreshInterval
+	}
 
-	if features.DefaultFeatureGate.Enabled(features.FlowExporter) {
+	if features.DefaultFeatureGate.Enabled(features.FlowExporter) || o.config.FlowExporter.Enable {
 		if o.config.FlowExporter.FlowCollectorAddr == "" {
 			o.config.FlowExporter.FlowCollectorAddr = defaultFlowCollectorAddress
 			if o.config.FlowCollectorAddr != "" {
@@ -473,6 +684,9 @@ func (o *Options) setK8sNodeDefaultOptions() {
 				o.config.FlowExporter.IdleFlowExportTimeout = o.config.IdleFlowExportTimeout
 			}
 		}
+		if o.config.FlowExporter.SPIFFE.Enable && o.config.FlowExporter.SPIFFE.CertDir == "" {
+			o.config.FlowExporter.SPIFFE.CertDir = defaultFlowExporterSPIFFECertDir
+		}
 	}
 
 	if o.config.NodePortLocal.Enable {
@@ -525,10 +739,42 @@ func (o *Options) validateEgressConfig(encapMode config.TrafficEncapModeType) er
 	if o.config.Egress.MaxEgressIPsPerNode > defaultMaxEgressIPsPerNode {
 		return fmt.Errorf("maxEgressIPsPerNode cannot be greater than %d", defaultMaxEgressIPsPerNode)
 	}
+	if o.config.Egress.AssignmentStabilizationWindow != "" {
+		window, err := time.ParseDuration(o.config.Egress.AssignmentStabilizationWindow)
+		if err != nil {
+			return fmt.Errorf("assignmentStabilizationWindow %s is invalid: %v", o.config.Egress.AssignmentStabilizationWindow, err)
+		}
+		if window < 0 {
+			return fmt.Errorf("assignmentStabilizationWindow cannot be negative")
+		}
+		o.egressAssignmentStabilizationWindow = window
+	}
 	o.enableEgress = true
 	return nil
 }
 
+func (o *Options) validateNamespaceTunnelVNIConfig(encapMode config.TrafficEncapModeType, encryptionMode config.TrafficEncryptionModeType) error {
+	if !o.config.EnableNamespaceTunnelVNI {
+		return nil
+	}
+	if !encapMode.SupportsEncap() {
+		return fmt.Errorf("enableNamespaceTunnelVNI requires %s or %s mode", config.TrafficEncapModeEncap, config.TrafficEncapModeHybrid)
+	}
+	if o.config.TunnelType != ovsconfig.GeneveTunnel && o.config.TunnelType != ovsconfig.VXLANTunnel {
+		return fmt.Errorf("enableNamespaceTunnelVNI requires tunnel type %s or %s", ovsconfig.GeneveTunnel, ovsconfig.VXLANTunnel)
+	}
+	// IPsec uses a dedicated tunnel port per peer Node which doesn't support setting the tunnel ID, and WireGuard
+	// doesn't use the tunnel.
+	if encryptionMode != config.TrafficEncryptionModeNone {
+		return fmt.Errorf("enableNamespaceTunnelVNI cannot be used with TrafficEncryptionMode %s", encryptionMode)
+	}
+	// The tunnel ID carries the LabelIdentity of the source Pod when StretchedNetworkPolicy is enabled.
+	if features.DefaultFeatureGate.Enabled(features.Multicluster) && o.config.Multicluster.EnableStretchedNetworkPolicy {
+		return fmt.Errorf("enableNamespaceTunnelVNI cannot be used with Multi-cluster StretchedNetworkPolicy")
+	}
+	return nil
+}
+
 func (o *Options) validateK8sNodeOptions() error {
 	if o.config.TunnelType != ovsconfig.VXLANTunnel && o.config.TunnelType != ovsconfig.GeneveTunnel &&
 		o.config.TunnelType != ovsconfig.GRETunnel && o.config.TunnelType != ovsconfig.STTTunnel {
@@ -549,6 +795,11 @@ func (o *Options) validateK8sNodeOptions() error {
 	if ipsecAuthMode == config.IPsecAuthenticationModeCert && !features.DefaultFeatureGate.Enabled(features.IPsecCertAuth) {
 		return fmt.Errorf("IPsec AuthenticationMode %s requires feature gate %s to be enabled", o.config.TrafficEncapMode, features.IPsecCertAuth)
 	}
+	if encryptionMode == config.TrafficEncryptionModeIPSec && ipsecAuthMode == config.IPsecAuthenticationModePSK {
+		if err := o.validateIPsecPSKConfig(); err != nil {
+			return fmt.Errorf("failed to validate IPsec PSK config: %w", err)
+		}
+	}
 
 	// Check if the enabled features are supported on the OS.
 	if err := o.checkUnsupportedFeatures(); err != nil {
@@ -596,6 +847,15 @@ func (o *Options) validateK8sNodeOptions() error {
 	if err := o.validateMulticlusterConfig(encapMode, encryptionMode); err != nil {
 		return err
 	}
+	if err := o.validateNamespaceTunnelVNIConfig(encapMode, encryptionMode); err != nil {
+		return err
+	}
+	if err := o.validateMultipathRoutingConfig(encapMode); err != nil {
+		return fmt.Errorf("failed to validate multipathRouting config: %v", err)
+	}
+	if err := o.validateDNSResolverRestrictionConfig(); err != nil {
+		return fmt.Errorf("failed to validate dnsResolverRestriction config: %v", err)
+	}
 	if err := o.validateNodePortLocalConfig(); err != nil {
 		return fmt.Errorf("failed to validate nodePortLocal config: %v", err)
 	}
@@ -615,6 +875,9 @@ func (o *Options) validateK8sNodeOptions() error {
 	if err := o.validateSecondaryNetworkConfig(); err != nil {
 		return fmt.Errorf("failed to validate secondary network config: %v", err)
 	}
+	if err := o.validateCPUAffinityConfig(); err != nil {
+		return fmt.Errorf("failed to validate cpuAffinity config: %v", err)
+	}
 
 	// Unlike checkUnsupportedFeatures, validateConfigForPlatform runs after all validations and
 	// after all fields in the Options struct have been initialized (e.g., enableProxy).
@@ -655,6 +918,23 @@ func (o *Options) validateExternalNodeOptions() error {
 	if err := o.validatePolicyBypassRulesConfig(); err != nil {
 		return fmt.Errorf("policyBypassRules configuration is invalid: %w", err)
 	}
+	if err := o.validateExternalNodeRegistrationConfig(); err != nil {
+		return fmt.Errorf("registration configuration is invalid: %w", err)
+	}
+	return nil
+}
+
+func (o *Options) validateExternalNodeRegistrationConfig() error {
+	registrationConfig := o.config.ExternalNode.Registration
+	if !registrationConfig.Enable {
+		return nil
+	}
+	if registrationConfig.Interface == "" {
+		return fmt.Errorf("interface must be provided when registration is enabled")
+	}
+	if _, err := externalnode.ParseRegistrationLabels(registrationConfig.Labels); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -729,6 +1009,325 @@ func (o *Options) setAuditLoggingDefaultOptions() {
 		compress := defaultAuditLogsCompressed
 		auditLogging.Compress = &compress
 	}
+	if auditLogging.Format == "" {
+		auditLogging.Format = networkpolicy.AuditLogFormatText
+	}
+	if auditLogging.SamplingRate == 0 {
+		auditLogging.SamplingRate = 1
+	}
+	if auditLogging.Destination == "" {
+		auditLogging.Destination = networkpolicy.AuditLogDestinationFile
+	}
+}
+
+func (o *Options) validateAuditLoggingConfig() error {
+	auditLogging := o.config.AuditLogging
+	if auditLogging.Format != "" && auditLogging.Format != networkpolicy.AuditLogFormatText && auditLogging.Format != networkpolicy.AuditLogFormatJSON {
+		return fmt.Errorf("unsupported format %s, must be one of %s and %s", auditLogging.Format, networkpolicy.AuditLogFormatText, networkpolicy.AuditLogFormatJSON)
+	}
+	if auditLogging.SamplingRate < 0 {
+		return fmt.Errorf("samplingRate must be greater than or equal to 0")
+	}
+	for logLabel, rate := range auditLogging.RuleSamplingRates {
+		if rate < 1 {
+			return fmt.Errorf("sampling rate of log label %s must be greater than or equal to 1", logLabel)
+		}
+	}
+	switch auditLogging.Destination {
+	case "", networkpolicy.AuditLogDestinationFile:
+	case networkpolicy.AuditLogDestinationUnixSocket:
+		if auditLogging.Address == "" {
+			return fmt.Errorf("address must be set when destination is %s", auditLogging.Destination)
+		}
+	case networkpolicy.AuditLogDestinationSyslog:
+		if _, _, err := networkpolicy.ParseSyslogAddress(auditLogging.Address); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported destination %s, must be one of %s, %s and %s", auditLogging.Destination,
+			networkpolicy.AuditLogDestinationFile, networkpolicy.AuditLogDestinationUnixSocket, networkpolicy.AuditLogDestinationSyslog)
+	}
+	return nil
+}
+
+func (o *Options) setSelfProfilingDefaultOptions() {
+	selfProfiling := &o.config.SelfProfiling
+	if selfProfiling.CPUThreshold == 0 {
+		selfProfiling.CPUThreshold = defaultSelfProfilingCPUThreshold
+	}
+	if selfProfiling.MemoryThreshold == 0 {
+		selfProfiling.MemoryThreshold = defaultSelfProfilingMemoryThreshold
+	}
+	if selfProfiling.CheckInterval == "" {
+		selfProfiling.CheckInterval = defaultSelfProfilingCheckInterval
+	}
+	if selfProfiling.CPUProfileDuration == "" {
+		selfProfiling.CPUProfileDuration = defaultSelfProfilingCPUProfileDuration
+	}
+	if selfProfiling.MinCaptureInterval == "" {
+		selfProfiling.MinCaptureInterval = defaultSelfProfilingMinCaptureInterval
+	}
+	if selfProfiling.MaxProfiles == 0 {
+		selfProfiling.MaxProfiles = defaultSelfProfilingMaxProfiles
+	}
+}
+
+func (o *Options) validateSelfProfilingConfig() error {
+	selfProfiling := o.config.SelfProfiling
+	if !selfProfiling.Enable {
+		return nil
+	}
+	if selfProfiling.CPUThreshold < 0 {
+		return fmt.Errorf("cpuThreshold must be greater than or equal to 0")
+	}
+	if selfProfiling.MemoryThreshold < 0 {
+		return fmt.Errorf("memoryThreshold must be greater than or equal to 0")
+	}
+	if selfProfiling.MaxProfiles <= 0 {
+		return fmt.Errorf("maxProfiles must be greater than 0")
+	}
+	parseDuration := func(name, value string) (time.Duration, error) {
+		duration, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, fmt.Errorf("%s is not a valid duration: %v", name, err)
+		}
+		if duration <= 0 {
+			return 0, fmt.Errorf("%s must be greater than 0", name)
+		}
+		return duration, nil
+	}
+	checkInterval, err := parseDuration("checkInterval", selfProfiling.CheckInterval)
+	if err != nil {
+		return err
+	}
+	cpuProfileDuration, err := parseDuration("cpuProfileDuration", selfProfiling.CPUProfileDuration)
+	if err != nil {
+		return err
+	}
+	minCaptureInterval, err := parseDuration("minCaptureInterval", selfProfiling.MinCaptureInterval)
+	if err != nil {
+		return err
+	}
+	o.selfProfilingConfig = profiling.Config{
+		CPUThreshold:       selfProfiling.CPUThreshold,
+		MemoryThreshold:    uint64(selfProfiling.MemoryThreshold) << 20,
+		CheckInterval:      checkInterval,
+		CPUProfileDuration: cpuProfileDuration,
+		MinCaptureInterval: minCaptureInterval,
+		MaxProfiles:        selfProfiling.MaxProfiles,
+	}
+	return nil
+}
+
+func (o *Options) setServiceProbeDefaultOptions() {
+	serviceProbe := &o.config.ServiceProbe
+	if serviceProbe.Interval == "" {
+		serviceProbe.Interval = defaultServiceProbeInterval
+	}
+	if serviceProbe.Timeout == "" {
+		serviceProbe.Timeout = defaultServiceProbeTimeout
+	}
+}
+
+func (o *Options) validateServiceProbeConfig() error {
+	serviceProbe := o.config.ServiceProbe
+	if !serviceProbe.Enable {
+		return nil
+	}
+	for _, service := range serviceProbe.Services {
+		namespace, name, err := cache.SplitMetaNamespaceKey(service)
+		if err != nil || namespace == "" || name == "" {
+			return fmt.Errorf("service %q is not in the <namespace>/<name> format", service)
+		}
+	}
+	interval, err := time.ParseDuration(serviceProbe.Interval)
+	if err != nil {
+		return fmt.Errorf("interval is not a valid duration: %v", err)
+	}
+	timeout, err := time.ParseDuration(serviceProbe.Timeout)
+	if err != nil {
+		return fmt.Errorf("timeout is not a valid duration: %v", err)
+	}
+	if timeout <= 0 || timeout > interval {
+		return fmt.Errorf("timeout must be greater than 0 and not greater than interval")
+	}
+	if serviceProbe.NetworkNamespace != "" && !filepath.IsAbs(serviceProbe.NetworkNamespace) {
+		return fmt.Errorf("networkNamespace must be an absolute path")
+	}
+	o.serviceProbeConfig = serviceprobe.Config{
+		Services:         serviceProbe.Services,
+		Interval:         interval,
+		Timeout:          timeout,
+		NetworkNamespace: serviceProbe.NetworkNamespace,
+	}
+	return nil
+}
+
+func (o *Options) setFastpathQueueDefaultOptions() {
+	fastpathQueue := &o.config.FastpathQueue
+	if fastpathQueue.Count == 0 {
+		fastpathQueue.Count = defaultFastpathQueueCount
+	}
+	if fastpathQueue.Rate == "" {
+		fastpathQueue.Rate = defaultFastpathQueueRate
+	}
+	if fastpathQueue.Burst == "" {
+		fastpathQueue.Burst = defaultFastpathQueueBurst
+	}
+}
+
+func (o *Options) validateFastpathQueueConfig() error {
+	fastpathQueue := o.config.FastpathQueue
+	if !fastpathQueue.Enable {
+		return nil
+	}
+	if fastpathQueue.Count <= 0 {
+		return fmt.Errorf("count must be greater than 0")
+	}
+	// OVS expects the rate in kbps and the burst size in kb.
+	rate, err := resource.ParseQuantity(fastpathQueue.Rate)
+	if err != nil {
+		return fmt.Errorf("rate is not a valid quantity: %v", err)
+	}
+	if rate.Value() < 1000 {
+		return fmt.Errorf("rate must be at least 1k")
+	}
+	burst, err := resource.ParseQuantity(fastpathQueue.Burst)
+	if err != nil {
+		return fmt.Errorf("burst is not a valid quantity: %v", err)
+	}
+	if burst.Value() < 1000 {
+		return fmt.Errorf("burst must be at least 1k")
+	}
+	o.fastpathQueueConfig = deviceplugin.Config{
+		Count: fastpathQueue.Count,
+		Rate:  int(rate.Value() / 1000),
+		Burst: int(burst.Value() / 1000),
+	}
+	return nil
+}
+
+func (o *Options) setMultipathRoutingDefaultOptions() {
+	if o.config.MultipathRouting.HealthCheckInterval == "" {
+		o.config.MultipathRouting.HealthCheckInterval = defaultMultipathHealthCheckInterval
+	}
+}
+
+func (o *Options) validateMultipathRoutingConfig(encapMode config.TrafficEncapModeType) error {
+	multipathRouting := o.config.MultipathRouting
+	if !multipathRouting.Enable {
+		return nil
+	}
+	if !encapMode.SupportsNoEncap() {
+		return fmt.Errorf("multipath routing requires %s or %s mode", config.TrafficEncapModeNoEncap, config.TrafficEncapModeHybrid)
+	}
+	if len(multipathRouting.UplinkInterfaces) == 0 {
+		return fmt.Errorf("uplinkInterfaces must be specified")
+	}
+	for _, name := range multipathRouting.UplinkInterfaces {
+		if name == "" {
+			return fmt.Errorf("uplinkInterfaces cannot contain an empty name")
+		}
+		if name == o.config.TransportInterface {
+			return fmt.Errorf("uplinkInterfaces cannot contain the transport interface %s", name)
+		}
+	}
+	interval, err := time.ParseDuration(multipathRouting.HealthCheckInterval)
+	if err != nil {
+		return fmt.Errorf("healthCheckInterval is not a valid duration: %v", err)
+	}
+	if interval < time.Second {
+		return fmt.Errorf("healthCheckInterval must be at least 1s")
+	}
+	o.multipathHealthCheckInterval = interval
+	return nil
+}
+
+func (o *Options) validateDNSResolverRestrictionConfig() error {
+	dnsResolverRestriction := o.config.DNSResolverRestriction
+	if !dnsResolverRestriction.Enable {
+		return nil
+	}
+	for _, ipStr := range dnsResolverRestriction.ClusterResolvers {
+		ip := net.ParseIP(ipStr)
+		if ip == nil {
+			return fmt.Errorf("clusterResolvers value %q is not a valid IP address", ipStr)
+		}
+		o.dnsClusterResolvers = append(o.dnsClusterResolvers, ip)
+	}
+	return nil
+}
+
+func (o *Options) validateFQDNCacheConfig() error {
+	if o.config.FQDNCacheMinTTL < 0 {
+		return fmt.Errorf("fqdnCacheMinTTL must be greater than or equal to 0")
+	}
+	if o.config.FQDNCacheStaleTTL < 0 {
+		return fmt.Errorf("fqdnCacheStaleTTL must be greater than or equal to 0")
+	}
+	staticIPs := make(map[string][]net.IP, len(o.config.FQDNStaticIPs))
+	for fqdn, ipStrs := range o.config.FQDNStaticIPs {
+		if fqdn == "" || strings.Contains(fqdn, "*") {
+			return fmt.Errorf("fqdnStaticIPs key %q must be a fully qualified domain name", fqdn)
+		}
+		for _, ipStr := range ipStrs {
+			ip := net.ParseIP(ipStr)
+			if ip == nil {
+				return fmt.Errorf("fqdnStaticIPs value %q for %s is not a valid IP address", ipStr, fqdn)
+			}
+			staticIPs[fqdn] = append(staticIPs[fqdn], ip)
+		}
+	}
+	o.fqdnCacheConfig = networkpolicy.FQDNCacheConfig{
+		MinTTL:    uint32(o.config.FQDNCacheMinTTL),
+		StaleTTL:  uint32(o.config.FQDNCacheStaleTTL),
+		StaticIPs: staticIPs,
+	}
+	return nil
+}
+
+func (o *Options) validateIPsecPSKConfig() error {
+	ipsec := o.config.IPsec
+	pskSource := config.IPsecPSKSource(ipsec.PSKSource)
+	if !pskSource.IsValid() {
+		return fmt.Errorf("pskSource %s is unknown", ipsec.PSKSource)
+	}
+	if pskSource == config.IPsecPSKSourceEnv {
+		return nil
+	}
+	o.ipsecPSKConfig = ipsecpsk.Config{
+		Source:    pskSource,
+		ZoneLabel: ipsec.PSKZoneLabel,
+	}
+	if pskSource != config.IPsecPSKSourceVault {
+		return nil
+	}
+	vault := ipsec.Vault
+	if vault.Address == "" {
+		return fmt.Errorf("vault.address must be set when pskSource is %s", pskSource)
+	}
+	if vault.Path == "" {
+		return fmt.Errorf("vault.path must be set when pskSource is %s", pskSource)
+	}
+	if vault.TokenFile == "" {
+		return fmt.Errorf("vault.tokenFile must be set when pskSource is %s", pskSource)
+	}
+	refreshInterval, err := time.ParseDuration(vault.RefreshInterval)
+	if err != nil {
+		return fmt.Errorf("vault.refreshInterval is not a valid duration: %v", err)
+	}
+	if refreshInterval <= 0 {
+		return fmt.Errorf("vault.refreshInterval must be greater than 0")
+	}
+	o.ipsecPSKConfig.Vault = ipsecpsk.VaultConfig{
+		Address:         vault.Address,
+		MountPath:       vault.MountPath,
+		Path:            vault.Path,
+		TokenFile:       vault.TokenFile,
+		CACertFile:      vault.CACertFile,
+		RefreshInterval: refreshInterval,
+	}
+	return nil
 }
 
 func (o *Options) validateSecondaryNetworkConfig() error {
@@ -736,29 +1335,58 @@ func (o *Options) validateSecondaryNetworkConfig() error {
 		return nil
 	}
 
-	if len(o.config.SecondaryNetwork.OVSBridges) == 0 {
+	bridgeNames := sets.New[string]()
+	physicalInterfaces := sets.New[string]()
+	for _, brConfig := range o.config.SecondaryNetwork.OVSBridges {
+		if brConfig.BridgeName == "" {
+			return fmt.Errorf("bridge name is not provided for the secondary network OVS bridge")
+		}
+		if bridgeNames.Has(brConfig.BridgeName) {
+			return fmt.Errorf("secondary network OVS bridge %s is specified more than once", brConfig.BridgeName)
+		}
+		bridgeNames.Insert(brConfig.BridgeName)
+		if len(brConfig.PhysicalInterfaces) > 8 {
+			return fmt.Errorf("at most eight physical interfaces can be specified for the secondary network OVS bridge")
+		}
+		for _, phyInterface := range brConfig.PhysicalInterfaces {
+			if physicalInterfaces.Has(phyInterface) {
+				return fmt.Errorf("physical interface %s is connected to more than one secondary network OVS bridge", phyInterface)
+			}
+			physicalInterfaces.Insert(phyInterface)
+		}
+	}
+
+	return nil
+}
+
+func (o *Options) validateCPUAffinityConfig() error {
+	cpuAffinityConfig := o.config.CPUAffinity
+	if !cpuAffinityConfig.Enable {
 		return nil
 	}
-	if len(o.config.SecondaryNetwork.OVSBridges) > 1 {
-		return fmt.Errorf("only one OVS bridge can be specified for secondary network")
+	if cpuAffinityConfig.OVSHandlerThreads < 0 {
+		return fmt.Errorf("ovsHandlerThreads must be greater than or equal to 0")
 	}
-	brConfig := o.config.SecondaryNetwork.OVSBridges[0]
-	if brConfig.BridgeName == "" {
-		return fmt.Errorf("bridge name is not provided for the secondary network OVS bridge")
+	if cpuAffinityConfig.OVSRevalidatorThreads < 0 {
+		return fmt.Errorf("ovsRevalidatorThreads must be greater than or equal to 0")
 	}
-	if len(brConfig.PhysicalInterfaces) > 8 {
-		return fmt.Errorf("at most eight physical interfaces can be specified for the secondary network OVS bridge")
+	if cpuAffinityConfig.OVSPMDCPUs != "" && cpuAffinityConfig.OVSPMDCPUs != cpuAffinityAuto {
+		cpus, err := numa.ParseCPUList(cpuAffinityConfig.OVSPMDCPUs)
+		if err != nil {
+			return fmt.Errorf("ovsPMDCPUs is not valid: %v", err)
+		}
+		o.ovsPMDCPUs = cpus
 	}
-
 	return nil
 }
 
 func (o *Options) validateNodePortLocalConfig() error {
-	o.enableNodePortLocal = o.config.NodePortLocal.Enable && features.DefaultFeatureGate.Enabled(features.NodePortLocal)
 	if !features.DefaultFeatureGate.Enabled(features.NodePortLocal) {
 		klog.InfoS("Feature gate `NodePortLocal` is deprecated, please use option `nodePortLocal.enable` to disable NodePortLocal")
 	}
-	if o.enableNodePortLocal {
+	// The port range is validated even if the feature gate is disabled, as NodePortLocal can be enabled at runtime by
+	// updating the feature gate.
+	if o.config.NodePortLocal.Enable {
 		startPort, endPort, err := parsePortRange(o.config.NodePortLocal.PortRange)
 		if err != nil {
 			return fmt.Errorf("NodePortLocal portRange is not valid: %v", err)
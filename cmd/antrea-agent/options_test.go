@@ -16,7 +16,9 @@ package main
 
 import (
 	"fmt"
+	"net"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,8 +26,15 @@ import (
 	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/controller/ipsecpsk"
+	"antrea.io/antrea/pkg/agent/controller/networkpolicy"
+	"antrea.io/antrea/pkg/agent/deviceplugin"
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/profiling"
+	"antrea.io/antrea/pkg/agent/serviceprobe"
 	agentconfig "antrea.io/antrea/pkg/config/agent"
 	"antrea.io/antrea/pkg/features"
+	"antrea.io/antrea/pkg/ovs/ovsconfig"
 )
 
 func TestOptionsValidateTLSOptions(t *testing.T) {
@@ -136,6 +145,58 @@ func TestOptionsValidateAntreaProxyConfig(t *testing.T) {
 			},
 			expectedErr: "LoadBalancerMode drs is unknown",
 		},
+		{
+			name:             "rejectUnallocatedClusterIPs enabled",
+			trafficEncapMode: config.TrafficEncapModeEncap,
+			antreaProxyConfig: agentconfig.AntreaProxyConfig{
+				Enable:                      ptr.To(true),
+				DefaultLoadBalancerMode:     config.LoadBalancerModeNAT.String(),
+				RejectUnallocatedClusterIPs: true,
+			},
+			expectedDefaultLoadBalancerMode: config.LoadBalancerModeNAT,
+		},
+		{
+			name:             "rejectUnallocatedClusterIPs with skipServices",
+			trafficEncapMode: config.TrafficEncapModeEncap,
+			antreaProxyConfig: agentconfig.AntreaProxyConfig{
+				Enable:                      ptr.To(true),
+				DefaultLoadBalancerMode:     config.LoadBalancerModeNAT.String(),
+				SkipServices:                []string{"kube-system/kube-dns"},
+				RejectUnallocatedClusterIPs: true,
+			},
+			expectedErr: "rejectUnallocatedClusterIPs cannot be enabled when skipServices is set",
+		},
+		{
+			name:             "rejectUnallocatedClusterIPs with serviceProxyName",
+			trafficEncapMode: config.TrafficEncapModeEncap,
+			antreaProxyConfig: agentconfig.AntreaProxyConfig{
+				Enable:                      ptr.To(true),
+				DefaultLoadBalancerMode:     config.LoadBalancerModeNAT.String(),
+				ServiceProxyName:            "antrea",
+				RejectUnallocatedClusterIPs: true,
+			},
+			expectedErr: "rejectUnallocatedClusterIPs cannot be enabled when serviceProxyName is set",
+		},
+		{
+			name:             "externalTrafficSNATIPPool in encap mode",
+			trafficEncapMode: config.TrafficEncapModeEncap,
+			antreaProxyConfig: agentconfig.AntreaProxyConfig{
+				Enable:                    ptr.To(true),
+				DefaultLoadBalancerMode:   config.LoadBalancerModeNAT.String(),
+				ExternalTrafficSNATIPPool: "snat-ip-pool",
+			},
+			expectedDefaultLoadBalancerMode: config.LoadBalancerModeNAT,
+		},
+		{
+			name:             "externalTrafficSNATIPPool in noEncap mode",
+			trafficEncapMode: config.TrafficEncapModeNoEncap,
+			antreaProxyConfig: agentconfig.AntreaProxyConfig{
+				Enable:                    ptr.To(true),
+				DefaultLoadBalancerMode:   config.LoadBalancerModeNAT.String(),
+				ExternalTrafficSNATIPPool: "snat-ip-pool",
+			},
+			expectedErr: "externalTrafficSNATIPPool requires encap mode",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -196,6 +257,35 @@ func TestOptionsValidateEgressConfig(t *testing.T) {
 			expectedErr:          "Egress Except CIDR 1.1.1.300/32 is invalid",
 			expectedEnableEgress: false,
 		},
+		{
+			name:             "invalid assignmentStabilizationWindow",
+			featureGateValue: true,
+			trafficEncapMode: config.TrafficEncapModeEncap,
+			egressConfig: agentconfig.EgressConfig{
+				AssignmentStabilizationWindow: "1x",
+			},
+			expectedErr:          "assignmentStabilizationWindow 1x is invalid",
+			expectedEnableEgress: false,
+		},
+		{
+			name:             "negative assignmentStabilizationWindow",
+			featureGateValue: true,
+			trafficEncapMode: config.TrafficEncapModeEncap,
+			egressConfig: agentconfig.EgressConfig{
+				AssignmentStabilizationWindow: "-1m",
+			},
+			expectedErr:          "assignmentStabilizationWindow cannot be negative",
+			expectedEnableEgress: false,
+		},
+		{
+			name:             "valid assignmentStabilizationWindow",
+			featureGateValue: true,
+			trafficEncapMode: config.TrafficEncapModeEncap,
+			egressConfig: agentconfig.EgressConfig{
+				AssignmentStabilizationWindow: "2m",
+			},
+			expectedEnableEgress: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -276,6 +366,77 @@ func TestOptionsValidateMulticastConfig(t *testing.T) {
 	}
 }
 
+func TestOptionsValidateNamespaceTunnelVNIConfig(t *testing.T) {
+	tests := []struct {
+		name                         string
+		tunnelType                   string
+		encapMode                    config.TrafficEncapModeType
+		encryptionMode               config.TrafficEncryptionModeType
+		enableStretchedNetworkPolicy bool
+		expectedErr                  string
+	}{
+		{
+			name:           "geneve",
+			tunnelType:     ovsconfig.GeneveTunnel,
+			encapMode:      config.TrafficEncapModeEncap,
+			encryptionMode: config.TrafficEncryptionModeNone,
+		},
+		{
+			name:           "vxlan in hybrid mode",
+			tunnelType:     ovsconfig.VXLANTunnel,
+			encapMode:      config.TrafficEncapModeHybrid,
+			encryptionMode: config.TrafficEncryptionModeNone,
+		},
+		{
+			name:           "noEncap mode",
+			tunnelType:     ovsconfig.GeneveTunnel,
+			encapMode:      config.TrafficEncapModeNoEncap,
+			encryptionMode: config.TrafficEncryptionModeNone,
+			expectedErr:    "enableNamespaceTunnelVNI requires encap or hybrid mode",
+		},
+		{
+			name:           "gre",
+			tunnelType:     ovsconfig.GRETunnel,
+			encapMode:      config.TrafficEncapModeEncap,
+			encryptionMode: config.TrafficEncryptionModeNone,
+			expectedErr:    "enableNamespaceTunnelVNI requires tunnel type geneve or vxlan",
+		},
+		{
+			name:           "IPsec",
+			tunnelType:     ovsconfig.GeneveTunnel,
+			encapMode:      config.TrafficEncapModeEncap,
+			encryptionMode: config.TrafficEncryptionModeIPSec,
+			expectedErr:    "enableNamespaceTunnelVNI cannot be used with TrafficEncryptionMode IPsec",
+		},
+		{
+			name:                         "StretchedNetworkPolicy",
+			tunnelType:                   ovsconfig.GeneveTunnel,
+			encapMode:                    config.TrafficEncapModeEncap,
+			encryptionMode:               config.TrafficEncryptionModeNone,
+			enableStretchedNetworkPolicy: true,
+			expectedErr:                  "enableNamespaceTunnelVNI cannot be used with Multi-cluster StretchedNetworkPolicy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.Multicluster, true)
+			o := &Options{config: &agentconfig.AgentConfig{
+				TunnelType:               tt.tunnelType,
+				EnableNamespaceTunnelVNI: true,
+				Multicluster: agentconfig.MulticlusterConfig{
+					EnableStretchedNetworkPolicy: tt.enableStretchedNetworkPolicy,
+				},
+			}}
+			err := o.validateNamespaceTunnelVNIConfig(tt.encapMode, tt.encryptionMode)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestOptionsValidateSecondaryNetworkConfig(t *testing.T) {
 	tests := []struct {
 		name               string
@@ -307,7 +468,19 @@ func TestOptionsValidateSecondaryNetworkConfig(t *testing.T) {
 			name:             "two bridges",
 			featureGateValue: true,
 			ovsBridges:       []string{"br1", "br2"},
-			expectedErr:      "only one OVS bridge can be specified for secondary network",
+		},
+		{
+			name:             "duplicate bridges",
+			featureGateValue: true,
+			ovsBridges:       []string{"br1", "br1"},
+			expectedErr:      "secondary network OVS bridge br1 is specified more than once",
+		},
+		{
+			name:               "interface connected to two bridges",
+			featureGateValue:   true,
+			ovsBridges:         []string{"br1", "br2"},
+			physicalInterfaces: []string{"eth1"},
+			expectedErr:        "physical interface eth1 is connected to more than one secondary network OVS bridge",
 		},
 		{
 			name:             "no bridge name",
@@ -343,3 +516,881 @@ func TestOptionsValidateSecondaryNetworkConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionsValidateFlowExporterTimeoutRules(t *testing.T) {
+	tests := []struct {
+		name                           string
+		timeoutRules                   []agentconfig.FlowExportTimeoutRule
+		expectedErr                    string
+		expectedRules                  []flowexporter.FlowExportTimeoutRule
+		expectedStaleConnectionTimeout time.Duration
+	}{
+		{
+			name: "valid rules",
+			timeoutRules: []agentconfig.FlowExportTimeoutRule{
+				{
+					Protocol:              "udp",
+					DestinationPorts:      []int{53},
+					IdleFlowExportTimeout: "1s",
+				},
+				{
+					Protocol:                "TCP",
+					DestinationPorts:        []int{3306, 5432},
+					ActiveFlowExportTimeout: "10m",
+				},
+			},
+			expectedRules: []flowexporter.FlowExportTimeoutRule{
+				{
+					Protocol:          17,
+					DestinationPorts:  []uint16{53},
+					ActiveFlowTimeout: 5 * time.Second,
+					IdleFlowTimeout:   5 * time.Second,
+				},
+				{
+					Protocol:          6,
+					DestinationPorts:  []uint16{3306, 5432},
+					ActiveFlowTimeout: 10 * time.Minute,
+					IdleFlowTimeout:   15 * time.Second,
+				},
+			},
+			expectedStaleConnectionTimeout: 20 * time.Minute,
+		},
+		{
+			name: "invalid protocol",
+			timeoutRules: []agentconfig.FlowExportTimeoutRule{
+				{Protocol: "ICMP"},
+			},
+			expectedErr: "invalid protocol ICMP in flow export timeout rule 0",
+		},
+		{
+			name: "invalid port",
+			timeoutRules: []agentconfig.FlowExportTimeoutRule{
+				{DestinationPorts: []int{70000}},
+			},
+			expectedErr: "invalid destination port 70000 in flow export timeout rule 0",
+		},
+		{
+			name: "invalid timeout",
+			timeoutRules: []agentconfig.FlowExportTimeoutRule{
+				{IdleFlowExportTimeout: "1"},
+			},
+			expectedErr: "invalid idleFlowExportTimeout in flow export timeout rule 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.FlowExporter, true)
+
+			o := &Options{config: &agentconfig.AgentConfig{
+				FlowExporter: agentconfig.FlowExporterConfig{
+					Enable:       true,
+					TimeoutRules: tt.timeoutRules,
+				},
+			}}
+			o.setK8sNodeDefaultOptions()
+			err := o.validateFlowExporterConfig()
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedRules, o.flowExportTimeoutRules)
+			assert.Equal(t, tt.expectedStaleConnectionTimeout, o.staleConnectionTimeout)
+		})
+	}
+}
+
+func TestOptionsValidateFlowExporterSPIFFEConfig(t *testing.T) {
+	tests := []struct {
+		name              string
+		flowCollectorAddr string
+		spiffeConfig      agentconfig.FlowExporterSPIFFEConfig
+		expectedErr       string
+		expectedCertDir   string
+	}{
+		{
+			name: "valid config",
+			spiffeConfig: agentconfig.FlowExporterSPIFFEConfig{
+				Enable:              true,
+				AuthorizedServerIDs: []string{"spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator"},
+			},
+			expectedCertDir: defaultFlowExporterSPIFFECertDir,
+		},
+		{
+			name:              "unsupported protocol",
+			flowCollectorAddr: "flow-aggregator/flow-aggregator:4739:tcp",
+			spiffeConfig: agentconfig.FlowExporterSPIFFEConfig{
+				Enable:              true,
+				AuthorizedServerIDs: []string{"spiffe://example.org"},
+			},
+			expectedErr: "SPIFFE can only be enabled when the flow collector protocol is tls",
+		},
+		{
+			name: "no authorized server ID",
+			spiffeConfig: agentconfig.FlowExporterSPIFFEConfig{
+				Enable: true,
+			},
+			expectedErr: "authorizedServerIDs must be provided when SPIFFE is enabled",
+		},
+		{
+			name: "invalid authorized server ID",
+			spiffeConfig: agentconfig.FlowExporterSPIFFEConfig{
+				Enable:              true,
+				AuthorizedServerIDs: []string{"https://example.org/flow-aggregator"},
+			},
+			expectedErr: "invalid SPIFFE ID",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.FlowExporter, true)
+
+			o := &Options{config: &agentconfig.AgentConfig{
+				FlowExporter: agentconfig.FlowExporterConfig{
+					Enable:            true,
+					FlowCollectorAddr: tt.flowCollectorAddr,
+					SPIFFE:            tt.spiffeConfig,
+				},
+			}}
+			o.setK8sNodeDefaultOptions()
+			err := o.validateFlowExporterConfig()
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCertDir, o.config.FlowExporter.SPIFFE.CertDir)
+		})
+	}
+}
+
+func TestOptionsValidateFlowExporterOTLPConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		otlpConfig    agentconfig.FlowExporterOTLPConfig
+		spiffeEnabled bool
+		expectedErr   string
+		expectedAddr  string
+		expectedProto string
+	}{
+		{
+			name:          "Service with default port",
+			otlpConfig:    agentconfig.FlowExporterOTLPConfig{Enable: true, Endpoint: "observability/otel-collector"},
+			expectedAddr:  "observability/otel-collector:4317",
+			expectedProto: "tcp",
+		},
+		{
+			name:          "IPv6 address with port",
+			otlpConfig:    agentconfig.FlowExporterOTLPConfig{Enable: true, Endpoint: "[fd00::10]:14317"},
+			expectedAddr:  "[fd00::10]:14317",
+			expectedProto: "tcp",
+		},
+		{
+			name:          "IPv6 address without port",
+			otlpConfig:    agentconfig.FlowExporterOTLPConfig{Enable: true, Endpoint: "fd00::10"},
+			expectedAddr:  "[fd00::10]:4317",
+			expectedProto: "tcp",
+		},
+		{
+			name:        "missing endpoint",
+			otlpConfig:  agentconfig.FlowExporterOTLPConfig{Enable: true},
+			expectedErr: "endpoint must be provided when OTLP export is enabled",
+		},
+		{
+			name:        "invalid port",
+			otlpConfig:  agentconfig.FlowExporterOTLPConfig{Enable: true, Endpoint: "collector:otlp"},
+			expectedErr: "invalid OTLP endpoint collector:otlp: invalid port otlp",
+		},
+		{
+			name:        "invalid endpoint",
+			otlpConfig:  agentconfig.FlowExporterOTLPConfig{Enable: true, Endpoint: "collector:4317:tls"},
+			expectedErr: "invalid OTLP endpoint collector:4317:tls",
+		},
+		{
+			name:          "SPIFFE enabled",
+			otlpConfig:    agentconfig.FlowExporterOTLPConfig{Enable: true, Endpoint: "collector"},
+			spiffeEnabled: true,
+			expectedErr:   "SPIFFE cannot be enabled when flow records are exported using OTLP",
+		},
+		{
+			name:          "disabled",
+			expectedAddr:  "flow-aggregator/flow-aggregator:4739",
+			expectedProto: "tls",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.FlowExporter, true)
+
+			o := &Options{config: &agentconfig.AgentConfig{
+				FlowExporter: agentconfig.FlowExporterConfig{
+					Enable: true,
+					OTLP:   tt.otlpConfig,
+					SPIFFE: agentconfig.FlowExporterSPIFFEConfig{
+						Enable:              tt.spiffeEnabled,
+						AuthorizedServerIDs: []string{"spiffe://example.org"},
+					},
+				},
+			}}
+			o.setK8sNodeDefaultOptions()
+			err := o.validateFlowExporterConfig()
+			if tt.expectedErr != "" {
+				require.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAddr, o.flowCollectorAddr)
+			assert.Equal(t, tt.expectedProto, o.flowCollectorProto)
+		})
+	}
+}
+
+func TestOptionsValidateCPUAffinityConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		cpuAffinityConfig  agentconfig.CPUAffinityConfig
+		expectedErr        string
+		expectedOVSPMDCPUs []int
+	}{
+		{
+			name: "disabled",
+			cpuAffinityConfig: agentconfig.CPUAffinityConfig{
+				OVSPMDCPUs: "invalid",
+			},
+		},
+		{
+			name: "CPU list",
+			cpuAffinityConfig: agentconfig.CPUAffinityConfig{
+				Enable:            true,
+				OVSPMDCPUs:        "2-3,6",
+				OVSHandlerThreads: 4,
+			},
+			expectedOVSPMDCPUs: []int{2, 3, 6},
+		},
+		{
+			name: "auto",
+			cpuAffinityConfig: agentconfig.CPUAffinityConfig{
+				Enable:               true,
+				OVSPMDCPUs:           "auto",
+				AlignPacketInWithNIC: true,
+			},
+		},
+		{
+			name: "invalid CPU list",
+			cpuAffinityConfig: agentconfig.CPUAffinityConfig{
+				Enable:     true,
+				OVSPMDCPUs: "3-1",
+			},
+			expectedErr: "ovsPMDCPUs is not valid",
+		},
+		{
+			name: "invalid revalidator threads",
+			cpuAffinityConfig: agentconfig.CPUAffinityConfig{
+				Enable:                true,
+				OVSRevalidatorThreads: -1,
+			},
+			expectedErr: "ovsRevalidatorThreads must be greater than or equal to 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				CPUAffinity: tt.cpuAffinityConfig,
+			}}
+			err := o.validateCPUAffinityConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedOVSPMDCPUs, o.ovsPMDCPUs)
+		})
+	}
+}
+
+func TestOptionsValidateSelfProfilingConfig(t *testing.T) {
+	tests := []struct {
+		name                        string
+		selfProfilingConfig         agentconfig.SelfProfilingConfig
+		expectedErr                 string
+		expectedSelfProfilingConfig profiling.Config
+	}{
+		{
+			name: "disabled",
+			selfProfilingConfig: agentconfig.SelfProfilingConfig{
+				CheckInterval: "invalid",
+			},
+		},
+		{
+			name: "valid",
+			selfProfilingConfig: agentconfig.SelfProfilingConfig{
+				Enable:             true,
+				CPUThreshold:       150,
+				MemoryThreshold:    512,
+				CheckInterval:      "5s",
+				CPUProfileDuration: "10s",
+				MinCaptureInterval: "30m",
+				MaxProfiles:        2,
+			},
+			expectedSelfProfilingConfig: profiling.Config{
+				CPUThreshold:       150,
+				MemoryThreshold:    512 << 20,
+				CheckInterval:      5 * time.Second,
+				CPUProfileDuration: 10 * time.Second,
+				MinCaptureInterval: 30 * time.Minute,
+				MaxProfiles:        2,
+			},
+		},
+		{
+			name: "invalid check interval",
+			selfProfilingConfig: agentconfig.SelfProfilingConfig{
+				Enable:             true,
+				CheckInterval:      "5",
+				CPUProfileDuration: "10s",
+				MinCaptureInterval: "30m",
+				MaxProfiles:        2,
+			},
+			expectedErr: "checkInterval is not a valid duration",
+		},
+		{
+			name: "invalid max profiles",
+			selfProfilingConfig: agentconfig.SelfProfilingConfig{
+				Enable:             true,
+				CheckInterval:      "5s",
+				CPUProfileDuration: "10s",
+				MinCaptureInterval: "30m",
+				MaxProfiles:        -1,
+			},
+			expectedErr: "maxProfiles must be greater than 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				SelfProfiling: tt.selfProfilingConfig,
+			}}
+			err := o.validateSelfProfilingConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedSelfProfilingConfig, o.selfProfilingConfig)
+		})
+	}
+}
+
+func TestOptionsValidateServiceProbeConfig(t *testing.T) {
+	tests := []struct {
+		name                       string
+		serviceProbeConfig         agentconfig.ServiceProbeConfig
+		expectedErr                string
+		expectedServiceProbeConfig serviceprobe.Config
+	}{
+		{
+			name: "disabled",
+			serviceProbeConfig: agentconfig.ServiceProbeConfig{
+				Interval: "invalid",
+			},
+		},
+		{
+			name: "valid",
+			serviceProbeConfig: agentconfig.ServiceProbeConfig{
+				Enable:           true,
+				Services:         []string{"kube-system/kube-dns"},
+				Interval:         "1m",
+				Timeout:          "2s",
+				NetworkNamespace: "/var/run/netns/probe",
+			},
+			expectedServiceProbeConfig: serviceprobe.Config{
+				Services:         []string{"kube-system/kube-dns"},
+				Interval:         time.Minute,
+				Timeout:          2 * time.Second,
+				NetworkNamespace: "/var/run/netns/probe",
+			},
+		},
+		{
+			name: "invalid Service",
+			serviceProbeConfig: agentconfig.ServiceProbeConfig{
+				Enable:   true,
+				Services: []string{"kube-dns"},
+				Interval: "30s",
+				Timeout:  "5s",
+			},
+			expectedErr: `service "kube-dns" is not in the <namespace>/<name> format`,
+		},
+		{
+			name: "timeout greater than interval",
+			serviceProbeConfig: agentconfig.ServiceProbeConfig{
+				Enable:   true,
+				Interval: "5s",
+				Timeout:  "10s",
+			},
+			expectedErr: "timeout must be greater than 0 and not greater than interval",
+		},
+		{
+			name: "relative network namespace path",
+			serviceProbeConfig: agentconfig.ServiceProbeConfig{
+				Enable:           true,
+				Interval:         "30s",
+				Timeout:          "5s",
+				NetworkNamespace: "probe",
+			},
+			expectedErr: "networkNamespace must be an absolute path",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				ServiceProbe: tt.serviceProbeConfig,
+			}}
+			err := o.validateServiceProbeConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedServiceProbeConfig, o.serviceProbeConfig)
+		})
+	}
+}
+
+func TestOptionsValidateFastpathQueueConfig(t *testing.T) {
+	tests := []struct {
+		name                        string
+		fastpathQueueConfig         agentconfig.FastpathQueueConfig
+		expectedErr                 string
+		expectedFastpathQueueConfig deviceplugin.Config
+	}{
+		{
+			name: "disabled",
+			fastpathQueueConfig: agentconfig.FastpathQueueConfig{
+				Rate: "invalid",
+			},
+		},
+		{
+			name: "valid",
+			fastpathQueueConfig: agentconfig.FastpathQueueConfig{
+				Enable: true,
+				Count:  4,
+				Rate:   "2.5G",
+				Burst:  "100M",
+			},
+			expectedFastpathQueueConfig: deviceplugin.Config{
+				Count: 4,
+				Rate:  2500000,
+				Burst: 100000,
+			},
+		},
+		{
+			name: "invalid count",
+			fastpathQueueConfig: agentconfig.FastpathQueueConfig{
+				Enable: true,
+				Count:  -1,
+				Rate:   "1G",
+				Burst:  "100M",
+			},
+			expectedErr: "count must be greater than 0",
+		},
+		{
+			name: "invalid rate",
+			fastpathQueueConfig: agentconfig.FastpathQueueConfig{
+				Enable: true,
+				Count:  8,
+				Rate:   "1Gbps",
+				Burst:  "100M",
+			},
+			expectedErr: "rate is not a valid quantity",
+		},
+		{
+			name: "burst too small",
+			fastpathQueueConfig: agentconfig.FastpathQueueConfig{
+				Enable: true,
+				Count:  8,
+				Rate:   "1G",
+				Burst:  "100",
+			},
+			expectedErr: "burst must be at least 1k",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				FastpathQueue: tt.fastpathQueueConfig,
+			}}
+			err := o.validateFastpathQueueConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFastpathQueueConfig, o.fastpathQueueConfig)
+		})
+	}
+}
+
+func TestOptionsValidateMultipathRoutingConfig(t *testing.T) {
+	tests := []struct {
+		name                   string
+		encapMode              config.TrafficEncapModeType
+		multipathRoutingConfig agentconfig.MultipathRoutingConfig
+		expectedErr            string
+		expectedInterval       time.Duration
+	}{
+		{
+			name:      "disabled",
+			encapMode: config.TrafficEncapModeEncap,
+			multipathRoutingConfig: agentconfig.MultipathRoutingConfig{
+				HealthCheckInterval: "invalid",
+			},
+		},
+		{
+			name:      "valid",
+			encapMode: config.TrafficEncapModeNoEncap,
+			multipathRoutingConfig: agentconfig.MultipathRoutingConfig{
+				Enable:              true,
+				UplinkInterfaces:    []string{"eth1", "eth2"},
+				HealthCheckInterval: "10s",
+			},
+			expectedInterval: 10 * time.Second,
+		},
+		{
+			name:      "encap mode",
+			encapMode: config.TrafficEncapModeEncap,
+			multipathRoutingConfig: agentconfig.MultipathRoutingConfig{
+				Enable:              true,
+				UplinkInterfaces:    []string{"eth1"},
+				HealthCheckInterval: "5s",
+			},
+			expectedErr: "multipath routing requires noEncap or hybrid mode",
+		},
+		{
+			name:      "no uplink",
+			encapMode: config.TrafficEncapModeHybrid,
+			multipathRoutingConfig: agentconfig.MultipathRoutingConfig{
+				Enable:              true,
+				HealthCheckInterval: "5s",
+			},
+			expectedErr: "uplinkInterfaces must be specified",
+		},
+		{
+			name:      "transport interface",
+			encapMode: config.TrafficEncapModeNoEncap,
+			multipathRoutingConfig: agentconfig.MultipathRoutingConfig{
+				Enable:              true,
+				UplinkInterfaces:    []string{"eth0"},
+				HealthCheckInterval: "5s",
+			},
+			expectedErr: "uplinkInterfaces cannot contain the transport interface eth0",
+		},
+		{
+			name:      "interval too short",
+			encapMode: config.TrafficEncapModeNoEncap,
+			multipathRoutingConfig: agentconfig.MultipathRoutingConfig{
+				Enable:              true,
+				UplinkInterfaces:    []string{"eth1"},
+				HealthCheckInterval: "500ms",
+			},
+			expectedErr: "healthCheckInterval must be at least 1s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				TransportInterface: "eth0",
+				MultipathRouting:   tt.multipathRoutingConfig,
+			}}
+			err := o.validateMultipathRoutingConfig(tt.encapMode)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedInterval, o.multipathHealthCheckInterval)
+		})
+	}
+}
+
+func TestOptionsValidateFQDNCacheConfig(t *testing.T) {
+	tests := []struct {
+		name                    string
+		config                  agentconfig.AgentConfig
+		expectedErr             string
+		expectedFQDNCacheConfig networkpolicy.FQDNCacheConfig
+	}{
+		{
+			name: "valid",
+			config: agentconfig.AgentConfig{
+				FQDNCacheMinTTL:   60,
+				FQDNCacheStaleTTL: 30,
+				FQDNStaticIPs: map[string][]string{
+					"db.example.com": {"10.20.0.10", "fd00::10"},
+				},
+			},
+			expectedFQDNCacheConfig: networkpolicy.FQDNCacheConfig{
+				MinTTL:   60,
+				StaleTTL: 30,
+				StaticIPs: map[string][]net.IP{
+					"db.example.com": {net.ParseIP("10.20.0.10"), net.ParseIP("fd00::10")},
+				},
+			},
+		},
+		{
+			name:        "invalid min TTL",
+			config:      agentconfig.AgentConfig{FQDNCacheMinTTL: -1},
+			expectedErr: "fqdnCacheMinTTL must be greater than or equal to 0",
+		},
+		{
+			name:        "invalid stale TTL",
+			config:      agentconfig.AgentConfig{FQDNCacheStaleTTL: -1},
+			expectedErr: "fqdnCacheStaleTTL must be greater than or equal to 0",
+		},
+		{
+			name: "wildcard FQDN",
+			config: agentconfig.AgentConfig{
+				FQDNStaticIPs: map[string][]string{"*.example.com": {"10.20.0.10"}},
+			},
+			expectedErr: "must be a fully qualified domain name",
+		},
+		{
+			name: "invalid IP",
+			config: agentconfig.AgentConfig{
+				FQDNStaticIPs: map[string][]string{"db.example.com": {"10.20.0"}},
+			},
+			expectedErr: "is not a valid IP address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &tt.config}
+			err := o.validateFQDNCacheConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFQDNCacheConfig, o.fqdnCacheConfig)
+		})
+	}
+}
+
+func TestOptionsValidateDNSResolverRestrictionConfig(t *testing.T) {
+	tests := []struct {
+		name                        string
+		dnsResolverRestriction      agentconfig.DNSResolverRestrictionConfig
+		expectedErr                 string
+		expectedDNSClusterResolvers []net.IP
+	}{
+		{
+			name: "disabled",
+			dnsResolverRestriction: agentconfig.DNSResolverRestrictionConfig{
+				ClusterResolvers: []string{"invalid"},
+			},
+		},
+		{
+			name: "default cluster resolvers",
+			dnsResolverRestriction: agentconfig.DNSResolverRestrictionConfig{
+				Enable: true,
+			},
+		},
+		{
+			name: "valid",
+			dnsResolverRestriction: agentconfig.DNSResolverRestrictionConfig{
+				Enable:           true,
+				ClusterResolvers: []string{"10.96.0.10", "fd00:10:96::a"},
+			},
+			expectedDNSClusterResolvers: []net.IP{net.ParseIP("10.96.0.10"), net.ParseIP("fd00:10:96::a")},
+		},
+		{
+			name: "invalid IP",
+			dnsResolverRestriction: agentconfig.DNSResolverRestrictionConfig{
+				Enable:           true,
+				ClusterResolvers: []string{"10.96.0"},
+			},
+			expectedErr: "is not a valid IP address",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				DNSResolverRestriction: tt.dnsResolverRestriction,
+			}}
+			err := o.validateDNSResolverRestrictionConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDNSClusterResolvers, o.dnsClusterResolvers)
+		})
+	}
+}
+
+func TestOptionsValidateAuditLoggingConfig(t *testing.T) {
+	tests := []struct {
+		name               string
+		auditLoggingConfig agentconfig.AuditLoggingConfig
+		expectedErr        string
+	}{
+		{
+			name: "default",
+		},
+		{
+			name: "json to syslog",
+			auditLoggingConfig: agentconfig.AuditLoggingConfig{
+				Format:            "json",
+				SamplingRate:      10,
+				RuleSamplingRates: map[string]int32{"critical": 1},
+				Destination:       "syslog",
+				Address:           "tcp://10.0.0.1:514",
+			},
+		},
+		{
+			name: "invalid format",
+			auditLoggingConfig: agentconfig.AuditLoggingConfig{
+				Format: "xml",
+			},
+			expectedErr: "unsupported format xml",
+		},
+		{
+			name: "invalid rule sampling rate",
+			auditLoggingConfig: agentconfig.AuditLoggingConfig{
+				RuleSamplingRates: map[string]int32{"critical": 0},
+			},
+			expectedErr: "sampling rate of log label critical must be greater than or equal to 1",
+		},
+		{
+			name: "missing Unix socket path",
+			auditLoggingConfig: agentconfig.AuditLoggingConfig{
+				Destination: "unixSocket",
+			},
+			expectedErr: "address must be set when destination is unixSocket",
+		},
+		{
+			name: "invalid syslog address",
+			auditLoggingConfig: agentconfig.AuditLoggingConfig{
+				Destination: "syslog",
+				Address:     "http://10.0.0.1:514",
+			},
+			expectedErr: "unsupported syslog network http",
+		},
+		{
+			name: "invalid destination",
+			auditLoggingConfig: agentconfig.AuditLoggingConfig{
+				Destination: "kafka",
+			},
+			expectedErr: "unsupported destination kafka",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				AuditLogging: tt.auditLoggingConfig,
+			}}
+			err := o.validateAuditLoggingConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestOptionsValidateIPsecPSKConfig(t *testing.T) {
+	tests := []struct {
+		name                   string
+		ipsecConfig            agentconfig.IPsecConfig
+		expectedErr            string
+		expectedIPsecPSKConfig ipsecpsk.Config
+	}{
+		{
+			name: "env",
+			ipsecConfig: agentconfig.IPsecConfig{
+				PSKSource: "env",
+			},
+		},
+		{
+			name: "secret",
+			ipsecConfig: agentconfig.IPsecConfig{
+				PSKSource:    "secret",
+				PSKZoneLabel: "zone",
+			},
+			expectedIPsecPSKConfig: ipsecpsk.Config{
+				Source:    config.IPsecPSKSourceSecret,
+				ZoneLabel: "zone",
+			},
+		},
+		{
+			name: "vault",
+			ipsecConfig: agentconfig.IPsecConfig{
+				PSKSource:    "vault",
+				PSKZoneLabel: "zone",
+				Vault: agentconfig.IPsecVaultConfig{
+					Address:         "https://vault:8200",
+					MountPath:       "secret",
+					Path:            "antrea/ipsec-psks",
+					TokenFile:       "/var/run/secrets/vault/token",
+					RefreshInterval: "30s",
+				},
+			},
+			expectedIPsecPSKConfig: ipsecpsk.Config{
+				Source:    config.IPsecPSKSourceVault,
+				ZoneLabel: "zone",
+				Vault: ipsecpsk.VaultConfig{
+					Address:         "https://vault:8200",
+					MountPath:       "secret",
+					Path:            "antrea/ipsec-psks",
+					TokenFile:       "/var/run/secrets/vault/token",
+					RefreshInterval: 30 * time.Second,
+				},
+			},
+		},
+		{
+			name: "unknown source",
+			ipsecConfig: agentconfig.IPsecConfig{
+				PSKSource: "file",
+			},
+			expectedErr: "pskSource file is unknown",
+		},
+		{
+			name: "vault without address",
+			ipsecConfig: agentconfig.IPsecConfig{
+				PSKSource: "vault",
+				Vault: agentconfig.IPsecVaultConfig{
+					Path:            "antrea/ipsec-psks",
+					TokenFile:       "/var/run/secrets/vault/token",
+					RefreshInterval: "30s",
+				},
+			},
+			expectedErr: "vault.address must be set",
+		},
+		{
+			name: "vault with invalid refresh interval",
+			ipsecConfig: agentconfig.IPsecConfig{
+				PSKSource: "vault",
+				Vault: agentconfig.IPsecVaultConfig{
+					Address:         "https://vault:8200",
+					Path:            "antrea/ipsec-psks",
+					TokenFile:       "/var/run/secrets/vault/token",
+					RefreshInterval: "30",
+				},
+			},
+			expectedErr: "vault.refreshInterval is not a valid duration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &Options{config: &agentconfig.AgentConfig{
+				IPsec: tt.ipsecConfig,
+			}}
+			err := o.validateIPsecPSKConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedIPsecPSKConfig, o.ipsecPSKConfig)
+		})
+	}
+}
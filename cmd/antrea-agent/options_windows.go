@@ -54,7 +54,14 @@ func (o *Options) checkUnsupportedFeatures() error {
 		unsupported = append(unsupported, "TunnelType: "+o.config.TunnelType)
 	}
 	_, encryptionMode := config.GetTrafficEncryptionModeFromStr(o.config.TrafficEncryptionMode)
-	if encryptionMode != config.TrafficEncryptionModeNone {
+	_, ipsecAuthMode := config.GetIPsecAuthenticationModeFromStr(o.config.IPsec.AuthenticationMode)
+	// IPsec is only supported with "cert" authentication mode, in which the IPsec connections are configured with
+	// strongSwan instead of the OVS IPsec monitor.
+	if encryptionMode == config.TrafficEncryptionModeIPSec {
+		if ipsecAuthMode != config.IPsecAuthenticationModeCert {
+			unsupported = append(unsupported, "IPsec AuthenticationMode: "+ipsecAuthMode.String())
+		}
+	} else if encryptionMode != config.TrafficEncryptionModeNone {
 		unsupported = append(unsupported, "TrafficEncryptionMode: "+encryptionMode.String())
 	}
 	if o.config.EnableBridgingMode {
@@ -63,6 +70,18 @@ func (o *Options) checkUnsupportedFeatures() error {
 	if o.config.SNATFullyRandomPorts {
 		unsupported = append(unsupported, "SNATFullyRandomPorts")
 	}
+	if o.config.CPUAffinity.Enable {
+		unsupported = append(unsupported, "CPUAffinity")
+	}
+	if o.config.ServiceProbe.NetworkNamespace != "" {
+		unsupported = append(unsupported, "ServiceProbe.NetworkNamespace")
+	}
+	if o.config.FastpathQueue.Enable {
+		unsupported = append(unsupported, "FastpathQueue")
+	}
+	if o.config.MultipathRouting.Enable {
+		unsupported = append(unsupported, "MultipathRouting")
+	}
 	if unsupported != nil {
 		return fmt.Errorf("unsupported features on Windows: {%s}", strings.Join(unsupported, ", "))
 	}
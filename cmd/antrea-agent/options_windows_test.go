@@ -66,6 +66,15 @@ func TestCheckUnsupportedFeatures(t *testing.T) {
 			agentconfig.AgentConfig{TrafficEncryptionMode: config.TrafficEncryptionModeIPSec.String()},
 			false,
 		},
+		{
+			"IPsec encryption with cert authentication",
+			agentconfig.AgentConfig{
+				FeatureGates:          map[string]bool{"IPsecCertAuth": true},
+				TrafficEncryptionMode: config.TrafficEncryptionModeIPSec.String(),
+				IPsec:                 agentconfig.IPsecConfig{AuthenticationMode: config.IPsecAuthenticationModeCert.String()},
+			},
+			true,
+		},
 		{
 			"WireGuard encryption",
 			agentconfig.AgentConfig{TrafficEncryptionMode: config.TrafficEncryptionModeWireGuard.String()},
@@ -20,10 +20,21 @@ import (
 	"strconv"
 	"strings"
 
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/util"
+	"antrea.io/antrea/pkg/agent/util/numa"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+	"antrea.io/antrea/pkg/ovs/ovsconfig"
 )
 
-var getAllNodeAddresses = util.GetAllNodeAddresses
+var (
+	getAllNodeAddresses = util.GetAllNodeAddresses
+	interfaceNUMANode   = numa.InterfaceNUMANode
+	numaNodeCPUs        = numa.NodeCPUs
+)
 
 func getAvailableNodePortAddresses(nodePortAddressesFromConfig []string, excludeDevices []string) ([]net.IP, []net.IP, error) {
 	// Get all IP addresses of Node
@@ -79,3 +90,48 @@ func parsePortRange(portRangeStr string) (start, end int, err error) {
 
 	return start, end, nil
 }
+
+// configureCPUAffinity configures the OVS datapath threads and the packet-in processing threads
+// of antrea-agent according to the cpuAffinity configuration and to the NUMA node of the
+// transport interface.
+func configureCPUAffinity(cpuAffinityConfig agentconfig.CPUAffinityConfig, ovsPMDCPUs []int, transportInterface string, ovsBridgeClient ovsconfig.OVSBridgeClient, ofClient openflow.Client) error {
+	numaNode, err := interfaceNUMANode(transportInterface)
+	if err != nil {
+		return err
+	}
+	metrics.TransportInterfaceNUMANode.Set(float64(numaNode))
+	var numaCPUs []int
+	if numaNode >= 0 {
+		if numaCPUs, err = numaNodeCPUs(numaNode); err != nil {
+			return err
+		}
+		klog.InfoS("Found NUMA node of transport interface", "interface", transportInterface, "numaNode", numaNode, "cpus", numaCPUs)
+	} else {
+		klog.InfoS("Transport interface is not attached to a NUMA node, NUMA-aware CPU placement will be skipped", "interface", transportInterface)
+	}
+
+	if cpuAffinityConfig.OVSPMDCPUs == cpuAffinityAuto {
+		ovsPMDCPUs = numaCPUs
+	}
+	otherConfig := map[string]interface{}{}
+	if len(ovsPMDCPUs) > 0 {
+		otherConfig["pmd-cpu-mask"] = numa.FormatCPUMask(ovsPMDCPUs)
+	}
+	if cpuAffinityConfig.OVSHandlerThreads > 0 {
+		otherConfig["n-handler-threads"] = strconv.Itoa(cpuAffinityConfig.OVSHandlerThreads)
+	}
+	if cpuAffinityConfig.OVSRevalidatorThreads > 0 {
+		otherConfig["n-revalidator-threads"] = strconv.Itoa(cpuAffinityConfig.OVSRevalidatorThreads)
+	}
+	if len(otherConfig) > 0 {
+		if err := ovsBridgeClient.UpdateOVSOtherConfig(otherConfig); err != nil {
+			return fmt.Errorf("failed to update OVS thread configuration: %w", err)
+		}
+		klog.InfoS("Updated OVS thread configuration", "otherConfig", otherConfig)
+	}
+
+	if cpuAffinityConfig.AlignPacketInWithNIC && len(numaCPUs) > 0 {
+		ofClient.SetPacketInCPUAffinity(numaCPUs)
+	}
+	return nil
+}
@@ -15,13 +15,18 @@
 package main
 
 import (
+	"fmt"
 	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 
+	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
 	"antrea.io/antrea/pkg/agent/util"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+	ovsconfigtest "antrea.io/antrea/pkg/ovs/ovsconfig/testing"
 )
 
 func TestGetAvailableNodePortAddresses(t *testing.T) {
@@ -122,3 +127,81 @@ func TestParsePortRange(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigureCPUAffinity(t *testing.T) {
+	tests := []struct {
+		name                string
+		cpuAffinityConfig   agentconfig.CPUAffinityConfig
+		ovsPMDCPUs          []int
+		numaNode            int
+		expectedOtherConfig map[string]interface{}
+		expectedPacketInCPU []int
+	}{
+		{
+			name: "auto PMD CPUs and aligned packetIn",
+			cpuAffinityConfig: agentconfig.CPUAffinityConfig{
+				Enable:               true,
+				OVSPMDCPUs:           "auto",
+				OVSHandlerThreads:    4,
+				AlignPacketInWithNIC: true,
+			},
+			numaNode: 1,
+			expectedOtherConfig: map[string]interface{}{
+				"pmd-cpu-mask":      "0xf0",
+				"n-handler-threads": "4",
+			},
+			expectedPacketInCPU: []int{4, 5, 6, 7},
+		},
+		{
+			name: "explicit PMD CPUs",
+			cpuAffinityConfig: agentconfig.CPUAffinityConfig{
+				Enable:                true,
+				OVSPMDCPUs:            "2-3",
+				OVSRevalidatorThreads: 2,
+			},
+			ovsPMDCPUs: []int{2, 3},
+			numaNode:   1,
+			expectedOtherConfig: map[string]interface{}{
+				"pmd-cpu-mask":          "0xc",
+				"n-revalidator-threads": "2",
+			},
+		},
+		{
+			name: "no NUMA node",
+			cpuAffinityConfig: agentconfig.CPUAffinityConfig{
+				Enable:               true,
+				OVSPMDCPUs:           "auto",
+				AlignPacketInWithNIC: true,
+			},
+			numaNode: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origInterfaceNUMANode, origNUMANodeCPUs := interfaceNUMANode, numaNodeCPUs
+			defer func() {
+				interfaceNUMANode, numaNodeCPUs = origInterfaceNUMANode, origNUMANodeCPUs
+			}()
+			interfaceNUMANode = func(ifName string) (int, error) {
+				return tt.numaNode, nil
+			}
+			numaNodeCPUs = func(node int) ([]int, error) {
+				if node != 1 {
+					return nil, fmt.Errorf("unknown NUMA node %d", node)
+				}
+				return []int{4, 5, 6, 7}, nil
+			}
+			ctrl := gomock.NewController(t)
+			mockOVSBridgeClient := ovsconfigtest.NewMockOVSBridgeClient(ctrl)
+			mockOFClient := openflowtest.NewMockClient(ctrl)
+			if tt.expectedOtherConfig != nil {
+				mockOVSBridgeClient.EXPECT().UpdateOVSOtherConfig(tt.expectedOtherConfig)
+			}
+			if tt.expectedPacketInCPU != nil {
+				mockOFClient.EXPECT().SetPacketInCPUAffinity(tt.expectedPacketInCPU)
+			}
+			err := configureCPUAffinity(tt.cpuAffinityConfig, tt.ovsPMDCPUs, "eth0", mockOVSBridgeClient, mockOFClient)
+			require.NoError(t, err)
+		})
+	}
+}
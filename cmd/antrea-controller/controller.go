@@ -25,6 +25,7 @@ import (
 	apiextensionclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	genericopenapi "k8s.io/apiserver/pkg/endpoints/openapi"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	genericoptions "k8s.io/apiserver/pkg/server/options"
@@ -49,17 +50,21 @@ import (
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
 	crdv1a2informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha2"
 	"antrea.io/antrea/pkg/clusteridentity"
+	controllerconfig "antrea.io/antrea/pkg/config/controller"
 	"antrea.io/antrea/pkg/controller/certificatesigningrequest"
+	"antrea.io/antrea/pkg/controller/clustergroupwebhook"
 	"antrea.io/antrea/pkg/controller/egress"
 	egressstore "antrea.io/antrea/pkg/controller/egress/store"
 	"antrea.io/antrea/pkg/controller/externalippool"
 	"antrea.io/antrea/pkg/controller/externalnode"
+	"antrea.io/antrea/pkg/controller/externaltrafficsnat"
 	"antrea.io/antrea/pkg/controller/grouping"
 	antreaipam "antrea.io/antrea/pkg/controller/ipam"
 	"antrea.io/antrea/pkg/controller/labelidentity"
 	"antrea.io/antrea/pkg/controller/metrics"
 	"antrea.io/antrea/pkg/controller/networkpolicy"
 	"antrea.io/antrea/pkg/controller/networkpolicy/store"
+	"antrea.io/antrea/pkg/controller/nodedrain"
 	"antrea.io/antrea/pkg/controller/querier"
 	"antrea.io/antrea/pkg/controller/serviceexternalip"
 	"antrea.io/antrea/pkg/controller/stats"
@@ -115,6 +120,7 @@ var allowedPaths = []string{
 	"/validate/egress",
 	"/validate/group",
 	"/validate/ippool",
+	"/validate/pod",
 	"/validate/supportbundlecollection",
 	"/validate/traceflow",
 	"/convert/clustergroup",
@@ -148,6 +154,7 @@ func run(o *Options) error {
 	tfInformer := crdInformerFactory.Crd().V1beta1().Traceflows()
 	cgInformer := crdInformerFactory.Crd().V1beta1().ClusterGroups()
 	grpInformer := crdInformerFactory.Crd().V1beta1().Groups()
+	nspInformer := crdInformerFactory.Crd().V1alpha1().NamespaceSecurityPolicies()
 	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
 	externalIPPoolInformer := crdInformerFactory.Crd().V1beta1().ExternalIPPools()
 	externalNodeInformer := crdInformerFactory.Crd().V1alpha1().ExternalNodes()
@@ -195,15 +202,22 @@ func run(o *Options) error {
 		tierInformer,
 		cgInformer,
 		grpInformer,
+		nspInformer,
 		addressGroupStore,
 		appliedToGroupStore,
 		networkPolicyStore,
 		groupStore,
 		enableMulticlusterNP)
+	podReadinessGateController := networkpolicy.NewPodReadinessGateController(client, podInformer, networkPolicyController)
 
 	var externalNodeController *externalnode.ExternalNodeController
 	if features.DefaultFeatureGate.Enabled(features.ExternalNode) {
-		externalNodeController = externalnode.NewExternalNodeController(crdClient, externalNodeInformer, eeInformer)
+		autoApprovalCIDRs, _ := netutils.ParseCIDRs(o.config.ExternalNode.AutoApprovalCIDRs)
+		approvalPolicy := externalnode.ApprovalPolicy{
+			Mode:              externalnode.ApprovalMode(o.config.ExternalNode.ApprovalMode),
+			AutoApprovalCIDRs: autoApprovalCIDRs,
+		}
+		externalNodeController = externalnode.NewExternalNodeController(client, crdClient, externalNodeInformer, eeInformer, approvalPolicy)
 	}
 
 	var bundleCollectionController *supportbundlecollection.Controller
@@ -215,7 +229,37 @@ func run(o *Options) error {
 
 	var networkPolicyStatusController *networkpolicy.StatusController
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
-		networkPolicyStatusController = networkpolicy.NewStatusController(crdClient, networkPolicyStore, acnpInformer, annpInformer)
+		// The SLO has been validated in Options.validate.
+		realizationSLO, _ := time.ParseDuration(o.config.NetworkPolicyRealizationSLO)
+		networkPolicyStatusController = networkpolicy.NewStatusController(client, crdClient, networkPolicyStore, acnpInformer, annpInformer, nspInformer, realizationSLO)
+		networkPolicyController.SetPolicyRealizationQuerier(networkPolicyStatusController)
+	}
+	// The Namespace selector has been validated in Options.validate.
+	acnpExemptionSelector, _ := labels.Parse(o.config.ACNPExemption.NamespaceSelector)
+	if acnpExemptionSelector.Empty() {
+		acnpExemptionSelector = nil
+	}
+	networkPolicyController.SetACNPExemption(networkpolicy.ACNPExemptionConfig{
+		Namespaces:        o.config.ACNPExemption.Namespaces,
+		NamespaceSelector: acnpExemptionSelector,
+		Tiers:             o.config.ACNPExemption.Tiers,
+	})
+
+	var clusterGroupWebhookController *clustergroupwebhook.Controller
+	if o.config.ClusterGroupWebhook.Enable {
+		// The timeout and resync interval have been validated in Options.validate.
+		timeout, _ := time.ParseDuration(o.config.ClusterGroupWebhook.Timeout)
+		resyncInterval, _ := time.ParseDuration(o.config.ClusterGroupWebhook.ResyncInterval)
+		clusterGroupWebhookController, err = clustergroupwebhook.NewController(client, networkPolicyController, env.GetAntreaNamespace(), clustergroupwebhook.Config{
+			URL:            o.config.ClusterGroupWebhook.URL,
+			ClusterGroups:  o.config.ClusterGroupWebhook.ClusterGroups,
+			CABundle:       []byte(o.config.ClusterGroupWebhook.CABundle),
+			Timeout:        timeout,
+			ResyncInterval: resyncInterval,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating ClusterGroup webhook controller: %w", err)
+		}
 	}
 
 	endpointQuerier := networkpolicy.NewEndpointQuerier(networkPolicyController)
@@ -228,10 +272,18 @@ func run(o *Options) error {
 	var egressController *egress.EgressController
 	var externalIPPoolController *externalippool.ExternalIPPoolController
 	var externalIPController *serviceexternalip.ServiceExternalIPController
+	var externalTrafficSNATController *externaltrafficsnat.ExternalTrafficSNATController
 	if features.DefaultFeatureGate.Enabled(features.Egress) || features.DefaultFeatureGate.Enabled(features.ServiceExternalIP) {
 		externalIPPoolController = externalippool.NewExternalIPPoolController(
 			crdClient, externalIPPoolInformer,
 		)
+		// Allocate the IPs used by antrea-agent to SNAT the external Service traffic forwarded to remote Endpoints.
+		externalTrafficSNATController = externaltrafficsnat.NewExternalTrafficSNATController(client, nodeInformer, externalIPPoolController)
+	}
+	var nodeDrainController *nodedrain.NodeDrainController
+	if (features.DefaultFeatureGate.Enabled(features.Egress) || features.DefaultFeatureGate.Enabled(features.ServiceExternalIP)) && o.config.NodeDrain.Enable {
+		// Move the Egress IPs and the Service external IPs off the Nodes being drained.
+		nodeDrainController = nodedrain.NewNodeDrainController(client, nodeInformer)
 	}
 
 	var csrApprovingController *certificatesigningrequest.CSRApprovingController
@@ -251,7 +303,8 @@ func run(o *Options) error {
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.Egress) {
-		egressController = egress.NewEgressController(crdClient, groupEntityIndex, egressInformer, externalIPPoolController, egressGroupStore)
+		egressController = egress.NewEgressController(client, crdClient, groupEntityIndex, egressInformer, externalIPPoolController, egressGroupStore, podInformer, namespaceInformer, o.config.PodEgressIP.Enable)
+		egressController.SetPodEgressIPAllowedPools(o.config.PodEgressIP.AllowedExternalIPPools)
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.ServiceExternalIP) {
@@ -267,7 +320,7 @@ func run(o *Options) error {
 	// aggregated data. For now it's only used for NetworkPolicy stats.
 	var statsAggregator *stats.Aggregator
 	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
-		statsAggregator = stats.NewAggregator(networkPolicyInformer, acnpInformer, annpInformer)
+		statsAggregator = stats.NewAggregator(networkPolicyInformer, acnpInformer, annpInformer, namespaceInformer)
 	}
 
 	cipherSuites, err := cipher.GenerateCipherSuitesList(o.config.TLSCipherSuites)
@@ -307,6 +360,8 @@ func run(o *Options) error {
 		statsAggregator,
 		bundleCollectionController,
 		traceflowController,
+		externalNodeController,
+		o.effectiveConfig(),
 		*o.config.EnablePrometheusMetrics,
 		cipherSuites,
 		cipher.TLSVersionMap[o.config.TLSMinVersion])
@@ -367,6 +422,8 @@ func run(o *Options) error {
 
 	go networkPolicyController.Run(stopCh)
 
+	go podReadinessGateController.Run(stopCh)
+
 	go apiServer.Run(ctx)
 
 	if features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
@@ -375,6 +432,7 @@ func run(o *Options) error {
 
 	if *o.config.EnablePrometheusMetrics {
 		metrics.InitializePrometheusMetrics()
+		metrics.RegisterCollector(networkpolicy.NewNetworkPolicyObjectCollector(networkPolicyController, networkPolicyStatusController))
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.Traceflow) {
@@ -384,14 +442,21 @@ func run(o *Options) error {
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		go networkPolicyStatusController.Run(stopCh)
 	}
+
+	if clusterGroupWebhookController != nil {
+		go clusterGroupWebhookController.Run(stopCh)
+	}
+
 	if features.DefaultFeatureGate.Enabled(features.NodeIPAM) && o.config.NodeIPAM.EnableNodeIPAM {
-		clusterCIDRs, _ := netutils.ParseCIDRs(o.config.NodeIPAM.ClusterCIDRs)
+		cidrs, _ := netutils.ParseCIDRs(o.config.NodeIPAM.ClusterCIDRs)
+		clusterCIDRs, expansionCIDRs := splitClusterCIDRs(cidrs)
 		_, serviceCIDR, _ := net.ParseCIDR(o.config.NodeIPAM.ServiceCIDR)
 		_, serviceCIDRv6, _ := net.ParseCIDR(o.config.NodeIPAM.ServiceCIDRv6)
-		err = startNodeIPAM(
+		nodeIPAM, err := startNodeIPAM(
 			client,
 			nodeInformer,
 			clusterCIDRs,
+			expansionCIDRs,
 			serviceCIDR,
 			serviceCIDRv6,
 			o.config.NodeIPAM.NodeCIDRMaskSizeIPv4,
@@ -400,10 +465,22 @@ func run(o *Options) error {
 		if err != nil {
 			return fmt.Errorf("failed to initialize node IPAM controller: %v", err)
 		}
+		if len(o.configFile) > 0 {
+			watcher, err := newClusterCIDRsWatcher(o.configFile, nodeIPAM, o.config.NodeIPAM.ClusterCIDRs)
+			if err != nil {
+				return fmt.Errorf("failed to watch cluster CIDRs for node IPAM controller: %v", err)
+			}
+			go watcher.Run(stopCh)
+		}
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.Egress) || features.DefaultFeatureGate.Enabled(features.ServiceExternalIP) {
 		go externalIPPoolController.Run(stopCh)
+		go externalTrafficSNATController.Run(stopCh)
+	}
+
+	if nodeDrainController != nil {
+		go nodeDrainController.Run(stopCh)
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.Egress) {
@@ -452,14 +529,40 @@ func getNodeCIDRMaskSizes(clusterCIDRs []*net.IPNet, maskSizeIPv4, maskSizeIPv6
 	return nodeMaskCIDRs
 }
 
+// splitClusterCIDRs returns the first CIDR of each IP family as the cluster CIDRs, and the
+// following CIDRs as the expansion CIDRs, which are only used when the previous CIDRs of the same
+// IP family are exhausted.
+func splitClusterCIDRs(cidrs []*net.IPNet) ([]*net.IPNet, []*net.IPNet) {
+	var clusterCIDRs, expansionCIDRs []*net.IPNet
+	hasIPv4, hasIPv6 := false, false
+	for _, cidr := range cidrs {
+		if netutils.IsIPv6CIDR(cidr) {
+			if hasIPv6 {
+				expansionCIDRs = append(expansionCIDRs, cidr)
+				continue
+			}
+			hasIPv6 = true
+		} else {
+			if hasIPv4 {
+				expansionCIDRs = append(expansionCIDRs, cidr)
+				continue
+			}
+			hasIPv4 = true
+		}
+		clusterCIDRs = append(clusterCIDRs, cidr)
+	}
+	return clusterCIDRs, expansionCIDRs
+}
+
 func startNodeIPAM(client clientset.Interface,
 	nodeInformer coreinformers.NodeInformer,
 	clusterCIDRs []*net.IPNet,
+	expansionCIDRs []*net.IPNet,
 	serviceCIDR *net.IPNet,
 	serviceCIDRv6 *net.IPNet,
 	nodeCIDRMaskSizeIPv4 int,
 	nodeCIDRMaskSizeIPv6 int,
-	stopCh <-chan struct{}) error {
+	stopCh <-chan struct{}) (*nodeipam.Controller, error) {
 
 	nodeCIDRMaskSizes := getNodeCIDRMaskSizes(clusterCIDRs, nodeCIDRMaskSizeIPv4, nodeCIDRMaskSizeIPv6)
 	nodeIPAM, err := nodeipam.NewNodeIpamController(
@@ -469,13 +572,14 @@ func startNodeIPAM(client clientset.Interface,
 		serviceCIDR,
 		serviceCIDRv6,
 		nodeCIDRMaskSizes,
+		expansionCIDRs,
 		ipam.RangeAllocatorType,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	go nodeIPAM.Run(stopCh)
-	return nil
+	return nodeIPAM, nil
 }
 
 func createAPIServerConfig(kubeconfig string,
@@ -501,6 +605,8 @@ func createAPIServerConfig(kubeconfig string,
 	statsAggregator *stats.Aggregator,
 	bundleCollectionStore *supportbundlecollection.Controller,
 	traceflowController *traceflow.Controller,
+	externalNodeController *externalnode.ExternalNodeController,
+	effectiveConfig *controllerconfig.ControllerConfig,
 	enableMetrics bool,
 	cipherSuites []uint16,
 	tlsMinVersion uint16) (*apiserver.Config, error) {
@@ -573,5 +679,7 @@ func createAPIServerConfig(kubeconfig string,
 		egressController,
 		externalIPPoolController,
 		bundleCollectionStore,
-		traceflowController), nil
+		traceflowController,
+		externalNodeController,
+		effectiveConfig), nil
 }
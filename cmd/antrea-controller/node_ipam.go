@@ -0,0 +1,128 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"net"
+	"path/filepath"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	netutils "k8s.io/utils/net"
+)
+
+// clusterCIDRsAdder is implemented by the NodeIPAM controller.
+type clusterCIDRsAdder interface {
+	AddClusterCIDRs(cidrs []*net.IPNet) error
+}
+
+// clusterCIDRsWatcher watches the antrea-controller configuration file and adds the cluster CIDRs
+// appended to nodeIPAM.clusterCIDRs to the NodeIPAM controller, without restarting it. Cluster
+// CIDRs cannot be removed at runtime, as Node CIDRs may have been allocated from them.
+type clusterCIDRsWatcher struct {
+	configFile   string
+	watcher      *fsnotify.Watcher
+	nodeIPAM     clusterCIDRsAdder
+	clusterCIDRs sets.Set[string]
+}
+
+func newClusterCIDRsWatcher(configFile string, nodeIPAM clusterCIDRsAdder, clusterCIDRs []string) (*clusterCIDRsWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("error when creating file watcher for configuration file: %w", err)
+	}
+	// Watch the directory instead of the file, as the file is replaced when the ConfigMap is updated.
+	if err := watcher.Add(filepath.Dir(configFile)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("error when starting file watch on configuration dir: %w", err)
+	}
+	w := &clusterCIDRsWatcher{
+		configFile:   configFile,
+		watcher:      watcher,
+		nodeIPAM:     nodeIPAM,
+		clusterCIDRs: sets.New[string](),
+	}
+	for _, cidr := range clusterCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			watcher.Close()
+			return nil, err
+		}
+		w.clusterCIDRs.Insert(ipNet.String())
+	}
+	return w, nil
+}
+
+func (w *clusterCIDRsWatcher) Run(stopCh <-chan struct{}) {
+	defer w.watcher.Close()
+	klog.InfoS("Watching configuration file for NodeIPAM cluster CIDRs", "file", w.configFile)
+	for {
+		select {
+		case <-stopCh:
+			return
+		case event, ok := <-w.watcher.Events:
+			if !ok {
+				klog.ErrorS(nil, "Configuration file watcher was closed, cluster CIDRs will no longer be updated")
+				return
+			}
+			klog.V(2).InfoS("Configuration file event", "event", event.String())
+			if err := w.syncClusterCIDRs(); err != nil {
+				klog.ErrorS(err, "Failed to update NodeIPAM cluster CIDRs")
+			}
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			klog.ErrorS(err, "Error when watching configuration file")
+		}
+	}
+}
+
+func (w *clusterCIDRsWatcher) syncClusterCIDRs() error {
+	o := newOptions()
+	o.configFile = w.configFile
+	if err := o.loadConfigFromFile(); err != nil {
+		return err
+	}
+	o.setDefaults()
+	if err := o.validateNodeIPAMControllerOptions(); err != nil {
+		return err
+	}
+	cidrs, _ := netutils.ParseCIDRs(o.config.NodeIPAM.ClusterCIDRs)
+	var newCIDRs []*net.IPNet
+	current := sets.New[string]()
+	for _, cidr := range cidrs {
+		current.Insert(cidr.String())
+		if !w.clusterCIDRs.Has(cidr.String()) {
+			newCIDRs = append(newCIDRs, cidr)
+		}
+	}
+	if removed := w.clusterCIDRs.Difference(current); removed.Len() > 0 {
+		klog.InfoS("Removing cluster CIDRs requires restarting antrea-controller, ignoring the removal", "cidrs", sets.List(removed))
+	}
+	if len(newCIDRs) == 0 {
+		return nil
+	}
+	if err := w.nodeIPAM.AddClusterCIDRs(newCIDRs); err != nil {
+		return err
+	}
+	for _, cidr := range newCIDRs {
+		w.clusterCIDRs.Insert(cidr.String())
+	}
+	klog.InfoS("Added NodeIPAM cluster CIDRs", "cidrs", newCIDRs)
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	netutils "k8s.io/utils/net"
+)
+
+type fakeClusterCIDRsAdder struct {
+	added []string
+}
+
+func (f *fakeClusterCIDRsAdder) AddClusterCIDRs(cidrs []*net.IPNet) error {
+	for _, cidr := range cidrs {
+		f.added = append(f.added, cidr.String())
+	}
+	return nil
+}
+
+func TestSplitClusterCIDRs(t *testing.T) {
+	cidrs, err := netutils.ParseCIDRs([]string{"10.10.0.0/16", "a:b::/64", "10.20.0.0/16", "10.30.0.0/16", "a:c::/64"})
+	require.NoError(t, err)
+	clusterCIDRs, expansionCIDRs := splitClusterCIDRs(cidrs)
+	assert.Equal(t, []*net.IPNet{cidrs[0], cidrs[1]}, clusterCIDRs)
+	assert.Equal(t, []*net.IPNet{cidrs[2], cidrs[3], cidrs[4]}, expansionCIDRs)
+}
+
+func TestClusterCIDRsWatcherSync(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "antrea-controller.conf")
+	writeConfig := func(cidrs string) {
+		config := "nodeIPAM:\n  enableNodeIPAM: true\n  clusterCIDRs: " + cidrs + "\n"
+		require.NoError(t, os.WriteFile(configFile, []byte(config), 0644))
+	}
+	writeConfig("[10.10.0.0/16]")
+	adder := &fakeClusterCIDRsAdder{}
+	w, err := newClusterCIDRsWatcher(configFile, adder, []string{"10.10.0.0/16"})
+	require.NoError(t, err)
+	defer w.watcher.Close()
+
+	require.NoError(t, w.syncClusterCIDRs())
+	assert.Empty(t, adder.added)
+
+	writeConfig("[10.10.0.0/16, 10.20.0.0/16]")
+	require.NoError(t, w.syncClusterCIDRs())
+	assert.Equal(t, []string{"10.20.0.0/16"}, adder.added)
+
+	// Removing a cluster CIDR is ignored.
+	writeConfig("[10.10.0.0/16]")
+	require.NoError(t, w.syncClusterCIDRs())
+	assert.Equal(t, []string{"10.20.0.0/16"}, adder.added)
+
+	// Invalid configurations are rejected.
+	writeConfig("[10.10.0.0/16, 10.10.1.0/24]")
+	assert.ErrorContains(t, w.syncClusterCIDRs(), "overlap")
+	assert.Equal(t, []string{"10.20.0.0/16"}, adder.added)
+}
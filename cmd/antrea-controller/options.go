@@ -15,17 +15,24 @@
 package main
 
 import (
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
 	netutils "k8s.io/utils/net"
 
 	"antrea.io/antrea/pkg/apis"
 	controllerconfig "antrea.io/antrea/pkg/config/controller"
+	"antrea.io/antrea/pkg/controller/externalnode"
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/util/yaml"
 )
@@ -44,6 +51,9 @@ const (
 	ipamIPv6MaskLo      = 64
 	ipamIPv6MaskHi      = 126
 	ipamIPv6MaskDefault = 64
+
+	defaultClusterGroupWebhookTimeout        = "10s"
+	defaultClusterGroupWebhookResyncInterval = "10m"
 )
 
 type Options struct {
@@ -92,6 +102,97 @@ func (o *Options) validate(args []string) error {
 		klog.InfoS("Multicluster feature gate is disabled. Multicluster.EnableStretchedNetworkPolicy is ignored")
 	}
 
+	if !features.DefaultFeatureGate.Enabled(features.Egress) && o.config.PodEgressIP.Enable {
+		klog.InfoS("Egress feature gate is disabled. PodEgressIP.Enable is ignored")
+	}
+
+	if !features.DefaultFeatureGate.Enabled(features.Egress) && !features.DefaultFeatureGate.Enabled(features.ServiceExternalIP) && o.config.NodeDrain.Enable {
+		klog.InfoS("Egress and ServiceExternalIP feature gates are disabled. NodeDrain.Enable is ignored")
+	}
+
+	if features.DefaultFeatureGate.Enabled(features.ExternalNode) {
+		if err := o.validateExternalNodeOptions(); err != nil {
+			return err
+		}
+	}
+
+	if err := o.validateNetworkPolicyRealizationSLO(); err != nil {
+		return err
+	}
+
+	if o.config.ClusterGroupWebhook.Enable {
+		if err := o.validateClusterGroupWebhookOptions(); err != nil {
+			return err
+		}
+	}
+
+	if err := o.validateACNPExemptionOptions(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *Options) validateACNPExemptionOptions() error {
+	exemption := o.config.ACNPExemption
+	for _, namespace := range exemption.Namespaces {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("ACNP exemption Namespace %q is invalid: %s", namespace, strings.Join(errs, ", "))
+		}
+	}
+	if _, err := labels.Parse(exemption.NamespaceSelector); err != nil {
+		return fmt.Errorf("ACNP exemption Namespace selector %q is invalid: %w", exemption.NamespaceSelector, err)
+	}
+	for _, tier := range exemption.Tiers {
+		if tier == "" {
+			return fmt.Errorf("ACNP exemption Tier names must not be empty")
+		}
+	}
+	return nil
+}
+
+func (o *Options) validateClusterGroupWebhookOptions() error {
+	webhookConfig := o.config.ClusterGroupWebhook
+	u, err := url.Parse(webhookConfig.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("ClusterGroup webhook URL %q is invalid, it must be an https URL", webhookConfig.URL)
+	}
+	if webhookConfig.CABundle != "" {
+		if ok := x509.NewCertPool().AppendCertsFromPEM([]byte(webhookConfig.CABundle)); !ok {
+			return fmt.Errorf("ClusterGroup webhook CA bundle is invalid")
+		}
+	}
+	timeout, err := time.ParseDuration(webhookConfig.Timeout)
+	if err != nil || timeout <= 0 {
+		return fmt.Errorf("ClusterGroup webhook timeout %s is invalid, it must be a positive duration", webhookConfig.Timeout)
+	}
+	resyncInterval, err := time.ParseDuration(webhookConfig.ResyncInterval)
+	if err != nil || resyncInterval < 0 {
+		return fmt.Errorf("ClusterGroup webhook resync interval %s is invalid", webhookConfig.ResyncInterval)
+	}
+	return nil
+}
+
+func (o *Options) validateNetworkPolicyRealizationSLO() error {
+	if o.config.NetworkPolicyRealizationSLO == "" {
+		return nil
+	}
+	slo, err := time.ParseDuration(o.config.NetworkPolicyRealizationSLO)
+	if err != nil || slo <= 0 {
+		return fmt.Errorf("NetworkPolicy realization SLO %s is invalid, it must be a positive duration", o.config.NetworkPolicyRealizationSLO)
+	}
+	return nil
+}
+
+func (o *Options) validateExternalNodeOptions() error {
+	switch externalnode.ApprovalMode(o.config.ExternalNode.ApprovalMode) {
+	case externalnode.ApprovalModeAuto, externalnode.ApprovalModeManual:
+	default:
+		return fmt.Errorf("ExternalNode approval mode %s is invalid", o.config.ExternalNode.ApprovalMode)
+	}
+	if _, err := netutils.ParseCIDRs(o.config.ExternalNode.AutoApprovalCIDRs); err != nil {
+		return fmt.Errorf("ExternalNode auto-approval CIDRs %v is invalid", o.config.ExternalNode.AutoApprovalCIDRs)
+	}
 	return nil
 }
 
@@ -105,24 +206,24 @@ func (o *Options) validateNodeIPAMControllerOptions() error {
 	if len(cidrs) == 0 {
 		return fmt.Errorf("at least one cluster CIDR must be specified")
 	}
-	if len(cidrs) > 2 {
-		return fmt.Errorf("at most two cluster CIDRs may be specified")
-	}
 
+	// The first CIDR of each IP family is the primary cluster CIDR of the family, the following
+	// ones are expansion CIDRs, used once the previous CIDRs of the same family are exhausted.
 	hasIP4, hasIP6 := false, false
-	var ipv6Mask int
-	for _, cidr := range cidrs {
+	var ipv6Masks []int
+	for i, cidr := range cidrs {
 		if cidr.IP.To4() == nil {
 			hasIP6 = true
-			ipv6Mask, _ = cidr.Mask.Size()
+			ipv6Mask, _ := cidr.Mask.Size()
+			ipv6Masks = append(ipv6Masks, ipv6Mask)
 		} else {
 			hasIP4 = true
 		}
-	}
-
-	dualStack := hasIP4 && hasIP6
-	if len(cidrs) > 1 && !dualStack {
-		return fmt.Errorf("at most one cluster CIDR may be specified for each IP family")
+		for _, other := range cidrs[:i] {
+			if cidr.Contains(other.IP) || other.Contains(cidr.IP) {
+				return fmt.Errorf("cluster CIDRs %s and %s overlap", other, cidr)
+			}
+		}
 	}
 
 	if hasIP4 {
@@ -139,8 +240,10 @@ func (o *Options) validateNodeIPAMControllerOptions() error {
 		}
 		// The subnet mask size cannot be greater than 16 more than the cluster mask size.
 		// See https://github.com/kubernetes/kubernetes/issues/44918 for more information.
-		if o.config.NodeIPAM.NodeCIDRMaskSizeIPv6-ipv6Mask > 16 {
-			return fmt.Errorf("the Node IPv6 CIDR size is too big, the cluster CIDR mask size cannot be greater than 16 more than the Node IPv6 CIDR mask size")
+		for _, ipv6Mask := range ipv6Masks {
+			if o.config.NodeIPAM.NodeCIDRMaskSizeIPv6-ipv6Mask > 16 {
+				return fmt.Errorf("the Node IPv6 CIDR size is too big, the cluster CIDR mask size cannot be greater than 16 more than the Node IPv6 CIDR mask size")
+			}
 		}
 	}
 
@@ -174,6 +277,14 @@ func (o *Options) loadConfigFromFile() error {
 	return nil
 }
 
+// effectiveConfig returns a copy of the configuration with the defaults applied and the state of all the controller
+// feature gates resolved. It must be called after complete.
+func (o *Options) effectiveConfig() *controllerconfig.ControllerConfig {
+	cfg := *o.config
+	cfg.FeatureGates = features.GetEffectiveFeatureGates(features.ControllerGates)
+	return &cfg
+}
+
 func (o *Options) setDefaults() {
 	if o.config.APIPort == 0 {
 		o.config.APIPort = apis.AntreaControllerAPIPort
@@ -197,6 +308,15 @@ func (o *Options) setDefaults() {
 	if o.config.IPsecCSRSignerConfig.AutoApprove == nil {
 		o.config.IPsecCSRSignerConfig.AutoApprove = ptrBool(true)
 	}
+	if o.config.ExternalNode.ApprovalMode == "" {
+		o.config.ExternalNode.ApprovalMode = string(externalnode.ApprovalModeAuto)
+	}
+	if o.config.ClusterGroupWebhook.Timeout == "" {
+		o.config.ClusterGroupWebhook.Timeout = defaultClusterGroupWebhookTimeout
+	}
+	if o.config.ClusterGroupWebhook.ResyncInterval == "" {
+		o.config.ClusterGroupWebhook.ResyncInterval = defaultClusterGroupWebhookResyncInterval
+	}
 	if o.config.ClientConnection.QPS == 0.0 {
 		o.config.ClientConnection.QPS = defaultClientQPS
 	}
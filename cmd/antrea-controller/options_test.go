@@ -35,6 +35,9 @@ func TestNewOptions(t *testing.T) {
 	assert.Equal(t, true, *op.config.IPsecCSRSignerConfig.AutoApprove)
 	assert.EqualValues(t, defaultClientQPS, op.config.ClientConnection.QPS)
 	assert.EqualValues(t, defaultClientBurst, op.config.ClientConnection.Burst)
+	assert.Equal(t, "Auto", op.config.ExternalNode.ApprovalMode)
+	assert.Equal(t, "10s", op.config.ClusterGroupWebhook.Timeout)
+	assert.Equal(t, "10m", op.config.ClusterGroupWebhook.ResyncInterval)
 }
 
 func TestValidateNodeIPAMControllerOptions(t *testing.T) {
@@ -92,7 +95,7 @@ func TestValidateNodeIPAMControllerOptions(t *testing.T) {
 			expectedErr: "at least one cluster CIDR must be specified",
 		},
 		{
-			name: "too many ClusterCIDRs",
+			name: "valid config with expansion CIDRs",
 			nodeIPAMConfig: controllerconfig.NodeIPAMConfig{
 				EnableNodeIPAM:       true,
 				ClusterCIDRs:         []string{"10.10.0.0/16", "a:b::0/64", "20.20.0.0/24"},
@@ -101,19 +104,29 @@ func TestValidateNodeIPAMControllerOptions(t *testing.T) {
 				NodeCIDRMaskSizeIPv4: 24,
 				NodeCIDRMaskSizeIPv6: 80,
 			},
-			expectedErr: "at most two cluster CIDRs may be specified",
+			expectedErr: "",
 		},
 		{
-			name: "at most one cluster CIDR of each type",
+			name: "overlapping ClusterCIDRs",
 			nodeIPAMConfig: controllerconfig.NodeIPAMConfig{
 				EnableNodeIPAM:       true,
-				ClusterCIDRs:         []string{"10.10.0.0/16", "20.20.0.0/24"},
+				ClusterCIDRs:         []string{"10.10.0.0/16", "10.10.20.0/24"},
 				ServiceCIDR:          "172.16.0.0/16",
 				ServiceCIDRv6:        "2620:124::0/64",
 				NodeCIDRMaskSizeIPv4: 24,
 				NodeCIDRMaskSizeIPv6: 80,
 			},
-			expectedErr: "at most one cluster CIDR may be specified for each IP family",
+			expectedErr: "cluster CIDRs 10.10.0.0/16 and 10.10.20.0/24 overlap",
+		},
+		{
+			name: "invalid Node IPv6 CIDR size for expansion CIDR",
+			nodeIPAMConfig: controllerconfig.NodeIPAMConfig{
+				EnableNodeIPAM:       true,
+				ClusterCIDRs:         []string{"a:b::0/64", "a:c::0/48"},
+				NodeCIDRMaskSizeIPv4: 24,
+				NodeCIDRMaskSizeIPv6: 80,
+			},
+			expectedErr: "the Node IPv6 CIDR size is too big",
 		},
 		{
 			name: "invalid Node IPv4 CIDR mask size",
@@ -176,3 +189,190 @@ func TestValidateNodeIPAMControllerOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateExternalNodeOptions(t *testing.T) {
+	testCases := []struct {
+		name               string
+		externalNodeConfig controllerconfig.ExternalNodeConfig
+		expectedErr        string
+	}{
+		{
+			name: "valid config",
+			externalNodeConfig: controllerconfig.ExternalNodeConfig{
+				ApprovalMode:      "Auto",
+				AutoApprovalCIDRs: []string{"172.16.0.0/16"},
+			},
+		},
+		{
+			name: "invalid approval mode",
+			externalNodeConfig: controllerconfig.ExternalNodeConfig{
+				ApprovalMode: "Always",
+			},
+			expectedErr: "ExternalNode approval mode Always is invalid",
+		},
+		{
+			name: "invalid auto-approval CIDRs",
+			externalNodeConfig: controllerconfig.ExternalNodeConfig{
+				ApprovalMode:      "Manual",
+				AutoApprovalCIDRs: []string{"172.16.0.0"},
+			},
+			expectedErr: "ExternalNode auto-approval CIDRs [172.16.0.0] is invalid",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Options{config: &controllerconfig.ControllerConfig{ExternalNode: tc.externalNodeConfig}}
+			err := o.validateExternalNodeOptions()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateNetworkPolicyRealizationSLO(t *testing.T) {
+	testCases := []struct {
+		slo         string
+		expectedErr string
+	}{
+		{slo: ""},
+		{slo: "10s"},
+		{slo: "1m30s"},
+		{slo: "10", expectedErr: "NetworkPolicy realization SLO 10 is invalid"},
+		{slo: "-5s", expectedErr: "NetworkPolicy realization SLO -5s is invalid"},
+		{slo: "0s", expectedErr: "NetworkPolicy realization SLO 0s is invalid"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.slo, func(t *testing.T) {
+			o := &Options{config: &controllerconfig.ControllerConfig{NetworkPolicyRealizationSLO: tc.slo}}
+			err := o.validateNetworkPolicyRealizationSLO()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateClusterGroupWebhookOptions(t *testing.T) {
+	testCases := []struct {
+		name          string
+		webhookConfig controllerconfig.ClusterGroupWebhookConfig
+		expectedErr   string
+	}{
+		{
+			name: "valid config",
+			webhookConfig: controllerconfig.ClusterGroupWebhookConfig{
+				URL:            "https://firewall.example.com/clustergroups",
+				ClusterGroups:  []string{"cg1"},
+				Timeout:        "10s",
+				ResyncInterval: "0s",
+			},
+		},
+		{
+			name: "http URL",
+			webhookConfig: controllerconfig.ClusterGroupWebhookConfig{
+				URL:            "http://firewall.example.com/clustergroups",
+				Timeout:        "10s",
+				ResyncInterval: "10m",
+			},
+			expectedErr: `ClusterGroup webhook URL "http://firewall.example.com/clustergroups" is invalid`,
+		},
+		{
+			name: "invalid CA bundle",
+			webhookConfig: controllerconfig.ClusterGroupWebhookConfig{
+				URL:            "https://firewall.example.com/clustergroups",
+				CABundle:       "invalid",
+				Timeout:        "10s",
+				ResyncInterval: "10m",
+			},
+			expectedErr: "ClusterGroup webhook CA bundle is invalid",
+		},
+		{
+			name: "invalid timeout",
+			webhookConfig: controllerconfig.ClusterGroupWebhookConfig{
+				URL:            "https://firewall.example.com/clustergroups",
+				Timeout:        "0s",
+				ResyncInterval: "10m",
+			},
+			expectedErr: "ClusterGroup webhook timeout 0s is invalid",
+		},
+		{
+			name: "invalid resync interval",
+			webhookConfig: controllerconfig.ClusterGroupWebhookConfig{
+				URL:            "https://firewall.example.com/clustergroups",
+				Timeout:        "10s",
+				ResyncInterval: "10",
+			},
+			expectedErr: "ClusterGroup webhook resync interval 10 is invalid",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Options{config: &controllerconfig.ControllerConfig{ClusterGroupWebhook: tc.webhookConfig}}
+			err := o.validateClusterGroupWebhookOptions()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateACNPExemptionOptions(t *testing.T) {
+	testCases := []struct {
+		name            string
+		exemptionConfig controllerconfig.ACNPExemptionConfig
+		expectedErr     string
+	}{
+		{
+			name: "valid config",
+			exemptionConfig: controllerconfig.ACNPExemptionConfig{
+				Namespaces:        []string{"kube-system", "kube-public"},
+				NamespaceSelector: "antrea.io/acnp-exempt in (true)",
+				Tiers:             []string{"securityops", "Emergency"},
+			},
+		},
+		{
+			name:            "empty config",
+			exemptionConfig: controllerconfig.ACNPExemptionConfig{},
+		},
+		{
+			name: "invalid Namespace",
+			exemptionConfig: controllerconfig.ACNPExemptionConfig{
+				Namespaces: []string{"Kube_System"},
+			},
+			expectedErr: `ACNP exemption Namespace "Kube_System" is invalid`,
+		},
+		{
+			name: "invalid Namespace selector",
+			exemptionConfig: controllerconfig.ACNPExemptionConfig{
+				NamespaceSelector: "antrea.io/acnp-exempt in true",
+			},
+			expectedErr: `ACNP exemption Namespace selector "antrea.io/acnp-exempt in true" is invalid`,
+		},
+		{
+			name: "empty Tier",
+			exemptionConfig: controllerconfig.ACNPExemptionConfig{
+				Namespaces: []string{"kube-system"},
+				Tiers:      []string{""},
+			},
+			expectedErr: "ACNP exemption Tier names must not be empty",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &Options{config: &controllerconfig.ControllerConfig{ACNPExemption: tc.exemptionConfig}}
+			err := o.validateACNPExemptionOptions()
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
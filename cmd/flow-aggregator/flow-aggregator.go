@@ -27,6 +27,7 @@ import (
 
 	aggregator "antrea.io/antrea/pkg/flowaggregator"
 	"antrea.io/antrea/pkg/flowaggregator/apiserver"
+	"antrea.io/antrea/pkg/flowaggregator/metrics"
 	"antrea.io/antrea/pkg/log"
 	"antrea.io/antrea/pkg/signals"
 	"antrea.io/antrea/pkg/util/cipher"
@@ -66,6 +67,7 @@ func run(configFile string) error {
 	}
 	klog.InfoS("Retrieved Antrea cluster UUID", "clusterUUID", clusterUUID)
 
+	metrics.InitializePrometheusMetrics()
 	flowAggregator, err := aggregator.NewFlowAggregator(
 		k8sClient,
 		clusterUUID,
@@ -91,7 +93,9 @@ func run(configFile string) error {
 		flowAggregator,
 		flowAggregator.APIServer.APIPort,
 		cipherSuites,
-		cipher.TLSVersionMap[flowAggregator.APIServer.TLSMinVersion])
+		cipher.TLSVersionMap[flowAggregator.APIServer.TLSMinVersion],
+		flowAggregator.APIServer.Consumers,
+		flowAggregator.APIServer.Admins)
 	if err != nil {
 		return fmt.Errorf("error when creating flow aggregator API server: %v", err)
 	}
@@ -43,6 +43,17 @@ type LabelIdentityExport struct {
 	NormalizedLabel string `json:"normalizedLabel,omitempty"`
 }
 
+// ClusterSelector selects member clusters of a ClusterSet. A member cluster is
+// selected if its ClusterID is listed in ClusterIDs, or if the labels of its
+// ClusterSet match LabelSelector.
+type ClusterSelector struct {
+	// ClusterIDs selects member clusters by their ClusterIDs.
+	ClusterIDs []string `json:"clusterIDs,omitempty"`
+	// LabelSelector selects member clusters by the labels of the ClusterSet in
+	// the member clusters. An empty LabelSelector selects all member clusters.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
 // RawResourceExport exports opaque resources.
 type RawResourceExport struct {
 	Data []byte `json:"data,omitempty"`
@@ -73,6 +84,10 @@ type ResourceExportSpec struct {
 	LabelIdentity *LabelIdentityExport `json:"labelIdentity,omitempty"`
 	// If exported resource kind is unknown.
 	Raw *RawResourceExport `json:"raw,omitempty"`
+	// ClusterSelector selects the member clusters the exported resource is
+	// imported to. It is only supported by AntreaClusterNetworkPolicy. When not
+	// specified, the resource is imported to all member clusters.
+	ClusterSelector *ClusterSelector `json:"clusterSelector,omitempty"`
 }
 
 type ResourceExportConditionType string
@@ -66,6 +66,10 @@ type ResourceImportSpec struct {
 	LabelIdentity *LabelIdentitySpec `json:"labelIdentity,omitempty"`
 	// If imported resource kind is unknown.
 	Raw *RawResourceImport `json:"raw,omitempty"`
+	// ClusterSelector selects the member clusters the resource is imported to.
+	// It is only supported by AntreaClusterNetworkPolicy. When not specified,
+	// the resource is imported to all member clusters.
+	ClusterSelector *ClusterSelector `json:"clusterSelector,omitempty"`
 }
 
 type ResourceImportConditionType string
@@ -74,6 +78,21 @@ const (
 	ResourceImportSucceeded ResourceImportConditionType = "Succeeded"
 )
 
+const (
+	// ResourceImportReasonRealized indicates the imported resource has been
+	// realized in the member cluster.
+	ResourceImportReasonRealized = "Realized"
+	// ResourceImportReasonTierNotFound indicates the Tier of the imported
+	// AntreaClusterNetworkPolicy does not exist in the member cluster.
+	ResourceImportReasonTierNotFound = "TierNotFound"
+	// ResourceImportReasonConflict indicates the imported resource conflicts
+	// with an existing resource in the member cluster.
+	ResourceImportReasonConflict = "Conflict"
+	// ResourceImportReasonFailed indicates the imported resource failed to be
+	// realized in the member cluster.
+	ResourceImportReasonFailed = "Failed"
+)
+
 // ResourceImportCondition indicates the condition of the ResourceImport in a cluster.
 type ResourceImportCondition struct {
 	Type ResourceImportConditionType `json:"type,omitempty"`
@@ -106,6 +106,67 @@ type ClusterStatus struct {
 	// ClusterID is the unique identifier of this cluster.
 	ClusterID  string             `json:"clusterID,omitempty"`
 	Conditions []ClusterCondition `json:"conditions,omitempty"`
+	// +optional
+	// Last time the member cluster updated its MemberClusterAnnounce.
+	// Used in leader clusters only.
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
+}
+
+// GatewayTunnelHealth indicates the liveness of the tunnel between the Gateways of
+// two member clusters.
+type GatewayTunnelHealth struct {
+	// ClusterID of the remote member cluster.
+	ClusterID string `json:"clusterID,omitempty"`
+	// Live is true when both member clusters are connected to the leader cluster and
+	// have an active Gateway exported to the ClusterSet.
+	Live bool `json:"live"`
+}
+
+// ClusterHealth summarizes the health of a member cluster, as observed by the leader
+// cluster.
+type ClusterHealth struct {
+	// ClusterID is the unique identifier of the member cluster.
+	ClusterID string `json:"clusterID,omitempty"`
+	// Healthy is true when the member cluster is connected to the leader cluster, has an
+	// active Gateway, and its ResourceExports are converged into ResourceImports in time.
+	Healthy bool `json:"healthy"`
+	// Connected indicates whether the member cluster has updated its MemberClusterAnnounce
+	// recently.
+	Connected bool `json:"connected"`
+	// +optional
+	// Last time the member cluster updated its MemberClusterAnnounce.
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
+	// IPs of the active Gateways exported by the member cluster. It is empty when the
+	// member cluster has no active Gateway.
+	GatewayIPs []string `json:"gatewayIPs,omitempty"`
+	// Number of ResourceExports from the member cluster which have not been converged
+	// into ResourceImports yet.
+	PendingExports int32 `json:"pendingExports,omitempty"`
+	// Number of ResourceExports from the member cluster which failed to be converged into
+	// ResourceImports.
+	FailedExports int32 `json:"failedExports,omitempty"`
+	// +optional
+	// Time elapsed since the oldest pending or failed ResourceExport of the member cluster
+	// was created.
+	ExportLag *metav1.Duration `json:"exportLag,omitempty"`
+	// Liveness of the tunnels between the Gateway of the member cluster and the Gateways
+	// of the other member clusters.
+	GatewayTunnels []GatewayTunnelHealth `json:"gatewayTunnels,omitempty"`
+	// +optional
+	// A human readable message indicating why the member cluster is not healthy.
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterSetHealth aggregates the health of all member clusters of the ClusterSet.
+type ClusterSetHealth struct {
+	// Healthy is true when all member clusters are healthy.
+	Healthy bool `json:"healthy"`
+	// Number of healthy member clusters.
+	HealthyClusters int32 `json:"healthyClusters,omitempty"`
+	// The health of individual member clusters.
+	Clusters []ClusterHealth `json:"clusters,omitempty"`
+	// Last time the health was computed.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
 }
 
 // ClusterSetStatus defines the observed state of ClusterSet.
@@ -120,6 +181,9 @@ type ClusterSetStatus struct {
 	ClusterStatuses []ClusterStatus `json:"clusterStatuses,omitempty"`
 	// The generation observed by the controller.
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// +optional
+	// The aggregated health of the member clusters. Used in leader clusters only.
+	Health *ClusterSetHealth `json:"health,omitempty"`
 }
 
 // +genclient
@@ -130,6 +194,7 @@ type ClusterSetStatus struct {
 // +kubebuilder:printcolumn:name="Leader Cluster Namespace",type=string,JSONPath=`.spec.namespace`,description="The leader cluster Namespace for the ClusterSet"
 // +kubebuilder:printcolumn:name="Total Clusters",type=string,JSONPath=`.status.totalClusters`,description="Total number of clusters in the ClusterSet"
 // +kubebuilder:printcolumn:name="Ready Clusters",type=string,JSONPath=`.status.readyClusters`,description="Number of ready clusters in the ClusterSet"
+// +kubebuilder:printcolumn:name="Healthy",type=boolean,JSONPath=`.status.health.healthy`,description="Whether all member clusters in the ClusterSet are healthy"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
 // ClusterSet represents a ClusterSet.
 type ClusterSet struct {
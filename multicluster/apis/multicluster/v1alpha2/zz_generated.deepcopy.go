@@ -19,6 +19,7 @@
 package v1alpha2
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -95,6 +96,40 @@ func (in *ClusterCondition) DeepCopy() *ClusterCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHealth) DeepCopyInto(out *ClusterHealth) {
+	*out = *in
+	if in.LastHeartbeatTime != nil {
+		in, out := &in.LastHeartbeatTime, &out.LastHeartbeatTime
+		*out = (*in).DeepCopy()
+	}
+	if in.GatewayIPs != nil {
+		in, out := &in.GatewayIPs, &out.GatewayIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExportLag != nil {
+		in, out := &in.ExportLag, &out.ExportLag
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GatewayTunnels != nil {
+		in, out := &in.GatewayTunnels, &out.GatewayTunnels
+		*out = make([]GatewayTunnelHealth, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealth.
+func (in *ClusterHealth) DeepCopy() *ClusterHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSet) DeepCopyInto(out *ClusterSet) {
 	*out = *in
@@ -138,6 +173,29 @@ func (in *ClusterSetCondition) DeepCopy() *ClusterSetCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterSetHealth) DeepCopyInto(out *ClusterSetHealth) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSetHealth.
+func (in *ClusterSetHealth) DeepCopy() *ClusterSetHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterSetHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSetList) DeepCopyInto(out *ClusterSetList) {
 	*out = *in
@@ -207,6 +265,11 @@ func (in *ClusterSetStatus) DeepCopyInto(out *ClusterSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(ClusterSetHealth)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterSetStatus.
@@ -229,6 +292,10 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LastHeartbeatTime != nil {
+		in, out := &in.LastHeartbeatTime, &out.LastHeartbeatTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -241,6 +308,21 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayTunnelHealth) DeepCopyInto(out *GatewayTunnelHealth) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayTunnelHealth.
+func (in *GatewayTunnelHealth) DeepCopy() *GatewayTunnelHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayTunnelHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeaderClusterInfo) DeepCopyInto(out *LeaderClusterInfo) {
 	*out = *in
@@ -155,7 +155,9 @@ func (r *LeaderClusterSetReconciler) runBackgroundTasks() {
 //  3. Individual cluster status is obtained from MemberClusterAnnounce
 //     controller.
 //  4. ReadyClusters is the number of member clusters with "Ready" = "True"
-//  5. Overall condition of the ClusterSet is also computed as follows:
+//  5. Health aggregates the heartbeat, Gateway and ResourceExport status of
+//     every member cluster, see computeClusterSetHealth.
+//  6. Overall condition of the ClusterSet is also computed as follows:
 //     a. "Ready" = "True" if all clusters have "Ready" = "True".
 //     Message & Reason will be absent.
 //     b. "Ready" = "Unknown" if all clusters have "Ready" = "Unknown".
@@ -207,6 +209,11 @@ func (r *LeaderClusterSetReconciler) updateStatus() {
 		}
 	}
 	status.ReadyClusters = int32(readyClusters)
+	health, err := r.computeClusterSetHealth(clusterStatuses)
+	if err != nil {
+		klog.ErrorS(err, "Failed to compute health of ClusterSet", "name", namespacedName)
+	}
+	status.Health = health
 	overallCondition := mcv1alpha2.ClusterSetCondition{
 		Type:               mcv1alpha2.ClusterSetReady,
 		Status:             v1.ConditionFalse,
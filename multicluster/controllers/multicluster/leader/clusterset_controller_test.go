@@ -29,6 +29,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"antrea.io/antrea/multicluster/apis/multicluster/constants"
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
 	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
 	"antrea.io/antrea/multicluster/controllers/multicluster/common"
 )
@@ -242,3 +244,108 @@ func TestLeaderClusterStatus(t *testing.T) {
 	assert.Equal(t, expectedStatus.Conditions[0].Status, actualStatus.Conditions[0].Status)
 	assert.Equal(t, expectedStatus.Conditions[0].Message, actualStatus.Conditions[0].Message)
 }
+
+func TestLeaderClusterSetHealth(t *testing.T) {
+	clusterInfoExport := func(clusterID string, gatewayIPs ...string) *mcv1alpha1.ResourceExport {
+		clusterInfo := &mcv1alpha1.ClusterInfo{ClusterID: clusterID}
+		for _, ip := range gatewayIPs {
+			clusterInfo.GatewayInfos = append(clusterInfo.GatewayInfos, mcv1alpha1.GatewayInfo{GatewayIP: ip})
+		}
+		return &mcv1alpha1.ResourceExport{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "mcs1", Name: clusterID + "-clusterinfo"},
+			Spec: mcv1alpha1.ResourceExportSpec{
+				ClusterID:   clusterID,
+				Kind:        constants.ClusterInfoKind,
+				ClusterInfo: clusterInfo,
+			},
+		}
+	}
+	failedExport := &mcv1alpha1.ResourceExport{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "mcs1",
+			Name:              "east-default-nginx-service",
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-2 * time.Minute)),
+		},
+		Spec: mcv1alpha1.ResourceExportSpec{
+			ClusterID: "east",
+			Namespace: "default",
+			Name:      "nginx",
+			Kind:      constants.ServiceKind,
+		},
+		Status: mcv1alpha1.ResourceExportStatus{
+			Conditions: []mcv1alpha1.ResourceExportCondition{{
+				Type:   mcv1alpha1.ResourceExportFailure,
+				Status: v1.ConditionFalse,
+			}},
+		},
+	}
+	succeededExport := failedExport.DeepCopy()
+	succeededExport.Status.Conditions[0].Type = mcv1alpha1.ResourceExportSucceeded
+	succeededExport.Status.Conditions[0].Status = v1.ConditionTrue
+	readyStatus := func(clusterID string, status v1.ConditionStatus) mcv1alpha2.ClusterStatus {
+		return mcv1alpha2.ClusterStatus{
+			ClusterID:  clusterID,
+			Conditions: []mcv1alpha2.ClusterCondition{{Type: mcv1alpha2.ClusterReady, Status: status}},
+		}
+	}
+
+	tests := []struct {
+		name                    string
+		objects                 []client.Object
+		statuses                []mcv1alpha2.ClusterStatus
+		expectedHealthy         bool
+		expectedHealthyClusters int32
+		expectedTunnels         map[string][]mcv1alpha2.GatewayTunnelHealth
+		expectedMessages        map[string]string
+	}{
+		{
+			name:     "all clusters healthy",
+			objects:  []client.Object{clusterInfoExport("east", "10.0.0.1"), clusterInfoExport("west", "10.0.1.1"), succeededExport},
+			statuses: []mcv1alpha2.ClusterStatus{readyStatus("east", v1.ConditionTrue), readyStatus("west", v1.ConditionTrue)},
+			expectedTunnels: map[string][]mcv1alpha2.GatewayTunnelHealth{
+				"east": {{ClusterID: "west", Live: true}},
+				"west": {{ClusterID: "east", Live: true}},
+			},
+			expectedHealthy:         true,
+			expectedHealthyClusters: 2,
+			expectedMessages:        map[string]string{"east": "", "west": ""},
+		},
+		{
+			name:     "disconnected cluster and failed export",
+			objects:  []client.Object{clusterInfoExport("east", "10.0.0.1"), clusterInfoExport("west", "10.0.1.1"), failedExport},
+			statuses: []mcv1alpha2.ClusterStatus{readyStatus("east", v1.ConditionTrue), readyStatus("west", v1.ConditionFalse)},
+			expectedTunnels: map[string][]mcv1alpha2.GatewayTunnelHealth{
+				"east": {{ClusterID: "west", Live: false}},
+				"west": {{ClusterID: "east", Live: false}},
+			},
+			expectedMessages: map[string]string{
+				"east": "1 ResourceExports failed; ResourceExports pending for 2m0s",
+				"west": "member cluster is disconnected",
+			},
+		},
+		{
+			name:     "no active Gateway",
+			objects:  []client.Object{clusterInfoExport("east")},
+			statuses: []mcv1alpha2.ClusterStatus{readyStatus("east", v1.ConditionTrue)},
+			expectedTunnels: map[string][]mcv1alpha2.GatewayTunnelHealth{
+				"east": nil,
+			},
+			expectedMessages: map[string]string{"east": "no active Gateway"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeRemoteClient, mockStatusManager := createMockClients(t, tt.objects...)
+			r := NewLeaderClusterSetReconciler(fakeRemoteClient, "mcs1", false, mockStatusManager)
+			health, err := r.computeClusterSetHealth(tt.statuses)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedHealthy, health.Healthy)
+			assert.Equal(t, tt.expectedHealthyClusters, health.HealthyClusters)
+			assert.Equal(t, len(tt.statuses), len(health.Clusters))
+			for _, clusterHealth := range health.Clusters {
+				assert.Equal(t, tt.expectedTunnels[clusterHealth.ClusterID], clusterHealth.GatewayTunnels)
+				assert.Equal(t, tt.expectedMessages[clusterHealth.ClusterID], clusterHealth.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2025 Antrea Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package leader
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"antrea.io/antrea/multicluster/apis/multicluster/constants"
+	mcv1alpha1 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha1"
+	mcv1alpha2 "antrea.io/antrea/multicluster/apis/multicluster/v1alpha2"
+)
+
+// MaxExportLag is the maximum duration a ResourceExport of a member cluster can stay
+// pending or failed before the member cluster is considered unhealthy.
+var MaxExportLag = time.Minute
+
+// computeClusterSetHealth aggregates the health of all member clusters from:
+//  1. The heartbeat status provided by the MemberClusterAnnounce controller.
+//  2. The active Gateways in the ClusterInfo exported by each member cluster.
+//  3. The ResourceExports of each member cluster which are not converged into
+//     ResourceImports yet, or failed to be converged.
+//
+// The tunnel between the Gateways of two member clusters is considered live when
+// both member clusters are connected and have an active Gateway.
+func (r *LeaderClusterSetReconciler) computeClusterSetHealth(clusterStatuses []mcv1alpha2.ClusterStatus) (*mcv1alpha2.ClusterSetHealth, error) {
+	resExportList := &mcv1alpha1.ResourceExportList{}
+	if err := r.List(context.TODO(), resExportList, &client.ListOptions{Namespace: r.namespace}); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	clusterHealths := make(map[string]*mcv1alpha2.ClusterHealth, len(clusterStatuses))
+	oldestPendingExports := map[string]time.Time{}
+	for _, status := range clusterStatuses {
+		health := &mcv1alpha2.ClusterHealth{
+			ClusterID:         status.ClusterID,
+			LastHeartbeatTime: status.LastHeartbeatTime,
+		}
+		for _, condition := range status.Conditions {
+			if condition.Type == mcv1alpha2.ClusterReady && condition.Status == v1.ConditionTrue {
+				health.Connected = true
+			}
+		}
+		clusterHealths[status.ClusterID] = health
+	}
+	for i := range resExportList.Items {
+		resExport := &resExportList.Items[i]
+		health, ok := clusterHealths[resExport.Spec.ClusterID]
+		if !ok || !resExport.DeletionTimestamp.IsZero() {
+			continue
+		}
+		switch resExport.Spec.Kind {
+		case constants.ClusterInfoKind:
+			if resExport.Spec.ClusterInfo != nil {
+				for _, gw := range resExport.Spec.ClusterInfo.GatewayInfos {
+					health.GatewayIPs = append(health.GatewayIPs, gw.GatewayIP)
+				}
+			}
+		case constants.ServiceKind, constants.EndpointsKind, constants.AntreaClusterNetworkPolicyKind:
+			conditions := resExport.Status.Conditions
+			if len(conditions) > 0 && conditions[0].Type == mcv1alpha1.ResourceExportSucceeded {
+				continue
+			}
+			if len(conditions) == 0 {
+				health.PendingExports += 1
+			} else {
+				health.FailedExports += 1
+			}
+			oldest, ok := oldestPendingExports[health.ClusterID]
+			if !ok || resExport.CreationTimestamp.Time.Before(oldest) {
+				oldestPendingExports[health.ClusterID] = resExport.CreationTimestamp.Time
+			}
+		}
+	}
+
+	clusterIDs := make([]string, 0, len(clusterHealths))
+	for clusterID := range clusterHealths {
+		clusterIDs = append(clusterIDs, clusterID)
+	}
+	sort.Strings(clusterIDs)
+	setHealth := &mcv1alpha2.ClusterSetHealth{
+		Healthy:        true,
+		LastUpdateTime: metav1.NewTime(now),
+	}
+	for _, clusterID := range clusterIDs {
+		health := clusterHealths[clusterID]
+		gatewayActive := health.Connected && len(health.GatewayIPs) > 0
+		for _, remoteID := range clusterIDs {
+			if remoteID == clusterID {
+				continue
+			}
+			remote := clusterHealths[remoteID]
+			health.GatewayTunnels = append(health.GatewayTunnels, mcv1alpha2.GatewayTunnelHealth{
+				ClusterID: remoteID,
+				Live:      gatewayActive && remote.Connected && len(remote.GatewayIPs) > 0,
+			})
+		}
+
+		var problems []string
+		if !health.Connected {
+			problems = append(problems, "member cluster is disconnected")
+		}
+		if len(health.GatewayIPs) == 0 {
+			problems = append(problems, "no active Gateway")
+		}
+		if health.FailedExports > 0 {
+			problems = append(problems, fmt.Sprintf("%d ResourceExports failed", health.FailedExports))
+		}
+		if oldest, ok := oldestPendingExports[clusterID]; ok {
+			lag := now.Sub(oldest).Truncate(time.Second)
+			health.ExportLag = &metav1.Duration{Duration: lag}
+			if lag > MaxExportLag {
+				problems = append(problems, fmt.Sprintf("ResourceExports pending for %s", lag))
+			}
+		}
+		health.Healthy = len(problems) == 0
+		health.Message = strings.Join(problems, "; ")
+		if health.Healthy {
+			setHealth.HealthyClusters += 1
+		} else {
+			setHealth.Healthy = false
+		}
+		setHealth.Clusters = append(setHealth.Clusters, *health)
+	}
+	return setHealth, nil
+}
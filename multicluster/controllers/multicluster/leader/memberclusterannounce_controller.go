@@ -223,6 +223,8 @@ func (r *MemberClusterAnnounceReconciler) GetMemberClusterStatuses() []mcv1alpha
 	index := 0
 	for _, v := range r.memberStatusMap {
 		status[index] = *v.status.DeepCopy()
+		lastHeartbeatTime := metav1.NewTime(v.lastUpdateTime)
+		status[index].LastHeartbeatTime = &lastHeartbeatTime
 		index += 1
 	}
 
@@ -168,6 +168,11 @@ func (r *ResourceExportReconciler) handleUpdateEvent(ctx context.Context,
 		klog.ErrorS(err, "Failed to update ResourceImport", "resourceimport", resImpName.String())
 		return err
 	}
+	if resExport.Spec.Kind == constants.AntreaClusterNetworkPolicyKind {
+		// The ClusterStatuses of ACNP ResourceImports are reported by the member clusters
+		// importing the policy, to reflect which members have realized it.
+		return nil
+	}
 	latestResImport := &mcsv1alpha1.ResourceImport{}
 	err = r.Client.Get(ctx, resImpName, latestResImport)
 	if err != nil {
@@ -397,11 +402,18 @@ func (r *ResourceExportReconciler) refreshACNPResourceImport(
 	newResImport.Spec.Name = resExport.Spec.Name
 	newResImport.Spec.Namespace = resExport.Spec.Namespace
 	newResImport.Spec.Kind = constants.AntreaClusterNetworkPolicyKind
+	if selector := resExport.Spec.ClusterSelector; selector != nil && selector.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selector.LabelSelector); err != nil {
+			return newResImport, false, fmt.Errorf("invalid cluster LabelSelector of ResourceExport %s/%s: %w", resExport.Namespace, resExport.Name, err)
+		}
+	}
 	if createResImport {
 		newResImport.Spec.ClusterNetworkPolicy = resExport.Spec.ClusterNetworkPolicy
+		newResImport.Spec.ClusterSelector = resExport.Spec.ClusterSelector
 		return newResImport, true, nil
 	}
-	if !apiequality.Semantic.DeepEqual(resExport.Spec.ClusterNetworkPolicy, resImport.Spec.ClusterNetworkPolicy) {
+	if !apiequality.Semantic.DeepEqual(resExport.Spec.ClusterNetworkPolicy, resImport.Spec.ClusterNetworkPolicy) ||
+		!apiequality.Semantic.DeepEqual(resExport.Spec.ClusterSelector, resImport.Spec.ClusterSelector) {
 		undeletedItems, err := r.getNotDeletedResourceExports(resExport)
 		if err != nil {
 			klog.ErrorS(err, "Failed to list ResourceExports for ACNP, retry later")
@@ -409,6 +421,7 @@ func (r *ResourceExportReconciler) refreshACNPResourceImport(
 		}
 		if len(undeletedItems) == 1 && undeletedItems[0].Name == resExport.Name && undeletedItems[0].Namespace == resExport.Namespace {
 			newResImport.Spec.ClusterNetworkPolicy = resExport.Spec.ClusterNetworkPolicy
+			newResImport.Spec.ClusterSelector = resExport.Spec.ClusterSelector
 			return newResImport, true, nil
 		}
 	}
@@ -371,6 +371,109 @@ func TestResourceExportReconciler_handleACNPExportCreateEvent(t *testing.T) {
 	}
 }
 
+func TestResourceExportReconciler_handleACNPExportWithClusterSelector(t *testing.T) {
+	tests := []struct {
+		name               string
+		clusterSelector    *mcsv1alpha1.ClusterSelector
+		existingResImport  *mcsv1alpha1.ResourceImport
+		expectedImportSpec *mcsv1alpha1.ResourceImportSpec
+		expectedErr        bool
+	}{
+		{
+			name: "create ResourceImport with ClusterSelector",
+			clusterSelector: &mcsv1alpha1.ClusterSelector{
+				ClusterIDs:    []string{"cluster-a"},
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "west"}},
+			},
+			expectedImportSpec: &mcsv1alpha1.ResourceImportSpec{
+				Name:                 "test-acnp",
+				Kind:                 constants.AntreaClusterNetworkPolicyKind,
+				ClusterNetworkPolicy: isolationACNPSpec,
+				ClusterSelector: &mcsv1alpha1.ClusterSelector{
+					ClusterIDs:    []string{"cluster-a"},
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "west"}},
+				},
+			},
+		},
+		{
+			name: "update ClusterSelector of ResourceImport",
+			clusterSelector: &mcsv1alpha1.ClusterSelector{
+				ClusterIDs: []string{"cluster-b"},
+			},
+			existingResImport: &mcsv1alpha1.ResourceImport{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "test-acnp-antreaclusternetworkpolicy",
+				},
+				Spec: mcsv1alpha1.ResourceImportSpec{
+					Name:                 "test-acnp",
+					Kind:                 constants.AntreaClusterNetworkPolicyKind,
+					ClusterNetworkPolicy: isolationACNPSpec,
+					ClusterSelector: &mcsv1alpha1.ClusterSelector{
+						ClusterIDs: []string{"cluster-a"},
+					},
+				},
+			},
+			expectedImportSpec: &mcsv1alpha1.ResourceImportSpec{
+				Name:                 "test-acnp",
+				Kind:                 constants.AntreaClusterNetworkPolicyKind,
+				ClusterNetworkPolicy: isolationACNPSpec,
+				ClusterSelector: &mcsv1alpha1.ClusterSelector{
+					ClusterIDs: []string{"cluster-b"},
+				},
+			},
+		},
+		{
+			name: "invalid cluster LabelSelector",
+			clusterSelector: &mcsv1alpha1.ClusterSelector{
+				LabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "region", Operator: "Invalid"},
+				}},
+			},
+			expectedErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resExport := &mcsv1alpha1.ResourceExport{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:  "default",
+					Name:       "test-acnp-export",
+					Finalizers: []string{constants.ResourceExportFinalizer},
+					Labels: map[string]string{
+						constants.SourceName:      "test-acnp",
+						constants.SourceNamespace: "",
+						constants.SourceKind:      constants.AntreaClusterNetworkPolicyKind,
+					},
+				},
+				Spec: mcsv1alpha1.ResourceExportSpec{
+					Name:                 "test-acnp",
+					Kind:                 constants.AntreaClusterNetworkPolicyKind,
+					ClusterNetworkPolicy: isolationACNPSpec,
+					ClusterSelector:      tt.clusterSelector,
+				},
+			}
+			objects := []client.Object{resExport}
+			if tt.existingResImport != nil {
+				objects = append(objects, tt.existingResImport)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(objects...).
+				WithStatusSubresource(&mcsv1alpha1.ResourceImport{}).Build()
+			r := NewResourceExportReconciler(fakeClient, common.TestScheme)
+			_, err := r.Reconcile(common.TestCtx, acnpResReq)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			resImport := &mcsv1alpha1.ResourceImport{}
+			require.NoError(t, fakeClient.Get(common.TestCtx, GetResourceImportName(resExport), resImport))
+			assert.Equal(t, *tt.expectedImportSpec, resImport.Spec)
+			assert.Empty(t, resImport.Status.ClusterStatuses)
+		})
+	}
+}
+
 var (
 	newResExport = &mcsv1alpha1.ResourceExport{
 		ObjectMeta: metav1.ObjectMeta{
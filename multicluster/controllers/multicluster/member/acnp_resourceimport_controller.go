@@ -17,12 +17,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,11 +54,21 @@ func (r *ResourceImportReconciler) handleResImpUpdateForClusterNetworkPolicy(ctx
 		Namespace: "",
 		Name:      common.ToMCResourceName(resImp.Spec.Name),
 	}
+	selected, err := r.isLocalClusterSelected(resImp.Spec.ClusterSelector)
+	if err != nil {
+		msg := fmt.Sprintf("Invalid ClusterSelector of ResourceImport for cluster %s: %v", r.localClusterID, err)
+		return ctrl.Result{}, r.reportImportFailure(ctx, resImp, multiclusterv1alpha1.ResourceImportReasonFailed, msg)
+	}
+	if !selected {
+		klog.InfoS("Skip importing ACNP since the local cluster is not selected by ResourceImport",
+			"acnp", acnpName.String(), "resourceimport", klog.KObj(resImp))
+		return ctrl.Result{}, r.removeUnselectedClusterNetworkPolicy(ctx, acnpName, resImp)
+	}
 	klog.InfoS("Updating ACNP corresponding to ResourceImport",
 		"acnp", acnpName.String(), "resourceimport", klog.KObj(resImp))
 
 	acnp := &v1beta1.ClusterNetworkPolicy{}
-	err := r.localClusterClient.Get(ctx, acnpName, acnp)
+	err = r.localClusterClient.Get(ctx, acnpName, acnp)
 	acnpNotFound := apierrors.IsNotFound(err)
 	if err != nil && !acnpNotFound {
 		return ctrl.Result{}, err
@@ -65,7 +78,7 @@ func (r *ResourceImportReconciler) handleResImpUpdateForClusterNetworkPolicy(ctx
 			msg := "Unable to import Antrea ClusterNetworkPolicy which conflicts with existing one in cluster " + r.localClusterID
 			err := errors.New(msg)
 			klog.ErrorS(err, "", "acnp", klog.KObj(acnp))
-			return ctrl.Result{}, r.reportStatusEvent(msg, ctx, resImp)
+			return ctrl.Result{}, r.reportImportFailure(ctx, resImp, multiclusterv1alpha1.ResourceImportReasonConflict, msg)
 		}
 	}
 	acnpObj := getMCAntreaClusterPolicy(resImp)
@@ -74,7 +87,7 @@ func (r *ResourceImportReconciler) handleResImpUpdateForClusterNetworkPolicy(ctx
 	tierNotFound := apierrors.IsNotFound(err)
 	if err != nil && !tierNotFound {
 		msg := fmt.Sprintf("Failed to get Tier %s in member cluster %s", tierName, r.localClusterID)
-		return ctrl.Result{}, r.reportStatusEvent(msg, ctx, resImp)
+		return ctrl.Result{}, r.reportImportFailure(ctx, resImp, multiclusterv1alpha1.ResourceImportReasonFailed, msg)
 	}
 	tierNotFoundMsg := fmt.Sprintf("ACNP Tier %s does not exist in importing cluster %s", tierName, r.localClusterID)
 	if !tierNotFound {
@@ -84,7 +97,7 @@ func (r *ResourceImportReconciler) handleResImpUpdateForClusterNetworkPolicy(ctx
 			if err = r.localClusterClient.Create(ctx, acnpObj, &client.CreateOptions{}); err != nil {
 				msg := "Failed to create imported Antrea ClusterNetworkPolicy in cluster " + r.localClusterID
 				klog.ErrorS(err, msg, "acnp", klog.KObj(acnpObj))
-				return ctrl.Result{}, r.reportStatusEvent(msg, ctx, resImp)
+				return ctrl.Result{}, r.reportImportFailure(ctx, resImp, multiclusterv1alpha1.ResourceImportReasonFailed, msg)
 			}
 			r.installedResImports.Add(*resImp)
 		} else if !apiequality.Semantic.DeepEqual(acnp.Spec, acnpObj.Spec) {
@@ -92,7 +105,7 @@ func (r *ResourceImportReconciler) handleResImpUpdateForClusterNetworkPolicy(ctx
 			if err = r.localClusterClient.Update(ctx, acnp, &client.UpdateOptions{}); err != nil {
 				msg := "Failed to update imported Antrea ClusterNetworkPolicy in cluster " + r.localClusterID
 				klog.ErrorS(err, msg, "acnp", klog.KObj(acnpObj))
-				return ctrl.Result{}, r.reportStatusEvent(msg, ctx, resImp)
+				return ctrl.Result{}, r.reportImportFailure(ctx, resImp, multiclusterv1alpha1.ResourceImportReasonFailed, msg)
 			}
 		}
 	} else if !acnpNotFound {
@@ -101,13 +114,124 @@ func (r *ResourceImportReconciler) handleResImpUpdateForClusterNetworkPolicy(ctx
 		if err = r.localClusterClient.Delete(ctx, acnpObj, &client.DeleteOptions{}); err != nil {
 			msg := "Failed to delete imported Antrea ClusterNetworkPolicy that no longer has a valid Tier for cluster " + r.localClusterID
 			klog.ErrorS(err, msg, "acnp", klog.KObj(acnpObj))
-			return ctrl.Result{}, r.reportStatusEvent(msg, ctx, resImp)
+			return ctrl.Result{}, r.reportImportFailure(ctx, resImp, multiclusterv1alpha1.ResourceImportReasonFailed, msg)
 		}
-		return ctrl.Result{}, r.reportStatusEvent(tierNotFoundMsg, ctx, resImp)
+		return ctrl.Result{}, r.reportImportFailure(ctx, resImp, multiclusterv1alpha1.ResourceImportReasonTierNotFound, tierNotFoundMsg)
 	} else {
-		return ctrl.Result{}, r.reportStatusEvent(tierNotFoundMsg, ctx, resImp)
+		return ctrl.Result{}, r.reportImportFailure(ctx, resImp, multiclusterv1alpha1.ResourceImportReasonTierNotFound, tierNotFoundMsg)
 	}
-	return ctrl.Result{}, nil
+	return ctrl.Result{}, r.updateResImportClusterStatus(ctx, resImp, &multiclusterv1alpha1.ResourceImportCondition{
+		Type:    multiclusterv1alpha1.ResourceImportSucceeded,
+		Status:  corev1.ConditionTrue,
+		Reason:  multiclusterv1alpha1.ResourceImportReasonRealized,
+		Message: "Antrea ClusterNetworkPolicy is realized in cluster " + r.localClusterID,
+	})
+}
+
+// isLocalClusterSelected returns whether the local cluster is selected by the ClusterSelector of a
+// ResourceImport. All member clusters are selected when the ClusterSelector is not specified.
+func (r *ResourceImportReconciler) isLocalClusterSelected(selector *multiclusterv1alpha1.ClusterSelector) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+	if slices.Contains(selector.ClusterIDs, r.localClusterID) {
+		return true, nil
+	}
+	if selector.LabelSelector == nil {
+		return false, nil
+	}
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector.LabelSelector)
+	if err != nil {
+		return false, err
+	}
+	return labelSelector.Matches(labels.Set(r.clusterLabels)), nil
+}
+
+// removeUnselectedClusterNetworkPolicy deletes the ACNP previously imported from a ResourceImport which
+// no longer selects the local cluster, and removes the status of the local cluster from the ResourceImport.
+func (r *ResourceImportReconciler) removeUnselectedClusterNetworkPolicy(ctx context.Context, acnpName types.NamespacedName,
+	resImp *multiclusterv1alpha1.ResourceImport) error {
+	acnp := &v1beta1.ClusterNetworkPolicy{}
+	err := r.localClusterClient.Get(ctx, acnpName, acnp)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	// An ACNP without the annotation is not created by the import and must not be deleted.
+	if _, ok := acnp.Annotations[common.AntreaMCACNPAnnotation]; err == nil && ok {
+		if err := client.IgnoreNotFound(r.localClusterClient.Delete(ctx, acnp, &client.DeleteOptions{})); err != nil {
+			klog.ErrorS(err, "Failed to delete imported ACNP which no longer selects the local cluster", "acnp", acnpName.String())
+			return err
+		}
+	}
+	r.installedResImports.Delete(*resImp)
+	return r.updateResImportClusterStatus(ctx, resImp, nil)
+}
+
+// reportImportFailure reports the failure to import a ResourceImport with an Event in the leader cluster,
+// and with the status of the local cluster in the ResourceImport.
+func (r *ResourceImportReconciler) reportImportFailure(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport,
+	reason, msg string) error {
+	if err := r.reportStatusEvent(msg, ctx, resImp); err != nil {
+		return err
+	}
+	return r.updateResImportClusterStatus(ctx, resImp, &multiclusterv1alpha1.ResourceImportCondition{
+		Type:    multiclusterv1alpha1.ResourceImportSucceeded,
+		Status:  corev1.ConditionFalse,
+		Reason:  reason,
+		Message: msg,
+	})
+}
+
+// updateResImportClusterStatus sets the condition of the local cluster in the ClusterStatuses of a
+// ResourceImport, so that the leader cluster can tell which member clusters have realized the imported
+// resource. The status of the local cluster is removed if condition is nil.
+func (r *ResourceImportReconciler) updateResImportClusterStatus(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport,
+	condition *multiclusterv1alpha1.ResourceImportCondition) error {
+	resImpName := types.NamespacedName{Namespace: resImp.Namespace, Name: resImp.Name}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latestResImp := &multiclusterv1alpha1.ResourceImport{}
+		if err := r.remoteCommonArea.Get(ctx, resImpName, latestResImp); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		var clusterStatuses []multiclusterv1alpha1.ResourceImportClusterStatus
+		var existingCondition *multiclusterv1alpha1.ResourceImportCondition
+		found := false
+		for i, s := range latestResImp.Status.ClusterStatuses {
+			if s.ClusterID != r.localClusterID {
+				clusterStatuses = append(clusterStatuses, s)
+				continue
+			}
+			found = true
+			if len(s.Conditions) > 0 {
+				existingCondition = &latestResImp.Status.ClusterStatuses[i].Conditions[0]
+			}
+		}
+		if condition == nil {
+			if !found {
+				return nil
+			}
+		} else {
+			newCondition := *condition
+			if existingCondition != nil && existingCondition.Status == newCondition.Status {
+				if existingCondition.Reason == newCondition.Reason && existingCondition.Message == newCondition.Message {
+					return nil
+				}
+				newCondition.LastTransitionTime = existingCondition.LastTransitionTime
+			} else {
+				newCondition.LastTransitionTime = metav1.Now()
+			}
+			clusterStatuses = append(clusterStatuses, multiclusterv1alpha1.ResourceImportClusterStatus{
+				ClusterID:  r.localClusterID,
+				Conditions: []multiclusterv1alpha1.ResourceImportCondition{newCondition},
+			})
+		}
+		latestResImp.Status.ClusterStatuses = clusterStatuses
+		if err := r.remoteCommonArea.Status().Update(ctx, latestResImp, &client.SubResourceUpdateOptions{}); err != nil {
+			klog.ErrorS(err, "Failed to update ResourceImport status", "resourceimport", resImpName.String())
+			return err
+		}
+		return nil
+	})
 }
 
 func (r *ResourceImportReconciler) handleResImpDeleteForClusterNetworkPolicy(ctx context.Context, resImp *multiclusterv1alpha1.ResourceImport) (ctrl.Result, error) {
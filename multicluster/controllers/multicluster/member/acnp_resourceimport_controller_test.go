@@ -124,7 +124,8 @@ var (
 
 func TestResourceImportReconciler_handleCopySpanACNPCreateEvent(t *testing.T) {
 	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(securityOpsTier).Build()
-	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(acnpResImport, acnpResImportNoMatchingTier, acnpResImportNoSpec).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(acnpResImport, acnpResImportNoMatchingTier, acnpResImportNoSpec).
+		WithStatusSubresource(&mcsv1alpha1.ResourceImport{}).Build()
 	remoteCluster := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", localClusterID, "default", nil)
 
 	tests := []struct {
@@ -302,7 +303,8 @@ func TestResourceImportReconciler_handleCopySpanACNPUpdateEvent(t *testing.T) {
 	}
 
 	fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(existingACNP1, existingACNP3, existingACNP4, securityOpsTier).Build()
-	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(acnpResImport, updatedResImport2, updatedResImport3).Build()
+	fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(acnpResImport, updatedResImport2, updatedResImport3).
+		WithStatusSubresource(&mcsv1alpha1.ResourceImport{}).Build()
 	remoteCluster := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", localClusterID, "default", nil)
 
 	r := newResourceImportReconciler(fakeClient, localClusterID, "default", remoteCluster)
@@ -375,3 +377,141 @@ func TestResourceImportReconciler_handleCopySpanACNPUpdateEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceImportReconciler_handleACNPWithClusterSelector(t *testing.T) {
+	importedACNP := &v1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        common.AntreaMCSPrefix + acnpImportName,
+			Annotations: map[string]string{common.AntreaMCACNPAnnotation: "true"},
+		},
+		Spec: *acnpResImport.Spec.ClusterNetworkPolicy,
+	}
+	localACNP := &v1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: common.AntreaMCSPrefix + acnpImportName,
+		},
+		Spec: *acnpResImport.Spec.ClusterNetworkPolicy,
+	}
+	otherClusterStatus := mcsv1alpha1.ResourceImportClusterStatus{
+		ClusterID: "cluster-b",
+		Conditions: []mcsv1alpha1.ResourceImportCondition{{
+			Type:   mcsv1alpha1.ResourceImportSucceeded,
+			Status: corev1.ConditionTrue,
+			Reason: mcsv1alpha1.ResourceImportReasonRealized,
+		}},
+	}
+	localClusterStatus := mcsv1alpha1.ResourceImportClusterStatus{
+		ClusterID: localClusterID,
+		Conditions: []mcsv1alpha1.ResourceImportCondition{{
+			Type:   mcsv1alpha1.ResourceImportSucceeded,
+			Status: corev1.ConditionTrue,
+			Reason: mcsv1alpha1.ResourceImportReasonRealized,
+		}},
+	}
+	tests := []struct {
+		name               string
+		clusterSelector    *mcsv1alpha1.ClusterSelector
+		tier               *v1beta1.Tier
+		existingACNP       *v1beta1.ClusterNetworkPolicy
+		existingStatuses   []mcsv1alpha1.ResourceImportClusterStatus
+		expectACNPExists   bool
+		expectedConditions map[string]*mcsv1alpha1.ResourceImportCondition
+	}{
+		{
+			name:             "selected by ClusterID",
+			clusterSelector:  &mcsv1alpha1.ClusterSelector{ClusterIDs: []string{localClusterID}},
+			tier:             securityOpsTier,
+			existingStatuses: []mcsv1alpha1.ResourceImportClusterStatus{otherClusterStatus},
+			expectACNPExists: true,
+			expectedConditions: map[string]*mcsv1alpha1.ResourceImportCondition{
+				"cluster-b":    {Status: corev1.ConditionTrue, Reason: mcsv1alpha1.ResourceImportReasonRealized},
+				localClusterID: {Status: corev1.ConditionTrue, Reason: mcsv1alpha1.ResourceImportReasonRealized},
+			},
+		},
+		{
+			name: "selected by cluster labels",
+			clusterSelector: &mcsv1alpha1.ClusterSelector{
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "west"}},
+			},
+			tier:             securityOpsTier,
+			expectACNPExists: true,
+			expectedConditions: map[string]*mcsv1alpha1.ResourceImportCondition{
+				localClusterID: {Status: corev1.ConditionTrue, Reason: mcsv1alpha1.ResourceImportReasonRealized},
+			},
+		},
+		{
+			name:             "selected but Tier not found",
+			clusterSelector:  &mcsv1alpha1.ClusterSelector{ClusterIDs: []string{localClusterID}},
+			expectACNPExists: false,
+			expectedConditions: map[string]*mcsv1alpha1.ResourceImportCondition{
+				localClusterID: {Status: corev1.ConditionFalse, Reason: mcsv1alpha1.ResourceImportReasonTierNotFound},
+			},
+		},
+		{
+			name: "not selected and imported ACNP removed",
+			clusterSelector: &mcsv1alpha1.ClusterSelector{
+				ClusterIDs:    []string{"cluster-b"},
+				LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "east"}},
+			},
+			tier:             securityOpsTier,
+			existingACNP:     importedACNP,
+			existingStatuses: []mcsv1alpha1.ResourceImportClusterStatus{otherClusterStatus, localClusterStatus},
+			expectACNPExists: false,
+			expectedConditions: map[string]*mcsv1alpha1.ResourceImportCondition{
+				"cluster-b": {Status: corev1.ConditionTrue, Reason: mcsv1alpha1.ResourceImportReasonRealized},
+			},
+		},
+		{
+			name:               "not selected and local ACNP kept",
+			clusterSelector:    &mcsv1alpha1.ClusterSelector{ClusterIDs: []string{"cluster-b"}},
+			tier:               securityOpsTier,
+			existingACNP:       localACNP,
+			expectACNPExists:   true,
+			expectedConditions: map[string]*mcsv1alpha1.ResourceImportCondition{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resImport := acnpResImport.DeepCopy()
+			resImport.Spec.ClusterSelector = tt.clusterSelector
+			resImport.Status.ClusterStatuses = tt.existingStatuses
+			var localObjects []client.Object
+			if tt.tier != nil {
+				localObjects = append(localObjects, tt.tier)
+			}
+			if tt.existingACNP != nil {
+				localObjects = append(localObjects, tt.existingACNP)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(localObjects...).Build()
+			fakeRemoteClient := fake.NewClientBuilder().WithScheme(common.TestScheme).WithObjects(resImport).
+				WithStatusSubresource(&mcsv1alpha1.ResourceImport{}).Build()
+			remoteCluster := commonarea.NewFakeRemoteCommonArea(fakeRemoteClient, "leader-cluster", localClusterID, "default", nil)
+			r := newResourceImportReconciler(fakeClient, localClusterID, "default", remoteCluster)
+			r.clusterLabels = map[string]string{"region": "west"}
+
+			_, err := r.Reconcile(ctx, acnpImpReq)
+			assert.NoError(t, err)
+
+			acnp := &v1beta1.ClusterNetworkPolicy{}
+			err = fakeClient.Get(ctx, types.NamespacedName{Name: common.AntreaMCSPrefix + acnpImportName}, acnp)
+			if tt.expectACNPExists {
+				assert.NoError(t, err)
+			} else {
+				assert.True(t, apierrors.IsNotFound(err))
+			}
+
+			latestResImport := &mcsv1alpha1.ResourceImport{}
+			assert.NoError(t, fakeRemoteClient.Get(ctx, acnpImpReq.NamespacedName, latestResImport))
+			conditions := map[string]*mcsv1alpha1.ResourceImportCondition{}
+			for _, s := range latestResImport.Status.ClusterStatuses {
+				if assert.Len(t, s.Conditions, 1) {
+					conditions[s.ClusterID] = &mcsv1alpha1.ResourceImportCondition{
+						Status: s.Conditions[0].Status,
+						Reason: s.Conditions[0].Reason,
+					}
+				}
+			}
+			assert.Equal(t, tt.expectedConditions, conditions)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"sync"
 	"time"
 
@@ -62,6 +63,9 @@ type MemberClusterSetReconciler struct {
 	clusterSetID    common.ClusterSetID
 	clusterID       common.ClusterID
 	installedLeader leaderClusterInfo
+	// installedLabels are the labels of the ClusterSet used to select the ResourceImports
+	// imported to the local cluster.
+	installedLabels map[string]string
 
 	remoteCommonArea             commonarea.RemoteCommonArea
 	enableStretchedNetworkPolicy bool
@@ -128,8 +132,11 @@ func (r *MemberClusterSetReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		clusterSetCreated = r.clusterID != common.ClusterID(clusterSet.Spec.ClusterID) || r.clusterSetID != common.ClusterSetID(clusterSet.Name)
 		leaderChanged := r.installedLeader.clusterID != newLeader.ClusterID || r.installedLeader.serverUrl != newLeader.Server ||
 			r.installedLeader.secretName != newLeader.Secret
+		// The RemoteCommonArea is recreated when the labels of the ClusterSet are changed, so that
+		// all ResourceImports are reconciled again with the new labels.
+		labelsChanged := !maps.Equal(r.installedLabels, clusterSet.Labels)
 
-		if !leaderChanged && !clusterSetCreated {
+		if !leaderChanged && !clusterSetCreated && !labelsChanged {
 			klog.V(2).InfoS("No change for leader cluster configuration")
 			return nil
 		}
@@ -192,6 +199,7 @@ func (r *MemberClusterSetReconciler) cleanUpResources(ctx context.Context) error
 		r.remoteCommonArea.Stop()
 		r.remoteCommonArea = nil
 		r.installedLeader = leaderClusterInfo{}
+		r.installedLabels = nil
 	}
 
 	if r.clusterID != common.InvalidClusterID {
@@ -228,12 +236,13 @@ func (r *MemberClusterSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		}
 	}()
 
-	// Ignore status update event via GenerationChangedPredicate
-	generationPredicate := predicate.GenerationChangedPredicate{}
+	// Ignore status update event via GenerationChangedPredicate, but handle label update
+	// event which changes the ResourceImports selecting the local cluster.
+	updatePredicate := predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{})
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&mcv1alpha2.ClusterSet{}).
 		Named("clusterset").
-		WithEventFilter(generationPredicate).
+		WithEventFilter(updatePredicate).
 		WithOptions(controller.Options{
 			MaxConcurrentReconciles: 1,
 		}).
@@ -281,6 +290,7 @@ func (r *MemberClusterSetReconciler) createRemoteCommonArea(clusterSet *mcv1alph
 		r.namespace,
 		r.remoteCommonArea,
 	)
+	resImportReconciler.clusterLabels = maps.Clone(clusterSet.Labels)
 	r.remoteCommonArea.AddImportReconciler(resImportReconciler)
 
 	if r.enableStretchedNetworkPolicy {
@@ -301,6 +311,7 @@ func (r *MemberClusterSetReconciler) createRemoteCommonArea(clusterSet *mcv1alph
 		serverUrl:  newLeader.Server,
 		secretName: newLeader.Secret,
 	}
+	r.installedLabels = maps.Clone(clusterSet.Labels)
 
 	return nil
 }
@@ -240,6 +240,7 @@ func TestMemberCreateOrUpdateRemoteCommonArea(t *testing.T) {
 			Namespace:  "mcs1",
 			Name:       "clusterset1",
 			Generation: 1,
+			Labels:     map[string]string{"region": "west"},
 		},
 		Spec: mcv1alpha2.ClusterSetSpec{
 			Leaders: []mcv1alpha2.LeaderClusterInfo{
@@ -274,6 +275,7 @@ func TestMemberCreateOrUpdateRemoteCommonArea(t *testing.T) {
 	err := reconciler.createRemoteCommonArea(existingClusterSet)
 	assert.Equal(t, nil, err)
 	assert.Equal(t, expectedInstalledLeader, reconciler.installedLeader)
+	assert.Equal(t, map[string]string{"region": "west"}, reconciler.installedLabels)
 }
 
 func TestMemberClusterSetAddWithoutClusterID(t *testing.T) {
@@ -62,6 +62,9 @@ type ResourceImportReconciler struct {
 	namespace           string
 	remoteCommonArea    commonarea.RemoteCommonArea
 	installedResImports cache.Indexer
+	// clusterLabels are the labels of the ClusterSet in the local cluster, which are matched
+	// against the ClusterSelector of ResourceImports.
+	clusterLabels map[string]string
 	// Saved Manager to indicate SetupWithManager() is done or not.
 	manager ctrl.Manager
 }
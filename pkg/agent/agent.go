@@ -28,6 +28,7 @@ import (
 	"github.com/containernetworking/plugins/pkg/ip"
 	"github.com/spf13/afero"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apitypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -43,17 +44,21 @@ import (
 	"antrea.io/antrea/pkg/agent/controller/trafficcontrol"
 	"antrea.io/antrea/pkg/agent/externalnode"
 	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/ipsec"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/openflow/cookie"
+	"antrea.io/antrea/pkg/agent/preflight"
 	"antrea.io/antrea/pkg/agent/route"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/agent/util"
 	"antrea.io/antrea/pkg/agent/wireguard"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	"antrea.io/antrea/pkg/client/clientset/versioned"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
 	"antrea.io/antrea/pkg/util/env"
+	utilexternalnode "antrea.io/antrea/pkg/util/externalnode"
 	utilip "antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
 	utilwait "antrea.io/antrea/pkg/util/wait"
@@ -87,6 +92,7 @@ var (
 
 	// getTransportIPNetDeviceByNameFn is meant to be overridden for testing.
 	getTransportIPNetDeviceByNameFn = getTransportIPNetDeviceByName
+	getIPNetDeviceByNameFn          = util.GetIPNetDeviceByName
 
 	// setLinkUp is meant to be overridden for testing
 	setLinkUp = util.SetLinkUp
@@ -116,6 +122,7 @@ type Initializer struct {
 	ofClient                 openflow.Client
 	routeClient              route.Interface
 	wireGuardClient          wireguard.Interface
+	ipsecClient              ipsec.Interface
 	ifaceStore               interfacestore.InterfaceStore
 	ovsBridge                string
 	hostGateway              string // name of gateway port on the OVS bridge
@@ -141,6 +148,11 @@ type Initializer struct {
 	stopCh                  <-chan struct{}
 	nodeType                config.NodeType
 	externalNodeNamespace   string
+	// externalNodeRegistration determines whether and how the agent registers its ExternalNode when it does not
+	// exist. It is used only when nodeType is externalNode.
+	externalNodeRegistration *agentconfig.ExternalNodeRegistrationConfig
+	// gatewayIPNets are the IP addresses configured on the host gateway interface by the agent, if any.
+	gatewayIPNets []*net.IPNet
 }
 
 func NewInitializer(
@@ -163,6 +175,7 @@ func NewInitializer(
 	stopCh <-chan struct{},
 	nodeType config.NodeType,
 	externalNodeNamespace string,
+	externalNodeRegistration *agentconfig.ExternalNodeRegistrationConfig,
 	connectUplinkToBridge bool,
 	enableAntreaProxy bool,
 	enableL7NetworkPolicy bool,
@@ -190,6 +203,7 @@ func NewInitializer(
 		stopCh:                   stopCh,
 		nodeType:                 nodeType,
 		externalNodeNamespace:    externalNodeNamespace,
+		externalNodeRegistration: externalNodeRegistration,
 		connectUplinkToBridge:    connectUplinkToBridge,
 		enableAntreaProxy:        enableAntreaProxy,
 		enableL7NetworkPolicy:    enableL7NetworkPolicy,
@@ -208,6 +222,12 @@ func (i *Initializer) GetWireGuardClient() wireguard.Interface {
 	return i.wireGuardClient
 }
 
+// GetIPsecClient returns the IPsec client, which is only created on Windows when IPsec is enabled with "cert"
+// authentication mode.
+func (i *Initializer) GetIPsecClient() ipsec.Interface {
+	return i.ipsecClient
+}
+
 // setupOVSBridge sets up the OVS bridge and create host gateway interface and tunnel port
 func (i *Initializer) setupOVSBridge() error {
 	if err := i.ovsBridgeClient.Create(); err != nil {
@@ -394,6 +414,10 @@ func (i *Initializer) Initialize() error {
 		return err
 	}
 
+	if err := i.checkKernelFeatures(); err != nil {
+		return err
+	}
+
 	if err := i.prepareHostNetwork(); err != nil {
 		return err
 	}
@@ -427,15 +451,19 @@ func (i *Initializer) Initialize() error {
 		if err := i.waitForIPsecMonitorDaemon(); err != nil {
 			return err
 		}
-		if err := i.readIPSecPSK(); err != nil {
-			return err
+		// When the PSKs are read from an external secret store, they are provided per Node pair to the
+		// NodeRouteController.
+		if i.networkConfig.IPsecConfig.PSKSource == config.IPsecPSKSourceEnv {
+			if err := i.readIPSecPSK(); err != nil {
+				return err
+			}
 		}
 	}
 
 	// Initialize for IPsec Certificate mode.
 	if i.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec &&
 		i.networkConfig.IPsecConfig.AuthenticationMode == config.IPsecAuthenticationModeCert {
-		if err := i.waitForIPsecMonitorDaemon(); err != nil {
+		if err := i.initializeIPsecCertAuth(); err != nil {
 			return err
 		}
 	} else {
@@ -947,15 +975,7 @@ func (i *Initializer) initK8sNodeLocalConfig(nodeName string) error {
 		if err != nil {
 			return fmt.Errorf("failed to get local IPNet device with transport interface %s: %v", i.networkConfig.TransportIface, err)
 		}
-		klog.InfoS("Updating Node transport addresses annotation")
-		var ips []string
-		if transportIPv4Addr != nil {
-			ips = append(ips, transportIPv4Addr.IP.String())
-		}
-		if transportIPv6Addr != nil {
-			ips = append(ips, transportIPv6Addr.IP.String())
-		}
-		if err := i.patchNodeAnnotations(nodeName, types.NodeTransportAddressAnnotationKey, strings.Join(ips, ",")); err != nil {
+		if err := i.patchNodeTransportAddressAnnotation(nodeName, transportIPv4Addr, transportIPv6Addr); err != nil {
 			return err
 		}
 	} else if len(i.networkConfig.TransportIfaceCIDRs) > 0 {
@@ -963,15 +983,7 @@ func (i *Initializer) initK8sNodeLocalConfig(nodeName string) error {
 		if err != nil {
 			return fmt.Errorf("failed to get local IPNet device with transport Address CIDR %s: %v", i.networkConfig.TransportIfaceCIDRs, err)
 		}
-		var ips []string
-		if transportIPv4Addr != nil {
-			ips = append(ips, transportIPv4Addr.IP.String())
-		}
-		if transportIPv6Addr != nil {
-			ips = append(ips, transportIPv6Addr.IP.String())
-		}
-		klog.InfoS("Updating Node transport addresses annotation")
-		if err := i.patchNodeAnnotations(nodeName, types.NodeTransportAddressAnnotationKey, strings.Join(ips, ",")); err != nil {
+		if err := i.patchNodeTransportAddressAnnotation(nodeName, transportIPv4Addr, transportIPv6Addr); err != nil {
 			return err
 		}
 	} else {
@@ -992,6 +1004,11 @@ func (i *Initializer) initK8sNodeLocalConfig(nodeName string) error {
 		}
 	}
 
+	multipathUplinks, err := i.initMultipathUplinks(node)
+	if err != nil {
+		return err
+	}
+
 	i.nodeConfig = &config.NodeConfig{
 		Name:                       nodeName,
 		Type:                       config.K8sNode,
@@ -1005,6 +1022,7 @@ func (i *Initializer) initK8sNodeLocalConfig(nodeName string) error {
 		UplinkNetConfig:            new(config.AdapterNetConfig),
 		NodeTransportInterfaceMTU:  transportInterface.MTU,
 		WireGuardConfig:            i.wireGuardConfig,
+		MultipathUplinks:           multipathUplinks,
 	}
 
 	i.networkConfig.InterfaceMTU, err = i.getInterfaceMTU(transportInterface)
@@ -1218,13 +1236,8 @@ func (i *Initializer) allocateGatewayAddresses(localSubnets []*net.IPNet, gatewa
 	if err := configureLinkAddresses(i.nodeConfig.GatewayConfig.LinkIndex, gwIPs); err != nil {
 		return err
 	}
-	// Periodically check whether IP configuration of the gateway is correct.
-	// Terminate when stopCh is closed.
-	go wait.Until(func() {
-		if err := configureLinkAddresses(i.nodeConfig.GatewayConfig.LinkIndex, gwIPs); err != nil {
-			klog.Errorf("Failed to check IP configuration of the gateway: %v", err)
-		}
-	}, 60*time.Second, i.stopCh)
+	// The IP configuration of the gateway is then checked periodically by the GatewayMonitor.
+	i.gatewayIPNets = gwIPs
 
 	for _, gwIP := range gwIPs {
 		if gwIP.IP.To4() != nil {
@@ -1239,6 +1252,38 @@ func (i *Initializer) allocateGatewayAddresses(localSubnets []*net.IPNet, gatewa
 	return nil
 }
 
+// initMultipathUplinks gets the addresses of the additional uplinks configured for multipath routing, and publishes
+// them in the multipath address annotation of the Node so that other Nodes can route Pod traffic through them.
+func (i *Initializer) initMultipathUplinks(node *v1.Node) ([]*config.MultipathUplink, error) {
+	if len(i.networkConfig.MultipathUplinkInterfaces) == 0 {
+		if node.Annotations[types.NodeMultipathAddressAnnotationKey] != "" {
+			klog.InfoS("Removing Node multipath addresses annotation")
+			i.patchNodeAnnotations(node.Name, types.NodeMultipathAddressAnnotationKey, nil)
+		}
+		return nil, nil
+	}
+	var uplinks []*config.MultipathUplink
+	var ips []string
+	for _, name := range i.networkConfig.MultipathUplinkInterfaces {
+		ipv4Addr, ipv6Addr, _, err := getIPNetDeviceByNameFn(name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get local IPNet device with multipath uplink interface %s: %v", name, err)
+		}
+		if ipv4Addr != nil {
+			ips = append(ips, ipv4Addr.IP.String())
+		}
+		if ipv6Addr != nil {
+			ips = append(ips, ipv6Addr.IP.String())
+		}
+		uplinks = append(uplinks, &config.MultipathUplink{Name: name, IPv4Addr: ipv4Addr, IPv6Addr: ipv6Addr})
+	}
+	klog.InfoS("Updating Node multipath addresses annotation", "addresses", ips)
+	if err := i.patchNodeAnnotations(node.Name, types.NodeMultipathAddressAnnotationKey, strings.Join(ips, ",")); err != nil {
+		return nil, err
+	}
+	return uplinks, nil
+}
+
 func (i *Initializer) patchNodeAnnotations(nodeName, key string, value interface{}) error {
 	patch, _ := json.Marshal(map[string]interface{}{
 		"metadata": map[string]interface{}{
@@ -1257,6 +1302,19 @@ func (i *Initializer) patchNodeAnnotations(nodeName, key string, value interface
 	return nil
 }
 
+// patchNodeTransportAddressAnnotation updates the transport addresses of the Node in its annotations.
+func (i *Initializer) patchNodeTransportAddressAnnotation(nodeName string, transportIPv4Addr, transportIPv6Addr *net.IPNet) error {
+	klog.InfoS("Updating Node transport addresses annotation")
+	var ips []string
+	if transportIPv4Addr != nil {
+		ips = append(ips, transportIPv4Addr.IP.String())
+	}
+	if transportIPv6Addr != nil {
+		ips = append(ips, transportIPv6Addr.IP.String())
+	}
+	return i.patchNodeAnnotations(nodeName, types.NodeTransportAddressAnnotationKey, strings.Join(ips, ","))
+}
+
 // getNodeInterfaceFromIP returns the IPv4/IPv6 configuration, and the associated interface according the give nodeIPs.
 // When searching the Node interface, antrea-gw0 is ignored because it is configured with the same address as Node IP
 // with NetworkPolicyOnly mode on public cloud setup, e.g., AKS.
@@ -1293,12 +1351,44 @@ func (i *Initializer) initNodeLocalConfig() error {
 	return nil
 }
 
+// checkKernelFeatures validates that the kernel supports the features required by the enabled Antrea
+// features, so that the agent fails with an actionable error instead of failing later in an obscure
+// way. The results are saved in the NodeConfig and reported in AntreaAgentInfo.
+func (i *Initializer) checkKernelFeatures() error {
+	preflightConfig := &preflight.Config{
+		EnableWireGuard: i.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeWireGuard ||
+			(i.networkConfig.EnableMulticlusterGW && i.networkConfig.MulticlusterEncryptionMode == config.TrafficEncryptionModeWireGuard),
+		EnableIPv6:            i.networkConfig.IPv6Enabled,
+		EnableHardwareOffload: i.ovsBridgeClient.IsHardwareOffloadEnabled(),
+	}
+	results := preflight.Run(preflightConfig)
+	for _, r := range results {
+		if r.Err != nil {
+			klog.ErrorS(r.Err, "Kernel feature is not supported", "feature", r.Name, "requiredBy", r.RequiredBy)
+		} else {
+			klog.V(2).InfoS("Kernel feature is supported", "feature", r.Name, "requiredBy", r.RequiredBy)
+		}
+	}
+	i.nodeConfig.KernelFeatureChecks = results
+	return preflight.Error(results)
+}
+
 func (i *Initializer) initVMLocalConfig(nodeName string) error {
 	var en *v1alpha1.ExternalNode
 	klog.InfoS("Initializing VM config", "ExternalNode", nodeName)
 	if err := wait.PollUntilContextCancel(wait.ContextForChannel(i.stopCh), 10*time.Second, true, func(ctx context.Context) (done bool, err error) {
 		en, err = i.crdClient.CrdV1alpha1().ExternalNodes(i.externalNodeNamespace).Get(context.TODO(), nodeName, metav1.GetOptions{})
 		if err != nil {
+			if errors.IsNotFound(err) && i.externalNodeRegistration != nil && i.externalNodeRegistration.Enable {
+				if err := i.registerExternalNode(nodeName); err != nil {
+					klog.ErrorS(err, "Failed to register ExternalNode", "ExternalNode", klog.KRef(i.externalNodeNamespace, nodeName))
+				}
+			}
+			return false, nil
+		}
+		// An ExternalNode registered by antrea-agent must be approved before it can be realized.
+		if state := utilexternalnode.GetRegistrationState(en); state == utilexternalnode.RegistrationStatePending || state == utilexternalnode.RegistrationStateRejected {
+			klog.InfoS("Waiting for ExternalNode to be approved", "ExternalNode", klog.KObj(en), "state", state)
 			return false, nil
 		}
 		return true, nil
@@ -1314,6 +1404,19 @@ func (i *Initializer) initVMLocalConfig(nodeName string) error {
 	return nil
 }
 
+// registerExternalNode creates the ExternalNode of the local Node, in the Pending registration state.
+func (i *Initializer) registerExternalNode(nodeName string) error {
+	en, err := externalnode.GenRegistrationExternalNode(nodeName, i.externalNodeNamespace, i.externalNodeRegistration)
+	if err != nil {
+		return err
+	}
+	if _, err := i.crdClient.CrdV1alpha1().ExternalNodes(i.externalNodeNamespace).Create(context.TODO(), en, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	klog.InfoS("Registered ExternalNode", "ExternalNode", klog.KObj(en), "labels", en.Labels)
+	return nil
+}
+
 // prepareOVSBridge operates OVS bridge.
 func (i *Initializer) prepareOVSBridge() error {
 	if i.nodeType == config.K8sNode {
@@ -41,6 +41,11 @@ var (
 
 	// setInterfaceARPAnnounce is meant to be overridden for testing.
 	setInterfaceARPAnnounce = util.EnsureARPAnnounceOnInterface
+
+	// setLinkHardwareAddr is meant to be overridden for testing.
+	setLinkHardwareAddr = func(name string, mac net.HardwareAddr) error {
+		return util.SetAdapterMACAddress(name, &mac)
+	}
 )
 
 // prepareHostNetwork returns immediately on Linux.
@@ -179,6 +184,12 @@ func (i *Initializer) RestoreOVSBridge() {
 	}
 }
 
+// initializeIPsecCertAuth checks if preconditions are met for using IPsec with "cert" authentication mode. The IPsec
+// tunnels are configured by the OVS IPsec monitor.
+func (i *Initializer) initializeIPsecCertAuth() error {
+	return i.waitForIPsecMonitorDaemon()
+}
+
 func (i *Initializer) setInterfaceMTU(iface string, mtu int) error {
 	return i.ovsBridgeClient.SetInterfaceMTU(iface, mtu)
 }
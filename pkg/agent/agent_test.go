@@ -47,6 +47,7 @@ import (
 	"antrea.io/antrea/pkg/ovs/ovsctl"
 	ovsctltest "antrea.io/antrea/pkg/ovs/ovsctl/testing"
 	"antrea.io/antrea/pkg/util/env"
+	utilexternalnode "antrea.io/antrea/pkg/util/externalnode"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/runtime"
 )
@@ -942,6 +943,17 @@ func TestInitVMLocalConfig(t *testing.T) {
 			crdClient:   fakeversioned.NewSimpleClientset(),
 			expectedErr: "context canceled",
 		},
+		{
+			name:        "registered external Node pending approval",
+			nodeName:    "testNode",
+			crdClient:   fakeversioned.NewSimpleClientset(withRegistrationState(testNode, utilexternalnode.RegistrationStatePending)),
+			expectedErr: "context canceled",
+		},
+		{
+			name:      "registered external Node approved",
+			nodeName:  "testNode",
+			crdClient: fakeversioned.NewSimpleClientset(withRegistrationState(testNode, utilexternalnode.RegistrationStateApproved)),
+		},
 	}
 
 	for _, tt := range tests {
@@ -967,6 +979,12 @@ func TestInitVMLocalConfig(t *testing.T) {
 	}
 }
 
+func withRegistrationState(en *crdv1alpha1.ExternalNode, state string) *crdv1alpha1.ExternalNode {
+	en = en.DeepCopy()
+	en.Annotations = map[string]string{utilexternalnode.RegistrationStateAnnotationKey: state}
+	return en
+}
+
 func TestValidateSupportedDPFeatures(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -18,20 +18,25 @@
 package agent
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/Microsoft/hcsshim"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/externalnode"
 	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/ipsec"
 	"antrea.io/antrea/pkg/agent/util"
 	antreasyscall "antrea.io/antrea/pkg/agent/util/syscall"
 	"antrea.io/antrea/pkg/agent/util/winnet"
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
 	utilip "antrea.io/antrea/pkg/util/ip"
@@ -44,6 +49,12 @@ var (
 
 	// setInterfaceARPAnnounce is meant to be overridden for testing.
 	setInterfaceARPAnnounce = func(ifaceName string, value int) error { return nil }
+
+	// setLinkHardwareAddr is meant to be overridden for testing. Restoring the MAC address of the host gateway
+	// interface is not supported on Windows, a change is only reported.
+	setLinkHardwareAddr = func(name string, mac net.HardwareAddr) error {
+		return fmt.Errorf("setting the MAC address of interface %s is not supported on Windows", name)
+	}
 )
 
 func (i *Initializer) prepareHostNetwork() error {
@@ -203,7 +214,60 @@ func (i *Initializer) prepareVMNetworkAndOVSExtension() error {
 // prepareOVSBridgeForK8sNode adds local port and uplink port to OVS bridge after OVS extension is enabled on HNSNetwork.
 // This function deletes OVS bridge and HNS network created by Antrea on failure.
 func (i *Initializer) prepareOVSBridgeForK8sNode() error {
-	return i.prepareOVSBridgeOnHNSNetwork()
+	if err := i.prepareOVSBridgeOnHNSNetwork(); err != nil {
+		return err
+	}
+	if features.DefaultFeatureGate.Enabled(features.Egress) {
+		// The traffic SNAT'd by OVS for Egress is output to the uplink directly, with the MAC address of the uplink's
+		// default gateway as the destination MAC. Failing to resolve it only impacts Egress, so it doesn't block the
+		// agent's startup.
+		if err := i.resolveUplinkGatewayMAC(); err != nil {
+			klog.ErrorS(err, "Failed to resolve the MAC address of the uplink's default gateway, Egress IPs cannot be assigned to this Node")
+		}
+	}
+	return nil
+}
+
+// resolveUplinkGatewayMAC resolves the MAC address of the default gateway of the bridge local interface, to which the
+// uplink's network configuration has been moved, and saves it in UplinkNetConfig.
+func (i *Initializer) resolveUplinkGatewayMAC() error {
+	brName := i.ovsBridgeClient.GetBridgeName()
+	brInterface, err := net.InterfaceByName(brName)
+	if err != nil {
+		return fmt.Errorf("failed to get interface %s: %w", brName, err)
+	}
+	gateway, err := util.GetDefaultGatewayByInterfaceIndex(brInterface.Index)
+	if err != nil {
+		return err
+	}
+	gatewayIP := net.ParseIP(gateway)
+	if gateway == "" || gatewayIP == nil {
+		return fmt.Errorf("no default gateway found on interface %s", brName)
+	}
+	// Send a packet to the gateway to trigger the resolution, in case it's not in the neighbor cache.
+	if conn, err := net.Dial("udp", net.JoinHostPort(gateway, "9")); err == nil {
+		_, _ = conn.Write([]byte{0})
+		conn.Close()
+	}
+	var gatewayMAC net.HardwareAddr
+	if err := wait.PollUntilContextTimeout(context.TODO(), 200*time.Millisecond, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+		neighbors, err := winnetUtil.GetNetNeighbor(&winnet.Neighbor{LinkIndex: brInterface.Index, IPAddress: gatewayIP})
+		if err != nil {
+			return false, err
+		}
+		for _, n := range neighbors {
+			if n.State != "Incomplete" && n.State != "Unreachable" && len(n.LinkLayerAddress) > 0 {
+				gatewayMAC = n.LinkLayerAddress
+				return true, nil
+			}
+		}
+		return false, nil
+	}); err != nil {
+		return fmt.Errorf("failed to resolve the MAC address of gateway %s: %w", gateway, err)
+	}
+	klog.InfoS("Resolved the MAC address of the uplink's default gateway", "gateway", gateway, "mac", gatewayMAC)
+	i.nodeConfig.UplinkNetConfig.GatewayMAC = gatewayMAC
+	return nil
 }
 
 // prepareOVSBridgeOnHNSNetwork adds local port and uplink to OVS bridge after the OVS Extension is enabled on HNSNetwork.
@@ -449,6 +513,14 @@ func (i *Initializer) ConnectUplinkToOVSBridge() error { return nil }
 // OVS is managed by system in Windows, network config can be retained after Antrea shutdown.
 func (i *Initializer) RestoreOVSBridge() {}
 
+// initializeIPsecCertAuth initializes the IPsec client with "cert" authentication mode. As the OVS IPsec monitor is
+// not available on Windows, the IPsec connections to peer Nodes are configured with strongSwan, and the tunnel
+// traffic sent through the default tunnel port is encrypted by the IPsec stack of the host.
+func (i *Initializer) initializeIPsecCertAuth() error {
+	i.ipsecClient = ipsec.NewStrongSwanClient(i.nodeConfig, i.networkConfig)
+	return i.ipsecClient.Init()
+}
+
 func (i *Initializer) setInterfaceMTU(iface string, mtu int) error {
 	if err := i.ovsBridgeClient.SetInterfaceMTU(iface, mtu); err != nil {
 		return err
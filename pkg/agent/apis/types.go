@@ -15,6 +15,8 @@
 package apis
 
 import (
+	"net"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -36,6 +38,7 @@ type AntreaAgentInfoResponse struct {
 	NetworkPolicyControllerInfo v1beta1.NetworkPolicyControllerInfo `json:"networkPolicyControllerInfo,omitempty"` // Antrea Agent NetworkPolicy information
 	LocalPodNum                 int32                               `json:"localPodNum,omitempty"`                 // The number of Pods which the agent is in charge of
 	AgentConditions             []v1beta1.AgentCondition            `json:"agentConditions,omitempty"`             // Agent condition contains types like AgentHealthy
+	KernelFeatureChecks         []v1beta1.KernelFeatureCheck        `json:"kernelFeatureChecks,omitempty"`         // The results of the checks of the kernel features required by the Agent
 }
 
 func (r AntreaAgentInfoResponse) GetTableHeader() []string {
@@ -77,6 +80,7 @@ type FQDNCacheResponse struct {
 	FQDNName       string    `json:"fqdnName,omitempty"`
 	IPAddress      string    `json:"ipAddress,omitempty"`
 	ExpirationTime time.Time `json:"expirationTime,omitempty"`
+	Static         bool      `json:"static,omitempty"`
 }
 
 func (r FQDNCacheResponse) GetTableHeader() []string {
@@ -84,10 +88,14 @@ func (r FQDNCacheResponse) GetTableHeader() []string {
 }
 
 func (r FQDNCacheResponse) GetTableRow(maxColumn int) []string {
+	expirationTime := r.ExpirationTime.String()
+	if r.Static {
+		expirationTime = "Never"
+	}
 	return []string{
 		r.FQDNName,
 		r.IPAddress,
-		r.ExpirationTime.String(),
+		expirationTime,
 	}
 }
 
@@ -140,9 +148,127 @@ func (r MulticastResponse) SortRows() bool {
 	return true
 }
 
+// MulticastGroupResponse describes the response struct of multicastgroups command.
+type MulticastGroupResponse struct {
+	Group               string              `json:"group,omitempty" antctl:"name,Multicast group IP"`
+	LocalReceivers      []string            `json:"localReceivers,omitempty"`
+	SourceReceivers     map[string][]string `json:"sourceReceivers,omitempty"`
+	RemoteReceiverNodes int                 `json:"remoteReceiverNodes"`
+	Senders             []string            `json:"senders,omitempty"`
+	Packets             uint64              `json:"packets"`
+	Bytes               uint64              `json:"bytes"`
+	PacketRate          uint64              `json:"packetRate"`
+	BitRate             uint64              `json:"bitRate"`
+	// InactiveTime is the duration since the last packet of the group was forwarded. It is empty if no packet has
+	// been forwarded.
+	InactiveTime string `json:"inactiveTime,omitempty"`
+}
+
+func (r MulticastGroupResponse) GetTableHeader() []string {
+	return []string{"GROUP", "LOCAL-RECEIVERS", "SOURCE-RECEIVERS", "REMOTE-RECEIVER-NODES", "SENDERS", "PACKETS", "BYTES", "PPS", "BPS", "INACTIVE"}
+}
+
+func (r MulticastGroupResponse) GetTableRow(maxColumnLength int) []string {
+	return []string{
+		r.Group,
+		printers.GenerateTableElementWithSummary(r.LocalReceivers, maxColumnLength),
+		printers.GenerateTableElementWithSummary(r.getSourceReceiverList(), maxColumnLength),
+		strconv.Itoa(r.RemoteReceiverNodes),
+		printers.GenerateTableElementWithSummary(r.Senders, maxColumnLength),
+		strconv.FormatUint(r.Packets, 10),
+		strconv.FormatUint(r.Bytes, 10),
+		strconv.FormatUint(r.PacketRate, 10),
+		strconv.FormatUint(r.BitRate, 10),
+		r.InactiveTime,
+	}
+}
+
+// getSourceReceiverList returns the source receivers in the "<source>: <receiver>" format, sorted by source.
+func (r MulticastGroupResponse) getSourceReceiverList() []string {
+	sources := make([]string, 0, len(r.SourceReceivers))
+	for source := range r.SourceReceivers {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+	var list []string
+	for _, source := range sources {
+		for _, receiver := range r.SourceReceivers[source] {
+			list = append(list, source+": "+receiver)
+		}
+	}
+	return list
+}
+
+func (r MulticastGroupResponse) SortRows() bool {
+	return true
+}
+
+// FlowResponse describes the response struct of flows command. It is a connection tracked by the Flow Exporter.
+type FlowResponse struct {
+	SourceIP             string    `json:"sourceIP"`
+	SourcePort           uint16    `json:"sourcePort"`
+	DestinationIP        string    `json:"destinationIP"`
+	DestinationPort      uint16    `json:"destinationPort"`
+	Protocol             string    `json:"protocol"`
+	SourcePod            string    `json:"sourcePod,omitempty"`
+	DestinationPod       string    `json:"destinationPod,omitempty"`
+	DestinationService   string    `json:"destinationService,omitempty"`
+	TCPState             string    `json:"tcpState,omitempty"`
+	IngressNetworkPolicy string    `json:"ingressNetworkPolicy,omitempty"`
+	IngressRuleName      string    `json:"ingressRuleName,omitempty"`
+	IngressRuleAction    string    `json:"ingressRuleAction,omitempty"`
+	EgressNetworkPolicy  string    `json:"egressNetworkPolicy,omitempty"`
+	EgressRuleName       string    `json:"egressRuleName,omitempty"`
+	EgressRuleAction     string    `json:"egressRuleAction,omitempty"`
+	Packets              uint64    `json:"packets"`
+	Bytes                uint64    `json:"bytes"`
+	ReversePackets       uint64    `json:"reversePackets"`
+	ReverseBytes         uint64    `json:"reverseBytes"`
+	StartTime            time.Time `json:"startTime"`
+}
+
+func (r FlowResponse) GetTableHeader() []string {
+	return []string{"SOURCE", "DESTINATION", "PROTOCOL", "SOURCE-POD", "DESTINATION-POD", "SERVICE", "STATE", "INGRESS-POLICY", "EGRESS-POLICY", "PACKETS", "BYTES"}
+}
+
+func (r FlowResponse) GetTableRow(_ int) []string {
+	return []string{
+		net.JoinHostPort(r.SourceIP, strconv.Itoa(int(r.SourcePort))),
+		net.JoinHostPort(r.DestinationIP, strconv.Itoa(int(r.DestinationPort))),
+		r.Protocol,
+		r.SourcePod,
+		r.DestinationPod,
+		r.DestinationService,
+		r.TCPState,
+		policyVerdict(r.IngressNetworkPolicy, r.IngressRuleAction),
+		policyVerdict(r.EgressNetworkPolicy, r.EgressRuleAction),
+		strconv.FormatUint(r.Packets+r.ReversePackets, 10),
+		strconv.FormatUint(r.Bytes+r.ReverseBytes, 10),
+	}
+}
+
+// policyVerdict returns the NetworkPolicy followed by the action of the matched rule in the "<policy> (<action>)"
+// format. The policy is empty when the connection was dropped because of the isolation of K8s NetworkPolicies.
+func policyVerdict(policy, action string) string {
+	if action == "" {
+		return policy
+	}
+	if policy == "" {
+		return action
+	}
+	return policy + " (" + action + ")"
+}
+
+func (r FlowResponse) SortRows() bool {
+	return true
+}
+
 // OVSFlowResponse is the response struct of ovsflows command.
 type OVSFlowResponse struct {
 	Flow string `json:"flow,omitempty"`
+	// Annotations describe the Pods, interfaces, NetworkPolicy rules and Services referenced by the flow. They are
+	// only set when decoding is requested.
+	Annotations []string `json:"annotations,omitempty"`
 }
 
 func (r OVSFlowResponse) GetTableHeader() []string {
@@ -150,7 +276,10 @@ func (r OVSFlowResponse) GetTableHeader() []string {
 }
 
 func (r OVSFlowResponse) GetTableRow(maxColumnLength int) []string {
-	return []string{r.Flow}
+	if len(r.Annotations) == 0 {
+		return []string{r.Flow}
+	}
+	return []string{r.Flow + " # " + strings.Join(r.Annotations, "; ")}
 }
 
 func (r OVSFlowResponse) SortRows() bool {
@@ -271,3 +400,23 @@ func (r BGPRouteResponse) GetTableRow(_ int) []string {
 func (r BGPRouteResponse) SortRows() bool {
 	return true
 }
+
+// DatapathDiffResponse describes the response struct of datapath diff command.
+type DatapathDiffResponse struct {
+	Type    string `json:"type,omitempty"`
+	State   string `json:"state,omitempty"`
+	Desired string `json:"desired,omitempty"`
+	Actual  string `json:"actual,omitempty"`
+}
+
+func (r DatapathDiffResponse) GetTableHeader() []string {
+	return []string{"TYPE", "STATE", "DESIRED", "ACTUAL"}
+}
+
+func (r DatapathDiffResponse) GetTableRow(_ int) []string {
+	return []string{r.Type, r.State, r.Desired, r.Actual}
+}
+
+func (r DatapathDiffResponse) SortRows() bool {
+	return true
+}
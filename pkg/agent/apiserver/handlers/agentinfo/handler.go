@@ -40,6 +40,7 @@ func HandleFunc(aq querier.AgentQuerier) http.HandlerFunc {
 			LocalPodNum:                 agentInfo.LocalPodNum,
 			AgentConditions:             agentInfo.AgentConditions,
 			NodeSubnets:                 agentInfo.NodeSubnets,
+			KernelFeatureChecks:         agentInfo.KernelFeatureChecks,
 		}
 		err := json.NewEncoder(w).Encode(info)
 		if err != nil {
@@ -0,0 +1,62 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datapathdiff
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/querier"
+	"antrea.io/antrea/pkg/agent/types"
+)
+
+func generateResponse(diffs []types.DatapathEntryDiff) []apis.DatapathDiffResponse {
+	resps := make([]apis.DatapathDiffResponse, 0, len(diffs))
+	for _, diff := range diffs {
+		resps = append(resps, apis.DatapathDiffResponse{
+			Type:    string(diff.Type),
+			State:   string(diff.State),
+			Desired: diff.Desired,
+			Actual:  diff.Actual,
+		})
+	}
+	return resps
+}
+
+// HandleFunc returns the function which can handle queries issued by the datapath diff command.
+func HandleFunc(aq querier.AgentQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ofDiffs, err := aq.GetOpenflowClient().DiffOFEntries()
+		if err != nil {
+			klog.ErrorS(err, "Failed to compare OpenFlow entries")
+			http.Error(w, "failed to compare OpenFlow entries: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		routeDiffs, err := aq.GetRouteClient().DiffRoutes()
+		if err != nil {
+			klog.ErrorS(err, "Failed to compare routes")
+			http.Error(w, "failed to compare routes: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resps := generateResponse(append(ofDiffs, routeDiffs...))
+		if err := json.NewEncoder(w).Encode(resps); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			klog.ErrorS(err, "Error when encoding datapath diff to json")
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datapathdiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	oftest "antrea.io/antrea/pkg/agent/openflow/testing"
+	queriertest "antrea.io/antrea/pkg/agent/querier/testing"
+	routetest "antrea.io/antrea/pkg/agent/route/testing"
+	"antrea.io/antrea/pkg/agent/types"
+)
+
+func TestDatapathDiffQuery(t *testing.T) {
+	flowDiff := types.DatapathEntryDiff{
+		Type:   types.DatapathEntryFlow,
+		State:  types.DatapathDiffUnexpected,
+		Actual: "table=PipelineRootClassifier, priority=200,ip actions=drop",
+	}
+	routeDiff := types.DatapathEntryDiff{
+		Type:    types.DatapathEntryRoute,
+		State:   types.DatapathDiffMissing,
+		Desired: "{Ifindex: 10 Dst: 10.10.1.0/24 Src: <nil> Gw: 192.168.77.101 Flags: [] Table: 0 Realm: 0}",
+	}
+	tests := []struct {
+		name             string
+		ofDiffs          []types.DatapathEntryDiff
+		ofErr            error
+		routeDiffs       []types.DatapathEntryDiff
+		routeErr         error
+		expectedStatus   int
+		expectedResponse []apis.DatapathDiffResponse
+	}{
+		{
+			name:             "no discrepancy",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.DatapathDiffResponse{},
+		},
+		{
+			name:           "flow and route discrepancies",
+			ofDiffs:        []types.DatapathEntryDiff{flowDiff},
+			routeDiffs:     []types.DatapathEntryDiff{routeDiff},
+			expectedStatus: http.StatusOK,
+			expectedResponse: []apis.DatapathDiffResponse{
+				{Type: "Flow", State: "Unexpected", Actual: flowDiff.Actual},
+				{Type: "Route", State: "Missing", Desired: routeDiff.Desired},
+			},
+		},
+		{
+			name:           "failed to dump flows",
+			ofErr:          fmt.Errorf("connection lost"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "failed to list routes",
+			routeErr:       fmt.Errorf("netlink error"),
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			q := queriertest.NewMockAgentQuerier(ctrl)
+			ofClient := oftest.NewMockClient(ctrl)
+			routeClient := routetest.NewMockInterface(ctrl)
+			q.EXPECT().GetOpenflowClient().Return(ofClient)
+			ofClient.EXPECT().DiffOFEntries().Return(tt.ofDiffs, tt.ofErr)
+			if tt.ofErr == nil {
+				q.EXPECT().GetRouteClient().Return(routeClient)
+				routeClient.EXPECT().DiffRoutes().Return(tt.routeDiffs, tt.routeErr)
+			}
+
+			handler := HandleFunc(q)
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var received []apis.DatapathDiffResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+				assert.Equal(t, tt.expectedResponse, received)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flows
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog/v2"
+
+	agentapi "antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/querier"
+)
+
+// HandleFunc returns the function which can handle queries issued by the flows command.
+func HandleFunc(fq querier.AgentFlowInfoQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if fq == nil || reflect.ValueOf(fq).IsNil() {
+			// The error message must match the "FOO is not enabled" pattern to pass antctl e2e tests.
+			http.Error(w, "flow exporter is not enabled", http.StatusServiceUnavailable)
+			return
+		}
+		filter, err := newFilterFromURLQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		conns := fq.GetConnections(filter)
+		resp := make([]agentapi.FlowResponse, 0, len(conns))
+		for i := range conns {
+			resp = append(resp, newFlowResponse(&conns[i]))
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			http.Error(w, "Failed to encode response: "+err.Error(), http.StatusInternalServerError)
+			klog.ErrorS(err, "Failed to encode response")
+		}
+	}
+}
+
+func newFilterFromURLQuery(query url.Values) (*querier.FlowQueryFilter, error) {
+	filter := &querier.FlowQueryFilter{
+		Namespace:     query.Get("namespace"),
+		Pod:           query.Get("pod"),
+		NetworkPolicy: query.Get("policy"),
+	}
+	if filter.Pod != "" && filter.Namespace == "" {
+		return nil, fmt.Errorf("namespace must be provided with pod")
+	}
+	if protocol := query.Get("protocol"); protocol != "" {
+		protocolID, err := flowexporter.LookupProtocolMap(protocol)
+		if err != nil {
+			return nil, err
+		}
+		filter.Protocol = protocolID
+	}
+	return filter, nil
+}
+
+func newFlowResponse(conn *flowexporter.Connection) agentapi.FlowResponse {
+	return agentapi.FlowResponse{
+		SourceIP:             conn.FlowKey.SourceAddress.String(),
+		SourcePort:           conn.FlowKey.SourcePort,
+		DestinationIP:        conn.FlowKey.DestinationAddress.String(),
+		DestinationPort:      conn.FlowKey.DestinationPort,
+		Protocol:             protocolToString(conn.FlowKey.Protocol),
+		SourcePod:            namespacedName(conn.SourcePodNamespace, conn.SourcePodName),
+		DestinationPod:       namespacedName(conn.DestinationPodNamespace, conn.DestinationPodName),
+		DestinationService:   conn.DestinationServicePortName,
+		TCPState:             conn.TCPState,
+		IngressNetworkPolicy: namespacedName(conn.IngressNetworkPolicyNamespace, conn.IngressNetworkPolicyName),
+		IngressRuleName:      conn.IngressNetworkPolicyRuleName,
+		IngressRuleAction:    flowexporter.RuleActionToString(conn.IngressNetworkPolicyRuleAction),
+		EgressNetworkPolicy:  namespacedName(conn.EgressNetworkPolicyNamespace, conn.EgressNetworkPolicyName),
+		EgressRuleName:       conn.EgressNetworkPolicyRuleName,
+		EgressRuleAction:     flowexporter.RuleActionToString(conn.EgressNetworkPolicyRuleAction),
+		Packets:              conn.OriginalPackets,
+		Bytes:                conn.OriginalBytes,
+		ReversePackets:       conn.ReversePackets,
+		ReverseBytes:         conn.ReverseBytes,
+		StartTime:            conn.StartTime,
+	}
+}
+
+// namespacedName returns the name in the "<namespace>/<name>" format, or only the name if it's cluster scoped.
+func namespacedName(namespace, name string) string {
+	if name == "" || namespace == "" {
+		return name
+	}
+	return namespace + "/" + name
+}
+
+func protocolToString(protocol uint8) string {
+	for name, id := range flowexporter.Protocols {
+		if id == protocol {
+			return strings.ToUpper(name)
+		}
+	}
+	return strconv.Itoa(int(protocol))
+}
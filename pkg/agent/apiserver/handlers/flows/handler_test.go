@@ -0,0 +1,175 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flows
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	"go.uber.org/mock/gomock"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/querier"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
+)
+
+func TestFlowsQuery(t *testing.T) {
+	startTime := time.Now().Add(-time.Minute).UTC()
+	conns := []flowexporter.Connection{
+		{
+			FlowKey:                        flowexporter.Tuple{SourceAddress: netip.MustParseAddr("10.10.0.1"), DestinationAddress: netip.MustParseAddr("10.96.0.100"), Protocol: 6, SourcePort: 34567, DestinationPort: 80},
+			StartTime:                      startTime,
+			SourcePodNamespace:             "ns1",
+			SourcePodName:                  "client",
+			DestinationPodNamespace:        "ns2",
+			DestinationPodName:             "web",
+			DestinationServicePortName:     "ns2/web:http",
+			TCPState:                       "ESTABLISHED",
+			IngressNetworkPolicyNamespace:  "ns2",
+			IngressNetworkPolicyName:       "allow-web",
+			IngressNetworkPolicyRuleName:   "rule1",
+			IngressNetworkPolicyRuleAction: ipfixregistry.NetworkPolicyRuleActionAllow,
+			OriginalPackets:                10,
+			OriginalBytes:                  1000,
+			ReversePackets:                 8,
+			ReverseBytes:                   4000,
+		},
+		{
+			FlowKey:                       flowexporter.Tuple{SourceAddress: netip.MustParseAddr("fd00::1"), DestinationAddress: netip.MustParseAddr("fd00::2"), Protocol: 17, SourcePort: 34567, DestinationPort: 53},
+			StartTime:                     startTime,
+			SourcePodNamespace:            "ns1",
+			SourcePodName:                 "client",
+			EgressNetworkPolicyName:       "acnp-deny-dns",
+			EgressNetworkPolicyRuleAction: ipfixregistry.NetworkPolicyRuleActionDrop,
+			OriginalPackets:               1,
+			OriginalBytes:                 60,
+		},
+	}
+	expectedResponse := []apis.FlowResponse{
+		{
+			SourceIP:             "10.10.0.1",
+			SourcePort:           34567,
+			DestinationIP:        "10.96.0.100",
+			DestinationPort:      80,
+			Protocol:             "TCP",
+			SourcePod:            "ns1/client",
+			DestinationPod:       "ns2/web",
+			DestinationService:   "ns2/web:http",
+			TCPState:             "ESTABLISHED",
+			IngressNetworkPolicy: "ns2/allow-web",
+			IngressRuleName:      "rule1",
+			IngressRuleAction:    "Allow",
+			Packets:              10,
+			Bytes:                1000,
+			ReversePackets:       8,
+			ReverseBytes:         4000,
+			StartTime:            startTime,
+		},
+		{
+			SourceIP:            "fd00::1",
+			SourcePort:          34567,
+			DestinationIP:       "fd00::2",
+			DestinationPort:     53,
+			Protocol:            "UDP",
+			SourcePod:           "ns1/client",
+			EgressNetworkPolicy: "acnp-deny-dns",
+			EgressRuleAction:    "Drop",
+			Packets:             1,
+			Bytes:               60,
+			StartTime:           startTime,
+		},
+	}
+
+	tests := []struct {
+		name             string
+		query            string
+		expectedFilter   *querier.FlowQueryFilter
+		conns            []flowexporter.Connection
+		expectedStatus   int
+		expectedResponse []apis.FlowResponse
+	}{
+		{
+			name:             "all flows",
+			expectedFilter:   &querier.FlowQueryFilter{},
+			conns:            conns,
+			expectedStatus:   http.StatusOK,
+			expectedResponse: expectedResponse,
+		},
+		{
+			name:             "filtered flows",
+			query:            "?namespace=ns1&pod=client&policy=acnp-deny-dns&protocol=udp",
+			expectedFilter:   &querier.FlowQueryFilter{Namespace: "ns1", Pod: "client", NetworkPolicy: "acnp-deny-dns", Protocol: 17},
+			conns:            conns[1:],
+			expectedStatus:   http.StatusOK,
+			expectedResponse: expectedResponse[1:],
+		},
+		{
+			name:             "no flow",
+			query:            "?namespace=ns3",
+			expectedFilter:   &querier.FlowQueryFilter{Namespace: "ns3"},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.FlowResponse{},
+		},
+		{
+			name:           "pod without namespace",
+			query:          "?pod=client",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown protocol",
+			query:          "?protocol=foo",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			q := queriertest.NewMockAgentFlowInfoQuerier(ctrl)
+			if tt.expectedFilter != nil {
+				q.EXPECT().GetConnections(tt.expectedFilter).Return(tt.conns)
+			}
+			handler := HandleFunc(q)
+			req, err := http.NewRequest(http.MethodGet, tt.query, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			require.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus != http.StatusOK {
+				return
+			}
+			var receivedResponse []apis.FlowResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &receivedResponse))
+			assert.Equal(t, tt.expectedResponse, receivedResponse)
+		})
+	}
+}
+
+func TestFlowsQueryNotEnabled(t *testing.T) {
+	var fq querier.AgentFlowInfoQuerier
+	recorder := httptest.NewRecorder()
+	req, err := http.NewRequest(http.MethodGet, "", nil)
+	require.NoError(t, err)
+	HandleFunc(fq).ServeHTTP(recorder, req)
+	assert.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+	assert.Equal(t, "flow exporter is not enabled\n", recorder.Body.String())
+}
@@ -42,6 +42,7 @@ func HandleFunc(npq querier.AgentNetworkPolicyInfoQuerier) http.HandlerFunc {
 				FQDNName:       entry.FQDNName,
 				IPAddress:      entry.IPAddress.String(),
 				ExpirationTime: entry.ExpirationTime,
+				Static:         entry.Static,
 			})
 		}
 		if err := json.NewEncoder(w).Encode(resp); err != nil {
@@ -0,0 +1,94 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastgroup
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/multicast"
+	"antrea.io/antrea/pkg/querier"
+)
+
+func generateResponse(stats *multicast.GroupTrafficStats, now time.Time) apis.MulticastGroupResponse {
+	localReceivers := make([]string, 0, len(stats.LocalReceivers))
+	for _, pod := range stats.LocalReceivers {
+		localReceivers = append(localReceivers, pod.Namespace+"/"+pod.Name)
+	}
+	var sourceReceivers map[string][]string
+	if len(stats.SourceReceivers) > 0 {
+		sourceReceivers = make(map[string][]string, len(stats.SourceReceivers))
+		for source, pods := range stats.SourceReceivers {
+			receivers := make([]string, 0, len(pods))
+			for _, pod := range pods {
+				receivers = append(receivers, pod.Namespace+"/"+pod.Name)
+			}
+			sort.Strings(receivers)
+			sourceReceivers[source] = receivers
+		}
+	}
+	resp := apis.MulticastGroupResponse{
+		Group:               stats.Group,
+		LocalReceivers:      localReceivers,
+		SourceReceivers:     sourceReceivers,
+		RemoteReceiverNodes: stats.RemoteReceiverNodes,
+		Senders:             stats.Senders,
+		Packets:             stats.Packets,
+		Bytes:               stats.Bytes,
+		PacketRate:          stats.PacketRate,
+		BitRate:             stats.BitRate,
+	}
+	if !stats.LastActiveTime.IsZero() {
+		resp.InactiveTime = duration.HumanDuration(now.Sub(stats.LastActiveTime))
+	}
+	return resp
+}
+
+// HandleFunc returns the function which can handle queries issued by 'antctl get multicastgroups' command.
+// It will return the members and the traffic statistics of the multicast groups on the local Node.
+func HandleFunc(mq querier.AgentMulticastInfoQuerier) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if mq == nil || reflect.ValueOf(mq).IsNil() {
+			http.Error(w, "Multicast is not enabled", http.StatusServiceUnavailable)
+			return
+		}
+		now := time.Now()
+		group := r.URL.Query().Get("name")
+		responses := []apis.MulticastGroupResponse{}
+		if group != "" {
+			stats := mq.GetGroupStats(group)
+			if stats == nil {
+				http.Error(w, "multicast group "+group+" not found", http.StatusNotFound)
+				return
+			}
+			responses = append(responses, generateResponse(stats, now))
+		} else {
+			for _, stats := range mq.GetAllGroupsStats() {
+				responses = append(responses, generateResponse(stats, now))
+			}
+		}
+		if err := json.NewEncoder(w).Encode(responses); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			klog.ErrorS(err, "Error when encoding multicast group statistics to json")
+		}
+	}
+}
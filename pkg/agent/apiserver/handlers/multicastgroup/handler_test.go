@@ -0,0 +1,124 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastgroup
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/multicast"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	queriertest "antrea.io/antrea/pkg/querier/testing"
+)
+
+func TestMulticastGroupQuery(t *testing.T) {
+	group1Stats := &multicast.GroupTrafficStats{
+		Group:               "225.1.2.3",
+		LocalReceivers:      []v1beta2.PodReference{{Name: "receiver1", Namespace: "ns1"}},
+		RemoteReceiverNodes: 2,
+		Senders:             []string{"10.10.0.5"},
+		Packets:             1000,
+		Bytes:               100000,
+		PacketRate:          10,
+		BitRate:             8000,
+		LastActiveTime:      time.Now().Add(-90 * time.Second),
+	}
+	group2Stats := &multicast.GroupTrafficStats{
+		Group:          "232.1.2.4",
+		LocalReceivers: []v1beta2.PodReference{{Name: "receiver2", Namespace: "ns2"}, {Name: "receiver3", Namespace: "ns2"}},
+		SourceReceivers: map[string][]v1beta2.PodReference{
+			"10.10.0.6": {{Name: "receiver3", Namespace: "ns2"}, {Name: "receiver2", Namespace: "ns2"}},
+			"10.10.0.7": {{Name: "receiver3", Namespace: "ns2"}},
+		},
+	}
+	group1Response := apis.MulticastGroupResponse{
+		Group:               "225.1.2.3",
+		LocalReceivers:      []string{"ns1/receiver1"},
+		RemoteReceiverNodes: 2,
+		Senders:             []string{"10.10.0.5"},
+		Packets:             1000,
+		Bytes:               100000,
+		PacketRate:          10,
+		BitRate:             8000,
+		InactiveTime:        "90s",
+	}
+	group2Response := apis.MulticastGroupResponse{
+		Group:          "232.1.2.4",
+		LocalReceivers: []string{"ns2/receiver2", "ns2/receiver3"},
+		SourceReceivers: map[string][]string{
+			"10.10.0.6": {"ns2/receiver2", "ns2/receiver3"},
+			"10.10.0.7": {"ns2/receiver3"},
+		},
+	}
+	tests := []struct {
+		name             string
+		group            string
+		expectedCalls    func(q *queriertest.MockAgentMulticastInfoQuerierMockRecorder)
+		expectedStatus   int
+		expectedResponse []apis.MulticastGroupResponse
+	}{
+		{
+			name: "all groups",
+			expectedCalls: func(q *queriertest.MockAgentMulticastInfoQuerierMockRecorder) {
+				q.GetAllGroupsStats().Return([]*multicast.GroupTrafficStats{group1Stats, group2Stats})
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.MulticastGroupResponse{group1Response, group2Response},
+		},
+		{
+			name:  "existing group",
+			group: "225.1.2.3",
+			expectedCalls: func(q *queriertest.MockAgentMulticastInfoQuerierMockRecorder) {
+				q.GetGroupStats("225.1.2.3").Return(group1Stats)
+			},
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.MulticastGroupResponse{group1Response},
+		},
+		{
+			name:  "unknown group",
+			group: "225.1.2.5",
+			expectedCalls: func(q *queriertest.MockAgentMulticastInfoQuerierMockRecorder) {
+				q.GetGroupStats("225.1.2.5").Return(nil)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			q := queriertest.NewMockAgentMulticastInfoQuerier(ctrl)
+			tt.expectedCalls(q.EXPECT())
+			handler := HandleFunc(q)
+			req, err := http.NewRequest(http.MethodGet, "?name="+tt.group, nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var received []apis.MulticastGroupResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+				assert.Equal(t, tt.expectedResponse, received)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovsflows
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/agent/apis"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	agentquerier "antrea.io/antrea/pkg/agent/querier"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+)
+
+var (
+	// ofPortRegexps match the OpenFlow port numbers in a dumped flow or group: the input port, the output port, and
+	// the target OpenFlow port which is matched or loaded in reg1 (TargetOFPortField).
+	ofPortRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`\bin_port=(\d+)\b`),
+		regexp.MustCompile(`\boutput:(\d+)\b`),
+		regexp.MustCompile(`\breg1=(0x[0-9a-f]+)\b`),
+		regexp.MustCompile(`\bset_field:(0x[0-9a-f]+)->reg1\b`),
+		regexp.MustCompile(`\bload:(0x[0-9a-f]+)->NXM_NX_REG1\[\]`),
+	}
+	// conjunctionRegexps match the conjunction IDs of NetworkPolicy rules in a dumped flow.
+	conjunctionRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`\bconj_id=(\d+)\b`),
+		regexp.MustCompile(`\bconjunction\((\d+),`),
+	}
+	// groupRegexps match the group IDs in a dumped flow or group.
+	groupRegexps = []*regexp.Regexp{
+		regexp.MustCompile(`\bgroup_id=(\d+)\b`),
+		regexp.MustCompile(`\bgroup:(\d+)\b`),
+	}
+)
+
+// findUint32Values returns the distinct values captured by the regexps in the flow, in the order they appear in the
+// regexps. Both decimal and hexadecimal (with the "0x" prefix) values are supported.
+func findUint32Values(flow string, regexps []*regexp.Regexp) []uint32 {
+	var values []uint32
+	seen := sets.New[uint32]()
+	for _, re := range regexps {
+		for _, match := range re.FindAllStringSubmatch(flow, -1) {
+			v, err := strconv.ParseUint(match[1], 0, 32)
+			if err != nil || seen.Has(uint32(v)) {
+				continue
+			}
+			seen.Insert(uint32(v))
+			values = append(values, uint32(v))
+		}
+	}
+	return values
+}
+
+func decodeOFPort(aq agentquerier.AgentQuerier, ofPort uint32) (string, bool) {
+	intf, found := aq.GetInterfaceStore().GetInterfaceByOFPort(ofPort)
+	if !found {
+		return "", false
+	}
+	if intf.Type == interfacestore.ContainerInterface {
+		return fmt.Sprintf("port %d: Pod %s/%s", ofPort, intf.PodNamespace, intf.PodName), true
+	}
+	return fmt.Sprintf("port %d: %s", ofPort, intf.InterfaceName), true
+}
+
+func decodeConjunction(aq agentquerier.AgentQuerier, conjID uint32) (string, bool) {
+	found, npRef, _, ruleName, _ := aq.GetOpenflowClient().GetPolicyInfoFromConjunction(conjID)
+	if !found {
+		return "", false
+	}
+	if ruleName == "" {
+		return fmt.Sprintf("conjunction %d: %s", conjID, npRef.ToString()), true
+	}
+	return fmt.Sprintf("conjunction %d: %s rule %s", conjID, npRef.ToString(), ruleName), true
+}
+
+func decodeGroup(aq agentquerier.AgentQuerier, groupID uint32) (string, bool) {
+	proxier := aq.GetProxier()
+	if proxier == nil {
+		return "", false
+	}
+	svcPortName, found := proxier.GetServiceByGroupID(binding.GroupIDType(groupID))
+	if !found {
+		return "", false
+	}
+	return fmt.Sprintf("group %d: Service %s", groupID, svcPortName.String()), true
+}
+
+// decodeFlows annotates the dumped flows and groups with the Pods, interfaces, NetworkPolicy rules and Services
+// their OpenFlow ports, conjunction IDs and group IDs correspond to, using the in-memory caches of the agent. Values
+// which cannot be mapped to a known entity are left undecoded.
+func decodeFlows(aq agentquerier.AgentQuerier, resps []apis.OVSFlowResponse) {
+	decoders := []struct {
+		regexps []*regexp.Regexp
+		decode  func(aq agentquerier.AgentQuerier, value uint32) (string, bool)
+	}{
+		{regexps: ofPortRegexps, decode: decodeOFPort},
+		{regexps: conjunctionRegexps, decode: decodeConjunction},
+		{regexps: groupRegexps, decode: decodeGroup},
+	}
+	for i := range resps {
+		for _, d := range decoders {
+			for _, v := range findUint32Values(resps[i].Flow, d.regexps) {
+				if annotation, ok := d.decode(aq, v); ok {
+					resps[i].Annotations = append(resps[i].Annotations, annotation)
+				}
+			}
+		}
+	}
+}
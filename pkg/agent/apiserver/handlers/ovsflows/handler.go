@@ -218,6 +218,7 @@ func HandleFunc(aq agentquerier.AgentQuerier) http.HandlerFunc {
 		table := r.URL.Query().Get("table")
 		groups := r.URL.Query().Get("groups")
 		tableNamesOnly := r.URL.Query().Has("table-names-only")
+		decode := r.URL.Query().Has("decode")
 
 		encodeResp := func() {
 			err = json.NewEncoder(w).Encode(resps)
@@ -300,6 +301,9 @@ func HandleFunc(aq agentquerier.AgentQuerier) http.HandlerFunc {
 			w.WriteHeader(http.StatusNotFound)
 			return
 		}
+		if decode {
+			decodeFlows(aq, resps)
+		}
 
 		encodeResp()
 	}
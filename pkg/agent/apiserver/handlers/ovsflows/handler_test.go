@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/types"
 
 	"antrea.io/antrea/pkg/agent/apis"
 	"antrea.io/antrea/pkg/agent/interfacestore"
@@ -35,6 +36,7 @@ import (
 	ovsctltest "antrea.io/antrea/pkg/ovs/ovsctl/testing"
 	"antrea.io/antrea/pkg/querier"
 	queriertest "antrea.io/antrea/pkg/querier/testing"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
 )
 
 var (
@@ -462,6 +464,51 @@ func TestGroups(t *testing.T) {
 	}
 }
 
+func TestDecodeFlows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	getFlowTableName = mockGetFlowTableName
+	getFlowTableID = mockGetFlowTableID
+	dumpedFlows := []string{
+		"table=IngressRule, priority=200,ip,reg1=0x5 actions=conjunction(10,2/3)",
+		"table=IngressRule, priority=190,conj_id=10,ip actions=set_field:0xa->reg3,goto_table:IngressMetric",
+		"table=IngressRule, priority=200,in_port=2,ip actions=group:3",
+		"table=IngressRule, priority=200,in_port=100 actions=output:NXM_NX_REG1[]",
+	}
+	tc := testCase{
+		testName:       "Decode flows of table IngressRule",
+		query:          "?table=IngressRule&decode",
+		expectedStatus: http.StatusOK,
+		resps: []apis.OVSFlowResponse{
+			{Flow: dumpedFlows[0], Annotations: []string{"port 5: Pod ns1/pod1", "conjunction 10: K8sNetworkPolicy:ns1/np1 rule rule1"}},
+			{Flow: dumpedFlows[1], Annotations: []string{"conjunction 10: K8sNetworkPolicy:ns1/np1 rule rule1"}},
+			{Flow: dumpedFlows[2], Annotations: []string{"port 2: antrea-gw0", "group 3: Service ns1/svc1:http"}},
+			{Flow: dumpedFlows[3]},
+		},
+	}
+
+	ovsctl := ovsctltest.NewMockOVSCtlClient(ctrl)
+	i := interfacestoretest.NewMockInterfaceStore(ctrl)
+	ofc := oftest.NewMockClient(ctrl)
+	p := proxytest.NewMockProxier(ctrl)
+	q := aqtest.NewMockAgentQuerier(ctrl)
+	q.EXPECT().GetOVSCtlClient().Return(ovsctl).Times(1)
+	q.EXPECT().GetInterfaceStore().Return(i).AnyTimes()
+	q.EXPECT().GetOpenflowClient().Return(ofc).AnyTimes()
+	q.EXPECT().GetProxier().Return(p).AnyTimes()
+	ovsctl.EXPECT().DumpTableFlows(uint8(80)).Return(dumpedFlows, nil).Times(1)
+	i.EXPECT().GetInterfaceByOFPort(uint32(5)).Return(interfacestore.NewContainerInterface("pod1-abcd", "container1", "pod1", "ns1", "", nil, nil, 0), true)
+	i.EXPECT().GetInterfaceByOFPort(uint32(2)).Return(&interfacestore.InterfaceConfig{InterfaceName: "antrea-gw0", Type: interfacestore.GatewayInterface}, true)
+	i.EXPECT().GetInterfaceByOFPort(uint32(100)).Return(nil, false)
+	npRef := &cpv1beta.NetworkPolicyReference{Type: cpv1beta.K8sNetworkPolicy, Namespace: "ns1", Name: "np1"}
+	ofc.EXPECT().GetPolicyInfoFromConjunction(uint32(10)).Return(true, npRef, "200", "rule1", "").Times(2)
+	p.EXPECT().GetServiceByGroupID(binding.GroupIDType(3)).Return(k8sproxy.ServicePortName{
+		NamespacedName: types.NamespacedName{Namespace: "ns1", Name: "svc1"},
+		Port:           "http",
+	}, true)
+
+	runHTTPTest(t, &tc, q)
+}
+
 func runHTTPTest(t *testing.T, tc *testCase, aq agentquerier.AgentQuerier) {
 	handler := HandleFunc(aq)
 	req, err := http.NewRequest(http.MethodGet, tc.query, nil)
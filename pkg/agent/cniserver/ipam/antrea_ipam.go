@@ -146,6 +146,9 @@ func (d *AntreaIPAM) Add(args *invoke.Args, k8sArgs *types.K8sArgs, networkConfi
 		// pass this request to next driver
 		return false, nil, nil
 	}
+	if err := d.controller.checkNamespace(allocator, string(k8sArgs.K8S_POD_NAMESPACE)); err != nil {
+		return true, nil, err
+	}
 
 	owner := *getAllocationOwner(args, k8sArgs, reservedOwner, false)
 	var ip net.IP
@@ -221,6 +221,16 @@ func (c *AntreaIPAMController) getPoolAllocatorByPod(namespace, podName string)
 	return mineTrue, allocator, ips, reservedOwner, err
 }
 
+// checkNamespace returns an error if the IPPool of the allocator cannot be used by the Namespace, according to the
+// namespaceSelector of the IPPool.
+func (c *AntreaIPAMController) checkNamespace(allocator *poolallocator.IPPoolAllocator, namespace string) error {
+	ns, err := c.namespaceLister.Get(namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get Namespace %s: %v", namespace, err)
+	}
+	return allocator.CheckNamespace(ns)
+}
+
 // Look up IPPools by matching PodOwnder.
 func (c *AntreaIPAMController) getPoolAllocatorsByOwner(podOwner *crdv1b1.PodOwner) ([]*poolallocator.IPPoolAllocator, error) {
 	var allocators []*poolallocator.IPPoolAllocator
@@ -0,0 +1,143 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cniserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/spf13/afero"
+	"k8s.io/klog/v2"
+)
+
+const cniResultsPath = "/var/run/antrea/cni/results"
+
+// cniResultEntry is the content persisted for a container.
+type cniResultEntry struct {
+	// Pending is true if the ADD request for the container has not completed. A pending entry which is still present
+	// after an agent restart means that the ADD request was interrupted and the configurations it has made must be
+	// cleaned up.
+	Pending bool `json:"pending,omitempty"`
+	// Result is the CNI result of the ADD request in the current CNI spec version, set when the request has completed.
+	Result json.RawMessage `json:"result,omitempty"`
+}
+
+// cniResultCache persists the state of the ADD requests of the infra containers, keyed by the container ID. Each
+// entry is stored in a separate file, so that a repeated ADD request for the same container, e.g. retried by kubelet
+// or by the container runtime after a restart, can be answered with the previous result without configuring the
+// interfaces again, and the interfaces configured by the ADD requests which were interrupted can be identified after
+// an agent restart.
+type cniResultCache struct {
+	fs      afero.Fs
+	mutex   sync.RWMutex
+	entries map[string]*cniResultEntry
+}
+
+func newCNIResultCache(fs afero.Fs) *cniResultCache {
+	return &cniResultCache{
+		fs:      afero.NewBasePathFs(fs, cniResultsPath),
+		entries: map[string]*cniResultEntry{},
+	}
+}
+
+// load reads the entries persisted by the previous agent process. Invalid files are removed.
+func (c *cniResultCache) load() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err := c.fs.MkdirAll("/", 0o700); err != nil {
+		return fmt.Errorf("error creating directory for CNI results: %w", err)
+	}
+	files, err := afero.ReadDir(c.fs, "/")
+	if err != nil {
+		return fmt.Errorf("error reading directory for CNI results: %w", err)
+	}
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		containerID := file.Name()
+		data, err := afero.ReadFile(c.fs, containerID)
+		entry := &cniResultEntry{}
+		if err == nil {
+			err = json.Unmarshal(data, entry)
+		}
+		if err != nil {
+			klog.ErrorS(err, "Removing invalid CNI result file", "container", containerID)
+			c.fs.Remove(containerID)
+			continue
+		}
+		c.entries[containerID] = entry
+	}
+	return nil
+}
+
+func (c *cniResultCache) get(containerID string) (*cniResultEntry, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	entry, exists := c.entries[containerID]
+	return entry, exists
+}
+
+// setPending persists a pending entry for the container, which must be done before making any configuration for it.
+func (c *cniResultCache) setPending(containerID string) error {
+	return c.save(containerID, &cniResultEntry{Pending: true})
+}
+
+// setResult persists the result of the completed ADD request for the container.
+func (c *cniResultCache) setResult(containerID string, result []byte) error {
+	return c.save(containerID, &cniResultEntry{Result: result})
+}
+
+func (c *cniResultCache) save(containerID string, entry *cniResultEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if err := afero.WriteFile(c.fs, containerID, data, 0o600); err != nil {
+		return fmt.Errorf("error writing CNI result file for container %s: %w", containerID, err)
+	}
+	c.entries[containerID] = entry
+	return nil
+}
+
+func (c *cniResultCache) delete(containerID string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if _, exists := c.entries[containerID]; !exists {
+		return nil
+	}
+	if err := c.fs.Remove(containerID); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing CNI result file for container %s: %w", containerID, err)
+	}
+	delete(c.entries, containerID)
+	return nil
+}
+
+// list returns the IDs of all the containers which have an entry, and the IDs of the ones with a pending entry.
+func (c *cniResultCache) list() (all []string, pending []string) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	for containerID, entry := range c.entries {
+		all = append(all, containerID)
+		if entry.Pending {
+			pending = append(pending, containerID)
+		}
+	}
+	return all, pending
+}
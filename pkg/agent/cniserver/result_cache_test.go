@@ -0,0 +1,71 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cniserver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/afero"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCNIResultCache(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	cache := newCNIResultCache(fs)
+	require.NoError(t, cache.load())
+
+	require.NoError(t, cache.setPending("c1"))
+	require.NoError(t, cache.setPending("c2"))
+	require.NoError(t, cache.setResult("c2", []byte(`{"cniVersion":"1.0.0"}`)))
+	entry, exists := cache.get("c1")
+	require.True(t, exists)
+	assert.True(t, entry.Pending)
+	entry, exists = cache.get("c2")
+	require.True(t, exists)
+	assert.False(t, entry.Pending)
+	assert.JSONEq(t, `{"cniVersion":"1.0.0"}`, string(entry.Result))
+	all, pending := cache.list()
+	assert.ElementsMatch(t, []string{"c1", "c2"}, all)
+	assert.Equal(t, []string{"c1"}, pending)
+
+	// The entries are restored by a new cache, e.g. after an agent restart.
+	newCache := newCNIResultCache(fs)
+	require.NoError(t, newCache.load())
+	assert.Equal(t, cache.entries, newCache.entries)
+
+	require.NoError(t, newCache.delete("c1"))
+	require.NoError(t, newCache.delete("c3"))
+	_, exists = newCache.get("c1")
+	assert.False(t, exists)
+	exists, err := afero.Exists(fs, filepath.Join(cniResultsPath, "c1"))
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestCNIResultCacheLoadInvalidFile(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	invalidFile := filepath.Join(cniResultsPath, "c1")
+	require.NoError(t, afero.WriteFile(fs, invalidFile, []byte("invalid"), 0o600))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(cniResultsPath, "c2"), []byte(`{"pending":true}`), 0o600))
+
+	cache := newCNIResultCache(fs)
+	require.NoError(t, cache.load())
+	assert.Equal(t, map[string]*cniResultEntry{"c2": {Pending: true}}, cache.entries)
+	exists, err := afero.Exists(fs, invalidFile)
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
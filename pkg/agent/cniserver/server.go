@@ -28,6 +28,7 @@ import (
 	current "github.com/containernetworking/cni/pkg/types/100"
 	"github.com/containernetworking/cni/pkg/version"
 	"github.com/containernetworking/plugins/pkg/ip"
+	"github.com/spf13/afero"
 	"google.golang.org/grpc"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clientset "k8s.io/client-go/kubernetes"
@@ -127,6 +128,8 @@ type CNIServer struct {
 	podNetworkWait *wait.Group
 	// flowRestoreCompleteWait will be decremented and Pod reconciliation is completed.
 	flowRestoreCompleteWait *wait.Group
+	// resultCache persists the state of the ADD requests of the infra containers.
+	resultCache *cniResultCache
 }
 
 var supportedCNIVersionSet map[string]bool
@@ -492,6 +495,24 @@ func (s *CNIServer) CmdAdd(ctx context.Context, request *cnipb.CniCmdRequest) (*
 			return nil, fmt.Errorf("allocated IP address not found")
 		}
 	} else {
+		// A repeated ADD request for the same container, e.g. retried by kubelet or by the container runtime after a
+		// restart, gets the result of the previous request if it has completed.
+		var prevResult cnitypes.Result
+		if prevResult, err = s.getPreviousAddResult(cniConfig); err != nil {
+			klog.ErrorS(err, "Failed to process the previous CmdAdd request for container", "container", cniConfig.ContainerId)
+			return s.configInterfaceFailureResponse(err), nil
+		}
+		if prevResult != nil {
+			klog.InfoS("CmdAdd for container succeeded with the previous result", "container", cniConfig.ContainerId)
+			success = true
+			return resultToResponse(prevResult), nil
+		}
+		// The pending state must be persisted before any configuration is made, so that the configurations can be
+		// cleaned up if the request is interrupted by an agent restart.
+		if err := s.resultCache.setPending(cniConfig.ContainerId); err != nil {
+			klog.ErrorS(err, "Failed to persist the CmdAdd request state for container", "container", cniConfig.ContainerId)
+			return s.configInterfaceFailureResponse(err), nil
+		}
 		// Request IP Address from IPAM driver.
 		ipamResult, err = ipam.ExecIPAMAdd(cniConfig.CniCmdArgs, cniConfig.K8sArgs, cniConfig.IPAM.Type, infraContainer)
 		if err != nil {
@@ -525,6 +546,13 @@ func (s *CNIServer) CmdAdd(ctx context.Context, request *cnipb.CniCmdRequest) (*
 		klog.ErrorS(err, "Failed to configure interfaces for container", "container", cniConfig.ContainerId)
 		return s.configInterfaceFailureResponse(err), nil
 	}
+	if isInfraContainer {
+		resultBytes, _ := json.Marshal(&result.Result)
+		if err := s.resultCache.setResult(cniConfig.ContainerId, resultBytes); err != nil {
+			klog.ErrorS(err, "Failed to persist the CmdAdd result for container", "container", cniConfig.ContainerId)
+			return s.configInterfaceFailureResponse(err), nil
+		}
+	}
 	cniVersion := cniConfig.CNIVersion
 	cniResult, _ := result.Result.GetAsVersion(cniVersion)
 
@@ -562,11 +590,45 @@ func (s *CNIServer) cmdDel(_ context.Context, cniConfig *CNIConfig) (*cnipb.CniC
 		return s.ipamFailureResponse(err), nil
 	}
 
+	if err := s.resultCache.delete(cniConfig.ContainerId); err != nil {
+		klog.ErrorS(err, "Failed to delete the CmdAdd result for container", "container", cniConfig.ContainerId)
+		return s.configInterfaceFailureResponse(err), nil
+	}
+
 	klog.InfoS("CmdDel for container succeeded", "container", cniConfig.ContainerId)
 
 	return &cnipb.CniCmdResponse{CniResult: []byte("")}, nil
 }
 
+// getPreviousAddResult returns the result of the previous ADD request for the infra container in the requested CNI
+// version, if the request has completed and the interfaces it configured are still present. Otherwise, it cleans up
+// the configurations made by the previous ADD request if there is any, e.g. when the request did not complete, so
+// that the new request can be processed from scratch. It must be called with the container locked.
+func (s *CNIServer) getPreviousAddResult(cniConfig *CNIConfig) (cnitypes.Result, error) {
+	containerID := cniConfig.ContainerId
+	entry, cached := s.resultCache.get(containerID)
+	_, configured := s.podConfigurator.ifaceStore.GetContainerInterface(containerID)
+	if cached && !entry.Pending && configured {
+		result, err := current.NewResult(entry.Result)
+		if err == nil {
+			return result.GetAsVersion(cniConfig.CNIVersion)
+		}
+		klog.ErrorS(err, "Failed to parse the previous CmdAdd result for container", "container", containerID)
+	}
+	if !cached {
+		// No ADD request has been recorded for the container, e.g. it was added by an agent of an earlier version.
+		return nil, nil
+	}
+	klog.InfoS("Cleaning up the configurations of the previous CmdAdd request for container", "container", containerID)
+	if err := s.podConfigurator.removeInterfaces(containerID); err != nil {
+		return nil, fmt.Errorf("failed to remove interfaces: %w", err)
+	}
+	if err := ipam.ExecIPAMDelete(cniConfig.CniCmdArgs, cniConfig.K8sArgs, cniConfig.IPAM.Type, cniConfig.getInfraContainer()); err != nil {
+		return nil, fmt.Errorf("failed to release IP addresses: %w", err)
+	}
+	return nil, s.resultCache.delete(containerID)
+}
+
 func (s *CNIServer) CmdDel(ctx context.Context, request *cnipb.CniCmdRequest) (*cnipb.CniCmdResponse, error) {
 	klog.InfoS("Received CmdDel request", "request", request)
 
@@ -636,6 +698,7 @@ func New(
 	isChaining, enableBridgingMode, enableSecondaryNetworkIPAM, disableTXChecksumOffload bool,
 	networkConfig *config.NetworkConfig,
 	podNetworkWait, flowRestoreCompleteWait *wait.Group,
+	fs afero.Fs,
 ) *CNIServer {
 	return &CNIServer{
 		cniSocket:                  cniSocket,
@@ -653,6 +716,7 @@ func New(
 		networkConfig:              networkConfig,
 		podNetworkWait:             podNetworkWait,
 		flowRestoreCompleteWait:    flowRestoreCompleteWait.Increment(),
+		resultCache:                newCNIResultCache(fs),
 	}
 }
 
@@ -670,6 +734,9 @@ func (s *CNIServer) Initialize(
 	if err != nil {
 		return fmt.Errorf("error during initialize podConfigurator: %v", err)
 	}
+	if err := s.resultCache.load(); err != nil {
+		return fmt.Errorf("error loading CNI results: %w", err)
+	}
 	if err := s.reconcile(); err != nil {
 		return fmt.Errorf("error during initial reconciliation for CNI server: %v", err)
 	}
@@ -775,7 +842,39 @@ func (s *CNIServer) reconcile() error {
 		return fmt.Errorf("failed to list Pods running on Node %s: %v", s.nodeConfig.Name, err)
 	}
 	filteredPods := s.filterPodsForReconcile(pods)
-	return s.podConfigurator.reconcile(filteredPods, s.containerAccess, s.podNetworkWait, s.flowRestoreCompleteWait)
+	s.cleanUpInterruptedAdds()
+	if err := s.podConfigurator.reconcile(filteredPods, s.containerAccess, s.podNetworkWait, s.flowRestoreCompleteWait); err != nil {
+		return err
+	}
+	// Remove the cached results of the containers whose interfaces no longer exist, e.g. the ones removed during
+	// reconciliation as their Pods have been deleted while the agent was not running.
+	containerIDs, _ := s.resultCache.list()
+	for _, containerID := range containerIDs {
+		if _, exists := s.podConfigurator.ifaceStore.GetContainerInterface(containerID); !exists {
+			if err := s.resultCache.delete(containerID); err != nil {
+				klog.ErrorS(err, "Failed to delete stale CmdAdd result", "container", containerID)
+			}
+		}
+	}
+	return nil
+}
+
+// cleanUpInterruptedAdds removes the interfaces configured by the ADD requests which were interrupted by an agent
+// restart, as they may never be retried or deleted, e.g. if the container runtime has created a new sandbox for the
+// Pod. The IPs allocated by these requests are released by the IPAM garbage collection during reconciliation, as
+// they have not been reported in the Pod status.
+func (s *CNIServer) cleanUpInterruptedAdds() {
+	_, pendingContainerIDs := s.resultCache.list()
+	for _, containerID := range pendingContainerIDs {
+		klog.InfoS("Cleaning up interfaces of interrupted CmdAdd request", "container", containerID)
+		if err := s.podConfigurator.removeInterfaces(containerID); err != nil {
+			klog.ErrorS(err, "Failed to remove interfaces of interrupted CmdAdd request", "container", containerID)
+			continue
+		}
+		if err := s.resultCache.delete(containerID); err != nil {
+			klog.ErrorS(err, "Failed to delete interrupted CmdAdd request state", "container", containerID)
+		}
+	}
 }
 
 func init() {
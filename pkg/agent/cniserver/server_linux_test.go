@@ -364,6 +364,106 @@ func TestCmdAdd(t *testing.T) {
 	}
 }
 
+func TestCmdAddRepeated(t *testing.T) {
+	ctx := context.TODO()
+	defer mockGetNSPath(nil)()
+	ipam.ResetIPAMResults()
+	controller := gomock.NewController(t)
+	ipamMock := ipamtest.NewMockIPAMDriver(controller)
+	cniserver := newMockCNIServer(t, controller, ipamMock, "test-cni-ipam", false, false)
+	testIfaceConfigurator := newTestInterfaceConfigurator()
+	requestMsg, hostInterfaceName := createCNIRequestAndInterfaceName(t, testPodNameA, "", ipamResult, "test-cni-ipam", true)
+	testIfaceConfigurator.hostIfaceName = hostInterfaceName
+	cniserver.podConfigurator.ifConfigurator = testIfaceConfigurator
+	containerID := requestMsg.CniArgs.ContainerId
+
+	// The interfaces are only configured once.
+	ipamMock.EXPECT().Add(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, &ipam.IPAMResult{Result: *ipamResult}, nil).Times(1)
+	mockRoute.EXPECT().AddLocalAntreaFlexibleIPAMPodRule(gomock.Any()).Return(nil).Times(1)
+	mockOVSBridgeClient.EXPECT().CreatePort(hostInterfaceName, gomock.Any(), gomock.Any()).Return(generateUUID(), nil).Times(1)
+	mockOVSBridgeClient.EXPECT().GetOFPort(hostInterfaceName, false).Return(int32(100), nil).Times(1)
+	mockOFClient.EXPECT().InstallPodFlows(hostInterfaceName, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	resp, err := cniserver.CmdAdd(ctx, requestMsg)
+	require.NoError(t, err)
+	require.Nil(t, resp.Error)
+	entry, exists := cniserver.resultCache.get(containerID)
+	require.True(t, exists)
+	assert.False(t, entry.Pending)
+
+	// The repeated request gets the same result.
+	repeatedResp, err := cniserver.CmdAdd(ctx, requestMsg)
+	require.NoError(t, err)
+	assert.Equal(t, resp, repeatedResp)
+
+	// The result is removed when the container is deleted.
+	mockOFClient.EXPECT().UninstallPodFlows(hostInterfaceName).Return(nil).Times(1)
+	mockOVSBridgeClient.EXPECT().DeletePort(gomock.Any()).Return(nil).Times(1)
+	mockRoute.EXPECT().DeleteLocalAntreaFlexibleIPAMPodRule(gomock.Any()).Return(nil).Times(1)
+	ipamMock.EXPECT().Del(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(1)
+	resp, err = cniserver.CmdDel(ctx, requestMsg)
+	require.NoError(t, err)
+	require.Nil(t, resp.Error)
+	_, exists = cniserver.resultCache.get(containerID)
+	assert.False(t, exists)
+}
+
+// reusableInterfaceConfigurator keeps the host interface name of fakeInterfaceConfigurator when the container link is
+// removed.
+type reusableInterfaceConfigurator struct {
+	*fakeInterfaceConfigurator
+}
+
+func (c *reusableInterfaceConfigurator) removeContainerLink(containerID, hostInterfaceName string) error {
+	hostIfaceName := c.hostIfaceName
+	defer func() {
+		c.hostIfaceName = hostIfaceName
+	}()
+	return c.fakeInterfaceConfigurator.removeContainerLink(containerID, hostInterfaceName)
+}
+
+func TestCmdAddAfterInterruptedAdd(t *testing.T) {
+	ctx := context.TODO()
+	defer mockGetNSPath(nil)()
+	ipam.ResetIPAMResults()
+	controller := gomock.NewController(t)
+	ipamMock := ipamtest.NewMockIPAMDriver(controller)
+	cniserver := newMockCNIServer(t, controller, ipamMock, "test-cni-ipam", false, false)
+	testIfaceConfigurator := newTestInterfaceConfigurator()
+	requestMsg, hostInterfaceName := createCNIRequestAndInterfaceName(t, testPodNameA, "", ipamResult, "test-cni-ipam", true)
+	testIfaceConfigurator.hostIfaceName = hostInterfaceName
+	// The host interface is created again after the stale one is removed.
+	cniserver.podConfigurator.ifConfigurator = &reusableInterfaceConfigurator{testIfaceConfigurator}
+	containerID := requestMsg.CniArgs.ContainerId
+
+	// A previous request connected the interface to OVS but did not complete.
+	stalePortID := generateUUID()
+	containerConfig := interfacestore.NewContainerInterface(hostInterfaceName, containerID, testPodNameA, testPodNamespace, "eth0", nil, []net.IP{net.ParseIP("10.1.2.100")}, 0)
+	containerConfig.OVSPortConfig = &interfacestore.OVSPortConfig{PortUUID: stalePortID, OFPort: 100}
+	ifaceStore.AddInterface(containerConfig)
+	require.NoError(t, cniserver.resultCache.setPending(containerID))
+
+	// The stale configurations are removed before the interfaces are configured again.
+	gomock.InOrder(
+		mockOFClient.EXPECT().UninstallPodFlows(hostInterfaceName).Return(nil).Times(1),
+		mockOVSBridgeClient.EXPECT().DeletePort(stalePortID).Return(nil).Times(1),
+		mockRoute.EXPECT().DeleteLocalAntreaFlexibleIPAMPodRule(gomock.Any()).Return(nil).Times(1),
+		ipamMock.EXPECT().Del(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil).Times(1),
+		ipamMock.EXPECT().Add(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, &ipam.IPAMResult{Result: *ipamResult}, nil).Times(1),
+	)
+	mockRoute.EXPECT().AddLocalAntreaFlexibleIPAMPodRule(gomock.Any()).Return(nil).Times(1)
+	mockOVSBridgeClient.EXPECT().CreatePort(hostInterfaceName, gomock.Any(), gomock.Any()).Return(generateUUID(), nil).Times(1)
+	mockOVSBridgeClient.EXPECT().GetOFPort(hostInterfaceName, false).Return(int32(101), nil).Times(1)
+	mockOFClient.EXPECT().InstallPodFlows(hostInterfaceName, gomock.Any(), gomock.Any(), uint32(101), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+
+	resp, err := cniserver.CmdAdd(ctx, requestMsg)
+	require.NoError(t, err)
+	require.Nil(t, resp.Error)
+	entry, exists := cniserver.resultCache.get(containerID)
+	require.True(t, exists)
+	assert.False(t, entry.Pending)
+}
+
 func TestCmdDel(t *testing.T) {
 	ovsPortID := generateUUID()
 	ovsPort := int32(100)
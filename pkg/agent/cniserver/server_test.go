@@ -26,6 +26,7 @@ import (
 	cnitypes "github.com/containernetworking/cni/pkg/types"
 	current "github.com/containernetworking/cni/pkg/types/100"
 	"github.com/google/uuid"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -767,6 +768,7 @@ func newCNIServer(t *testing.T) *CNIServer {
 		containerAccess:         newContainerAccessArbitrator(),
 		podNetworkWait:          wait.NewGroup(),
 		flowRestoreCompleteWait: wait.NewGroup().Increment(),
+		resultCache:             newCNIResultCache(afero.NewMemMapFs()),
 	}
 	cniServer.networkConfig = &config.NetworkConfig{InterfaceMTU: 1450}
 	return cniServer
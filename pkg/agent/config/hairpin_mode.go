@@ -0,0 +1,55 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import "strings"
+
+// HairpinMode determines how Service connections whose selected Endpoint is reached through the same interface the
+// connections come from, i.e. hairpin connections, are handled.
+type HairpinMode int
+
+const (
+	// HairpinModeSNAT performs SNAT on hairpin connections so that reply packets always come back to OVS.
+	HairpinModeSNAT HairpinMode = iota
+	// HairpinModePreserveClientIP preserves the client IP of hairpin connections initiated through the Antrea gateway.
+	HairpinModePreserveClientIP
+	HairpinModeInvalid = -1
+)
+
+var (
+	hairpinModeStrs = [...]string{
+		"SNAT",
+		"PreserveClientIP",
+	}
+)
+
+// GetHairpinModeFromStr returns true and HairpinMode corresponding to input string.
+// Otherwise, false and undefined value is returned
+func GetHairpinModeFromStr(str string) (bool, HairpinMode) {
+	for idx, ms := range hairpinModeStrs {
+		if strings.EqualFold(ms, str) {
+			return true, HairpinMode(idx)
+		}
+	}
+	return false, HairpinModeInvalid
+}
+
+// String returns value in string.
+func (m HairpinMode) String() string {
+	if m == HairpinModeInvalid {
+		return "invalid"
+	}
+	return hairpinModeStrs[m]
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetHairpinModeFromStr(t *testing.T) {
+	tests := []struct {
+		name         string
+		str          string
+		expectedOK   bool
+		expectedMode HairpinMode
+	}{
+		{
+			name:         "snat",
+			str:          "SNAT",
+			expectedOK:   true,
+			expectedMode: HairpinModeSNAT,
+		},
+		{
+			name:         "lowercase preserveclientip",
+			str:          "preserveclientip",
+			expectedOK:   true,
+			expectedMode: HairpinModePreserveClientIP,
+		},
+		{
+			name:         "preserveclientip",
+			str:          "PreserveClientIP",
+			expectedOK:   true,
+			expectedMode: HairpinModePreserveClientIP,
+		},
+		{
+			name:       "invalid",
+			str:        "NoSNAT",
+			expectedOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotOK, gotMode := GetHairpinModeFromStr(tt.str)
+			assert.Equal(t, tt.expectedOK, gotOK)
+			if tt.expectedOK {
+				assert.Equal(t, tt.expectedMode, gotMode)
+			}
+		})
+	}
+}
+
+func TestHairpinModeString(t *testing.T) {
+	assert.Equal(t, "SNAT", HairpinModeSNAT.String())
+	assert.Equal(t, "PreserveClientIP", HairpinModePreserveClientIP.String())
+	assert.Equal(t, "invalid", HairpinMode(HairpinModeInvalid).String())
+}
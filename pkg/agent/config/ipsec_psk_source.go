@@ -0,0 +1,36 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+// IPsecPSKSource is the source of the pre-shared keys used for IKE authentication in "psk" mode.
+type IPsecPSKSource string
+
+const (
+	// IPsecPSKSourceEnv means a single cluster-wide PSK is read from an environment variable.
+	IPsecPSKSourceEnv IPsecPSKSource = "env"
+	// IPsecPSKSourceSecret means per-Node-pair PSKs are read from a Kubernetes Secret.
+	IPsecPSKSourceSecret IPsecPSKSource = "secret"
+	// IPsecPSKSourceVault means per-Node-pair PSKs are read from a HashiCorp Vault server.
+	IPsecPSKSourceVault IPsecPSKSource = "vault"
+)
+
+// IsValid returns whether the IPsecPSKSource is supported.
+func (s IPsecPSKSource) IsValid() bool {
+	switch s {
+	case IPsecPSKSourceEnv, IPsecPSKSourceSecret, IPsecPSKSourceVault:
+		return true
+	}
+	return false
+}
@@ -17,7 +17,9 @@ package config
 import (
 	"fmt"
 	"net"
+	"time"
 
+	"antrea.io/antrea/pkg/agent/preflight"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 )
 
@@ -53,14 +55,24 @@ const (
 )
 
 const (
-	L7RedirectTargetPortName = "antrea-l7-tap0"
-	L7RedirectReturnPortName = "antrea-l7-tap1"
-	L7SuricataSocketPath     = "/var/run/suricata/suricata_eve.socket"
+	L7RedirectTargetPortName  = "antrea-l7-tap0"
+	L7RedirectReturnPortName  = "antrea-l7-tap1"
+	L7SuricataSocketPath      = "/var/run/suricata/suricata_eve.socket"
+	L7SuricataAuditSocketPath = "/var/run/suricata/suricata_audit.socket"
 )
 
 const (
 	NodeNetworkPolicyIngressRulesChain = "ANTREA-POL-INGRESS-RULES"
 	NodeNetworkPolicyEgressRulesChain  = "ANTREA-POL-EGRESS-RULES"
+	// NodeNetworkPolicyHostPortRulesChain is the chain of ingress rules enforced on traffic forwarded to local Pods
+	// through a hostPort.
+	NodeNetworkPolicyHostPortRulesChain = "ANTREA-POL-HOSTPORT-RULES"
+	// NodeNetworkPolicyHostNetworkPodPortsChain is the chain which marks ingress packets destined to the ports of
+	// local hostNetwork Pods.
+	NodeNetworkPolicyHostNetworkPodPortsChain = "ANTREA-POL-HOSTNET-PORTS"
+	// NodeNetworkPolicyHostPortsChain is the chain which sends DNAT'd packets destined to the hostPorts of local Pods
+	// to NodeNetworkPolicyHostPortRulesChain.
+	NodeNetworkPolicyHostPortsChain = "ANTREA-POL-HOSTPORTS"
 
 	NodeNetworkPolicyPrefix = "ANTREA-POL"
 )
@@ -120,12 +132,15 @@ func (g *GatewayConfig) String() string {
 }
 
 type AdapterNetConfig struct {
-	Name       string
-	Index      int
-	MAC        net.HardwareAddr
-	IPs        []*net.IPNet
-	MTU        int
-	Gateway    string
+	Name    string
+	Index   int
+	MAC     net.HardwareAddr
+	IPs     []*net.IPNet
+	MTU     int
+	Gateway string
+	// GatewayMAC is the MAC address of Gateway. It is only resolved on Windows when Egress is enabled, and is used as
+	// the destination MAC of the packets SNAT'd by OVS.
+	GatewayMAC net.HardwareAddr
 	DNSServers string
 	Routes     []interface{}
 	// OFPort is the OpenFlow port number of the uplink interface allocated by OVS.
@@ -191,6 +206,20 @@ type NodeConfig struct {
 	WireGuardConfig *WireGuardConfig
 	// The config of the Egress interface.
 	EgressConfig *EgressConfig
+	// The results of the startup-time checks of the kernel features required by the enabled Antrea features.
+	KernelFeatureChecks []preflight.Result
+	// The additional uplinks through which peer Nodes can be reached in noEncap mode, when multipath routing is
+	// enabled.
+	MultipathUplinks []*MultipathUplink
+}
+
+// MultipathUplink is an additional uplink of the Node used to route the Pod traffic to peer Nodes in noEncap mode.
+type MultipathUplink struct {
+	Name string
+	// The IPv4 address of the uplink. It has the network mask information.
+	IPv4Addr *net.IPNet
+	// The IPv6 address of the uplink. It has the network mask information.
+	IPv6Addr *net.IPNet
 }
 
 func (n *NodeConfig) String() string {
@@ -201,7 +230,10 @@ func (n *NodeConfig) String() string {
 // IPsecConfig includes IPsec related configurations.
 type IPsecConfig struct {
 	AuthenticationMode IPsecAuthenticationMode
-	PSK                string
+	// PSKSource is the source of the PSKs when AuthenticationMode is "psk". PSK is only set when it is "env", otherwise
+	// the PSK of each Node pair is provided by the NodeRouteController's PSK provider.
+	PSKSource IPsecPSKSource
+	PSK       string
 }
 
 // NetworkConfig includes user provided network configuration parameters.
@@ -229,6 +261,12 @@ type NetworkConfig struct {
 
 	EnableMulticlusterGW       bool
 	MulticlusterEncryptionMode TrafficEncryptionModeType
+
+	// MultipathUplinkInterfaces are the names of the additional uplinks through which peer Nodes can be reached in
+	// noEncap mode. Multipath routing is disabled if it is empty.
+	MultipathUplinkInterfaces []string
+	// MultipathHealthCheckInterval is the interval between two consecutive probes of the paths to peer Nodes.
+	MultipathHealthCheckInterval time.Duration
 }
 
 // IsIPv4Enabled returns true if the cluster network supports IPv4. Legal cases are:
@@ -74,6 +74,8 @@ const (
 	minEgressMark = 1
 	// maxEgressMark is the maximum mark of Egress IPs can be configured on a Node.
 	maxEgressMark = 255
+	// maxEgressFailoverHistory is the maximum number of failovers recorded in the status of an Egress.
+	maxEgressFailoverHistory = 10
 
 	egressIPIndex       = "egressIP"
 	externalIPPoolIndex = "externalIPPool"
@@ -100,8 +102,6 @@ type egressState struct {
 	ofPorts sets.Set[int32]
 	// The actual Pods of the Egress. Used to identify stale Pods when updating or deleting an Egress.
 	pods sets.Set[string]
-	// Rate-limit of this Egress.
-	rateLimitMeter *rateLimitMeter
 }
 
 type rateLimitMeter struct {
@@ -134,6 +134,10 @@ type egressIPState struct {
 	ruleInstalled bool
 	// The subnet the Egress IP is associated with.
 	subnetInfo *crdv1b1.SubnetInfo
+	// The rate-limits desired by the Egresses referring to it, keyed by Egress name.
+	rateLimits map[string]*rateLimitMeter
+	// The rate-limit actually installed for this Egress IP. All traffic SNATed through the IP shares the meter.
+	rateLimitMeter *rateLimitMeter
 }
 
 // egressRouteTable stores the route table ID created for a subnet and the marks that are referencing it.
@@ -226,6 +230,7 @@ func NewEgressController(
 	podUpdateSubscriber channel.Subscriber,
 	serviceCIDRInterface servicecidr.Interface,
 	maxEgressIPsPerNode int,
+	stabilizationWindow time.Duration,
 	trafficShapingEnabled bool,
 	supportSeparateSubnet bool,
 	linkMonitor linkmonitor.Interface,
@@ -290,13 +295,13 @@ func NewEgressController(
 			resyncPeriod,
 		)
 	}
-	ipAssigner, err := newIPAssigner(nodeTransportInterface, egressDummyDevice, linkMonitor)
+	ipAssigner, err := newIPAssigner(nodeTransportInterface, egressDummyDevice, linkMonitor, c.externalIPPoolLister)
 	if err != nil {
 		return nil, fmt.Errorf("initializing egressIP assigner failed: %v", err)
 	}
 	c.ipAssigner = ipAssigner
 
-	c.egressIPScheduler = NewEgressIPScheduler(cluster, egressInformer, nodeInformers, maxEgressIPsPerNode)
+	c.egressIPScheduler = NewEgressIPScheduler(cluster, egressInformer, nodeInformers, maxEgressIPsPerNode, stabilizationWindow)
 
 	c.egressInformer.AddIndexers(
 		cache.Indexers{
@@ -755,36 +760,64 @@ func bandwidthToRateLimitMeter(bandwidth *crdv1b1.Bandwidth, meterID uint32) *ra
 	}
 }
 
-func (c *EgressController) realizeEgressQoS(egressName string, eState *egressState, mark uint32, bandwidth *crdv1b1.Bandwidth) error {
+func (c *EgressController) realizeEgressQoS(egressName, egressIP string, mark uint32, bandwidth *crdv1b1.Bandwidth) error {
 	if !c.trafficShapingEnabled {
 		if bandwidth != nil {
 			klog.InfoS("Bandwidth in the Egress is ignored because OVS meters are not supported or trafficShaping is not enabled in Antrea-agent config.", "EgressName", egressName)
 		}
 		return nil
 	}
-	var desiredRateLimit *rateLimitMeter
+	c.egressIPStatesMutex.Lock()
+	defer c.egressIPStatesMutex.Unlock()
+
+	ipState, exist := c.egressIPStates[egressIP]
+	if !exist {
+		return nil
+	}
 	// QoS is desired only if the Egress is configured on this Node.
+	var desiredRateLimit *rateLimitMeter
 	if mark != 0 {
 		desiredRateLimit = bandwidthToRateLimitMeter(bandwidth, mark)
 	}
+	if desiredRateLimit != nil {
+		if ipState.rateLimits == nil {
+			ipState.rateLimits = map[string]*rateLimitMeter{}
+		}
+		ipState.rateLimits[egressName] = desiredRateLimit
+	} else {
+		delete(ipState.rateLimits, egressName)
+	}
+	return c.syncEgressIPQoS(ipState)
+}
+
+// syncEgressIPQoS installs the meter of an Egress IP according to the rate-limits desired by the Egresses referring to
+// it. If multiple Egresses sharing the IP configure different bandwidths, the most restrictive one takes effect.
+// It must be called with egressIPStatesMutex held.
+func (c *EgressController) syncEgressIPQoS(ipState *egressIPState) error {
+	var desiredRateLimit *rateLimitMeter
+	for _, rateLimit := range ipState.rateLimits {
+		if desiredRateLimit == nil || rateLimit.Rate < desiredRateLimit.Rate ||
+			(rateLimit.Rate == desiredRateLimit.Rate && rateLimit.Burst < desiredRateLimit.Burst) {
+			desiredRateLimit = rateLimit
+		}
+	}
 	// Nothing changes.
-	if eState.rateLimitMeter.Equals(desiredRateLimit) {
+	if ipState.rateLimitMeter.Equals(desiredRateLimit) {
 		return nil
 	}
-	// It's desired to have QoS on this Node, install/override it.
-	if desiredRateLimit != nil {
-		if err := c.ofClient.InstallEgressQoS(mark, desiredRateLimit.Rate, desiredRateLimit.Burst); err != nil {
+	// Uninstall the previous meter if it's no longer desired or its ID has changed.
+	if ipState.rateLimitMeter != nil && (desiredRateLimit == nil || ipState.rateLimitMeter.MeterID != desiredRateLimit.MeterID) {
+		if err := c.ofClient.UninstallEgressQoS(ipState.rateLimitMeter.MeterID); err != nil {
 			return err
 		}
-		eState.rateLimitMeter = desiredRateLimit
-		return nil
+		ipState.rateLimitMeter = nil
 	}
-	// It's undesired to have QoS on this Node, uninstall it.
-	if eState.rateLimitMeter != nil {
-		if err := c.ofClient.UninstallEgressQoS(eState.rateLimitMeter.MeterID); err != nil {
+	// It's desired to have QoS on this Node, install/override it.
+	if desiredRateLimit != nil {
+		if err := c.ofClient.InstallEgressQoS(desiredRateLimit.MeterID, desiredRateLimit.Rate, desiredRateLimit.Burst); err != nil {
 			return err
 		}
-		eState.rateLimitMeter = nil
+		ipState.rateLimitMeter = desiredRateLimit
 	}
 	return nil
 }
@@ -802,8 +835,13 @@ func (c *EgressController) unrealizeEgressIP(egressName, egressIP string) error
 		return nil
 	}
 	// Unlink the Egress from the EgressIP. If it's the last Egress referring to it, uninstall its datapath rules and
-	// release the mark if installed.
+	// release the mark if installed. Otherwise, the meter is kept as the other Egresses are still using it, but its
+	// rate-limit may need to be updated.
 	ipState.egressNames.Delete(egressName)
+	delete(ipState.rateLimits, egressName)
+	if err := c.syncEgressIPQoS(ipState); err != nil {
+		return err
+	}
 	if len(ipState.egressNames) > 0 {
 		return nil
 	}
@@ -949,6 +987,15 @@ func (c *EgressController) updateEgressStatus(egress *crdv1b1.Egress, egressIP s
 					Message:            fmt.Sprintf("Failed to assign the IP to EgressNode: %v", scheduleErr),
 				},
 			}
+			if scheduleErr == memberlist.ErrNoNodeAvailable {
+				desiredStatus.Conditions = append(desiredStatus.Conditions, crdv1b1.EgressCondition{
+					Type:               crdv1b1.NoSchedulableNode,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.Now(),
+					Reason:             "NoNodeAvailable",
+					Message:            fmt.Sprintf("No Node selected by ExternalIPPool %s can hold the Egress IP", egress.Spec.ExternalIPPool),
+				})
+			}
 		}
 	} else {
 		// The Egress IP is assigned to a Node (egressIP != "") but it's not this Node (isLocal == false), do nothing.
@@ -957,19 +1004,31 @@ func (c *EgressController) updateEgressStatus(egress *crdv1b1.Egress, egressIP s
 
 	toUpdate := egress.DeepCopy()
 	var updateErr, getErr error
+	// failover is the change of the Node that holds the Egress IP made by the update, if any.
+	var failover *crdv1b1.EgressFailover
 	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		failover = nil
 		if compareEgressStatus(&toUpdate.Status, desiredStatus) {
 			return nil
 		}
 		// Must make a copy here as we will append more conditions. If it's appended to desiredStatus directly, there
 		// would be duplicate conditions when the function retries.
 		statusToUpdate := desiredStatus.DeepCopy()
-		// Copy conditions other than crdv1b1.IPAssigned to statusToUpdate.
+		// Copy conditions not managed by this function to statusToUpdate.
 		for _, c := range toUpdate.Status.Conditions {
-			if c.Type != crdv1b1.IPAssigned {
+			if !isAssignmentCondition(c.Type) {
 				statusToUpdate.Conditions = append(statusToUpdate.Conditions, c)
 			}
 		}
+		statusToUpdate.FailoverHistory = toUpdate.Status.FailoverHistory
+		if toUpdate.Status.EgressNode != "" && toUpdate.Status.EgressNode != statusToUpdate.EgressNode {
+			failover = &crdv1b1.EgressFailover{
+				FromNode: toUpdate.Status.EgressNode,
+				ToNode:   statusToUpdate.EgressNode,
+				Time:     metav1.Now(),
+			}
+			statusToUpdate.FailoverHistory = appendEgressFailover(statusToUpdate.FailoverHistory, *failover)
+		}
 		toUpdate.Status = *statusToUpdate
 
 		klog.V(2).InfoS("Updating Egress status", "Egress", egress.Name, "oldNode", egress.Status.EgressNode, "newNode", toUpdate.Status.EgressNode)
@@ -986,9 +1045,32 @@ func (c *EgressController) updateEgressStatus(egress *crdv1b1.Egress, egressIP s
 	}
 	klog.V(2).InfoS("Updated Egress status", "Egress", egress.Name)
 	metrics.AntreaEgressStatusUpdates.Inc()
+	if failover != nil {
+		if failover.ToNode != "" {
+			c.record.Eventf(egress, corev1.EventTypeNormal, "Failover", "Egress %s with IP %s failed over from Node %s to Node %s", egress.Name, egressIP, failover.FromNode, failover.ToNode)
+		} else {
+			c.record.Eventf(egress, corev1.EventTypeWarning, "Failover", "Egress %s with IP %s was released by Node %s and no Node could take it over", egress.Name, egress.Status.EgressIP, failover.FromNode)
+		}
+	}
 	return nil
 }
 
+// isAssignmentCondition returns whether the Egress condition is about the assignment of the Egress IP to a Node, in
+// which case it is managed by the agents.
+func isAssignmentCondition(conditionType crdv1b1.EgressConditionType) bool {
+	return conditionType == crdv1b1.IPAssigned || conditionType == crdv1b1.NoSchedulableNode
+}
+
+// appendEgressFailover appends a failover to the failover history of an Egress, keeping only the most recent
+// maxEgressFailoverHistory failovers.
+func appendEgressFailover(history []crdv1b1.EgressFailover, failover crdv1b1.EgressFailover) []crdv1b1.EgressFailover {
+	history = append(history, failover)
+	if len(history) > maxEgressFailoverHistory {
+		history = history[len(history)-maxEgressFailoverHistory:]
+	}
+	return history
+}
+
 func (c *EgressController) syncEgress(egressName string) error {
 	startTime := time.Now()
 	defer func() {
@@ -1084,7 +1166,7 @@ func (c *EgressController) syncEgress(egressName string) error {
 		return err
 	}
 
-	if err = c.realizeEgressQoS(egressName, eState, mark, egress.Spec.Bandwidth); err != nil {
+	if err = c.realizeEgressQoS(egressName, desiredEgressIP, mark, egress.Spec.Bandwidth); err != nil {
 		return err
 	}
 
@@ -1160,16 +1242,10 @@ func (c *EgressController) uninstallEgress(egressName string, eState *egressStat
 	if err := c.uninstallPodFlows(egressName, eState, eState.ofPorts, eState.pods); err != nil {
 		return err
 	}
-	// Release the EgressIP's mark if the Egress is the last one referring to it.
+	// Release the EgressIP's mark and uninstall its meter if the Egress is the last one referring to it.
 	if err := c.unrealizeEgressIP(egressName, eState.egressIP); err != nil {
 		return err
 	}
-	// Uninstall its meter.
-	if c.trafficShapingEnabled && eState.rateLimitMeter != nil {
-		if err := c.ofClient.UninstallEgressQoS(eState.rateLimitMeter.MeterID); err != nil {
-			return err
-		}
-	}
 	// Unassign the Egress IP from the local Node if it was assigned by the agent.
 	unassigned, err := c.ipAssigner.UnassignIP(eState.egressIP)
 	if err != nil {
@@ -1394,7 +1470,8 @@ func isEgressSchedulable(egress *crdv1b1.Egress) bool {
 	return egress.Spec.EgressIP != "" && egress.Spec.ExternalIPPool != ""
 }
 
-// compareEgressStatus compares two Egress Statuses, ignoring LastTransitionTime and conditions other than IPAssigned, returns true if they are equal.
+// compareEgressStatus compares two Egress Statuses, ignoring LastTransitionTime, the failover history and conditions
+// other than IPAssigned and NoSchedulableNode, returns true if they are equal.
 func compareEgressStatus(currentStatus, desiredStatus *crdv1b1.EgressStatus) bool {
 	if currentStatus == nil && desiredStatus == nil {
 		return true
@@ -1405,13 +1482,18 @@ func compareEgressStatus(currentStatus, desiredStatus *crdv1b1.EgressStatus) boo
 	if currentStatus.EgressIP != desiredStatus.EgressIP || currentStatus.EgressNode != desiredStatus.EgressNode {
 		return false
 	}
-	currentIPAssignedCondition := crdv1b1.GetEgressCondition(currentStatus.Conditions, crdv1b1.IPAssigned)
-	desiredIPAssignedCondition := crdv1b1.GetEgressCondition(desiredStatus.Conditions, crdv1b1.IPAssigned)
-	if currentIPAssignedCondition == nil && desiredIPAssignedCondition == nil {
-		return true
-	}
-	if currentIPAssignedCondition == nil || desiredIPAssignedCondition == nil {
-		return false
+	for _, conditionType := range []crdv1b1.EgressConditionType{crdv1b1.IPAssigned, crdv1b1.NoSchedulableNode} {
+		currentCondition := crdv1b1.GetEgressCondition(currentStatus.Conditions, conditionType)
+		desiredCondition := crdv1b1.GetEgressCondition(desiredStatus.Conditions, conditionType)
+		if currentCondition == nil && desiredCondition == nil {
+			continue
+		}
+		if currentCondition == nil || desiredCondition == nil {
+			return false
+		}
+		if currentCondition.Status != desiredCondition.Status || currentCondition.Reason != desiredCondition.Reason || currentCondition.Message != desiredCondition.Message {
+			return false
+		}
 	}
-	return currentIPAssignedCondition.Status == desiredIPAssignedCondition.Status && currentIPAssignedCondition.Reason == desiredIPAssignedCondition.Reason && currentIPAssignedCondition.Message == desiredIPAssignedCondition.Message
+	return true
 }
@@ -34,6 +34,7 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	k8sv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
 	"antrea.io/antrea/pkg/agent/interfacestore"
@@ -52,6 +53,7 @@ import (
 	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
 	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/util/channel"
 	"antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
@@ -137,7 +139,7 @@ func (c *fakeSingleNodeCluster) AddClusterEventHandler(handler memberlist.Cluste
 
 func mockNewIPAssigner(ipAssigner ipassigner.IPAssigner) func() {
 	originalNewIPAssigner := newIPAssigner
-	newIPAssigner = func(_, _ string, _ linkmonitor.Interface) (ipassigner.IPAssigner, error) {
+	newIPAssigner = func(_, _ string, _ linkmonitor.Interface, _ crdlisters.ExternalIPPoolLister) (ipassigner.IPAssigner, error) {
 		return ipAssigner, nil
 	}
 	return func() {
@@ -201,6 +203,7 @@ func newFakeController(t *testing.T, initObjects []runtime.Object) *fakeControll
 		podUpdateChannel,
 		mockServiceCIDRProvider,
 		255,
+		0,
 		true,
 		true,
 		nil,
@@ -1430,6 +1433,42 @@ func addPodInterface(ifaceStore interfacestore.InterfaceStore, podNamespace, pod
 	})
 }
 
+func TestRealizeSharedEgressIPQoS(t *testing.T) {
+	c := newFakeController(t, nil)
+	c.trafficShapingEnabled = true
+
+	realize := func(egressName string, bandwidth *crdv1b1.Bandwidth) {
+		mark, err := c.realizeEgressIP(egressName, fakeLocalEgressIP1, nil)
+		require.NoError(t, err)
+		require.NoError(t, c.realizeEgressQoS(egressName, fakeLocalEgressIP1, mark, bandwidth))
+	}
+
+	c.mockOFClient.EXPECT().InstallSNATMarkFlows(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockRouteClient.EXPECT().AddSNATRule(net.ParseIP(fakeLocalEgressIP1), uint32(1))
+	c.mockOFClient.EXPECT().InstallEgressQoS(uint32(1), uint32(10000), uint32(20000))
+	realize("egressA", &newFakeBandwidth)
+
+	// The most restrictive bandwidth takes effect when multiple Egresses share the IP.
+	c.mockOFClient.EXPECT().InstallEgressQoS(uint32(1), uint32(500), uint32(500))
+	realize("egressB", &fakeBandwidth)
+	// Nothing changes as the effective bandwidth is the same.
+	realize("egressC", nil)
+	realize("egressA", &newFakeBandwidth)
+
+	// The meter is kept but updated when the Egress providing the effective bandwidth is removed.
+	c.mockOFClient.EXPECT().InstallEgressQoS(uint32(1), uint32(10000), uint32(20000))
+	require.NoError(t, c.unrealizeEgressIP("egressB", fakeLocalEgressIP1))
+	// The meter is kept as long as an Egress sharing the IP has a bandwidth.
+	require.NoError(t, c.unrealizeEgressIP("egressC", fakeLocalEgressIP1))
+
+	// The meter is uninstalled when the last Egress referring to the IP is removed.
+	c.mockOFClient.EXPECT().UninstallEgressQoS(uint32(1))
+	c.mockOFClient.EXPECT().UninstallSNATMarkFlows(uint32(1))
+	c.mockRouteClient.EXPECT().DeleteSNATRule(uint32(1))
+	require.NoError(t, c.unrealizeEgressIP("egressA", fakeLocalEgressIP1))
+	assert.Len(t, c.egressIPStates, 0)
+}
+
 func TestUpdateEgressStatus(t *testing.T) {
 	getError := fmt.Errorf("fake get Egress error")
 	updateConflictError := &errors.StatusError{ErrStatus: metav1.Status{Reason: metav1.StatusReasonConflict, Message: "update Egress conflict"}}
@@ -1447,6 +1486,7 @@ func TestUpdateEgressStatus(t *testing.T) {
 		expectedGetCalled    int
 		expectedError        error
 		expectedEgressStatus crdv1b1.EgressStatus
+		expectedEvent        string
 	}{
 		{
 			name: "updating static Egress succeeds immediately",
@@ -1568,6 +1608,7 @@ func TestUpdateEgressStatus(t *testing.T) {
 			expectedEgressStatus: crdv1b1.EgressStatus{
 				Conditions: []crdv1b1.EgressCondition{
 					{Type: crdv1b1.IPAssigned, Status: v1.ConditionFalse, Reason: "AssignmentError", Message: "Failed to assign the IP to EgressNode: no Node available"},
+					{Type: crdv1b1.NoSchedulableNode, Status: v1.ConditionTrue, Reason: "NoNodeAvailable", Message: "No Node selected by ExternalIPPool " + fakeExternalIPPool + " can hold the Egress IP"},
 					{Type: crdv1b1.IPAllocated, Status: v1.ConditionTrue, Reason: "Allocated", Message: "EgressIP is successfully allocated"},
 				},
 			},
@@ -1592,10 +1633,66 @@ func TestUpdateEgressStatus(t *testing.T) {
 			expectedEgressStatus: crdv1b1.EgressStatus{
 				Conditions: []crdv1b1.EgressCondition{
 					{Type: crdv1b1.IPAssigned, Status: v1.ConditionFalse, Reason: "AssignmentError", Message: "Failed to assign the IP to EgressNode: no Node available"},
+					{Type: crdv1b1.NoSchedulableNode, Status: v1.ConditionTrue, Reason: "NoNodeAvailable", Message: "No Node selected by ExternalIPPool " + fakeExternalIPPool + " can hold the Egress IP"},
 					{Type: crdv1b1.IPAllocated, Status: v1.ConditionTrue, Reason: "Allocated", Message: "EgressIP is successfully allocated"},
 				},
 			},
 		},
+		{
+			name: "updating HA Egress records failover to this Node",
+			egress: &crdv1b1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", ResourceVersion: "fake-ResourceVersion"},
+				Spec:       crdv1b1.EgressSpec{EgressIP: fakeLocalEgressIP1, ExternalIPPool: fakeExternalIPPool},
+				Status: crdv1b1.EgressStatus{
+					EgressNode: fakeNode2,
+					EgressIP:   fakeLocalEgressIP1,
+					Conditions: []crdv1b1.EgressCondition{
+						{Type: crdv1b1.IPAssigned, Status: v1.ConditionTrue, Reason: "Assigned", Message: "EgressIP is successfully assigned to EgressNode"},
+					},
+				},
+			},
+			egressIP:             fakeLocalEgressIP1,
+			expectedUpdateCalled: 1,
+			expectedEgressStatus: crdv1b1.EgressStatus{
+				EgressNode: fakeNode,
+				EgressIP:   fakeLocalEgressIP1,
+				Conditions: []crdv1b1.EgressCondition{
+					{Type: crdv1b1.IPAssigned, Status: v1.ConditionTrue, Reason: "Assigned", Message: "EgressIP is successfully assigned to EgressNode"},
+				},
+				FailoverHistory: []crdv1b1.EgressFailover{
+					{FromNode: fakeNode2, ToNode: fakeNode},
+				},
+			},
+			expectedEvent: fmt.Sprintf("Normal Failover Egress egressA with IP %s failed over from Node %s to Node %s", fakeLocalEgressIP1, fakeNode2, fakeNode),
+		},
+		{
+			name: "updating HA Egress records failover when no Node is available",
+			egress: &crdv1b1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", ResourceVersion: "fake-ResourceVersion"},
+				Spec:       crdv1b1.EgressSpec{EgressIP: fakeRemoteEgressIP1, ExternalIPPool: fakeExternalIPPool},
+				Status: crdv1b1.EgressStatus{
+					EgressNode: fakeNode2,
+					EgressIP:   fakeRemoteEgressIP1,
+					FailoverHistory: []crdv1b1.EgressFailover{
+						{FromNode: fakeNode, ToNode: fakeNode2},
+					},
+				},
+			},
+			scheduleErr:          memberlist.ErrNoNodeAvailable,
+			selectedNodeForIP:    fakeNode,
+			expectedUpdateCalled: 1,
+			expectedEgressStatus: crdv1b1.EgressStatus{
+				Conditions: []crdv1b1.EgressCondition{
+					{Type: crdv1b1.IPAssigned, Status: v1.ConditionFalse, Reason: "AssignmentError", Message: "Failed to assign the IP to EgressNode: no Node available"},
+					{Type: crdv1b1.NoSchedulableNode, Status: v1.ConditionTrue, Reason: "NoNodeAvailable", Message: "No Node selected by ExternalIPPool " + fakeExternalIPPool + " can hold the Egress IP"},
+				},
+				FailoverHistory: []crdv1b1.EgressFailover{
+					{FromNode: fakeNode, ToNode: fakeNode2},
+					{FromNode: fakeNode2},
+				},
+			},
+			expectedEvent: fmt.Sprintf("Warning Failover Egress egressA with IP %s was released by Node %s and no Node could take it over", fakeRemoteEgressIP1, fakeNode2),
+		},
 		{
 			name: "updating HA Egress with schedule error does nothing when the Node is not selected to update",
 			egress: &crdv1b1.Egress{
@@ -1638,7 +1735,8 @@ func TestUpdateEgressStatus(t *testing.T) {
 
 			localIPDetector := &fakeLocalIPDetector{localIPs: sets.New[string](fakeLocalEgressIP1)}
 			cluster := newFakeMemberlistCluster([]string{tt.selectedNodeForIP})
-			c := &EgressController{crdClient: fakeClient, nodeName: fakeNode, localIPDetector: localIPDetector, cluster: cluster}
+			recorder := record.NewFakeRecorder(10)
+			c := &EgressController{crdClient: fakeClient, nodeName: fakeNode, localIPDetector: localIPDetector, cluster: cluster, record: recorder}
 			err := c.updateEgressStatus(tt.egress, tt.egressIP, tt.scheduleErr)
 			if err != tt.expectedError {
 				t.Errorf("Update Egress error not match, got: %v, expected: %v", err, tt.expectedError)
@@ -1647,10 +1745,26 @@ func TestUpdateEgressStatus(t *testing.T) {
 			assert.Equal(t, tt.expectedUpdateCalled, updateCalled, "Update called num not match")
 			gotEgress, _ := c.crdClient.CrdV1beta1().Egresses().Get(context.TODO(), tt.egress.Name, metav1.GetOptions{})
 			assert.True(t, k8s.SemanticIgnoringTime.DeepEqual(tt.expectedEgressStatus, gotEgress.Status), "Expected:\n%v\nGot:\n%v", tt.expectedEgressStatus, gotEgress.Status)
+			if tt.expectedEvent != "" {
+				require.Len(t, recorder.Events, 1)
+				assert.Equal(t, tt.expectedEvent, <-recorder.Events)
+			} else {
+				assert.Empty(t, recorder.Events)
+			}
 		})
 	}
 }
 
+func TestAppendEgressFailover(t *testing.T) {
+	var history []crdv1b1.EgressFailover
+	for i := 0; i < maxEgressFailoverHistory+2; i++ {
+		history = appendEgressFailover(history, crdv1b1.EgressFailover{FromNode: fmt.Sprintf("node%d", i)})
+	}
+	require.Len(t, history, maxEgressFailoverHistory)
+	assert.Equal(t, "node2", history[0].FromNode)
+	assert.Equal(t, fmt.Sprintf("node%d", maxEgressFailoverHistory+1), history[maxEgressFailoverHistory-1].FromNode)
+}
+
 func TestGetEgress(t *testing.T) {
 	egress := &crdv1b1.Egress{
 		ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
@@ -1928,6 +2042,21 @@ func TestCompareEgressStatus(t *testing.T) {
 			},
 			expectedReturn: false,
 		},
+		{
+			name: "New Status has NoSchedulableNode Condition that old one doesn't",
+			status1: &crdv1b1.EgressStatus{
+				Conditions: []crdv1b1.EgressCondition{
+					newCondition(crdv1b1.IPAssigned, v1.ConditionFalse, "AssignmentError", "Failed to assign the IP to EgressNode: no Node available"),
+				},
+			},
+			status2: &crdv1b1.EgressStatus{
+				Conditions: []crdv1b1.EgressCondition{
+					newCondition(crdv1b1.IPAssigned, v1.ConditionFalse, "AssignmentError", "Failed to assign the IP to EgressNode: no Node available"),
+					newCondition(crdv1b1.NoSchedulableNode, v1.ConditionTrue, "NoNodeAvailable", "No Node selected by ExternalIPPool pool1 can hold the Egress IP"),
+				},
+			},
+			expectedReturn: false,
+		},
 		{
 			name: "New Status has irrelevant Condition that old one doesn't",
 			status1: &crdv1b1.EgressStatus{
@@ -19,6 +19,7 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/agent/memberlist"
 	"antrea.io/antrea/pkg/agent/types"
@@ -61,7 +63,7 @@ type egressIPScheduler struct {
 
 	// queue is used to trigger scheduling. Triggering multiple times before the item is consumed will only cause one
 	// execution of scheduling.
-	queue workqueue.TypedInterface[string]
+	queue workqueue.TypedDelayingInterface[string]
 
 	// mutex is used to protect scheduleResults.
 	mutex           sync.RWMutex
@@ -78,9 +80,18 @@ type egressIPScheduler struct {
 	// It takes precedence over the default value.
 	nodeToMaxEgressIPs      map[string]int
 	nodeToMaxEgressIPsMutex sync.RWMutex
+
+	// stabilizationWindow is the period after an Egress IP is assigned to a Node during which it is kept on this Node,
+	// as long as this Node is still eligible for it. 0 disables it.
+	stabilizationWindow time.Duration
+	clock               clock.Clock
+}
+
+func NewEgressIPScheduler(cluster memberlist.Interface, egressInformer crdinformers.EgressInformer, nodeInformer corev1informers.NodeInformer, maxEgressIPsPerNode int, stabilizationWindow time.Duration) *egressIPScheduler {
+	return newEgressIPScheduler(cluster, egressInformer, nodeInformer, maxEgressIPsPerNode, stabilizationWindow, clock.RealClock{})
 }
 
-func NewEgressIPScheduler(cluster memberlist.Interface, egressInformer crdinformers.EgressInformer, nodeInformer corev1informers.NodeInformer, maxEgressIPsPerNode int) *egressIPScheduler {
+func newEgressIPScheduler(cluster memberlist.Interface, egressInformer crdinformers.EgressInformer, nodeInformer corev1informers.NodeInformer, maxEgressIPsPerNode int, stabilizationWindow time.Duration, clk clock.Clock) *egressIPScheduler {
 	s := &egressIPScheduler{
 		cluster:             cluster,
 		egressLister:        egressInformer.Lister(),
@@ -89,7 +100,9 @@ func NewEgressIPScheduler(cluster memberlist.Interface, egressInformer crdinform
 		scheduledOnce:       &atomic.Bool{},
 		maxEgressIPsPerNode: maxEgressIPsPerNode,
 		nodeToMaxEgressIPs:  map[string]int{},
-		queue:               workqueue.NewTyped[string](),
+		queue:               workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[string]{}),
+		stabilizationWindow: stabilizationWindow,
+		clock:               clk,
 	}
 	egressInformer.Informer().AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
@@ -187,7 +200,10 @@ func (s *egressIPScheduler) updateEgress(old, cur interface{}) {
 		return
 	}
 	if oldEgress.Spec.EgressIP == curEgress.Spec.EgressIP && oldEgress.Spec.ExternalIPPool == curEgress.Spec.ExternalIPPool {
-		return
+		// The previous assignment of the Egress IP is read from the status when the stabilization window is enabled.
+		if s.stabilizationWindow <= 0 || oldEgress.Status.EgressNode == curEgress.Status.EgressNode {
+			return
+		}
 	}
 	s.queue.Add(workItem)
 	klog.V(2).InfoS("Egress UPDATE event triggered Egress IP scheduling", "egress", klog.KObj(curEgress))
@@ -320,6 +336,20 @@ func (s *egressIPScheduler) getMaxEgressIPsByNode(nodeName string) int {
 	return s.maxEgressIPsPerNode
 }
 
+// getPreviousAssignment returns the Node the Egress IP was previously assigned to and when it was assigned, according to
+// the Egress status. The status is shared by all agents, unlike their schedule results and their view of the memberlist
+// cluster history, so that all agents make the same decision.
+func getPreviousAssignment(egress *crdv1b1.Egress) (string, time.Time) {
+	if egress.Status.EgressNode == "" || egress.Status.EgressIP != egress.Spec.EgressIP {
+		return "", time.Time{}
+	}
+	condition := crdv1b1.GetEgressCondition(egress.Status.Conditions, crdv1b1.IPAssigned)
+	if condition == nil || condition.Status != corev1.ConditionTrue {
+		return "", time.Time{}
+	}
+	return egress.Status.EgressNode, condition.LastTransitionTime.Time
+}
+
 // schedule takes the spec of Egress and ExternalIPPool and the state of memberlist cluster as inputs, generates
 // scheduling results deterministically. When every Node's capacity is sufficient, each Egress's schedule is independent
 // and is only determined by the consistent hash map. When any Node's capacity is insufficient, one Egress's schedule
@@ -329,10 +359,20 @@ func (s *egressIPScheduler) getMaxEgressIPsByNode(nodeName string) int {
 // Note that it's possible that different agents decide different IP - Node assignment because their caches of Egress or
 // the states of memberlist cluster are inconsistent at a moment. But all agents should get the same schedule results
 // and correct IP assignment when their caches converge.
+//
+// When a stabilization window is configured, an Egress IP is kept on the Node it is assigned to according to the Egress
+// status during the window following the assignment, as long as this Node is still alive, selected by the
+// ExternalIPPool and has enough capacity. This prevents Egress IPs from moving back and forth when many agents restart
+// at the same time, e.g. during upgrades. Egress IPs are moved to the Nodes determined by the consistent hash map once
+// the window has expired. As the decision only depends on the Egress status and on the time, all agents make the same
+// decision, as long as their clocks are synchronized.
 func (s *egressIPScheduler) schedule() {
 	var egressesToUpdate []string
 	newResults := map[string]*scheduleResult{}
 	nodeToIPs := map[string]sets.Set[string]{}
+	now := s.clock.Now()
+	// nextWindowExpiry is how long until the first stabilization window of the kept Egress IPs expires.
+	var nextWindowExpiry time.Duration
 	egresses, _ := s.egressLister.List(labels.Everything())
 	// Sort Egresses by creation timestamp to make the result deterministic and prioritize objected created earlier
 	// when the total capacity is insufficient.
@@ -364,6 +404,23 @@ func (s *egressIPScheduler) schedule() {
 			newResults[egress.Name] = &scheduleResult{err: err}
 			continue
 		}
+		if s.stabilizationWindow > 0 {
+			prevNode, assignedTime := getPreviousAssignment(egress)
+			remainingWindow := assignedTime.Add(s.stabilizationWindow).Sub(now)
+			if prevNode != "" && prevNode != node && remainingWindow > 0 {
+				prevNodeFilter := func(node string) bool {
+					return node == prevNode
+				}
+				// The previous Node is kept only if it's still eligible for the Egress IP.
+				if selectedNode, err := s.cluster.SelectNodeForIP(egress.Spec.EgressIP, egress.Spec.ExternalIPPool, prevNodeFilter, maxEgressIPsFilter); err == nil && selectedNode == prevNode {
+					klog.V(2).InfoS("Keeping Egress IP on its previous Node during stabilization window", "egress", klog.KObj(egress), "node", prevNode, "selectedNode", node)
+					node = prevNode
+					if nextWindowExpiry == 0 || remainingWindow < nextWindowExpiry {
+						nextWindowExpiry = remainingWindow
+					}
+				}
+			}
+		}
 		result := &scheduleResult{
 			ip:   egress.Spec.EgressIP,
 			node: node,
@@ -405,5 +462,11 @@ func (s *egressIPScheduler) schedule() {
 		}
 	}
 
+	// Reschedule when a stabilization window expires, so that the Egress IPs kept on their previous Nodes are moved to
+	// the Nodes determined by the consistent hash map.
+	if nextWindowExpiry > 0 {
+		s.queue.AddAfter(workItem, nextWindowExpiry)
+	}
+
 	s.scheduledOnce.Store(true)
 }
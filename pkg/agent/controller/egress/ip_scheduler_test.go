@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
+	clocktesting "k8s.io/utils/clock/testing"
 
 	"antrea.io/antrea/pkg/agent/consistenthash"
 	"antrea.io/antrea/pkg/agent/memberlist"
@@ -197,7 +198,7 @@ func TestSchedule(t *testing.T) {
 			informerFactory := informers.NewSharedInformerFactory(clientset, 0)
 			nodeInformer := informerFactory.Core().V1().Nodes()
 
-			s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, tt.maxEgressIPsPerNode)
+			s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, tt.maxEgressIPsPerNode, 0)
 			s.nodeToMaxEgressIPs = tt.nodeToMaxEgressIPs
 			stopCh := make(chan struct{})
 			defer close(stopCh)
@@ -212,6 +213,91 @@ func TestSchedule(t *testing.T) {
 	}
 }
 
+func TestScheduleWithStabilizationWindow(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	assignedStatus := func(ip, node string, assignedTime time.Time) crdv1b1.EgressStatus {
+		return crdv1b1.EgressStatus{
+			EgressIP:   ip,
+			EgressNode: node,
+			Conditions: []crdv1b1.EgressCondition{
+				{Type: crdv1b1.IPAssigned, Status: corev1.ConditionTrue, LastTransitionTime: metav1.NewTime(assignedTime)},
+			},
+		}
+	}
+	egresses := []runtime.Object{
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA", CreationTimestamp: metav1.NewTime(time.Unix(1, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.1", ExternalIPPool: "pool1"},
+			// The assignment differs from the consistent hash result (node1) and was made 30s ago.
+			Status: assignedStatus("1.1.1.1", "node2", fakeClock.Now().Add(-30*time.Second)),
+		},
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB", CreationTimestamp: metav1.NewTime(time.Unix(2, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.11", ExternalIPPool: "pool1"},
+			// The assigned Node is no longer part of the cluster.
+			Status: assignedStatus("1.1.1.11", "node3", fakeClock.Now()),
+		},
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressC", UID: "uidC", CreationTimestamp: metav1.NewTime(time.Unix(3, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.21", ExternalIPPool: "pool1"},
+			// The assignment is for a different Egress IP.
+			Status: assignedStatus("1.1.1.20", "node2", fakeClock.Now()),
+		},
+		&crdv1b1.Egress{
+			ObjectMeta: metav1.ObjectMeta{Name: "egressD", UID: "uidD", CreationTimestamp: metav1.NewTime(time.Unix(4, 0))},
+			Spec:       crdv1b1.EgressSpec{EgressIP: "1.1.1.31", ExternalIPPool: "pool1"},
+			// The status doesn't say when the Egress IP was assigned.
+			Status: crdv1b1.EgressStatus{EgressIP: "1.1.1.31", EgressNode: "node2"},
+		},
+	}
+	fakeCluster := newFakeMemberlistCluster([]string{"node1", "node2"})
+	crdClient := fakeversioned.NewSimpleClientset(egresses...)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 0)
+	egressInformer := crdInformerFactory.Crd().V1beta1().Egresses()
+	clientset := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+	nodeInformer := informerFactory.Core().V1().Nodes()
+
+	s := newEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, 3, time.Minute, fakeClock)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	crdInformerFactory.Start(stopCh)
+	informerFactory.Start(stopCh)
+	crdInformerFactory.WaitForCacheSync(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	// During the window following the assignment, the assigned Node is kept if it's still eligible.
+	s.schedule()
+	assertScheduleResult(t, s, "egressA", "1.1.1.1", "node2", true)
+	assertScheduleResult(t, s, "egressB", "1.1.1.11", "node2", true)
+	assertScheduleResult(t, s, "egressC", "1.1.1.21", "node1", true)
+	assertScheduleResult(t, s, "egressD", "1.1.1.31", "node1", true)
+
+	// The result doesn't depend on the previous schedule results of the agent: a restarted agent makes the same
+	// decision.
+	restarted := newEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, 3, time.Minute, fakeClock)
+	restarted.schedule()
+	assert.Equal(t, s.scheduleResults, restarted.scheduleResults)
+
+	// When the assigned Node leaves, the Egress IP is moved immediately.
+	fakeCluster.updateNodes([]string{"node1"})
+	s.schedule()
+	assertScheduleResult(t, s, "egressA", "1.1.1.1", "node1", true)
+	fakeCluster.updateNodes([]string{"node1", "node2"})
+	s.schedule()
+	assertScheduleResult(t, s, "egressA", "1.1.1.1", "node2", true)
+
+	// Once the window following the assignment has expired, the consistent hash result is used.
+	fakeClock.Step(29 * time.Second)
+	s.schedule()
+	assertScheduleResult(t, s, "egressA", "1.1.1.1", "node2", true)
+	fakeClock.Step(time.Second)
+	s.schedule()
+	assertScheduleResult(t, s, "egressA", "1.1.1.1", "node1", true)
+	assertScheduleResult(t, s, "egressB", "1.1.1.11", "node2", true)
+	assertScheduleResult(t, s, "egressC", "1.1.1.21", "node1", true)
+}
+
 func BenchmarkSchedule(b *testing.B) {
 	var egresses []runtime.Object
 	for i := 0; i < 1000; i++ {
@@ -232,7 +318,7 @@ func BenchmarkSchedule(b *testing.B) {
 	informerFactory := informers.NewSharedInformerFactory(clientset, 0)
 	nodeInformer := informerFactory.Core().V1().Nodes()
 
-	s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, 10)
+	s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, 10, 0)
 	stopCh := make(chan struct{})
 	defer close(stopCh)
 	crdInformerFactory.Start(stopCh)
@@ -282,7 +368,7 @@ func TestRun(t *testing.T) {
 	informerFactory := informers.NewSharedInformerFactory(clientset, 0)
 	nodeInformer := informerFactory.Core().V1().Nodes()
 
-	s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, 2)
+	s := NewEgressIPScheduler(fakeCluster, egressInformer, nodeInformer, 2, 0)
 	egressUpdates := make(chan string, 10)
 	s.AddEventHandler(func(egress string) {
 		egressUpdates <- egress
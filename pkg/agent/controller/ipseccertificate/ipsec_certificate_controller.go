@@ -39,6 +39,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 
+	"antrea.io/antrea/pkg/agent/ipsec"
 	antreaapis "antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 )
@@ -62,6 +63,9 @@ const (
 	// certificateWaitTimeout controls the amount of time we wait for certificate approval in
 	// one iteration.
 	certificateWaitTimeout = 15 * time.Minute
+	// certificateFilesCheckInterval is the interval at which the certificate files are checked when they are loaded by
+	// ipsecClient, as the CA certificate can be rotated in place.
+	certificateFilesCheckInterval = time.Minute
 )
 
 var defaultCertificatesPath = "/var/run/openvswitch"
@@ -70,8 +74,11 @@ var defaultCertificatesPath = "/var/run/openvswitch"
 type Controller struct {
 	kubeClient      clientset.Interface
 	ovsBridgeClient ovsconfig.OVSBridgeClient
-	nodeName        string
-	queue           workqueue.TypedRateLimitingInterface[string]
+	// ipsecClient configures the certificates for the IKE daemon on the platforms where the OVS IPsec monitor is
+	// not available, i.e. Windows. It is nil on Linux.
+	ipsecClient ipsec.Interface
+	nodeName    string
+	queue       workqueue.TypedRateLimitingInterface[string]
 
 	rotateCertificate  func() (*certificateKeyPair, error)
 	certificateKeyPair *certificateKeyPair
@@ -98,17 +105,20 @@ var _ Manager = (*Controller)(nil)
 func NewIPSecCertificateController(
 	kubeClient clientset.Interface,
 	ovsBridgeClient ovsconfig.OVSBridgeClient,
+	ipsecClient ipsec.Interface,
 	nodeName string,
 ) *Controller {
-	return newIPSecCertificateControllerWithCustomClock(kubeClient, ovsBridgeClient, nodeName, clock.RealClock{})
+	return newIPSecCertificateControllerWithCustomClock(kubeClient, ovsBridgeClient, ipsecClient, nodeName, clock.RealClock{})
 }
 
 func newIPSecCertificateControllerWithCustomClock(kubeClient clientset.Interface,
 	ovsBridgeClient ovsconfig.OVSBridgeClient,
+	ipsecClient ipsec.Interface,
 	nodeName string, clock clock.WithTicker) *Controller {
 	controller := &Controller{
 		kubeClient:      kubeClient,
 		ovsBridgeClient: ovsBridgeClient,
+		ipsecClient:     ipsecClient,
 		nodeName:        nodeName,
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
@@ -117,8 +127,10 @@ func newIPSecCertificateControllerWithCustomClock(kubeClient clientset.Interface
 				Clock: clock,
 			},
 		),
-		clock:                 clock,
-		caPath:                filepath.Join(defaultCertificatesPath, "ca", "ca.crt"),
+		clock: clock,
+		// On Windows, the volumes of the hostProcess container are mounted under the path given by the
+		// CONTAINER_SANDBOX_MOUNT_POINT environment variable, which is not set on Linux.
+		caPath:                filepath.Join(os.Getenv("CONTAINER_SANDBOX_MOUNT_POINT"), defaultCertificatesPath, "ca", "ca.crt"),
 		certificateFolderPath: defaultCertificatesPath,
 	}
 	controller.rotateCertificate = controller.newCertificateKeyPair
@@ -299,12 +311,21 @@ func (c *Controller) syncConfigurations() error {
 	}
 	// Re-queue after the interval to renew the certificate.
 	addAfter := deadline.Sub(c.clock.Now())
+	if c.ipsecClient != nil && addAfter > certificateFilesCheckInterval {
+		addAfter = certificateFilesCheckInterval
+	}
 	c.queue.AddAfter(workerItemKey, addAfter)
 	// Sync OVS bridge configurations.
 	if err := c.syncOVSConfigurations(c.certificateKeyPair.certificatePath,
 		c.certificateKeyPair.privateKeyPath, caCertificatePath); err != nil {
 		return err
 	}
+	if c.ipsecClient != nil {
+		if err := c.ipsecClient.UpdateCertificate(c.certificateKeyPair.certificatePath,
+			c.certificateKeyPair.privateKeyPath, c.caPath); err != nil {
+			return fmt.Errorf("failed to update IPsec certificates: %w", err)
+		}
+	}
 	atomic.StoreUint32(&c.syncedOnce, 1)
 	return nil
 }
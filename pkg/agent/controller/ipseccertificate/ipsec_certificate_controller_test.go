@@ -46,6 +46,7 @@ import (
 	"k8s.io/utils/clock"
 	testingclock "k8s.io/utils/clock/testing"
 
+	ipsectest "antrea.io/antrea/pkg/agent/ipsec/testing"
 	ovsconfigtest "antrea.io/antrea/pkg/ovs/ovsconfig/testing"
 )
 
@@ -133,7 +134,7 @@ func newFakeController(t *testing.T, clock clock.WithTicker) *fakeController {
 	err = certutil.WriteCert(filepath.Join(defaultCertificatesPath, "ca", "ca.crt"), caData)
 	require.NoError(t, err)
 
-	c := newIPSecCertificateControllerWithCustomClock(fakeClient, mockOVSBridgeClient, fakeNodeName, clock)
+	c := newIPSecCertificateControllerWithCustomClock(fakeClient, mockOVSBridgeClient, nil, fakeNodeName, clock)
 	return &fakeController{
 		Controller:       c,
 		mockController:   mockController,
@@ -245,6 +246,10 @@ func TestController_syncConfigurations(t *testing.T) {
 			"ca_cert":     caCertificatePath,
 		}
 		fakeController.mockBridgeClient.EXPECT().UpdateOVSOtherConfig(expectedOVSConfig)
+		// The certificates should also be configured for the IKE daemon on Windows.
+		mockIPsecClient := ipsectest.NewMockInterface(fakeController.mockController)
+		fakeController.ipsecClient = mockIPsecClient
+		mockIPsecClient.EXPECT().UpdateCertificate(newCertDst, newKeyDst, fakeController.caPath).Times(2)
 		// syncConfigurations should not block and get signed certificates from CSR successfully.
 		err = fakeController.syncConfigurations()
 		assert.NoError(t, err)
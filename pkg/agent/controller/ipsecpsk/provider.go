@@ -0,0 +1,179 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ipsecpsk provides the pre-shared keys (PSKs) used to authenticate the IPsec tunnels between pairs of Nodes,
+// from an external secret store.
+//
+// The secret store holds a set of named keys. The PSK of the tunnel between Node A and Node B is the value of the first
+// of the following keys which exists:
+//   - "<A>_<B>": the PSK of the Node pair, with the Node names sorted.
+//   - "zone.<Z>" if both Nodes are in zone Z, or "zone.<Z1>_<Z2>" if they are in zones Z1 and Z2, with the zone names
+//     sorted. The zone of a Node is the value of its zone label.
+//   - "default": the PSK of all the other Node pairs.
+//
+// As both Nodes of a pair compute the same key name, they always use the same PSK for their tunnel.
+package ipsecpsk
+
+import (
+	"fmt"
+	"maps"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	clientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+)
+
+const (
+	// SecretName is the name of the Secret storing the PSKs in the Antrea Namespace, when the PSK source is "secret".
+	// Each entry in the Secret is a named key, as described in the package documentation.
+	SecretName = "antrea-ipsec-psks" // #nosec G101
+
+	// DefaultZoneLabel is the default label of Nodes identifying their zone.
+	DefaultZoneLabel = "topology.kubernetes.io/zone"
+
+	defaultKeyName   = "default"
+	zoneKeyPrefix    = "zone."
+	pairKeySeparator = "_"
+)
+
+// Provider provides the PSKs used to authenticate the IPsec tunnels between the local Node and its peers.
+type Provider interface {
+	// GetPSK returns the PSK of the IPsec tunnel between the local Node and the given peer Node.
+	GetPSK(peerNodeName string) (string, error)
+	// AddEventHandler registers a handler which is called every time the PSKs change. It must be called before Run.
+	AddEventHandler(handler func())
+	// HasSynced returns whether the PSKs have been read from the secret store at least once.
+	HasSynced() bool
+	Run(stopCh <-chan struct{})
+}
+
+// Config is the configuration of the Provider.
+type Config struct {
+	// Source is the secret store from which the PSKs are read. It must be "secret" or "vault".
+	Source config.IPsecPSKSource
+	// ZoneLabel is the label of Nodes identifying their zone.
+	ZoneLabel string
+	// Vault is the configuration of the Vault server when Source is "vault".
+	Vault VaultConfig
+}
+
+// NewProvider returns a Provider reading the PSKs from the secret store specified in the config.
+func NewProvider(pskConfig Config, kubeClient clientset.Interface, nodeLister corelisters.NodeLister, nodeName string) (Provider, error) {
+	store := newKeyStore(nodeName, pskConfig.ZoneLabel, nodeLister)
+	switch pskConfig.Source {
+	case config.IPsecPSKSourceSecret:
+		return newSecretProvider(store, kubeClient), nil
+	case config.IPsecPSKSourceVault:
+		return newVaultProvider(store, pskConfig.Vault)
+	}
+	return nil, fmt.Errorf("unsupported IPsec PSK source %q", pskConfig.Source)
+}
+
+// keyStore stores the named keys read from a secret store, and resolves the PSK of Node pairs from them. It is
+// shared by all the Provider implementations.
+type keyStore struct {
+	nodeName   string
+	zoneLabel  string
+	nodeLister corelisters.NodeLister
+
+	mutex    sync.RWMutex
+	keys     map[string]string
+	handlers []func()
+}
+
+func newKeyStore(nodeName, zoneLabel string, nodeLister corelisters.NodeLister) *keyStore {
+	if zoneLabel == "" {
+		zoneLabel = DefaultZoneLabel
+	}
+	return &keyStore{
+		nodeName:   nodeName,
+		zoneLabel:  zoneLabel,
+		nodeLister: nodeLister,
+	}
+}
+
+func (s *keyStore) AddEventHandler(handler func()) {
+	s.handlers = append(s.handlers, handler)
+}
+
+// setKeys replaces the stored keys, and notifies the handlers if they have changed.
+func (s *keyStore) setKeys(keys map[string]string, source string) {
+	s.mutex.Lock()
+	changed := s.keys == nil || !maps.Equal(s.keys, keys)
+	s.keys = keys
+	s.mutex.Unlock()
+	if !changed {
+		return
+	}
+	klog.InfoS("IPsec PSKs changed", "source", source, "keys", len(keys))
+	for _, handler := range s.handlers {
+		handler()
+	}
+}
+
+func (s *keyStore) GetPSK(peerNodeName string) (string, error) {
+	localZone, err := s.getNodeZone(s.nodeName)
+	if err != nil {
+		return "", err
+	}
+	peerZone, err := s.getNodeZone(peerNodeName)
+	if err != nil {
+		return "", err
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	for _, name := range keyNames(s.nodeName, localZone, peerNodeName, peerZone) {
+		if psk := s.keys[name]; psk != "" {
+			return psk, nil
+		}
+	}
+	return "", fmt.Errorf("no IPsec PSK found for Nodes %s and %s", s.nodeName, peerNodeName)
+}
+
+func (s *keyStore) getNodeZone(nodeName string) (string, error) {
+	node, err := s.nodeLister.Get(nodeName)
+	if err != nil {
+		// A deleted Node has no zone, its PSK can still be resolved from the Node pair and default keys.
+		if errors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error when getting Node %s: %w", nodeName, err)
+	}
+	return node.Labels[s.zoneLabel], nil
+}
+
+// keyNames returns the names of the keys which may store the PSK of a Node pair, from the most specific to the least
+// specific one. The result doesn't depend on the order of the Nodes.
+func keyNames(node1, zone1, node2, zone2 string) []string {
+	names := []string{pairKeyName(node1, node2)}
+	if zone1 != "" && zone2 != "" {
+		if zone1 == zone2 {
+			names = append(names, zoneKeyPrefix+zone1)
+		} else {
+			names = append(names, zoneKeyPrefix+pairKeyName(zone1, zone2))
+		}
+	}
+	return append(names, defaultKeyName)
+}
+
+func pairKeyName(name1, name2 string) string {
+	if name1 > name2 {
+		name1, name2 = name2, name1
+	}
+	return name1 + pairKeySeparator + name2
+}
@@ -0,0 +1,222 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipsecpsk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/util/env"
+)
+
+func newNode(name, zone string) *corev1.Node {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if zone != "" {
+		node.Labels = map[string]string{DefaultZoneLabel: zone}
+	}
+	return node
+}
+
+func newNodeLister(t *testing.T, nodes ...*corev1.Node) corelisters.NodeLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, node := range nodes {
+		require.NoError(t, indexer.Add(node))
+	}
+	return corelisters.NewNodeLister(indexer)
+}
+
+func TestGetPSK(t *testing.T) {
+	nodeLister := newNodeLister(t,
+		newNode("node-a", "zone-1"),
+		newNode("node-b", "zone-1"),
+		newNode("node-c", "zone-2"),
+		newNode("node-d", ""),
+	)
+	tests := []struct {
+		name        string
+		keys        map[string]string
+		peerNode    string
+		expectedPSK string
+		expectedErr string
+	}{
+		{
+			name:        "Node pair key",
+			keys:        map[string]string{"node-a_node-b": "pair", "zone.zone-1": "zone", "default": "default"},
+			peerNode:    "node-b",
+			expectedPSK: "pair",
+		},
+		{
+			name:        "same zone key",
+			keys:        map[string]string{"zone.zone-1": "zone", "default": "default"},
+			peerNode:    "node-b",
+			expectedPSK: "zone",
+		},
+		{
+			name:        "zone pair key",
+			keys:        map[string]string{"zone.zone-1": "zone", "zone.zone-1_zone-2": "zones", "default": "default"},
+			peerNode:    "node-c",
+			expectedPSK: "zones",
+		},
+		{
+			name:        "Node without zone",
+			keys:        map[string]string{"zone.zone-1": "zone", "default": "default"},
+			peerNode:    "node-d",
+			expectedPSK: "default",
+		},
+		{
+			name:        "deleted Node",
+			keys:        map[string]string{"node-a_node-e": "pair", "default": "default"},
+			peerNode:    "node-e",
+			expectedPSK: "pair",
+		},
+		{
+			name:        "no key",
+			keys:        map[string]string{"zone.zone-1": "zone"},
+			peerNode:    "node-c",
+			expectedErr: "no IPsec PSK found for Nodes node-a and node-c",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newKeyStore("node-a", "", nodeLister)
+			store.setKeys(tt.keys, "test")
+			psk, err := store.GetPSK(tt.peerNode)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPSK, psk)
+			}
+		})
+	}
+}
+
+func TestKeyNamesSymmetric(t *testing.T) {
+	assert.Equal(t, keyNames("node-a", "zone-1", "node-b", "zone-2"), keyNames("node-b", "zone-2", "node-a", "zone-1"))
+	assert.Equal(t, []string{"node-a_node-b", "zone.zone-1_zone-2", "default"}, keyNames("node-b", "zone-2", "node-a", "zone-1"))
+}
+
+func TestSecretProvider(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: SecretName, Namespace: env.GetAntreaNamespace()},
+		Data:       map[string][]byte{"default": []byte("psk1")},
+	}
+	client := fake.NewSimpleClientset(newNode("node-a", ""), newNode("node-b", ""), secret)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	nodeInformer := informerFactory.Core().V1().Nodes()
+	provider, err := NewProvider(Config{Source: config.IPsecPSKSourceSecret}, client, nodeInformer.Lister(), "node-a")
+	require.NoError(t, err)
+	changes := make(chan struct{}, 10)
+	provider.AddEventHandler(func() {
+		changes <- struct{}{}
+	})
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+	go provider.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, provider.HasSynced))
+	<-changes
+	psk, err := provider.GetPSK("node-b")
+	require.NoError(t, err)
+	assert.Equal(t, "psk1", psk)
+
+	secret.Data["default"] = []byte("psk2")
+	_, err = client.CoreV1().Secrets(secret.Namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	select {
+	case <-changes:
+	case <-time.After(5 * time.Second):
+		t.Fatal("PSK change was not notified")
+	}
+	psk, err = provider.GetPSK("node-b")
+	require.NoError(t, err)
+	assert.Equal(t, "psk2", psk)
+}
+
+func TestVaultProvider(t *testing.T) {
+	var response string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/secret/data/antrea/ipsec" || r.Header.Get(vaultTokenHeader) != "token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token\n"), 0600))
+
+	nodeLister := newNodeLister(t, newNode("node-a", "zone-1"), newNode("node-b", "zone-1"))
+	provider, err := NewProvider(Config{
+		Source: config.IPsecPSKSourceVault,
+		Vault: VaultConfig{
+			Address:         server.URL,
+			MountPath:       "secret",
+			Path:            "antrea/ipsec",
+			TokenFile:       tokenFile,
+			RefreshInterval: time.Minute,
+		},
+	}, nil, nodeLister, "node-a")
+	require.NoError(t, err)
+	p := provider.(*vaultProvider)
+	changes := 0
+	p.AddEventHandler(func() {
+		changes++
+	})
+
+	response = `{"data":{"data":{"zone.zone-1":"psk1","invalid":1},"metadata":{"version":1}}}`
+	p.refresh(context.TODO())
+	assert.True(t, p.HasSynced())
+	assert.Equal(t, 1, changes)
+	psk, err := p.GetPSK("node-b")
+	require.NoError(t, err)
+	assert.Equal(t, "psk1", psk)
+
+	// Unchanged PSKs are not notified.
+	p.refresh(context.TODO())
+	assert.Equal(t, 1, changes)
+
+	response = `{"data":{"data":{"zone.zone-1":"psk2"},"metadata":{"version":2}}}`
+	p.refresh(context.TODO())
+	assert.Equal(t, 2, changes)
+	psk, err = p.GetPSK("node-b")
+	require.NoError(t, err)
+	assert.Equal(t, "psk2", psk)
+
+	// The previous PSKs are kept when they cannot be read.
+	require.NoError(t, os.WriteFile(tokenFile, []byte("expired"), 0600))
+	p.refresh(context.TODO())
+	assert.Equal(t, 2, changes)
+	psk, err = p.GetPSK("node-b")
+	require.NoError(t, err)
+	assert.Equal(t, "psk2", psk)
+}
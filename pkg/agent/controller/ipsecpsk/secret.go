@@ -0,0 +1,90 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipsecpsk
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/util/env"
+)
+
+const secretSourceName = "secret"
+
+// secretProvider reads the PSKs from the antrea-ipsec-psks Secret in the Antrea Namespace. Only this Secret is watched.
+type secretProvider struct {
+	*keyStore
+	secretInformer cache.SharedIndexInformer
+	hasSynced      cache.InformerSynced
+}
+
+func newSecretProvider(store *keyStore, kubeClient clientset.Interface) *secretProvider {
+	p := &secretProvider{keyStore: store}
+	p.secretInformer = coreinformers.NewFilteredSecretInformer(kubeClient,
+		env.GetAntreaNamespace(),
+		0,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+		func(options *metav1.ListOptions) {
+			options.FieldSelector = fields.OneTermEqualSelector("metadata.name", SecretName).String()
+		})
+	registration, _ := p.secretInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    p.addSecret,
+		UpdateFunc: p.updateSecret,
+		DeleteFunc: p.deleteSecret,
+	})
+	// The PSKs are synced once the event handlers have processed the initial list, which may be empty if the Secret
+	// doesn't exist yet.
+	p.hasSynced = registration.HasSynced
+	return p
+}
+
+func (p *secretProvider) addSecret(obj interface{}) {
+	secret := obj.(*corev1.Secret)
+	klog.V(2).InfoS("Processing Secret ADD event", "Secret", klog.KObj(secret))
+	p.setKeys(secretKeys(secret), secretSourceName)
+}
+
+func (p *secretProvider) updateSecret(_, obj interface{}) {
+	secret := obj.(*corev1.Secret)
+	klog.V(2).InfoS("Processing Secret UPDATE event", "Secret", klog.KObj(secret))
+	p.setKeys(secretKeys(secret), secretSourceName)
+}
+
+func (p *secretProvider) deleteSecret(obj interface{}) {
+	klog.V(2).InfoS("Processing Secret DELETE event", "Secret", SecretName)
+	p.setKeys(map[string]string{}, secretSourceName)
+}
+
+func secretKeys(secret *corev1.Secret) map[string]string {
+	keys := make(map[string]string, len(secret.Data))
+	for name, value := range secret.Data {
+		keys[name] = string(value)
+	}
+	return keys
+}
+
+func (p *secretProvider) HasSynced() bool {
+	return p.hasSynced()
+}
+
+func (p *secretProvider) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting IPsec PSK provider", "source", secretSourceName, "secret", SecretName)
+	p.secretInformer.Run(stopCh)
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipsecpsk
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	vaultSourceName = "vault"
+
+	vaultTokenHeader = "X-Vault-Token"
+	// vaultRequestTimeout is the timeout of the requests to the Vault server.
+	vaultRequestTimeout = 10 * time.Second
+)
+
+// VaultConfig is the configuration of the Vault server storing the PSKs.
+type VaultConfig struct {
+	// Address is the address of the Vault server.
+	Address string
+	// MountPath is the mount path of the KV version 2 secrets engine.
+	MountPath string
+	// Path is the path of the secret storing the PSKs, relative to MountPath.
+	Path string
+	// TokenFile is the file containing the token used to authenticate to the Vault server.
+	TokenFile string
+	// CACertFile is the file containing the CA certificate used to verify the Vault server. If empty, the system CAs
+	// are used.
+	CACertFile string
+	// RefreshInterval is the interval at which the PSKs are read from the Vault server.
+	RefreshInterval time.Duration
+}
+
+// vaultProvider reads the PSKs from a secret of a KV version 2 secrets engine of a Vault server. As Vault doesn't
+// notify clients of changes, the secret is read periodically.
+type vaultProvider struct {
+	*keyStore
+	config    VaultConfig
+	secretURL string
+	client    *http.Client
+	synced    atomic.Bool
+}
+
+// vaultKVv2Response is the response of the Vault server to a read request of a KV version 2 secret. Only the fields
+// used by the provider are decoded.
+type vaultKVv2Response struct {
+	Data struct {
+		Data map[string]interface{} `json:"data"`
+	} `json:"data"`
+}
+
+func newVaultProvider(store *keyStore, config VaultConfig) (*vaultProvider, error) {
+	if _, err := url.Parse(config.Address); err != nil {
+		return nil, fmt.Errorf("invalid Vault address %q: %w", config.Address, err)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.CACertFile != "" {
+		caCert, err := os.ReadFile(config.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("error when reading Vault CA certificate: %w", err)
+		}
+		caPool := x509.NewCertPool()
+		if !caPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid certificate found in Vault CA certificate file %s", config.CACertFile)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    caPool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return &vaultProvider{
+		keyStore:  store,
+		config:    config,
+		secretURL: fmt.Sprintf("%s/v1/%s/data/%s", strings.TrimSuffix(config.Address, "/"), strings.Trim(config.MountPath, "/"), strings.Trim(config.Path, "/")),
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   vaultRequestTimeout,
+		},
+	}, nil
+}
+
+func (p *vaultProvider) HasSynced() bool {
+	return p.synced.Load()
+}
+
+func (p *vaultProvider) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting IPsec PSK provider", "source", vaultSourceName, "address", p.config.Address, "path", p.config.Path)
+	wait.UntilWithContext(wait.ContextForChannel(stopCh), p.refresh, p.config.RefreshInterval)
+}
+
+// refresh reads the PSKs from the Vault server. If it fails, the previously read PSKs are kept, so that the existing
+// IPsec tunnels are not affected by an unavailability of the Vault server.
+func (p *vaultProvider) refresh(ctx context.Context) {
+	keys, err := p.readKeys(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Failed to read IPsec PSKs from Vault", "address", p.config.Address, "path", p.config.Path)
+		return
+	}
+	p.setKeys(keys, vaultSourceName)
+	p.synced.Store(true)
+}
+
+func (p *vaultProvider) readKeys(ctx context.Context) (map[string]string, error) {
+	// The token is read every time, as it may be renewed by an external agent.
+	token, err := os.ReadFile(p.config.TokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("error when reading Vault token: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.secretURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(vaultTokenHeader, strings.TrimSpace(string(token)))
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, fmt.Errorf("unexpected status code %d from Vault: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	var secret vaultKVv2Response
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, fmt.Errorf("error when decoding Vault response: %w", err)
+	}
+	keys := make(map[string]string, len(secret.Data.Data))
+	for name, value := range secret.Data.Data {
+		psk, ok := value.(string)
+		if !ok {
+			klog.InfoS("Ignored IPsec PSK with non-string value in Vault secret", "key", name)
+			continue
+		}
+		keys[name] = psk
+	}
+	return keys, nil
+}
@@ -273,3 +273,16 @@ func (l *l7VlanIDAllocator) query(ruleID string) uint32 {
 	}
 	return 0
 }
+
+// queryRuleID returns the ID of the rule which the VLAN ID is allocated for.
+func (l *l7VlanIDAllocator) queryRuleID(vlanID uint32) (string, bool) {
+	l.RLock()
+	defer l.RUnlock()
+
+	for ruleID, id := range l.ruleIDToVlanID {
+		if id == vlanID {
+			return ruleID, true
+		}
+	}
+	return "", false
+}
@@ -295,12 +295,17 @@ func TestVlanIDAllocator(t *testing.T) {
 
 	vlanID2 := vlanIDAllocator.allocate(ruleID2)
 	assert.Equal(t, vlanID2, vlanIDAllocator.query(ruleID2))
+	ruleID, found := vlanIDAllocator.queryRuleID(vlanID2)
+	assert.True(t, found)
+	assert.Equal(t, ruleID2, ruleID)
 
 	vlanIDAllocator.release(ruleID1)
 	assert.Equal(t, uint32(0), vlanIDAllocator.query(ruleID1))
 
 	vlanIDAllocator.release(ruleID2)
 	assert.Equal(t, uint32(0), vlanIDAllocator.query(ruleID2))
+	_, found = vlanIDAllocator.queryRuleID(vlanID2)
+	assert.False(t, found)
 
 	vlanID3 := vlanIDAllocator.allocate(ruleID3)
 	assert.Equal(t, vlanID3, vlanIDAllocator.query(ruleID3))
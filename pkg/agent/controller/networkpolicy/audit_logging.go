@@ -15,7 +15,9 @@
 package networkpolicy
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
@@ -43,13 +45,29 @@ const (
 	nullPlaceholder        = "<nil>"
 )
 
+const (
+	// AuditLogFormatText is the default audit log format, in which the fields of a log are separated by spaces.
+	AuditLogFormatText = "text"
+	// AuditLogFormatJSON is the audit log format in which each log is a JSON object.
+	AuditLogFormatJSON = "json"
+
+	// AuditLogDestinationFile is the default audit log destination, i.e. the np.log file with rotation.
+	AuditLogDestinationFile = "file"
+	// AuditLogDestinationUnixSocket streams audit logs to a Unix domain socket.
+	AuditLogDestinationUnixSocket = "unixSocket"
+	// AuditLogDestinationSyslog sends audit logs to a syslog endpoint.
+	AuditLogDestinationSyslog = "syslog"
+)
+
 // AuditLogger is used for network policy audit logging.
 // Includes a lumberjack logger and a map used for log deduplication.
 type AuditLogger struct {
 	bufferLength     time.Duration
 	clock            clock.Clock // enable the use of a "virtual" clock for unit tests
 	npLogger         *log.Logger
+	format           string
 	logDeduplication logRecordDedupMap
+	logSampling      logRuleSampling
 }
 
 type AuditLoggerOptions struct {
@@ -57,24 +75,39 @@ type AuditLoggerOptions struct {
 	MaxBackups int
 	MaxAge     int
 	Compress   bool
+	// Format is the format of audit logs, AuditLogFormatText or AuditLogFormatJSON.
+	Format string
+	// SamplingRate means that only 1 out of SamplingRate packets is logged for each rule. 0 and 1 mean that all
+	// packets are logged.
+	SamplingRate int
+	// RuleSamplingRates overrides SamplingRate for the rules with the log labels used as keys.
+	RuleSamplingRates map[string]int
+	// Destination is where audit logs are written to, AuditLogDestinationFile, AuditLogDestinationUnixSocket or
+	// AuditLogDestinationSyslog.
+	Destination string
+	// Address is the path of the Unix domain socket for AuditLogDestinationUnixSocket, or the address of the syslog
+	// endpoint in the "<network>://<address>" form for AuditLogDestinationSyslog.
+	Address string
 }
 
 // logInfo will be set by retrieving info from packetin and register.
 type logInfo struct {
-	tableName    string // name of the table sending packetin
-	npRef        string // Network Policy name reference
-	ruleName     string // Network Policy rule name for Antrea-native policies
-	direction    string // Direction of the Network Policy rule (Ingress / Egress)
-	logLabel     string // Network Policy user-defined log label
-	disposition  string // Allow/Drop of the rule sending packetin
-	ofPriority   string // openflow priority of the flow sending packetin
-	appliedToRef string // namespace and name of the Pod to which the Network Policy is applied
-	srcIP        string // source IP of the traffic logged
-	srcPort      string // source port of the traffic logged
-	destIP       string // destination IP of the traffic logged
-	destPort     string // destination port of the traffic logged
-	pktLength    string // packet length of packetin
-	protocolStr  string // protocol of the traffic logged
+	tableName    string  // name of the table sending packetin
+	npRef        string  // Network Policy name reference
+	ruleName     string  // Network Policy rule name for Antrea-native policies
+	direction    string  // Direction of the Network Policy rule (Ingress / Egress)
+	logLabel     string  // Network Policy user-defined log label
+	disposition  string  // Allow/Drop of the rule sending packetin
+	ofPriority   string  // openflow priority of the flow sending packetin
+	appliedToRef string  // namespace and name of the Pod to which the Network Policy is applied
+	srcIP        string  // source IP of the traffic logged
+	srcPort      string  // source port of the traffic logged
+	destIP       string  // destination IP of the traffic logged
+	destPort     string  // destination port of the traffic logged
+	pktLength    string  // packet length of packetin
+	protocolStr  string  // protocol of the traffic logged
+	samplingRate int     // sampling rate of the rule, only set when packets of the rule are sampled
+	l7           *l7Info // layer 7 metadata of the traffic, only set for logs of the layer 7 engine
 }
 
 // logDedupRecord will be used as 1 sec buffer for log deduplication.
@@ -82,6 +115,7 @@ type logDedupRecord struct {
 	count         int64            // record count of duplicate log
 	initTime      time.Time        // initial time upon receiving packet log
 	bufferTimerCh <-chan time.Time // 1 sec buffer for each log
+	info          *logInfo         // info of the first packet log
 }
 
 // logRecordDedupMap includes a map of log buffers and a r/w mutex for accessing the map.
@@ -90,6 +124,32 @@ type logRecordDedupMap struct {
 	logMap   map[string]*logDedupRecord
 }
 
+// logRuleSampling includes the sampling rates of rules and the number of packets seen for each rule.
+type logRuleSampling struct {
+	defaultRate  int
+	labelRates   map[string]int
+	mutex        sync.Mutex
+	packetCounts map[string]int
+}
+
+// sample returns whether the packet log of the rule should be logged according to the sampling rate of the rule. The
+// first packet of a rule is always logged.
+func (s *logRuleSampling) sample(ob *logInfo) (bool, int) {
+	rate, ok := s.labelRates[ob.logLabel]
+	if !ok {
+		rate = s.defaultRate
+	}
+	if rate <= 1 {
+		return true, 1
+	}
+	ruleKey := strings.Join([]string{ob.npRef, ob.ruleName, ob.direction}, " ")
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	count := s.packetCounts[ruleKey]
+	s.packetCounts[ruleKey] = (count + 1) % rate
+	return count == 0, rate
+}
+
 // getLogKey returns the log record in logDeduplication map by logMsg.
 func (l *AuditLogger) getLogKey(logMsg string) *logDedupRecord {
 	l.logDeduplication.logMutex.Lock()
@@ -109,30 +169,108 @@ func (l *AuditLogger) terminateLogKey(logMsg string) {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	logRecord := l.logDeduplication.logMap[logMsg]
-	if logRecord.count == 1 {
+	l.printLog(logRecord.info, logMsg, logRecord.count, l.clock.Since(logRecord.initTime))
+	delete(l.logDeduplication.logMap, logMsg)
+}
+
+// jsonLogRecord is the audit log in AuditLogFormatJSON format. Placeholders of the text format are omitted.
+type jsonLogRecord struct {
+	Timestamp       string  `json:"timestamp"`
+	TableName       string  `json:"tableName"`
+	PolicyRef       string  `json:"networkPolicy"`
+	RuleName        string  `json:"ruleName,omitempty"`
+	Direction       string  `json:"direction"`
+	Disposition     string  `json:"action"`
+	OFPriority      string  `json:"ofPriority,omitempty"`
+	AppliedToRef    string  `json:"appliedTo,omitempty"`
+	SourceIP        string  `json:"sourceIP"`
+	SourcePort      string  `json:"sourcePort,omitempty"`
+	DestinationIP   string  `json:"destinationIP"`
+	DestinationPort string  `json:"destinationPort,omitempty"`
+	Protocol        string  `json:"protocol"`
+	PacketLength    string  `json:"packetLength,omitempty"`
+	LogLabel        string  `json:"logLabel,omitempty"`
+	PacketCount     int64   `json:"packetCount"`
+	Duration        float64 `json:"durationSeconds,omitempty"`
+	SamplingRate    int     `json:"samplingRate,omitempty"`
+	L7Protocol      string  `json:"l7Protocol,omitempty"`
+	HTTPHost        string  `json:"httpHost,omitempty"`
+	HTTPMethod      string  `json:"httpMethod,omitempty"`
+	HTTPPath        string  `json:"httpPath,omitempty"`
+	TLSSNI          string  `json:"tlsSNI,omitempty"`
+}
+
+func omitPlaceholder(s string) string {
+	if s == nullPlaceholder {
+		return ""
+	}
+	return s
+}
+
+func buildJSONLogMsg(ob *logInfo, timestamp time.Time, count int64, duration time.Duration) string {
+	record := jsonLogRecord{
+		Timestamp:       timestamp.Format(time.RFC3339Nano),
+		TableName:       ob.tableName,
+		PolicyRef:       ob.npRef,
+		RuleName:        omitPlaceholder(ob.ruleName),
+		Direction:       ob.direction,
+		Disposition:     ob.disposition,
+		OFPriority:      omitPlaceholder(ob.ofPriority),
+		AppliedToRef:    omitPlaceholder(ob.appliedToRef),
+		SourceIP:        ob.srcIP,
+		SourcePort:      omitPlaceholder(ob.srcPort),
+		DestinationIP:   ob.destIP,
+		DestinationPort: omitPlaceholder(ob.destPort),
+		Protocol:        ob.protocolStr,
+		PacketLength:    omitPlaceholder(ob.pktLength),
+		LogLabel:        omitPlaceholder(ob.logLabel),
+		PacketCount:     count,
+		SamplingRate:    ob.samplingRate,
+	}
+	if ob.l7 != nil {
+		record.L7Protocol = ob.l7.protocol
+		record.HTTPHost = ob.l7.httpHost
+		record.HTTPMethod = ob.l7.httpMethod
+		record.HTTPPath = ob.l7.httpPath
+		record.TLSSNI = ob.l7.tlsSNI
+	}
+	if count > 1 {
+		record.Duration = duration.Seconds()
+	}
+	// Marshalling a struct of strings and numbers never fails.
+	data, _ := json.Marshal(record)
+	return string(data)
+}
+
+// printLog writes the log of ob in the configured format. count is the number of duplicate packets within duration.
+func (l *AuditLogger) printLog(ob *logInfo, logMsg string, count int64, duration time.Duration) {
+	if l.format == AuditLogFormatJSON {
+		l.npLogger.Print(buildJSONLogMsg(ob, l.clock.Now(), count, duration))
+		return
+	}
+	if count == 1 {
 		l.npLogger.Print(logMsg)
 	} else {
-		l.npLogger.Printf("%s [%d packets in %s]", logMsg, logRecord.count, time.Since(logRecord.initTime))
+		l.npLogger.Printf("%s [%d packets in %s]", logMsg, count, duration)
 	}
-	delete(l.logDeduplication.logMap, logMsg)
 }
 
 // updateLogKey initiates record or increases the count in logDeduplication corresponding to given logMsg.
-func (l *AuditLogger) updateLogKey(logMsg string, bufferLength time.Duration) bool {
+func (l *AuditLogger) updateLogKey(logMsg string, ob *logInfo, bufferLength time.Duration) bool {
 	l.logDeduplication.logMutex.Lock()
 	defer l.logDeduplication.logMutex.Unlock()
 	_, exists := l.logDeduplication.logMap[logMsg]
 	if exists {
 		l.logDeduplication.logMap[logMsg].count++
 	} else {
-		record := logDedupRecord{1, l.clock.Now(), l.clock.After(bufferLength)}
+		record := logDedupRecord{1, l.clock.Now(), l.clock.After(bufferLength), ob}
 		l.logDeduplication.logMap[logMsg] = &record
 	}
 	return exists
 }
 
 func buildLogMsg(ob *logInfo) string {
-	return strings.Join([]string{
+	msg := strings.Join([]string{
 		ob.tableName,
 		ob.npRef,
 		ob.ruleName,
@@ -148,17 +286,31 @@ func buildLogMsg(ob *logInfo) string {
 		ob.pktLength,
 		ob.logLabel,
 	}, " ")
+	if ob.l7 != nil {
+		msg += " " + ob.l7.String()
+	}
+	return msg
 }
 
 // LogDedupPacket logs information in ob based on disposition and duplication conditions.
 func (l *AuditLogger) LogDedupPacket(ob *logInfo) {
+	// Skip the packet log if it is not sampled.
+	if l.logSampling.packetCounts != nil {
+		sampled, rate := l.logSampling.sample(ob)
+		if !sampled {
+			return
+		}
+		if rate > 1 {
+			ob.samplingRate = rate
+		}
+	}
 	// Deduplicate non-Allow packet log.
 	logMsg := buildLogMsg(ob)
 	if ob.disposition == openflow.DispositionToString[openflow.DispositionAllow] {
-		l.npLogger.Print(logMsg)
+		l.printLog(ob, logMsg, 1, 0)
 	} else {
 		// Increase count if duplicated within 1 sec, create buffer otherwise.
-		exists := l.updateLogKey(logMsg, l.bufferLength)
+		exists := l.updateLogKey(logMsg, ob, l.bufferLength)
 		if !exists {
 			// Go routine for logging when buffer timer stops.
 			go l.logAfterTimer(logMsg)
@@ -178,21 +330,46 @@ func newAuditLogger(options *AuditLoggerOptions) (*AuditLogger, error) {
 		return nil, fmt.Errorf("received error while accessing network policy log directory: %v", err)
 	}
 
-	// Use lumberjack log file rotation.
-	logOutput := &lumberjack.Logger{
-		Filename:   logFile,
-		MaxSize:    options.MaxSize,
-		MaxBackups: options.MaxBackups,
-		MaxAge:     options.MaxAge,
-		Compress:   options.Compress,
+	var logOutput io.Writer
+	switch options.Destination {
+	case AuditLogDestinationUnixSocket:
+		logOutput = newStreamLogWriter("unix", options.Address)
+	case AuditLogDestinationSyslog:
+		network, address, err := ParseSyslogAddress(options.Address)
+		if err != nil {
+			return nil, err
+		}
+		logOutput = newSyslogWriter(network, address)
+	default:
+		// Use lumberjack log file rotation.
+		logOutput = &lumberjack.Logger{
+			Filename:   logFile,
+			MaxSize:    options.MaxSize,
+			MaxBackups: options.MaxBackups,
+			MaxAge:     options.MaxAge,
+			Compress:   options.Compress,
+		}
 	}
 
+	logFlags := log.Ldate | log.Lmicroseconds
+	if options.Format == AuditLogFormatJSON {
+		// The timestamp is included in the JSON object.
+		logFlags = 0
+	}
 	auditLogger := &AuditLogger{
 		bufferLength:     time.Second,
 		clock:            clock.RealClock{},
-		npLogger:         log.New(logOutput, "", log.Ldate|log.Lmicroseconds),
+		npLogger:         log.New(logOutput, "", logFlags),
+		format:           options.Format,
 		logDeduplication: logRecordDedupMap{logMap: make(map[string]*logDedupRecord)},
 	}
+	if options.SamplingRate > 1 || len(options.RuleSamplingRates) > 0 {
+		auditLogger.logSampling = logRuleSampling{
+			defaultRate:  options.SamplingRate,
+			labelRates:   options.RuleSamplingRates,
+			packetCounts: make(map[string]int),
+		}
+	}
 	klog.InfoS("Initialized Antrea-native Policy Logger for audit logging", "logFile", logFile, "options", options)
 	return auditLogger, nil
 }
@@ -208,6 +208,71 @@ func TestRedirectPacketLog(t *testing.T) {
 	assert.Contains(t, actual, expected)
 }
 
+func TestJSONPacketLog(t *testing.T) {
+	clock := clocktesting.NewFakeClock(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock)
+	auditLogger.npLogger.SetFlags(0)
+	auditLogger.format = AuditLogFormatJSON
+	ob, _ := newLogInfo(actionDrop)
+	ob.direction = "Ingress"
+	ob.appliedToRef = "default/pod1"
+	ob.ofPriority = nullPlaceholder
+
+	auditLogger.LogDedupPacket(ob)
+	clock.Step(time.Millisecond)
+	auditLogger.LogDedupPacket(ob)
+	clock.Step(testBufferLength)
+	actual := <-mockNPLogger.logged
+	assert.JSONEq(t, `{
+		"timestamp": "2025-01-02T03:04:05.101Z",
+		"tableName": "AntreaPolicyIngressRule",
+		"networkPolicy": "AntreaNetworkPolicy:default/test",
+		"ruleName": "test-rule",
+		"direction": "Ingress",
+		"action": "Drop",
+		"appliedTo": "default/pod1",
+		"sourceIP": "0.0.0.0",
+		"sourcePort": "35402",
+		"destinationIP": "1.1.1.1",
+		"destinationPort": "80",
+		"protocol": "TCP",
+		"packetLength": "60",
+		"logLabel": "test-label",
+		"packetCount": 2,
+		"durationSeconds": 0.101
+	}`, actual)
+}
+
+func TestPacketLogSampling(t *testing.T) {
+	auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock.RealClock{})
+	auditLogger.logSampling = logRuleSampling{
+		defaultRate:  3,
+		labelRates:   map[string]int{"critical": 1},
+		packetCounts: make(map[string]int),
+	}
+	ob, expected := newLogInfo(actionAllow)
+	for i := 0; i < 7; i++ {
+		auditLogger.LogDedupPacket(ob)
+	}
+	// The 1st, 4th and 7th packets are logged.
+	for i := 0; i < 3; i++ {
+		actual := <-mockNPLogger.logged
+		assert.Contains(t, actual, expected)
+	}
+	assert.Empty(t, mockNPLogger.logged)
+	assert.Equal(t, 3, ob.samplingRate)
+
+	// Packets of the rules with the "critical" log label are always logged.
+	criticalOb, criticalExpected := newLogInfo(actionAllow)
+	criticalOb.logLabel = "critical"
+	criticalExpected = buildLogMsg(criticalOb)
+	for i := 0; i < 2; i++ {
+		auditLogger.LogDedupPacket(criticalOb)
+		actual := <-mockNPLogger.logged
+		assert.Contains(t, actual, criticalExpected)
+	}
+}
+
 func TestGetNetworkPolicyInfo(t *testing.T) {
 	prepareMockOFTablesWithCache()
 	generateMatch := func(regID int, data []byte) openflow15.MatchField {
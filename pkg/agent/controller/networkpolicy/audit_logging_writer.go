@@ -0,0 +1,162 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+)
+
+const (
+	logWriterDialTimeout  = 5 * time.Second
+	logWriterWriteTimeout = 5 * time.Second
+	// logWriterRetryInterval is the minimum interval between two attempts to connect to the receiver. The logs sent
+	// in-between are dropped without trying to connect.
+	logWriterRetryInterval = time.Second
+	// logWriterQueueSize is the maximum number of logs waiting to be sent to the receiver.
+	logWriterQueueSize = 10000
+
+	logDroppedReasonQueueFull = "queue_full"
+	logDroppedReasonSendError = "send_error"
+
+	// The syslog priority of audit logs, i.e. facility local0 (16) and severity informational (6).
+	syslogPriority = 16*8 + 6
+	syslogAppName  = "antrea-agent"
+)
+
+// ParseSyslogAddress parses the address of a syslog endpoint in the "<network>://<address>" form, where network is
+// one of "udp", "tcp", "unix" and "unixgram". If the network is omitted, "udp" is used.
+func ParseSyslogAddress(address string) (string, string, error) {
+	network, addr, found := strings.Cut(address, "://")
+	if !found {
+		network, addr = "udp", address
+	}
+	if addr == "" {
+		return "", "", fmt.Errorf("syslog address must not be empty")
+	}
+	switch network {
+	case "udp", "tcp":
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return "", "", fmt.Errorf("invalid syslog address %s: %v", address, err)
+		}
+	case "unix", "unixgram":
+	default:
+		return "", "", fmt.Errorf("unsupported syslog network %s, must be one of udp, tcp, unix and unixgram", network)
+	}
+	return network, addr, nil
+}
+
+// netLogWriter is an io.Writer which sends each log to a network connection asynchronously, so that the audit logging
+// of packets is never blocked by a slow or unavailable receiver. The logs are queued in a bounded queue and dropped if
+// the queue is full. The connection is established lazily and re-established after a failure, and the logs sent while
+// the receiver is unavailable are dropped. Dropped logs are counted by the NetworkPolicyAuditLogDroppedCount metric.
+type netLogWriter struct {
+	network string
+	address string
+	// frame converts a log to the message sent over the connection.
+	frame func(p []byte) []byte
+	queue chan []byte
+	// The following fields are only accessed by the run goroutine.
+	conn       net.Conn
+	retryAfter time.Time
+}
+
+func newNetLogWriter(network, address string, frame func(p []byte) []byte) *netLogWriter {
+	w := &netLogWriter{
+		network: network,
+		address: address,
+		frame:   frame,
+		queue:   make(chan []byte, logWriterQueueSize),
+	}
+	go w.run()
+	return w
+}
+
+// Write queues the log without blocking, and returns an error if the log is dropped because the queue is full.
+func (w *netLogWriter) Write(p []byte) (int, error) {
+	// p may be reused by the caller once Write returns.
+	msg := w.frame(bytes.Clone(p))
+	select {
+	case w.queue <- msg:
+		return len(p), nil
+	default:
+		metrics.NetworkPolicyAuditLogDroppedCount.WithLabelValues(logDroppedReasonQueueFull).Inc()
+		return 0, fmt.Errorf("log queue is full")
+	}
+}
+
+func (w *netLogWriter) run() {
+	for msg := range w.queue {
+		if err := w.send(msg); err != nil {
+			metrics.NetworkPolicyAuditLogDroppedCount.WithLabelValues(logDroppedReasonSendError).Inc()
+			klog.V(4).InfoS("Dropped audit log", "network", w.network, "address", w.address, "err", err)
+		}
+	}
+}
+
+func (w *netLogWriter) send(msg []byte) error {
+	if w.conn == nil {
+		if time.Now().Before(w.retryAfter) {
+			return fmt.Errorf("receiver is unavailable")
+		}
+		conn, err := net.DialTimeout(w.network, w.address, logWriterDialTimeout)
+		if err != nil {
+			w.retryAfter = time.Now().Add(logWriterRetryInterval)
+			return err
+		}
+		w.conn = conn
+	}
+	w.conn.SetWriteDeadline(time.Now().Add(logWriterWriteTimeout))
+	if _, err := w.conn.Write(msg); err != nil {
+		w.conn.Close()
+		w.conn = nil
+		return err
+	}
+	return nil
+}
+
+// newStreamLogWriter returns a writer which streams logs separated by newlines.
+func newStreamLogWriter(network, address string) *netLogWriter {
+	return newNetLogWriter(network, address, func(p []byte) []byte { return p })
+}
+
+// newSyslogWriter returns a writer which sends each log as an RFC 5424 syslog message. Messages sent over TCP are
+// framed with octet counting as described in RFC 6587.
+func newSyslogWriter(network, address string) *netLogWriter {
+	hostname, _ := os.Hostname()
+	if hostname == "" {
+		hostname = "-"
+	}
+	return newNetLogWriter(network, address, func(p []byte) []byte {
+		msg := formatSyslogMessage(hostname, time.Now(), p)
+		if network == "tcp" {
+			return append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+		}
+		return msg
+	})
+}
+
+func formatSyslogMessage(hostname string, timestamp time.Time, p []byte) []byte {
+	header := fmt.Sprintf("<%d>1 %s %s %s %d - - ", syslogPriority, timestamp.Format(time.RFC3339Nano), hostname, syslogAppName, os.Getpid())
+	return append([]byte(header), bytes.TrimRight(p, "\n")...)
+}
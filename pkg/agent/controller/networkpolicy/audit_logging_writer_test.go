@@ -0,0 +1,146 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/component-base/metrics/testutil"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+)
+
+func TestParseSyslogAddress(t *testing.T) {
+	tests := []struct {
+		address         string
+		expectedNetwork string
+		expectedAddress string
+		expectedErr     string
+	}{
+		{address: "10.0.0.1:514", expectedNetwork: "udp", expectedAddress: "10.0.0.1:514"},
+		{address: "tcp://syslog.example.com:6514", expectedNetwork: "tcp", expectedAddress: "syslog.example.com:6514"},
+		{address: "unixgram:///dev/log", expectedNetwork: "unixgram", expectedAddress: "/dev/log"},
+		{address: "udp://10.0.0.1", expectedErr: "invalid syslog address udp://10.0.0.1"},
+		{address: "tcp://", expectedErr: "syslog address must not be empty"},
+		{address: "http://10.0.0.1:514", expectedErr: "unsupported syslog network http"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.address, func(t *testing.T) {
+			network, address, err := ParseSyslogAddress(tt.address)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedNetwork, network)
+			assert.Equal(t, tt.expectedAddress, address)
+		})
+	}
+}
+
+func TestFormatSyslogMessage(t *testing.T) {
+	timestamp := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	msg := formatSyslogMessage("node1", timestamp, []byte("log message\n"))
+	assert.Equal(t, fmt.Sprintf("<134>1 2025-01-02T03:04:05Z node1 antrea-agent %d - - log message", os.Getpid()), string(msg))
+}
+
+func TestSyslogWriterUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	writer := newSyslogWriter("udp", conn.LocalAddr().String())
+	n, err := writer.Write([]byte("log message\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 12, n)
+
+	buf := make([]byte, 1024)
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	n, _, err = conn.ReadFrom(buf)
+	require.NoError(t, err)
+	assert.Regexp(t, regexp.MustCompile(`^<134>1 \S+ \S+ antrea-agent \d+ - - log message$`), string(buf[:n]))
+}
+
+func TestStreamLogWriter(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "np.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+	defer listener.Close()
+
+	writer := newStreamLogWriter("unix", socketPath)
+	_, err = writer.Write([]byte("log message 1\n"))
+	require.NoError(t, err)
+	_, err = writer.Write([]byte("log message 2\n"))
+	require.NoError(t, err)
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	reader := bufio.NewReader(conn)
+	for _, expected := range []string{"log message 1\n", "log message 2\n"} {
+		line, err := reader.ReadString('\n')
+		require.NoError(t, err)
+		assert.Equal(t, expected, line)
+	}
+}
+
+func TestNetLogWriterDroppedLogs(t *testing.T) {
+	metrics.InitializeNetworkPolicyMetrics()
+	getDroppedCount := func(reason string) float64 {
+		count, err := testutil.GetCounterMetricValue(metrics.NetworkPolicyAuditLogDroppedCount.WithLabelValues(reason))
+		require.NoError(t, err)
+		return count
+	}
+
+	t.Run("receiver unavailable", func(t *testing.T) {
+		sendErrors := getDroppedCount(logDroppedReasonSendError)
+		writer := newStreamLogWriter("unix", filepath.Join(t.TempDir(), "np.sock"))
+		// The log is queued and dropped asynchronously when it cannot be sent.
+		_, err := writer.Write([]byte("dropped\n"))
+		require.NoError(t, err)
+		assert.EventuallyWithT(t, func(c *assert.CollectT) {
+			assert.Equal(c, sendErrors+1, getDroppedCount(logDroppedReasonSendError))
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("queue full", func(t *testing.T) {
+		queueFull := getDroppedCount(logDroppedReasonQueueFull)
+		// The writer is not running, so that the logs are not dequeued.
+		writer := &netLogWriter{
+			frame: func(p []byte) []byte { return p },
+			queue: make(chan []byte, 1),
+		}
+		p := []byte("log message\n")
+		n, err := writer.Write(p)
+		require.NoError(t, err)
+		assert.Equal(t, len(p), n)
+		// The queued log must not be modified when the caller reuses its buffer.
+		copy(p, "modified")
+		_, err = writer.Write([]byte("dropped\n"))
+		assert.EqualError(t, err, "log queue is full")
+		assert.Equal(t, queueFull+1, getDroppedCount(logDroppedReasonQueueFull))
+		assert.Equal(t, "log message\n", string(<-writer.queue))
+	})
+}
@@ -100,6 +100,10 @@ type rule struct {
 	EnableLogging bool
 	// LogLabel is a string associated to the NetworkPolicy rule. Used for logging.
 	LogLabel string
+	// NodeTrafficTypes are the categories of traffic destined to the Node matched by this rule. Only set for ingress
+	// rules of policies applied to Nodes. It is omitted from the hash when empty so that the IDs of other rules are
+	// not affected.
+	NodeTrafficTypes []crdv1beta1.NodeTrafficType `json:",omitempty"`
 }
 
 func (r *rule) Less(r2 *rule) bool {
@@ -168,7 +172,7 @@ func (r *CompletedRule) String() string {
 
 // isAntreaNetworkPolicyRule returns true if the rule is part of a Antrea policy.
 func (r *CompletedRule) isAntreaNetworkPolicyRule() bool {
-	return r.SourceRef.Type != v1beta.K8sNetworkPolicy
+	return !v1beta.HasK8sNetworkPolicySemantics(r.SourceRef)
 }
 
 func (r *CompletedRule) isIGMPEgressPolicyRule() bool {
@@ -288,6 +292,34 @@ func (c *ruleCache) getAppliedNetworkPolicies(pod, namespace string, npFilter *q
 	return policies
 }
 
+// appliedToGroupHasPod returns whether the given AppliedToGroup includes the given Pod.
+func (c *ruleCache) appliedToGroupHasPod(group, pod, namespace string) bool {
+	memberPod := &v1beta.GroupMember{Pod: &v1beta.PodReference{Name: pod, Namespace: namespace}}
+	c.appliedToSetLock.RLock()
+	defer c.appliedToSetLock.RUnlock()
+	return c.appliedToSetByGroup[group].Has(memberPod)
+}
+
+// getAppliedRuleIDs returns the IDs of the rules applied to the given Pod.
+func (c *ruleCache) getAppliedRuleIDs(pod, namespace string) []string {
+	var groups []string
+	memberPod := &v1beta.GroupMember{Pod: &v1beta.PodReference{Name: pod, Namespace: namespace}}
+	c.appliedToSetLock.RLock()
+	for group, memberSet := range c.appliedToSetByGroup {
+		if memberSet.Has(memberPod) {
+			groups = append(groups, group)
+		}
+	}
+	c.appliedToSetLock.RUnlock()
+
+	ruleIDs := sets.New[string]()
+	for _, group := range groups {
+		keys, _ := c.rules.IndexKeys(appliedToGroupIndex, group)
+		ruleIDs.Insert(keys...)
+	}
+	return sets.List(ruleIDs)
+}
+
 func (c *ruleCache) getEffectiveRulesByNetworkPolicy(uid string) []*rule {
 	objs, _ := c.rules.ByIndex(policyIndex, uid)
 	if len(objs) == 0 {
@@ -702,21 +734,22 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 		appliedToGroups = r.AppliedToGroups
 	}
 	rule := &rule{
-		Direction:       r.Direction,
-		From:            r.From,
-		To:              r.To,
-		Services:        r.Services,
-		L7Protocols:     r.L7Protocols,
-		Action:          r.Action,
-		Priority:        r.Priority,
-		PolicyPriority:  policy.Priority,
-		TierPriority:    policy.TierPriority,
-		AppliedToGroups: appliedToGroups,
-		Name:            r.Name,
-		PolicyUID:       policy.UID,
-		SourceRef:       policy.SourceRef,
-		EnableLogging:   r.EnableLogging,
-		LogLabel:        r.LogLabel,
+		Direction:        r.Direction,
+		From:             r.From,
+		To:               r.To,
+		Services:         r.Services,
+		L7Protocols:      r.L7Protocols,
+		Action:           r.Action,
+		Priority:         r.Priority,
+		PolicyPriority:   policy.Priority,
+		TierPriority:     policy.TierPriority,
+		AppliedToGroups:  appliedToGroups,
+		Name:             r.Name,
+		PolicyUID:        policy.UID,
+		SourceRef:        policy.SourceRef,
+		EnableLogging:    r.EnableLogging,
+		LogLabel:         r.LogLabel,
+		NodeTrafficTypes: r.NodeTrafficTypes,
 	}
 	rule.ID = hashRule(rule)
 	rule.PolicyName = policy.Name
@@ -727,7 +760,7 @@ func toRule(r *v1beta.NetworkPolicyRule, policy *v1beta.NetworkPolicy, maxPriori
 // getMaxPriority returns the highest rule priority for v1beta.NetworkPolicy that is created
 // by Antrea-native policies. For K8s NetworkPolicies, it always returns -1.
 func getMaxPriority(policy *v1beta.NetworkPolicy) int32 {
-	if policy.SourceRef.Type == v1beta.K8sNetworkPolicy {
+	if v1beta.HasK8sNetworkPolicySemantics(policy.SourceRef) {
 		return -1
 	}
 	maxPriority := int32(-1)
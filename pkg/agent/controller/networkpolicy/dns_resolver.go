@@ -0,0 +1,288 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"net"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/agent/openflow"
+	agenttypes "antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/util/channel"
+)
+
+const (
+	dnsResolverControllerName = "AntreaAgentDNSResolverController"
+	dnsResolverWorkers        = 4
+	// How often the statistics of the dropped DNS queries are exported.
+	dnsResolverMetricsInterval = 30 * time.Second
+
+	// clusterDNSResolvers stands for the cluster DNS resolvers in the "pod.antrea.io/dns-resolvers" annotation.
+	clusterDNSResolvers = "cluster"
+)
+
+// podDNSResolvers is the state of a Pod whose DNS resolver flows are installed.
+type podDNSResolvers struct {
+	ofPort    uint32
+	resolvers []net.IP
+}
+
+// DNSResolverController restricts the DNS queries of the local Pods annotated with "pod.antrea.io/dns-resolvers" to
+// the resolvers allowed by the annotation, by installing flows which drop the DNS queries sent to other resolvers. It
+// prevents the Pods from exfiltrating data through arbitrary resolvers. The number of dropped packets is exported for
+// each restricted Pod.
+type DNSResolverController struct {
+	ofClient         openflow.Client
+	interfaceStore   interfacestore.InterfaceStore
+	podLister        corelisters.PodLister
+	podListerSynced  cache.InformerSynced
+	clusterResolvers []net.IP
+	queue            workqueue.TypedRateLimitingInterface[types.NamespacedName]
+
+	mutex sync.Mutex
+	// installedPods stores the state of the Pods whose DNS resolver flows are installed.
+	installedPods map[types.NamespacedName]*podDNSResolvers
+}
+
+// NewDNSResolverController returns a new *DNSResolverController. If clusterResolvers is empty, the ClusterIP of the
+// kube-dns Service is used as the cluster DNS resolver.
+func NewDNSResolverController(
+	ofClient openflow.Client,
+	interfaceStore interfacestore.InterfaceStore,
+	podInformer cache.SharedIndexInformer,
+	podUpdateSubscriber channel.Subscriber,
+	clusterResolvers []net.IP,
+) *DNSResolverController {
+	if len(clusterResolvers) == 0 {
+		if ip := net.ParseIP(os.Getenv(kubeDNSServiceHost)); ip != nil {
+			clusterResolvers = []net.IP{ip}
+		} else {
+			klog.InfoS("Unable to derive the cluster DNS resolver from the kube-dns Service, the DNS queries of the Pods allowing \"cluster\" resolvers will be dropped")
+		}
+	}
+	c := &DNSResolverController{
+		ofClient:         ofClient,
+		interfaceStore:   interfaceStore,
+		podLister:        corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced:  podInformer.HasSynced,
+		clusterResolvers: clusterResolvers,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[types.NamespacedName](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+				Name: "dnsresolver",
+			},
+		),
+		installedPods: map[types.NamespacedName]*podDNSResolvers{},
+	}
+	// Pod add events are handled with the events from podUpdateSubscriber, which are only received once the Pod
+	// interface has been created by the CNIServer.
+	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.processPodUpdate,
+		DeleteFunc: c.processPodDelete,
+	})
+	podUpdateSubscriber.Subscribe(c.processPodCNIEvent)
+	return c
+}
+
+func (c *DNSResolverController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting controller", "controller", dnsResolverControllerName)
+	defer klog.InfoS("Shutting down controller", "controller", dnsResolverControllerName)
+	if !cache.WaitForNamedCacheSync(dnsResolverControllerName, stopCh, c.podListerSynced) {
+		return
+	}
+	pods, _ := c.podLister.List(labels.Everything())
+	for _, pod := range pods {
+		if _, exists := pod.Annotations[agenttypes.PodDNSResolversAnnotationKey]; exists && !pod.Spec.HostNetwork {
+			c.queue.Add(types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name})
+		}
+	}
+	for i := 0; i < dnsResolverWorkers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	go wait.Until(c.updateMetrics, dnsResolverMetricsInterval, stopCh)
+	<-stopCh
+}
+
+func (c *DNSResolverController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *DNSResolverController) processNextWorkItem() bool {
+	podRef, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(podRef)
+
+	if err := c.syncPod(podRef); err == nil {
+		c.queue.Forget(podRef)
+	} else {
+		c.queue.AddRateLimited(podRef)
+		klog.ErrorS(err, "Error syncing Pod DNS resolvers, requeuing", "pod", podRef)
+	}
+	return true
+}
+
+func (c *DNSResolverController) syncPod(podRef types.NamespacedName) error {
+	var resolvers []net.IP
+	var ofPort uint32
+	restricted := false
+	pod, err := c.podLister.Pods(podRef.Namespace).Get(podRef.Name)
+	if err == nil && !pod.Spec.HostNetwork {
+		resolvers, restricted = c.getPodDNSResolvers(pod)
+	}
+	if restricted {
+		containerConfigs := c.interfaceStore.GetContainerInterfacesByPod(podRef.Name, podRef.Namespace)
+		if len(containerConfigs) == 0 {
+			// The Pod will be synced again when the CNIServer has created its interface.
+			klog.V(2).InfoS("Pod container config not found, skip restricting DNS resolvers", "pod", podRef)
+			restricted = false
+		} else {
+			ofPort = uint32(containerConfigs[0].OFPort)
+		}
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	installed, exists := c.installedPods[podRef]
+	if exists && (!restricted || installed.ofPort != ofPort) {
+		if err := c.uninstallPodFlows(podRef, installed.ofPort); err != nil {
+			return err
+		}
+		exists = false
+	}
+	if !restricted || (exists && slices.EqualFunc(installed.resolvers, resolvers, net.IP.Equal)) {
+		return nil
+	}
+	if err := c.ofClient.InstallPodDNSResolverFlows(ofPort, resolvers); err != nil {
+		return err
+	}
+	c.installedPods[podRef] = &podDNSResolvers{ofPort: ofPort, resolvers: resolvers}
+	klog.V(2).InfoS("Restricted DNS resolvers of Pod", "pod", podRef, "resolvers", resolvers)
+	return nil
+}
+
+// uninstallPodFlows removes the DNS resolver flows installed for the Pod. The flows are kept if the ofPort has been
+// reused by another restricted Pod in the meantime, as they have been replaced by the flows of the other Pod.
+func (c *DNSResolverController) uninstallPodFlows(podRef types.NamespacedName, ofPort uint32) error {
+	reused := false
+	for otherPodRef, installed := range c.installedPods {
+		if otherPodRef != podRef && installed.ofPort == ofPort {
+			reused = true
+			break
+		}
+	}
+	if !reused {
+		if err := c.ofClient.UninstallPodDNSResolverFlows(ofPort); err != nil {
+			return err
+		}
+	}
+	delete(c.installedPods, podRef)
+	metrics.DNSResolverDroppedPacketCount.DeleteLabelValues(podRef.Namespace, podRef.Name)
+	klog.V(2).InfoS("Removed DNS resolver restriction of Pod", "pod", podRef)
+	return nil
+}
+
+// getPodDNSResolvers returns the resolvers allowed by the annotation of the Pod, and whether its DNS queries are
+// restricted. The invalid resolvers are ignored, so that the queries sent to them are dropped.
+func (c *DNSResolverController) getPodDNSResolvers(pod *corev1.Pod) ([]net.IP, bool) {
+	value, exists := pod.Annotations[agenttypes.PodDNSResolversAnnotationKey]
+	if !exists {
+		return nil, false
+	}
+	var resolvers []net.IP
+	for _, resolver := range strings.Split(value, ",") {
+		resolver = strings.TrimSpace(resolver)
+		if resolver == "" {
+			continue
+		}
+		if resolver == clusterDNSResolvers {
+			resolvers = append(resolvers, c.clusterResolvers...)
+			continue
+		}
+		ip := net.ParseIP(resolver)
+		if ip == nil {
+			klog.ErrorS(nil, "Ignored invalid DNS resolver, it must be an IP address or \"cluster\"", "pod", klog.KObj(pod), "resolver", resolver)
+			continue
+		}
+		resolvers = append(resolvers, ip)
+	}
+	return resolvers, true
+}
+
+// updateMetrics exports the number of DNS query packets dropped for each restricted Pod.
+func (c *DNSResolverController) updateMetrics() {
+	dropMetrics := c.ofClient.DNSResolverDropMetrics()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for podRef, installed := range c.installedPods {
+		var packets uint64
+		if metric, ok := dropMetrics[installed.ofPort]; ok {
+			packets = metric.Packets
+		}
+		metrics.DNSResolverDroppedPacketCount.WithLabelValues(podRef.Namespace, podRef.Name).Set(float64(packets))
+	}
+}
+
+// processPodCNIEvent enqueues the Pod whose interface has just been created or deleted by the CNIServer.
+func (c *DNSResolverController) processPodCNIEvent(e interface{}) {
+	podEvent := e.(agenttypes.PodUpdate)
+	c.queue.Add(types.NamespacedName{Namespace: podEvent.PodNamespace, Name: podEvent.PodName})
+}
+
+// processPodUpdate enqueues the Pod if its DNS resolvers annotation has been updated.
+func (c *DNSResolverController) processPodUpdate(old, cur interface{}) {
+	oldPod := old.(*corev1.Pod)
+	curPod := cur.(*corev1.Pod)
+	oldValue, oldExists := oldPod.Annotations[agenttypes.PodDNSResolversAnnotationKey]
+	curValue, curExists := curPod.Annotations[agenttypes.PodDNSResolversAnnotationKey]
+	if oldExists == curExists && oldValue == curValue {
+		return
+	}
+	c.queue.Add(types.NamespacedName{Namespace: curPod.Namespace, Name: curPod.Name})
+}
+
+func (c *DNSResolverController) processPodDelete(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		pod, ok = deletedState.Obj.(*corev1.Pod)
+		if !ok {
+			return
+		}
+	}
+	c.queue.Add(types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name})
+}
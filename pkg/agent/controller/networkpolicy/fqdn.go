@@ -87,6 +87,19 @@ type ipWithExpiration struct {
 	expirationTime time.Time
 }
 
+// FQDNCacheConfig configures the cache of the IPs resolved for the FQDNs selected by policy rules.
+type FQDNCacheConfig struct {
+	// MinTTL is the minimum TTL in seconds of the IPs resolved from DNS responses.
+	MinTTL uint32
+	// StaleTTL is the duration in seconds for which an expired IP is still retained after it is no longer included in
+	// the DNS responses for its FQDN, so that the connections of applications using it are not denied while their DNS
+	// cache is refreshed.
+	StaleTTL uint32
+	// StaticIPs are the IPs which are always considered resolved for a FQDN, in addition to the ones from DNS
+	// responses. The keys are lowercase FQDNs.
+	StaticIPs map[string][]net.IP
+}
+
 // subscriber is a entity that subsribes for datapath rule realization
 // results of a specific FQDN. It is needed in case of DNS query interception:
 // the fqdnController needs to make sure that all fqdn rules that DNS
@@ -128,6 +141,9 @@ type fqdnController struct {
 	// dnsServerAddr stores the coreDNS server address, or the user provided DNS server address.
 	dnsServerAddr string
 	minTTL        uint32
+	staleTTL      time.Duration
+	// staticIPs are the IPs pinned for FQDNs by the administrator. They never expire.
+	staticIPs map[string][]net.IP
 
 	// dirtyRuleHandler is a callback that is run upon finding a rule out-of-sync.
 	dirtyRuleHandler func(string)
@@ -161,7 +177,7 @@ type fqdnController struct {
 	clock clock.Clock
 }
 
-func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServerOverride string, dirtyRuleHandler func(string), v4Enabled, v6Enabled bool, gwPort uint32, clock clock.WithTicker, fqdnCacheMinTTL uint32) (*fqdnController, error) {
+func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServerOverride string, dirtyRuleHandler func(string), v4Enabled, v6Enabled bool, gwPort uint32, clock clock.WithTicker, cacheConfig FQDNCacheConfig) (*fqdnController, error) {
 	controller := &fqdnController{
 		ofClient:         client,
 		dirtyRuleHandler: dirtyRuleHandler,
@@ -183,7 +199,17 @@ func newFQDNController(client openflow.Client, allocator *idAllocator, dnsServer
 		ipv6Enabled:            v6Enabled,
 		gwPort:                 gwPort,
 		clock:                  clock,
-		minTTL:                 fqdnCacheMinTTL,
+		minTTL:                 cacheConfig.MinTTL,
+		staleTTL:               time.Duration(cacheConfig.StaleTTL) * time.Second,
+		staticIPs:              map[string][]net.IP{},
+	}
+	for fqdn, ips := range cacheConfig.StaticIPs {
+		fqdn = strings.ToLower(fqdn)
+		for _, ip := range ips {
+			if (ip.To4() != nil && v4Enabled) || (ip.To4() == nil && v6Enabled) {
+				controller.staticIPs[fqdn] = append(controller.staticIPs[fqdn], ip)
+			}
+		}
 	}
 	if controller.ofClient != nil {
 		if err := controller.ofClient.NewDNSPacketInConjunction(dnsInterceptRuleID); err != nil {
@@ -267,6 +293,7 @@ func (f *fqdnController) getIPsForFQDNSelectors(fqdns []string) []net.IP {
 					matchedIPs = append(matchedIPs, ipData.ip)
 				}
 			}
+			matchedIPs = append(matchedIPs, f.staticIPs[fqdn]...)
 		}
 	}
 	return matchedIPs
@@ -296,6 +323,12 @@ func (f *fqdnController) addFQDNSelector(ruleID string, fqdns []string) {
 						f.setFQDNMatchSelector(fqdn, fqdnSelectorItem)
 					}
 				}
+				// The FQDNs with static IPs can match it as well, even if they have never been resolved.
+				for fqdn := range f.staticIPs {
+					if fqdnSelectorItem.matches(fqdn) {
+						f.setFQDNMatchSelector(fqdn, fqdnSelectorItem)
+					}
+				}
 			} else {
 				// As the selector matches name, only the FQDN of this name matches it.
 				f.setFQDNMatchSelector(fqdnSelectorItem.matchName, fqdnSelectorItem)
@@ -436,8 +469,13 @@ func (f *fqdnController) onDNSResponse(
 
 	updateIPWithExpiration := func(ip string, ipMeta ipWithExpiration) {
 		ipWithExpirationMap[ip] = ipMeta
-		if timeToRequery == nil || ipMeta.expirationTime.Before(*timeToRequery) {
-			timeToRequery = &ipMeta.expirationTime
+		requeryTime := ipMeta.expirationTime
+		if !requeryTime.After(currentTime) {
+			// The IP is stale, query the FQDN again when it must be removed.
+			requeryTime = requeryTime.Add(f.staleTTL)
+		}
+		if timeToRequery == nil || requeryTime.Before(*timeToRequery) {
+			timeToRequery = &requeryTime
 		}
 	}
 
@@ -457,11 +495,12 @@ func (f *fqdnController) onDNSResponse(
 		for cachedIPStr, cachedIPMeta := range cachedDNSMeta.responseIPs {
 			if newIPMeta, exist := newIPsWithExpiration[cachedIPStr]; !exist {
 				// The IP was not found in current response.
-				if cachedIPMeta.expirationTime.Before(currentTime) {
+				if cachedIPMeta.expirationTime.Add(f.staleTTL).Before(currentTime) {
 					// this IP is expired and stale, remove it by not including it but also signal an update to syncRules.
 					addressUpdate = true
 				} else {
-					// It hasn't expired yet, so just retain it with its existing expirationTime.
+					// It hasn't expired yet, or has been stale for less than staleTTL, so just retain it with its
+					// existing expirationTime.
 					updateIPWithExpiration(cachedIPStr, cachedIPMeta)
 				}
 			} else {
@@ -54,7 +54,7 @@ func newMockFQDNController(t *testing.T, controller *gomock.Controller, dnsServe
 		false,
 		config.DefaultHostGatewayOFPort,
 		clockToInject,
-		fqdnCacheMinTTL,
+		FQDNCacheConfig{MinTTL: fqdnCacheMinTTL},
 	)
 	require.NoError(t, err)
 	return f, mockOFClient
@@ -401,6 +401,7 @@ func TestGetIPsForFQDNSelectors(t *testing.T) {
 		fqdns                      []string
 		existingSelectorItemToFQDN map[fqdnSelectorItem]sets.Set[string]
 		existingDNSCache           map[string]dnsMeta
+		staticIPs                  map[string][]net.IP
 		expectedMatchedIPs         []net.IP
 	}{
 		{
@@ -420,6 +421,25 @@ func TestGetIPsForFQDNSelectors(t *testing.T) {
 			},
 			expectedMatchedIPs: []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("192.155.12.1"), net.ParseIP("192.158.1.38")},
 		},
+		{
+			name:  "static ip",
+			fqdns: []string{"test.antrea.io"},
+			existingSelectorItemToFQDN: map[fqdnSelectorItem]sets.Set[string]{
+				selectorItem: sets.New[string]("test.antrea.io"),
+			},
+			existingDNSCache: map[string]dnsMeta{
+				"test.antrea.io": {
+					responseIPs: map[string]ipWithExpiration{
+						"127.0.0.1": {net.ParseIP("127.0.0.1"), time.Now()},
+					},
+				},
+			},
+			staticIPs: map[string][]net.IP{
+				"test.antrea.io":  {net.ParseIP("10.10.0.1")},
+				"other.antrea.io": {net.ParseIP("10.10.0.2")},
+			},
+			expectedMatchedIPs: []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("10.10.0.1")},
+		},
 		{
 			name:               "no matched ip",
 			fqdns:              []string{"^.*antrea[.]io$"},
@@ -437,12 +457,43 @@ func TestGetIPsForFQDNSelectors(t *testing.T) {
 			if tc.existingDNSCache != nil {
 				f.dnsEntryCache = tc.existingDNSCache
 			}
+			if tc.staticIPs != nil {
+				f.staticIPs = tc.staticIPs
+			}
 			gotOutput := f.getIPsForFQDNSelectors(tc.fqdns)
 			assert.ElementsMatch(t, tc.expectedMatchedIPs, gotOutput)
 		})
 	}
 }
 
+func TestFQDNStaticIPs(t *testing.T) {
+	controller := gomock.NewController(t)
+	mockOFClient := openflowtest.NewMockClient(controller)
+	mockOFClient.EXPECT().NewDNSPacketInConjunction(gomock.Any()).Return(nil).AnyTimes()
+	f, err := newFQDNController(mockOFClient, newIDAllocator(testAsyncDeleteInterval), "8.8.8.8:53", func(string) {}, true, false,
+		config.DefaultHostGatewayOFPort, clock.RealClock{}, FQDNCacheConfig{
+			StaticIPs: map[string][]net.IP{
+				"Static.Antrea.io": {net.ParseIP("10.10.0.1"), net.ParseIP("fd00::1")},
+				"other.example.io": {net.ParseIP("10.10.0.2")},
+			},
+		})
+	require.NoError(t, err)
+	// The FQDNs are lowercase and the IPs of disabled IP families are ignored.
+	assert.Equal(t, map[string][]net.IP{
+		"static.antrea.io": {net.ParseIP("10.10.0.1")},
+		"other.example.io": {net.ParseIP("10.10.0.2")},
+	}, f.staticIPs)
+
+	// A wildcard selector matches the FQDNs with static IPs, even if they have never been resolved.
+	f.addFQDNSelector("mockRule1", []string{"*antrea.io"})
+	assert.Equal(t, []net.IP{net.ParseIP("10.10.0.1")}, f.getIPsForFQDNSelectors([]string{"*antrea.io"}))
+
+	// The static IPs are still known to the controller when the FQDN is no longer selected.
+	f.deleteFQDNSelector("mockRule1", []string{"*antrea.io"})
+	assert.Empty(t, f.fqdnToSelectorItem)
+	assert.Len(t, f.staticIPs, 2)
+}
+
 func TestSyncDirtyRules(t *testing.T) {
 	testFQDN := "test.antrea.io"
 	selectorItem := fqdnSelectorItem{
@@ -602,6 +653,7 @@ func TestOnDNSResponse(t *testing.T) {
 	tests := []struct {
 		name                  string
 		existingDNSCache      map[string]dnsMeta
+		staleTTL              time.Duration
 		dnsResponseIPs        map[string]ipWithExpiration
 		expectedIPs           map[string]ipWithExpiration
 		expectedRequeryAfter  *time.Duration
@@ -680,6 +732,44 @@ func TestOnDNSResponse(t *testing.T) {
 			},
 			expectedRequeryAfter: ptr.To(5 * time.Second),
 		},
+		{
+			name: "stale IP is retained until stale TTL expires",
+			existingDNSCache: map[string]dnsMeta{
+				testFQDN: {
+					responseIPs: map[string]ipWithExpiration{
+						"192.1.1.1": {ip: net.ParseIP("192.1.1.1"), expirationTime: currentTime.Add(-1 * time.Second)},
+					},
+				},
+			},
+			staleTTL: 3 * time.Second,
+			dnsResponseIPs: map[string]ipWithExpiration{
+				"192.1.1.3": {ip: net.ParseIP("192.1.1.3"), expirationTime: currentTime.Add(5 * time.Second)},
+			},
+			expectedIPs: map[string]ipWithExpiration{
+				"192.1.1.1": {ip: net.ParseIP("192.1.1.1"), expirationTime: currentTime.Add(-1 * time.Second)},
+				"192.1.1.3": {ip: net.ParseIP("192.1.1.3"), expirationTime: currentTime.Add(5 * time.Second)},
+			},
+			// The FQDN is queried again when the stale IP must be removed.
+			expectedRequeryAfter: ptr.To(2 * time.Second),
+		},
+		{
+			name: "stale IP with expired stale TTL is evicted",
+			existingDNSCache: map[string]dnsMeta{
+				testFQDN: {
+					responseIPs: map[string]ipWithExpiration{
+						"192.1.1.1": {ip: net.ParseIP("192.1.1.1"), expirationTime: currentTime.Add(-5 * time.Second)},
+					},
+				},
+			},
+			staleTTL: 3 * time.Second,
+			dnsResponseIPs: map[string]ipWithExpiration{
+				"192.1.1.3": {ip: net.ParseIP("192.1.1.3"), expirationTime: currentTime.Add(5 * time.Second)},
+			},
+			expectedIPs: map[string]ipWithExpiration{
+				"192.1.1.3": {ip: net.ParseIP("192.1.1.3"), expirationTime: currentTime.Add(5 * time.Second)},
+			},
+			expectedRequeryAfter: ptr.To(5 * time.Second),
+		},
 		{
 			name:             "existingDNSCache is empty, the new response matches a selector.",
 			existingDNSCache: map[string]dnsMeta{},
@@ -712,6 +802,7 @@ func TestOnDNSResponse(t *testing.T) {
 			fakeClock := newFakeClock(currentTime)
 			controller := gomock.NewController(t)
 			f, _ := newMockFQDNController(t, controller, nil, fakeClock, 0)
+			f.staleTTL = tc.staleTTL
 			f.dnsEntryCache = tc.existingDNSCache
 			if tc.mockSelectorToRuleIDs != nil {
 				f.selectorItemToRuleIDs = tc.mockSelectorToRuleIDs
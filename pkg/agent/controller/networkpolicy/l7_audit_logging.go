@@ -0,0 +1,297 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	utilcache "k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/openflow"
+	v1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+const (
+	// l7EngineTableName replaces the OVS table name in the audit logs written for the events of the layer 7 engine.
+	l7EngineTableName = "L7Engine"
+	// rejectedFlowTimeout is how long a flow rejected by the layer 7 engine is remembered, so that the transactions
+	// of the flow which are logged by the engine after the alert are not audited as allowed.
+	rejectedFlowTimeout = time.Minute
+)
+
+// l7Info is the layer 7 metadata of the traffic matching a L7 NetworkPolicy rule.
+type l7Info struct {
+	protocol   string
+	httpHost   string
+	httpMethod string
+	httpPath   string
+	tlsSNI     string
+}
+
+// String returns the layer 7 metadata in the text format of audit logs, e.g. `http host="foo.com" method="GET" path="/"`.
+// The values are quoted, as they are controlled by the client and may contain spaces or newlines.
+func (i *l7Info) String() string {
+	protocol := i.protocol
+	if protocol == "" {
+		protocol = nullPlaceholder
+	}
+	fields := []string{protocol}
+	for _, kv := range []struct{ key, value string }{
+		{"host", i.httpHost},
+		{"method", i.httpMethod},
+		{"path", i.httpPath},
+		{"sni", i.tlsSNI},
+	} {
+		if kv.value != "" {
+			fields = append(fields, kv.key+"="+strconv.Quote(kv.value))
+		}
+	}
+	return strings.Join(fields, " ")
+}
+
+// suricataEvent holds the fields of the Suricata EVE events which are used for audit logging.
+// See https://docs.suricata.io/en/latest/output/eve/eve-json-format.html.
+type suricataEvent struct {
+	FlowID    int64  `json:"flow_id"`
+	EventType string `json:"event_type"`
+	TenantID  uint32 `json:"tenant_id"`
+	SrcIP     string `json:"src_ip"`
+	SrcPort   int32  `json:"src_port"`
+	DestIP    string `json:"dest_ip"`
+	DestPort  int32  `json:"dest_port"`
+	Proto     string `json:"proto"`
+	AppProto  string `json:"app_proto"`
+	Alert     *struct {
+		Action string `json:"action"`
+	} `json:"alert"`
+	HTTP *struct {
+		Hostname string `json:"hostname"`
+		URL      string `json:"url"`
+		Method   string `json:"http_method"`
+	} `json:"http"`
+	TLS *struct {
+		SNI string `json:"sni"`
+	} `json:"tls"`
+}
+
+// l7AuditListener receives the events of the layer 7 engine from a Unix domain socket, and writes audit logs with the
+// layer 7 metadata of the traffic for the L7 NetworkPolicy rules which have logging enabled. Rejected requests are
+// reported by alert events, and allowed requests by http and tls events.
+type l7AuditListener struct {
+	socketPath  string
+	auditLogger *AuditLogger
+	ifaceStore  interfacestore.InterfaceStore
+	// getRule returns the L7 NetworkPolicy rule which the VLAN ID is allocated for. The VLAN ID is also the ID of
+	// the Suricata tenant of the rule.
+	getRule func(vlanID uint32) (*rule, bool)
+	// rejectedFlows stores the keys of the flows rejected in the last rejectedFlowTimeout.
+	rejectedFlows *utilcache.Expiring
+}
+
+// l7FlowKey identifies a flow analyzed by the layer 7 engine. The flow ID is only unique within a Suricata tenant.
+type l7FlowKey struct {
+	tenantID uint32
+	flowID   int64
+}
+
+func newL7AuditListener(auditLogger *AuditLogger, ifaceStore interfacestore.InterfaceStore, getRule func(vlanID uint32) (*rule, bool)) *l7AuditListener {
+	return &l7AuditListener{
+		socketPath:    config.L7SuricataAuditSocketPath,
+		auditLogger:   auditLogger,
+		ifaceStore:    ifaceStore,
+		getRule:       getRule,
+		rejectedFlows: utilcache.NewExpiring(),
+	}
+}
+
+// getL7Rule returns the L7 NetworkPolicy rule which the VLAN ID is allocated for.
+func (c *Controller) getL7Rule(vlanID uint32) (*rule, bool) {
+	ruleID, ok := c.l7VlanIDAllocator.queryRuleID(vlanID)
+	if !ok {
+		return nil, false
+	}
+	obj, exists, _ := c.ruleCache.rules.GetByKey(ruleID)
+	if !exists {
+		return nil, false
+	}
+	return obj.(*rule), true
+}
+
+func (l *l7AuditListener) Run(stopCh <-chan struct{}) {
+	wait.Until(func() {
+		l.listenAndAcceptConn(stopCh)
+	}, 5*time.Second, stopCh)
+}
+
+func (l *l7AuditListener) listenAndAcceptConn(stopCh <-chan struct{}) {
+	// Remove the stale socket.
+	if err := os.Remove(l.socketPath); err != nil && !os.IsNotExist(err) {
+		klog.ErrorS(err, "Failed to remove stale socket", "path", l.socketPath)
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(l.socketPath), 0750); err != nil {
+		klog.ErrorS(err, "Failed to create directory", "dir", filepath.Dir(l.socketPath))
+		return
+	}
+	listener, err := net.Listen("unix", l.socketPath)
+	if err != nil {
+		klog.ErrorS(err, "Failed to listen on Suricata audit socket", "path", l.socketPath)
+		return
+	}
+	var wg sync.WaitGroup
+	// Wait for all goroutines (accept + all connection handlers) to return. The call to Wait() needs to happen after
+	// the listener is closed.
+	defer wg.Wait()
+	defer listener.Close()
+	errCh := make(chan error, 1)
+	klog.InfoS("Listening for L7 engine events for audit logging", "path", l.socketPath)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				klog.ErrorS(err, "Error accepting Suricata connection")
+				errCh <- err
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				l.handleClientConnection(conn)
+			}()
+		}
+	}()
+	select {
+	case <-stopCh:
+	case <-errCh:
+	}
+}
+
+func (l *l7AuditListener) handleClientConnection(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		data, err := reader.ReadBytes('\n')
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			klog.ErrorS(err, "Error reading L7 engine event")
+			return
+		}
+		if err := l.processEvent(data); err != nil {
+			klog.ErrorS(err, "Error processing L7 engine event")
+		}
+	}
+}
+
+// markFlowRejected remembers that the flow was rejected for rejectedFlowTimeout.
+func (l *l7AuditListener) markFlowRejected(key l7FlowKey) {
+	l.rejectedFlows.Set(key, struct{}{}, rejectedFlowTimeout)
+}
+
+func (l *l7AuditListener) isFlowRejected(key l7FlowKey) bool {
+	_, rejected := l.rejectedFlows.Get(key)
+	return rejected
+}
+
+func (l *l7AuditListener) processEvent(data []byte) error {
+	var event suricataEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return fmt.Errorf("error parsing L7 engine event: %w", err)
+	}
+	flowKey := l7FlowKey{tenantID: event.TenantID, flowID: event.FlowID}
+	var disposition string
+	switch event.EventType {
+	case "alert":
+		// Only the default reject rule of a L7 NetworkPolicy rule generates alerts.
+		if event.Alert == nil || event.Alert.Action != "blocked" {
+			return nil
+		}
+		l.markFlowRejected(flowKey)
+		disposition = openflow.DispositionToString[openflow.DispositionRej]
+	case "http", "tls":
+		if l.isFlowRejected(flowKey) {
+			return nil
+		}
+		disposition = openflow.DispositionToString[openflow.DispositionAllow]
+	default:
+		return nil
+	}
+
+	r, found := l.getRule(event.TenantID)
+	if !found {
+		klog.V(2).InfoS("L7 NetworkPolicy rule not found for L7 engine event", "tenantID", event.TenantID)
+		return nil
+	}
+	if !r.EnableLogging {
+		return nil
+	}
+	ob := &logInfo{
+		tableName:   l7EngineTableName,
+		npRef:       r.SourceRef.ToString(),
+		ruleName:    r.Name,
+		disposition: disposition,
+		srcIP:       event.SrcIP,
+		destIP:      event.DestIP,
+		protocolStr: event.Proto,
+		logLabel:    r.LogLabel,
+		l7:          &l7Info{protocol: event.AppProto},
+	}
+	var localIP string
+	if r.Direction == v1beta.DirectionIn {
+		ob.direction = "Ingress"
+		localIP = event.DestIP
+	} else {
+		ob.direction = "Egress"
+		localIP = event.SrcIP
+	}
+	if iface, ok := l.ifaceStore.GetInterfaceByIP(localIP); ok && iface.Type == interfacestore.ContainerInterface {
+		ob.appliedToRef = fmt.Sprintf("%s/%s", iface.ContainerInterfaceConfig.PodNamespace, iface.ContainerInterfaceConfig.PodName)
+	}
+	if event.Proto == "TCP" || event.Proto == "UDP" {
+		ob.srcPort = strconv.Itoa(int(event.SrcPort))
+		ob.destPort = strconv.Itoa(int(event.DestPort))
+	}
+	if event.HTTP != nil {
+		ob.l7.protocol = "http"
+		ob.l7.httpHost = event.HTTP.Hostname
+		ob.l7.httpMethod = event.HTTP.Method
+		ob.l7.httpPath = event.HTTP.URL
+	}
+	if event.TLS != nil {
+		ob.l7.protocol = "tls"
+		ob.l7.tlsSNI = event.TLS.SNI
+	}
+	// The layer 7 engine works on the reassembled stream, so there is no OpenFlow priority and packet length.
+	fillLogInfoPlaceholders([]*string{&ob.ruleName, &ob.ofPriority, &ob.appliedToRef, &ob.srcPort, &ob.destPort, &ob.pktLength, &ob.logLabel})
+	l.auditLogger.LogDedupPacket(ob)
+	return nil
+}
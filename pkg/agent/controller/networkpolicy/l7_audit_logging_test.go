@@ -0,0 +1,139 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+)
+
+func TestL7AuditListenerProcessEvent(t *testing.T) {
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod1-abcd", "c1", "pod1", "default", "", nil, []net.IP{net.ParseIP("10.10.1.10")}, 0))
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod2-abcd", "c2", "pod2", "default", "", nil, []net.IP{net.ParseIP("10.10.1.9")}, 0))
+	rules := map[uint32]*rule{
+		1: {Direction: v1beta2.DirectionIn, Name: "test-rule", SourceRef: testANNPRef, EnableLogging: true, LogLabel: "test-label"},
+		2: {Direction: v1beta2.DirectionOut, Name: "test-rule", SourceRef: testANNPRef, EnableLogging: true},
+		3: {Direction: v1beta2.DirectionIn, Name: "test-rule", SourceRef: testANNPRef},
+	}
+	getRule := func(vlanID uint32) (*rule, bool) {
+		r, ok := rules[vlanID]
+		return r, ok
+	}
+
+	tests := []struct {
+		name         string
+		events       []string
+		expectedLogs []string
+	}{
+		{
+			name: "allowed HTTP request",
+			events: []string{
+				`{"flow_id":1,"event_type":"http","tenant_id":1,"src_ip":"10.10.1.9","src_port":55822,"dest_ip":"10.10.1.10","dest_port":80,"proto":"TCP","http":{"hostname":"10.10.1.10","url":"/public/index.html","http_method":"GET"}}`,
+			},
+			expectedLogs: []string{
+				"L7Engine AntreaNetworkPolicy:default/test test-rule Ingress Allow <nil> default/pod1 10.10.1.9 55822 10.10.1.10 80 TCP <nil> test-label http host=\"10.10.1.10\" method=\"GET\" path=\"/public/index.html\"",
+			},
+		},
+		{
+			name: "rejected HTTP request",
+			events: []string{
+				`{"flow_id":2,"event_type":"alert","tenant_id":1,"src_ip":"10.10.1.9","src_port":45034,"dest_ip":"10.10.1.10","dest_port":80,"proto":"TCP","app_proto":"http","alert":{"action":"blocked"},"http":{"hostname":"10.10.1.10","url":"/admin/index.html","http_method":"GET"}}`,
+				`{"flow_id":2,"event_type":"http","tenant_id":1,"src_ip":"10.10.1.9","src_port":45034,"dest_ip":"10.10.1.10","dest_port":80,"proto":"TCP","http":{"hostname":"10.10.1.10","url":"/admin/index.html","http_method":"GET"}}`,
+			},
+			expectedLogs: []string{
+				"L7Engine AntreaNetworkPolicy:default/test test-rule Ingress Reject <nil> default/pod1 10.10.1.9 45034 10.10.1.10 80 TCP <nil> test-label http host=\"10.10.1.10\" method=\"GET\" path=\"/admin/index.html\"",
+			},
+		},
+		{
+			name: "HTTP request with special characters",
+			events: []string{
+				`{"flow_id":6,"event_type":"http","tenant_id":1,"src_ip":"10.10.1.9","src_port":55822,"dest_ip":"10.10.1.10","dest_port":80,"proto":"TCP","http":{"hostname":"10.10.1.10","url":"/a b\nc","http_method":"GET"}}`,
+			},
+			expectedLogs: []string{
+				"http host=\"10.10.1.10\" method=\"GET\" path=\"/a b\\nc\"",
+			},
+		},
+		{
+			name: "same flow ID rejected in another tenant",
+			events: []string{
+				`{"flow_id":7,"event_type":"alert","tenant_id":1,"src_ip":"10.10.1.9","src_port":45034,"dest_ip":"10.10.1.10","dest_port":80,"proto":"TCP","app_proto":"http","alert":{"action":"blocked"},"http":{"hostname":"10.10.1.10","url":"/admin/index.html","http_method":"GET"}}`,
+				`{"flow_id":7,"event_type":"tls","tenant_id":2,"src_ip":"10.10.1.9","src_port":38222,"dest_ip":"1.1.1.1","dest_port":443,"proto":"TCP","tls":{"sni":"www.google.com"}}`,
+			},
+			expectedLogs: []string{
+				"Ingress Reject",
+				"Egress Allow",
+			},
+		},
+		{
+			name: "allowed TLS handshake",
+			events: []string{
+				`{"flow_id":3,"event_type":"tls","tenant_id":2,"src_ip":"10.10.1.9","src_port":38222,"dest_ip":"1.1.1.1","dest_port":443,"proto":"TCP","tls":{"sni":"www.google.com"}}`,
+			},
+			expectedLogs: []string{
+				"L7Engine AntreaNetworkPolicy:default/test test-rule Egress Allow <nil> default/pod2 10.10.1.9 38222 1.1.1.1 443 TCP <nil> <nil> tls sni=\"www.google.com\"",
+			},
+		},
+		{
+			name: "rule without logging",
+			events: []string{
+				`{"flow_id":4,"event_type":"http","tenant_id":3,"src_ip":"10.10.1.9","src_port":55822,"dest_ip":"10.10.1.10","dest_port":80,"proto":"TCP","http":{"hostname":"10.10.1.10","url":"/","http_method":"GET"}}`,
+			},
+		},
+		{
+			name: "unknown tenant and event type",
+			events: []string{
+				`{"flow_id":5,"event_type":"http","tenant_id":4,"src_ip":"10.10.1.9","src_port":55822,"dest_ip":"10.10.1.10","dest_port":80,"proto":"TCP"}`,
+				`{"flow_id":5,"event_type":"flow","tenant_id":1,"src_ip":"10.10.1.9","src_port":55822,"dest_ip":"10.10.1.10","dest_port":80,"proto":"TCP"}`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditLogger, mockNPLogger := newTestAuditLogger(testBufferLength, clock.RealClock{})
+			listener := newL7AuditListener(auditLogger, ifaceStore, getRule)
+			for _, event := range tt.events {
+				require.NoError(t, listener.processEvent([]byte(event)))
+			}
+			for _, expected := range tt.expectedLogs {
+				select {
+				case actual := <-mockNPLogger.logged:
+					assert.Contains(t, actual, expected)
+				case <-time.After(time.Second):
+					t.Fatalf("Expected log %q was not written", expected)
+				}
+			}
+			select {
+			case actual := <-mockNPLogger.logged:
+				t.Errorf("Unexpected log %q", actual)
+			case <-time.After(2 * testBufferLength):
+			}
+		})
+	}
+}
+
+func TestL7AuditListenerProcessInvalidEvent(t *testing.T) {
+	auditLogger, _ := newTestAuditLogger(testBufferLength, clock.RealClock{})
+	listener := newL7AuditListener(auditLogger, interfacestore.NewInterfaceStore(), nil)
+	assert.ErrorContains(t, listener.processEvent([]byte("{")), "error parsing L7 engine event")
+}
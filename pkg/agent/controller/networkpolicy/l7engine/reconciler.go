@@ -48,12 +48,57 @@ const (
 
 	suricataCommandSocket = "/var/run/suricata/suricata-command.socket"
 
-	protocolHTTP = "http"
-	protocolTLS  = "tls"
+	protocolHTTP  = "http"
+	protocolTLS   = "tls"
+	protocolKafka = "kafka"
 
 	scCmdOK = "OK"
 )
 
+// Kafka API keys, which identify the type of Kafka requests. See https://kafka.apache.org/protocol#protocol_api_keys.
+const (
+	kafkaAPIKeyProduce              = 0
+	kafkaAPIKeyFetch                = 1
+	kafkaAPIKeyListOffsets          = 2
+	kafkaAPIKeyMetadata             = 3
+	kafkaAPIKeyOffsetCommit         = 8
+	kafkaAPIKeyOffsetFetch          = 9
+	kafkaAPIKeyFindCoordinator      = 10
+	kafkaAPIKeyJoinGroup            = 11
+	kafkaAPIKeyHeartbeat            = 12
+	kafkaAPIKeyLeaveGroup           = 13
+	kafkaAPIKeySyncGroup            = 14
+	kafkaAPIKeySaslHandshake        = 17
+	kafkaAPIKeyAPIVersions          = 18
+	kafkaAPIKeyInitProducerID       = 22
+	kafkaAPIKeyOffsetForLeaderEpoch = 23
+	kafkaAPIKeySaslAuthenticate     = 36
+
+	kafkaRoleProduce = "produce"
+	kafkaRoleConsume = "consume"
+
+	// kafkaMaxRequestSize is the default maximum size of the requests accepted by Kafka brokers, i.e. the default value
+	// of socket.request.max.bytes. A larger request size means that the connection doesn't carry Kafka requests.
+	kafkaMaxRequestSize = 104857600
+	// kafkaInspectSize is the maximum number of bytes of a Kafka request in which the topic is matched.
+	kafkaInspectSize = 4096
+)
+
+var (
+	// kafkaRoleAPIKeys are the Kafka requests a client needs to send to fulfill a role.
+	kafkaRoleAPIKeys = map[string][]int{
+		kafkaRoleProduce: {kafkaAPIKeyProduce, kafkaAPIKeyMetadata, kafkaAPIKeySaslHandshake, kafkaAPIKeyAPIVersions,
+			kafkaAPIKeyInitProducerID, kafkaAPIKeySaslAuthenticate},
+		kafkaRoleConsume: {kafkaAPIKeyFetch, kafkaAPIKeyListOffsets, kafkaAPIKeyMetadata, kafkaAPIKeyOffsetCommit,
+			kafkaAPIKeyOffsetFetch, kafkaAPIKeyFindCoordinator, kafkaAPIKeyJoinGroup, kafkaAPIKeyHeartbeat,
+			kafkaAPIKeyLeaveGroup, kafkaAPIKeySyncGroup, kafkaAPIKeySaslHandshake, kafkaAPIKeyAPIVersions,
+			kafkaAPIKeyOffsetForLeaderEpoch, kafkaAPIKeySaslAuthenticate},
+	}
+	// kafkaTopicAPIKeys are the Kafka requests which operate on the records of specific topics. The topic of a
+	// KafkaProtocol is only matched against these requests.
+	kafkaTopicAPIKeys = sets.New[int](kafkaAPIKeyProduce, kafkaAPIKeyFetch, kafkaAPIKeyListOffsets, kafkaAPIKeyOffsetCommit)
+)
+
 type scCmdRet struct {
 	Message string `json:"message"`
 	Return  string `json:"return"`
@@ -64,7 +109,9 @@ var (
 	defaultFS = afero.NewOsFs()
 
 	// Create the config file /etc/suricata/antrea.yaml for Antrea which will be included in the default Suricata config file
-	// /etc/suricata/suricata.yaml. Two event logs in the config serve alert gilogging and http event logging purposes respectively.
+	// /etc/suricata/suricata.yaml. The first two event logs in the config serve alert logging and http event logging
+	// purposes respectively. The third event log streams the alert, http and tls events to the Antrea Agent, which adds
+	// the layer 7 metadata of the traffic matching L7 NetworkPolicy rules to the audit logs.
 	suricataAntreaConfigData = fmt.Sprintf(`%%YAML 1.1
 ---
 outputs:
@@ -97,6 +144,23 @@ outputs:
       types:
         - http:
             extended: yes
+  - eve-log:
+      enabled: yes
+      filetype: unix_stream
+      filename: %[4]s
+      pcap-file: false
+      community-id: false
+      community-id-seed: 0
+      xff:
+        enabled: no
+      types:
+        - alert:
+            metadata:
+              app-layer: yes
+        - http:
+            extended: yes
+        - tls:
+            extended: yes
 af-packet:
   - interface: %[2]s
     threads: auto
@@ -121,7 +185,147 @@ af-packet:
 multi-detect:
   enabled: yes
   selector: vlan
-`, config.L7SuricataSocketPath, config.L7RedirectTargetPortName, config.L7RedirectReturnPortName)
+security:
+  lua:
+    allow-rules: yes
+`, config.L7SuricataSocketPath, config.L7RedirectTargetPortName, config.L7RedirectReturnPortName, config.L7SuricataAuditSocketPath)
+
+	// kafkaScriptTemplate is the Lua script used by the Suricata rule rejecting the Kafka requests which are not allowed
+	// by a L7 NetworkPolicy rule. As Suricata doesn't have a Kafka parser, the script frames the requests sent by the
+	// client: a Kafka request starts with the request size (int32), followed by the API key (int16). The script is
+	// called for every TCP segment sent by the client, which may carry multiple pipelined requests or a part of a
+	// request, and it returns 1 as soon as a request is not allowed, so that the connection is rejected before the
+	// broker receives the whole request. The state of the framing is kept in flowvars: the sequence number following
+	// the inspected data, which is used to skip retransmitted data, the number of bytes of the current request which
+	// don't need to be inspected, and the beginning of the current request if it cannot be inspected yet.
+	kafkaScriptTemplate = `-- Generated by Antrea for %[1]s.
+local allowedRequests = {
+%[2]s}
+local topicAPIKeys = {%[3]s}
+local maxRequestSize = %[4]d
+local inspectSize = %[5]d
+
+function init(args)
+    local needs = {}
+    needs["packet"] = tostring(true)
+    needs["payload"] = tostring(true)
+    needs["flowvar"] = {"antrea_kafka_seq", "antrea_kafka_skip", "antrea_kafka_pending"}
+    return needs
+end
+
+local function readUint(data, pos, size)
+    local value = 0
+    for i = pos, pos + size - 1 do
+        value = value * 256 + data:byte(i)
+    end
+    return value
+end
+
+-- tcpSeq returns the sequence number of the TCP segment in the Ethernet frame, or nil if it cannot be parsed.
+local function tcpSeq(packet)
+    local pos = 13
+    if #packet < pos + 1 then
+        return nil
+    end
+    local etherType = readUint(packet, pos, 2)
+    while etherType == 0x8100 or etherType == 0x88a8 do
+        pos = pos + 4
+        if #packet < pos + 1 then
+            return nil
+        end
+        etherType = readUint(packet, pos, 2)
+    end
+    local ip = pos + 2
+    local tcp
+    if etherType == 0x0800 and #packet >= ip + 19 and packet:byte(ip + 9) == 6 then
+        tcp = ip + packet:byte(ip) %% 16 * 4
+    elseif etherType == 0x86dd and #packet >= ip + 39 and packet:byte(ip + 6) == 6 then
+        tcp = ip + 40
+    else
+        return nil
+    end
+    if #packet < tcp + 7 then
+        return nil
+    end
+    return readUint(packet, tcp + 4, 4)
+end
+
+local function isAllowed(apiKey, request)
+    for _, allowed in ipairs(allowedRequests) do
+        if allowed.apiKeys == nil or allowed.apiKeys[apiKey] then
+            if allowed.topics == nil or not topicAPIKeys[apiKey] then
+                return true
+            end
+            for _, topic in ipairs(allowed.topics) do
+                if request:find(topic, 1, true) then
+                    return true
+                end
+            end
+        end
+    end
+    return false
+end
+
+local function setFlowvar(index, value)
+    ScFlowvarSet(index, value, #value)
+end
+
+function match(args)
+    local payload = args["payload"]
+    if payload == nil or #payload == 0 then
+        return 0
+    end
+    local seq = args["packet"] and tcpSeq(args["packet"])
+    if seq == nil then
+        return 1
+    end
+    local nextSeq = (seq + #payload) %% 4294967296
+    local expectedSeq = tonumber(ScFlowvarGet(0))
+    if expectedSeq ~= nil then
+        local ahead = (seq - expectedSeq) %% 4294967296
+        if ahead >= 2147483648 then
+            -- The segment is retransmitted, only the data following the inspected data is inspected.
+            local overlap = 4294967296 - ahead
+            if overlap >= #payload then
+                return 0
+            end
+            payload = payload:sub(overlap + 1)
+        elseif ahead > 0 then
+            -- Data is missing before the segment, so the beginning of the requests in the segment is unknown.
+            return 1
+        end
+    end
+    local pending = ScFlowvarGet(2)
+    local data = (pending and pending:sub(2) or "") .. payload
+    local pos = (tonumber(ScFlowvarGet(1)) or 0) + 1
+    while pos + 5 <= #data do
+        local size = readUint(data, pos, 4)
+        if size < 8 or size > maxRequestSize then
+            return 1
+        end
+        local inspectLength = math.min(size, inspectSize)
+        if pos + 3 + inspectLength > #data then
+            break
+        end
+        if not isAllowed(readUint(data, pos + 4, 2), data:sub(pos + 4, pos + 3 + inspectLength)) then
+            return 1
+        end
+        pos = pos + 4 + size
+    end
+    local skip = 0
+    -- The pending data is prefixed so that the flowvar is never empty.
+    pending = "-"
+    if pos > #data then
+        skip = pos - 1 - #data
+    else
+        pending = pending .. data:sub(pos)
+    end
+    setFlowvar(0, tostring(nextSeq))
+    setFlowvar(1, tostring(skip))
+    setFlowvar(2, pending)
+    return 0
+end
+`
 )
 
 type threadSafeSet[T comparable] struct {
@@ -175,10 +379,19 @@ func NewReconciler(ofClient openflow.Client) *Reconciler {
 	}
 }
 
-func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Set[string]) *bytes.Buffer {
+func generateTenantRulesData(policyName string, protoKeywords map[string]sets.Set[string], kafkaScriptPath string) *bytes.Buffer {
 	rulesData := bytes.NewBuffer(nil)
 	sid := 1
 
+	// Kafka requests are inspected one by one by a Lua script, which rejects the connection as soon as a request is
+	// not allowed, as a pass rule would allow the whole connection. As Kafka cannot be used with other protocols in a
+	// rule, neither the default reject rule nor pass rules are generated.
+	if kafkaScriptPath != "" {
+		allKeywords := fmt.Sprintf(`msg: "Reject %s by %s"; flow: to_server, established; lua: %s; sid: %d;`, protocolKafka, policyName, kafkaScriptPath, sid)
+		rulesData.WriteString(fmt.Sprintf("reject tcp any any -> any any (%s)\n", allKeywords))
+		return rulesData
+	}
+
 	// Generate default reject rule.
 	allKeywords := fmt.Sprintf(`msg: "Reject by %s"; flow: to_server, established; sid: %d;`, policyName, sid)
 	rule := fmt.Sprintf("reject ip any any -> any any (%s)\n", allKeywords)
@@ -208,6 +421,21 @@ func generateTenantRulesPath(vlanID uint32) string {
 	return fmt.Sprintf("%s/antrea-l7-networkpolicy-%d.rules", tenantRulesDir, vlanID)
 }
 
+func generateTenantKafkaScriptPath(vlanID uint32) string {
+	return fmt.Sprintf("%s/antrea-l7-networkpolicy-%d-kafka.lua", tenantRulesDir, vlanID)
+}
+
+// generateKafkaScriptData generates the Lua script rejecting the Kafka requests which don't match any of the given
+// Lua tables of allowed requests.
+func generateKafkaScriptData(policyName string, allowedRequests sets.Set[string]) *bytes.Buffer {
+	var requests strings.Builder
+	for _, allowed := range sets.List(allowedRequests) {
+		fmt.Fprintf(&requests, "    %s,\n", allowed)
+	}
+	return bytes.NewBufferString(fmt.Sprintf(kafkaScriptTemplate, policyName, requests.String(),
+		luaIntSet(sets.List(kafkaTopicAPIKeys)), kafkaMaxRequestSize, kafkaInspectSize))
+}
+
 func generateTenantConfigPath(vlanID uint32) string {
 	return fmt.Sprintf("%s/antrea-tenant-%d.yaml", tenantConfigsDir, vlanID)
 }
@@ -266,6 +494,54 @@ func convertProtocolTLS(tls *v1beta.TLSProtocol) string {
 	return strings.Join(keywords, " ")
 }
 
+// convertProtocolKafka returns the Lua table of the Kafka requests allowed by the KafkaProtocol, which is matched by the
+// script generated by generateKafkaScriptData. The table has the API keys of the allowed requests, and the encoded
+// topic which must be found in the requests operating on the records of topics. A missing field matches everything.
+// As Kafka requests don't have a fixed layout across API keys and versions, the topic is matched anywhere in the first
+// kafkaInspectSize bytes of the request.
+func convertProtocolKafka(kafka *v1beta.KafkaProtocol) string {
+	var apiKeys []int
+	if kafka.Role != "" {
+		apiKeys = kafkaRoleAPIKeys[kafka.Role]
+	} else if kafka.Topic != "" {
+		apiKeys = sets.List(sets.New(kafkaRoleAPIKeys[kafkaRoleProduce]...).Insert(kafkaRoleAPIKeys[kafkaRoleConsume]...))
+	}
+	var fields []string
+	if len(apiKeys) > 0 {
+		fields = append(fields, fmt.Sprintf("apiKeys = {%s}", luaIntSet(apiKeys)))
+	}
+	if kafka.Topic != "" {
+		fields = append(fields, fmt.Sprintf("topics = {%s}", strings.Join(kafkaEncodedTopics(kafka.Topic), ", ")))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(fields, ", "))
+}
+
+// luaIntSet returns the fields of a Lua table used as a set of the integers.
+func luaIntSet(values []int) string {
+	fields := make([]string, 0, len(values))
+	for _, v := range values {
+		fields = append(fields, fmt.Sprintf("[%d] = true", v))
+	}
+	return strings.Join(fields, ", ")
+}
+
+// kafkaEncodedTopics returns the Lua string literals of the topic name prefixed by its length, which is encoded as an
+// int16 in legacy request versions or as an unsigned varint of the length plus one in flexible request versions. A
+// valid topic name is at most 249 characters long and only contains ASCII alphanumerics, '.', '_' and '-', so it
+// doesn't need to be escaped. The length is written with 3-digit decimal escapes, which cannot absorb the following
+// digits of the topic name.
+func kafkaEncodedTopics(topic string) []string {
+	length := len(topic)
+	legacyPrefix := fmt.Sprintf(`\000\%03d`, length)
+	var compactPrefix string
+	if length+1 < 0x80 {
+		compactPrefix = fmt.Sprintf(`\%03d`, length+1)
+	} else {
+		compactPrefix = fmt.Sprintf(`\%03d\%03d`, (length+1)&0x7f|0x80, (length+1)>>7)
+	}
+	return []string{fmt.Sprintf(`"%s%s"`, legacyPrefix, topic), fmt.Sprintf(`"%s%s"`, compactPrefix, topic)}
+}
+
 func (r *Reconciler) StartSuricataOnce() error {
 	return r.startSuricataOnce.Do(r.startSuricata)
 }
@@ -290,8 +566,9 @@ func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protoco
 		return err
 	}
 
-	// Generate the keyword part used in Suricata rules.
+	// Generate the keyword part used in Suricata rules, and the Kafka requests allowed by the Lua script.
 	protoKeywords := make(map[string]sets.Set[string])
+	kafkaRequests := sets.New[string]()
 	for _, protocol := range l7Protocols {
 		if protocol.HTTP != nil {
 			httpKeywords := convertProtocolHTTP(protocol.HTTP)
@@ -307,12 +584,25 @@ func (r *Reconciler) AddRule(ruleID, policyName string, vlanID uint32, l7Protoco
 			}
 			protoKeywords[protocolTLS].Insert(tlsKeywords)
 		}
+		if protocol.Kafka != nil {
+			kafkaRequests.Insert(convertProtocolKafka(protocol.Kafka))
+		}
 	}
 
 	klog.InfoS("Reconciling L7 rule", "RuleID", ruleID, "PolicyName", policyName)
+	// Write the Lua script matching Kafka requests to file, or delete the stale one if the rule has no Kafka protocol.
+	var kafkaScriptPath string
+	if kafkaRequests.Len() > 0 {
+		kafkaScriptPath = generateTenantKafkaScriptPath(vlanID)
+		if err := writeConfigFile(kafkaScriptPath, generateKafkaScriptData(policyName, kafkaRequests)); err != nil {
+			return fmt.Errorf("failed to write Kafka script to file %s for L7 rule %s of %s, err: %w", kafkaScriptPath, ruleID, policyName, err)
+		}
+	} else {
+		removeKafkaScript(vlanID, ruleID)
+	}
 	// Write the Suricata rules to file.
 	rulesPath := generateTenantRulesPath(vlanID)
-	rulesData := generateTenantRulesData(policyName, protoKeywords)
+	rulesData := generateTenantRulesData(policyName, protoKeywords, kafkaScriptPath)
 	if err := writeConfigFile(rulesPath, rulesData); err != nil {
 		return fmt.Errorf("failed to write Suricata rules data to file %s for L7 rule %s of %s, err: %w", rulesPath, ruleID, policyName, err)
 	}
@@ -340,10 +630,18 @@ func (r *Reconciler) DeleteRule(ruleID string, vlanID uint32) error {
 	if err := defaultFS.Remove(rulesPath); err != nil {
 		klog.ErrorS(err, "Failed to delete rules file", "FilePath", rulesPath, "RuleID", ruleID)
 	}
+	removeKafkaScript(vlanID, ruleID)
 
 	return nil
 }
 
+func removeKafkaScript(vlanID uint32, ruleID string) {
+	kafkaScriptPath := generateTenantKafkaScriptPath(vlanID)
+	if err := defaultFS.Remove(kafkaScriptPath); err != nil && !os.IsNotExist(err) {
+		klog.ErrorS(err, "Failed to delete Kafka script file", "FilePath", kafkaScriptPath, "RuleID", ruleID)
+	}
+}
+
 func (r *Reconciler) addBindingSuricataTenant(vlanID uint32, rulesPath string) error {
 	tenantConfigPath := generateTenantConfigPath(vlanID)
 	exists, err := afero.Exists(defaultFS, tenantConfigPath)
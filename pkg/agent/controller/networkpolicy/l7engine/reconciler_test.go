@@ -16,6 +16,7 @@ package l7engine
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -121,6 +122,63 @@ func TestConvertProtocolTLS(t *testing.T) {
 	}
 }
 
+func TestConvertProtocolKafka(t *testing.T) {
+	testCases := []struct {
+		name     string
+		kafka    *v1beta.KafkaProtocol
+		expected string
+	}{
+		{
+			name:     "without role and topic",
+			kafka:    &v1beta.KafkaProtocol{},
+			expected: "{}",
+		},
+		{
+			name: "with role",
+			kafka: &v1beta.KafkaProtocol{
+				Role: "produce",
+			},
+			expected: "{apiKeys = {[0] = true, [3] = true, [17] = true, [18] = true, [22] = true, [36] = true}}",
+		},
+		{
+			name: "with role and topic",
+			kafka: &v1beta.KafkaProtocol{
+				Role:  "produce",
+				Topic: "app.orders",
+			},
+			expected: `{apiKeys = {[0] = true, [3] = true, [17] = true, [18] = true, [22] = true, [36] = true}, topics = {"\000\010app.orders", "\011app.orders"}}`,
+		},
+		{
+			name: "with topic",
+			kafka: &v1beta.KafkaProtocol{
+				Topic: "orders",
+			},
+			expected: `{apiKeys = {[0] = true, [1] = true, [2] = true, [3] = true, [8] = true, [9] = true, [10] = true, [11] = true, [12] = true, [13] = true, [14] = true, [17] = true, [18] = true, [22] = true, [23] = true, [36] = true}, topics = {"\000\006orders", "\007orders"}}`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, convertProtocolKafka(tc.kafka))
+		})
+	}
+}
+
+func TestKafkaEncodedTopics(t *testing.T) {
+	assert.Equal(t, []string{`"\000\0061topic"`, `"\0071topic"`}, kafkaEncodedTopics("1topic"))
+	// The length of a long topic name takes two bytes when encoded as an unsigned varint.
+	assert.Equal(t, []string{`"\000\200` + strings.Repeat("a", 200) + `"`, `"\201\001` + strings.Repeat("a", 200) + `"`}, kafkaEncodedTopics(strings.Repeat("a", 200)))
+}
+
+func TestGenerateKafkaScriptData(t *testing.T) {
+	script := generateKafkaScriptData("AntreaNetworkPolicy:test-l7", sets.New[string]("{apiKeys = {[3] = true}}", "{}")).String()
+	assert.True(t, strings.HasPrefix(script, "-- Generated by Antrea for AntreaNetworkPolicy:test-l7.\n"))
+	assert.Contains(t, script, "local allowedRequests = {\n    {apiKeys = {[3] = true}},\n    {},\n}\n")
+	assert.Contains(t, script, "local topicAPIKeys = {[0] = true, [1] = true, [2] = true, [8] = true}\n")
+	assert.Contains(t, script, "local maxRequestSize = 104857600\nlocal inspectSize = 4096\n")
+	assert.Contains(t, script, "local ahead = (seq - expectedSeq) % 4294967296\n")
+	assert.NotContains(t, script, "%!")
+}
+
 func TestStartSuricata(t *testing.T) {
 	defaultFS = afero.NewMemMapFs()
 	defer func() {
@@ -157,6 +215,8 @@ func TestRuleLifecycle(t *testing.T) {
 		updatedL7Protocols   []v1beta.L7Protocol
 		expectedRules        string
 		expectedUpdatedRules string
+		// expectedKafkaScript is part of the Lua script generated for the Kafka protocols, if any.
+		expectedKafkaScript string
 	}{
 		{
 			name: "protocol HTTP",
@@ -177,6 +237,25 @@ func TestRuleLifecycle(t *testing.T) {
 			expectedRules:        `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; http.uri; content:"/index.html"; startswith; endswith; http.method; content:"GET"; http.host; content:"www.google.com"; startswith; endswith; sid: 2;)`,
 			expectedUpdatedRules: `pass http any any -> any any (msg: "Allow http by AntreaNetworkPolicy:test-l7"; sid: 2;)`,
 		},
+		{
+			name: "protocol Kafka",
+			l7Protocols: []v1beta.L7Protocol{
+				{
+					Kafka: &v1beta.KafkaProtocol{
+						Role:  "produce",
+						Topic: "orders",
+					},
+				},
+			},
+			updatedL7Protocols: []v1beta.L7Protocol{
+				{
+					Kafka: &v1beta.KafkaProtocol{},
+				},
+			},
+			expectedRules:        `reject tcp any any -> any any (msg: "Reject kafka by AntreaNetworkPolicy:test-l7"; flow: to_server, established; lua: /etc/suricata/rules/antrea-l7-networkpolicy-1-kafka.lua; sid: 1;)`,
+			expectedUpdatedRules: `reject tcp any any -> any any (msg: "Reject kafka by AntreaNetworkPolicy:test-l7"; flow: to_server, established; lua: /etc/suricata/rules/antrea-l7-networkpolicy-1-kafka.lua; sid: 1;)`,
+			expectedKafkaScript:  `topics = {"\000\006orders", "\007orders"}`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -206,6 +285,17 @@ func TestRuleLifecycle(t *testing.T) {
 			assert.NoError(t, err)
 			assert.True(t, ok)
 
+			kafkaScriptPath := generateTenantKafkaScriptPath(vlanID)
+			if tc.expectedKafkaScript != "" {
+				ok, err = afero.FileContainsBytes(defaultFS, kafkaScriptPath, []byte(tc.expectedKafkaScript))
+				assert.NoError(t, err)
+				assert.True(t, ok)
+			} else {
+				exists, err := afero.Exists(defaultFS, kafkaScriptPath)
+				assert.NoError(t, err)
+				assert.False(t, exists)
+			}
+
 			configPath := generateTenantConfigPath(vlanID)
 			ok, err = afero.FileContainsBytes(defaultFS, configPath, []byte(rulesPath))
 			assert.NoError(t, err)
@@ -232,6 +322,10 @@ func TestRuleLifecycle(t *testing.T) {
 			exists, err = afero.Exists(defaultFS, configPath)
 			assert.NoError(t, err)
 			assert.False(t, exists)
+
+			exists, err = afero.Exists(defaultFS, kafkaScriptPath)
+			assert.NoError(t, err)
+			assert.False(t, exists)
 		})
 	}
 }
@@ -20,15 +20,18 @@ import (
 	"net"
 	"reflect"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"antrea.io/ofnet/ofctrl"
 	"github.com/spf13/afero"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/runtime/serializer/protobuf"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/util/workqueue"
@@ -44,6 +47,7 @@ import (
 	proxytypes "antrea.io/antrea/pkg/agent/proxy/types"
 	"antrea.io/antrea/pkg/agent/route"
 	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/apis/controlplane/install"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	"antrea.io/antrea/pkg/querier"
@@ -143,6 +147,8 @@ type Controller struct {
 	// ofClient registers packetin for Antrea Policy logging.
 	ofClient    openflow.Client
 	auditLogger *AuditLogger
+	// l7AuditListener writes the audit logs of the traffic analyzed by the layer 7 engine.
+	l7AuditListener *l7AuditListener
 	// statusManager syncs NetworkPolicy statuses with the antrea-controller.
 	// It's only for Antrea NetworkPolicies.
 	statusManager         StatusManager
@@ -152,8 +158,8 @@ type Controller struct {
 	addressGroupWatcher   *watcher
 	fullSyncGroup         sync.WaitGroup
 	ifaceStore            interfacestore.InterfaceStore
-	// denyConnStore is for storing deny connections for flow exporter.
-	denyConnStore  *connections.DenyConnectionStore
+	// denyConnStore is for storing deny connections for flow exporter. It is nil when flow exporter is not running.
+	denyConnStore  atomic.Pointer[connections.DenyConnectionStore]
 	gwPort         uint32
 	tunPort        uint32
 	nodeConfig     *config.NodeConfig
@@ -165,6 +171,10 @@ type Controller struct {
 	appliedToGroupStore *fileStore
 	addressGroupStore   *fileStore
 
+	// podRealizationTracker keeps track of the Pods for which NetworkPolicy rules have been realized. It's only
+	// used by the Pod readiness gate.
+	podRealizationTracker *podRealizationTracker
+
 	logPacketAction           packetInAction
 	rejectRequestAction       packetInAction
 	storeDenyConnectionAction packetInAction
@@ -197,7 +207,8 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 	nodeConfig *config.NodeConfig,
 	podNetworkWait *utilwait.Group,
 	l7Reconciler *l7engine.Reconciler,
-	fqdnCacheMinTTL uint32) (*Controller, error) {
+	fqdnCacheConfig FQDNCacheConfig,
+	podReadinessGateEnabled bool) (*Controller, error) {
 	idAllocator := newIDAllocator(asyncRuleDeleteInterval, dnsInterceptRuleID)
 	c := &Controller{
 		antreaClientProvider: antreaClientGetter,
@@ -228,7 +239,7 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 
 	var err error
 	if antreaPolicyEnabled {
-		if c.fqdnController, err = newFQDNController(ofClient, idAllocator, dnsServerOverride, c.enqueueRule, v4Enabled, v6Enabled, gwPort, clock.RealClock{}, fqdnCacheMinTTL); err != nil {
+		if c.fqdnController, err = newFQDNController(ofClient, idAllocator, dnsServerOverride, c.enqueueRule, v4Enabled, v6Enabled, gwPort, clock.RealClock{}, fqdnCacheConfig); err != nil {
 			return nil, err
 		}
 
@@ -263,6 +274,9 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 	if statusManagerEnabled {
 		c.statusManager = newStatusController(antreaClientGetter, nodeName, c.ruleCache)
 	}
+	if podReadinessGateEnabled {
+		c.podRealizationTracker = newPodRealizationTracker()
+	}
 	// Create a WaitGroup that is used to block network policy workers from asynchronously processing
 	// NP rules until the events preceding bookmark are synced. It can also be used as part of the
 	// solution to a deterministic mechanism for when to cleanup flows from previous round.
@@ -279,6 +293,9 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 				return nil, err
 			}
 			c.auditLogger = auditLogger
+			if l7NetworkPolicyEnabled {
+				c.l7AuditListener = newL7AuditListener(auditLogger, ifaceStore, c.getL7Rule)
+			}
 		}
 	}
 
@@ -289,12 +306,12 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 
 	c.networkPolicyWatcher = &watcher{
 		objectType: "NetworkPolicy",
-		watchFunc: func() (watch.Interface, error) {
+		watchFunc: func(resourceVersion string) (watch.Interface, error) {
 			antreaClient, err := c.antreaClientProvider.GetAntreaClient()
 			if err != nil {
 				return nil, err
 			}
-			return antreaClient.ControlplaneV1beta2().NetworkPolicies().Watch(context.TODO(), options)
+			return antreaClient.ControlplaneV1beta2().NetworkPolicies().Watch(context.TODO(), resumableWatchOptions(options, resourceVersion))
 		},
 		AddFunc: func(obj runtime.Object) error {
 			policy, ok := obj.(*v1beta2.NetworkPolicy)
@@ -333,7 +350,7 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 			updated := c.ruleCache.UpdateNetworkPolicy(policy)
 			// If any rule or the generation changes, we ensure statusManager will resync the policy's status once, in
 			// case the changes don't cause any actual rule update but the whole policy's generation is changed.
-			if c.statusManagerEnabled && updated && v1beta2.IsSourceAntreaNativePolicy(policy.SourceRef) {
+			if c.statusManagerEnabled && updated && v1beta2.IsRealizationStatusReported(policy.SourceRef) {
 				c.statusManager.Resync(policy.UID)
 			}
 			return nil
@@ -373,7 +390,7 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 				// For the former case, agent must resync the statuses as the controller lost the previous statuses.
 				// For the latter case, agent doesn't need to do anything. However, we are not able to differentiate the
 				// two cases. Anyway there's no harm to do a periodical resync.
-				if c.statusManagerEnabled && v1beta2.IsSourceAntreaNativePolicy(policies[i].SourceRef) {
+				if c.statusManagerEnabled && v1beta2.IsRealizationStatusReported(policies[i].SourceRef) {
 					c.statusManager.Resync(policies[i].UID)
 				}
 			}
@@ -392,12 +409,12 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 
 	c.appliedToGroupWatcher = &watcher{
 		objectType: "AppliedToGroup",
-		watchFunc: func() (watch.Interface, error) {
+		watchFunc: func(resourceVersion string) (watch.Interface, error) {
 			antreaClient, err := c.antreaClientProvider.GetAntreaClient()
 			if err != nil {
 				return nil, err
 			}
-			return antreaClient.ControlplaneV1beta2().AppliedToGroups().Watch(context.TODO(), options)
+			return antreaClient.ControlplaneV1beta2().AppliedToGroups().Watch(context.TODO(), resumableWatchOptions(options, resourceVersion))
 		},
 		AddFunc: func(obj runtime.Object) error {
 			group, ok := obj.(*v1beta2.AppliedToGroup)
@@ -463,12 +480,12 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 
 	c.addressGroupWatcher = &watcher{
 		objectType: "AddressGroup",
-		watchFunc: func() (watch.Interface, error) {
+		watchFunc: func(resourceVersion string) (watch.Interface, error) {
 			antreaClient, err := c.antreaClientProvider.GetAntreaClient()
 			if err != nil {
 				return nil, err
 			}
-			return antreaClient.ControlplaneV1beta2().AddressGroups().Watch(context.TODO(), options)
+			return antreaClient.ControlplaneV1beta2().AddressGroups().Watch(context.TODO(), resumableWatchOptions(options, resourceVersion))
 		},
 		AddFunc: func(obj runtime.Object) error {
 			group, ok := obj.(*v1beta2.AddressGroup)
@@ -540,6 +557,8 @@ func NewNetworkPolicyController(antreaClientGetter client.AntreaClientProvider,
 
 func (c *Controller) GetFQDNCache(fqdnFilter *querier.FQDNCacheFilter) []types.DnsCacheEntry {
 	cacheEntryList := []types.DnsCacheEntry{}
+	c.fqdnController.fqdnSelectorMutex.Lock()
+	defer c.fqdnController.fqdnSelectorMutex.Unlock()
 	for fqdn, dnsMeta := range c.fqdnController.dnsEntryCache {
 		for _, ipWithExpiration := range dnsMeta.responseIPs {
 			if fqdnFilter == nil || fqdnFilter.DomainRegex.MatchString(fqdn) {
@@ -548,6 +567,13 @@ func (c *Controller) GetFQDNCache(fqdnFilter *querier.FQDNCacheFilter) []types.D
 			}
 		}
 	}
+	for fqdn, ips := range c.fqdnController.staticIPs {
+		if fqdnFilter == nil || fqdnFilter.DomainRegex.MatchString(fqdn) {
+			for _, ip := range ips {
+				cacheEntryList = append(cacheEntryList, types.DnsCacheEntry{FQDNName: fqdn, IPAddress: ip, Static: true})
+			}
+		}
+	}
 	return cacheEntryList
 }
 
@@ -608,8 +634,10 @@ func (c *Controller) GetControllerConnectionStatus() bool {
 	return c.addressGroupWatcher.isConnected() && c.appliedToGroupWatcher.isConnected() && c.networkPolicyWatcher.isConnected()
 }
 
+// SetDenyConnStore sets the store of deny connections, or unsets it if denyConnStore is nil, e.g. when flow exporter
+// is disabled at runtime.
 func (c *Controller) SetDenyConnStore(denyConnStore *connections.DenyConnectionStore) {
-	c.denyConnStore = denyConnStore
+	c.denyConnStore.Store(denyConnStore)
 }
 
 // Run begins watching and processing Antrea AddressGroups, AppliedToGroups
@@ -671,6 +699,10 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		go c.statusManager.Run(stopCh)
 	}
 
+	if c.l7AuditListener != nil {
+		go c.l7AuditListener.Run(stopCh)
+	}
+
 	<-stopCh
 }
 
@@ -789,6 +821,9 @@ func (c *Controller) syncRule(key string) error {
 			// harmless to delete it.
 			c.statusManager.DeleteRuleRealization(key)
 		}
+		if c.podRealizationTracker != nil {
+			c.podRealizationTracker.deleteRule(key)
+		}
 		if c.l7NetworkPolicyEnabled {
 			if vlanID := c.l7VlanIDAllocator.query(key); vlanID != 0 {
 				if err := c.l7RuleReconciler.DeleteRule(key, vlanID); err != nil {
@@ -826,7 +861,14 @@ func (c *Controller) syncRule(key string) error {
 	if isNodeNetworkPolicy {
 		err = c.nodeReconciler.Reconcile(rule)
 	} else {
+		var pods sets.Set[string]
+		if c.podRealizationTracker != nil {
+			pods = realizablePods(rule, c.ifaceStore)
+		}
 		err = c.podReconciler.Reconcile(rule)
+		if err == nil && c.podRealizationTracker != nil {
+			c.podRealizationTracker.setRealizedPods(key, pods)
+		}
 		if c.fqdnController != nil {
 			// No matter whether the rule reconciliation succeeds or not, fqdnController
 			// needs to be notified of the status.
@@ -837,7 +879,7 @@ func (c *Controller) syncRule(key string) error {
 	if err != nil {
 		return err
 	}
-	if c.statusManagerEnabled && v1beta2.IsSourceAntreaNativePolicy(rule.SourceRef) {
+	if c.statusManagerEnabled && v1beta2.IsRealizationStatusReported(rule.SourceRef) {
 		c.statusManager.SetRuleRealization(key, rule.PolicyUID)
 	}
 	return nil
@@ -888,18 +930,28 @@ func (c *Controller) syncRules(keys []string) error {
 			return err
 		}
 	}
+	var realizablePodsByRule map[string]sets.Set[string]
+	if c.podRealizationTracker != nil {
+		realizablePodsByRule = make(map[string]sets.Set[string], len(allPodRules))
+		for _, rule := range allPodRules {
+			realizablePodsByRule[rule.ID] = realizablePods(rule, c.ifaceStore)
+		}
+	}
 	if err := c.podReconciler.BatchReconcile(allPodRules); err != nil {
 		return err
 	}
+	for ruleID, pods := range realizablePodsByRule {
+		c.podRealizationTracker.setRealizedPods(ruleID, pods)
+	}
 	if c.statusManagerEnabled {
 		for _, rule := range allPodRules {
-			if v1beta2.IsSourceAntreaNativePolicy(rule.SourceRef) {
+			if v1beta2.IsRealizationStatusReported(rule.SourceRef) {
 				c.statusManager.SetRuleRealization(rule.ID, rule.PolicyUID)
 			}
 		}
 		if c.nodeNetworkPolicyEnabled {
 			for _, rule := range allNodeRules {
-				if v1beta2.IsSourceAntreaNativePolicy(rule.SourceRef) {
+				if v1beta2.IsRealizationStatusReported(rule.SourceRef) {
 					c.statusManager.SetRuleRealization(rule.ID, rule.PolicyUID)
 				}
 			}
@@ -923,8 +975,9 @@ func (c *Controller) handleErr(err error, key string) {
 type watcher struct {
 	// objectType is the type of objects being watched, used for logging.
 	objectType string
-	// watchFunc is the function that starts the watch.
-	watchFunc func() (watch.Interface, error)
+	// watchFunc is the function that starts the watch, resuming the previous one from the provided resourceVersion if
+	// it's not empty.
+	watchFunc func(resourceVersion string) (watch.Interface, error)
 	// AddFunc is the function that handles added event.
 	AddFunc func(obj runtime.Object) error
 	// UpdateFunc is the function that handles modified event.
@@ -943,6 +996,30 @@ type watcher struct {
 	fullSyncWaitGroup *sync.WaitGroup
 	// fullSynced indicates if the resource has been synced at least once since agent started.
 	fullSynced bool
+	// resourceVersion is the resourceVersion of the last bookmark event received, up to which all events have been
+	// handled. It's used to resume the watch after it's disconnected, without receiving all objects again.
+	resourceVersion string
+}
+
+// resumableWatchOptions returns the options to watch resources, with bookmark events enabled and resuming the
+// previous watch from the provided resourceVersion.
+func resumableWatchOptions(options metav1.ListOptions, resourceVersion string) metav1.ListOptions {
+	options.AllowWatchBookmarks = true
+	options.ResourceVersion = resourceVersion
+	return options
+}
+
+// updateResourceVersion records the resourceVersion of a bookmark event. It returns whether the bookmark event
+// indicates that the watch resumes the previous one.
+func (w *watcher) updateResourceVersion(obj runtime.Object) bool {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	if resourceVersion := accessor.GetResourceVersion(); resourceVersion != "" {
+		w.resourceVersion = resourceVersion
+	}
+	return accessor.GetAnnotations()[apis.WatchResumedAnnotationKey] == "true"
 }
 
 func (w *watcher) isConnected() bool {
@@ -986,7 +1063,7 @@ func (w *watcher) onFullSync() {
 
 func (w *watcher) watch() {
 	klog.Infof("Starting watch for %s", w.objectType)
-	watcher, err := w.watchFunc()
+	watcher, err := w.watchFunc(w.resourceVersion)
 	if err != nil {
 		klog.Warningf("Failed to start watch for %s: %v", w.objectType, err)
 		w.fallback()
@@ -1009,15 +1086,27 @@ func (w *watcher) watch() {
 		watcher.Stop()
 	}()
 
+	// If an event cannot be handled, the next watch must not resume this one but receive all objects again.
+	handled := false
+	defer func() {
+		if !handled {
+			w.resourceVersion = ""
+		}
+	}()
+
 	// First receive init events from the result channel and buffer them until
 	// a Bookmark event is received, indicating that all init events have been
-	// received.
+	// received. If the Bookmark event indicates that the previous watch is
+	// resumed, there are no init events, and the following events are the
+	// ones missed since the previous watch.
 	var initObjects []runtime.Object
+	resumed := false
 loop:
 	for {
 		event, ok := <-watcher.ResultChan()
 		if !ok {
 			klog.Warningf("Result channel for %s was closed", w.objectType)
+			handled = true
 			return
 		}
 		switch event.Type {
@@ -1025,25 +1114,34 @@ loop:
 			klog.V(2).Infof("Added %s (%#v)", w.objectType, event.Object)
 			initObjects = append(initObjects, event.Object)
 		case watch.Bookmark:
+			resumed = w.updateResourceVersion(event.Object)
 			break loop
 		}
 	}
-	klog.Infof("Received %d init events for %s", len(initObjects), w.objectType)
+	if resumed {
+		klog.InfoS("Resumed watch", "objectType", w.objectType, "resourceVersion", w.resourceVersion)
+	} else {
+		klog.Infof("Received %d init events for %s", len(initObjects), w.objectType)
 
-	eventCount += len(initObjects)
-	if err := w.ReplaceFunc(initObjects); err != nil {
-		klog.Errorf("Failed to handle init events: %v", err)
-		return
+		eventCount += len(initObjects)
+		if err := w.ReplaceFunc(initObjects); err != nil {
+			klog.Errorf("Failed to handle init events: %v", err)
+			return
+		}
+		w.onFullSync()
 	}
-	w.onFullSync()
 
 	for {
 		event, ok := <-watcher.ResultChan()
 		if !ok {
+			handled = true
 			return
 		}
 		klog.V(2).InfoS("Received event", "eventType", event.Type, "objectType", w.objectType, "object", event.Object)
 		switch event.Type {
+		case watch.Bookmark:
+			w.updateResourceVersion(event.Object)
+			continue
 		case watch.Added:
 			if err := w.AddFunc(event.Object); err != nil {
 				klog.Errorf("Failed to handle added event: %v", err)
@@ -107,7 +107,8 @@ func newTestController() (*Controller, *fake.Clientset, *mockReconciler) {
 		&config.NodeConfig{},
 		wait.NewGroup(),
 		l7reconciler,
-		0)
+		FQDNCacheConfig{},
+		false)
 	reconciler := newMockReconciler()
 	controller.podReconciler = reconciler
 	controller.auditLogger = nil
@@ -964,4 +965,26 @@ func TestGetFqdnCache(t *testing.T) {
 	pattern := regexp.MustCompile("^.*[.]io$")
 	returnedList = controller.GetFQDNCache(&querier.FQDNCacheFilter{DomainRegex: pattern})
 	assert.ElementsMatch(t, []agenttypes.DnsCacheEntry{expectedEntryList[3]}, returnedList)
+
+	// Static IPs are included, whether or not the FQDN has been resolved.
+	controller.fqdnController.staticIPs = map[string][]net.IP{
+		"antrea.io":   {net.ParseIP("10.0.0.5")},
+		"example.org": {net.ParseIP("10.0.0.6")},
+	}
+	staticEntryList := []agenttypes.DnsCacheEntry{
+		{
+			FQDNName:  "antrea.io",
+			IPAddress: net.ParseIP("10.0.0.5"),
+			Static:    true,
+		},
+		{
+			FQDNName:  "example.org",
+			IPAddress: net.ParseIP("10.0.0.6"),
+			Static:    true,
+		},
+	}
+	returnedList = controller.GetFQDNCache(nil)
+	assert.ElementsMatch(t, append(expectedEntryList, staticEntryList...), returnedList)
+	returnedList = controller.GetFQDNCache(&querier.FQDNCacheFilter{DomainRegex: pattern})
+	assert.ElementsMatch(t, []agenttypes.DnsCacheEntry{expectedEntryList[3], staticEntryList[0]}, returnedList)
 }
@@ -68,6 +68,15 @@ NodeNetworkPolicy data path implementation using iptables/ip6tables involves fou
 4. From/To ipset:
    - Created for the NodeNetworkPolicy rule, containing all source IP addresses (ingress) or destination IP addresses (egress).
 
+An ingress rule can be restricted to some categories of traffic destined to the Node with nodeTrafficTypes:
+  - NodeOS and HostNetworkPod traffic is delivered locally and goes through ANTREA-POL-INGRESS-RULES. Packets destined
+    to the ports of local hostNetwork Pods are marked with HostNetworkPodTrafficMark in ANTREA-POL-HOSTNET-PORTS, and a
+    rule matching only one of the two categories matches the mark, e.g. "-m mark --mark 0x0/0x40000000" for NodeOS.
+  - HostPort traffic is DNAT'd and forwarded to local Pods. New connections to the hostPorts of local Pods are sent to
+    ANTREA-POL-HOSTPORT-RULES by ANTREA-POL-HOSTPORTS, in which the core iptables rules match the original destination
+    port of the connection. As the chain name would be too long otherwise, no service chain is created for these rules,
+    and a core iptables rule is generated for each service instead.
+
 Assuming four ingress NodeNetworkPolicy rules with IDs RULE1, RULE2, RULE3 and RULE4 prioritized in descending order.
 Core iptables rules organized by priorities in ANTREA-POL-INGRESS-RULES like the following.
 
@@ -162,8 +171,12 @@ type nodePolicyLastRealized struct {
 	ipnets map[iptables.Protocol]string
 	// serviceIPTChain tracks the last realized service iptables chain if a rule has multiple services.
 	serviceIPTChain string
-	// coreIPTChain tracks the last realized iptables chain where the core iptables rule is installed.
+	// coreIPTChain tracks the last realized iptables chain where the core iptables rule is installed. It is empty if
+	// the rule only applies to hostPort traffic.
 	coreIPTChain string
+	// hostPortIPTChain tracks the last realized iptables chain where the core iptables rules for hostPort traffic are
+	// installed. It is empty if the rule doesn't apply to hostPort traffic.
+	hostPortIPTChain string
 }
 
 func newNodePolicyLastRealized() *nodePolicyLastRealized {
@@ -189,11 +202,13 @@ func newNodeReconciler(routeClient route.Interface, ipv4Enabled, ipv6Enabled boo
 		ipProtocols = append(ipProtocols, iptables.ProtocolIPv4)
 		coreIPTChains[newChainKey(config.NodeNetworkPolicyIngressRulesChain, false)] = newCoreIPTChain()
 		coreIPTChains[newChainKey(config.NodeNetworkPolicyEgressRulesChain, false)] = newCoreIPTChain()
+		coreIPTChains[newChainKey(config.NodeNetworkPolicyHostPortRulesChain, false)] = newCoreIPTChain()
 	}
 	if ipv6Enabled {
 		ipProtocols = append(ipProtocols, iptables.ProtocolIPv6)
 		coreIPTChains[newChainKey(config.NodeNetworkPolicyIngressRulesChain, true)] = newCoreIPTChain()
 		coreIPTChains[newChainKey(config.NodeNetworkPolicyEgressRulesChain, true)] = newCoreIPTChain()
+		coreIPTChains[newChainKey(config.NodeNetworkPolicyHostPortRulesChain, true)] = newCoreIPTChain()
 	}
 
 	return &nodeReconciler{
@@ -242,6 +257,7 @@ func (r *nodeReconciler) batchAdd(rules []*CompletedRule) error {
 	serviceIPTRules := make(map[iptables.Protocol][][]string)
 	ingressCoreIPTRules := make(map[iptables.Protocol][]*coreIPTRule)
 	egressCoreIPTRules := make(map[iptables.Protocol][]*coreIPTRule)
+	hostPortCoreIPTRules := make(map[iptables.Protocol][]*coreIPTRule)
 
 	for _, rule := range rules {
 		iptRules, lastRealized := r.computeIPTRules(rule)
@@ -260,11 +276,16 @@ func (r *nodeReconciler) batchAdd(rules []*CompletedRule) error {
 			}
 
 			// Collect all core iptables rules.
-			coreIPTRule := &coreIPTRule{ruleID, iptRule.Priority, iptRule.CoreIPTRules}
-			if rule.Direction == v1beta2.DirectionIn {
-				ingressCoreIPTRules[ipProtocol] = append(ingressCoreIPTRules[ipProtocol], coreIPTRule)
-			} else {
-				egressCoreIPTRules[ipProtocol] = append(egressCoreIPTRules[ipProtocol], coreIPTRule)
+			if lastRealized.coreIPTChain != "" {
+				coreIPTRule := &coreIPTRule{ruleID, iptRule.Priority, iptRule.CoreIPTRules}
+				if rule.Direction == v1beta2.DirectionIn {
+					ingressCoreIPTRules[ipProtocol] = append(ingressCoreIPTRules[ipProtocol], coreIPTRule)
+				} else {
+					egressCoreIPTRules[ipProtocol] = append(egressCoreIPTRules[ipProtocol], coreIPTRule)
+				}
+			}
+			if lastRealized.hostPortIPTChain != "" {
+				hostPortCoreIPTRules[ipProtocol] = append(hostPortCoreIPTRules[ipProtocol], &coreIPTRule{ruleID, iptRule.Priority, iptRule.HostPortIPTRules})
 			}
 		}
 		lastRealizeds[ruleID] = lastRealized
@@ -280,6 +301,9 @@ func (r *nodeReconciler) batchAdd(rules []*CompletedRule) error {
 		if err := r.addOrUpdateCoreIPTRules(config.NodeNetworkPolicyEgressRulesChain, isIPv6, false, egressCoreIPTRules[ipProtocol]...); err != nil {
 			return err
 		}
+		if err := r.addOrUpdateCoreIPTRules(config.NodeNetworkPolicyHostPortRulesChain, isIPv6, false, hostPortCoreIPTRules[ipProtocol]...); err != nil {
+			return err
+		}
 	}
 
 	for ruleID, lastRealized := range lastRealizeds {
@@ -301,8 +325,15 @@ func (r *nodeReconciler) Forget(ruleID string) error {
 
 	for _, ipProtocol := range r.ipProtocols {
 		isIPv6 := iptables.IsIPv6Protocol(ipProtocol)
-		if err := r.deleteCoreIPTRule(ruleID, coreIPTChain, isIPv6); err != nil {
-			return err
+		if coreIPTChain != "" {
+			if err := r.deleteCoreIPTRule(ruleID, coreIPTChain, isIPv6); err != nil {
+				return err
+			}
+		}
+		if lastRealized.hostPortIPTChain != "" {
+			if err := r.deleteCoreIPTRule(ruleID, lastRealized.hostPortIPTChain, isIPv6); err != nil {
+				return err
+			}
 		}
 		if lastRealized.serviceIPTChain != "" {
 			if err := r.routeClient.DeleteNodeNetworkPolicyIPTables([]string{lastRealized.serviceIPTChain}, isIPv6); err != nil {
@@ -338,9 +369,10 @@ func (r *nodeReconciler) computeIPTRules(rule *CompletedRule) (map[iptables.Prot
 		RulePriority:   rule.Priority,
 	}
 
+	matchInput, matchHostPort, inputMark := getNodeTrafficMatch(rule)
 	var serviceIPTChain, serviceIPTRuleTarget, coreIPTRuleTarget string
 	var service *v1beta2.Service
-	if len(rule.Services) > 1 {
+	if len(rule.Services) > 1 && matchInput {
 		// If a rule has multiple services, create a chain to install iptables rules for these services, with the target
 		// of the services determined by the rule's action. The core iptables rule should target the chain.
 		serviceIPTChain = fmt.Sprintf("%s-%s", config.NodeNetworkPolicyPrefix, strings.ToUpper(ruleID))
@@ -363,7 +395,12 @@ func (r *nodeReconciler) computeIPTRules(rule *CompletedRule) (map[iptables.Prot
 		coreIPTChain = config.NodeNetworkPolicyEgressRulesChain
 	}
 	coreIPTRuleComment := fmt.Sprintf("Antrea: for rule %s, policy %s", rule.Name, rule.SourceRef.ToString())
-	lastRealized.coreIPTChain = coreIPTChain
+	if matchInput {
+		lastRealized.coreIPTChain = coreIPTChain
+	}
+	if matchHostPort {
+		lastRealized.hostPortIPTChain = config.NodeNetworkPolicyHostPortRulesChain
+	}
 
 	nodePolicyRules := make(map[iptables.Protocol]*types.NodePolicyRule)
 	for _, ipProtocol := range r.ipProtocols {
@@ -397,29 +434,46 @@ func (r *nodeReconciler) computeIPTRules(rule *CompletedRule) (map[iptables.Prot
 			lastRealized.ipnets[ipProtocol] = ipnet
 		}
 
-		coreIPTRules := buildCoreIPTRules(ipProtocol,
-			coreIPTChain,
-			ipset,
-			ipnet,
-			coreIPTRuleTarget,
-			coreIPTRuleComment,
-			service,
-			rule.Direction == v1beta2.DirectionIn,
-			// If the target of a core iptables rule is not a service chain, the iptables rule for logging should be
-			// generated along with the core iptables rule. Otherwise, the iptables rules for logging should be generated
-			// along with the service iptables rules.
-			enableLogging && serviceIPTChain == "",
-			logLabel)
+		var coreIPTRules []string
+		if matchInput {
+			coreIPTRules = buildCoreIPTRules(ipProtocol,
+				coreIPTChain,
+				ipset,
+				ipnet,
+				inputMark,
+				coreIPTRuleTarget,
+				coreIPTRuleComment,
+				service,
+				false,
+				rule.Direction == v1beta2.DirectionIn,
+				// If the target of a core iptables rule is not a service chain, the iptables rule for logging should be
+				// generated along with the core iptables rule. Otherwise, the iptables rules for logging should be generated
+				// along with the service iptables rules.
+				enableLogging && serviceIPTChain == "",
+				logLabel)
+		}
+		var hostPortIPTRules []string
+		if matchHostPort {
+			hostPortIPTRules = buildHostPortIPTRules(ipProtocol,
+				ipset,
+				ipnet,
+				ruleActionToIPTTarget(rule.Action),
+				coreIPTRuleComment,
+				rule.Services,
+				enableLogging,
+				logLabel)
+		}
 
 		nodePolicyRules[ipProtocol] = &types.NodePolicyRule{
-			IPSet:           ipset,
-			IPSetMembers:    ipnets,
-			Priority:        priority,
-			ServiceIPTChain: serviceIPTChain,
-			ServiceIPTRules: serviceIPTRules,
-			CoreIPTChain:    coreIPTChain,
-			CoreIPTRules:    coreIPTRules,
-			IsIPv6:          isIPv6,
+			IPSet:            ipset,
+			IPSetMembers:     ipnets,
+			Priority:         priority,
+			ServiceIPTChain:  serviceIPTChain,
+			ServiceIPTRules:  serviceIPTRules,
+			CoreIPTChain:     lastRealized.coreIPTChain,
+			CoreIPTRules:     coreIPTRules,
+			HostPortIPTRules: hostPortIPTRules,
+			IsIPv6:           isIPv6,
 		}
 	}
 
@@ -441,7 +495,7 @@ func (r *nodeReconciler) add(rule *CompletedRule) error {
 				return err
 			}
 		}
-		if err := r.addOrUpdateCoreIPTRules(iptRule.CoreIPTChain, iptRule.IsIPv6, false, &coreIPTRule{ruleID, iptRule.Priority, iptRule.CoreIPTRules}); err != nil {
+		if err := r.addOrUpdateRuleCoreIPTRules(lastRealized, ruleID, iptRule, false); err != nil {
 			return err
 		}
 	}
@@ -475,7 +529,7 @@ func (r *nodeReconciler) update(lastRealized *nodePolicyLastRealized, newRule *C
 				return err
 			}
 			if shouldUpdateCoreIPTRules {
-				if err := r.addOrUpdateCoreIPTRules(iptRule.CoreIPTChain, iptRule.IsIPv6, true, &coreIPTRule{ruleID, iptRule.Priority, iptRule.CoreIPTRules}); err != nil {
+				if err := r.addOrUpdateRuleCoreIPTRules(newLastRealized, ruleID, iptRule, true); err != nil {
 					return err
 				}
 			}
@@ -483,7 +537,7 @@ func (r *nodeReconciler) update(lastRealized *nodePolicyLastRealized, newRule *C
 			// If the previous rule used an ipset, sync the new core iptables rule first to remove its reference, then
 			// delete the unused ipset.
 			if shouldUpdateCoreIPTRules {
-				if err := r.addOrUpdateCoreIPTRules(iptRule.CoreIPTChain, iptRule.IsIPv6, true, &coreIPTRule{ruleID, iptRule.Priority, iptRule.CoreIPTRules}); err != nil {
+				if err := r.addOrUpdateRuleCoreIPTRules(newLastRealized, ruleID, iptRule, true); err != nil {
 					return err
 				}
 			}
@@ -492,7 +546,7 @@ func (r *nodeReconciler) update(lastRealized *nodePolicyLastRealized, newRule *C
 			}
 		} else {
 			if shouldUpdateCoreIPTRules {
-				if err := r.addOrUpdateCoreIPTRules(iptRule.CoreIPTChain, iptRule.IsIPv6, true, &coreIPTRule{ruleID, iptRule.Priority, iptRule.CoreIPTRules}); err != nil {
+				if err := r.addOrUpdateRuleCoreIPTRules(newLastRealized, ruleID, iptRule, true); err != nil {
 					return err
 				}
 			}
@@ -503,6 +557,21 @@ func (r *nodeReconciler) update(lastRealized *nodePolicyLastRealized, newRule *C
 	return nil
 }
 
+// addOrUpdateRuleCoreIPTRules installs or updates the core iptables rules of a rule in the chains it applies to.
+func (r *nodeReconciler) addOrUpdateRuleCoreIPTRules(lastRealized *nodePolicyLastRealized, ruleID string, iptRule *types.NodePolicyRule, isUpdate bool) error {
+	if lastRealized.coreIPTChain != "" {
+		if err := r.addOrUpdateCoreIPTRules(lastRealized.coreIPTChain, iptRule.IsIPv6, isUpdate, &coreIPTRule{ruleID, iptRule.Priority, iptRule.CoreIPTRules}); err != nil {
+			return err
+		}
+	}
+	if lastRealized.hostPortIPTChain != "" {
+		if err := r.addOrUpdateCoreIPTRules(lastRealized.hostPortIPTChain, iptRule.IsIPv6, isUpdate, &coreIPTRule{ruleID, iptRule.Priority, iptRule.HostPortIPTRules}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *nodeReconciler) addOrUpdateCoreIPTRules(chain string, isIPv6 bool, isUpdate bool, newRules ...*coreIPTRule) error {
 	if len(newRules) == 0 {
 		return nil
@@ -657,9 +726,11 @@ func buildCoreIPTRules(ipProtocol iptables.Protocol,
 	iptChain string,
 	ipset string,
 	ipnet string,
+	mark *uint32,
 	iptRuleTarget string,
 	iptRuleComment string,
 	service *v1beta2.Service,
+	matchOrigDstPort bool,
 	isIngress bool,
 	enableLogging bool,
 	logLabel string) []string {
@@ -684,6 +755,9 @@ func buildCoreIPTRules(ipProtocol iptables.Protocol,
 			return rules
 		}
 	}
+	if mark != nil {
+		builder = builder.MatchMark(*mark, types.HostNetworkPodTrafficMark)
+	}
 	if service != nil {
 		transProtocol := getServiceTransProtocol(service.Protocol)
 		switch transProtocol {
@@ -693,8 +767,14 @@ func buildCoreIPTRules(ipProtocol iptables.Protocol,
 			fallthrough
 		case "sctp":
 			builder = builder.MatchTransProtocol(transProtocol).
-				MatchPortSrc(service.SrcPort, service.SrcEndPort).
-				MatchPortDst(service.Port, service.EndPort)
+				MatchPortSrc(service.SrcPort, service.SrcEndPort)
+			if matchOrigDstPort {
+				// The destination port of the packet has been translated to the container port, while the rule
+				// matches the hostPort.
+				builder = builder.MatchCTOrigDstPort(service.Port, service.EndPort)
+			} else {
+				builder = builder.MatchPortDst(service.Port, service.EndPort)
+			}
 		case "icmp":
 			builder = builder.MatchICMP(service.ICMPType, service.ICMPCode, ipProtocol)
 		}
@@ -713,6 +793,47 @@ func buildCoreIPTRules(ipProtocol iptables.Protocol,
 	return rules
 }
 
+// buildHostPortIPTRules builds the core iptables rules installed in the chain for hostPort traffic. A core iptables rule
+// is generated for each service of the rule.
+func buildHostPortIPTRules(ipProtocol iptables.Protocol,
+	ipset string,
+	ipnet string,
+	iptRuleTarget string,
+	iptRuleComment string,
+	services []v1beta2.Service,
+	enableLogging bool,
+	logLabel string) []string {
+	if len(services) == 0 {
+		return buildCoreIPTRules(ipProtocol, config.NodeNetworkPolicyHostPortRulesChain, ipset, ipnet, nil, iptRuleTarget, iptRuleComment, nil, true, true, enableLogging, logLabel)
+	}
+	var rules []string
+	for i := range services {
+		rules = append(rules, buildCoreIPTRules(ipProtocol, config.NodeNetworkPolicyHostPortRulesChain, ipset, ipnet, nil, iptRuleTarget, iptRuleComment, &services[i], true, true, enableLogging, logLabel)...)
+	}
+	return rules
+}
+
+// getNodeTrafficMatch returns whether a rule applies to the traffic delivered locally, i.e. destined to the Node OS or
+// hostNetwork Pods, and whether it applies to the traffic forwarded to local Pods through hostPorts. If the rule only
+// applies to one of the Node OS and hostNetwork Pods, the returned mark is the value of HostNetworkPodTrafficMark that
+// the core iptables rule must match.
+func getNodeTrafficMatch(rule *CompletedRule) (bool, bool, *uint32) {
+	if rule.Direction != v1beta2.DirectionIn || len(rule.NodeTrafficTypes) == 0 {
+		return true, false, nil
+	}
+	trafficTypes := sets.New[secv1beta1.NodeTrafficType](rule.NodeTrafficTypes...)
+	nodeOS := trafficTypes.Has(secv1beta1.NodeTrafficTypeNodeOS)
+	hostNetworkPod := trafficTypes.Has(secv1beta1.NodeTrafficTypeHostNetworkPod)
+	var mark *uint32
+	if nodeOS && !hostNetworkPod {
+		mark = new(uint32)
+	} else if hostNetworkPod && !nodeOS {
+		hostNetworkPodMark := types.HostNetworkPodTrafficMark
+		mark = &hostNetworkPodMark
+	}
+	return nodeOS || hostNetworkPod, trafficTypes.Has(secv1beta1.NodeTrafficTypeHostPort), mark
+}
+
 func buildServiceIPTRules(ipProtocol iptables.Protocol,
 	services []v1beta2.Service,
 	chain string,
@@ -71,6 +71,8 @@ var (
 	ingressRuleID1 = "ingressRule1"
 	ingressRuleID2 = "ingressRule2"
 	ingressRuleID3 = "ingressRule3"
+	ingressRuleID4 = "ingressRule4"
+	ingressRuleID5 = "ingressRule5"
 	egressRuleID1  = "egressRule1"
 	egressRuleID2  = "egressRule2"
 	ingressRule1   = &CompletedRule{
@@ -220,6 +222,40 @@ var (
 		FromAddresses: nil,
 		ToAddresses:   nil,
 	}
+	ingressRule4WithHostNetworkPodAndHostPort = &CompletedRule{
+		rule: &rule{
+			ID:               ingressRuleID4,
+			Name:             "ingress-rule-04",
+			PolicyName:       "ingress-policy",
+			Direction:        v1beta2.DirectionIn,
+			Services:         []v1beta2.Service{serviceTCP80, serviceTCP443},
+			Action:           &ruleActionAllow,
+			Priority:         4,
+			PolicyPriority:   &policyPriority1,
+			TierPriority:     &tierPriority2,
+			SourceRef:        &cnp1,
+			NodeTrafficTypes: []secv1beta1.NodeTrafficType{secv1beta1.NodeTrafficTypeHostNetworkPod, secv1beta1.NodeTrafficTypeHostPort},
+		},
+		FromAddresses: addressGroup1,
+		ToAddresses:   nil,
+	}
+	ingressRule5WithNodeOS = &CompletedRule{
+		rule: &rule{
+			ID:               ingressRuleID5,
+			Name:             "ingress-rule-05",
+			PolicyName:       "ingress-policy",
+			Direction:        v1beta2.DirectionIn,
+			Services:         []v1beta2.Service{serviceTCP8080},
+			Action:           &ruleActionAllow,
+			Priority:         5,
+			PolicyPriority:   &policyPriority1,
+			TierPriority:     &tierPriority2,
+			SourceRef:        &cnp1,
+			NodeTrafficTypes: []secv1beta1.NodeTrafficType{secv1beta1.NodeTrafficTypeNodeOS},
+		},
+		FromAddresses: addressGroup1,
+		ToAddresses:   nil,
+	}
 	egressRule1 = &CompletedRule{
 		rule: &rule{
 			ID:             egressRuleID1,
@@ -661,6 +697,59 @@ func TestNodeReconcilerReconcileAndForget(t *testing.T) {
 				ingressRuleID3,
 			},
 		},
+		{
+			name:        "IPv4, add ingress rules with node traffic types, then forget them",
+			ipv4Enabled: true,
+			ipv6Enabled: false,
+			expectedCalls: func(mockRouteClient *routetest.MockInterfaceMockRecorder) {
+				serviceRules := [][]string{
+					{
+						`-A ANTREA-POL-INGRESSRULE4 -p tcp --dport 80 -j ACCEPT`,
+						`-A ANTREA-POL-INGRESSRULE4 -p tcp --dport 443 -j ACCEPT`,
+					},
+				}
+				coreRules1 := [][]string{
+					{
+						`-A ANTREA-POL-INGRESS-RULES -s 1.1.1.1/32 -m mark --mark 0x40000000/0x40000000 -j ANTREA-POL-INGRESSRULE4 -m comment --comment "Antrea: for rule ingress-rule-04, policy AntreaClusterNetworkPolicy:name1"`,
+					},
+				}
+				hostPortRules := [][]string{
+					{
+						`-A ANTREA-POL-HOSTPORT-RULES -s 1.1.1.1/32 -p tcp -m conntrack --ctorigdstport 80 -j ACCEPT -m comment --comment "Antrea: for rule ingress-rule-04, policy AntreaClusterNetworkPolicy:name1"`,
+						`-A ANTREA-POL-HOSTPORT-RULES -s 1.1.1.1/32 -p tcp -m conntrack --ctorigdstport 443 -j ACCEPT -m comment --comment "Antrea: for rule ingress-rule-04, policy AntreaClusterNetworkPolicy:name1"`,
+					},
+				}
+				coreRules2 := [][]string{
+					{
+						`-A ANTREA-POL-INGRESS-RULES -s 1.1.1.1/32 -m mark --mark 0x40000000/0x40000000 -j ANTREA-POL-INGRESSRULE4 -m comment --comment "Antrea: for rule ingress-rule-04, policy AntreaClusterNetworkPolicy:name1"`,
+						`-A ANTREA-POL-INGRESS-RULES -s 1.1.1.1/32 -m mark --mark 0x0/0x40000000 -p tcp --dport 8080 -j ACCEPT -m comment --comment "Antrea: for rule ingress-rule-05, policy AntreaClusterNetworkPolicy:name1"`,
+					},
+				}
+				coreRules3 := [][]string{
+					{
+						`-A ANTREA-POL-INGRESS-RULES -s 1.1.1.1/32 -m mark --mark 0x0/0x40000000 -p tcp --dport 8080 -j ACCEPT -m comment --comment "Antrea: for rule ingress-rule-05, policy AntreaClusterNetworkPolicy:name1"`,
+					},
+				}
+				gomock.InOrder(
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESSRULE4"}, serviceRules, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, coreRules1, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-HOSTPORT-RULES"}, hostPortRules, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, coreRules2, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, coreRules3, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-HOSTPORT-RULES"}, [][]string{nil}, false),
+					mockRouteClient.DeleteNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESSRULE4"}, false),
+					mockRouteClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-INGRESS-RULES"}, [][]string{nil}, false),
+				)
+			},
+			rulesToAdd: []*CompletedRule{
+				ingressRule4WithHostNetworkPodAndHostPort,
+				ingressRule5WithNodeOS,
+			},
+			rulesToForget: []string{
+				ingressRuleID4,
+				ingressRuleID5,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
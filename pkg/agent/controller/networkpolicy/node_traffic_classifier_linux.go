@@ -0,0 +1,241 @@
+//go:build linux
+// +build linux
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/route"
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/agent/util/iptables"
+)
+
+const (
+	nodeTrafficClassifierName = "NodeTrafficClassifier"
+	// nodeTrafficClassifierKey is the only key of the workqueue, as the iptables rules are always computed from all
+	// local Pods.
+	nodeTrafficClassifierKey = "key"
+)
+
+// NodeTrafficClassifier installs the iptables rules which classify the ingress traffic of the Node, so that Node
+// NetworkPolicy rules can match the traffic destined to hostNetwork Pods and the traffic forwarded to hostPorts
+// separately from the traffic destined to the Node OS:
+//   - In ANTREA-POL-HOSTNET-PORTS, packets destined to the container ports of local hostNetwork Pods are marked with
+//     HostNetworkPodTrafficMark.
+//   - In ANTREA-POL-HOSTPORTS, new connections whose original destination port is a hostPort of a local Pod are sent
+//     to ANTREA-POL-HOSTPORT-RULES.
+type NodeTrafficClassifier struct {
+	routeClient     route.Interface
+	podInformer     cache.SharedIndexInformer
+	podListerSynced cache.InformerSynced
+	ipProtocols     []iptables.Protocol
+	queue           workqueue.TypedRateLimitingInterface[string]
+	// installedRules caches the last installed iptables rules by chain. It's only accessed by the worker.
+	installedRules map[string][]string
+}
+
+func NewNodeTrafficClassifier(podInformer cache.SharedIndexInformer, routeClient route.Interface, ipv4Enabled, ipv6Enabled bool) *NodeTrafficClassifier {
+	var ipProtocols []iptables.Protocol
+	if ipv4Enabled {
+		ipProtocols = append(ipProtocols, iptables.ProtocolIPv4)
+	}
+	if ipv6Enabled {
+		ipProtocols = append(ipProtocols, iptables.ProtocolIPv6)
+	}
+	c := &NodeTrafficClassifier{
+		routeClient:     routeClient,
+		podInformer:     podInformer,
+		podListerSynced: podInformer.HasSynced,
+		ipProtocols:     ipProtocols,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "nodeTrafficClassifier",
+			},
+		),
+		installedRules: map[string][]string{},
+	}
+	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueuePod,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldPod, newPod := oldObj.(*corev1.Pod), newObj.(*corev1.Pod)
+			// Only the ports and the phase of a Pod affect the iptables rules.
+			if isPodTerminated(oldPod) != isPodTerminated(newPod) || !reflect.DeepEqual(oldPod.Spec.Containers, newPod.Spec.Containers) {
+				c.enqueuePod(newObj)
+			}
+		},
+		DeleteFunc: c.enqueuePod,
+	})
+	return c
+}
+
+func (c *NodeTrafficClassifier) enqueuePod(obj interface{}) {
+	c.queue.Add(nodeTrafficClassifierKey)
+}
+
+func (c *NodeTrafficClassifier) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting", "controller", nodeTrafficClassifierName)
+	defer klog.InfoS("Shutting down", "controller", nodeTrafficClassifierName)
+
+	if !cache.WaitForNamedCacheSync(nodeTrafficClassifierName, stopCh, c.podListerSynced) {
+		return
+	}
+	// Sync the iptables rules once even if there is no local Pod, so that the stale rules are removed.
+	c.queue.Add(nodeTrafficClassifierKey)
+
+	go wait.Until(c.worker, time.Second, stopCh)
+	<-stopCh
+}
+
+func (c *NodeTrafficClassifier) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *NodeTrafficClassifier) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.sync(); err == nil {
+		c.queue.Forget(key)
+	} else {
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Error syncing Node traffic classification rules, requeuing")
+	}
+	return true
+}
+
+func (c *NodeTrafficClassifier) sync() error {
+	hostNetworkPorts := sets.New[protocolPort]()
+	hostPorts := sets.New[protocolPort]()
+	for _, obj := range c.podInformer.GetStore().List() {
+		pod := obj.(*corev1.Pod)
+		if isPodTerminated(pod) {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			for _, port := range container.Ports {
+				protocol := getPodPortTransProtocol(port.Protocol)
+				if pod.Spec.HostNetwork {
+					hostNetworkPorts.Insert(protocolPort{protocol: protocol, port: port.ContainerPort})
+				} else if port.HostPort != 0 {
+					hostPorts.Insert(protocolPort{protocol: protocol, port: port.HostPort})
+				}
+			}
+		}
+	}
+
+	desiredRules := map[string][]string{
+		config.NodeNetworkPolicyHostNetworkPodPortsChain: buildHostNetworkPortIPTRules(sortedProtocolPorts(hostNetworkPorts)),
+		config.NodeNetworkPolicyHostPortsChain:           buildHostPortClassifierIPTRules(sortedProtocolPorts(hostPorts)),
+	}
+	for _, chain := range []string{config.NodeNetworkPolicyHostNetworkPodPortsChain, config.NodeNetworkPolicyHostPortsChain} {
+		rules := desiredRules[chain]
+		if installedRules, ok := c.installedRules[chain]; ok && reflect.DeepEqual(installedRules, rules) {
+			continue
+		}
+		for _, ipProtocol := range c.ipProtocols {
+			if err := c.routeClient.AddOrUpdateNodeNetworkPolicyIPTables([]string{chain}, [][]string{rules}, ipProtocol == iptables.ProtocolIPv6); err != nil {
+				return fmt.Errorf("failed to install iptables rules in chain %s: %w", chain, err)
+			}
+		}
+		c.installedRules[chain] = rules
+	}
+	return nil
+}
+
+// protocolPort is a transport protocol and port pair exposed by a local Pod.
+type protocolPort struct {
+	protocol string
+	port     int32
+}
+
+// sortedProtocolPorts returns the items of the set sorted by protocol and port, to generate the iptables rules in a
+// stable order.
+func sortedProtocolPorts(set sets.Set[protocolPort]) []protocolPort {
+	ports := set.UnsortedList()
+	sort.Slice(ports, func(i, j int) bool {
+		if ports[i].protocol != ports[j].protocol {
+			return ports[i].protocol < ports[j].protocol
+		}
+		return ports[i].port < ports[j].port
+	})
+	return ports
+}
+
+// buildHostNetworkPortIPTRules builds the iptables rules marking the packets destined to the given ports with
+// HostNetworkPodTrafficMark.
+func buildHostNetworkPortIPTRules(ports []protocolPort) []string {
+	var rules []string
+	for _, p := range ports {
+		port := intstr.FromInt32(p.port)
+		rules = append(rules, iptables.NewRuleBuilder(config.NodeNetworkPolicyHostNetworkPodPortsChain).
+			MatchTransProtocol(p.protocol).
+			MatchPortDst(&port, nil).
+			SetTarget(iptables.MarkTarget).
+			SetTargetOrMark(types.HostNetworkPodTrafficMark).
+			Done().
+			GetRule())
+	}
+	return rules
+}
+
+// buildHostPortClassifierIPTRules builds the iptables rules sending the connections whose original destination port is
+// one of the given hostPorts to the chain of hostPort Node NetworkPolicy rules.
+func buildHostPortClassifierIPTRules(ports []protocolPort) []string {
+	var rules []string
+	for _, p := range ports {
+		port := intstr.FromInt32(p.port)
+		rules = append(rules, iptables.NewRuleBuilder(config.NodeNetworkPolicyHostPortsChain).
+			MatchTransProtocol(p.protocol).
+			MatchCTOrigDstPort(&port, nil).
+			SetTarget(config.NodeNetworkPolicyHostPortRulesChain).
+			Done().
+			GetRule())
+	}
+	return rules
+}
+
+func getPodPortTransProtocol(protocol corev1.Protocol) string {
+	if protocol == "" {
+		return "tcp"
+	}
+	return strings.ToLower(string(protocol))
+}
+
+func isPodTerminated(pod *corev1.Pod) bool {
+	return pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+}
@@ -0,0 +1,87 @@
+//go:build linux
+// +build linux
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	routetest "antrea.io/antrea/pkg/agent/route/testing"
+)
+
+func newTestPodWithPorts(name string, hostNetwork bool, phase corev1.PodPhase, ports ...corev1.ContainerPort) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: corev1.PodSpec{
+			HostNetwork: hostNetwork,
+			Containers:  []corev1.Container{{Name: "c1", Ports: ports}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestNodeTrafficClassifierSync(t *testing.T) {
+	hostNetworkPod := newTestPodWithPorts("pod1", true, corev1.PodRunning,
+		corev1.ContainerPort{ContainerPort: 9100},
+		corev1.ContainerPort{ContainerPort: 53, Protocol: corev1.ProtocolUDP},
+	)
+	hostPortPod := newTestPodWithPorts("pod2", false, corev1.PodRunning,
+		corev1.ContainerPort{ContainerPort: 80, HostPort: 8080},
+		corev1.ContainerPort{ContainerPort: 443},
+	)
+	completedPod := newTestPodWithPorts("pod3", true, corev1.PodSucceeded,
+		corev1.ContainerPort{ContainerPort: 9200},
+	)
+
+	ctrl := gomock.NewController(t)
+	mockRouteClient := routetest.NewMockInterface(ctrl)
+	podInformer := informers.NewSharedInformerFactory(fake.NewSimpleClientset(), 0).Core().V1().Pods().Informer()
+	c := NewNodeTrafficClassifier(podInformer, mockRouteClient, true, true)
+	for _, pod := range []*corev1.Pod{hostNetworkPod, hostPortPod, completedPod} {
+		require.NoError(t, podInformer.GetStore().Add(pod))
+	}
+
+	hostNetworkPortsRules := []string{
+		`-A ANTREA-POL-HOSTNET-PORTS -p tcp --dport 9100 -j MARK --or-mark 0x40000000`,
+		`-A ANTREA-POL-HOSTNET-PORTS -p udp --dport 53 -j MARK --or-mark 0x40000000`,
+	}
+	hostPortsRules := []string{
+		`-A ANTREA-POL-HOSTPORTS -p tcp -m conntrack --ctorigdstport 8080 -j ANTREA-POL-HOSTPORT-RULES`,
+	}
+	for _, isIPv6 := range []bool{false, true} {
+		mockRouteClient.EXPECT().AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-HOSTNET-PORTS"}, [][]string{hostNetworkPortsRules}, isIPv6)
+		mockRouteClient.EXPECT().AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-HOSTPORTS"}, [][]string{hostPortsRules}, isIPv6)
+	}
+	require.NoError(t, c.sync())
+
+	// Nothing should be updated if the rules don't change.
+	require.NoError(t, c.sync())
+
+	// Only the chain whose rules change should be updated.
+	require.NoError(t, podInformer.GetStore().Delete(hostPortPod))
+	for _, isIPv6 := range []bool{false, true} {
+		mockRouteClient.EXPECT().AddOrUpdateNodeNetworkPolicyIPTables([]string{"ANTREA-POL-HOSTPORTS"}, [][]string{nil}, isIPv6)
+	}
+	require.NoError(t, c.sync())
+}
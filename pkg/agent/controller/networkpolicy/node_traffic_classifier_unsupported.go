@@ -0,0 +1,33 @@
+//go:build !linux
+// +build !linux
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/agent/route"
+)
+
+type NodeTrafficClassifier struct{}
+
+func NewNodeTrafficClassifier(podInformer cache.SharedIndexInformer, routeClient route.Interface, ipv4Enabled, ipv6Enabled bool) *NodeTrafficClassifier {
+	return &NodeTrafficClassifier{}
+}
+
+func (c *NodeTrafficClassifier) Run(stopCh <-chan struct{}) {
+}
@@ -104,6 +104,11 @@ func getInfoInReg(regMatch *ofctrl.MatchField, rng *openflow15.NXRange) (uint32,
 }
 
 func (c *Controller) storeDenyConnection(pktIn *ofctrl.PacketIn) error {
+	denyConnStore := c.denyConnStore.Load()
+	if denyConnStore == nil {
+		// Flow exporter has been disabled at runtime.
+		return nil
+	}
 	packet, err := binding.ParsePacketIn(pktIn)
 	if err != nil {
 		return fmt.Errorf("error in parsing packetIn: %v", err)
@@ -137,8 +142,8 @@ func (c *Controller) storeDenyConnection(pktIn *ofctrl.PacketIn) error {
 	}
 
 	// No need to obtain connection info again if it already exists in denyConnectionStore.
-	if conn, exist := c.denyConnStore.GetConnByKey(flowexporter.NewConnectionKey(&denyConn)); exist {
-		c.denyConnStore.AddOrUpdateConn(conn, time.Now(), uint64(packet.IPLength))
+	if conn, exist := denyConnStore.GetConnByKey(flowexporter.NewConnectionKey(&denyConn)); exist {
+		denyConnStore.AddOrUpdateConn(conn, time.Now(), uint64(packet.IPLength))
 		return nil
 	}
 
@@ -192,7 +197,7 @@ func (c *Controller) storeDenyConnection(pktIn *ofctrl.PacketIn) error {
 			denyConn.EgressNetworkPolicyRuleAction = flowexporter.RuleActionToUint8(disposition)
 		}
 	}
-	c.denyConnStore.AddOrUpdateConn(&denyConn, time.Now(), uint64(packet.IPLength))
+	denyConnStore.AddOrUpdateConn(&denyConn, time.Now(), uint64(packet.IPLength))
 	return nil
 }
 
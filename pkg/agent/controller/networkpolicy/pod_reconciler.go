@@ -16,6 +16,7 @@ package networkpolicy
 
 import (
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"net"
 	"strconv"
@@ -27,6 +28,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow"
 	proxytypes "antrea.io/antrea/pkg/agent/proxy/types"
 	"antrea.io/antrea/pkg/agent/types"
@@ -323,6 +325,7 @@ func (r *podReconciler) Reconcile(rule *CompletedRule) error {
 	}
 	if ofRuleInstallErr != nil && ofPriority != nil && !registeredBefore {
 		priorityAssigner.assigner.release(*ofPriority)
+		updatePriorityUtilization(ruleTable, priorityAssigner)
 	}
 	return ofRuleInstallErr
 }
@@ -403,15 +406,28 @@ func (r *podReconciler) getOFPriority(rule *CompletedRule, tableID uint8, pa *ta
 	}
 	ofPriority, registered := pa.assigner.getOFPriority(p)
 	if !registered {
-		allPrioritiesInPolicy := make([]types.Priority, rule.MaxPriority+1)
-		for i := int32(0); i <= rule.MaxPriority; i++ {
-			allPrioritiesInPolicy[i] = types.Priority{
-				TierPriority:   *rule.TierPriority,
-				PolicyPriority: *rule.PolicyPriority,
-				RulePriority:   i,
+		allPrioritiesInPolicy := func() []types.Priority {
+			priorities := make([]types.Priority, rule.MaxPriority+1)
+			for i := int32(0); i <= rule.MaxPriority; i++ {
+				priorities[i] = types.Priority{
+					TierPriority:   *rule.TierPriority,
+					PolicyPriority: *rule.PolicyPriority,
+					RulePriority:   i,
+				}
 			}
+			return priorities
+		}
+		priorityUpdates, revertFunc, err := pa.assigner.registerPriorities(allPrioritiesInPolicy())
+		if err != nil && !errors.Is(err, errPriorityOverflow) {
+			// The registration may fail if the ofPriority space is fragmented. Repack it and
+			// retry once.
+			klog.ErrorS(err, "Failed to register priorities, defragmenting priorities before retrying", "rule", rule.ID, "table", openflow.GetFlowTableName(tableID))
+			if defragErr := r.defragmentPriorities(tableID, pa); defragErr != nil {
+				klog.ErrorS(defragErr, "Failed to defragment priorities", "table", openflow.GetFlowTableName(tableID))
+				return nil, registered, err
+			}
+			priorityUpdates, revertFunc, err = pa.assigner.registerPriorities(allPrioritiesInPolicy())
 		}
-		priorityUpdates, revertFunc, err := pa.assigner.registerPriorities(allPrioritiesInPolicy)
 		if err != nil {
 			return nil, registered, err
 		}
@@ -423,6 +439,15 @@ func (r *podReconciler) getOFPriority(rule *CompletedRule, tableID uint8, pa *ta
 				return nil, registered, err
 			}
 		}
+		// Registering the new priorities required moving many installed flows, which means that
+		// there is little room left around the existing priorities. Repack them so that the next
+		// registrations are cheap. This is best-effort, the new priorities are registered anyway.
+		if len(priorityUpdates) > defragmentReassignThreshold {
+			if err := r.defragmentPriorities(tableID, pa); err != nil {
+				klog.ErrorS(err, "Failed to defragment priorities", "table", openflow.GetFlowTableName(tableID))
+			}
+		}
+		updatePriorityUtilization(tableID, pa)
 		ofPriority, _ = pa.assigner.getOFPriority(p)
 	}
 	klog.V(2).InfoS("Assigning OFPriority to rule", "rule", rule.ID, "priority", ofPriority)
@@ -465,6 +490,7 @@ func (r *podReconciler) BatchReconcile(rules []*CompletedRule) error {
 			for _, ofPriority := range ofPriorities {
 				pa.assigner.release(*ofPriority)
 			}
+			updatePriorityUtilization(tableID, pa)
 		}
 	}
 	return ofRuleInstallErr
@@ -488,13 +514,40 @@ func (r *podReconciler) registerOFPriorities(rules []*CompletedRule) error {
 		}
 	}
 	for tableID, priorities := range prioritiesToRegister {
-		if _, _, err := r.priorityAssigners[tableID].assigner.registerPriorities(priorities); err != nil {
+		pa := r.priorityAssigners[tableID]
+		if _, _, err := pa.assigner.registerPriorities(priorities); err != nil {
 			return err
 		}
+		updatePriorityUtilization(tableID, pa)
 	}
 	return nil
 }
 
+// defragmentPriorities repacks the ofPriorities registered for an OVS table, and re-assigns the
+// installed flows accordingly. The caller must hold the mutex of the tablePriorityAssigner.
+func (r *podReconciler) defragmentPriorities(tableID uint8, pa *tablePriorityAssigner) error {
+	priorityUpdates, revertFunc, err := pa.assigner.defragment()
+	if err != nil {
+		return err
+	}
+	if len(priorityUpdates) == 0 {
+		return nil
+	}
+	tableName := openflow.GetFlowTableName(tableID)
+	klog.InfoS("Defragmenting OpenFlow priorities", "table", tableName, "updates", len(priorityUpdates))
+	if err := r.ofClient.ReassignFlowPriorities(priorityUpdates, tableID); err != nil {
+		revertFunc()
+		return err
+	}
+	metrics.OVSFlowPriorityDefragmentationCount.WithLabelValues(tableName).Inc()
+	return nil
+}
+
+// updatePriorityUtilization reports the ratio of ofPriorities in use for an OVS table.
+func updatePriorityUtilization(tableID uint8, pa *tablePriorityAssigner) {
+	metrics.OVSFlowPriorityUtilization.WithLabelValues(openflow.GetFlowTableName(tableID)).Set(pa.assigner.utilization())
+}
+
 // add converts CompletedRule to PolicyRule(s) and invokes installOFRule to install them.
 func (r *podReconciler) add(rule *CompletedRule, ofPriority *uint16, table uint8) error {
 	klog.V(2).InfoS("Adding new rule", "rule", rule)
@@ -996,6 +1049,7 @@ func (r *podReconciler) uninstallOFRule(ofID uint32, table uint8) error {
 			// If there are stalePriorities, priorityAssigners[table] must not be nil.
 			priorityAssigner := r.priorityAssigners[table]
 			priorityAssigner.assigner.release(uint16(priorityNum))
+			updatePriorityUtilization(table, priorityAssigner)
 		}
 	}
 	r.idAllocator.forgetRule(ofID)
@@ -1195,7 +1249,16 @@ func groupMembersToOFAddresses(groupMemberSet v1beta2.GroupMemberSet) []types.Ad
 
 func ipBlocksToOFAddresses(ipBlocks []v1beta2.IPBlock, ipv4Enabled, ipv6Enabled, ctMatch bool) []types.Address {
 	// Must not return nil as it means not restricted by addresses in Openflow implementation.
-	addresses := make([]types.Address, 0)
+	// IPBlocks of large lists are compiled to CIDRs without Except by antrea-controller, in which case each IPBlock is
+	// converted to exactly one address.
+	addresses := make([]types.Address, 0, len(ipBlocks))
+	appendAddress := func(ipNet *net.IPNet) {
+		if ctMatch {
+			addresses = append(addresses, openflow.NewCTIPNetAddress(*ipNet))
+		} else {
+			addresses = append(addresses, openflow.NewIPNetAddress(*ipNet))
+		}
+	}
 	for idx := range ipBlocks {
 		b := &ipBlocks[idx]
 		blockCIDR := ip.IPNetToNetIPNet(&b.CIDR)
@@ -1207,6 +1270,10 @@ func ipBlocksToOFAddresses(ipBlocks []v1beta2.IPBlock, ipv4Enabled, ipv6Enabled,
 			klog.V(2).InfoS("IPBlock is using unsupported address family, skipping it", "cidr", blockCIDR.String())
 			continue
 		}
+		if len(b.Except) == 0 {
+			appendAddress(blockCIDR)
+			continue
+		}
 		exceptIPNets := make([]*net.IPNet, 0, len(b.Except))
 		for i := range b.Except {
 			c := b.Except[i]
@@ -1219,11 +1286,7 @@ func ipBlocksToOFAddresses(ipBlocks []v1beta2.IPBlock, ipv4Enabled, ipv6Enabled,
 			continue
 		}
 		for _, d := range diffCIDRs {
-			if ctMatch {
-				addresses = append(addresses, openflow.NewCTIPNetAddress(*d))
-			} else {
-				addresses = append(addresses, openflow.NewIPNetAddress(*d))
-			}
+			appendAddress(d)
 		}
 	}
 
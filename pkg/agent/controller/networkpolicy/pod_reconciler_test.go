@@ -973,6 +973,50 @@ func TestReconcileWithTransientError(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDefragmentPriorities(t *testing.T) {
+	prepareMockTables()
+	tableID := openflow.GetAntreaPolicyBaselineTierTables()[0].GetID()
+	p1 := types.Priority{TierPriority: baselineTierPriority, PolicyPriority: 1, RulePriority: 1}
+	p2 := types.Priority{TierPriority: baselineTierPriority, PolicyPriority: 1, RulePriority: 0}
+	tests := []struct {
+		name               string
+		reassignErr        error
+		expectedErr        bool
+		expectedOFPriority map[types.Priority]uint16
+	}{
+		{
+			name:               "success",
+			expectedOFPriority: map[types.Priority]uint16{p1: 52, p2: 137},
+		},
+		{
+			name:               "failure",
+			reassignErr:        errTransient,
+			expectedErr:        true,
+			expectedOFPriority: map[types.Priority]uint16{p1: 100, p2: 101},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			mockOFClient := openflowtest.NewMockClient(controller)
+			r := newTestReconciler(t, controller, interfacestore.NewInterfaceStore(), mockOFClient, true, false)
+			pa := r.priorityAssigners[tableID]
+			pa.assigner.updatePriorityAssignment(100, p1)
+			pa.assigner.updatePriorityAssignment(101, p2)
+
+			mockOFClient.EXPECT().ReassignFlowPriorities(map[uint16]uint16{100: 52, 101: 137}, tableID).Return(tt.reassignErr)
+			err := r.defragmentPriorities(tableID, pa)
+			if tt.expectedErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOFPriority, pa.assigner.priorityMap)
+			assert.NoError(t, pa.assigner.audit())
+		})
+	}
+}
+
 func TestReconcilerBatchReconcile(t *testing.T) {
 	ifaceStore := interfacestore.NewInterfaceStore()
 	ifaceStore.AddInterface(&interfacestore.InterfaceConfig{
@@ -15,6 +15,7 @@
 package networkpolicy
 
 import (
+	"errors"
 	"fmt"
 	"math"
 	"sort"
@@ -36,8 +37,16 @@ const (
 	priorityOffsetMultiTier      = float64(20)
 	priorityOffsetDefaultTier    = float64(100)
 	tierOffsetMultiTier          = uint16(200)
+	// defragmentReassignThreshold is the number of registered Priorities that can be reassigned
+	// when registering new Priorities, above which the ofPriority space is considered fragmented
+	// and is repacked.
+	defragmentReassignThreshold = 50
 )
 
+// errPriorityOverflow is returned when there are not enough ofPriorities left in the table to register
+// new Priorities. Defragmenting the ofPriority space does not help in that case.
+var errPriorityOverflow = errors.New("number of priorities to be registered is greater than available openflow priorities")
+
 // priorityUpdate stores the original and updated ofPriority of a Priority.
 type priorityUpdate struct {
 	Original uint16
@@ -274,7 +283,7 @@ func (pa *priorityAssigner) registerPriorities(priorities []types.Priority) (map
 	if numPriorityToRegister == 0 {
 		return nil, nil, nil
 	} else if uint16(numPriorityToRegister+len(pa.sortedPriorities)) > pa.policyTopPriority-pa.policyBottomPriority+1 {
-		return nil, nil, fmt.Errorf("number of priorities to be registered is greater than available openflow priorities")
+		return nil, nil, errPriorityOverflow
 	}
 	sort.Sort(types.ByPriority(prioritiesToRegister))
 	var consecutivePriorities [][]types.Priority
@@ -396,3 +405,84 @@ func (pa *priorityAssigner) unregisterPriority(priority types.Priority) {
 	}
 	pa.sortedPriorities = append(pa.sortedPriorities[:idxToDel], pa.sortedPriorities[idxToDel+1:]...)
 }
+
+// utilization returns the ratio of ofPriorities in use in the table managed by the priorityAssigner.
+func (pa *priorityAssigner) utilization() float64 {
+	return float64(len(pa.sortedPriorities)) / float64(pa.policyTopPriority-pa.policyBottomPriority+1)
+}
+
+// audit verifies that the Priority <-> ofPriority mappings are consistent with each other, and
+// that the ofPriorities of the sorted Priorities are strictly increasing and within the boundaries
+// of the table.
+func (pa *priorityAssigner) audit() error {
+	if len(pa.priorityMap) != len(pa.sortedPriorities) || len(pa.ofPriorityMap) != len(pa.sortedPriorities) {
+		return fmt.Errorf("inconsistent number of registered priorities: priorityMap %d, ofPriorityMap %d, sortedPriorities %d",
+			len(pa.priorityMap), len(pa.ofPriorityMap), len(pa.sortedPriorities))
+	}
+	for i, p := range pa.sortedPriorities {
+		of, ok := pa.priorityMap[p]
+		if !ok {
+			return fmt.Errorf("priority %v is not mapped to any ofPriority", p)
+		}
+		if mapped, ok := pa.ofPriorityMap[of]; !ok || !mapped.Equals(p) {
+			return fmt.Errorf("ofPriority %d is not mapped back to priority %v", of, p)
+		}
+		if of < pa.policyBottomPriority || of > pa.policyTopPriority {
+			return fmt.Errorf("ofPriority %d of priority %v is out of range [%d, %d]", of, p, pa.policyBottomPriority, pa.policyTopPriority)
+		}
+		if i > 0 && pa.priorityMap[pa.sortedPriorities[i-1]] >= of {
+			return fmt.Errorf("ofPriority %d of priority %v is not higher than the ofPriority of priority %v", of, p, pa.sortedPriorities[i-1])
+		}
+	}
+	return nil
+}
+
+// defragment repacks all registered Priorities by spreading them evenly across the ofPriority space
+// of the table, while preserving their relative order. Registrations and releases over time can
+// leave registered Priorities clustered together, in which case registering new Priorities requires
+// reassigning a large number of installed flows; repacking leaves the same gap between any two
+// adjacent Priorities. Like registerPriorities, it returns the ofPriority updates to be applied to
+// the installed flows, and a revert function that can undo the repacking if any error occurred in
+// data plane.
+func (pa *priorityAssigner) defragment() (map[uint16]uint16, func(), error) {
+	if err := pa.audit(); err != nil {
+		return nil, nil, fmt.Errorf("cannot defragment inconsistent priorities: %w", err)
+	}
+	numPriorities := len(pa.sortedPriorities)
+	if numPriorities == 0 {
+		return nil, nil, nil
+	}
+	span := int(pa.policyTopPriority-pa.policyBottomPriority) + 1
+	// step is the distance between two adjacent Priorities after repacking. The free slots left
+	// by the integer division are split evenly below the lowest and above the highest Priority.
+	step := span / numPriorities
+	start := int(pa.policyBottomPriority) + (span-step*(numPriorities-1)-1)/2
+	allPriorityUpdates := map[types.Priority]*priorityUpdate{}
+	for i, p := range pa.sortedPriorities {
+		updated := uint16(start + i*step)
+		if original := pa.priorityMap[p]; original != updated {
+			allPriorityUpdates[p] = &priorityUpdate{Original: original, Updated: updated}
+		}
+	}
+	if len(allPriorityUpdates) == 0 {
+		return nil, nil, nil
+	}
+	applyUpdates := func(getOFPriorities func(update *priorityUpdate) (uint16, uint16)) {
+		// All the old mappings need to be deleted first, as an updated ofPriority may be the
+		// original ofPriority of another Priority.
+		for _, update := range allPriorityUpdates {
+			from, _ := getOFPriorities(update)
+			delete(pa.ofPriorityMap, from)
+		}
+		for p, update := range allPriorityUpdates {
+			_, to := getOFPriorities(update)
+			pa.ofPriorityMap[to] = p
+			pa.priorityMap[p] = to
+		}
+	}
+	applyUpdates(func(update *priorityUpdate) (uint16, uint16) { return update.Original, update.Updated })
+	revertFunc := func() {
+		applyUpdates(func(update *priorityUpdate) (uint16, uint16) { return update.Updated, update.Original })
+	}
+	return priorityUpdatesToOFUpdates(allPriorityUpdates), revertFunc, nil
+}
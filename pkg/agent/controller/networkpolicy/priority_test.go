@@ -349,3 +349,77 @@ func TestRegisterAllOFPriorities(t *testing.T) {
 	_, _, err = pa.registerPriorities([]types.Priority{extraPriority})
 	assert.Errorf(t, err, "Error should be raised after max number of priorities are registered")
 }
+
+func TestAudit(t *testing.T) {
+	pa := newPriorityAssigner(true)
+	pa.updatePriorityAssignment(100, p1121)
+	pa.updatePriorityAssignment(101, p1120)
+	pa.updatePriorityAssignment(102, p110)
+	assert.NoError(t, pa.audit())
+
+	// The ofPriorities are no longer ordered like the Priorities.
+	delete(pa.ofPriorityMap, 101)
+	pa.ofPriorityMap[103] = p1120
+	pa.priorityMap[p1120] = 103
+	assert.Error(t, pa.audit())
+
+	pa = newPriorityAssigner(true)
+	pa.updatePriorityAssignment(100, p1121)
+	// The ofPriority is not mapped back to the Priority.
+	delete(pa.ofPriorityMap, 100)
+	assert.Error(t, pa.audit())
+}
+
+func TestDefragment(t *testing.T) {
+	pa := newPriorityAssigner(true)
+	pa.updatePriorityAssignment(100, p1121)
+	pa.updatePriorityAssignment(101, p1120)
+	pa.updatePriorityAssignment(102, p110)
+	assert.InDelta(t, 3.0/171, pa.utilization(), 1e-9)
+
+	// The 171 ofPriorities of the baseline tier table are split into 3 chunks of 57, and the
+	// remaining free slots are split evenly below and above the registered Priorities.
+	updates, revertFunc, err := pa.defragment()
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint16]uint16{100: 38, 101: 95, 102: 152}, updates)
+	assert.Equal(t, map[uint16]types.Priority{38: p1121, 95: p1120, 152: p110}, pa.ofPriorityMap)
+	assert.Equal(t, map[types.Priority]uint16{p1121: 38, p1120: 95, p110: 152}, pa.priorityMap)
+	assert.Equal(t, types.ByPriority{p1121, p1120, p110}, pa.sortedPriorities)
+	assert.NoError(t, pa.audit())
+
+	// Defragmenting again is a no-op.
+	updates, _, err = pa.defragment()
+	assert.NoError(t, err)
+	assert.Empty(t, updates)
+
+	revertFunc()
+	assert.Equal(t, map[uint16]types.Priority{100: p1121, 101: p1120, 102: p110}, pa.ofPriorityMap)
+	assert.Equal(t, map[types.Priority]uint16{p1121: 100, p1120: 101, p110: 102}, pa.priorityMap)
+	assert.NoError(t, pa.audit())
+}
+
+func TestDefragmentOverlappingUpdates(t *testing.T) {
+	pa := newPriorityAssigner(true)
+	// The updated ofPriority of each Priority is the original ofPriority of the Priority below.
+	pa.updatePriorityAssignment(95, p1121)
+	pa.updatePriorityAssignment(152, p1120)
+	pa.updatePriorityAssignment(170, p110)
+
+	updates, revertFunc, err := pa.defragment()
+	assert.NoError(t, err)
+	assert.Equal(t, map[uint16]uint16{95: 38, 152: 95, 170: 152}, updates)
+	assert.Equal(t, map[uint16]types.Priority{38: p1121, 95: p1120, 152: p110}, pa.ofPriorityMap)
+	assert.NoError(t, pa.audit())
+
+	revertFunc()
+	assert.Equal(t, map[uint16]types.Priority{95: p1121, 152: p1120, 170: p110}, pa.ofPriorityMap)
+	assert.NoError(t, pa.audit())
+}
+
+func TestDefragmentEmpty(t *testing.T) {
+	pa := newPriorityAssigner(false)
+	updates, revertFunc, err := pa.defragment()
+	assert.NoError(t, err)
+	assert.Nil(t, updates)
+	assert.Nil(t, revertFunc)
+}
@@ -0,0 +1,301 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	agenttypes "antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/util/channel"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+const (
+	// PodNetworkPoliciesRealizedCondition is the type of the Pod condition set by antrea-agent once all the
+	// NetworkPolicies applied to the Pod have been realized. Pods can include it in their readinessGates so that
+	// they are not considered ready before their NetworkPolicies are enforced.
+	PodNetworkPoliciesRealizedCondition corev1.PodConditionType = apis.PodNetworkPoliciesRealizedCondition
+
+	readinessGateControllerName = "AntreaAgentPodReadinessGateController"
+	// How long to wait before checking the realization of a Pod's NetworkPolicies again.
+	readinessGateRecheckInterval = 1 * time.Second
+)
+
+// podRealizationTracker keeps track of the Pods for which each NetworkPolicy rule has been realized.
+type podRealizationTracker struct {
+	mutex sync.RWMutex
+	// rulePods maps a rule ID to the keys of the Pods for which the rule has been realized.
+	rulePods map[string]sets.Set[string]
+}
+
+func newPodRealizationTracker() *podRealizationTracker {
+	return &podRealizationTracker{rulePods: map[string]sets.Set[string]{}}
+}
+
+// realizablePods returns the keys of the target Pods of the rule that have an interface on this Node. Rules are
+// only realized for Pods with an interface, so it must be called before reconciling the rule.
+func realizablePods(rule *CompletedRule, ifaceStore interfacestore.InterfaceStore) sets.Set[string] {
+	pods := sets.New[string]()
+	for _, member := range rule.TargetMembers {
+		if member.Pod == nil {
+			continue
+		}
+		if len(ifaceStore.GetContainerInterfacesByPod(member.Pod.Name, member.Pod.Namespace)) > 0 {
+			pods.Insert(k8s.NamespacedName(member.Pod.Namespace, member.Pod.Name))
+		}
+	}
+	return pods
+}
+
+func (t *podRealizationTracker) setRealizedPods(ruleID string, pods sets.Set[string]) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.rulePods[ruleID] = pods
+}
+
+func (t *podRealizationTracker) deleteRule(ruleID string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.rulePods, ruleID)
+}
+
+func (t *podRealizationTracker) isRealized(ruleID string, podKey string) bool {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+	return t.rulePods[ruleID].Has(podKey)
+}
+
+// podNetworkPoliciesRealized returns whether all the NetworkPolicy rules applied to the Pod have been realized. The
+// NetworkPolicies which have not been received yet are unknown to the agent, so the NetworkPolicies computed for the
+// Pod by antrea-controller must have been received first: the agent must have at least their generations, and its
+// AppliedToGroups must include the Pod.
+func (c *Controller) podNetworkPoliciesRealized(pod *corev1.Pod) bool {
+	podKey := k8s.NamespacedName(pod.Namespace, pod.Name)
+	value, exists := pod.Annotations[apis.PodNetworkPoliciesAnnotationKey]
+	if !exists {
+		klog.V(4).InfoS("NetworkPolicies of Pod are not computed by antrea-controller yet", "pod", podKey)
+		return false
+	}
+	var expectedPolicies []apis.PodNetworkPolicy
+	if err := json.Unmarshal([]byte(value), &expectedPolicies); err != nil {
+		klog.ErrorS(err, "Failed to parse NetworkPolicies of Pod", "pod", podKey)
+		return false
+	}
+	for _, expected := range expectedPolicies {
+		policy := c.ruleCache.getNetworkPolicy(expected.UID)
+		if policy == nil || policy.Generation < expected.Generation {
+			klog.V(4).InfoS("NetworkPolicy is not received yet", "uid", expected.UID, "generation", expected.Generation, "pod", podKey)
+			return false
+		}
+		for _, group := range expected.AppliedToGroups {
+			if !c.ruleCache.appliedToGroupHasPod(group, pod.Name, pod.Namespace) {
+				klog.V(4).InfoS("AppliedToGroup does not include Pod yet", "group", group, "pod", podKey)
+				return false
+			}
+		}
+	}
+	for _, ruleID := range c.ruleCache.getAppliedRuleIDs(pod.Name, pod.Namespace) {
+		if !c.podRealizationTracker.isRealized(ruleID, podKey) {
+			klog.V(4).InfoS("NetworkPolicy rule is not realized for Pod yet", "ruleID", ruleID, "pod", podKey)
+			return false
+		}
+	}
+	return true
+}
+
+// PodReadinessGateController sets the PodNetworkPoliciesRealizedCondition condition of the local Pods which
+// include it in their readinessGates, once all the NetworkPolicies applied to them have been realized, including
+// the ones computed by antrea-controller for the Pods which have not been received by this Node yet.
+type PodReadinessGateController struct {
+	kubeClient       kubernetes.Interface
+	podInformer      cache.SharedIndexInformer
+	podLister        corelisters.PodLister
+	podListerSynced  cache.InformerSynced
+	policyController *Controller
+	queue            workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewPodReadinessGateController returns a new *PodReadinessGateController. The provided policyController must be
+// created with the Pod readiness gate enabled.
+func NewPodReadinessGateController(kubeClient kubernetes.Interface,
+	podInformer cache.SharedIndexInformer,
+	podUpdateSubscriber channel.Subscriber,
+	policyController *Controller) *PodReadinessGateController {
+	c := &PodReadinessGateController{
+		kubeClient:       kubeClient,
+		podInformer:      podInformer,
+		podLister:        corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced:  podInformer.HasSynced,
+		policyController: policyController,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "podreadinessgate",
+			},
+		),
+	}
+	c.podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueuePod,
+		UpdateFunc: func(_, newObj interface{}) { c.enqueuePod(newObj) },
+	})
+	// The Pod can only be realized after its interface is created, subscribe to the Pod events published by the
+	// CNIServer to check it again in time.
+	podUpdateSubscriber.Subscribe(c.processPodUpdate)
+	return c
+}
+
+func (c *PodReadinessGateController) enqueuePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || !podRequiresRealizedCondition(pod) {
+		return
+	}
+	c.queue.Add(k8s.NamespacedName(pod.Namespace, pod.Name))
+}
+
+func (c *PodReadinessGateController) processPodUpdate(e interface{}) {
+	podEvent := e.(agenttypes.PodUpdate)
+	if !podEvent.IsAdd {
+		return
+	}
+	c.queue.Add(k8s.NamespacedName(podEvent.PodNamespace, podEvent.PodName))
+}
+
+// podRequiresRealizedCondition returns whether the Pod has the readiness gate but the condition is not true yet.
+func podRequiresRealizedCondition(pod *corev1.Pod) bool {
+	if pod.DeletionTimestamp != nil {
+		return false
+	}
+	hasReadinessGate := false
+	for _, gate := range pod.Spec.ReadinessGates {
+		if gate.ConditionType == PodNetworkPoliciesRealizedCondition {
+			hasReadinessGate = true
+			break
+		}
+	}
+	if !hasReadinessGate {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == PodNetworkPoliciesRealizedCondition {
+			return condition.Status != corev1.ConditionTrue
+		}
+	}
+	return true
+}
+
+func (c *PodReadinessGateController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting controller", "name", readinessGateControllerName)
+	defer klog.InfoS("Shutting down controller", "name", readinessGateControllerName)
+
+	if !cache.WaitForNamedCacheSync(readinessGateControllerName, stopCh, c.podListerSynced) {
+		return
+	}
+	// The realization status is incomplete until the NetworkPolicies of the initial Pods have been installed.
+	if err := c.policyController.podNetworkWait.WaitUntil(stopCh); err != nil {
+		return
+	}
+	go wait.Until(c.worker, time.Second, stopCh)
+	<-stopCh
+}
+
+func (c *PodReadinessGateController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *PodReadinessGateController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.syncPod(key); err != nil {
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Failed to sync Pod readiness gate", "pod", key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *PodReadinessGateController) syncPod(key string) error {
+	namespace, name := k8s.SplitNamespacedName(key)
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !podRequiresRealizedCondition(pod) {
+		return nil
+	}
+	// NetworkPolicies are not enforced for hostNetwork Pods.
+	if !pod.Spec.HostNetwork {
+		if len(c.policyController.ifaceStore.GetContainerInterfacesByPod(name, namespace)) == 0 {
+			klog.V(2).InfoS("Interface of Pod is not created yet", "pod", key)
+			return nil
+		}
+		if !c.policyController.podNetworkPoliciesRealized(pod) {
+			c.queue.AddAfter(key, readinessGateRecheckInterval)
+			return nil
+		}
+	}
+	return c.setRealizedCondition(pod)
+}
+
+func (c *PodReadinessGateController) setRealizedCondition(pod *corev1.Pod) error {
+	patch := map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": []corev1.PodCondition{
+				{
+					Type:               PodNetworkPoliciesRealizedCondition,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.Now(),
+				},
+			},
+		},
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	if _, err := c.kubeClient.CoreV1().Pods(pod.Namespace).Patch(context.TODO(), pod.Name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{}, "status"); err != nil {
+		return fmt.Errorf("error patching status of Pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	klog.InfoS("Set NetworkPolicies realized condition for Pod", "pod", klog.KObj(pod))
+	return nil
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	"antrea.io/antrea/pkg/util/channel"
+)
+
+func newReadinessGatePod(name string, hostNetwork bool, conditions ...corev1.PodCondition) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   testNamespace,
+			Name:        name,
+			Annotations: map[string]string{apis.PodNetworkPoliciesAnnotationKey: "[]"},
+		},
+		Spec: corev1.PodSpec{
+			HostNetwork:    hostNetwork,
+			ReadinessGates: []corev1.PodReadinessGate{{ConditionType: PodNetworkPoliciesRealizedCondition}},
+		},
+		Status: corev1.PodStatus{Conditions: conditions},
+	}
+}
+
+func TestPodRequiresRealizedCondition(t *testing.T) {
+	tests := []struct {
+		name     string
+		pod      *corev1.Pod
+		expected bool
+	}{
+		{
+			name:     "without readiness gate",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "pod1"}},
+			expected: false,
+		},
+		{
+			name:     "without condition",
+			pod:      newReadinessGatePod("pod1", false),
+			expected: true,
+		},
+		{
+			name:     "condition false",
+			pod:      newReadinessGatePod("pod1", false, corev1.PodCondition{Type: PodNetworkPoliciesRealizedCondition, Status: corev1.ConditionFalse}),
+			expected: true,
+		},
+		{
+			name:     "condition true",
+			pod:      newReadinessGatePod("pod1", false, corev1.PodCondition{Type: PodNetworkPoliciesRealizedCondition, Status: corev1.ConditionTrue}),
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, podRequiresRealizedCondition(tt.pod))
+		})
+	}
+}
+
+func TestPodReadinessGateControllerSyncPod(t *testing.T) {
+	pod1 := newReadinessGatePod("pod1", false)
+	pod1.Annotations[apis.PodNetworkPoliciesAnnotationKey] = `[{"uid":"policy1","generation":2,"appliedToGroups":["atg1"]}]`
+	hostNetworkPod := newReadinessGatePod("pod2", true)
+	podWithoutInterface := newReadinessGatePod("pod3", false)
+	// The NetworkPolicies of pod4 are not computed by antrea-controller yet.
+	podWithoutPolicies := newReadinessGatePod("pod4", false)
+	delete(podWithoutPolicies.Annotations, apis.PodNetworkPoliciesAnnotationKey)
+	// The AppliedToGroup atg2 computed by antrea-controller for pod5 does not include it yet.
+	podWithoutAppliedToGroup := newReadinessGatePod("pod5", false)
+	podWithoutAppliedToGroup.Annotations[apis.PodNetworkPoliciesAnnotationKey] = `[{"uid":"policy1","generation":1,"appliedToGroups":["atg2"]}]`
+	kubeClient := k8sfake.NewSimpleClientset(pod1, hostNetworkPod, podWithoutInterface, podWithoutPolicies, podWithoutAppliedToGroup)
+	podInformer := coreinformers.NewPodInformer(kubeClient, metav1.NamespaceAll, 0, cache.Indexers{})
+
+	policyController, _, _ := newTestController()
+	policyController.podRealizationTracker = newPodRealizationTracker()
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod1-iface", "c1", "pod1", testNamespace, "eth0", nil, nil, 0))
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod4-iface", "c4", "pod4", testNamespace, "eth0", nil, nil, 0))
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod5-iface", "c5", "pod5", testNamespace, "eth0", nil, nil, 0))
+	policyController.ifaceStore = ifaceStore
+	// rule1 of policy1 is applied to pod1. Only the first generation of policy1 has been received.
+	policyController.ruleCache.policyMap["policy1"] = &v1beta2.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{UID: "policy1"}, Generation: 1}
+	policyController.ruleCache.appliedToSetByGroup["atg1"] = v1beta2.NewGroupMemberSet(newAppliedToGroupMemberPod("pod1", testNamespace))
+	policyController.ruleCache.appliedToSetByGroup["atg2"] = v1beta2.NewGroupMemberSet()
+	policyController.ruleCache.rules.Add(&rule{ID: "rule1", PolicyUID: "policy1", AppliedToGroups: []string{"atg1"}})
+
+	c := NewPodReadinessGateController(kubeClient, podInformer, channel.NewSubscribableChannel("PodUpdate", 100), policyController)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go podInformer.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, podInformer.HasSynced))
+
+	getCondition := func(name string) *corev1.PodCondition {
+		pod, err := kubeClient.CoreV1().Pods(testNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == PodNetworkPoliciesRealizedCondition {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	// The condition of hostNetwork Pods is set immediately.
+	require.NoError(t, c.syncPod("ns1/pod2"))
+	condition := getCondition("pod2")
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+
+	// The condition is not set before the Pod interface is created.
+	require.NoError(t, c.syncPod("ns1/pod3"))
+	assert.Nil(t, getCondition("pod3"))
+
+	// The condition is not set before rule1 is realized for pod1.
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	assert.Nil(t, getCondition("pod1"))
+	policyController.podRealizationTracker.setRealizedPods("rule1", sets.New[string]("ns1/pod2"))
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	assert.Nil(t, getCondition("pod1"))
+
+	// The condition is not set before the generation of policy1 computed by antrea-controller is received.
+	policyController.podRealizationTracker.setRealizedPods("rule1", sets.New[string]("ns1/pod1"))
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	assert.Nil(t, getCondition("pod1"))
+
+	policyController.ruleCache.policyMap["policy1"] = &v1beta2.NetworkPolicy{ObjectMeta: metav1.ObjectMeta{UID: "policy1"}, Generation: 2}
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	condition = getCondition("pod1")
+	require.NotNil(t, condition)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+
+	// The condition is not set before antrea-controller computes the NetworkPolicies of the Pod, even if no rule is
+	// applied to it.
+	require.NoError(t, c.syncPod("ns1/pod4"))
+	assert.Nil(t, getCondition("pod4"))
+
+	// The condition is not set before the AppliedToGroups computed by antrea-controller include the Pod.
+	require.NoError(t, c.syncPod("ns1/pod5"))
+	assert.Nil(t, getCondition("pod5"))
+
+	// Deleted Pods are ignored.
+	require.NoError(t, c.syncPod("ns1/pod6"))
+}
+
+func TestRealizablePods(t *testing.T) {
+	ifaceStore := interfacestore.NewInterfaceStore()
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod1-iface", "c1", "pod1", testNamespace, "eth0", nil, nil, 0))
+	rule := &CompletedRule{
+		rule: &rule{ID: "rule1"},
+		TargetMembers: v1beta2.NewGroupMemberSet(
+			newAppliedToGroupMemberPod("pod1", testNamespace),
+			newAppliedToGroupMemberPod("pod2", testNamespace),
+		),
+	}
+	assert.Equal(t, sets.New[string]("ns1/pod1"), realizablePods(rule, ifaceStore))
+}
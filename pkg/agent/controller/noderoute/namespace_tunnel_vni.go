@@ -0,0 +1,248 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noderoute
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/openflow"
+	agenttypes "antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/util/channel"
+)
+
+const (
+	namespaceTunnelVNIControllerName = "NamespaceTunnelVNIController"
+	namespaceTunnelVNIWorkers        = 4
+
+	// maxTunnelVNI is the maximum VNI supported by Geneve and VXLAN, which is a 24-bit field.
+	maxTunnelVNI = 1<<24 - 1
+)
+
+// NamespaceTunnelVNIController sets the tunnel ID, i.e. the VNI of Geneve and VXLAN, of the tunneled traffic of the
+// local Pods to the VNI specified by the "namespace.antrea.io/tunnel-vni" annotation of their Namespaces, by updating
+// the classifier flows of the Pods. It lets the devices of the underlay network and observability tools attribute
+// overlay traffic to tenants.
+type NamespaceTunnelVNIController struct {
+	ofClient              openflow.Client
+	interfaceStore        interfacestore.InterfaceStore
+	podLister             corelisters.PodLister
+	podListerSynced       cache.InformerSynced
+	namespaceLister       corelisters.NamespaceLister
+	namespaceListerSynced cache.InformerSynced
+	queue                 workqueue.TypedRateLimitingInterface[types.NamespacedName]
+
+	mutex sync.Mutex
+	// podVNIs stores the VNI loaded into the classifier flow of each Pod. The Pods whose classifier flow doesn't load
+	// any VNI are not stored.
+	podVNIs map[types.NamespacedName]uint32
+}
+
+func NewNamespaceTunnelVNIController(
+	ofClient openflow.Client,
+	interfaceStore interfacestore.InterfaceStore,
+	podInformer cache.SharedIndexInformer,
+	namespaceInformer coreinformers.NamespaceInformer,
+	podUpdateSubscriber channel.Subscriber,
+) *NamespaceTunnelVNIController {
+	c := &NamespaceTunnelVNIController{
+		ofClient:              ofClient,
+		interfaceStore:        interfaceStore,
+		podLister:             corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced:       podInformer.HasSynced,
+		namespaceLister:       namespaceInformer.Lister(),
+		namespaceListerSynced: namespaceInformer.Informer().HasSynced,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedItemBasedRateLimiter[types.NamespacedName](),
+			workqueue.TypedRateLimitingQueueConfig[types.NamespacedName]{
+				Name: "namespaceTunnelVNI",
+			},
+		),
+		podVNIs: map[types.NamespacedName]uint32{},
+	}
+	// Pod add events are handled with the events from podUpdateSubscriber, which are only received after the Pod
+	// flows have been installed by the CNIServer.
+	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: c.processPodDelete,
+	})
+	namespaceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.processNamespaceUpdate,
+	})
+	podUpdateSubscriber.Subscribe(c.processPodCNIAddEvent)
+	return c
+}
+
+func (c *NamespaceTunnelVNIController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting controller", "controller", namespaceTunnelVNIControllerName)
+	defer klog.InfoS("Shutting down controller", "controller", namespaceTunnelVNIControllerName)
+	if !cache.WaitForNamedCacheSync(namespaceTunnelVNIControllerName, stopCh, c.podListerSynced, c.namespaceListerSynced) {
+		return
+	}
+	pods, _ := c.podLister.List(labels.Everything())
+	for _, pod := range pods {
+		if !pod.Spec.HostNetwork {
+			c.queue.Add(types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name})
+		}
+	}
+	for i := 0; i < namespaceTunnelVNIWorkers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (c *NamespaceTunnelVNIController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *NamespaceTunnelVNIController) processNextWorkItem() bool {
+	podRef, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(podRef)
+
+	if err := c.syncPod(podRef); err == nil {
+		c.queue.Forget(podRef)
+	} else {
+		c.queue.AddRateLimited(podRef)
+		klog.ErrorS(err, "Error syncing Pod tunnel VNI, requeuing", "pod", podRef)
+	}
+	return true
+}
+
+func (c *NamespaceTunnelVNIController) syncPod(podRef types.NamespacedName) error {
+	pod, err := c.podLister.Pods(podRef.Namespace).Get(podRef.Name)
+	if err != nil || pod.Spec.HostNetwork {
+		return nil
+	}
+	containerConfigs := c.interfaceStore.GetContainerInterfacesByPod(podRef.Name, podRef.Namespace)
+	if len(containerConfigs) == 0 {
+		// The Pod will be synced again when the CNIServer has installed its flows.
+		klog.V(2).InfoS("Pod container config not found, skip syncing tunnel VNI", "pod", podRef)
+		return nil
+	}
+	namespace, err := c.namespaceLister.Get(podRef.Namespace)
+	if err != nil {
+		return fmt.Errorf("error when getting Namespace %s: %w", podRef.Namespace, err)
+	}
+	vni, hasVNI := getNamespaceTunnelVNI(namespace)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	installedVNI, installed := c.podVNIs[podRef]
+	if hasVNI == installed && vni == installedVNI {
+		return nil
+	}
+	var tunnelID *uint32
+	if hasVNI {
+		tunnelID = &vni
+	}
+	if err := c.ofClient.InstallPodFlows(
+		containerConfigs[0].InterfaceName,
+		containerConfigs[0].IPs,
+		containerConfigs[0].MAC,
+		uint32(containerConfigs[0].OFPort),
+		containerConfigs[0].VLANID,
+		tunnelID,
+	); err != nil {
+		return err
+	}
+	if hasVNI {
+		c.podVNIs[podRef] = vni
+	} else {
+		delete(c.podVNIs, podRef)
+	}
+	klog.V(2).InfoS("Updated tunnel VNI of Pod", "pod", podRef, "vni", vni)
+	return nil
+}
+
+// processPodCNIAddEvent enqueues the Pod whose flows have just been installed by the CNIServer, without any VNI.
+func (c *NamespaceTunnelVNIController) processPodCNIAddEvent(e interface{}) {
+	podEvent := e.(agenttypes.PodUpdate)
+	if !podEvent.IsAdd {
+		return
+	}
+	podRef := types.NamespacedName{
+		Namespace: podEvent.PodNamespace,
+		Name:      podEvent.PodName,
+	}
+	c.mutex.Lock()
+	delete(c.podVNIs, podRef)
+	c.mutex.Unlock()
+	c.queue.Add(podRef)
+}
+
+// processPodDelete removes the Pod from podVNIs. Its flows are uninstalled by the CNIServer.
+func (c *NamespaceTunnelVNIController) processPodDelete(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		pod, ok = deletedState.Obj.(*corev1.Pod)
+		if !ok {
+			return
+		}
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.podVNIs, types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name})
+}
+
+// processNamespaceUpdate enqueues all Pods in the Namespace if its tunnel VNI annotation has been updated.
+func (c *NamespaceTunnelVNIController) processNamespaceUpdate(old, cur interface{}) {
+	oldNS, _ := old.(*corev1.Namespace)
+	curNS, _ := cur.(*corev1.Namespace)
+	if oldNS.Annotations[agenttypes.NamespaceTunnelVNIAnnotationKey] == curNS.Annotations[agenttypes.NamespaceTunnelVNIAnnotationKey] {
+		return
+	}
+	pods, _ := c.podLister.Pods(curNS.Name).List(labels.Everything())
+	for _, pod := range pods {
+		if !pod.Spec.HostNetwork {
+			c.queue.Add(types.NamespacedName{Namespace: pod.Namespace, Name: pod.Name})
+		}
+	}
+}
+
+// getNamespaceTunnelVNI returns the VNI specified by the annotation of the Namespace. An invalid VNI is ignored.
+func getNamespaceTunnelVNI(namespace *corev1.Namespace) (uint32, bool) {
+	value, exists := namespace.Annotations[agenttypes.NamespaceTunnelVNIAnnotationKey]
+	if !exists {
+		return 0, false
+	}
+	vni, err := strconv.ParseUint(value, 10, 32)
+	if err != nil || vni == 0 || vni > maxTunnelVNI {
+		klog.ErrorS(err, "Ignored invalid tunnel VNI annotation, it must be an integer between 1 and 16777215", "namespace", namespace.Name, "value", value)
+		return 0, false
+	}
+	return uint32(vni), true
+}
@@ -0,0 +1,161 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noderoute
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	oftest "antrea.io/antrea/pkg/agent/openflow/testing"
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/util/channel"
+)
+
+func newTunnelVNITestNamespace(vni string) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1"}}
+	if vni != "" {
+		ns.Annotations = map[string]string{types.NamespaceTunnelVNIAnnotationKey: vni}
+	}
+	return ns
+}
+
+func TestNamespaceTunnelVNIControllerSyncPod(t *testing.T) {
+	podMAC, _ := net.ParseMAC("aa:bb:cc:dd:ee:ff")
+	podIPs := []net.IP{net.ParseIP("1.1.0.2")}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod1", Namespace: "ns1"}}
+	podRef := apitypes.NamespacedName{Namespace: "ns1", Name: "pod1"}
+
+	tests := []struct {
+		name               string
+		namespace          *corev1.Namespace
+		installedVNI       *uint32
+		expectedTunnelID   *uint32
+		expectInstallFlows bool
+	}{
+		{
+			name:               "VNI set",
+			namespace:          newTunnelVNITestNamespace("100"),
+			expectedTunnelID:   ptr.To[uint32](100),
+			expectInstallFlows: true,
+		},
+		{
+			name:               "VNI changed",
+			namespace:          newTunnelVNITestNamespace("200"),
+			installedVNI:       ptr.To[uint32](100),
+			expectedTunnelID:   ptr.To[uint32](200),
+			expectInstallFlows: true,
+		},
+		{
+			name:         "VNI unchanged",
+			namespace:    newTunnelVNITestNamespace("100"),
+			installedVNI: ptr.To[uint32](100),
+		},
+		{
+			name:               "VNI removed",
+			namespace:          newTunnelVNITestNamespace(""),
+			installedVNI:       ptr.To[uint32](100),
+			expectInstallFlows: true,
+		},
+		{
+			name:      "no VNI",
+			namespace: newTunnelVNITestNamespace(""),
+		},
+		{
+			name:      "invalid VNI",
+			namespace: newTunnelVNITestNamespace("16777216"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			ofClient := oftest.NewMockClient(ctrl)
+			ifaceStore := interfacestore.NewInterfaceStore()
+			containerConfig := interfacestore.NewContainerInterface("pod1-abcd", "c1", "pod1", "ns1", "eth0", podMAC, podIPs, 0)
+			containerConfig.OVSPortConfig = &interfacestore.OVSPortConfig{OFPort: 3}
+			ifaceStore.AddInterface(containerConfig)
+
+			clientset := fake.NewSimpleClientset(tt.namespace, pod)
+			informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+			podUpdateChannel := channel.NewSubscribableChannel("PodUpdate", 100)
+			c := NewNamespaceTunnelVNIController(ofClient, ifaceStore, informerFactory.Core().V1().Pods().Informer(), informerFactory.Core().V1().Namespaces(), podUpdateChannel)
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			informerFactory.Start(stopCh)
+			informerFactory.WaitForCacheSync(stopCh)
+
+			if tt.installedVNI != nil {
+				c.podVNIs[podRef] = *tt.installedVNI
+			}
+			if tt.expectInstallFlows {
+				ofClient.EXPECT().InstallPodFlows("pod1-abcd", podIPs, podMAC, uint32(3), uint16(0), tt.expectedTunnelID)
+			}
+			require.NoError(t, c.syncPod(podRef))
+			if tt.expectedTunnelID != nil {
+				assert.Equal(t, map[apitypes.NamespacedName]uint32{podRef: *tt.expectedTunnelID}, c.podVNIs)
+			} else {
+				assert.Empty(t, c.podVNIs)
+			}
+		})
+	}
+}
+
+func TestNamespaceTunnelVNIControllerPodCNIAddEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	clientset := fake.NewSimpleClientset()
+	informerFactory := informers.NewSharedInformerFactory(clientset, 0)
+	podUpdateChannel := channel.NewSubscribableChannel("PodUpdate", 100)
+	c := NewNamespaceTunnelVNIController(oftest.NewMockClient(ctrl), interfacestore.NewInterfaceStore(), informerFactory.Core().V1().Pods().Informer(), informerFactory.Core().V1().Namespaces(), podUpdateChannel)
+	podRef := apitypes.NamespacedName{Namespace: "ns1", Name: "pod1"}
+	c.podVNIs[podRef] = 100
+
+	// The flows installed by the CNIServer don't load any VNI, the Pod must be synced again.
+	c.processPodCNIAddEvent(types.PodUpdate{PodNamespace: "ns1", PodName: "pod1", IsAdd: true})
+	assert.Empty(t, c.podVNIs)
+	assert.Equal(t, 1, c.queue.Len())
+}
+
+func TestGetNamespaceTunnelVNI(t *testing.T) {
+	tests := []struct {
+		value       string
+		expectedVNI uint32
+		expectedOK  bool
+	}{
+		{value: "", expectedOK: false},
+		{value: "1", expectedVNI: 1, expectedOK: true},
+		{value: "16777215", expectedVNI: 16777215, expectedOK: true},
+		{value: "0", expectedOK: false},
+		{value: "16777216", expectedOK: false},
+		{value: "-1", expectedOK: false},
+		{value: "tenant1", expectedOK: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			vni, ok := getNamespaceTunnelVNI(newTunnelVNITestNamespace(tt.value))
+			assert.Equal(t, tt.expectedVNI, vni)
+			assert.Equal(t, tt.expectedOK, ok)
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"net/netip"
+	"slices"
 	"sync"
 	"time"
 
@@ -36,7 +37,9 @@ import (
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/controller/ipseccertificate"
+	"antrea.io/antrea/pkg/agent/controller/ipsecpsk"
 	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/ipsec"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/route"
 	"antrea.io/antrea/pkg/agent/types"
@@ -88,10 +91,17 @@ type Controller struct {
 	maskSizeV4      int
 	maskSizeV6      int
 	wireGuardClient wireguard.Interface
+	// ipsecClient configures the IPsec connections to peer Nodes on the platforms where the OVS IPsec monitor is not
+	// available, i.e. Windows. On these platforms, the tunnel traffic sent through the default tunnel port is
+	// encrypted, instead of creating a separate IPsec tunnel port for each peer Node. It is nil on Linux.
+	ipsecClient ipsec.Interface
 	// ipsecCertificateManager is useful for determining whether the ipsec certificate has been configured
 	// or not when IPsec is enabled with "cert" mode. The NodeRouteController must wait for the certificate
 	// to be configured before installing routes/flows to peer Nodes to prevent unencrypted traffic across Nodes.
 	ipsecCertificateManager ipseccertificate.Manager
+	// ipsecPSKProvider provides the PSK of each Node pair when IPsec is enabled with "psk" mode and the PSKs are read
+	// from an external secret store. It is nil if a single cluster-wide PSK is used.
+	ipsecPSKProvider ipsecpsk.Provider
 	// flowRestoreCompleteWait is to be decremented after installing flows for initial Nodes.
 	flowRestoreCompleteWait *utilwait.Group
 	// hasProcessedInitialList keeps track of whether the initial informer list has been
@@ -112,7 +122,9 @@ func NewNodeRouteController(
 	networkConfig *config.NetworkConfig,
 	nodeConfig *config.NodeConfig,
 	wireguardClient wireguard.Interface,
+	ipsecClient ipsec.Interface,
 	ipsecCertificateManager ipseccertificate.Manager,
+	ipsecPSKProvider ipsecpsk.Provider,
 	flowRestoreCompleteWait *utilwait.Group,
 ) *Controller {
 	controller := &Controller{
@@ -135,7 +147,9 @@ func NewNodeRouteController(
 		installedNodes:          cache.NewIndexer(nodeRouteInfoKeyFunc, cache.Indexers{nodeRouteInfoPodCIDRIndexName: nodeRouteInfoPodCIDRIndexFunc}),
 		podSubnets:              sets.New[netip.Prefix](),
 		wireGuardClient:         wireguardClient,
+		ipsecClient:             ipsecClient,
 		ipsecCertificateManager: ipsecCertificateManager,
+		ipsecPSKProvider:        ipsecPSKProvider,
 		flowRestoreCompleteWait: flowRestoreCompleteWait.Increment(),
 	}
 	if nodeConfig.PodIPv4CIDR != nil {
@@ -165,6 +179,10 @@ func NewNodeRouteController(
 	// UpstreamHasSynced is used by hasProcessedInitialList to determine whether even handlers
 	// have been called for the initial list.
 	controller.hasProcessedInitialList.UpstreamHasSynced = registration.HasSynced
+	if ipsecPSKProvider != nil {
+		// The IPsec tunnels of all Nodes must be updated with the new PSKs when they change.
+		ipsecPSKProvider.AddEventHandler(controller.enqueueAllNodes)
+	}
 	return controller
 }
 
@@ -185,9 +203,15 @@ type nodeRouteInfo struct {
 	nodeName           string
 	podCIDRs           []*net.IPNet
 	nodeIPs            *utilip.DualStackIPs
+	tunnelIPs          *utilip.DualStackIPs
 	gatewayIPs         *utilip.DualStackIPs
 	nodeMAC            net.HardwareAddr
 	wireGuardPublicKey string
+	ipsecPSK           string
+	// multipathIPs are the IPs of the additional uplinks of the Node, used for multipath routing.
+	multipathIPs []net.IP
+	// localTransportIPs are the transport IPs of the local Node when the routes to the Node were installed.
+	localTransportIPs utilip.DualStackIPs
 }
 
 // enqueueNode adds an object to the controller work queue
@@ -216,6 +240,24 @@ func (c *Controller) enqueueNode(obj interface{}, isInInitialList bool) {
 	}
 }
 
+// enqueueAllNodes adds all the Nodes to the controller work queue.
+func (c *Controller) enqueueAllNodes() {
+	nodes, err := c.nodeLister.List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list Nodes")
+		return
+	}
+	for _, node := range nodes {
+		c.enqueueNode(node, false)
+	}
+}
+
+// ReconcileLocalTransportAddresses reconciles the routes, flows and tunnels to all the Nodes, after the transport
+// addresses of the local Node have been updated in the NodeConfig.
+func (c *Controller) ReconcileLocalTransportAddresses() {
+	c.enqueueAllNodes()
+}
+
 // removeStaleGatewayRoutes removes all the gateway routes which no longer correspond to a Node in
 // the cluster. If the antrea agent restarts and Nodes have left the cluster, this function will
 // take care of removing routes which are no longer valid.
@@ -273,16 +315,25 @@ func (c *Controller) removeStaleTunnelPorts() error {
 				klog.Errorf("Failed to retrieve IP address of Node %s: %v", node.Name, err)
 				continue
 			}
-			var remoteName, psk string
+			ifaceID := util.GenerateNodeTunnelInterfaceKey(node.Name)
+			ifaceName := util.GenerateNodeTunnelInterfaceName(node.Name)
+			psk, err := c.getIPsecPSK(node.Name)
+			if err != nil {
+				// The PSK may be temporarily unavailable, keep the existing tunnel port.
+				klog.ErrorS(err, "Failed to get IPsec PSK of Node, keeping its tunnel port", "node", node.Name)
+				desiredInterfaces[ifaceID] = true
+				continue
+			}
+			// Tunnel ports for which only the PSK read from the secret store is stale are kept,
+			// createIPSecTunnelPort will update their PSK in place.
+			if c.ipsecPSKProvider != nil && psk != "" && interfaceConfig.PSK != "" {
+				psk = interfaceConfig.PSK
+			}
+			var remoteName string
 			// remote_name and psk are mutually exclusive.
-			switch c.networkConfig.IPsecConfig.AuthenticationMode {
-			case config.IPsecAuthenticationModeCert:
+			if c.networkConfig.IPsecConfig.AuthenticationMode == config.IPsecAuthenticationModeCert {
 				remoteName = node.Name
-			case config.IPsecAuthenticationModePSK:
-				psk = c.networkConfig.IPsecConfig.PSK
 			}
-			ifaceID := util.GenerateNodeTunnelInterfaceKey(node.Name)
-			ifaceName := util.GenerateNodeTunnelInterfaceName(node.Name)
 			if c.compareInterfaceConfig(interfaceConfig, peerNodeIPs.IPv4, psk, remoteName, ifaceName) || c.compareInterfaceConfig(interfaceConfig, peerNodeIPs.IPv6, psk, remoteName, ifaceName) {
 				desiredInterfaces[ifaceID] = true
 			}
@@ -384,6 +435,10 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 		c.networkConfig.IPsecConfig.AuthenticationMode == config.IPsecAuthenticationModeCert {
 		cacheSynced = append(cacheSynced, c.ipsecCertificateManager.HasSynced)
 	}
+	// Wait for the PSKs to be read from the secret store before creating the IPsec tunnel ports.
+	if c.ipsecPSKProvider != nil {
+		cacheSynced = append(cacheSynced, c.ipsecPSKProvider.HasSynced)
+	}
 	if !cache.WaitForNamedCacheSync(controllerName, stopCh, cacheSynced...) {
 		return
 	}
@@ -514,7 +569,11 @@ func (c *Controller) deleteNodeRoute(nodeName string) error {
 		c.podSubnets.Delete(subnets...)
 	}()
 
-	if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec {
+	if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec && c.ipsecClient != nil {
+		if err := c.ipsecClient.DeletePeer(nodeName); err != nil {
+			return fmt.Errorf("failed to delete IPsec connection to Node %s: %w", nodeName, err)
+		}
+	} else if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec {
 		interfaceConfig, ok := c.interfaceStore.GetNodeTunnelInterface(nodeName)
 		if !ok {
 			// Tunnel port not created for this Node.
@@ -547,14 +606,35 @@ func (c *Controller) addNodeRoute(nodeName string, node *corev1.Node) error {
 		klog.ErrorS(err, "Failed to retrieve Node IP addresses", "node", node.Name)
 		return err
 	}
+	peerTunnelIPs, err := k8s.GetNodeTunnelAddrs(node)
+	if err != nil {
+		klog.ErrorS(err, "Failed to retrieve Node tunnel IP addresses", "node", node.Name)
+		return err
+	}
+	peerMultipathIPs, err := k8s.GetNodeMultipathAddrs(node)
+	if err != nil {
+		klog.ErrorS(err, "Failed to retrieve Node multipath addresses", "node", node.Name)
+		return err
+	}
 	peerWireGuardPublicKey := node.Annotations[types.NodeWireGuardPublicAnnotationKey]
+	var peerIPsecPSK string
+	if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec {
+		if peerIPsecPSK, err = c.getIPsecPSK(nodeName); err != nil {
+			return err
+		}
+	}
 
+	localTransportIPs := c.getLocalTransportIPs()
 	nrInfo, installed, _ := c.installedNodes.GetByKey(nodeName)
-	// Route is already added for this Node and Node MAC, transport IP
-	// and WireGuard public key are not changed.
+	// Route is already added for this Node and Node MAC, transport IP, tunnel IP,
+	// WireGuard public key, IPsec PSK, multipath IPs and local transport IP are not changed.
 	if installed && nrInfo.(*nodeRouteInfo).nodeMAC.String() == peerNodeMAC.String() &&
+		localTransportIPs.Equal(nrInfo.(*nodeRouteInfo).localTransportIPs) &&
 		peerNodeIPs.Equal(*nrInfo.(*nodeRouteInfo).nodeIPs) &&
-		nrInfo.(*nodeRouteInfo).wireGuardPublicKey == peerWireGuardPublicKey {
+		peerTunnelIPs.Equal(*nrInfo.(*nodeRouteInfo).tunnelIPs) &&
+		nrInfo.(*nodeRouteInfo).wireGuardPublicKey == peerWireGuardPublicKey &&
+		nrInfo.(*nodeRouteInfo).ipsecPSK == peerIPsecPSK &&
+		slices.EqualFunc(nrInfo.(*nodeRouteInfo).multipathIPs, peerMultipathIPs, net.IP.Equal) {
 		return nil
 	}
 
@@ -618,15 +698,24 @@ func (c *Controller) addNodeRoute(nodeName string, node *corev1.Node) error {
 	}
 
 	var ipsecTunOFPort uint32
-	if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec {
+	if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec && c.ipsecClient != nil {
+		// The tunnel traffic sent through the default tunnel port is encrypted by the IPsec stack of the host.
+		peerNodeIP := peerTunnelIPs.IPv4
+		if peerNodeIP == nil {
+			peerNodeIP = peerTunnelIPs.IPv6
+		}
+		if err := c.ipsecClient.UpdatePeer(nodeName, peerNodeIP); err != nil {
+			return fmt.Errorf("failed to update IPsec connection to Node %s: %w", nodeName, err)
+		}
+	} else if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeIPSec {
 		// Create a separate tunnel port for the Node, as OVS IPsec monitor needs to
 		// read PSK and remote IP from the Node's tunnel interface to create IPsec
 		// security policies.
-		peerNodeIP := peerNodeIPs.IPv4
+		peerNodeIP := peerTunnelIPs.IPv4
 		if peerNodeIP == nil {
-			peerNodeIP = peerNodeIPs.IPv6
+			peerNodeIP = peerTunnelIPs.IPv6
 		}
-		port, err := c.createIPSecTunnelPort(nodeName, peerNodeIP)
+		port, err := c.createIPSecTunnelPort(nodeName, peerNodeIP, peerIPsecPSK)
 		if err != nil {
 			return err
 		}
@@ -634,9 +723,9 @@ func (c *Controller) addNodeRoute(nodeName string, node *corev1.Node) error {
 	}
 
 	if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeWireGuard && peerWireGuardPublicKey != "" {
-		peerNodeIP := peerNodeIPs.IPv4
+		peerNodeIP := peerTunnelIPs.IPv4
 		if peerNodeIP == nil {
-			peerNodeIP = peerNodeIPs.IPv6
+			peerNodeIP = peerTunnelIPs.IPv6
 		}
 		if err := c.wireGuardClient.UpdatePeer(nodeName, peerWireGuardPublicKey, peerNodeIP, peerPodCIDRs); err != nil {
 			return err
@@ -646,7 +735,7 @@ func (c *Controller) addNodeRoute(nodeName string, node *corev1.Node) error {
 	if err = c.ofClient.InstallNodeFlows(
 		nodeName,
 		peerConfigs,
-		peerNodeIPs,
+		c.getPeerFlowIPs(peerNodeIPs, peerTunnelIPs),
 		ipsecTunOFPort,
 		peerNodeMAC); err != nil {
 		return fmt.Errorf("failed to install flows to Node %s: %v", nodeName, err)
@@ -655,12 +744,12 @@ func (c *Controller) addNodeRoute(nodeName string, node *corev1.Node) error {
 	peerGatewayIPs := new(utilip.DualStackIPs)
 	for peerPodCIDR, peerGatewayIP := range peerConfigs {
 		if peerGatewayIP.To4() == nil {
-			if err := c.routeClient.AddRoutes(peerPodCIDR, nodeName, peerNodeIPs.IPv6, peerGatewayIP); err != nil {
+			if err := c.routeClient.AddRoutes(peerPodCIDR, nodeName, peerNodeIPs.IPv6, peerGatewayIP, peerMultipathIPs); err != nil {
 				return err
 			}
 			peerGatewayIPs.IPv6 = peerGatewayIP
 		} else {
-			if err := c.routeClient.AddRoutes(peerPodCIDR, nodeName, peerNodeIPs.IPv4, peerGatewayIP); err != nil {
+			if err := c.routeClient.AddRoutes(peerPodCIDR, nodeName, peerNodeIPs.IPv4, peerGatewayIP, peerMultipathIPs); err != nil {
 				return err
 			}
 			peerGatewayIPs.IPv4 = peerGatewayIP
@@ -671,14 +760,44 @@ func (c *Controller) addNodeRoute(nodeName string, node *corev1.Node) error {
 		nodeName:           nodeName,
 		podCIDRs:           peerPodCIDRs,
 		nodeIPs:            peerNodeIPs,
+		tunnelIPs:          peerTunnelIPs,
 		gatewayIPs:         peerGatewayIPs,
 		nodeMAC:            peerNodeMAC,
 		wireGuardPublicKey: peerWireGuardPublicKey,
+		ipsecPSK:           peerIPsecPSK,
+		multipathIPs:       peerMultipathIPs,
+		localTransportIPs:  localTransportIPs,
 	})
 
 	return err
 }
 
+// getLocalTransportIPs returns the transport IPs of the local Node.
+func (c *Controller) getLocalTransportIPs() utilip.DualStackIPs {
+	var ips utilip.DualStackIPs
+	if c.nodeConfig.NodeTransportIPv4Addr != nil {
+		ips.IPv4 = c.nodeConfig.NodeTransportIPv4Addr.IP
+	}
+	if c.nodeConfig.NodeTransportIPv6Addr != nil {
+		ips.IPv6 = c.nodeConfig.NodeTransportIPv6Addr.IP
+	}
+	return ips
+}
+
+// getPeerFlowIPs returns the IPs of the remote Node used by the flows to the Node. The tunnel IP of an address family is
+// used only if the Node is reached through the tunnel, which is decided with the transport IP. Otherwise, the traffic
+// is routed to the transport IP of the Node, e.g. in noEncap mode, and the tunnel IP is ignored.
+func (c *Controller) getPeerFlowIPs(peerNodeIPs, peerTunnelIPs *utilip.DualStackIPs) *utilip.DualStackIPs {
+	flowIPs := *peerNodeIPs
+	if peerNodeIPs.IPv4 != nil && c.networkConfig.NeedsTunnelToPeer(peerNodeIPs.IPv4, c.nodeConfig.NodeTransportIPv4Addr) {
+		flowIPs.IPv4 = peerTunnelIPs.IPv4
+	}
+	if peerNodeIPs.IPv6 != nil && c.networkConfig.NeedsTunnelToPeer(peerNodeIPs.IPv6, c.nodeConfig.NodeTransportIPv6Addr) {
+		flowIPs.IPv6 = peerTunnelIPs.IPv6
+	}
+	return &flowIPs
+}
+
 func getPodCIDRsOnNode(node *corev1.Node) []string {
 	if node.Spec.PodCIDRs != nil {
 		return node.Spec.PodCIDRs
@@ -692,19 +811,37 @@ func getPodCIDRsOnNode(node *corev1.Node) []string {
 	return []string{node.Spec.PodCIDR}
 }
 
+// getIPsecPSK returns the PSK of the IPsec tunnel to the remote Node, or an empty string if IPsec doesn't use "psk"
+// authentication mode.
+func (c *Controller) getIPsecPSK(nodeName string) (string, error) {
+	if c.networkConfig.IPsecConfig.AuthenticationMode != config.IPsecAuthenticationModePSK {
+		return "", nil
+	}
+	if c.ipsecPSKProvider == nil {
+		return c.networkConfig.IPsecConfig.PSK, nil
+	}
+	return c.ipsecPSKProvider.GetPSK(nodeName)
+}
+
 // createIPSecTunnelPort creates an IPsec tunnel port for the remote Node if the
-// tunnel does not exist, and returns the ofport number.
-func (c *Controller) createIPSecTunnelPort(nodeName string, nodeIP net.IP) (int32, error) {
+// tunnel does not exist, and returns the ofport number. psk is the PSK of the
+// tunnel, which must be empty if IPsec doesn't use "psk" authentication mode.
+func (c *Controller) createIPSecTunnelPort(nodeName string, nodeIP net.IP, psk string) (int32, error) {
 	portName := util.GenerateNodeTunnelInterfaceName(nodeName)
 	interfaceConfig, exists := c.interfaceStore.GetNodeTunnelInterface(nodeName)
 
-	var remoteName, psk string
+	var remoteName string
 	// remote_name and psk are mutually exclusive.
-	switch c.networkConfig.IPsecConfig.AuthenticationMode {
-	case config.IPsecAuthenticationModeCert:
+	if c.networkConfig.IPsecConfig.AuthenticationMode == config.IPsecAuthenticationModeCert {
 		remoteName = nodeName
-	case config.IPsecAuthenticationModePSK:
-		psk = c.networkConfig.IPsecConfig.PSK
+	}
+	// If only the PSK changes, which happens when the PSKs are rotated in the secret store, update it in place, so
+	// that the OVS IPsec monitor rekeys the SAs of the tunnel without recreating the tunnel port.
+	if exists && psk != "" && interfaceConfig.PSK != "" && interfaceConfig.PSK != psk &&
+		c.compareInterfaceConfig(interfaceConfig, nodeIP, interfaceConfig.PSK, remoteName, portName) {
+		if err := c.updateIPSecTunnelPSK(interfaceConfig, psk); err != nil {
+			return 0, err
+		}
 	}
 	// check if Node IP, PSK, remote name, or tunnel type changes. This can
 	// happen if removeStaleTunnelPorts fails to remove a "stale"
@@ -770,6 +907,26 @@ func (c *Controller) createIPSecTunnelPort(nodeName string, nodeIP net.IP) (int3
 	return ofPort, nil
 }
 
+// updateIPSecTunnelPSK updates the PSK of an existing IPsec tunnel port.
+func (c *Controller) updateIPSecTunnelPSK(interfaceConfig *interfacestore.InterfaceConfig, psk string) error {
+	options, err := c.ovsBridgeClient.GetInterfaceOptions(interfaceConfig.InterfaceName)
+	if err != nil {
+		return fmt.Errorf("failed to get options of IPsec tunnel port %s: %w", interfaceConfig.InterfaceName, err)
+	}
+	updatedOptions := make(map[string]interface{}, len(options))
+	for k, v := range options {
+		updatedOptions[k] = v
+	}
+	updatedOptions["psk"] = psk
+	if err := c.ovsBridgeClient.SetInterfaceOptions(interfaceConfig.InterfaceName, updatedOptions); err != nil {
+		return fmt.Errorf("failed to update PSK of IPsec tunnel port %s: %w", interfaceConfig.InterfaceName, err)
+	}
+	klog.InfoS("Updated PSK of IPsec tunnel port", "node", interfaceConfig.NodeName, "interface", interfaceConfig.InterfaceName)
+	interfaceConfig.PSK = psk
+	c.interfaceStore.UpdateInterface(interfaceConfig)
+	return nil
+}
+
 // ParseTunnelInterfaceConfig initializes and returns an InterfaceConfig struct
 // for a tunnel interface. It reads tunnel type, remote IP, IPsec PSK from the
 // OVS interface options, and NodeName from the OVS port external_ids.
@@ -16,6 +16,7 @@ package noderoute
 
 import (
 	"context"
+	"fmt"
 	"net"
 	"net/netip"
 	"testing"
@@ -32,6 +33,7 @@ import (
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/interfacestore"
+	ipsectest "antrea.io/antrea/pkg/agent/ipsec/testing"
 	oftest "antrea.io/antrea/pkg/agent/openflow/testing"
 	routetest "antrea.io/antrea/pkg/agent/route/testing"
 	"antrea.io/antrea/pkg/agent/types"
@@ -105,6 +107,26 @@ func (f *fakeIPsecCertificateManager) HasSynced() bool {
 	return true
 }
 
+type fakeIPsecPSKProvider struct {
+	psks map[string]string
+}
+
+func (f *fakeIPsecPSKProvider) GetPSK(peerNodeName string) (string, error) {
+	psk, ok := f.psks[peerNodeName]
+	if !ok {
+		return "", fmt.Errorf("no IPsec PSK found for Node %s", peerNodeName)
+	}
+	return psk, nil
+}
+
+func (f *fakeIPsecPSKProvider) AddEventHandler(handler func()) {}
+
+func (f *fakeIPsecPSKProvider) HasSynced() bool {
+	return true
+}
+
+func (f *fakeIPsecPSKProvider) Run(stopCh <-chan struct{}) {}
+
 func newController(t testing.TB, networkConfig *config.NetworkConfig, objects ...runtime.Object) *fakeController {
 	clientset := fake.NewSimpleClientset(objects...)
 	informerFactory := informers.NewSharedInformerFactory(clientset, 12*time.Hour)
@@ -116,7 +138,7 @@ func newController(t testing.TB, networkConfig *config.NetworkConfig, objects ..
 	ipsecCertificateManager := &fakeIPsecCertificateManager{}
 	ovsCtlClient := ovsctltest.NewMockOVSCtlClient(ctrl)
 	wireguardClient := wgtest.NewMockInterface(ctrl)
-	c := NewNodeRouteController(informerFactory.Core().V1().Nodes(), ofClient, ovsCtlClient, ovsClient, routeClient, interfaceStore, networkConfig, nodeConfig, wireguardClient, ipsecCertificateManager, utilwait.NewGroup())
+	c := NewNodeRouteController(informerFactory.Core().V1().Nodes(), ofClient, ovsCtlClient, ovsClient, routeClient, interfaceStore, networkConfig, nodeConfig, wireguardClient, nil, ipsecCertificateManager, nil, utilwait.NewGroup())
 	require.Equal(t, 24, c.maskSizeV4)
 	require.Equal(t, 48, c.maskSizeV6)
 	// Check that the podSubnets set already includes local PodCIDRs.
@@ -170,8 +192,8 @@ func TestControllerWithDuplicatePodCIDR(t *testing.T) {
 
 		c.clientset.CoreV1().Nodes().Create(context.TODO(), node1, metav1.CreateOptions{})
 		c.ofClient.EXPECT().InstallNodeFlows("node1", gomock.Any(), &dsIPs1, uint32(0), nil).Times(1)
-		c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway).Times(1)
-		c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway).Times(1)
+		c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway, nil).Times(1)
+		c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway, nil).Times(1)
 		c.processNextWorkItem()
 
 		// Since node1 is not deleted yet, routes and flows for otherNode shouldn't be installed as its PodCIDR is duplicate.
@@ -187,7 +209,7 @@ func TestControllerWithDuplicatePodCIDR(t *testing.T) {
 
 		// After node1 is deleted, routes and flows should be installed for otherNode successfully.
 		c.ofClient.EXPECT().InstallNodeFlows("otherNode", gomock.Any(), &dsIPs2, uint32(0), nil).Times(1)
-		c.routeClient.EXPECT().AddRoutes(podCIDR1, "otherNode", nodeIP2, podCIDR1Gateway).Times(1)
+		c.routeClient.EXPECT().AddRoutes(podCIDR1, "otherNode", nodeIP2, podCIDR1Gateway, nil).Times(1)
 		c.processNextWorkItem()
 	}()
 
@@ -212,8 +234,8 @@ func TestLookupIPInPodSubnets(t *testing.T) {
 
 	c.clientset.CoreV1().Nodes().Create(context.TODO(), node1, metav1.CreateOptions{})
 	c.ofClient.EXPECT().InstallNodeFlows("node1", gomock.Any(), &dsIPs1, uint32(0), nil).Times(1)
-	c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway).Times(1)
-	c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway).Times(1)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway, nil).Times(1)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway, nil).Times(1)
 	c.processNextWorkItem()
 
 	testCases := []struct {
@@ -279,8 +301,8 @@ func BenchmarkLookupIPInPodSubnets(b *testing.B) {
 
 	c.clientset.CoreV1().Nodes().Create(context.TODO(), node1, metav1.CreateOptions{})
 	c.ofClient.EXPECT().InstallNodeFlows("node1", gomock.Any(), &dsIPs1, uint32(0), nil).Times(1)
-	c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway).Times(1)
-	c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway).Times(1)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway, nil).Times(1)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway, nil).Times(1)
 	c.processNextWorkItem()
 
 	localPodIP := netip.MustParseAddr("1.1.0.99")
@@ -452,7 +474,7 @@ func TestCreateIPSecTunnelPortPSK(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := c.createIPSecTunnelPort(tt.nodeName, tt.peerNodeIP)
+			got, err := c.createIPSecTunnelPort(tt.nodeName, tt.peerNodeIP, "changeme")
 			hasErr := err != nil
 			assert.Equal(t, tt.wantErr, hasErr)
 			assert.Equal(t, tt.want, got)
@@ -497,7 +519,7 @@ func TestCreateIPSecTunnelPortCert(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := c.createIPSecTunnelPort(tt.nodeName, tt.peerNodeIP)
+			got, err := c.createIPSecTunnelPort(tt.nodeName, tt.peerNodeIP, "")
 			hasErr := err != nil
 			assert.Equal(t, tt.wantErr, hasErr)
 			assert.Equal(t, tt.want, got)
@@ -505,6 +527,87 @@ func TestCreateIPSecTunnelPortCert(t *testing.T) {
 	}
 }
 
+func TestRemoveStaleTunnelPortsWithPSKProvider(t *testing.T) {
+	c := setup(t, []*interfacestore.InterfaceConfig{
+		{
+			Type:          interfacestore.IPSecTunnelInterface,
+			InterfaceName: util.GenerateNodeTunnelInterfaceName("xyz-k8s-0-1"),
+			TunnelInterfaceConfig: &interfacestore.TunnelInterfaceConfig{
+				NodeName: "xyz-k8s-0-1",
+				Type:     ovsconfig.TunnelType("vxlan"),
+				PSK:      "oldpsk",
+				RemoteIP: nodeIP1,
+			},
+			OVSPortConfig: &interfacestore.OVSPortConfig{
+				PortUUID: "123",
+			},
+		},
+	}, config.IPsecAuthenticationModePSK)
+	c.networkConfig.IPsecConfig.PSK = ""
+	c.ipsecPSKProvider = &fakeIPsecPSKProvider{psks: map[string]string{"xyz-k8s-0-1": "newpsk"}}
+
+	defer c.queue.ShutDown()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	nodeWithTunnel := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "xyz-k8s-0-1",
+		},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{
+				{
+					Type:    corev1.NodeInternalIP,
+					Address: nodeIP1.String(),
+				},
+			},
+		},
+	}
+	c.clientset.CoreV1().Nodes().Create(context.TODO(), nodeWithTunnel, metav1.CreateOptions{})
+	require.Eventually(t, func() bool {
+		_, err := c.nodeLister.Get(nodeWithTunnel.Name)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// The tunnel port whose PSK is stale is kept, its PSK will be updated in place.
+	err := c.removeStaleTunnelPorts()
+	assert.NoError(t, err)
+}
+
+func TestCreateIPSecTunnelPortPSKRotation(t *testing.T) {
+	portName := util.GenerateNodeTunnelInterfaceName("xyz-k8s-0-1")
+	c := setup(t, []*interfacestore.InterfaceConfig{
+		{
+			Type:          interfacestore.IPSecTunnelInterface,
+			InterfaceName: portName,
+			TunnelInterfaceConfig: &interfacestore.TunnelInterfaceConfig{
+				NodeName: "xyz-k8s-0-1",
+				Type:     "vxlan",
+				PSK:      "oldpsk",
+				RemoteIP: nodeIP1,
+			},
+			OVSPortConfig: &interfacestore.OVSPortConfig{
+				PortUUID: "123",
+				OFPort:   int32(5),
+			},
+		},
+	}, config.IPsecAuthenticationModePSK)
+	defer c.queue.ShutDown()
+
+	c.ovsClient.EXPECT().GetInterfaceOptions(portName).Return(map[string]string{"remote_ip": nodeIP1.String(), "psk": "oldpsk"}, nil)
+	c.ovsClient.EXPECT().SetInterfaceOptions(portName, map[string]interface{}{"remote_ip": nodeIP1.String(), "psk": "newpsk"}).Return(nil)
+	c.ovsClient.EXPECT().GetOFPort(portName, false).Return(int32(5), nil)
+	c.ovsCtlClient.EXPECT().SetPortNoFlood(5)
+
+	got, err := c.createIPSecTunnelPort("xyz-k8s-0-1", nodeIP1, "newpsk")
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), got)
+	interfaceConfig, ok := c.interfaceStore.GetNodeTunnelInterface("xyz-k8s-0-1")
+	require.True(t, ok)
+	assert.Equal(t, "newpsk", interfaceConfig.PSK)
+}
+
 func TestGetNodeMAC(t *testing.T) {
 	validMac, _ := net.ParseMAC("00:1B:44:11:3A:B7")
 
@@ -775,6 +878,102 @@ func TestDeleteNodeRoute(t *testing.T) {
 	}
 }
 
+func TestNodeRouteWithIPsecClient(t *testing.T) {
+	c := newController(t, &config.NetworkConfig{
+		TrafficEncryptionMode: config.TrafficEncryptionModeIPSec,
+		IPsecConfig: config.IPsecConfig{
+			AuthenticationMode: config.IPsecAuthenticationModeCert,
+		},
+	}, node1)
+	defer c.queue.ShutDown()
+	ipsecClient := ipsectest.NewMockInterface(gomock.NewController(t))
+	c.ipsecClient = ipsecClient
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+
+	// The tunnel traffic to the Node is encrypted by the IPsec stack of the host, no IPsec tunnel port is created.
+	ipsecClient.EXPECT().UpdatePeer(node1.Name, nodeIP1)
+	c.ofClient.EXPECT().InstallNodeFlows("node1", gomock.Any(), &dsIPs1, uint32(0), nil)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway, nil)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway, nil)
+	require.NoError(t, c.syncNodeRoute(node1.Name))
+
+	ipsecClient.EXPECT().DeletePeer(node1.Name)
+	c.ofClient.EXPECT().UninstallNodeFlows(node1.Name)
+	c.routeClient.EXPECT().DeleteRoutes(podCIDR1)
+	c.routeClient.EXPECT().DeleteRoutes(podCIDR1v6)
+	require.NoError(t, c.deleteNodeRoute(node1.Name))
+}
+
+func TestNodeRouteWithTunnelAddress(t *testing.T) {
+	tunnelIP := net.ParseIP("10.20.20.20")
+	node := node1.DeepCopy()
+	node.Annotations = map[string]string{types.NodeTunnelAddressAnnotationKey: tunnelIP.String()}
+	tests := []struct {
+		name            string
+		encapMode       config.TrafficEncapModeType
+		expectedFlowIPs *utilip.DualStackIPs
+		expectedRouteIP net.IP
+	}{
+		{
+			name:            "encap",
+			encapMode:       config.TrafficEncapModeEncap,
+			expectedFlowIPs: &utilip.DualStackIPs{IPv4: tunnelIP},
+			expectedRouteIP: nodeIP1,
+		},
+		{
+			// The annotation only overrides the tunnel destination, the traffic is still routed to the Node IP.
+			name:            "noEncap",
+			encapMode:       config.TrafficEncapModeNoEncap,
+			expectedFlowIPs: &dsIPs1,
+			expectedRouteIP: nodeIP1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newController(t, &config.NetworkConfig{TrafficEncapMode: tt.encapMode}, node)
+			defer c.queue.ShutDown()
+
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			c.informerFactory.Start(stopCh)
+			c.informerFactory.WaitForCacheSync(stopCh)
+
+			c.ofClient.EXPECT().InstallNodeFlows("node1", gomock.Any(), tt.expectedFlowIPs, uint32(0), nil)
+			c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", tt.expectedRouteIP, podCIDR1Gateway, nil)
+			c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway, nil)
+			require.NoError(t, c.syncNodeRoute(node.Name))
+		})
+	}
+}
+
+func TestNodeRouteWithLocalTransportAddressChange(t *testing.T) {
+	c := newController(t, &config.NetworkConfig{TrafficEncapMode: config.TrafficEncapModeEncap}, node1)
+	defer c.queue.ShutDown()
+	localNodeConfig := *nodeConfig
+	localNodeConfig.NodeTransportIPv4Addr = &net.IPNet{IP: net.ParseIP("10.10.10.1"), Mask: net.CIDRMask(24, 32)}
+	c.nodeConfig = &localNodeConfig
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+
+	c.ofClient.EXPECT().InstallNodeFlows("node1", gomock.Any(), &dsIPs1, uint32(0), nil).Times(2)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway, nil).Times(2)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway, nil).Times(2)
+	require.NoError(t, c.syncNodeRoute(node1.Name))
+	// The routes are not reinstalled when nothing has changed.
+	require.NoError(t, c.syncNodeRoute(node1.Name))
+
+	// The routes are reinstalled when the transport address of the local Node changes.
+	localNodeConfig.NodeTransportIPv4Addr = &net.IPNet{IP: net.ParseIP("10.10.10.2"), Mask: net.CIDRMask(24, 32)}
+	require.NoError(t, c.syncNodeRoute(node1.Name))
+}
+
 func TestInitialListHasSynced(t *testing.T) {
 	c := newController(t, &config.NetworkConfig{}, node1)
 	defer c.queue.ShutDown()
@@ -787,8 +986,8 @@ func TestInitialListHasSynced(t *testing.T) {
 	require.Error(t, c.flowRestoreCompleteWait.WaitWithTimeout(100*time.Millisecond))
 
 	c.ofClient.EXPECT().InstallNodeFlows("node1", gomock.Any(), &dsIPs1, uint32(0), nil).Times(1)
-	c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway).Times(1)
-	c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway).Times(1)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1, "node1", nodeIP1, podCIDR1Gateway, nil).Times(1)
+	c.routeClient.EXPECT().AddRoutes(podCIDR1v6, "node1", nil, podCIDR1v6Gateway, nil).Times(1)
 	c.processNextWorkItem()
 
 	assert.True(t, c.hasProcessedInitialList.HasSynced())
@@ -35,6 +35,7 @@ import (
 	"antrea.io/antrea/pkg/agent/ipassigner/linkmonitor"
 	"antrea.io/antrea/pkg/agent/memberlist"
 	"antrea.io/antrea/pkg/agent/types"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
 	"antrea.io/antrea/pkg/querier"
 )
 
@@ -68,6 +69,8 @@ type ServiceExternalIPController struct {
 	endpointsLister       corelisters.EndpointsLister
 	endpointsListerSynced cache.InformerSynced
 
+	externalIPPoolListerSynced cache.InformerSynced
+
 	queue workqueue.TypedRateLimitingInterface[apimachinerytypes.NamespacedName]
 
 	externalIPStates      map[apimachinerytypes.NamespacedName]externalIPState
@@ -90,6 +93,7 @@ func NewServiceExternalIPController(
 	cluster memberlist.Interface,
 	serviceInformer coreinformers.ServiceInformer,
 	endpointsInformer coreinformers.EndpointsInformer,
+	externalIPPoolInformer crdinformers.ExternalIPPoolInformer,
 	linkMonitor linkmonitor.Interface,
 ) (*ServiceExternalIPController, error) {
 	c := &ServiceExternalIPController{
@@ -101,17 +105,19 @@ func NewServiceExternalIPController(
 				Name: "AgentServiceExternalIP",
 			},
 		),
-		serviceInformer:       serviceInformer.Informer(),
-		serviceLister:         serviceInformer.Lister(),
-		serviceListerSynced:   serviceInformer.Informer().HasSynced,
-		endpointsInformer:     endpointsInformer.Informer(),
-		endpointsLister:       endpointsInformer.Lister(),
-		endpointsListerSynced: endpointsInformer.Informer().HasSynced,
-		externalIPStates:      make(map[apimachinerytypes.NamespacedName]externalIPState),
-		assignedIPs:           make(map[string]sets.Set[string]),
-		linkMonitor:           linkMonitor,
-	}
-	ipAssigner, err := ipassigner.NewIPAssigner(nodeTransportInterface, "", linkMonitor)
+		serviceInformer:            serviceInformer.Informer(),
+		serviceLister:              serviceInformer.Lister(),
+		serviceListerSynced:        serviceInformer.Informer().HasSynced,
+		endpointsInformer:          endpointsInformer.Informer(),
+		endpointsLister:            endpointsInformer.Lister(),
+		endpointsListerSynced:      endpointsInformer.Informer().HasSynced,
+		externalIPPoolListerSynced: externalIPPoolInformer.Informer().HasSynced,
+		externalIPStates:           make(map[apimachinerytypes.NamespacedName]externalIPState),
+		assignedIPs:                make(map[string]sets.Set[string]),
+		linkMonitor:                linkMonitor,
+	}
+	// The ExternalIPPools are used to determine how the external IPs are announced.
+	ipAssigner, err := ipassigner.NewIPAssigner(nodeTransportInterface, "", linkMonitor, externalIPPoolInformer.Lister())
 	if err != nil {
 		return nil, fmt.Errorf("initializing service external IP assigner failed: %v", err)
 	}
@@ -245,7 +251,7 @@ func (c *ServiceExternalIPController) Run(stopCh <-chan struct{}) {
 	klog.Infof("Starting %s", controllerName)
 	defer klog.Infof("Shutting down %s", controllerName)
 
-	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.serviceListerSynced, c.endpointsListerSynced, c.linkMonitor.HasSynced) {
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.serviceListerSynced, c.endpointsListerSynced, c.externalIPPoolListerSynced, c.linkMonitor.HasSynced) {
 		return
 	}
 
@@ -30,6 +30,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 
+	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
@@ -223,6 +224,7 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 				if ruleRef != nil {
 					ob.NetworkPolicyRule = ruleRef.Name
 				}
+				ob.NetworkPolicyRuleStats = c.getNetworkPolicyRuleStats(egressInfo)
 			}
 			obs = append(obs, *ob)
 		}
@@ -242,6 +244,7 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 			if ruleRef != nil {
 				ob.NetworkPolicyRule = ruleRef.Name
 			}
+			ob.NetworkPolicyRuleStats = c.getNetworkPolicyRuleStats(ingressInfo)
 		}
 		obs = append(obs, *ob)
 	}
@@ -258,6 +261,7 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 				if npRef := ruleRef.PolicyRef; npRef != nil {
 					ob.NetworkPolicy = npRef.ToString()
 					ob.NetworkPolicyRule = ruleRef.Name
+					ob.NetworkPolicyRuleStats = c.getNetworkPolicyRuleStats(notAllowConjInfo)
 				}
 				if ruleRef.Action != nil && *ruleRef.Action == crdv1beta1.RuleActionReject {
 					ob.Action = crdv1beta1.ActionRejected
@@ -345,6 +349,7 @@ func (c *Controller) parsePacketIn(pktIn *ofctrl.PacketIn) (*crdv1beta1.Traceflo
 		} else {
 			// Output port is Pod port, packet is delivered.
 			ob.Action = crdv1beta1.ActionDelivered
+			ob.InterfaceStats = c.getPodInterfaceStats(outputPort)
 		}
 		ob.ComponentInfo = openflow.OutputTable.GetName()
 		ob.Component = crdv1beta1.ComponentForwarding
@@ -431,6 +436,46 @@ func getCTSrcValue(matchers *ofctrl.Matchers, isIPv6 bool) (string, error) {
 	return regValue.String(), nil
 }
 
+// getNetworkPolicyRuleStats returns the hit statistics of the NetworkPolicy rule on this Node, so that users can tell
+// whether real traffic matches the same rule as the Traceflow packet.
+func (c *Controller) getNetworkPolicyRuleStats(ruleID uint32) *crdv1beta1.NetworkPolicyRuleStats {
+	hitStats := c.ofClient.NetworkPolicyRuleHitStats(ruleID)
+	if hitStats == nil {
+		return nil
+	}
+	stats := &crdv1beta1.NetworkPolicyRuleStats{
+		Packets:  int64(hitStats.Packets),
+		Bytes:    int64(hitStats.Bytes),
+		Sessions: int64(hitStats.Sessions),
+	}
+	if !hitStats.LastHitTime.IsZero() {
+		lastHitTime := v1.NewTime(hitStats.LastHitTime)
+		stats.LastHitTime = &lastHitTime
+	}
+	return stats
+}
+
+// getPodInterfaceStats returns the counters of the interface of the Pod which the packet is delivered to. On Windows,
+// the packet still goes through the HNS endpoint of the Pod, which can drop it. It returns nil if the counters cannot
+// be read, e.g. on Linux.
+func (c *Controller) getPodInterfaceStats(ofPort uint32) *crdv1beta1.InterfaceStats {
+	iface, ok := c.interfaceStore.GetInterfaceByOFPort(ofPort)
+	if !ok || iface.Type != interfacestore.ContainerInterface {
+		return nil
+	}
+	stats, err := c.getInterfaceStats(iface.InterfaceName)
+	if err != nil {
+		klog.V(4).InfoS("Failed to get stats of Pod interface", "interface", iface.InterfaceName, "err", err)
+		return nil
+	}
+	return &crdv1beta1.InterfaceStats{
+		PacketsReceived:        stats.PacketsReceived,
+		PacketsSent:            stats.PacketsSent,
+		DroppedPacketsIncoming: stats.DroppedPacketsIncoming,
+		DroppedPacketsOutgoing: stats.DroppedPacketsOutgoing,
+	}
+}
+
 func getNetworkPolicyObservation(tableID uint8, ingress bool) *crdv1beta1.Observation {
 	ob := new(crdv1beta1.Observation)
 	ob.Component = crdv1beta1.ComponentNetworkPolicy
@@ -19,6 +19,7 @@ import (
 	"net"
 	"reflect"
 	"testing"
+	"time"
 
 	"antrea.io/libOpenflow/openflow15"
 	"antrea.io/libOpenflow/protocol"
@@ -32,9 +33,11 @@ import (
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/openflow"
+	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	queriertest "antrea.io/antrea/pkg/querier/testing"
 )
 
@@ -274,6 +277,7 @@ func TestParsePacketIn(t *testing.T) {
 
 	pktBytesPodToIP := getTestPacketBytes(dstIPv4)
 	pktBytesPodToPod := getTestPacketBytes(pod2IPv4)
+	ruleLastHitTime := time.Now().Truncate(time.Second)
 
 	tests := []struct {
 		name               string
@@ -281,10 +285,82 @@ func TestParsePacketIn(t *testing.T) {
 		nodeConfig         *config.NodeConfig
 		tfState            *traceflowState
 		pktIn              *ofctrl.PacketIn
-		expectedCalls      func(*queriertest.MockAgentNetworkPolicyInfoQuerier, *queriertest.MockEgressQuerier)
+		expectedCalls      func(*queriertest.MockAgentNetworkPolicyInfoQuerier, *queriertest.MockEgressQuerier, *openflowtest.MockClient)
+		interfaceStats     *statsv1alpha1.PodInterfaceStats
 		expectedTf         *crdv1beta1.Traceflow
 		expectedNodeResult *crdv1beta1.NodeResult
 	}{
+		{
+			name: "packet delivered to local Pod on Windows Node",
+			networkConfig: &config.NetworkConfig{
+				TrafficEncapMode: 0,
+			},
+			nodeConfig: &config.NodeConfig{
+				TunnelOFPort: 1,
+				GatewayConfig: &config.GatewayConfig{
+					OFPort: 3,
+				},
+			},
+			tfState: &traceflowState{
+				name: "traceflow-pod-to-pod",
+				tag:  1,
+			},
+			pktIn: &ofctrl.PacketIn{
+				PacketIn: &openflow15.PacketIn{
+					TableId: openflow.OutputTable.GetID(),
+					Match: openflow15.Match{
+						Fields: []openflow15.MatchField{*matchOutPort},
+					},
+					Data: util.NewBuffer(pktBytesPodToPod),
+				},
+			},
+			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier, ofClient *openflowtest.MockClient) {
+			},
+			interfaceStats: &statsv1alpha1.PodInterfaceStats{
+				EndpointID:             "ep2",
+				PacketsReceived:        30,
+				PacketsSent:            40,
+				BytesReceived:          3000,
+				DroppedPacketsIncoming: 5,
+			},
+			expectedTf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "traceflow-pod-to-pod",
+				},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						Namespace: pod2.Namespace,
+						Pod:       pod2.Name,
+					},
+				},
+				Status: crdv1beta1.TraceflowStatus{
+					Phase:        crdv1beta1.Running,
+					DataplaneTag: 1,
+				},
+			},
+			expectedNodeResult: &crdv1beta1.NodeResult{
+				Observations: []crdv1beta1.Observation{
+					{
+						Component: crdv1beta1.ComponentForwarding,
+						Action:    crdv1beta1.ActionReceived,
+					},
+					{
+						Component:     crdv1beta1.ComponentForwarding,
+						ComponentInfo: openflow.OutputTable.GetName(),
+						Action:        crdv1beta1.ActionDelivered,
+						InterfaceStats: &crdv1beta1.InterfaceStats{
+							PacketsReceived:        30,
+							PacketsSent:            40,
+							DroppedPacketsIncoming: 5,
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "packet at source Node for local Egress",
 			networkConfig: &config.NetworkConfig{
@@ -310,7 +386,7 @@ func TestParsePacketIn(t *testing.T) {
 					Data: util.NewBuffer(pktBytesPodToIP),
 				},
 			},
-			expectedCalls: func(npQuerierq *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+			expectedCalls: func(npQuerierq *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier, ofClient *openflowtest.MockClient) {
 				egressQuerier.EXPECT().GetEgress(pod1.Namespace, pod1.Name).Return(egressName, egressIP, egressNode, nil)
 			},
 			expectedTf: &crdv1beta1.Traceflow{
@@ -380,7 +456,7 @@ func TestParsePacketIn(t *testing.T) {
 					Data: util.NewBuffer(pktBytesPodToIP),
 				},
 			},
-			expectedCalls: func(npQuerierq *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+			expectedCalls: func(npQuerierq *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier, ofClient *openflowtest.MockClient) {
 				egressQuerier.EXPECT().GetEgress(pod1.Namespace, pod1.Name).Return(egressName, egressIP, egressNode, nil)
 			},
 			expectedTf: &crdv1beta1.Traceflow{
@@ -448,7 +524,7 @@ func TestParsePacketIn(t *testing.T) {
 					Data: util.NewBuffer(pktBytesPodToIP),
 				},
 			},
-			expectedCalls: func(npQuerierq *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+			expectedCalls: func(npQuerierq *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier, ofClient *openflowtest.MockClient) {
 				egressQuerier.EXPECT().GetEgressIPByMark(uint32(1)).Return(egressIP, nil)
 			},
 			expectedTf: &crdv1beta1.Traceflow{
@@ -505,7 +581,7 @@ func TestParsePacketIn(t *testing.T) {
 					Data: util.NewBuffer(pktBytesPodToPod),
 				},
 			},
-			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier, ofClient *openflowtest.MockClient) {
 				npQuerier.EXPECT().GetNetworkPolicyByRuleFlowID(uint32(2)).Return(
 					&v1beta2.NetworkPolicyReference{
 						Type: v1beta2.AntreaClusterNetworkPolicy,
@@ -517,6 +593,10 @@ func TestParsePacketIn(t *testing.T) {
 						Name: "egress-allow-rule",
 					},
 				)
+				ofClient.EXPECT().NetworkPolicyRuleHitStats(uint32(2)).Return(&types.RuleHitStats{
+					RuleMetric:  types.RuleMetric{Bytes: 1735, Packets: 12, Sessions: 1},
+					LastHitTime: ruleLastHitTime,
+				})
 			},
 			expectedTf: &crdv1beta1.Traceflow{
 				ObjectMeta: metav1.ObjectMeta{
@@ -550,6 +630,12 @@ func TestParsePacketIn(t *testing.T) {
 						Action:            crdv1beta1.ActionForwarded,
 						NetworkPolicy:     string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-1",
 						NetworkPolicyRule: "egress-allow-rule",
+						NetworkPolicyRuleStats: &crdv1beta1.NetworkPolicyRuleStats{
+							Packets:     12,
+							Bytes:       1735,
+							Sessions:    1,
+							LastHitTime: &metav1.Time{Time: ruleLastHitTime},
+						},
 					},
 				},
 			},
@@ -570,7 +656,7 @@ func TestParsePacketIn(t *testing.T) {
 					Data: util.NewBuffer(pktBytesPodToPod),
 				},
 			},
-			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier, ofClient *openflowtest.MockClient) {
 				npQuerier.EXPECT().GetNetworkPolicyByRuleFlowID(uint32(1)).Return(
 					&v1beta2.NetworkPolicyReference{
 						Type: v1beta2.AntreaClusterNetworkPolicy,
@@ -582,6 +668,7 @@ func TestParsePacketIn(t *testing.T) {
 						Name: "ingress-allow-rule",
 					},
 				)
+				ofClient.EXPECT().NetworkPolicyRuleHitStats(uint32(1)).Return(&types.RuleHitStats{})
 			},
 			expectedTf: &crdv1beta1.Traceflow{
 				ObjectMeta: metav1.ObjectMeta{
@@ -609,11 +696,12 @@ func TestParsePacketIn(t *testing.T) {
 						Action:    crdv1beta1.ActionReceived,
 					},
 					{
-						Component:         crdv1beta1.ComponentNetworkPolicy,
-						ComponentInfo:     openflow.IngressRuleTable.GetName(),
-						Action:            crdv1beta1.ActionForwarded,
-						NetworkPolicy:     string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-2",
-						NetworkPolicyRule: "ingress-allow-rule",
+						Component:              crdv1beta1.ComponentNetworkPolicy,
+						ComponentInfo:          openflow.IngressRuleTable.GetName(),
+						Action:                 crdv1beta1.ActionForwarded,
+						NetworkPolicy:          string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-2",
+						NetworkPolicyRule:      "ingress-allow-rule",
+						NetworkPolicyRuleStats: &crdv1beta1.NetworkPolicyRuleStats{},
 					},
 				},
 			},
@@ -635,7 +723,7 @@ func TestParsePacketIn(t *testing.T) {
 					Data: util.NewBuffer(pktBytesPodToPod),
 				},
 			},
-			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier) {
+			expectedCalls: func(npQuerier *queriertest.MockAgentNetworkPolicyInfoQuerier, egressQuerier *queriertest.MockEgressQuerier, ofClient *openflowtest.MockClient) {
 				npQuerier.EXPECT().GetRuleByFlowID(uint32(2)).Return(
 					&types.PolicyRule{
 						Name: "egress-drop-rule",
@@ -645,6 +733,10 @@ func TestParsePacketIn(t *testing.T) {
 						},
 					},
 				)
+				ofClient.EXPECT().NetworkPolicyRuleHitStats(uint32(2)).Return(&types.RuleHitStats{
+					RuleMetric:  types.RuleMetric{Bytes: 336, Packets: 4, Sessions: 4},
+					LastHitTime: ruleLastHitTime,
+				})
 			},
 			expectedTf: &crdv1beta1.Traceflow{
 				ObjectMeta: metav1.ObjectMeta{
@@ -678,6 +770,12 @@ func TestParsePacketIn(t *testing.T) {
 						Action:            crdv1beta1.ActionDropped,
 						NetworkPolicy:     string(v1beta2.AntreaClusterNetworkPolicy) + ":acnp-3",
 						NetworkPolicyRule: "egress-drop-rule",
+						NetworkPolicyRuleStats: &crdv1beta1.NetworkPolicyRuleStats{
+							Packets:     4,
+							Bytes:       336,
+							Sessions:    4,
+							LastHitTime: &metav1.Time{Time: ruleLastHitTime},
+						},
 					},
 				},
 			},
@@ -692,7 +790,12 @@ func TestParsePacketIn(t *testing.T) {
 			tfc.crdInformerFactory.Start(stopCh)
 			tfc.crdInformerFactory.WaitForCacheSync(stopCh)
 			tfc.runningTraceflows[tt.expectedTf.Status.DataplaneTag] = tt.tfState
-			tt.expectedCalls(tfc.networkPolicyQuerier, tfc.egressQuerier)
+			tt.expectedCalls(tfc.networkPolicyQuerier, tfc.egressQuerier, tfc.mockOFClient)
+			if tt.interfaceStats != nil {
+				tfc.getInterfaceStats = func(ifaceName string) (*statsv1alpha1.PodInterfaceStats, error) {
+					return tt.interfaceStats, nil
+				}
+			}
 
 			tf, nodeResult, _, err := tfc.parsePacketIn(tt.pktIn)
 			require.NoError(t, err)
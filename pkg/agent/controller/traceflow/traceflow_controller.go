@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"antrea.io/libOpenflow/protocol"
+	"github.com/miekg/dns"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,8 +40,10 @@ import (
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/stats"
 	"antrea.io/antrea/pkg/agent/util"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	clientsetversioned "antrea.io/antrea/pkg/client/clientset/versioned"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
@@ -67,7 +70,15 @@ const (
 	icmpv6EchoRequestType uint8 = 128
 	icmpEchoRequestCode   uint8 = 0
 
+	// ICMPv6 Neighbor Discovery message types.
+	ndpNeighborSolicitationType  uint8 = 135
+	ndpNeighborAdvertisementType uint8 = 136
+	// Neighbor Discovery messages must be sent with a hop limit of 255 (RFC 4861).
+	ndpHopLimit uint8 = 255
+
 	defaultTTL uint8 = 64
+	// Default destination port of DNS queries.
+	defaultDNSPort uint16 = 53
 )
 
 type traceflowState struct {
@@ -107,6 +118,8 @@ type Controller struct {
 	// with dataplane tag to be the key.
 	runningTraceflows map[int8]*traceflowState
 	enableAntreaProxy bool
+	// getInterfaceStats reads the counters of a local Pod interface, which is only supported on Windows.
+	getInterfaceStats func(ifaceName string) (*statsv1alpha1.PodInterfaceStats, error)
 }
 
 // NewTraceflowController instantiates a new Controller object which will process Traceflow
@@ -145,6 +158,7 @@ func NewTraceflowController(
 		),
 		runningTraceflows: make(map[int8]*traceflowState),
 		enableAntreaProxy: enableAntreaProxy,
+		getInterfaceStats: stats.GetInterfaceStats,
 	}
 
 	// Add handlers for Traceflow events.
@@ -527,7 +541,7 @@ func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestor
 		packet.TTL = defaultTTL
 	}
 
-	// TCP > UDP > ICMP > other IP protocol.
+	// TCP > UDP > NDP > ICMP > other IP protocol.
 	if tf.Spec.Packet.TransportHeader.TCP != nil {
 		packet.IPProto = protocol.Type_TCP
 		packet.SourcePort = uint16(tf.Spec.Packet.TransportHeader.TCP.SrcPort)
@@ -547,6 +561,40 @@ func (c *Controller) preparePacket(tf *crdv1beta1.Traceflow, intf *interfacestor
 		packet.TCPFlags = uint8(0)
 		packet.SourcePort = uint16(tf.Spec.Packet.TransportHeader.UDP.SrcPort)
 		packet.DestinationPort = uint16(tf.Spec.Packet.TransportHeader.UDP.DstPort)
+		if dnsQuery := tf.Spec.Packet.TransportHeader.UDP.DNS; dnsQuery != nil && !liveTraffic {
+			if packet.DestinationPort == 0 {
+				packet.DestinationPort = defaultDNSPort
+			}
+			data, err := newDNSQueryData(dnsQuery, packet.IsIPv6)
+			if err != nil {
+				return nil, err
+			}
+			packet.UDPData = data
+		}
+	} else if ndp := tf.Spec.Packet.TransportHeader.NDP; ndp != nil {
+		if liveTraffic {
+			return nil, errors.New("NDP is not supported in live-traffic Traceflow")
+		}
+		if !packet.IsIPv6 {
+			return nil, errors.New("NDP is only supported with IPv6")
+		}
+		// The target address of the NDP message is the destination IP.
+		if packet.DestinationIP == nil {
+			return nil, errors.New("NDP requires a destination IP")
+		}
+		packet.IPProto = protocol.Type_IPv6ICMP
+		packet.TCPFlags = uint8(0)
+		if ndp.Type == crdv1beta1.NDPNeighborAdvertisement {
+			packet.ICMPType = ndpNeighborAdvertisementType
+		} else {
+			packet.ICMPType = ndpNeighborSolicitationType
+		}
+		packet.ICMPCode = 0
+		if tf.Spec.Packet.IPv6Header.HopLimit == 0 {
+			packet.TTL = ndpHopLimit
+		}
+		packet.ICMPData = newNDPData(ndp.Type, packet.DestinationIP, packet.SourceMAC)
+		return packet, nil
 	} else if tf.Spec.Packet.TransportHeader.ICMP != nil {
 		isICMP = true
 		packet.TCPFlags = uint8(0)
@@ -607,3 +655,48 @@ func (c *Controller) cleanupTraceflow(tfName string) {
 		}
 	}
 }
+
+// newDNSQueryData returns the payload of a UDP packet carrying the provided DNS query.
+func newDNSQueryData(query *crdv1beta1.DNSQuery, isIPv6 bool) ([]byte, error) {
+	qType := dns.TypeA
+	if isIPv6 {
+		qType = dns.TypeAAAA
+	}
+	if query.QueryType != "" {
+		t, ok := dns.StringToType[query.QueryType]
+		if !ok {
+			return nil, fmt.Errorf("unsupported DNS query type %s", query.QueryType)
+		}
+		qType = t
+	}
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(query.QueryName), qType)
+	data, err := msg.Pack()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build DNS query for %s: %w", query.QueryName, err)
+	}
+	return data, nil
+}
+
+// newNDPData returns the body of an ICMPv6 Neighbor Solicitation or Neighbor Advertisement
+// message (after the type, code and checksum fields), as defined in RFC 4861. The link-layer
+// address option is always included.
+func newNDPData(ndpType crdv1beta1.NDPMessageType, target net.IP, linkLayerAddr net.HardwareAddr) []byte {
+	// 4 bytes for the reserved field or flags, 16 bytes for the target address, and 8 bytes for
+	// the link-layer address option.
+	data := make([]byte, 28)
+	// Source link-layer address option.
+	optionType := byte(1)
+	if ndpType == crdv1beta1.NDPNeighborAdvertisement {
+		// Set the Solicited and Override flags.
+		data[0] = 0x60
+		// Target link-layer address option.
+		optionType = 2
+	}
+	copy(data[4:20], target.To16())
+	data[20] = optionType
+	// The length of the option in units of 8 bytes.
+	data[21] = 1
+	copy(data[22:28], linkLayerAddr)
+	return data
+}
@@ -16,11 +16,13 @@ package traceflow
 
 import (
 	"bytes"
+	"errors"
 	"net"
 	"os"
 	"testing"
 
 	"antrea.io/libOpenflow/protocol"
+	"github.com/miekg/dns"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -39,6 +41,7 @@ import (
 	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
 	"antrea.io/antrea/pkg/agent/util"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
@@ -152,6 +155,9 @@ func newFakeTraceflowController(t *testing.T, initObjects []runtime.Object, netw
 			},
 		),
 		runningTraceflows: make(map[int8]*traceflowState),
+		getInterfaceStats: func(ifaceName string) (*statsv1alpha1.PodInterfaceStats, error) {
+			return nil, errors.New("Pod interface stats are only supported on Windows")
+		},
 	}
 
 	return &fakeTraceflowController{
@@ -565,6 +571,66 @@ func TestPreparePacket(t *testing.T) {
 				TTL:             64,
 			},
 		},
+		{
+			name: "NDP Neighbor Solicitation packet",
+			tf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf15", UID: "uid15"},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						IP: "fd00:10::2",
+					},
+					Packet: crdv1beta1.Packet{
+						IPv6Header: &crdv1beta1.IPv6Header{},
+						TransportHeader: crdv1beta1.TransportHeader{
+							NDP: &crdv1beta1.NDPHeader{Type: crdv1beta1.NDPNeighborSolicitation},
+						},
+					},
+				},
+			},
+			intf: &interfacestore.InterfaceConfig{
+				IPs: []net.IP{net.ParseIP("fd00:10::1")},
+				MAC: pod1MAC,
+			},
+			expectedPacket: &binding.Packet{
+				IsIPv6:        true,
+				SourceIP:      net.ParseIP("fd00:10::1"),
+				SourceMAC:     pod1MAC,
+				DestinationIP: net.ParseIP("fd00:10::2"),
+				IPProto:       protocol.Type_IPv6ICMP,
+				TTL:           255,
+				ICMPType:      135,
+				ICMPData: []byte{
+					0, 0, 0, 0,
+					0xfd, 0, 0, 0x10, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x2,
+					1, 1, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0x0f,
+				},
+			},
+		},
+		{
+			name: "NDP packet with IPv4",
+			tf: &crdv1beta1.Traceflow{
+				ObjectMeta: metav1.ObjectMeta{Name: "tf16", UID: "uid16"},
+				Spec: crdv1beta1.TraceflowSpec{
+					Source: crdv1beta1.Source{
+						Namespace: pod1.Namespace,
+						Pod:       pod1.Name,
+					},
+					Destination: crdv1beta1.Destination{
+						IP: dstIPv4,
+					},
+					Packet: crdv1beta1.Packet{
+						TransportHeader: crdv1beta1.TransportHeader{
+							NDP: &crdv1beta1.NDPHeader{Type: crdv1beta1.NDPNeighborAdvertisement},
+						},
+					},
+				},
+			},
+			expectedErr: "NDP is only supported with IPv6",
+		},
 	}
 
 	for _, tt := range tcs {
@@ -591,6 +657,43 @@ func TestPreparePacket(t *testing.T) {
 	}
 }
 
+func TestPrepareDNSPacket(t *testing.T) {
+	tf := &crdv1beta1.Traceflow{
+		ObjectMeta: metav1.ObjectMeta{Name: "tf1", UID: "uid1"},
+		Spec: crdv1beta1.TraceflowSpec{
+			Source: crdv1beta1.Source{
+				Namespace: pod1.Namespace,
+				Pod:       pod1.Name,
+			},
+			Destination: crdv1beta1.Destination{
+				IP: dstIPv4,
+			},
+			Packet: crdv1beta1.Packet{
+				TransportHeader: crdv1beta1.TransportHeader{
+					UDP: &crdv1beta1.UDPHeader{
+						SrcPort: 10000,
+						DNS:     &crdv1beta1.DNSQuery{QueryName: "antrea.io"},
+					},
+				},
+			},
+		},
+	}
+	tfc := newFakeTraceflowController(t, []runtime.Object{tf}, nil, nil)
+	podInterfaces := tfc.interfaceStore.GetContainerInterfacesByPod(pod1.Name, pod1.Namespace)
+
+	pkt, err := tfc.preparePacket(tf, podInterfaces[0], false)
+	require.NoError(t, err)
+	assert.Equal(t, protocol.Type_UDP, pkt.IPProto)
+	assert.Equal(t, uint16(10000), pkt.SourcePort)
+	assert.Equal(t, defaultDNSPort, pkt.DestinationPort)
+	msg := new(dns.Msg)
+	require.NoError(t, msg.Unpack(pkt.UDPData))
+	require.Len(t, msg.Question, 1)
+	assert.Equal(t, "antrea.io.", msg.Question[0].Name)
+	assert.Equal(t, dns.TypeA, msg.Question[0].Qtype)
+	assert.True(t, msg.RecursionDesired)
+}
+
 func TestErrTraceflowCRD(t *testing.T) {
 	tf := &crdv1beta1.Traceflow{
 		ObjectMeta: metav1.ObjectMeta{
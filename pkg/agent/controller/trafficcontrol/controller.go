@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -81,8 +82,10 @@ var (
 
 // trafficControlState keeps the actual state of a TrafficControl that has been realized.
 type trafficControlState struct {
-	// The actual name of target port used by a TrafficControl.
+	// The actual name of target port used by a TrafficControl. It is empty when the target port is the port of a Pod.
 	targetPortName string
+	// The actual Pod whose port is used as the target port by a TrafficControl.
+	targetPod string
 	// The actual openflow port for which we have installed for a TrafficControl.
 	targetOFPort uint32
 	// The actual name of return port used by a TrafficControl.
@@ -203,11 +206,18 @@ func NewTrafficControlController(ofClient openflow.Client,
 }
 
 // processPodUpdate will be called when CNIServer publishes a Pod update event, and the event of TrafficControl which is
-// the effective one of the Pod is triggered.
+// the effective one of the Pod is triggered. The events of the TrafficControls using the Pod as the target port are
+// also triggered, as the port of the Pod changes when the Pod is recreated.
 func (c *Controller) processPodUpdate(e interface{}) {
+	podEvent := e.(types.PodUpdate)
+	if pod, err := c.podLister.Pods(podEvent.PodNamespace).Get(podEvent.PodName); err == nil {
+		for tc := range c.filterTCsTargetingPod(pod) {
+			c.queue.Add(tc)
+		}
+	}
+
 	c.podToTCBindingsMutex.RLock()
 	defer c.podToTCBindingsMutex.RUnlock()
-	podEvent := e.(types.PodUpdate)
 	pod := k8s.NamespacedName(podEvent.PodNamespace, podEvent.PodName)
 	binding, exists := c.podToTCBindings[pod]
 	if !exists {
@@ -216,6 +226,30 @@ func (c *Controller) processPodUpdate(e interface{}) {
 	c.queue.Add(binding.effectiveTC)
 }
 
+func matchedTargetPod(pod *v1.Pod, port *v1alpha2.PodPort) bool {
+	if port == nil || pod.Namespace != port.Namespace {
+		return false
+	}
+	podSelector, err := metav1.LabelSelectorAsSelector(&port.PodSelector)
+	if err != nil {
+		return false
+	}
+	return podSelector.Matches(labels.Set(pod.Labels))
+}
+
+// filterTCsTargetingPod returns the TrafficControls whose target port is the port of a Pod selected by the same
+// selector as the provided Pod.
+func (c *Controller) filterTCsTargetingPod(pod *v1.Pod) sets.Set[string] {
+	affectedTCs := sets.New[string]()
+	allTCs, _ := c.trafficControlLister.List(labels.Everything())
+	for _, tc := range allTCs {
+		if matchedTargetPod(pod, tc.Spec.TargetPort.Pod) {
+			affectedTCs.Insert(tc.GetName())
+		}
+	}
+	return affectedTCs
+}
+
 func (c *Controller) matchedPod(pod *v1.Pod, to *v1alpha2.AppliedTo) bool {
 	if to.NamespaceSelector == nil && to.PodSelector == nil {
 		return false
@@ -244,7 +278,7 @@ func (c *Controller) filterAffectedTCsByPod(pod *v1.Pod) sets.Set[string] {
 	affectedTCs := sets.New[string]()
 	allTCs, _ := c.trafficControlLister.List(labels.Everything())
 	for _, tc := range allTCs {
-		if c.matchedPod(pod, &tc.Spec.AppliedTo) {
+		if c.matchedPod(pod, &tc.Spec.AppliedTo) || matchedTargetPod(pod, tc.Spec.TargetPort.Pod) {
 			affectedTCs.Insert(tc.GetName())
 		}
 	}
@@ -675,6 +709,33 @@ func (c *Controller) createERSPANPort(portName string, tunnelConfig *v1alpha2.ER
 	return portUUID, err
 }
 
+// getTargetPod returns the Pod whose port is used as the target port, and the ofPort of the Pod. An empty string is
+// returned if no selected Pod with an OVS port is running on this Node.
+func (c *Controller) getTargetPod(port *v1alpha2.PodPort) (string, uint32, error) {
+	podSelector, err := metav1.LabelSelectorAsSelector(&port.PodSelector)
+	if err != nil {
+		return "", 0, err
+	}
+	pods, err := c.podLister.Pods(port.Namespace).List(podSelector)
+	if err != nil {
+		return "", 0, err
+	}
+	sort.Slice(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+	for _, pod := range pods {
+		if pod.Spec.HostNetwork || pod.DeletionTimestamp != nil {
+			continue
+		}
+		podInterfaces := c.interfaceStore.GetContainerInterfacesByPod(pod.Name, pod.Namespace)
+		if len(podInterfaces) == 0 || podInterfaces[0].OFPort <= 0 {
+			continue
+		}
+		return k8s.NamespacedName(pod.Namespace, pod.Name), uint32(podInterfaces[0].OFPort), nil
+	}
+	return "", 0, nil
+}
+
 func (c *Controller) getPortName(port *v1alpha2.TrafficControlPort) string {
 	var portName string
 	switch {
@@ -857,7 +918,8 @@ func (c *Controller) syncTrafficControl(tcName string) error {
 		}
 	}
 
-	// Get name of the target port.
+	// Get name of the target port. It is empty when the target port is the port of a Pod, which is not managed by the
+	// TrafficControl.
 	targetPortName := c.getPortName(&tc.Spec.TargetPort)
 	// If the name is different from the cached name in the TrafficControl state, it could be caused by the target port
 	// update of the TrafficControl or the creation of the TrafficControl.
@@ -873,10 +935,21 @@ func (c *Controller) syncTrafficControl(tcName string) error {
 		tcState.targetPortName = targetPortName
 	}
 
-	// Get or create the target port.
-	targetOFPort, err := c.getOrCreateTrafficControlPort(&tc.Spec.TargetPort, targetPortName, tcName, false)
-	if err != nil {
-		return err
+	var targetOFPort uint32
+	var targetPod string
+	if tc.Spec.TargetPort.Pod != nil {
+		// Resolve the port of the target Pod, which changes when the Pod is recreated.
+		if targetPod, targetOFPort, err = c.getTargetPod(tc.Spec.TargetPort.Pod); err != nil {
+			return err
+		}
+		if targetPod != tcState.targetPod {
+			klog.InfoS("Target Pod of TrafficControl changed", "TrafficControl", tcName, "oldPod", tcState.targetPod, "newPod", targetPod, "ofPort", targetOFPort)
+		}
+	} else {
+		// Get or create the target port.
+		if targetOFPort, err = c.getOrCreateTrafficControlPort(&tc.Spec.TargetPort, targetPortName, tcName, false); err != nil {
+			return err
+		}
 	}
 
 	// Check if the mark flows should be updated.
@@ -896,6 +969,10 @@ func (c *Controller) syncTrafficControl(tcName string) error {
 	newOfPorts := sets.New[int32]()
 	for _, pod := range pods {
 		podNN := k8s.NamespacedName(pod.Namespace, pod.Name)
+		// The traffic of the target Pod must not be sent back to itself.
+		if podNN == targetPod {
+			continue
+		}
 		newPods.Insert(podNN)
 		stalePods.Delete(podNN)
 
@@ -914,9 +991,17 @@ func (c *Controller) syncTrafficControl(tcName string) error {
 		newOfPorts.Insert(podInterfaces[0].OFPort)
 	}
 
-	// If target ofPort / direction / action in TrafficControl is updated, the mark flows should be reinstalled; if the
-	// new ofPort set is different from the old ofPort set, the mark flows should be also reinstalled.
-	if needUpdateMarkFlows || !newOfPorts.Equal(tcState.ofPorts) {
+	if targetOFPort == 0 {
+		// No target Pod is running on this Node, uninstall the mark flows until there is one.
+		if tcState.targetOFPort != 0 {
+			if err = c.ofClient.UninstallTrafficControlMarkFlows(tc.Name); err != nil {
+				return err
+			}
+		}
+		newOfPorts = sets.New[int32]()
+	} else if needUpdateMarkFlows || !newOfPorts.Equal(tcState.ofPorts) {
+		// If target ofPort / direction / action in TrafficControl is updated, the mark flows should be reinstalled; if
+		// the new ofPort set is different from the old ofPort set, the mark flows should be also reinstalled.
 		var ofPorts []uint32
 		for _, port := range sets.List(newOfPorts) {
 			ofPorts = append(ofPorts, uint32(port))
@@ -934,6 +1019,7 @@ func (c *Controller) syncTrafficControl(tcName string) error {
 	tcState.pods = newPods
 	tcState.ofPorts = newOfPorts
 	tcState.targetOFPort = targetOFPort
+	tcState.targetPod = targetPod
 	tcState.action = tc.Spec.Action
 	tcState.direction = tc.Spec.Direction
 
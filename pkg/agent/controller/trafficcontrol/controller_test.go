@@ -255,6 +255,8 @@ func generateTrafficControl(name string,
 		tc.Spec.TargetPort.GRE = targetPort
 	case *v1alpha2.ERSPANTunnel:
 		tc.Spec.TargetPort.ERSPAN = targetPort
+	case *v1alpha2.PodPort:
+		tc.Spec.TargetPort.Pod = targetPort
 	}
 
 	switch returnPort := returnPort.(type) {
@@ -1178,6 +1180,80 @@ func TestPodDelete(t *testing.T) {
 	require.Equal(t, expectedPod3Binding, c.podToTCBindings[pod3NN])
 }
 
+func TestTargetPod(t *testing.T) {
+	targetPod := &v1alpha2.PodPort{Namespace: "ns1", PodSelector: metav1.LabelSelector{MatchLabels: labels2}}
+	tc1 := generateTrafficControl(tc1Name, nil, labels1, directionIngress, actionMirror, targetPod, false, nil)
+	interfaces := []*interfacestore.InterfaceConfig{
+		podInterface1,
+		podInterface3,
+	}
+
+	c := newFakeController(t, []runtime.Object{pod1, pod2, pod3}, []runtime.Object{tc1}, interfaces)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	c.startInformers(stopCh)
+	go c.podUpdateChannel.Run(stopCh)
+
+	// The interface of the target Pod is not ready, and mark flows will not be installed.
+	waitEvents(t, 1, c)
+	item, _ := c.queue.Get()
+	require.Equal(t, tc1Name, item)
+	require.NoError(t, c.syncTrafficControl(item))
+	c.queue.Done(item)
+
+	expectedState := &trafficControlState{
+		action:    actionMirror,
+		direction: directionIngress,
+		ofPorts:   sets.New[int32](),
+		pods:      sets.New[string](pod1NN, pod3NN),
+	}
+	require.Equal(t, expectedState, c.tcStates[tc1Name])
+	require.Empty(t, c.portToTCBindings)
+
+	// Mark flows are expected to be installed after the interface of the target Pod is ready.
+	c.mockOFClient.EXPECT().InstallTrafficControlMarkFlows(tc1Name, []uint32{pod1OFPort, pod3OFPort}, pod2OFPort, directionIngress, actionMirror, types.TrafficControlFlowPriorityMedium)
+	c.interfaceStore.AddInterface(podInterface2)
+	c.podUpdateChannel.Notify(types.PodUpdate{PodName: "pod2", PodNamespace: "ns1"})
+
+	waitEvents(t, 1, c)
+	item, _ = c.queue.Get()
+	require.Equal(t, tc1Name, item)
+	require.NoError(t, c.syncTrafficControl(item))
+	c.queue.Done(item)
+
+	expectedState = &trafficControlState{
+		targetOFPort: pod2OFPort,
+		targetPod:    pod2NN,
+		action:       actionMirror,
+		direction:    directionIngress,
+		ofPorts:      sets.New[int32](int32(pod1OFPort), int32(pod3OFPort)),
+		pods:         sets.New[string](pod1NN, pod3NN),
+	}
+	require.Equal(t, expectedState, c.tcStates[tc1Name])
+
+	// Mark flows are expected to be uninstalled after the interface of the target Pod is removed, e.g. when the Pod is
+	// being recreated.
+	c.mockOFClient.EXPECT().UninstallTrafficControlMarkFlows(tc1Name)
+	c.interfaceStore.DeleteInterface(podInterface2)
+	c.podUpdateChannel.Notify(types.PodUpdate{PodName: "pod2", PodNamespace: "ns1"})
+
+	waitEvents(t, 1, c)
+	item, _ = c.queue.Get()
+	require.Equal(t, tc1Name, item)
+	require.NoError(t, c.syncTrafficControl(item))
+	c.queue.Done(item)
+
+	expectedState = &trafficControlState{
+		action:    actionMirror,
+		direction: directionIngress,
+		ofPorts:   sets.New[int32](),
+		pods:      sets.New[string](pod1NN, pod3NN),
+	}
+	require.Equal(t, expectedState, c.tcStates[tc1Name])
+}
+
 func int32Ptr(i int32) *int32 {
 	j := i
 	return &j
@@ -0,0 +1,233 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceplugin
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/ovs/ovsconfig"
+	"antrea.io/antrea/pkg/util/channel"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+const (
+	controllerName = "FastpathQueueController"
+	// Set resyncPeriod to 0 to disable resyncing.
+	resyncPeriod = 0
+	// How long to wait before retrying the processing of a Pod.
+	minRetryDelay = 5 * time.Second
+	maxRetryDelay = 300 * time.Second
+)
+
+// Config is the configuration of the fastpath queue device plugin.
+type Config struct {
+	// Count is the number of units advertised to kubelet.
+	Count int
+	// Rate is the rate in kbps of the traffic sent by a Pod, per unit allocated to it.
+	Rate int
+	// Burst is the burst size in kb of the traffic sent by a Pod, per unit allocated to it.
+	Burst int
+}
+
+// provisionedPod is the container and the number of units for which the rate cap of a Pod has been configured.
+type provisionedPod struct {
+	containerID string
+	units       int64
+}
+
+// Controller caps the rate of the traffic sent by the local Pods which have been allocated "antrea.io/fastpath-queue"
+// units, by configuring a dedicated OVS policer on their network interface. The rate and burst size of the policer are
+// proportional to the number of allocated units.
+type Controller struct {
+	ovsBridgeClient ovsconfig.OVSBridgeClient
+	interfaceStore  interfacestore.InterfaceStore
+	podLister       corelisters.PodLister
+	podListerSynced cache.InformerSynced
+	queue           workqueue.TypedRateLimitingInterface[string]
+	rate            int
+	burst           int
+
+	// provisionedPods is only accessed by the single worker.
+	provisionedPods map[string]provisionedPod
+}
+
+// NewController creates a new Controller. podInformer must only watch the Pods running on the Node.
+func NewController(
+	ovsBridgeClient ovsconfig.OVSBridgeClient,
+	interfaceStore interfacestore.InterfaceStore,
+	podInformer cache.SharedIndexInformer,
+	podUpdateSubscriber channel.Subscriber,
+	config Config,
+) *Controller {
+	c := &Controller{
+		ovsBridgeClient: ovsBridgeClient,
+		interfaceStore:  interfaceStore,
+		podLister:       corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced: podInformer.HasSynced,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "fastpathQueue",
+			},
+		),
+		rate:            config.Rate,
+		burst:           config.Burst,
+		provisionedPods: map[string]provisionedPod{},
+	}
+	podInformer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueuePod,
+			UpdateFunc: func(old, cur interface{}) { c.enqueuePod(cur) },
+			DeleteFunc: c.enqueuePod,
+		},
+		resyncPeriod,
+	)
+	podUpdateSubscriber.Subscribe(c.processPodUpdate)
+	return c
+}
+
+func (c *Controller) enqueuePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.ErrorS(nil, "Received unexpected object", "object", obj)
+			return
+		}
+		pod, ok = deletedState.Obj.(*corev1.Pod)
+		if !ok {
+			klog.ErrorS(nil, "DeletedFinalStateUnknown contains non-Pod object", "object", deletedState.Obj)
+			return
+		}
+	}
+	if pod.Spec.HostNetwork {
+		return
+	}
+	c.queue.Add(k8s.NamespacedName(pod.Namespace, pod.Name))
+}
+
+// processPodUpdate is called when CNIServer publishes a Pod update event, as the interface of the Pod may have been
+// created after the Pod events were processed.
+func (c *Controller) processPodUpdate(e interface{}) {
+	podEvent := e.(types.PodUpdate)
+	if podEvent.IsAdd {
+		c.queue.Add(k8s.NamespacedName(podEvent.PodNamespace, podEvent.PodName))
+	}
+}
+
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting " + controllerName)
+	defer klog.InfoS("Shutting down " + controllerName)
+
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.podListerSynced) {
+		return
+	}
+	// A single worker is used, so that provisionedPods does not need to be protected by a mutex.
+	go wait.Until(c.worker, time.Second, stopCh)
+	<-stopCh
+}
+
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.syncPod(key); err == nil {
+		c.queue.Forget(key)
+	} else {
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Error syncing Pod, requeuing", "pod", key)
+	}
+	return true
+}
+
+// getPodUnits returns the number of "antrea.io/fastpath-queue" units allocated to the Pod, which is the sum of the
+// units requested by its containers. The units requested by init containers are ignored, as they are trimmed from the
+// Pods stored by the local Pod informer.
+func getPodUnits(pod *corev1.Pod) int64 {
+	var units int64
+	for _, container := range pod.Spec.Containers {
+		// Extended resources can be specified in limits only, in which case the requests default to the limits.
+		if quantity, ok := container.Resources.Requests[ResourceName]; ok {
+			units += quantity.Value()
+		} else if quantity, ok := container.Resources.Limits[ResourceName]; ok {
+			units += quantity.Value()
+		}
+	}
+	return units
+}
+
+func (c *Controller) syncPod(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// The policer is deleted with the interface of the Pod.
+			delete(c.provisionedPods, key)
+			return nil
+		}
+		return err
+	}
+	units := getPodUnits(pod)
+	provisioned, exists := c.provisionedPods[key]
+	if units == 0 && !exists {
+		return nil
+	}
+	interfaces := c.interfaceStore.GetContainerInterfacesByPod(name, namespace)
+	if len(interfaces) == 0 {
+		// The Pod will be processed again when its interface is created.
+		delete(c.provisionedPods, key)
+		return nil
+	}
+	podInterface := interfaces[0]
+	desired := provisionedPod{containerID: podInterface.ContainerID, units: units}
+	if exists && provisioned == desired {
+		return nil
+	}
+	rate, burst := int(units)*c.rate, int(units)*c.burst
+	if err := c.ovsBridgeClient.SetInterfaceIngressPolicing(podInterface.InterfaceName, rate, burst); err != nil {
+		return fmt.Errorf("error when configuring policer of interface %s: %w", podInterface.InterfaceName, err)
+	}
+	if units == 0 {
+		delete(c.provisionedPods, key)
+	} else {
+		c.provisionedPods[key] = desired
+	}
+	klog.V(2).InfoS("Provisioned bandwidth of Pod", "pod", key, "units", units, "rate", rate, "burst", burst)
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceplugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	ovsconfigtest "antrea.io/antrea/pkg/ovs/ovsconfig/testing"
+	"antrea.io/antrea/pkg/util/channel"
+)
+
+func newPod(name string, containerUnits ...int64) *corev1.Pod {
+	var containers []corev1.Container
+	for _, units := range containerUnits {
+		container := corev1.Container{Name: "c"}
+		if units > 0 {
+			container.Resources.Limits = corev1.ResourceList{ResourceName: *resource.NewQuantity(units, resource.DecimalSI)}
+		}
+		containers = append(containers, container)
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: name},
+		Spec:       corev1.PodSpec{Containers: containers},
+	}
+}
+
+func TestGetPodUnits(t *testing.T) {
+	tests := []struct {
+		name          string
+		pod           *corev1.Pod
+		expectedUnits int64
+	}{
+		{
+			name: "no unit",
+			pod:  newPod("pod1", 0),
+		},
+		{
+			name:          "single container",
+			pod:           newPod("pod1", 2),
+			expectedUnits: 2,
+		},
+		{
+			name:          "multiple containers",
+			pod:           newPod("pod1", 1, 0, 2),
+			expectedUnits: 3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedUnits, getPodUnits(tt.pod))
+		})
+	}
+}
+
+func TestSyncPod(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOVSBridgeClient := ovsconfigtest.NewMockOVSBridgeClient(ctrl)
+	podInformer := coreinformers.NewPodInformer(fake.NewSimpleClientset(), metav1.NamespaceAll, 0, cache.Indexers{})
+	ifaceStore := interfacestore.NewInterfaceStore()
+	c := NewController(mockOVSBridgeClient, ifaceStore, podInformer, channel.NewSubscribableChannel("PodUpdate", 100), Config{Count: 8, Rate: 1000, Burst: 100})
+
+	pod := newPod("pod1", 2)
+	require.NoError(t, podInformer.GetIndexer().Add(pod))
+	// The Pod is ignored until its interface is created.
+	require.NoError(t, c.syncPod("ns1/pod1"))
+
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod1-abc", "container1", "pod1", "ns1", "eth0", nil, nil, 0))
+	mockOVSBridgeClient.EXPECT().SetInterfaceIngressPolicing("pod1-abc", 2000, 200)
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	assert.Equal(t, provisionedPod{containerID: "container1", units: 2}, c.provisionedPods["ns1/pod1"])
+	// The policer is not configured again if the Pod has not changed.
+	require.NoError(t, c.syncPod("ns1/pod1"))
+
+	// The policer is configured again when the Pod is recreated.
+	ifaceStore.DeleteInterface(ifaceStore.GetContainerInterfacesByPod("pod1", "ns1")[0])
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod1-abc", "container2", "pod1", "ns1", "eth0", nil, nil, 0))
+	mockOVSBridgeClient.EXPECT().SetInterfaceIngressPolicing("pod1-abc", 2000, 200)
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	assert.Equal(t, provisionedPod{containerID: "container2", units: 2}, c.provisionedPods["ns1/pod1"])
+
+	require.NoError(t, podInformer.GetIndexer().Delete(pod))
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	assert.Empty(t, c.provisionedPods)
+
+	// Pods without units are ignored.
+	require.NoError(t, podInformer.GetIndexer().Add(newPod("pod2", 0)))
+	ifaceStore.AddInterface(interfacestore.NewContainerInterface("pod2-abc", "container3", "pod2", "ns1", "eth0", nil, nil, 0))
+	require.NoError(t, c.syncPod("ns1/pod2"))
+	assert.Empty(t, c.provisionedPods)
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceplugin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc"
+	grpcinsecure "google.golang.org/grpc/credentials/insecure"
+	"k8s.io/klog/v2"
+	pluginapi "k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+)
+
+const (
+	// ResourceName is the name of the extended resource advertised to kubelet. Each unit of the resource allocated to
+	// a Pod raises the cap on the rate of the traffic sent by the Pod.
+	ResourceName = "antrea.io/fastpath-queue"
+	// envFastpathQueueIDs is the environment variable in which the IDs of the units allocated to a container are
+	// passed to it, separated by commas.
+	envFastpathQueueIDs = "ANTREA_FASTPATH_QUEUE_IDS"
+
+	socketName        = "antrea-fastpath-queue.sock"
+	kubeletSocketName = "kubelet.sock"
+	registerTimeout   = 10 * time.Second
+	retryInterval     = 30 * time.Second
+)
+
+// Plugin is a kubelet device plugin which advertises a fixed number of "antrea.io/fastpath-queue" units, so that the
+// scheduler does not allocate more units on a Node than it has been provisioned for. The units are fungible: the rate
+// cap of a Pod only depends on the number of units allocated to it, which is configured by the Controller.
+type Plugin struct {
+	count int
+	// pluginDir is the directory of the kubelet device plugin sockets.
+	pluginDir string
+}
+
+// NewPlugin creates a new Plugin advertising count units.
+func NewPlugin(count int) *Plugin {
+	return &Plugin{
+		count:     count,
+		pluginDir: pluginapi.DevicePluginPath,
+	}
+}
+
+// Run serves the device plugin API and registers the plugin with kubelet until stopCh is closed. The plugin is
+// registered again whenever kubelet restarts, as kubelet removes the sockets of all the plugins when it starts.
+func (p *Plugin) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting device plugin", "resource", ResourceName, "count", p.count)
+	defer klog.InfoS("Shutting down device plugin", "resource", ResourceName)
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		klog.ErrorS(err, "Failed to create file watcher for device plugin dir")
+		return
+	}
+	defer watcher.Close()
+	if err := watcher.Add(p.pluginDir); err != nil {
+		klog.ErrorS(err, "Failed to start file watch on device plugin dir", "dir", p.pluginDir)
+		return
+	}
+
+	for {
+		server, err := p.start()
+		var retryCh <-chan time.Time
+		if err != nil {
+			klog.ErrorS(err, "Failed to start device plugin, will retry", "resource", ResourceName)
+			retryCh = time.After(retryInterval)
+		}
+		restart := p.waitForRestart(stopCh, watcher, retryCh)
+		if server != nil {
+			server.Stop()
+		}
+		if !restart {
+			return
+		}
+	}
+}
+
+// waitForRestart blocks until the plugin must be restarted, which is when kubelet has created its socket again or
+// retryCh is ready, and returns true. It returns false if stopCh is closed or if the dir can no longer be watched.
+func (p *Plugin) waitForRestart(stopCh <-chan struct{}, watcher *fsnotify.Watcher, retryCh <-chan time.Time) bool {
+	kubeletSocket := filepath.Join(p.pluginDir, kubeletSocketName)
+	for {
+		select {
+		case <-stopCh:
+			return false
+		case <-retryCh:
+			return true
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return false
+			}
+			if event.Name == kubeletSocket && event.Has(fsnotify.Create) {
+				klog.InfoS("Kubelet restarted, registering device plugin again", "resource", ResourceName)
+				return true
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return false
+			}
+			klog.ErrorS(err, "Error when watching device plugin dir")
+		}
+	}
+}
+
+// start serves the device plugin API on the plugin socket, and registers the plugin with kubelet.
+func (p *Plugin) start() (*grpc.Server, error) {
+	socket := filepath.Join(p.pluginDir, socketName)
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("error when removing stale socket %s: %w", socket, err)
+	}
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		return nil, fmt.Errorf("error when listening on socket %s: %w", socket, err)
+	}
+	server := grpc.NewServer()
+	pluginapi.RegisterDevicePluginServer(server, p)
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			klog.ErrorS(err, "Device plugin server stopped", "resource", ResourceName)
+		}
+	}()
+	if err := p.register(); err != nil {
+		server.Stop()
+		return nil, err
+	}
+	klog.InfoS("Registered device plugin with kubelet", "resource", ResourceName)
+	return server, nil
+}
+
+func (p *Plugin) register() error {
+	conn, err := grpc.NewClient(
+		"unix://"+filepath.Join(p.pluginDir, kubeletSocketName),
+		grpc.WithTransportCredentials(grpcinsecure.NewCredentials()),
+	)
+	if err != nil {
+		return fmt.Errorf("error getting the gRPC client for kubelet: %w", err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), registerTimeout)
+	defer cancel()
+	client := pluginapi.NewRegistrationClient(conn)
+	if _, err := client.Register(ctx, &pluginapi.RegisterRequest{
+		Version:      pluginapi.Version,
+		Endpoint:     socketName,
+		ResourceName: ResourceName,
+	}); err != nil {
+		return fmt.Errorf("error registering device plugin with kubelet: %w", err)
+	}
+	return nil
+}
+
+func (p *Plugin) devices() []*pluginapi.Device {
+	devices := make([]*pluginapi.Device, 0, p.count)
+	for i := 0; i < p.count; i++ {
+		devices = append(devices, &pluginapi.Device{
+			ID:     fmt.Sprintf("fastpath-queue-%d", i),
+			Health: pluginapi.Healthy,
+		})
+	}
+	return devices
+}
+
+func (p *Plugin) GetDevicePluginOptions(context.Context, *pluginapi.Empty) (*pluginapi.DevicePluginOptions, error) {
+	return &pluginapi.DevicePluginOptions{}, nil
+}
+
+// ListAndWatch sends the list of units once, as they never become unhealthy, and keeps the stream open until kubelet
+// or the plugin closes it.
+func (p *Plugin) ListAndWatch(_ *pluginapi.Empty, stream pluginapi.DevicePlugin_ListAndWatchServer) error {
+	if err := stream.Send(&pluginapi.ListAndWatchResponse{Devices: p.devices()}); err != nil {
+		return err
+	}
+	<-stream.Context().Done()
+	return nil
+}
+
+func (p *Plugin) GetPreferredAllocation(context.Context, *pluginapi.PreferredAllocationRequest) (*pluginapi.PreferredAllocationResponse, error) {
+	return &pluginapi.PreferredAllocationResponse{}, nil
+}
+
+// Allocate passes the IDs of the allocated units to the containers. Nothing else is required, as the rate cap is
+// configured once the Pod network interface has been created.
+func (p *Plugin) Allocate(_ context.Context, req *pluginapi.AllocateRequest) (*pluginapi.AllocateResponse, error) {
+	resp := &pluginapi.AllocateResponse{}
+	for _, containerReq := range req.ContainerRequests {
+		resp.ContainerResponses = append(resp.ContainerResponses, &pluginapi.ContainerAllocateResponse{
+			Envs: map[string]string{envFastpathQueueIDs: strings.Join(containerReq.DevicesIDs, ",")},
+		})
+	}
+	return resp, nil
+}
+
+func (p *Plugin) PreStartContainer(context.Context, *pluginapi.PreStartContainerRequest) (*pluginapi.PreStartContainerResponse, error) {
+	return &pluginapi.PreStartContainerResponse{}, nil
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deviceplugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pluginapi "k8s.io/kubelet/pkg/apis/deviceplugin/v1beta1"
+)
+
+func TestAllocate(t *testing.T) {
+	p := NewPlugin(2)
+	assert.Equal(t, []*pluginapi.Device{
+		{ID: "fastpath-queue-0", Health: pluginapi.Healthy},
+		{ID: "fastpath-queue-1", Health: pluginapi.Healthy},
+	}, p.devices())
+
+	resp, err := p.Allocate(context.Background(), &pluginapi.AllocateRequest{
+		ContainerRequests: []*pluginapi.ContainerAllocateRequest{
+			{DevicesIDs: []string{"fastpath-queue-0", "fastpath-queue-1"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.ContainerResponses, 1)
+	assert.Equal(t, map[string]string{envFastpathQueueIDs: "fastpath-queue-0,fastpath-queue-1"}, resp.ContainerResponses[0].Envs)
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalnode
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"antrea.io/antrea/pkg/agent/util"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	agentConfig "antrea.io/antrea/pkg/config/agent"
+	"antrea.io/antrea/pkg/util/externalnode"
+)
+
+var (
+	getIPNetDeviceByName = util.GetIPNetDeviceByName
+	getHostname          = os.Hostname
+)
+
+// registrationTemplateData is the data used to render the label templates of a self-registered ExternalNode.
+type registrationTemplateData struct {
+	NodeName string
+	Hostname string
+	OS       string
+}
+
+// ParseRegistrationLabels parses the label value templates of the registration configuration.
+func ParseRegistrationLabels(labels map[string]string) (map[string]*template.Template, error) {
+	templates := make(map[string]*template.Template, len(labels))
+	for key, value := range labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label key %s: %s", key, strings.Join(errs, "; "))
+		}
+		tmpl, err := template.New(key).Option("missingkey=error").Parse(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template for label %s: %w", key, err)
+		}
+		templates[key] = tmpl
+	}
+	return templates, nil
+}
+
+func renderRegistrationLabels(labels map[string]string, data *registrationTemplateData) (map[string]string, error) {
+	templates, err := ParseRegistrationLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+	rendered := make(map[string]string, len(templates))
+	for key, tmpl := range templates {
+		var value strings.Builder
+		if err := tmpl.Execute(&value, data); err != nil {
+			return nil, fmt.Errorf("failed to render label %s: %w", key, err)
+		}
+		if errs := validation.IsValidLabelValue(value.String()); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid value %q for label %s: %s", value.String(), key, strings.Join(errs, "; "))
+		}
+		rendered[key] = value.String()
+	}
+	return rendered, nil
+}
+
+// GenRegistrationExternalNode generates the ExternalNode created by antrea-agent to register the local Node. The
+// ExternalNode is annotated with the Pending registration state, so that it is not realized before being approved.
+func GenRegistrationExternalNode(nodeName, namespace string, registrationConfig *agentConfig.ExternalNodeRegistrationConfig) (*v1alpha1.ExternalNode, error) {
+	v4IPNet, _, _, err := getIPNetDeviceByName(registrationConfig.Interface)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IP addresses of interface %s: %w", registrationConfig.Interface, err)
+	}
+	if v4IPNet == nil {
+		return nil, fmt.Errorf("no IPv4 address is configured on interface %s", registrationConfig.Interface)
+	}
+	hostname, err := getHostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname: %w", err)
+	}
+	labels, err := renderRegistrationLabels(registrationConfig.Labels, &registrationTemplateData{
+		NodeName: nodeName,
+		Hostname: hostname,
+		OS:       runtime.GOOS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1alpha1.ExternalNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nodeName,
+			Namespace: namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				externalnode.RegistrationStateAnnotationKey: externalnode.RegistrationStatePending,
+			},
+		},
+		Spec: v1alpha1.ExternalNodeSpec{
+			Interfaces: []v1alpha1.NetworkInterface{
+				{
+					Name: registrationConfig.Interface,
+					IPs:  []string{v4IPNet.IP.String()},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalnode
+
+import (
+	"fmt"
+	"net"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+	"antrea.io/antrea/pkg/util/externalnode"
+)
+
+func TestGenRegistrationExternalNode(t *testing.T) {
+	v4IPNet := &net.IPNet{IP: net.ParseIP("172.16.100.3"), Mask: net.CIDRMask(24, 32)}
+	tests := []struct {
+		name               string
+		registrationConfig *agentconfig.ExternalNodeRegistrationConfig
+		v4IPNet            *net.IPNet
+		getIPErr           error
+		expectedEN         *v1alpha1.ExternalNode
+		expectedErr        string
+	}{
+		{
+			name: "labels from template",
+			registrationConfig: &agentconfig.ExternalNodeRegistrationConfig{
+				Enable:    true,
+				Interface: "eth0",
+				Labels: map[string]string{
+					"role":                   "db",
+					"antrea.io/hostname":     "{{.Hostname}}",
+					"antrea.io/os":           "{{.OS}}",
+					"antrea.io/vm-node-name": "vm-{{.NodeName}}",
+				},
+			},
+			v4IPNet: v4IPNet,
+			expectedEN: &v1alpha1.ExternalNode{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "vm1",
+					Namespace: "vm-ns",
+					Labels: map[string]string{
+						"role":                   "db",
+						"antrea.io/hostname":     "host1",
+						"antrea.io/os":           runtime.GOOS,
+						"antrea.io/vm-node-name": "vm-vm1",
+					},
+					Annotations: map[string]string{
+						externalnode.RegistrationStateAnnotationKey: externalnode.RegistrationStatePending,
+					},
+				},
+				Spec: v1alpha1.ExternalNodeSpec{
+					Interfaces: []v1alpha1.NetworkInterface{
+						{Name: "eth0", IPs: []string{"172.16.100.3"}},
+					},
+				},
+			},
+		},
+		{
+			name: "unknown template field",
+			registrationConfig: &agentconfig.ExternalNodeRegistrationConfig{
+				Enable:    true,
+				Interface: "eth0",
+				Labels:    map[string]string{"zone": "{{.Zone}}"},
+			},
+			v4IPNet:     v4IPNet,
+			expectedErr: "failed to render label zone",
+		},
+		{
+			name: "invalid rendered label value",
+			registrationConfig: &agentconfig.ExternalNodeRegistrationConfig{
+				Enable:    true,
+				Interface: "eth0",
+				Labels:    map[string]string{"name": "{{.NodeName}}/{{.Hostname}}"},
+			},
+			v4IPNet:     v4IPNet,
+			expectedErr: "invalid value \"vm1/host1\" for label name",
+		},
+		{
+			name: "interface not found",
+			registrationConfig: &agentconfig.ExternalNodeRegistrationConfig{
+				Enable:    true,
+				Interface: "eth1",
+			},
+			getIPErr:    fmt.Errorf("link not found"),
+			expectedErr: "failed to get IP addresses of interface eth1",
+		},
+		{
+			name: "no IPv4 address",
+			registrationConfig: &agentconfig.ExternalNodeRegistrationConfig{
+				Enable:    true,
+				Interface: "eth0",
+			},
+			expectedErr: "no IPv4 address is configured on interface eth0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer mockGetIPNetDeviceByName(tt.v4IPNet, tt.getIPErr)()
+			defer mockGetHostname("host1")()
+			en, err := GenRegistrationExternalNode("vm1", "vm-ns", tt.registrationConfig)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedEN, en)
+		})
+	}
+}
+
+func TestParseRegistrationLabels(t *testing.T) {
+	_, err := ParseRegistrationLabels(map[string]string{"role": "{{.NodeName"})
+	assert.ErrorContains(t, err, "invalid template for label role")
+	_, err = ParseRegistrationLabels(map[string]string{"invalid key": "db"})
+	assert.ErrorContains(t, err, "invalid label key invalid key")
+	templates, err := ParseRegistrationLabels(map[string]string{"role": "db", "host": "{{.Hostname}}"})
+	require.NoError(t, err)
+	assert.Len(t, templates, 2)
+}
+
+func mockGetIPNetDeviceByName(v4IPNet *net.IPNet, err error) func() {
+	prevGetIPNetDeviceByName := getIPNetDeviceByName
+	getIPNetDeviceByName = func(ifaceName string) (*net.IPNet, *net.IPNet, *net.Interface, error) {
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return v4IPNet, nil, &net.Interface{Name: ifaceName}, nil
+	}
+	return func() { getIPNetDeviceByName = prevGetIPNetDeviceByName }
+}
+
+func mockGetHostname(hostname string) func() {
+	prevGetHostname := getHostname
+	getHostname = func() (string, error) {
+		return hostname, nil
+	}
+	return func() { getHostname = prevGetHostname }
+}
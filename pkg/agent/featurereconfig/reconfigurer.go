@@ -0,0 +1,225 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featurereconfig
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/component-base/featuregate"
+	"k8s.io/klog/v2"
+
+	agentconfig "antrea.io/antrea/pkg/config/agent"
+	"antrea.io/antrea/pkg/features"
+	"antrea.io/antrea/pkg/util/yaml"
+)
+
+// Handler starts and stops the components of a feature which can be enabled and disabled at runtime.
+type Handler struct {
+	// Start starts the components of the feature, which must stop when stopCh is closed.
+	Start func(stopCh <-chan struct{}) error
+	// Cleanup is optional. It is called after stopCh has been closed because the feature has been disabled, to
+	// remove the configurations made by the components. It is not called when Antrea Agent stops.
+	Cleanup func() error
+}
+
+// Reconfigurer starts the components of the reconfigurable features which are enabled, and watches the Antrea Agent
+// configuration file to start or stop them when their feature gates are updated. The file is reloaded when it is
+// changed, e.g. when the antrea-agent ConfigMap is updated and synced by kubelet, or when Antrea Agent receives a
+// SIGHUP signal. Updates of the other feature gates are ignored and require Antrea Agent to be restarted.
+type Reconfigurer struct {
+	configFile  string
+	featureGate featuregate.MutableFeatureGate
+	// watcher is nil if no configuration file is used.
+	watcher  *fsnotify.Watcher
+	reloadCh chan os.Signal
+
+	mutex    sync.Mutex
+	handlers map[featuregate.Feature]Handler
+	// stopChs stores the channels used to stop the components of the running features.
+	stopChs map[featuregate.Feature]chan struct{}
+}
+
+func NewReconfigurer(configFile string, featureGate featuregate.MutableFeatureGate) (*Reconfigurer, error) {
+	r := &Reconfigurer{
+		configFile:  configFile,
+		featureGate: featureGate,
+		reloadCh:    make(chan os.Signal, 1),
+		handlers:    map[featuregate.Feature]Handler{},
+		stopChs:     map[featuregate.Feature]chan struct{}{},
+	}
+	if configFile == "" {
+		return r, nil
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("error when creating file watcher for configuration file: %w", err)
+	}
+	// Watch the directory instead of the file, as the file is replaced when the ConfigMap it is mounted from is
+	// updated, after which the file itself can no longer be tracked.
+	if err := watcher.Add(filepath.Dir(configFile)); err != nil {
+		watcher.Close()
+		return nil, fmt.Errorf("error when starting file watch on configuration dir: %w", err)
+	}
+	r.watcher = watcher
+	return r, nil
+}
+
+// AddHandler registers the Handler of a reconfigurable feature. It must be called before Run.
+func (r *Reconfigurer) AddHandler(feature featuregate.Feature, handler Handler) error {
+	if !features.ReconfigurableOnAgent(feature) {
+		return fmt.Errorf("feature %s cannot be reconfigured at runtime", feature)
+	}
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.handlers[feature] = handler
+	return nil
+}
+
+// Run starts the features which are enabled, and reconfigures them until stopCh is closed.
+func (r *Reconfigurer) Run(stopCh <-chan struct{}) {
+	r.mutex.Lock()
+	for feature := range r.handlers {
+		if r.featureGate.Enabled(feature) {
+			r.startFeature(feature)
+		}
+	}
+	r.mutex.Unlock()
+	defer r.stopAllFeatures()
+
+	if r.watcher == nil {
+		<-stopCh
+		return
+	}
+	defer r.watcher.Close()
+	signal.Notify(r.reloadCh, syscall.SIGHUP)
+	defer signal.Stop(r.reloadCh)
+
+	klog.InfoS("Watching Antrea Agent configuration file for feature gate updates", "file", r.configFile)
+	for {
+		select {
+		case <-stopCh:
+			return
+		case event, ok := <-r.watcher.Events:
+			if !ok {
+				klog.ErrorS(nil, "Configuration file watcher has been closed, feature gates will no longer be reconfigured")
+				<-stopCh
+				return
+			}
+			klog.V(4).InfoS("Configuration file event", "event", event.String())
+			r.reload()
+		case err, ok := <-r.watcher.Errors:
+			if !ok {
+				klog.ErrorS(nil, "Configuration file watcher has been closed, feature gates will no longer be reconfigured")
+				<-stopCh
+				return
+			}
+			klog.ErrorS(err, "Error when watching configuration file")
+		case <-r.reloadCh:
+			klog.InfoS("Received signal, reloading configuration file", "file", r.configFile)
+			r.reload()
+		}
+	}
+}
+
+// reload reads the feature gates from the configuration file, and starts or stops the reconfigurable features whose
+// feature gates have been updated.
+func (r *Reconfigurer) reload() {
+	data, err := os.ReadFile(r.configFile)
+	if err != nil {
+		klog.ErrorS(err, "Failed to read configuration file", "file", r.configFile)
+		return
+	}
+	config := &agentconfig.AgentConfig{}
+	if err := yaml.UnmarshalLenient(data, config); err != nil {
+		klog.ErrorS(err, "Failed to decode configuration file", "file", r.configFile)
+		return
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for feature, spec := range r.featureGate.GetAll() {
+		if !features.AgentGates.Has(feature) {
+			continue
+		}
+		enabled := spec.Default
+		if value, exists := config.FeatureGates[string(feature)]; exists {
+			enabled = value
+		}
+		if enabled == r.featureGate.Enabled(feature) {
+			continue
+		}
+		if _, exists := r.handlers[feature]; !exists {
+			klog.InfoS("Feature gate cannot be updated at runtime, Antrea Agent must be restarted to apply the update", "feature", feature, "enabled", enabled)
+			continue
+		}
+		if err := r.featureGate.SetFromMap(map[string]bool{string(feature): enabled}); err != nil {
+			klog.ErrorS(err, "Failed to update feature gate", "feature", feature, "enabled", enabled)
+			continue
+		}
+		if enabled {
+			r.startFeature(feature)
+		} else {
+			r.stopFeature(feature)
+		}
+	}
+}
+
+// startFeature starts the components of the feature. If they fail to start, the feature gate is disabled, so that
+// starting them is attempted again the next time the configuration file is reloaded.
+func (r *Reconfigurer) startFeature(feature featuregate.Feature) {
+	stopCh := make(chan struct{})
+	if err := r.handlers[feature].Start(stopCh); err != nil {
+		close(stopCh)
+		klog.ErrorS(err, "Failed to start feature", "feature", feature)
+		if err := r.featureGate.SetFromMap(map[string]bool{string(feature): false}); err != nil {
+			klog.ErrorS(err, "Failed to disable feature gate", "feature", feature)
+		}
+		return
+	}
+	r.stopChs[feature] = stopCh
+	klog.InfoS("Started feature", "feature", feature)
+}
+
+func (r *Reconfigurer) stopFeature(feature featuregate.Feature) {
+	stopCh, exists := r.stopChs[feature]
+	if !exists {
+		return
+	}
+	close(stopCh)
+	delete(r.stopChs, feature)
+	if cleanup := r.handlers[feature].Cleanup; cleanup != nil {
+		if err := cleanup(); err != nil {
+			klog.ErrorS(err, "Failed to clean up feature", "feature", feature)
+		}
+	}
+	klog.InfoS("Stopped feature", "feature", feature)
+}
+
+// stopAllFeatures stops the components of all running features without cleaning up their configurations, which must
+// be preserved when Antrea Agent stops.
+func (r *Reconfigurer) stopAllFeatures() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for feature, stopCh := range r.stopChs {
+		close(stopCh)
+		delete(r.stopChs, feature)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package featurereconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/component-base/featuregate"
+
+	"antrea.io/antrea/pkg/features"
+)
+
+// fakeFeature records the state of the components of a feature.
+type fakeFeature struct {
+	mutex    sync.Mutex
+	stopCh   <-chan struct{}
+	starts   int
+	cleanups int
+	startErr error
+}
+
+func (f *fakeFeature) handler() Handler {
+	return Handler{
+		Start: func(stopCh <-chan struct{}) error {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+			if f.startErr != nil {
+				return f.startErr
+			}
+			f.stopCh = stopCh
+			f.starts++
+			return nil
+		},
+		Cleanup: func() error {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+			f.cleanups++
+			return nil
+		},
+	}
+}
+
+func (f *fakeFeature) running() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.stopCh == nil {
+		return false
+	}
+	select {
+	case <-f.stopCh:
+		return false
+	default:
+		return true
+	}
+}
+
+func (f *fakeFeature) getCounts() (int, int) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.starts, f.cleanups
+}
+
+func newTestFeatureGate(t *testing.T) featuregate.MutableFeatureGate {
+	featureGate := featuregate.NewFeatureGate()
+	require.NoError(t, featureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+		features.AntreaProxy:   {Default: true, PreRelease: featuregate.GA},
+		features.FlowExporter:  {Default: false, PreRelease: featuregate.Alpha},
+		features.NodePortLocal: {Default: true, PreRelease: featuregate.GA},
+	}))
+	return featureGate
+}
+
+func writeConfigFile(t *testing.T, path string, featureGates map[string]bool) {
+	content := "featureGates:\n"
+	for name, enabled := range featureGates {
+		content += fmt.Sprintf("  %s: %t\n", name, enabled)
+	}
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+}
+
+func TestReconfigurer(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "antrea-agent.conf")
+	writeConfigFile(t, configFile, map[string]bool{})
+	featureGate := newTestFeatureGate(t)
+
+	r, err := NewReconfigurer(configFile, featureGate)
+	require.NoError(t, err)
+	flowExporter, npl := &fakeFeature{}, &fakeFeature{}
+	require.NoError(t, r.AddHandler(features.FlowExporter, flowExporter.handler()))
+	require.NoError(t, r.AddHandler(features.NodePortLocal, npl.handler()))
+	stopCh := make(chan struct{})
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		r.Run(stopCh)
+	}()
+
+	// Only the features enabled by default are started.
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.True(c, npl.running())
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.False(t, flowExporter.running())
+
+	writeConfigFile(t, configFile, map[string]bool{
+		string(features.FlowExporter):  true,
+		string(features.NodePortLocal): false,
+		string(features.AntreaProxy):   false,
+	})
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.True(c, flowExporter.running())
+		assert.False(c, npl.running())
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.True(t, featureGate.Enabled(features.FlowExporter))
+	assert.False(t, featureGate.Enabled(features.NodePortLocal))
+	// AntreaProxy cannot be reconfigured at runtime.
+	assert.True(t, featureGate.Enabled(features.AntreaProxy))
+	starts, cleanups := npl.getCounts()
+	assert.Equal(t, 1, starts)
+	assert.Equal(t, 1, cleanups)
+
+	// The running features are stopped without being cleaned up when Antrea Agent stops.
+	close(stopCh)
+	<-doneCh
+	assert.False(t, flowExporter.running())
+	starts, cleanups = flowExporter.getCounts()
+	assert.Equal(t, 1, starts)
+	assert.Equal(t, 0, cleanups)
+}
+
+func TestReconfigurerStartFailure(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), "antrea-agent.conf")
+	writeConfigFile(t, configFile, map[string]bool{string(features.FlowExporter): true})
+	featureGate := newTestFeatureGate(t)
+	require.NoError(t, featureGate.SetFromMap(map[string]bool{string(features.FlowExporter): true}))
+
+	r, err := NewReconfigurer(configFile, featureGate)
+	require.NoError(t, err)
+	flowExporter := &fakeFeature{startErr: fmt.Errorf("error")}
+	require.NoError(t, r.AddHandler(features.FlowExporter, flowExporter.handler()))
+
+	r.mutex.Lock()
+	r.startFeature(features.FlowExporter)
+	r.mutex.Unlock()
+	assert.False(t, flowExporter.running())
+	assert.False(t, featureGate.Enabled(features.FlowExporter))
+
+	// Starting the feature is attempted again when the configuration file is reloaded.
+	flowExporter.startErr = nil
+	r.reload()
+	assert.True(t, flowExporter.running())
+	assert.True(t, featureGate.Enabled(features.FlowExporter))
+}
+
+func TestReconfigurerAddHandler(t *testing.T) {
+	r, err := NewReconfigurer("", newTestFeatureGate(t))
+	require.NoError(t, err)
+	assert.NoError(t, r.AddHandler(features.NodePortLocal, Handler{}))
+	assert.EqualError(t, r.AddHandler(features.AntreaProxy, Handler{}), "feature AntreaProxy cannot be reconfigured at runtime")
+}
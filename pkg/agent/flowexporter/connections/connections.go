@@ -49,7 +49,7 @@ func NewConnectionStore(
 		connections:            make(map[flowexporter.ConnectionKey]*flowexporter.Connection),
 		podStore:               podStore,
 		antreaProxier:          proxier,
-		expirePriorityQueue:    priorityqueue.NewExpirePriorityQueue(o.ActiveFlowTimeout, o.IdleFlowTimeout),
+		expirePriorityQueue:    priorityqueue.NewExpirePriorityQueue(o.ActiveFlowTimeout, o.IdleFlowTimeout, o.TimeoutRules...),
 		staleConnectionTimeout: o.StaleConnectionTimeout,
 	}
 }
@@ -86,7 +86,7 @@ func (cs *ConntrackConnectionStore) Run(stopCh <-chan struct{}) {
 	for {
 		select {
 		case <-stopCh:
-			break
+			return
 		case <-pollTicker.C:
 			_, err := cs.Poll()
 			if err != nil {
@@ -249,6 +249,18 @@ func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *flowexporter.Connectio
 		// Update the necessary fields that are used in generating flow records.
 		// Can same 5-tuple flow get deleted and added to conntrack table? If so use ID.
 		existingConn.StopTime = conn.StopTime
+		// The counters restart from zero when the conntrack entry is recreated with the same 5-tuple between two
+		// polls, which happens with the OVS userspace and Windows datapaths as they do not keep the counters of
+		// expired entries. Reset the "prev" counters in this case, so that the delta counts of the exported records
+		// are computed from the counters of the new entry instead of being negative.
+		if conn.OriginalPackets < existingConn.PrevPackets || conn.OriginalBytes < existingConn.PrevBytes ||
+			conn.ReversePackets < existingConn.PrevReversePackets || conn.ReverseBytes < existingConn.PrevReverseBytes {
+			klog.V(4).InfoS("Counters of connection were reset", "connection", existingConn)
+			existingConn.PrevPackets = 0
+			existingConn.PrevBytes = 0
+			existingConn.PrevReversePackets = 0
+			existingConn.PrevReverseBytes = 0
+		}
 		existingConn.OriginalBytes = conn.OriginalBytes
 		existingConn.OriginalPackets = conn.OriginalPackets
 		existingConn.ReverseBytes = conn.ReverseBytes
@@ -264,7 +276,7 @@ func (cs *ConntrackConnectionStore) AddOrUpdateConn(conn *flowexporter.Connectio
 				cs.expirePriorityQueue.WriteItemToQueue(connKey, existingConn)
 			} else {
 				cs.connectionStore.expirePriorityQueue.Update(existingItem, existingItem.ActiveExpireTime,
-					time.Now().Add(cs.connectionStore.expirePriorityQueue.IdleFlowTimeout))
+					time.Now().Add(cs.connectionStore.expirePriorityQueue.GetIdleFlowTimeout(existingItem.Conn)))
 			}
 		}
 		klog.V(4).InfoS("Antrea flow updated", "connection", existingConn)
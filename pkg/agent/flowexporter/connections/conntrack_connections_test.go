@@ -45,6 +45,7 @@ var (
 	tuple1 = flowexporter.Tuple{SourceAddress: netip.MustParseAddr("5.6.7.8"), DestinationAddress: netip.MustParseAddr("8.7.6.5"), Protocol: 6, SourcePort: 60001, DestinationPort: 200}
 	tuple2 = flowexporter.Tuple{SourceAddress: netip.MustParseAddr("1.2.3.4"), DestinationAddress: netip.MustParseAddr("4.3.2.1"), Protocol: 6, SourcePort: 65280, DestinationPort: 255}
 	tuple3 = flowexporter.Tuple{SourceAddress: netip.MustParseAddr("10.10.10.10"), DestinationAddress: netip.MustParseAddr("4.3.2.1"), Protocol: 6, SourcePort: 60000, DestinationPort: 100}
+	tuple4 = flowexporter.Tuple{SourceAddress: netip.MustParseAddr("10.10.10.11"), DestinationAddress: netip.MustParseAddr("4.3.2.1"), Protocol: 6, SourcePort: 60000, DestinationPort: 100}
 	pod1   = &v1.Pod{
 		Status: v1.PodStatus{
 			PodIPs: []v1.PodIP{
@@ -176,6 +177,49 @@ func TestConntrackConnectionStore_AddOrUpdateConn(t *testing.T) {
 				IsActive:        true,
 			},
 		},
+		{
+			// If the conntrack entry was recreated with the same 5-tuple, its counters
+			// restart from zero and the "prev" counters are reset.
+			name:    "updateRecreatedConn",
+			flowKey: tuple4,
+			oldConn: &flowexporter.Connection{
+				StartTime:          refTime.Add(-(time.Second * 50)),
+				StopTime:           refTime.Add(-(time.Second * 30)),
+				LastExportTime:     refTime.Add(-(time.Second * 30)),
+				OriginalPackets:    0xfff,
+				OriginalBytes:      0xbaaaaa00000000,
+				ReversePackets:     0xf,
+				ReverseBytes:       0xbaa,
+				PrevPackets:        0xfff,
+				PrevBytes:          0xbaaaaa00000000,
+				PrevReversePackets: 0xf,
+				PrevReverseBytes:   0xbaa,
+				FlowKey:            tuple4,
+				IsPresent:          true,
+			},
+			newConn: flowexporter.Connection{
+				StartTime:       refTime.Add(-(time.Second * 10)),
+				StopTime:        refTime,
+				OriginalPackets: 0xf,
+				OriginalBytes:   0xbaa,
+				ReversePackets:  0xf,
+				ReverseBytes:    0xba,
+				FlowKey:         tuple4,
+				IsPresent:       true,
+			},
+			expectedConn: flowexporter.Connection{
+				StartTime:       refTime.Add(-(time.Second * 50)),
+				StopTime:        refTime,
+				LastExportTime:  refTime.Add(-(time.Second * 30)),
+				OriginalPackets: 0xf,
+				OriginalBytes:   0xbaa,
+				ReversePackets:  0xf,
+				ReverseBytes:    0xba,
+				FlowKey:         tuple4,
+				IsPresent:       true,
+				IsActive:        true,
+			},
+		},
 		{
 			// If the polled new connection is dying, the old connection present
 			// in connection store will not be updated.
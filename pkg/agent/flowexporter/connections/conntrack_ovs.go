@@ -54,6 +54,32 @@ var (
 	}
 )
 
+var (
+	// ovsTCPStateMap maps the TCP states of OVS conntrack, defined at
+	// https://github.com/openvswitch/ovs/blob/main/lib/ct-dpif.h, to the ones of the Linux conntrack module
+	// which are different.
+	ovsTCPStateMap = map[string]string{
+		"CLOSED":     "CLOSE",
+		"LISTEN":     "NONE",
+		"FIN_WAIT_1": "FIN_WAIT",
+		"CLOSING":    "FIN_WAIT",
+		"FIN_WAIT_2": "FIN_WAIT",
+	}
+	// tcpStateOrder orders the TCP states of the Linux conntrack module by progress of the connection.
+	tcpStateOrder = map[string]int{
+		"NONE":        0,
+		"SYN_SENT":    1,
+		"SYN_SENT2":   1,
+		"SYN_RECV":    2,
+		"ESTABLISHED": 3,
+		"FIN_WAIT":    4,
+		"CLOSE_WAIT":  5,
+		"LAST_ACK":    6,
+		"TIME_WAIT":   7,
+		"CLOSE":       8,
+	}
+)
+
 // connTrackOvsCtl implements ConnTrackDumper. This supports OVS userspace datapath scenarios.
 var _ ConnTrackDumper = new(connTrackOvsCtl)
 
@@ -125,6 +151,7 @@ func flowStringToAntreaConnection(flow string, zoneFilter uint16) (*flowexporter
 	flowSlice := strings.Split(flow, ",")
 	isReply := false
 	inZone := false
+	var origTCPState, replyTCPState string
 	for _, fs := range flowSlice {
 		// Indicator to populate reply or reverse fields
 		if strings.Contains(fs, "reply") {
@@ -269,18 +296,24 @@ func flowStringToAntreaConnection(flow string, zoneFilter uint16) (*flowexporter
 				return nil, fmt.Errorf("conversion of id %s to int failed: %v", fields[len(fields)-1], err)
 			}
 			conn.ID = uint32(val)
-		case strings.Contains(fs, "protoinfo"):
-			fields := strings.Split(fs, "(")
-			// retrieve tcpState from state or state_orig
-			if strings.Contains(fields[1], "state") {
-				items := strings.Split(fields[1], "=")
-				conn.TCPState = items[1]
+		case strings.HasPrefix(strings.TrimPrefix(fs, "protoinfo=("), "state"):
+			// The TCP state is either dumped as "state", when both directions are in the same state, or as
+			// "state_orig" and "state_reply".
+			fs = strings.TrimSuffix(strings.TrimPrefix(fs, "protoinfo=("), ")")
+			fields := strings.Split(fs, "=")
+			if fields[0] == "state_reply" {
+				replyTCPState = fields[len(fields)-1]
+			} else {
+				origTCPState = fields[len(fields)-1]
 			}
 		}
 	}
 	if !inZone {
 		return nil, nil
 	}
+	if origTCPState != "" || replyTCPState != "" {
+		conn.TCPState = ovsTCPStateToConntrackTCPState(origTCPState, replyTCPState)
+	}
 
 	// Add current time as stop time.
 	conn.StopTime = time.Now()
@@ -291,6 +324,26 @@ func flowStringToAntreaConnection(flow string, zoneFilter uint16) (*flowexporter
 	return &conn, nil
 }
 
+// ovsTCPStateToConntrackTCPState converts the TCP states of both directions of a connection tracked by the OVS
+// userspace or Windows datapath to a single TCP state named as in the Linux conntrack module, so that connections are
+// handled in the same way regardless of the datapath, e.g. when determining whether a connection is dying, and the
+// exported records are consistent. The most advanced state of both directions is used.
+func ovsTCPStateToConntrackTCPState(origState, replyState string) string {
+	state := ""
+	for _, s := range []string{origState, replyState} {
+		if s == "" {
+			continue
+		}
+		if mapped, ok := ovsTCPStateMap[s]; ok {
+			s = mapped
+		}
+		if state == "" || tcpStateOrder[s] > tcpStateOrder[state] {
+			state = s
+		}
+	}
+	return state
+}
+
 func hasAnyProto(text string) bool {
 	for proto := range flowexporter.Protocols {
 		if strings.Contains(strings.ToLower(text), proto) {
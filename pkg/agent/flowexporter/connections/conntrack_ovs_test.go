@@ -0,0 +1,72 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+)
+
+func TestFlowStringToAntreaConnectionTCPState(t *testing.T) {
+	const flowPrefix = "tcp,orig=(src=10.10.0.2,dst=10.10.0.3,sport=41284,dport=80,packets=10,bytes=1000),reply=(src=10.10.0.3,dst=10.10.0.2,sport=80,dport=41284,packets=8,bytes=2000),start=2020-07-25T08:40:08.959,id=982464968,zone=65520,status=SEEN_REPLY|ASSURED|CONFIRMED,timeout=120"
+	tests := []struct {
+		name             string
+		protoinfo        string
+		expectedTCPState string
+	}{
+		{
+			name:             "same state in both directions",
+			protoinfo:        ",protoinfo=(state=ESTABLISHED)",
+			expectedTCPState: "ESTABLISHED",
+		},
+		{
+			name:             "same state with window scale",
+			protoinfo:        ",protoinfo=(state=ESTABLISHED,wscale_orig=7,wscale_reply=7)",
+			expectedTCPState: "ESTABLISHED",
+		},
+		{
+			name:             "different states",
+			protoinfo:        ",protoinfo=(state_orig=FIN_WAIT_2,state_reply=TIME_WAIT)",
+			expectedTCPState: "TIME_WAIT",
+		},
+		{
+			name:             "OVS state names",
+			protoinfo:        ",protoinfo=(state_orig=FIN_WAIT_1,state_reply=ESTABLISHED,flags_orig=WINDOW_SCALE|SACK_PERM,flags_reply=WINDOW_SCALE|SACK_PERM)",
+			expectedTCPState: "FIN_WAIT",
+		},
+		{
+			name:             "closed",
+			protoinfo:        ",protoinfo=(state=CLOSED)",
+			expectedTCPState: "CLOSE",
+		},
+		{
+			name: "no protoinfo",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, err := flowStringToAntreaConnection(flowPrefix+tt.protoinfo, openflow.CtZone)
+			require.NoError(t, err)
+			require.NotNil(t, conn)
+			assert.Equal(t, tt.expectedTCPState, conn.TCPState)
+			assert.Equal(t, uint64(10), conn.OriginalPackets)
+			assert.Equal(t, uint64(2000), conn.ReverseBytes)
+		})
+	}
+}
@@ -46,7 +46,7 @@ func (ds *DenyConnectionStore) RunPeriodicDeletion(stopCh <-chan struct{}) {
 	for {
 		select {
 		case <-stopCh:
-			break
+			return
 		case <-pollTicker.C:
 			deleteIfStaleConn := func(key flowexporter.ConnectionKey, conn *flowexporter.Connection) error {
 				if conn.ReadyToDelete || time.Since(conn.LastExportTime) >= ds.staleConnectionTimeout {
@@ -87,7 +87,7 @@ func (ds *DenyConnectionStore) AddOrUpdateConn(conn *flowexporter.Connection, ti
 			ds.expirePriorityQueue.WriteItemToQueue(connKey, conn)
 		} else {
 			ds.connectionStore.expirePriorityQueue.Update(existingItem, existingItem.ActiveExpireTime,
-				time.Now().Add(ds.connectionStore.expirePriorityQueue.IdleFlowTimeout))
+				time.Now().Add(ds.connectionStore.expirePriorityQueue.GetIdleFlowTimeout(existingItem.Conn)))
 		}
 		klog.V(4).InfoS("Deny connection has been updated", "connection", conn)
 	} else {
@@ -20,6 +20,9 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/util/spiffe"
 )
 
 const (
@@ -52,3 +55,36 @@ func getClientCertKey(ctx context.Context, k8sClient kubernetes.Interface) ([]by
 	}
 	return clientSecret.Data["tls.crt"], clientSecret.Data["tls.key"], nil
 }
+
+// loadSPIFFECredentials loads the X.509 SVID of the Node, and checks that the flow aggregator presents an SVID with an
+// authorized SPIFFE ID. The verified SVID of the flow aggregator is the only trusted root certificate of the
+// connection established by the exporting process, which cannot verify the SPIFFE ID itself, so that the connection
+// can only be established with the flow aggregator which has been verified.
+func (exp *FlowExporter) loadSPIFFECredentials(ctx context.Context) error {
+	source, err := spiffe.LoadX509Source(exp.spiffeCertDir)
+	if err != nil {
+		return fmt.Errorf("cannot load SVID: %w", err)
+	}
+	serverCertPEM, err := spiffe.VerifyServerID(ctx, exp.exporterInput.CollectorAddress, source, exp.spiffeServerIDs)
+	if err != nil {
+		return fmt.Errorf("cannot verify SPIFFE ID of flow aggregator: %w", err)
+	}
+	tlsConfig := exp.exporterInput.TLSClientConfig
+	tlsConfig.CAData, tlsConfig.CertData, tlsConfig.KeyData = serverCertPEM, source.CertPEM, source.KeyPEM
+	exp.spiffeSource = source
+	return nil
+}
+
+// svidRotated returns whether the X.509 SVID used by the current connection to the flow aggregator has been rotated.
+func (exp *FlowExporter) svidRotated() bool {
+	if exp.spiffeSource == nil {
+		return false
+	}
+	source, err := spiffe.LoadX509Source(exp.spiffeCertDir)
+	if err != nil {
+		// The files may be being updated, the SVID will be checked again in the next export cycle.
+		klog.V(2).InfoS("Failed to load SVID", "err", err)
+		return false
+	}
+	return !source.Equal(exp.spiffeSource)
+}
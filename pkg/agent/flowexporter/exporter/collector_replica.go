@@ -0,0 +1,86 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/util/flowexport"
+	k8sutil "antrea.io/antrea/pkg/util/k8s"
+)
+
+// collectorReplicaCheckInterval is the interval at which the flow exporter checks whether another replica of a
+// sharded flow aggregator should be used, e.g. after the flow aggregator has been scaled.
+const collectorReplicaCheckInterval = time.Minute
+
+// selectCollectorReplica returns the address of the flow aggregator replica the Node exports flow records to, when
+// the flow aggregator is provided as a headless Service. The replica is selected among the ready endpoints of the
+// Service by consistent hashing of the Node name, so that each replica receives the records of a subset of the Nodes,
+// and only the Nodes of a replica are moved to other replicas when it is removed.
+func (exp *FlowExporter) selectCollectorReplica(ctx context.Context, namespace, name string) (string, error) {
+	endpointSlices, err := exp.k8sClient.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, name),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list EndpointSlices of FlowAggregator Service %s/%s: %w", namespace, name, err)
+	}
+	replicas := sets.New[string]()
+	for _, endpointSlice := range endpointSlices.Items {
+		for _, endpoint := range endpointSlice.Endpoints {
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			if len(endpoint.Addresses) > 0 {
+				replicas.Insert(endpoint.Addresses[0])
+			}
+		}
+	}
+	replica := flowexport.SelectShard(exp.nodeName, sets.List(replicas))
+	if replica == "" {
+		return "", fmt.Errorf("no ready endpoint for FlowAggregator Service: %s/%s", namespace, name)
+	}
+	klog.V(2).InfoS("Selected FlowAggregator replica", "replica", replica, "replicas", replicas.Len())
+	return replica, nil
+}
+
+// collectorReplicaChanged returns whether the flow aggregator replica selected for the Node is no longer the one the
+// flow exporter is connected to. It is checked at most once every collectorReplicaCheckInterval.
+func (exp *FlowExporter) collectorReplicaChanged() bool {
+	if !exp.shardedCollector || time.Since(exp.lastCollectorReplicaCheck) < collectorReplicaCheckInterval {
+		return false
+	}
+	exp.lastCollectorReplicaCheck = time.Now()
+	host, port, err := net.SplitHostPort(exp.collectorAddr)
+	if err != nil {
+		return false
+	}
+	ns, name := k8sutil.SplitNamespacedName(host)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	replica, err := exp.selectCollectorReplica(ctx, ns, name)
+	if err != nil {
+		klog.ErrorS(err, "Failed to select FlowAggregator replica")
+		return false
+	}
+	return net.JoinHostPort(replica, port) != exp.exporterInput.CollectorAddress
+}
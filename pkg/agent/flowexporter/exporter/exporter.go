@@ -24,6 +24,7 @@ import (
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	"github.com/vmware/go-ipfix/pkg/exporter"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -36,6 +37,7 @@ import (
 	"antrea.io/antrea/pkg/agent/flowexporter/priorityqueue"
 	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/proxy"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/ipfix"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
@@ -110,6 +112,12 @@ var (
 	AntreaInfoElementsIPv6 = append(antreaInfoElementsCommon, []string{"destinationClusterIPv6"}...)
 )
 
+// PodInterfaceStatsProvider provides the counters of the interfaces of the local Pods. It is only available on Windows,
+// where the packets dropped by the HNS endpoints of the Pods are not visible in the OVS flows and in conntrack.
+type PodInterfaceStatsProvider interface {
+	GetPodInterfaceStats(namespace, name string) (*statsv1alpha1.PodInterfaceStats, bool)
+}
+
 type FlowExporter struct {
 	collectorAddr          string
 	conntrackConnStore     *connections.ConntrackConnectionStore
@@ -135,6 +143,22 @@ type FlowExporter struct {
 	egressQuerier          querier.EgressQuerier
 	podStore               podstore.Interface
 	l7Listener             *connections.L7Listener
+	// policyFlowsOnly restricts the exported flows to the ones which matched a NetworkPolicy rule.
+	policyFlowsOnly bool
+	// spiffeCertDir is the directory of the X.509 SVID used to connect to the flow aggregator, if SPIFFE is enabled.
+	spiffeCertDir string
+	// spiffeServerIDs are the SPIFFE IDs the flow aggregator is authorized to have.
+	spiffeServerIDs []string
+	// spiffeSource holds the X.509 SVID used by the current connection to the flow aggregator.
+	spiffeSource *spiffe.X509Source
+	// otlpExporter is set when flow records are exported to an OpenTelemetry collector instead of an IPFIX
+	// collector.
+	otlpExporter *otlpExporter
+	// shardedCollector is set when the flow aggregator is provided as a headless Service, in which case
+	// one of its replicas is selected for the Node.
+	shardedCollector bool
+	// lastCollectorReplicaCheck is the last time the selection of the flow aggregator replica was checked.
+	lastCollectorReplicaCheck time.Time
 }
 
 func genObservationID(nodeName string) uint32 {
@@ -162,7 +186,8 @@ func prepareExporterInputArgs(collectorProto, nodeName string) exporter.Exporter
 func NewFlowExporter(podStore podstore.Interface, proxier proxy.Proxier, k8sClient kubernetes.Interface, nodeRouteController *noderoute.Controller,
 	trafficEncapMode config.TrafficEncapModeType, nodeConfig *config.NodeConfig, v4Enabled, v6Enabled bool, serviceCIDRNet, serviceCIDRNetv6 *net.IPNet,
 	ovsDatapathType ovsconfig.OVSDatapathType, proxyEnabled bool, npQuerier querier.AgentNetworkPolicyInfoQuerier, o *flowexporter.FlowExporterOptions,
-	egressQuerier querier.EgressQuerier, podL7FlowExporterAttrGetter connections.PodL7FlowExporterAttrGetter, l7FlowExporterEnabled bool) (*FlowExporter, error) {
+	egressQuerier querier.EgressQuerier, podL7FlowExporterAttrGetter connections.PodL7FlowExporterAttrGetter, l7FlowExporterEnabled bool,
+	podInterfaceStatsProvider PodInterfaceStatsProvider) (*FlowExporter, error) {
 	// Initialize IPFIX registry
 	registry := ipfix.NewIPFIXRegistry()
 	registry.LoadRegistry()
@@ -187,6 +212,11 @@ func NewFlowExporter(podStore podstore.Interface, proxier proxy.Proxier, k8sClie
 		klog.InfoS("NodeRouteController is nil, will not be able to determine flow type for connections")
 	}
 
+	var otlpExp *otlpExporter
+	if o.OTLP != nil {
+		otlpExp = newOTLPExporter(nodeName, podStore, podInterfaceStatsProvider, o.OTLP)
+	}
+
 	return &FlowExporter{
 		collectorAddr:          o.FlowCollectorAddr,
 		conntrackConnStore:     conntrackConnStore,
@@ -206,6 +236,10 @@ func NewFlowExporter(podStore podstore.Interface, proxier proxy.Proxier, k8sClie
 		egressQuerier:          egressQuerier,
 		podStore:               podStore,
 		l7Listener:             l7Listener,
+		policyFlowsOnly:        o.PolicyFlowsOnly,
+		spiffeCertDir:          o.SPIFFECertDir,
+		spiffeServerIDs:        o.SPIFFEAuthorizedServerIDs,
+		otlpExporter:           otlpExp,
 	}, nil
 }
 
@@ -213,6 +247,54 @@ func (exp *FlowExporter) GetDenyConnStore() *connections.DenyConnectionStore {
 	return exp.denyConnStore
 }
 
+func (exp *FlowExporter) GetConntrackConnStore() *connections.ConntrackConnectionStore {
+	return exp.conntrackConnStore
+}
+
+// GetConnections returns a copy of the connections in the conntrack and deny connection stores which match the
+// filter. The connections which are ready to be deleted from the stores are skipped.
+func (exp *FlowExporter) GetConnections(filter *querier.FlowQueryFilter) []flowexporter.Connection {
+	var conns []flowexporter.Connection
+	collect := func(key flowexporter.ConnectionKey, conn *flowexporter.Connection) error {
+		if !conn.ReadyToDelete && connectionMatchesFilter(conn, filter) {
+			conns = append(conns, *conn)
+		}
+		return nil
+	}
+	exp.conntrackConnStore.ForAllConnectionsDo(collect)
+	exp.denyConnStore.ForAllConnectionsDo(collect)
+	return conns
+}
+
+func connectionMatchesFilter(conn *flowexporter.Connection, filter *querier.FlowQueryFilter) bool {
+	if filter == nil {
+		return true
+	}
+	if filter.Protocol != 0 && conn.FlowKey.Protocol != filter.Protocol {
+		return false
+	}
+	if filter.Namespace != "" {
+		matchesPod := func(namespace, name string) bool {
+			return namespace == filter.Namespace && (filter.Pod == "" || name == filter.Pod)
+		}
+		if !matchesPod(conn.SourcePodNamespace, conn.SourcePodName) && !matchesPod(conn.DestinationPodNamespace, conn.DestinationPodName) {
+			return false
+		}
+	}
+	if filter.NetworkPolicy != "" {
+		matchesPolicy := func(namespace, name string) bool {
+			if name == "" {
+				return false
+			}
+			return filter.NetworkPolicy == name || filter.NetworkPolicy == namespace+"/"+name
+		}
+		if !matchesPolicy(conn.IngressNetworkPolicyNamespace, conn.IngressNetworkPolicyName) && !matchesPolicy(conn.EgressNetworkPolicyNamespace, conn.EgressNetworkPolicyName) {
+			return false
+		}
+	}
+	return true
+}
+
 func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 	go exp.podStore.Run(stopCh)
 	// Start L7 connection flow socket
@@ -238,13 +320,19 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 	for {
 		select {
 		case <-stopCh:
-			if exp.process != nil {
-				exp.process.CloseConnToCollector()
-			}
+			exp.closeConnToCollector()
 			expireTimer.Stop()
 			return
 		case <-expireTimer.C:
-			if exp.process == nil {
+			if exp.process != nil && exp.svidRotated() {
+				klog.InfoS("SVID has been rotated, reconnecting to the flow collector")
+				exp.closeConnToCollector()
+			}
+			if exp.connectedToCollector() && exp.collectorReplicaChanged() {
+				klog.InfoS("Flow aggregator replica selected for the Node has changed, reconnecting to the flow collector")
+				exp.closeConnToCollector()
+			}
+			if !exp.connectedToCollector() {
 				ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 				err := exp.initFlowExporter(ctx)
 				cancel()
@@ -253,10 +341,7 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 					// There could be other errors while initializing flow exporter
 					// other than connecting to IPFIX collector, therefore closing
 					// the connection and resetting the process.
-					if exp.process != nil {
-						exp.process.CloseConnToCollector()
-						exp.process = nil
-					}
+					exp.closeConnToCollector()
 					// Initializing flow exporter fails, will retry in next cycle.
 					expireTimer.Reset(defaultTimeout)
 					continue
@@ -270,8 +355,7 @@ func (exp *FlowExporter) Run(stopCh <-chan struct{}) {
 				// If there is an error when sending flow records because of intermittent
 				// connectivity, we reset the connection to IPFIX collector and retry
 				// in the next export cycle to reinitialize the connection and send flow records.
-				exp.process.CloseConnToCollector()
-				exp.process = nil
+				exp.closeConnToCollector()
 				expireTimer.Reset(defaultTimeout)
 				continue
 			}
@@ -301,11 +385,39 @@ func (exp *FlowExporter) sendFlowRecords() (time.Duration, error) {
 	}
 	// Clear expiredConns slice after exporting. Allocated memory is kept.
 	exp.expiredConns = exp.expiredConns[:0]
+	if exp.otlpExporter != nil {
+		// OTLP records are sent in batches, once all the expired connections have been processed.
+		if err := exp.otlpExporter.flush(); err != nil {
+			return nextExpireTime, err
+		}
+	}
 	return nextExpireTime, nil
 }
 
+// connectedToCollector returns whether the flow exporter is currently connected to the flow collector.
+func (exp *FlowExporter) connectedToCollector() bool {
+	if exp.otlpExporter != nil {
+		return exp.otlpExporter.connected()
+	}
+	return exp.process != nil
+}
+
+// closeConnToCollector closes the connection to the flow collector, if any. A new connection will be established in
+// the next export cycle.
+func (exp *FlowExporter) closeConnToCollector() {
+	if exp.otlpExporter != nil {
+		exp.otlpExporter.close()
+		return
+	}
+	if exp.process != nil {
+		exp.process.CloseConnToCollector()
+		exp.process = nil
+	}
+}
+
 func (exp *FlowExporter) resolveCollectorAddress(ctx context.Context) error {
 	exp.exporterInput.CollectorAddress = ""
+	exp.shardedCollector = false
 	host, port, err := net.SplitHostPort(exp.collectorAddr)
 	if err != nil {
 		return err
@@ -322,7 +434,17 @@ func (exp *FlowExporter) resolveCollectorAddress(ctx context.Context) error {
 	if svc.Spec.ClusterIP == "" {
 		return fmt.Errorf("ClusterIP is not available for FlowAggregator Service: %s/%s", ns, name)
 	}
-	exp.exporterInput.CollectorAddress = net.JoinHostPort(svc.Spec.ClusterIP, port)
+	if svc.Spec.ClusterIP == corev1.ClusterIPNone {
+		replica, err := exp.selectCollectorReplica(ctx, ns, name)
+		if err != nil {
+			return err
+		}
+		exp.shardedCollector = true
+		exp.lastCollectorReplicaCheck = time.Now()
+		exp.exporterInput.CollectorAddress = net.JoinHostPort(replica, port)
+	} else {
+		exp.exporterInput.CollectorAddress = net.JoinHostPort(svc.Spec.ClusterIP, port)
+	}
 	if exp.exporterInput.TLSClientConfig != nil {
 		exp.exporterInput.TLSClientConfig.ServerName = fmt.Sprintf("%s.%s.svc", name, ns)
 	}
@@ -330,22 +452,49 @@ func (exp *FlowExporter) resolveCollectorAddress(ctx context.Context) error {
 	return nil
 }
 
+// collectorServerName returns the name used to verify the certificate of the flow collector: the DNS name of the
+// Service if the collector is provided as <Service namespace>/<Service name>, or the host otherwise.
+func collectorServerName(collectorAddr string) string {
+	host, _, err := net.SplitHostPort(collectorAddr)
+	if err != nil {
+		return ""
+	}
+	if ns, name := k8sutil.SplitNamespacedName(host); ns != "" {
+		return fmt.Sprintf("%s.%s.svc", name, ns)
+	}
+	return host
+}
+
 func (exp *FlowExporter) initFlowExporter(ctx context.Context) error {
 	if err := exp.resolveCollectorAddress(ctx); err != nil {
 		return err
 	}
+	if exp.otlpExporter != nil {
+		if err := exp.otlpExporter.connect(exp.exporterInput.CollectorAddress, collectorServerName(exp.collectorAddr)); err != nil {
+			return err
+		}
+		klog.V(2).InfoS("Initialized flow exporter for OTLP collector", "address", exp.exporterInput.CollectorAddress)
+		metrics.ReconnectionsToFlowCollector.Inc()
+		return nil
+	}
 	var err error
 	if exp.exporterInput.TLSClientConfig != nil {
 		tlsConfig := exp.exporterInput.TLSClientConfig
-		// if CA certificate, client certificate and key do not exist during initialization,
-		// it will retry to obtain the credentials in next export cycle
-		tlsConfig.CAData, err = getCACert(ctx, exp.k8sClient)
-		if err != nil {
-			return fmt.Errorf("cannot retrieve CA cert: %v", err)
-		}
-		tlsConfig.CertData, tlsConfig.KeyData, err = getClientCertKey(ctx, exp.k8sClient)
-		if err != nil {
-			return fmt.Errorf("cannot retrieve client cert and key: %v", err)
+		if exp.spiffeCertDir != "" {
+			if err := exp.loadSPIFFECredentials(ctx); err != nil {
+				return err
+			}
+		} else {
+			// if CA certificate, client certificate and key do not exist during initialization,
+			// it will retry to obtain the credentials in next export cycle
+			tlsConfig.CAData, err = getCACert(ctx, exp.k8sClient)
+			if err != nil {
+				return fmt.Errorf("cannot retrieve CA cert: %v", err)
+			}
+			tlsConfig.CertData, tlsConfig.KeyData, err = getClientCertKey(ctx, exp.k8sClient)
+			if err != nil {
+				return fmt.Errorf("cannot retrieve client cert and key: %v", err)
+			}
 		}
 		// TLS transport does not need any tempRefTimeout, so sending 0.
 		exp.exporterInput.TempRefTimeout = 0
@@ -684,7 +833,17 @@ func (exp *FlowExporter) fillEgressInfo(conn *flowexporter.Connection) {
 	}
 }
 
+// matchesNetworkPolicy returns whether the connection matched a NetworkPolicy rule in either direction. Connections
+// dropped because of the isolation of a Pod by a K8s NetworkPolicy have a rule action but no policy name.
+func matchesNetworkPolicy(conn *flowexporter.Connection) bool {
+	return conn.IngressNetworkPolicyName != "" || conn.EgressNetworkPolicyName != "" ||
+		conn.IngressNetworkPolicyRuleAction != 0 || conn.EgressNetworkPolicyRuleAction != 0
+}
+
 func (exp *FlowExporter) exportConn(conn *flowexporter.Connection) error {
+	if exp.policyFlowsOnly && !matchesNetworkPolicy(conn) {
+		return nil
+	}
 	conn.FlowType = exp.findFlowType(*conn)
 	if conn.FlowType == flowTypeUnsupported {
 		return nil
@@ -697,6 +856,10 @@ func (exp *FlowExporter) exportConn(conn *flowexporter.Connection) error {
 			return nil
 		}
 	}
+	if exp.otlpExporter != nil {
+		exp.otlpExporter.addConn(conn, exp.nodeName)
+		return nil
+	}
 	// TODO: more records per data set will be supported when go-ipfix supports size check when adding records
 	if err := exp.addConnToSet(conn); err != nil {
 		return err
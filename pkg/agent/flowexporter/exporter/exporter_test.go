@@ -32,16 +32,22 @@ import (
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/utils/ptr"
 
 	"antrea.io/antrea/pkg/agent/flowexporter"
 	"antrea.io/antrea/pkg/agent/flowexporter/connections"
 	connectionstest "antrea.io/antrea/pkg/agent/flowexporter/connections/testing"
 	"antrea.io/antrea/pkg/agent/metrics"
 	ipfixtest "antrea.io/antrea/pkg/ipfix/testing"
+	"antrea.io/antrea/pkg/querier"
 	queriertest "antrea.io/antrea/pkg/querier/testing"
+	"antrea.io/antrea/pkg/util/flowexport"
+	"antrea.io/antrea/pkg/util/spiffe"
+	spiffetesting "antrea.io/antrea/pkg/util/spiffe/testing"
 )
 
 const (
@@ -264,6 +270,23 @@ func testSendDataSet(t *testing.T, v4Enabled bool, v6Enabled bool) {
 	}
 }
 
+func TestFlowExporter_svidRotated(t *testing.T) {
+	ca, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	certDir := t.TempDir()
+	require.NoError(t, ca.WriteX509Source(certDir, "spiffe://example.org/ns/kube-system/sa/antrea-agent"))
+
+	exp := &FlowExporter{spiffeCertDir: certDir}
+	assert.False(t, exp.svidRotated(), "No SVID is used by the current connection")
+
+	exp.spiffeSource, err = spiffe.LoadX509Source(certDir)
+	require.NoError(t, err)
+	assert.False(t, exp.svidRotated())
+
+	require.NoError(t, ca.WriteX509Source(certDir, "spiffe://example.org/ns/kube-system/sa/antrea-agent"))
+	assert.True(t, exp.svidRotated())
+}
+
 func TestFlowExporter_resolveCollectorAddress(t *testing.T) {
 	ctx := context.Background()
 
@@ -289,6 +312,39 @@ func TestFlowExporter_resolveCollectorAddress(t *testing.T) {
 				// missing ClusterIP
 			},
 		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc-headless",
+				Namespace: "ns",
+			},
+			Spec: corev1.ServiceSpec{
+				Type:      corev1.ServiceTypeClusterIP,
+				ClusterIP: corev1.ClusterIPNone,
+			},
+		},
+		&discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc-headless-abcde",
+				Namespace: "ns",
+				Labels:    map[string]string{discoveryv1.LabelServiceName: "svc-headless"},
+			},
+			AddressType: discoveryv1.AddressTypeIPv4,
+			Endpoints: []discoveryv1.Endpoint{
+				{Addresses: []string{"10.10.0.11"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}},
+				{Addresses: []string{"10.10.0.12"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}},
+				{Addresses: []string{"10.10.0.13"}, Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}},
+			},
+		},
+		&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "svc-headless-no-endpoints",
+				Namespace: "ns",
+			},
+			Spec: corev1.ServiceSpec{
+				Type:      corev1.ServiceTypeClusterIP,
+				ClusterIP: corev1.ClusterIPNone,
+			},
+		},
 	)
 
 	testCases := []struct {
@@ -316,6 +372,18 @@ func TestFlowExporter_resolveCollectorAddress(t *testing.T) {
 			expectedAddr:       "10.96.1.201:4739",
 			expectedServerName: "svc1.ns.svc",
 		},
+		{
+			name:               "headless Service with TLS",
+			inputAddr:          "ns/svc-headless:4739",
+			withTLS:            true,
+			expectedAddr:       net.JoinHostPort(flowexport.SelectShard("node1", []string{"10.10.0.11", "10.10.0.12"}), "4739"),
+			expectedServerName: "svc-headless.ns.svc",
+		},
+		{
+			name:        "headless Service without ready endpoints",
+			inputAddr:   "ns/svc-headless-no-endpoints:4739",
+			expectedErr: "no ready endpoint for FlowAggregator Service",
+		},
 		{
 			name:        "Service without ClusterIP",
 			inputAddr:   "ns/svc2:4739",
@@ -337,6 +405,7 @@ func TestFlowExporter_resolveCollectorAddress(t *testing.T) {
 					CollectorProtocol: "tcp",
 				},
 				k8sClient: k8sClient,
+				nodeName:  "node1",
 			}
 			if tc.withTLS {
 				exp.exporterInput.TLSClientConfig = &exporter.ExporterTLSClientConfig{}
@@ -802,6 +871,59 @@ func TestFlowExporter_findFlowType(t *testing.T) {
 	}
 }
 
+func TestFlowExporter_exportConnPolicyFlowsOnly(t *testing.T) {
+	for _, tc := range []struct {
+		name             string
+		conn             flowexporter.Connection
+		expectedExported bool
+	}{
+		{
+			name:             "no policy",
+			conn:             flowexporter.Connection{SourcePodName: "podA", DestinationPodName: "podB"},
+			expectedExported: false,
+		},
+		{
+			name:             "allowed by ingress policy",
+			conn:             flowexporter.Connection{SourcePodName: "podA", DestinationPodName: "podB", IngressNetworkPolicyName: "np1", IngressNetworkPolicyRuleAction: ipfixregistry.NetworkPolicyRuleActionAllow},
+			expectedExported: true,
+		},
+		{
+			name:             "dropped by K8s NetworkPolicy isolation",
+			conn:             flowexporter.Connection{SourcePodName: "podA", DestinationPodName: "podB", EgressNetworkPolicyRuleAction: ipfixregistry.NetworkPolicyRuleActionDrop},
+			expectedExported: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedExported, matchesNetworkPolicy(&tc.conn))
+			ctrl := gomock.NewController(t)
+			mockIPFIXExpProc := ipfixtest.NewMockIPFIXExportingProcess(ctrl)
+			mockDataSet := ipfixentitiestesting.NewMockSet(ctrl)
+			flowExp := &FlowExporter{
+				process:             mockIPFIXExpProc,
+				ipfixSet:            mockDataSet,
+				elementsListv4:      getElemList(IANAInfoElementsIPv4, AntreaInfoElementsIPv4),
+				templateIDv4:        testTemplateIDv4,
+				v4Enabled:           true,
+				isNetworkPolicyOnly: true,
+				policyFlowsOnly:     true,
+			}
+			tc.conn.FlowKey = flowexporter.Tuple{SourceAddress: netip.MustParseAddr("1.2.3.4"), DestinationAddress: netip.MustParseAddr("4.3.2.1"), Protocol: 6, SourcePort: 65280, DestinationPort: 255}
+			if tc.expectedExported {
+				mockDataSet.EXPECT().ResetSet()
+				mockDataSet.EXPECT().PrepareSet(ipfixentities.Data, flowExp.templateIDv4).Return(nil)
+				mockDataSet.EXPECT().AddRecordV2(gomock.Any(), flowExp.templateIDv4).Return(nil)
+				mockIPFIXExpProc.EXPECT().SendSet(mockDataSet).Return(0, nil)
+			}
+			require.NoError(t, flowExp.exportConn(&tc.conn))
+			if tc.expectedExported {
+				assert.Equal(t, uint64(1), flowExp.numDataSetsSent)
+			} else {
+				assert.Equal(t, uint64(0), flowExp.numDataSetsSent)
+			}
+		})
+	}
+}
+
 func TestFlowExporter_fillEgressInfo(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	testCases := []struct {
@@ -854,3 +976,87 @@ func TestFlowExporter_fillEgressInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestFlowExporter_GetConnections(t *testing.T) {
+	o := &flowexporter.FlowExporterOptions{
+		ActiveFlowTimeout:      testActiveFlowTimeout,
+		IdleFlowTimeout:        testIdleFlowTimeout,
+		StaleConnectionTimeout: 1,
+		PollInterval:           1,
+	}
+	flowExp := &FlowExporter{
+		conntrackConnStore: connections.NewConntrackConnectionStore(nil, true, false, nil, nil, nil, nil, o),
+		denyConnStore:      connections.NewDenyConnectionStore(nil, nil, o),
+	}
+	tcpConn := flowexporter.Connection{
+		FlowKey:                       flowexporter.Tuple{SourceAddress: netip.MustParseAddr("10.10.0.1"), DestinationAddress: netip.MustParseAddr("10.10.0.2"), Protocol: 6, SourcePort: 34567, DestinationPort: 80},
+		SourcePodNamespace:            "ns1",
+		SourcePodName:                 "client",
+		DestinationPodNamespace:       "ns2",
+		DestinationPodName:            "web",
+		IngressNetworkPolicyNamespace: "ns2",
+		IngressNetworkPolicyName:      "allow-web",
+	}
+	udpConn := flowexporter.Connection{
+		FlowKey:            flowexporter.Tuple{SourceAddress: netip.MustParseAddr("10.10.0.1"), DestinationAddress: netip.MustParseAddr("10.96.0.10"), Protocol: 17, SourcePort: 34567, DestinationPort: 53},
+		SourcePodNamespace: "ns1",
+		SourcePodName:      "client",
+	}
+	deletedConn := flowexporter.Connection{
+		FlowKey:            flowexporter.Tuple{SourceAddress: netip.MustParseAddr("10.10.0.1"), DestinationAddress: netip.MustParseAddr("10.10.0.2"), Protocol: 6, SourcePort: 34568, DestinationPort: 80},
+		SourcePodNamespace: "ns1",
+		SourcePodName:      "client",
+		ReadyToDelete:      true,
+	}
+	deniedConn := flowexporter.Connection{
+		FlowKey:                       flowexporter.Tuple{SourceAddress: netip.MustParseAddr("10.10.0.3"), DestinationAddress: netip.MustParseAddr("10.10.0.2"), Protocol: 6, SourcePort: 34569, DestinationPort: 80},
+		SourcePodNamespace:            "ns3",
+		SourcePodName:                 "attacker",
+		EgressNetworkPolicyName:       "acnp-deny",
+		EgressNetworkPolicyRuleAction: ipfixregistry.NetworkPolicyRuleActionDrop,
+	}
+	for _, conn := range []flowexporter.Connection{tcpConn, udpConn, deletedConn} {
+		flowExp.conntrackConnStore.AddConnToMap(&conn.FlowKey, &conn)
+	}
+	flowExp.denyConnStore.AddConnToMap(&deniedConn.FlowKey, &deniedConn)
+
+	for _, tc := range []struct {
+		name          string
+		filter        *querier.FlowQueryFilter
+		expectedConns []flowexporter.Connection
+	}{
+		{
+			name:          "no filter",
+			expectedConns: []flowexporter.Connection{tcpConn, udpConn, deniedConn},
+		},
+		{
+			name:          "Namespace",
+			filter:        &querier.FlowQueryFilter{Namespace: "ns2"},
+			expectedConns: []flowexporter.Connection{tcpConn},
+		},
+		{
+			name:          "Pod",
+			filter:        &querier.FlowQueryFilter{Namespace: "ns1", Pod: "client", Protocol: 17},
+			expectedConns: []flowexporter.Connection{udpConn},
+		},
+		{
+			name:          "unknown Pod",
+			filter:        &querier.FlowQueryFilter{Namespace: "ns1", Pod: "web"},
+			expectedConns: nil,
+		},
+		{
+			name:          "namespaced NetworkPolicy",
+			filter:        &querier.FlowQueryFilter{NetworkPolicy: "ns2/allow-web"},
+			expectedConns: []flowexporter.Connection{tcpConn},
+		},
+		{
+			name:          "cluster-scoped NetworkPolicy",
+			filter:        &querier.FlowQueryFilter{NetworkPolicy: "acnp-deny", Protocol: 6},
+			expectedConns: []flowexporter.Connection{deniedConn},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ElementsMatch(t, tc.expectedConns, flowExp.GetConnections(tc.filter))
+		})
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"time"
+
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/util/ip"
+	"antrea.io/antrea/pkg/util/podstore"
+)
+
+const (
+	otlpScopeName     = "antrea.io/flowexporter"
+	otlpExportTimeout = 10 * time.Second
+	// otlpFlowEventName is the body of all the exported log records, so that flow records can easily be told apart
+	// from other logs received by the collector.
+	otlpFlowEventName = "antrea.flow"
+)
+
+// otlpExporter exports flow records as OTLP log records to an OpenTelemetry collector over gRPC. Records are buffered
+// by addConn and sent in a single request by flush.
+type otlpExporter struct {
+	insecure  bool
+	podLabels bool
+	podStore  podstore.Interface
+	// podInterfaceStatsProvider is only set on Windows. It provides the counters of the HNS endpoints of the local
+	// Pods, which are added to the attributes of the Pods.
+	podInterfaceStatsProvider PodInterfaceStatsProvider
+	resource                  *resourcepb.Resource
+	conn                      *grpc.ClientConn
+	client                    collogspb.LogsServiceClient
+	records                   []*logspb.LogRecord
+}
+
+func newOTLPExporter(nodeName string, podStore podstore.Interface, podInterfaceStatsProvider PodInterfaceStatsProvider, o *flowexporter.OTLPOptions) *otlpExporter {
+	return &otlpExporter{
+		insecure:                  o.Insecure,
+		podLabels:                 o.PodLabels,
+		podStore:                  podStore,
+		podInterfaceStatsProvider: podInterfaceStatsProvider,
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{
+				stringAttr("service.name", "antrea-agent"),
+				stringAttr("k8s.node.name", nodeName),
+			},
+		},
+	}
+}
+
+// connect creates the gRPC connection to the collector. serverName is used to verify the certificate of the collector
+// when TLS is used.
+func (e *otlpExporter) connect(address, serverName string) error {
+	creds := insecure.NewCredentials()
+	if !e.insecure {
+		creds = credentials.NewTLS(&tls.Config{ServerName: serverName, MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return fmt.Errorf("error when creating gRPC connection to OTLP collector %s: %w", address, err)
+	}
+	e.conn = conn
+	e.client = collogspb.NewLogsServiceClient(conn)
+	return nil
+}
+
+func (e *otlpExporter) connected() bool {
+	return e.conn != nil
+}
+
+func (e *otlpExporter) close() {
+	if e.conn != nil {
+		e.conn.Close()
+	}
+	e.conn = nil
+	e.client = nil
+	e.records = e.records[:0]
+}
+
+// flush sends all the buffered records to the collector in a single request.
+func (e *otlpExporter) flush() error {
+	if len(e.records) == 0 {
+		return nil
+	}
+	req := &collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: e.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: otlpScopeName},
+				LogRecords: e.records,
+			}},
+		}},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), otlpExportTimeout)
+	defer cancel()
+	resp, err := e.client.Export(ctx, req)
+	if err != nil {
+		return fmt.Errorf("error when exporting %d flow records to OTLP collector: %w", len(e.records), err)
+	}
+	if partialSuccess := resp.GetPartialSuccess(); partialSuccess != nil && partialSuccess.RejectedLogRecords > 0 {
+		klog.InfoS("Some flow records were rejected by the OTLP collector", "rejected", partialSuccess.RejectedLogRecords, "message", partialSuccess.ErrorMessage)
+	}
+	if klog.V(5).Enabled() {
+		klog.InfoS("Flow records exported to OTLP collector", "count", len(e.records))
+	}
+	e.records = e.records[:0]
+	return nil
+}
+
+// addConn converts the connection to a log record and buffers it until the next call to flush.
+func (e *otlpExporter) addConn(conn *flowexporter.Connection, nodeName string) {
+	e.records = append(e.records, e.connToLogRecord(conn, nodeName, time.Now()))
+}
+
+func (e *otlpExporter) connToLogRecord(conn *flowexporter.Connection, nodeName string, observedTime time.Time) *logspb.LogRecord {
+	attrs := []*commonpb.KeyValue{
+		stringAttr("source.address", conn.FlowKey.SourceAddress.String()),
+		intAttr("source.port", int64(conn.FlowKey.SourcePort)),
+		stringAttr("destination.address", conn.FlowKey.DestinationAddress.String()),
+		intAttr("destination.port", int64(conn.FlowKey.DestinationPort)),
+		stringAttr("network.transport", ip.IPProtocolNumberToString(conn.FlowKey.Protocol, "Unknown Protocol")),
+		intAttr("flow.start_time_unix_seconds", conn.StartTime.Unix()),
+		intAttr("flow.end_time_unix_seconds", conn.StopTime.Unix()),
+		stringAttr("flow.end_reason", flowEndReason(conn)),
+		stringAttr("flow.type", flowTypeToString(conn.FlowType)),
+		intAttr("flow.packets", int64(conn.OriginalPackets)),
+		intAttr("flow.bytes", int64(conn.OriginalBytes)),
+		intAttr("flow.packets_delta", int64(conn.OriginalPackets)-int64(conn.PrevPackets)),
+		intAttr("flow.bytes_delta", int64(conn.OriginalBytes)-int64(conn.PrevBytes)),
+		intAttr("flow.reverse_packets", int64(conn.ReversePackets)),
+		intAttr("flow.reverse_bytes", int64(conn.ReverseBytes)),
+		intAttr("flow.reverse_packets_delta", int64(conn.ReversePackets)-int64(conn.PrevReversePackets)),
+		intAttr("flow.reverse_bytes_delta", int64(conn.ReverseBytes)-int64(conn.PrevReverseBytes)),
+	}
+	attrs = e.appendPodAttrs(attrs, "source", conn.SourcePodNamespace, conn.SourcePodName, conn.FlowKey.SourceAddress.String(), nodeName, conn.StartTime)
+	attrs = e.appendPodAttrs(attrs, "destination", conn.DestinationPodNamespace, conn.DestinationPodName, conn.FlowKey.DestinationAddress.String(), nodeName, conn.StartTime)
+	if conn.DestinationServicePortName != "" {
+		attrs = append(attrs,
+			stringAttr("destination.k8s.service.port_name", conn.DestinationServicePortName),
+			stringAttr("destination.k8s.service.address", conn.OriginalDestinationAddress.String()),
+			intAttr("destination.k8s.service.port", int64(conn.OriginalDestinationPort)),
+		)
+	}
+	attrs = appendPolicyAttrs(attrs, "ingress", conn.IngressNetworkPolicyNamespace, conn.IngressNetworkPolicyName, conn.IngressNetworkPolicyType, conn.IngressNetworkPolicyRuleName, conn.IngressNetworkPolicyRuleAction)
+	attrs = appendPolicyAttrs(attrs, "egress", conn.EgressNetworkPolicyNamespace, conn.EgressNetworkPolicyName, conn.EgressNetworkPolicyType, conn.EgressNetworkPolicyRuleName, conn.EgressNetworkPolicyRuleAction)
+	attrs = appendNonEmptyAttrs(attrs,
+		"tcp.state", conn.TCPState,
+		"antrea.egress.name", conn.EgressName,
+		"antrea.egress.ip", conn.EgressIP,
+		"antrea.egress.node_name", conn.EgressNodeName,
+		"app_protocol.name", conn.AppProtocolName,
+		"http.values", conn.HttpVals,
+	)
+	return &logspb.LogRecord{
+		TimeUnixNano:         uint64(conn.StopTime.UnixNano()),
+		ObservedTimeUnixNano: uint64(observedTime.UnixNano()),
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: otlpFlowEventName}},
+		Attributes:           attrs,
+	}
+}
+
+// appendPodAttrs appends the attributes of the source or destination Pod of the flow, if it is a local Pod.
+func (e *otlpExporter) appendPodAttrs(attrs []*commonpb.KeyValue, prefix, namespace, name, podIP, nodeName string, startTime time.Time) []*commonpb.KeyValue {
+	if name == "" {
+		return attrs
+	}
+	attrs = append(attrs,
+		stringAttr(prefix+".k8s.namespace.name", namespace),
+		stringAttr(prefix+".k8s.pod.name", name),
+		stringAttr(prefix+".k8s.node.name", nodeName),
+	)
+	if e.podInterfaceStatsProvider != nil {
+		if stats, ok := e.podInterfaceStatsProvider.GetPodInterfaceStats(namespace, name); ok {
+			attrs = append(attrs,
+				intAttr(prefix+".k8s.pod.interface.packets_received", stats.PacketsReceived),
+				intAttr(prefix+".k8s.pod.interface.packets_sent", stats.PacketsSent),
+				intAttr(prefix+".k8s.pod.interface.dropped_packets_incoming", stats.DroppedPacketsIncoming),
+				intAttr(prefix+".k8s.pod.interface.dropped_packets_outgoing", stats.DroppedPacketsOutgoing),
+			)
+		}
+	}
+	if !e.podLabels {
+		return attrs
+	}
+	pod, ok := e.podStore.GetPodByIPAndTime(podIP, startTime)
+	if !ok {
+		return attrs
+	}
+	for key, value := range pod.Labels {
+		attrs = append(attrs, stringAttr(prefix+".k8s.pod.label."+key, value))
+	}
+	return attrs
+}
+
+func appendPolicyAttrs(attrs []*commonpb.KeyValue, direction, namespace, name string, policyType uint8, ruleName string, ruleAction uint8) []*commonpb.KeyValue {
+	prefix := "antrea." + direction + "_network_policy."
+	if name == "" && ruleAction == ipfixregistry.NetworkPolicyRuleActionNoAction {
+		return attrs
+	}
+	attrs = appendNonEmptyAttrs(attrs,
+		prefix+"name", name,
+		prefix+"namespace", namespace,
+		prefix+"type", policyTypeToString(policyType),
+		prefix+"rule_name", ruleName,
+	)
+	return append(attrs, stringAttr(prefix+"rule_action", flowexporter.RuleActionToString(ruleAction)))
+}
+
+// appendNonEmptyAttrs appends string attributes provided as key-value pairs, skipping the ones with an empty value.
+func appendNonEmptyAttrs(attrs []*commonpb.KeyValue, kvs ...string) []*commonpb.KeyValue {
+	for i := 0; i+1 < len(kvs); i += 2 {
+		if kvs[i+1] != "" {
+			attrs = append(attrs, stringAttr(kvs[i], kvs[i+1]))
+		}
+	}
+	return attrs
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+func intAttr(key string, value int64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value}}}
+}
+
+func flowEndReason(conn *flowexporter.Connection) string {
+	if flowexporter.IsConnectionDying(conn) {
+		return "EndOfFlow"
+	} else if conn.IsActive {
+		return "ActiveTimeout"
+	}
+	return "IdleTimeout"
+}
+
+func flowTypeToString(flowType uint8) string {
+	switch flowType {
+	case ipfixregistry.FlowTypeIntraNode:
+		return "IntraNode"
+	case ipfixregistry.FlowTypeInterNode:
+		return "InterNode"
+	case ipfixregistry.FlowTypeToExternal:
+		return "ToExternal"
+	case ipfixregistry.FlowTypeFromExternal:
+		return "FromExternal"
+	default:
+		return "Unknown"
+	}
+}
+
+func policyTypeToString(policyType uint8) string {
+	switch policyType {
+	case ipfixregistry.PolicyTypeK8sNetworkPolicy:
+		return "K8sNetworkPolicy"
+	case ipfixregistry.PolicyTypeAntreaNetworkPolicy:
+		return "AntreaNetworkPolicy"
+	case ipfixregistry.PolicyTypeAntreaClusterNetworkPolicy:
+		return "AntreaClusterNetworkPolicy"
+	default:
+		return ""
+	}
+}
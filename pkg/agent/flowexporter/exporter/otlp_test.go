@@ -0,0 +1,214 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	podstoretest "antrea.io/antrea/pkg/util/podstore/testing"
+)
+
+type fakeLogsServer struct {
+	collogspb.UnimplementedLogsServiceServer
+	mutex    sync.Mutex
+	requests []*collogspb.ExportLogsServiceRequest
+}
+
+func (s *fakeLogsServer) Export(_ context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests = append(s.requests, req)
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func attrsToMap(attrs []*commonpb.KeyValue) map[string]interface{} {
+	m := make(map[string]interface{}, len(attrs))
+	for _, attr := range attrs {
+		switch v := attr.Value.Value.(type) {
+		case *commonpb.AnyValue_StringValue:
+			m[attr.Key] = v.StringValue
+		case *commonpb.AnyValue_IntValue:
+			m[attr.Key] = v.IntValue
+		}
+	}
+	return m
+}
+
+func newTestOTLPConnection() *flowexporter.Connection {
+	startTime := time.Unix(1700000000, 0)
+	return &flowexporter.Connection{
+		StartTime: startTime,
+		StopTime:  startTime.Add(10 * time.Second),
+		IsActive:  true,
+		IsPresent: true,
+		FlowKey: flowexporter.Tuple{
+			SourceAddress:      netip.MustParseAddr("10.10.0.2"),
+			DestinationAddress: netip.MustParseAddr("10.10.1.3"),
+			Protocol:           6,
+			SourcePort:         45000,
+			DestinationPort:    8080,
+		},
+		OriginalPackets:                10,
+		OriginalBytes:                  1000,
+		PrevPackets:                    4,
+		PrevBytes:                      400,
+		ReversePackets:                 8,
+		ReverseBytes:                   800,
+		SourcePodNamespace:             "ns1",
+		SourcePodName:                  "client",
+		DestinationServicePortName:     "ns2/server:http",
+		OriginalDestinationAddress:     netip.MustParseAddr("10.96.0.10"),
+		OriginalDestinationPort:        80,
+		EgressNetworkPolicyName:        "allow-http",
+		EgressNetworkPolicyNamespace:   "ns1",
+		EgressNetworkPolicyType:        ipfixregistry.PolicyTypeAntreaNetworkPolicy,
+		EgressNetworkPolicyRuleName:    "rule1",
+		EgressNetworkPolicyRuleAction:  ipfixregistry.NetworkPolicyRuleActionAllow,
+		IngressNetworkPolicyRuleAction: ipfixregistry.NetworkPolicyRuleActionNoAction,
+		TCPState:                       "ESTABLISHED",
+		FlowType:                       ipfixregistry.FlowTypeInterNode,
+	}
+}
+
+func TestOTLPConnToLogRecord(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	podStore := podstoretest.NewMockInterface(ctrl)
+	conn := newTestOTLPConnection()
+	podStore.EXPECT().GetPodByIPAndTime("10.10.0.2", conn.StartTime).Return(&corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "client", Labels: map[string]string{"app": "client"}},
+	}, true)
+
+	e := newOTLPExporter("node1", podStore, nil, &flowexporter.OTLPOptions{PodLabels: true})
+	observedTime := time.Unix(1700000020, 0)
+	record := e.connToLogRecord(conn, "node1", observedTime)
+
+	assert.Equal(t, uint64(conn.StopTime.UnixNano()), record.TimeUnixNano)
+	assert.Equal(t, uint64(observedTime.UnixNano()), record.ObservedTimeUnixNano)
+	assert.Equal(t, otlpFlowEventName, record.Body.GetStringValue())
+	assert.Equal(t, map[string]interface{}{
+		"source.address":                           "10.10.0.2",
+		"source.port":                              int64(45000),
+		"destination.address":                      "10.10.1.3",
+		"destination.port":                         int64(8080),
+		"network.transport":                        "TCP",
+		"flow.start_time_unix_seconds":             int64(1700000000),
+		"flow.end_time_unix_seconds":               int64(1700000010),
+		"flow.end_reason":                          "ActiveTimeout",
+		"flow.type":                                "InterNode",
+		"flow.packets":                             int64(10),
+		"flow.bytes":                               int64(1000),
+		"flow.packets_delta":                       int64(6),
+		"flow.bytes_delta":                         int64(600),
+		"flow.reverse_packets":                     int64(8),
+		"flow.reverse_bytes":                       int64(800),
+		"flow.reverse_packets_delta":               int64(8),
+		"flow.reverse_bytes_delta":                 int64(800),
+		"source.k8s.namespace.name":                "ns1",
+		"source.k8s.pod.name":                      "client",
+		"source.k8s.node.name":                     "node1",
+		"source.k8s.pod.label.app":                 "client",
+		"destination.k8s.service.port_name":        "ns2/server:http",
+		"destination.k8s.service.address":          "10.96.0.10",
+		"destination.k8s.service.port":             int64(80),
+		"antrea.egress_network_policy.name":        "allow-http",
+		"antrea.egress_network_policy.namespace":   "ns1",
+		"antrea.egress_network_policy.type":        "AntreaNetworkPolicy",
+		"antrea.egress_network_policy.rule_name":   "rule1",
+		"antrea.egress_network_policy.rule_action": "Allow",
+		"tcp.state":                                "ESTABLISHED",
+	}, attrsToMap(record.Attributes))
+}
+
+type fakePodInterfaceStatsProvider map[string]*statsv1alpha1.PodInterfaceStats
+
+func (p fakePodInterfaceStatsProvider) GetPodInterfaceStats(namespace, name string) (*statsv1alpha1.PodInterfaceStats, bool) {
+	stats, ok := p[namespace+"/"+name]
+	return stats, ok
+}
+
+func TestOTLPConnToLogRecordWithInterfaceStats(t *testing.T) {
+	conn := newTestOTLPConnection()
+	provider := fakePodInterfaceStatsProvider{
+		"ns1/client": {PacketsReceived: 8, PacketsSent: 12, DroppedPacketsIncoming: 1, DroppedPacketsOutgoing: 2},
+	}
+	e := newOTLPExporter("node1", nil, provider, &flowexporter.OTLPOptions{})
+	record := e.connToLogRecord(conn, "node1", time.Unix(1700000020, 0))
+
+	attrs := attrsToMap(record.Attributes)
+	assert.Equal(t, int64(8), attrs["source.k8s.pod.interface.packets_received"])
+	assert.Equal(t, int64(12), attrs["source.k8s.pod.interface.packets_sent"])
+	assert.Equal(t, int64(1), attrs["source.k8s.pod.interface.dropped_packets_incoming"])
+	assert.Equal(t, int64(2), attrs["source.k8s.pod.interface.dropped_packets_outgoing"])
+	assert.NotContains(t, attrs, "destination.k8s.pod.interface.packets_received")
+}
+
+func TestOTLPExporterFlush(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	logsServer := &fakeLogsServer{}
+	collogspb.RegisterLogsServiceServer(server, logsServer)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	e := newOTLPExporter("node1", nil, nil, &flowexporter.OTLPOptions{Insecure: true})
+	require.NoError(t, e.connect(listener.Addr().String(), ""))
+	defer e.close()
+	assert.True(t, e.connected())
+
+	// Nothing is sent when there is no record.
+	require.NoError(t, e.flush())
+	conn := newTestOTLPConnection()
+	e.addConn(conn, "node1")
+	e.addConn(conn, "node1")
+	require.NoError(t, e.flush())
+	assert.Empty(t, e.records)
+
+	logsServer.mutex.Lock()
+	defer logsServer.mutex.Unlock()
+	require.Len(t, logsServer.requests, 1)
+	resourceLogs := logsServer.requests[0].ResourceLogs
+	require.Len(t, resourceLogs, 1)
+	assert.Equal(t, map[string]interface{}{
+		"service.name":  "antrea-agent",
+		"k8s.node.name": "node1",
+	}, attrsToMap(resourceLogs[0].Resource.Attributes))
+	require.Len(t, resourceLogs[0].ScopeLogs, 1)
+	assert.Equal(t, otlpScopeName, resourceLogs[0].ScopeLogs[0].Scope.Name)
+	assert.Len(t, resourceLogs[0].ScopeLogs[0].LogRecords, 2)
+}
+
+func TestCollectorServerName(t *testing.T) {
+	assert.Equal(t, "otel-collector.observability.svc", collectorServerName("observability/otel-collector:4317"))
+	assert.Equal(t, "collector.example.com", collectorServerName("collector.example.com:4317"))
+	assert.Equal(t, "fd00::1", collectorServerName("[fd00::1]:4317"))
+}
@@ -0,0 +1,70 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"sync"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/querier"
+)
+
+// Provider provides the running FlowExporter to the components which consume its connections. A new FlowExporter is
+// created each time the FlowExporter feature gate is enabled at runtime, as a stopped FlowExporter cannot be run
+// again, so these components must not keep a reference to it.
+type Provider struct {
+	mutex        sync.RWMutex
+	flowExporter *FlowExporter
+}
+
+var _ querier.AgentFlowInfoQuerier = new(Provider)
+
+func NewProvider() *Provider {
+	return &Provider{}
+}
+
+// Set sets the running FlowExporter, or nil if the FlowExporter has been stopped.
+func (p *Provider) Set(flowExporter *FlowExporter) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.flowExporter = flowExporter
+}
+
+// Get returns the running FlowExporter, or nil if the FlowExporter is not running.
+func (p *Provider) Get() *FlowExporter {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	return p.flowExporter
+}
+
+// GetConnections returns the connections of the running FlowExporter which match the filter, or nil if the
+// FlowExporter is not running.
+func (p *Provider) GetConnections(filter *querier.FlowQueryFilter) []flowexporter.Connection {
+	exp := p.Get()
+	if exp == nil {
+		return nil
+	}
+	return exp.GetConnections(filter)
+}
+
+// ForAllConnectionsDo executes the callback for each connection in the conntrack connection store of the running
+// FlowExporter, if any.
+func (p *Provider) ForAllConnectionsDo(callback flowexporter.ConnectionMapCallBack) error {
+	exp := p.Get()
+	if exp == nil {
+		return nil
+	}
+	return exp.GetConntrackConnStore().ForAllConnectionsDo(callback)
+}
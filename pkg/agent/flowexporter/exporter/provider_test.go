@@ -0,0 +1,68 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	"antrea.io/antrea/pkg/agent/flowexporter/connections"
+)
+
+func TestProvider(t *testing.T) {
+	o := &flowexporter.FlowExporterOptions{
+		ActiveFlowTimeout:      testActiveFlowTimeout,
+		IdleFlowTimeout:        testIdleFlowTimeout,
+		StaleConnectionTimeout: 1,
+		PollInterval:           1,
+	}
+	conn := flowexporter.Connection{
+		FlowKey:            flowexporter.Tuple{SourceAddress: netip.MustParseAddr("10.10.0.1"), DestinationAddress: netip.MustParseAddr("10.10.0.2"), Protocol: 6, SourcePort: 34567, DestinationPort: 80},
+		SourcePodNamespace: "ns1",
+		SourcePodName:      "client",
+	}
+	countConns := func(p *Provider) int {
+		count := 0
+		require.NoError(t, p.ForAllConnectionsDo(func(key flowexporter.ConnectionKey, conn *flowexporter.Connection) error {
+			count++
+			return nil
+		}))
+		return count
+	}
+
+	p := NewProvider()
+	assert.Nil(t, p.Get())
+	assert.Nil(t, p.GetConnections(nil))
+	assert.Equal(t, 0, countConns(p))
+
+	flowExp := &FlowExporter{
+		conntrackConnStore: connections.NewConntrackConnectionStore(nil, true, false, nil, nil, nil, nil, o),
+		denyConnStore:      connections.NewDenyConnectionStore(nil, nil, o),
+	}
+	flowExp.conntrackConnStore.AddConnToMap(&conn.FlowKey, &conn)
+	p.Set(flowExp)
+	assert.Same(t, flowExp, p.Get())
+	assert.Equal(t, []flowexporter.Connection{conn}, p.GetConnections(nil))
+	assert.Equal(t, 1, countConns(p))
+
+	// The connections of a stopped FlowExporter are no longer provided.
+	p.Set(nil)
+	assert.Nil(t, p.GetConnections(nil))
+	assert.Equal(t, 0, countConns(p))
+}
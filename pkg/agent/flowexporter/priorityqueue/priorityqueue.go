@@ -30,18 +30,39 @@ type ExpirePriorityQueue struct {
 	items             []*flowexporter.ItemToExpire
 	ActiveFlowTimeout time.Duration
 	IdleFlowTimeout   time.Duration
-	KeyToItem         map[flowexporter.ConnectionKey]*flowexporter.ItemToExpire
+	// TimeoutRules override ActiveFlowTimeout and IdleFlowTimeout for the
+	// connections they match. The first matching rule is used.
+	TimeoutRules []flowexporter.FlowExportTimeoutRule
+	KeyToItem    map[flowexporter.ConnectionKey]*flowexporter.ItemToExpire
 }
 
-func NewExpirePriorityQueue(activeFlowTimeout time.Duration, idleFlowTimeout time.Duration) *ExpirePriorityQueue {
+func NewExpirePriorityQueue(activeFlowTimeout time.Duration, idleFlowTimeout time.Duration, timeoutRules ...flowexporter.FlowExportTimeoutRule) *ExpirePriorityQueue {
 	return &ExpirePriorityQueue{
 		items:             make([]*flowexporter.ItemToExpire, 0),
 		ActiveFlowTimeout: activeFlowTimeout,
 		IdleFlowTimeout:   idleFlowTimeout,
+		TimeoutRules:      timeoutRules,
 		KeyToItem:         make(map[flowexporter.ConnectionKey]*flowexporter.ItemToExpire),
 	}
 }
 
+// GetFlowTimeouts returns the active and idle flow export timeouts of the
+// connection, which are set by the first matching timeout rule if any.
+func (pq *ExpirePriorityQueue) GetFlowTimeouts(conn *flowexporter.Connection) (time.Duration, time.Duration) {
+	for i := range pq.TimeoutRules {
+		if pq.TimeoutRules[i].Matches(conn) {
+			return pq.TimeoutRules[i].ActiveFlowTimeout, pq.TimeoutRules[i].IdleFlowTimeout
+		}
+	}
+	return pq.ActiveFlowTimeout, pq.IdleFlowTimeout
+}
+
+// GetIdleFlowTimeout returns the idle flow export timeout of the connection.
+func (pq *ExpirePriorityQueue) GetIdleFlowTimeout(conn *flowexporter.Connection) time.Duration {
+	_, idleFlowTimeout := pq.GetFlowTimeouts(conn)
+	return idleFlowTimeout
+}
+
 func (pq *ExpirePriorityQueue) Len() int {
 	return len(pq.items)
 }
@@ -130,10 +151,11 @@ func (pq *ExpirePriorityQueue) GetExpiryFromExpirePriorityQueue() time.Duration
 // has the same connKey, it will be overwritten by the new item.
 func (pq *ExpirePriorityQueue) WriteItemToQueue(connKey flowexporter.ConnectionKey, conn *flowexporter.Connection) {
 	currTime := time.Now()
+	activeFlowTimeout, idleFlowTimeout := pq.GetFlowTimeouts(conn)
 	pqItem := &flowexporter.ItemToExpire{
 		Conn:             conn,
-		ActiveExpireTime: currTime.Add(pq.ActiveFlowTimeout),
-		IdleExpireTime:   currTime.Add(pq.IdleFlowTimeout),
+		ActiveExpireTime: currTime.Add(activeFlowTimeout),
+		IdleExpireTime:   currTime.Add(idleFlowTimeout),
 	}
 	// If connKey exists in pq, it is removed first to avoid having multiple pqItems with same key
 	// in the queue, which can cause memory leak as the previous one can't be updated or removed.
@@ -143,7 +165,8 @@ func (pq *ExpirePriorityQueue) WriteItemToQueue(connKey flowexporter.ConnectionK
 }
 
 func (pq *ExpirePriorityQueue) ResetActiveExpireTimeAndPush(pqItem *flowexporter.ItemToExpire, currTime time.Time) {
-	pqItem.ActiveExpireTime = currTime.Add(pq.ActiveFlowTimeout)
+	activeFlowTimeout, _ := pq.GetFlowTimeouts(pqItem.Conn)
+	pqItem.ActiveExpireTime = currTime.Add(activeFlowTimeout)
 	heap.Push(pq, pqItem)
 }
 
@@ -201,3 +201,61 @@ func TestExpirePriorityQueue_GetTopExpiredItem(t *testing.T) {
 		assert.Equal(t, tc.expectedResult, result)
 	}
 }
+
+func TestExpirePriorityQueue_TimeoutRules(t *testing.T) {
+	dnsRule := flowexporter.FlowExportTimeoutRule{
+		Protocol:          17,
+		DestinationPorts:  []uint16{53},
+		ActiveFlowTimeout: 1 * time.Second,
+		IdleFlowTimeout:   2 * time.Second,
+	}
+	dbRule := flowexporter.FlowExportTimeoutRule{
+		Protocol:          6,
+		DestinationPorts:  []uint16{5432},
+		ActiveFlowTimeout: 5 * time.Minute,
+		IdleFlowTimeout:   1 * time.Minute,
+	}
+	pq := NewExpirePriorityQueue(5*time.Second, 15*time.Second, dnsRule, dbRule)
+
+	for _, tc := range []struct {
+		name                      string
+		conn                      *flowexporter.Connection
+		expectedActiveFlowTimeout time.Duration
+		expectedIdleFlowTimeout   time.Duration
+	}{
+		{
+			name:                      "DNS connection",
+			conn:                      &flowexporter.Connection{FlowKey: flowexporter.Tuple{Protocol: 17, DestinationPort: 53}},
+			expectedActiveFlowTimeout: 1 * time.Second,
+			expectedIdleFlowTimeout:   2 * time.Second,
+		},
+		{
+			name: "database connection through Service",
+			conn: &flowexporter.Connection{
+				FlowKey:                 flowexporter.Tuple{Protocol: 6, DestinationPort: 15432},
+				OriginalDestinationPort: 5432,
+			},
+			expectedActiveFlowTimeout: 5 * time.Minute,
+			expectedIdleFlowTimeout:   1 * time.Minute,
+		},
+		{
+			name:                      "no matching rule",
+			conn:                      &flowexporter.Connection{FlowKey: flowexporter.Tuple{Protocol: 6, DestinationPort: 53}},
+			expectedActiveFlowTimeout: 5 * time.Second,
+			expectedIdleFlowTimeout:   15 * time.Second,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			activeFlowTimeout, idleFlowTimeout := pq.GetFlowTimeouts(tc.conn)
+			assert.Equal(t, tc.expectedActiveFlowTimeout, activeFlowTimeout)
+			assert.Equal(t, tc.expectedIdleFlowTimeout, idleFlowTimeout)
+
+			startTime := time.Now()
+			connKey := flowexporter.NewConnectionKey(tc.conn)
+			pq.WriteItemToQueue(connKey, tc.conn)
+			item := pq.KeyToItem[connKey]
+			assert.WithinRange(t, item.ActiveExpireTime, startTime.Add(tc.expectedActiveFlowTimeout), time.Now().Add(tc.expectedActiveFlowTimeout))
+			assert.WithinRange(t, item.IdleExpireTime, startTime.Add(tc.expectedIdleFlowTimeout), time.Now().Add(tc.expectedIdleFlowTimeout))
+		})
+	}
+}
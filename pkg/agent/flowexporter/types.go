@@ -97,12 +97,69 @@ type ItemToExpire struct {
 	Index int
 }
 
+// FlowExportTimeoutRule overrides the active and idle flow export timeouts for
+// the connections matching its protocol and destination ports.
+type FlowExportTimeoutRule struct {
+	// Protocol is the protocol identifier of the matched connections. 0 matches
+	// all protocols.
+	Protocol uint8
+	// DestinationPorts are the destination ports of the matched connections. For
+	// Service connections, both the Service port and the Endpoint port are
+	// considered. An empty list matches all ports.
+	DestinationPorts  []uint16
+	ActiveFlowTimeout time.Duration
+	IdleFlowTimeout   time.Duration
+}
+
+// Matches returns whether the connection belongs to the traffic class of the rule.
+func (r *FlowExportTimeoutRule) Matches(conn *Connection) bool {
+	if r.Protocol != 0 && r.Protocol != conn.FlowKey.Protocol {
+		return false
+	}
+	if len(r.DestinationPorts) == 0 {
+		return true
+	}
+	for _, port := range r.DestinationPorts {
+		if port == conn.FlowKey.DestinationPort || (conn.OriginalDestinationPort != 0 && port == conn.OriginalDestinationPort) {
+			return true
+		}
+	}
+	return false
+}
+
 type FlowExporterOptions struct {
-	FlowCollectorAddr      string
-	FlowCollectorProto     string
-	ActiveFlowTimeout      time.Duration
-	IdleFlowTimeout        time.Duration
+	FlowCollectorAddr  string
+	FlowCollectorProto string
+	ActiveFlowTimeout  time.Duration
+	IdleFlowTimeout    time.Duration
+	// TimeoutRules are evaluated in order, the first rule matching a connection
+	// determines its export timeouts. ActiveFlowTimeout and IdleFlowTimeout are
+	// used for the connections which do not match any rule.
+	TimeoutRules           []FlowExportTimeoutRule
 	StaleConnectionTimeout time.Duration
 	PollInterval           time.Duration
 	ConnectUplinkToBridge  bool
+	// PolicyFlowsOnly restricts the exported flows to the ones which matched
+	// a NetworkPolicy rule.
+	PolicyFlowsOnly bool
+
+	// SPIFFECertDir is the directory of the X.509 SVID used to connect to the
+	// flow aggregator. It is empty if SPIFFE is not enabled.
+	SPIFFECertDir string
+	// SPIFFEAuthorizedServerIDs are the SPIFFE IDs the flow aggregator is
+	// authorized to have.
+	SPIFFEAuthorizedServerIDs []string
+
+	// OTLP is set when flow records are exported to an OpenTelemetry collector
+	// using OTLP over gRPC instead of IPFIX. FlowCollectorAddr is then the
+	// address of the OTLP gRPC endpoint.
+	OTLP *OTLPOptions
+}
+
+type OTLPOptions struct {
+	// Insecure disables TLS for the connection to the collector.
+	Insecure bool
+	// PodLabels attaches the labels of the source and destination Pods to the
+	// exported records.
+	PodLabels bool
 }
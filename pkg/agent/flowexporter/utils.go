@@ -84,6 +84,21 @@ func RuleActionToUint8(action string) uint8 {
 	}
 }
 
+// RuleActionToString converts network policy rule action from uint8 to string. It returns an empty string if the
+// connection didn't match any rule.
+func RuleActionToString(action uint8) string {
+	switch action {
+	case registry.NetworkPolicyRuleActionAllow:
+		return "Allow"
+	case registry.NetworkPolicyRuleActionDrop:
+		return "Drop"
+	case registry.NetworkPolicyRuleActionReject:
+		return "Reject"
+	default:
+		return ""
+	}
+}
+
 // policyTypeToUint8 converts NetworkPolicy type to uint8
 func PolicyTypeToUint8(policyType v1beta2.NetworkPolicyType) uint8 {
 	switch policyType {
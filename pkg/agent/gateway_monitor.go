@@ -0,0 +1,97 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"bytes"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// gatewayCheckInterval is the interval at which the configuration of the host gateway interface is checked.
+const gatewayCheckInterval = 10 * time.Second
+
+// GatewayMonitor checks periodically that the host gateway interface still has the MAC address and the IP addresses
+// configured by the agent, and restores them if they have been changed or removed on the host, e.g. by scripts or
+// other network agents. The gateway MAC and IPs are programmed in the OpenFlow pipeline and in the host routes, so
+// such changes would otherwise break all the Pod traffic silently.
+type GatewayMonitor struct {
+	initializer *Initializer
+	// intact is whether the configuration of the host gateway interface was intact, or could be restored, at the
+	// last check.
+	intact atomic.Bool
+}
+
+// NewGatewayMonitor returns a GatewayMonitor for the host gateway interface. It must be called after the Initializer
+// has set up the host gateway interface.
+func (i *Initializer) NewGatewayMonitor() *GatewayMonitor {
+	m := &GatewayMonitor{initializer: i}
+	m.intact.Store(true)
+	return m
+}
+
+func (m *GatewayMonitor) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting GatewayMonitor")
+	defer klog.InfoS("Shutting down GatewayMonitor")
+
+	wait.Until(func() {
+		if err := m.checkGatewayConfig(); err != nil {
+			klog.ErrorS(err, "The host gateway interface is misconfigured, Pod traffic may be disrupted", "interface", m.initializer.hostGateway)
+			m.intact.Store(false)
+			return
+		}
+		m.intact.Store(true)
+	}, gatewayCheckInterval, stopCh)
+}
+
+// IsGatewayConfigIntact returns whether the configuration of the host gateway interface was intact, or could be
+// restored, at the last check.
+func (m *GatewayMonitor) IsGatewayConfigIntact() bool {
+	return m.intact.Load()
+}
+
+// checkGatewayConfig restores the state, the MAC address and the IP addresses of the host gateway interface if they
+// no longer match the ones the agent was initialized with. It returns an error if they cannot be restored.
+func (m *GatewayMonitor) checkGatewayConfig() error {
+	i := m.initializer
+	gatewayConfig := i.nodeConfig.GatewayConfig
+	// setLinkUp also brings the interface back up if it has been set down.
+	mac, linkIndex, err := setLinkUp(i.hostGateway)
+	if err != nil {
+		return fmt.Errorf("failed to get host gateway interface: %w", err)
+	}
+	if linkIndex != gatewayConfig.LinkIndex {
+		// The routes of the Pod CIDRs refer to the interface by index, they cannot be restored without
+		// reinitializing the agent.
+		return fmt.Errorf("host gateway interface has been recreated with index %d, expected %d, restart antrea-agent to restore it", linkIndex, gatewayConfig.LinkIndex)
+	}
+	if !bytes.Equal(mac, gatewayConfig.MAC) {
+		klog.InfoS("MAC address of the host gateway interface has been changed, restoring it", "interface", i.hostGateway, "mac", mac, "expectedMAC", gatewayConfig.MAC)
+		if err := setLinkHardwareAddr(i.hostGateway, gatewayConfig.MAC); err != nil {
+			return fmt.Errorf("failed to restore MAC address %s of host gateway interface: %w", gatewayConfig.MAC, err)
+		}
+	}
+	if len(i.gatewayIPNets) > 0 {
+		// Any missing IP address is added back, and any extra IP address is removed.
+		if err := configureLinkAddresses(gatewayConfig.LinkIndex, i.gatewayIPNets); err != nil {
+			return fmt.Errorf("failed to restore IP addresses of host gateway interface: %w", err)
+		}
+	}
+	return nil
+}
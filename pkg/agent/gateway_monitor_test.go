@@ -0,0 +1,114 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"antrea.io/antrea/pkg/agent/config"
+)
+
+func mockSetLinkHardwareAddr(t *testing.T, returnedErr error) *net.HardwareAddr {
+	var restoredMAC net.HardwareAddr
+	originalSetLinkHardwareAddr := setLinkHardwareAddr
+	setLinkHardwareAddr = func(name string, mac net.HardwareAddr) error {
+		restoredMAC = mac
+		return returnedErr
+	}
+	t.Cleanup(func() { setLinkHardwareAddr = originalSetLinkHardwareAddr })
+	return &restoredMAC
+}
+
+func TestGatewayMonitorCheckGatewayConfig(t *testing.T) {
+	gatewayMAC, _ := net.ParseMAC("0e:c5:5a:2f:d1:3b")
+	otherMAC, _ := net.ParseMAC("0e:c5:5a:2f:d1:3c")
+	_, gatewayIPNet, _ := net.ParseCIDR("10.10.0.1/24")
+	gatewayIPNet.IP = net.ParseIP("10.10.0.1")
+
+	tests := []struct {
+		name                   string
+		linkMAC                net.HardwareAddr
+		linkIndex              int
+		setLinkUpErr           error
+		setLinkHardwareAddrErr error
+		configureAddressesErr  error
+		expectedRestoredMAC    net.HardwareAddr
+		expectedErr            string
+	}{
+		{
+			name:      "intact",
+			linkMAC:   gatewayMAC,
+			linkIndex: 10,
+		},
+		{
+			name:                "MAC changed",
+			linkMAC:             otherMAC,
+			linkIndex:           10,
+			expectedRestoredMAC: gatewayMAC,
+		},
+		{
+			name:                   "failed to restore MAC",
+			linkMAC:                otherMAC,
+			linkIndex:              10,
+			setLinkHardwareAddrErr: fmt.Errorf("not supported"),
+			expectedRestoredMAC:    gatewayMAC,
+			expectedErr:            "failed to restore MAC address 0e:c5:5a:2f:d1:3b of host gateway interface",
+		},
+		{
+			name:         "interface removed",
+			setLinkUpErr: fmt.Errorf("link not found"),
+			expectedErr:  "failed to get host gateway interface",
+		},
+		{
+			name:        "interface recreated",
+			linkMAC:     gatewayMAC,
+			linkIndex:   11,
+			expectedErr: "host gateway interface has been recreated with index 11, expected 10",
+		},
+		{
+			name:                  "failed to restore IP addresses",
+			linkMAC:               gatewayMAC,
+			linkIndex:             10,
+			configureAddressesErr: fmt.Errorf("permission denied"),
+			expectedErr:           "failed to restore IP addresses of host gateway interface",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSetLinkUp(t, tt.linkMAC, tt.linkIndex, tt.setLinkUpErr)
+			mockConfigureLinkAddress(t, tt.configureAddressesErr)
+			restoredMAC := mockSetLinkHardwareAddr(t, tt.setLinkHardwareAddrErr)
+			initializer := &Initializer{
+				hostGateway: "antrea-gw0",
+				nodeConfig: &config.NodeConfig{
+					GatewayConfig: &config.GatewayConfig{Name: "antrea-gw0", MAC: gatewayMAC, LinkIndex: 10},
+				},
+				gatewayIPNets: []*net.IPNet{gatewayIPNet},
+			}
+			m := initializer.NewGatewayMonitor()
+			err := m.checkGatewayConfig()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedRestoredMAC, *restoredMAC)
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostport
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+const (
+	controllerName = "AntreaAgentHostPortController"
+	// The rules of all the Pods are synced at once, so a single key is used for the workqueue.
+	syncKey = "sync"
+
+	minRetryDelay = 5 * time.Second
+	maxRetryDelay = 300 * time.Second
+)
+
+// PortMapping describes how the traffic sent to a hostPort of the Node is translated to a Pod.
+type PortMapping struct {
+	// PodKey is the namespaced name of the Pod.
+	PodKey string
+	// HostIP is the Node IP the hostPort is bound to. An empty HostIP means all the Node IPs.
+	HostIP        string
+	HostPort      int32
+	PodIP         string
+	ContainerPort int32
+	Protocol      corev1.Protocol
+}
+
+// hostPortRules is an interface to abstract the datapath operations required by hostPorts.
+type hostPortRules interface {
+	// Init installs the rules that are not specific to any PortMapping.
+	Init() error
+	// SyncRules replaces all the existing PortMapping rules with the rules of the provided PortMappings.
+	SyncRules(mappings []PortMapping) error
+}
+
+// Controller implements the hostPort feature natively, so that the portmap CNI plugin does not
+// need to be chained with the Antrea CNI plugin. It watches the Pods running on the Node and
+// installs the rules which translate the traffic sent to the hostPorts of a Pod's containers
+// to the Pod.
+type Controller struct {
+	podInformer     cache.SharedIndexInformer
+	podLister       corelisters.PodLister
+	podListerSynced cache.InformerSynced
+	rules           hostPortRules
+	initialized     bool
+	queue           workqueue.TypedRateLimitingInterface[string]
+}
+
+func NewController(podInformer cache.SharedIndexInformer, ipv4Enabled, ipv6Enabled bool) (*Controller, error) {
+	rules, err := newHostPortRules(ipv4Enabled, ipv6Enabled)
+	if err != nil {
+		return nil, fmt.Errorf("error when creating hostPort rules: %w", err)
+	}
+	return newController(podInformer, rules), nil
+}
+
+func newController(podInformer cache.SharedIndexInformer, rules hostPortRules) *Controller {
+	c := &Controller{
+		podInformer:     podInformer,
+		podLister:       corelisters.NewPodLister(podInformer.GetIndexer()),
+		podListerSynced: podInformer.HasSynced,
+		rules:           rules,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "hostport",
+			},
+		),
+	}
+	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if podHasHostPorts(obj.(*corev1.Pod)) {
+				c.queue.Add(syncKey)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			if podHasHostPorts(oldObj.(*corev1.Pod)) || podHasHostPorts(newObj.(*corev1.Pod)) {
+				c.queue.Add(syncKey)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			pod, ok := obj.(*corev1.Pod)
+			if !ok {
+				deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					return
+				}
+				pod, ok = deletedState.Obj.(*corev1.Pod)
+				if !ok {
+					return
+				}
+			}
+			if podHasHostPorts(pod) {
+				c.queue.Add(syncKey)
+			}
+		},
+	})
+	return c
+}
+
+func podHasHostPorts(pod *corev1.Pod) bool {
+	if pod.Spec.HostNetwork {
+		return false
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.HostPort != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getPortMappings returns the PortMappings of the provided Pods, sorted by Pod and hostPort.
+// Pods which have completed are ignored, as their IPs may have been reused by other Pods.
+func getPortMappings(pods []*corev1.Pod) []PortMapping {
+	var mappings []PortMapping
+	for _, pod := range pods {
+		if !podHasHostPorts(pod) || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		podKey := k8s.NamespacedName(pod.Namespace, pod.Name)
+		for _, podIP := range pod.Status.PodIPs {
+			ip := net.ParseIP(podIP.IP)
+			if ip == nil {
+				continue
+			}
+			isIPv4 := ip.To4() != nil
+			for _, container := range pod.Spec.Containers {
+				for _, port := range container.Ports {
+					if port.HostPort == 0 {
+						continue
+					}
+					hostIP := port.HostIP
+					if hostIP != "" {
+						parsedHostIP := net.ParseIP(hostIP)
+						// The hostIP only applies to the IP family of the Pod IP it matches.
+						if parsedHostIP == nil || (parsedHostIP.To4() != nil) != isIPv4 {
+							continue
+						}
+						if parsedHostIP.IsUnspecified() {
+							hostIP = ""
+						}
+					}
+					protocol := port.Protocol
+					if protocol == "" {
+						protocol = corev1.ProtocolTCP
+					}
+					mappings = append(mappings, PortMapping{
+						PodKey:        podKey,
+						HostIP:        hostIP,
+						HostPort:      port.HostPort,
+						PodIP:         podIP.IP,
+						ContainerPort: port.ContainerPort,
+						Protocol:      protocol,
+					})
+				}
+			}
+		}
+	}
+	slices.SortFunc(mappings, func(a, b PortMapping) int {
+		if c := strings.Compare(a.PodKey, b.PodKey); c != 0 {
+			return c
+		}
+		if a.HostPort != b.HostPort {
+			return int(a.HostPort - b.HostPort)
+		}
+		if c := strings.Compare(string(a.Protocol), string(b.Protocol)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.PodIP, b.PodIP)
+	})
+	return mappings
+}
+
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting controller", "name", controllerName)
+	defer klog.InfoS("Shutting down controller", "name", controllerName)
+
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.podListerSynced) {
+		return
+	}
+	// Sync the rules once after startup to remove the rules of the Pods deleted while the
+	// agent was not running.
+	c.queue.Add(syncKey)
+	go wait.Until(c.worker, time.Second, stopCh)
+	<-stopCh
+}
+
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.syncRules(); err != nil {
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Failed to sync hostPort rules")
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *Controller) syncRules() error {
+	startTime := time.Now()
+	defer func() {
+		klog.V(2).InfoS("Finished syncing hostPort rules", "durationTime", time.Since(startTime))
+	}()
+	if !c.initialized {
+		if err := c.rules.Init(); err != nil {
+			return fmt.Errorf("error when initializing hostPort rules: %w", err)
+		}
+		c.initialized = true
+	}
+	pods, err := c.podLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	return c.rules.SyncRules(getPortMappings(pods))
+}
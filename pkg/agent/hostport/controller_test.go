@@ -0,0 +1,138 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostport
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+type fakeRules struct {
+	initErr     error
+	initCalls   int
+	mappings    []PortMapping
+	syncedCalls int
+}
+
+func (r *fakeRules) Init() error {
+	r.initCalls++
+	return r.initErr
+}
+
+func (r *fakeRules) SyncRules(mappings []PortMapping) error {
+	r.syncedCalls++
+	r.mappings = mappings
+	return nil
+}
+
+func newPod(name string, podIPs []string, ports ...corev1.ContainerPort) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: name},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: "c1", Ports: ports}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	for _, ip := range podIPs {
+		pod.Status.PodIPs = append(pod.Status.PodIPs, corev1.PodIP{IP: ip})
+	}
+	return pod
+}
+
+func TestGetPortMappings(t *testing.T) {
+	hostNetworkPod := newPod("host-network", []string{"192.168.1.1"}, corev1.ContainerPort{ContainerPort: 80, HostPort: 8080})
+	hostNetworkPod.Spec.HostNetwork = true
+	completedPod := newPod("completed", []string{"10.10.0.5"}, corev1.ContainerPort{ContainerPort: 80, HostPort: 8080})
+	completedPod.Status.Phase = corev1.PodSucceeded
+	tests := []struct {
+		name     string
+		pods     []*corev1.Pod
+		expected []PortMapping
+	}{
+		{
+			name: "without hostPort",
+			pods: []*corev1.Pod{newPod("pod1", []string{"10.10.0.1"}, corev1.ContainerPort{ContainerPort: 80})},
+		},
+		{
+			name: "ignored Pods",
+			pods: []*corev1.Pod{hostNetworkPod, completedPod, newPod("no-ip", nil, corev1.ContainerPort{ContainerPort: 80, HostPort: 8080})},
+		},
+		{
+			name: "dual-stack Pod",
+			pods: []*corev1.Pod{
+				newPod("pod2", []string{"10.10.0.2", "fd00:10::2"}, corev1.ContainerPort{ContainerPort: 53, HostPort: 5353, Protocol: corev1.ProtocolUDP}),
+				newPod("pod1", []string{"10.10.0.1", "fd00:10::1"}, corev1.ContainerPort{ContainerPort: 80, HostPort: 8080}),
+			},
+			expected: []PortMapping{
+				{PodKey: "ns1/pod1", HostPort: 8080, PodIP: "10.10.0.1", ContainerPort: 80, Protocol: corev1.ProtocolTCP},
+				{PodKey: "ns1/pod1", HostPort: 8080, PodIP: "fd00:10::1", ContainerPort: 80, Protocol: corev1.ProtocolTCP},
+				{PodKey: "ns1/pod2", HostPort: 5353, PodIP: "10.10.0.2", ContainerPort: 53, Protocol: corev1.ProtocolUDP},
+				{PodKey: "ns1/pod2", HostPort: 5353, PodIP: "fd00:10::2", ContainerPort: 53, Protocol: corev1.ProtocolUDP},
+			},
+		},
+		{
+			name: "hostIP",
+			pods: []*corev1.Pod{
+				newPod("pod1", []string{"10.10.0.1", "fd00:10::1"},
+					corev1.ContainerPort{ContainerPort: 80, HostPort: 8080, HostIP: "192.168.1.1"},
+					corev1.ContainerPort{ContainerPort: 443, HostPort: 8443, HostIP: "0.0.0.0"},
+				),
+			},
+			expected: []PortMapping{
+				{PodKey: "ns1/pod1", HostIP: "192.168.1.1", HostPort: 8080, PodIP: "10.10.0.1", ContainerPort: 80, Protocol: corev1.ProtocolTCP},
+				{PodKey: "ns1/pod1", HostPort: 8443, PodIP: "10.10.0.1", ContainerPort: 443, Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getPortMappings(tt.pods))
+		})
+	}
+}
+
+func TestSyncRules(t *testing.T) {
+	pod1 := newPod("pod1", []string{"10.10.0.1"}, corev1.ContainerPort{ContainerPort: 80, HostPort: 8080})
+	pod2 := newPod("pod2", []string{"10.10.0.2"}, corev1.ContainerPort{ContainerPort: 80})
+	client := fake.NewSimpleClientset(pod1, pod2)
+	podInformer := coreinformers.NewPodInformer(client, metav1.NamespaceAll, 0, cache.Indexers{})
+	rules := &fakeRules{initErr: fmt.Errorf("iptables error")}
+	c := newController(podInformer, rules)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go podInformer.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, podInformer.HasSynced))
+
+	// Rules are not synced if the initialization fails, and the initialization is retried.
+	require.Error(t, c.syncRules())
+	assert.Equal(t, 0, rules.syncedCalls)
+	rules.initErr = nil
+	require.NoError(t, c.syncRules())
+	require.NoError(t, c.syncRules())
+	assert.Equal(t, 2, rules.initCalls)
+	assert.Equal(t, 2, rules.syncedCalls)
+	assert.Equal(t, []PortMapping{
+		{PodKey: "ns1/pod1", HostPort: 8080, PodIP: "10.10.0.1", ContainerPort: 80, Protocol: corev1.ProtocolTCP},
+	}, rules.mappings)
+}
@@ -0,0 +1,142 @@
+//go:build !windows
+// +build !windows
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostport
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	utilnet "k8s.io/utils/net"
+
+	"antrea.io/antrea/pkg/agent/util/iptables"
+)
+
+const (
+	// HostPortChain is the chain of the nat table in which the DNAT rules of hostPorts are installed.
+	HostPortChain = "ANTREA-HOSTPORT"
+	// HostPortMasqueradeChain is the chain of the nat table in which the rules masquerading the
+	// hairpin traffic, i.e. the traffic sent by a Pod to its own hostPort, are installed.
+	HostPortMasqueradeChain = "ANTREA-HOSTPORT-MASQ"
+)
+
+var jumpToHostPortChainRule = []string{
+	"-m", "addrtype", "--dst-type", "LOCAL", "-m", "comment", "--comment", "Antrea: jump to hostPort rules", "-j", HostPortChain,
+}
+
+var jumpToHostPortMasqueradeChainRule = []string{
+	"-m", "comment", "--comment", "Antrea: jump to hostPort masquerade rules", "-j", HostPortMasqueradeChain,
+}
+
+type iptablesRules struct {
+	table       iptables.Interface
+	ipv4Enabled bool
+	ipv6Enabled bool
+}
+
+func newHostPortRules(ipv4Enabled, ipv6Enabled bool) (hostPortRules, error) {
+	table, err := iptables.New(ipv4Enabled, ipv6Enabled)
+	if err != nil {
+		return nil, err
+	}
+	return &iptablesRules{
+		table:       table,
+		ipv4Enabled: ipv4Enabled,
+		ipv6Enabled: ipv6Enabled,
+	}, nil
+}
+
+func (r *iptablesRules) enabledProtocols() []iptables.Protocol {
+	var protocols []iptables.Protocol
+	if r.ipv4Enabled {
+		protocols = append(protocols, iptables.ProtocolIPv4)
+	}
+	if r.ipv6Enabled {
+		protocols = append(protocols, iptables.ProtocolIPv6)
+	}
+	return protocols
+}
+
+// Init creates the hostPort chains and links them to the PREROUTING (for incoming traffic), OUTPUT
+// (for locally-generated traffic) and POSTROUTING (for hairpin traffic) chains.
+func (r *iptablesRules) Init() error {
+	for _, protocol := range r.enabledProtocols() {
+		for _, chain := range []string{HostPortChain, HostPortMasqueradeChain} {
+			if err := r.table.EnsureChain(protocol, iptables.NATTable, chain); err != nil {
+				return err
+			}
+		}
+		if err := r.table.AppendRule(protocol, iptables.NATTable, iptables.PreRoutingChain, jumpToHostPortChainRule); err != nil {
+			return err
+		}
+		if err := r.table.AppendRule(protocol, iptables.NATTable, iptables.OutputChain, jumpToHostPortChainRule); err != nil {
+			return err
+		}
+		if err := r.table.AppendRule(protocol, iptables.NATTable, iptables.PostRoutingChain, jumpToHostPortMasqueradeChainRule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SyncRules rewrites the hostPort chains with iptables-restore. The "--noflush" option is used so
+// that only the hostPort chains are flushed.
+func (r *iptablesRules) SyncRules(mappings []PortMapping) error {
+	for _, protocol := range r.enabledProtocols() {
+		isIPv6 := iptables.IsIPv6Protocol(protocol)
+		if err := r.table.Restore(buildRestoreData(mappings, isIPv6), false, isIPv6); err != nil {
+			return fmt.Errorf("error when syncing hostPort rules: %w", err)
+		}
+	}
+	return nil
+}
+
+func buildRestoreData(mappings []PortMapping, isIPv6 bool) string {
+	var dnatRules, masqueradeRules []string
+	for _, mapping := range mappings {
+		if utilnet.IsIPv6String(mapping.PodIP) != isIPv6 {
+			continue
+		}
+		protocol := strings.ToLower(string(mapping.Protocol))
+		comment := fmt.Sprintf(`-m comment --comment "%s hostPort %d"`, mapping.PodKey, mapping.HostPort)
+		destination := net.JoinHostPort(mapping.PodIP, strconv.Itoa(int(mapping.ContainerPort)))
+		dnatRule := fmt.Sprintf("-A %s", HostPortChain)
+		if mapping.HostIP != "" {
+			dnatRule += fmt.Sprintf(" -d %s", mapping.HostIP)
+		}
+		dnatRule += fmt.Sprintf(" -p %s -m %s --dport %d %s -j DNAT --to-destination %s", protocol, protocol, mapping.HostPort, comment, destination)
+		dnatRules = append(dnatRules, dnatRule)
+		// Without masquerading, the Pod would reply to itself directly when accessing its own
+		// hostPort, and the reply would not be translated back.
+		masqueradeRules = append(masqueradeRules, fmt.Sprintf("-A %s -s %s -d %s -p %s -m %s --dport %d %s -j MASQUERADE",
+			HostPortMasqueradeChain, mapping.PodIP, mapping.PodIP, protocol, protocol, mapping.ContainerPort, comment))
+	}
+	var data strings.Builder
+	data.WriteString("*nat\n")
+	data.WriteString(iptables.MakeChainLine(HostPortChain) + "\n")
+	data.WriteString(iptables.MakeChainLine(HostPortMasqueradeChain) + "\n")
+	for _, rule := range dnatRules {
+		data.WriteString(rule + "\n")
+	}
+	for _, rule := range masqueradeRules {
+		data.WriteString(rule + "\n")
+	}
+	data.WriteString("COMMIT\n")
+	return data.String()
+}
@@ -0,0 +1,70 @@
+//go:build !windows
+// +build !windows
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hostport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+
+	"antrea.io/antrea/pkg/agent/util/iptables"
+	iptablestest "antrea.io/antrea/pkg/agent/util/iptables/testing"
+)
+
+func TestIPTablesRules(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockIPTables := iptablestest.NewMockInterface(ctrl)
+	rules := &iptablesRules{
+		table:       mockIPTables,
+		ipv4Enabled: true,
+		ipv6Enabled: true,
+	}
+
+	for _, protocol := range []iptables.Protocol{iptables.ProtocolIPv4, iptables.ProtocolIPv6} {
+		mockIPTables.EXPECT().EnsureChain(protocol, iptables.NATTable, HostPortChain)
+		mockIPTables.EXPECT().EnsureChain(protocol, iptables.NATTable, HostPortMasqueradeChain)
+		mockIPTables.EXPECT().AppendRule(protocol, iptables.NATTable, iptables.PreRoutingChain, jumpToHostPortChainRule)
+		mockIPTables.EXPECT().AppendRule(protocol, iptables.NATTable, iptables.OutputChain, jumpToHostPortChainRule)
+		mockIPTables.EXPECT().AppendRule(protocol, iptables.NATTable, iptables.PostRoutingChain, jumpToHostPortMasqueradeChainRule)
+	}
+	require.NoError(t, rules.Init())
+
+	mockIPTables.EXPECT().Restore(`*nat
+:ANTREA-HOSTPORT - [0:0]
+:ANTREA-HOSTPORT-MASQ - [0:0]
+-A ANTREA-HOSTPORT -p tcp -m tcp --dport 8080 -m comment --comment "ns1/pod1 hostPort 8080" -j DNAT --to-destination 10.10.0.1:80
+-A ANTREA-HOSTPORT -d 192.168.1.1 -p udp -m udp --dport 5353 -m comment --comment "ns1/pod2 hostPort 5353" -j DNAT --to-destination 10.10.0.2:53
+-A ANTREA-HOSTPORT-MASQ -s 10.10.0.1 -d 10.10.0.1 -p tcp -m tcp --dport 80 -m comment --comment "ns1/pod1 hostPort 8080" -j MASQUERADE
+-A ANTREA-HOSTPORT-MASQ -s 10.10.0.2 -d 10.10.0.2 -p udp -m udp --dport 53 -m comment --comment "ns1/pod2 hostPort 5353" -j MASQUERADE
+COMMIT
+`, false, false)
+	mockIPTables.EXPECT().Restore(`*nat
+:ANTREA-HOSTPORT - [0:0]
+:ANTREA-HOSTPORT-MASQ - [0:0]
+-A ANTREA-HOSTPORT -p tcp -m tcp --dport 8080 -m comment --comment "ns1/pod1 hostPort 8080" -j DNAT --to-destination [fd00:10::1]:80
+-A ANTREA-HOSTPORT-MASQ -s fd00:10::1 -d fd00:10::1 -p tcp -m tcp --dport 80 -m comment --comment "ns1/pod1 hostPort 8080" -j MASQUERADE
+COMMIT
+`, false, true)
+	require.NoError(t, rules.SyncRules([]PortMapping{
+		{PodKey: "ns1/pod1", HostPort: 8080, PodIP: "10.10.0.1", ContainerPort: 80, Protocol: corev1.ProtocolTCP},
+		{PodKey: "ns1/pod1", HostPort: 8080, PodIP: "fd00:10::1", ContainerPort: 80, Protocol: corev1.ProtocolTCP},
+		{PodKey: "ns1/pod2", HostIP: "192.168.1.1", HostPort: 5353, PodIP: "10.10.0.2", ContainerPort: 53, Protocol: corev1.ProtocolUDP},
+	}))
+}
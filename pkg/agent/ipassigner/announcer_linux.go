@@ -0,0 +1,320 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipassigner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mdlayher/arp"
+	mdndp "github.com/mdlayher/ndp"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/agent/util/arping"
+	"antrea.io/antrea/pkg/agent/util/ndp"
+)
+
+const (
+	// announcementRate and announcementBurst limit the number of GARP/unsolicited NA sent per second by the Node, to
+	// avoid flooding the network and the neighbors' CPUs when many IPs fail over to this Node at once.
+	announcementRate  = 100
+	announcementBurst = 20
+	// announcementWorkers is the number of workers sending announcements concurrently.
+	announcementWorkers = 4
+	// maxConcurrentVerifications is the maximum number of announcements being verified concurrently. Verifications
+	// take up to announcementVerifyTimeout each and run outside the workers, so that they don't slow down the
+	// announcements of a large failover. When the limit is reached, announcements are not verified.
+	maxConcurrentVerifications = 64
+	// announcementMaxRetries is the maximum number of times an announcement is retried after a failure.
+	announcementMaxRetries = 5
+	// announcementVerifyTimeout is how long to wait for a conflicting reply after an announcement is sent.
+	announcementVerifyTimeout = 500 * time.Millisecond
+
+	minAnnouncementRetryDelay = 1 * time.Second
+	maxAnnouncementRetryDelay = 30 * time.Second
+
+	announcementFailureSendError = "send_error"
+	announcementFailureConflict  = "conflict"
+)
+
+// errAnnouncementConflict indicates another host still claims the IP after the announcement.
+var errAnnouncementConflict = errors.New("IP is still claimed by another host")
+
+var ethernetBroadcast = net.HardwareAddr{0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+
+// announcer sends gratuitous ARP (IPv4) and unsolicited Neighbor Advertisement (IPv6) for assigned IPs. Announcements
+// are queued and paced, retried with exponential backoff on failure, and verified asynchronously by checking that no
+// other host still answers for the IP after the announcement.
+type announcer struct {
+	queue   workqueue.TypedRateLimitingInterface[string]
+	limiter *rate.Limiter
+	// verifications limits the number of concurrent verifications.
+	verifications chan struct{}
+	// verifyWG tracks the running verifications, so that tests can wait for them.
+	verifyWG sync.WaitGroup
+	// getInterface returns the interface through which the IP should be announced, and false if the IP is no longer
+	// assigned, in which case the announcement is discarded.
+	getInterface func(ip string) (*net.Interface, bool)
+	// announceFn and verifyFn are stored as fields to allow overriding them in tests.
+	announceFn func(ip net.IP, iface *net.Interface) error
+	verifyFn   func(ip net.IP, iface *net.Interface, timeout time.Duration) error
+}
+
+func newAnnouncer(getInterface func(ip string) (*net.Interface, bool)) *announcer {
+	return &announcer{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minAnnouncementRetryDelay, maxAnnouncementRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "ipAnnouncer",
+			},
+		),
+		limiter:       rate.NewLimiter(announcementRate, announcementBurst),
+		verifications: make(chan struct{}, maxConcurrentVerifications),
+		getInterface:  getInterface,
+		announceFn:    announceIP,
+		verifyFn:      verifyIP,
+	}
+}
+
+// enqueue requests an announcement for the IP. Multiple requests for the same IP that haven't been processed are
+// merged into one.
+func (a *announcer) enqueue(ip string) {
+	a.queue.Forget(ip)
+	a.queue.Add(ip)
+}
+
+func (a *announcer) run(stopCh <-chan struct{}) {
+	defer a.queue.ShutDown()
+
+	ctx := wait.ContextForChannel(stopCh)
+	for i := 0; i < announcementWorkers; i++ {
+		go wait.Until(func() {
+			for a.processNextItem(ctx) {
+			}
+		}, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+func (a *announcer) processNextItem(ctx context.Context) bool {
+	ip, quit := a.queue.Get()
+	if quit {
+		return false
+	}
+	defer a.queue.Done(ip)
+
+	if err := a.limiter.Wait(ctx); err != nil {
+		// The context is canceled, the queue is shutting down.
+		return true
+	}
+	iface, err := a.announce(ip)
+	if err != nil {
+		a.handleErr(ip, err)
+		return true
+	}
+	if iface == nil {
+		a.queue.Forget(ip)
+		return true
+	}
+	select {
+	case a.verifications <- struct{}{}:
+		a.verifyWG.Add(1)
+		go func() {
+			defer func() {
+				<-a.verifications
+				a.verifyWG.Done()
+			}()
+			a.verify(ip, iface)
+		}()
+	default:
+		klog.V(2).InfoS("Too many IP announcements being verified, skipping verification", "ip", ip)
+		a.queue.Forget(ip)
+	}
+	return true
+}
+
+// handleErr retries the announcement of the IP with backoff, until announcementMaxRetries is reached.
+func (a *announcer) handleErr(ip string, err error) {
+	if a.queue.NumRequeues(ip) < announcementMaxRetries {
+		klog.ErrorS(err, "Failed to announce IP, retrying", "ip", ip)
+		a.queue.AddRateLimited(ip)
+		return
+	}
+	klog.ErrorS(err, "Failed to announce IP, giving up", "ip", ip, "retries", announcementMaxRetries)
+	a.queue.Forget(ip)
+}
+
+// announce sends the announcement of the IP and returns the interface through which it was sent. A nil interface is
+// returned if the IP is no longer assigned.
+func (a *announcer) announce(ip string) (*net.Interface, error) {
+	iface, assigned := a.getInterface(ip)
+	if !assigned {
+		klog.V(2).InfoS("IP is no longer assigned, skipping announcement", "ip", ip)
+		return nil, nil
+	}
+	parsedIP := net.ParseIP(ip)
+	if err := a.announceFn(parsedIP, iface); err != nil {
+		metrics.IPAnnouncementFailureCount.WithLabelValues(ipFamily(parsedIP), announcementFailureSendError).Inc()
+		return nil, err
+	}
+	metrics.IPAnnouncementCount.WithLabelValues(ipFamily(parsedIP)).Inc()
+	return iface, nil
+}
+
+// verify checks that no other host still claims the IP after the announcement, and retries the announcement if one
+// does.
+func (a *announcer) verify(ip string, iface *net.Interface) {
+	parsedIP := net.ParseIP(ip)
+	if err := a.verifyFn(parsedIP, iface, announcementVerifyTimeout); err != nil {
+		if errors.Is(err, errAnnouncementConflict) {
+			metrics.IPAnnouncementFailureCount.WithLabelValues(ipFamily(parsedIP), announcementFailureConflict).Inc()
+			a.handleErr(ip, err)
+			return
+		}
+		// Failing to verify doesn't mean the announcement failed, don't retry in this case.
+		klog.V(2).InfoS("Unable to verify IP announcement", "ip", ip, "interface", iface.Name, "err", err)
+	}
+	a.queue.Forget(ip)
+}
+
+func ipFamily(ip net.IP) string {
+	if utilnet.IsIPv4(ip) {
+		return "ipv4"
+	}
+	return "ipv6"
+}
+
+func announceIP(ip net.IP, iface *net.Interface) error {
+	if utilnet.IsIPv4(ip) {
+		klog.V(2).InfoS("Sending gratuitous ARP", "ip", ip, "interface", iface.Name)
+		if err := arping.GratuitousARPOverIface(ip, iface); err != nil {
+			return fmt.Errorf("failed to send gratuitous ARP: %w", err)
+		}
+		return nil
+	}
+	klog.V(2).InfoS("Sending neighbor advertisement", "ip", ip, "interface", iface.Name)
+	if err := ndp.NeighborAdvertisement(ip, iface); err != nil {
+		return fmt.Errorf("failed to send neighbor advertisement: %w", err)
+	}
+	return nil
+}
+
+// verifyIP checks that no other host answers for the IP after it has been announced. It sends an ARP probe (IPv4)
+// or a Neighbor Solicitation (IPv6) for the IP and returns errAnnouncementConflict if a reply is received from a
+// hardware address different from the interface's.
+func verifyIP(ip net.IP, iface *net.Interface, timeout time.Duration) error {
+	if utilnet.IsIPv4(ip) {
+		return verifyIPv4(ip.To4(), iface, timeout)
+	}
+	return verifyIPv6(ip, iface, timeout)
+}
+
+func verifyIPv4(ip net.IP, iface *net.Interface, timeout time.Duration) error {
+	client, err := arp.Dial(iface)
+	if err != nil {
+		return fmt.Errorf("failed to dial ARP client: %w", err)
+	}
+	defer client.Close()
+
+	// Use an ARP probe (sender IP 0.0.0.0) to avoid updating the neighbors' caches with the verification request.
+	probe, err := arp.NewPacket(arp.OperationRequest, iface.HardwareAddr, net.IPv4zero.To4(), ethernetBroadcast, ip)
+	if err != nil {
+		return err
+	}
+	if err := client.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	if err := client.WriteTo(probe, ethernetBroadcast); err != nil {
+		return fmt.Errorf("failed to send ARP probe: %w", err)
+	}
+	for {
+		pkt, _, err := client.Read()
+		if err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				return nil
+			}
+			return err
+		}
+		if pkt.Operation != arp.OperationReply || !pkt.SenderIP.Equal(ip) {
+			continue
+		}
+		if !bytes.Equal(pkt.SenderHardwareAddr, iface.HardwareAddr) {
+			return fmt.Errorf("%w: %s", errAnnouncementConflict, pkt.SenderHardwareAddr)
+		}
+	}
+}
+
+func verifyIPv6(ip net.IP, iface *net.Interface, timeout time.Duration) error {
+	conn, _, err := mdndp.Listen(iface, mdndp.LinkLocal)
+	if err != nil {
+		return fmt.Errorf("failed to create NDP connection: %w", err)
+	}
+	defer conn.Close()
+
+	target, _ := netip.AddrFromSlice(ip)
+	dst, err := mdndp.SolicitedNodeMulticast(target)
+	if err != nil {
+		return err
+	}
+	ns := &mdndp.NeighborSolicitation{
+		TargetAddress: target,
+		Options: []mdndp.Option{
+			&mdndp.LinkLayerAddress{
+				Direction: mdndp.Source,
+				Addr:      iface.HardwareAddr,
+			},
+		},
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		return err
+	}
+	if err := conn.WriteTo(ns, nil, dst); err != nil {
+		return fmt.Errorf("failed to send neighbor solicitation: %w", err)
+	}
+	for {
+		msg, _, _, err := conn.ReadFrom()
+		if err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				return nil
+			}
+			return err
+		}
+		na, ok := msg.(*mdndp.NeighborAdvertisement)
+		if !ok || na.TargetAddress != target {
+			continue
+		}
+		for _, o := range na.Options {
+			lla, ok := o.(*mdndp.LinkLayerAddress)
+			if !ok || lla.Direction != mdndp.Target {
+				continue
+			}
+			if !bytes.Equal(lla.Addr, iface.HardwareAddr) {
+				return fmt.Errorf("%w: %s", errAnnouncementConflict, lla.Addr)
+			}
+		}
+	}
+}
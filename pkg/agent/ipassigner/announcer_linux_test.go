@@ -0,0 +1,163 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipassigner
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func newFakeAnnouncer(assigned map[string]*net.Interface, announceErr, verifyErr error) (*announcer, *[]string) {
+	var announced []string
+	a := &announcer{
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](time.Millisecond, time.Millisecond),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "ipAnnouncer",
+			},
+		),
+		limiter:       rate.NewLimiter(rate.Inf, 1),
+		verifications: make(chan struct{}, maxConcurrentVerifications),
+		getInterface: func(ip string) (*net.Interface, bool) {
+			iface, ok := assigned[ip]
+			return iface, ok
+		},
+		announceFn: func(ip net.IP, iface *net.Interface) error {
+			announced = append(announced, ip.String())
+			return announceErr
+		},
+		verifyFn: func(ip net.IP, iface *net.Interface, timeout time.Duration) error {
+			return verifyErr
+		},
+	}
+	return a, &announced
+}
+
+func TestAnnouncerProcessNextItem(t *testing.T) {
+	eth0 := &net.Interface{Name: "eth0", Index: 1, HardwareAddr: net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0x01}}
+	tests := []struct {
+		name              string
+		ip                string
+		assigned          map[string]*net.Interface
+		announceErr       error
+		verifyErr         error
+		expectedAnnounced []string
+		expectedRequeues  int
+	}{
+		{
+			name:              "announced and verified",
+			ip:                "1.1.1.1",
+			assigned:          map[string]*net.Interface{"1.1.1.1": eth0},
+			expectedAnnounced: []string{"1.1.1.1"},
+		},
+		{
+			name:              "IPv6 announced and verified",
+			ip:                "2021::1",
+			assigned:          map[string]*net.Interface{"2021::1": eth0},
+			expectedAnnounced: []string{"2021::1"},
+		},
+		{
+			name:     "IP no longer assigned",
+			ip:       "1.1.1.1",
+			assigned: map[string]*net.Interface{},
+		},
+		{
+			name:              "send error",
+			ip:                "1.1.1.1",
+			assigned:          map[string]*net.Interface{"1.1.1.1": eth0},
+			announceErr:       errors.New("network is down"),
+			expectedAnnounced: []string{"1.1.1.1"},
+			expectedRequeues:  1,
+		},
+		{
+			name:              "conflict",
+			ip:                "1.1.1.1",
+			assigned:          map[string]*net.Interface{"1.1.1.1": eth0},
+			verifyErr:         errAnnouncementConflict,
+			expectedAnnounced: []string{"1.1.1.1"},
+			expectedRequeues:  1,
+		},
+		{
+			name:              "verification error",
+			ip:                "1.1.1.1",
+			assigned:          map[string]*net.Interface{"1.1.1.1": eth0},
+			verifyErr:         errors.New("failed to dial ARP client"),
+			expectedAnnounced: []string{"1.1.1.1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, announced := newFakeAnnouncer(tt.assigned, tt.announceErr, tt.verifyErr)
+			defer a.queue.ShutDown()
+			a.enqueue(tt.ip)
+			assert.True(t, a.processNextItem(context.TODO()))
+			a.verifyWG.Wait()
+			assert.Equal(t, tt.expectedAnnounced, *announced)
+			assert.Equal(t, tt.expectedRequeues, a.queue.NumRequeues(tt.ip))
+		})
+	}
+}
+
+func TestAnnouncerGiveUpAfterMaxRetries(t *testing.T) {
+	eth0 := &net.Interface{Name: "eth0", Index: 1}
+	a, announced := newFakeAnnouncer(map[string]*net.Interface{"1.1.1.1": eth0}, nil, errAnnouncementConflict)
+	defer a.queue.ShutDown()
+	a.enqueue("1.1.1.1")
+	for i := 0; i <= announcementMaxRetries; i++ {
+		assert.True(t, a.processNextItem(context.TODO()))
+		a.verifyWG.Wait()
+	}
+	assert.Len(t, *announced, announcementMaxRetries+1)
+	assert.Equal(t, 0, a.queue.NumRequeues("1.1.1.1"))
+	assert.Equal(t, 0, a.queue.Len())
+}
+
+func TestAnnouncerMergeDuplicates(t *testing.T) {
+	eth0 := &net.Interface{Name: "eth0", Index: 1}
+	a, _ := newFakeAnnouncer(map[string]*net.Interface{"1.1.1.1": eth0, "1.1.1.2": eth0}, nil, nil)
+	defer a.queue.ShutDown()
+	a.enqueue("1.1.1.1")
+	a.enqueue("1.1.1.1")
+	a.enqueue("1.1.1.2")
+	assert.Equal(t, 2, a.queue.Len())
+}
+
+func TestAnnouncerVerifyAsync(t *testing.T) {
+	eth0 := &net.Interface{Name: "eth0", Index: 1}
+	a, announced := newFakeAnnouncer(map[string]*net.Interface{"1.1.1.1": eth0, "1.1.1.2": eth0}, nil, nil)
+	defer a.queue.ShutDown()
+	verifyCh := make(chan struct{})
+	a.verifyFn = func(ip net.IP, iface *net.Interface, timeout time.Duration) error {
+		<-verifyCh
+		return errAnnouncementConflict
+	}
+	a.enqueue("1.1.1.1")
+	a.enqueue("1.1.1.2")
+	// The second IP is announced while the verification of the first one is still in progress.
+	assert.True(t, a.processNextItem(context.TODO()))
+	assert.True(t, a.processNextItem(context.TODO()))
+	assert.Equal(t, []string{"1.1.1.1", "1.1.1.2"}, *announced)
+	close(verifyCh)
+	a.verifyWG.Wait()
+	assert.Equal(t, 1, a.queue.NumRequeues("1.1.1.1"))
+	assert.Equal(t, 1, a.queue.NumRequeues("1.1.1.2"))
+}
@@ -18,11 +18,14 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
@@ -30,10 +33,9 @@ import (
 	"antrea.io/antrea/pkg/agent/ipassigner/linkmonitor"
 	"antrea.io/antrea/pkg/agent/ipassigner/responder"
 	"antrea.io/antrea/pkg/agent/util"
-	"antrea.io/antrea/pkg/agent/util/arping"
-	"antrea.io/antrea/pkg/agent/util/ndp"
 	"antrea.io/antrea/pkg/agent/util/sysctl"
 	crdv1b1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 )
 
 // VLAN interfaces created by antrea-agent will be named with the prefix.
@@ -41,6 +43,10 @@ import (
 // It can be used to determine whether it's safe to delete an interface when it's no longer used.
 const vlanInterfacePrefix = "antrea-ext."
 
+// MACVLAN interfaces created by antrea-agent for the IPs announced with VRRP will be named with the prefix.
+// For example, when the Virtual Router ID is 10, the name will be antrea-vrrp.10.
+const vrrpInterfacePrefix = "antrea-vrrp."
+
 // assignee is the unit that IPs are assigned to. All IPs from the same VLAN share an assignee.
 type assignee struct {
 	// logicalInterface is the interface IPs should be logically assigned to. It's also used for IP advertisement.
@@ -57,6 +63,9 @@ type assignee struct {
 	ndpResponder responder.Responder
 	// ips tracks IPs that have been assigned to this assignee.
 	ips sets.Set[string]
+	// vrrpSpeaker sends the VRRP advertisements of the IP assigned to this assignee. The field is nil if the
+	// assignee is not a VRRP virtual router or no IP is assigned to it.
+	vrrpSpeaker *vrrpSpeaker
 }
 
 // deletable returns whether this assignee can be safely deleted.
@@ -68,15 +77,19 @@ func (as *assignee) deletable() bool {
 	if as.link == nil {
 		return false
 	}
-	// Do not delete non VLAN interfaces.
-	if _, ok := as.link.(*netlink.Vlan); !ok {
-		return false
-	}
-	// Do not delete VLAN interfaces not created by antrea-agent.
-	if !strings.HasPrefix(as.link.Attrs().Name, vlanInterfacePrefix) {
-		return false
+	// Do not delete VLAN and MACVLAN interfaces not created by antrea-agent, and other interfaces.
+	switch as.link.(type) {
+	case *netlink.Vlan:
+		return strings.HasPrefix(as.link.Attrs().Name, vlanInterfacePrefix)
+	case *netlink.Macvlan:
+		return strings.HasPrefix(as.link.Attrs().Name, vrrpInterfacePrefix)
 	}
-	return true
+	return false
+}
+
+func (as *assignee) isVRRP() bool {
+	_, ok := as.link.(*netlink.Macvlan)
+	return ok
 }
 
 func (as *assignee) destroy() error {
@@ -90,7 +103,13 @@ func (as *assignee) assign(ip net.IP, subnetInfo *crdv1b1.SubnetInfo) error {
 	// If there is a real link, add the IP to its address list.
 	if as.link != nil {
 		addr := getIPNet(ip, subnetInfo)
-		if err := netlink.AddrAdd(as.link, &netlink.Addr{IPNet: addr}); err != nil {
+		nlAddr := &netlink.Addr{IPNet: addr}
+		// The virtual IP may still be assigned to the previous master when it fails over, skip Duplicate Address
+		// Detection to make it usable immediately.
+		if as.isVRRP() && utilnet.IsIPv6(ip) {
+			nlAddr.Flags = unix.IFA_F_NODAD
+		}
+		if err := netlink.AddrAdd(as.link, nlAddr); err != nil {
 			if !errors.Is(err, unix.EEXIST) {
 				return fmt.Errorf("failed to add IP %v to interface %s: %v", addr, as.link.Attrs().Name, err)
 			} else {
@@ -111,27 +130,15 @@ func (as *assignee) assign(ip net.IP, subnetInfo *crdv1b1.SubnetInfo) error {
 			return fmt.Errorf("failed to assign IP %v to NDP responder: %v", ip, err)
 		}
 	}
-	// Always advertise the IP when the IP is newly assigned to this Node.
-	as.advertise(ip)
 	as.ips.Insert(ip.String())
 	return nil
 }
 
-func (as *assignee) advertise(ip net.IP) {
-	if utilnet.IsIPv4(ip) {
-		klog.V(2).InfoS("Sending gratuitous ARP", "ip", ip)
-		if err := arping.GratuitousARPOverIface(ip, as.logicalInterface); err != nil {
-			klog.ErrorS(err, "Failed to send gratuitous ARP", "ip", ip)
-		}
-	} else {
-		klog.V(2).InfoS("Sending neighbor advertisement", "ip", ip)
-		if err := ndp.NeighborAdvertisement(ip, as.logicalInterface); err != nil {
-			klog.ErrorS(err, "Failed to send neighbor advertisement", "ip", ip)
-		}
-	}
-}
-
 func (as *assignee) unassign(ip net.IP, subnetInfo *crdv1b1.SubnetInfo) error {
+	if as.vrrpSpeaker != nil {
+		as.vrrpSpeaker.stop()
+		as.vrrpSpeaker = nil
+	}
 	// If there is a real link, delete the IP from its address list.
 	if as.link != nil {
 		addr := getIPNet(ip, subnetInfo)
@@ -159,6 +166,18 @@ func (as *assignee) unassign(ip net.IP, subnetInfo *crdv1b1.SubnetInfo) error {
 	return nil
 }
 
+// ensureVRRPSpeaker ensures the VRRP advertisements of the IP are sent with the provided configuration.
+func (as *assignee) ensureVRRPSpeaker(ip net.IP, config vrrpConfig) {
+	if as.vrrpSpeaker != nil {
+		if as.vrrpSpeaker.ip.Equal(ip) && as.vrrpSpeaker.config == config {
+			return
+		}
+		as.vrrpSpeaker.stop()
+	}
+	as.vrrpSpeaker = newVRRPSpeaker(as.logicalInterface, ip, config)
+	go as.vrrpSpeaker.run()
+}
+
 func (as *assignee) getVLANID() (int, bool) {
 	if as.link == nil {
 		return 0, false
@@ -210,15 +229,27 @@ type ipAssigner struct {
 	defaultAssignee *assignee
 	// vlanAssignees contains the vlan-based assignees that IPs with VLAN tag will be assigned to, keyed by VLAN ID.
 	vlanAssignees map[int32]*assignee
+	// vrrpAssignees contains the macvlan-based assignees that IPs announced with VRRP will be assigned to, keyed by
+	// Virtual Router ID.
+	vrrpAssignees map[int32]*assignee
+	// vrrpIPs contains the Virtual Router IDs of the assigned IPs announced with VRRP.
+	vrrpIPs map[string]int32
+	// externalIPPoolLister is used to get the announcement of the ExternalIPPools the IPs are allocated from. IPs are
+	// always announced with GARP (IPv4) and Unsolicited NA (IPv6) if it's nil.
+	externalIPPoolLister crdlisters.ExternalIPPoolLister
+	// arpIgnore is the arp_ignore value of the external interface.
+	arpIgnore int
 	// assignIPs caches the IPs that have been assigned.
 	// TODO: Add a goroutine to ensure that the cache is in sync with the IPs assigned to the dummy device in case the
 	// IPs are removed by users accidentally.
 	assignedIPs map[string]*crdv1b1.SubnetInfo
 	mutex       sync.RWMutex
+	// announcer sends and verifies GARP (IPv4) and Unsolicited NA (IPv6) for the assigned IPs.
+	announcer *announcer
 }
 
 // NewIPAssigner returns an *ipAssigner.
-func NewIPAssigner(nodeTransportInterface string, dummyDeviceName string, linkMonitor linkmonitor.Interface) (IPAssigner, error) {
+func NewIPAssigner(nodeTransportInterface string, dummyDeviceName string, linkMonitor linkmonitor.Interface, externalIPPoolLister crdlisters.ExternalIPPoolLister) (IPAssigner, error) {
 	ipv4, ipv6, externalInterface, err := util.GetIPNetDeviceByName(nodeTransportInterface)
 	if err != nil {
 		return nil, fmt.Errorf("get IPNetDevice from name %s error: %+v", nodeTransportInterface, err)
@@ -230,19 +261,23 @@ func NewIPAssigner(nodeTransportInterface string, dummyDeviceName string, linkMo
 			logicalInterface: externalInterface,
 			ips:              sets.New[string](),
 		},
-		vlanAssignees: map[int32]*assignee{},
+		vlanAssignees:        map[int32]*assignee{},
+		vrrpAssignees:        map[int32]*assignee{},
+		vrrpIPs:              map[string]int32{},
+		externalIPPoolLister: externalIPPoolLister,
 	}
+	a.announcer = newAnnouncer(a.getAnnouncementInterface)
 	if ipv4 != nil {
 		// For the Egress scenario, the external IPs should always be present on the dummy
 		// interface as they are used as tunnel endpoints. If arp_ignore is set to a value
 		// other than 0, the host will not reply to ARP requests received on the transport
 		// interface when the target IPs are assigned on the dummy interface. So a userspace
 		// ARP responder is needed to handle ARP requests for the Egress IPs.
-		arpIgnore, err := getARPIgnoreForInterface(externalInterface.Name)
+		a.arpIgnore, err = getARPIgnoreForInterface(externalInterface.Name)
 		if err != nil {
 			return nil, err
 		}
-		if dummyDeviceName == "" || arpIgnore > 0 {
+		if dummyDeviceName == "" || a.arpIgnore > 0 {
 			a.defaultAssignee.arpResponder = responder.NewARPResponder(externalInterface.Name, linkMonitor)
 		}
 	}
@@ -262,9 +297,38 @@ func NewIPAssigner(nodeTransportInterface string, dummyDeviceName string, linkMo
 	for _, vlan := range vlans {
 		a.addVLANAssignee(vlan, int32(vlan.VlanId))
 	}
+	macvlans, err := getVRRPInterfaces(externalInterface.Index)
+	if err != nil {
+		return nil, fmt.Errorf("error when getting VRRP devices: %w", err)
+	}
+	for virtualRouterID, macvlan := range macvlans {
+		a.addVRRPAssignee(macvlan, virtualRouterID)
+	}
 	return a, nil
 }
 
+// getVRRPInterfaces returns the MACVLAN interfaces of the given parent interface created for the IPs announced with
+// VRRP, keyed by Virtual Router ID.
+func getVRRPInterfaces(parentIndex int) (map[int32]*netlink.Macvlan, error) {
+	links, err := netlink.LinkList()
+	if err != nil {
+		return nil, err
+	}
+	macvlans := map[int32]*netlink.Macvlan{}
+	for _, link := range links {
+		macvlan, ok := link.(*netlink.Macvlan)
+		if !ok || macvlan.ParentIndex != parentIndex || !strings.HasPrefix(macvlan.Name, vrrpInterfacePrefix) {
+			continue
+		}
+		virtualRouterID, err := strconv.ParseUint(strings.TrimPrefix(macvlan.Name, vrrpInterfacePrefix), 10, 8)
+		if err != nil {
+			continue
+		}
+		macvlans[int32(virtualRouterID)] = macvlan
+	}
+	return macvlans, nil
+}
+
 // getVLANInterfaces returns all VLAN sub-interfaces of the given parent interface.
 func getVLANInterfaces(parentIndex int) ([]*netlink.Vlan, error) {
 	links, err := netlink.LinkList()
@@ -336,14 +400,55 @@ func (a *ipAssigner) loadIPAddresses() error {
 			a.assignedIPs[k] = v
 		}
 	}
+	// Load IPs assigned to the VRRP interfaces.
+	for virtualRouterID, vrrpAssignee := range a.vrrpAssignees {
+		newAssignedIPs, err := vrrpAssignee.loadIPAddresses()
+		if err != nil {
+			return err
+		}
+		for k, v := range newAssignedIPs {
+			a.assignedIPs[k] = v
+			a.vrrpIPs[k] = virtualRouterID
+		}
+	}
 	return nil
 }
 
+// getVRRPConfig returns the configuration of the VRRP virtual router the IP should be announced with, and false if
+// the IP should not be announced with VRRP.
+func (a *ipAssigner) getVRRPConfig(ip net.IP) (*vrrpConfig, bool) {
+	if a.externalIPPoolLister == nil {
+		return nil, false
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return nil, false
+	}
+	addr = addr.Unmap()
+	pools, _ := a.externalIPPoolLister.List(labels.Everything())
+	for _, pool := range pools {
+		virtualRouterID, ok := crdv1b1.GetVRRPVirtualRouterID(pool, addr)
+		if !ok {
+			continue
+		}
+		interval := pool.Spec.Announcement.VRRP.AdvertisementInterval
+		if interval == 0 {
+			interval = defaultVRRPAdvertisementInterval
+		}
+		return &vrrpConfig{virtualRouterID: uint8(virtualRouterID), advertisementInterval: uint16(interval)}, true
+	}
+	return nil, false
+}
+
 // AssignIP ensures the provided IP is assigned to the system and advertised to its neighbors.
 //   - If subnetInfo is nil or the vlan is 0, the IP will be assigned to the default interface, and its advertisement
 //     will be sent through the external interface.
 //   - Otherwise, the IP will be assigned to a corresponding vlan sub-interface of the external interface, and its
 //     advertisement will be sent through the vlan sub-interface (though via the external interface eventually).
+//   - If the IP is allocated from an ExternalIPPool announced with VRRP, the IP will be assigned to a macvlan
+//     sub-interface of the external interface using the virtual MAC address of its VRRP virtual router, and VRRP
+//     advertisements will be sent through the macvlan sub-interface in addition to its GARP (IPv4) or Unsolicited NA
+//     (IPv6).
 func (a *ipAssigner) AssignIP(ip string, subnetInfo *crdv1b1.SubnetInfo, forceAdvertise bool) (bool, error) {
 	parsedIP := net.ParseIP(ip)
 	if parsedIP == nil {
@@ -352,18 +457,30 @@ func (a *ipAssigner) AssignIP(ip string, subnetInfo *crdv1b1.SubnetInfo, forceAd
 	a.mutex.Lock()
 	defer a.mutex.Unlock()
 
-	as, err := a.getAssignee(subnetInfo, true)
+	var as *assignee
+	var err error
+	vrrp, isVRRP := a.getVRRPConfig(parsedIP)
+	if isVRRP {
+		as, err = a.getVRRPAssignee(int32(vrrp.virtualRouterID), utilnet.IsIPv6(parsedIP), true)
+	} else {
+		as, err = a.getAssignee(subnetInfo, true)
+	}
 	if err != nil {
 		return false, err
 	}
 
 	oldSubnetInfo, exists := a.assignedIPs[ip]
 	if exists {
+		oldVirtualRouterID, wasVRRP := a.vrrpIPs[ip]
 		// ipAssigner doesn't care about the gateway.
-		if crdv1b1.CompareSubnetInfo(subnetInfo, oldSubnetInfo, true) {
+		if crdv1b1.CompareSubnetInfo(subnetInfo, oldSubnetInfo, true) && isVRRP == wasVRRP &&
+			(!isVRRP || oldVirtualRouterID == int32(vrrp.virtualRouterID)) {
 			klog.V(2).InfoS("The IP is already assigned", "ip", ip)
+			if isVRRP {
+				as.ensureVRRPSpeaker(parsedIP, *vrrp)
+			}
 			if forceAdvertise {
-				as.advertise(parsedIP)
+				a.announcer.enqueue(ip)
 			}
 			return false, nil
 		}
@@ -376,6 +493,12 @@ func (a *ipAssigner) AssignIP(ip string, subnetInfo *crdv1b1.SubnetInfo, forceAd
 		return false, err
 	}
 	a.assignedIPs[ip] = subnetInfo
+	if isVRRP {
+		a.vrrpIPs[ip] = int32(vrrp.virtualRouterID)
+		as.ensureVRRPSpeaker(parsedIP, *vrrp)
+	}
+	// Always advertise the IP when the IP is newly assigned to this Node.
+	a.announcer.enqueue(ip)
 	return true, nil
 }
 
@@ -400,7 +523,13 @@ func (a *ipAssigner) UnassignIP(ip string) (bool, error) {
 }
 
 func (a *ipAssigner) unassign(ip net.IP, subnetInfo *crdv1b1.SubnetInfo) error {
-	as, _ := a.getAssignee(subnetInfo, false)
+	virtualRouterID, isVRRP := a.vrrpIPs[ip.String()]
+	var as *assignee
+	if isVRRP {
+		as = a.vrrpAssignees[virtualRouterID]
+	} else {
+		as, _ = a.getAssignee(subnetInfo, false)
+	}
 	// The assignee doesn't exist, meaning the IP has been unassigned previously.
 	if as == nil {
 		return nil
@@ -409,12 +538,21 @@ func (a *ipAssigner) unassign(ip net.IP, subnetInfo *crdv1b1.SubnetInfo) error {
 		return err
 	}
 	if as.deletable() {
-		klog.InfoS("Deleting VLAN sub-interface", "interface", as.logicalInterface.Name, "vlan", subnetInfo.VLAN)
+		if isVRRP {
+			klog.InfoS("Deleting VRRP sub-interface", "interface", as.logicalInterface.Name, "vrid", virtualRouterID)
+		} else {
+			klog.InfoS("Deleting VLAN sub-interface", "interface", as.logicalInterface.Name, "vlan", subnetInfo.VLAN)
+		}
 		if err := as.destroy(); err != nil {
 			return err
 		}
-		delete(a.vlanAssignees, subnetInfo.VLAN)
+		if isVRRP {
+			delete(a.vrrpAssignees, virtualRouterID)
+		} else {
+			delete(a.vlanAssignees, subnetInfo.VLAN)
+		}
 	}
+	delete(a.vrrpIPs, ip.String())
 	delete(a.assignedIPs, ip.String())
 	return nil
 }
@@ -465,8 +603,30 @@ func (a *ipAssigner) GetInterfaceID(subnetInfo *crdv1b1.SubnetInfo) (int, bool)
 	return as.logicalInterface.Index, true
 }
 
-// Run starts the ARP responder and NDP responder.
+// getAnnouncementInterface returns the interface through which the IP should be advertised, and false if the IP is
+// no longer assigned.
+func (a *ipAssigner) getAnnouncementInterface(ip string) (*net.Interface, bool) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	subnetInfo, exists := a.assignedIPs[ip]
+	if !exists {
+		return nil, false
+	}
+	var as *assignee
+	if virtualRouterID, isVRRP := a.vrrpIPs[ip]; isVRRP {
+		as = a.vrrpAssignees[virtualRouterID]
+	} else {
+		as, _ = a.getAssignee(subnetInfo, false)
+	}
+	if as == nil {
+		return nil, false
+	}
+	return as.logicalInterface, true
+}
+
+// Run starts the ARP responder, NDP responder, and IP announcer.
 func (a *ipAssigner) Run(ch <-chan struct{}) {
+	go a.announcer.run(ch)
 	if a.defaultAssignee.arpResponder != nil {
 		go a.defaultAssignee.arpResponder.Run(ch)
 	}
@@ -510,7 +670,58 @@ func (a *ipAssigner) getAssignee(subnetInfo *crdv1b1.SubnetInfo, createIfNotExis
 	return as, nil
 }
 
+// getVRRPAssignee gets or creates the macvlan device for the VRRP virtual router if it doesn't exist.
+func (a *ipAssigner) getVRRPAssignee(virtualRouterID int32, isIPv6 bool, createIfNotExist bool) (*assignee, error) {
+	if as, exists := a.vrrpAssignees[virtualRouterID]; exists {
+		return as, nil
+	}
+	if !createIfNotExist {
+		return nil, nil
+	}
+	// If arp_ignore is 0, the external interface would answer ARP requests for the virtual IP with its own MAC
+	// address, racing with the replies sent from the macvlan interface with the virtual MAC address.
+	if !isIPv6 && a.arpIgnore == 0 {
+		return nil, fmt.Errorf("arp_ignore of interface %s must be set to 1 or 2 to announce IPv4 addresses with VRRP", a.externalInterface.Name)
+	}
+
+	name := fmt.Sprintf("%s%d", vrrpInterfacePrefix, virtualRouterID)
+	klog.InfoS("Creating VRRP sub-interface", "interface", name, "parent", a.externalInterface.Name, "vrid", virtualRouterID)
+	macvlan := &netlink.Macvlan{
+		LinkAttrs: netlink.LinkAttrs{
+			Name:         name,
+			ParentIndex:  a.externalInterface.Index,
+			HardwareAddr: vrrpVirtualMAC(uint8(virtualRouterID), isIPv6),
+		},
+		Mode: netlink.MACVLAN_MODE_BRIDGE,
+	}
+	if err := netlink.LinkAdd(macvlan); err != nil {
+		if !errors.Is(err, unix.EEXIST) {
+			return nil, fmt.Errorf("error creating VRRP sub-interface for Virtual Router ID %d: %w", virtualRouterID, err)
+		}
+	}
+	return a.addVRRPAssignee(macvlan, virtualRouterID)
+}
+
+func (a *ipAssigner) addVRRPAssignee(link netlink.Link, virtualRouterID int32) (*assignee, error) {
+	as, err := newLinkAssignee(link)
+	if err != nil {
+		return nil, err
+	}
+	a.vrrpAssignees[virtualRouterID] = as
+	return as, nil
+}
+
 func (a *ipAssigner) addVLANAssignee(link netlink.Link, vlan int32) (*assignee, error) {
+	as, err := newLinkAssignee(link)
+	if err != nil {
+		return nil, err
+	}
+	a.vlanAssignees[vlan] = as
+	return as, nil
+}
+
+// newLinkAssignee returns an assignee for a sub-interface of the external interface.
+func newLinkAssignee(link netlink.Link) (*assignee, error) {
 	name := link.Attrs().Name
 	// Loose mode is needed because incoming traffic received on the interface is expected to be received on the parent
 	// external interface when looking up the main table. To make it look up the custom table, we will need to restore
@@ -532,14 +743,12 @@ func (a *ipAssigner) addVLANAssignee(link netlink.Link, vlan int32) (*assignee,
 	if err != nil {
 		return nil, err
 	}
-	// VLAN interface can answer ARP/NDP directly, no need to create userspace responders.
-	as := &assignee{
+	// VLAN and MACVLAN interfaces can answer ARP/NDP directly, no need to create userspace responders.
+	return &assignee{
 		logicalInterface: iface,
 		link:             link,
 		ips:              sets.New[string](),
-	}
-	a.vlanAssignees[vlan] = as
-	return as, nil
+	}, nil
 }
 
 func getIPNet(ip net.IP, subnetInfo *crdv1b1.SubnetInfo) *net.IPNet {
@@ -15,11 +15,184 @@
 package ipassigner
 
 import (
-	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
 
 	"antrea.io/antrea/pkg/agent/ipassigner/linkmonitor"
+	"antrea.io/antrea/pkg/agent/util/winnet"
+	crdv1b1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 )
 
-func NewIPAssigner(nodeTransportInterface string, dummyDeviceName string, linkMonitor linkmonitor.Interface) (IPAssigner, error) {
-	return nil, errors.New("IPAssigner is not implemented on Windows")
+var winnetUtil winnet.Interface = &winnet.Handle{}
+
+// ipAssigner assigns IPs to the Node's transport interface on Windows. The IPs are assigned with SkipAsSource set, so
+// that the host never uses them as the source address of its own traffic, and can be told apart from the IPs
+// configured by others. As other software may configure IPs with SkipAsSource as well, only the IPs which belong to an
+// ExternalIPPool are considered owned by the ipAssigner. Windows announces the IPs with gratuitous ARP when they are
+// assigned.
+// Assigning IPs to VLAN sub-interfaces and announcing IPs with VRRP are not supported.
+type ipAssigner struct {
+	// externalInterface is the interface to which the IPs are assigned.
+	externalInterface *net.Interface
+	// externalIPPoolLister is used to tell the IPs assigned by the ipAssigner from the ones configured by others.
+	externalIPPoolLister crdlisters.ExternalIPPoolLister
+
+	mutex sync.RWMutex
+	// assignedIPs caches the IPs that are assigned to the external interface.
+	assignedIPs map[string]*crdv1b1.SubnetInfo
+}
+
+// NewIPAssigner returns an *ipAssigner.
+func NewIPAssigner(nodeTransportInterface string, dummyDeviceName string, linkMonitor linkmonitor.Interface, externalIPPoolLister crdlisters.ExternalIPPoolLister) (IPAssigner, error) {
+	externalInterface, err := net.InterfaceByName(nodeTransportInterface)
+	if err != nil {
+		return nil, fmt.Errorf("get interface by name %s error: %w", nodeTransportInterface, err)
+	}
+	return &ipAssigner{
+		externalInterface:    externalInterface,
+		externalIPPoolLister: externalIPPoolLister,
+		assignedIPs:          map[string]*crdv1b1.SubnetInfo{},
+	}, nil
+}
+
+func (a *ipAssigner) loadIPAddresses() error {
+	ips, err := winnetUtil.GetNetAdapterSkipAsSourceIPAddresses(a.externalInterface.Name)
+	if err != nil {
+		return err
+	}
+	assignedIPs := map[string]*crdv1b1.SubnetInfo{}
+	for _, ip := range ips {
+		if !a.isExternalIPPoolIP(ip) {
+			klog.V(2).InfoS("Ignored IP which doesn't belong to any ExternalIPPool", "ip", ip, "interface", a.externalInterface.Name)
+			continue
+		}
+		assignedIPs[ip.String()] = nil
+	}
+	a.assignedIPs = assignedIPs
+	return nil
+}
+
+// isExternalIPPoolIP returns whether the IP belongs to an ExternalIPPool. The ExternalIPPool informer must have been
+// synced.
+func (a *ipAssigner) isExternalIPPoolIP(ip net.IP) bool {
+	if a.externalIPPoolLister == nil {
+		return false
+	}
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+	pools, _ := a.externalIPPoolLister.List(labels.Everything())
+	for _, pool := range pools {
+		for _, ipRange := range pool.Spec.IPRanges {
+			start, end, ok := crdv1b1.ParseIPRange(ipRange)
+			if !ok {
+				continue
+			}
+			if start.Compare(addr) <= 0 && addr.Compare(end) <= 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AssignIP ensures the provided IP is assigned to the external interface. Only IPv4 is supported, and subnetInfo must
+// not specify a VLAN.
+func (a *ipAssigner) AssignIP(ip string, subnetInfo *crdv1b1.SubnetInfo, forceAdvertise bool) (bool, error) {
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return false, fmt.Errorf("invalid IP %s", ip)
+	}
+	if !utilnet.IsIPv4(parsedIP) {
+		return false, fmt.Errorf("IPv6 IP %s is not supported on Windows", ip)
+	}
+	if subnetInfo != nil && subnetInfo.VLAN != 0 {
+		return false, fmt.Errorf("assigning IP %s to VLAN %d is not supported on Windows", ip, subnetInfo.VLAN)
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if _, exists := a.assignedIPs[ip]; exists {
+		klog.V(2).InfoS("The IP is already assigned", "ip", ip)
+		a.assignedIPs[ip] = subnetInfo
+		return false, nil
+	}
+	ipNet := &net.IPNet{IP: parsedIP, Mask: net.CIDRMask(32, 32)}
+	if err := winnetUtil.AddNetAdapterSkipAsSourceIPAddress(a.externalInterface.Name, ipNet); err != nil {
+		return false, fmt.Errorf("failed to add IP %s to interface %s: %w", ip, a.externalInterface.Name, err)
+	}
+	a.assignedIPs[ip] = subnetInfo
+	return true, nil
+}
+
+// UnassignIP ensures the provided IP is not assigned to the external interface.
+func (a *ipAssigner) UnassignIP(ip string) (bool, error) {
+	parsedIP := net.ParseIP(ip)
+	if parsedIP == nil {
+		return false, fmt.Errorf("invalid IP %s", ip)
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if _, exists := a.assignedIPs[ip]; !exists {
+		klog.V(2).InfoS("The IP is not assigned", "ip", ip)
+		return false, nil
+	}
+	if err := winnetUtil.RemoveNetAdapterIPAddress(a.externalInterface.Name, parsedIP); err != nil {
+		return false, fmt.Errorf("failed to delete IP %s from interface %s: %w", ip, a.externalInterface.Name, err)
+	}
+	delete(a.assignedIPs, ip)
+	return true, nil
+}
+
+// AssignedIPs return the IPs that are assigned to the external interface.
+func (a *ipAssigner) AssignedIPs() map[string]*crdv1b1.SubnetInfo {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	// Return a copy.
+	copy := map[string]*crdv1b1.SubnetInfo{}
+	for k, v := range a.assignedIPs {
+		copy[k] = v
+	}
+	return copy
+}
+
+// InitIPs loads the IPs from the external interface and replaces the IPs that are assigned to it with the given ones.
+// The SkipAsSource IPs which don't belong to any ExternalIPPool are left untouched. It's not thread-safe and should only be called once for initialization before calling other methods.
+func (a *ipAssigner) InitIPs(desired map[string]*crdv1b1.SubnetInfo) error {
+	if err := a.loadIPAddresses(); err != nil {
+		return fmt.Errorf("error when loading IP addresses from the system: %v", err)
+	}
+	staleIPs := sets.StringKeySet(a.assignedIPs)
+	for ip, desiredSubnetInfo := range desired {
+		if _, err := a.AssignIP(ip, desiredSubnetInfo, true); err != nil {
+			return err
+		}
+		staleIPs.Delete(ip)
+	}
+	for ip := range staleIPs {
+		if _, err := a.UnassignIP(ip); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetInterfaceID always returns false as assigning IPs to VLAN sub-interfaces is not supported on Windows.
+func (a *ipAssigner) GetInterfaceID(subnetInfo *crdv1b1.SubnetInfo) (int, bool) {
+	return 0, false
+}
+
+func (a *ipAssigner) Run(ch <-chan struct{}) {
+	<-ch
 }
@@ -21,8 +21,10 @@ func NewLinkMonitor() *linkMonitor {
 	return &linkMonitor{}
 }
 
+// HasSynced always returns true as there is no cache to sync. The link monitor is only used to track the VLAN
+// sub-interfaces created by the IPAssigner, which is not supported on Windows.
 func (d *linkMonitor) HasSynced() bool {
-	return false
+	return true
 }
 
 func (d *linkMonitor) AddEventHandler(handler LinkEventHandler, linkNames ...string) {
@@ -14,23 +14,98 @@
 
 package ipassigner
 
-// Not implemented yet. The feature gate verification will protect this from being run.
-type localIPDetector struct{}
+import (
+	"net"
+	"sync"
+	"time"
 
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// localIPListInterval is the interval at which the IP addresses on the Node are listed. Windows doesn't provide a
+// netlink-like subscription which can be consumed easily, so the detector polls the IP addresses instead.
+const localIPListInterval = 2 * time.Second
+
+type localIPDetector struct {
+	mutex         sync.RWMutex
+	localIPs      sets.Set[string]
+	cacheSynced   bool
+	eventHandlers []LocalIPEventHandler
+	// listIPAddresses is stored as a field to allow overriding it in tests.
+	listIPAddresses func() ([]net.Addr, error)
+}
+
+func NewLocalIPDetector() *localIPDetector {
+	return &localIPDetector{
+		localIPs:        sets.New[string](),
+		listIPAddresses: net.InterfaceAddrs,
+	}
+}
+
+// IsLocalIP checks if the provided IP is configured on the Node.
 func (d *localIPDetector) IsLocalIP(ip string) bool {
-	return false
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	return d.localIPs.Has(ip)
 }
 
-func (d *localIPDetector) Run(stopCh <-chan struct{}) {
+func (d *localIPDetector) HasSynced() bool {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	return d.cacheSynced
 }
 
 func (d *localIPDetector) AddEventHandler(handler LocalIPEventHandler) {
+	d.eventHandlers = append(d.eventHandlers, handler)
 }
 
-func (d *localIPDetector) HasSynced() bool {
-	return false
+func (d *localIPDetector) Run(stopCh <-chan struct{}) {
+	klog.Infof("Starting localIPDetector")
+
+	go wait.Until(d.syncIPAddresses, localIPListInterval, stopCh)
+
+	<-stopCh
 }
 
-func NewLocalIPDetector() *localIPDetector {
-	return &localIPDetector{}
+func (d *localIPDetector) notify(ip string, added bool) {
+	for _, handler := range d.eventHandlers {
+		handler(ip, added)
+	}
+}
+
+// syncIPAddresses lists the IP addresses on the Node and calls eventHandlers to process the ones added or removed
+// since the last time.
+func (d *localIPDetector) syncIPAddresses() {
+	addresses, err := d.listIPAddresses()
+	if err != nil {
+		klog.ErrorS(err, "Failed to list IP addresses on the Node")
+		return
+	}
+	ips := sets.New[string]()
+	for _, addr := range addresses {
+		if ipNet, ok := addr.(*net.IPNet); ok {
+			ips.Insert(ipNet.IP.String())
+		}
+	}
+
+	addedAddresses, deletedAddresses := func() (sets.Set[string], sets.Set[string]) {
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+
+		added := ips.Difference(d.localIPs)
+		deleted := d.localIPs.Difference(ips)
+		d.localIPs = ips
+		d.cacheSynced = true
+		return added, deleted
+	}()
+	for addr := range addedAddresses {
+		klog.V(4).InfoS("Detected new local IP address", "ip", addr)
+		d.notify(addr, true)
+	}
+	for addr := range deletedAddresses {
+		klog.V(4).InfoS("Detected deleted local IP address", "ip", addr)
+		d.notify(addr, false)
+	}
 }
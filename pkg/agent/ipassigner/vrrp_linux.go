@@ -0,0 +1,235 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipassigner
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"time"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+)
+
+const (
+	// vrrpProtocol is the IP protocol number of VRRP.
+	vrrpProtocol = 112
+	// vrrpVersion and vrrpTypeAdvertisement are the version and the type of the VRRPv3 packets defined in RFC 5798.
+	vrrpVersion           = 3
+	vrrpTypeAdvertisement = 1
+	// vrrpTTL is the TTL (IPv4) or hop limit (IPv6) of VRRP packets, which must be 255.
+	vrrpTTL = 255
+	// vrrpPriority is the priority advertised by the Node owning the virtual IP. As antrea-agent decides which Node
+	// owns the virtual IP, there is never a backup advertising it, and the default priority of a backup is used.
+	vrrpPriority = 100
+	// vrrpReleasePriority is the priority advertised when the Node stops owning the virtual IP, which tells the
+	// neighbors that the virtual router has stopped participating.
+	vrrpReleasePriority = 0
+	// defaultVRRPAdvertisementInterval is the interval between advertisements in centiseconds if not specified.
+	defaultVRRPAdvertisementInterval = 100
+	// vrrpHeaderLen is the length of the VRRP header, without the IP addresses.
+	vrrpHeaderLen = 8
+)
+
+var (
+	vrrpIPv4Group = net.IPv4(224, 0, 0, 18)
+	vrrpIPv6Group = net.ParseIP("ff02::12")
+)
+
+// vrrpConfig is the configuration of the VRRP virtual router an IP is announced with.
+type vrrpConfig struct {
+	virtualRouterID uint8
+	// advertisementInterval is in centiseconds.
+	advertisementInterval uint16
+}
+
+// vrrpVirtualMAC returns the virtual router MAC address of the virtual router, as defined in RFC 5798 section 7.3.
+func vrrpVirtualMAC(virtualRouterID uint8, isIPv6 bool) net.HardwareAddr {
+	if isIPv6 {
+		return net.HardwareAddr{0x00, 0x00, 0x5e, 0x00, 0x02, virtualRouterID}
+	}
+	return net.HardwareAddr{0x00, 0x00, 0x5e, 0x00, 0x01, virtualRouterID}
+}
+
+// marshalVRRPAdvertisement returns a VRRPv3 advertisement for the virtual IP. The checksum is left zero.
+func marshalVRRPAdvertisement(config vrrpConfig, priority uint8, ip net.IP) []byte {
+	addr := ip.To4()
+	if addr == nil {
+		addr = ip.To16()
+	}
+	b := make([]byte, vrrpHeaderLen+len(addr))
+	b[0] = vrrpVersion<<4 | vrrpTypeAdvertisement
+	b[1] = config.virtualRouterID
+	b[2] = priority
+	// The number of IP addresses contained in the advertisement.
+	b[3] = 1
+	// The 4 upper bits are reserved, the 12 lower bits are the advertisement interval.
+	binary.BigEndian.PutUint16(b[4:6], config.advertisementInterval&0x0fff)
+	copy(b[vrrpHeaderLen:], addr)
+	return b
+}
+
+// vrrpIPv4Checksum returns the checksum of the VRRP packet sent over IPv4, which covers the IPv4 pseudo-header.
+func vrrpIPv4Checksum(src, dst net.IP, packet []byte) uint16 {
+	pseudoHeader := make([]byte, 12)
+	copy(pseudoHeader[0:4], src.To4())
+	copy(pseudoHeader[4:8], dst.To4())
+	pseudoHeader[9] = vrrpProtocol
+	binary.BigEndian.PutUint16(pseudoHeader[10:12], uint16(len(packet)))
+
+	var sum uint32
+	for _, b := range [][]byte{pseudoHeader, packet} {
+		for i := 0; i+1 < len(b); i += 2 {
+			sum += uint32(b[i])<<8 | uint32(b[i+1])
+		}
+		if len(b)%2 == 1 {
+			sum += uint32(b[len(b)-1]) << 8
+		}
+	}
+	for sum > 0xffff {
+		sum = sum>>16 + sum&0xffff
+	}
+	return ^uint16(sum)
+}
+
+// vrrpSpeaker periodically sends the VRRP advertisements of a virtual IP from the interface the IP is assigned to, as
+// the master of the virtual router. It doesn't process the advertisements of other routers, as the Node owning the
+// virtual IP is decided by antrea-agent.
+type vrrpSpeaker struct {
+	iface  *net.Interface
+	ip     net.IP
+	config vrrpConfig
+	stopCh chan struct{}
+	doneCh chan struct{}
+}
+
+func newVRRPSpeaker(iface *net.Interface, ip net.IP, config vrrpConfig) *vrrpSpeaker {
+	return &vrrpSpeaker{
+		iface:  iface,
+		ip:     ip,
+		config: config,
+		stopCh: make(chan struct{}),
+		doneCh: make(chan struct{}),
+	}
+}
+
+// stop stops sending advertisements, and sends an advertisement with priority 0 to release the virtual router.
+func (s *vrrpSpeaker) stop() {
+	close(s.stopCh)
+	<-s.doneCh
+}
+
+func (s *vrrpSpeaker) run() {
+	defer close(s.doneCh)
+	klog.InfoS("Starting VRRP speaker", "ip", s.ip, "interface", s.iface.Name, "vrid", s.config.virtualRouterID)
+	defer klog.InfoS("Stopped VRRP speaker", "ip", s.ip, "interface", s.iface.Name, "vrid", s.config.virtualRouterID)
+
+	var conn net.PacketConn
+	defer func() {
+		if conn != nil {
+			conn.Close()
+		}
+	}()
+	send := func(priority uint8) {
+		var err error
+		if conn == nil {
+			if conn, err = s.dial(); err != nil {
+				klog.ErrorS(err, "Failed to create VRRP connection", "ip", s.ip, "interface", s.iface.Name)
+				return
+			}
+		}
+		if err = s.send(conn, priority); err != nil {
+			klog.ErrorS(err, "Failed to send VRRP advertisement", "ip", s.ip, "interface", s.iface.Name)
+			// Recreate the connection next time in case the interface has been recreated.
+			conn.Close()
+			conn = nil
+		}
+	}
+
+	ticker := time.NewTicker(time.Duration(s.config.advertisementInterval) * 10 * time.Millisecond)
+	defer ticker.Stop()
+	send(vrrpPriority)
+	for {
+		select {
+		case <-ticker.C:
+			send(vrrpPriority)
+		case <-s.stopCh:
+			send(vrrpReleasePriority)
+			return
+		}
+	}
+}
+
+// dial returns a raw IP connection sending VRRP packets from the interface. The IPv4 packets are sent with the virtual
+// IP as the source IP, and the IPv6 packets with the link-local address of the interface, as required by RFC 5798.
+func (s *vrrpSpeaker) dial() (net.PacketConn, error) {
+	if utilnet.IsIPv4(s.ip) {
+		conn, err := net.ListenPacket(fmt.Sprintf("ip4:%d", vrrpProtocol), s.ip.String())
+		if err != nil {
+			return nil, err
+		}
+		pc := ipv4.NewPacketConn(conn)
+		if err := pc.SetMulticastInterface(s.iface); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if err := pc.SetMulticastTTL(vrrpTTL); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		if err := pc.SetMulticastLoopback(false); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return conn, nil
+	}
+	conn, err := net.ListenPacket(fmt.Sprintf("ip6:%d", vrrpProtocol), "::")
+	if err != nil {
+		return nil, err
+	}
+	pc := ipv6.NewPacketConn(conn)
+	if err := pc.SetMulticastInterface(s.iface); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := pc.SetMulticastHopLimit(vrrpTTL); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := pc.SetMulticastLoopback(false); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	// Let the kernel compute the checksum with the IPv6 pseudo-header, at offset 6 of the VRRP packet.
+	if err := pc.SetChecksum(true, 6); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (s *vrrpSpeaker) send(conn net.PacketConn, priority uint8) error {
+	packet := marshalVRRPAdvertisement(s.config, priority, s.ip)
+	if utilnet.IsIPv4(s.ip) {
+		binary.BigEndian.PutUint16(packet[6:8], vrrpIPv4Checksum(s.ip, vrrpIPv4Group, packet))
+		_, err := conn.WriteTo(packet, &net.IPAddr{IP: vrrpIPv4Group})
+		return err
+	}
+	_, err := conn.WriteTo(packet, &net.IPAddr{IP: vrrpIPv6Group, Zone: s.iface.Name})
+	return err
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipassigner
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVRRPVirtualMAC(t *testing.T) {
+	assert.Equal(t, "00:00:5e:00:01:0a", vrrpVirtualMAC(10, false).String())
+	assert.Equal(t, "00:00:5e:00:02:ff", vrrpVirtualMAC(255, true).String())
+}
+
+func TestMarshalVRRPAdvertisement(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         vrrpConfig
+		priority       uint8
+		ip             net.IP
+		expectedPacket []byte
+	}{
+		{
+			name:     "IPv4",
+			config:   vrrpConfig{virtualRouterID: 10, advertisementInterval: 100},
+			priority: vrrpPriority,
+			ip:       net.ParseIP("192.168.1.10"),
+			expectedPacket: []byte{
+				0x31, 0x0a, 0x64, 0x01, 0x00, 0x64, 0x00, 0x00,
+				0xc0, 0xa8, 0x01, 0x0a,
+			},
+		},
+		{
+			name:     "IPv6 release",
+			config:   vrrpConfig{virtualRouterID: 255, advertisementInterval: 4095},
+			priority: vrrpReleasePriority,
+			ip:       net.ParseIP("2021:1::a"),
+			expectedPacket: []byte{
+				0x31, 0xff, 0x00, 0x01, 0x0f, 0xff, 0x00, 0x00,
+				0x20, 0x21, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedPacket, marshalVRRPAdvertisement(tt.config, tt.priority, tt.ip))
+		})
+	}
+}
+
+func TestVRRPIPv4Checksum(t *testing.T) {
+	ip := net.ParseIP("192.168.1.10")
+	packet := marshalVRRPAdvertisement(vrrpConfig{virtualRouterID: 10, advertisementInterval: 100}, vrrpPriority, ip)
+	checksum := vrrpIPv4Checksum(ip, vrrpIPv4Group, packet)
+	assert.Equal(t, uint16(0x069c), checksum)
+	// The checksum of a packet including its checksum must be 0.
+	binary.BigEndian.PutUint16(packet[6:8], checksum)
+	assert.Equal(t, uint16(0), vrrpIPv4Checksum(ip, vrrpIPv4Group, packet))
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipsec
+
+import (
+	"net"
+)
+
+// Interface configures the IPsec connections to peer Nodes on the platforms where the OVS IPsec monitor is not
+// available, i.e. Windows. On these platforms, the tunnel traffic sent through the default tunnel port is encrypted
+// by the IPsec stack of the host.
+type Interface interface {
+	// Init checks that the IKE daemon is running and removes the IPsec connections configured by a previous
+	// instance of antrea-agent.
+	Init() error
+	// UpdateCertificate sets the certificate, private key and CA certificate used to authenticate the Node to its
+	// peers. The IPsec connections are updated to use them.
+	UpdateCertificate(certPath, keyPath, caPath string) error
+	// UpdatePeer creates or updates the IPsec connection to the peer Node.
+	UpdatePeer(nodeName string, peerNodeIP net.IP) error
+	// DeletePeer deletes the IPsec connection to the peer Node.
+	DeletePeer(nodeName string) error
+}
@@ -0,0 +1,385 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipsec
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"k8s.io/klog/v2"
+	"k8s.io/utils/exec"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/ovs/ovsconfig"
+)
+
+const (
+	// defaultConfigPath is the swanctl configuration file written by antrea-agent. It contains all the IPsec
+	// connections to peer Nodes.
+	defaultConfigPath = "/var/run/antrea/ipsec/swanctl.conf"
+	// connectionNamePrefix is the prefix of the names of the IKE connections to peer Nodes.
+	connectionNamePrefix = "antrea-"
+	// blockConnectionName is the name of the connection installing the drop policies for the tunnel traffic. Node
+	// names cannot contain underscores, so it cannot conflict with the connection to a peer Node.
+	blockConnectionName = connectionNamePrefix + "_block"
+)
+
+// defaultTunnelPorts are the IANA ports of the tunnel protocols, used when no tunnel port is configured.
+var defaultTunnelPorts = map[ovsconfig.TunnelType]int32{
+	ovsconfig.GeneveTunnel: 6081,
+	ovsconfig.VXLANTunnel:  4789,
+	ovsconfig.STTTunnel:    7471,
+}
+
+// The connection to a peer Node uses two CHILD_SAs in transport mode, one for the tunnel packets sent to the peer
+// and one for the tunnel packets received from the peer, in the same way as the OVS IPsec monitor. The traffic
+// selectors only match the tunnel traffic, as the source port of the tunnel packets is not fixed.
+// The block connection installs drop policies for all the tunnel traffic, so that it is never sent or received in
+// clear text, e.g. before the certificate is ready or when a peer Node cannot be configured. The policies of the
+// connections to peer Nodes have more specific traffic selectors, so they take precedence.
+var configTemplate = template.Must(template.New("swanctl").Parse(`# Generated by antrea-agent, do not edit.
+connections {
+  {{ .BlockConnectionName }} {
+    children {
+      in {
+        local_ts = 0.0.0.0/0[{{ .Protocol }}/{{ .Port }}],::/0[{{ .Protocol }}/{{ .Port }}]
+        remote_ts = 0.0.0.0/0[{{ .Protocol }}],::/0[{{ .Protocol }}]
+        mode = drop
+        start_action = trap
+      }
+      out {
+        local_ts = 0.0.0.0/0[{{ .Protocol }}],::/0[{{ .Protocol }}]
+        remote_ts = 0.0.0.0/0[{{ .Protocol }}/{{ .Port }}],::/0[{{ .Protocol }}/{{ .Port }}]
+        mode = drop
+        start_action = trap
+      }
+    }
+  }
+{{- range .Connections }}
+  {{ .Name }} {
+    version = 2
+    local_addrs = {{ .LocalIP }}
+    remote_addrs = {{ .PeerIP }}
+    local {
+      auth = pubkey
+      certs = {{ $.CertPath }}
+      id = {{ $.NodeName }}
+    }
+    remote {
+      auth = pubkey
+      cacerts = {{ $.CAPath }}
+      id = {{ .NodeName }}
+    }
+    children {
+      in {
+        local_ts = {{ .LocalIP }}[{{ $.Protocol }}/{{ $.Port }}]
+        remote_ts = {{ .PeerIP }}[{{ $.Protocol }}]
+        mode = transport
+        start_action = trap
+      }
+      out {
+        local_ts = {{ .LocalIP }}[{{ $.Protocol }}]
+        remote_ts = {{ .PeerIP }}[{{ $.Protocol }}/{{ $.Port }}]
+        mode = transport
+        start_action = trap
+      }
+    }
+  }
+{{- end }}
+}
+{{- if .KeyPath }}
+secrets {
+  private-antrea {
+    file = {{ .KeyPath }}
+  }
+}
+{{- end }}
+`))
+
+type peer struct {
+	localIP net.IP
+	peerIP  net.IP
+}
+
+// strongSwanClient implements Interface with strongSwan. All the IPsec connections are written to a single swanctl
+// configuration file, which is loaded again every time it changes. As "swanctl --load-all" unloads the connections
+// which are no longer in the file, there is no need to track the connections loaded in the IKE daemon.
+type strongSwanClient struct {
+	exec       exec.Interface
+	configPath string
+	nodeName   string
+	nodeConfig *config.NodeConfig
+	// protocol and port identify the tunnel traffic to encrypt.
+	protocol string
+	port     int32
+
+	// mutex protects the fields below, as the connections are updated by both the NodeRouteController and the
+	// IPsec certificate controller.
+	mutex    sync.Mutex
+	certPath string
+	keyPath  string
+	caPath   string
+	// certDigest is the digest of the content of the certificate, private key and CA certificate, which can be
+	// rotated in place.
+	certDigest string
+	// peers is a map from Node names to the peers with an IPsec connection.
+	peers map[string]*peer
+}
+
+var _ Interface = (*strongSwanClient)(nil)
+
+// NewStrongSwanClient returns an Interface configuring the IPsec connections to peer Nodes with the swanctl command
+// of strongSwan, which must be in the PATH of antrea-agent. The IKE daemon of strongSwan must be running on the
+// Node.
+func NewStrongSwanClient(nodeConfig *config.NodeConfig, networkConfig *config.NetworkConfig) Interface {
+	protocol := "udp"
+	if networkConfig.TunnelType == ovsconfig.STTTunnel {
+		protocol = "tcp"
+	}
+	port := networkConfig.TunnelPort
+	if port == 0 {
+		port = defaultTunnelPorts[networkConfig.TunnelType]
+	}
+	return &strongSwanClient{
+		exec:       exec.New(),
+		configPath: defaultConfigPath,
+		nodeName:   nodeConfig.Name,
+		nodeConfig: nodeConfig,
+		protocol:   protocol,
+		port:       port,
+		peers:      make(map[string]*peer),
+	}
+}
+
+func (c *strongSwanClient) Init() error {
+	if output, err := c.exec.Command("swanctl", "--stats").CombinedOutput(); err != nil {
+		return fmt.Errorf("IPsec was requested, but the strongSwan IKE daemon does not seem to be running: %w, output: %s", err, output)
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// Loading a configuration without connections unloads the connections configured by a previous instance of
+	// antrea-agent. The NodeRouteController configures the connections to the current peers again.
+	return c.loadConfig()
+}
+
+func (c *strongSwanClient) UpdateCertificate(certPath, keyPath, caPath string) error {
+	var err error
+	if certPath, err = absPath(certPath); err != nil {
+		return err
+	}
+	if keyPath, err = absPath(keyPath); err != nil {
+		return err
+	}
+	if caPath, err = absPath(caPath); err != nil {
+		return err
+	}
+	certDigest, err := fileDigest(certPath, keyPath, caPath)
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// The configuration must be loaded again when the files are updated in place, e.g. when the CA certificate is
+	// rotated, as the IKE daemon only reads them when the configuration is loaded.
+	if c.certPath == certPath && c.keyPath == keyPath && c.caPath == caPath && c.certDigest == certDigest {
+		return nil
+	}
+	oldCertPath, oldKeyPath, oldCAPath, oldCertDigest := c.certPath, c.keyPath, c.caPath, c.certDigest
+	c.certPath, c.keyPath, c.caPath, c.certDigest = certPath, keyPath, caPath, certDigest
+	klog.InfoS("Updating strongSwan configuration for IPsec certificates", "cert", c.certPath, "key", c.keyPath, "ca", c.caPath)
+	if err := c.loadConfig(); err != nil {
+		c.certPath, c.keyPath, c.caPath, c.certDigest = oldCertPath, oldKeyPath, oldCAPath, oldCertDigest
+		return err
+	}
+	return nil
+}
+
+func (c *strongSwanClient) UpdatePeer(nodeName string, peerNodeIP net.IP) error {
+	localIP, err := c.getLocalIP(peerNodeIP)
+	if err != nil {
+		return err
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.certPath == "" {
+		return fmt.Errorf("IPsec certificate is not configured yet")
+	}
+	oldPeer, exists := c.peers[nodeName]
+	if exists && oldPeer.localIP.Equal(localIP) && oldPeer.peerIP.Equal(peerNodeIP) {
+		return nil
+	}
+	klog.InfoS("Updating IPsec connection to peer Node", "node", nodeName, "peerNodeIP", peerNodeIP)
+	c.peers[nodeName] = &peer{localIP: localIP, peerIP: peerNodeIP}
+	if err := c.loadConfig(); err != nil {
+		if exists {
+			c.peers[nodeName] = oldPeer
+		} else {
+			delete(c.peers, nodeName)
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *strongSwanClient) DeletePeer(nodeName string) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	oldPeer, exists := c.peers[nodeName]
+	if !exists {
+		return nil
+	}
+	klog.InfoS("Deleting IPsec connection to peer Node", "node", nodeName)
+	delete(c.peers, nodeName)
+	if err := c.loadConfig(); err != nil {
+		c.peers[nodeName] = oldPeer
+		return err
+	}
+	// Unloading the connection doesn't close its established SAs.
+	if output, err := c.exec.Command("swanctl", "--terminate", "--ike", connectionName(nodeName), "--force").CombinedOutput(); err != nil {
+		klog.ErrorS(err, "Failed to terminate IKE SA of peer Node", "node", nodeName, "output", string(output))
+	}
+	return nil
+}
+
+func (c *strongSwanClient) getLocalIP(peerNodeIP net.IP) (net.IP, error) {
+	transportAddr := c.nodeConfig.NodeTransportIPv4Addr
+	if peerNodeIP.To4() == nil {
+		transportAddr = c.nodeConfig.NodeTransportIPv6Addr
+	}
+	if transportAddr == nil {
+		return nil, fmt.Errorf("no transport IP of the same family as peer Node IP %s", peerNodeIP)
+	}
+	return transportAddr.IP, nil
+}
+
+// loadConfig writes the swanctl configuration file and loads it into the IKE daemon. If it cannot be loaded, the
+// configuration may be partially loaded, so the configuration without any peer Node is loaded instead, which blocks
+// all the tunnel traffic until the configuration is loaded successfully. It must be called with mutex held.
+func (c *strongSwanClient) loadConfig() error {
+	err := c.writeAndLoadConfig(true)
+	if err == nil {
+		return nil
+	}
+	if blockErr := c.writeAndLoadConfig(false); blockErr != nil {
+		klog.ErrorS(blockErr, "Failed to block tunnel traffic after failing to load strongSwan configuration")
+	}
+	return err
+}
+
+func (c *strongSwanClient) writeAndLoadConfig(withPeers bool) error {
+	data, err := c.renderConfig(withPeers)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.configPath), 0700); err != nil {
+		return fmt.Errorf("error creating directory for strongSwan configuration: %w", err)
+	}
+	if err := os.WriteFile(c.configPath, data, 0600); err != nil {
+		return fmt.Errorf("error writing strongSwan configuration: %w", err)
+	}
+	if output, err := c.exec.Command("swanctl", "--load-all", "--noprompt", "--file", c.configPath).CombinedOutput(); err != nil {
+		return fmt.Errorf("error loading strongSwan configuration: %w, output: %s", err, output)
+	}
+	return nil
+}
+
+// renderConfig renders the swanctl configuration. The connections to peer Nodes are only included if withPeers is
+// true.
+func (c *strongSwanClient) renderConfig(withPeers bool) ([]byte, error) {
+	type connection struct {
+		Name     string
+		NodeName string
+		LocalIP  string
+		PeerIP   string
+	}
+	nodeNames := make([]string, 0, len(c.peers))
+	if withPeers {
+		for nodeName := range c.peers {
+			nodeNames = append(nodeNames, nodeName)
+		}
+	}
+	sort.Strings(nodeNames)
+	connections := make([]connection, 0, len(nodeNames))
+	for _, nodeName := range nodeNames {
+		p := c.peers[nodeName]
+		connections = append(connections, connection{
+			Name:     connectionName(nodeName),
+			NodeName: nodeName,
+			LocalIP:  p.localIP.String(),
+			PeerIP:   p.peerIP.String(),
+		})
+	}
+	var buf bytes.Buffer
+	if err := configTemplate.Execute(&buf, struct {
+		BlockConnectionName string
+		NodeName            string
+		CertPath            string
+		KeyPath             string
+		CAPath              string
+		Protocol            string
+		Port                int32
+		Connections         []connection
+	}{
+		BlockConnectionName: blockConnectionName,
+		NodeName:            c.nodeName,
+		CertPath:            c.certPath,
+		KeyPath:             c.keyPath,
+		CAPath:              c.caPath,
+		Protocol:            c.protocol,
+		Port:                c.port,
+		Connections:         connections,
+	}); err != nil {
+		return nil, fmt.Errorf("error rendering strongSwan configuration: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// connectionName returns the name of the IKE connection to the peer Node. Dots are used as separators in the
+// swanctl configuration, so they are replaced with underscores, which cannot be found in Node names.
+func connectionName(nodeName string) string {
+	return connectionNamePrefix + strings.ReplaceAll(nodeName, ".", "_")
+}
+
+// fileDigest returns the SHA-256 digest of the content of the provided files.
+func fileDigest(paths ...string) (string, error) {
+	h := sha256.New()
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("error reading %s: %w", path, err)
+		}
+		h.Write(data)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// absPath returns the absolute path of a file in the format used in the swanctl configuration, which doesn't
+// support backslashes.
+func absPath(path string) (string, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("error getting absolute path of %s: %w", path, err)
+	}
+	return filepath.ToSlash(abs), nil
+}
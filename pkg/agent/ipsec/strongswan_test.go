@@ -0,0 +1,235 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipsec
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/exec"
+	exectesting "k8s.io/utils/exec/testing"
+
+	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/ovs/ovsconfig"
+)
+
+const expectedBlockConnection = `  antrea-_block {
+    children {
+      in {
+        local_ts = 0.0.0.0/0[udp/6081],::/0[udp/6081]
+        remote_ts = 0.0.0.0/0[udp],::/0[udp]
+        mode = drop
+        start_action = trap
+      }
+      out {
+        local_ts = 0.0.0.0/0[udp],::/0[udp]
+        remote_ts = 0.0.0.0/0[udp/6081],::/0[udp/6081]
+        mode = drop
+        start_action = trap
+      }
+    }
+  }
+`
+
+const expectedConfig = `# Generated by antrea-agent, do not edit.
+connections {
+` + expectedBlockConnection + `  antrea-node2_example_com {
+    version = 2
+    local_addrs = 192.168.1.1
+    remote_addrs = 192.168.1.3
+    local {
+      auth = pubkey
+      certs = /certs/node1.crt
+      id = node1
+    }
+    remote {
+      auth = pubkey
+      cacerts = /certs/ca.crt
+      id = node2.example.com
+    }
+    children {
+      in {
+        local_ts = 192.168.1.1[udp/6081]
+        remote_ts = 192.168.1.3[udp]
+        mode = transport
+        start_action = trap
+      }
+      out {
+        local_ts = 192.168.1.1[udp]
+        remote_ts = 192.168.1.3[udp/6081]
+        mode = transport
+        start_action = trap
+      }
+    }
+  }
+  antrea-node3 {
+    version = 2
+    local_addrs = fd00::1
+    remote_addrs = fd00::3
+    local {
+      auth = pubkey
+      certs = /certs/node1.crt
+      id = node1
+    }
+    remote {
+      auth = pubkey
+      cacerts = /certs/ca.crt
+      id = node3
+    }
+    children {
+      in {
+        local_ts = fd00::1[udp/6081]
+        remote_ts = fd00::3[udp]
+        mode = transport
+        start_action = trap
+      }
+      out {
+        local_ts = fd00::1[udp]
+        remote_ts = fd00::3[udp/6081]
+        mode = transport
+        start_action = trap
+      }
+    }
+  }
+}
+secrets {
+  private-antrea {
+    file = /certs/node1.key
+  }
+}
+`
+
+// fakeSwanctl records the swanctl commands and makes them all return the same error.
+type fakeSwanctl struct {
+	exectesting.FakeExec
+	commands []string
+	err      error
+}
+
+func (f *fakeSwanctl) Command(cmd string, args ...string) exec.Cmd {
+	f.commands = append(f.commands, strings.Join(append([]string{cmd}, args...), " "))
+	return &exectesting.FakeCmd{
+		CombinedOutputScript: []exectesting.FakeAction{func() ([]byte, []byte, error) { return nil, nil, f.err }},
+	}
+}
+
+// writeTestCertificates writes fake certificate files to a temporary directory and returns its absolute path in the
+// format used in the swanctl configuration.
+func writeTestCertificates(t *testing.T) string {
+	dir := t.TempDir()
+	for _, name := range []string{"node1.crt", "node1.key", "node1-new.crt", "node1-new.key", "ca.crt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(name), 0600))
+	}
+	certsDir, err := absPath(dir)
+	require.NoError(t, err)
+	return certsDir
+}
+
+func newTestStrongSwanClient(t *testing.T, swanctl *fakeSwanctl) *strongSwanClient {
+	_, ipv4Net, _ := net.ParseCIDR("192.168.1.1/24")
+	ipv4Net.IP = net.ParseIP("192.168.1.1")
+	_, ipv6Net, _ := net.ParseCIDR("fd00::1/64")
+	ipv6Net.IP = net.ParseIP("fd00::1")
+	nodeConfig := &config.NodeConfig{
+		Name:                  "node1",
+		NodeTransportIPv4Addr: ipv4Net,
+		NodeTransportIPv6Addr: ipv6Net,
+	}
+	c := NewStrongSwanClient(nodeConfig, &config.NetworkConfig{TunnelType: ovsconfig.GeneveTunnel}).(*strongSwanClient)
+	c.exec = swanctl
+	c.configPath = filepath.Join(t.TempDir(), "swanctl.conf")
+	return c
+}
+
+func TestStrongSwanClient(t *testing.T) {
+	swanctl := &fakeSwanctl{}
+	c := newTestStrongSwanClient(t, swanctl)
+	certsDir := writeTestCertificates(t)
+	loadCommand := fmt.Sprintf("swanctl --load-all --noprompt --file %s", c.configPath)
+
+	// The tunnel traffic is blocked until the certificate is ready.
+	require.NoError(t, c.Init())
+	assert.Equal(t, []string{"swanctl --stats", loadCommand}, swanctl.commands)
+	data, err := os.ReadFile(c.configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "# Generated by antrea-agent, do not edit.\nconnections {\n"+expectedBlockConnection+"}\n", string(data))
+
+	assert.EqualError(t, c.UpdatePeer("node2.example.com", net.ParseIP("192.168.1.3")), "IPsec certificate is not configured yet")
+	require.NoError(t, c.UpdateCertificate(certsDir+"/node1.crt", certsDir+"/node1.key", certsDir+"/ca.crt"))
+	require.NoError(t, c.UpdatePeer("node2.example.com", net.ParseIP("192.168.1.3")))
+	require.NoError(t, c.UpdatePeer("node3", net.ParseIP("fd00::3")))
+	data, err = os.ReadFile(c.configPath)
+	require.NoError(t, err)
+	assert.Equal(t, strings.ReplaceAll(expectedConfig, "/certs", certsDir), string(data))
+
+	// The configuration is not loaded again if nothing changes.
+	swanctl.commands = nil
+	require.NoError(t, c.UpdatePeer("node3", net.ParseIP("fd00::3")))
+	require.NoError(t, c.UpdateCertificate(certsDir+"/node1.crt", certsDir+"/node1.key", certsDir+"/ca.crt"))
+	assert.Empty(t, swanctl.commands)
+
+	// The configuration is loaded again when the CA certificate is rotated in place.
+	require.NoError(t, os.WriteFile(filepath.Join(certsDir, "ca.crt"), []byte("new-ca.crt"), 0600))
+	require.NoError(t, c.UpdateCertificate(certsDir+"/node1.crt", certsDir+"/node1.key", certsDir+"/ca.crt"))
+	assert.Equal(t, []string{loadCommand}, swanctl.commands)
+	swanctl.commands = nil
+
+	require.NoError(t, c.DeletePeer("node3"))
+	assert.Equal(t, []string{loadCommand, "swanctl --terminate --ike antrea-node3 --force"}, swanctl.commands)
+	assert.Len(t, c.peers, 1)
+}
+
+func TestStrongSwanClientLoadFailure(t *testing.T) {
+	swanctl := &fakeSwanctl{}
+	c := newTestStrongSwanClient(t, swanctl)
+	certsDir := writeTestCertificates(t)
+	require.NoError(t, c.UpdateCertificate(certsDir+"/node1.crt", certsDir+"/node1.key", certsDir+"/ca.crt"))
+
+	swanctl.err = fmt.Errorf("connection refused")
+	swanctl.commands = nil
+	assert.ErrorContains(t, c.UpdatePeer("node2", net.ParseIP("192.168.1.3")), "error loading strongSwan configuration")
+	assert.Empty(t, c.peers)
+	// The configuration without peer Nodes is loaded to block the tunnel traffic.
+	loadCommand := fmt.Sprintf("swanctl --load-all --noprompt --file %s", c.configPath)
+	assert.Equal(t, []string{loadCommand, loadCommand}, swanctl.commands)
+	data, err := os.ReadFile(c.configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), expectedBlockConnection)
+	assert.NotContains(t, string(data), "antrea-node2")
+	assert.ErrorContains(t, c.UpdateCertificate(certsDir+"/node1-new.crt", certsDir+"/node1-new.key", certsDir+"/ca.crt"), "error loading strongSwan configuration")
+	assert.Equal(t, certsDir+"/node1.crt", c.certPath)
+	assert.ErrorContains(t, c.UpdateCertificate(certsDir+"/missing.crt", certsDir+"/node1.key", certsDir+"/ca.crt"), "error reading")
+
+	// The peer is configured when it's retried.
+	swanctl.err = nil
+	require.NoError(t, c.UpdatePeer("node2", net.ParseIP("192.168.1.3")))
+	assert.Len(t, c.peers, 1)
+}
+
+func TestNewStrongSwanClient(t *testing.T) {
+	nodeConfig := &config.NodeConfig{Name: "node1"}
+	c := NewStrongSwanClient(nodeConfig, &config.NetworkConfig{TunnelType: ovsconfig.VXLANTunnel}).(*strongSwanClient)
+	assert.Equal(t, "udp", c.protocol)
+	assert.Equal(t, int32(4789), c.port)
+	c = NewStrongSwanClient(nodeConfig, &config.NetworkConfig{TunnelType: ovsconfig.STTTunnel, TunnelPort: 7000}).(*strongSwanClient)
+	assert.Equal(t, "tcp", c.protocol)
+	assert.Equal(t, int32(7000), c.port)
+}
@@ -37,6 +37,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/consistenthash"
+	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
 	crdlister "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
@@ -265,6 +266,12 @@ func (c *Cluster) handleUpdateNode(oldObj, newObj interface{}) {
 		return
 	}
 	oldNode := oldObj.(*corev1.Node)
+	if isNodeDraining(node) != isNodeDraining(oldNode) {
+		affectedEIPs := c.filterEIPsFromNodeLabels(oldNode).Union(c.filterEIPsFromNodeLabels(node))
+		c.enqueueExternalIPPools(affectedEIPs.Insert(allNodesConsistentHashMapKey))
+		klog.V(2).InfoS("Processed Node UPDATE event, draining state changed", "nodeName", node.Name, "draining", isNodeDraining(node))
+		return
+	}
 	if reflect.DeepEqual(node.GetLabels(), oldNode.GetLabels()) {
 		klog.V(2).InfoS("Processed Node UPDATE event, labels not changed", "nodeName", node.Name)
 		return
@@ -279,6 +286,32 @@ func (c *Cluster) handleUpdateNode(oldObj, newObj interface{}) {
 	klog.V(2).InfoS("Processed Node UPDATE event", "nodeName", node.Name, "affectedExternalIPPoolNum", affectedEIPs.Len())
 }
 
+// isNodeDraining returns whether the Node has been marked as draining by antrea-controller.
+func isNodeDraining(node *corev1.Node) bool {
+	_, exists := node.Annotations[apis.NodeDrainingAnnotationKey]
+	return exists
+}
+
+// selectNodes returns the names of the provided Nodes which are alive. The Nodes which are draining are excluded so
+// that the IPs assigned to them are moved to other Nodes, unless all the alive Nodes are draining.
+func selectNodes(nodes []*corev1.Node, aliveNodes sets.Set[string]) []string {
+	var selectedNodes, drainingNodes []string
+	for _, node := range nodes {
+		if !aliveNodes.Has(node.Name) {
+			continue
+		}
+		if isNodeDraining(node) {
+			drainingNodes = append(drainingNodes, node.Name)
+		} else {
+			selectedNodes = append(selectedNodes, node.Name)
+		}
+	}
+	if len(selectedNodes) == 0 {
+		return drainingNodes
+	}
+	return selectedNodes
+}
+
 func (c *Cluster) enqueueExternalIPPools(eips sets.Set[string]) {
 	for eip := range eips {
 		c.queue.Add(eip)
@@ -444,13 +477,7 @@ func (c *Cluster) syncConsistentHash(eipName string) error {
 		if err != nil {
 			return err
 		}
-		var allNodes []string
-		for _, node := range allKNodes {
-			nodeName := node.Name
-			if allAgentNodes.Has(nodeName) {
-				allNodes = append(allNodes, nodeName)
-			}
-		}
+		allNodes := selectNodes(allKNodes, allAgentNodes)
 		allNodesConsistentHashMap := NewNodeConsistentHashMap()
 		allNodesConsistentHashMap.Add(allNodes...)
 		c.consistentHashRWMutex.Lock()
@@ -480,15 +507,8 @@ func (c *Cluster) syncConsistentHash(eipName string) error {
 		if err != nil {
 			return fmt.Errorf("listing Nodes error: %v", err)
 		}
-		aliveNodes := c.AliveNodes()
 		// Node alive and Node labels match ExternalIPPool nodeSelector.
-		var aliveAndMatchedNodes []string
-		for _, node := range nodes {
-			nodeName := node.Name
-			if aliveNodes.Has(nodeName) {
-				aliveAndMatchedNodes = append(aliveAndMatchedNodes, nodeName)
-			}
-		}
+		aliveAndMatchedNodes := selectNodes(nodes, c.AliveNodes())
 		consistentHashMap := NewNodeConsistentHashMap()
 		consistentHashMap.Add(aliveAndMatchedNodes...)
 		c.consistentHashRWMutex.Lock()
@@ -29,6 +29,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
@@ -679,3 +680,43 @@ func TestCluster_RejoinNodes(t *testing.T) {
 	mockMemberlist.EXPECT().Join([]string{"10.0.0.2"})
 	fakeCluster.cluster.RejoinNodes()
 }
+
+func TestSelectNodes(t *testing.T) {
+	newNode := func(name string, draining bool) *v1.Node {
+		node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if draining {
+			node.Annotations = map[string]string{apis.NodeDrainingAnnotationKey: "true"}
+		}
+		return node
+	}
+	tests := []struct {
+		name       string
+		nodes      []*v1.Node
+		aliveNodes sets.Set[string]
+		expected   []string
+	}{
+		{
+			name:       "no draining Node",
+			nodes:      []*v1.Node{newNode("node1", false), newNode("node2", false), newNode("node3", false)},
+			aliveNodes: sets.New("node1", "node2"),
+			expected:   []string{"node1", "node2"},
+		},
+		{
+			name:       "draining Node excluded",
+			nodes:      []*v1.Node{newNode("node1", true), newNode("node2", false), newNode("node3", false)},
+			aliveNodes: sets.New("node1", "node2", "node3"),
+			expected:   []string{"node2", "node3"},
+		},
+		{
+			name:       "all alive Nodes draining",
+			nodes:      []*v1.Node{newNode("node1", true), newNode("node2", true), newNode("node3", false)},
+			aliveNodes: sets.New("node1", "node2"),
+			expected:   []string{"node1", "node2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, selectNodes(tt.nodes, tt.aliveNodes))
+		})
+	}
+}
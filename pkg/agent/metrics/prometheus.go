@@ -27,8 +27,34 @@ const (
 	LabelPacketInMeterNetworkPolicy   = "PacketInMeterNetworkPolicy"
 	LabelPacketInMeterTraceflow       = "PacketInMeterTraceflow"
 	LabelPacketInMeterDNSInterception = "PacketInMeterDNSInterception"
+
+	LabelDropReasonAntreaPolicyIngressDeny          = "AntreaPolicyIngressDeny"
+	LabelDropReasonAntreaPolicyEgressDeny           = "AntreaPolicyEgressDeny"
+	LabelDropReasonK8sNetworkPolicyIngressIsolation = "K8sNetworkPolicyIngressIsolation"
+	LabelDropReasonK8sNetworkPolicyEgressIsolation  = "K8sNetworkPolicyEgressIsolation"
+	LabelDropReasonInvalidConnState                 = "InvalidConnState"
+	LabelDropReasonNoMatchingConnection             = "NoMatchingConnection"
+	LabelDropReasonSpoofGuard                       = "SpoofGuard"
+	LabelDropReasonUnknownDestination               = "UnknownDestination"
+	LabelDropReasonDNSResolverRestriction           = "DNSResolverRestriction"
+	LabelDropReasonMTUExceeded                      = "MTUExceeded"
 )
 
+// DropReasons lists the reasons of the packets dropped by the OVS pipeline, as reported by
+// antrea_agent_ovs_dropped_packet_count.
+var DropReasons = []string{
+	LabelDropReasonAntreaPolicyIngressDeny,
+	LabelDropReasonAntreaPolicyEgressDeny,
+	LabelDropReasonK8sNetworkPolicyIngressIsolation,
+	LabelDropReasonK8sNetworkPolicyEgressIsolation,
+	LabelDropReasonInvalidConnState,
+	LabelDropReasonNoMatchingConnection,
+	LabelDropReasonSpoofGuard,
+	LabelDropReasonUnknownDestination,
+	LabelDropReasonDNSResolverRestriction,
+	LabelDropReasonMTUExceeded,
+}
+
 var (
 	EgressNetworkPolicyRuleCount = metrics.NewGauge(
 		&metrics.GaugeOpts{
@@ -120,6 +146,68 @@ var (
 		[]string{"operation"},
 	)
 
+	OVSFlowPriorityUtilization = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ovs_flow_priority_utilization",
+			Help:           "Ratio of the OpenFlow priority space in use by Antrea-native policy rules, for each OVS flow table. The TableName is used as label.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"table_name"},
+	)
+
+	OVSFlowPriorityDefragmentationCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ovs_flow_priority_defragmentation_count",
+			Help:           "Number of times the OpenFlow priorities of Antrea-native policy rules were repacked, for each OVS flow table. The TableName is used as label.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"table_name"},
+	)
+
+	OVSDBConnectionState = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ovsdb_connection_state",
+			Help:           "State of the connection to the OVSDB server: connected (0), reconnecting (1) or circuit open (2).",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	OVSDBTransactionReplayCount = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ovsdb_transaction_replay_count",
+			Help:           "Number of OVSDB transactions which were replayed after the connection to the OVSDB server was re-established.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	OVSDBTransactionRejectedCount = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ovsdb_transaction_rejected_count",
+			Help:           "Number of OVSDB transactions which were rejected without being sent, because the circuit breaker was open or the connection was not re-established in time.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	OVSDBCircuitBreakerOpenCount = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ovsdb_circuit_breaker_open_count",
+			Help:           "Number of times the circuit breaker of the connection to the OVSDB server was opened after repeated failures.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
 	// OVSMeterPacketDroppedCount is defined as a Gauge and not a Counter, even though this metric is monotonically
 	// increasing (only being reset to 0 on restart).  This is because we want to set its value directly using the
 	// Set method (using the value provided by OVS), and using Inc / Add is not convenient.
@@ -134,6 +222,43 @@ var (
 		[]string{"meter_id"},
 	)
 
+	// OVSDroppedPacketCount is incremented by the packets dropped since the previous collection of the statistics of
+	// the OVS flows and ports, so that it is not reset when the flows are reinstalled.
+	OVSDroppedPacketCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ovs_dropped_packet_count",
+			Help:           "Number of packets dropped by the OVS pipeline and datapath, split by drop reason.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"reason"},
+	)
+
+	// DNSResolverDroppedPacketCount is defined as a Gauge and not a Counter, as its values are set directly from the
+	// statistics of the OVS flows collected periodically.
+	DNSResolverDroppedPacketCount = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "dns_resolver_dropped_packet_count",
+			Help:           "Number of DNS query packets dropped for each local Pod whose DNS resolvers are restricted with the \"pod.antrea.io/dns-resolvers\" annotation.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"namespace", "pod"},
+	)
+
+	NetworkPolicyAuditLogDroppedCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "networkpolicy_audit_log_dropped_count",
+			Help:           "Number of NetworkPolicy audit logs dropped before being sent to the syslog or Unix socket destination. The reason label is queue_full if the logs were produced faster than they could be sent, and send_error if they could not be sent.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"reason"},
+	)
+
 	TotalConnectionsInConnTrackTable = metrics.NewGauge(
 		&metrics.GaugeOpts{
 			Namespace:      metricNamespaceAntrea,
@@ -183,6 +308,150 @@ var (
 			StabilityLevel: metrics.ALPHA,
 		},
 	)
+
+	TransportInterfaceNUMANode = metrics.NewGauge(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "transport_interface_numa_node",
+			Help:           "NUMA node of the transport interface. The value is -1 if the interface is not attached to a NUMA node. This metric is only reported when cpuAffinity is enabled.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	PacketInHandlerNUMAAligned = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "packet_in_handler_numa_aligned",
+			Help:           "Whether the thread processing packet-in messages of a category is pinned to the CPUs of the NUMA node of the transport interface (1) or not (0). This metric is only reported when cpuAffinity.alignPacketInWithNIC is enabled.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"category"},
+	)
+
+	IPAnnouncementCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ip_announcement_count",
+			Help:           "Number of gratuitous ARP or unsolicited Neighbor Advertisement announcements sent for IPs assigned by the Antrea Agent (e.g. Egress IPs and Service external IPs).",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"family"},
+	)
+
+	IPAnnouncementFailureCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "ip_announcement_failure_count",
+			Help:           "Number of failed announcements for IPs assigned by the Antrea Agent. The reason label is send_error if the announcement could not be sent, and conflict if another host still claimed the IP after the announcement.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"family", "reason"},
+	)
+
+	// The multicast group metrics are defined as Gauges and not Counters, as their values are set directly from the
+	// statistics collected periodically by the multicast controller.
+	MulticastGroupPacketCount = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicast_group_packet_count",
+			Help:           "Number of multicast packets forwarded by OVS for each multicast group. The group IP is used as label.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"multicast_group"},
+	)
+
+	MulticastGroupByteCount = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicast_group_byte_count",
+			Help:           "Number of multicast bytes forwarded by OVS for each multicast group. The group IP is used as label.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"multicast_group"},
+	)
+
+	MulticastGroupLocalReceiverCount = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicast_group_local_receiver_count",
+			Help:           "Number of local Pods which have joined each multicast group. The group IP is used as label.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"multicast_group"},
+	)
+
+	MulticastGroupRemoteReceiverNodeCount = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicast_group_remote_receiver_node_count",
+			Help:           "Number of other Nodes with Pods which have joined each multicast group. It is only reported in encap mode. The group IP is used as label.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"multicast_group"},
+	)
+
+	MulticastGroupSenderCount = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicast_group_sender_count",
+			Help:           "Number of sources sending traffic for each multicast group through the multicast interfaces of the Node. The group IP is used as label.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"multicast_group"},
+	)
+
+	MulticastGroupLastActiveTimestamp = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "multicast_group_last_active_timestamp_seconds",
+			Help:           "Unix timestamp of the last time a packet was forwarded by OVS for each multicast group, 0 if no packet has been forwarded. The group IP is used as label.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"multicast_group"},
+	)
+
+	ServiceProbeSuccess = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "service_probe_success",
+			Help:           "Whether the last probe of a Service address succeeded (1) or not (0). The Service, the probed address and the source of the probe (node or netns) are used as labels.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"service", "address", "source"},
+	)
+
+	ServiceProbeDurationSeconds = metrics.NewGaugeVec(
+		&metrics.GaugeOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "service_probe_duration_seconds",
+			Help:           "Duration of the last probe of a Service address, i.e. the time taken to establish a TCP connection, or to time out. The Service, the probed address and the source of the probe (node or netns) are used as labels.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"service", "address", "source"},
+	)
+
+	ServiceProbeFailureCount = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemAgent,
+			Name:           "service_probe_failure_count",
+			Help:           "Number of failed probes of a Service address. The Service, the probed address and the source of the probe (node or netns) are used as labels.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"service", "address", "source"},
+	)
 )
 
 func InitializePrometheusMetrics() {
@@ -192,6 +461,10 @@ func InitializePrometheusMetrics() {
 	InitializeNetworkPolicyMetrics()
 	InitializeOVSMetrics()
 	InitializeConnectionMetrics()
+	InitializeCPUAffinityMetrics()
+	InitializeIPAnnouncementMetrics()
+	InitializeMulticastMetrics()
+	InitializeServiceProbeMetrics()
 }
 
 func InitializePodMetrics() {
@@ -212,6 +485,14 @@ func InitializeNetworkPolicyMetrics() {
 	if err := legacyregistry.Register(NetworkPolicyCount); err != nil {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_count")
 	}
+
+	if err := legacyregistry.Register(DNSResolverDroppedPacketCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_dns_resolver_dropped_packet_count")
+	}
+
+	if err := legacyregistry.Register(NetworkPolicyAuditLogDroppedCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_networkpolicy_audit_log_dropped_count")
+	}
 }
 
 func InitializeOVSMetrics() {
@@ -234,6 +515,24 @@ func InitializeOVSMetrics() {
 	if err := legacyregistry.Register(OVSMeterPacketDroppedCount); err != nil {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ovs_meter_packet_dropped_count")
 	}
+	if err := legacyregistry.Register(OVSFlowPriorityUtilization); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ovs_flow_priority_utilization")
+	}
+	if err := legacyregistry.Register(OVSFlowPriorityDefragmentationCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ovs_flow_priority_defragmentation_count")
+	}
+	if err := legacyregistry.Register(OVSDBConnectionState); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ovsdb_connection_state")
+	}
+	if err := legacyregistry.Register(OVSDBTransactionReplayCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ovsdb_transaction_replay_count")
+	}
+	if err := legacyregistry.Register(OVSDBTransactionRejectedCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ovsdb_transaction_rejected_count")
+	}
+	if err := legacyregistry.Register(OVSDBCircuitBreakerOpenCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ovsdb_circuit_breaker_open_count")
+	}
 	// Initialize OpenFlow operations metrics with label add, modify and delete
 	// since those metrics won't come out until observation.
 	for _, ops := range []string{"add", "modify", "delete"} {
@@ -244,6 +543,12 @@ func InitializeOVSMetrics() {
 	for _, label := range []string{LabelPacketInMeterNetworkPolicy, LabelPacketInMeterTraceflow, LabelPacketInMeterDNSInterception} {
 		OVSMeterPacketDroppedCount.WithLabelValues(label)
 	}
+	if err := legacyregistry.Register(OVSDroppedPacketCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ovs_dropped_packet_count")
+	}
+	for _, reason := range DropReasons {
+		OVSDroppedPacketCount.WithLabelValues(reason)
+	}
 }
 
 func InitializeConnectionMetrics() {
@@ -263,3 +568,54 @@ func InitializeConnectionMetrics() {
 		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_conntrack_max_connection_count")
 	}
 }
+
+func InitializeCPUAffinityMetrics() {
+	if err := legacyregistry.Register(TransportInterfaceNUMANode); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_transport_interface_numa_node")
+	}
+	if err := legacyregistry.Register(PacketInHandlerNUMAAligned); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_packet_in_handler_numa_aligned")
+	}
+}
+
+func InitializeIPAnnouncementMetrics() {
+	if err := legacyregistry.Register(IPAnnouncementCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ip_announcement_count")
+	}
+	if err := legacyregistry.Register(IPAnnouncementFailureCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_ip_announcement_failure_count")
+	}
+}
+
+func InitializeMulticastMetrics() {
+	if err := legacyregistry.Register(MulticastGroupPacketCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicast_group_packet_count")
+	}
+	if err := legacyregistry.Register(MulticastGroupByteCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicast_group_byte_count")
+	}
+	if err := legacyregistry.Register(MulticastGroupLocalReceiverCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicast_group_local_receiver_count")
+	}
+	if err := legacyregistry.Register(MulticastGroupRemoteReceiverNodeCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicast_group_remote_receiver_node_count")
+	}
+	if err := legacyregistry.Register(MulticastGroupSenderCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicast_group_sender_count")
+	}
+	if err := legacyregistry.Register(MulticastGroupLastActiveTimestamp); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_multicast_group_last_active_timestamp_seconds")
+	}
+}
+
+func InitializeServiceProbeMetrics() {
+	if err := legacyregistry.Register(ServiceProbeSuccess); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_service_probe_success")
+	}
+	if err := legacyregistry.Register(ServiceProbeDurationSeconds); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_service_probe_duration_seconds")
+	}
+	if err := legacyregistry.Register(ServiceProbeFailureCount); err != nil {
+		klog.ErrorS(err, "Failed to register metrics with Prometheus", "metrics", "antrea_agent_service_probe_failure_count")
+	}
+}
@@ -33,6 +33,7 @@ import (
 
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/interfacestore"
+	"antrea.io/antrea/pkg/agent/metrics"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/agent/util"
@@ -60,6 +61,26 @@ const (
 	// nodeUpdateKey is a key to trigger the Node list operation and update the OpenFlow group buckets to report
 	// the local multicast groups to other Nodes.
 	nodeUpdateKey = "nodeUpdate"
+
+	// groupStatsInterval is the interval to collect the traffic statistics of multicast groups.
+	groupStatsInterval = 10 * time.Second
+)
+
+// sourceFilterMode describes how the sources of a membership event update the sources a local member receives the
+// multicast traffic from, as defined by IGMPv3 source filtering (RFC 3376).
+type sourceFilterMode uint8
+
+const (
+	// anySource means that the member joins the group for any source (IGMPv1, IGMPv2 and IGMPv3 EXCLUDE mode
+	// reports), or leaves the group for all sources.
+	anySource sourceFilterMode = iota
+	// includeSources means that the member joins the group only for the sources of the event.
+	includeSources
+	// allowSources means that the sources of the event are added to the sources of the member.
+	allowSources
+	// blockSources means that the sources of the event are removed from the sources of the member. The member leaves
+	// the group if no source is left.
+	blockSources
 )
 
 var workerCount uint8 = 2
@@ -67,8 +88,11 @@ var workerCount uint8 = 2
 type mcastGroupEvent struct {
 	group net.IP
 	eType eventType
-	time  time.Time
-	iface *interfacestore.InterfaceConfig
+	// filterMode and sources are set with the IGMPv3 group records which have sources.
+	filterMode sourceFilterMode
+	sources    []net.IP
+	time       time.Time
+	iface      *interfacestore.InterfaceConfig
 	// srcNode is the Node IP where the IGMP report message is sent from. It is set only with encap mode.
 	srcNode net.IP
 }
@@ -78,6 +102,11 @@ type GroupMemberStatus struct {
 	// localMembers is a map for the local Pod member and its last update time, key is the Pod's interface name,
 	// and value is its last update time.
 	localMembers map[string]time.Time
+	// localMemberSources is a map for the local Pod members which have joined the group only for specific sources
+	// with IGMPv3 source filtering, key is the Pod's interface name, and value is the set of source IPs. The local
+	// members which are not in the map receive the multicast traffic from any source. The sets are never modified in
+	// place, so that they can be shared between the copies of a GroupMemberStatus.
+	localMemberSources map[string]sets.Set[string]
 	// remoteMembers is a set for Nodes which have joined the multicast group in the cluster. The Node's IP is
 	// added in the set.
 	remoteMembers sets.Set[string]
@@ -103,10 +132,11 @@ func (c *Controller) eventHandler(stopCh <-chan struct{}) {
 // addGroupMemberStatus adds the new group into groupCache.
 func (c *Controller) addGroupMemberStatus(e *mcastGroupEvent) {
 	status := &GroupMemberStatus{
-		group:         e.group,
-		ofGroupID:     c.v4GroupAllocator.Allocate(),
-		remoteMembers: sets.New[string](),
-		localMembers:  make(map[string]time.Time),
+		group:              e.group,
+		ofGroupID:          c.v4GroupAllocator.Allocate(),
+		remoteMembers:      sets.New[string](),
+		localMembers:       make(map[string]time.Time),
+		localMemberSources: make(map[string]sets.Set[string]),
 	}
 	status = addGroupMember(status, e)
 	c.groupCache.Add(status)
@@ -116,20 +146,25 @@ func (c *Controller) addGroupMemberStatus(e *mcastGroupEvent) {
 
 // updateGroupMemberStatus updates the group status in groupCache. If a "join" message is sent from an existing member,
 // only updates the lastIGMPReport time. If a "join" message is sent from an "unknown" member, updates the lastIGMPReport time and
-// adds the new member into the group's local member set. If a "leave" message is sent from an existing member, removes
+// adds the new member into the group's local member set. If the sources of an existing local member are changed with
+// IGMPv3 source filtering, the group is synced again. If a "leave" message is sent from an existing member, removes
 // it from the group's local member set, and if the member is the last one in local cache, a query message on the group
 // is sent out to check if there are still local members in the group.
 func (c *Controller) updateGroupMemberStatus(obj interface{}, e *mcastGroupEvent) {
 	status := obj.(*GroupMemberStatus)
 	newStatus := &GroupMemberStatus{
-		group:         status.group,
-		localMembers:  make(map[string]time.Time),
-		remoteMembers: status.remoteMembers.Union(nil),
-		ofGroupID:     status.ofGroupID,
+		group:              status.group,
+		localMembers:       make(map[string]time.Time),
+		localMemberSources: make(map[string]sets.Set[string]),
+		remoteMembers:      status.remoteMembers.Union(nil),
+		ofGroupID:          status.ofGroupID,
 	}
 	for m, t := range status.localMembers {
 		newStatus.localMembers[m] = t
 	}
+	for m, sources := range status.localMemberSources {
+		newStatus.localMemberSources[m] = sources
+	}
 	exist := memberExists(status, e)
 	switch e.eType {
 	case groupJoin:
@@ -138,8 +173,32 @@ func (c *Controller) updateGroupMemberStatus(obj interface{}, e *mcastGroupEvent
 		if !exist {
 			klog.InfoS("Added member to multicast group", "group", e.group.String(), "member", e.iface.InterfaceName)
 			c.queue.Add(newStatus.group.String())
+		} else if memberSourcesChanged(status, newStatus, e) {
+			klog.InfoS("Updated sources of member in multicast group", "group", e.group.String(), "member", e.iface.InterfaceName, "sources", sets.List(newStatus.localMemberSources[e.iface.InterfaceName]))
+			c.queue.Add(newStatus.group.String())
 		}
 	case groupLeave:
+		if exist && e.filterMode == blockSources {
+			if e.iface.Type != interfacestore.ContainerInterface {
+				return
+			}
+			sources, ok := status.localMemberSources[e.iface.InterfaceName]
+			// Blocking sources is ignored for the members which have joined the group for any source.
+			if !ok {
+				return
+			}
+			remainingSources := sources.Difference(newIPSet(e.sources))
+			if remainingSources.Len() > 0 {
+				if remainingSources.Len() < sources.Len() {
+					newStatus.localMemberSources[e.iface.InterfaceName] = remainingSources
+					c.groupCache.Update(newStatus)
+					klog.InfoS("Removed sources of member in multicast group", "group", e.group.String(), "member", e.iface.InterfaceName, "sources", sets.List(remainingSources))
+					c.queue.Add(newStatus.group.String())
+				}
+				return
+			}
+			// The member leaves the group if all its sources are blocked.
+		}
 		if exist {
 			newStatus = deleteGroupMember(newStatus, e)
 			c.groupCache.Update(newStatus)
@@ -250,7 +309,12 @@ type Controller struct {
 	// include the multicast groups that local Pod members join.
 	installedLocalGroups      sets.Set[string]
 	installedLocalGroupsMutex sync.RWMutex
-	mRouteClient              *MRouteClient
+	// installedSourceGroups saves the OpenFlow groups which are configured on OVS for the sources that local Pods
+	// join with IGMPv3 source filtering. It is a map from multicast group IPs to maps from source IPs to OpenFlow
+	// group IDs.
+	installedSourceGroups      map[string]map[string]binding.GroupIDType
+	installedSourceGroupsMutex sync.Mutex
+	mRouteClient               *MRouteClient
 	// queryInterval is the interval to send IGMP query messages.
 	queryInterval time.Duration
 	// mcastGroupTimeout is the timeout to detect a group as stale if no IGMP report is received within the time.
@@ -270,6 +334,11 @@ type Controller struct {
 	// ipv6Enabled is the flag that if it is running on IPv6 cluster.
 	// TODO: remove this flag after IPv6 is supported in Multicast.
 	ipv6Enabled bool
+	// groupStats saves the statistics of each multicast group collected in the last interval, and groupStatsTime is
+	// the time when they were collected.
+	groupStats      map[string]*GroupTrafficStats
+	groupStatsTime  time.Time
+	groupStatsMutex sync.RWMutex
 }
 
 func NewMulticastController(ofClient openflow.Client,
@@ -294,15 +363,16 @@ func NewMulticastController(ofClient openflow.Client,
 	})
 	multicastRouteClient := newRouteClient(nodeConfig, groupCache, multicastSocket, multicastInterfaces, enableFlexibleIPAM)
 	c := &Controller{
-		ofClient:             ofClient,
-		ifaceStore:           ifaceStore,
-		v4GroupAllocator:     v4GroupAllocator,
-		nodeConfig:           nodeConfig,
-		igmpSnooper:          groupSnooper,
-		groupEventCh:         eventCh,
-		groupCache:           groupCache,
-		installedGroups:      sets.New[string](),
-		installedLocalGroups: sets.New[string](),
+		ofClient:              ofClient,
+		ifaceStore:            ifaceStore,
+		v4GroupAllocator:      v4GroupAllocator,
+		nodeConfig:            nodeConfig,
+		igmpSnooper:           groupSnooper,
+		groupEventCh:          eventCh,
+		groupCache:            groupCache,
+		installedGroups:       sets.New[string](),
+		installedLocalGroups:  sets.New[string](),
+		installedSourceGroups: make(map[string]map[string]binding.GroupIDType),
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
 			workqueue.TypedRateLimitingQueueConfig[string]{
@@ -398,6 +468,8 @@ func (c *Controller) Run(stopCh <-chan struct{}) {
 
 	// Periodically check the group member status, and remove the groups in which no members exist
 	go wait.NonSlidingUntil(c.clearStaleGroups, c.queryInterval, stopCh)
+	// Periodically collect the traffic statistics of multicast groups.
+	go wait.NonSlidingUntil(c.collectGroupStats, groupStatsInterval, stopCh)
 	go c.eventHandler(stopCh)
 
 	for i := 0; i < int(workerCount); i++ {
@@ -463,12 +535,22 @@ func (c *Controller) syncGroup(groupKey string) error {
 	} else {
 		memberPorts = append(memberPorts, c.nodeConfig.GatewayConfig.OFPort)
 	}
+	// sourceMemberPorts is a map from the sources which local members have joined the group for with IGMPv3 source
+	// filtering to the ports of these members. These members are not added to the OpenFlow group of the multicast
+	// group, which forwards the traffic from any source.
+	sourceMemberPorts := make(map[string][]uint32)
 	for memberInterfaceName := range status.localMembers {
 		obj, found := c.ifaceStore.GetInterfaceByName(memberInterfaceName)
 		if !found {
 			klog.InfoS("Failed to find interface from cache", "interface", memberInterfaceName)
 			continue
 		}
+		if sources, ok := status.localMemberSources[memberInterfaceName]; ok {
+			for source := range sources {
+				sourceMemberPorts[source] = append(sourceMemberPorts[source], uint32(obj.OFPort))
+			}
+			continue
+		}
 		memberPorts = append(memberPorts, uint32(obj.OFPort))
 	}
 	var remoteNodeReceivers []net.IP
@@ -529,6 +611,9 @@ func (c *Controller) syncGroup(groupKey string) error {
 			// remoteMembers is always empty with noEncap mode.
 			if status.remoteMembers.Len() == 0 {
 				// Remove the multicast OpenFlow flow and group entries if none Pod member on local or remote Node is in the group.
+				if err := c.syncSourceGroups(status, nil, nil, nil); err != nil {
+					return err
+				}
 				if err := c.ofClient.UninstallMulticastFlows(status.group); err != nil {
 					klog.ErrorS(err, "Failed to uninstall multicast flows", "group", groupKey)
 					return err
@@ -556,7 +641,7 @@ func (c *Controller) syncGroup(groupKey string) error {
 			return err
 		}
 		klog.InfoS("Updated OpenFlow group for receivers in multicast group", "group", groupKey, "ofGroup", status.ofGroupID, "localReceivers", memberPorts, "remoteReceivers", remoteNodeReceivers)
-		return nil
+		return c.syncSourceGroups(status, memberPorts, sourceMemberPorts, remoteNodeReceivers)
 	}
 	// Install OpenFlow group for a new multicast group which has local Pod receivers joined.
 	if err := c.ofClient.InstallMulticastGroup(status.ofGroupID, memberPorts, remoteNodeReceivers); err != nil {
@@ -569,6 +654,9 @@ func (c *Controller) syncGroup(groupKey string) error {
 		return err
 	}
 	klog.InfoS("Installed OpenFlow flows for multicast group", "group", groupKey, "ofGroup", status.ofGroupID, "localReceivers", memberPorts, "remoteReceivers", remoteNodeReceivers)
+	if err := c.syncSourceGroups(status, memberPorts, sourceMemberPorts, remoteNodeReceivers); err != nil {
+		return err
+	}
 	if len(status.localMembers) > 0 {
 		err := installLocalMulticastGroup()
 		if err != nil {
@@ -579,6 +667,60 @@ func (c *Controller) syncGroup(groupKey string) error {
 	return nil
 }
 
+// syncSourceGroups installs an OpenFlow group and a flow for each source which local members have joined the multicast
+// group for with IGMPv3 source filtering, so that the traffic from the source is forwarded to these members in addition
+// to the receivers of the traffic from any source. The OpenFlow groups and flows of the sources which are no longer
+// joined are removed.
+func (c *Controller) syncSourceGroups(status *GroupMemberStatus, memberPorts []uint32, sourceMemberPorts map[string][]uint32, remoteNodeReceivers []net.IP) error {
+	groupKey := status.group.String()
+	c.installedSourceGroupsMutex.Lock()
+	defer c.installedSourceGroupsMutex.Unlock()
+	sourceGroups, ok := c.installedSourceGroups[groupKey]
+	if !ok {
+		sourceGroups = make(map[string]binding.GroupIDType)
+		c.installedSourceGroups[groupKey] = sourceGroups
+	}
+	for source, ports := range sourceMemberPorts {
+		ofGroupID, installed := sourceGroups[source]
+		if !installed {
+			ofGroupID = c.v4GroupAllocator.Allocate()
+		}
+		receivers := append(append(make([]uint32, 0, len(memberPorts)+len(ports)), memberPorts...), ports...)
+		if err := c.ofClient.InstallMulticastGroup(ofGroupID, receivers, remoteNodeReceivers); err != nil {
+			if !installed {
+				c.v4GroupAllocator.Release(ofGroupID)
+			}
+			return err
+		}
+		sourceGroups[source] = ofGroupID
+		if err := c.ofClient.InstallMulticastSourceFlows(status.group, net.ParseIP(source), ofGroupID); err != nil {
+			klog.ErrorS(err, "Failed to install multicast flows for source", "group", groupKey, "source", source)
+			return err
+		}
+		klog.V(2).InfoS("Installed OpenFlow group and flows for source in multicast group", "group", groupKey, "source", source, "ofGroup", ofGroupID, "localReceivers", receivers)
+	}
+	for source, ofGroupID := range sourceGroups {
+		if _, ok := sourceMemberPorts[source]; ok {
+			continue
+		}
+		if err := c.ofClient.UninstallMulticastSourceFlows(status.group, net.ParseIP(source)); err != nil {
+			klog.ErrorS(err, "Failed to uninstall multicast flows for source", "group", groupKey, "source", source)
+			return err
+		}
+		if err := c.ofClient.UninstallMulticastGroup(ofGroupID); err != nil {
+			klog.ErrorS(err, "Failed to uninstall multicast group for source", "group", groupKey, "source", source)
+			return err
+		}
+		c.v4GroupAllocator.Release(ofGroupID)
+		delete(sourceGroups, source)
+		klog.V(2).InfoS("Removed OpenFlow group and flows for source in multicast group", "group", groupKey, "source", source, "ofGroup", ofGroupID)
+	}
+	if len(sourceGroups) == 0 {
+		delete(c.installedSourceGroups, groupKey)
+	}
+	return nil
+}
+
 func (c *Controller) groupHasInstalled(groupKey string) bool {
 	c.installedGroupsMutex.RLock()
 	defer c.installedGroupsMutex.RUnlock()
@@ -705,7 +847,7 @@ func (c *Controller) syncNodes() error {
 		if n.Name == c.nodeConfig.Name {
 			continue
 		}
-		nip, err := k8s.GetNodeTransportAddrs(n)
+		nip, err := k8s.GetNodeTunnelAddrs(n)
 		if err != nil {
 			klog.ErrorS(err, "Failed to retrieve Node IP addresses", "node", n.Name)
 			return err
@@ -765,6 +907,25 @@ func (c *Controller) GetGroupPods() map[string][]v1beta2.PodReference {
 	return groupPodsMap
 }
 
+// getSourceReceivers returns the local Pods which have joined the multicast group only for specific sources, keyed by
+// the source IP.
+func (c *Controller) getSourceReceivers(status *GroupMemberStatus) map[string][]v1beta2.PodReference {
+	if len(status.localMemberSources) == 0 {
+		return nil
+	}
+	sourceReceivers := make(map[string][]v1beta2.PodReference)
+	for member, sources := range status.localMemberSources {
+		iface, found := c.ifaceStore.GetInterfaceByName(member)
+		if !found {
+			continue
+		}
+		for source := range sources {
+			sourceReceivers[source] = append(sourceReceivers[source], v1beta2.PodReference{Name: iface.PodName, Namespace: iface.PodNamespace})
+		}
+	}
+	return sourceReceivers
+}
+
 // PodTrafficStats encodes the inbound and outbound multicast statistics of each Pod.
 type PodTrafficStats struct {
 	Inbound, Outbound uint64
@@ -809,18 +970,125 @@ func (c *Controller) GetAllPodsStats() map[*interfacestore.InterfaceConfig]*PodT
 	return statsMap
 }
 
+// GroupTrafficStats encodes the members and the traffic statistics of a multicast group.
+type GroupTrafficStats struct {
+	Group string
+	// LocalReceivers are the local Pods which have joined the group.
+	LocalReceivers []v1beta2.PodReference
+	// SourceReceivers are the local Pods which have joined the group only for specific sources with IGMPv3 source
+	// filtering, keyed by the source IP. The other local Pods receive the group traffic from any source.
+	SourceReceivers map[string][]v1beta2.PodReference
+	// RemoteReceiverNodes is the number of other Nodes with Pods which have joined the group. It is only set in
+	// encap mode.
+	RemoteReceiverNodes int
+	// Senders are the sources of the group traffic forwarded between the Node and the external network.
+	Senders []string
+	// Packets and Bytes are the total traffic forwarded by OVS for the group.
+	Packets, Bytes uint64
+	// PacketRate and BitRate are the average rates of the group traffic, in packets per second and bits per
+	// second, in the last collection interval.
+	PacketRate, BitRate uint64
+	// LastActiveTime is the last time a packet of the group was forwarded. It is zero if no packet has been
+	// forwarded.
+	LastActiveTime time.Time
+}
+
+// GetAllGroupsStats gets the statistics of all multicast groups collected in the last interval.
+func (c *Controller) GetAllGroupsStats() []*GroupTrafficStats {
+	c.groupStatsMutex.RLock()
+	defer c.groupStatsMutex.RUnlock()
+	stats := make([]*GroupTrafficStats, 0, len(c.groupStats))
+	for _, groupStats := range c.groupStats {
+		stats = append(stats, groupStats)
+	}
+	return stats
+}
+
+// GetGroupStats gets the statistics of a multicast group collected in the last interval.
+func (c *Controller) GetGroupStats(group string) *GroupTrafficStats {
+	c.groupStatsMutex.RLock()
+	defer c.groupStatsMutex.RUnlock()
+	return c.groupStats[group]
+}
+
+// collectGroupStats collects the members and the traffic statistics of all multicast groups, computes the traffic
+// rates since the previous collection, and updates the Prometheus metrics.
+func (c *Controller) collectGroupStats() {
+	now := time.Now()
+	groupMetrics := c.ofClient.MulticastGroupMetrics()
+	groupPods := c.GetGroupPods()
+	newGroupStats := make(map[string]*GroupTrafficStats)
+	for _, obj := range c.groupCache.List() {
+		status := obj.(*GroupMemberStatus)
+		group := status.group.String()
+		stats := &GroupTrafficStats{
+			Group:               group,
+			LocalReceivers:      groupPods[group],
+			SourceReceivers:     c.getSourceReceivers(status),
+			RemoteReceiverNodes: status.remoteMembers.Len(),
+			Senders:             sets.List(c.mRouteClient.getGroupSenders(group)),
+		}
+		if metric, ok := groupMetrics[group]; ok {
+			stats.Packets = metric.Packets
+			stats.Bytes = metric.Bytes
+			stats.LastActiveTime = metric.LastHitTime
+		}
+		newGroupStats[group] = stats
+	}
+
+	c.groupStatsMutex.Lock()
+	elapsed := now.Sub(c.groupStatsTime).Seconds()
+	for group, stats := range newGroupStats {
+		prevStats, ok := c.groupStats[group]
+		// The counters are reset when the group flow is reinstalled, in which case the rates cannot be computed.
+		if !ok || elapsed <= 0 || stats.Packets < prevStats.Packets || stats.Bytes < prevStats.Bytes {
+			continue
+		}
+		stats.PacketRate = uint64(float64(stats.Packets-prevStats.Packets) / elapsed)
+		stats.BitRate = uint64(float64(stats.Bytes-prevStats.Bytes) * 8 / elapsed)
+	}
+	oldGroupStats := c.groupStats
+	c.groupStats = newGroupStats
+	c.groupStatsTime = now
+	c.groupStatsMutex.Unlock()
+
+	for group, stats := range newGroupStats {
+		metrics.MulticastGroupPacketCount.WithLabelValues(group).Set(float64(stats.Packets))
+		metrics.MulticastGroupByteCount.WithLabelValues(group).Set(float64(stats.Bytes))
+		metrics.MulticastGroupLocalReceiverCount.WithLabelValues(group).Set(float64(len(stats.LocalReceivers)))
+		metrics.MulticastGroupRemoteReceiverNodeCount.WithLabelValues(group).Set(float64(stats.RemoteReceiverNodes))
+		metrics.MulticastGroupSenderCount.WithLabelValues(group).Set(float64(len(stats.Senders)))
+		lastActiveTimestamp := float64(0)
+		if !stats.LastActiveTime.IsZero() {
+			lastActiveTimestamp = float64(stats.LastActiveTime.Unix())
+		}
+		metrics.MulticastGroupLastActiveTimestamp.WithLabelValues(group).Set(lastActiveTimestamp)
+	}
+	for group := range oldGroupStats {
+		if _, ok := newGroupStats[group]; ok {
+			continue
+		}
+		metrics.MulticastGroupPacketCount.DeleteLabelValues(group)
+		metrics.MulticastGroupByteCount.DeleteLabelValues(group)
+		metrics.MulticastGroupLocalReceiverCount.DeleteLabelValues(group)
+		metrics.MulticastGroupRemoteReceiverNodeCount.DeleteLabelValues(group)
+		metrics.MulticastGroupSenderCount.DeleteLabelValues(group)
+		metrics.MulticastGroupLastActiveTimestamp.DeleteLabelValues(group)
+	}
+}
+
 func (c *Controller) checkNodeUpdate(old interface{}, cur interface{}) {
 	oldNode := old.(*corev1.Node)
 	if oldNode.Name == c.nodeConfig.Name {
 		return
 	}
 	curNode := cur.(*corev1.Node)
-	oldIPs, err := k8s.GetNodeTransportAddrs(oldNode)
+	oldIPs, err := k8s.GetNodeTunnelAddrs(oldNode)
 	if err != nil {
 		klog.ErrorS(err, "Failed to retrieve Node old IP addresses", "node", oldNode.Name)
 		return
 	}
-	newIPs, err := k8s.GetNodeTransportAddrs(curNode)
+	newIPs, err := k8s.GetNodeTunnelAddrs(curNode)
 	if err != nil {
 		klog.ErrorS(err, "Failed to retrieve Node current IP addresses", "node", curNode.Name)
 		return
@@ -872,7 +1140,21 @@ func memberExists(status *GroupMemberStatus, e *mcastGroupEvent) bool {
 
 func addGroupMember(status *GroupMemberStatus, e *mcastGroupEvent) *GroupMemberStatus {
 	if e.iface.Type == interfacestore.ContainerInterface {
+		_, exist := status.localMembers[e.iface.InterfaceName]
 		status.localMembers[e.iface.InterfaceName] = e.time
+		switch e.filterMode {
+		case anySource:
+			delete(status.localMemberSources, e.iface.InterfaceName)
+		case includeSources:
+			status.localMemberSources[e.iface.InterfaceName] = newIPSet(e.sources)
+		case allowSources:
+			// New sources are not added for the members which have joined the group for any source.
+			if sources, ok := status.localMemberSources[e.iface.InterfaceName]; ok {
+				status.localMemberSources[e.iface.InterfaceName] = sources.Union(newIPSet(e.sources))
+			} else if !exist {
+				status.localMemberSources[e.iface.InterfaceName] = newIPSet(e.sources)
+			}
+		}
 		klog.V(2).InfoS("Added local member from multicast group", "group", e.group.String(), "member", e.iface.InterfaceName)
 	} else {
 		status.remoteMembers.Insert(e.srcNode.String())
@@ -884,6 +1166,7 @@ func addGroupMember(status *GroupMemberStatus, e *mcastGroupEvent) *GroupMemberS
 func deleteGroupMember(status *GroupMemberStatus, e *mcastGroupEvent) *GroupMemberStatus {
 	if e.iface.Type == interfacestore.ContainerInterface {
 		delete(status.localMembers, e.iface.InterfaceName)
+		delete(status.localMemberSources, e.iface.InterfaceName)
 		klog.V(2).InfoS("Deleted local member from multicast group", "group", e.group.String(), "member", e.iface.InterfaceName)
 	} else {
 		status.remoteMembers.Delete(e.srcNode.String())
@@ -891,3 +1174,25 @@ func deleteGroupMember(status *GroupMemberStatus, e *mcastGroupEvent) *GroupMemb
 	}
 	return status
 }
+
+// memberSourcesChanged returns whether the sources of the local member of the event are different in the two
+// GroupMemberStatus.
+func memberSourcesChanged(oldStatus, newStatus *GroupMemberStatus, e *mcastGroupEvent) bool {
+	if e.iface.Type != interfacestore.ContainerInterface {
+		return false
+	}
+	oldSources, oldOK := oldStatus.localMemberSources[e.iface.InterfaceName]
+	newSources, newOK := newStatus.localMemberSources[e.iface.InterfaceName]
+	if oldOK != newOK {
+		return true
+	}
+	return oldOK && !oldSources.Equal(newSources)
+}
+
+func newIPSet(ips []net.IP) sets.Set[string] {
+	ipSet := sets.New[string]()
+	for _, ip := range ips {
+		ipSet.Insert(ip.String())
+	}
+	return ipSet
+}
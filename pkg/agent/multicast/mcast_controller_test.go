@@ -50,6 +50,7 @@ import (
 	agentutil "antrea.io/antrea/pkg/agent/util"
 	"antrea.io/antrea/pkg/apis/controlplane/v1beta2"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
 	"antrea.io/antrea/pkg/util/channel"
 )
 
@@ -176,6 +177,89 @@ func TestUpdateGroupMemberStatus(t *testing.T) {
 	}
 }
 
+func TestSourceSpecificGroupMembers(t *testing.T) {
+	mctrl := newMockMulticastController(t, false, false)
+	require.NoError(t, mctrl.initialize())
+	mctrl.mRouteClient.multicastInterfaceConfigs = []multicastInterfaceConfig{
+		{Name: if1.InterfaceName, IPv4Addr: &net.IPNet{IP: nodeIf1IP, Mask: net.IPv4Mask(255, 255, 255, 0)}},
+	}
+	mockIfaceStore.EXPECT().GetInterfaceByName(if1.InterfaceName).Return(if1, true).AnyTimes()
+	mockIfaceStore.EXPECT().GetInterfaceByName(if2.InterfaceName).Return(if2, true).AnyTimes()
+	mgroup := net.ParseIP("232.1.2.3")
+	source1 := net.ParseIP("10.10.0.5")
+	source2 := net.ParseIP("10.10.0.6")
+	gatewayPort := mctrl.nodeConfig.GatewayConfig.OFPort
+	now := time.Now()
+
+	getStatus := func() *GroupMemberStatus {
+		obj, exists, _ := mctrl.groupCache.GetByKey(mgroup.String())
+		require.True(t, exists)
+		return obj.(*GroupMemberStatus)
+	}
+	updateStatus := func(e *mcastGroupEvent) {
+		mctrl.addOrUpdateGroupEvent(e)
+	}
+	syncGroup := func() {
+		require.Equal(t, 1, mctrl.queue.Len())
+		key, _ := mctrl.queue.Get()
+		require.NoError(t, mctrl.syncGroup(key))
+		mctrl.queue.Forget(key)
+		mctrl.queue.Done(key)
+	}
+
+	// if1 joins the group only for source1.
+	updateStatus(&mcastGroupEvent{group: mgroup, eType: groupJoin, filterMode: includeSources, sources: []net.IP{source1}, time: now, iface: if1})
+	status := getStatus()
+	assert.Equal(t, map[string]sets.Set[string]{if1.InterfaceName: sets.New[string](source1.String())}, status.localMemberSources)
+	var source1GroupID binding.GroupIDType
+	mockOFClient.EXPECT().InstallMulticastGroup(status.ofGroupID, []uint32{gatewayPort}, nil)
+	mockOFClient.EXPECT().InstallMulticastFlows(mgroup, status.ofGroupID)
+	mockOFClient.EXPECT().InstallMulticastGroup(gomock.Any(), []uint32{gatewayPort, uint32(if1.OFPort)}, nil).Do(
+		func(groupID binding.GroupIDType, _ []uint32, _ []net.IP) {
+			source1GroupID = groupID
+		})
+	mockOFClient.EXPECT().InstallMulticastSourceFlows(mgroup, source1, gomock.Any())
+	mockMulticastSocket.EXPECT().MulticastInterfaceJoinMgroup(mgroup.To4(), nodeIf1IP.To4(), if1.InterfaceName)
+	syncGroup()
+	assert.Equal(t, map[string]map[string]binding.GroupIDType{mgroup.String(): {source1.String(): source1GroupID}}, mctrl.installedSourceGroups)
+
+	// A report with the same sources doesn't trigger a sync.
+	updateStatus(&mcastGroupEvent{group: mgroup, eType: groupJoin, filterMode: includeSources, sources: []net.IP{source1}, time: now.Add(time.Second), iface: if1})
+	assert.Equal(t, 0, mctrl.queue.Len())
+
+	// if2 joins the group for any source, and if1 adds source2 and removes source1.
+	updateStatus(&mcastGroupEvent{group: mgroup, eType: groupJoin, time: now.Add(2 * time.Second), iface: if2})
+	updateStatus(&mcastGroupEvent{group: mgroup, eType: groupJoin, filterMode: allowSources, sources: []net.IP{source2}, time: now.Add(2 * time.Second), iface: if1})
+	updateStatus(&mcastGroupEvent{group: mgroup, eType: groupLeave, filterMode: blockSources, sources: []net.IP{source1}, time: now.Add(2 * time.Second), iface: if1})
+	// Sources allowed by a member which has joined the group for any source are ignored.
+	updateStatus(&mcastGroupEvent{group: mgroup, eType: groupJoin, filterMode: allowSources, sources: []net.IP{source1}, time: now.Add(2 * time.Second), iface: if2})
+	status = getStatus()
+	assert.Equal(t, map[string]sets.Set[string]{if1.InterfaceName: sets.New[string](source2.String())}, status.localMemberSources)
+	var source2GroupID binding.GroupIDType
+	mockOFClient.EXPECT().InstallMulticastGroup(status.ofGroupID, []uint32{gatewayPort, uint32(if2.OFPort)}, nil)
+	mockOFClient.EXPECT().InstallMulticastGroup(gomock.Any(), []uint32{gatewayPort, uint32(if2.OFPort), uint32(if1.OFPort)}, nil).Do(
+		func(groupID binding.GroupIDType, _ []uint32, _ []net.IP) {
+			source2GroupID = groupID
+		})
+	mockOFClient.EXPECT().InstallMulticastSourceFlows(mgroup, source2, gomock.Any())
+	mockOFClient.EXPECT().UninstallMulticastSourceFlows(mgroup, source1)
+	mockOFClient.EXPECT().UninstallMulticastGroup(source1GroupID)
+	syncGroup()
+	assert.Equal(t, map[string]map[string]binding.GroupIDType{mgroup.String(): {source2.String(): source2GroupID}}, mctrl.installedSourceGroups)
+	assert.Equal(t, map[string][]v1beta2.PodReference{source2.String(): {{Name: if1.PodName, Namespace: if1.PodNamespace}}}, mctrl.getSourceReceivers(status))
+
+	// if1 leaves the group when its last source is blocked.
+	updateStatus(&mcastGroupEvent{group: mgroup, eType: groupLeave, filterMode: blockSources, sources: []net.IP{source2}, time: now.Add(3 * time.Second), iface: if1})
+	status = getStatus()
+	assert.NotContains(t, status.localMembers, if1.InterfaceName)
+	assert.Empty(t, status.localMemberSources)
+	mockOFClient.EXPECT().InstallMulticastGroup(status.ofGroupID, []uint32{gatewayPort, uint32(if2.OFPort)}, nil)
+	mockOFClient.EXPECT().UninstallMulticastSourceFlows(mgroup, source2)
+	mockOFClient.EXPECT().UninstallMulticastGroup(source2GroupID)
+	syncGroup()
+	assert.Empty(t, mctrl.installedSourceGroups)
+}
+
 func TestCheckNodeUpdate(t *testing.T) {
 	for _, tc := range []struct {
 		name        string
@@ -377,6 +461,50 @@ func TestGetGroupPods(t *testing.T) {
 	}
 }
 
+func TestCollectGroupStats(t *testing.T) {
+	now := time.Now()
+	mctrl := newMockMulticastController(t, false, false)
+	err := mctrl.initialize()
+	require.NoError(t, err)
+	group := "224.96.1.2"
+	require.NoError(t, mctrl.groupCache.Add(&GroupMemberStatus{
+		group:        net.ParseIP(group),
+		localMembers: map[string]time.Time{if1.InterfaceName: now},
+	}))
+	require.NoError(t, mctrl.mRouteClient.outboundRouteCache.Add(&outboundMulticastRouteEntry{
+		multicastRouteEntry: multicastRouteEntry{group: group, src: "10.1.2.3", updatedTime: now},
+	}))
+	mockIfaceStore.EXPECT().GetInterfaceByName(if1.InterfaceName).Return(if1, true).AnyTimes()
+	lastHitTime := now.Add(-time.Second).Truncate(time.Second)
+
+	mockOFClient.EXPECT().MulticastGroupMetrics().Return(map[string]*types.RuleHitStats{
+		group: {RuleMetric: types.RuleMetric{Packets: 100, Bytes: 10000}, LastHitTime: lastHitTime},
+	})
+	mctrl.collectGroupStats()
+	expectedStats := &GroupTrafficStats{
+		Group:          group,
+		LocalReceivers: []v1beta2.PodReference{{Name: if1.PodName, Namespace: if1.PodNamespace}},
+		Senders:        []string{"10.1.2.3"},
+		Packets:        100,
+		Bytes:          10000,
+		LastActiveTime: lastHitTime,
+	}
+	assert.Equal(t, expectedStats, mctrl.GetGroupStats(group))
+
+	// Simulate that the previous statistics were collected 10 seconds ago.
+	mctrl.groupStatsTime = mctrl.groupStatsTime.Add(-10 * time.Second)
+	mockOFClient.EXPECT().MulticastGroupMetrics().Return(map[string]*types.RuleHitStats{
+		group: {RuleMetric: types.RuleMetric{Packets: 1100, Bytes: 110000}, LastHitTime: lastHitTime},
+	})
+	mctrl.collectGroupStats()
+	stats := mctrl.GetGroupStats(group)
+	require.NotNil(t, stats)
+	assert.InDelta(t, 100, stats.PacketRate, 1)
+	assert.InDelta(t, 80000, stats.BitRate, 100)
+	assert.Len(t, mctrl.GetAllGroupsStats(), 1)
+	assert.Nil(t, mctrl.GetGroupStats("224.96.1.3"))
+}
+
 func TestGetPodStats(t *testing.T) {
 	mctrl := newMockMulticastController(t, false, false)
 	err := mctrl.initialize()
@@ -143,6 +143,8 @@ func (s *IGMPSnooper) validatePacketAndNotify(event *mcastGroupEvent, igmpType u
 		// If any rule is desired to drop the traffic, Antrea Agent removes the Pod from
 		// the OpenFlow group bucket directly
 		event.eType = groupLeave
+		event.filterMode = anySource
+		event.sources = nil
 	}
 	s.eventCh <- event
 }
@@ -267,16 +269,18 @@ func (s *IGMPSnooper) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 		for _, gr := range msg.GroupRecords {
 			mgroup := gr.MulticastAddress
 			klog.V(2).InfoS("Received IGMPv3 Report message", "group", mgroup.String(), "interface", iface.InterfaceName, "pod", podName, "recordType", gr.Type, "sourceCount", gr.NumberOfSources)
-			evtType := groupJoin
-			if (gr.Type == protocol.IGMPIsIn || gr.Type == protocol.IGMPToIn) && gr.NumberOfSources == 0 {
-				evtType = groupLeave
+			evtType, filterMode, sources := parseIGMPv3GroupRecord(gr)
+			if (filterMode == allowSources || filterMode == blockSources) && len(sources) == 0 {
+				continue
 			}
 			event := &mcastGroupEvent{
-				group:   mgroup,
-				eType:   evtType,
-				time:    now,
-				iface:   iface,
-				srcNode: srcNode,
+				group:      mgroup,
+				eType:      evtType,
+				filterMode: filterMode,
+				sources:    sources,
+				time:       now,
+				iface:      iface,
+				srcNode:    srcNode,
 			}
 			s.validatePacketAndNotify(event, igmpType, *pktData)
 		}
@@ -294,6 +298,26 @@ func (s *IGMPSnooper) HandlePacketIn(pktIn *ofctrl.PacketIn) error {
 	return nil
 }
 
+// parseIGMPv3GroupRecord returns the type of the membership event, the source filter mode and the sources of an
+// IGMPv3 group record. The sources excluded by EXCLUDE mode records are not supported, and the member receives
+// the traffic from any source in this case.
+func parseIGMPv3GroupRecord(gr protocol.IGMPv3GroupRecord) (eventType, sourceFilterMode, []net.IP) {
+	switch gr.Type {
+	case protocol.IGMPIsIn, protocol.IGMPToIn:
+		// An INCLUDE mode record without sources means that the member has left the group.
+		if gr.NumberOfSources == 0 {
+			return groupLeave, anySource, nil
+		}
+		return groupJoin, includeSources, gr.SourceAddresses
+	case protocol.IGMPAllow:
+		return groupJoin, allowSources, gr.SourceAddresses
+	case protocol.IGMPBlock:
+		return groupLeave, blockSources, gr.SourceAddresses
+	default:
+		return groupJoin, anySource, nil
+	}
+}
+
 func (s *IGMPSnooper) parseSrcNode(pktIn *ofctrl.PacketIn) (net.IP, error) {
 	matches := pktIn.GetMatches()
 	tunSrcField := matches.GetMatchByName(binding.NxmFieldTunIPv4Src)
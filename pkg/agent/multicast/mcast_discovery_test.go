@@ -150,6 +150,59 @@ func TestParseIGMPPacket(t *testing.T) {
 	}
 }
 
+func TestParseIGMPv3GroupRecord(t *testing.T) {
+	group := net.ParseIP("232.1.2.3")
+	sources := []net.IP{net.ParseIP("10.10.0.5"), net.ParseIP("10.10.0.6")}
+	for _, tc := range []struct {
+		name               string
+		record             protocol.IGMPv3GroupRecord
+		expectedEventType  eventType
+		expectedFilterMode sourceFilterMode
+		expectedSources    []net.IP
+	}{
+		{
+			name:               "IS_IN with sources",
+			record:             protocol.IGMPv3GroupRecord{Type: protocol.IGMPIsIn, NumberOfSources: 2, MulticastAddress: group, SourceAddresses: sources},
+			expectedEventType:  groupJoin,
+			expectedFilterMode: includeSources,
+			expectedSources:    sources,
+		},
+		{
+			name:               "TO_IN without sources",
+			record:             protocol.IGMPv3GroupRecord{Type: protocol.IGMPToIn, MulticastAddress: group},
+			expectedEventType:  groupLeave,
+			expectedFilterMode: anySource,
+		},
+		{
+			name:               "IS_EX with sources",
+			record:             protocol.IGMPv3GroupRecord{Type: protocol.IGMPIsEx, NumberOfSources: 2, MulticastAddress: group, SourceAddresses: sources},
+			expectedEventType:  groupJoin,
+			expectedFilterMode: anySource,
+		},
+		{
+			name:               "ALLOW",
+			record:             protocol.IGMPv3GroupRecord{Type: protocol.IGMPAllow, NumberOfSources: 2, MulticastAddress: group, SourceAddresses: sources},
+			expectedEventType:  groupJoin,
+			expectedFilterMode: allowSources,
+			expectedSources:    sources,
+		},
+		{
+			name:               "BLOCK",
+			record:             protocol.IGMPv3GroupRecord{Type: protocol.IGMPBlock, NumberOfSources: 2, MulticastAddress: group, SourceAddresses: sources},
+			expectedEventType:  groupLeave,
+			expectedFilterMode: blockSources,
+			expectedSources:    sources,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			eType, filterMode, sources := parseIGMPv3GroupRecord(tc.record)
+			assert.Equal(t, tc.expectedEventType, eType)
+			assert.Equal(t, tc.expectedFilterMode, filterMode)
+			assert.Equal(t, tc.expectedSources, sources)
+		})
+	}
+}
+
 func TestIGMPRemoteReport(t *testing.T) {
 	controller := gomock.NewController(t)
 	mockOFClient := openflowtest.NewMockClient(controller)
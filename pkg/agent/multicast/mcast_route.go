@@ -181,6 +181,23 @@ func (c *MRouteClient) deleteOutboundMRoute(mRoute *outboundMulticastRouteEntry)
 	return nil
 }
 
+// getGroupSenders returns the sources of the multicast traffic of the group which is forwarded between the Antrea
+// gateway and the multicast interfaces.
+func (c *MRouteClient) getGroupSenders(group string) sets.Set[string] {
+	senders := sets.New[string]()
+	inboundEntries, _ := c.inboundRouteCache.ByIndex(GroupNameIndexName, group)
+	for _, obj := range inboundEntries {
+		senders.Insert(obj.(*inboundMulticastRouteEntry).src)
+	}
+	for _, obj := range c.outboundRouteCache.List() {
+		entry := obj.(*outboundMulticastRouteEntry)
+		if entry.group == group {
+			senders.Insert(entry.src)
+		}
+	}
+	return senders
+}
+
 // addOutboundMrouteEntry configures multicast route from Antrea gateway to all the multicast interfaces,
 // allowing multicast srcNode Pods to send multicast traffic to external.
 func (c *MRouteClient) addOutboundMrouteEntry(src net.IP, group net.IP) error {
@@ -0,0 +1,250 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+// nodeIPCheckInterval is the interval at which the transport addresses of the Node are checked, as there is no
+// Node event when they change.
+const nodeIPCheckInterval = 30 * time.Second
+
+// NodeRouteReconciler reconciles the routes, flows and tunnels to the peer Nodes after the transport addresses of the
+// local Node have changed. It is implemented by the NodeRouteController.
+type NodeRouteReconciler interface {
+	ReconcileLocalTransportAddresses()
+}
+
+// NodeIPMonitor detects changes to the IP addresses of the local Node after the agent has been initialized, e.g.
+// when the DHCP lease of the Node is renewed with a different address or when the Node is re-IPed by the cloud
+// provider. When a change is detected, the new addresses are updated in the NodeConfig, and the default tunnel port,
+// the Node annotations, the OpenFlow flows, the host routes and the routes to the peer Nodes which depend on them are
+// reconciled in place, without restarting the agent. The NodeRouteControllers of the other Nodes reconcile the
+// routes, the tunnel ports and the WireGuard peers for this Node when they receive the updated Node.
+type NodeIPMonitor struct {
+	initializer         *Initializer
+	nodeLister          corelisters.NodeLister
+	nodeListerSynced    cache.InformerSynced
+	nodeRouteReconciler NodeRouteReconciler
+	notifyCh            chan struct{}
+	// reconcilePending is true when the NodeConfig has been updated with the new addresses, but the datapath has not
+	// been reconciled successfully yet. prevTransportIPv4Addr and prevTransportIPv6Addr are the transport addresses
+	// the datapath was configured with before the change.
+	reconcilePending      bool
+	prevTransportIPv4Addr *net.IPNet
+	prevTransportIPv6Addr *net.IPNet
+}
+
+// nodeAddresses are the IP addresses and the transport IP addresses of the Node.
+type nodeAddresses struct {
+	nodeIPv4Addr      *net.IPNet
+	nodeIPv6Addr      *net.IPNet
+	transportIPv4Addr *net.IPNet
+	transportIPv6Addr *net.IPNet
+}
+
+// NewNodeIPMonitor returns a NodeIPMonitor for the local Node. It must be called after the Initializer has
+// initialized the Node configuration.
+func (i *Initializer) NewNodeIPMonitor(nodeInformer coreinformers.NodeInformer, nodeRouteReconciler NodeRouteReconciler) *NodeIPMonitor {
+	m := &NodeIPMonitor{
+		initializer:         i,
+		nodeLister:          nodeInformer.Lister(),
+		nodeListerSynced:    nodeInformer.Informer().HasSynced,
+		nodeRouteReconciler: nodeRouteReconciler,
+		notifyCh:            make(chan struct{}, 1),
+	}
+	nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(_, cur interface{}) {
+			if node, ok := cur.(*v1.Node); ok && node.Name == i.nodeConfig.Name {
+				m.notify()
+			}
+		},
+	})
+	return m
+}
+
+func (m *NodeIPMonitor) notify() {
+	select {
+	case m.notifyCh <- struct{}{}:
+	default:
+	}
+}
+
+func (m *NodeIPMonitor) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting NodeIPMonitor")
+	defer klog.InfoS("Shutting down NodeIPMonitor")
+
+	if !cache.WaitForNamedCacheSync("NodeIPMonitor", stopCh, m.nodeListerSynced) {
+		return
+	}
+	ticker := time.NewTicker(nodeIPCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-m.notifyCh:
+		case <-ticker.C:
+		}
+		if err := m.syncNodeIPs(); err != nil {
+			klog.ErrorS(err, "Failed to sync the IP addresses of the Node")
+		}
+	}
+}
+
+// syncNodeIPs updates the NodeConfig with the new addresses of the Node if they have changed, and reconciles the
+// datapath with them. If the datapath cannot be reconciled, it is retried on the next call.
+func (m *NodeIPMonitor) syncNodeIPs() error {
+	addrs, err := m.checkNodeIPs()
+	if err != nil {
+		return err
+	}
+	if addrs != nil {
+		if err := m.updateNodeConfig(addrs); err != nil {
+			return err
+		}
+	}
+	if !m.reconcilePending {
+		return nil
+	}
+	if err := m.reconcileDatapath(); err != nil {
+		return err
+	}
+	m.reconcilePending = false
+	klog.InfoS("Reconciled the datapath with the new Node IP addresses")
+	return nil
+}
+
+// checkNodeIPs returns the new addresses of the Node if the Node IP addresses or the transport IP addresses no longer
+// match the ones in the NodeConfig, and the new addresses are configured on a local interface. It returns nil if the
+// addresses have not changed.
+func (m *NodeIPMonitor) checkNodeIPs() (*nodeAddresses, error) {
+	i := m.initializer
+	nodeConfig := i.nodeConfig
+	node, err := m.nodeLister.Get(nodeConfig.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Node %s: %w", nodeConfig.Name, err)
+	}
+	nodeIPs, err := k8s.GetNodeAddrs(node)
+	if err != nil {
+		return nil, fmt.Errorf("failed to obtain Node IP addresses: %w", err)
+	}
+	addrs := &nodeAddresses{
+		nodeIPv4Addr: nodeConfig.NodeIPv4Addr,
+		nodeIPv6Addr: nodeConfig.NodeIPv6Addr,
+	}
+	nodeIPsChanged := !ipNetAddr(nodeConfig.NodeIPv4Addr).Equal(nodeIPs.IPv4) || !ipNetAddr(nodeConfig.NodeIPv6Addr).Equal(nodeIPs.IPv6)
+	if nodeIPsChanged {
+		// The Node IP addresses are reported by kubelet, which may update them before the new addresses are
+		// configured on the local interface, or after the old ones have been removed. Wait for the new addresses
+		// to be configured, as the datapath cannot be configured with them otherwise.
+		addrs.nodeIPv4Addr, addrs.nodeIPv6Addr, _, err = i.getNodeInterfaceFromIP(nodeIPs)
+		if err != nil {
+			return nil, fmt.Errorf("new Node IP addresses %v are not configured on a local interface yet: %w", nodeIPs, err)
+		}
+		klog.InfoS("Node IP addresses have changed", "oldIPv4", nodeConfig.NodeIPv4Addr, "oldIPv6", nodeConfig.NodeIPv6Addr,
+			"newIPv4", nodeIPs.IPv4, "newIPv6", nodeIPs.IPv6)
+	}
+
+	if i.networkConfig.TransportIface != "" {
+		addrs.transportIPv4Addr, addrs.transportIPv6Addr, _, err = getTransportIPNetDeviceByNameFn(i.networkConfig.TransportIface, i.ovsBridge)
+	} else if len(i.networkConfig.TransportIfaceCIDRs) > 0 {
+		addrs.transportIPv4Addr, addrs.transportIPv6Addr, _, err = getIPNetDeviceByCIDRs(i.networkConfig.TransportIfaceCIDRs)
+	} else {
+		// The transport addresses are the Node IP addresses.
+		if !nodeIPsChanged {
+			return nil, nil
+		}
+		addrs.transportIPv4Addr, addrs.transportIPv6Addr = addrs.nodeIPv4Addr, addrs.nodeIPv6Addr
+		return addrs, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get transport interface addresses: %w", err)
+	}
+	if !ipNetAddr(nodeConfig.NodeTransportIPv4Addr).Equal(ipNetAddr(addrs.transportIPv4Addr)) ||
+		!ipNetAddr(nodeConfig.NodeTransportIPv6Addr).Equal(ipNetAddr(addrs.transportIPv6Addr)) {
+		klog.InfoS("Node transport IP addresses have changed", "oldIPv4", nodeConfig.NodeTransportIPv4Addr, "oldIPv6", nodeConfig.NodeTransportIPv6Addr,
+			"newIPv4", addrs.transportIPv4Addr, "newIPv6", addrs.transportIPv6Addr)
+		return addrs, nil
+	}
+	if nodeIPsChanged {
+		return addrs, nil
+	}
+	return nil, nil
+}
+
+// updateNodeConfig updates the NodeConfig with the new addresses of the Node. The IP families of the Node cannot
+// change, as the datapath is configured for the IP families of the Node when the agent is initialized.
+func (m *NodeIPMonitor) updateNodeConfig(addrs *nodeAddresses) error {
+	nodeConfig := m.initializer.nodeConfig
+	if (addrs.nodeIPv4Addr == nil) != (nodeConfig.NodeIPv4Addr == nil) || (addrs.nodeIPv6Addr == nil) != (nodeConfig.NodeIPv6Addr == nil) ||
+		(addrs.transportIPv4Addr == nil) != (nodeConfig.NodeTransportIPv4Addr == nil) || (addrs.transportIPv6Addr == nil) != (nodeConfig.NodeTransportIPv6Addr == nil) {
+		return fmt.Errorf("the IP families of the Node addresses have changed, which requires restarting the agent")
+	}
+	// Keep the addresses the datapath was configured with if a previous change has not been reconciled yet.
+	if !m.reconcilePending {
+		m.prevTransportIPv4Addr, m.prevTransportIPv6Addr = nodeConfig.NodeTransportIPv4Addr, nodeConfig.NodeTransportIPv6Addr
+	}
+	nodeConfig.NodeIPv4Addr = addrs.nodeIPv4Addr
+	nodeConfig.NodeIPv6Addr = addrs.nodeIPv6Addr
+	nodeConfig.NodeTransportIPv4Addr = addrs.transportIPv4Addr
+	nodeConfig.NodeTransportIPv6Addr = addrs.transportIPv6Addr
+	m.reconcilePending = true
+	return nil
+}
+
+// reconcileDatapath reconciles the configuration which depends on the addresses of the Node with the NodeConfig. It
+// is idempotent and can be retried.
+func (m *NodeIPMonitor) reconcileDatapath() error {
+	i := m.initializer
+	nodeConfig := i.nodeConfig
+	if i.networkConfig.TransportIface != "" || len(i.networkConfig.TransportIfaceCIDRs) > 0 {
+		if err := i.patchNodeTransportAddressAnnotation(nodeConfig.Name, nodeConfig.NodeTransportIPv4Addr, nodeConfig.NodeTransportIPv6Addr); err != nil {
+			return fmt.Errorf("failed to update the transport addresses annotation of the Node: %w", err)
+		}
+	}
+	// The local IP of the default tunnel port is only set on Windows, where it is the transport IP of the Node.
+	if i.networkConfig.NeedsTunnelInterface() && i.getTunnelPortLocalIP() != nil {
+		if err := i.setupDefaultTunnelInterface(); err != nil {
+			return fmt.Errorf("failed to update the default tunnel port: %w", err)
+		}
+	}
+	if err := i.ofClient.UpdateNodeIPs(); err != nil {
+		return fmt.Errorf("failed to update the flows with the new Node IP addresses: %w", err)
+	}
+	if err := i.routeClient.UpdateNodeTransportAddresses(m.prevTransportIPv4Addr, m.prevTransportIPv6Addr); err != nil {
+		return fmt.Errorf("failed to update the routes with the new Node transport addresses: %w", err)
+	}
+	m.nodeRouteReconciler.ReconcileLocalTransportAddresses()
+	return nil
+}
+
+func ipNetAddr(ipNet *net.IPNet) net.IP {
+	if ipNet == nil {
+		return nil
+	}
+	return ipNet.IP
+}
@@ -0,0 +1,227 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package agent
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mock "go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/agent/config"
+	oftest "antrea.io/antrea/pkg/agent/openflow/testing"
+	routetest "antrea.io/antrea/pkg/agent/route/testing"
+	"antrea.io/antrea/pkg/util/ip"
+)
+
+func newNodeWithInternalIP(name, internalIP string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: internalIP}},
+		},
+	}
+}
+
+func TestNodeIPMonitorCheckNodeIPs(t *testing.T) {
+	_, nodeIPNet, _ := net.ParseCIDR("192.168.10.10/24")
+	nodeIPNet.IP = net.ParseIP("192.168.10.10")
+	_, transportIPNet, _ := net.ParseCIDR("172.16.10.10/24")
+	transportIPNet.IP = net.ParseIP("172.16.10.10")
+	_, newTransportIPNet, _ := net.ParseCIDR("172.16.10.20/24")
+	newTransportIPNet.IP = net.ParseIP("172.16.10.20")
+	_, newNodeIPNet, _ := net.ParseCIDR("192.168.10.20/24")
+	newNodeIPNet.IP = net.ParseIP("192.168.10.20")
+	ipDevice := &net.Interface{Name: "eth0", Index: 2}
+
+	tests := []struct {
+		name                 string
+		nodeIP               string
+		networkConfig        *config.NetworkConfig
+		nodeIPConfigured     bool
+		transportIPv4Addr    *net.IPNet
+		nodeTransportIPv4Net *net.IPNet
+		expectedAddrs        *nodeAddresses
+		expectedErr          string
+	}{
+		{
+			name:                 "unchanged Node IP",
+			nodeIP:               "192.168.10.10",
+			networkConfig:        &config.NetworkConfig{},
+			nodeIPConfigured:     true,
+			nodeTransportIPv4Net: nodeIPNet,
+		},
+		{
+			name:                 "changed Node IP",
+			nodeIP:               "192.168.10.20",
+			networkConfig:        &config.NetworkConfig{},
+			nodeIPConfigured:     true,
+			nodeTransportIPv4Net: nodeIPNet,
+			expectedAddrs: &nodeAddresses{
+				nodeIPv4Addr:      newNodeIPNet,
+				transportIPv4Addr: newNodeIPNet,
+			},
+		},
+		{
+			name:                 "changed Node IP not configured yet",
+			nodeIP:               "192.168.10.20",
+			networkConfig:        &config.NetworkConfig{},
+			nodeTransportIPv4Net: nodeIPNet,
+			expectedErr:          "new Node IP addresses",
+		},
+		{
+			name:                 "unchanged transport IP",
+			nodeIP:               "192.168.10.10",
+			networkConfig:        &config.NetworkConfig{TransportIface: "eth1"},
+			nodeIPConfigured:     true,
+			transportIPv4Addr:    transportIPNet,
+			nodeTransportIPv4Net: transportIPNet,
+		},
+		{
+			name:                 "changed transport IP",
+			nodeIP:               "192.168.10.10",
+			networkConfig:        &config.NetworkConfig{TransportIfaceCIDRs: []string{"172.16.10.0/24"}},
+			nodeIPConfigured:     true,
+			transportIPv4Addr:    newTransportIPNet,
+			nodeTransportIPv4Net: transportIPNet,
+			expectedAddrs: &nodeAddresses{
+				nodeIPv4Addr:      nodeIPNet,
+				transportIPv4Addr: newTransportIPNet,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prevGetIPNetDeviceFromIP := getIPNetDeviceFromIP
+			getIPNetDeviceFromIP = func(localIP *ip.DualStackIPs, ignoredHostInterfaces sets.Set[string]) (*net.IPNet, *net.IPNet, *net.Interface, error) {
+				if !tt.nodeIPConfigured {
+					return nil, nil, nil, fmt.Errorf("unable to find local IPs and device")
+				}
+				return &net.IPNet{IP: localIP.IPv4, Mask: nodeIPNet.Mask}, nil, ipDevice, nil
+			}
+			t.Cleanup(func() { getIPNetDeviceFromIP = prevGetIPNetDeviceFromIP })
+			mockGetTransportIPNetDeviceByName(t, tt.transportIPv4Addr, nil, ipDevice)
+			mockGetIPNetDeviceByCIDRs(t, tt.transportIPv4Addr, nil, ipDevice)
+
+			client := fake.NewSimpleClientset(newNodeWithInternalIP("node1", tt.nodeIP))
+			informerFactory := informers.NewSharedInformerFactory(client, 0)
+			initializer := &Initializer{
+				networkConfig: tt.networkConfig,
+				nodeConfig: &config.NodeConfig{
+					Name:                  "node1",
+					NodeIPv4Addr:          nodeIPNet,
+					NodeTransportIPv4Addr: tt.nodeTransportIPv4Net,
+				},
+				hostGateway: "antrea-gw0",
+			}
+			monitor := initializer.NewNodeIPMonitor(informerFactory.Core().V1().Nodes(), &fakeNodeRouteReconciler{})
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			informerFactory.Start(stopCh)
+			informerFactory.WaitForCacheSync(stopCh)
+
+			addrs, err := monitor.checkNodeIPs()
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedAddrs, addrs)
+			}
+		})
+	}
+}
+
+func TestNodeIPMonitorRun(t *testing.T) {
+	_, nodeIPNet, _ := net.ParseCIDR("192.168.10.10/24")
+	nodeIPNet.IP = net.ParseIP("192.168.10.10")
+	prevGetIPNetDeviceFromIP := getIPNetDeviceFromIP
+	getIPNetDeviceFromIP = func(localIP *ip.DualStackIPs, ignoredHostInterfaces sets.Set[string]) (*net.IPNet, *net.IPNet, *net.Interface, error) {
+		return &net.IPNet{IP: localIP.IPv4, Mask: nodeIPNet.Mask}, nil, &net.Interface{Name: "eth0", Index: 2}, nil
+	}
+	t.Cleanup(func() { getIPNetDeviceFromIP = prevGetIPNetDeviceFromIP })
+
+	ctrl := mock.NewController(t)
+	ofClient := oftest.NewMockClient(ctrl)
+	routeClient := routetest.NewMockInterface(ctrl)
+	nodeRouteReconciler := &fakeNodeRouteReconciler{}
+	client := fake.NewSimpleClientset(newNodeWithInternalIP("node1", "192.168.10.10"))
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	nodeConfig := &config.NodeConfig{
+		Name:                  "node1",
+		NodeIPv4Addr:          nodeIPNet,
+		NodeTransportIPv4Addr: nodeIPNet,
+	}
+	initializer := &Initializer{
+		networkConfig: &config.NetworkConfig{TrafficEncapMode: config.TrafficEncapModeNoEncap},
+		nodeConfig:    nodeConfig,
+		ofClient:      ofClient,
+		routeClient:   routeClient,
+		hostGateway:   "antrea-gw0",
+	}
+	monitor := initializer.NewNodeIPMonitor(informerFactory.Core().V1().Nodes(), nodeRouteReconciler)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	// An update of the Node which doesn't change its IP doesn't reconcile the datapath.
+	node := newNodeWithInternalIP("node1", "192.168.10.10")
+	node.Labels = map[string]string{"foo": "bar"}
+	_, err := client.CoreV1().Nodes().Update(context.TODO(), node, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, monitor.syncNodeIPs())
+	assert.Equal(t, 0, nodeRouteReconciler.calls)
+
+	// The datapath is reconciled in place when the Node IP changes, and the reconciliation is retried until it
+	// succeeds.
+	_, err = client.CoreV1().Nodes().Update(context.TODO(), newNodeWithInternalIP("node1", "192.168.10.20"), metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		node, err := monitor.nodeLister.Get("node1")
+		return err == nil && node.Status.Addresses[0].Address == "192.168.10.20"
+	}, 5*time.Second, 10*time.Millisecond)
+	ofClient.EXPECT().UpdateNodeIPs().Return(fmt.Errorf("bridge disconnected"))
+	assert.ErrorContains(t, monitor.syncNodeIPs(), "bridge disconnected")
+	assert.Equal(t, "192.168.10.20", nodeConfig.NodeIPv4Addr.IP.String())
+	assert.Equal(t, "192.168.10.20", nodeConfig.NodeTransportIPv4Addr.IP.String())
+	assert.Equal(t, 0, nodeRouteReconciler.calls)
+
+	ofClient.EXPECT().UpdateNodeIPs()
+	routeClient.EXPECT().UpdateNodeTransportAddresses(nodeIPNet, nil)
+	require.NoError(t, monitor.syncNodeIPs())
+	assert.Equal(t, 1, nodeRouteReconciler.calls)
+
+	// Nothing is reconciled once the datapath has been updated.
+	require.NoError(t, monitor.syncNodeIPs())
+	assert.Equal(t, 1, nodeRouteReconciler.calls)
+}
+
+type fakeNodeRouteReconciler struct {
+	calls int
+}
+
+func (f *fakeNodeRouteReconciler) ReconcileLocalTransportAddresses() {
+	f.calls++
+}
@@ -76,9 +76,16 @@ func compareNPLAnnotationLists(annotations1, annotations2 []npltypes.NPLAnnotati
 	if len(annotations1) != len(annotations2) {
 		return false
 	}
+	// With dual-stack Pods, the same Node port can be allocated for both IP families, so the
+	// Node IP and the protocol are used to break ties.
 	nplAnnotationLess := func(a1, a2 *npltypes.NPLAnnotation) bool {
-		return a1.NodePort < a2.NodePort
-
+		if a1.NodePort != a2.NodePort {
+			return a1.NodePort < a2.NodePort
+		}
+		if a1.NodeIP != a2.NodeIP {
+			return a1.NodeIP < a2.NodeIP
+		}
+		return a1.Protocol < a2.Protocol
 	}
 	sort.Slice(annotations1, func(i, j int) bool {
 		return nplAnnotationLess(&annotations1[i], &annotations1[j])
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	"antrea.io/antrea/pkg/agent/nodeportlocal/portcache"
@@ -29,6 +30,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -37,6 +39,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
 )
 
 const (
@@ -51,30 +54,45 @@ const (
 )
 
 type NPLController struct {
-	portTable   *portcache.PortTable
+	// portTables contains one PortTable per enabled IP family.
+	portTables  []*portcache.PortTable
 	kubeClient  clientset.Interface
 	queue       workqueue.TypedRateLimitingInterface[string]
 	podInformer cache.SharedIndexInformer
 	podLister   corelisters.PodLister
 	svcInformer cache.SharedIndexInformer
 	nodeName    string
+	// podHandler and svcHandler are the registrations of the event handlers, used to remove them when NodePortLocal
+	// is disabled at runtime.
+	podHandler cache.ResourceEventHandlerRegistration
+	svcHandler cache.ResourceEventHandlerRegistration
+	// stoppedCh is closed when Run returns, after the workers have stopped.
+	stoppedCh chan struct{}
 }
 
 func NewNPLController(kubeClient clientset.Interface,
 	podInformer cache.SharedIndexInformer,
 	svcInformer cache.SharedIndexInformer,
 	pt *portcache.PortTable,
+	ptIPv6 *portcache.PortTable,
 	nodeName string) *NPLController {
+	var portTables []*portcache.PortTable
+	for _, table := range []*portcache.PortTable{pt, ptIPv6} {
+		if table != nil {
+			portTables = append(portTables, table)
+		}
+	}
 	c := NPLController{
 		kubeClient:  kubeClient,
-		portTable:   pt,
+		portTables:  portTables,
 		podInformer: podInformer,
 		podLister:   corelisters.NewPodLister(podInformer.GetIndexer()),
 		svcInformer: svcInformer,
 		nodeName:    nodeName,
+		stoppedCh:   make(chan struct{}),
 	}
 
-	podInformer.AddEventHandlerWithResyncPeriod(
+	c.podHandler, _ = podInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.enqueuePod,
 			DeleteFunc: c.enqueuePod,
@@ -83,7 +101,7 @@ func NewNPLController(kubeClient clientset.Interface,
 		resyncPeriod,
 	)
 
-	svcInformer.AddEventHandlerWithResyncPeriod(
+	c.svcHandler, _ = svcInformer.AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    c.enqueueSvc,
 			DeleteFunc: c.enqueueSvc,
@@ -122,9 +140,12 @@ func podKeyFunc(pod *corev1.Pod) string {
 // Run starts to watch and process Pod updates for the Node where Antrea Agent is running.
 // It starts a queue and a fixed number of workers to process the objects from the queue.
 func (c *NPLController) Run(stopCh <-chan struct{}) {
+	var wg sync.WaitGroup
 	defer func() {
 		klog.Infof("Shutting down %s", controllerName)
 		c.queue.ShutDown()
+		wg.Wait()
+		close(c.stoppedCh)
 	}()
 
 	klog.Infof("Starting %s", controllerName)
@@ -135,12 +156,57 @@ func (c *NPLController) Run(stopCh <-chan struct{}) {
 	c.waitForRulesInitialization()
 
 	for i := 0; i < numWorkers; i++ {
-		go wait.Until(c.Worker, time.Second, stopCh)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			wait.Until(c.Worker, time.Second, stopCh)
+		}()
 	}
 
 	<-stopCh
 }
 
+// Cleanup removes the event handlers of the Controller, all the NodePortLocal rules programmed in the Node, and the
+// NodePortLocal annotations of the Pods running on the Node. It is meant to be called when NodePortLocal is disabled
+// at runtime, after the stop channel of the Controller has been closed, and waits for the workers to stop first, so
+// that they do not program rules or annotate Pods again while they are being removed.
+func (c *NPLController) Cleanup() error {
+	<-c.stoppedCh
+	var errs []error
+	if c.podHandler != nil {
+		if err := c.podInformer.RemoveEventHandler(c.podHandler); err != nil {
+			errs = append(errs, fmt.Errorf("error when removing Pod event handler: %w", err))
+		}
+	}
+	if c.svcHandler != nil {
+		if err := c.svcInformer.RemoveEventHandler(c.svcHandler); err != nil {
+			errs = append(errs, fmt.Errorf("error when removing Service event handler: %w", err))
+		}
+	}
+	for _, pt := range c.portTables {
+		// Deleting the rules one by one also releases the local ports.
+		for _, obj := range pt.PortTableCache.List() {
+			data := obj.(*portcache.NodePortData)
+			if err := pt.DeleteRule(data.PodKey, data.PodPort, data.Protocol.Protocol); err != nil {
+				errs = append(errs, fmt.Errorf("error when deleting NodePortLocal rule for Pod %s: %w", data.PodKey, err))
+			}
+		}
+		if err := pt.PodPortRules.DeleteAllRules(); err != nil {
+			errs = append(errs, fmt.Errorf("error when deleting NodePortLocal rules: %w", err))
+		}
+	}
+	pods, err := c.podLister.List(labels.Everything())
+	if err != nil {
+		errs = append(errs, fmt.Errorf("error when listing Pods: %w", err))
+	}
+	for _, pod := range pods {
+		if err := c.cleanupNPLAnnotationForPod(pod); err != nil {
+			errs = append(errs, fmt.Errorf("error when removing NodePortLocal annotation of Pod %s: %w", podKeyFunc(pod), err))
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
 func (c *NPLController) syncPod(key string) error {
 	obj, exists, err := c.podInformer.GetIndexer().GetByKey(key)
 	if err != nil {
@@ -379,7 +445,59 @@ func (c *NPLController) processNextWorkItem() bool {
 }
 
 func (c *NPLController) deleteAllPortRulesIfAny(podKey string) error {
-	return c.portTable.DeleteRulesForPod(podKey)
+	for _, pt := range c.portTables {
+		if err := pt.DeleteRulesForPod(podKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getPortTable returns the PortTable for the provided IP family, or nil if the IP family is not
+// enabled for NodePortLocal.
+func (c *NPLController) getPortTable(isIPv6 bool) *portcache.PortTable {
+	for _, pt := range c.portTables {
+		if pt.IsIPv6 == isIPv6 {
+			return pt
+		}
+	}
+	return nil
+}
+
+// getPodIPForFamily returns the IP address of the Pod for the provided IP family, or an empty
+// string if the Pod has no address of this family.
+func getPodIPForFamily(pod *corev1.Pod, isIPv6 bool) string {
+	podIPs := []string{pod.Status.PodIP}
+	if len(pod.Status.PodIPs) > 0 {
+		podIPs = podIPs[:0]
+		for _, podIP := range pod.Status.PodIPs {
+			podIPs = append(podIPs, podIP.IP)
+		}
+	}
+	for _, podIP := range podIPs {
+		if podIP != "" && utilnet.IsIPv6String(podIP) == isIPv6 {
+			return podIP
+		}
+	}
+	return ""
+}
+
+// getNodeIPForFamily returns the IP address of the Pod's Node for the provided IP family, or an
+// empty string if the Node has no address of this family.
+func getNodeIPForFamily(pod *corev1.Pod, isIPv6 bool) string {
+	hostIPs := []string{pod.Status.HostIP}
+	if len(pod.Status.HostIPs) > 0 {
+		hostIPs = hostIPs[:0]
+		for _, hostIP := range pod.Status.HostIPs {
+			hostIPs = append(hostIPs, hostIP.IP)
+		}
+	}
+	for _, hostIP := range hostIPs {
+		if hostIP != "" && utilnet.IsIPv6String(hostIP) == isIPv6 {
+			return hostIP
+		}
+	}
+	return ""
 }
 
 // handleRemovePod removes rules from port table and
@@ -400,8 +518,7 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 	pod := obj.(*corev1.Pod)
 	klog.V(2).Infof("Got add/update event for Pod: %s", key)
 
-	podIP := pod.Status.PodIP
-	if podIP == "" {
+	if pod.Status.PodIP == "" {
 		klog.Infof("IP address not set for Pod: %s", key)
 		return nil
 	}
@@ -409,8 +526,6 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 	targetPortsInt, targetPortsStr := c.getTargetPortsForServicesOfPod(pod)
 	klog.V(2).Infof("Pod %s is selected by a Service for which NodePortLocal is enabled", key)
 
-	var nodePort int
-	podPorts := make(map[string]struct{})
 	podContainers := pod.Spec.Containers
 	nplAnnotations := []types.NPLAnnotation{}
 
@@ -457,6 +572,46 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 		return nil
 	}
 
+	// Rules are managed independently for each IP family: for dual-stack Pods, one NPL
+	// annotation is generated for each Node IP.
+	for _, pt := range c.portTables {
+		if err := c.syncPodRules(pod, key, pt, targetPortsInt, hostPorts, nplAnnotationsRequiredMap); err != nil {
+			return err
+		}
+	}
+	for _, annotation := range nplAnnotationsRequiredMap {
+		nplAnnotationsRequired = append(nplAnnotationsRequired, annotation)
+	}
+
+	// finally, we can check if the current annotation matches the expected one (which we built
+	// when syncing the rules for each IP family). If not, the Pod needed to be patched.
+	updatePodAnnotation := !compareNPLAnnotationLists(nplAnnotations, nplAnnotationsRequired)
+	if updatePodAnnotation {
+		return c.updatePodNPLAnnotation(pod, nplAnnotationsRequired)
+	}
+	return nil
+}
+
+// syncPodRules ensures that the rules in the provided PortTable match the target ports of the
+// Pod, and adds the expected NPL annotations for the IP family of the PortTable to
+// nplAnnotationsRequiredMap.
+func (c *NPLController) syncPodRules(
+	pod *corev1.Pod,
+	key string,
+	pt *portcache.PortTable,
+	targetPortsInt sets.Set[string],
+	hostPorts map[string]int,
+	nplAnnotationsRequiredMap map[string]types.NPLAnnotation,
+) error {
+	podIP := getPodIPForFamily(pod, pt.IsIPv6)
+	nodeIP := getNodeIPForFamily(pod, pt.IsIPv6)
+	if podIP == "" || nodeIP == "" {
+		klog.V(2).InfoS("Pod or Node has no IP address for the IP family, skipping NodePortLocal rules", "pod", klog.KObj(pod), "isIPv6", pt.IsIPv6)
+		return pt.DeleteRulesForPod(key)
+	}
+
+	var nodePort int
+	podPorts := make(map[string]struct{})
 	// first, check which rules are needed based on the target ports of the Services selecting the Pod
 	// (ignoring NPL annotations) and make sure they are present. As we do so, we build the expected list of
 	// NPL annotations for the Pod.
@@ -466,12 +621,12 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 			return fmt.Errorf("failed to parse port number and protocol from %s for Pod %s: %v", targetPortProto, key, err)
 		}
 		podPorts[targetPortProto] = struct{}{}
-		portData := c.portTable.GetEntry(key, port, protocol)
+		portData := pt.GetEntry(key, port, protocol)
 		// Special handling for a rule that was previously marked for deletion but could not
 		// be deleted properly: we have to retry now.
 		if portData != nil && portData.Defunct() {
 			klog.InfoS("Deleting defunct rule for Pod to prevent re-use", "pod", klog.KObj(pod), "podIP", podIP, "port", port, "protocol", protocol)
-			if err := c.portTable.DeleteRule(key, port, protocol); err != nil {
+			if err := pt.DeleteRule(key, port, protocol); err != nil {
 				return fmt.Errorf("failed to delete defunct rule for Pod %s, Pod Port %d, Protocol %s: %w", key, port, protocol, err)
 			}
 			portData = nil
@@ -480,7 +635,7 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 			if hport, ok := hostPorts[targetPortProto]; ok {
 				nodePort = hport
 			} else {
-				nodePort, err = c.portTable.AddRule(key, port, protocol, podIP)
+				nodePort, err = pt.AddRule(key, port, protocol, podIP)
 				if err != nil {
 					return fmt.Errorf("failed to add rule for Pod %s: %v", key, err)
 				}
@@ -488,37 +643,28 @@ func (c *NPLController) handleAddUpdatePod(key string, obj interface{}) error {
 		} else {
 			nodePort = portData.NodePort
 		}
-		if _, ok := nplAnnotationsRequiredMap[portcache.NodePortProtoFormat(nodePort, protocol)]; !ok {
-			nplAnnotationsRequiredMap[portcache.NodePortProtoFormat(nodePort, protocol)] = types.NPLAnnotation{
+		annotationKey := nodeIP + "/" + portcache.NodePortProtoFormat(nodePort, protocol)
+		if _, ok := nplAnnotationsRequiredMap[annotationKey]; !ok {
+			nplAnnotationsRequiredMap[annotationKey] = types.NPLAnnotation{
 				PodPort:  port,
-				NodeIP:   pod.Status.HostIP,
+				NodeIP:   nodeIP,
 				NodePort: nodePort,
 				Protocol: protocol,
 			}
 		}
 	}
-	for _, annotation := range nplAnnotationsRequiredMap {
-		nplAnnotationsRequired = append(nplAnnotationsRequired, annotation)
-	}
 
 	// second, delete any existing rule that is not needed based on the current Pod
 	// specification.
-	entries := c.portTable.GetDataForPod(key)
+	entries := pt.GetDataForPod(key)
 	for _, data := range entries {
 		proto := data.Protocol
 		if _, exists := podPorts[util.BuildPortProto(fmt.Sprint(data.PodPort), proto.Protocol)]; !exists {
-			if err := c.portTable.DeleteRule(key, data.PodPort, proto.Protocol); err != nil {
+			if err := pt.DeleteRule(key, data.PodPort, proto.Protocol); err != nil {
 				return fmt.Errorf("failed to delete rule for Pod %s, Pod Port %d, Protocol %s: %w", key, data.PodPort, proto.Protocol, err)
 			}
 		}
 	}
-
-	// finally, we can check if the current annotation matches the expected one (which we built
-	// in the first step). If not, the Pod needed to be patched.
-	updatePodAnnotation := !compareNPLAnnotationLists(nplAnnotations, nplAnnotationsRequired)
-	if updatePodAnnotation {
-		return c.updatePodNPLAnnotation(pod, nplAnnotationsRequired)
-	}
 	return nil
 }
 
@@ -543,7 +689,10 @@ func (c *NPLController) waitForRulesInitialization() {
 
 	// in case of an error when listing Pods above, allNPLPorts will be
 	// empty and all NPL iptables rules will be deleted.
-	allNPLPorts := []rules.PodNodePort{}
+	allNPLPorts := make(map[*portcache.PortTable][]rules.PodNodePort, len(c.portTables))
+	for _, pt := range c.portTables {
+		allNPLPorts[pt] = []rules.PodNodePort{}
+	}
 	for i := range podList {
 		// For each Pod:
 		// check if a valid NodePortLocal annotation exists for this Pod:
@@ -567,35 +716,58 @@ func (c *NPLController) waitForRulesInitialization() {
 		}
 
 		for _, npl := range nplData {
-			if npl.NodePort > c.portTable.EndPort || npl.NodePort < c.portTable.StartPort {
+			// Annotations created before dual-stack support may not include the Node IP, in
+			// which case they belong to the IP family of the primary Pod IP.
+			isIPv6 := utilnet.IsIPv6String(pod.Status.PodIP)
+			if npl.NodeIP != "" {
+				isIPv6 = utilnet.IsIPv6String(npl.NodeIP)
+			}
+			pt := c.getPortTable(isIPv6)
+			if pt == nil {
+				// ignoring annotation for now, it will be removed by the first call
+				// to handleAddUpdatePod
+				klog.V(2).InfoS("Found NodePortLocal annotation for an IP family which is not enabled", "pod", klog.KObj(pod), "nodeIP", npl.NodeIP)
+				continue
+			}
+			if npl.NodePort > pt.EndPort || npl.NodePort < pt.StartPort {
 				// ignoring annotation for now, it will be removed by the first call
 				// to handleAddUpdatePod
 				klog.V(2).InfoS("Found NodePortLocal annotation for which the allocated port doesn't fall into the configured range", "pod", klog.KObj(pod))
 				continue
 			}
-			allNPLPorts = append(allNPLPorts, rules.PodNodePort{
+			podIP := getPodIPForFamily(pod, isIPv6)
+			if podIP == "" {
+				continue
+			}
+			allNPLPorts[pt] = append(allNPLPorts[pt], rules.PodNodePort{
 				PodKey:   podKey,
 				NodePort: npl.NodePort,
 				PodPort:  npl.PodPort,
-				PodIP:    pod.Status.PodIP,
+				PodIP:    podIP,
 				Protocol: npl.Protocol,
 			})
 		}
 	}
 
-	rulesInitialized := make(chan struct{})
-	if err := c.addRulesForNPLPorts(allNPLPorts, rulesInitialized); err != nil {
-		klog.ErrorS(err, "Cannot install NodePortLocal rules")
-		return
+	var rulesInitialized []chan struct{}
+	for _, pt := range c.portTables {
+		synced := make(chan struct{})
+		if err := c.addRulesForNPLPorts(pt, allNPLPorts[pt], synced); err != nil {
+			klog.ErrorS(err, "Cannot install NodePortLocal rules", "isIPv6", pt.IsIPv6)
+			return
+		}
+		rulesInitialized = append(rulesInitialized, synced)
 	}
 
 	klog.InfoS("Waiting for initialization of NodePortLocal rules to complete")
-	<-rulesInitialized
+	for _, synced := range rulesInitialized {
+		<-synced
+	}
 	klog.InfoS("Initialization of NodePortLocal rules successful")
 }
 
-func (c *NPLController) addRulesForNPLPorts(allNPLPorts []rules.PodNodePort, synced chan<- struct{}) error {
-	return c.portTable.RestoreRules(allNPLPorts, synced)
+func (c *NPLController) addRulesForNPLPorts(pt *portcache.PortTable, allNPLPorts []rules.PodNodePort, synced chan<- struct{}) error {
+	return pt.RestoreRules(allNPLPorts, synced)
 }
 
 // cleanupNPLAnnotationForPod removes the NodePortLocal annotation from the Pod's annotations map entirely.
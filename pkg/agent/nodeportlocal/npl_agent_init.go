@@ -23,11 +23,13 @@ import (
 
 	nplk8s "antrea.io/antrea/pkg/agent/nodeportlocal/k8s"
 	"antrea.io/antrea/pkg/agent/nodeportlocal/portcache"
+	"antrea.io/antrea/pkg/util/runtime"
 )
 
 // InitializeNPLAgent initializes the NodePortLocal agent.
 // It sets up event handlers to handle Pod add, update and delete events.
 // When a Pod gets created, a free Node port is obtained from the port table cache and a DNAT rule is added to NAT traffic to the Pod's ip:port.
+// A separate port table is used for each enabled IP family. IPv6 is not supported on Windows.
 func InitializeNPLAgent(
 	kubeClient clientset.Interface,
 	serviceInformer coreinformers.ServiceInformer,
@@ -35,11 +37,23 @@ func InitializeNPLAgent(
 	startPort int,
 	endPort int,
 	nodeName string,
+	ipv4Enabled bool,
+	ipv6Enabled bool,
 ) (*nplk8s.NPLController, error) {
-	portTable, err := portcache.NewPortTable(startPort, endPort)
-	if err != nil {
-		return nil, fmt.Errorf("error when initializing NodePortLocal port table: %v", err)
+	var portTable, portTableIPv6 *portcache.PortTable
+	var err error
+	if ipv4Enabled {
+		portTable, err = portcache.NewPortTable(startPort, endPort, false)
+		if err != nil {
+			return nil, fmt.Errorf("error when initializing NodePortLocal port table: %v", err)
+		}
+	}
+	if ipv6Enabled && !runtime.IsWindowsPlatform() {
+		portTableIPv6, err = portcache.NewPortTable(startPort, endPort, true)
+		if err != nil {
+			return nil, fmt.Errorf("error when initializing NodePortLocal IPv6 port table: %v", err)
+		}
 	}
 
-	return nplk8s.NewNPLController(kubeClient, podInformer, serviceInformer.Informer(), portTable, nodeName), nil
+	return nplk8s.NewNPLController(kubeClient, podInformer, serviceInformer.Informer(), portTable, portTableIPv6, nodeName), nil
 }
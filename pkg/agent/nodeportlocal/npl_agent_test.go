@@ -56,6 +56,8 @@ const (
 	defaultNodeName       = "test-node"
 	defaultHostIP         = "10.10.10.10"
 	defaultPodIP          = "192.168.32.10"
+	defaultHostIPv6       = "fd00:10:10::10"
+	defaultPodIPv6        = "fd00:192:168:32::10"
 	defaultPort           = 80
 	defaultAppSelectorKey = "foo"
 	defaultAppSelectorVal = "test-pod"
@@ -176,12 +178,14 @@ func getTestSvcWithPortName(portName string) *corev1.Service {
 
 type testData struct {
 	*testing.T
-	stopCh      chan struct{}
-	ctrl        *gomock.Controller
-	k8sClient   *k8sfake.Clientset
-	portTable   *portcache.PortTable
-	svcInformer cache.SharedIndexInformer
-	wg          sync.WaitGroup
+	stopCh        chan struct{}
+	ctrl          *gomock.Controller
+	k8sClient     *k8sfake.Clientset
+	portTable     *portcache.PortTable
+	portTableIPv6 *portcache.PortTable
+	svcInformer   cache.SharedIndexInformer
+	controller    *k8s.NPLController
+	wg            sync.WaitGroup
 }
 
 func (t *testData) runWrapper(c *k8s.NPLController) {
@@ -198,6 +202,7 @@ type customizePodPortRulesExpectations func(*rulestesting.MockPodPortRules)
 type testConfig struct {
 	customPortOpenerExpectations   customizePortOpenerExpectations
 	customPodPortRulesExpectations customizePodPortRulesExpectations
+	ipv6PortTable                  bool
 }
 
 func newTestConfig() *testConfig {
@@ -214,6 +219,11 @@ func (tc *testConfig) withCustomPodPortRulesExpectations(fn customizePodPortRule
 	return tc
 }
 
+func (tc *testConfig) withIPv6PortTable() *testConfig {
+	tc.ipv6PortTable = true
+	return tc
+}
+
 func setUp(t *testing.T, tc *testConfig, objects ...runtime.Object) *testData {
 	t.Setenv("NODE_NAME", defaultNodeName)
 
@@ -238,6 +248,15 @@ func setUp(t *testing.T, tc *testConfig, objects ...runtime.Object) *testData {
 	k8sClient := k8sfake.NewSimpleClientset(objects...)
 
 	portTable := newPortTable(mockIPTables, mockPortOpener)
+	var portTableIPv6 *portcache.PortTable
+	if tc.ipv6PortTable {
+		mockIP6Tables := rulestesting.NewMockPodPortRules(mockCtrl)
+		mockIP6Tables.EXPECT().AddRule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockIP6Tables.EXPECT().DeleteRule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockIP6Tables.EXPECT().AddAllRules(gomock.Any()).AnyTimes()
+		portTableIPv6 = newPortTable(mockIP6Tables, mockPortOpener)
+		portTableIPv6.IsIPv6 = true
+	}
 
 	resyncPeriod := 0 * time.Minute
 	// informerFactory is initialized and started from cmd/antrea-agent/agent.go
@@ -254,15 +273,17 @@ func setUp(t *testing.T, tc *testConfig, objects ...runtime.Object) *testData {
 	)
 	svcInformer := informerFactory.Core().V1().Services().Informer()
 
-	c := k8s.NewNPLController(k8sClient, localPodInformer, svcInformer, portTable, defaultNodeName)
+	c := k8s.NewNPLController(k8sClient, localPodInformer, svcInformer, portTable, portTableIPv6, defaultNodeName)
 
 	data := &testData{
-		T:           t,
-		stopCh:      make(chan struct{}),
-		ctrl:        mockCtrl,
-		k8sClient:   k8sClient,
-		portTable:   portTable,
-		svcInformer: svcInformer,
+		T:             t,
+		stopCh:        make(chan struct{}),
+		ctrl:          mockCtrl,
+		k8sClient:     k8sClient,
+		portTable:     portTable,
+		portTableIPv6: portTableIPv6,
+		svcInformer:   svcInformer,
+		controller:    c,
 	}
 
 	data.runWrapper(c)
@@ -510,6 +531,76 @@ func TestPodDelete(t *testing.T) {
 	assert.NoError(t, err, "Error when polling for port table update")
 }
 
+// TestCleanup verifies that when NodePortLocal is disabled at runtime, all NPL rules are deleted and the
+// Pod's NPL annotation is removed.
+func TestCleanup(t *testing.T) {
+	tc := newTestConfig().withCustomPodPortRulesExpectations(func(mockIPTables *rulestesting.MockPodPortRules) {
+		mockIPTables.EXPECT().AddRule(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		mockIPTables.EXPECT().AddAllRules(gomock.Any()).AnyTimes()
+		mockIPTables.EXPECT().DeleteRule(defaultStartPort, defaultPodIP, defaultPort, protocolTCP)
+		mockIPTables.EXPECT().DeleteAllRules()
+	})
+	testData, _, testPod := setUpWithTestServiceAndPod(t, tc, nil)
+	testData.tearDown()
+
+	require.NoError(t, testData.controller.Cleanup())
+	_, err := testData.pollForPodAnnotation(testPod.Name, false)
+	require.NoError(t, err, "Poll for annotation check failed")
+	assert.False(t, testData.portTable.RuleExists(defaultPodKey, defaultPort, protocolTCP))
+}
+
+// TestDualStackPod verifies that for a dual-stack Pod, one NPL rule is added to the port table of
+// each IP family and that the Pod is annotated with one entry per Node IP. It then deletes the
+// Service and verifies that both the rules and the annotation are removed.
+func TestDualStackPod(t *testing.T) {
+	testSvc := getTestSvc()
+	testPod := getTestPod()
+	testPod.Status.PodIPs = []corev1.PodIP{{IP: defaultPodIP}, {IP: defaultPodIPv6}}
+	testPod.Status.HostIPs = []corev1.HostIP{{IP: defaultHostIP}, {IP: defaultHostIPv6}}
+	testData := setUp(t, newTestConfig().withIPv6PortTable(), testSvc, testPod)
+	defer testData.tearDown()
+
+	value, err := testData.pollForPodAnnotationWithCondition(testPod.Name, func(value []types.NPLAnnotation) bool { return len(value) == 2 })
+	require.NoError(t, err, "Poll for annotation check failed")
+	nodePort := defaultStartPort
+	expectedAnnotations := newExpectedNPLAnnotations().
+		Add(&nodePort, defaultPort, protocolTCP).
+		AddWithNodeIP(defaultHostIPv6, &nodePort, defaultPort, protocolTCP)
+	expectedAnnotations.Check(t, value)
+	assert.True(t, testData.portTable.RuleExists(defaultPodKey, defaultPort, protocolTCP))
+	assert.True(t, testData.portTableIPv6.RuleExists(defaultPodKey, defaultPort, protocolTCP))
+	podIPv6Entry := testData.portTableIPv6.GetEntry(defaultPodKey, defaultPort, protocolTCP)
+	require.NotNil(t, podIPv6Entry)
+	assert.Equal(t, defaultPodIPv6, podIPv6Entry.PodIP)
+
+	err = testData.k8sClient.CoreV1().Services(defaultNS).Delete(context.TODO(), testSvc.Name, metav1.DeleteOptions{})
+	require.NoError(t, err, "Service deletion failed")
+
+	_, err = testData.pollForPodAnnotation(testPod.Name, false)
+	require.NoError(t, err, "Poll for annotation check failed")
+	assert.False(t, testData.portTable.RuleExists(defaultPodKey, defaultPort, protocolTCP))
+	assert.False(t, testData.portTableIPv6.RuleExists(defaultPodKey, defaultPort, protocolTCP))
+}
+
+// TestIPv6OnlyPod verifies that for an IPv6-only Pod, the NPL rule is only added to the IPv6 port
+// table and that the annotation uses the IPv6 Node IP.
+func TestIPv6OnlyPod(t *testing.T) {
+	testSvc := getTestSvc()
+	testPod := getTestPod()
+	testPod.Status.PodIP = defaultPodIPv6
+	testPod.Status.HostIP = defaultHostIPv6
+	testData := setUp(t, newTestConfig().withIPv6PortTable(), testSvc, testPod)
+	defer testData.tearDown()
+
+	value, err := testData.pollForPodAnnotation(testPod.Name, true)
+	require.NoError(t, err, "Poll for annotation check failed")
+	expectedAnnotations := npltesting.NewExpectedNPLAnnotations(nil, defaultStartPort, defaultEndPort).
+		AddWithNodeIP(defaultHostIPv6, nil, defaultPort, protocolTCP)
+	expectedAnnotations.Check(t, value)
+	assert.False(t, testData.portTable.RuleExists(defaultPodKey, defaultPort, protocolTCP))
+	assert.True(t, testData.portTableIPv6.RuleExists(defaultPodKey, defaultPort, protocolTCP))
+}
+
 // TestPodAddMultiPort creates a Pod and a Service with two target ports.
 // It verifies that the Pod's NPL annotation and the local port table are updated with both ports.
 // It then updates the Service to remove one of the target ports.
@@ -57,7 +57,9 @@ type LocalPortOpener interface {
 	OpenLocalPort(port int, protocol string) (io.Closer, error)
 }
 
-type localPortOpener struct{}
+type localPortOpener struct {
+	isIPv6 bool
+}
 
 type PortTable struct {
 	PortTableCache  cache.Indexer
@@ -66,7 +68,9 @@ type PortTable struct {
 	PortSearchStart int
 	PodPortRules    rules.PodPortRules
 	LocalPortOpener LocalPortOpener
-	tableLock       sync.RWMutex
+	// IsIPv6 indicates whether the PortTable manages NodePortLocal mappings for IPv6 Pod addresses.
+	IsIPv6    bool
+	tableLock sync.RWMutex
 }
 
 func GetPortTableKey(obj interface{}) (string, error) {
@@ -144,7 +148,7 @@ func PodKeyIndexFunc(obj interface{}) ([]string, error) {
 	return []string{npData.PodKey}, nil
 }
 
-func NewPortTable(start, end int) (*PortTable, error) {
+func NewPortTable(start, end int, isIPv6 bool) (*PortTable, error) {
 	ptable := PortTable{
 		PortTableCache: cache.NewIndexer(GetPortTableKey, cache.Indexers{
 			NodePortIndex:    NodePortIndexFunc,
@@ -154,8 +158,9 @@ func NewPortTable(start, end int) (*PortTable, error) {
 		StartPort:       start,
 		EndPort:         end,
 		PortSearchStart: start,
-		PodPortRules:    rules.InitRules(),
-		LocalPortOpener: &localPortOpener{},
+		PodPortRules:    rules.InitRules(isIPv6),
+		LocalPortOpener: &localPortOpener{isIPv6: isIPv6},
+		IsIPv6:          isIPv6,
 	}
 	if err := ptable.PodPortRules.Init(); err != nil {
 		return nil, err
@@ -223,12 +228,15 @@ func NodePortProtoFormat(nodeport int, protocol string) string {
 // This is inspired by the openLocalPort function in kube-proxy:
 // https://github.com/kubernetes/kubernetes/blob/86f8c3ee91b6faec437f97e3991107747d7fc5e8/pkg/proxy/iptables/proxier.go#L1664
 func (lpo *localPortOpener) OpenLocalPort(port int, protocol string) (io.Closer, error) {
-	// For now, NodePortLocal only supports IPv4 and TCP/UDP.
+	// For now, NodePortLocal only supports TCP/UDP.
 	var network string
 	var socket io.Closer
 	switch protocol {
 	case "tcp":
 		network = "tcp4"
+		if lpo.isIPv6 {
+			network = "tcp6"
+		}
 		listener, err := net.Listen(network, fmt.Sprintf(":%d", port))
 		if err != nil {
 			return nil, err
@@ -236,6 +244,9 @@ func (lpo *localPortOpener) OpenLocalPort(port int, protocol string) (io.Closer,
 		socket = listener
 	case "udp":
 		network = "udp4"
+		if lpo.isIPv6 {
+			network = "udp6"
+		}
 		addr, err := net.ResolveUDPAddr(network, fmt.Sprintf(":%d", port))
 		if err != nil {
 			return nil, err
@@ -246,6 +257,6 @@ func (lpo *localPortOpener) OpenLocalPort(port int, protocol string) (io.Closer,
 		}
 		socket = conn
 	}
-	klog.V(2).InfoS("Opened local port", "port", port)
+	klog.V(2).InfoS("Opened local port", "port", port, "network", network)
 	return socket, nil
 }
@@ -20,6 +20,7 @@ package rules
 import (
 	"bytes"
 	"fmt"
+	"net"
 
 	"k8s.io/klog/v2"
 
@@ -27,9 +28,9 @@ import (
 )
 
 // InitRules initializes rules based on the underlying implementation
-func InitRules() PodPortRules {
+func InitRules(isIPv6 bool) PodPortRules {
 	// This can be extended based on the system capability.
-	return NewIPTableRules()
+	return NewIPTableRules(isIPv6)
 }
 
 // NodePortLocalChain is the name of the chain in IPTABLES for Node Port Local
@@ -39,18 +40,28 @@ const NodePortLocalChain = "ANTREA-NODE-PORT-LOCAL"
 type iptablesRules struct {
 	name  string
 	table iptables.Interface
+	// isIPv6 indicates whether the rules are programmed with ip6tables instead of iptables.
+	isIPv6 bool
 }
 
 // NewIPTableRules retruns a new instance of IPTableRules
-func NewIPTableRules() *iptablesRules {
-	iptInstance, _ := iptables.New(true, false)
+func NewIPTableRules(isIPv6 bool) *iptablesRules {
+	iptInstance, _ := iptables.New(!isIPv6, isIPv6)
 	iptRule := iptablesRules{
-		name:  "NPL",
-		table: iptInstance,
+		name:   "NPL",
+		table:  iptInstance,
+		isIPv6: isIPv6,
 	}
 	return &iptRule
 }
 
+func (ipt *iptablesRules) ipProtocol() iptables.Protocol {
+	if ipt.isIPv6 {
+		return iptables.ProtocolIPv6
+	}
+	return iptables.ProtocolIPv4
+}
+
 // Init initializes IPTABLES rules for NPL. Currently it deletes existing rules to ensure that no stale entries are present.
 func (ipt *iptablesRules) Init() error {
 	if err := ipt.initRules(); err != nil {
@@ -63,16 +74,16 @@ func (ipt *iptablesRules) Init() error {
 // traffic) and OUTPUT chain (for locally-generated traffic). All NPL DNAT rules
 // will be added to this chain.
 func (ipt *iptablesRules) initRules() error {
-	if err := ipt.table.EnsureChain(iptables.ProtocolIPv4, iptables.NATTable, NodePortLocalChain); err != nil {
+	if err := ipt.table.EnsureChain(ipt.ipProtocol(), iptables.NATTable, NodePortLocalChain); err != nil {
 		return err
 	}
 	ruleSpec := []string{
 		"-p", "all", "-m", "addrtype", "--dst-type", "LOCAL", "-j", NodePortLocalChain,
 	}
-	if err := ipt.table.AppendRule(iptables.ProtocolIPv4, iptables.NATTable, iptables.PreRoutingChain, ruleSpec); err != nil {
+	if err := ipt.table.AppendRule(ipt.ipProtocol(), iptables.NATTable, iptables.PreRoutingChain, ruleSpec); err != nil {
 		return err
 	}
-	if err := ipt.table.AppendRule(iptables.ProtocolIPv4, iptables.NATTable, iptables.OutputChain, ruleSpec); err != nil {
+	if err := ipt.table.AppendRule(ipt.ipProtocol(), iptables.NATTable, iptables.OutputChain, ruleSpec); err != nil {
 		return err
 	}
 	return nil
@@ -87,9 +98,9 @@ func buildRuleForPod(port int, podIP, protocol string) []string {
 
 // AddRule appends a DNAT rule in NodePortLocalChain chain of NAT table.
 func (ipt *iptablesRules) AddRule(nodePort int, podIP string, podPort int, protocol string) error {
-	podAddr := fmt.Sprintf("%s:%d", podIP, podPort)
+	podAddr := net.JoinHostPort(podIP, fmt.Sprint(podPort))
 	rule := buildRuleForPod(nodePort, podAddr, protocol)
-	if err := ipt.table.AppendRule(iptables.ProtocolIPv4, iptables.NATTable, NodePortLocalChain, rule); err != nil {
+	if err := ipt.table.AppendRule(ipt.ipProtocol(), iptables.NATTable, NodePortLocalChain, rule); err != nil {
 		return err
 	}
 	klog.InfoS("Successfully added DNAT rule", "podAddr", podAddr, "nodePort", nodePort, "protocol", protocol)
@@ -103,12 +114,12 @@ func (ipt *iptablesRules) AddAllRules(nplList []PodNodePort) error {
 	writeLine(iptablesData, "*nat")
 	writeLine(iptablesData, iptables.MakeChainLine(NodePortLocalChain))
 	for _, nplData := range nplList {
-		destination := nplData.PodIP + ":" + fmt.Sprint(nplData.PodPort)
+		destination := net.JoinHostPort(nplData.PodIP, fmt.Sprint(nplData.PodPort))
 		rule := buildRuleForPod(nplData.NodePort, destination, nplData.Protocol)
 		writeLine(iptablesData, append([]string{"-A", NodePortLocalChain}, rule...)...)
 	}
 	writeLine(iptablesData, "COMMIT")
-	if err := ipt.table.Restore(iptablesData.String(), false, false); err != nil {
+	if err := ipt.table.Restore(iptablesData.String(), false, ipt.isIPv6); err != nil {
 		return err
 	}
 	return nil
@@ -116,9 +127,9 @@ func (ipt *iptablesRules) AddAllRules(nplList []PodNodePort) error {
 
 // DeleteRule deletes a specific NPL rule from NodePortLocalChain chain
 func (ipt *iptablesRules) DeleteRule(nodePort int, podIP string, podPort int, protocol string) error {
-	podAddr := fmt.Sprintf("%s:%d", podIP, podPort)
+	podAddr := net.JoinHostPort(podIP, fmt.Sprint(podPort))
 	rule := buildRuleForPod(nodePort, podAddr, protocol)
-	if err := ipt.table.DeleteRule(iptables.ProtocolIPv4, iptables.NATTable, NodePortLocalChain, rule); err != nil {
+	if err := ipt.table.DeleteRule(ipt.ipProtocol(), iptables.NATTable, NodePortLocalChain, rule); err != nil {
 		return err
 	}
 	klog.InfoS("Successfully deleted DNAT rule", "podAddr", podAddr, "nodePort", nodePort, "protocol", protocol)
@@ -127,7 +138,7 @@ func (ipt *iptablesRules) DeleteRule(nodePort int, podIP string, podPort int, pr
 
 // DeleteAllRules deletes all NPL rules programmed in the node
 func (ipt *iptablesRules) DeleteAllRules() error {
-	exists, err := ipt.table.ChainExists(iptables.ProtocolIPv4, iptables.NATTable, NodePortLocalChain)
+	exists, err := ipt.table.ChainExists(ipt.ipProtocol(), iptables.NATTable, NodePortLocalChain)
 	if err != nil {
 		return fmt.Errorf("failed to check if NodePortLocal chain exists in NAT table: %v", err)
 	}
@@ -137,13 +148,13 @@ func (ipt *iptablesRules) DeleteAllRules() error {
 	ruleSpec := []string{
 		"-p", "all", "-m", "addrtype", "--dst-type", "LOCAL", "-j", NodePortLocalChain,
 	}
-	if err := ipt.table.DeleteRule(iptables.ProtocolIPv4, iptables.NATTable, iptables.PreRoutingChain, ruleSpec); err != nil {
+	if err := ipt.table.DeleteRule(ipt.ipProtocol(), iptables.NATTable, iptables.PreRoutingChain, ruleSpec); err != nil {
 		return err
 	}
-	if err := ipt.table.DeleteRule(iptables.ProtocolIPv4, iptables.NATTable, iptables.OutputChain, ruleSpec); err != nil {
+	if err := ipt.table.DeleteRule(ipt.ipProtocol(), iptables.NATTable, iptables.OutputChain, ruleSpec); err != nil {
 		return err
 	}
-	if err := ipt.table.DeleteChain(iptables.ProtocolIPv4, iptables.NATTable, NodePortLocalChain); err != nil {
+	if err := ipt.table.DeleteChain(ipt.ipProtocol(), iptables.NATTable, NodePortLocalChain); err != nil {
 		return err
 	}
 	return nil
@@ -86,6 +86,32 @@ func TestAddAndDeleteRule(t *testing.T) {
 	}
 }
 
+func TestAddAndDeleteRuleIPv6(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockIPTables := iptablestest.NewMockInterface(ctrl)
+	rules := iptablesRules{
+		name:   "test-rules",
+		table:  mockIPTables,
+		isIPv6: true,
+	}
+
+	mockIPTables.EXPECT().EnsureChain(iptables.ProtocolIPv6, iptables.NATTable, NodePortLocalChain)
+	mockIPTables.EXPECT().AppendRule(iptables.ProtocolIPv6, iptables.NATTable, iptables.PreRoutingChain, []string{"-p", "all", "-m", "addrtype", "--dst-type", "LOCAL", "-j", NodePortLocalChain})
+	mockIPTables.EXPECT().AppendRule(iptables.ProtocolIPv6, iptables.NATTable, iptables.OutputChain, []string{"-p", "all", "-m", "addrtype", "--dst-type", "LOCAL", "-j", NodePortLocalChain})
+	mockIPTables.EXPECT().AppendRule(iptables.ProtocolIPv6, iptables.NATTable, NodePortLocalChain, []string{"-p", "tcp", "-m", "tcp", "--dport", "7", "-j", "DNAT", "--to-destination", "[fd00:10::2]:17"})
+	mockIPTables.EXPECT().Restore(`*nat
+:ANTREA-NODE-PORT-LOCAL - [0:0]
+-A ANTREA-NODE-PORT-LOCAL -p udp -m udp --dport 27 -j DNAT --to-destination [fd00:10::3]:37
+COMMIT
+`, false, true)
+	mockIPTables.EXPECT().DeleteRule(iptables.ProtocolIPv6, iptables.NATTable, NodePortLocalChain, []string{"-p", "tcp", "-m", "tcp", "--dport", "7", "-j", "DNAT", "--to-destination", "[fd00:10::2]:17"})
+
+	require.NoError(t, rules.Init())
+	require.NoError(t, rules.AddRule(7, "fd00:10::2", 17, "tcp"))
+	require.NoError(t, rules.AddAllRules([]PodNodePort{{NodePort: 27, PodPort: 37, PodIP: "fd00:10::3", Protocol: "udp"}}))
+	require.NoError(t, rules.DeleteRule(7, "fd00:10::2", 17, "tcp"))
+}
+
 func TestAddAndDeleteAllRules(t *testing.T) {
 	tests := []struct {
 		name          string
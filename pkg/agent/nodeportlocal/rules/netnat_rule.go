@@ -34,8 +34,9 @@ var (
 	antreaNatNPL = util.AntreaNatName
 )
 
-// InitRules initializes rules based on the netnatstaticmapping implementation on windows
-func InitRules() PodPortRules {
+// InitRules initializes rules based on the netnatstaticmapping implementation on windows.
+// NodePortLocal only supports IPv4 on Windows, so isIPv6 is ignored.
+func InitRules(isIPv6 bool) PodPortRules {
 	return NewNetNatRules()
 }
 
@@ -37,10 +37,10 @@ func NewExpectedNPLAnnotations(nodeIP *string, nplStartPort, nplEndPort int) *Ex
 	}
 }
 
-func (a *ExpectedNPLAnnotations) find(podPort int, protocol string) *types.NPLAnnotation {
+func (a *ExpectedNPLAnnotations) find(podPort int, protocol string, nodeIP string) *types.NPLAnnotation {
 	for idx := range a.annotations {
 		annotation := &a.annotations[idx]
-		if annotation.PodPort == podPort && annotation.Protocol == protocol {
+		if annotation.PodPort == podPort && annotation.Protocol == protocol && (annotation.NodeIP == "" || annotation.NodeIP == nodeIP) {
 			return annotation
 		}
 	}
@@ -48,13 +48,20 @@ func (a *ExpectedNPLAnnotations) find(podPort int, protocol string) *types.NPLAn
 }
 
 func (a *ExpectedNPLAnnotations) Add(nodePort *int, podPort int, protocol string) *ExpectedNPLAnnotations {
-	annotation := types.NPLAnnotation{PodPort: podPort, Protocol: protocol}
+	var nodeIP string
+	if a.nodeIP != nil {
+		nodeIP = *a.nodeIP
+	}
+	return a.AddWithNodeIP(nodeIP, nodePort, podPort, protocol)
+}
+
+// AddWithNodeIP adds an expected annotation for a specific Node IP, which is required to check the
+// annotations of dual-stack Pods (one annotation is expected for each IP family).
+func (a *ExpectedNPLAnnotations) AddWithNodeIP(nodeIP string, nodePort *int, podPort int, protocol string) *ExpectedNPLAnnotations {
+	annotation := types.NPLAnnotation{PodPort: podPort, Protocol: protocol, NodeIP: nodeIP}
 	if nodePort != nil {
 		annotation.NodePort = *nodePort
 	}
-	if a.nodeIP != nil {
-		annotation.NodeIP = *a.nodeIP
-	}
 	a.annotations = append(a.annotations, annotation)
 	return a
 }
@@ -62,7 +69,7 @@ func (a *ExpectedNPLAnnotations) Add(nodePort *int, podPort int, protocol string
 func (a *ExpectedNPLAnnotations) Check(t *testing.T, nplValue []types.NPLAnnotation) {
 	assert.Equal(t, len(a.annotations), len(nplValue), "Invalid number of NPL annotations")
 	for _, nplAnnotation := range nplValue {
-		expectedAnnotation := a.find(nplAnnotation.PodPort, nplAnnotation.Protocol)
+		expectedAnnotation := a.find(nplAnnotation.PodPort, nplAnnotation.Protocol, nplAnnotation.NodeIP)
 		if !assert.NotNilf(t, expectedAnnotation, "Unexpected annotation with PodPort %d", nplAnnotation.PodPort) {
 			continue
 		}
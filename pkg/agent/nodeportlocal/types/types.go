@@ -14,11 +14,43 @@
 
 package types
 
+import "fmt"
+
 const (
 	NPLAnnotationKey        = "nodeportlocal.antrea.io"
 	NPLEnabledAnnotationKey = "nodeportlocal.antrea.io/enabled"
+	// NPLEgressModeAnnotationKey is the annotation key on Pods and Namespaces which determines how Egress applies to
+	// the Pods exposed via NodePortLocal. The annotation on a Pod takes precedence over the one on its Namespace.
+	NPLEgressModeAnnotationKey = "nodeportlocal.antrea.io/egress-mode"
 )
 
+// EgressMode determines how Egress applies to a Pod exposed via NodePortLocal.
+type EgressMode string
+
+const (
+	// EgressModeCoexist means the Pod's traffic is still subject to Egress while the Pod is exposed via NodePortLocal.
+	// The reply traffic of the connections received via NodePortLocal ports always returns through the Node which
+	// received them, but the connections initiated by the Pod are SNAT'd with the Egress IP, even when their peer is
+	// a NodePortLocal client.
+	EgressModeCoexist EgressMode = "Coexist"
+	// EgressModePreferNodePortLocal means the Pod is excluded from Egress while it is exposed via NodePortLocal, so
+	// that all its traffic to the external network leaves with the IP of its Node, which is also the IP used by the
+	// clients to reach its NodePortLocal ports.
+	EgressModePreferNodePortLocal EgressMode = "PreferNodePortLocal"
+)
+
+// ParseEgressMode returns the EgressMode of the provided annotation value. An empty value means the default
+// EgressModeCoexist.
+func ParseEgressMode(value string) (EgressMode, error) {
+	switch EgressMode(value) {
+	case "", EgressModeCoexist:
+		return EgressModeCoexist, nil
+	case EgressModePreferNodePortLocal:
+		return EgressModePreferNodePortLocal, nil
+	}
+	return "", fmt.Errorf("invalid value %q for annotation %s, supported values are %s and %s", value, NPLEgressModeAnnotationKey, EgressModeCoexist, EgressModePreferNodePortLocal)
+}
+
 // NPLAnnotation is the structure used for setting NodePortLocal annotation on the Pods.
 type NPLAnnotation struct {
 	PodPort  int    `json:"podPort"`
@@ -18,11 +18,15 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"antrea.io/libOpenflow/openflow15"
 	"antrea.io/libOpenflow/protocol"
 	ofutil "antrea.io/libOpenflow/util"
 	"antrea.io/ofnet/ofctrl"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/config"
@@ -34,6 +38,7 @@ import (
 	crdv1alpha2 "antrea.io/antrea/pkg/apis/crd/v1alpha2"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	utilip "antrea.io/antrea/pkg/util/ip"
+	"antrea.io/antrea/pkg/util/runtime"
 	"antrea.io/antrea/third_party/proxy"
 )
 
@@ -90,8 +95,11 @@ type Client interface {
 	// semantics(call succeeds if all the flows are installed successfully, otherwise no
 	// flows will be installed). Calls to InstallPodFlows are idempotent. Concurrent calls
 	// to InstallPodFlows and / or UninstallPodFlows are supported as long as they are all
-	// for different interfaceNames.
-	InstallPodFlows(interfaceName string, podInterfaceIPs []net.IP, podInterfaceMAC net.HardwareAddr, ofPort uint32, vlanID uint16, labelID *uint32) error
+	// for different interfaceNames. If tunnelID is not nil, it is loaded into the tunnel ID of
+	// the packets sent by the Pod, which is the LabelIdentity of the Pod when Multi-cluster
+	// StretchedNetworkPolicy is enabled, or the VNI of its Namespace when Namespace tunnel VNI is
+	// enabled.
+	InstallPodFlows(interfaceName string, podInterfaceIPs []net.IP, podInterfaceMAC net.HardwareAddr, ofPort uint32, vlanID uint16, tunnelID *uint32) error
 
 	// UninstallPodFlows removes the connection to the local Pod specified with the
 	// interfaceName. UninstallPodFlows will do nothing if no connection to the Pod was established.
@@ -122,6 +130,38 @@ type Client interface {
 	// UninstallServiceFlows removes flows installed by InstallServiceFlows.
 	UninstallServiceFlows(svcIP net.IP, svcPort uint16, protocol binding.Protocol) error
 
+	// InstallServiceConnectionRateLimit installs or updates the OpenFlow meter which limits the rate of new
+	// connections to a Service. The meter is used by Service flows whose ConnectionRateMeterID is meterID.
+	InstallServiceConnectionRateLimit(meterID, rate, burst uint32) error
+	// UninstallServiceConnectionRateLimit removes the OpenFlow meter installed by InstallServiceConnectionRateLimit.
+	UninstallServiceConnectionRateLimit(meterID uint32) error
+
+	// InstallServiceRejectFlow installs the flow which rejects new connections to the Service entrypoint, like a
+	// Service without Endpoint. It is used when the Service has reached its maximum number of concurrent connections.
+	// Existing connections are not affected.
+	InstallServiceRejectFlow(config *types.ServiceConfig) error
+	// UninstallServiceRejectFlow removes the flow installed by InstallServiceRejectFlow.
+	UninstallServiceRejectFlow(svcIP net.IP, svcPort uint16, protocol binding.Protocol) error
+
+	// InstallServiceCIDRRejectFlows installs the flows which reject new TCP and UDP connections to the IPs in the
+	// provided Service CIDRs that are not allocated to any Service, like a Service without Endpoint. The connections
+	// to the excluded IPs, which are the ClusterIPs of the Services not handled by AntreaProxy, are not rejected. The
+	// flows installed for previous Service CIDRs and excluded IPs are replaced.
+	InstallServiceCIDRRejectFlows(serviceCIDRs []*net.IPNet, excludedIPs []net.IP) error
+
+	// InstallExternalTrafficSNATFlows installs the flows which SNAT the externally-originated Service connections
+	// forwarded to Endpoints on remote Nodes with the provided IP, instead of the Antrea gateway IP of the same IP
+	// family. The flows installed for the previous IP are replaced, and they are removed if the provided IP is nil.
+	InstallExternalTrafficSNATFlows(snatIP net.IP) error
+
+	// InstallPeerExternalTrafficSNATFlows installs the flow which forwards the packets destined for the external traffic
+	// SNAT IP of a remote Node to the Node through tunnel. The flow installed for the previous IP of the Node is
+	// replaced.
+	InstallPeerExternalTrafficSNATFlows(hostname string, snatIP, tunnelPeerIP net.IP) error
+
+	// UninstallPeerExternalTrafficSNATFlows removes the flow installed by InstallPeerExternalTrafficSNATFlows.
+	UninstallPeerExternalTrafficSNATFlows(hostname string) error
+
 	// GetFlowTableStatus should return an array of flow table status, all existing flow tables should be included in the list.
 	GetFlowTableStatus() []binding.TableStatus
 
@@ -191,16 +231,25 @@ type Client interface {
 	IsConnected() bool
 
 	// ReplayFlows should be called when a spurious disconnection occurs. After we reconnect to
-	// the OFSwitch, we need to replay all the flows cached by the client. ReplayFlows will try
-	// to replay as many flows as possible, and will log an error when a flow cannot be
-	// installed.
+	// the OFSwitch, we need to replay all the flows cached by the client. If the flows installed
+	// before the disconnection are still present on the OFSwitch, only the flows which differ
+	// from the cached ones are synced. Otherwise, ReplayFlows will try to replay as many flows as
+	// possible, and will log an error when a flow cannot be installed.
 	ReplayFlows()
 
+	// UpdateNodeIPs updates the flows which match or use the IP addresses of the local Node, after
+	// they have been updated in the NodeConfig.
+	UpdateNodeIPs() error
+
 	// DeleteStaleFlows deletes all flows from the previous round which are no longer needed. It
 	// should be called by the agent after all required flows have been installed / updated with
 	// the new round number.
 	DeleteStaleFlows() error
 
+	// DiffOFEntries compares the OpenFlow flows and groups expected by the agent with the ones realized on the OVS
+	// bridge, and returns the discrepancies.
+	DiffOFEntries() ([]types.DatapathEntryDiff, error)
+
 	// GetTunnelVirtualMAC() returns GlobalVirtualMAC used for tunnel traffic.
 	GetTunnelVirtualMAC() net.HardwareAddr
 
@@ -247,8 +296,17 @@ type Client interface {
 	RegisterPacketInHandler(packetHandlerReason uint8, packetInHandler PacketInHandler)
 
 	StartPacketInHandler(stopCh <-chan struct{})
+	// SetPacketInCPUAffinity pins the threads processing packet-in messages to the provided CPUs.
+	// It must be called before StartPacketInHandler.
+	SetPacketInCPUAffinity(cpus []int)
 	// Get traffic metrics of each NetworkPolicy rule.
 	NetworkPolicyMetrics() map[uint32]*types.RuleMetric
+	// Get traffic metric and last hit time of the NetworkPolicy rule with the specified ID.
+	NetworkPolicyRuleHitStats(ruleID uint32) *types.RuleHitStats
+
+	// Get traffic metrics of the DNS queries dropped for each local Pod whose DNS resolvers are restricted, keyed by
+	// the ofPort of the Pod.
+	DNSResolverDropMetrics() map[uint32]*types.RuleMetric
 
 	// Get multicast ingress metrics of each Pod in MulticastIngressPodMetricTable.
 	MulticastIngressPodMetrics() map[uint32]*types.RuleMetric
@@ -258,6 +316,8 @@ type Client interface {
 	MulticastEgressPodMetrics() map[string]*types.RuleMetric
 	// Get multicast Pod ingress statistics from MulticastEgressPodMetricTable with specified src IP.
 	MulticastEgressPodMetricsByIP(ip net.IP) *types.RuleMetric
+	// Get multicast traffic statistics of each multicast group from MulticastRoutingTable, keyed by the group IP.
+	MulticastGroupMetrics() map[string]*types.RuleHitStats
 
 	// SendTCPPacketOut sends TCP packet as a packet-out to OVS.
 	SendTCPPacketOut(
@@ -322,6 +382,13 @@ type Client interface {
 
 	// UninstallMulticastFlows removes the flow matching the given multicastIP.
 	UninstallMulticastFlows(multicastIP net.IP) error
+	// InstallMulticastSourceFlows installs the flow to forward the Multicast traffic sent from sourceIP to
+	// multicastIP with the given group, which takes precedence over the flow installed by InstallMulticastFlows.
+	// It is used to forward the traffic of a source to the receivers which have joined the multicast group with
+	// IGMPv3 source filtering.
+	InstallMulticastSourceFlows(multicastIP net.IP, sourceIP net.IP, groupID binding.GroupIDType) error
+	// UninstallMulticastSourceFlows removes the flow matching the given multicastIP and sourceIP.
+	UninstallMulticastSourceFlows(multicastIP net.IP, sourceIP net.IP) error
 	// InstallMulticastFlexibleIPAMFlows installs two flows and forwards them to the first table of Multicast Pipeline
 	// when flexibleIPAM is enabled, with one flow matching inbound multicast traffic from the uplink and the other from
 	// the host interface, making multicast packets coming from the host and other Nodes be forward to the OVS multicast pipeline.
@@ -414,6 +481,15 @@ type Client interface {
 
 	// InstallL7NetworkPolicyFlows will be called only when at least one L7 NetworkPolicy is applied locally.
 	InstallL7NetworkPolicyFlows() error
+
+	// InstallPodDNSResolverFlows installs the flows which drop the DNS queries sent by the local Pod with the
+	// provided ofPort to any resolver other than the provided ones. The flows installed for the Pod previously are
+	// replaced. It must only be called when DNS resolver restriction is enabled.
+	InstallPodDNSResolverFlows(ofPort uint32, resolvers []net.IP) error
+
+	// UninstallPodDNSResolverFlows removes the flows installed by InstallPodDNSResolverFlows for the local Pod with
+	// the provided ofPort.
+	UninstallPodDNSResolverFlows(ofPort uint32) error
 }
 
 // GetFlowTableStatus returns an array of flow table status.
@@ -619,7 +695,7 @@ func (c *client) UninstallNodeFlows(hostname string) error {
 	return c.deleteFlows(c.featurePodConnectivity.nodeCachedFlows, hostname)
 }
 
-func (c *client) InstallPodFlows(interfaceName string, podInterfaceIPs []net.IP, podInterfaceMAC net.HardwareAddr, ofPort uint32, vlanID uint16, labelID *uint32) error {
+func (c *client) InstallPodFlows(interfaceName string, podInterfaceIPs []net.IP, podInterfaceMAC net.HardwareAddr, ofPort uint32, vlanID uint16, tunnelID *uint32) error {
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
 
@@ -629,7 +705,7 @@ func (c *client) InstallPodFlows(interfaceName string, podInterfaceIPs []net.IP,
 
 	localGatewayMAC := c.nodeConfig.GatewayConfig.MAC
 	flows := []binding.Flow{
-		c.featurePodConnectivity.podClassifierFlow(ofPort, isAntreaFlexibleIPAM, labelID),
+		c.featurePodConnectivity.podClassifierFlow(ofPort, isAntreaFlexibleIPAM, tunnelID),
 		c.featurePodConnectivity.l2ForwardCalcFlow(podInterfaceMAC, ofPort),
 	}
 
@@ -816,6 +892,119 @@ func (c *client) UninstallServiceFlows(svcIP net.IP, svcPort uint16, protocol bi
 	return c.deleteFlows(c.featureService.cachedFlows, cacheKey)
 }
 
+func (c *client) InstallServiceConnectionRateLimit(meterID, rate, burst uint32) error {
+	if !c.ovsMetersAreSupported {
+		return fmt.Errorf("OpenFlow meters are not supported by the OVS datapath")
+	}
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	meter := c.genOFMeter(binding.MeterIDType(meterID), ofctrl.MeterBurst|ofctrl.MeterPktps, rate, burst)
+	_, installed := c.featureService.cachedMeter.Load(meterID)
+	if !installed {
+		if err := meter.Add(); err != nil {
+			return fmt.Errorf("error when installing Service connection rate limiting OF Meter %d: %w", meterID, err)
+		}
+	} else {
+		if err := meter.Modify(); err != nil {
+			return fmt.Errorf("error when modifying Service connection rate limiting OF Meter %d: %w", meterID, err)
+		}
+	}
+	c.featureService.cachedMeter.Store(meterID, meter)
+	return nil
+}
+
+func (c *client) UninstallServiceConnectionRateLimit(meterID uint32) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	mCache, ok := c.featureService.cachedMeter.Load(meterID)
+	if ok {
+		meter := mCache.(binding.Meter)
+		if err := meter.Delete(); err != nil {
+			return fmt.Errorf("error when deleting Service connection rate limiting OF Meter %d: %w", meterID, err)
+		}
+		c.featureService.cachedMeter.Delete(meterID)
+	}
+	return nil
+}
+
+func generateServiceRejectFlowCacheKey(svcIP net.IP, svcPort uint16, protocol binding.Protocol) string {
+	return fmt.Sprintf("R%s%s%x", svcIP, protocol, svcPort)
+}
+
+func (c *client) InstallServiceRejectFlow(config *types.ServiceConfig) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	cacheKey := generateServiceRejectFlowCacheKey(config.ServiceIP, config.ServicePort, config.Protocol)
+	return c.addFlows(c.featureService.cachedFlows, cacheKey, []binding.Flow{c.featureService.serviceRejectFlow(config)})
+}
+
+func (c *client) UninstallServiceRejectFlow(svcIP net.IP, svcPort uint16, protocol binding.Protocol) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	cacheKey := generateServiceRejectFlowCacheKey(svcIP, svcPort, protocol)
+	return c.deleteFlows(c.featureService.cachedFlows, cacheKey)
+}
+
+func (c *client) InstallServiceCIDRRejectFlows(serviceCIDRs []*net.IPNet, excludedIPs []net.IP) error {
+	var flows []binding.Flow
+	for _, serviceCIDR := range serviceCIDRs {
+		flows = append(flows, c.featureService.serviceCIDRRejectFlows(*serviceCIDR)...)
+	}
+	for _, ip := range excludedIPs {
+		flows = append(flows, c.featureService.serviceCIDRRejectExclusionFlow(ip))
+	}
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.modifyFlows(c.featureService.cachedFlows, "svc-cidr-reject", flows)
+}
+
+func (c *client) InstallExternalTrafficSNATFlows(snatIP net.IP) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	if snatIP == nil {
+		return c.deleteFlows(c.featureService.cachedFlows, "external-traffic-snat")
+	}
+	return c.modifyFlows(c.featureService.cachedFlows, "external-traffic-snat", c.featureService.externalTrafficSNATFlows(snatIP))
+}
+
+func generatePeerExternalTrafficSNATFlowCacheKey(hostname string) string {
+	return fmt.Sprintf("external-traffic-snat-%s", hostname)
+}
+
+func (c *client) InstallPeerExternalTrafficSNATFlows(hostname string, snatIP, tunnelPeerIP net.IP) error {
+	flows := []binding.Flow{c.featureService.l3FwdFlowToRemoteExternalTrafficSNATIP(snatIP, tunnelPeerIP)}
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.modifyFlows(c.featureService.cachedFlows, generatePeerExternalTrafficSNATFlowCacheKey(hostname), flows)
+}
+
+func (c *client) UninstallPeerExternalTrafficSNATFlows(hostname string) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.deleteFlows(c.featureService.cachedFlows, generatePeerExternalTrafficSNATFlowCacheKey(hostname))
+}
+
+func generatePodDNSResolverFlowCacheKey(ofPort uint32) string {
+	return fmt.Sprintf("dns-resolver-%d", ofPort)
+}
+
+func (c *client) InstallPodDNSResolverFlows(ofPort uint32, resolvers []net.IP) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	flows := c.featureNetworkPolicy.dnsResolverFlows(ofPort, resolvers)
+	return c.modifyFlows(c.featureNetworkPolicy.cachedFlows, generatePodDNSResolverFlowCacheKey(ofPort), flows)
+}
+
+func (c *client) UninstallPodDNSResolverFlows(ofPort uint32) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+
+	return c.deleteFlows(c.featureNetworkPolicy.cachedFlows, generatePodDNSResolverFlowCacheKey(ofPort))
+}
+
 func (c *client) GetServiceFlowKeys(svcIP net.IP, svcPort uint16, protocol binding.Protocol, endpoints []proxy.Endpoint) []string {
 	cacheKey := generateServicePortFlowCacheKey(svcIP, svcPort, protocol)
 	flowKeys := c.getFlowKeysFromCache(c.featureService.cachedFlows, cacheKey)
@@ -967,6 +1156,7 @@ func (c *client) generatePipelines() {
 		c.enableMulticast,
 		c.proxyAll,
 		c.connectUplinkToBridge,
+		c.enableDNSResolverRestriction,
 		c.nodeType,
 		c.groupIDAllocator)
 	c.activatedFeatures = append(c.activatedFeatures, c.featureNetworkPolicy)
@@ -1055,11 +1245,17 @@ func (c *client) InstallSNATBypassServiceFlows(serviceCIDRs []*net.IPNet) error
 }
 
 func (c *client) InstallSNATMarkFlows(snatIP net.IP, mark uint32) error {
-	flow := c.featureEgress.snatIPFromTunnelFlow(snatIP, mark)
+	flows := []binding.Flow{c.featureEgress.snatIPFromTunnelFlow(snatIP, mark)}
+	if runtime.IsWindowsPlatform() {
+		if c.featureEgress.uplinkGatewayMAC == nil {
+			return fmt.Errorf("the MAC address of the uplink's default gateway is unknown, SNAT IP %s cannot be used", snatIP)
+		}
+		flows = append(flows, c.featureEgress.snatMarkFlows(snatIP, mark)...)
+	}
 	cacheKey := fmt.Sprintf("s%x", mark)
 	c.replayMutex.RLock()
 	defer c.replayMutex.RUnlock()
-	return c.addFlows(c.featureEgress.cachedFlows, cacheKey, []binding.Flow{flow})
+	return c.addFlows(c.featureEgress.cachedFlows, cacheKey, flows)
 }
 
 func (c *client) UninstallSNATMarkFlows(mark uint32) error {
@@ -1134,6 +1330,12 @@ func (c *client) ReplayFlows() {
 	c.replayMutex.Lock()
 	defer c.replayMutex.Unlock()
 
+	if synced, err := c.syncOFEntries(); err != nil {
+		klog.ErrorS(err, "Error when syncing OpenFlow entries incrementally, replaying all entries")
+	} else if synced {
+		return
+	}
+
 	if err := c.initialize(); err != nil {
 		klog.Errorf("Error during flow replay: %v", err)
 	}
@@ -1156,6 +1358,261 @@ func (c *client) ReplayFlows() {
 	}
 }
 
+// syncOFEntries syncs the OpenFlow entries cached by the client to the OVS bridge incrementally, when the flows
+// installed in the current round are still present on the bridge, i.e. when the OpenFlow connection was interrupted
+// but ovs-vswitchd was not restarted. Only the missing, modified and unexpected flows are synced in a bundle, which is
+// much faster than replaying all the flows on Nodes with a large number of flows. It returns false without changing
+// anything if there is no flow of the current round on the bridge, in which case all the entries must be replayed.
+func (c *client) syncOFEntries() (bool, error) {
+	cookieID, cookieMask := cookie.CookieMaskForRound(c.roundInfo.RoundNum)
+	actualFlows, err := c.bridge.DumpFlowMods(cookieID, cookieMask)
+	if err != nil {
+		return false, fmt.Errorf("error when dumping flows: %w", err)
+	}
+	if len(actualFlows) == 0 {
+		return false, nil
+	}
+	dumpedGroups, err := c.ovsctlClient.DumpGroups()
+	if err != nil {
+		return false, fmt.Errorf("error when dumping groups: %w", err)
+	}
+	actualGroupIDs := sets.New[binding.GroupIDType]()
+	for _, group := range dumpedGroups {
+		if matches := groupIDRegex.FindStringSubmatch(group); matches != nil {
+			id, _ := strconv.ParseUint(matches[1], 10, 32)
+			actualGroupIDs.Insert(binding.GroupIDType(id))
+		}
+	}
+
+	desiredFlows := c.defaultFlows()
+	var meters, addGroups, modGroups []binding.OFEntry
+	if c.ovsMetersAreSupported {
+		for _, meterID := range []binding.MeterIDType{PacketInMeterIDNP, PacketInMeterIDTF, PacketInMeterIDDNS} {
+			meters = append(meters, c.genOFMeter(meterID, ofctrl.MeterBurst|ofctrl.MeterPktps, uint32(c.packetInRate), uint32(2*c.packetInRate)))
+		}
+	}
+	desiredGroupIDs := sets.New[binding.GroupIDType]()
+	for _, activeFeature := range c.activatedFeatures {
+		desiredFlows = append(desiredFlows, activeFeature.initFlows()...)
+		desiredFlows = append(desiredFlows, activeFeature.replayFlows()...)
+		meters = append(meters, activeFeature.replayMeters()...)
+		for _, groups := range [][]binding.OFEntry{activeFeature.initGroups(), activeFeature.replayGroups()} {
+			for _, group := range groups {
+				groupID := group.(binding.Group).GetID()
+				desiredGroupIDs.Insert(groupID)
+				if actualGroupIDs.Has(groupID) {
+					modGroups = append(modGroups, group)
+				} else {
+					addGroups = append(addGroups, group)
+				}
+			}
+		}
+	}
+
+	// The errors of meter messages are not reported, as they cannot be sent in a bundle. A meter is added in case it
+	// is missing, and then modified in case it already exists, in which case the add message is rejected.
+	for _, meter := range meters {
+		if err := meter.Add(); err != nil {
+			return false, fmt.Errorf("error when adding meter: %w", err)
+		}
+		if err := meter.Modify(); err != nil {
+			return false, fmt.Errorf("error when modifying meter: %w", err)
+		}
+	}
+	// Groups must be synced before flows, as a flow referring to a missing group cannot be installed.
+	if err := c.ofEntryOperations.AddOFEntries(addGroups); err != nil {
+		return false, fmt.Errorf("error when adding groups: %w", err)
+	}
+	if err := c.ofEntryOperations.ModifyOFEntries(modGroups); err != nil {
+		return false, fmt.Errorf("error when modifying groups: %w", err)
+	}
+	addFlows, modFlows, delFlows := getFlowModChanges(desiredFlows, actualFlows)
+	if err := c.ofEntryOperations.BundleOps(addFlows, modFlows, delFlows); err != nil {
+		return false, fmt.Errorf("error when syncing flows: %w", err)
+	}
+	// Unexpected groups are deleted after flows, as the flows referring to a deleted group are deleted by OVS.
+	var delGroups []binding.OFEntry
+	for _, groupID := range sets.List(actualGroupIDs.Difference(desiredGroupIDs)) {
+		delGroups = append(delGroups, c.bridge.NewGroup(groupID))
+	}
+	if err := c.ofEntryOperations.DeleteOFEntries(delGroups); err != nil {
+		return false, fmt.Errorf("error when deleting groups: %w", err)
+	}
+	klog.InfoS("Synced OpenFlow entries incrementally", "addedFlows", len(addFlows), "modifiedFlows", len(modFlows),
+		"deletedFlows", len(delFlows), "addedGroups", len(addGroups), "deletedGroups", len(delGroups))
+	return true, nil
+}
+
+func (c *client) UpdateNodeIPs() error {
+	c.replayMutex.Lock()
+	defer c.replayMutex.Unlock()
+
+	nodeIPs := getNodeIPs(c.ipProtocols, c.nodeConfig)
+	c.featurePodConnectivity.nodeIPs = nodeIPs
+	if c.featureEgress != nil {
+		c.featureEgress.nodeIPs = nodeIPs
+	}
+	synced, err := c.syncOFEntries()
+	if err != nil {
+		return err
+	}
+	if !synced {
+		return fmt.Errorf("no flows of the current round are installed")
+	}
+	return nil
+}
+
+// getNodeIPs returns the IP address of the local Node for each IP protocol.
+func getNodeIPs(ipProtocols []binding.Protocol, nodeConfig *config.NodeConfig) map[binding.Protocol]net.IP {
+	nodeIPs := make(map[binding.Protocol]net.IP)
+	for _, ipProtocol := range ipProtocols {
+		switch ipProtocol {
+		case binding.ProtocolIP:
+			nodeIPs[ipProtocol] = nodeConfig.NodeIPv4Addr.IP
+		case binding.ProtocolIPv6:
+			nodeIPs[ipProtocol] = nodeConfig.NodeIPv6Addr.IP
+		}
+	}
+	return nodeIPs
+}
+
+// getFlowModChanges returns the flows which must be added, modified and deleted to realize the desired flows, based
+// on the differences reported by diffFlows.
+func getFlowModChanges(desiredFlows, actualFlows []*openflow15.FlowMod) (addFlows, modFlows, delFlows []*openflow15.FlowMod) {
+	for _, diff := range diffFlows(desiredFlows, actualFlows) {
+		switch diff.state {
+		case types.DatapathDiffMissing:
+			addFlows = append(addFlows, diff.desired)
+		case types.DatapathDiffModified:
+			modFlows = append(modFlows, diff.desired)
+		case types.DatapathDiffUnexpected:
+			delFlows = append(delFlows, diff.actual)
+		}
+	}
+	return addFlows, modFlows, delFlows
+}
+
+func (c *client) DiffOFEntries() ([]types.DatapathEntryDiff, error) {
+	desiredFlows, desiredGroupIDs := c.getDesiredOFEntries()
+	actualFlows, err := c.bridge.DumpFlowMods(0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error when dumping flows: %w", err)
+	}
+	actualGroups, err := c.ovsctlClient.DumpGroups()
+	if err != nil {
+		return nil, fmt.Errorf("error when dumping groups: %w", err)
+	}
+	var diffs []types.DatapathEntryDiff
+	for _, diff := range diffFlows(desiredFlows, actualFlows) {
+		diffs = append(diffs, diff.datapathEntryDiff())
+	}
+	diffs = append(diffs, diffGroups(desiredGroupIDs, actualGroups)...)
+	return diffs, nil
+}
+
+// getDesiredOFEntries returns the flows and the IDs of the groups which are expected to be realized on the OVS
+// bridge, i.e. the entries which would be installed by ReplayFlows.
+func (c *client) getDesiredOFEntries() ([]*openflow15.FlowMod, sets.Set[binding.GroupIDType]) {
+	// The write lock is required as the feature caches are read in the same way as ReplayFlows does.
+	c.replayMutex.Lock()
+	defer c.replayMutex.Unlock()
+
+	flows := c.defaultFlows()
+	groupIDs := sets.New[binding.GroupIDType]()
+	for _, activeFeature := range c.activatedFeatures {
+		flows = append(flows, activeFeature.initFlows()...)
+		flows = append(flows, activeFeature.replayFlows()...)
+		for _, groups := range [][]binding.OFEntry{activeFeature.initGroups(), activeFeature.replayGroups()} {
+			for _, group := range groups {
+				groupIDs.Insert(group.(binding.Group).GetID())
+			}
+		}
+	}
+	return flows, groupIDs
+}
+
+// flowDiff is a discrepancy between a desired flow and the flow realized on the OVS bridge. desired is nil when state
+// is DatapathDiffUnexpected, and actual is nil when state is DatapathDiffMissing.
+type flowDiff struct {
+	state   types.DatapathDiffState
+	desired *openflow15.FlowMod
+	actual  *openflow15.FlowMod
+}
+
+func (d *flowDiff) datapathEntryDiff() types.DatapathEntryDiff {
+	diff := types.DatapathEntryDiff{
+		Type:  types.DatapathEntryFlow,
+		State: d.state,
+	}
+	if d.desired != nil {
+		diff.Desired = binding.FlowModToString(d.desired)
+	}
+	if d.actual != nil {
+		diff.Actual = binding.FlowModToString(d.actual)
+	}
+	return diff
+}
+
+// diffFlows compares the desired flows with the actual flows. Flows are identified by their table, priority and
+// match, and a flow with the same identity but different actions is reported as modified.
+func diffFlows(desiredFlows, actualFlows []*openflow15.FlowMod) []flowDiff {
+	desiredFlowMap := make(map[string]*openflow15.FlowMod, len(desiredFlows))
+	for _, flow := range desiredFlows {
+		desiredFlowMap[getFlowModKey(flow)] = flow
+	}
+	actualFlowMap := make(map[string]*openflow15.FlowMod, len(actualFlows))
+	for _, flow := range actualFlows {
+		actualFlowMap[getFlowModKey(flow)] = flow
+	}
+	var diffs []flowDiff
+	for key, desiredFlow := range desiredFlowMap {
+		actualFlow, ok := actualFlowMap[key]
+		if !ok {
+			diffs = append(diffs, flowDiff{state: types.DatapathDiffMissing, desired: desiredFlow})
+		} else if binding.FlowModActionString(desiredFlow) != binding.FlowModActionString(actualFlow) {
+			diffs = append(diffs, flowDiff{state: types.DatapathDiffModified, desired: desiredFlow, actual: actualFlow})
+		}
+	}
+	for key, actualFlow := range actualFlowMap {
+		if _, ok := desiredFlowMap[key]; !ok {
+			diffs = append(diffs, flowDiff{state: types.DatapathDiffUnexpected, actual: actualFlow})
+		}
+	}
+	return diffs
+}
+
+var groupIDRegex = regexp.MustCompile(`group_id=(\d+)`)
+
+// diffGroups compares the IDs of the desired groups with the groups dumped from the OVS bridge.
+func diffGroups(desiredGroupIDs sets.Set[binding.GroupIDType], actualGroups []string) []types.DatapathEntryDiff {
+	var diffs []types.DatapathEntryDiff
+	actualGroupIDs := sets.New[binding.GroupIDType]()
+	for _, group := range actualGroups {
+		matches := groupIDRegex.FindStringSubmatch(group)
+		if matches == nil {
+			continue
+		}
+		id, _ := strconv.ParseUint(matches[1], 10, 32)
+		groupID := binding.GroupIDType(id)
+		actualGroupIDs.Insert(groupID)
+		if !desiredGroupIDs.Has(groupID) {
+			diffs = append(diffs, types.DatapathEntryDiff{
+				Type:   types.DatapathEntryGroup,
+				State:  types.DatapathDiffUnexpected,
+				Actual: group,
+			})
+		}
+	}
+	for _, groupID := range sets.List(desiredGroupIDs.Difference(actualGroupIDs)) {
+		diffs = append(diffs, types.DatapathEntryDiff{
+			Type:    types.DatapathEntryGroup,
+			State:   types.DatapathDiffMissing,
+			Desired: fmt.Sprintf("group_id=%d", groupID),
+		})
+	}
+	return diffs
+}
+
 func (c *client) deleteFlowsByRoundNum(roundNum uint64) error {
 	cookieID, cookieMask := cookie.CookieMaskForRound(roundNum)
 	return c.bridge.DeleteFlowsByCookie(cookieID, cookieMask)
@@ -1200,6 +1657,9 @@ func (c *client) SendTraceflowPacket(dataplaneTag uint8, packet *binding.Packet,
 			SetICMPCode(packet.ICMPCode).
 			SetICMPID(packet.ICMPEchoID).
 			SetICMPSequence(packet.ICMPEchoSeq)
+		if len(packet.ICMPData) > 0 {
+			packetOutBuilder = packetOutBuilder.SetICMPData(packet.ICMPData)
+		}
 	case protocol.Type_TCP:
 		if packet.IsIPv6 {
 			packetOutBuilder = packetOutBuilder.SetIPProtocol(binding.ProtocolTCPv6)
@@ -1227,6 +1687,9 @@ func (c *client) SendTraceflowPacket(dataplaneTag uint8, packet *binding.Packet,
 		}
 		packetOutBuilder = packetOutBuilder.SetUDPDstPort(packet.DestinationPort).
 			SetUDPSrcPort(udpSrcPort)
+		if len(packet.UDPData) > 0 {
+			packetOutBuilder = packetOutBuilder.SetUDPData(packet.UDPData)
+		}
 	default:
 		packetOutBuilder = packetOutBuilder.SetIPProtocolValue(packet.IsIPv6, packet.IPProto)
 	}
@@ -1453,6 +1916,21 @@ func (c *client) UninstallMulticastFlows(multicastIP net.IP) error {
 	return c.deleteFlows(c.featureMulticast.cachedFlows, cacheKey)
 }
 
+func (c *client) InstallMulticastSourceFlows(multicastIP net.IP, sourceIP net.IP, groupID binding.GroupIDType) error {
+	flows := c.featureMulticast.localMulticastSourceForwardFlows(multicastIP, sourceIP, groupID)
+	cacheKey := fmt.Sprintf("multicast_%s_%s", multicastIP.String(), sourceIP.String())
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	return c.addFlows(c.featureMulticast.cachedFlows, cacheKey, flows)
+}
+
+func (c *client) UninstallMulticastSourceFlows(multicastIP net.IP, sourceIP net.IP) error {
+	c.replayMutex.RLock()
+	defer c.replayMutex.RUnlock()
+	cacheKey := fmt.Sprintf("multicast_%s_%s", multicastIP.String(), sourceIP.String())
+	return c.deleteFlows(c.featureMulticast.cachedFlows, cacheKey)
+}
+
 func (c *client) InstallMulticastFlexibleIPAMFlows() error {
 	firstMulticastTable := c.pipelines[pipelineMulticast].GetFirstTable()
 	flows := c.featureMulticast.multicastForwardFlexibleIPAMFlows(firstMulticastTable)
@@ -1704,6 +2182,165 @@ func (c *client) getMeterStats() {
 	}
 }
 
+// dropFlowSource identifies the flows of a table which drop packets for a given reason.
+type dropFlowSource struct {
+	table  *Table
+	reason string
+	// isDropFlow returns whether the dumped flow drops packets for the reason.
+	isDropFlow func(flow string, flowMap map[string]string) bool
+}
+
+// droppedPacketsKey identifies a source of dropped packets, i.e. a drop flow or an OVS port.
+type droppedPacketsKey struct {
+	reason string
+	source string
+}
+
+func isDropActionFlow(flow string, _ map[string]string) bool {
+	return strings.HasSuffix(flow, "actions=drop")
+}
+
+// isIsolationDropFlow returns whether the flow drops the packets of an isolated Pod. Depending on whether logging or
+// deny tracking is enabled, the packets are either dropped by the flow directly or sent to antrea-agent and dropped.
+func isIsolationDropFlow(_ string, flowMap map[string]string) bool {
+	return flowMap["priority"] == strconv.Itoa(int(priorityNormal))
+}
+
+func isInvalidConnStateDropFlow(flow string, flowMap map[string]string) bool {
+	return isDropActionFlow(flow, flowMap) && strings.Contains(flowMap["ct_state"], "+inv")
+}
+
+// isNoMatchingConnectionDropFlow returns whether the flow drops the packets received from the tunnel which neither
+// belong to an existing connection nor match an Egress.
+func isNoMatchingConnectionDropFlow(flow string, flowMap map[string]string) bool {
+	return isDropActionFlow(flow, flowMap) && strings.Contains(flowMap["ct_state"], "+new")
+}
+
+func isDNSResolverDropFlow(flow string, flowMap map[string]string) bool {
+	return isDropActionFlow(flow, flowMap) && flowMap["ct_tp_dst"] == strconv.Itoa(int(dnsPort))
+}
+
+// getDropFlowSources returns the flows of the OVS pipeline dropping packets, with the reason of the drops.
+func getDropFlowSources() []dropFlowSource {
+	return []dropFlowSource{
+		{IngressMetricTable, metrics.LabelDropReasonAntreaPolicyIngressDeny, isDropActionFlow},
+		{EgressMetricTable, metrics.LabelDropReasonAntreaPolicyEgressDeny, isDropActionFlow},
+		{IngressDefaultTable, metrics.LabelDropReasonK8sNetworkPolicyIngressIsolation, isIsolationDropFlow},
+		{EgressDefaultTable, metrics.LabelDropReasonK8sNetworkPolicyEgressIsolation, isIsolationDropFlow},
+		{ConntrackStateTable, metrics.LabelDropReasonInvalidConnState, isInvalidConnStateDropFlow},
+		{DSRServiceMarkTable, metrics.LabelDropReasonInvalidConnState, isInvalidConnStateDropFlow},
+		{EgressMarkTable, metrics.LabelDropReasonNoMatchingConnection, isNoMatchingConnectionDropFlow},
+		{SpoofGuardTable, metrics.LabelDropReasonSpoofGuard, isDropActionFlow},
+		{ARPSpoofGuardTable, metrics.LabelDropReasonSpoofGuard, isDropActionFlow},
+		{OutputTable, metrics.LabelDropReasonUnknownDestination, isDropActionFlow},
+		{EgressSecurityClassifierTable, metrics.LabelDropReasonDNSResolverRestriction, isDNSResolverDropFlow},
+	}
+}
+
+// flowStatsRegex matches the statistics of a dumped flow, which change over time.
+var flowStatsRegex = regexp.MustCompile(`(n_packets|n_bytes|idle_age|hard_age)=[^,]*, `)
+
+// parseDropFlows returns the number of packets dropped by each of the provided flows which drop packets according to
+// isDropFlow, keyed by the flow without its statistics.
+func parseDropFlows(flows []string, isDropFlow func(flow string, flowMap map[string]string) bool) map[string]uint64 {
+	// example SpoofGuard drop flow format:
+	// table=SpoofGuard, n_packets=12, n_bytes=888, priority=0 actions=drop
+	result := map[string]uint64{}
+	for _, flow := range flows {
+		flowMap := parseFlowToMap(flow)
+		if !isDropFlow(flow, flowMap) {
+			continue
+		}
+		result[flowStatsRegex.ReplaceAllString(flow, "")] += parseFlowMetric(flowMap).Packets
+	}
+	return result
+}
+
+// parsePortTxErrors returns the number of transmit errors of each port in the output of "ovs-ofctl dump-ports". The
+// OVS datapath counts the packets which exceed the MTU of the output port as transmit errors, and drops them.
+func parsePortTxErrors(portsDump string) map[string]uint64 {
+	// example port statistics format:
+	//   port  "antrea-gw0": rx pkts=41, bytes=3178, drop=0, errs=0, frame=0, over=0, crc=0
+	//            tx pkts=46, bytes=3584, drop=0, errs=2, coll=0
+	//            duration=1234.567s
+	result := map[string]uint64{}
+	var port string
+	for _, line := range strings.Split(portsDump, "\n") {
+		line = strings.TrimSpace(line)
+		if rest, found := strings.CutPrefix(line, "port "); found {
+			port, _, _ = strings.Cut(rest, ":")
+			port = strings.TrimSpace(port)
+			continue
+		}
+		if port == "" || !strings.HasPrefix(line, "tx ") {
+			continue
+		}
+		for _, field := range strings.Split(line[len("tx "):], ",") {
+			if value, found := strings.CutPrefix(strings.TrimSpace(field), "errs="); found {
+				errs, _ := strconv.ParseUint(value, 10, 64)
+				result[port] = errs
+			}
+		}
+	}
+	return result
+}
+
+// getDroppedPackets returns the number of packets dropped by each drop flow of the OVS pipeline and by each OVS port.
+// The tables which are not part of the pipeline are ignored.
+func (c *client) getDroppedPackets() (map[droppedPacketsKey]uint64, error) {
+	result := map[droppedPacketsKey]uint64{}
+	for _, source := range getDropFlowSources() {
+		if !source.table.IsInitialized() {
+			continue
+		}
+		flows, err := c.ovsctlClient.DumpTableFlows(source.table.GetID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump OVS flows of table %s: %w", source.table.GetName(), err)
+		}
+		for flow, packets := range parseDropFlows(flows, source.isDropFlow) {
+			result[droppedPacketsKey{reason: source.reason, source: flow}] = packets
+		}
+	}
+	portsDump, err := c.ovsctlClient.RunOfctlCmd("dump-ports")
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump OVS ports: %w", err)
+	}
+	for port, errs := range parsePortTxErrors(string(portsDump)) {
+		result[droppedPacketsKey{reason: metrics.LabelDropReasonMTUExceeded, source: port}] = errs
+	}
+	return result, nil
+}
+
+// getNewlyDroppedPackets returns the number of packets dropped since the previous collection, keyed by drop reason.
+// The statistics of a flow or port are reset when it is reinstalled, in which case all its dropped packets are new.
+func getNewlyDroppedPackets(previous, current map[droppedPacketsKey]uint64) map[string]uint64 {
+	result := map[string]uint64{}
+	for key, packets := range current {
+		if previousPackets, ok := previous[key]; ok && packets >= previousPackets {
+			packets -= previousPackets
+		}
+		if packets > 0 {
+			result[key.reason] += packets
+		}
+	}
+	return result
+}
+
+// getDropStats collects the statistics of the flows and ports dropping packets and increments
+// antrea_agent_ovs_dropped_packet_count by the number of packets dropped since the previous collection.
+func (c *client) getDropStats() {
+	droppedPackets, err := c.getDroppedPackets()
+	if err != nil {
+		// Keep the previous statistics, so that the packets dropped until now are counted by the next collection.
+		klog.ErrorS(err, "Failed to collect the statistics of dropped packets")
+		return
+	}
+	for reason, packets := range getNewlyDroppedPackets(c.previousDroppedPackets, droppedPackets) {
+		metrics.OVSDroppedPacketCount.WithLabelValues(reason).Add(float64(packets))
+	}
+	c.previousDroppedPackets = droppedPackets
+}
+
 func (c *client) SubscribeOFPortStatusMessage(statusCh chan *openflow15.PortStatus) {
 	c.bridge.SubscribePortStatusConsumer(statusCh)
 }
@@ -30,8 +30,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/metrics"
 	nodeiptest "antrea.io/antrea/pkg/agent/nodeip/testing"
 	"antrea.io/antrea/pkg/agent/openflow/cookie"
 	opstest "antrea.io/antrea/pkg/agent/openflow/operations/testing"
@@ -52,6 +54,8 @@ var (
 
 	fakeGatewayMAC, _ = net.ParseMAC("0a:00:00:00:00:01")
 	fakeUplinkMAC, _  = net.ParseMAC("0a:00:00:00:00:02")
+	// fakeUplinkGatewayMAC is the MAC address of the uplink's default gateway.
+	fakeUplinkGatewayMAC, _ = net.ParseMAC("0a:00:00:00:00:03")
 
 	fakeGatewayIPv4, fakePodIPv4CIDR, _ = net.ParseCIDR("10.10.0.1/24")
 	fakeNodeIPv4, fakeNodeIPv4Addr, _   = net.ParseCIDR("192.168.77.100/24")
@@ -81,20 +85,21 @@ func skipTest(tb testing.TB, skipLinux, skipWindows bool) {
 }
 
 type clientOptions struct {
-	enableOVSMeters            bool
-	enableProxy                bool
-	enableAntreaPolicy         bool
-	enableEgress               bool
-	enableEgressTrafficShaping bool
-	proxyAll                   bool
-	enableDSR                  bool
-	connectUplinkToBridge      bool
-	enableMulticast            bool
-	enableTrafficControl       bool
-	enableMulticluster         bool
-	enableL7NetworkPolicy      bool
-	enableL7FlowExporter       bool
-	trafficEncryptionMode      config.TrafficEncryptionModeType
+	enableOVSMeters              bool
+	enableProxy                  bool
+	enableAntreaPolicy           bool
+	enableEgress                 bool
+	enableEgressTrafficShaping   bool
+	proxyAll                     bool
+	enableDSR                    bool
+	connectUplinkToBridge        bool
+	enableMulticast              bool
+	enableTrafficControl         bool
+	enableMulticluster           bool
+	enableL7NetworkPolicy        bool
+	enableL7FlowExporter         bool
+	enableDNSResolverRestriction bool
+	trafficEncryptionMode        config.TrafficEncryptionModeType
 }
 
 type clientOptionsFn func(*clientOptions)
@@ -168,6 +173,10 @@ func enableMulticluster(o *clientOptions) {
 	o.enableMulticluster = true
 }
 
+func enableDNSResolverRestriction(o *clientOptions) {
+	o.enableDNSResolverRestriction = true
+}
+
 func setTrafficEncryptionMode(trafficEncryptionMode config.TrafficEncryptionModeType) clientOptionsFn {
 	return func(o *clientOptions) {
 		o.trafficEncryptionMode = trafficEncryptionMode
@@ -417,6 +426,7 @@ func newFakeClientWithBridge(
 		o.enableTrafficControl,
 		o.enableL7FlowExporter,
 		o.enableMulticluster,
+		o.enableDNSResolverRestriction,
 		NewGroupAllocator(),
 		false,
 		defaultPacketInRate)
@@ -487,8 +497,9 @@ func newFakeClientWithBridge(
 		Type:                  nodeType,
 		HostInterfaceOFPort:   ovsconfig.BridgeOFPort,
 		UplinkNetConfig: &config.AdapterNetConfig{
-			MAC:    fakeUplinkMAC,
-			OFPort: uint32(config.DefaultUplinkOFPort),
+			MAC:        fakeUplinkMAC,
+			GatewayMAC: fakeUplinkGatewayMAC,
+			OFPort:     uint32(config.DefaultUplinkOFPort),
 		},
 	}
 	egressConfig := &config.EgressConfig{
@@ -1264,6 +1275,7 @@ func Test_client_InstallServiceFlows(t *testing.T) {
 		isNested           bool
 		isDSR              bool
 		enableMulticluster bool
+		preserveClientIP   bool
 		expectedFlows      []string
 	}{
 		{
@@ -1283,6 +1295,15 @@ func Test_client_InstallServiceFlows(t *testing.T) {
 				"cookie=0x1030000000000, table=ServiceLB, priority=200,tcp,reg4=0x10000/0x70000,nw_dst=10.96.0.100,tp_dst=80 actions=set_field:0x200/0x200->reg0,set_field:0x20000/0x70000->reg4,set_field:0x65->reg7,group:101",
 			},
 		},
+		{
+			name:             "Service ClusterIP,preserve hairpin client IP",
+			protocol:         binding.ProtocolTCP,
+			svcIP:            svcIPv4,
+			preserveClientIP: true,
+			expectedFlows: []string{
+				"cookie=0x1030000000000, table=ServiceLB, priority=200,tcp,reg4=0x10000/0x70000,nw_dst=10.96.0.100,tp_dst=80 actions=set_field:0x200/0x200->reg0,set_field:0x20000/0x70000->reg4,set_field:0x64->reg7,set_field:0x20000000/0x20000000->reg4,group:100",
+			},
+		},
 		{
 			name:     "Service ClusterIP,multicluster,nested",
 			protocol: binding.ProtocolTCP,
@@ -1471,17 +1492,18 @@ func Test_client_InstallServiceFlows(t *testing.T) {
 			cacheKey := generateServicePortFlowCacheKey(tc.svcIP, port, tc.protocol)
 
 			assert.NoError(t, fc.InstallServiceFlows(&types.ServiceConfig{
-				ServiceIP:          tc.svcIP,
-				ServicePort:        port,
-				Protocol:           tc.protocol,
-				TrafficPolicyLocal: tc.trafficPolicyLocal,
-				LocalGroupID:       localGroupID,
-				ClusterGroupID:     clusterGroupID,
-				AffinityTimeout:    tc.affinityTimeout,
-				IsExternal:         tc.isExternal,
-				IsNodePort:         tc.isNodePort,
-				IsNested:           tc.isNested,
-				IsDSR:              tc.isDSR,
+				ServiceIP:               tc.svcIP,
+				ServicePort:             port,
+				Protocol:                tc.protocol,
+				TrafficPolicyLocal:      tc.trafficPolicyLocal,
+				LocalGroupID:            localGroupID,
+				ClusterGroupID:          clusterGroupID,
+				AffinityTimeout:         tc.affinityTimeout,
+				IsExternal:              tc.isExternal,
+				IsNodePort:              tc.isNodePort,
+				IsNested:                tc.isNested,
+				IsDSR:                   tc.isDSR,
+				PreserveHairpinClientIP: tc.preserveClientIP,
 			}))
 			fCacheI, ok := fc.featureService.cachedFlows.Load(cacheKey)
 			require.True(t, ok)
@@ -1536,6 +1558,172 @@ func Test_client_GetServiceFlowKeys(t *testing.T) {
 	assert.ElementsMatch(t, expectedFlowKeys, flowKeys)
 }
 
+func Test_client_InstallServiceCIDRRejectFlows(t *testing.T) {
+	testCases := []struct {
+		name             string
+		serviceCIDRs     []*net.IPNet
+		excludedIPs      []net.IP
+		newServiceCIDRs  []*net.IPNet
+		expectedFlows    []string
+		expectedNewFlows []string
+	}{
+		{
+			name: "IPv4",
+			serviceCIDRs: []*net.IPNet{
+				utilip.MustParseCIDR("10.96.0.0/24"),
+			},
+			excludedIPs: []net.IP{net.ParseIP("10.96.0.10")},
+			newServiceCIDRs: []*net.IPNet{
+				utilip.MustParseCIDR("10.96.0.0/16"),
+			},
+			expectedFlows: []string{
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,tcp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/24 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,udp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/24 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,sctp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/24 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=191,ip,reg4=0x10000/0x70000,nw_dst=10.96.0.10 actions=goto_table:EndpointDNAT",
+			},
+			expectedNewFlows: []string{
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,tcp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/16 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,udp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/16 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,sctp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/16 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+			},
+		},
+		{
+			name: "dual-stack",
+			serviceCIDRs: []*net.IPNet{
+				utilip.MustParseCIDR("10.96.0.0/24"),
+				utilip.MustParseCIDR("1096::/80"),
+			},
+			excludedIPs: []net.IP{net.ParseIP("10.96.0.10"), net.ParseIP("1096::10")},
+			newServiceCIDRs: []*net.IPNet{
+				utilip.MustParseCIDR("10.96.0.0/16"),
+			},
+			expectedFlows: []string{
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,tcp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/24 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,udp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/24 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,sctp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/24 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,tcp6,reg4=0x10000/0x70000,ipv6_dst=1096::/80 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,udp6,reg4=0x10000/0x70000,ipv6_dst=1096::/80 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,sctp6,reg4=0x10000/0x70000,ipv6_dst=1096::/80 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=191,ip,reg4=0x10000/0x70000,nw_dst=10.96.0.10 actions=goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=191,ipv6,reg4=0x10000/0x70000,ipv6_dst=1096::10 actions=goto_table:EndpointDNAT",
+			},
+			expectedNewFlows: []string{
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,tcp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/16 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,udp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/16 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+				"cookie=0x1030000000000, table=ServiceLB, priority=190,sctp,reg4=0x10000/0x70000,nw_dst=10.96.0.0/16 actions=set_field:0x4000/0x4000->reg0,goto_table:EndpointDNAT",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := opstest.NewMockOFEntryOperations(ctrl)
+
+			fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap)
+			defer resetPipelines()
+
+			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+			assert.NoError(t, fc.InstallServiceCIDRRejectFlows(tc.serviceCIDRs, tc.excludedIPs))
+			fCacheI, ok := fc.featureService.cachedFlows.Load("svc-cidr-reject")
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+
+			m.EXPECT().BundleOps(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			assert.NoError(t, fc.InstallServiceCIDRRejectFlows(tc.newServiceCIDRs, nil))
+			fCacheI, ok = fc.featureService.cachedFlows.Load("svc-cidr-reject")
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedNewFlows, getFlowStrings(fCacheI))
+		})
+	}
+}
+
+func Test_client_InstallExternalTrafficSNATFlows(t *testing.T) {
+	testCases := []struct {
+		name             string
+		snatIP           net.IP
+		newSNATIP        net.IP
+		expectedFlows    []string
+		expectedNewFlows []string
+	}{
+		{
+			name:      "IPv4",
+			snatIP:    net.ParseIP("192.168.1.100"),
+			newSNATIP: net.ParseIP("192.168.1.101"),
+			expectedFlows: []string{
+				"cookie=0x1030000000000, table=SNAT, priority=191,ct_state=+new+trk,ct_mark=0x20/0x20,ip,reg0=0x2/0xf actions=ct(commit,table=L2ForwardingCalc,zone=65521,nat(src=192.168.1.100),exec(set_field:0x10/0x10->ct_mark))",
+				"cookie=0x1030000000000, table=UnSNAT, priority=200,ip,nw_dst=192.168.1.100 actions=ct(table=ConntrackZone,zone=65521,nat)",
+			},
+			expectedNewFlows: []string{
+				"cookie=0x1030000000000, table=SNAT, priority=191,ct_state=+new+trk,ct_mark=0x20/0x20,ip,reg0=0x2/0xf actions=ct(commit,table=L2ForwardingCalc,zone=65521,nat(src=192.168.1.101),exec(set_field:0x10/0x10->ct_mark))",
+				"cookie=0x1030000000000, table=UnSNAT, priority=200,ip,nw_dst=192.168.1.101 actions=ct(table=ConntrackZone,zone=65521,nat)",
+			},
+		},
+		{
+			name:      "IPv6",
+			snatIP:    net.ParseIP("fec0:192:168:1::100"),
+			newSNATIP: net.ParseIP("fec0:192:168:1::101"),
+			expectedFlows: []string{
+				"cookie=0x1030000000000, table=SNAT, priority=191,ct_state=+new+trk,ct_mark=0x20/0x20,ipv6,reg0=0x2/0xf actions=ct(commit,table=L2ForwardingCalc,zone=65511,nat(src=fec0:192:168:1::100),exec(set_field:0x10/0x10->ct_mark))",
+				"cookie=0x1030000000000, table=UnSNAT, priority=200,ipv6,ipv6_dst=fec0:192:168:1::100 actions=ct(table=ConntrackZone,zone=65511,nat)",
+			},
+			expectedNewFlows: []string{
+				"cookie=0x1030000000000, table=SNAT, priority=191,ct_state=+new+trk,ct_mark=0x20/0x20,ipv6,reg0=0x2/0xf actions=ct(commit,table=L2ForwardingCalc,zone=65511,nat(src=fec0:192:168:1::101),exec(set_field:0x10/0x10->ct_mark))",
+				"cookie=0x1030000000000, table=UnSNAT, priority=200,ipv6,ipv6_dst=fec0:192:168:1::101 actions=ct(table=ConntrackZone,zone=65511,nat)",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := opstest.NewMockOFEntryOperations(ctrl)
+
+			isIPv6 := tc.snatIP.To4() == nil
+			fc := newFakeClient(m, !isIPv6, isIPv6, config.K8sNode, config.TrafficEncapModeEncap)
+			defer resetPipelines()
+
+			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+			assert.NoError(t, fc.InstallExternalTrafficSNATFlows(tc.snatIP))
+			fCacheI, ok := fc.featureService.cachedFlows.Load("external-traffic-snat")
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+
+			m.EXPECT().BundleOps(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			assert.NoError(t, fc.InstallExternalTrafficSNATFlows(tc.newSNATIP))
+			fCacheI, ok = fc.featureService.cachedFlows.Load("external-traffic-snat")
+			require.True(t, ok)
+			assert.ElementsMatch(t, tc.expectedNewFlows, getFlowStrings(fCacheI))
+
+			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+			assert.NoError(t, fc.InstallExternalTrafficSNATFlows(nil))
+			_, ok = fc.featureService.cachedFlows.Load("external-traffic-snat")
+			require.False(t, ok)
+		})
+	}
+}
+
+func Test_client_InstallPeerExternalTrafficSNATFlows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+
+	fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeEncap)
+	defer resetPipelines()
+
+	cacheKey := generatePeerExternalTrafficSNATFlowCacheKey("node1")
+	m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+	assert.NoError(t, fc.InstallPeerExternalTrafficSNATFlows("node1", net.ParseIP("192.168.1.101"), net.ParseIP("192.168.77.101")))
+	fCacheI, ok := fc.featureService.cachedFlows.Load(cacheKey)
+	require.True(t, ok)
+	assert.ElementsMatch(t, []string{
+		"cookie=0x1030000000000, table=L3Forwarding, priority=200,ip,nw_dst=192.168.1.101 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,goto_table:L3DecTTL",
+	}, getFlowStrings(fCacheI))
+
+	m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+	assert.NoError(t, fc.UninstallPeerExternalTrafficSNATFlows("node1"))
+	_, ok = fc.featureService.cachedFlows.Load(cacheKey)
+	require.False(t, ok)
+}
+
 func Test_client_InstallSNATBypassServiceFlows(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -1624,7 +1812,10 @@ func Test_client_InstallSNATMarkFlows(t *testing.T) {
 		name                  string
 		snatIP                net.IP
 		trafficShapingEnabled bool
+		skipWindows           bool
 		expectedFlows         []string
+		// SNAT is performed in OVS on Windows.
+		expectedWindowsFlows []string
 	}{
 		{
 			name:                  "IPv4 SNAT IP",
@@ -1633,6 +1824,13 @@ func Test_client_InstallSNATMarkFlows(t *testing.T) {
 			expectedFlows: []string{
 				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ip,tun_dst=192.168.77.100 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 			},
+			expectedWindowsFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ip,tun_dst=192.168.77.100 actions=set_field:0x64/0xff->pkt_mark,set_field:0a:00:00:00:00:02->eth_src,set_field:0a:00:00:00:00:03->eth_dst,set_field:0x40/0xf0->reg0,goto_table:L3DecTTL",
+				"cookie=0x1040000000000, table=SNATMark, priority=210,pkt_mark=0x64/0xff,ct_state=+new+trk,ip actions=ct(commit,table=SNAT,zone=65520,exec(move:NXM_NX_REG0[0..3]->NXM_NX_CT_MARK[0..3],set_field:0x20/0x20->ct_mark))",
+				"cookie=0x1040000000000, table=SNAT, priority=210,pkt_mark=0x64/0xff,ct_state=+new+trk,ip actions=ct(commit,table=L2ForwardingCalc,zone=65521,nat(src=192.168.77.100))",
+				"cookie=0x1040000000000, table=Classifier, priority=210,ip,in_port=32770,nw_dst=192.168.77.100 actions=set_field:0x4/0xf->reg0,set_field:0x200/0x200->reg0,goto_table:UnSNAT",
+				"cookie=0x1040000000000, table=UnSNAT, priority=200,ip,nw_dst=192.168.77.100 actions=ct(table=ConntrackZone,zone=65521,nat)",
+			},
 		},
 		{
 			name:                  "IPv6 SNAT IP",
@@ -1641,11 +1839,19 @@ func Test_client_InstallSNATMarkFlows(t *testing.T) {
 			expectedFlows: []string{
 				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ipv6,tun_ipv6_dst=fec0:192:168:77::100 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 			},
+			expectedWindowsFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ipv6,tun_ipv6_dst=fec0:192:168:77::100 actions=set_field:0x64/0xff->pkt_mark,set_field:0a:00:00:00:00:02->eth_src,set_field:0a:00:00:00:00:03->eth_dst,set_field:0x40/0xf0->reg0,goto_table:L3DecTTL",
+				"cookie=0x1040000000000, table=SNATMark, priority=210,pkt_mark=0x64/0xff,ct_state=+new+trk,ipv6 actions=ct(commit,table=SNAT,zone=65510,exec(move:NXM_NX_REG0[0..3]->NXM_NX_CT_MARK[0..3],set_field:0x20/0x20->ct_mark))",
+				"cookie=0x1040000000000, table=SNAT, priority=210,pkt_mark=0x64/0xff,ct_state=+new+trk,ipv6 actions=ct(commit,table=L2ForwardingCalc,zone=65511,nat(src=fec0:192:168:77::100))",
+				"cookie=0x1040000000000, table=Classifier, priority=210,ipv6,in_port=32770,ipv6_dst=fec0:192:168:77::100 actions=set_field:0x4/0xf->reg0,set_field:0x200/0x200->reg0,goto_table:UnSNAT",
+				"cookie=0x1040000000000, table=UnSNAT, priority=200,ipv6,ipv6_dst=fec0:192:168:77::100 actions=ct(table=ConntrackZone,zone=65511,nat)",
+			},
 		},
 		{
 			name:                  "IPv4 SNAT IP trafficShaping",
 			snatIP:                net.ParseIP("192.168.77.100"),
 			trafficShapingEnabled: true,
+			skipWindows:           true,
 			expectedFlows: []string{
 				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ip,tun_dst=192.168.77.100 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:EgressQoS",
 			},
@@ -1654,6 +1860,7 @@ func Test_client_InstallSNATMarkFlows(t *testing.T) {
 			name:                  "IPv6 SNAT IP trafficShaping",
 			snatIP:                net.ParseIP("fec0:192:168:77::100"),
 			trafficShapingEnabled: true,
+			skipWindows:           true,
 			expectedFlows: []string{
 				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ipv6,tun_ipv6_dst=fec0:192:168:77::100 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:EgressQoS",
 			},
@@ -1661,6 +1868,7 @@ func Test_client_InstallSNATMarkFlows(t *testing.T) {
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			skipTest(t, false, tc.skipWindows)
 			ctrl := gomock.NewController(t)
 			m := opstest.NewMockOFEntryOperations(ctrl)
 			fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap, setEnableEgressTrafficShaping(tc.trafficShapingEnabled))
@@ -1669,11 +1877,15 @@ func Test_client_InstallSNATMarkFlows(t *testing.T) {
 			m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
 			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
 
+			expectedFlows := tc.expectedFlows
+			if runtime.IsWindowsPlatform() {
+				expectedFlows = tc.expectedWindowsFlows
+			}
 			cacheKey := fmt.Sprintf("s%x", mark)
 			assert.NoError(t, fc.InstallSNATMarkFlows(tc.snatIP, mark))
 			fCacheI, ok := fc.featureEgress.cachedFlows.Load(cacheKey)
 			require.True(t, ok)
-			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+			assert.ElementsMatch(t, expectedFlows, getFlowStrings(fCacheI))
 
 			assert.NoError(t, fc.UninstallSNATMarkFlows(mark))
 			_, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
@@ -1689,8 +1901,10 @@ func Test_client_InstallPodSNATFlows(t *testing.T) {
 	testCases := []struct {
 		name                  string
 		trafficShapingEnabled bool
+		skipWindows           bool
 		snatMark              uint32
 		expectedFlows         []string
+		expectedWindowsFlows  []string
 	}{
 		{
 			name:                  "SNAT on Local",
@@ -1699,6 +1913,9 @@ func Test_client_InstallPodSNATFlows(t *testing.T) {
 			expectedFlows: []string{
 				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ip,in_port=100 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 			},
+			expectedWindowsFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ip,in_port=100 actions=set_field:0x64/0xff->pkt_mark,set_field:0a:00:00:00:00:02->eth_src,set_field:0a:00:00:00:00:03->eth_dst,set_field:0x40/0xf0->reg0,goto_table:L3DecTTL",
+			},
 		},
 		{
 			name:                  "SNAT on Remote",
@@ -1706,10 +1923,14 @@ func Test_client_InstallPodSNATFlows(t *testing.T) {
 			expectedFlows: []string{
 				"cookie=0x1040000000000, table=EgressMark, priority=200,ip,in_port=100 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
 			},
+			expectedWindowsFlows: []string{
+				"cookie=0x1040000000000, table=EgressMark, priority=200,ip,in_port=100 actions=set_field:0a:00:00:00:00:01->eth_src,set_field:aa:bb:cc:dd:ee:ff->eth_dst,set_field:192.168.77.101->tun_dst,set_field:0x10/0xf0->reg0,set_field:0x80000/0x80000->reg0,goto_table:L2ForwardingCalc",
+			},
 		},
 		{
 			name:                  "SNAT on Local trafficShaping",
 			trafficShapingEnabled: true,
+			skipWindows:           true,
 			snatMark:              uint32(100),
 			expectedFlows: []string{
 				"cookie=0x1040000000000, table=EgressMark, priority=200,ct_state=+trk,ip,in_port=100 actions=set_field:0x64/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:EgressQoS",
@@ -1719,6 +1940,7 @@ func Test_client_InstallPodSNATFlows(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
+			skipTest(t, false, tc.skipWindows)
 			ctrl := gomock.NewController(t)
 			m := opstest.NewMockOFEntryOperations(ctrl)
 			fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap, setEnableEgressTrafficShaping(tc.trafficShapingEnabled))
@@ -1728,10 +1950,14 @@ func Test_client_InstallPodSNATFlows(t *testing.T) {
 			m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
 			cacheKey := fmt.Sprintf("p%x", ofPort)
 
+			expectedFlows := tc.expectedFlows
+			if runtime.IsWindowsPlatform() {
+				expectedFlows = tc.expectedWindowsFlows
+			}
 			assert.NoError(t, fc.InstallPodSNATFlows(ofPort, snatIP, tc.snatMark))
 			fCacheI, ok := fc.featureEgress.cachedFlows.Load(cacheKey)
 			require.True(t, ok)
-			assert.ElementsMatch(t, tc.expectedFlows, getFlowStrings(fCacheI))
+			assert.ElementsMatch(t, expectedFlows, getFlowStrings(fCacheI))
 
 			assert.NoError(t, fc.UninstallPodSNATFlows(ofPort))
 			_, ok = fc.featureEgress.cachedFlows.Load(cacheKey)
@@ -1740,6 +1966,42 @@ func Test_client_InstallPodSNATFlows(t *testing.T) {
 	}
 }
 
+func Test_client_InstallPodDNSResolverFlows(t *testing.T) {
+	ofPort := uint32(100)
+	resolvers := []net.IP{net.ParseIP("10.96.0.10"), net.ParseIP("8.8.8.8")}
+
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+	fc := newFakeClient(m, true, false, config.K8sNode, config.TrafficEncapModeEncap, enableDNSResolverRestriction)
+	defer resetPipelines()
+
+	m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().BundleOps(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+	cacheKey := generatePodDNSResolverFlowCacheKey(ofPort)
+
+	assert.NoError(t, fc.InstallPodDNSResolverFlows(ofPort, resolvers))
+	fCacheI, ok := fc.featureNetworkPolicy.cachedFlows.Load(cacheKey)
+	require.True(t, ok)
+	// For TCP and UDP, a flow allowing the queries to each resolver and a flow dropping the other queries.
+	flows := getFlowStrings(fCacheI)
+	require.Len(t, flows, 6)
+	for _, flow := range flows {
+		assert.Contains(t, flow, "table=EgressSecurityClassifier")
+		assert.Contains(t, flow, "in_port=100")
+	}
+
+	// Removing a resolver deletes its flows.
+	assert.NoError(t, fc.InstallPodDNSResolverFlows(ofPort, resolvers[:1]))
+	fCacheI, ok = fc.featureNetworkPolicy.cachedFlows.Load(cacheKey)
+	require.True(t, ok)
+	assert.Len(t, getFlowStrings(fCacheI), 4)
+
+	assert.NoError(t, fc.UninstallPodDNSResolverFlows(ofPort))
+	_, ok = fc.featureNetworkPolicy.cachedFlows.Load(cacheKey)
+	require.False(t, ok)
+}
+
 func Test_client_InstallEgressQoS(t *testing.T) {
 	meterID := uint32(100)
 	meterRate := uint32(100)
@@ -2031,7 +2293,7 @@ func Test_client_setBasePacketOutBuilder(t *testing.T) {
 }
 
 func prepareSetBasePacketOutBuilder(ctrl *gomock.Controller, success bool) *client {
-	ofClient := NewClient(bridgeName, bridgeMgmtAddr, nodeiptest.NewFakeNodeIPChecker(), true, true, false, false, false, false, false, false, false, false, false, false, false, nil, false, defaultPacketInRate)
+	ofClient := NewClient(bridgeName, bridgeMgmtAddr, nodeiptest.NewFakeNodeIPChecker(), true, true, false, false, false, false, false, false, false, false, false, false, false, false, nil, false, defaultPacketInRate)
 	m := ovsoftest.NewMockBridge(ctrl)
 	ofClient.bridge = m
 	bridge := binding.OFBridge{}
@@ -2292,6 +2554,34 @@ func Test_client_InstallMulticastFlows(t *testing.T) {
 	}
 }
 
+func Test_client_InstallMulticastSourceFlows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	m := opstest.NewMockOFEntryOperations(ctrl)
+
+	fc := newFakeClient(m, true, true, config.K8sNode, config.TrafficEncapModeEncap, enableMulticast)
+	defer resetPipelines()
+
+	multicastIP := net.ParseIP("232.1.2.3")
+	sourceIP := net.ParseIP("10.10.0.5")
+	groupID := binding.GroupIDType(103)
+	expectedFlows := []string{
+		"cookie=0x1050000000000, table=MulticastRouting, priority=201,ip,nw_src=10.10.0.5,nw_dst=232.1.2.3 actions=group:103",
+	}
+
+	m.EXPECT().AddAll(gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().DeleteAll(gomock.Any()).Return(nil).Times(1)
+
+	cacheKey := "multicast_232.1.2.3_10.10.0.5"
+	assert.NoError(t, fc.InstallMulticastSourceFlows(multicastIP, sourceIP, groupID))
+	fCacheI, ok := fc.featureMulticast.cachedFlows.Load(cacheKey)
+	require.True(t, ok)
+	assert.ElementsMatch(t, expectedFlows, getFlowStrings(fCacheI))
+
+	assert.NoError(t, fc.UninstallMulticastSourceFlows(multicastIP, sourceIP))
+	_, ok = fc.featureMulticast.cachedFlows.Load(cacheKey)
+	require.False(t, ok)
+}
+
 func Test_client_InstallMulticastRemoteReportFlows(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	m := opstest.NewMockOFEntryOperations(ctrl)
@@ -2818,6 +3108,10 @@ func Test_client_ReplayFlows(t *testing.T) {
 
 	expectedFlows = append(expectedFlows, replayedFlows...)
 
+	// No flow of the current round is present on the bridge, e.g. when ovs-vswitchd has been restarted, so all the
+	// entries are replayed.
+	cookieID, cookieMask := cookie.CookieMaskForRound(fc.roundInfo.RoundNum)
+	bridge.EXPECT().DumpFlowMods(cookieID, cookieMask).Return(nil, nil).Times(1)
 	bridge.EXPECT().DeleteGroupAll().Return(nil).Times(1)
 	bridge.EXPECT().DeleteMeterAll().Return(nil).Times(1)
 
@@ -2908,6 +3202,102 @@ func TestCachedFlowIsDrop(t *testing.T) {
 	assert.False(t, isDropFlow(msg))
 }
 
+func TestDiffFlows(t *testing.T) {
+	_, ipCIDR1, _ := net.ParseCIDR("192.168.2.30/32")
+	_, ipCIDR2, _ := net.ParseCIDR("192.168.2.31/32")
+	_, ipCIDR3, _ := net.ParseCIDR("192.168.2.32/32")
+	buildFlow := func(ipCIDR *net.IPNet, drop bool) *openflow15.FlowMod {
+		builder := EgressDefaultTable.ofTable.BuildFlow(priority100).MatchDstIPNet(*ipCIDR).Action()
+		var flow binding.Flow
+		if drop {
+			flow = builder.Drop().Done()
+		} else {
+			flow = builder.GotoTable(1).Done()
+		}
+		return getFlowModMessage(flow, binding.AddMessage)
+	}
+	unchangedFlow := buildFlow(ipCIDR1, true)
+	desiredModifiedFlow := buildFlow(ipCIDR2, true)
+	actualModifiedFlow := buildFlow(ipCIDR2, false)
+	missingFlow := buildFlow(ipCIDR3, true)
+	unexpectedFlow := getFlowModMessage(EgressDefaultTable.ofTable.BuildFlow(priority200).MatchDstIPNet(*ipCIDR1).Action().Drop().Done(), binding.AddMessage)
+
+	var diffs []types.DatapathEntryDiff
+	for _, diff := range diffFlows(
+		[]*openflow15.FlowMod{unchangedFlow, desiredModifiedFlow, missingFlow},
+		[]*openflow15.FlowMod{unchangedFlow, actualModifiedFlow, unexpectedFlow},
+	) {
+		diffs = append(diffs, diff.datapathEntryDiff())
+	}
+	assert.ElementsMatch(t, []types.DatapathEntryDiff{
+		{
+			Type:    types.DatapathEntryFlow,
+			State:   types.DatapathDiffModified,
+			Desired: binding.FlowModToString(desiredModifiedFlow),
+			Actual:  binding.FlowModToString(actualModifiedFlow),
+		},
+		{
+			Type:    types.DatapathEntryFlow,
+			State:   types.DatapathDiffMissing,
+			Desired: binding.FlowModToString(missingFlow),
+		},
+		{
+			Type:   types.DatapathEntryFlow,
+			State:  types.DatapathDiffUnexpected,
+			Actual: binding.FlowModToString(unexpectedFlow),
+		},
+	}, diffs)
+}
+
+func TestGetFlowModChanges(t *testing.T) {
+	_, ipCIDR1, _ := net.ParseCIDR("192.168.2.30/32")
+	_, ipCIDR2, _ := net.ParseCIDR("192.168.2.31/32")
+	_, ipCIDR3, _ := net.ParseCIDR("192.168.2.32/32")
+	buildFlow := func(ipCIDR *net.IPNet, drop bool) *openflow15.FlowMod {
+		builder := EgressDefaultTable.ofTable.BuildFlow(priority100).MatchDstIPNet(*ipCIDR).Action()
+		var flow binding.Flow
+		if drop {
+			flow = builder.Drop().Done()
+		} else {
+			flow = builder.GotoTable(1).Done()
+		}
+		return getFlowModMessage(flow, binding.AddMessage)
+	}
+	unchangedFlow := buildFlow(ipCIDR1, true)
+	desiredModifiedFlow := buildFlow(ipCIDR2, true)
+	actualModifiedFlow := buildFlow(ipCIDR2, false)
+	missingFlow := buildFlow(ipCIDR3, true)
+	unexpectedFlow := getFlowModMessage(EgressDefaultTable.ofTable.BuildFlow(priority200).MatchDstIPNet(*ipCIDR1).Action().Drop().Done(), binding.AddMessage)
+
+	addFlows, modFlows, delFlows := getFlowModChanges(
+		[]*openflow15.FlowMod{unchangedFlow, desiredModifiedFlow, missingFlow},
+		[]*openflow15.FlowMod{unchangedFlow, actualModifiedFlow, unexpectedFlow},
+	)
+	assert.Equal(t, []*openflow15.FlowMod{missingFlow}, addFlows)
+	assert.Equal(t, []*openflow15.FlowMod{desiredModifiedFlow}, modFlows)
+	assert.Equal(t, []*openflow15.FlowMod{unexpectedFlow}, delFlows)
+}
+
+func TestDiffGroups(t *testing.T) {
+	actualGroups := []string{
+		"group_id=1,type=all,bucket=bucket_id:0,actions=resubmit:EgressRule",
+		"group_id=3,type=select,bucket=bucket_id:0,actions=resubmit:EndpointDNAT",
+	}
+	diffs := diffGroups(sets.New[binding.GroupIDType](1, 2), actualGroups)
+	assert.Equal(t, []types.DatapathEntryDiff{
+		{
+			Type:   types.DatapathEntryGroup,
+			State:  types.DatapathDiffUnexpected,
+			Actual: "group_id=3,type=select,bucket=bucket_id:0,actions=resubmit:EndpointDNAT",
+		},
+		{
+			Type:    types.DatapathEntryGroup,
+			State:   types.DatapathDiffMissing,
+			Desired: "group_id=2",
+		},
+	}, diffs)
+}
+
 func TestSubscribeOFPortStatusMessage(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ch := make(chan *openflow15.PortStatus)
@@ -2945,3 +3335,164 @@ func Test_client_InstallL7NetworkPolicyFlows(t *testing.T) {
 	require.True(t, ok)
 	assert.ElementsMatch(t, expectedFlows, getFlowStrings(fCacheI))
 }
+
+func TestParseDropFlows(t *testing.T) {
+	tests := []struct {
+		name       string
+		flows      []string
+		isDropFlow func(flow string, flowMap map[string]string) bool
+		want       map[string]uint64
+	}{
+		{
+			name: "AntreaPolicy deny",
+			flows: []string{
+				"table=IngressMetric, n_packets=0, n_bytes=0, hard_timeout=300, priority=202,ip,reg0=0x100000/0x100000,reg3=0x3,nw_tos=28 actions=controller(max_len=65535,id=15768)",
+				"table=IngressMetric, n_packets=11, n_bytes=1661, priority=200,ct_state=-new,ct_label=0x1/0xffffffff,ip actions=goto_table:IngressConntrack",
+				"table=IngressMetric, n_packets=4, n_bytes=338, priority=200,reg0=0x100000/0x100000,reg3=0xb actions=drop",
+				"table=IngressMetric, n_packets=2, n_bytes=148, priority=200,reg0=0x100000/0x100000,reg3=0xc actions=drop",
+				"table=IngressMetric, n_packets=1407190, n_bytes=509746586, priority=0 actions=goto_table:IngressConntrack",
+			},
+			isDropFlow: isDropActionFlow,
+			want: map[string]uint64{
+				"table=IngressMetric, priority=200,reg0=0x100000/0x100000,reg3=0xb actions=drop": 4,
+				"table=IngressMetric, priority=200,reg0=0x100000/0x100000,reg3=0xc actions=drop": 2,
+			},
+		},
+		{
+			name: "K8s NetworkPolicy isolation",
+			flows: []string{
+				"table=IngressDefaultRule, n_packets=3, n_bytes=222, priority=200,reg1=0x5 actions=drop",
+				"table=IngressDefaultRule, n_packets=1, n_bytes=74, priority=200,reg1=0x6 actions=set_field:0x400/0x400->reg0,set_field:0x8000000/0x8000000->reg4,goto_table:Output",
+				"table=IngressDefaultRule, n_packets=0, n_bytes=0, hard_timeout=300, priority=202,ip,reg1=0x5,nw_tos=28 actions=controller(max_len=65535,id=15768)",
+				"table=IngressDefaultRule, n_packets=1407190, n_bytes=509746586, priority=0 actions=goto_table:IngressMetric",
+			},
+			isDropFlow: isIsolationDropFlow,
+			want: map[string]uint64{
+				"table=IngressDefaultRule, priority=200,reg1=0x5 actions=drop":                                                                              3,
+				"table=IngressDefaultRule, priority=200,reg1=0x6 actions=set_field:0x400/0x400->reg0,set_field:0x8000000/0x8000000->reg4,goto_table:Output": 1,
+			},
+		},
+		{
+			name: "invalid connection state",
+			flows: []string{
+				"table=ConntrackState, n_packets=5, n_bytes=300, priority=200,ct_state=+inv+trk,ip actions=drop",
+				"table=ConntrackState, n_packets=0, n_bytes=0, hard_timeout=300, priority=192,ct_state=+rpl+trk,ip,nw_tos=28 actions=drop",
+				"table=ConntrackState, n_packets=120, n_bytes=9600, priority=0 actions=goto_table:PreRoutingClassifier",
+			},
+			isDropFlow: isInvalidConnStateDropFlow,
+			want: map[string]uint64{
+				"table=ConntrackState, priority=200,ct_state=+inv+trk,ip actions=drop": 5,
+			},
+		},
+		{
+			name: "no matching connection",
+			flows: []string{
+				"table=EgressMark, n_packets=8, n_bytes=592, priority=190,ct_state=+new+trk,ip,reg0=0/0xf actions=drop",
+				"table=EgressMark, n_packets=30, n_bytes=2220, priority=200,ct_state=+new+trk,ip,tun_dst=192.168.77.113 actions=set_field:0x1/0xff->pkt_mark,set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+				"table=EgressMark, n_packets=96, n_bytes=7104, priority=0 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
+			},
+			isDropFlow: isNoMatchingConnectionDropFlow,
+			want: map[string]uint64{
+				"table=EgressMark, priority=190,ct_state=+new+trk,ip,reg0=0/0xf actions=drop": 8,
+			},
+		},
+		{
+			name: "DNS resolver restriction",
+			flows: []string{
+				"table=EgressSecurityClassifier, n_packets=3, n_bytes=222, priority=200,ct_state=+new+trk,ct_nw_proto=17,ct_tp_dst=53,udp,in_port=5 actions=drop",
+				"table=EgressSecurityClassifier, n_packets=1, n_bytes=80, priority=200,ct_state=+new+trk,ct_nw_proto=6,ct_tp_dst=53,tcp,in_port=5 actions=drop",
+				"table=EgressSecurityClassifier, n_packets=7, n_bytes=518, priority=210,ct_state=+new+trk,ct_nw_proto=17,ct_tp_dst=53,udp,in_port=5,nw_dst=10.96.0.10 actions=goto_table:EgressRule",
+			},
+			isDropFlow: isDNSResolverDropFlow,
+			want: map[string]uint64{
+				"table=EgressSecurityClassifier, priority=200,ct_state=+new+trk,ct_nw_proto=17,ct_tp_dst=53,udp,in_port=5 actions=drop": 3,
+				"table=EgressSecurityClassifier, priority=200,ct_state=+new+trk,ct_nw_proto=6,ct_tp_dst=53,tcp,in_port=5 actions=drop":  1,
+			},
+		},
+		{
+			name: "no dropped packet",
+			flows: []string{
+				"table=SpoofGuard, n_packets=30, n_bytes=2220, priority=200,ip,in_port=5,dl_src=0a:58:0a:0a:00:05,nw_src=10.10.0.5 actions=goto_table:UnSNAT",
+				"table=SpoofGuard, n_packets=0, n_bytes=0, priority=0 actions=drop",
+			},
+			isDropFlow: isDropActionFlow,
+			want: map[string]uint64{
+				"table=SpoofGuard, priority=0 actions=drop": 0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseDropFlows(tt.flows, tt.isDropFlow))
+		})
+	}
+}
+
+func TestParsePortTxErrors(t *testing.T) {
+	portsDump := `OFPST_PORT reply (OF1.5) (xid=0x2): 3 ports
+  port  "antrea-gw0": rx pkts=41, bytes=3178, drop=0, errs=0, frame=0, over=0, crc=0
+           tx pkts=46, bytes=3584, drop=0, errs=2, coll=0
+           duration=1234.567s
+  port  "antrea-tun0": rx pkts=120, bytes=9600, drop=0, errs=0, frame=0, over=0, crc=0
+           tx pkts=130, bytes=10400, drop=0, errs=5, coll=0
+           duration=1234.567s
+  port  5: rx pkts=30, bytes=2220, drop=0, errs=0, frame=0, over=0, crc=0
+           tx pkts=28, bytes=2072, drop=3, errs=0, coll=0
+           duration=1200.123s
+`
+	assert.Equal(t, map[string]uint64{
+		`"antrea-gw0"`:  2,
+		`"antrea-tun0"`: 5,
+		"5":             0,
+	}, parsePortTxErrors(portsDump))
+}
+
+func TestGetNewlyDroppedPackets(t *testing.T) {
+	flow1 := droppedPacketsKey{reason: metrics.LabelDropReasonSpoofGuard, source: "table=SpoofGuard, priority=0 actions=drop"}
+	flow2 := droppedPacketsKey{reason: metrics.LabelDropReasonSpoofGuard, source: "table=ARPSpoofGuard, priority=0 actions=drop"}
+	port := droppedPacketsKey{reason: metrics.LabelDropReasonMTUExceeded, source: `"antrea-tun0"`}
+	tests := []struct {
+		name     string
+		previous map[droppedPacketsKey]uint64
+		current  map[droppedPacketsKey]uint64
+		want     map[string]uint64
+	}{
+		{
+			name:    "first collection",
+			current: map[droppedPacketsKey]uint64{flow1: 3, flow2: 2, port: 1},
+			want: map[string]uint64{
+				metrics.LabelDropReasonSpoofGuard:  5,
+				metrics.LabelDropReasonMTUExceeded: 1,
+			},
+		},
+		{
+			name:     "increased statistics",
+			previous: map[droppedPacketsKey]uint64{flow1: 3, flow2: 2, port: 1},
+			current:  map[droppedPacketsKey]uint64{flow1: 5, flow2: 2, port: 1},
+			want: map[string]uint64{
+				metrics.LabelDropReasonSpoofGuard: 2,
+			},
+		},
+		{
+			name:     "reinstalled flow",
+			previous: map[droppedPacketsKey]uint64{flow1: 5, flow2: 2},
+			current:  map[droppedPacketsKey]uint64{flow1: 1, flow2: 4},
+			want: map[string]uint64{
+				metrics.LabelDropReasonSpoofGuard: 3,
+			},
+		},
+		{
+			name:     "new and deleted flows",
+			previous: map[droppedPacketsKey]uint64{flow1: 5},
+			current:  map[droppedPacketsKey]uint64{flow2: 4},
+			want: map[string]uint64{
+				metrics.LabelDropReasonSpoofGuard: 4,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, getNewlyDroppedPackets(tt.previous, tt.current))
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/openflow/cookie"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
+	"antrea.io/antrea/pkg/util/runtime"
 )
 
 type featureEgress struct {
@@ -35,6 +36,13 @@ type featureEgress struct {
 	exceptCIDRs map[binding.Protocol][]net.IPNet
 	nodeIPs     map[binding.Protocol]net.IP
 	gatewayMAC  net.HardwareAddr
+	// The following fields are only used on Windows, where SNAT is performed in OVS and the SNAT'd packets are output
+	// to the uplink directly.
+	ctZones          map[binding.Protocol]int
+	snatCtZones      map[binding.Protocol]int
+	uplinkPort       uint32
+	uplinkMAC        net.HardwareAddr
+	uplinkGatewayMAC net.HardwareAddr
 
 	category                   cookie.Category
 	enableEgressTrafficShaping bool
@@ -59,15 +67,21 @@ func newFeatureEgress(cookieAllocator cookie.Allocator,
 	}
 
 	nodeIPs := make(map[binding.Protocol]net.IP)
+	ctZones := make(map[binding.Protocol]int)
+	snatCtZones := make(map[binding.Protocol]int)
 	for _, ipProtocol := range ipProtocols {
 		switch ipProtocol {
 		case binding.ProtocolIP:
 			nodeIPs[ipProtocol] = nodeConfig.NodeIPv4Addr.IP
+			ctZones[ipProtocol] = CtZone
+			snatCtZones[ipProtocol] = SNATCtZone
 		case binding.ProtocolIPv6:
 			nodeIPs[ipProtocol] = nodeConfig.NodeIPv6Addr.IP
+			ctZones[ipProtocol] = CtZoneV6
+			snatCtZones[ipProtocol] = SNATCtZoneV6
 		}
 	}
-	return &featureEgress{
+	f := &featureEgress{
 		cachedFlows:                newFlowCategoryCache(),
 		cachedMeter:                sync.Map{},
 		cookieAllocator:            cookieAllocator,
@@ -75,15 +89,26 @@ func newFeatureEgress(cookieAllocator cookie.Allocator,
 		ipProtocols:                ipProtocols,
 		nodeIPs:                    nodeIPs,
 		gatewayMAC:                 nodeConfig.GatewayConfig.MAC,
+		ctZones:                    ctZones,
+		snatCtZones:                snatCtZones,
 		category:                   cookie.Egress,
 		enableEgressTrafficShaping: enableEgressTrafficShaping,
 	}
+	if nodeConfig.UplinkNetConfig != nil {
+		f.uplinkPort = nodeConfig.UplinkNetConfig.OFPort
+		f.uplinkMAC = nodeConfig.UplinkNetConfig.MAC
+		f.uplinkGatewayMAC = nodeConfig.UplinkNetConfig.GatewayMAC
+	}
+	return f
 }
 
 func (f *featureEgress) initFlows() []*openflow15.FlowMod {
 	// This installs the flows to enable Pods to communicate to the external IP addresses. The flows identify the packets
 	// from local Pods to the external IP address, and mark the packets to be SNAT'd with the configured SNAT IPs.
 	initialFlows := f.externalFlows()
+	if runtime.IsWindowsPlatform() {
+		initialFlows = append(initialFlows, f.snatOutputFlows()...)
+	}
 	if f.enableEgressTrafficShaping {
 		initialFlows = append(initialFlows, f.egressQoSDefaultFlow())
 	}
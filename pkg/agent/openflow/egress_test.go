@@ -20,27 +20,34 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/util/runtime"
 )
 
 func egressInitFlows(isIPv4 bool) []string {
+	var flows []string
+	if runtime.IsWindowsPlatform() {
+		flows = append(flows,
+			"cookie=0x1040000000000, table=L2ForwardingCalc, priority=200,reg0=0x40/0xf0,dl_dst=0a:00:00:00:00:03 actions=set_field:0x8002->reg1,set_field:0x200000/0x600000->reg0,goto_table:ConntrackCommit",
+		)
+	}
 	if isIPv4 {
-		return []string{
+		return append(flows,
 			"cookie=0x1040000000000, table=L3Forwarding, priority=190,ct_state=-rpl+trk,ip,reg0=0x3/0xf,reg4=0x0/0x100000 actions=goto_table:EgressMark",
 			"cookie=0x1040000000000, table=L3Forwarding, priority=190,ct_state=-rpl+trk,ip,reg0=0x1/0xf actions=set_field:0a:00:00:00:00:01->eth_dst,goto_table:EgressMark",
 			"cookie=0x1040000000000, table=EgressMark, priority=210,ip,nw_dst=192.168.78.0/24 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 			"cookie=0x1040000000000, table=EgressMark, priority=210,ip,nw_dst=192.168.77.100 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 			"cookie=0x1040000000000, table=EgressMark, priority=190,ct_state=+new+trk,ip,reg0=0x1/0xf actions=drop",
 			"cookie=0x1040000000000, table=EgressMark, priority=0 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
-		}
+		)
 	}
-	return []string{
+	return append(flows,
 		"cookie=0x1040000000000, table=L3Forwarding, priority=190,ct_state=-rpl+trk,ipv6,reg0=0x3/0xf,reg4=0x0/0x100000 actions=goto_table:EgressMark",
 		"cookie=0x1040000000000, table=L3Forwarding, priority=190,ct_state=-rpl+trk,ipv6,reg0=0x1/0xf actions=set_field:0a:00:00:00:00:01->eth_dst,goto_table:EgressMark",
 		"cookie=0x1040000000000, table=EgressMark, priority=210,ipv6,ipv6_dst=fec0:192:168:78::/80 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 		"cookie=0x1040000000000, table=EgressMark, priority=210,ipv6,ipv6_dst=fec0:192:168:77::100 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
 		"cookie=0x1040000000000, table=EgressMark, priority=190,ct_state=+new+trk,ipv6,reg0=0x1/0xf actions=drop",
 		"cookie=0x1040000000000, table=EgressMark, priority=0 actions=set_field:0x20/0xf0->reg0,goto_table:L2ForwardingCalc",
-	}
+	)
 }
 
 func Test_featureEgress_initFlows(t *testing.T) {
@@ -155,6 +155,9 @@ var (
 	FromExternalRegMark = binding.NewOneBitRegMark(4, 27)
 	// reg4[28]: Mark to indicate that whether the traffic's source is a local Pod or the Node.
 	FromLocalRegMark = binding.NewOneBitRegMark(4, 28)
+	// reg4[29]: Mark to indicate that the client IP of the Service's hairpin connections initiated through the Antrea
+	// gateway should be preserved.
+	PreserveHairpinClientIPRegMark = binding.NewOneBitRegMark(4, 29)
 
 	// reg5(NXM_NX_REG5)
 	// Field to cache the Egress conjunction ID hit by TraceFlow packet.
@@ -210,7 +213,7 @@ var (
 	ServiceCTMark    = binding.NewOneBitCTMark(4)
 	NotServiceCTMark = binding.NewOneBitZeroCTMark(4)
 
-	// CTMark[5]: Mark to indicate SNAT is performed on the connection for Service.
+	// CTMark[5]: Mark to indicate SNAT is performed on the connection for Service, or for Egress on Windows.
 	// This CT mark is only used in CtZone / CtZoneV6.
 	ConnSNATCTMark = binding.NewOneBitCTMark(5)
 
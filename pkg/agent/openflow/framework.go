@@ -19,6 +19,7 @@ import (
 
 	"antrea.io/antrea/pkg/agent/config"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
+	"antrea.io/antrea/pkg/util/runtime"
 )
 
 // OVS pipelines are generated by a framework called FlexiblePipeline. There are some abstractions introduced in this
@@ -239,7 +240,7 @@ func (f *featureNetworkPolicy) getRequiredTables() []*Table {
 			)
 		}
 	}
-	if f.nodeType == config.ExternalNode {
+	if f.nodeType == config.ExternalNode || f.enableDNSResolverRestriction {
 		tables = append(tables,
 			EgressSecurityClassifierTable,
 		)
@@ -280,6 +281,10 @@ func (f *featureEgress) getRequiredTables() []*Table {
 	if f.enableEgressTrafficShaping {
 		tables = append(tables, EgressQoSTable)
 	}
+	if runtime.IsWindowsPlatform() {
+		// SNAT is performed in OVS on Windows.
+		tables = append(tables, UnSNATTable, SNATMarkTable, SNATTable)
+	}
 	return tables
 }
 
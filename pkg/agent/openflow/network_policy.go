@@ -20,6 +20,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"antrea.io/libOpenflow/openflow15"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -2027,6 +2028,67 @@ func (c *client) MulticastEgressPodMetricsByIP(ip net.IP) *types.RuleMetric {
 	return &metric
 }
 
+func (c *client) MulticastGroupMetrics() map[string]*types.RuleHitStats {
+	routingFlows, _ := c.ovsctlClient.DumpTableFlows(MulticastRoutingTable.ofTable.GetID())
+	return parseMulticastGroupFlows(routingFlows, time.Now())
+}
+
+// parseMulticastGroupFlows collects the statistics of the flows forwarding the traffic of a multicast group, which
+// match the group IP as the destination. The statistics of the flows forwarding the traffic of a multicast group from
+// specific sources are merged into the statistics of the group. The other flows in MulticastRoutingTable are ignored.
+func parseMulticastGroupFlows(flows []string, now time.Time) map[string]*types.RuleHitStats {
+	// example MulticastRouting flow format:
+	// table=MulticastRouting, n_packets=1020, n_bytes=102000, idle_age=3, priority=200,ip,nw_dst=225.1.2.3 actions=group:1026
+	// table=MulticastRouting, n_packets=20, n_bytes=2000, idle_age=1, priority=201,ip,nw_src=10.10.0.5,nw_dst=232.1.2.3 actions=group:1027
+	result := map[string]*types.RuleHitStats{}
+	for _, flow := range flows {
+		flowMap := parseFlowToMap(flow)
+		group, ok := flowMap["nw_dst"]
+		if !ok {
+			continue
+		}
+		stats := &types.RuleHitStats{RuleMetric: parseFlowMetric(flowMap)}
+		if stats.Packets > 0 {
+			if idleAge, err := strconv.Atoi(flowMap["idle_age"]); err == nil {
+				stats.LastHitTime = now.Add(-time.Duration(idleAge) * time.Second).Truncate(time.Second)
+			}
+		}
+		if groupStats, ok := result[group]; ok {
+			groupStats.Merge(&stats.RuleMetric)
+			if stats.LastHitTime.After(groupStats.LastHitTime) {
+				groupStats.LastHitTime = stats.LastHitTime
+			}
+		} else {
+			result[group] = stats
+		}
+	}
+	return result
+}
+
+func (c *client) DNSResolverDropMetrics() map[uint32]*types.RuleMetric {
+	result := map[uint32]*types.RuleMetric{}
+	// example EgressSecurityClassifier drop flow format:
+	// table=EgressSecurityClassifier, n_packets=3, n_bytes=222, priority=200,ct_state=+new+trk,ct_nw_proto=17,ct_tp_dst=53,udp,in_port=5 actions=drop
+	flows, _ := c.ovsctlClient.DumpTableFlows(EgressSecurityClassifierTable.ofTable.GetID())
+	for _, flow := range flows {
+		if !strings.HasSuffix(flow, "actions=drop") || !strings.Contains(flow, "ct_tp_dst=53") {
+			continue
+		}
+		flowMap := parseFlowToMap(flow)
+		ofPort, err := strconv.ParseUint(flowMap["in_port"], 10, 32)
+		if err != nil {
+			continue
+		}
+		metric := parseFlowMetric(flowMap)
+		if accMetric, ok := result[uint32(ofPort)]; ok {
+			accMetric.Merge(&metric)
+		} else {
+			result[uint32(ofPort)] = &metric
+		}
+	}
+	return result
+}
+
 func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	result := map[uint32]*types.RuleMetric{}
 	collectMetricsFromFlows := func(table *Table, getMetricAndID func(flowMap map[string]string) (uint32, types.RuleMetric)) {
@@ -2060,6 +2122,38 @@ func (c *client) NetworkPolicyMetrics() map[uint32]*types.RuleMetric {
 	return result
 }
 
+func (c *client) NetworkPolicyRuleHitStats(ruleID uint32) *types.RuleHitStats {
+	stats := &types.RuleHitStats{}
+	now := time.Now()
+	// idle_age is the number of seconds since a packet last matched the flow, the rule was last hit when any of its
+	// metric flows was.
+	minIdleAge := -1
+	for _, table := range []*Table{EgressMetricTable, IngressMetricTable} {
+		dumpedFlows, _ := c.ovsctlClient.DumpTableFlows(table.ofTable.GetID())
+		for _, flow := range dumpedFlows {
+			if !strings.Contains(flow, metricFlowIdentifier) {
+				continue
+			}
+			flowMap := parseFlowToMap(flow)
+			id, metric := parseMetricFlow(flowMap)
+			if id != ruleID {
+				continue
+			}
+			stats.Merge(&metric)
+			if metric.Packets == 0 {
+				continue
+			}
+			if idleAge, err := strconv.Atoi(flowMap["idle_age"]); err == nil && (minIdleAge < 0 || idleAge < minIdleAge) {
+				minIdleAge = idleAge
+			}
+		}
+	}
+	if minIdleAge >= 0 {
+		stats.LastHitTime = now.Add(-time.Duration(minIdleAge) * time.Second).Truncate(time.Second)
+	}
+	return stats
+}
+
 type featureNetworkPolicy struct {
 	cookieAllocator       cookie.Allocator
 	ipProtocols           []binding.Protocol
@@ -2091,7 +2185,10 @@ type featureNetworkPolicy struct {
 	enableL7NetworkPolicy bool
 	enableMulticast       bool
 	proxyAll              bool
-	ctZoneSrcField        *binding.RegField
+	// enableDNSResolverRestriction enables restricting the DNS queries of Pods to the allowed resolvers, in
+	// EgressSecurityClassifierTable.
+	enableDNSResolverRestriction bool
+	ctZoneSrcField               *binding.RegField
 	// deterministic represents whether to generate flows deterministically.
 	// For example, if a flow has multiple actions, setting it to true can get consistent flow.
 	// Enabling it may carry a performance impact. It's disabled by default and should only be used in testing.
@@ -2116,27 +2213,29 @@ func newFeatureNetworkPolicy(
 	enableMulticast bool,
 	proxyAll bool,
 	connectUplinkToBridge bool,
+	enableDNSResolverRestriction bool,
 	nodeType config.NodeType,
 	grpAllocator GroupAllocator) *featureNetworkPolicy {
 	return &featureNetworkPolicy{
-		cookieAllocator:          cookieAllocator,
-		ipProtocols:              ipProtocols,
-		bridge:                   bridge,
-		nodeType:                 nodeType,
-		enableL7NetworkPolicy:    enableL7NetworkPolicy,
-		l7NetworkPolicyConfig:    l7NetworkPolicyConfig,
-		globalConjMatchFlowCache: make(map[string]*conjMatchFlowContext),
-		policyCache:              cache.NewIndexer(policyConjKeyFunc, cache.Indexers{priorityIndex: priorityIndexFunc}),
-		enableMulticast:          enableMulticast,
-		ovsMetersAreSupported:    ovsMetersAreSupported,
-		enableDenyTracking:       enableDenyTracking,
-		enableAntreaPolicy:       enableAntreaPolicy,
-		proxyAll:                 proxyAll,
-		category:                 cookie.NetworkPolicy,
-		ctZoneSrcField:           getZoneSrcField(connectUplinkToBridge),
-		loggingGroupCache:        sync.Map{},
-		cachedFlows:              newFlowCategoryCache(),
-		groupAllocator:           grpAllocator,
+		cookieAllocator:              cookieAllocator,
+		ipProtocols:                  ipProtocols,
+		bridge:                       bridge,
+		nodeType:                     nodeType,
+		enableL7NetworkPolicy:        enableL7NetworkPolicy,
+		l7NetworkPolicyConfig:        l7NetworkPolicyConfig,
+		globalConjMatchFlowCache:     make(map[string]*conjMatchFlowContext),
+		policyCache:                  cache.NewIndexer(policyConjKeyFunc, cache.Indexers{priorityIndex: priorityIndexFunc}),
+		enableMulticast:              enableMulticast,
+		ovsMetersAreSupported:        ovsMetersAreSupported,
+		enableDenyTracking:           enableDenyTracking,
+		enableAntreaPolicy:           enableAntreaPolicy,
+		proxyAll:                     proxyAll,
+		enableDNSResolverRestriction: enableDNSResolverRestriction,
+		category:                     cookie.NetworkPolicy,
+		ctZoneSrcField:               getZoneSrcField(connectUplinkToBridge),
+		loggingGroupCache:            sync.Map{},
+		cachedFlows:                  newFlowCategoryCache(),
+		groupAllocator:               grpAllocator,
 	}
 }
 
@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"antrea.io/libOpenflow/openflow15"
 	"antrea.io/ofnet/ofctrl"
@@ -1194,6 +1195,96 @@ func TestNetworkPolicyMetrics(t *testing.T) {
 	}
 }
 
+func TestNetworkPolicyRuleHitStats(t *testing.T) {
+	egressFlows := []string{
+		"table=61, n_packets=0, n_bytes=0, hard_timeout=300, priority=202,ip,reg0=0x100000/0x100000,reg3=0x4,nw_tos=28 actions=controller(max_len=65535,id=15768)",
+		"table=61, n_packets=1, n_bytes=74, idle_age=30, priority=200,ct_state=+new,ct_label=0x200000000/0xffffffff00000000,ip actions=goto_table:70",
+		"table=61, n_packets=11, n_bytes=1661, idle_age=5, priority=200,ct_state=-new,ct_label=0x200000000/0xffffffff00000000,ip actions=goto_table:70",
+		"table=61, n_packets=4, n_bytes=336, idle_age=10, priority=200,reg0=0x100000/0x100000,reg3=0x4 actions=drop",
+		"table=61, n_packets=1502362, n_bytes=601635949, idle_age=0, priority=0 actions=goto_table:70",
+	}
+	ingressFlows := []string{
+		"table=101, n_packets=0, n_bytes=0, idle_age=100, priority=200,ct_state=+new,ct_label=0x1/0xffffffff,ip actions=resubmit(,105)",
+		"table=101, n_packets=0, n_bytes=0, idle_age=100, priority=200,ct_state=-new,ct_label=0x1/0xffffffff,ip actions=resubmit(,105)",
+		"table=101, n_packets=1407190, n_bytes=509746586, idle_age=0, priority=0 actions=resubmit(,105)",
+	}
+	tests := []struct {
+		name            string
+		ruleID          uint32
+		expectedMetric  types.RuleMetric
+		expectedIdleAge time.Duration
+		expectedNoHit   bool
+	}{
+		{
+			name:            "allow rule",
+			ruleID:          2,
+			expectedMetric:  types.RuleMetric{Bytes: 1735, Sessions: 1, Packets: 12},
+			expectedIdleAge: 5 * time.Second,
+		},
+		{
+			name:            "drop rule",
+			ruleID:          4,
+			expectedMetric:  types.RuleMetric{Bytes: 336, Sessions: 4, Packets: 4},
+			expectedIdleAge: 10 * time.Second,
+		},
+		{
+			name:          "rule never hit",
+			ruleID:        1,
+			expectedNoHit: true,
+		},
+		{
+			name:          "unknown rule",
+			ruleID:        10,
+			expectedNoHit: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			preparePipelines()
+			defer resetPipelines()
+			c = prepareClient(ctrl, false)
+			mockOVSClient := ovsctltest.NewMockOVSCtlClient(ctrl)
+			c.ovsctlClient = mockOVSClient
+			gomock.InOrder(
+				mockOVSClient.EXPECT().DumpTableFlows(EgressMetricTable.ofTable.GetID()).Return(egressFlows, nil),
+				mockOVSClient.EXPECT().DumpTableFlows(IngressMetricTable.ofTable.GetID()).Return(ingressFlows, nil),
+			)
+			start := time.Now()
+			got := c.NetworkPolicyRuleHitStats(tt.ruleID)
+			assert.Equal(t, tt.expectedMetric, got.RuleMetric)
+			if tt.expectedNoHit {
+				assert.True(t, got.LastHitTime.IsZero())
+			} else {
+				assert.WithinDuration(t, start.Add(-tt.expectedIdleAge), got.LastHitTime, 2*time.Second)
+			}
+		})
+	}
+}
+
+func TestParseMulticastGroupFlows(t *testing.T) {
+	flows := []string{
+		"table=MulticastRouting, n_packets=1020, n_bytes=102000, idle_age=3, priority=200,ip,nw_dst=225.1.2.3 actions=group:1026",
+		"table=MulticastRouting, n_packets=0, n_bytes=0, idle_age=120, priority=200,ip,nw_dst=225.1.2.4 actions=group:1027",
+		"table=MulticastRouting, n_packets=10, n_bytes=1000, idle_age=5, priority=200,ip,nw_dst=232.1.2.3 actions=group:1028",
+		"table=MulticastRouting, n_packets=20, n_bytes=2000, idle_age=1, priority=201,ip,nw_src=10.10.0.5,nw_dst=232.1.2.3 actions=group:1029",
+		"table=MulticastRouting, n_packets=15, n_bytes=1500, idle_age=1, priority=190,ip actions=output:2",
+	}
+	now := time.Now()
+	got := parseMulticastGroupFlows(flows, now)
+	assert.Equal(t, map[string]*types.RuleHitStats{
+		"225.1.2.3": {
+			RuleMetric:  types.RuleMetric{Packets: 1020, Bytes: 102000},
+			LastHitTime: now.Add(-3 * time.Second).Truncate(time.Second),
+		},
+		"225.1.2.4": {},
+		"232.1.2.3": {
+			RuleMetric:  types.RuleMetric{Packets: 30, Bytes: 3000},
+			LastHitTime: now.Add(-1 * time.Second).Truncate(time.Second),
+		},
+	}, got)
+}
+
 func TestGetMatchFlowUpdates(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	preparePipelines()
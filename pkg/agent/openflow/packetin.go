@@ -18,6 +18,8 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"runtime"
+	"strconv"
 
 	"antrea.io/libOpenflow/openflow15"
 	"antrea.io/libOpenflow/protocol"
@@ -26,6 +28,8 @@ import (
 	"golang.org/x/time/rate"
 	"k8s.io/klog/v2"
 
+	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/agent/util/numa"
 	"antrea.io/antrea/pkg/ovs/openflow"
 )
 
@@ -129,7 +133,35 @@ func (c *client) subscribeFeaturePacketIn(featurePacketIn *featureStartPacketIn)
 	return nil
 }
 
+// SetPacketInCPUAffinity sets the CPUs the threads processing packet-in messages are pinned to.
+func (c *client) SetPacketInCPUAffinity(cpus []int) {
+	c.packetInCPUs = cpus
+}
+
+// pinPacketInThread locks the calling goroutine to its OS thread and pins the thread to the
+// configured packet-in CPUs. The resulting placement is reported with a metric.
+func (c *client) pinPacketInThread(category uint8) {
+	runtime.LockOSThread()
+	categoryLabel := strconv.Itoa(int(category))
+	if err := numa.SetCurrentThreadAffinity(c.packetInCPUs); err != nil {
+		klog.ErrorS(err, "Failed to set CPU affinity of packetIn handler", "category", category, "cpus", c.packetInCPUs)
+		metrics.PacketInHandlerNUMAAligned.WithLabelValues(categoryLabel).Set(0)
+		return
+	}
+	cpus, err := numa.CurrentThreadCPUs()
+	if err != nil || !numa.IsSubset(cpus, c.packetInCPUs) {
+		klog.ErrorS(err, "CPU affinity of packetIn handler does not match the configuration", "category", category, "cpus", cpus, "expectedCPUs", c.packetInCPUs)
+		metrics.PacketInHandlerNUMAAligned.WithLabelValues(categoryLabel).Set(0)
+		return
+	}
+	klog.InfoS("Pinned packetIn handler to CPUs", "category", category, "cpus", cpus)
+	metrics.PacketInHandlerNUMAAligned.WithLabelValues(categoryLabel).Set(1)
+}
+
 func (c *client) parsePacketIn(featurePacketIn *featureStartPacketIn) {
+	if len(c.packetInCPUs) > 0 {
+		c.pinPacketInThread(featurePacketIn.category)
+	}
 	for {
 		pktIn := featurePacketIn.packetInQueue.GetRateLimited(featurePacketIn.stopCh)
 		if pktIn == nil {
@@ -393,25 +393,26 @@ type flowCategoryCache struct {
 }
 
 type client struct {
-	enableProxy                bool
-	proxyAll                   bool
-	enableDSR                  bool
-	enableAntreaPolicy         bool
-	enableL7NetworkPolicy      bool
-	enableDenyTracking         bool
-	enableEgress               bool
-	enableEgressTrafficShaping bool
-	enableMulticast            bool
-	enableTrafficControl       bool
-	enableL7FlowExporter       bool
-	enableMulticluster         bool
-	enablePrometheusMetrics    bool
-	connectUplinkToBridge      bool
-	nodeType                   config.NodeType
-	roundInfo                  types.RoundInfo
-	cookieAllocator            cookie.Allocator
-	bridge                     binding.Bridge
-	groupIDAllocator           GroupAllocator
+	enableProxy                  bool
+	proxyAll                     bool
+	enableDSR                    bool
+	enableAntreaPolicy           bool
+	enableL7NetworkPolicy        bool
+	enableDenyTracking           bool
+	enableEgress                 bool
+	enableEgressTrafficShaping   bool
+	enableMulticast              bool
+	enableTrafficControl         bool
+	enableL7FlowExporter         bool
+	enableMulticluster           bool
+	enablePrometheusMetrics      bool
+	enableDNSResolverRestriction bool
+	connectUplinkToBridge        bool
+	nodeType                     config.NodeType
+	roundInfo                    types.RoundInfo
+	cookieAllocator              cookie.Allocator
+	bridge                       binding.Bridge
+	groupIDAllocator             GroupAllocator
 
 	featurePodConnectivity          *featurePodConnectivity
 	featureService                  *featureService
@@ -448,10 +449,16 @@ type client struct {
 	// packetInHandlers stores handler to process PacketIn event. When a packetIn
 	// arrives, openflow send packet to registered handler in this map.
 	packetInHandlers map[uint8]PacketInHandler
+	// packetInCPUs stores the CPUs the threads processing packet-in messages are pinned to. It is
+	// empty if CPU affinity is not configured.
+	packetInCPUs []int
 	// Supported IP Protocols (IP or IPv6) on the current Node.
 	ipProtocols []binding.Protocol
 	// ovsctlClient is the interface for executing OVS "ovs-ofctl" and "ovs-appctl" commands.
 	ovsctlClient ovsctl.OVSCtlClient
+	// previousDroppedPackets stores the number of packets dropped by each drop flow and OVS port at the previous
+	// collection of the dropped packet statistics. It is only accessed by getDropStats.
+	previousDroppedPackets map[droppedPacketsKey]uint64
 
 	nodeIPChecker nodeip.Checker
 }
@@ -459,12 +466,14 @@ type client struct {
 func (c *client) Run(stopCh <-chan struct{}) {
 	// Start PacketIn
 	c.StartPacketInHandler(stopCh)
-	// Start OVS meter stats collection
+	// Start OVS meter and dropped packet stats collection
 	if c.enablePrometheusMetrics {
 		if c.ovsMetersAreSupported {
 			klog.Info("Start collecting OVS meter stats")
 			go wait.Until(c.getMeterStats, time.Second*30, stopCh)
 		}
+		klog.Info("Start collecting OVS dropped packet stats")
+		go wait.Until(c.getDropStats, time.Second*30, stopCh)
 	}
 }
 
@@ -552,18 +561,19 @@ func (f *featurePodConnectivity) gatewayClassifierFlows() []binding.Flow {
 }
 
 // podClassifierFlow generates the flow to mark the packets from a local Pod port.
-// If multi-cluster is enabled, also load podLabelID into LabelIDField.
-func (f *featurePodConnectivity) podClassifierFlow(podOFPort uint32, isAntreaFlexibleIPAM bool, podLabelID *uint32) binding.Flow {
+// If tunnelID is provided, i.e. the LabelIdentity of the Pod when multi-cluster StretchedNetworkPolicy is enabled, or
+// the VNI of its Namespace when Namespace tunnel VNI is enabled, also load it into the tunnel ID.
+func (f *featurePodConnectivity) podClassifierFlow(podOFPort uint32, isAntreaFlexibleIPAM bool, tunnelID *uint32) binding.Flow {
 	regMarksToLoad := []*binding.RegMark{FromPodRegMark, FromLocalRegMark}
 	if isAntreaFlexibleIPAM {
 		regMarksToLoad = append(regMarksToLoad, AntreaFlexibleIPAMRegMark, RewriteMACRegMark)
 	}
-	if podLabelID != nil {
+	if tunnelID != nil {
 		return ClassifierTable.ofTable.BuildFlow(priorityLow).
 			Cookie(f.cookieAllocator.Request(f.category).Raw()).
 			MatchInPort(podOFPort).
 			Action().LoadRegMark(regMarksToLoad...).
-			Action().SetTunnelID(uint64(*podLabelID)).
+			Action().SetTunnelID(uint64(*tunnelID)).
 			Action().GotoStage(stageValidation).
 			Done()
 	}
@@ -740,6 +750,22 @@ func (f *featureService) snatConntrackFlows() []binding.Flow {
 			// SNAT since HairpinCTMark loaded in DNAT CT zone also cannot be read in SNAT CT zone. HairpinCTMark is used
 			// to output packets of hairpin connections in OutputTable.
 
+			// This generates the flow to match the first packet of hairpin Service connection initiated through the Antrea
+			// gateway with ConnSNATCTMark and HairpinCTMark, and the Service requires the client IP to be preserved, then
+			// commit the connection in SNAT CT zone without performing SNAT. The connection is still committed with
+			// ServiceCTMark and HairpinCTMark so that the subsequent packets, which pass through SNAT CT zone because of
+			// ConnSNATCTMark, can be output in the same way as the SNAT'd hairpin connections.
+			SNATTable.ofTable.BuildFlow(priorityHigh).
+				Cookie(cookieID).
+				MatchProtocol(ipProtocol).
+				MatchCTStateNew(true).
+				MatchCTStateTrk(true).
+				MatchRegMark(FromGatewayRegMark, PreserveHairpinClientIPRegMark).
+				MatchCTMark(HairpinCTMark).
+				Action().CT(true, SNATTable.GetNext(), f.snatCtZones[ipProtocol], nil).
+				LoadToCtMark(ServiceCTMark, HairpinCTMark).
+				CTDone().
+				Done(),
 			// This generates the flow to match the first packet of hairpin Service connection initiated through the Antrea
 			// gateway with ConnSNATCTMark and HairpinCTMark, then perform SNAT in SNAT CT zone with a virtual IP.
 			SNATTable.ofTable.BuildFlow(priorityNormal).
@@ -849,6 +875,54 @@ func (f *featureService) snatConntrackFlows() []binding.Flow {
 	return flows
 }
 
+// externalTrafficSNATFlows generates the flows to SNAT the NodePort / LoadBalancer connections (non-hairpin) initiated
+// through the Antrea gateway with ConnSNATCTMark with the provided external traffic SNAT IP, instead of the Antrea
+// gateway IP, and to unSNAT their reply packets, which are forwarded back to this Node through tunnel by the remote
+// Nodes. The SNAT flow has a higher priority than the one using the Antrea gateway IP in snatConntrackFlows, and a
+// lower priority than the ones for hairpin connections.
+func (f *featureService) externalTrafficSNATFlows(snatIP net.IP) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	ipProtocol := getIPProtocol(snatIP)
+	return []binding.Flow{
+		SNATTable.ofTable.BuildFlow(priorityLow+1).
+			Cookie(cookieID).
+			MatchProtocol(ipProtocol).
+			MatchCTStateNew(true).
+			MatchCTStateTrk(true).
+			MatchRegMark(FromGatewayRegMark).
+			MatchCTMark(ConnSNATCTMark).
+			Action().CT(true, SNATTable.GetNext(), f.snatCtZones[ipProtocol], nil).
+			SNAT(&binding.IPRange{StartIP: snatIP, EndIP: snatIP}, nil).
+			LoadToCtMark(ServiceCTMark).
+			CTDone().
+			Done(),
+		UnSNATTable.ofTable.BuildFlow(priorityNormal).
+			Cookie(cookieID).
+			MatchProtocol(ipProtocol).
+			MatchDstIP(snatIP).
+			Action().CT(false, UnSNATTable.GetNext(), f.snatCtZones[ipProtocol], nil).
+			NAT().
+			CTDone().
+			Done(),
+	}
+}
+
+// l3FwdFlowToRemoteExternalTrafficSNATIP generates the flow to forward the packets destined for the external traffic
+// SNAT IP of a remote Node, i.e. the reply packets of the NodePort / LoadBalancer connections SNAT'd by the remote Node,
+// to the remote Node through tunnel.
+func (f *featureService) l3FwdFlowToRemoteExternalTrafficSNATIP(snatIP, tunnelPeer net.IP) binding.Flow {
+	return L3ForwardingTable.ofTable.BuildFlow(priorityNormal).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(getIPProtocol(snatIP)).
+		MatchDstIP(snatIP).
+		Action().SetSrcMAC(f.gatewayMAC).     // Rewrite src MAC to local gateway MAC.
+		Action().SetDstMAC(GlobalVirtualMAC). // Rewrite dst MAC to virtual MAC.
+		Action().SetTunnelDst(tunnelPeer).    // Flow based tunnel. Set tunnel destination.
+		Action().LoadRegMark(ToTunnelRegMark).
+		Action().GotoTable(L3DecTTLTable.GetID()).
+		Done()
+}
+
 // TODO: Use DuplicateToBuilder or integrate this function into original one to avoid unexpected difference.
 // flowsToTrace generates Traceflow specific flows in the connectionTrackStateTable or L2ForwardingCalcTable for featurePodConnectivity.
 // When packet is not provided, the flows bypass the drop flow in conntrackStateFlow to avoid unexpected drop of the
@@ -2090,6 +2164,50 @@ func (f *featureNetworkPolicy) dnsPacketInFlow(conjunctionID uint32) binding.Flo
 		Done()
 }
 
+// dnsResolverFlows generates the flows to drop the DNS queries, over both UDP and TCP, sent by the local Pod with the
+// provided ofPort to any resolver other than the allowed ones. The original destination of the connections is matched,
+// so that the queries to a Service, e.g. the kube-dns Service, are matched before the DNAT. The queries to the allowed
+// resolvers are forwarded to the next table, where they are subject to the NetworkPolicies as usual.
+func (f *featureNetworkPolicy) dnsResolverFlows(ofPort uint32, resolvers []net.IP) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	var flows []binding.Flow
+	for _, ipProtocol := range f.ipProtocols {
+		protocols := []binding.Protocol{binding.ProtocolTCP, binding.ProtocolUDP}
+		if ipProtocol == binding.ProtocolIPv6 {
+			protocols = []binding.Protocol{binding.ProtocolTCPv6, binding.ProtocolUDPv6}
+		}
+		for _, protocol := range protocols {
+			for _, resolver := range resolvers {
+				if getIPProtocol(resolver) != ipProtocol {
+					continue
+				}
+				flows = append(flows, EgressSecurityClassifierTable.ofTable.BuildFlow(priorityHigh).
+					Cookie(cookieID).
+					MatchProtocol(protocol).
+					MatchInPort(ofPort).
+					MatchCTStateNew(true).
+					MatchCTStateTrk(true).
+					MatchCTProtocol(protocol).
+					MatchCTDstPort(uint16(dnsPort)).
+					MatchCTDstIP(resolver).
+					Action().NextTable().
+					Done())
+			}
+			flows = append(flows, EgressSecurityClassifierTable.ofTable.BuildFlow(priorityNormal).
+				Cookie(cookieID).
+				MatchProtocol(protocol).
+				MatchInPort(ofPort).
+				MatchCTStateNew(true).
+				MatchCTStateTrk(true).
+				MatchCTProtocol(protocol).
+				MatchCTDstPort(uint16(dnsPort)).
+				Action().Drop().
+				Done())
+		}
+	}
+	return flows
+}
+
 // localProbeFlows generates the flows to forward locally generated request packets to stageConntrack directly, bypassing
 // ingress rule of Network Policies. The packets are sent by kubelet to probe the liveness/readiness of local Pods.
 // On Linux and when OVS kernel datapath is used, the probe packets are identified by matching the HostLocalSourceMark.
@@ -2212,15 +2330,98 @@ func (f *featureEgress) snatIPFromTunnelFlow(snatIP net.IP, mark uint32) binding
 		MatchProtocol(ipProtocol).
 		MatchCTStateTrk(true).
 		MatchTunnelDst(snatIP).
-		Action().LoadPktMarkRange(mark, snatPktMarkRange).
-		Action().LoadRegMark(ToGatewayRegMark)
+		Action().LoadPktMarkRange(mark, snatPktMarkRange)
+	return f.localSNATActions(fb).Done()
+}
+
+// localSNATActions appends the actions for the packets to be SNAT'd with a SNAT IP on the local Node. On Linux, the
+// packets are forwarded to the Antrea gateway, and SNAT is performed by the host according to the packet mark. On
+// Windows, SNAT is performed in OVS by the flows generated by snatMarkFlows, and the SNAT'd packets are output to the
+// uplink directly with the MAC address of the uplink's default gateway as the destination MAC.
+func (f *featureEgress) localSNATActions(fb binding.FlowBuilder) binding.FlowBuilder {
+	if runtime.IsWindowsPlatform() {
+		return fb.Action().SetSrcMAC(f.uplinkMAC).
+			Action().SetDstMAC(f.uplinkGatewayMAC).
+			Action().LoadRegMark(ToUplinkRegMark).
+			Action().GotoTable(L3DecTTLTable.GetID())
+	}
+	fb = fb.Action().LoadRegMark(ToGatewayRegMark)
 	if f.enableEgressTrafficShaping {
 		// To apply rate-limit on all traffic.
-		fb = fb.Action().GotoTable(EgressQoSTable.GetID())
-	} else {
-		fb = fb.Action().GotoStage(stageSwitching)
+		return fb.Action().GotoTable(EgressQoSTable.GetID())
+	}
+	return fb.Action().GotoStage(stageSwitching)
+}
+
+// snatMarkFlows generates the flows that perform SNAT in OVS for the packets marked with the ID of a local SNAT IP,
+// and unSNAT the reply packets received from the uplink. They are only installed on Windows, where the host cannot
+// perform SNAT according to the packet mark.
+func (f *featureEgress) snatMarkFlows(snatIP net.IP, mark uint32) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	ipProtocol := getIPProtocol(snatIP)
+	return []binding.Flow{
+		// This generates the flow to match the first packet of the connection to be SNAT'd, and commit it into the
+		// DNAT CT zone with ConnSNATCTMark before SNAT is performed, as the packet will bypass ConntrackCommitTable after
+		// SNAT. ConnSNATCTMark makes the subsequent packets of the connection SNAT'd in SNATTable.
+		SNATMarkTable.ofTable.BuildFlow(priorityHigh).
+			Cookie(cookieID).
+			MatchProtocol(ipProtocol).
+			MatchCTStateNew(true).
+			MatchCTStateTrk(true).
+			MatchPktMark(mark, &types.SNATIPMarkMask).
+			Action().CT(true, SNATMarkTable.GetNext(), f.ctZones[ipProtocol], nil).
+			MoveToCtMarkField(PktSourceField, ConnSourceCTMarkField).
+			LoadToCtMark(ConnSNATCTMark).
+			CTDone().
+			Done(),
+		// This generates the flow to perform SNAT with the SNAT IP for the first packet of the connection.
+		SNATTable.ofTable.BuildFlow(priorityHigh).
+			Cookie(cookieID).
+			MatchProtocol(ipProtocol).
+			MatchCTStateNew(true).
+			MatchCTStateTrk(true).
+			MatchPktMark(mark, &types.SNATIPMarkMask).
+			Action().CT(true, SNATTable.GetNext(), f.snatCtZones[ipProtocol], nil).
+			SNAT(&binding.IPRange{StartIP: snatIP, EndIP: snatIP}, nil).
+			CTDone().
+			Done(),
+		// This generates the flow to forward the reply packets destined for the SNAT IP from the uplink to
+		// stageConntrackState, where they are unSNAT'd.
+		ClassifierTable.ofTable.BuildFlow(priorityHigh).
+			Cookie(cookieID).
+			MatchProtocol(ipProtocol).
+			MatchInPort(f.uplinkPort).
+			MatchDstIP(snatIP).
+			Action().LoadRegMark(FromUplinkRegMark, RewriteMACRegMark).
+			Action().GotoStage(stageConntrackState).
+			Done(),
+		// This generates the flow to unSNAT the reply packets of connections committed in SNAT CT zone by the above flows.
+		UnSNATTable.ofTable.BuildFlow(priorityNormal).
+			Cookie(cookieID).
+			MatchProtocol(ipProtocol).
+			MatchDstIP(snatIP).
+			Action().CT(false, UnSNATTable.GetNext(), f.snatCtZones[ipProtocol], nil).
+			NAT().
+			CTDone().
+			Done(),
+	}
+}
+
+// snatOutputFlows generates the flow to output the packets SNAT'd in OVS to the uplink. It is only installed on Windows.
+func (f *featureEgress) snatOutputFlows() []binding.Flow {
+	if f.uplinkGatewayMAC == nil {
+		return nil
+	}
+	return []binding.Flow{
+		L2ForwardingCalcTable.ofTable.BuildFlow(priorityNormal).
+			Cookie(f.cookieAllocator.Request(f.category).Raw()).
+			MatchRegMark(ToUplinkRegMark).
+			MatchDstMAC(f.uplinkGatewayMAC).
+			Action().LoadToRegField(TargetOFPortField, f.uplinkPort).
+			Action().LoadRegMark(OutputToOFPortRegMark).
+			Action().GotoStage(stageConntrack).
+			Done(),
 	}
-	return fb.Done()
 }
 
 // snatRuleFlow generates the flow that applies the SNAT rule for a local Pod. If the SNAT IP exists on the local Node,
@@ -2236,15 +2437,8 @@ func (f *featureEgress) snatRuleFlow(ofPort uint32, snatIP net.IP, snatMark uint
 			MatchProtocol(ipProtocol).
 			MatchCTStateTrk(true).
 			MatchInPort(ofPort).
-			Action().LoadPktMarkRange(snatMark, snatPktMarkRange).
-			Action().LoadRegMark(ToGatewayRegMark)
-		if f.enableEgressTrafficShaping {
-			// To apply rate-limit on all traffic.
-			fb = fb.Action().GotoTable(EgressQoSTable.GetID())
-		} else {
-			fb = fb.Action().GotoStage(stageSwitching)
-		}
-		return fb.Done()
+			Action().LoadPktMarkRange(snatMark, snatPktMarkRange)
+		return f.localSNATActions(fb).Done()
 	}
 	// SNAT IP should be on a remote Node.
 	return EgressMarkTable.ofTable.BuildFlow(priorityNormal).
@@ -2361,6 +2555,9 @@ func (f *featureService) serviceLearnFlow(config *types.ServiceConfig) binding.F
 	if config.IsExternal {
 		regMarksToLoad = append(regMarksToLoad, ToExternalAddressRegMark)
 	}
+	if config.PreserveHairpinClientIP {
+		regMarksToLoad = append(regMarksToLoad, PreserveHairpinClientIPRegMark)
+	}
 	return learnFlowBuilderLearnAction.LoadRegMark(regMarksToLoad...).
 		Done().
 		Action().LoadRegMark(EpSelectedRegMark).
@@ -2370,7 +2567,7 @@ func (f *featureService) serviceLearnFlow(config *types.ServiceConfig) binding.F
 
 // serviceLBFlows generates the flows which use the specific groups to do Endpoint selection.
 func (f *featureService) serviceLBFlows(config *types.ServiceConfig) []binding.Flow {
-	buildFlow := func(priority uint16, groupID binding.GroupIDType, extraMatcher func(b binding.FlowBuilder) binding.FlowBuilder) binding.Flow {
+	buildFlow := func(priority uint16, groupID binding.GroupIDType, withRateLimit bool, extraMatcher func(b binding.FlowBuilder) binding.FlowBuilder) binding.Flow {
 		flowBuilder := ServiceLBTable.ofTable.BuildFlow(priority).
 			Cookie(f.cookieAllocator.Request(f.category).Raw()).
 			MatchProtocol(config.Protocol).
@@ -2405,29 +2602,95 @@ func (f *featureService) serviceLBFlows(config *types.ServiceConfig) []binding.F
 		if config.IsNested {
 			regMarksToLoad = append(regMarksToLoad, NestedServiceRegMark)
 		}
+		if config.PreserveHairpinClientIP {
+			regMarksToLoad = append(regMarksToLoad, PreserveHairpinClientIPRegMark)
+		}
+		// The meter drops the first packets of new connections exceeding the rate limit of the Service, before they
+		// undergo Endpoint selection.
+		if withRateLimit && config.ConnectionRateMeterID != 0 {
+			flowBuilder = flowBuilder.Action().Meter(config.ConnectionRateMeterID)
+		}
 		return flowBuilder.
 			Action().LoadRegMark(regMarksToLoad...).
 			Action().Group(groupID).Done()
 	}
 	flows := []binding.Flow{
-		buildFlow(priorityNormal, config.TrafficPolicyGroupID(), nil),
+		buildFlow(priorityNormal, config.TrafficPolicyGroupID(), true, nil),
 	}
 	if config.IsExternal && config.TrafficPolicyLocal {
 		// For short-circuiting flow, an extra match condition matching packet from a local Pod or the Node is added.
-		flows = append(flows, buildFlow(priorityHigh, config.ClusterGroupID, func(b binding.FlowBuilder) binding.FlowBuilder {
+		flows = append(flows, buildFlow(priorityHigh, config.ClusterGroupID, true, func(b binding.FlowBuilder) binding.FlowBuilder {
 			return b.MatchRegMark(FromLocalRegMark)
 		}))
 	}
 	if config.IsDSR {
 		// For DSR Service, we add a flow to match packets received from tunnel device, which means it has been
-		// load-balanced once in ingress Node, and we must select a local Endpoint on this Node.
-		flows = append(flows, buildFlow(priorityHigh, config.LocalGroupID, func(b binding.FlowBuilder) binding.FlowBuilder {
+		// load-balanced once in ingress Node, and we must select a local Endpoint on this Node. The rate of new
+		// connections has been limited in ingress Node.
+		flows = append(flows, buildFlow(priorityHigh, config.LocalGroupID, false, func(b binding.FlowBuilder) binding.FlowBuilder {
 			return b.MatchRegMark(FromTunnelRegMark)
 		}))
 	}
 	return flows
 }
 
+// serviceRejectFlow generates the flow which rejects the packets of new connections to the Service entrypoint, in the
+// same way as the packets to a Service without Endpoint. It has a higher priority than the flows generated by
+// serviceLBFlows.
+func (f *featureService) serviceRejectFlow(config *types.ServiceConfig) binding.Flow {
+	flowBuilder := ServiceLBTable.ofTable.BuildFlow(priorityHigh+1).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(config.Protocol).
+		MatchDstPort(config.ServicePort, nil).
+		MatchRegMark(EpToSelectRegMark)
+	if config.IsNodePort {
+		flowBuilder = flowBuilder.MatchRegMark(ToNodePortAddressRegMark)
+	} else {
+		flowBuilder = flowBuilder.MatchDstIP(config.ServiceIP)
+	}
+	return flowBuilder.
+		Action().LoadRegMark(SvcNoEpRegMark).
+		Action().GotoTable(EndpointDNATTable.GetID()).
+		Done()
+}
+
+// serviceCIDRRejectFlows generates the flows which reject the packets of new TCP, UDP and SCTP connections to the IPs in
+// the Service CIDR, in the same way as the packets to a Service without Endpoint. They have a lower priority than the
+// flows generated by serviceLBFlows, so they only match the packets to the ClusterIPs which are not allocated to any
+// Service (or the ports which are not exposed by the Service), which would be blackholed otherwise.
+func (f *featureService) serviceCIDRRejectFlows(serviceCIDR net.IPNet) []binding.Flow {
+	cookieID := f.cookieAllocator.Request(f.category).Raw()
+	protocols := []binding.Protocol{binding.ProtocolTCP, binding.ProtocolUDP, binding.ProtocolSCTP}
+	if serviceCIDR.IP.To4() == nil {
+		protocols = []binding.Protocol{binding.ProtocolTCPv6, binding.ProtocolUDPv6, binding.ProtocolSCTPv6}
+	}
+	var flows []binding.Flow
+	for _, protocol := range protocols {
+		flows = append(flows, ServiceLBTable.ofTable.BuildFlow(priorityLow).
+			Cookie(cookieID).
+			MatchProtocol(protocol).
+			MatchDstIPNet(serviceCIDR).
+			MatchRegMark(EpToSelectRegMark).
+			Action().LoadRegMark(SvcNoEpRegMark).
+			Action().GotoTable(EndpointDNATTable.GetID()).
+			Done())
+	}
+	return flows
+}
+
+// serviceCIDRRejectExclusionFlow generates the flow which lets the packets to the ClusterIP of a Service that is not
+// handled by AntreaProxy (e.g. a Service with the label service.kubernetes.io/service-proxy-name) bypass the flows
+// generated by serviceCIDRRejectFlows, so that they are handled by the proxy the Service belongs to.
+func (f *featureService) serviceCIDRRejectExclusionFlow(ip net.IP) binding.Flow {
+	return ServiceLBTable.ofTable.BuildFlow(priorityLow + 1).
+		Cookie(f.cookieAllocator.Request(f.category).Raw()).
+		MatchProtocol(getIPProtocol(ip)).
+		MatchDstIP(ip).
+		MatchRegMark(EpToSelectRegMark).
+		Action().NextTable().
+		Done()
+}
+
 // dsrServiceMarkFlow generates the flow which matches the packets with the following attributes:
 //  1. It's accessing the DSR Service's IP and port.
 //  2. It's externally originated.
@@ -2798,6 +3061,22 @@ func (f *featureMulticast) localMulticastForwardFlows(multicastIP net.IP, groupI
 	}
 }
 
+// localMulticastSourceForwardFlows generates the flow to forward the multicast packets sent from the given source to
+// the given multicast IP with the given group. It has a higher priority than the flow generated by function
+// "localMulticastForwardFlows", so that the packets from the source are only forwarded to the receivers which have
+// joined the multicast group for any source or for this source with IGMPv3 source filtering.
+func (f *featureMulticast) localMulticastSourceForwardFlows(multicastIP net.IP, sourceIP net.IP, groupID binding.GroupIDType) []binding.Flow {
+	return []binding.Flow{
+		MulticastRoutingTable.ofTable.BuildFlow(priorityNormal + 1).
+			Cookie(f.cookieAllocator.Request(f.category).Raw()).
+			MatchProtocol(binding.ProtocolIP).
+			MatchSrcIP(sourceIP).
+			MatchDstIP(multicastIP).
+			Action().Group(groupID).
+			Done(),
+	}
+}
+
 // externalMulticastReceiverFlow generates the flow to output multicast packets to Antrea gateway interface (to the host interface
 // and the uplink interface when flexibleIPAM is enabled), so that local Pods can send multicast packets to the external receivers.
 // For the case that one or more local Pods have joined the target multicast group, it is handled by the flows created by
@@ -2835,34 +3114,36 @@ func NewClient(bridgeName string,
 	enableTrafficControl bool,
 	enableL7FlowExporter bool,
 	enableMulticluster bool,
+	enableDNSResolverRestriction bool,
 	groupIDAllocator GroupAllocator,
 	enablePrometheusMetrics bool,
 	packetInRate int,
 ) *client {
 	bridge := binding.NewOFBridge(bridgeName, mgmtAddr)
 	c := &client{
-		bridge:                     bridge,
-		nodeIPChecker:              nodeIPCheck,
-		enableProxy:                enableProxy,
-		proxyAll:                   proxyAll,
-		enableDSR:                  enableDSR,
-		enableAntreaPolicy:         enableAntreaPolicy,
-		enableL7NetworkPolicy:      enableL7NetworkPolicy,
-		enableDenyTracking:         enableDenyTracking,
-		enableEgress:               enableEgress,
-		enableEgressTrafficShaping: enableEgressTrafficShaping,
-		enableMulticast:            enableMulticast,
-		enableTrafficControl:       enableTrafficControl,
-		enableL7FlowExporter:       enableL7FlowExporter,
-		enableMulticluster:         enableMulticluster,
-		enablePrometheusMetrics:    enablePrometheusMetrics,
-		connectUplinkToBridge:      connectUplinkToBridge,
-		pipelines:                  make(map[binding.PipelineID]binding.Pipeline),
-		packetInHandlers:           map[uint8]PacketInHandler{},
-		ovsctlClient:               ovsctl.NewClient(bridgeName),
-		ovsMetersAreSupported:      OVSMetersAreSupported(),
-		packetInRate:               packetInRate,
-		groupIDAllocator:           groupIDAllocator,
+		bridge:                       bridge,
+		nodeIPChecker:                nodeIPCheck,
+		enableProxy:                  enableProxy,
+		proxyAll:                     proxyAll,
+		enableDSR:                    enableDSR,
+		enableAntreaPolicy:           enableAntreaPolicy,
+		enableL7NetworkPolicy:        enableL7NetworkPolicy,
+		enableDenyTracking:           enableDenyTracking,
+		enableEgress:                 enableEgress,
+		enableEgressTrafficShaping:   enableEgressTrafficShaping,
+		enableMulticast:              enableMulticast,
+		enableTrafficControl:         enableTrafficControl,
+		enableL7FlowExporter:         enableL7FlowExporter,
+		enableMulticluster:           enableMulticluster,
+		enablePrometheusMetrics:      enablePrometheusMetrics,
+		enableDNSResolverRestriction: enableDNSResolverRestriction,
+		connectUplinkToBridge:        connectUplinkToBridge,
+		pipelines:                    make(map[binding.PipelineID]binding.Pipeline),
+		packetInHandlers:             map[uint8]PacketInHandler{},
+		ovsctlClient:                 ovsctl.NewClient(bridgeName),
+		ovsMetersAreSupported:        OVSMetersAreSupported(),
+		packetInRate:                 packetInRate,
+		groupIDAllocator:             groupIDAllocator,
 	}
 	c.ofEntryOperations = operations.NewOFEntryOperations(bridge)
 	return c
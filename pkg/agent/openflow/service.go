@@ -34,6 +34,7 @@ type featureService struct {
 
 	cachedFlows *flowCategoryCache
 	groupCache  sync.Map
+	cachedMeter sync.Map
 
 	gatewayIPs             map[binding.Protocol]net.IP
 	virtualIPs             map[binding.Protocol]net.IP
@@ -112,6 +113,7 @@ func newFeatureService(
 		bridge:                 bridge,
 		cachedFlows:            newFlowCategoryCache(),
 		groupCache:             sync.Map{},
+		cachedMeter:            sync.Map{},
 		gatewayIPs:             gatewayIPs,
 		virtualIPs:             virtualIPs,
 		virtualNodePortDNATIPs: virtualNodePortDNATIPs,
@@ -191,5 +193,12 @@ func (f *featureService) initGroups() []binding.OFEntry {
 }
 
 func (f *featureService) replayMeters() []binding.OFEntry {
-	return nil
+	var meters []binding.OFEntry
+	f.cachedMeter.Range(func(id, value interface{}) bool {
+		meter := value.(binding.Meter)
+		meter.Reset()
+		meters = append(meters, meter)
+		return true
+	})
+	return meters
 }
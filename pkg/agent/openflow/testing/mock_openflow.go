@@ -109,6 +109,20 @@ func (mr *MockClientMockRecorder) BatchInstallPolicyRuleFlows(ofPolicyRules any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchInstallPolicyRuleFlows", reflect.TypeOf((*MockClient)(nil).BatchInstallPolicyRuleFlows), ofPolicyRules)
 }
 
+// DNSResolverDropMetrics mocks base method.
+func (m *MockClient) DNSResolverDropMetrics() map[uint32]*types.RuleMetric {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DNSResolverDropMetrics")
+	ret0, _ := ret[0].(map[uint32]*types.RuleMetric)
+	return ret0
+}
+
+// DNSResolverDropMetrics indicates an expected call of DNSResolverDropMetrics.
+func (mr *MockClientMockRecorder) DNSResolverDropMetrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DNSResolverDropMetrics", reflect.TypeOf((*MockClient)(nil).DNSResolverDropMetrics))
+}
+
 // DeleteAddressFromDNSConjunction mocks base method.
 func (m *MockClient) DeleteAddressFromDNSConjunction(id uint32, addrs []types.Address) error {
 	m.ctrl.T.Helper()
@@ -151,6 +165,21 @@ func (mr *MockClientMockRecorder) DeleteStaleFlows() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaleFlows", reflect.TypeOf((*MockClient)(nil).DeleteStaleFlows))
 }
 
+// DiffOFEntries mocks base method.
+func (m *MockClient) DiffOFEntries() ([]types.DatapathEntryDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffOFEntries")
+	ret0, _ := ret[0].([]types.DatapathEntryDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffOFEntries indicates an expected call of DiffOFEntries.
+func (mr *MockClientMockRecorder) DiffOFEntries() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffOFEntries", reflect.TypeOf((*MockClient)(nil).DiffOFEntries))
+}
+
 // Disconnect mocks base method.
 func (m *MockClient) Disconnect() error {
 	m.ctrl.T.Helper()
@@ -296,6 +325,20 @@ func (mr *MockClientMockRecorder) InstallEndpointFlows(protocol, endpoints any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallEndpointFlows", reflect.TypeOf((*MockClient)(nil).InstallEndpointFlows), protocol, endpoints)
 }
 
+// InstallExternalTrafficSNATFlows mocks base method.
+func (m *MockClient) InstallExternalTrafficSNATFlows(snatIP net.IP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallExternalTrafficSNATFlows", snatIP)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallExternalTrafficSNATFlows indicates an expected call of InstallExternalTrafficSNATFlows.
+func (mr *MockClientMockRecorder) InstallExternalTrafficSNATFlows(snatIP any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallExternalTrafficSNATFlows", reflect.TypeOf((*MockClient)(nil).InstallExternalTrafficSNATFlows), snatIP)
+}
+
 // InstallL7NetworkPolicyFlows mocks base method.
 func (m *MockClient) InstallL7NetworkPolicyFlows() error {
 	m.ctrl.T.Helper()
@@ -366,6 +409,20 @@ func (mr *MockClientMockRecorder) InstallMulticastRemoteReportFlows(groupID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallMulticastRemoteReportFlows", reflect.TypeOf((*MockClient)(nil).InstallMulticastRemoteReportFlows), groupID)
 }
 
+// InstallMulticastSourceFlows mocks base method.
+func (m *MockClient) InstallMulticastSourceFlows(multicastIP, sourceIP net.IP, groupID openflow0.GroupIDType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallMulticastSourceFlows", multicastIP, sourceIP, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallMulticastSourceFlows indicates an expected call of InstallMulticastSourceFlows.
+func (mr *MockClientMockRecorder) InstallMulticastSourceFlows(multicastIP, sourceIP, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallMulticastSourceFlows", reflect.TypeOf((*MockClient)(nil).InstallMulticastSourceFlows), multicastIP, sourceIP, groupID)
+}
+
 // InstallMulticlusterClassifierFlows mocks base method.
 func (m *MockClient) InstallMulticlusterClassifierFlows(tunnelOFPort uint32, isGateway bool) error {
 	m.ctrl.T.Helper()
@@ -436,18 +493,46 @@ func (mr *MockClientMockRecorder) InstallNodeFlows(hostname, peerConfigs, tunnel
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallNodeFlows", reflect.TypeOf((*MockClient)(nil).InstallNodeFlows), hostname, peerConfigs, tunnelPeerIP, ipsecTunOFPort, peerNodeMAC)
 }
 
+// InstallPeerExternalTrafficSNATFlows mocks base method.
+func (m *MockClient) InstallPeerExternalTrafficSNATFlows(hostname string, snatIP, tunnelPeerIP net.IP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallPeerExternalTrafficSNATFlows", hostname, snatIP, tunnelPeerIP)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallPeerExternalTrafficSNATFlows indicates an expected call of InstallPeerExternalTrafficSNATFlows.
+func (mr *MockClientMockRecorder) InstallPeerExternalTrafficSNATFlows(hostname, snatIP, tunnelPeerIP any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPeerExternalTrafficSNATFlows", reflect.TypeOf((*MockClient)(nil).InstallPeerExternalTrafficSNATFlows), hostname, snatIP, tunnelPeerIP)
+}
+
+// InstallPodDNSResolverFlows mocks base method.
+func (m *MockClient) InstallPodDNSResolverFlows(ofPort uint32, resolvers []net.IP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallPodDNSResolverFlows", ofPort, resolvers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallPodDNSResolverFlows indicates an expected call of InstallPodDNSResolverFlows.
+func (mr *MockClientMockRecorder) InstallPodDNSResolverFlows(ofPort, resolvers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodDNSResolverFlows", reflect.TypeOf((*MockClient)(nil).InstallPodDNSResolverFlows), ofPort, resolvers)
+}
+
 // InstallPodFlows mocks base method.
-func (m *MockClient) InstallPodFlows(interfaceName string, podInterfaceIPs []net.IP, podInterfaceMAC net.HardwareAddr, ofPort uint32, vlanID uint16, labelID *uint32) error {
+func (m *MockClient) InstallPodFlows(interfaceName string, podInterfaceIPs []net.IP, podInterfaceMAC net.HardwareAddr, ofPort uint32, vlanID uint16, tunnelID *uint32) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InstallPodFlows", interfaceName, podInterfaceIPs, podInterfaceMAC, ofPort, vlanID, labelID)
+	ret := m.ctrl.Call(m, "InstallPodFlows", interfaceName, podInterfaceIPs, podInterfaceMAC, ofPort, vlanID, tunnelID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // InstallPodFlows indicates an expected call of InstallPodFlows.
-func (mr *MockClientMockRecorder) InstallPodFlows(interfaceName, podInterfaceIPs, podInterfaceMAC, ofPort, vlanID, labelID any) *gomock.Call {
+func (mr *MockClientMockRecorder) InstallPodFlows(interfaceName, podInterfaceIPs, podInterfaceMAC, ofPort, vlanID, tunnelID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodFlows", reflect.TypeOf((*MockClient)(nil).InstallPodFlows), interfaceName, podInterfaceIPs, podInterfaceMAC, ofPort, vlanID, labelID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallPodFlows", reflect.TypeOf((*MockClient)(nil).InstallPodFlows), interfaceName, podInterfaceIPs, podInterfaceMAC, ofPort, vlanID, tunnelID)
 }
 
 // InstallPodSNATFlows mocks base method.
@@ -520,6 +605,34 @@ func (mr *MockClientMockRecorder) InstallSNATMarkFlows(snatIP, mark any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallSNATMarkFlows", reflect.TypeOf((*MockClient)(nil).InstallSNATMarkFlows), snatIP, mark)
 }
 
+// InstallServiceCIDRRejectFlows mocks base method.
+func (m *MockClient) InstallServiceCIDRRejectFlows(serviceCIDRs []*net.IPNet, excludedIPs []net.IP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallServiceCIDRRejectFlows", serviceCIDRs, excludedIPs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallServiceCIDRRejectFlows indicates an expected call of InstallServiceCIDRRejectFlows.
+func (mr *MockClientMockRecorder) InstallServiceCIDRRejectFlows(serviceCIDRs, excludedIPs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallServiceCIDRRejectFlows", reflect.TypeOf((*MockClient)(nil).InstallServiceCIDRRejectFlows), serviceCIDRs, excludedIPs)
+}
+
+// InstallServiceConnectionRateLimit mocks base method.
+func (m *MockClient) InstallServiceConnectionRateLimit(meterID, rate, burst uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallServiceConnectionRateLimit", meterID, rate, burst)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallServiceConnectionRateLimit indicates an expected call of InstallServiceConnectionRateLimit.
+func (mr *MockClientMockRecorder) InstallServiceConnectionRateLimit(meterID, rate, burst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallServiceConnectionRateLimit", reflect.TypeOf((*MockClient)(nil).InstallServiceConnectionRateLimit), meterID, rate, burst)
+}
+
 // InstallServiceFlows mocks base method.
 func (m *MockClient) InstallServiceFlows(config *types.ServiceConfig) error {
 	m.ctrl.T.Helper()
@@ -548,6 +661,20 @@ func (mr *MockClientMockRecorder) InstallServiceGroup(groupID, withSessionAffini
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallServiceGroup", reflect.TypeOf((*MockClient)(nil).InstallServiceGroup), groupID, withSessionAffinity, endpoints)
 }
 
+// InstallServiceRejectFlow mocks base method.
+func (m *MockClient) InstallServiceRejectFlow(config *types.ServiceConfig) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallServiceRejectFlow", config)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallServiceRejectFlow indicates an expected call of InstallServiceRejectFlow.
+func (mr *MockClientMockRecorder) InstallServiceRejectFlow(config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallServiceRejectFlow", reflect.TypeOf((*MockClient)(nil).InstallServiceRejectFlow), config)
+}
+
 // InstallTraceflowFlows mocks base method.
 func (m *MockClient) InstallTraceflowFlows(dataplaneTag uint8, liveTraffic, droppedOnly, receiverOnly bool, packet *openflow0.Packet, ofPort uint32, timeoutSeconds uint16) error {
 	m.ctrl.T.Helper()
@@ -646,6 +773,20 @@ func (mr *MockClientMockRecorder) MulticastEgressPodMetricsByIP(ip any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MulticastEgressPodMetricsByIP", reflect.TypeOf((*MockClient)(nil).MulticastEgressPodMetricsByIP), ip)
 }
 
+// MulticastGroupMetrics mocks base method.
+func (m *MockClient) MulticastGroupMetrics() map[string]*types.RuleHitStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MulticastGroupMetrics")
+	ret0, _ := ret[0].(map[string]*types.RuleHitStats)
+	return ret0
+}
+
+// MulticastGroupMetrics indicates an expected call of MulticastGroupMetrics.
+func (mr *MockClientMockRecorder) MulticastGroupMetrics() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MulticastGroupMetrics", reflect.TypeOf((*MockClient)(nil).MulticastGroupMetrics))
+}
+
 // MulticastIngressPodMetrics mocks base method.
 func (m *MockClient) MulticastIngressPodMetrics() map[uint32]*types.RuleMetric {
 	m.ctrl.T.Helper()
@@ -688,6 +829,20 @@ func (mr *MockClientMockRecorder) NetworkPolicyMetrics() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkPolicyMetrics", reflect.TypeOf((*MockClient)(nil).NetworkPolicyMetrics))
 }
 
+// NetworkPolicyRuleHitStats mocks base method.
+func (m *MockClient) NetworkPolicyRuleHitStats(ruleID uint32) *types.RuleHitStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NetworkPolicyRuleHitStats", ruleID)
+	ret0, _ := ret[0].(*types.RuleHitStats)
+	return ret0
+}
+
+// NetworkPolicyRuleHitStats indicates an expected call of NetworkPolicyRuleHitStats.
+func (mr *MockClientMockRecorder) NetworkPolicyRuleHitStats(ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NetworkPolicyRuleHitStats", reflect.TypeOf((*MockClient)(nil).NetworkPolicyRuleHitStats), ruleID)
+}
+
 // NewDNSPacketInConjunction mocks base method.
 func (m *MockClient) NewDNSPacketInConjunction(id uint32) error {
 	m.ctrl.T.Helper()
@@ -852,6 +1007,18 @@ func (mr *MockClientMockRecorder) SendUDPPacketOut(srcMAC, dstMAC, srcIP, dstIP,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendUDPPacketOut", reflect.TypeOf((*MockClient)(nil).SendUDPPacketOut), srcMAC, dstMAC, srcIP, dstIP, inPort, outPort, isIPv6, udpSrcPort, udpDstPort, udpData, mutatePacketOut)
 }
 
+// SetPacketInCPUAffinity mocks base method.
+func (m *MockClient) SetPacketInCPUAffinity(cpus []int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPacketInCPUAffinity", cpus)
+}
+
+// SetPacketInCPUAffinity indicates an expected call of SetPacketInCPUAffinity.
+func (mr *MockClientMockRecorder) SetPacketInCPUAffinity(cpus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPacketInCPUAffinity", reflect.TypeOf((*MockClient)(nil).SetPacketInCPUAffinity), cpus)
+}
+
 // StartPacketInHandler mocks base method.
 func (m *MockClient) StartPacketInHandler(stopCh <-chan struct{}) {
 	m.ctrl.T.Helper()
@@ -946,6 +1113,20 @@ func (mr *MockClientMockRecorder) UninstallMulticastGroup(groupID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallMulticastGroup", reflect.TypeOf((*MockClient)(nil).UninstallMulticastGroup), groupID)
 }
 
+// UninstallMulticastSourceFlows mocks base method.
+func (m *MockClient) UninstallMulticastSourceFlows(multicastIP, sourceIP net.IP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallMulticastSourceFlows", multicastIP, sourceIP)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallMulticastSourceFlows indicates an expected call of UninstallMulticastSourceFlows.
+func (mr *MockClientMockRecorder) UninstallMulticastSourceFlows(multicastIP, sourceIP any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallMulticastSourceFlows", reflect.TypeOf((*MockClient)(nil).UninstallMulticastSourceFlows), multicastIP, sourceIP)
+}
+
 // UninstallMulticlusterFlows mocks base method.
 func (m *MockClient) UninstallMulticlusterFlows(clusterID string) error {
 	m.ctrl.T.Helper()
@@ -988,6 +1169,34 @@ func (mr *MockClientMockRecorder) UninstallNodeFlows(hostname any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallNodeFlows", reflect.TypeOf((*MockClient)(nil).UninstallNodeFlows), hostname)
 }
 
+// UninstallPeerExternalTrafficSNATFlows mocks base method.
+func (m *MockClient) UninstallPeerExternalTrafficSNATFlows(hostname string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallPeerExternalTrafficSNATFlows", hostname)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallPeerExternalTrafficSNATFlows indicates an expected call of UninstallPeerExternalTrafficSNATFlows.
+func (mr *MockClientMockRecorder) UninstallPeerExternalTrafficSNATFlows(hostname any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallPeerExternalTrafficSNATFlows", reflect.TypeOf((*MockClient)(nil).UninstallPeerExternalTrafficSNATFlows), hostname)
+}
+
+// UninstallPodDNSResolverFlows mocks base method.
+func (m *MockClient) UninstallPodDNSResolverFlows(ofPort uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallPodDNSResolverFlows", ofPort)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallPodDNSResolverFlows indicates an expected call of UninstallPodDNSResolverFlows.
+func (mr *MockClientMockRecorder) UninstallPodDNSResolverFlows(ofPort any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallPodDNSResolverFlows", reflect.TypeOf((*MockClient)(nil).UninstallPodDNSResolverFlows), ofPort)
+}
+
 // UninstallPodFlows mocks base method.
 func (m *MockClient) UninstallPodFlows(interfaceName string) error {
 	m.ctrl.T.Helper()
@@ -1045,6 +1254,20 @@ func (mr *MockClientMockRecorder) UninstallSNATMarkFlows(mark any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallSNATMarkFlows", reflect.TypeOf((*MockClient)(nil).UninstallSNATMarkFlows), mark)
 }
 
+// UninstallServiceConnectionRateLimit mocks base method.
+func (m *MockClient) UninstallServiceConnectionRateLimit(meterID uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallServiceConnectionRateLimit", meterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallServiceConnectionRateLimit indicates an expected call of UninstallServiceConnectionRateLimit.
+func (mr *MockClientMockRecorder) UninstallServiceConnectionRateLimit(meterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallServiceConnectionRateLimit", reflect.TypeOf((*MockClient)(nil).UninstallServiceConnectionRateLimit), meterID)
+}
+
 // UninstallServiceFlows mocks base method.
 func (m *MockClient) UninstallServiceFlows(svcIP net.IP, svcPort uint16, protocol openflow0.Protocol) error {
 	m.ctrl.T.Helper()
@@ -1073,6 +1296,20 @@ func (mr *MockClientMockRecorder) UninstallServiceGroup(groupID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallServiceGroup", reflect.TypeOf((*MockClient)(nil).UninstallServiceGroup), groupID)
 }
 
+// UninstallServiceRejectFlow mocks base method.
+func (m *MockClient) UninstallServiceRejectFlow(svcIP net.IP, svcPort uint16, protocol openflow0.Protocol) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UninstallServiceRejectFlow", svcIP, svcPort, protocol)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UninstallServiceRejectFlow indicates an expected call of UninstallServiceRejectFlow.
+func (mr *MockClientMockRecorder) UninstallServiceRejectFlow(svcIP, svcPort, protocol any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallServiceRejectFlow", reflect.TypeOf((*MockClient)(nil).UninstallServiceRejectFlow), svcIP, svcPort, protocol)
+}
+
 // UninstallTraceflowFlows mocks base method.
 func (m *MockClient) UninstallTraceflowFlows(dataplaneTag uint8) error {
 	m.ctrl.T.Helper()
@@ -1128,3 +1365,17 @@ func (mr *MockClientMockRecorder) UninstallVMUplinkFlows(hostInterfaceName any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UninstallVMUplinkFlows", reflect.TypeOf((*MockClient)(nil).UninstallVMUplinkFlows), hostInterfaceName)
 }
+
+// UpdateNodeIPs mocks base method.
+func (m *MockClient) UpdateNodeIPs() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodeIPs")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNodeIPs indicates an expected call of UpdateNodeIPs.
+func (mr *MockClientMockRecorder) UpdateNodeIPs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodeIPs", reflect.TypeOf((*MockClient)(nil).UpdateNodeIPs))
+}
@@ -54,6 +54,7 @@ import (
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	"antrea.io/antrea/pkg/util/auth"
 	"antrea.io/antrea/pkg/util/env"
+	"antrea.io/antrea/pkg/util/s3"
 	"antrea.io/antrea/pkg/util/sftp"
 )
 
@@ -61,6 +62,7 @@ type storageProtocolType string
 
 const (
 	sftpProtocol storageProtocolType = "sftp"
+	s3Protocol   storageProtocolType = "s3"
 )
 
 const (
@@ -129,6 +131,7 @@ type Controller struct {
 	interfaceStore        interfacestore.InterfaceStore
 	queue                 workqueue.TypedRateLimitingInterface[string]
 	sftpUploader          sftp.Uploader
+	s3Uploader            s3.Uploader
 	captureInterface      PacketCapturer
 	mutex                 sync.Mutex
 	// A name-state mapping for all PacketCapture CRs.
@@ -154,6 +157,7 @@ func NewPacketCaptureController(
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "packetcapture"},
 		),
 		sftpUploader: sftp.NewUploader(),
+		s3Uploader:   s3.NewUploader(),
 		captures:     make(map[string]*packetCaptureState),
 	}
 
@@ -552,11 +556,12 @@ func (c *Controller) parseIPs(ctx context.Context, pc *crdv1alpha1.PacketCapture
 	return
 }
 
-func (c *Controller) getUploaderByProtocol(protocol storageProtocolType) (sftp.Uploader, error) {
-	if protocol == sftpProtocol {
-		return c.sftpUploader, nil
+// getStorageProtocol returns the protocol of the file server URL. URLs without a scheme are sftp URLs.
+func getStorageProtocol(url string) storageProtocolType {
+	if strings.HasPrefix(url, string(s3Protocol)+"://") {
+		return s3Protocol
 	}
-	return nil, fmt.Errorf("unsupported protocol %s", protocol)
+	return sftpProtocol
 }
 
 func (c *Controller) generatePacketsPathForServer(name string) string {
@@ -565,10 +570,6 @@ func (c *Controller) generatePacketsPathForServer(name string) string {
 
 func (c *Controller) uploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCapture, outputFile afero.File) error {
 	klog.V(2).InfoS("Uploading captured packets for PacketCapture", "name", pc.Name)
-	uploader, err := c.getUploaderByProtocol(sftpProtocol)
-	if err != nil {
-		return fmt.Errorf("failed to upload packets while getting uploader: %w", err)
-	}
 	if _, err := outputFile.Seek(0, 0); err != nil {
 		return fmt.Errorf("failed to upload to the file server while setting offset: %v", err)
 	}
@@ -584,15 +585,27 @@ func (c *Controller) uploadPackets(ctx context.Context, pc *crdv1alpha1.PacketCa
 	if serverAuth.BasicAuthentication == nil {
 		return fmt.Errorf("failed to get basic authentication info for the file server")
 	}
+	fileServer := pc.Spec.FileServer
+	fileName := c.generatePacketsPathForServer(pc.Name)
+	if getStorageProtocol(fileServer.URL) == s3Protocol {
+		// For object storage, the username and password are the access key ID and the secret access key.
+		cfg := &s3.Config{
+			Endpoint:        fileServer.Endpoint,
+			Region:          fileServer.Region,
+			AccessKeyID:     serverAuth.BasicAuthentication.Username,
+			SecretAccessKey: serverAuth.BasicAuthentication.Password,
+		}
+		return c.s3Uploader.Upload(ctx, fileServer.URL, fileName, cfg, outputFile)
+	}
 	cfg, err := sftp.GetSSHClientConfig(
 		serverAuth.BasicAuthentication.Username,
 		serverAuth.BasicAuthentication.Password,
-		pc.Spec.FileServer.HostPublicKey,
+		fileServer.HostPublicKey,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to generate SSH client config: %w", err)
 	}
-	return uploader.Upload(pc.Spec.FileServer.URL, c.generatePacketsPathForServer(pc.Name), cfg, outputFile)
+	return c.sftpUploader.Upload(fileServer.URL, fileName, cfg, outputFile)
 }
 
 func (c *Controller) updateStatus(ctx context.Context, pc *crdv1alpha1.PacketCapture, state packetCaptureState) error {
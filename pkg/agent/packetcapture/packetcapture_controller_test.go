@@ -47,6 +47,7 @@ import (
 	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
 	"antrea.io/antrea/pkg/util/k8s"
+	"antrea.io/antrea/pkg/util/s3"
 	sftptesting "antrea.io/antrea/pkg/util/sftp/testing"
 )
 
@@ -168,6 +169,19 @@ func (uploader *testUploader) Upload(url string, fileName string, config *ssh.Cl
 	return nil
 }
 
+type testS3Uploader struct {
+	url      string
+	fileName string
+	config   *s3.Config
+}
+
+func (uploader *testS3Uploader) Upload(ctx context.Context, url string, fileName string, config *s3.Config, outputFile io.Reader) error {
+	uploader.url = url
+	uploader.fileName = fileName
+	uploader.config = config
+	return nil
+}
+
 func craftTestPacket() gopacket.Packet {
 	buffer := gopacket.NewSerializeBuffer()
 	options := gopacket.SerializeOptions{}
@@ -667,3 +681,34 @@ func TestUploadPackets(t *testing.T) {
 		})
 	}
 }
+
+func TestUploadPacketsToS3(t *testing.T) {
+	pc := genTestCR("foo", testCaptureNum)
+	pc.Spec.FileServer = &crdv1alpha1.PacketCaptureFileServer{
+		URL:      "s3://captures/cluster-1",
+		Endpoint: "https://minio.example.com:9000",
+	}
+	pcc := newFakePacketCaptureController(t, nil, nil)
+	s3Uploader := &testS3Uploader{}
+	pcc.s3Uploader = s3Uploader
+	// The sftp uploader fails if it is called, as it expects a different URL.
+	pcc.sftpUploader = &testUploader{url: testFTPUrl}
+	f, err := afero.TempFile(afero.NewMemMapFs(), "", "upload-test")
+	require.NoError(t, err)
+	defer f.Close()
+
+	require.NoError(t, pcc.uploadPackets(context.Background(), pc, f))
+	assert.Equal(t, "s3://captures/cluster-1", s3Uploader.url)
+	assert.Equal(t, "foo.pcapng", s3Uploader.fileName)
+	assert.Equal(t, &s3.Config{
+		Endpoint:        "https://minio.example.com:9000",
+		AccessKeyID:     "username",
+		SecretAccessKey: "password",
+	}, s3Uploader.config)
+}
+
+func TestGetStorageProtocol(t *testing.T) {
+	assert.Equal(t, s3Protocol, getStorageProtocol("s3://captures/cluster-1"))
+	assert.Equal(t, sftpProtocol, getStorageProtocol("sftp://127.0.0.1:22/path"))
+	assert.Equal(t, sftpProtocol, getStorageProtocol("127.0.0.1:22/path"))
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package preflight validates at startup that the kernel of the Node supports the features
+// required by the enabled Antrea features, so that antrea-agent can fail fast with an actionable
+// error instead of failing later in an obscure way.
+package preflight
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Config determines which kernel features are required.
+type Config struct {
+	// EnableWireGuard is true when WireGuard is used to encrypt Pod traffic (trafficEncryptionMode
+	// or multicluster.trafficEncryptionMode is set to wireGuard).
+	EnableWireGuard bool
+	// EnableIPv6 is true when the Node has an IPv6 Pod network.
+	EnableIPv6 bool
+	// EnableHardwareOffload is true when OVS hardware offload is enabled, in which case datapath
+	// flows are offloaded using tc.
+	EnableHardwareOffload bool
+}
+
+// Result is the result of a single check.
+type Result struct {
+	// Name is the name of the kernel feature.
+	Name string
+	// RequiredBy is the Antrea feature or configuration which requires the kernel feature.
+	RequiredBy string
+	// Err is nil if the kernel feature is supported. Otherwise it explains why the feature is
+	// not supported and how to fix it.
+	Err error
+}
+
+type check struct {
+	name       string
+	requiredBy string
+	run        func() error
+}
+
+// Run runs the checks which are relevant for the provided configuration and returns their
+// results.
+func Run(config *Config) []Result {
+	checks := getChecks(config)
+	results := make([]Result, 0, len(checks))
+	for _, c := range checks {
+		results = append(results, Result{
+			Name:       c.name,
+			RequiredBy: c.requiredBy,
+			Err:        c.run(),
+		})
+	}
+	return results
+}
+
+// Error returns an error listing all the failed checks, or nil if all checks passed.
+func Error(results []Result) error {
+	var msgs []string
+	for _, r := range results {
+		if r.Err != nil {
+			msgs = append(msgs, fmt.Sprintf("%s (required by %s): %v", r.Name, r.RequiredBy, r.Err))
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	return fmt.Errorf("the kernel does not support all the required features: %s", strings.Join(msgs, "; "))
+}
@@ -0,0 +1,136 @@
+//go:build linux
+// +build linux
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preflight
+
+import (
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+
+	"antrea.io/antrea/pkg/agent/util/sysctl"
+)
+
+// These are used for unit testing.
+var (
+	sysModuleDir     = "/sys/module"
+	kernelConfigPath = "/proc/config.gz"
+	getSysctlNet     = sysctl.GetSysctlNet
+	qdiscList        = netlink.QdiscList
+)
+
+func getChecks(config *Config) []check {
+	checks := []check{
+		{name: "ConntrackZones", requiredBy: "OVS datapath", run: checkConntrackZones},
+	}
+	if config.EnableWireGuard {
+		checks = append(checks, check{name: "WireGuard", requiredBy: "WireGuard encryption", run: checkWireGuard})
+	}
+	if config.EnableIPv6 {
+		checks = append(checks, check{name: "IPv6Forwarding", requiredBy: "IPv6 Pod network", run: checkIPv6Forwarding})
+	}
+	if config.EnableHardwareOffload {
+		checks = append(checks, check{name: "TCQdisc", requiredBy: "OVS hardware offload", run: checkTCQdisc})
+	}
+	return checks
+}
+
+// isModuleLoaded returns whether the provided kernel module is loaded. Built-in modules are
+// also listed in /sys/module as long as they have parameters or a version.
+func isModuleLoaded(name string) bool {
+	_, err := os.Stat(filepath.Join(sysModuleDir, name))
+	return err == nil
+}
+
+// getKernelConfigOption returns the value of the provided option in the kernel build
+// configuration. The returned bool is false if the configuration is not available, which is the
+// case when the kernel is not built with CONFIG_IKCONFIG_PROC.
+func getKernelConfigOption(option string) (string, bool, error) {
+	f, err := os.Open(kernelConfigPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return "", false, err
+	}
+	defer gz.Close()
+	scanner := bufio.NewScanner(gz)
+	prefix := option + "="
+	for scanner.Scan() {
+		if value, found := strings.CutPrefix(scanner.Text(), prefix); found {
+			return value, true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", false, err
+	}
+	// Options which are not set are not listed, or listed as "# CONFIG_FOO is not set".
+	return "", true, nil
+}
+
+func checkConntrackZones() error {
+	if _, err := getSysctlNet("netfilter/nf_conntrack_max"); err != nil {
+		return fmt.Errorf("connection tracking is not available, make sure that the nf_conntrack kernel module is loaded ('modprobe nf_conntrack'): %w", err)
+	}
+	value, known, err := getKernelConfigOption("CONFIG_NF_CONNTRACK_ZONES")
+	if err != nil {
+		// The kernel configuration is only used for a more precise validation.
+		return nil
+	}
+	if known && value != "y" {
+		return fmt.Errorf("the kernel is built without connection tracking zones (CONFIG_NF_CONNTRACK_ZONES), which are required by the OVS datapath")
+	}
+	return nil
+}
+
+func checkWireGuard() error {
+	if !isModuleLoaded("wireguard") {
+		return fmt.Errorf("the wireguard kernel module is not loaded, it is built into Linux 5.6 and later and can be installed separately for older kernels; try running 'modprobe wireguard' on the Node")
+	}
+	return nil
+}
+
+func checkIPv6Forwarding() error {
+	disabled, err := getSysctlNet("ipv6/conf/all/disable_ipv6")
+	if err != nil {
+		return fmt.Errorf("IPv6 is not available, make sure that the kernel is not booted with 'ipv6.disable=1': %w", err)
+	}
+	if disabled != 0 {
+		return fmt.Errorf("IPv6 is disabled on the Node, set sysctl net.ipv6.conf.all.disable_ipv6 to 0")
+	}
+	if _, err := getSysctlNet("ipv6/conf/all/forwarding"); err != nil {
+		return fmt.Errorf("IPv6 forwarding cannot be configured: %w", err)
+	}
+	return nil
+}
+
+func checkTCQdisc() error {
+	if _, err := qdiscList(nil); err != nil {
+		return fmt.Errorf("tc qdiscs cannot be listed, make sure that the kernel is built with traffic control support (CONFIG_NET_SCHED): %w", err)
+	}
+	return nil
+}
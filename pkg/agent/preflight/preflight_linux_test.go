@@ -0,0 +1,143 @@
+//go:build linux
+// +build linux
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preflight
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netlink"
+)
+
+func mockKernel(t *testing.T, modules []string, kernelConfig string, sysctls map[string]int) {
+	moduleDir := t.TempDir()
+	for _, m := range modules {
+		require.NoError(t, os.Mkdir(filepath.Join(moduleDir, m), 0755))
+	}
+	configPath := filepath.Join(t.TempDir(), "config.gz")
+	if kernelConfig != "" {
+		f, err := os.Create(configPath)
+		require.NoError(t, err)
+		gz := gzip.NewWriter(f)
+		_, err = gz.Write([]byte(kernelConfig))
+		require.NoError(t, err)
+		require.NoError(t, gz.Close())
+		require.NoError(t, f.Close())
+	}
+	prevSysModuleDir, prevKernelConfigPath, prevGetSysctlNet, prevQdiscList := sysModuleDir, kernelConfigPath, getSysctlNet, qdiscList
+	t.Cleanup(func() {
+		sysModuleDir, kernelConfigPath, getSysctlNet, qdiscList = prevSysModuleDir, prevKernelConfigPath, prevGetSysctlNet, prevQdiscList
+	})
+	sysModuleDir = moduleDir
+	kernelConfigPath = configPath
+	getSysctlNet = func(sysctl string) (int, error) {
+		if value, ok := sysctls[sysctl]; ok {
+			return value, nil
+		}
+		return -1, fmt.Errorf("open /proc/sys/net/%s: no such file or directory", sysctl)
+	}
+	qdiscList = func(link netlink.Link) ([]netlink.Qdisc, error) {
+		return nil, nil
+	}
+}
+
+func TestRun(t *testing.T) {
+	defaultSysctls := map[string]int{
+		"netfilter/nf_conntrack_max": 262144,
+		"ipv6/conf/all/disable_ipv6": 0,
+		"ipv6/conf/all/forwarding":   1,
+	}
+	tests := []struct {
+		name           string
+		config         *Config
+		modules        []string
+		kernelConfig   string
+		sysctls        map[string]int
+		expectedChecks []string
+		expectedErr    string
+	}{
+		{
+			name:           "default",
+			config:         &Config{},
+			kernelConfig:   "CONFIG_NF_CONNTRACK=m\nCONFIG_NF_CONNTRACK_ZONES=y\n",
+			sysctls:        defaultSysctls,
+			expectedChecks: []string{"ConntrackZones"},
+		},
+		{
+			name:           "all features",
+			config:         &Config{EnableWireGuard: true, EnableIPv6: true, EnableHardwareOffload: true},
+			modules:        []string{"wireguard"},
+			sysctls:        defaultSysctls,
+			expectedChecks: []string{"ConntrackZones", "WireGuard", "IPv6Forwarding", "TCQdisc"},
+		},
+		{
+			name:           "conntrack zones not built",
+			config:         &Config{},
+			kernelConfig:   "CONFIG_NF_CONNTRACK=m\n# CONFIG_NF_CONNTRACK_ZONES is not set\n",
+			sysctls:        defaultSysctls,
+			expectedChecks: []string{"ConntrackZones"},
+			expectedErr:    "ConntrackZones (required by OVS datapath): the kernel is built without connection tracking zones",
+		},
+		{
+			name:           "conntrack not loaded",
+			config:         &Config{},
+			sysctls:        map[string]int{},
+			expectedChecks: []string{"ConntrackZones"},
+			expectedErr:    "connection tracking is not available",
+		},
+		{
+			name:           "WireGuard module missing",
+			config:         &Config{EnableWireGuard: true},
+			sysctls:        defaultSysctls,
+			expectedChecks: []string{"ConntrackZones", "WireGuard"},
+			expectedErr:    "WireGuard (required by WireGuard encryption): the wireguard kernel module is not loaded",
+		},
+		{
+			name:   "IPv6 disabled",
+			config: &Config{EnableIPv6: true},
+			sysctls: map[string]int{
+				"netfilter/nf_conntrack_max": 262144,
+				"ipv6/conf/all/disable_ipv6": 1,
+			},
+			expectedChecks: []string{"ConntrackZones", "IPv6Forwarding"},
+			expectedErr:    "IPv6 is disabled on the Node",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockKernel(t, tt.modules, tt.kernelConfig, tt.sysctls)
+			results := Run(tt.config)
+			var checks []string
+			for _, r := range results {
+				checks = append(checks, r.Name)
+			}
+			assert.Equal(t, tt.expectedChecks, checks)
+			err := Error(results)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+//go:build !linux
+// +build !linux
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preflight
+
+// No kernel feature is checked on other platforms. Windows Nodes rely on the OVS Hyper-V extension
+// and the Windows host networking stack, which are validated when the OVS bridge is created.
+func getChecks(config *Config) []check {
+	return nil
+}
@@ -0,0 +1,37 @@
+//go:build windows
+// +build windows
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiling
+
+import (
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// getProcessCPUTime returns the total CPU time (user and kernel) consumed by the agent process.
+func getProcessCPUTime() (time.Duration, error) {
+	var creationTime, exitTime, kernelTime, userTime windows.Filetime
+	if err := windows.GetProcessTimes(windows.CurrentProcess(), &creationTime, &exitTime, &kernelTime, &userTime); err != nil {
+		return 0, err
+	}
+	// Filetime values are expressed in 100-nanosecond intervals.
+	toDuration := func(ft windows.Filetime) time.Duration {
+		return time.Duration(uint64(ft.HighDateTime)<<32|uint64(ft.LowDateTime)) * 100
+	}
+	return toDuration(kernelTime) + toDuration(userTime), nil
+}
@@ -0,0 +1,236 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiling
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime/metrics"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/util/logdir"
+)
+
+const (
+	profilesDirName = "profiles"
+
+	profileKindCPU  = "cpu"
+	profileKindHeap = "heap"
+
+	profileTimeFormat = "20060102-150405"
+	profileSuffix     = ".pprof"
+)
+
+// ProfilesDir returns the directory in which the profiles captured automatically are stored. It is under the log
+// directory, so that the profiles are persisted across restarts of the agent, which may be caused by the spike.
+func ProfilesDir() string {
+	return filepath.Join(logdir.GetLogDir(), profilesDirName)
+}
+
+// Config is the configuration of the profiling Watchdog.
+type Config struct {
+	// CPUThreshold is the CPU usage of the agent, as a percentage of one CPU core, above which profiles are captured.
+	// 0 means CPU usage is not monitored.
+	CPUThreshold int
+	// MemoryThreshold is the memory usage of the agent in bytes, above which profiles are captured. 0 means memory
+	// usage is not monitored.
+	MemoryThreshold uint64
+	// CheckInterval is the interval at which the resource usage of the agent is checked.
+	CheckInterval time.Duration
+	// CPUProfileDuration is the duration of the CPU profiles.
+	CPUProfileDuration time.Duration
+	// MinCaptureInterval is the minimum interval between two captures, to limit the overhead of profiling when
+	// resource usage stays above the thresholds.
+	MinCaptureInterval time.Duration
+	// MaxProfiles is the maximum number of profiles of each kind retained on disk. The oldest ones are removed first.
+	MaxProfiles int
+}
+
+// Watchdog monitors the CPU and memory usage of the agent, and automatically captures a CPU profile and a heap
+// profile when the usage exceeds the configured thresholds. The profiles are stored in ProfilesDir and included in
+// the support bundles collected from the agent.
+type Watchdog struct {
+	config Config
+	dir    string
+	clock  clock.Clock
+
+	lastCheckTime   time.Time
+	lastCPUTime     time.Duration
+	lastCaptureTime time.Time
+
+	// The following functions are stored as fields to allow overriding them in tests.
+	getCPUTime       func() (time.Duration, error)
+	getMemoryUsage   func() uint64
+	writeCPUProfile  func(w io.Writer, duration time.Duration) error
+	writeHeapProfile func(w io.Writer) error
+}
+
+func NewWatchdog(config Config) *Watchdog {
+	return newWatchdog(config, ProfilesDir(), clock.RealClock{})
+}
+
+func newWatchdog(config Config, dir string, clock clock.Clock) *Watchdog {
+	return &Watchdog{
+		config:           config,
+		dir:              dir,
+		clock:            clock,
+		getCPUTime:       getProcessCPUTime,
+		getMemoryUsage:   getMemoryUsage,
+		writeCPUProfile:  writeCPUProfile,
+		writeHeapProfile: pprof.WriteHeapProfile,
+	}
+}
+
+func (w *Watchdog) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting profiling watchdog", "cpuThreshold", w.config.CPUThreshold, "memoryThreshold", w.config.MemoryThreshold, "dir", w.dir)
+	if err := os.MkdirAll(w.dir, 0755); err != nil {
+		klog.ErrorS(err, "Failed to create directory for profiles, profiles will not be captured automatically", "dir", w.dir)
+		return
+	}
+	wait.Until(w.check, w.config.CheckInterval, stopCh)
+}
+
+// check measures the resource usage of the agent since the previous check, and captures profiles if it exceeds the
+// thresholds.
+func (w *Watchdog) check() {
+	now := w.clock.Now()
+	cpuTime, err := w.getCPUTime()
+	if err != nil {
+		klog.ErrorS(err, "Failed to get CPU time of the agent")
+		return
+	}
+	lastCheckTime, lastCPUTime := w.lastCheckTime, w.lastCPUTime
+	w.lastCheckTime, w.lastCPUTime = now, cpuTime
+	// The CPU usage cannot be computed for the first check.
+	var cpuUsage int
+	if !lastCheckTime.IsZero() && now.After(lastCheckTime) {
+		cpuUsage = int((cpuTime - lastCPUTime) * 100 / now.Sub(lastCheckTime))
+	}
+	memoryUsage := w.getMemoryUsage()
+
+	var reasons []string
+	if w.config.CPUThreshold > 0 && cpuUsage > w.config.CPUThreshold {
+		reasons = append(reasons, fmt.Sprintf("CPU usage %d%% exceeds threshold %d%%", cpuUsage, w.config.CPUThreshold))
+	}
+	if w.config.MemoryThreshold > 0 && memoryUsage > w.config.MemoryThreshold {
+		reasons = append(reasons, fmt.Sprintf("memory usage %d bytes exceeds threshold %d bytes", memoryUsage, w.config.MemoryThreshold))
+	}
+	if len(reasons) == 0 {
+		return
+	}
+	if !w.lastCaptureTime.IsZero() && now.Sub(w.lastCaptureTime) < w.config.MinCaptureInterval {
+		klog.V(2).InfoS("Resource usage exceeds threshold but profiles were captured recently, skipping capture", "reasons", reasons, "lastCaptureTime", w.lastCaptureTime)
+		return
+	}
+	w.lastCaptureTime = now
+	klog.InfoS("Resource usage exceeds threshold, capturing profiles", "reasons", reasons)
+	w.capture(now)
+	// The CPU profile takes time to capture, measure the CPU usage from now on for the next check.
+	if cpuTime, err := w.getCPUTime(); err == nil {
+		w.lastCheckTime, w.lastCPUTime = w.clock.Now(), cpuTime
+	}
+}
+
+func (w *Watchdog) capture(now time.Time) {
+	timestamp := now.UTC().Format(profileTimeFormat)
+	// Capture the heap profile first, as it reflects the current memory usage.
+	if err := w.writeProfile(profileKindHeap, timestamp, w.writeHeapProfile); err != nil {
+		klog.ErrorS(err, "Failed to capture heap profile")
+	}
+	if err := w.writeProfile(profileKindCPU, timestamp, func(f io.Writer) error {
+		return w.writeCPUProfile(f, w.config.CPUProfileDuration)
+	}); err != nil {
+		klog.ErrorS(err, "Failed to capture CPU profile")
+	}
+}
+
+func (w *Watchdog) writeProfile(kind, timestamp string, write func(w io.Writer) error) error {
+	path := filepath.Join(w.dir, kind+"-"+timestamp+profileSuffix)
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		os.Remove(path)
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	klog.InfoS("Captured profile", "kind", kind, "path", path)
+	w.removeOldProfiles(kind)
+	return nil
+}
+
+// removeOldProfiles removes the oldest profiles of the given kind, so that at most MaxProfiles of them are retained.
+func (w *Watchdog) removeOldProfiles(kind string) {
+	entries, err := os.ReadDir(w.dir)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list profiles", "dir", w.dir)
+		return
+	}
+	var profiles []string
+	for _, entry := range entries {
+		if entry.Type().IsRegular() && strings.HasPrefix(entry.Name(), kind+"-") && strings.HasSuffix(entry.Name(), profileSuffix) {
+			profiles = append(profiles, entry.Name())
+		}
+	}
+	if len(profiles) <= w.config.MaxProfiles {
+		return
+	}
+	// The timestamp in the names makes the lexical order chronological.
+	sort.Strings(profiles)
+	for _, name := range profiles[:len(profiles)-w.config.MaxProfiles] {
+		if err := os.Remove(filepath.Join(w.dir, name)); err != nil {
+			klog.ErrorS(err, "Failed to remove old profile", "name", name)
+		}
+	}
+}
+
+func writeCPUProfile(w io.Writer, duration time.Duration) error {
+	// It fails if a CPU profile is already being captured, e.g. via the pprof HTTP handlers.
+	if err := pprof.StartCPUProfile(w); err != nil {
+		return err
+	}
+	time.Sleep(duration)
+	pprof.StopCPUProfile()
+	return nil
+}
+
+// getMemoryUsage returns the memory mapped by the Go runtime and not released to the OS, which approximates the
+// resident memory of the agent.
+func getMemoryUsage() uint64 {
+	samples := []metrics.Sample{
+		{Name: "/memory/classes/total:bytes"},
+		{Name: "/memory/classes/heap/released:bytes"},
+	}
+	metrics.Read(samples)
+	for _, sample := range samples {
+		if sample.Value.Kind() != metrics.KindUint64 {
+			return 0
+		}
+	}
+	return samples[0].Value.Uint64() - samples[1].Value.Uint64()
+}
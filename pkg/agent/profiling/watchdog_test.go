@@ -0,0 +1,156 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profiling
+
+import (
+	"io"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+type fakeUsage struct {
+	cpuTime     time.Duration
+	memoryUsage uint64
+}
+
+func newFakeWatchdog(t *testing.T, config Config, usage *fakeUsage) (*Watchdog, *clocktesting.FakeClock, string) {
+	dir := t.TempDir()
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 6, 1, 10, 0, 0, 0, time.UTC))
+	w := newWatchdog(config, dir, fakeClock)
+	w.getCPUTime = func() (time.Duration, error) {
+		return usage.cpuTime, nil
+	}
+	w.getMemoryUsage = func() uint64 {
+		return usage.memoryUsage
+	}
+	w.writeCPUProfile = func(f io.Writer, duration time.Duration) error {
+		_, err := f.Write([]byte("cpu"))
+		return err
+	}
+	w.writeHeapProfile = func(f io.Writer) error {
+		_, err := f.Write([]byte("heap"))
+		return err
+	}
+	return w, fakeClock, dir
+}
+
+func listProfiles(t *testing.T, dir string) []string {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestWatchdogCheck(t *testing.T) {
+	config := Config{
+		CPUThreshold:       100,
+		MemoryThreshold:    1024,
+		CheckInterval:      10 * time.Second,
+		MinCaptureInterval: time.Hour,
+		MaxProfiles:        3,
+	}
+	tests := []struct {
+		name             string
+		cpuTimeIncrease  time.Duration
+		memoryUsage      uint64
+		expectedProfiles []string
+	}{
+		{
+			name:            "below thresholds",
+			cpuTimeIncrease: 5 * time.Second,
+			memoryUsage:     512,
+		},
+		{
+			name:             "CPU above threshold",
+			cpuTimeIncrease:  15 * time.Second,
+			memoryUsage:      512,
+			expectedProfiles: []string{"cpu-20250601-100010.pprof", "heap-20250601-100010.pprof"},
+		},
+		{
+			name:             "memory above threshold",
+			cpuTimeIncrease:  5 * time.Second,
+			memoryUsage:      2048,
+			expectedProfiles: []string{"cpu-20250601-100010.pprof", "heap-20250601-100010.pprof"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usage := &fakeUsage{}
+			w, fakeClock, dir := newFakeWatchdog(t, config, usage)
+			// The first check only records the CPU time.
+			w.check()
+			assert.Empty(t, listProfiles(t, dir))
+
+			fakeClock.Step(config.CheckInterval)
+			usage.cpuTime += tt.cpuTimeIncrease
+			usage.memoryUsage = tt.memoryUsage
+			w.check()
+			assert.Equal(t, tt.expectedProfiles, listProfiles(t, dir))
+		})
+	}
+}
+
+func TestWatchdogMinCaptureInterval(t *testing.T) {
+	config := Config{
+		MemoryThreshold:    1024,
+		CheckInterval:      10 * time.Second,
+		MinCaptureInterval: time.Minute,
+		MaxProfiles:        3,
+	}
+	usage := &fakeUsage{memoryUsage: 2048}
+	w, fakeClock, dir := newFakeWatchdog(t, config, usage)
+
+	w.check()
+	assert.Len(t, listProfiles(t, dir), 2)
+	// Profiles were captured less than MinCaptureInterval ago.
+	fakeClock.Step(config.CheckInterval)
+	w.check()
+	assert.Len(t, listProfiles(t, dir), 2)
+
+	fakeClock.Step(config.MinCaptureInterval)
+	w.check()
+	assert.Len(t, listProfiles(t, dir), 4)
+}
+
+func TestWatchdogRemoveOldProfiles(t *testing.T) {
+	config := Config{
+		MemoryThreshold: 1024,
+		CheckInterval:   10 * time.Second,
+		MaxProfiles:     2,
+	}
+	usage := &fakeUsage{memoryUsage: 2048}
+	w, fakeClock, dir := newFakeWatchdog(t, config, usage)
+
+	for i := 0; i < 3; i++ {
+		w.check()
+		fakeClock.Step(config.CheckInterval)
+	}
+	assert.Equal(t, []string{
+		"cpu-20250601-100010.pprof",
+		"cpu-20250601-100020.pprof",
+		"heap-20250601-100010.pprof",
+		"heap-20250601-100020.pprof",
+	}, listProfiles(t, dir))
+}
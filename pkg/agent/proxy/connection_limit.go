@@ -0,0 +1,307 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	agentconfig "antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/proxy/types"
+	agenttypes "antrea.io/antrea/pkg/agent/types"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+const (
+	// The OpenFlow meters limiting the rate of new connections to Services use IDs starting from
+	// serviceConnectionRateMeterIDStart, to avoid conflicts with the meters used for Egress QoS and packet-in rate
+	// limiting. Each IP family gets serviceConnectionRateMeterIDsPerFamily IDs, as the IPv4 and IPv6 proxiers share
+	// the same OpenFlow client in dual-stack clusters.
+	serviceConnectionRateMeterIDStart      = 1024
+	serviceConnectionRateMeterIDsPerFamily = 16384
+	// connectionLimitCheckInterval is the interval at which the number of concurrent connections to Services with a
+	// maximum number of concurrent connections is checked.
+	connectionLimitCheckInterval = 2 * time.Second
+)
+
+// serviceEntrypoint identifies an address through which a Service can be accessed, as seen by conntrack in the
+// original direction of a connection.
+type serviceEntrypoint struct {
+	ip       string
+	port     uint16
+	protocol uint8
+}
+
+type serviceConnectionLimit struct {
+	maxConnections uint32
+	entrypoints    []*agenttypes.ServiceConfig
+	// rejecting indicates whether the flows rejecting new connections to the entrypoints are installed.
+	rejecting bool
+}
+
+type connectionRateMeter struct {
+	id   uint32
+	rate uint32
+}
+
+type meterIDAllocator struct {
+	nextID       uint32
+	maxID        uint32
+	availableIDs []uint32
+}
+
+func newMeterIDAllocator(isIPv6 bool) *meterIDAllocator {
+	minID := uint32(serviceConnectionRateMeterIDStart)
+	if isIPv6 {
+		minID += serviceConnectionRateMeterIDsPerFamily
+	}
+	return &meterIDAllocator{
+		nextID: minID,
+		maxID:  minID + serviceConnectionRateMeterIDsPerFamily - 1,
+	}
+}
+
+func (a *meterIDAllocator) allocate() (uint32, error) {
+	if len(a.availableIDs) > 0 {
+		id := a.availableIDs[0]
+		a.availableIDs = a.availableIDs[1:]
+		return id, nil
+	}
+	if a.nextID <= a.maxID {
+		id := a.nextID
+		a.nextID += 1
+		return id, nil
+	}
+	return 0, fmt.Errorf("no meter ID available")
+}
+
+func (a *meterIDAllocator) release(id uint32) {
+	a.availableIDs = append(a.availableIDs, id)
+}
+
+// ensureConnectionRateMeter ensures the OpenFlow meter limiting the rate of new connections to the Service is
+// installed with the rate specified by the Service, or removed if the Service doesn't specify it. It returns the ID
+// of the meter, or 0 if the rate is not limited. It must be called after the Service flows using a stale meter have
+// been removed, as deleting a meter also deletes the flows using it.
+func (p *proxier) ensureConnectionRateMeter(svcPortName k8sproxy.ServicePortName, svcInfo *types.ServiceInfo) (uint32, bool) {
+	meter, exists := p.connectionRateMeters[svcPortName]
+	if svcInfo.ConnectionRateLimit == 0 {
+		if exists {
+			return 0, p.removeConnectionRateMeter(svcPortName)
+		}
+		return 0, true
+	}
+	if exists && meter.rate == svcInfo.ConnectionRateLimit {
+		return meter.id, true
+	}
+	if !exists {
+		id, err := p.connectionRateMeterAllocator.allocate()
+		if err != nil {
+			klog.ErrorS(err, "Failed to allocate meter ID for Service, the rate of new connections will not be limited", "ServicePortName", svcPortName)
+			return 0, true
+		}
+		meter = &connectionRateMeter{id: id}
+	}
+	// Allow a burst of new connections up to the rate limit.
+	if err := p.ofClient.InstallServiceConnectionRateLimit(meter.id, svcInfo.ConnectionRateLimit, svcInfo.ConnectionRateLimit); err != nil {
+		klog.ErrorS(err, "Failed to install meter limiting the rate of new connections to Service", "ServicePortName", svcPortName, "meterID", meter.id)
+		if !exists {
+			p.connectionRateMeterAllocator.release(meter.id)
+			// OpenFlow meters may not be supported by the datapath, don't block the installation of the Service.
+			return 0, true
+		}
+		return 0, false
+	}
+	meter.rate = svcInfo.ConnectionRateLimit
+	p.connectionRateMeters[svcPortName] = meter
+	return meter.id, true
+}
+
+// getConnectionRateMeterID returns the ID of the meter installed for the Service, or 0 if there isn't one.
+func (p *proxier) getConnectionRateMeterID(svcPortName k8sproxy.ServicePortName) uint32 {
+	if meter, exists := p.connectionRateMeters[svcPortName]; exists {
+		return meter.id
+	}
+	return 0
+}
+
+func (p *proxier) removeConnectionRateMeter(svcPortName k8sproxy.ServicePortName) bool {
+	meter, exists := p.connectionRateMeters[svcPortName]
+	if !exists {
+		return true
+	}
+	if err := p.ofClient.UninstallServiceConnectionRateLimit(meter.id); err != nil {
+		klog.ErrorS(err, "Failed to uninstall meter limiting the rate of new connections to Service", "ServicePortName", svcPortName, "meterID", meter.id)
+		return false
+	}
+	p.connectionRateMeterAllocator.release(meter.id)
+	delete(p.connectionRateMeters, svcPortName)
+	return true
+}
+
+// getServiceEntrypoints returns the configurations of all the addresses through which the Service is accessed in
+// OVS. Only the fields identifying the address are set.
+func (p *proxier) getServiceEntrypoints(svcInfo *types.ServiceInfo) []*agenttypes.ServiceConfig {
+	svcPort := uint16(svcInfo.Port())
+	svcProto := svcInfo.OFProtocol
+	entrypoints := []*agenttypes.ServiceConfig{{
+		ServiceIP:   svcInfo.ClusterIP(),
+		ServicePort: svcPort,
+		Protocol:    svcProto,
+	}}
+	if p.proxyAll {
+		if svcInfo.NodePort() != 0 {
+			nodePortIP := agentconfig.VirtualNodePortDNATIPv4
+			if p.isIPv6 {
+				nodePortIP = agentconfig.VirtualNodePortDNATIPv6
+			}
+			entrypoints = append(entrypoints, &agenttypes.ServiceConfig{
+				ServiceIP:   nodePortIP,
+				ServicePort: uint16(svcInfo.NodePort()),
+				Protocol:    svcProto,
+				IsNodePort:  true,
+			})
+		}
+		for _, externalIP := range svcInfo.ExternalIPStrings() {
+			entrypoints = append(entrypoints, &agenttypes.ServiceConfig{
+				ServiceIP:   net.ParseIP(externalIP),
+				ServicePort: svcPort,
+				Protocol:    svcProto,
+			})
+		}
+	}
+	if p.proxyLoadBalancerIPs {
+		for _, ingress := range svcInfo.LoadBalancerIPStrings() {
+			if ingress != "" {
+				entrypoints = append(entrypoints, &agenttypes.ServiceConfig{
+					ServiceIP:   net.ParseIP(ingress),
+					ServicePort: svcPort,
+					Protocol:    svcProto,
+				})
+			}
+		}
+	}
+	return entrypoints
+}
+
+// updateConnectionLimit updates the maximum number of concurrent connections and the entrypoints of the Service, or
+// stops limiting them if svcInfo is nil or doesn't specify a maximum. It always removes the flows rejecting new
+// connections to the stale entrypoints, which will be installed again by the next check if the Service is still
+// saturated.
+func (p *proxier) updateConnectionLimit(svcPortName k8sproxy.ServicePortName, svcInfo *types.ServiceInfo) bool {
+	p.connectionLimitsMutex.Lock()
+	defer p.connectionLimitsMutex.Unlock()
+	limit, exists := p.connectionLimits[svcPortName]
+	if exists && limit.rejecting {
+		if !p.uninstallServiceRejectFlows(limit.entrypoints) {
+			return false
+		}
+		limit.rejecting = false
+	}
+	if svcInfo == nil || svcInfo.MaxConnections == 0 {
+		delete(p.connectionLimits, svcPortName)
+		return true
+	}
+	p.connectionLimits[svcPortName] = &serviceConnectionLimit{
+		maxConnections: svcInfo.MaxConnections,
+		entrypoints:    p.getServiceEntrypoints(svcInfo),
+	}
+	return true
+}
+
+// installServiceRejectFlows installs the flows rejecting new connections to the entrypoints. If any of them fails to
+// be installed, the installed ones are removed, so that the installation can be retried by the next check.
+func (p *proxier) installServiceRejectFlows(entrypoints []*agenttypes.ServiceConfig) bool {
+	for _, entrypoint := range entrypoints {
+		if err := p.ofClient.InstallServiceRejectFlow(entrypoint); err != nil {
+			klog.ErrorS(err, "Failed to install flow rejecting new connections to Service", "ip", entrypoint.ServiceIP, "port", entrypoint.ServicePort)
+			p.uninstallServiceRejectFlows(entrypoints)
+			return false
+		}
+	}
+	return true
+}
+
+func (p *proxier) uninstallServiceRejectFlows(entrypoints []*agenttypes.ServiceConfig) bool {
+	for _, entrypoint := range entrypoints {
+		if err := p.ofClient.UninstallServiceRejectFlow(entrypoint.ServiceIP, entrypoint.ServicePort, entrypoint.Protocol); err != nil {
+			klog.ErrorS(err, "Failed to uninstall flow rejecting new connections to Service", "ip", entrypoint.ServiceIP, "port", entrypoint.ServicePort)
+			return false
+		}
+	}
+	return true
+}
+
+func protocolNumber(protocol corev1.Protocol) uint8 {
+	switch protocol {
+	case corev1.ProtocolUDP:
+		return 17
+	case corev1.ProtocolSCTP:
+		return 132
+	default:
+		return 6
+	}
+}
+
+// checkConnectionLimits counts the concurrent connections to the Services which have a maximum number of concurrent
+// connections, and starts rejecting new connections to the Services which have reached their maximum, or stops doing
+// it for the Services which are below their maximum again.
+func (p *proxier) checkConnectionLimits() {
+	p.connectionLimitsMutex.Lock()
+	hasLimits := len(p.connectionLimits) > 0
+	p.connectionLimitsMutex.Unlock()
+	if !hasLimits {
+		return
+	}
+
+	zone := uint16(openflow.CtZone)
+	if p.isIPv6 {
+		zone = openflow.CtZoneV6
+	}
+	connections, err := p.countServiceConnections(zone)
+	if err != nil {
+		klog.ErrorS(err, "Failed to count Service connections, the maximum number of concurrent connections of Services cannot be enforced")
+		return
+	}
+
+	p.connectionLimitsMutex.Lock()
+	defer p.connectionLimitsMutex.Unlock()
+	for svcPortName, limit := range p.connectionLimits {
+		var count uint32
+		for _, entrypoint := range limit.entrypoints {
+			count += uint32(connections[serviceEntrypoint{
+				ip:       entrypoint.ServiceIP.String(),
+				port:     entrypoint.ServicePort,
+				protocol: protocolNumber(svcPortName.Protocol),
+			}])
+		}
+		if count >= limit.maxConnections && !limit.rejecting {
+			klog.InfoS("Service reached its maximum number of concurrent connections, rejecting new connections", "ServicePortName", svcPortName, "connections", count, "maxConnections", limit.maxConnections)
+			if p.installServiceRejectFlows(limit.entrypoints) {
+				limit.rejecting = true
+			}
+		} else if count < limit.maxConnections && limit.rejecting {
+			klog.InfoS("Service is below its maximum number of concurrent connections, accepting new connections", "ServicePortName", svcPortName, "connections", count, "maxConnections", limit.maxConnections)
+			if p.uninstallServiceRejectFlows(limit.entrypoints) {
+				limit.rejecting = false
+			}
+		}
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+
+	"github.com/ti-mo/conntrack"
+)
+
+// TCP states defined in https://github.com/torvalds/linux/blob/master/net/netfilter/nf_conntrack_proto_tcp.c.
+const (
+	tcpConntrackTimeWait = 7
+	tcpConntrackClose    = 8
+)
+
+// countServiceConnections counts the live connections in the given conntrack zone by their original destination.
+// Connections which are closing are not counted, as they no longer consume resources of the backends.
+func countServiceConnections(zone uint16) (map[serviceEntrypoint]int, error) {
+	conn, err := conntrack.Dial(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error when dialing conntrack: %w", err)
+	}
+	defer conn.Close()
+	flows, err := conn.DumpFilter(conntrack.Filter{}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error when dumping conntrack: %w", err)
+	}
+	connections := make(map[serviceEntrypoint]int)
+	for i := range flows {
+		flow := &flows[i]
+		if flow.Zone != zone || flow.Status.Dying() {
+			continue
+		}
+		if tcp := flow.ProtoInfo.TCP; tcp != nil && (tcp.State == tcpConntrackTimeWait || tcp.State == tcpConntrackClose) {
+			continue
+		}
+		connections[serviceEntrypoint{
+			ip:       flow.TupleOrig.IP.DestinationAddress.String(),
+			port:     flow.TupleOrig.Proto.DestinationPort,
+			protocol: flow.TupleOrig.Proto.Protocol,
+		}]++
+	}
+	return connections, nil
+}
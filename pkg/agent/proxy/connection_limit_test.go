@@ -0,0 +1,128 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+	ofmock "antrea.io/antrea/pkg/agent/openflow/testing"
+	antreatypes "antrea.io/antrea/pkg/agent/types"
+	binding "antrea.io/antrea/pkg/ovs/openflow"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+func TestMeterIDAllocator(t *testing.T) {
+	a := newMeterIDAllocator(false)
+	id1, err := a.allocate()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(serviceConnectionRateMeterIDStart), id1)
+	id2, err := a.allocate()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(serviceConnectionRateMeterIDStart+1), id2)
+	a.release(id1)
+	id3, err := a.allocate()
+	require.NoError(t, err)
+	assert.Equal(t, id1, id3)
+
+	a6 := newMeterIDAllocator(true)
+	id6, err := a6.allocate()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(serviceConnectionRateMeterIDStart+serviceConnectionRateMeterIDsPerFamily), id6)
+	for i := 1; i < serviceConnectionRateMeterIDsPerFamily; i++ {
+		_, err = a6.allocate()
+		require.NoError(t, err)
+	}
+	_, err = a6.allocate()
+	assert.Error(t, err)
+}
+
+func TestCheckConnectionLimits(t *testing.T) {
+	svcPortName := k8sproxy.ServicePortName{
+		NamespacedName: apimachinerytypes.NamespacedName{Namespace: "ns", Name: "svc"},
+		Port:           "80",
+		Protocol:       corev1.ProtocolTCP,
+	}
+	entrypoint := &antreatypes.ServiceConfig{
+		ServiceIP:   net.ParseIP("10.96.0.10"),
+		ServicePort: 80,
+		Protocol:    binding.ProtocolTCP,
+	}
+	key := serviceEntrypoint{ip: "10.96.0.10", port: 80, protocol: 6}
+	tests := []struct {
+		name              string
+		connections       int
+		rejecting         bool
+		expectedRejecting bool
+		expectedCalls     func(mockOFClient *ofmock.MockClient)
+	}{
+		{
+			name:              "below maximum",
+			connections:       9,
+			expectedRejecting: false,
+		},
+		{
+			name:              "reach maximum",
+			connections:       10,
+			expectedRejecting: true,
+			expectedCalls: func(mockOFClient *ofmock.MockClient) {
+				mockOFClient.EXPECT().InstallServiceRejectFlow(entrypoint)
+			},
+		},
+		{
+			name:              "still saturated",
+			connections:       12,
+			rejecting:         true,
+			expectedRejecting: true,
+		},
+		{
+			name:              "below maximum again",
+			connections:       9,
+			rejecting:         true,
+			expectedRejecting: false,
+			expectedCalls: func(mockOFClient *ofmock.MockClient) {
+				mockOFClient.EXPECT().UninstallServiceRejectFlow(entrypoint.ServiceIP, entrypoint.ServicePort, entrypoint.Protocol)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockOFClient, mockRouteClient := getMockClients(ctrl)
+			fp := newFakeProxier(mockRouteClient, mockOFClient, nil, openflow.NewGroupAllocator(), false)
+			fp.countServiceConnections = func(zone uint16) (map[serviceEntrypoint]int, error) {
+				assert.Equal(t, uint16(openflow.CtZone), zone)
+				return map[serviceEntrypoint]int{key: tt.connections}, nil
+			}
+			fp.connectionLimits[svcPortName] = &serviceConnectionLimit{
+				maxConnections: 10,
+				entrypoints:    []*antreatypes.ServiceConfig{entrypoint},
+				rejecting:      tt.rejecting,
+			}
+			if tt.expectedCalls != nil {
+				tt.expectedCalls(mockOFClient)
+			}
+			fp.checkConnectionLimits()
+			assert.Equal(t, tt.expectedRejecting, fp.connectionLimits[svcPortName].rejecting)
+		})
+	}
+}
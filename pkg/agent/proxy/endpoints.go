@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
 	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/agent/proxy/types"
@@ -211,20 +212,29 @@ func (t *endpointsChangesTracker) endpointsToEndpointsMap(endpoints *corev1.Endp
 	return endpointsMap
 }
 
-// Update updates an EndpointsMap and numLocalEndpoints based on current changes.
+// Update updates an EndpointsMap and numLocalEndpoints based on current changes. numLocalEndpoints stores the number
+// of local and ready endpoints of each Service, which is reported by the Service health check server.
 func (t *endpointsChangesTracker) Update(em types.EndpointsMap, numLocalEndpoints map[apimachinerytypes.NamespacedName]int) {
 	for _, change := range t.checkoutChanges() {
 		for spn := range change.previous {
 			delete(em, spn)
 			delete(numLocalEndpoints, spn.NamespacedName)
 		}
+		// A change is about a single Service. Its endpoints are counted by IP, as an endpoint appears once for each
+		// port of the Service.
+		localEndpointIPs := sets.New[string]()
+		var namespacedName apimachinerytypes.NamespacedName
 		for spn, endpoints := range change.current {
 			em[spn] = endpoints
+			namespacedName = spn.NamespacedName
 			for _, endpoint := range endpoints {
 				if endpoint.GetIsLocal() && endpoint.IsReady() {
-					numLocalEndpoints[spn.NamespacedName] += 1
+					localEndpointIPs.Insert(endpoint.IP())
 				}
 			}
 		}
+		if localEndpointIPs.Len() > 0 {
+			numLocalEndpoints[namespacedName] = localEndpointIPs.Len()
+		}
 	}
 }
@@ -0,0 +1,73 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+
+	"antrea.io/antrea/pkg/agent/proxy/types"
+)
+
+func TestEndpointsChangesTrackerUpdateNumLocalEndpoints(t *testing.T) {
+	localNode, remoteNode := "node1", "node2"
+	newEndpoints := func(addresses ...corev1.EndpointAddress) *corev1.Endpoints {
+		return &corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "svc1"},
+			Subsets: []corev1.EndpointSubset{{
+				Addresses: addresses,
+				Ports: []corev1.EndpointPort{
+					{Name: "http", Port: 80, Protocol: corev1.ProtocolTCP},
+					{Name: "https", Port: 443, Protocol: corev1.ProtocolTCP},
+				},
+			}},
+		}
+	}
+	localAddress1 := corev1.EndpointAddress{IP: "10.10.0.1", NodeName: &localNode}
+	localAddress2 := corev1.EndpointAddress{IP: "10.10.0.2", NodeName: &localNode}
+	remoteAddress := corev1.EndpointAddress{IP: "10.10.1.1", NodeName: &remoteNode}
+	svc := apimachinerytypes.NamespacedName{Namespace: "ns1", Name: "svc1"}
+
+	tracker := newEndpointsChangesTracker(localNode, false, false)
+	em := types.EndpointsMap{}
+	numLocalEndpoints := map[apimachinerytypes.NamespacedName]int{}
+
+	// The local endpoints are counted once, regardless of the number of ports of the Service.
+	endpoints1 := newEndpoints(localAddress1, localAddress2, remoteAddress)
+	tracker.OnEndpointUpdate(nil, endpoints1)
+	tracker.Update(em, numLocalEndpoints)
+	assert.Len(t, em, 2)
+	assert.Equal(t, map[apimachinerytypes.NamespacedName]int{svc: 2}, numLocalEndpoints)
+
+	endpoints2 := newEndpoints(localAddress2, remoteAddress)
+	tracker.OnEndpointUpdate(endpoints1, endpoints2)
+	tracker.Update(em, numLocalEndpoints)
+	assert.Equal(t, map[apimachinerytypes.NamespacedName]int{svc: 1}, numLocalEndpoints)
+
+	endpoints3 := newEndpoints(remoteAddress)
+	tracker.OnEndpointUpdate(endpoints2, endpoints3)
+	tracker.Update(em, numLocalEndpoints)
+	assert.Len(t, em, 2)
+	assert.Empty(t, numLocalEndpoints)
+
+	tracker.OnEndpointUpdate(endpoints3, nil)
+	tracker.Update(em, numLocalEndpoints)
+	assert.Empty(t, em)
+	assert.Empty(t, numLocalEndpoints)
+}
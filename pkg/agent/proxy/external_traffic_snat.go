@@ -0,0 +1,293 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apitypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	utilnet "k8s.io/utils/net"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+const (
+	externalTrafficSNATControllerName = "ExternalTrafficSNATController"
+	// How long to wait before retrying the processing of a Node change.
+	externalTrafficSNATMinRetryDelay = 5 * time.Second
+	externalTrafficSNATMaxRetryDelay = 300 * time.Second
+)
+
+// ExternalTrafficSNATController installs the flows which SNAT the external Service traffic forwarded to remote
+// Endpoints with the external traffic SNAT IP of the Node, instead of the Node IP. It requests the IP from the
+// configured ExternalIPPool by annotating the Node, and antrea-controller reports the allocated IP with another Node
+// annotation. It also installs the flows which forward the reply packets destined for the SNAT IPs of the other Nodes
+// to them through the tunnel.
+type ExternalTrafficSNATController struct {
+	nodeName         string
+	snatIPPool       string
+	ofClient         openflow.Client
+	client           clientset.Interface
+	nodeLister       corelisters.NodeLister
+	nodeListerSynced cache.InformerSynced
+	v4Enabled        bool
+	v6Enabled        bool
+	// installedIPs caches the SNAT IP for which flows have been installed, for each Node.
+	installedIPs map[string]string
+	// queue maintains the Node names that need to be synced.
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+func NewExternalTrafficSNATController(nodeName string,
+	snatIPPool string,
+	ofClient openflow.Client,
+	client clientset.Interface,
+	nodeInformer coreinformers.NodeInformer,
+	v4Enabled bool,
+	v6Enabled bool) *ExternalTrafficSNATController {
+	c := &ExternalTrafficSNATController{
+		nodeName:         nodeName,
+		snatIPPool:       snatIPPool,
+		ofClient:         ofClient,
+		client:           client,
+		nodeLister:       nodeInformer.Lister(),
+		nodeListerSynced: nodeInformer.Informer().HasSynced,
+		v4Enabled:        v4Enabled,
+		v6Enabled:        v6Enabled,
+		installedIPs:     map[string]string{},
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](externalTrafficSNATMinRetryDelay, externalTrafficSNATMaxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "externalTrafficSNAT",
+			},
+		),
+	}
+	nodeInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.enqueueNode,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldNode, newNode := oldObj.(*corev1.Node), newObj.(*corev1.Node)
+			if getNodeSNATIP(oldNode) != getNodeSNATIP(newNode) || getNodeSNATIPPool(oldNode) != getNodeSNATIPPool(newNode) {
+				c.enqueueNode(newObj)
+			}
+		},
+		DeleteFunc: c.enqueueNode,
+	})
+	return c
+}
+
+func (c *ExternalTrafficSNATController) enqueueNode(obj interface{}) {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.ErrorS(nil, "Received unexpected object", "object", obj)
+			return
+		}
+		node, ok = deletedState.Obj.(*corev1.Node)
+		if !ok {
+			klog.ErrorS(nil, "DeletedFinalStateUnknown contains non-Node object", "object", deletedState.Obj)
+			return
+		}
+	}
+	c.queue.Add(node.Name)
+}
+
+func (c *ExternalTrafficSNATController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting", "controller", externalTrafficSNATControllerName)
+	defer klog.InfoS("Shutting down", "controller", externalTrafficSNATControllerName)
+
+	if !cache.WaitForNamedCacheSync(externalTrafficSNATControllerName, stopCh, c.nodeListerSynced) {
+		return
+	}
+
+	// Request the SNAT IP from the configured ExternalIPPool, or withdraw the previous request if the option has been
+	// unset.
+	if err := wait.PollUntilContextCancel(wait.ContextForChannel(stopCh), externalTrafficSNATMinRetryDelay, true, func(ctx context.Context) (bool, error) {
+		if err := c.updateSNATIPPoolAnnotation(); err != nil {
+			klog.ErrorS(err, "Failed to update the external traffic SNAT IP pool annotation of the Node, will retry")
+			return false, nil
+		}
+		return true, nil
+	}); err != nil {
+		return
+	}
+
+	// A single worker is used, so that installedIPs doesn't need to be protected by a mutex.
+	go wait.Until(c.worker, time.Second, stopCh)
+	<-stopCh
+}
+
+// updateSNATIPPoolAnnotation sets the ExternalIPPool from which the SNAT IP of the Node should be allocated in the
+// annotations of the Node, or removes it if no ExternalIPPool is configured.
+func (c *ExternalTrafficSNATController) updateSNATIPPoolAnnotation() error {
+	node, err := c.nodeLister.Get(c.nodeName)
+	if err != nil {
+		return err
+	}
+	if getNodeSNATIPPool(node) == c.snatIPPool {
+		return nil
+	}
+	var value interface{}
+	if c.snatIPPool != "" {
+		value = c.snatIPPool
+	}
+	patch, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				types.NodeExternalTrafficSNATIPPoolAnnotationKey: value,
+			},
+		},
+	})
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		_, err := c.client.CoreV1().Nodes().Patch(context.TODO(), c.nodeName, apitypes.MergePatchType, patch, metav1.PatchOptions{}, "status")
+		return err
+	})
+}
+
+func (c *ExternalTrafficSNATController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *ExternalTrafficSNATController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+	if err := c.syncNode(key); err == nil {
+		c.queue.Forget(key)
+	} else {
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Error syncing Node, requeuing", "node", key)
+	}
+	return true
+}
+
+func (c *ExternalTrafficSNATController) syncNode(nodeName string) error {
+	var snatIP net.IP
+	node, err := c.nodeLister.Get(nodeName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	if node != nil {
+		snatIP = net.ParseIP(getNodeSNATIP(node))
+		if snatIP != nil && !c.isIPFamilyEnabled(snatIP) {
+			klog.InfoS("Ignored external traffic SNAT IP as its IP family is not enabled", "node", nodeName, "ip", snatIP)
+			snatIP = nil
+		}
+	}
+	if nodeName == c.nodeName {
+		return c.syncLocalNode(node, snatIP)
+	}
+	return c.syncRemoteNode(nodeName, node, snatIP)
+}
+
+func (c *ExternalTrafficSNATController) syncLocalNode(node *corev1.Node, snatIP net.IP) error {
+	// Ignore the IP allocated from an ExternalIPPool which is no longer configured, antrea-controller will remove it
+	// after processing the updated request.
+	if c.snatIPPool == "" || node == nil || getNodeSNATIPPool(node) != c.snatIPPool {
+		snatIP = nil
+	}
+	if c.installedIPs[c.nodeName] == ipString(snatIP) {
+		return nil
+	}
+	if err := c.ofClient.InstallExternalTrafficSNATFlows(snatIP); err != nil {
+		return fmt.Errorf("error when installing external traffic SNAT flows: %w", err)
+	}
+	if snatIP == nil {
+		delete(c.installedIPs, c.nodeName)
+		klog.InfoS("Uninstalled external traffic SNAT flows, the Node IP will be used to SNAT external Service traffic")
+	} else {
+		c.installedIPs[c.nodeName] = snatIP.String()
+		klog.InfoS("Installed external traffic SNAT flows", "ip", snatIP)
+	}
+	return nil
+}
+
+func (c *ExternalTrafficSNATController) syncRemoteNode(nodeName string, node *corev1.Node, snatIP net.IP) error {
+	var tunnelPeerIP net.IP
+	if snatIP != nil {
+		tunnelAddrs, err := k8s.GetNodeTunnelAddrs(node)
+		if err != nil {
+			return fmt.Errorf("error when getting tunnel IPs of Node %s: %w", nodeName, err)
+		}
+		if utilnet.IsIPv6(snatIP) {
+			tunnelPeerIP = tunnelAddrs.IPv6
+		} else {
+			tunnelPeerIP = tunnelAddrs.IPv4
+		}
+		if tunnelPeerIP == nil {
+			klog.InfoS("Ignored external traffic SNAT IP as the Node has no tunnel IP of the same family", "node", nodeName, "ip", snatIP)
+			snatIP = nil
+		}
+	}
+	if c.installedIPs[nodeName] == ipString(snatIP) {
+		return nil
+	}
+	if snatIP == nil {
+		if err := c.ofClient.UninstallPeerExternalTrafficSNATFlows(nodeName); err != nil {
+			return fmt.Errorf("error when uninstalling external traffic SNAT flows of Node %s: %w", nodeName, err)
+		}
+		delete(c.installedIPs, nodeName)
+		return nil
+	}
+	if err := c.ofClient.InstallPeerExternalTrafficSNATFlows(nodeName, snatIP, tunnelPeerIP); err != nil {
+		return fmt.Errorf("error when installing external traffic SNAT flows of Node %s: %w", nodeName, err)
+	}
+	c.installedIPs[nodeName] = snatIP.String()
+	return nil
+}
+
+func (c *ExternalTrafficSNATController) isIPFamilyEnabled(ip net.IP) bool {
+	if utilnet.IsIPv6(ip) {
+		return c.v6Enabled
+	}
+	return c.v4Enabled
+}
+
+func ipString(ip net.IP) string {
+	if ip == nil {
+		return ""
+	}
+	return ip.String()
+}
+
+func getNodeSNATIPPool(node *corev1.Node) string {
+	return node.Annotations[types.NodeExternalTrafficSNATIPPoolAnnotationKey]
+}
+
+func getNodeSNATIP(node *corev1.Node) string {
+	return node.Annotations[types.NodeExternalTrafficSNATIPAnnotationKey]
+}
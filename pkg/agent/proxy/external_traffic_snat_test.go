@@ -0,0 +1,188 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	ofmock "antrea.io/antrea/pkg/agent/openflow/testing"
+	"antrea.io/antrea/pkg/agent/types"
+)
+
+func newSNATTestNode(name, nodeIP, ipPool, snatIP string) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{},
+		},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: nodeIP}},
+		},
+	}
+	if ipPool != "" {
+		node.Annotations[types.NodeExternalTrafficSNATIPPoolAnnotationKey] = ipPool
+	}
+	if snatIP != "" {
+		node.Annotations[types.NodeExternalTrafficSNATIPAnnotationKey] = snatIP
+	}
+	return node
+}
+
+func newTestExternalTrafficSNATController(t *testing.T, snatIPPool string, objects ...runtime.Object) (*ExternalTrafficSNATController, *ofmock.MockClient, *fake.Clientset) {
+	ctrl := gomock.NewController(t)
+	mockOFClient := ofmock.NewMockClient(ctrl)
+	client := fake.NewSimpleClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	c := NewExternalTrafficSNATController("node1", snatIPPool, mockOFClient, client, informerFactory.Core().V1().Nodes(), true, false)
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+	return c, mockOFClient, client
+}
+
+func TestExternalTrafficSNATControllerSyncLocalNode(t *testing.T) {
+	tests := []struct {
+		name         string
+		snatIPPool   string
+		node         *corev1.Node
+		installedIP  string
+		expectedCall func(mockOFClient *ofmock.MockClient)
+		expectedIP   string
+	}{
+		{
+			name:       "IP allocated",
+			snatIPPool: "eip1",
+			node:       newSNATTestNode("node1", "192.168.0.1", "eip1", "10.10.10.1"),
+			expectedCall: func(mockOFClient *ofmock.MockClient) {
+				mockOFClient.EXPECT().InstallExternalTrafficSNATFlows(net.ParseIP("10.10.10.1"))
+			},
+			expectedIP: "10.10.10.1",
+		},
+		{
+			name:        "IP unchanged",
+			snatIPPool:  "eip1",
+			node:        newSNATTestNode("node1", "192.168.0.1", "eip1", "10.10.10.1"),
+			installedIP: "10.10.10.1",
+			expectedIP:  "10.10.10.1",
+		},
+		{
+			name:        "IP released",
+			snatIPPool:  "eip1",
+			node:        newSNATTestNode("node1", "192.168.0.1", "eip1", ""),
+			installedIP: "10.10.10.1",
+			expectedCall: func(mockOFClient *ofmock.MockClient) {
+				mockOFClient.EXPECT().InstallExternalTrafficSNATFlows(nil)
+			},
+		},
+		{
+			name:        "IP allocated from previous pool",
+			snatIPPool:  "eip2",
+			node:        newSNATTestNode("node1", "192.168.0.1", "eip1", "10.10.10.1"),
+			installedIP: "10.10.10.1",
+			expectedCall: func(mockOFClient *ofmock.MockClient) {
+				mockOFClient.EXPECT().InstallExternalTrafficSNATFlows(nil)
+			},
+		},
+		{
+			name:       "IPv6 not enabled",
+			snatIPPool: "eip1",
+			node:       newSNATTestNode("node1", "192.168.0.1", "eip1", "fec0::1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, mockOFClient, _ := newTestExternalTrafficSNATController(t, tt.snatIPPool, tt.node)
+			if tt.installedIP != "" {
+				c.installedIPs["node1"] = tt.installedIP
+			}
+			if tt.expectedCall != nil {
+				tt.expectedCall(mockOFClient)
+			}
+			require.NoError(t, c.syncNode("node1"))
+			assert.Equal(t, tt.expectedIP, c.installedIPs["node1"])
+		})
+	}
+}
+
+func TestExternalTrafficSNATControllerSyncRemoteNode(t *testing.T) {
+	c, mockOFClient, client := newTestExternalTrafficSNATController(t, "",
+		newSNATTestNode("node1", "192.168.0.1", "", ""),
+		newSNATTestNode("node2", "192.168.0.2", "eip1", "10.10.10.2"))
+
+	mockOFClient.EXPECT().InstallPeerExternalTrafficSNATFlows("node2", net.ParseIP("10.10.10.2"), net.ParseIP("192.168.0.2"))
+	require.NoError(t, c.syncNode("node2"))
+	assert.Equal(t, "10.10.10.2", c.installedIPs["node2"])
+
+	// Syncing the Node again should not reinstall the flows.
+	require.NoError(t, c.syncNode("node2"))
+
+	require.NoError(t, client.CoreV1().Nodes().Delete(context.TODO(), "node2", metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		_, err := c.nodeLister.Get("node2")
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+	mockOFClient.EXPECT().UninstallPeerExternalTrafficSNATFlows("node2")
+	require.NoError(t, c.syncNode("node2"))
+	assert.NotContains(t, c.installedIPs, "node2")
+}
+
+func TestExternalTrafficSNATControllerUpdateSNATIPPoolAnnotation(t *testing.T) {
+	tests := []struct {
+		name         string
+		snatIPPool   string
+		node         *corev1.Node
+		expectedPool string
+	}{
+		{
+			name:         "set pool",
+			snatIPPool:   "eip1",
+			node:         newSNATTestNode("node1", "192.168.0.1", "", ""),
+			expectedPool: "eip1",
+		},
+		{
+			name:         "update pool",
+			snatIPPool:   "eip2",
+			node:         newSNATTestNode("node1", "192.168.0.1", "eip1", "10.10.10.1"),
+			expectedPool: "eip2",
+		},
+		{
+			name:       "remove pool",
+			snatIPPool: "",
+			node:       newSNATTestNode("node1", "192.168.0.1", "eip1", "10.10.10.1"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _, client := newTestExternalTrafficSNATController(t, tt.snatIPPool, tt.node)
+			require.NoError(t, c.updateSNATIPPoolAnnotation())
+			node, err := client.CoreV1().Nodes().Get(context.TODO(), "node1", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedPool, getNodeSNATIPPool(node))
+		})
+	}
+}
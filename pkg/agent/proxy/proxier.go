@@ -32,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
 	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
 	clientset "k8s.io/client-go/kubernetes"
@@ -80,6 +81,9 @@ type Proxier interface {
 	// GetServiceByIP returns the ServicePortName struct for the given serviceString(ClusterIP:Port/Proto).
 	// False is returned if the serviceString is not found in serviceStringMap.
 	GetServiceByIP(serviceStr string) (k8sproxy.ServicePortName, bool)
+	// GetServiceByGroupID returns the ServicePortName struct for the given OVS group ID.
+	// False is returned if the group ID is not allocated for a Service.
+	GetServiceByGroupID(groupID binding.GroupIDType) (k8sproxy.ServicePortName, bool)
 }
 
 type proxier struct {
@@ -116,6 +120,17 @@ type proxier struct {
 	serviceStringMap map[string]k8sproxy.ServicePortName
 	// serviceStringMapMutex protects serviceStringMap object.
 	serviceStringMapMutex sync.Mutex
+	// connectionRateMeters stores the OpenFlow meters limiting the rate of new connections to Services.
+	connectionRateMeters         map[k8sproxy.ServicePortName]*connectionRateMeter
+	connectionRateMeterAllocator *meterIDAllocator
+	// connectionLimits stores the maximum number of concurrent connections of Services, which is enforced by
+	// checkConnectionLimits periodically.
+	connectionLimits map[k8sproxy.ServicePortName]*serviceConnectionLimit
+	// connectionLimitsMutex protects connectionLimits, which is accessed by both syncProxyRules and
+	// checkConnectionLimits.
+	connectionLimitsMutex sync.Mutex
+	// countServiceConnections is stored as a field to allow overriding it in tests.
+	countServiceConnections func(zone uint16) (map[serviceEntrypoint]int, error)
 
 	serviceHealthServer healthcheck.ServiceHealthServer
 	numLocalEndpoints   map[apimachinerytypes.NamespacedName]int
@@ -191,6 +206,12 @@ func (p *proxier) removeStaleServices() {
 		if !p.removeServiceFlows(svcInfo) {
 			continue
 		}
+		if !p.removeConnectionRateMeter(svcPortName) {
+			continue
+		}
+		if !p.updateConnectionLimit(svcPortName, nil) {
+			continue
+		}
 		// Remove Service group which has only local Endpoints.
 		if !p.removeServiceGroup(svcPortName, true) {
 			continue
@@ -353,8 +374,8 @@ func (p *proxier) removeStaleServiceConntrackEntries(svcPortName k8sproxy.Servic
 		svcIPToPort[virtualNodePortDNATIP.String()] = nodePort
 	}
 
-	// Clean up the UDP conntrack entries matching the stale Service IPs and ports. For a UDP Service without Endpoint,
-	// no UDP conntrack entry will have been generated, but there is no harm in calling this function.
+	// Clean up the UDP / SCTP conntrack entries matching the stale Service IPs and ports. For a Service without Endpoint,
+	// no conntrack entry will have been generated, but there is no harm in calling this function.
 	for svcIPStr, port := range svcIPToPort {
 		svcIP := net.ParseIP(svcIPStr)
 		if err := p.routeClient.ClearConntrackEntryForService(svcIP, port, nil, svcProto); err != nil {
@@ -421,7 +442,7 @@ func (p *proxier) removeStaleConntrackEntries(svcPortName k8sproxy.ServicePortNa
 		staleSvcIPToPort[virtualNodePortDNATIP.String()] = pNodePort
 		svcNodePortChanged = true
 	}
-	// Clean up the UDP conntrack entries matching the stale Service IPs and ports.
+	// Clean up the UDP / SCTP conntrack entries matching the stale Service IPs and ports.
 	for svcIPStr, port := range staleSvcIPToPort {
 		svcIP := net.ParseIP(svcIPStr)
 		if err := p.routeClient.ClearConntrackEntryForService(svcIP, port, nil, pSvcInfo.OFProtocol); err != nil {
@@ -447,7 +468,7 @@ func (p *proxier) removeStaleConntrackEntries(svcPortName k8sproxy.ServicePortNa
 			remainingSvcIPToPort[nodeIP.String()] = nodePort
 		}
 	}
-	// Clean up the UDP conntrack entries matching the remaining Service IPs and ports, and the stale Endpoint IPs.
+	// Clean up the UDP / SCTP conntrack entries matching the remaining Service IPs and ports, and the stale Endpoint IPs.
 	for svcIPStr, port := range remainingSvcIPToPort {
 		for _, endpoint := range staleEndpoints {
 			svcIP := net.ParseIP(svcIPStr)
@@ -541,7 +562,7 @@ func smallSliceSame(s1, s2 []string) []string {
 	return same
 }
 
-func (p *proxier) installNodePortService(localGroupID, clusterGroupID binding.GroupIDType, svcPort uint16, protocol binding.Protocol, trafficPolicyLocal bool, affinityTimeout uint16) error {
+func (p *proxier) installNodePortService(localGroupID, clusterGroupID binding.GroupIDType, svcPort uint16, protocol binding.Protocol, trafficPolicyLocal bool, affinityTimeout uint16, preserveHairpinClientIP bool, connectionRateMeterID uint32) error {
 	if svcPort == 0 {
 		return nil
 	}
@@ -550,17 +571,19 @@ func (p *proxier) installNodePortService(localGroupID, clusterGroupID binding.Gr
 		svcIP = agentconfig.VirtualNodePortDNATIPv6
 	}
 	if err := p.ofClient.InstallServiceFlows(&agenttypes.ServiceConfig{
-		ServiceIP:          svcIP,
-		ServicePort:        svcPort,
-		Protocol:           protocol,
-		TrafficPolicyLocal: trafficPolicyLocal,
-		LocalGroupID:       localGroupID,
-		ClusterGroupID:     clusterGroupID,
-		AffinityTimeout:    affinityTimeout,
-		IsExternal:         true,
-		IsNodePort:         true,
-		IsNested:           false, // Unsupported for NodePort
-		IsDSR:              false, // Unsupported because external traffic has been DNAT'd in host network before it's forwarded to OVS.
+		ServiceIP:               svcIP,
+		ServicePort:             svcPort,
+		Protocol:                protocol,
+		TrafficPolicyLocal:      trafficPolicyLocal,
+		LocalGroupID:            localGroupID,
+		ClusterGroupID:          clusterGroupID,
+		AffinityTimeout:         affinityTimeout,
+		IsExternal:              true,
+		IsNodePort:              true,
+		IsNested:                false, // Unsupported for NodePort
+		IsDSR:                   false, // Unsupported because external traffic has been DNAT'd in host network before it's forwarded to OVS.
+		PreserveHairpinClientIP: preserveHairpinClientIP,
+		ConnectionRateMeterID:   connectionRateMeterID,
 	}); err != nil {
 		return fmt.Errorf("failed to install NodePort load balancing OVS flows: %w", err)
 	}
@@ -595,21 +618,25 @@ func (p *proxier) installExternalIPService(svcInfoStr string,
 	protocol binding.Protocol,
 	trafficPolicyLocal bool,
 	affinityTimeout uint16,
-	loadBalancerMode agentconfig.LoadBalancerMode) error {
+	loadBalancerMode agentconfig.LoadBalancerMode,
+	preserveHairpinClientIP bool,
+	connectionRateMeterID uint32) error {
 	for _, externalIP := range externalIPStrings {
 		ip := net.ParseIP(externalIP)
 		if err := p.ofClient.InstallServiceFlows(&agenttypes.ServiceConfig{
-			ServiceIP:          ip,
-			ServicePort:        svcPort,
-			Protocol:           protocol,
-			TrafficPolicyLocal: trafficPolicyLocal,
-			LocalGroupID:       localGroupID,
-			ClusterGroupID:     clusterGroupID,
-			AffinityTimeout:    affinityTimeout,
-			IsExternal:         true,
-			IsNodePort:         false,
-			IsNested:           false, // Unsupported for ExternalIP
-			IsDSR:              features.DefaultFeatureGate.Enabled(features.LoadBalancerModeDSR) && loadBalancerMode == agentconfig.LoadBalancerModeDSR,
+			ServiceIP:               ip,
+			ServicePort:             svcPort,
+			Protocol:                protocol,
+			TrafficPolicyLocal:      trafficPolicyLocal,
+			LocalGroupID:            localGroupID,
+			ClusterGroupID:          clusterGroupID,
+			AffinityTimeout:         affinityTimeout,
+			IsExternal:              true,
+			IsNodePort:              false,
+			IsNested:                false, // Unsupported for ExternalIP
+			IsDSR:                   features.DefaultFeatureGate.Enabled(features.LoadBalancerModeDSR) && loadBalancerMode == agentconfig.LoadBalancerModeDSR,
+			PreserveHairpinClientIP: preserveHairpinClientIP,
+			ConnectionRateMeterID:   connectionRateMeterID,
 		}); err != nil {
 			return fmt.Errorf("failed to install ExternalIP load balancing OVS flows: %w", err)
 		}
@@ -641,22 +668,26 @@ func (p *proxier) installLoadBalancerService(svcInfoStr string,
 	protocol binding.Protocol,
 	trafficPolicyLocal bool,
 	affinityTimeout uint16,
-	loadBalancerMode agentconfig.LoadBalancerMode) error {
+	loadBalancerMode agentconfig.LoadBalancerMode,
+	preserveHairpinClientIP bool,
+	connectionRateMeterID uint32) error {
 	for _, ingress := range loadBalancerIPStrings {
 		if ingress != "" {
 			ip := net.ParseIP(ingress)
 			if err := p.ofClient.InstallServiceFlows(&agenttypes.ServiceConfig{
-				ServiceIP:          ip,
-				ServicePort:        svcPort,
-				Protocol:           protocol,
-				TrafficPolicyLocal: trafficPolicyLocal,
-				LocalGroupID:       localGroupID,
-				ClusterGroupID:     clusterGroupID,
-				AffinityTimeout:    affinityTimeout,
-				IsExternal:         true,
-				IsNodePort:         false,
-				IsNested:           false, // Unsupported for LoadBalancerIP
-				IsDSR:              features.DefaultFeatureGate.Enabled(features.LoadBalancerModeDSR) && loadBalancerMode == agentconfig.LoadBalancerModeDSR,
+				ServiceIP:               ip,
+				ServicePort:             svcPort,
+				Protocol:                protocol,
+				TrafficPolicyLocal:      trafficPolicyLocal,
+				LocalGroupID:            localGroupID,
+				ClusterGroupID:          clusterGroupID,
+				AffinityTimeout:         affinityTimeout,
+				IsExternal:              true,
+				IsNodePort:              false,
+				IsNested:                false, // Unsupported for LoadBalancerIP
+				IsDSR:                   features.DefaultFeatureGate.Enabled(features.LoadBalancerModeDSR) && loadBalancerMode == agentconfig.LoadBalancerModeDSR,
+				PreserveHairpinClientIP: preserveHairpinClientIP,
+				ConnectionRateMeterID:   connectionRateMeterID,
 			}); err != nil {
 				return fmt.Errorf("failed to install LoadBalancerIP load balancing OVS flows: %w", err)
 			}
@@ -712,13 +743,15 @@ func (p *proxier) installServices() {
 				svcInfo.StickyMaxAgeSeconds() != pSvcInfo.StickyMaxAgeSeconds() || // All Service flows use it.
 				svcInfo.ExternalPolicyLocal() != pSvcInfo.ExternalPolicyLocal() || // It affects the group ID used by external Service flows.
 				svcInfo.InternalPolicyLocal() != pSvcInfo.InternalPolicyLocal() || // It affects the group ID used by internal Service flows.
-				svcInfo.LoadBalancerMode != pSvcInfo.LoadBalancerMode
+				svcInfo.LoadBalancerMode != pSvcInfo.LoadBalancerMode ||
+				svcInfo.HairpinMode != pSvcInfo.HairpinMode ||
+				svcInfo.ConnectionRateLimit != pSvcInfo.ConnectionRateLimit // All Service flows use the meter limiting it.
 			needUpdateServiceExternalAddresses = serviceExternalAddressesChanged(svcInfo, pSvcInfo)
 			needUpdateEndpoints = pSvcInfo.SessionAffinityType() != svcInfo.SessionAffinityType() ||
 				pSvcInfo.ExternalPolicyLocal() != svcInfo.ExternalPolicyLocal() ||
 				pSvcInfo.InternalPolicyLocal() != svcInfo.InternalPolicyLocal()
 			if p.cleanupStaleUDPSvcConntrack && needClearConntrackEntries(pSvcInfo.OFProtocol) {
-				// We clean the UDP conntrack entries for the following Service update cases:
+				// We clean the UDP / SCTP conntrack entries for the following Service update cases:
 				// - Service port changed, clean the conntrack entries matched by each of the current clusterIP / externalIPs
 				//   / loadBalancerIPs and the stale Service port.
 				// - ClusterIP changed, clean the conntrack entries matched by the clusterIP and the Service port.
@@ -750,7 +783,7 @@ func (p *proxier) installServices() {
 		if len(staleEndpoints) > 0 || len(newEndpoints) > 0 {
 			needUpdateEndpoints = true
 		}
-		// We also clean the conntrack entries related to the stale Endpoints for a UDP Service. Conntrack entries
+		// We also clean the conntrack entries related to the stale Endpoints for a UDP / SCTP Service. Conntrack entries
 		// matched by each of stale Endpoint IPs and each of the remaining Service IPs and ports will be deleted.
 		if len(staleEndpoints) > 0 && needClearConntrackEntries(svcInfo.OFProtocol) {
 			needCleanupStaleUDPServiceConntrack = true
@@ -797,11 +830,24 @@ func (p *proxier) installServices() {
 					continue
 				}
 			}
-			if !p.installServiceFlows(svcInfo, localGroupID, clusterGroupID) {
+			// The meter must be updated after the previous flows are deleted, as deleting a meter also deletes the
+			// flows using it.
+			connectionRateMeterID, ok := p.ensureConnectionRateMeter(svcPortName, svcInfo)
+			if !ok {
+				continue
+			}
+			if !p.installServiceFlows(svcInfo, localGroupID, clusterGroupID, connectionRateMeterID) {
 				continue
 			}
 		} else if needUpdateServiceExternalAddresses {
-			if !p.updateServiceExternalAddresses(pSvcInfo, svcInfo, localGroupID, clusterGroupID) {
+			if !p.updateServiceExternalAddresses(pSvcInfo, svcInfo, localGroupID, clusterGroupID, p.getConnectionRateMeterID(svcPortName)) {
+				continue
+			}
+		}
+		// The entrypoints of the Service may have changed, in which case the flows rejecting new connections to the
+		// stale entrypoints must be removed.
+		if needUpdateService || needUpdateServiceExternalAddresses || svcInfo.MaxConnections != pSvcInfo.MaxConnections {
+			if !p.updateConnectionLimit(svcPortName, svcInfo) {
 				continue
 			}
 		}
@@ -850,7 +896,7 @@ func getAffinityTimeout(svcInfo *types.ServiceInfo) uint16 {
 	return uint16(affinityTimeout)
 }
 
-func (p *proxier) installServiceFlows(svcInfo *types.ServiceInfo, localGroupID, clusterGroupID binding.GroupIDType) bool {
+func (p *proxier) installServiceFlows(svcInfo *types.ServiceInfo, localGroupID, clusterGroupID binding.GroupIDType, connectionRateMeterID uint32) bool {
 	svcInfoStr := svcInfo.String()
 	svcPort := uint16(svcInfo.Port())
 	svcProto := svcInfo.OFProtocol
@@ -863,39 +909,42 @@ func (p *proxier) installServiceFlows(svcInfo *types.ServiceInfo, localGroupID,
 		isNestedService = svcInfo.IsNested
 	}
 	loadBalancerMode := p.getLoadBalancerMode(svcInfo)
+	preserveHairpinClientIP := svcInfo.HairpinMode == agentconfig.HairpinModePreserveClientIP
 
 	// Install ClusterIP flows.
 	if err := p.ofClient.InstallServiceFlows(&agenttypes.ServiceConfig{
-		ServiceIP:          svcInfo.ClusterIP(),
-		ServicePort:        svcPort,
-		Protocol:           svcProto,
-		TrafficPolicyLocal: svcInfo.InternalPolicyLocal(),
-		LocalGroupID:       localGroupID,
-		ClusterGroupID:     clusterGroupID,
-		AffinityTimeout:    affinityTimeout,
-		IsExternal:         false,
-		IsNodePort:         false,
-		IsNested:           isNestedService,
-		IsDSR:              false, // not applicable for ClusterIP
+		ServiceIP:               svcInfo.ClusterIP(),
+		ServicePort:             svcPort,
+		Protocol:                svcProto,
+		TrafficPolicyLocal:      svcInfo.InternalPolicyLocal(),
+		LocalGroupID:            localGroupID,
+		ClusterGroupID:          clusterGroupID,
+		AffinityTimeout:         affinityTimeout,
+		IsExternal:              false,
+		IsNodePort:              false,
+		IsNested:                isNestedService,
+		IsDSR:                   false, // not applicable for ClusterIP
+		PreserveHairpinClientIP: preserveHairpinClientIP,
+		ConnectionRateMeterID:   connectionRateMeterID,
 	}); err != nil {
 		klog.ErrorS(err, "Error when installing ClusterIP flows for Service", "ServiceInfo", svcInfoStr)
 		return false
 	}
 	if p.proxyAll {
 		// Install NodePort flows and configurations.
-		if err := p.installNodePortService(localGroupID, clusterGroupID, uint16(svcInfo.NodePort()), svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout); err != nil {
+		if err := p.installNodePortService(localGroupID, clusterGroupID, uint16(svcInfo.NodePort()), svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, preserveHairpinClientIP, connectionRateMeterID); err != nil {
 			klog.ErrorS(err, "Error when installing NodePort flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
 		// Install ExternalIP flows and configurations.
-		if err := p.installExternalIPService(svcInfoStr, localGroupID, clusterGroupID, svcInfo.ExternalIPStrings(), svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode); err != nil {
+		if err := p.installExternalIPService(svcInfoStr, localGroupID, clusterGroupID, svcInfo.ExternalIPStrings(), svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode, preserveHairpinClientIP, connectionRateMeterID); err != nil {
 			klog.ErrorS(err, "Error when installing ExternalIP flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
 	}
 	// Install LoadBalancer flows and configurations.
 	if p.proxyLoadBalancerIPs {
-		if err := p.installLoadBalancerService(svcInfoStr, localGroupID, clusterGroupID, svcInfo.LoadBalancerIPStrings(), svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode); err != nil {
+		if err := p.installLoadBalancerService(svcInfoStr, localGroupID, clusterGroupID, svcInfo.LoadBalancerIPStrings(), svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode, preserveHairpinClientIP, connectionRateMeterID); err != nil {
 			klog.ErrorS(err, "Error when installing LoadBalancer flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
@@ -903,7 +952,7 @@ func (p *proxier) installServiceFlows(svcInfo *types.ServiceInfo, localGroupID,
 	return true
 }
 
-func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.ServiceInfo, localGroupID, clusterGroupID binding.GroupIDType) bool {
+func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.ServiceInfo, localGroupID, clusterGroupID binding.GroupIDType, connectionRateMeterID uint32) bool {
 	pSvcInfoStr := pSvcInfo.String()
 	svcInfoStr := svcInfo.String()
 	pSvcPort := uint16(pSvcInfo.Port())
@@ -914,13 +963,14 @@ func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.Servic
 	svcProto := svcInfo.OFProtocol
 	affinityTimeout := getAffinityTimeout(svcInfo)
 	loadBalancerMode := p.getLoadBalancerMode(svcInfo)
+	preserveHairpinClientIP := svcInfo.HairpinMode == agentconfig.HairpinModePreserveClientIP
 	if p.proxyAll {
 		if pSvcNodePort != svcNodePort {
 			if err := p.uninstallNodePortService(pSvcNodePort, pSvcProto); err != nil {
 				klog.ErrorS(err, "Error when uninstalling NodePort flows and configurations for Service", "ServiceInfo", pSvcInfoStr)
 				return false
 			}
-			if err := p.installNodePortService(localGroupID, clusterGroupID, svcNodePort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout); err != nil {
+			if err := p.installNodePortService(localGroupID, clusterGroupID, svcNodePort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, preserveHairpinClientIP, connectionRateMeterID); err != nil {
 				klog.ErrorS(err, "Error when installing NodePort flows and configurations for Service", "ServiceInfo", svcInfoStr)
 				return false
 			}
@@ -931,7 +981,7 @@ func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.Servic
 			klog.ErrorS(err, "Error when uninstalling ExternalIP flows and configurations for Service", "ServiceInfo", pSvcInfoStr)
 			return false
 		}
-		if err := p.installExternalIPService(svcInfoStr, localGroupID, clusterGroupID, addedExternalIPs, svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode); err != nil {
+		if err := p.installExternalIPService(svcInfoStr, localGroupID, clusterGroupID, addedExternalIPs, svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode, preserveHairpinClientIP, connectionRateMeterID); err != nil {
 			klog.ErrorS(err, "Error when installing ExternalIP flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
@@ -943,7 +993,7 @@ func (p *proxier) updateServiceExternalAddresses(pSvcInfo, svcInfo *types.Servic
 			klog.ErrorS(err, "Error when uninstalling LoadBalancer flows and configurations for Service", "ServiceInfo", pSvcInfoStr)
 			return false
 		}
-		if err := p.installLoadBalancerService(svcInfoStr, localGroupID, clusterGroupID, addedLoadBalancerIPs, svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode); err != nil {
+		if err := p.installLoadBalancerService(svcInfoStr, localGroupID, clusterGroupID, addedLoadBalancerIPs, svcPort, svcProto, svcInfo.ExternalPolicyLocal(), affinityTimeout, loadBalancerMode, preserveHairpinClientIP, connectionRateMeterID); err != nil {
 			klog.ErrorS(err, "Error when installing LoadBalancer flows and configurations for Service", "ServiceInfo", svcInfoStr)
 			return false
 		}
@@ -1202,6 +1252,10 @@ func (p *proxier) GetServiceByIP(serviceStr string) (k8sproxy.ServicePortName, b
 	return serviceInfo, exists
 }
 
+func (p *proxier) GetServiceByGroupID(groupID binding.GroupIDType) (k8sproxy.ServicePortName, bool) {
+	return p.groupCounter.GetServicePortName(groupID)
+}
+
 func (p *proxier) addServiceByIP(serviceStr string, servicePortName k8sproxy.ServicePortName) {
 	p.serviceStringMapMutex.Lock()
 	defer p.serviceStringMapMutex.Unlock()
@@ -1228,6 +1282,7 @@ func (p *proxier) Run(stopCh <-chan struct{}) {
 		} else {
 			go p.endpointsConfig.Run(stopCh)
 		}
+		go wait.Until(p.checkConnectionLimits, connectionLimitCheckInterval, stopCh)
 		p.stopChan = stopCh
 		p.SyncLoop()
 	})
@@ -1420,6 +1475,10 @@ func newProxier(
 		endpointReferenceCounter:          map[string]int{},
 		nodeLabels:                        map[string]string{},
 		serviceStringMap:                  map[string]k8sproxy.ServicePortName{},
+		connectionRateMeters:              map[k8sproxy.ServicePortName]*connectionRateMeter{},
+		connectionRateMeterAllocator:      newMeterIDAllocator(isIPv6),
+		connectionLimits:                  map[k8sproxy.ServicePortName]*serviceConnectionLimit{},
+		countServiceConnections:           countServiceConnections,
 		groupCounter:                      groupCounter,
 		ofClient:                          ofClient,
 		routeClient:                       routeClient,
@@ -1483,6 +1542,14 @@ func (p *metaProxierWrapper) GetServiceByIP(serviceStr string) (k8sproxy.Service
 	return p.ipv4Proxier.GetServiceByIP(serviceStr)
 }
 
+func (p *metaProxierWrapper) GetServiceByGroupID(groupID binding.GroupIDType) (k8sproxy.ServicePortName, bool) {
+	// The IPv4 and IPv6 proxiers allocate group IDs from the same allocator.
+	if svcPortName, ok := p.ipv4Proxier.GetServiceByGroupID(groupID); ok {
+		return svcPortName, true
+	}
+	return p.ipv6Proxier.GetServiceByGroupID(groupID)
+}
+
 func newDualStackProxier(
 	hostname string,
 	serviceProxyName string,
@@ -1667,6 +1734,14 @@ func NewProxier(hostname string,
 	return proxier, nil
 }
 
+// needClearConntrackEntries returns whether the conntrack entries of a Service must be cleared when its IPs, ports or
+// Endpoints change. Unlike TCP clients, which use a new source port when re-establishing a connection, UDP and SCTP
+// clients often keep sending packets with the same 5-tuple, which would keep matching the stale conntrack entries and
+// being forwarded to the stale Endpoints until the entries expire.
 func needClearConntrackEntries(protocol binding.Protocol) bool {
-	return protocol == binding.ProtocolUDP || protocol == binding.ProtocolUDPv6
+	switch protocol {
+	case binding.ProtocolUDP, binding.ProtocolUDPv6, binding.ProtocolSCTP, binding.ProtocolSCTPv6:
+		return true
+	}
+	return false
 }
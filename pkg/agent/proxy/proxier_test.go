@@ -1934,6 +1934,20 @@ func TestClusterIPRemove(t *testing.T) {
 			})
 		})
 	})
+	t.Run("IPv4 SCTP", func(t *testing.T) {
+		t.Run("EndpointSlice", func(t *testing.T) {
+			t.Run("InternalTrafficPolicy Cluster", func(t *testing.T) {
+				testClusterIPRemove(t, binding.ProtocolSCTP, false, false, true)
+			})
+		})
+	})
+	t.Run("IPv6 SCTP", func(t *testing.T) {
+		t.Run("EndpointSlice", func(t *testing.T) {
+			t.Run("InternalTrafficPolicy Cluster", func(t *testing.T) {
+				testClusterIPRemove(t, binding.ProtocolSCTPv6, true, false, true)
+			})
+		})
+	})
 }
 
 func TestNodePortRemove(t *testing.T) {
@@ -2461,6 +2475,12 @@ func TestLoadBalancerRemoveEndpoints(t *testing.T) {
 	t.Run("IPv6 UDP", func(t *testing.T) {
 		testLoadBalancerRemoveEndpoints(t, binding.ProtocolUDPv6, true)
 	})
+	t.Run("IPv4 SCTP", func(t *testing.T) {
+		testLoadBalancerRemoveEndpoints(t, binding.ProtocolSCTP, false)
+	})
+	t.Run("IPv6 SCTP", func(t *testing.T) {
+		testLoadBalancerRemoveEndpoints(t, binding.ProtocolSCTPv6, true)
+	})
 }
 
 func testSessionAffinity(t *testing.T, affinitySeconds int32, isIPv6 bool) {
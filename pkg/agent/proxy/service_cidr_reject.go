@@ -0,0 +1,199 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"net"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/openflow"
+	"antrea.io/antrea/pkg/agent/servicecidr"
+	k8sproxy "antrea.io/antrea/third_party/proxy"
+)
+
+const (
+	serviceCIDRRejectRetryDelay = 10 * time.Second
+	proxySyncCheckInterval      = time.Second
+)
+
+// ServiceCIDRRejecter installs the flows which reject new connections to the ClusterIPs that are not allocated to any
+// Service, using the Service CIDRs discovered by servicecidr.Interface. Without them, the packets to such IPs are
+// forwarded to the default gateway, and the clients only fail after a timeout. The ClusterIPs of the Services which
+// are not handled by AntreaProxy, because of their service.kubernetes.io/service-proxy-name label, are excluded.
+type ServiceCIDRRejecter struct {
+	ofClient             openflow.Client
+	serviceCIDRInterface servicecidr.Interface
+	proxyProvider        k8sproxy.Provider
+	serviceLister        corelisters.ServiceLister
+	serviceListerSynced  cache.InformerSynced
+	// ignoredServiceSelector selects the Services which are not handled by AntreaProxy.
+	ignoredServiceSelector labels.Selector
+	// serviceCIDRUpdateCh is notified when the Service CIDRs or the ClusterIPs of the ignored Services change.
+	serviceCIDRUpdateCh chan struct{}
+	// serviceCIDRUpdateRetryDelay is the delay after which the flows are installed again when failing to install them.
+	serviceCIDRUpdateRetryDelay time.Duration
+}
+
+func NewServiceCIDRRejecter(ofClient openflow.Client,
+	serviceCIDRInterface servicecidr.Interface,
+	proxyProvider k8sproxy.Provider,
+	serviceInformer coreinformers.ServiceInformer) *ServiceCIDRRejecter {
+	// rejectUnallocatedClusterIPs cannot be enabled with serviceProxyName, so AntreaProxy ignores all the Services
+	// with the label.
+	ignoredServiceRequirement, _ := labels.NewRequirement(labelServiceProxyName, selection.Exists, nil)
+	r := &ServiceCIDRRejecter{
+		ofClient:                    ofClient,
+		serviceCIDRInterface:        serviceCIDRInterface,
+		proxyProvider:               proxyProvider,
+		serviceLister:               serviceInformer.Lister(),
+		serviceListerSynced:         serviceInformer.Informer().HasSynced,
+		ignoredServiceSelector:      labels.NewSelector().Add(*ignoredServiceRequirement),
+		serviceCIDRUpdateCh:         make(chan struct{}, 1),
+		serviceCIDRUpdateRetryDelay: serviceCIDRRejectRetryDelay,
+	}
+	serviceCIDRInterface.AddEventHandler(r.onServiceCIDRUpdate)
+	serviceInformer.Informer().AddEventHandlerWithResyncPeriod(cache.ResourceEventHandlerFuncs{
+		AddFunc: r.onServiceAdd,
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldService := oldObj.(*corev1.Service)
+			newService := newObj.(*corev1.Service)
+			if r.isIgnored(oldService) != r.isIgnored(newService) ||
+				(r.isIgnored(newService) && !slices.Equal(oldService.Spec.ClusterIPs, newService.Spec.ClusterIPs)) {
+				r.notify()
+			}
+		},
+		DeleteFunc: r.onServiceDelete,
+	}, resyncPeriod)
+	return r
+}
+
+func (r *ServiceCIDRRejecter) isIgnored(service *corev1.Service) bool {
+	return r.ignoredServiceSelector.Matches(labels.Set(service.Labels))
+}
+
+func (r *ServiceCIDRRejecter) onServiceAdd(obj interface{}) {
+	if r.isIgnored(obj.(*corev1.Service)) {
+		r.notify()
+	}
+}
+
+func (r *ServiceCIDRRejecter) onServiceDelete(obj interface{}) {
+	service, ok := obj.(*corev1.Service)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		service, ok = tombstone.Obj.(*corev1.Service)
+		if !ok {
+			return
+		}
+	}
+	if r.isIgnored(service) {
+		r.notify()
+	}
+}
+
+// onServiceCIDRUpdate will be called when ServiceCIDRs change.
+// It ensures the flows will be installed once after this call.
+func (r *ServiceCIDRRejecter) onServiceCIDRUpdate(_ []*net.IPNet) {
+	r.notify()
+}
+
+func (r *ServiceCIDRRejecter) notify() {
+	select {
+	case r.serviceCIDRUpdateCh <- struct{}{}:
+	default:
+		// The previous event is not processed yet, discard the new event.
+	}
+}
+
+func (r *ServiceCIDRRejecter) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting ServiceCIDRRejecter")
+	defer klog.InfoS("Shutting down ServiceCIDRRejecter")
+
+	if !cache.WaitForNamedCacheSync("ServiceCIDRRejecter", stopCh, r.serviceListerSynced) {
+		return
+	}
+	// The flows must not be installed before AntreaProxy has installed the flows of the existing Services, otherwise
+	// the connections to their ClusterIPs would be rejected.
+	if err := wait.PollUntilContextCancel(wait.ContextForChannel(stopCh), proxySyncCheckInterval, true, func(ctx context.Context) (bool, error) {
+		return r.proxyProvider.SyncedOnce(), nil
+	}); err != nil {
+		return
+	}
+
+	// The timer fires immediately, to install the flows for the Service CIDRs which may have been discovered already.
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-r.serviceCIDRUpdateCh:
+			klog.V(2).InfoS("Received service CIDR update")
+		case <-timer.C:
+			klog.V(2).InfoS("Service CIDR update timer expired")
+		}
+		serviceCIDRs, err := r.serviceCIDRInterface.GetServiceCIDRs()
+		if err != nil {
+			klog.V(2).InfoS("Service CIDRs are not available yet", "err", err)
+			// No need to retry in this case as the Service CIDRs won't be available until it receives a service CIDRs update.
+			continue
+		}
+		excludedIPs, err := r.getIgnoredClusterIPs()
+		if err != nil {
+			klog.ErrorS(err, "Failed to get the ClusterIPs of the Services ignored by AntreaProxy, will retry")
+			timer.Reset(r.serviceCIDRUpdateRetryDelay)
+			continue
+		}
+		if err := r.ofClient.InstallServiceCIDRRejectFlows(serviceCIDRs, excludedIPs); err != nil {
+			klog.ErrorS(err, "Failed to install reject flows for Service CIDRs, will retry", "serviceCIDRs", serviceCIDRs)
+			// Schedule a retry as it should be transient error.
+			timer.Reset(r.serviceCIDRUpdateRetryDelay)
+			continue
+		}
+		klog.InfoS("Installed reject flows for Service CIDRs", "serviceCIDRs", serviceCIDRs, "excludedIPs", excludedIPs)
+	}
+}
+
+// getIgnoredClusterIPs returns the ClusterIPs of the Services which are not handled by AntreaProxy. The connections to
+// them must not be rejected, as they are handled by another proxy.
+func (r *ServiceCIDRRejecter) getIgnoredClusterIPs() ([]net.IP, error) {
+	services, err := r.serviceLister.List(r.ignoredServiceSelector)
+	if err != nil {
+		return nil, err
+	}
+	var ips []net.IP
+	for _, service := range services {
+		for _, clusterIP := range service.Spec.ClusterIPs {
+			if ip := net.ParseIP(clusterIP); ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+	}
+	return ips, nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+
+	ofmock "antrea.io/antrea/pkg/agent/openflow/testing"
+	servicecidrtesting "antrea.io/antrea/pkg/agent/servicecidr/testing"
+	utilip "antrea.io/antrea/pkg/util/ip"
+	k8sproxytesting "antrea.io/antrea/third_party/proxy/testing"
+)
+
+func TestServiceCIDRRejecter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOFClient := ofmock.NewMockClient(ctrl)
+	mockServiceCIDRInterface := servicecidrtesting.NewMockInterface(ctrl)
+	mockProxyProvider := k8sproxytesting.NewMockProvider(ctrl)
+
+	// The Service handled by another proxy must not be rejected, unlike the Services handled by AntreaProxy, whose
+	// ClusterIPs have higher priority flows.
+	ignoredService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc1", Namespace: "ns1", Labels: map[string]string{labelServiceProxyName: "other-proxy"}},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.96.0.10", ClusterIPs: []string{"10.96.0.10"}},
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc2", Namespace: "ns1"},
+		Spec:       corev1.ServiceSpec{ClusterIP: "10.96.0.11", ClusterIPs: []string{"10.96.0.11"}},
+	}
+	client := fake.NewSimpleClientset(ignoredService, service)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	mockServiceCIDRInterface.EXPECT().AddEventHandler(gomock.Any())
+	r := NewServiceCIDRRejecter(mockOFClient, mockServiceCIDRInterface, mockProxyProvider, informerFactory.Core().V1().Services())
+	r.serviceCIDRUpdateRetryDelay = 100 * time.Millisecond
+
+	serviceCIDRs := []*net.IPNet{utilip.MustParseCIDR("10.96.0.0/16")}
+	newServiceCIDRs := []*net.IPNet{utilip.MustParseCIDR("10.96.0.0/15")}
+	excludedIPs := []net.IP{net.ParseIP("10.96.0.10")}
+	installed := make(chan []*net.IPNet, 10)
+	installedExcludedIPs := make(chan []net.IP, 10)
+	gomock.InOrder(
+		// The flows are not installed until AntreaProxy has synced once.
+		mockProxyProvider.EXPECT().SyncedOnce().Return(false),
+		mockProxyProvider.EXPECT().SyncedOnce().Return(true),
+		mockServiceCIDRInterface.EXPECT().GetServiceCIDRs().Return(nil, fmt.Errorf("not initialized")),
+		mockServiceCIDRInterface.EXPECT().GetServiceCIDRs().Return(serviceCIDRs, nil),
+		// A failure is retried after the retry delay.
+		mockOFClient.EXPECT().InstallServiceCIDRRejectFlows(serviceCIDRs, excludedIPs).Return(fmt.Errorf("error")),
+		mockServiceCIDRInterface.EXPECT().GetServiceCIDRs().Return(serviceCIDRs, nil),
+		mockOFClient.EXPECT().InstallServiceCIDRRejectFlows(serviceCIDRs, excludedIPs).DoAndReturn(func(cidrs []*net.IPNet, _ []net.IP) error {
+			installed <- cidrs
+			return nil
+		}),
+		mockServiceCIDRInterface.EXPECT().GetServiceCIDRs().Return(newServiceCIDRs, nil),
+		mockOFClient.EXPECT().InstallServiceCIDRRejectFlows(newServiceCIDRs, excludedIPs).DoAndReturn(func(cidrs []*net.IPNet, _ []net.IP) error {
+			installed <- cidrs
+			return nil
+		}),
+		mockServiceCIDRInterface.EXPECT().GetServiceCIDRs().Return(newServiceCIDRs, nil),
+		mockOFClient.EXPECT().InstallServiceCIDRRejectFlows(newServiceCIDRs, gomock.Any()).DoAndReturn(func(_ []*net.IPNet, ips []net.IP) error {
+			installedExcludedIPs <- ips
+			return nil
+		}),
+	)
+
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	go r.Run(stopCh)
+
+	// The Service CIDRs get initialized.
+	r.onServiceCIDRUpdate(serviceCIDRs)
+	select {
+	case cidrs := <-installed:
+		assert.Equal(t, serviceCIDRs, cidrs)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timeout waiting for reject flows to be installed")
+	}
+
+	r.onServiceCIDRUpdate(newServiceCIDRs)
+	select {
+	case cidrs := <-installed:
+		assert.Equal(t, newServiceCIDRs, cidrs)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timeout waiting for reject flows to be updated")
+	}
+
+	// The flows are updated when the Service is no longer ignored by AntreaProxy.
+	ignoredService = ignoredService.DeepCopy()
+	ignoredService.Labels = nil
+	_, err := client.CoreV1().Services("ns1").Update(context.TODO(), ignoredService, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	select {
+	case ips := <-installedExcludedIPs:
+		assert.Empty(t, ips)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timeout waiting for reject flows to be updated")
+	}
+}
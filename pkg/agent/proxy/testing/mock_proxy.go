@@ -70,6 +70,21 @@ func (mr *MockProxierMockRecorder) GetProxyProvider() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProxyProvider", reflect.TypeOf((*MockProxier)(nil).GetProxyProvider))
 }
 
+// GetServiceByGroupID mocks base method.
+func (m *MockProxier) GetServiceByGroupID(groupID openflow.GroupIDType) (proxy.ServicePortName, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetServiceByGroupID", groupID)
+	ret0, _ := ret[0].(proxy.ServicePortName)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetServiceByGroupID indicates an expected call of GetServiceByGroupID.
+func (mr *MockProxierMockRecorder) GetServiceByGroupID(groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceByGroupID", reflect.TypeOf((*MockProxier)(nil).GetServiceByGroupID), groupID)
+}
+
 // GetServiceByIP mocks base method.
 func (m *MockProxier) GetServiceByIP(serviceStr string) (proxy.ServicePortName, bool) {
 	m.ctrl.T.Helper()
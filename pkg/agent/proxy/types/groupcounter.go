@@ -36,6 +36,8 @@ type GroupCounter interface {
 	Recycle(svcPortName k8sproxy.ServicePortName, isEndpointsLocal bool) bool
 	// GetAllGroupIDs gets all group IDs related to the Service.
 	GetAllGroupIDs(svcNamespacedName string) []binding.GroupIDType
+	// GetServicePortName gets the Service which the group ID is allocated for.
+	GetServicePortName(groupID binding.GroupIDType) (k8sproxy.ServicePortName, bool)
 }
 
 type groupCounter struct {
@@ -45,10 +47,18 @@ type groupCounter struct {
 
 	servicePortNamesMap map[string]sets.Set[string]
 	groupMap            map[string]binding.GroupIDType
+	// svcPortNameMap stores the reverse mapping of groupMap, from group ID to ServicePortName.
+	svcPortNameMap map[binding.GroupIDType]k8sproxy.ServicePortName
 }
 
 func NewGroupCounter(groupAllocator openflow.GroupAllocator, groupIDUpdates chan<- string) *groupCounter {
-	return &groupCounter{groupMap: map[string]binding.GroupIDType{}, groupAllocator: groupAllocator, groupIDUpdates: groupIDUpdates, servicePortNamesMap: map[string]sets.Set[string]{}}
+	return &groupCounter{
+		groupMap:            map[string]binding.GroupIDType{},
+		groupAllocator:      groupAllocator,
+		groupIDUpdates:      groupIDUpdates,
+		servicePortNamesMap: map[string]sets.Set[string]{},
+		svcPortNameMap:      map[binding.GroupIDType]k8sproxy.ServicePortName{},
+	}
 }
 
 func keyString(svcPortName k8sproxy.ServicePortName, isEndpointsLocal bool) string {
@@ -88,6 +98,7 @@ func (c *groupCounter) AllocateIfNotExist(svcPortName k8sproxy.ServicePortName,
 	}
 	id := c.groupAllocator.Allocate()
 	c.groupMap[key] = id
+	c.svcPortNameMap[id] = svcPortName
 	c.updateServicePortNameMap(svcPortName.NamespacedName.String(), key)
 	c.groupIDUpdates <- svcPortName.NamespacedName.String()
 	return id
@@ -108,6 +119,7 @@ func (c *groupCounter) Recycle(svcPortName k8sproxy.ServicePortName, isEndpoints
 	key := keyString(svcPortName, isEndpointsLocal)
 	if id, ok := c.groupMap[key]; ok {
 		delete(c.groupMap, key)
+		delete(c.svcPortNameMap, id)
 		c.groupAllocator.Release(id)
 		c.deleteServicePortNameMap(svcPortName.NamespacedName.String(), key)
 		c.groupIDUpdates <- svcPortName.NamespacedName.String()
@@ -127,3 +139,10 @@ func (c *groupCounter) GetAllGroupIDs(svcNamespacedName string) []binding.GroupI
 	}
 	return ids
 }
+
+func (c *groupCounter) GetServicePortName(groupID binding.GroupIDType) (k8sproxy.ServicePortName, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	svcPortName, exist := c.svcPortNameMap[groupID]
+	return svcPortName, exist
+}
@@ -15,6 +15,8 @@
 package types
 
 import (
+	"strconv"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
@@ -37,6 +39,12 @@ type ServiceInfo struct {
 	IsNested bool
 	// The load balancer mode specified in annotations.
 	LoadBalancerMode *config.LoadBalancerMode
+	// The hairpin mode specified in annotations.
+	HairpinMode config.HairpinMode
+	// The maximum rate of new connections per second specified in annotations. 0 means unlimited.
+	ConnectionRateLimit uint32
+	// The maximum number of concurrent connections specified in annotations. 0 means unlimited.
+	MaxConnections uint32
 }
 
 func getLoadBalancerMode(service *corev1.Service) *config.LoadBalancerMode {
@@ -51,11 +59,43 @@ func getLoadBalancerMode(service *corev1.Service) *config.LoadBalancerMode {
 	return nil
 }
 
+// getHairpinMode returns the hairpin mode specified in annotations, or HairpinModeSNAT if the annotation is not set or
+// invalid.
+func getHairpinMode(service *corev1.Service) config.HairpinMode {
+	modeStr, exists := service.Annotations[types.ServiceHairpinModeAnnotationKey]
+	if !exists {
+		return config.HairpinModeSNAT
+	}
+	ok, mode := config.GetHairpinModeFromStr(modeStr)
+	if !ok {
+		klog.ErrorS(nil, "The Service's hairpin mode annotation is invalid", "Service", klog.KObj(service), "mode", modeStr)
+		return config.HairpinModeSNAT
+	}
+	return mode
+}
+
+// getConnectionLimit returns the value of the connection limit annotation, or 0 if the annotation is not set or invalid.
+func getConnectionLimit(service *corev1.Service, annotationKey string) uint32 {
+	limitStr, exists := service.Annotations[annotationKey]
+	if !exists {
+		return 0
+	}
+	limit, err := strconv.ParseUint(limitStr, 10, 32)
+	if err != nil {
+		klog.ErrorS(err, "The Service's connection limit annotation is invalid", "Service", klog.KObj(service), "annotation", annotationKey, "value", limitStr)
+		return 0
+	}
+	return uint32(limit)
+}
+
 // NewServiceInfo returns a new k8sproxy.ServicePort which abstracts a serviceInfo.
 func NewServiceInfo(port *corev1.ServicePort, service *corev1.Service, baseInfo *k8sproxy.BaseServiceInfo) k8sproxy.ServicePort {
 	info := &ServiceInfo{BaseServiceInfo: baseInfo}
 	info.IsNested = mccommon.IsMulticlusterService(service)
 	info.LoadBalancerMode = getLoadBalancerMode(service)
+	info.HairpinMode = getHairpinMode(service)
+	info.ConnectionRateLimit = getConnectionLimit(service, types.ServiceConnectionRateLimitAnnotationKey)
+	info.MaxConnections = getConnectionLimit(service, types.ServiceMaxConnectionsAnnotationKey)
 	if utilnet.IsIPv6(baseInfo.ClusterIP()) {
 		info.OFProtocol = openflow.ProtocolTCPv6
 		switch port.Protocol {
@@ -26,6 +26,7 @@ import (
 	"antrea.io/antrea/pkg/agent/memberlist"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/proxy"
+	"antrea.io/antrea/pkg/agent/route"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/ovs/ovsconfig"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
@@ -42,6 +43,7 @@ type AgentQuerier interface {
 	GetAgentInfo(agentInfo *v1beta1.AntreaAgentInfo, partial bool)
 	GetOpenflowClient() openflow.Client
 	GetOVSCtlClient() ovsctl.OVSCtlClient
+	GetRouteClient() route.Interface
 	GetProxier() proxy.Proxier
 	GetNetworkPolicyInfoQuerier() querier.AgentNetworkPolicyInfoQuerier
 	GetMemberlistCluster() memberlist.Interface
@@ -49,12 +51,18 @@ type AgentQuerier interface {
 	GetBGPPolicyInfoQuerier() querier.AgentBGPPolicyInfoQuerier
 }
 
+// GatewayConfigChecker reports whether the configuration of the host gateway interface is intact.
+type GatewayConfigChecker interface {
+	IsGatewayConfigIntact() bool
+}
+
 type agentQuerier struct {
 	nodeConfig               *config.NodeConfig
 	networkConfig            *config.NetworkConfig
 	interfaceStore           interfacestore.InterfaceStore
 	k8sClient                clientset.Interface
 	ofClient                 openflow.Client
+	routeClient              route.Interface
 	ovsBridgeClient          ovsconfig.OVSBridgeClient
 	proxier                  proxy.Proxier
 	networkPolicyInfoQuerier querier.AgentNetworkPolicyInfoQuerier
@@ -63,6 +71,8 @@ type agentQuerier struct {
 	memberlistCluster        memberlist.Interface
 	nodeLister               corelisters.NodeLister
 	bgpPolicyInfoQuerier     querier.AgentBGPPolicyInfoQuerier
+	// gatewayConfigChecker is nil if the agent doesn't manage a host gateway interface.
+	gatewayConfigChecker GatewayConfigChecker
 }
 
 func NewAgentQuerier(
@@ -71,6 +81,7 @@ func NewAgentQuerier(
 	interfaceStore interfacestore.InterfaceStore,
 	k8sClient clientset.Interface,
 	ofClient openflow.Client,
+	routeClient route.Interface,
 	ovsBridgeClient ovsconfig.OVSBridgeClient,
 	proxier proxy.Proxier,
 	networkPolicyInfoQuerier querier.AgentNetworkPolicyInfoQuerier,
@@ -79,6 +90,7 @@ func NewAgentQuerier(
 	memberlistCluster memberlist.Interface,
 	nodeLister corelisters.NodeLister,
 	bgpPolicyInfoQuerier querier.AgentBGPPolicyInfoQuerier,
+	gatewayConfigChecker GatewayConfigChecker,
 ) *agentQuerier {
 	return &agentQuerier{
 		nodeConfig:               nodeConfig,
@@ -86,6 +98,7 @@ func NewAgentQuerier(
 		interfaceStore:           interfaceStore,
 		k8sClient:                k8sClient,
 		ofClient:                 ofClient,
+		routeClient:              routeClient,
 		ovsBridgeClient:          ovsBridgeClient,
 		proxier:                  proxier,
 		networkPolicyInfoQuerier: networkPolicyInfoQuerier,
@@ -94,6 +107,7 @@ func NewAgentQuerier(
 		memberlistCluster:        memberlistCluster,
 		nodeLister:               nodeLister,
 		bgpPolicyInfoQuerier:     bgpPolicyInfoQuerier,
+		gatewayConfigChecker:     gatewayConfigChecker,
 	}
 }
 
@@ -137,6 +151,11 @@ func (aq *agentQuerier) GetOVSCtlClient() ovsctl.OVSCtlClient {
 	return ovsctl.NewClient(aq.nodeConfig.OVSBridge)
 }
 
+// GetRouteClient returns route.Interface.
+func (aq *agentQuerier) GetRouteClient() route.Interface {
+	return aq.routeClient
+}
+
 // GetProxier returns proxy.Proxier.
 func (aq *agentQuerier) GetProxier() proxy.Proxier {
 	return aq.proxier
@@ -182,7 +201,7 @@ func (aq agentQuerier) getAgentConditions(ovsConnected bool) []v1beta1.AgentCond
 	if !aq.ofClient.IsConnected() {
 		openflowConnectionStatus = v1.ConditionFalse
 	}
-	return []v1beta1.AgentCondition{
+	conditions := []v1beta1.AgentCondition{
 		{
 			Type:              v1beta1.AgentHealthy,
 			Status:            v1.ConditionTrue,
@@ -204,6 +223,18 @@ func (aq agentQuerier) getAgentConditions(ovsConnected bool) []v1beta1.AgentCond
 			LastHeartbeatTime: lastHeartbeatTime,
 		},
 	}
+	if aq.gatewayConfigChecker != nil {
+		gatewayConfigStatus := v1.ConditionTrue
+		if !aq.gatewayConfigChecker.IsGatewayConfigIntact() {
+			gatewayConfigStatus = v1.ConditionFalse
+		}
+		conditions = append(conditions, v1beta1.AgentCondition{
+			Type:              v1beta1.GatewayConfigIntact,
+			Status:            gatewayConfigStatus,
+			LastHeartbeatTime: lastHeartbeatTime,
+		})
+	}
+	return conditions
 }
 
 // getNetworkPolicyControllerInfo gets current network policy controller info
@@ -250,7 +281,28 @@ func (aq agentQuerier) GetAgentInfo(agentInfo *v1beta1.AntreaAgentInfo, partial
 		agentInfo.OVSInfo.BridgeName = aq.nodeConfig.OVSBridge
 		agentInfo.APIPort = aq.apiPort
 		agentInfo.NodePortLocalPortRange = aq.nplRange
+		agentInfo.KernelFeatureChecks = aq.getKernelFeatureChecks()
+	}
+}
+
+// getKernelFeatureChecks returns the results of the kernel feature checks run when the agent started.
+func (aq agentQuerier) getKernelFeatureChecks() []v1beta1.KernelFeatureCheck {
+	if len(aq.nodeConfig.KernelFeatureChecks) == 0 {
+		return nil
+	}
+	checks := make([]v1beta1.KernelFeatureCheck, 0, len(aq.nodeConfig.KernelFeatureChecks))
+	for _, r := range aq.nodeConfig.KernelFeatureChecks {
+		check := v1beta1.KernelFeatureCheck{
+			Name:       r.Name,
+			RequiredBy: r.RequiredBy,
+			Supported:  r.Err == nil,
+		}
+		if r.Err != nil {
+			check.Message = r.Err.Error()
+		}
+		checks = append(checks, check)
 	}
+	return checks
 }
 
 // GetBGPPolicyInfoQuerier returns AgentBGPPolicyInfoQuerier.
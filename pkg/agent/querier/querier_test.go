@@ -15,6 +15,7 @@
 package querier
 
 import (
+	"fmt"
 	"net"
 	"testing"
 
@@ -26,6 +27,7 @@ import (
 	"antrea.io/antrea/pkg/agent/config"
 	interfacestoretest "antrea.io/antrea/pkg/agent/interfacestore/testing"
 	openflowtest "antrea.io/antrea/pkg/agent/openflow/testing"
+	"antrea.io/antrea/pkg/agent/preflight"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 	ovsconfigtest "antrea.io/antrea/pkg/ovs/ovsconfig/testing"
@@ -128,6 +130,10 @@ func TestAgentQuerierGetAgentInfo(t *testing.T) {
 				NodeIPv4Addr: getIPNet("10.10.0.10"),
 				PodIPv4CIDR:  getIPNet("20.20.20.0/24"),
 				PodIPv6CIDR:  getIPNet("2001:ab03:cd04:55ef::/64"),
+				KernelFeatureChecks: []preflight.Result{
+					{Name: "ConntrackZones", RequiredBy: "OVS datapath"},
+					{Name: "WireGuard", RequiredBy: "WireGuard encryption", Err: fmt.Errorf("the wireguard kernel module is not loaded")},
+				},
 			},
 			apiPort: 10350,
 			partial: false,
@@ -164,6 +170,10 @@ func TestAgentQuerierGetAgentInfo(t *testing.T) {
 						Status: corev1.ConditionTrue,
 					},
 				},
+				KernelFeatureChecks: []v1beta1.KernelFeatureCheck{
+					{Name: "ConntrackZones", RequiredBy: "OVS datapath", Supported: true},
+					{Name: "WireGuard", RequiredBy: "WireGuard encryption", Supported: false, Message: "the wireguard kernel module is not loaded"},
+				},
 				APIPort:                10350,
 				NodePortLocalPortRange: defaultNPLPortRange,
 				Version:                "UNKNOWN",
@@ -196,3 +206,72 @@ func TestAgentQuerierGetAgentInfo(t *testing.T) {
 		})
 	}
 }
+
+type fakeGatewayConfigChecker struct {
+	intact bool
+}
+
+func (c *fakeGatewayConfigChecker) IsGatewayConfigIntact() bool {
+	return c.intact
+}
+
+func TestAgentQuerierGetAgentConditions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	ofClient := openflowtest.NewMockClient(ctrl)
+	ofClient.EXPECT().IsConnected().Return(true).AnyTimes()
+	networkPolicyInfoQuerier := queriertest.NewMockAgentNetworkPolicyInfoQuerier(ctrl)
+	networkPolicyInfoQuerier.EXPECT().GetControllerConnectionStatus().Return(true).AnyTimes()
+
+	tests := []struct {
+		name                 string
+		gatewayConfigChecker GatewayConfigChecker
+		expectedConditions   map[v1beta1.AgentConditionType]corev1.ConditionStatus
+	}{
+		{
+			name: "no gateway",
+			expectedConditions: map[v1beta1.AgentConditionType]corev1.ConditionStatus{
+				v1beta1.AgentHealthy:           corev1.ConditionTrue,
+				v1beta1.ControllerConnectionUp: corev1.ConditionTrue,
+				v1beta1.OVSDBConnectionUp:      corev1.ConditionTrue,
+				v1beta1.OpenflowConnectionUp:   corev1.ConditionTrue,
+			},
+		},
+		{
+			name:                 "gateway config intact",
+			gatewayConfigChecker: &fakeGatewayConfigChecker{intact: true},
+			expectedConditions: map[v1beta1.AgentConditionType]corev1.ConditionStatus{
+				v1beta1.AgentHealthy:           corev1.ConditionTrue,
+				v1beta1.ControllerConnectionUp: corev1.ConditionTrue,
+				v1beta1.OVSDBConnectionUp:      corev1.ConditionTrue,
+				v1beta1.OpenflowConnectionUp:   corev1.ConditionTrue,
+				v1beta1.GatewayConfigIntact:    corev1.ConditionTrue,
+			},
+		},
+		{
+			name:                 "gateway config broken",
+			gatewayConfigChecker: &fakeGatewayConfigChecker{intact: false},
+			expectedConditions: map[v1beta1.AgentConditionType]corev1.ConditionStatus{
+				v1beta1.AgentHealthy:           corev1.ConditionTrue,
+				v1beta1.ControllerConnectionUp: corev1.ConditionTrue,
+				v1beta1.OVSDBConnectionUp:      corev1.ConditionTrue,
+				v1beta1.OpenflowConnectionUp:   corev1.ConditionTrue,
+				v1beta1.GatewayConfigIntact:    corev1.ConditionFalse,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aq := agentQuerier{
+				ofClient:                 ofClient,
+				networkPolicyInfoQuerier: networkPolicyInfoQuerier,
+				gatewayConfigChecker:     tt.gatewayConfigChecker,
+			}
+			conditions := aq.getAgentConditions(true)
+			actualConditions := make(map[v1beta1.AgentConditionType]corev1.ConditionStatus, len(conditions))
+			for _, condition := range conditions {
+				actualConditions[condition.Type] = condition.Status
+			}
+			assert.Equal(t, tt.expectedConditions, actualConditions)
+		})
+	}
+}
@@ -32,6 +32,7 @@ import (
 	memberlist "antrea.io/antrea/pkg/agent/memberlist"
 	openflow "antrea.io/antrea/pkg/agent/openflow"
 	proxy "antrea.io/antrea/pkg/agent/proxy"
+	route "antrea.io/antrea/pkg/agent/route"
 	v1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	ovsctl "antrea.io/antrea/pkg/ovs/ovsctl"
 	querier "antrea.io/antrea/pkg/querier"
@@ -229,3 +230,17 @@ func (mr *MockAgentQuerierMockRecorder) GetProxier() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProxier", reflect.TypeOf((*MockAgentQuerier)(nil).GetProxier))
 }
+
+// GetRouteClient mocks base method.
+func (m *MockAgentQuerier) GetRouteClient() route.Interface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRouteClient")
+	ret0, _ := ret[0].(route.Interface)
+	return ret0
+}
+
+// GetRouteClient indicates an expected call of GetRouteClient.
+func (mr *MockAgentQuerierMockRecorder) GetRouteClient() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteClient", reflect.TypeOf((*MockAgentQuerier)(nil).GetRouteClient))
+}
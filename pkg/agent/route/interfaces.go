@@ -21,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/agent/config"
+	"antrea.io/antrea/pkg/agent/types"
 	binding "antrea.io/antrea/pkg/ovs/openflow"
 )
 
@@ -36,13 +37,20 @@ type Interface interface {
 	// It should be idempotent and can be safely called on every startup.
 	Initialize(nodeConfig *config.NodeConfig, done func()) error
 
+	// UpdateNodeTransportAddresses should update the configuration which depends on the transport addresses of the
+	// Node, after they have been updated in the NodeConfig. The previous addresses are provided so that the
+	// configuration depending on them can be removed.
+	UpdateNodeTransportAddresses(prevIPv4Addr, prevIPv6Addr *net.IPNet) error
+
 	// Reconcile should remove orphaned routes and related configuration based on the desired podCIDRs.
 	// If IPv6 is enabled in the cluster, Reconcile should also remove the orphaned IPv6 neighbors.
 	Reconcile(podCIDRs []string) error
 
 	// AddRoutes should add routes to the provided podCIDR.
 	// It should override the routes if they already exist, without error.
-	AddRoutes(podCIDR *net.IPNet, peerNodeName string, peerNodeIP, peerGwIP net.IP) error
+	// peerMultipathIPs are the IPs of the additional uplinks of the peer Node. They are used as additional next hops
+	// when multipath routing is enabled.
+	AddRoutes(podCIDR *net.IPNet, peerNodeName string, peerNodeIP, peerGwIP net.IP, peerMultipathIPs []net.IP) error
 
 	// DeleteRoutes should delete routes to the provided podCIDR.
 	// It should do nothing if the routes don't exist, without error.
@@ -121,4 +129,8 @@ type Interface interface {
 
 	// DeleteNodeNetworkPolicyIPTables deletes iptables chains and rules within the chains for NodeNetworkPolicy.
 	DeleteNodeNetworkPolicyIPTables(iptablesChains []string, isIPv6 bool) error
+
+	// DiffRoutes compares the routes expected by Antrea with the ones installed in the system, and returns the
+	// expected routes which are missing.
+	DiffRoutes() ([]types.DatapathEntryDiff, error)
 }
@@ -0,0 +1,290 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"math/rand"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// multipathFailureThreshold is the number of consecutive failed probes after which a path is removed from the
+	// multipath routes.
+	multipathFailureThreshold = 3
+	// multipathProbeTimeout is the time to wait for the replies to the probes of the paths.
+	multipathProbeTimeout = time.Second
+
+	protocolICMP   = 1
+	protocolICMPv6 = 58
+)
+
+// multipathRoute describes a route to a peer Node through multiple gateways.
+type multipathRoute struct {
+	podCIDR *net.IPNet
+	// gateways are the IPs of the peer Node used as next hops. The first one is the transport IP of the peer Node.
+	gateways []net.IP
+}
+
+// pathState tracks the health of a gateway of the multipath routes.
+type pathState struct {
+	healthy bool
+	// failures is the number of consecutive failed probes.
+	failures int
+}
+
+// pathProber probes the reachability of the gateways of the multipath routes.
+type pathProber interface {
+	// probe returns the IPs, among the provided ones, which replied to the probe within the timeout.
+	probe(ips []net.IP, timeout time.Duration) sets.Set[string]
+}
+
+// icmpPathProber probes the gateways with ICMP echo requests.
+type icmpPathProber struct {
+	id  int
+	seq int
+}
+
+func newICMPPathProber() *icmpPathProber {
+	// #nosec G404: random number generator not used for security purposes.
+	return &icmpPathProber{id: rand.Intn(1 << 16)}
+}
+
+func (p *icmpPathProber) probe(ips []net.IP, timeout time.Duration) sets.Set[string] {
+	var ipv4s, ipv6s []net.IP
+	for _, ip := range ips {
+		if ip.To4() != nil {
+			ipv4s = append(ipv4s, ip)
+		} else {
+			ipv6s = append(ipv6s, ip)
+		}
+	}
+	p.seq = (p.seq + 1) & 0xffff
+	reachable := sets.New[string]()
+	if len(ipv4s) > 0 {
+		p.probeFamily("ip4:icmp", "0.0.0.0", protocolICMP, ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply, ipv4s, timeout, reachable)
+	}
+	if len(ipv6s) > 0 {
+		p.probeFamily("ip6:ipv6-icmp", "::", protocolICMPv6, ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply, ipv6s, timeout, reachable)
+	}
+	return reachable
+}
+
+func (p *icmpPathProber) probeFamily(network, address string, protocol int, requestType, replyType icmp.Type, ips []net.IP, timeout time.Duration, reachable sets.Set[string]) {
+	conn, err := icmp.ListenPacket(network, address)
+	if err != nil {
+		klog.ErrorS(err, "Failed to open ICMP socket to probe the paths to peer Nodes", "network", network)
+		return
+	}
+	defer conn.Close()
+	targets := sets.New[string]()
+	for _, ip := range ips {
+		msg := icmp.Message{
+			Type: requestType,
+			Body: &icmp.Echo{ID: p.id, Seq: p.seq},
+		}
+		msgBytes, err := msg.Marshal(nil)
+		if err != nil {
+			klog.ErrorS(err, "Failed to marshal ICMP message")
+			return
+		}
+		if _, err := conn.WriteTo(msgBytes, &net.IPAddr{IP: ip}); err != nil {
+			klog.V(2).InfoS("Failed to send ICMP echo request", "ip", ip, "err", err)
+			continue
+		}
+		targets.Insert(ip.String())
+	}
+	if err := conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		klog.ErrorS(err, "Failed to set read deadline of ICMP socket")
+		return
+	}
+	buffer := make([]byte, 1500)
+	for targets.Len() > 0 {
+		n, peer, err := conn.ReadFrom(buffer)
+		if err != nil {
+			// The deadline has been reached.
+			return
+		}
+		msg, err := icmp.ParseMessage(protocol, buffer[:n])
+		if err != nil || msg.Type != replyType {
+			continue
+		}
+		echo, ok := msg.Body.(*icmp.Echo)
+		if !ok || echo.ID != p.id || echo.Seq != p.seq {
+			continue
+		}
+		peerIP := peer.(*net.IPAddr).IP.String()
+		if targets.Has(peerIP) {
+			targets.Delete(peerIP)
+			reachable.Insert(peerIP)
+		}
+	}
+}
+
+// getMultipathGateways returns the gateways of the route to a peer Node: the transport IP of the peer Node, followed by
+// the IPs of the additional uplinks of the peer Node which are in the subnet of an additional uplink of this Node. It
+// returns nil if multipath routing is disabled.
+func (c *Client) getMultipathGateways(nodeIP net.IP, peerMultipathIPs []net.IP) []net.IP {
+	if len(c.nodeConfig.MultipathUplinks) == 0 {
+		return nil
+	}
+	isIPv4 := nodeIP.To4() != nil
+	gateways := []net.IP{nodeIP}
+	for _, peerIP := range peerMultipathIPs {
+		if (peerIP.To4() != nil) != isIPv4 || containsIP(gateways, peerIP) {
+			continue
+		}
+		for _, uplink := range c.nodeConfig.MultipathUplinks {
+			uplinkAddr := uplink.IPv4Addr
+			if !isIPv4 {
+				uplinkAddr = uplink.IPv6Addr
+			}
+			if uplinkAddr != nil && uplinkAddr.Contains(peerIP) {
+				gateways = append(gateways, peerIP)
+				break
+			}
+		}
+	}
+	return gateways
+}
+
+func containsIP(ips []net.IP, ip net.IP) bool {
+	for _, i := range ips {
+		if i.Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// newMultipathRoute returns the route to the provided podCIDR through the healthy gateways. The gateways which have
+// not been probed yet are considered healthy. If none of the gateways is healthy, all of them are used, as the traffic
+// would be dropped otherwise. It must be called with nodeRoutesMutex held.
+func (c *Client) newMultipathRoute(podCIDR *net.IPNet, gateways []net.IP) *netlink.Route {
+	var healthyGateways []net.IP
+	for _, gateway := range gateways {
+		if state, exists := c.pathStates[gateway.String()]; !exists || state.healthy {
+			healthyGateways = append(healthyGateways, gateway)
+		}
+	}
+	if len(healthyGateways) == 0 {
+		healthyGateways = gateways
+	}
+	route := &netlink.Route{Dst: podCIDR}
+	if len(healthyGateways) == 1 {
+		route.Gw = healthyGateways[0]
+		return route
+	}
+	for _, gateway := range healthyGateways {
+		route.MultiPath = append(route.MultiPath, &netlink.NexthopInfo{Gw: gateway})
+	}
+	return route
+}
+
+// multipathKey returns a string identifying the next hops of a multipath route, regardless of their order.
+func multipathKey(route *netlink.Route) string {
+	if len(route.MultiPath) == 0 {
+		return ""
+	}
+	gateways := make([]string, 0, len(route.MultiPath))
+	for _, nexthop := range route.MultiPath {
+		gateways = append(gateways, nexthop.Gw.String())
+	}
+	sort.Strings(gateways)
+	return strings.Join(gateways, ",")
+}
+
+// checkMultipathHealth probes the gateways of the multipath routes, and updates the routes when a gateway becomes
+// unhealthy after multipathFailureThreshold consecutive failed probes, or becomes healthy again after a successful
+// probe.
+func (c *Client) checkMultipathHealth() {
+	gateways := map[string]net.IP{}
+	func() {
+		c.nodeRoutesMutex.Lock()
+		defer c.nodeRoutesMutex.Unlock()
+		for _, route := range c.multipathRoutes {
+			for _, gateway := range route.gateways {
+				gateways[gateway.String()] = gateway
+			}
+		}
+		// Forget the gateways which are no longer used.
+		for gateway := range c.pathStates {
+			if _, exists := gateways[gateway]; !exists {
+				delete(c.pathStates, gateway)
+			}
+		}
+	}()
+	if len(gateways) == 0 {
+		return
+	}
+	ips := make([]net.IP, 0, len(gateways))
+	for _, gateway := range gateways {
+		ips = append(ips, gateway)
+	}
+	reachable := c.pathProber.probe(ips, multipathProbeTimeout)
+
+	c.nodeRoutesMutex.Lock()
+	defer c.nodeRoutesMutex.Unlock()
+	changed := false
+	for gateway := range gateways {
+		state, exists := c.pathStates[gateway]
+		if !exists {
+			state = &pathState{healthy: true}
+			c.pathStates[gateway] = state
+		}
+		if reachable.Has(gateway) {
+			state.failures = 0
+			if !state.healthy {
+				klog.InfoS("Path to peer Node is healthy again", "gateway", gateway)
+				state.healthy = true
+				changed = true
+			}
+			continue
+		}
+		state.failures++
+		if state.healthy && state.failures >= multipathFailureThreshold {
+			klog.InfoS("Path to peer Node is unhealthy", "gateway", gateway, "failedProbes", state.failures)
+			state.healthy = false
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	for podCIDRStr, mpRoute := range c.multipathRoutes {
+		route := c.newMultipathRoute(mpRoute.podCIDR, mpRoute.gateways)
+		var installedRoutes []*netlink.Route
+		if value, ok := c.nodeRoutes.Load(podCIDRStr); ok {
+			installedRoutes = value.([]*netlink.Route)
+		}
+		if containsRoute(installedRoutes, route) {
+			continue
+		}
+		if err := c.netlink.RouteReplace(route); err != nil {
+			klog.ErrorS(err, "Failed to update multipath route", "route", route)
+			continue
+		}
+		c.nodeRoutes.Store(podCIDRStr, []*netlink.Route{route})
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vishvananda/netlink"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/agent/config"
+	ipsettest "antrea.io/antrea/pkg/agent/util/ipset/testing"
+	netlinktest "antrea.io/antrea/pkg/agent/util/netlink/testing"
+	"antrea.io/antrea/pkg/util/ip"
+)
+
+type fakePathProber struct {
+	reachable sets.Set[string]
+}
+
+func (p *fakePathProber) probe(ips []net.IP, timeout time.Duration) sets.Set[string] {
+	return p.reachable
+}
+
+func TestMultipathRoutes(t *testing.T) {
+	ipv4, nodeTransPortIPv4Addr, _ := net.ParseCIDR("172.16.10.2/24")
+	nodeTransPortIPv4Addr.IP = ipv4
+	uplinkIP, uplinkAddr, _ := net.ParseCIDR("172.16.20.2/24")
+	uplinkAddr.IP = uplinkIP
+	podCIDR := ip.MustParseCIDR("192.168.10.0/24")
+	nodeIP := net.ParseIP("172.16.10.3")
+	peerUplinkIP := net.ParseIP("172.16.20.3")
+
+	ctrl := gomock.NewController(t)
+	mockNetlink := netlinktest.NewMockInterface(ctrl)
+	mockIPSet := ipsettest.NewMockInterface(ctrl)
+	prober := &fakePathProber{reachable: sets.New[string]("172.16.10.3")}
+	c := &Client{netlink: mockNetlink,
+		ipset: mockIPSet,
+		networkConfig: &config.NetworkConfig{
+			TrafficEncapMode: config.TrafficEncapModeNoEncap,
+			IPv4Enabled:      true,
+		},
+		nodeConfig: &config.NodeConfig{
+			GatewayConfig: &config.GatewayConfig{
+				Name:      "antrea-gw0",
+				IPv4:      net.ParseIP("192.168.1.1"),
+				LinkIndex: 10,
+			},
+			NodeTransportIPv4Addr: nodeTransPortIPv4Addr,
+			MultipathUplinks:      []*config.MultipathUplink{{Name: "eth1", IPv4Addr: uplinkAddr}},
+		},
+		multipathRoutes: map[string]*multipathRoute{},
+		pathStates:      map[string]*pathState{},
+		pathProber:      prober,
+	}
+	expectedMultipathRoute := &netlink.Route{
+		Dst: podCIDR,
+		MultiPath: []*netlink.NexthopInfo{
+			{Gw: nodeIP},
+			{Gw: peerUplinkIP},
+		},
+	}
+	singlePathRoute := &netlink.Route{Dst: podCIDR, Gw: nodeIP}
+
+	// The IPs of the peer Node which are not in the subnet of a local uplink, or of another address family, are ignored.
+	mockIPSet.EXPECT().AddEntry(antreaPodIPSet, "192.168.10.0/24")
+	mockNetlink.EXPECT().RouteReplace(expectedMultipathRoute)
+	assert.NoError(t, c.AddRoutes(podCIDR, "node0", nodeIP, net.ParseIP("192.168.10.1"),
+		[]net.IP{peerUplinkIP, net.ParseIP("172.16.30.3"), net.ParseIP("fec0::3")}))
+
+	// The path through the additional uplink is removed after multipathFailureThreshold consecutive failed probes.
+	for i := 1; i < multipathFailureThreshold; i++ {
+		c.checkMultipathHealth()
+	}
+	mockNetlink.EXPECT().RouteReplace(singlePathRoute)
+	c.checkMultipathHealth()
+	routes, _ := c.nodeRoutes.Load(podCIDR.String())
+	assert.Equal(t, []*netlink.Route{singlePathRoute}, routes)
+
+	// The path is restored after a successful probe.
+	prober.reachable.Insert("172.16.20.3")
+	mockNetlink.EXPECT().RouteReplace(expectedMultipathRoute)
+	c.checkMultipathHealth()
+	routes, _ = c.nodeRoutes.Load(podCIDR.String())
+	assert.Equal(t, []*netlink.Route{expectedMultipathRoute}, routes)
+
+	// The states of the paths are forgotten once the route is deleted.
+	mockIPSet.EXPECT().DelEntry(antreaPodIPSet, "192.168.10.0/24")
+	mockNetlink.EXPECT().RouteDel(expectedMultipathRoute)
+	assert.NoError(t, c.DeleteRoutes(podCIDR))
+	c.checkMultipathHealth()
+	assert.Empty(t, c.multipathRoutes)
+	assert.Empty(t, c.pathStates)
+}
@@ -0,0 +1,234 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+)
+
+// routeBatchSize is the maximum number of RTM_NEWROUTE messages sent with a single sendmsg call. A message is less
+// than 100 bytes for the routes installed by Antrea, so a batch fits in the default socket buffers.
+const routeBatchSize = 256
+
+// routeBatcher replaces routes with multiple RTM_NEWROUTE messages sent in a single sendmsg call, and collects the
+// acknowledgements of all the messages, instead of waiting for the acknowledgement of each route before sending the
+// next one. The kernel processes the messages in order, so the result is the same as replacing the routes one by one.
+type routeBatcher struct {
+	mutex sync.Mutex
+	fd    int
+	seq   uint32
+}
+
+func newRouteBatcher(timeout time.Duration) (*routeBatcher, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_ROUTE)
+	if err != nil {
+		return nil, err
+	}
+	if err := func() error {
+		if err := unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+			return err
+		}
+		tv := unix.NsecToTimeval(timeout.Nanoseconds())
+		if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_SNDTIMEO, &tv); err != nil {
+			return err
+		}
+		if err := unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &tv); err != nil {
+			return err
+		}
+		// Do not include the original requests in the error messages, so that the acknowledgements of a whole batch
+		// are small.
+		return unix.SetsockoptInt(fd, unix.SOL_NETLINK, unix.NETLINK_CAP_ACK, 1)
+	}(); err != nil {
+		unix.Close(fd)
+		return nil, err
+	}
+	return &routeBatcher{fd: fd}, nil
+}
+
+// isBatchable returns whether the route only has the attributes supported by newRouteReplaceMessage. The other
+// routes must be replaced with the netlink library.
+func isBatchable(route *netlink.Route) bool {
+	if route.Dst == nil || route.Dst.IP == nil || route.Encap != nil || route.Via != nil || route.NewDst != nil || route.MPLSDst != nil {
+		return false
+	}
+	if route.MTU != 0 || route.AdvMSS != 0 || route.Hoplimit != 0 || route.Realm != 0 || route.Tos != 0 {
+		return false
+	}
+	for _, nh := range route.MultiPath {
+		if nh.Encap != nil || nh.Via != nil || nh.NewDst != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// newRouteReplaceMessage returns the RTM_NEWROUTE message replacing the route, which is equivalent to the message
+// sent by netlink.Handle.RouteReplace for the supported attributes.
+func newRouteReplaceMessage(route *netlink.Route, seq uint32) []byte {
+	req := nl.NewNetlinkRequest(unix.RTM_NEWROUTE, unix.NLM_F_CREATE|unix.NLM_F_REPLACE|unix.NLM_F_ACK)
+	req.Seq = seq
+	ipData := func(ip net.IP) []byte {
+		if ip4 := ip.To4(); ip4 != nil {
+			return ip4
+		}
+		return ip.To16()
+	}
+	uint32Data := func(v uint32) []byte {
+		b := make([]byte, 4)
+		binary.NativeEndian.PutUint32(b, v)
+		return b
+	}
+
+	msg := nl.NewRtMsg()
+	dstLen, _ := route.Dst.Mask.Size()
+	msg.Dst_len = uint8(dstLen)
+	msg.Family = uint8(nl.GetIPFamily(route.Dst.IP))
+	msg.Flags = uint32(route.Flags)
+	msg.Scope = uint8(route.Scope)
+	if route.Protocol > 0 {
+		msg.Protocol = uint8(route.Protocol)
+	}
+	if route.Type > 0 {
+		msg.Type = uint8(route.Type)
+	}
+	attrs := []*nl.RtAttr{nl.NewRtAttr(unix.RTA_DST, ipData(route.Dst.IP))}
+	if route.Src != nil {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_PREFSRC, ipData(route.Src)))
+	}
+	if route.Gw != nil {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_GATEWAY, ipData(route.Gw)))
+	}
+	if len(route.MultiPath) > 0 {
+		var buf []byte
+		for _, nh := range route.MultiPath {
+			rtnh := &nl.RtNexthop{
+				RtNexthop: unix.RtNexthop{
+					Hops:    uint8(nh.Hops),
+					Ifindex: int32(nh.LinkIndex),
+					Flags:   uint8(nh.Flags),
+				},
+			}
+			if nh.Gw != nil {
+				rtnh.Children = []nl.NetlinkRequestData{nl.NewRtAttr(unix.RTA_GATEWAY, ipData(nh.Gw))}
+			}
+			buf = append(buf, rtnh.Serialize()...)
+		}
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_MULTIPATH, buf))
+	}
+	if route.Table > 0 {
+		if route.Table >= 256 {
+			msg.Table = unix.RT_TABLE_UNSPEC
+			attrs = append(attrs, nl.NewRtAttr(unix.RTA_TABLE, uint32Data(uint32(route.Table))))
+		} else {
+			msg.Table = uint8(route.Table)
+		}
+	}
+	if route.Priority > 0 {
+		attrs = append(attrs, nl.NewRtAttr(unix.RTA_PRIORITY, uint32Data(uint32(route.Priority))))
+	}
+	req.AddData(msg)
+	for _, attr := range attrs {
+		req.AddData(attr)
+	}
+	req.AddData(nl.NewRtAttr(unix.RTA_OIF, uint32Data(uint32(route.LinkIndex))))
+	return req.Serialize()
+}
+
+// replaceRoutes replaces the routes, which must be batchable, and returns the error of each route.
+func (b *routeBatcher) replaceRoutes(routes []*netlink.Route) []error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	errs := make([]error, len(routes))
+	for start := 0; start < len(routes); start += routeBatchSize {
+		end := min(start+routeBatchSize, len(routes))
+		b.replaceBatch(routes[start:end], errs[start:end])
+	}
+	return errs
+}
+
+func (b *routeBatcher) replaceBatch(routes []*netlink.Route, errs []error) {
+	var buf []byte
+	// pending maps the sequence numbers of the messages which have not been acknowledged to the index of the route.
+	pending := make(map[uint32]int, len(routes))
+	for i, route := range routes {
+		b.seq++
+		pending[b.seq] = i
+		buf = append(buf, newRouteReplaceMessage(route, b.seq)...)
+	}
+	failAll := func(err error) {
+		for _, i := range pending {
+			errs[i] = err
+		}
+	}
+	if err := unix.Sendto(b.fd, buf, 0, &unix.SockaddrNetlink{Family: unix.AF_NETLINK}); err != nil {
+		failAll(fmt.Errorf("failed to send netlink messages: %w", err))
+		return
+	}
+	rb := make([]byte, unix.Getpagesize()*16)
+	for len(pending) > 0 {
+		n, _, err := unix.Recvfrom(b.fd, rb, 0)
+		if err != nil {
+			failAll(fmt.Errorf("failed to receive netlink acknowledgements: %w", err))
+			return
+		}
+		msgs, err := syscall.ParseNetlinkMessage(rb[:n])
+		if err != nil {
+			failAll(fmt.Errorf("failed to parse netlink acknowledgements: %w", err))
+			return
+		}
+		for _, m := range msgs {
+			i, ok := pending[m.Header.Seq]
+			// Ignore the messages of the requests which have timed out before.
+			if !ok || m.Header.Type != unix.NLMSG_ERROR || len(m.Data) < 4 {
+				continue
+			}
+			delete(pending, m.Header.Seq)
+			if errno := -int32(binary.NativeEndian.Uint32(m.Data[0:4])); errno != 0 {
+				errs[i] = syscall.Errno(errno)
+			}
+		}
+	}
+}
+
+// replaceRoutes replaces the routes in order and returns the error of each route. Consecutive batchable routes are
+// replaced in batches.
+func (c *Client) replaceRoutes(routes []*netlink.Route) []error {
+	errs := make([]error, len(routes))
+	batchStart := 0
+	flush := func(end int) {
+		if end > batchStart {
+			copy(errs[batchStart:end], c.routeBatcher.replaceRoutes(routes[batchStart:end]))
+		}
+	}
+	for i, route := range routes {
+		if c.routeBatcher != nil && isBatchable(route) {
+			continue
+		}
+		flush(i)
+		errs[i] = c.netlink.RouteReplace(route)
+		batchStart = i + 1
+	}
+	flush(len(routes))
+	return errs
+}
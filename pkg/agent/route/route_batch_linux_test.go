@@ -0,0 +1,125 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package route
+
+import (
+	"encoding/binary"
+	"net"
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vishvananda/netlink"
+	"github.com/vishvananda/netlink/nl"
+	"golang.org/x/sys/unix"
+
+	"antrea.io/antrea/pkg/util/ip"
+)
+
+func TestNewRouteReplaceMessage(t *testing.T) {
+	tests := []struct {
+		name           string
+		route          *netlink.Route
+		expectedFamily uint8
+		expectedDstLen uint8
+		expectedFlags  uint32
+		expectedScope  uint8
+		expectedAttrs  map[uint16][]byte
+	}{
+		{
+			name: "IPv4 onlink route",
+			route: &netlink.Route{
+				Dst:       ip.MustParseCIDR("10.10.1.0/24"),
+				Gw:        net.ParseIP("10.10.1.1"),
+				LinkIndex: 10,
+				Flags:     int(netlink.FLAG_ONLINK),
+			},
+			expectedFamily: unix.AF_INET,
+			expectedDstLen: 24,
+			expectedFlags:  unix.RTNH_F_ONLINK,
+			expectedScope:  unix.RT_SCOPE_UNIVERSE,
+			expectedAttrs: map[uint16][]byte{
+				unix.RTA_DST:     {10, 10, 1, 0},
+				unix.RTA_GATEWAY: {10, 10, 1, 1},
+				unix.RTA_OIF:     binary.NativeEndian.AppendUint32(nil, 10),
+			},
+		},
+		{
+			name: "IPv6 link route with source",
+			route: &netlink.Route{
+				Dst:       ip.MustParseCIDR("fd00:10:10:1::/64"),
+				Src:       net.ParseIP("fd00:10:10:1::1"),
+				LinkIndex: 10,
+				Scope:     netlink.SCOPE_LINK,
+				Table:     300,
+			},
+			expectedFamily: unix.AF_INET6,
+			expectedDstLen: 64,
+			expectedScope:  unix.RT_SCOPE_LINK,
+			expectedAttrs: map[uint16][]byte{
+				unix.RTA_DST:     net.ParseIP("fd00:10:10:1::"),
+				unix.RTA_PREFSRC: net.ParseIP("fd00:10:10:1::1"),
+				unix.RTA_TABLE:   binary.NativeEndian.AppendUint32(nil, 300),
+				unix.RTA_OIF:     binary.NativeEndian.AppendUint32(nil, 10),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs, err := syscall.ParseNetlinkMessage(newRouteReplaceMessage(tt.route, 100))
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+			assert.Equal(t, uint16(unix.RTM_NEWROUTE), msgs[0].Header.Type)
+			assert.Equal(t, uint16(unix.NLM_F_REQUEST|unix.NLM_F_CREATE|unix.NLM_F_REPLACE|unix.NLM_F_ACK), msgs[0].Header.Flags)
+			assert.Equal(t, uint32(100), msgs[0].Header.Seq)
+
+			rtMsg := nl.DeserializeRtMsg(msgs[0].Data)
+			assert.Equal(t, tt.expectedFamily, rtMsg.Family)
+			assert.Equal(t, tt.expectedDstLen, rtMsg.Dst_len)
+			assert.Equal(t, tt.expectedFlags, rtMsg.Flags)
+			assert.Equal(t, tt.expectedScope, rtMsg.Scope)
+			attrs, err := nl.ParseRouteAttr(msgs[0].Data[unix.SizeofRtMsg:])
+			require.NoError(t, err)
+			actualAttrs := make(map[uint16][]byte, len(attrs))
+			for _, attr := range attrs {
+				actualAttrs[attr.Attr.Type] = attr.Value
+			}
+			assert.Equal(t, tt.expectedAttrs, actualAttrs)
+		})
+	}
+}
+
+func TestIsBatchable(t *testing.T) {
+	dst := ip.MustParseCIDR("10.10.1.0/24")
+	assert.True(t, isBatchable(&netlink.Route{Dst: dst, Gw: net.ParseIP("10.10.0.1")}))
+	assert.True(t, isBatchable(&netlink.Route{Dst: dst, MultiPath: []*netlink.NexthopInfo{{Gw: net.ParseIP("10.10.0.1")}, {Gw: net.ParseIP("10.10.0.2")}}}))
+	assert.False(t, isBatchable(&netlink.Route{Gw: net.ParseIP("10.10.0.1")}))
+	assert.False(t, isBatchable(&netlink.Route{Dst: dst, MTU: 1400}))
+}
+
+func TestRouteBatcherReplaceRoutes(t *testing.T) {
+	b := &routeBatcher{fd: -1}
+	routes := []*netlink.Route{
+		{Dst: ip.MustParseCIDR("10.10.1.0/24"), Gw: net.ParseIP("10.10.0.1")},
+		{Dst: ip.MustParseCIDR("10.10.2.0/24"), Gw: net.ParseIP("10.10.0.2")},
+	}
+	// The messages cannot be sent with an invalid socket, and the error is reported for all the routes.
+	errs := b.replaceRoutes(routes)
+	require.Len(t, errs, 2)
+	assert.ErrorContains(t, errs[0], "failed to send netlink messages")
+	assert.ErrorContains(t, errs[1], "failed to send netlink messages")
+	assert.Equal(t, uint32(2), b.seq)
+}
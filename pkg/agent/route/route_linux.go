@@ -16,6 +16,7 @@ package route
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
@@ -55,6 +56,10 @@ const (
 	vxlanPort  = 4789
 	genevePort = 6081
 
+	// netlinkSocketTimeout is the send and receive timeout of the netlink socket, consistent with the default timeout
+	// used by the netlink library for the sockets created for each request.
+	netlinkSocketTimeout = 60 * time.Second
+
 	// Antrea managed ipset.
 	// antreaPodIPSet contains all Per-Node IPAM Pod CIDRs of this cluster.
 	antreaPodIPSet = "ANTREA-POD-IP"
@@ -118,8 +123,20 @@ type Client struct {
 	iptables               iptables.Interface
 	ipset                  ipset.Interface
 	netlink                utilnetlink.Interface
+	// routeBatcher replaces routes in batches. Routes are replaced one by one with netlink if it's nil.
+	routeBatcher *routeBatcher
 	// nodeRoutes caches ip routes to remote Pods. It's a map of podCIDR to routes.
 	nodeRoutes sync.Map
+	// nodeRoutesMutex serializes the updates of the routes to remote Pods by AddRoutes, DeleteRoutes and the health
+	// check of the multipath routes.
+	nodeRoutesMutex sync.Mutex
+	// multipathRoutes caches the routes to remote Pods through multiple uplinks. It's a map of podCIDR to routes.
+	// Protected by nodeRoutesMutex.
+	multipathRoutes map[string]*multipathRoute
+	// pathStates tracks the health of the gateways of the multipath routes. It's a map of gateway IP to its state.
+	// Protected by nodeRoutesMutex.
+	pathStates map[string]*pathState
+	pathProber pathProber
 	// nodeNeighbors caches IPv6 Neighbors to remote host gateway
 	nodeNeighbors sync.Map
 	// markToSNATIP caches marks to SNAT IPs. It's used in Egress feature.
@@ -191,6 +208,22 @@ func NewClient(networkConfig *config.NetworkConfig,
 	egressSNATRandomFully bool,
 	serviceCIDRProvider servicecidr.Interface,
 	wireguardPort int) (*Client, error) {
+	// Use a dedicated NETLINK_ROUTE socket for all the netlink requests of the client, instead of opening and closing
+	// a socket for each request, which reduces the number of syscalls significantly when there are many routes to
+	// program, e.g. in clusters with thousands of Nodes. Requests sharing the socket are serialized by the library.
+	netlinkHandle, err := netlink.NewHandle(unix.NETLINK_ROUTE)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create netlink handle: %w", err)
+	}
+	if err := netlinkHandle.SetSocketTimeout(netlinkSocketTimeout); err != nil {
+		netlinkHandle.Close()
+		return nil, fmt.Errorf("failed to set timeout of netlink socket: %w", err)
+	}
+	routeBatcher, err := newRouteBatcher(netlinkSocketTimeout)
+	if err != nil {
+		netlinkHandle.Close()
+		return nil, fmt.Errorf("failed to create netlink socket for route batches: %w", err)
+	}
 	return &Client{
 		networkConfig:               networkConfig,
 		noSNAT:                      noSNAT,
@@ -202,7 +235,8 @@ func NewClient(networkConfig *config.NetworkConfig,
 		nodeNetworkPolicyEnabled:    nodeNetworkPolicyEnabled,
 		nodeLatencyMonitorEnabled:   nodeLatencyMonitorEnabled,
 		ipset:                       ipset.NewClient(),
-		netlink:                     &netlink.Handle{},
+		netlink:                     netlinkHandle,
+		routeBatcher:                routeBatcher,
 		isCloudEKS:                  env.IsCloudEKS(),
 		serviceCIDRProvider:         serviceCIDRProvider,
 		serviceExternalIPReferences: make(map[string]sets.Set[string]),
@@ -212,7 +246,10 @@ func NewClient(networkConfig *config.NetworkConfig,
 			antreaExternalIPIPSet:  {},
 			antreaExternalIPIP6Set: {},
 		},
-		wireguardPort: wireguardPort,
+		wireguardPort:   wireguardPort,
+		multipathRoutes: make(map[string]*multipathRoute),
+		pathStates:      make(map[string]*pathState),
+		pathProber:      newICMPPathProber(),
 	}, nil
 }
 
@@ -298,6 +335,10 @@ func (c *Client) Initialize(nodeConfig *config.NodeConfig, done func()) error {
 // It will not return until stopCh is closed.
 func (c *Client) Run(stopCh <-chan struct{}) {
 	<-c.iptablesInitialized
+	if len(c.nodeConfig.MultipathUplinks) > 0 {
+		klog.InfoS("Starting health check of multipath routes", "interval", c.networkConfig.MultipathHealthCheckInterval)
+		go wait.Until(c.checkMultipathHealth, c.networkConfig.MultipathHealthCheckInterval, stopCh)
+	}
 	klog.InfoS("Starting iptables, ipset and route sync", "interval", SyncInterval)
 	wait.Until(c.syncIPInfra, SyncInterval, stopCh)
 }
@@ -323,13 +364,25 @@ type routeKey struct {
 	linkIndex int
 	dst       string
 	gw        string
+	multipath string
 	tableID   int
 }
 
-func (c *Client) syncRoute() error {
+func newRouteKey(route *netlink.Route) routeKey {
+	return routeKey{
+		linkIndex: route.LinkIndex,
+		dst:       route.Dst.String(),
+		gw:        route.Gw.String(),
+		multipath: multipathKey(route),
+		tableID:   route.Table,
+	}
+}
+
+// listRouteKeys returns the keys of the routes currently installed in the system.
+func (c *Client) listRouteKeys() (sets.Set[routeKey], error) {
 	routeList, err := c.netlink.RouteList(nil, netlink.FAMILY_ALL)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	routeKeys := sets.New[routeKey]()
 	for i := range routeList {
@@ -337,57 +390,34 @@ func (c *Client) syncRoute() error {
 		if r.Dst == nil || r.Dst.IP.IsUnspecified() {
 			continue
 		}
-		routeKeys.Insert(routeKey{
-			linkIndex: r.LinkIndex,
-			dst:       r.Dst.String(),
-			gw:        r.Gw.String(),
-			tableID:   r.Table,
-		})
-	}
-	restoreRoute := func(route *netlink.Route) bool {
-		if routeKeys.Has(routeKey{
-			linkIndex: route.LinkIndex,
-			dst:       route.Dst.String(),
-			gw:        route.Gw.String(),
-			tableID:   route.Table,
-		}) {
-			return true
-		}
-		if err := c.netlink.RouteReplace(route); err != nil {
-			klog.ErrorS(err, "Failed to sync route", "Route", route)
-			return false
-		}
-		return true
+		routeKeys.Insert(newRouteKey(r))
 	}
+	return routeKeys, nil
+}
+
+// desiredRoutes returns all the routes which are expected to be installed in the system by Antrea.
+func (c *Client) desiredRoutes() []*netlink.Route {
+	var routes []*netlink.Route
 	c.nodeRoutes.Range(func(_, v interface{}) bool {
-		for _, route := range v.([]*netlink.Route) {
-			if !restoreRoute(route) {
-				return false
-			}
-		}
+		routes = append(routes, v.([]*netlink.Route)...)
 		return true
 	})
 	if c.proxyAll {
 		c.serviceRoutes.Range(func(_, v interface{}) bool {
-			route := v.(*netlink.Route)
-			return restoreRoute(route)
+			routes = append(routes, v.(*netlink.Route))
+			return true
 		})
 	}
 	c.egressRoutes.Range(func(_, v any) bool {
-		for _, route := range v.([]*netlink.Route) {
-			if !restoreRoute(route) {
-				return false
-			}
-		}
+		routes = append(routes, v.([]*netlink.Route)...)
 		return true
 	})
 	// These routes are installed automatically by the kernel when the address is configured on
 	// the interface (with "proto kernel"). If these routes are deleted manually by mistake, we
 	// restore them as part of this sync (without "proto kernel"). An alternative would be to
 	// flap the interface, but this seems like a better approach.
-	gwAutoconfRoutes := []*netlink.Route{}
 	if c.nodeConfig.PodIPv4CIDR != nil {
-		gwAutoconfRoutes = append(gwAutoconfRoutes, &netlink.Route{
+		routes = append(routes, &netlink.Route{
 			LinkIndex: c.nodeConfig.GatewayConfig.LinkIndex,
 			Dst:       c.nodeConfig.PodIPv4CIDR,
 			Src:       c.nodeConfig.GatewayConfig.IPv4,
@@ -397,7 +427,7 @@ func (c *Client) syncRoute() error {
 	if c.nodeConfig.PodIPv6CIDR != nil {
 		// Here we assume the IPv6 link-local address always exists on antrea-gw0
 		// to avoid unexpected issues in the IPv6 forwarding.
-		gwAutoconfRoutes = append(gwAutoconfRoutes, &netlink.Route{
+		routes = append(routes, &netlink.Route{
 			LinkIndex: c.nodeConfig.GatewayConfig.LinkIndex,
 			Dst:       c.nodeConfig.PodIPv6CIDR,
 			Src:       c.nodeConfig.GatewayConfig.IPv6,
@@ -411,12 +441,47 @@ func (c *Client) syncRoute() error {
 			},
 		)
 	}
-	for _, route := range gwAutoconfRoutes {
-		restoreRoute(route)
+	return routes
+}
+
+func (c *Client) syncRoute() error {
+	routeKeys, err := c.listRouteKeys()
+	if err != nil {
+		return err
+	}
+	var missingRoutes []*netlink.Route
+	for _, route := range c.desiredRoutes() {
+		if !routeKeys.Has(newRouteKey(route)) {
+			missingRoutes = append(missingRoutes, route)
+		}
+	}
+	for i, err := range c.replaceRoutes(missingRoutes) {
+		if err != nil {
+			klog.ErrorS(err, "Failed to sync route", "Route", missingRoutes[i])
+		}
 	}
 	return nil
 }
 
+// DiffRoutes reports the routes which are expected to be installed by Antrea but are missing in the system.
+func (c *Client) DiffRoutes() ([]types.DatapathEntryDiff, error) {
+	routeKeys, err := c.listRouteKeys()
+	if err != nil {
+		return nil, err
+	}
+	var diffs []types.DatapathEntryDiff
+	for _, route := range c.desiredRoutes() {
+		if !routeKeys.Has(newRouteKey(route)) {
+			diffs = append(diffs, types.DatapathEntryDiff{
+				Type:    types.DatapathEntryRoute,
+				State:   types.DatapathDiffMissing,
+				Desired: route.String(),
+			})
+		}
+	}
+	return diffs, nil
+}
+
 // syncIPSet ensures that the required ipset exists, and it has the initial members.
 func (c *Client) syncIPSet() error {
 	// Create the ipsets to store all Pod CIDRs for constructing full-mesh routing in encap/noEncap/hybrid modes. In
@@ -939,6 +1004,18 @@ func (c *Client) restoreIptablesData(podCIDR *net.IPNet,
 		writeLine(iptablesData, iptables.MakeChainLine(chain))
 	}
 
+	if c.nodeNetworkPolicyEnabled {
+		// New connections DNAT'd to local Pods through a hostPort must go through the ingress NodeNetworkPolicy rules
+		// for hostPort traffic before being accepted by the following rules.
+		writeLine(iptablesData, []string{
+			"-A", antreaForwardChain,
+			"-m", "comment", "--comment", `"Antrea: jump to hostPort NodeNetworkPolicy rules"`,
+			"-o", c.nodeConfig.GatewayConfig.Name,
+			"-m", "conntrack", "--ctstate", "NEW",
+			"-m", "conntrack", "--ctstate", "DNAT",
+			"-j", config.NodeNetworkPolicyHostPortsChain,
+		}...)
+	}
 	writeLine(iptablesData, []string{
 		"-A", antreaForwardChain,
 		"-m", "comment", "--comment", `"Antrea: accept packets from local Pods"`,
@@ -1131,6 +1208,39 @@ func (c *Client) initIPRoutes() error {
 	return nil
 }
 
+// UpdateNodeTransportAddresses replaces the previous transport addresses of the Node configured on the gateway
+// interface in networkPolicyOnly mode with the current ones.
+func (c *Client) UpdateNodeTransportAddresses(prevIPv4Addr, prevIPv6Addr *net.IPNet) error {
+	if !c.networkConfig.TrafficEncapMode.IsNetworkPolicyOnly() {
+		return nil
+	}
+	if err := c.initIPRoutes(); err != nil {
+		return err
+	}
+	gwLink, err := c.netlink.LinkByName(c.nodeConfig.GatewayConfig.Name)
+	if err != nil {
+		return fmt.Errorf("error getting link %s: %v", c.nodeConfig.GatewayConfig.Name, err)
+	}
+	for _, addrs := range [][2]*net.IPNet{
+		{prevIPv4Addr, c.nodeConfig.NodeTransportIPv4Addr},
+		{prevIPv6Addr, c.nodeConfig.NodeTransportIPv6Addr},
+	} {
+		prevAddr, curAddr := addrs[0], addrs[1]
+		if prevAddr == nil || (curAddr != nil && curAddr.IP.Equal(prevAddr.IP)) {
+			continue
+		}
+		prefixLength := 32
+		if utilnet.IsIPv6(prevAddr.IP) {
+			prefixLength = 128
+		}
+		_, gwIP, _ := net.ParseCIDR(fmt.Sprintf("%s/%d", prevAddr.IP.String(), prefixLength))
+		if err := c.netlink.AddrDel(gwLink, &netlink.Addr{IPNet: gwIP}); err != nil && !errors.Is(err, unix.EADDRNOTAVAIL) {
+			return fmt.Errorf("failed to delete address %s from gw %s: %v", gwIP, gwLink.Attrs().Name, err)
+		}
+	}
+	return nil
+}
+
 func (c *Client) initServiceIPRoutes() error {
 	if c.networkConfig.IPv4Enabled {
 		if err := c.addVirtualServiceIPRoute(false); err != nil {
@@ -1165,6 +1275,11 @@ func (c *Client) initNodeNetworkPolicy() {
 			SetTarget(preNodeNetworkPolicyIngressRulesChain).
 			Done().
 			GetRule(),
+		iptables.NewRuleBuilder(antreaInputChain).
+			SetComment("Antrea: jump to mark ingress packets to hostNetwork Pods").
+			SetTarget(config.NodeNetworkPolicyHostNetworkPodPortsChain).
+			Done().
+			GetRule(),
 		iptables.NewRuleBuilder(antreaInputChain).
 			SetComment("Antrea: jump to ingress NodeNetworkPolicy rules").
 			SetTarget(config.NodeNetworkPolicyIngressRulesChain).
@@ -1219,6 +1334,9 @@ func (c *Client) initNodeNetworkPolicy() {
 		c.nodeNetworkPolicyIPTablesIPv6.Store(preNodeNetworkPolicyEgressRulesChain, preEgressChainRules)
 		c.nodeNetworkPolicyIPTablesIPv6.Store(config.NodeNetworkPolicyIngressRulesChain, []string{})
 		c.nodeNetworkPolicyIPTablesIPv6.Store(config.NodeNetworkPolicyEgressRulesChain, []string{})
+		c.nodeNetworkPolicyIPTablesIPv6.Store(config.NodeNetworkPolicyHostNetworkPodPortsChain, []string{})
+		c.nodeNetworkPolicyIPTablesIPv6.Store(config.NodeNetworkPolicyHostPortsChain, []string{})
+		c.nodeNetworkPolicyIPTablesIPv6.Store(config.NodeNetworkPolicyHostPortRulesChain, []string{})
 	}
 	if c.networkConfig.IPv4Enabled {
 		c.nodeNetworkPolicyIPTablesIPv4.Store(antreaInputChain, antreaInputChainRules)
@@ -1227,6 +1345,9 @@ func (c *Client) initNodeNetworkPolicy() {
 		c.nodeNetworkPolicyIPTablesIPv4.Store(preNodeNetworkPolicyEgressRulesChain, preEgressChainRules)
 		c.nodeNetworkPolicyIPTablesIPv4.Store(config.NodeNetworkPolicyIngressRulesChain, []string{})
 		c.nodeNetworkPolicyIPTablesIPv4.Store(config.NodeNetworkPolicyEgressRulesChain, []string{})
+		c.nodeNetworkPolicyIPTablesIPv4.Store(config.NodeNetworkPolicyHostNetworkPodPortsChain, []string{})
+		c.nodeNetworkPolicyIPTablesIPv4.Store(config.NodeNetworkPolicyHostPortsChain, []string{})
+		c.nodeNetworkPolicyIPTablesIPv4.Store(config.NodeNetworkPolicyHostPortRulesChain, []string{})
 	}
 }
 
@@ -1489,7 +1610,10 @@ func (c *Client) listIPv6NeighborsOnGateway() (map[string]*netlink.Neigh, error)
 }
 
 // AddRoutes adds routes to a new podCIDR. It overrides the routes if they already exist.
-func (c *Client) AddRoutes(podCIDR *net.IPNet, nodeName string, nodeIP, nodeGwIP net.IP) error {
+func (c *Client) AddRoutes(podCIDR *net.IPNet, nodeName string, nodeIP, nodeGwIP net.IP, nodeMultipathIPs []net.IP) error {
+	c.nodeRoutesMutex.Lock()
+	defer c.nodeRoutesMutex.Unlock()
+
 	var nodeTransportIPAddr *net.IPNet
 	if podCIDR.IP.To4() == nil {
 		nodeTransportIPAddr = c.nodeConfig.NodeTransportIPv6Addr
@@ -1509,6 +1633,7 @@ func (c *Client) AddRoutes(podCIDR *net.IPNet, nodeName string, nodeIP, nodeGwIP
 	}
 	var routes []*netlink.Route
 	requireNodeGwIPv6RouteAndNeigh := false
+	delete(c.multipathRoutes, podCIDRStr)
 	// If WireGuard is enabled, create a route via WireGuard device regardless of the traffic encapsulation modes.
 	if c.networkConfig.TrafficEncryptionMode == config.TrafficEncryptionModeWireGuard {
 		podCIDRRoute.LinkIndex = c.nodeConfig.WireGuardConfig.LinkIndex
@@ -1537,17 +1662,36 @@ func (c *Client) AddRoutes(podCIDR *net.IPNet, nodeName string, nodeIP, nodeGwIP
 		routes = append(routes, podCIDRRoute)
 	} else if c.networkConfig.NeedsDirectRoutingToPeer(nodeIP, nodeTransportIPAddr) {
 		// NoEncap traffic to Node on the same subnet.
-		// Set the peerNodeIP as next hop.
-		podCIDRRoute.Gw = nodeIP
+		// Set the peerNodeIP as next hop, and the IPs of the other uplinks of the peer Node as additional next hops
+		// when multipath routing is enabled.
+		if gateways := c.getMultipathGateways(nodeIP, nodeMultipathIPs); len(gateways) > 1 {
+			c.multipathRoutes[podCIDRStr] = &multipathRoute{podCIDR: podCIDR, gateways: gateways}
+			podCIDRRoute = c.newMultipathRoute(podCIDR, gateways)
+		} else {
+			podCIDRRoute.Gw = nodeIP
+		}
 		routes = append(routes, podCIDRRoute)
 	} else {
 		// NetworkPolicyOnly mode or NoEncap traffic to a Node on a different subnet.
 		// Routing should be handled by a route which is already present on the host.
 		klog.InfoS("Skip adding routes to peer", "node", nodeName, "ip", nodeIP, "podCIDR", podCIDR)
 	}
+	var installedRoutes []*netlink.Route
+	if value, ok := c.nodeRoutes.Load(podCIDRStr); ok {
+		installedRoutes = value.([]*netlink.Route)
+	}
+	var changedRoutes []*netlink.Route
 	for _, route := range routes {
-		if err := c.netlink.RouteReplace(route); err != nil {
-			return fmt.Errorf("failed to install route to peer %s (%s) with netlink. Route config: %s. Error: %v", nodeName, nodeIP, route.String(), err)
+		// Skip the routes which have been installed with the same configuration. Missing routes are restored by
+		// syncRoute periodically. Otherwise, the route is replaced atomically, without being deleted first, to avoid
+		// transient absence of the route to the peer.
+		if !containsRoute(installedRoutes, route) {
+			changedRoutes = append(changedRoutes, route)
+		}
+	}
+	for i, err := range c.replaceRoutes(changedRoutes) {
+		if err != nil {
+			return fmt.Errorf("failed to install route to peer %s (%s) with netlink. Route config: %s. Error: %v", nodeName, nodeIP, changedRoutes[i].String(), err)
 		}
 	}
 	// Delete stale route and neigh to peer gateway.
@@ -1596,8 +1740,28 @@ func (c *Client) AddRoutes(podCIDR *net.IPNet, nodeName string, nodeIP, nodeGwIP
 	return nil
 }
 
+// containsRoute returns whether the routes contain a route with the same configuration as the given route.
+func containsRoute(routes []*netlink.Route, route *netlink.Route) bool {
+	for _, r := range routes {
+		if r.LinkIndex == route.LinkIndex &&
+			r.Scope == route.Scope &&
+			r.Flags == route.Flags &&
+			r.Table == route.Table &&
+			utilip.IPNetEqual(r.Dst, route.Dst) &&
+			r.Gw.Equal(route.Gw) &&
+			r.Src.Equal(route.Src) &&
+			multipathKey(r) == multipathKey(route) {
+			return true
+		}
+	}
+	return false
+}
+
 // DeleteRoutes deletes routes to a PodCIDR. It does nothing if the routes doesn't exist.
 func (c *Client) DeleteRoutes(podCIDR *net.IPNet) error {
+	c.nodeRoutesMutex.Lock()
+	defer c.nodeRoutesMutex.Unlock()
+
 	podCIDRStr := podCIDR.String()
 	ipsetName := getIPSetName(podCIDR.IP)
 	// Delete this podCIDR from antreaPodIPSet as the CIDR is no longer for Pods.
@@ -1605,6 +1769,7 @@ func (c *Client) DeleteRoutes(podCIDR *net.IPNet) error {
 		return err
 	}
 
+	delete(c.multipathRoutes, podCIDRStr)
 	routes, exists := c.nodeRoutes.Load(podCIDRStr)
 	if exists {
 		c.nodeRoutes.Delete(podCIDRStr)
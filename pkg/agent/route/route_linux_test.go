@@ -115,6 +115,42 @@ func TestSyncRoutes(t *testing.T) {
 	assert.NoError(t, c.syncRoute())
 }
 
+func TestDiffRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockNetlink := netlinktest.NewMockInterface(ctrl)
+
+	nodeRoute1 := &netlink.Route{Dst: ip.MustParseCIDR("192.168.1.0/24"), Gw: net.ParseIP("1.1.1.1")}
+	nodeRoute2 := &netlink.Route{Dst: ip.MustParseCIDR("192.168.2.0/24"), Gw: net.ParseIP("1.1.1.2")}
+	gwAutoconfRoute := &netlink.Route{
+		LinkIndex: 10,
+		Dst:       ip.MustParseCIDR("192.168.0.0/24"),
+		Src:       net.ParseIP("192.168.0.1"),
+		Scope:     netlink.SCOPE_LINK,
+	}
+	mockNetlink.EXPECT().RouteList(nil, netlink.FAMILY_ALL).Return([]netlink.Route{*nodeRoute1, *gwAutoconfRoute}, nil)
+
+	c := &Client{
+		netlink:    mockNetlink,
+		nodeRoutes: sync.Map{},
+		nodeConfig: &config.NodeConfig{
+			GatewayConfig: &config.GatewayConfig{LinkIndex: 10, IPv4: net.ParseIP("192.168.0.1")},
+			PodIPv4CIDR:   ip.MustParseCIDR("192.168.0.0/24"),
+		},
+	}
+	c.nodeRoutes.Store("192.168.1.0/24", []*netlink.Route{nodeRoute1})
+	c.nodeRoutes.Store("192.168.2.0/24", []*netlink.Route{nodeRoute2})
+
+	diffs, err := c.DiffRoutes()
+	assert.NoError(t, err)
+	assert.Equal(t, []types.DatapathEntryDiff{
+		{
+			Type:    types.DatapathEntryRoute,
+			State:   types.DatapathDiffMissing,
+			Desired: nodeRoute2.String(),
+		},
+	}, diffs)
+}
+
 func TestRestoreEgressRoutesAndRules(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockNetlink := netlinktest.NewMockInterface(ctrl)
@@ -425,15 +461,20 @@ COMMIT
 :ANTREA-INPUT - [0:0]
 :ANTREA-OUTPUT - [0:0]
 :ANTREA-POL-EGRESS-RULES - [0:0]
+:ANTREA-POL-HOSTNET-PORTS - [0:0]
+:ANTREA-POL-HOSTPORT-RULES - [0:0]
+:ANTREA-POL-HOSTPORTS - [0:0]
 :ANTREA-POL-INGRESS-RULES - [0:0]
 :ANTREA-POL-PRE-EGRESS-RULES - [0:0]
 :ANTREA-POL-PRE-INGRESS-RULES - [0:0]
+-A ANTREA-FORWARD -m comment --comment "Antrea: jump to hostPort NodeNetworkPolicy rules" -o antrea-gw0 -m conntrack --ctstate NEW -m conntrack --ctstate DNAT -j ANTREA-POL-HOSTPORTS
 -A ANTREA-FORWARD -m comment --comment "Antrea: accept packets from local Pods" -i antrea-gw0 -j ACCEPT
 -A ANTREA-FORWARD -m comment --comment "Antrea: accept packets to local Pods" -o antrea-gw0 -j ACCEPT
 -A ANTREA-INPUT -i antrea-gw0 -p icmp --icmp-type 8 -m comment --comment "Antrea: allow ICMP probes from NodeLatencyMonitor" -j ACCEPT
 -A ANTREA-INPUT -i antrea-gw0 -p icmp --icmp-type 0 -m comment --comment "Antrea: allow ICMP probes from NodeLatencyMonitor" -j ACCEPT
 -A ANTREA-INPUT -m comment --comment "Antrea: allow WireGuard input packets" -p udp --dport 51820 -j ACCEPT
 -A ANTREA-INPUT -m comment --comment "Antrea: jump to static ingress NodeNetworkPolicy rules" -j ANTREA-POL-PRE-INGRESS-RULES
+-A ANTREA-INPUT -m comment --comment "Antrea: jump to mark ingress packets to hostNetwork Pods" -j ANTREA-POL-HOSTNET-PORTS
 -A ANTREA-INPUT -m comment --comment "Antrea: jump to ingress NodeNetworkPolicy rules" -j ANTREA-POL-INGRESS-RULES
 -A ANTREA-OUTPUT -o antrea-gw0 -p icmp --icmp-type 8 -m comment --comment "Antrea: allow ICMP probes from NodeLatencyMonitor" -j ACCEPT
 -A ANTREA-OUTPUT -o antrea-gw0 -p icmp --icmp-type 0 -m comment --comment "Antrea: allow ICMP probes from NodeLatencyMonitor" -j ACCEPT
@@ -477,15 +518,20 @@ COMMIT
 :ANTREA-INPUT - [0:0]
 :ANTREA-OUTPUT - [0:0]
 :ANTREA-POL-EGRESS-RULES - [0:0]
+:ANTREA-POL-HOSTNET-PORTS - [0:0]
+:ANTREA-POL-HOSTPORT-RULES - [0:0]
+:ANTREA-POL-HOSTPORTS - [0:0]
 :ANTREA-POL-INGRESS-RULES - [0:0]
 :ANTREA-POL-PRE-EGRESS-RULES - [0:0]
 :ANTREA-POL-PRE-INGRESS-RULES - [0:0]
+-A ANTREA-FORWARD -m comment --comment "Antrea: jump to hostPort NodeNetworkPolicy rules" -o antrea-gw0 -m conntrack --ctstate NEW -m conntrack --ctstate DNAT -j ANTREA-POL-HOSTPORTS
 -A ANTREA-FORWARD -m comment --comment "Antrea: accept packets from local Pods" -i antrea-gw0 -j ACCEPT
 -A ANTREA-FORWARD -m comment --comment "Antrea: accept packets to local Pods" -o antrea-gw0 -j ACCEPT
 -A ANTREA-INPUT -i antrea-gw0 -p icmpv6 --icmpv6-type 128 -m comment --comment "Antrea: allow ICMP probes from NodeLatencyMonitor" -j ACCEPT
 -A ANTREA-INPUT -i antrea-gw0 -p icmpv6 --icmpv6-type 129 -m comment --comment "Antrea: allow ICMP probes from NodeLatencyMonitor" -j ACCEPT
 -A ANTREA-INPUT -m comment --comment "Antrea: allow WireGuard input packets" -p udp --dport 51820 -j ACCEPT
 -A ANTREA-INPUT -m comment --comment "Antrea: jump to static ingress NodeNetworkPolicy rules" -j ANTREA-POL-PRE-INGRESS-RULES
+-A ANTREA-INPUT -m comment --comment "Antrea: jump to mark ingress packets to hostNetwork Pods" -j ANTREA-POL-HOSTNET-PORTS
 -A ANTREA-INPUT -m comment --comment "Antrea: jump to ingress NodeNetworkPolicy rules" -j ANTREA-POL-INGRESS-RULES
 -A ANTREA-OUTPUT -o antrea-gw0 -p icmpv6 --icmpv6-type 128 -m comment --comment "Antrea: allow ICMP probes from NodeLatencyMonitor" -j ACCEPT
 -A ANTREA-OUTPUT -o antrea-gw0 -p icmpv6 --icmpv6-type 129 -m comment --comment "Antrea: allow ICMP probes from NodeLatencyMonitor" -j ACCEPT
@@ -837,6 +883,29 @@ func TestInitIPRoutes(t *testing.T) {
 	}
 }
 
+func TestUpdateNodeTransportAddresses(t *testing.T) {
+	ipv4, prevIPv4Addr, _ := net.ParseCIDR("172.16.10.2/24")
+	prevIPv4Addr.IP = ipv4
+	ipv4, curIPv4Addr, _ := net.ParseCIDR("172.16.10.3/24")
+	curIPv4Addr.IP = ipv4
+
+	ctrl := gomock.NewController(t)
+	mockNetlink := netlinktest.NewMockInterface(ctrl)
+	c := &Client{netlink: mockNetlink,
+		networkConfig: &config.NetworkConfig{TrafficEncapMode: config.TrafficEncapModeNetworkPolicyOnly},
+		nodeConfig: &config.NodeConfig{
+			GatewayConfig:         &config.GatewayConfig{Name: "antrea-gw0"},
+			NodeTransportIPv4Addr: curIPv4Addr,
+		},
+	}
+	_, curGwIP, _ := net.ParseCIDR("172.16.10.3/32")
+	_, prevGwIP, _ := net.ParseCIDR("172.16.10.2/32")
+	mockNetlink.EXPECT().LinkByName("antrea-gw0").Return(&netlink.Dummy{LinkAttrs: netlink.LinkAttrs{Name: "antrea-gw0"}}, nil).Times(2)
+	mockNetlink.EXPECT().AddrReplace(gomock.Any(), &netlink.Addr{IPNet: curGwIP})
+	mockNetlink.EXPECT().AddrDel(gomock.Any(), &netlink.Addr{IPNet: prevGwIP})
+	assert.NoError(t, c.UpdateNodeTransportAddresses(prevIPv4Addr, nil))
+}
+
 func TestInitServiceIPRoutes(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1200,11 +1269,47 @@ func TestAddRoutes(t *testing.T) {
 			}
 			tt.expectedIPSetCalls(mockIPSet.EXPECT())
 			tt.expectedNetlinkCalls(mockNetlink.EXPECT())
-			assert.NoError(t, c.AddRoutes(tt.podCIDR, tt.nodeName, tt.nodeIP, tt.nodeGwIP))
+			assert.NoError(t, c.AddRoutes(tt.podCIDR, tt.nodeName, tt.nodeIP, tt.nodeGwIP, nil))
 		})
 	}
 }
 
+func TestAddRoutesWithInstalledRoutes(t *testing.T) {
+	ipv4, nodeTransPortIPv4Addr, _ := net.ParseCIDR("172.16.10.2/24")
+	nodeTransPortIPv4Addr.IP = ipv4
+	podCIDR := ip.MustParseCIDR("192.168.10.0/24")
+
+	ctrl := gomock.NewController(t)
+	mockNetlink := netlinktest.NewMockInterface(ctrl)
+	mockIPSet := ipsettest.NewMockInterface(ctrl)
+	c := &Client{netlink: mockNetlink,
+		ipset: mockIPSet,
+		networkConfig: &config.NetworkConfig{
+			TrafficEncapMode: config.TrafficEncapModeNoEncap,
+			IPv4Enabled:      true,
+		},
+		nodeConfig: &config.NodeConfig{
+			GatewayConfig: &config.GatewayConfig{
+				Name:      "antrea-gw0",
+				IPv4:      net.ParseIP("192.168.1.1"),
+				LinkIndex: 10,
+			},
+			NodeTransportIPv4Addr: nodeTransPortIPv4Addr,
+		},
+	}
+	c.nodeRoutes.Store(podCIDR.String(), []*netlink.Route{{Gw: net.ParseIP("172.16.10.3"), Dst: podCIDR}})
+
+	// The route has been installed with the same configuration.
+	mockIPSet.EXPECT().AddEntry(antreaPodIPSet, "192.168.10.0/24").Times(2)
+	assert.NoError(t, c.AddRoutes(podCIDR, "node0", net.ParseIP("172.16.10.3"), net.ParseIP("192.168.10.1"), nil))
+
+	// The route is replaced when the Node IP changes.
+	mockNetlink.EXPECT().RouteReplace(&netlink.Route{Gw: net.ParseIP("172.16.10.4"), Dst: podCIDR})
+	assert.NoError(t, c.AddRoutes(podCIDR, "node0", net.ParseIP("172.16.10.4"), net.ParseIP("192.168.10.1"), nil))
+	routes, _ := c.nodeRoutes.Load(podCIDR.String())
+	assert.Equal(t, []*netlink.Route{{Gw: net.ParseIP("172.16.10.4"), Dst: podCIDR}}, routes)
+}
+
 func TestDeleteRoutes(t *testing.T) {
 	tests := []struct {
 		name                  string
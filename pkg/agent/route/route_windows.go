@@ -30,6 +30,7 @@ import (
 	"antrea.io/antrea/pkg/agent/config"
 	"antrea.io/antrea/pkg/agent/openflow"
 	"antrea.io/antrea/pkg/agent/servicecidr"
+	"antrea.io/antrea/pkg/agent/types"
 	"antrea.io/antrea/pkg/agent/util"
 	antreasyscall "antrea.io/antrea/pkg/agent/util/syscall"
 	"antrea.io/antrea/pkg/agent/util/winfirewall"
@@ -168,6 +169,12 @@ func (c *Client) initServiceIPRoutes() error {
 	return nil
 }
 
+// UpdateNodeTransportAddresses is a no-op on Windows, as the routes to the peer Nodes, which depend on the transport
+// address of the Node, are reconciled by the NodeRouteController.
+func (c *Client) UpdateNodeTransportAddresses(prevIPv4Addr, prevIPv6Addr *net.IPNet) error {
+	return nil
+}
+
 // Reconcile removes the orphaned routes and related configuration based on the desired podCIDRs and Service IPs. Only
 // the route entries on the host gateway interface are stored in the cache.
 func (c *Client) Reconcile(podCIDRs []string) error {
@@ -216,7 +223,8 @@ func (c *Client) Reconcile(podCIDRs []string) error {
 
 // AddRoutes adds routes to the provided podCIDR.
 // It overrides the routes if they already exist, without error.
-func (c *Client) AddRoutes(podCIDR *net.IPNet, nodeName string, peerNodeIP, peerGwIP net.IP) error {
+// Multipath routing is not supported on Windows, so peerMultipathIPs is ignored.
+func (c *Client) AddRoutes(podCIDR *net.IPNet, nodeName string, peerNodeIP, peerGwIP net.IP, peerMultipathIPs []net.IP) error {
 	obj, found := c.nodeRoutes.Load(podCIDR.String())
 	route := &winnet.Route{
 		DestinationSubnet: podCIDR,
@@ -404,37 +412,68 @@ func (c *Client) syncIPInfra() {
 	klog.V(3).Info("Successfully synced netNatStaticMapping and route")
 }
 
-func (c *Client) syncRoute() error {
-	restoreRoute := func(route *winnet.Route) bool {
-		if err := c.winnet.ReplaceNetRoute(route); err != nil {
-			klog.ErrorS(err, "Failed to sync route", "Route", route)
-			return false
-		}
-		return true
-	}
+// desiredRoutes returns all the routes which are expected to be installed in the system by Antrea.
+func (c *Client) desiredRoutes() []*winnet.Route {
+	var routes []*winnet.Route
 	c.nodeRoutes.Range(func(_, v interface{}) bool {
-		route := v.(*winnet.Route)
-		return restoreRoute(route)
+		routes = append(routes, v.(*winnet.Route))
+		return true
 	})
 	if c.proxyAll {
 		c.serviceRoutes.Range(func(_, v interface{}) bool {
-			route := v.(*winnet.Route)
-			return restoreRoute(route)
+			routes = append(routes, v.(*winnet.Route))
+			return true
 		})
 	}
 	// The route is installed automatically by the kernel when the address is configured on the interface. If the route
 	// is deleted manually by mistake, we restore it.
-	gwAutoconfRoute := &winnet.Route{
+	routes = append(routes, &winnet.Route{
 		LinkIndex:         c.nodeConfig.GatewayConfig.LinkIndex,
 		DestinationSubnet: c.nodeConfig.PodIPv4CIDR,
 		GatewayAddress:    net.IPv4zero,
 		RouteMetric:       winnet.MetricDefault,
-	}
-	restoreRoute(gwAutoconfRoute)
+	})
+	return routes
+}
 
+func (c *Client) syncRoute() error {
+	for _, route := range c.desiredRoutes() {
+		if err := c.winnet.ReplaceNetRoute(route); err != nil {
+			klog.ErrorS(err, "Failed to sync route", "Route", route)
+		}
+	}
 	return nil
 }
 
+// DiffRoutes reports the routes which are expected to be installed by Antrea but are missing in the system.
+func (c *Client) DiffRoutes() ([]types.DatapathEntryDiff, error) {
+	routes, err := c.winnet.RouteListFiltered(antreasyscall.AF_INET, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []types.DatapathEntryDiff
+	for _, desiredRoute := range c.desiredRoutes() {
+		found := false
+		for i := range routes {
+			route := &routes[i]
+			if route.LinkIndex == desiredRoute.LinkIndex &&
+				iputil.IPNetEqual(route.DestinationSubnet, desiredRoute.DestinationSubnet) &&
+				route.GatewayAddress.Equal(desiredRoute.GatewayAddress) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			diffs = append(diffs, types.DatapathEntryDiff{
+				Type:    types.DatapathEntryRoute,
+				State:   types.DatapathDiffMissing,
+				Desired: desiredRoute.String(),
+			})
+		}
+	}
+	return diffs, nil
+}
+
 func (c *Client) syncNetNatStaticMapping() error {
 	if err := c.winnet.AddNetNat(antreaNatNodePort, virtualNodePortDNATIPv4Net); err != nil {
 		return err
@@ -480,6 +519,7 @@ func (c *Client) initFwRules() error {
 	return nil
 }
 
+// AddSNATRule is a no-op on Windows, where SNAT for Egress is performed in OVS.
 func (c *Client) AddSNATRule(snatIP net.IP, mark uint32) error {
 	return nil
 }
@@ -614,8 +654,10 @@ func (c *Client) ClearConntrackEntryForService(svcIP net.IP, svcPort uint16, end
 	return errors.New("ClearConntrackEntryForService is not implemented on Windows")
 }
 
+// RestoreEgressRoutesAndRules is a no-op on Windows, where Egress routes and rules are never installed as
+// EgressSeparateSubnet is not supported.
 func (c *Client) RestoreEgressRoutesAndRules(minTableID, maxTableID int) error {
-	return errors.New("RestoreEgressRoutesAndRules is not implemented on Windows")
+	return nil
 }
 
 func (c *Client) AddEgressRoutes(tableID uint32, dev int, gateway net.IP, prefixLength int) error {
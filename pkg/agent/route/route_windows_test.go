@@ -237,7 +237,7 @@ func TestAddRoutes(t *testing.T) {
 				nodeConfig:    tt.nodeConfig,
 			}
 			tt.expectedNetUtilCalls(netutil.EXPECT())
-			assert.NoError(t, c.AddRoutes(tt.podCIDR, tt.nodeName, tt.nodeIP, tt.nodeGwIP))
+			assert.NoError(t, c.AddRoutes(tt.podCIDR, tt.nodeName, tt.nodeIP, tt.nodeGwIP, nil))
 		})
 	}
 }
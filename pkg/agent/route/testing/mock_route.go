@@ -29,6 +29,7 @@ import (
 	reflect "reflect"
 
 	config "antrea.io/antrea/pkg/agent/config"
+	types "antrea.io/antrea/pkg/agent/types"
 	openflow "antrea.io/antrea/pkg/ovs/openflow"
 	gomock "go.uber.org/mock/gomock"
 	sets "k8s.io/apimachinery/pkg/util/sets"
@@ -171,17 +172,17 @@ func (mr *MockInterfaceMockRecorder) AddRouteForLink(dstCIDR, linkIndex any) *go
 }
 
 // AddRoutes mocks base method.
-func (m *MockInterface) AddRoutes(podCIDR *net.IPNet, peerNodeName string, peerNodeIP, peerGwIP net.IP) error {
+func (m *MockInterface) AddRoutes(podCIDR *net.IPNet, peerNodeName string, peerNodeIP, peerGwIP net.IP, peerMultipathIPs []net.IP) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddRoutes", podCIDR, peerNodeName, peerNodeIP, peerGwIP)
+	ret := m.ctrl.Call(m, "AddRoutes", podCIDR, peerNodeName, peerNodeIP, peerGwIP, peerMultipathIPs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // AddRoutes indicates an expected call of AddRoutes.
-func (mr *MockInterfaceMockRecorder) AddRoutes(podCIDR, peerNodeName, peerNodeIP, peerGwIP any) *gomock.Call {
+func (mr *MockInterfaceMockRecorder) AddRoutes(podCIDR, peerNodeName, peerNodeIP, peerGwIP, peerMultipathIPs any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoutes", reflect.TypeOf((*MockInterface)(nil).AddRoutes), podCIDR, peerNodeName, peerNodeIP, peerGwIP)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoutes", reflect.TypeOf((*MockInterface)(nil).AddRoutes), podCIDR, peerNodeName, peerNodeIP, peerGwIP, peerMultipathIPs)
 }
 
 // AddSNATRule mocks base method.
@@ -352,6 +353,21 @@ func (mr *MockInterfaceMockRecorder) DeleteSNATRule(mark any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSNATRule", reflect.TypeOf((*MockInterface)(nil).DeleteSNATRule), mark)
 }
 
+// DiffRoutes mocks base method.
+func (m *MockInterface) DiffRoutes() ([]types.DatapathEntryDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiffRoutes")
+	ret0, _ := ret[0].([]types.DatapathEntryDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiffRoutes indicates an expected call of DiffRoutes.
+func (mr *MockInterfaceMockRecorder) DiffRoutes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiffRoutes", reflect.TypeOf((*MockInterface)(nil).DiffRoutes))
+}
+
 // Initialize mocks base method.
 func (m *MockInterface) Initialize(nodeConfig *config.NodeConfig, done func()) error {
 	m.ctrl.T.Helper()
@@ -433,3 +449,17 @@ func (mr *MockInterfaceMockRecorder) UnMigrateRoutesFromGw(route, linkName any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnMigrateRoutesFromGw", reflect.TypeOf((*MockInterface)(nil).UnMigrateRoutesFromGw), route, linkName)
 }
+
+// UpdateNodeTransportAddresses mocks base method.
+func (m *MockInterface) UpdateNodeTransportAddresses(prevIPv4Addr, prevIPv6Addr *net.IPNet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNodeTransportAddresses", prevIPv4Addr, prevIPv6Addr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNodeTransportAddresses indicates an expected call of UpdateNodeTransportAddresses.
+func (mr *MockInterfaceMockRecorder) UpdateNodeTransportAddresses(prevIPv4Addr, prevIPv6Addr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNodeTransportAddresses", reflect.TypeOf((*MockInterface)(nil).UpdateNodeTransportAddresses), prevIPv4Addr, prevIPv6Addr)
+}
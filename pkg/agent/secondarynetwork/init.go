@@ -37,9 +37,10 @@ var (
 )
 
 type Controller struct {
-	ovsBridgeClient ovsconfig.OVSBridgeClient
-	secNetConfig    *agentconfig.SecondaryNetworkConfig
-	podController   *podwatch.PodController
+	// ovsBridgeClients are the clients of the secondary OVS bridges, in the order of the configuration.
+	ovsBridgeClients []ovsconfig.OVSBridgeClient
+	secNetConfig     *agentconfig.SecondaryNetworkConfig
+	podController    *podwatch.PodController
 }
 
 func NewController(
@@ -51,7 +52,7 @@ func NewController(
 	primaryInterfaceStore interfacestore.InterfaceStore,
 	secNetConfig *agentconfig.SecondaryNetworkConfig, ovsdb *ovsdb.OVSDB,
 ) (*Controller, error) {
-	ovsBridgeClient, err := createOVSBridge(secNetConfig.OVSBridges, ovsdb)
+	ovsBridgeClients, err := createOVSBridges(secNetConfig.OVSBridges, ovsdb)
 	if err != nil {
 		return nil, err
 	}
@@ -67,14 +68,14 @@ func NewController(
 	// k8s.v1.cni.cncf.io/networks Annotation defined.
 	podWatchController, err := podwatch.NewPodController(
 		k8sClient, netAttachDefClient, podInformer,
-		podUpdateSubscriber, primaryInterfaceStore, ovsBridgeClient)
+		podUpdateSubscriber, primaryInterfaceStore, ovsBridgeClients)
 	if err != nil {
 		return nil, err
 	}
 	return &Controller{
-		ovsBridgeClient: ovsBridgeClient,
-		secNetConfig:    secNetConfig,
-		podController:   podWatchController}, nil
+		ovsBridgeClients: ovsBridgeClients,
+		secNetConfig:     secNetConfig,
+		podController:    podWatchController}, nil
 }
 
 // Run starts the Pod controller for secondary networks.
@@ -96,16 +97,16 @@ func createNetworkAttachDefClient(config componentbaseconfig.ClientConnectionCon
 	return netAttachDefClient, nil
 }
 
-func createOVSBridge(bridges []agentconfig.OVSBridgeConfig, ovsdb *ovsdb.OVSDB) (ovsconfig.OVSBridgeClient, error) {
-	if len(bridges) == 0 {
-		return nil, nil
+// createOVSBridges creates the secondary OVS bridges and returns their clients, in the order of the configuration.
+func createOVSBridges(bridges []agentconfig.OVSBridgeConfig, ovsdb *ovsdb.OVSDB) ([]ovsconfig.OVSBridgeClient, error) {
+	var ovsBridgeClients []ovsconfig.OVSBridgeClient
+	for _, bridgeConfig := range bridges {
+		ovsBridgeClient := newOVSBridgeFn(bridgeConfig.BridgeName, ovsconfig.OVSDatapathSystem, ovsdb)
+		if err := ovsBridgeClient.Create(); err != nil {
+			return nil, fmt.Errorf("failed to create OVS bridge %s: %v", bridgeConfig.BridgeName, err)
+		}
+		klog.InfoS("OVS bridge created", "bridge", bridgeConfig.BridgeName)
+		ovsBridgeClients = append(ovsBridgeClients, ovsBridgeClient)
 	}
-	// Only one OVS bridge is supported.
-	bridgeConfig := bridges[0]
-	ovsBridgeClient := newOVSBridgeFn(bridgeConfig.BridgeName, ovsconfig.OVSDatapathSystem, ovsdb)
-	if err := ovsBridgeClient.Create(); err != nil {
-		return nil, fmt.Errorf("failed to create OVS bridge %s: %v", bridgeConfig.BridgeName, err)
-	}
-	klog.InfoS("OVS bridge created", "bridge", bridgeConfig.BridgeName)
-	return ovsBridgeClient, nil
+	return ovsBridgeClients, nil
 }
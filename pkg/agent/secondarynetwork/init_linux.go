@@ -35,13 +35,14 @@ var (
 
 // Initialize sets up OVS bridges.
 func (c *Controller) Initialize() error {
-	// We only support moving and restoring of interface configuration to OVS Bridge for the single physical interface case.
-	if len(c.secNetConfig.OVSBridges) != 0 {
-		phyInterfaces := make([]string, len(c.secNetConfig.OVSBridges[0].PhysicalInterfaces))
-		copy(phyInterfaces, c.secNetConfig.OVSBridges[0].PhysicalInterfaces)
+	for i, bridgeConfig := range c.secNetConfig.OVSBridges {
+		// We only support moving and restoring of interface configuration to OVS Bridge for the single physical
+		// interface case.
+		phyInterfaces := make([]string, len(bridgeConfig.PhysicalInterfaces))
+		copy(phyInterfaces, bridgeConfig.PhysicalInterfaces)
 		if len(phyInterfaces) == 1 {
 			bridgedName, _, err := util.PrepareHostInterfaceConnection(
-				c.ovsBridgeClient,
+				c.ovsBridgeClients[i],
 				phyInterfaces[0],
 				0,
 				map[string]interface{}{
@@ -54,17 +55,19 @@ func (c *Controller) Initialize() error {
 			}
 			phyInterfaces[0] = bridgedName
 		}
-		if err := connectPhyInterfacesToOVSBridge(c.ovsBridgeClient, phyInterfaces); err != nil {
+		if err := connectPhyInterfacesToOVSBridge(c.ovsBridgeClients[i], phyInterfaces); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-// Restore restores interface configuration from secondary-bridge back to host-interface.
+// Restore restores interface configuration from secondary-bridges back to host-interfaces.
 func (c *Controller) Restore() {
-	if len(c.secNetConfig.OVSBridges) != 0 && len(c.secNetConfig.OVSBridges[0].PhysicalInterfaces) == 1 {
-		util.RestoreHostInterfaceConfiguration(c.secNetConfig.OVSBridges[0].BridgeName, c.secNetConfig.OVSBridges[0].PhysicalInterfaces[0])
+	for _, bridgeConfig := range c.secNetConfig.OVSBridges {
+		if len(bridgeConfig.PhysicalInterfaces) == 1 {
+			util.RestoreHostInterfaceConfiguration(bridgeConfig.BridgeName, bridgeConfig.PhysicalInterfaces[0])
+		}
 	}
 }
 
@@ -55,7 +55,7 @@ func TestCreateOVSBridge(t *testing.T) {
 			name:       "two bridges",
 			ovsBridges: []string{"br1", "br2"},
 			expectedCalls: func(m *ovsconfigtest.MockOVSBridgeClient) {
-				m.EXPECT().Create().Return(nil)
+				m.EXPECT().Create().Return(nil).Times(2)
 			},
 		},
 		{
@@ -83,15 +83,13 @@ func TestCreateOVSBridge(t *testing.T) {
 				tc.expectedCalls(mockOVSBridgeClient)
 			}
 
-			brClient, err := createOVSBridge(bridges, nil)
+			brClients, err := createOVSBridges(bridges, nil)
 			if tc.expectedErr != "" {
 				assert.ErrorContains(t, err, tc.expectedErr)
-				assert.Nil(t, brClient)
+				assert.Nil(t, brClients)
 			} else {
 				require.NoError(t, err)
-				if tc.expectedCalls != nil {
-					assert.NotNil(t, brClient)
-				}
+				assert.Len(t, brClients, len(tc.ovsBridges))
 			}
 		})
 	}
@@ -81,6 +81,13 @@ type podCNIInfo struct {
 	netNS       string
 }
 
+// ovsBridge is an additional secondary OVS bridge, with the InterfaceConfigurator of the VLAN interfaces connected to
+// it.
+type ovsBridge struct {
+	client                ovsconfig.OVSBridgeClient
+	interfaceConfigurator InterfaceConfigurator
+}
+
 type PodController struct {
 	kubeClient            clientset.Interface
 	netAttachDefClient    netdefclient.K8sCniCncfIoV1Interface
@@ -94,6 +101,11 @@ type PodController struct {
 	// Map from "namespace/pod" to podCNIInfo.
 	cniCache           sync.Map
 	vfDeviceIDUsageMap sync.Map
+	// Map from bridge name to the secondary OVS bridges other than the first one, which is used by the VLAN
+	// networks not specifying a bridge and whose client is ovsBridgeClient.
+	additionalBridges map[string]*ovsBridge
+	// Map from the UUID of the OVS ports connected to an additional secondary OVS bridge to the name of the bridge.
+	portBridges sync.Map
 }
 
 func NewPodController(
@@ -102,13 +114,27 @@ func NewPodController(
 	podInformer cache.SharedIndexInformer,
 	podUpdateSubscriber channel.Subscriber,
 	primaryInterfaceStore interfacestore.InterfaceStore,
-	ovsBridgeClient ovsconfig.OVSBridgeClient,
+	ovsBridgeClients []ovsconfig.OVSBridgeClient,
 ) (*PodController, error) {
 	ifaceStore := interfacestore.NewInterfaceStore()
+	var ovsBridgeClient ovsconfig.OVSBridgeClient
+	if len(ovsBridgeClients) > 0 {
+		ovsBridgeClient = ovsBridgeClients[0]
+	}
 	interfaceConfigurator, err := cniserver.NewSecondaryInterfaceConfigurator(ovsBridgeClient, ifaceStore)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create SecondaryInterfaceConfigurator: %v", err)
 	}
+	additionalBridges := map[string]*ovsBridge{}
+	for i := 1; i < len(ovsBridgeClients); i++ {
+		client := ovsBridgeClients[i]
+		// The interfaces of all the bridges are stored in the same InterfaceStore.
+		configurator, err := cniserver.NewSecondaryInterfaceConfigurator(client, ifaceStore)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create SecondaryInterfaceConfigurator for OVS bridge %s: %v", client.GetBridgeName(), err)
+		}
+		additionalBridges[client.GetBridgeName()] = &ovsBridge{client: client, interfaceConfigurator: configurator}
+	}
 	pc := PodController{
 		kubeClient:         kubeClient,
 		netAttachDefClient: netAttachDefClient,
@@ -123,6 +149,7 @@ func NewPodController(
 		ovsBridgeClient:       ovsBridgeClient,
 		interfaceStore:        ifaceStore,
 		interfaceConfigurator: interfaceConfigurator,
+		additionalBridges:     additionalBridges,
 		ipamAllocator:         ipam.GetSecondaryNetworkAllocator(),
 	}
 	podInformer.AddEventHandlerWithResyncPeriod(
@@ -248,7 +275,10 @@ func (pc *PodController) removeInterfaces(interfaces []*interfacestore.Interface
 		// Since only VLAN and SR-IOV interfaces are supported by now, we judge the
 		// interface type by checking interfaceConfig.OVSPortConfig is set or not.
 		if interfaceConfig.OVSPortConfig != nil {
-			err = pc.interfaceConfigurator.DeleteVLANSecondaryInterface(interfaceConfig)
+			err = pc.getPortInterfaceConfigurator(interfaceConfig.PortUUID).DeleteVLANSecondaryInterface(interfaceConfig)
+			if err == nil {
+				pc.portBridges.Delete(interfaceConfig.PortUUID)
+			}
 		} else {
 			err = pc.deleteSriovSecondaryInterface(interfaceConfig)
 		}
@@ -344,6 +374,13 @@ func (pc *PodController) configureSecondaryInterface(
 ) (*current.Result, error) {
 	var ipamResult *ipam.IPAMResult
 	var ifConfigErr error
+	var vlanInterfaceConfigurator InterfaceConfigurator
+	if networkConfig.NetworkType == vlanNetworkType {
+		var err error
+		if vlanInterfaceConfigurator, err = pc.getVLANInterfaceConfigurator(networkConfig.OVSBridge); err != nil {
+			return nil, err
+		}
+	}
 	if networkConfig.IPAM != nil {
 		var err error
 		podOwner := &crdv1b1.PodOwner{
@@ -373,17 +410,20 @@ func (pc *PodController) configureSecondaryInterface(
 
 	switch networkConfig.NetworkType {
 	case sriovNetworkType:
-		ifConfigErr = pc.configureSriovAsSecondaryInterface(pod, network, resourceName, podCNIInfo, int(networkConfig.MTU), &ipamResult.Result)
+		ifConfigErr = pc.configureSriovAsSecondaryInterface(pod, network, resourceName, podCNIInfo, int(networkConfig.MTU), networkConfig.vfTrafficControl, &ipamResult.Result)
 	case vlanNetworkType:
 		if networkConfig.VLAN > 0 {
 			// Let VLAN ID in the CNI network configuration override the IPPool subnet
 			// VLAN.
 			ipamResult.VLANID = uint16(networkConfig.VLAN)
 		}
-		ifConfigErr = pc.interfaceConfigurator.ConfigureVLANSecondaryInterface(
+		ifConfigErr = vlanInterfaceConfigurator.ConfigureVLANSecondaryInterface(
 			pod.Name, pod.Namespace,
 			podCNIInfo.containerID, podCNIInfo.netNS, network.InterfaceRequest,
 			int(networkConfig.MTU), ipamResult)
+		if ifConfigErr == nil {
+			pc.storePortBridge(pod, podCNIInfo.containerID, network.InterfaceRequest, networkConfig.OVSBridge)
+		}
 	}
 	return &ipamResult.Result, ifConfigErr
 }
@@ -523,9 +563,22 @@ func validateNetworkConfig(cniConfig []byte) (*SecondaryNetworkConfig, error) {
 			return &networkConfig, fmt.Errorf("invalid VLAN ID %d", networkConfig.VLAN)
 		}
 	}
+	if networkConfig.OVSBridge != "" && networkConfig.NetworkType != vlanNetworkType {
+		return &networkConfig, fmt.Errorf("OVS bridge is only supported for the %s network type", vlanNetworkType)
+	}
 	if networkConfig.MTU < 0 {
 		return &networkConfig, fmt.Errorf("invalid MTU %d", networkConfig.MTU)
 	}
+	if networkConfig.TrafficControl != nil {
+		if networkConfig.NetworkType != sriovNetworkType {
+			return &networkConfig, fmt.Errorf("traffic control is only supported for the %s network type", sriovNetworkType)
+		}
+		trafficControl, err := parseTrafficControlConfig(networkConfig.TrafficControl)
+		if err != nil {
+			return &networkConfig, fmt.Errorf("invalid traffic control: %v", err)
+		}
+		networkConfig.vfTrafficControl = trafficControl
+	}
 	if networkConfig.IPAM != nil {
 		if networkConfig.IPAM.Type != ipam.AntreaIPAMType {
 			return &networkConfig, fmt.Errorf("unsupported IPAM type %s", networkConfig.IPAM.Type)
@@ -563,11 +616,70 @@ func checkForPodSecondaryNetworkAttachment(pod *corev1.Pod) (string, bool) {
 	return netObj, netObjExist
 }
 
+// getVLANInterfaceConfigurator returns the InterfaceConfigurator of the VLAN interfaces connected to the provided
+// secondary OVS bridge, or to the first bridge if bridgeName is empty.
+func (pc *PodController) getVLANInterfaceConfigurator(bridgeName string) (InterfaceConfigurator, error) {
+	if bridgeName == "" || (pc.ovsBridgeClient != nil && pc.ovsBridgeClient.GetBridgeName() == bridgeName) {
+		return pc.interfaceConfigurator, nil
+	}
+	if bridge, ok := pc.additionalBridges[bridgeName]; ok {
+		return bridge.interfaceConfigurator, nil
+	}
+	return nil, fmt.Errorf("secondary network OVS bridge %s is not configured", bridgeName)
+}
+
+// getPortInterfaceConfigurator returns the InterfaceConfigurator of the secondary OVS bridge the port is connected to.
+func (pc *PodController) getPortInterfaceConfigurator(portUUID string) InterfaceConfigurator {
+	if bridgeName, ok := pc.portBridges.Load(portUUID); ok {
+		if bridge, ok := pc.additionalBridges[bridgeName.(string)]; ok {
+			return bridge.interfaceConfigurator
+		}
+	}
+	return pc.interfaceConfigurator
+}
+
+// storePortBridge records the additional secondary OVS bridge the port of the VLAN interface of the Pod has been
+// connected to, so that the interface can be deleted from the bridge.
+func (pc *PodController) storePortBridge(pod *corev1.Pod, containerID, ifDev, bridgeName string) {
+	if _, ok := pc.additionalBridges[bridgeName]; !ok {
+		return
+	}
+	for _, interfaceConfig := range pc.interfaceStore.GetContainerInterfacesByPod(pod.Name, pod.Namespace) {
+		if interfaceConfig.ContainerID == containerID && interfaceConfig.IFDev == ifDev && interfaceConfig.OVSPortConfig != nil {
+			pc.portBridges.Store(interfaceConfig.PortUUID, bridgeName)
+			return
+		}
+	}
+}
+
 // initializeSecondaryInterfaceStore restores secondary interfaceStore when agent restarts.
 func (pc *PodController) initializeSecondaryInterfaceStore() error {
-	ovsPorts, err := pc.ovsBridgeClient.GetPortList()
+	ifaceList, err := getBridgeInterfaces(pc.ovsBridgeClient)
+	if err != nil {
+		return err
+	}
+	for bridgeName, bridge := range pc.additionalBridges {
+		bridgeIfaceList, err := getBridgeInterfaces(bridge.client)
+		if err != nil {
+			return err
+		}
+		for _, intf := range bridgeIfaceList {
+			pc.portBridges.Store(intf.PortUUID, bridgeName)
+		}
+		ifaceList = append(ifaceList, bridgeIfaceList...)
+	}
+
+	pc.interfaceStore.Initialize(ifaceList)
+	klog.InfoS("Successfully initialized the secondary bridge interface store")
+
+	return nil
+}
+
+// getBridgeInterfaces returns the configurations of the container interfaces connected to the secondary OVS bridge.
+func getBridgeInterfaces(ovsBridgeClient ovsconfig.OVSBridgeClient) ([]*interfacestore.InterfaceConfig, error) {
+	ovsPorts, err := ovsBridgeClient.GetPortList()
 	if err != nil {
-		return fmt.Errorf("failed to list OVS ports for the secondary bridge: %w", err)
+		return nil, fmt.Errorf("failed to list OVS ports for the secondary bridge: %w", err)
 	}
 
 	ifaceList := make([]*interfacestore.InterfaceConfig, 0, len(ovsPorts))
@@ -595,11 +707,7 @@ func (pc *PodController) initializeSecondaryInterfaceStore() error {
 
 		ifaceList = append(ifaceList, intf)
 	}
-
-	pc.interfaceStore.Initialize(ifaceList)
-	klog.InfoS("Successfully initialized the secondary bridge interface store")
-
-	return nil
+	return ifaceList, nil
 }
 
 // reconcileSecondaryInterfaces restores cniCache when agent restarts using primary interfaceStore.
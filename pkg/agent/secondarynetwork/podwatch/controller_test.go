@@ -216,6 +216,77 @@ func init() {
 			sriovResourceName2: {sriovDeviceID21},
 		}, nil
 	}
+	installVFTrafficControlFn = func(vfDeviceID string, tc *vfTrafficControl) error {
+		return nil
+	}
+	uninstallVFTrafficControlFn = func(vfDeviceID string) error {
+		return nil
+	}
+}
+
+func TestValidateNetworkConfigTrafficControl(t *testing.T) {
+	_, deniedCIDR, _ := net.ParseCIDR("10.10.0.0/16")
+	tests := []struct {
+		name                   string
+		config                 string
+		expectedTrafficControl *vfTrafficControl
+		expectedErr            string
+	}{
+		{
+			name:   "rate and denied CIDRs",
+			config: `{"cniVersion": "0.3.0", "type": "antrea", "networkType": "sriov", "trafficControl": {"rate": "100M", "burst": "8M", "deniedCIDRs": ["10.10.0.0/16"]}}`,
+			expectedTrafficControl: &vfTrafficControl{
+				rate:        12500000,
+				burst:       1000000,
+				deniedCIDRs: []*net.IPNet{deniedCIDR},
+			},
+		},
+		{
+			name:                   "burst defaults to rate",
+			config:                 `{"cniVersion": "0.3.0", "type": "antrea", "networkType": "sriov", "trafficControl": {"rate": "1G"}}`,
+			expectedTrafficControl: &vfTrafficControl{rate: 125000000, burst: 125000000},
+		},
+		{
+			name:        "burst without rate",
+			config:      `{"cniVersion": "0.3.0", "type": "antrea", "networkType": "sriov", "trafficControl": {"burst": "1M"}}`,
+			expectedErr: "invalid traffic control: burst cannot be set without rate",
+		},
+		{
+			name:        "invalid rate",
+			config:      `{"cniVersion": "0.3.0", "type": "antrea", "networkType": "sriov", "trafficControl": {"rate": "1"}}`,
+			expectedErr: "invalid traffic control: invalid rate 1: must be between 8 and 34359738360",
+		},
+		{
+			name:        "invalid denied CIDR",
+			config:      `{"cniVersion": "0.3.0", "type": "antrea", "networkType": "sriov", "trafficControl": {"deniedCIDRs": ["10.10.0.0"]}}`,
+			expectedErr: "invalid traffic control: invalid denied CIDR 10.10.0.0: invalid CIDR address: 10.10.0.0",
+		},
+		{
+			name:        "VLAN network",
+			config:      `{"cniVersion": "0.3.0", "type": "antrea", "networkType": "vlan", "trafficControl": {"rate": "100M"}}`,
+			expectedErr: "traffic control is only supported for the sriov network type",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			networkConfig, err := validateNetworkConfig([]byte(tc.config))
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedTrafficControl, networkConfig.vfTrafficControl)
+			}
+		})
+	}
+}
+
+func TestValidateNetworkConfigOVSBridge(t *testing.T) {
+	networkConfig, err := validateNetworkConfig([]byte(`{"cniVersion": "0.3.0", "type": "antrea", "networkType": "vlan", "ovsBridge": "br2"}`))
+	require.NoError(t, err)
+	assert.Equal(t, "br2", networkConfig.OVSBridge)
+
+	_, err = validateNetworkConfig([]byte(`{"cniVersion": "0.3.0", "type": "antrea", "networkType": "sriov", "ovsBridge": "br2"}`))
+	assert.EqualError(t, err, "OVS bridge is only supported for the vlan network type")
 }
 
 func TestPodControllerRun(t *testing.T) {
@@ -232,7 +303,7 @@ func TestPodControllerRun(t *testing.T) {
 		client,
 		netdefclient,
 		informerFactory.Core().V1().Pods().Informer(),
-		nil, primaryInterfaceStore, mockOVSBridgeClient)
+		nil, primaryInterfaceStore, []ovsconfig.OVSBridgeClient{mockOVSBridgeClient})
 	podController.interfaceConfigurator = interfaceConfigurator
 	podController.ipamAllocator = mockIPAM
 	cniCache := &podController.cniCache
@@ -1202,3 +1273,44 @@ func TestReconcileSecondaryInterfaces(t *testing.T) {
 	_, foundPod3 := pc.cniCache.Load("nsA/Pod3")
 	assert.False(t, foundPod3, "Stale interface should have been removed")
 }
+
+func TestAdditionalOVSBridges(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	pc, mockIPAM, interfaceConfigurator, mockOVSBridgeClient := testPodController(ctrl)
+	mockOVSBridgeClient.EXPECT().GetBridgeName().Return("br1").AnyTimes()
+	mockOVSBridgeClient2 := ovsconfigtest.NewMockOVSBridgeClient(ctrl)
+	interfaceConfigurator2 := podwatchtesting.NewMockInterfaceConfigurator(ctrl)
+	pc.additionalBridges = map[string]*ovsBridge{
+		"br2": {client: mockOVSBridgeClient2, interfaceConfigurator: interfaceConfigurator2},
+	}
+
+	for bridgeName, expectedConfigurator := range map[string]InterfaceConfigurator{
+		"":    interfaceConfigurator,
+		"br1": interfaceConfigurator,
+		"br2": interfaceConfigurator2,
+	} {
+		configurator, err := pc.getVLANInterfaceConfigurator(bridgeName)
+		require.NoError(t, err)
+		assert.Equal(t, expectedConfigurator, configurator)
+	}
+	_, err := pc.getVLANInterfaceConfigurator("br3")
+	assert.EqualError(t, err, "secondary network OVS bridge br3 is not configured")
+
+	uuids, ovsPorts, _ := createTestInterfaces()
+	mockOVSBridgeClient.EXPECT().GetPortList().Return(ovsPorts[:1], nil)
+	mockOVSBridgeClient2.EXPECT().GetPortList().Return(ovsPorts[1:2], nil)
+	require.NoError(t, pc.initializeSecondaryInterfaceStore())
+	assert.Equal(t, 2, pc.interfaceStore.Len())
+
+	// The interfaces are deleted from the bridges they are connected to.
+	interfaceConfig1, ok := pc.interfaceStore.GetContainerInterface(uuids["uuid1"])
+	require.True(t, ok)
+	interfaceConfig2, ok := pc.interfaceStore.GetContainerInterface(uuids["uuid2"])
+	require.True(t, ok)
+	interfaceConfigurator.EXPECT().DeleteVLANSecondaryInterface(interfaceConfig1).Return(nil)
+	interfaceConfigurator2.EXPECT().DeleteVLANSecondaryInterface(interfaceConfig2).Return(nil)
+	mockIPAM.EXPECT().SecondaryNetworkRelease(gomock.Any()).Return(nil).Times(2)
+	require.NoError(t, pc.removeInterfaces([]*interfacestore.InterfaceConfig{interfaceConfig1, interfaceConfig2}))
+	_, ok = pc.portBridges.Load(interfaceConfig2.PortUUID)
+	assert.False(t, ok)
+}
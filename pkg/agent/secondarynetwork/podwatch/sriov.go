@@ -17,10 +17,13 @@ package podwatch
 import (
 	"context"
 	"fmt"
+	"math"
+	"net"
 	"path"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/klog/v2"
 
 	// Version v1 of the kubelet API was introduced in K8s v1.20.
@@ -44,6 +47,11 @@ var (
 	// getPodContainerDeviceIDsFn is used to retrieve SRIOV device IDs
 	// assigned to a specific Pod. It can be overridden by unit tests.
 	getPodContainerDeviceIDsFn = getPodContainerDeviceIDs
+	// installVFTrafficControlFn and uninstallVFTrafficControlFn are used to
+	// enforce traffic controls on the representor of a VF. They can be
+	// overridden by unit tests.
+	installVFTrafficControlFn   = installVFTrafficControl
+	uninstallVFTrafficControlFn = uninstallVFTrafficControl
 )
 
 // Structure to associate a unique VF's PCI Address to the Linux ethernet interface.
@@ -53,6 +61,57 @@ type podSriovVFDeviceIDInfo struct {
 	ifName       string
 }
 
+// vfTrafficControl is the parsed form of TrafficControlConfig.
+type vfTrafficControl struct {
+	// Rate limit in bytes per second. 0 means no rate limit.
+	rate uint32
+	// Burst size in bytes.
+	burst       uint32
+	deniedCIDRs []*net.IPNet
+}
+
+func parseTrafficControlConfig(config *TrafficControlConfig) (*vfTrafficControl, error) {
+	tc := &vfTrafficControl{}
+	if config.Rate != "" {
+		rate, err := parseBitsAsBytes(config.Rate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate %s: %v", config.Rate, err)
+		}
+		tc.rate = rate
+		tc.burst = rate
+		if config.Burst != "" {
+			burst, err := parseBitsAsBytes(config.Burst)
+			if err != nil {
+				return nil, fmt.Errorf("invalid burst %s: %v", config.Burst, err)
+			}
+			tc.burst = burst
+		}
+	} else if config.Burst != "" {
+		return nil, fmt.Errorf("burst cannot be set without rate")
+	}
+	for _, cidr := range config.DeniedCIDRs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid denied CIDR %s: %v", cidr, err)
+		}
+		tc.deniedCIDRs = append(tc.deniedCIDRs, ipNet)
+	}
+	return tc, nil
+}
+
+// parseBitsAsBytes parses a quantity of bits, e.g. "100M", and returns it in bytes.
+func parseBitsAsBytes(value string) (uint32, error) {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return 0, err
+	}
+	bytes := quantity.Value() / 8
+	if bytes <= 0 || bytes > math.MaxUint32 {
+		return 0, fmt.Errorf("must be between 8 and %d", uint64(math.MaxUint32)*8)
+	}
+	return uint32(bytes), nil
+}
+
 // getPodContainerDeviceIDs returns the device IDs assigned to a Pod's containers.
 func getPodContainerDeviceIDs(podName string, podNamespace string) (map[string][]string, error) {
 	conn, err := grpc.NewClient(
@@ -170,15 +229,31 @@ func (pc *PodController) configureSriovAsSecondaryInterface(
 	resourceName string,
 	podCNIInfo *podCNIInfo,
 	mtu int,
+	trafficControl *vfTrafficControl,
 	result *current.Result,
 ) error {
 	podSriovVFDeviceID, err := pc.assignUnusedSriovVFDeviceID(pod.Name, pod.Namespace, resourceName, network.InterfaceRequest)
 	if err != nil {
 		return err
 	}
+	// The traffic of the VF bypasses OVS, so the traffic controls are enforced on
+	// its representor, which stays in the host network namespace. They are
+	// installed before the VF is moved to the container network namespace, so
+	// that the Pod never sends uncontrolled traffic.
+	if trafficControl != nil {
+		if err = installVFTrafficControlFn(podSriovVFDeviceID, trafficControl); err != nil {
+			pc.releaseSriovVFDeviceID(pod.Name, pod.Namespace, network.InterfaceRequest)
+			return fmt.Errorf("failed to install traffic control for VF %s: %w", podSriovVFDeviceID, err)
+		}
+	}
 	if err = pc.interfaceConfigurator.ConfigureSriovSecondaryInterface(
 		pod.Name, pod.Namespace, podCNIInfo.containerID, podCNIInfo.netNS,
 		network.InterfaceRequest, mtu, podSriovVFDeviceID, result); err != nil {
+		if trafficControl != nil {
+			if err := uninstallVFTrafficControlFn(podSriovVFDeviceID); err != nil {
+				klog.ErrorS(err, "Failed to uninstall traffic control for VF", "deviceID", podSriovVFDeviceID)
+			}
+		}
 		return fmt.Errorf("SRIOV Interface creation failed: %v", err)
 	}
 	return nil
@@ -186,7 +261,11 @@ func (pc *PodController) configureSriovAsSecondaryInterface(
 
 func (pc *PodController) deleteSriovSecondaryInterface(interfaceConfig *interfacestore.InterfaceConfig) error {
 	// NOTE: SR-IOV VF interface clean-up will be handled by SR-IOV device plugin. The interface
-	// is not deleted here.
+	// is not deleted here. The VF's traffic controls are removed, as the VF may be
+	// assigned to another Pod later. The interface name is the VF's PCI address.
+	if err := uninstallVFTrafficControlFn(interfaceConfig.InterfaceName); err != nil {
+		return fmt.Errorf("failed to uninstall traffic control for VF %s: %w", interfaceConfig.InterfaceName, err)
+	}
 	if err := pc.interfaceConfigurator.DeleteSriovSecondaryInterface(interfaceConfig); err != nil {
 		return err
 	}
@@ -0,0 +1,149 @@
+//go:build linux
+// +build linux
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package podwatch
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Mellanox/sriovnet"
+	"github.com/vishvananda/netlink"
+	"golang.org/x/sys/unix"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// The filters matching the denied CIDRs are evaluated before the rate limit,
+	// so that denied traffic doesn't consume the bandwidth of the Pod.
+	deniedCIDRFilterPriority = 1
+	rateLimitFilterPriority  = 2
+)
+
+// getVFRepresentor returns the name of the representor of the VF with the provided PCI address. The representor only
+// exists when the NIC eSwitch is in switchdev mode.
+func getVFRepresentor(vfDeviceID string) (string, error) {
+	uplink, err := sriovnet.GetUplinkRepresentor(vfDeviceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get uplink representor: %w", err)
+	}
+	vfIndex, err := sriovnet.GetVfIndexByPciAddress(vfDeviceID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get VF index: %w", err)
+	}
+	return sriovnet.GetVfRepresentor(uplink, vfIndex)
+}
+
+func ingressQdisc(linkIndex int) *netlink.Ingress {
+	return &netlink.Ingress{
+		QdiscAttrs: netlink.QdiscAttrs{
+			LinkIndex: linkIndex,
+			Handle:    netlink.MakeHandle(0xffff, 0),
+			Parent:    netlink.HANDLE_INGRESS,
+		},
+	}
+}
+
+// installVFTrafficControl installs TC filters on the ingress of the VF representor, i.e. for the traffic sent by the
+// Pod through the VF. The filters are offloaded to the NIC when supported. Any existing filter is removed first.
+func installVFTrafficControl(vfDeviceID string, tc *vfTrafficControl) error {
+	representor, err := getVFRepresentor(vfDeviceID)
+	if err != nil {
+		return fmt.Errorf("traffic control requires the VF representor, is the NIC in switchdev mode? %w", err)
+	}
+	link, err := netlink.LinkByName(representor)
+	if err != nil {
+		return fmt.Errorf("failed to get link of VF representor %s: %w", representor, err)
+	}
+	linkIndex := link.Attrs().Index
+	qdisc := ingressQdisc(linkIndex)
+	if err := netlink.QdiscDel(qdisc); err != nil && !errors.Is(err, unix.ENOENT) && !errors.Is(err, unix.EINVAL) {
+		return fmt.Errorf("failed to delete ingress qdisc of VF representor %s: %w", representor, err)
+	}
+	if err := netlink.QdiscAdd(qdisc); err != nil {
+		return fmt.Errorf("failed to add ingress qdisc to VF representor %s: %w", representor, err)
+	}
+
+	for _, cidr := range tc.deniedCIDRs {
+		ethType := uint16(unix.ETH_P_IP)
+		if cidr.IP.To4() == nil {
+			ethType = unix.ETH_P_IPV6
+		}
+		filter := &netlink.Flower{
+			FilterAttrs: netlink.FilterAttrs{
+				LinkIndex: linkIndex,
+				Parent:    netlink.HANDLE_MIN_INGRESS,
+				Priority:  deniedCIDRFilterPriority,
+				Protocol:  ethType,
+			},
+			EthType:    ethType,
+			DestIP:     cidr.IP,
+			DestIPMask: cidr.Mask,
+			Actions: []netlink.Action{
+				&netlink.GenericAction{ActionAttrs: netlink.ActionAttrs{Action: netlink.TC_ACT_SHOT}},
+			},
+		}
+		if err := netlink.FilterAdd(filter); err != nil {
+			return fmt.Errorf("failed to add filter for denied CIDR %s to VF representor %s: %w", cidr, representor, err)
+		}
+	}
+
+	if tc.rate > 0 {
+		police := netlink.NewPoliceAction()
+		police.Rate = tc.rate
+		police.Burst = tc.burst
+		police.ExceedAction = netlink.TC_POLICE_SHOT
+		police.NotExceedAction = netlink.TC_POLICE_OK
+		filter := &netlink.MatchAll{
+			FilterAttrs: netlink.FilterAttrs{
+				LinkIndex: linkIndex,
+				Parent:    netlink.HANDLE_MIN_INGRESS,
+				Priority:  rateLimitFilterPriority,
+				Protocol:  unix.ETH_P_ALL,
+			},
+			Actions: []netlink.Action{police},
+		}
+		if err := netlink.FilterAdd(filter); err != nil {
+			return fmt.Errorf("failed to add rate limit filter to VF representor %s: %w", representor, err)
+		}
+	}
+	klog.InfoS("Installed traffic control for VF", "deviceID", vfDeviceID, "representor", representor,
+		"rate", tc.rate, "burst", tc.burst, "deniedCIDRs", tc.deniedCIDRs)
+	return nil
+}
+
+// uninstallVFTrafficControl removes the TC filters installed by installVFTrafficControl, if any.
+func uninstallVFTrafficControl(vfDeviceID string) error {
+	representor, err := getVFRepresentor(vfDeviceID)
+	if err != nil {
+		// Without a representor, no traffic control can have been installed for the VF.
+		klog.V(2).InfoS("VF representor not found, skipping traffic control cleanup", "deviceID", vfDeviceID, "err", err)
+		return nil
+	}
+	link, err := netlink.LinkByName(representor)
+	if err != nil {
+		var linkNotFoundErr netlink.LinkNotFoundError
+		if errors.As(err, &linkNotFoundErr) {
+			return nil
+		}
+		return fmt.Errorf("failed to get link of VF representor %s: %w", representor, err)
+	}
+	if err := netlink.QdiscDel(ingressQdisc(link.Attrs().Index)); err != nil && !errors.Is(err, unix.ENOENT) && !errors.Is(err, unix.EINVAL) {
+		return fmt.Errorf("failed to delete ingress qdisc of VF representor %s: %w", representor, err)
+	}
+	return nil
+}
@@ -32,4 +32,24 @@ type SecondaryNetworkConfig struct {
 	// non-zero VLAN is specified, it will override the VLAN in the Antrea
 	// IPAM IPPool subnet.
 	VLAN int32 `json:"vlan,omitempty"`
+	// Name of the secondary OVS bridge to which the interfaces are connected.
+	// It must be one of the bridges in the antrea-agent configuration.
+	// Applicable only to the VLAN network type. Defaults to the first bridge.
+	OVSBridge string `json:"ovsBridge,omitempty"`
+	// Traffic controls enforced with TC on the VF representor, as the traffic of
+	// SR-IOV interfaces bypasses OVS. Applicable only to the SR-IOV network type.
+	TrafficControl *TrafficControlConfig `json:"trafficControl,omitempty"`
+	// The parsed traffic controls, set when validating the configuration.
+	vfTrafficControl *vfTrafficControl
+}
+
+type TrafficControlConfig struct {
+	// Maximum rate of the traffic sent by the Pod through the VF, in bits per
+	// second, e.g. "100M". Traffic exceeding the rate is dropped.
+	Rate string `json:"rate,omitempty"`
+	// Burst size of the traffic sent by the Pod through the VF, in bits, e.g.
+	// "10M". Defaults to the rate if not specified.
+	Burst string `json:"burst,omitempty"`
+	// The Pod is not allowed to send traffic to these CIDRs through the VF.
+	DeniedCIDRs []string `json:"deniedCIDRs,omitempty"`
 }
@@ -0,0 +1,33 @@
+//go:build linux
+// +build linux
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceprobe
+
+import (
+	"github.com/containernetworking/plugins/pkg/ns"
+)
+
+// runInNetNS runs f in the network namespace at netNSPath, or in the current network namespace if netNSPath is
+// empty. The sockets created by f remain in the network namespace after it returns.
+func runInNetNS(netNSPath string, f func() error) error {
+	if netNSPath == "" {
+		return f()
+	}
+	return ns.WithNetNSPath(netNSPath, func(ns.NetNS) error {
+		return f()
+	})
+}
@@ -0,0 +1,31 @@
+//go:build windows
+// +build windows
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceprobe
+
+import (
+	"fmt"
+)
+
+// runInNetNS runs f in the current network namespace. Probing from another network namespace is not supported on
+// Windows.
+func runInNetNS(netNSPath string, f func() error) error {
+	if netNSPath != "" {
+		return fmt.Errorf("probing Services from a network namespace is not supported on Windows")
+	}
+	return f()
+}
@@ -0,0 +1,215 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceprobe
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/agent/types"
+)
+
+const (
+	controllerName = "ServiceProber"
+
+	// sourceNode is the value of the source label of the probes sent from the Node network namespace.
+	sourceNode = "node"
+	// sourceNetNS is the value of the source label of the probes sent from the probe network namespace.
+	sourceNetNS = "netns"
+)
+
+// Config is the configuration of the Prober.
+type Config struct {
+	// Services are the Services to probe, in the "<namespace>/<name>" format, in addition to the Services annotated
+	// with "service.antrea.io/probe: true".
+	Services []string
+	// Interval is the interval between two rounds of probes.
+	Interval time.Duration
+	// Timeout is the timeout of a single probe.
+	Timeout time.Duration
+	// NetworkNamespace is the path of the network namespace from which the Services are also probed. The Services
+	// are only probed from the Node network namespace if it is empty.
+	NetworkNamespace string
+}
+
+// target is a Service address probed from a source. Its fields are the label values of the probe metrics.
+type target struct {
+	service string
+	address string
+	source  string
+}
+
+// dialFunc establishes a TCP connection to the address from the network namespace at netNSPath, or from the Node
+// network namespace if netNSPath is empty, and closes it.
+type dialFunc func(ctx context.Context, netNSPath string, address string) error
+
+// Prober periodically connects to the ClusterIPs of the selected Services, and exports the results as Prometheus
+// metrics, so that the reachability of critical Services can be monitored from every Node. Only TCP ports are
+// probed, as a connection to a UDP or SCTP port cannot be verified without knowing the application protocol.
+type Prober struct {
+	serviceLister       corelisters.ServiceLister
+	serviceListerSynced cache.InformerSynced
+	services            sets.Set[string]
+	interval            time.Duration
+	timeout             time.Duration
+	netNSPath           string
+	dial                dialFunc
+	clock               clock.PassiveClock
+
+	// probedTargets are the targets probed in the last round, used to delete the metrics of the targets which are no
+	// longer probed.
+	probedTargets sets.Set[target]
+}
+
+// NewProber creates a new Prober.
+func NewProber(serviceInformer coreinformers.ServiceInformer, config Config) *Prober {
+	return &Prober{
+		serviceLister:       serviceInformer.Lister(),
+		serviceListerSynced: serviceInformer.Informer().HasSynced,
+		services:            sets.New[string](config.Services...),
+		interval:            config.Interval,
+		timeout:             config.Timeout,
+		netNSPath:           config.NetworkNamespace,
+		dial:                dialTCP,
+		clock:               clock.RealClock{},
+		probedTargets:       sets.New[target](),
+	}
+}
+
+func (p *Prober) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Starting " + controllerName)
+	defer klog.InfoS("Shutting down " + controllerName)
+
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, p.serviceListerSynced) {
+		return
+	}
+	wait.Until(p.probeAll, p.interval, stopCh)
+}
+
+func (p *Prober) isProbed(service *corev1.Service) bool {
+	if p.services.Has(service.Namespace + "/" + service.Name) {
+		return true
+	}
+	probe, _ := strconv.ParseBool(service.Annotations[types.ServiceProbeAnnotationKey])
+	return probe
+}
+
+// getTargets returns the targets to probe: the TCP ports of all the ClusterIPs of the selected Services, from the
+// Node network namespace and from the probe network namespace if it is configured. Headless Services are skipped.
+func (p *Prober) getTargets() []target {
+	services, _ := p.serviceLister.List(labels.Everything())
+	sources := []string{sourceNode}
+	if p.netNSPath != "" {
+		sources = append(sources, sourceNetNS)
+	}
+	var targets []target
+	for _, service := range services {
+		if !p.isProbed(service) {
+			continue
+		}
+		serviceName := service.Namespace + "/" + service.Name
+		clusterIPs := service.Spec.ClusterIPs
+		if len(clusterIPs) == 0 && service.Spec.ClusterIP != "" {
+			clusterIPs = []string{service.Spec.ClusterIP}
+		}
+		for _, clusterIP := range clusterIPs {
+			if net.ParseIP(clusterIP) == nil {
+				continue
+			}
+			for _, port := range service.Spec.Ports {
+				if port.Protocol != corev1.ProtocolTCP && port.Protocol != "" {
+					continue
+				}
+				address := net.JoinHostPort(clusterIP, strconv.Itoa(int(port.Port)))
+				for _, source := range sources {
+					targets = append(targets, target{service: serviceName, address: address, source: source})
+				}
+			}
+		}
+	}
+	return targets
+}
+
+// probeAll probes all the targets concurrently, and updates the metrics once all the probes are done.
+func (p *Prober) probeAll() {
+	targets := p.getTargets()
+	var wg sync.WaitGroup
+	results := make([]error, len(targets))
+	durations := make([]time.Duration, len(targets))
+	for i := range targets {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			durations[i], results[i] = p.probe(targets[i])
+		}(i)
+	}
+	wg.Wait()
+
+	newTargets := sets.New[target]()
+	for i, t := range targets {
+		newTargets.Insert(t)
+		success := 1.0
+		if err := results[i]; err != nil {
+			klog.V(2).InfoS("Service probe failed", "service", t.service, "address", t.address, "source", t.source, "err", err)
+			success = 0
+			metrics.ServiceProbeFailureCount.WithLabelValues(t.service, t.address, t.source).Inc()
+		}
+		metrics.ServiceProbeSuccess.WithLabelValues(t.service, t.address, t.source).Set(success)
+		metrics.ServiceProbeDurationSeconds.WithLabelValues(t.service, t.address, t.source).Set(durations[i].Seconds())
+	}
+	for t := range p.probedTargets.Difference(newTargets) {
+		metrics.ServiceProbeSuccess.DeleteLabelValues(t.service, t.address, t.source)
+		metrics.ServiceProbeDurationSeconds.DeleteLabelValues(t.service, t.address, t.source)
+		metrics.ServiceProbeFailureCount.DeleteLabelValues(t.service, t.address, t.source)
+	}
+	p.probedTargets = newTargets
+}
+
+func (p *Prober) probe(t target) (time.Duration, error) {
+	netNSPath := ""
+	if t.source == sourceNetNS {
+		netNSPath = p.netNSPath
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+	start := p.clock.Now()
+	err := p.dial(ctx, netNSPath, t.address)
+	return p.clock.Since(start), err
+}
+
+func dialTCP(ctx context.Context, netNSPath string, address string) error {
+	return runInNetNS(netNSPath, func() error {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	})
+}
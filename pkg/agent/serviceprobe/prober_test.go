@@ -0,0 +1,187 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serviceprobe
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/component-base/metrics/testutil"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+	"antrea.io/antrea/pkg/agent/types"
+)
+
+func newService(namespace, name string, annotations map[string]string, clusterIPs []string, ports ...corev1.ServicePort) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Annotations: annotations},
+		Spec: corev1.ServiceSpec{
+			ClusterIP:  clusterIPs[0],
+			ClusterIPs: clusterIPs,
+			Ports:      ports,
+		},
+	}
+}
+
+var (
+	tcpPort80  = corev1.ServicePort{Port: 80, Protocol: corev1.ProtocolTCP}
+	tcpPort443 = corev1.ServicePort{Port: 443, Protocol: corev1.ProtocolTCP}
+	udpPort53  = corev1.ServicePort{Port: 53, Protocol: corev1.ProtocolUDP}
+	probed     = map[string]string{types.ServiceProbeAnnotationKey: "true"}
+)
+
+func newTestProber(t *testing.T, config Config, objects ...runtime.Object) *Prober {
+	client := fake.NewSimpleClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	p := NewProber(informerFactory.Core().V1().Services(), config)
+	stopCh := make(chan struct{})
+	t.Cleanup(func() { close(stopCh) })
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+	return p
+}
+
+func TestGetTargets(t *testing.T) {
+	services := []runtime.Object{
+		newService("kube-system", "kube-dns", nil, []string{"10.96.0.10"}, udpPort53, corev1.ServicePort{Port: 53, Protocol: corev1.ProtocolTCP}),
+		newService("default", "web", probed, []string{"10.96.0.20", "fd00::20"}, tcpPort80, tcpPort443),
+		newService("default", "headless", probed, []string{corev1.ClusterIPNone}, tcpPort80),
+		newService("default", "not-probed", map[string]string{types.ServiceProbeAnnotationKey: "false"}, []string{"10.96.0.30"}, tcpPort80),
+	}
+	tests := []struct {
+		name            string
+		config          Config
+		expectedTargets []target
+	}{
+		{
+			name:   "annotated Services",
+			config: Config{},
+			expectedTargets: []target{
+				{service: "default/web", address: "10.96.0.20:80", source: sourceNode},
+				{service: "default/web", address: "10.96.0.20:443", source: sourceNode},
+				{service: "default/web", address: "[fd00::20]:80", source: sourceNode},
+				{service: "default/web", address: "[fd00::20]:443", source: sourceNode},
+			},
+		},
+		{
+			name:   "configured Services with network namespace",
+			config: Config{Services: []string{"kube-system/kube-dns", "default/not-probed"}, NetworkNamespace: "/var/run/netns/probe"},
+			expectedTargets: []target{
+				{service: "default/not-probed", address: "10.96.0.30:80", source: sourceNode},
+				{service: "default/not-probed", address: "10.96.0.30:80", source: sourceNetNS},
+				{service: "kube-system/kube-dns", address: "10.96.0.10:53", source: sourceNode},
+				{service: "kube-system/kube-dns", address: "10.96.0.10:53", source: sourceNetNS},
+				{service: "default/web", address: "10.96.0.20:80", source: sourceNode},
+				{service: "default/web", address: "10.96.0.20:80", source: sourceNetNS},
+				{service: "default/web", address: "10.96.0.20:443", source: sourceNode},
+				{service: "default/web", address: "10.96.0.20:443", source: sourceNetNS},
+				{service: "default/web", address: "[fd00::20]:80", source: sourceNode},
+				{service: "default/web", address: "[fd00::20]:80", source: sourceNetNS},
+				{service: "default/web", address: "[fd00::20]:443", source: sourceNode},
+				{service: "default/web", address: "[fd00::20]:443", source: sourceNetNS},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestProber(t, tt.config, services...)
+			assert.ElementsMatch(t, tt.expectedTargets, p.getTargets())
+		})
+	}
+}
+
+func TestProbeAll(t *testing.T) {
+	metrics.InitializeServiceProbeMetrics()
+	p := newTestProber(t, Config{Timeout: time.Second, NetworkNamespace: "/var/run/netns/probe"},
+		newService("default", "web", probed, []string{"10.96.0.20"}, tcpPort80))
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	p.clock = fakeClock
+	var mutex sync.Mutex
+	unreachable := map[string]bool{"/var/run/netns/probe": true}
+	p.dial = func(ctx context.Context, netNSPath string, address string) error {
+		mutex.Lock()
+		defer mutex.Unlock()
+		fakeClock.Step(100 * time.Millisecond)
+		if unreachable[netNSPath] {
+			return fmt.Errorf("connection timed out")
+		}
+		return nil
+	}
+
+	p.probeAll()
+	expected := `
+# HELP antrea_agent_service_probe_success [ALPHA] Whether the last probe of a Service address succeeded (1) or not (0). The Service, the probed address and the source of the probe (node or netns) are used as labels.
+# TYPE antrea_agent_service_probe_success gauge
+antrea_agent_service_probe_success{address="10.96.0.20:80",service="default/web",source="netns"} 0
+antrea_agent_service_probe_success{address="10.96.0.20:80",service="default/web",source="node"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected), "antrea_agent_service_probe_success"))
+	failures, err := testutil.GetCounterMetricValue(metrics.ServiceProbeFailureCount.WithLabelValues("default/web", "10.96.0.20:80", sourceNetNS))
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, failures)
+	duration, err := testutil.GetGaugeMetricValue(metrics.ServiceProbeDurationSeconds.WithLabelValues("default/web", "10.96.0.20:80", sourceNode))
+	require.NoError(t, err)
+	assert.Greater(t, duration, 0.0)
+
+	// The metrics of a Service are deleted when it is no longer probed.
+	p.probedTargets.Insert(target{service: "default/db", address: "10.96.0.30:5432", source: sourceNode})
+	metrics.ServiceProbeSuccess.WithLabelValues("default/db", "10.96.0.30:5432", sourceNode).Set(1)
+	mutex.Lock()
+	unreachable = map[string]bool{}
+	mutex.Unlock()
+	p.probeAll()
+	expected = `
+# HELP antrea_agent_service_probe_success [ALPHA] Whether the last probe of a Service address succeeded (1) or not (0). The Service, the probed address and the source of the probe (node or netns) are used as labels.
+# TYPE antrea_agent_service_probe_success gauge
+antrea_agent_service_probe_success{address="10.96.0.20:80",service="default/web",source="netns"} 1
+antrea_agent_service_probe_success{address="10.96.0.20:80",service="default/web",source="node"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(legacyregistry.DefaultGatherer, strings.NewReader(expected), "antrea_agent_service_probe_success"))
+}
+
+func TestDialTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.Close()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	assert.NoError(t, dialTCP(ctx, "", address))
+	listener.Close()
+	assert.Error(t, dialTCP(ctx, "", address))
+}
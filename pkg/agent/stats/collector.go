@@ -49,6 +49,8 @@ type statsCollection struct {
 	antreaNetworkPolicyStats map[types.UID]map[string]*statsv1alpha1.TrafficStats
 	// multicastGroups is a map that encodes the list of Pods that has joined the multicast group.
 	multicastGroups map[string][]cpv1beta.PodReference
+	// namespaceTrafficStats is a mapping from Namespace names to the traffic stats of their local Pods.
+	namespaceTrafficStats map[string]*namespaceTrafficStats
 }
 
 // Collector is responsible for collecting stats from the Openflow client, calculating the delta compared with the last
@@ -62,21 +64,25 @@ type Collector struct {
 	ofClient             openflow.Client
 	networkPolicyQuerier querier.AgentNetworkPolicyInfoQuerier
 	multicastQuerier     querier.AgentMulticastInfoQuerier
+	// namespaceTrafficCollector accounts the traffic of the local Pods by Namespace. It is nil if the
+	// NamespaceTrafficStats feature is disabled.
+	namespaceTrafficCollector *NamespaceTrafficCollector
 	// lastStatsCollection is the last statistics that has been reported to antrea-controller successfully.
 	// It is used to calculate the delta of the statistics that will be reported.
 	lastStatsCollection *statsCollection
 	multicastEnabled    bool
 }
 
-func NewCollector(antreaClientProvider client.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier, mcQuerier *multicast.Controller) *Collector {
+func NewCollector(antreaClientProvider client.AntreaClientProvider, ofClient openflow.Client, npQuerier querier.AgentNetworkPolicyInfoQuerier, mcQuerier *multicast.Controller, nsTrafficCollector *NamespaceTrafficCollector) *Collector {
 	nodeName, _ := env.GetNodeName()
 	manager := &Collector{
-		nodeName:             nodeName,
-		antreaClientProvider: antreaClientProvider,
-		ofClient:             ofClient,
-		networkPolicyQuerier: npQuerier,
-		multicastQuerier:     mcQuerier,
-		multicastEnabled:     mcQuerier != nil,
+		nodeName:                  nodeName,
+		antreaClientProvider:      antreaClientProvider,
+		ofClient:                  ofClient,
+		networkPolicyQuerier:      npQuerier,
+		multicastQuerier:          mcQuerier,
+		namespaceTrafficCollector: nsTrafficCollector,
+		multicastEnabled:          mcQuerier != nil,
 	}
 	return manager
 }
@@ -140,11 +146,16 @@ func (m *Collector) collect() *statsCollection {
 	if m.multicastEnabled {
 		multicastGroupMap = m.multicastQuerier.GetGroupPods()
 	}
+	var namespaceStatsMap map[string]*namespaceTrafficStats
+	if m.namespaceTrafficCollector != nil {
+		namespaceStatsMap = m.namespaceTrafficCollector.getNamespaceTrafficStats()
+	}
 	return &statsCollection{
 		networkPolicyStats:              npStatsMap,
 		antreaClusterNetworkPolicyStats: acnpStatsMap,
 		antreaNetworkPolicyStats:        annpStatsMap,
 		multicastGroups:                 multicastGroupMap,
+		namespaceTrafficStats:           namespaceStatsMap,
 	}
 }
 
@@ -220,8 +231,9 @@ func (m *Collector) calculateNodeStatsSummary(curStatsCollection *statsCollectio
 		acnpStats, annpStats = m.mergeStatsWithIGMPReports(acnpStats, annpStats)
 		multicastGroups = m.convertMulticastGroups(curStatsCollection.multicastGroups)
 	}
+	namespaceStats := calculateNamespaceDiff(curStatsCollection.namespaceTrafficStats, m.lastStatsCollection.namespaceTrafficStats)
 	// Semantically, reporting networkpolicy statistics with zero length is equal to reporting the same multicastGroupInfo.
-	if len(npStats) == 0 && len(acnpStats) == 0 && len(annpStats) == 0 && !multicastGroupsUpdated {
+	if len(npStats) == 0 && len(acnpStats) == 0 && len(annpStats) == 0 && len(namespaceStats) == 0 && !multicastGroupsUpdated {
 		return nil
 	}
 	return &cpv1beta.NodeStatsSummary{
@@ -232,6 +244,7 @@ func (m *Collector) calculateNodeStatsSummary(curStatsCollection *statsCollectio
 		AntreaClusterNetworkPolicies: acnpStats,
 		AntreaNetworkPolicies:        annpStats,
 		Multicast:                    multicastGroups,
+		Namespaces:                   namespaceStats,
 	}
 }
 
@@ -381,3 +394,36 @@ func calculateDiff(curStatsMap, lastStatsMap map[types.UID]*statsv1alpha1.Traffi
 	}
 	return statsList
 }
+
+// calculateNamespaceDiff calculates the delta of the traffic stats of each Namespace. The stats of the
+// NamespaceTrafficCollector are cumulative, so the delta is never negative.
+func calculateNamespaceDiff(curStatsMap, lastStatsMap map[string]*namespaceTrafficStats) []cpv1beta.NamespaceTrafficStats {
+	if len(curStatsMap) == 0 {
+		return nil
+	}
+	diff := func(cur, last statsv1alpha1.TrafficStats) statsv1alpha1.TrafficStats {
+		return statsv1alpha1.TrafficStats{
+			Packets:  cur.Packets - last.Packets,
+			Sessions: cur.Sessions - last.Sessions,
+			Bytes:    cur.Bytes - last.Bytes,
+		}
+	}
+	statsList := make([]cpv1beta.NamespaceTrafficStats, 0, len(curStatsMap))
+	for namespace, curStats := range curStatsMap {
+		stats := cpv1beta.NamespaceTrafficStats{
+			Namespace: namespace,
+			Ingress:   curStats.ingress,
+			Egress:    curStats.egress,
+		}
+		if lastStats, exists := lastStatsMap[namespace]; exists {
+			stats.Ingress = diff(curStats.ingress, lastStats.ingress)
+			stats.Egress = diff(curStats.egress, lastStats.egress)
+		}
+		// If the statistics of the Namespace remain unchanged, no need to report it.
+		if stats.Ingress.Bytes == 0 && stats.Egress.Bytes == 0 && stats.Ingress.Sessions == 0 && stats.Egress.Sessions == 0 {
+			continue
+		}
+		statsList = append(statsList, stats)
+	}
+	return statsList
+}
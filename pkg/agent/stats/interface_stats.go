@@ -0,0 +1,139 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/client"
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+// InterfaceStatsCollector is responsible for collecting the traffic counters of the local Pod interfaces and
+// reporting them to the antrea-controller periodically, as a NodeInterfaceStats object named after the Node. It is
+// only supported on Windows, where the counters are read from the HNS endpoints of the Pods, as Pod traffic which is
+// dropped by HNS is not visible in the OVS flow stats.
+type InterfaceStatsCollector struct {
+	nodeName string
+	// antreaClientProvider provides interfaces to get antreaClient, which will be used to report the statistics to the
+	// antrea-controller.
+	antreaClientProvider client.AntreaClientProvider
+	interfaceStore       interfacestore.InterfaceStore
+	collectPeriod        time.Duration
+
+	mutex sync.RWMutex
+	// lastStats stores the counters of the Pod interfaces collected in the last period, keyed by Pod Namespace and
+	// name. They are added to the flow records exported for the Pods.
+	lastStats map[string]statsv1alpha1.PodInterfaceStats
+}
+
+func NewInterfaceStatsCollector(nodeName string, antreaClientProvider client.AntreaClientProvider, interfaceStore interfacestore.InterfaceStore) *InterfaceStatsCollector {
+	return &InterfaceStatsCollector{
+		nodeName:             nodeName,
+		antreaClientProvider: antreaClientProvider,
+		interfaceStore:       interfaceStore,
+		collectPeriod:        collectPeriod,
+	}
+}
+
+// Run runs a loop that collects the interface statistics and reports them until the provided channel is closed.
+func (c *InterfaceStatsCollector) Run(stopCh <-chan struct{}) {
+	klog.InfoS("Start collecting Pod interface stats")
+	wait.Until(func() {
+		summary := c.collect()
+		c.updateLastStats(summary.PodInterfaceStats)
+		if err := c.report(summary); err != nil {
+			klog.ErrorS(err, "Failed to report Pod interface stats")
+		}
+	}, c.collectPeriod, stopCh)
+}
+
+// collect collects the counters of all the local Pod interfaces. The interfaces whose counters cannot be read, e.g.
+// because the Pod is being deleted, are skipped.
+func (c *InterfaceStatsCollector) collect() *statsv1alpha1.NodeInterfaceStats {
+	interfaces := c.interfaceStore.GetInterfacesByType(interfacestore.ContainerInterface)
+	podInterfaceStats := make([]statsv1alpha1.PodInterfaceStats, 0, len(interfaces))
+	for _, iface := range interfaces {
+		stats, err := getInterfaceStats(iface.InterfaceName)
+		if err != nil {
+			klog.V(2).InfoS("Failed to get stats of Pod interface", "interface", iface.InterfaceName, "err", err)
+			continue
+		}
+		stats.Pod = statsv1alpha1.PodReference{Name: iface.PodName, Namespace: iface.PodNamespace}
+		stats.InterfaceName = iface.InterfaceName
+		podInterfaceStats = append(podInterfaceStats, *stats)
+	}
+	sort.Slice(podInterfaceStats, func(i, j int) bool {
+		if podInterfaceStats[i].Pod.Namespace != podInterfaceStats[j].Pod.Namespace {
+			return podInterfaceStats[i].Pod.Namespace < podInterfaceStats[j].Pod.Namespace
+		}
+		if podInterfaceStats[i].Pod.Name != podInterfaceStats[j].Pod.Name {
+			return podInterfaceStats[i].Pod.Name < podInterfaceStats[j].Pod.Name
+		}
+		return podInterfaceStats[i].InterfaceName < podInterfaceStats[j].InterfaceName
+	})
+	return &statsv1alpha1.NodeInterfaceStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: c.nodeName,
+		},
+		PodInterfaceStats: podInterfaceStats,
+	}
+}
+
+func (c *InterfaceStatsCollector) updateLastStats(podInterfaceStats []statsv1alpha1.PodInterfaceStats) {
+	lastStats := make(map[string]statsv1alpha1.PodInterfaceStats, len(podInterfaceStats))
+	for _, stats := range podInterfaceStats {
+		lastStats[k8s.NamespacedName(stats.Pod.Namespace, stats.Pod.Name)] = stats
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.lastStats = lastStats
+}
+
+// GetPodInterfaceStats returns the counters of the interface of a local Pod collected in the last period.
+func (c *InterfaceStatsCollector) GetPodInterfaceStats(namespace, name string) (*statsv1alpha1.PodInterfaceStats, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	stats, ok := c.lastStats[k8s.NamespacedName(namespace, name)]
+	if !ok {
+		return nil, false
+	}
+	return &stats, true
+}
+
+// GetInterfaceStats reads the current counters of a local Pod interface. It is only supported on Windows, where the
+// counters are read from the HNS endpoint of the Pod.
+func GetInterfaceStats(ifaceName string) (*statsv1alpha1.PodInterfaceStats, error) {
+	return getInterfaceStats(ifaceName)
+}
+
+func (c *InterfaceStatsCollector) report(summary *statsv1alpha1.NodeInterfaceStats) error {
+	antreaClient, err := c.antreaClientProvider.GetAntreaClient()
+	if err != nil {
+		return err
+	}
+	klog.V(4).InfoS("Reporting Pod interface stats", "interfaces", len(summary.PodInterfaceStats))
+	_, err = antreaClient.StatsV1alpha1().NodeInterfaceStats().Create(context.TODO(), summary, metav1.CreateOptions{})
+	return err
+}
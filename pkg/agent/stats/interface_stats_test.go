@@ -0,0 +1,112 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/agent/interfacestore"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/client/clientset/versioned"
+	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+)
+
+type antreaClientGetter struct {
+	clientset versioned.Interface
+}
+
+func (g *antreaClientGetter) GetAntreaClient() (versioned.Interface, error) {
+	return g.clientset, nil
+}
+
+func mockGetInterfaceStats(stats map[string]*statsv1alpha1.PodInterfaceStats) func() {
+	prevGetInterfaceStats := getInterfaceStats
+	getInterfaceStats = func(ifaceName string) (*statsv1alpha1.PodInterfaceStats, error) {
+		s, ok := stats[ifaceName]
+		if !ok {
+			return nil, fmt.Errorf("HNS endpoint %s not found", ifaceName)
+		}
+		return s.DeepCopy(), nil
+	}
+	return func() { getInterfaceStats = prevGetInterfaceStats }
+}
+
+func TestInterfaceStatsCollector(t *testing.T) {
+	ifaceStore := interfacestore.NewInterfaceStore()
+	for _, iface := range []*interfacestore.InterfaceConfig{
+		interfacestore.NewContainerInterface("pod2-3c4d5e", "container2", "pod2", "ns1", "", nil, []net.IP{net.ParseIP("10.10.0.12")}, 0),
+		interfacestore.NewContainerInterface("pod1-a1b2c3", "container1", "pod1", "ns1", "", nil, []net.IP{net.ParseIP("10.10.0.11")}, 0),
+		// The HNS endpoint of pod3 has been removed and its stats cannot be read.
+		interfacestore.NewContainerInterface("pod3-f6a7b8", "container3", "pod3", "ns2", "", nil, []net.IP{net.ParseIP("10.10.0.13")}, 0),
+		interfacestore.NewGatewayInterface("antrea-gw0", nil),
+	} {
+		ifaceStore.AddInterface(iface)
+	}
+	defer mockGetInterfaceStats(map[string]*statsv1alpha1.PodInterfaceStats{
+		"pod1-a1b2c3": {EndpointID: "ep1", PacketsReceived: 10, PacketsSent: 20, BytesReceived: 1000, BytesSent: 2000},
+		"pod2-3c4d5e": {EndpointID: "ep2", PacketsReceived: 30, PacketsSent: 40, BytesReceived: 3000, BytesSent: 4000, DroppedPacketsIncoming: 5},
+	})()
+
+	clientset := fakeversioned.NewSimpleClientset()
+	c := NewInterfaceStatsCollector("node1", &antreaClientGetter{clientset}, ifaceStore)
+	c.collectPeriod = 10 * time.Millisecond
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go c.Run(stopCh)
+
+	expected := &statsv1alpha1.NodeInterfaceStats{
+		ObjectMeta: metav1.ObjectMeta{Name: "node1"},
+		PodInterfaceStats: []statsv1alpha1.PodInterfaceStats{
+			{
+				Pod:             statsv1alpha1.PodReference{Name: "pod1", Namespace: "ns1"},
+				InterfaceName:   "pod1-a1b2c3",
+				EndpointID:      "ep1",
+				PacketsReceived: 10,
+				PacketsSent:     20,
+				BytesReceived:   1000,
+				BytesSent:       2000,
+			},
+			{
+				Pod:                    statsv1alpha1.PodReference{Name: "pod2", Namespace: "ns1"},
+				InterfaceName:          "pod2-3c4d5e",
+				EndpointID:             "ep2",
+				PacketsReceived:        30,
+				PacketsSent:            40,
+				BytesReceived:          3000,
+				BytesSent:              4000,
+				DroppedPacketsIncoming: 5,
+			},
+		},
+	}
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		summary, err := clientset.StatsV1alpha1().NodeInterfaceStats().Get(context.TODO(), "node1", metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, expected, summary)
+	}, 2*time.Second, 10*time.Millisecond)
+
+	stats, ok := c.GetPodInterfaceStats("ns1", "pod2")
+	require.True(t, ok)
+	assert.Equal(t, expected.PodInterfaceStats[1], *stats)
+	_, ok = c.GetPodInterfaceStats("ns2", "pod3")
+	assert.False(t, ok)
+}
@@ -0,0 +1,53 @@
+//go:build windows
+// +build windows
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"github.com/Microsoft/hcsshim"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+var (
+	getHNSEndpointByName = hcsshim.GetHNSEndpointByName
+	getHNSEndpointStats  = hcsshim.GetHNSEndpointStats
+
+	getInterfaceStats = getHNSEndpointInterfaceStats
+)
+
+// getHNSEndpointInterfaceStats reads the counters of the HNS endpoint which backs the provided Pod interface. The
+// name of the HNS endpoint is the same as the name of the interface.
+func getHNSEndpointInterfaceStats(ifaceName string) (*statsv1alpha1.PodInterfaceStats, error) {
+	endpoint, err := getHNSEndpointByName(ifaceName)
+	if err != nil {
+		return nil, err
+	}
+	endpointStats, err := getHNSEndpointStats(endpoint.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &statsv1alpha1.PodInterfaceStats{
+		EndpointID:             endpoint.Id,
+		PacketsReceived:        int64(endpointStats.PacketsReceived),
+		PacketsSent:            int64(endpointStats.PacketsSent),
+		BytesReceived:          int64(endpointStats.BytesReceived),
+		BytesSent:              int64(endpointStats.BytesSent),
+		DroppedPacketsIncoming: int64(endpointStats.DroppedPacketsIncoming),
+		DroppedPacketsOutgoing: int64(endpointStats.DroppedPacketsOutgoing),
+	}, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+const (
+	// Period for reading the connections of the FlowExporter to account the traffic of Namespaces. It must be shorter
+	// than collectPeriod.
+	namespaceTrafficPollPeriod = 5 * time.Second
+)
+
+// ConnectionStore is the store of the connections tracked by the FlowExporter, which is updated periodically from the
+// conntrack table.
+type ConnectionStore interface {
+	ForAllConnectionsDo(callback flowexporter.ConnectionMapCallBack) error
+}
+
+// namespaceTrafficStats is the traffic stats of the local Pods of a Namespace.
+type namespaceTrafficStats struct {
+	ingress statsv1alpha1.TrafficStats
+	egress  statsv1alpha1.TrafficStats
+}
+
+// connectionCounters is the packet and byte counters of a connection, in both directions, when it was last polled.
+type connectionCounters struct {
+	startTime time.Time
+	packets   uint64
+	bytes     uint64
+}
+
+// NamespaceTrafficCollector accounts the traffic of the local Pods by Namespace, by polling the connection store of the
+// FlowExporter, so that the conntrack table is not dumped a second time. A connection is accounted as ingress traffic of
+// the Namespace of its destination Pod, and as egress traffic of the Namespace of its source Pod. The packets and bytes
+// of both directions of a connection are accounted.
+type NamespaceTrafficCollector struct {
+	connStore ConnectionStore
+
+	mutex sync.RWMutex
+	// connections is the counters of the connections seen in the last poll.
+	connections map[flowexporter.ConnectionKey]connectionCounters
+	// namespaceStats is the cumulative traffic stats of each Namespace since the collector was started.
+	namespaceStats map[string]*namespaceTrafficStats
+	// initialized indicates whether the connection store has been polled once. The traffic of the connections existing
+	// at the first poll, before the collector was started, is not accounted.
+	initialized bool
+}
+
+func NewNamespaceTrafficCollector(connStore ConnectionStore) *NamespaceTrafficCollector {
+	return &NamespaceTrafficCollector{
+		connStore:      connStore,
+		connections:    map[flowexporter.ConnectionKey]connectionCounters{},
+		namespaceStats: map[string]*namespaceTrafficStats{},
+	}
+}
+
+// Run polls the connection store periodically until the provided channel is closed.
+func (c *NamespaceTrafficCollector) Run(stopCh <-chan struct{}) {
+	klog.Info("Start collecting Namespace traffic stats")
+	wait.Until(c.poll, namespaceTrafficPollPeriod, stopCh)
+}
+
+func (c *NamespaceTrafficCollector) poll() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	curConnections := make(map[flowexporter.ConnectionKey]connectionCounters, len(c.connections))
+	c.connStore.ForAllConnectionsDo(func(key flowexporter.ConnectionKey, conn *flowexporter.Connection) error {
+		// The connection store only holds the connections of which the source or destination is a local Pod.
+		if conn.SourcePodNamespace == "" && conn.DestinationPodNamespace == "" {
+			return nil
+		}
+		cur := connectionCounters{
+			startTime: conn.StartTime,
+			packets:   conn.OriginalPackets + conn.ReversePackets,
+			bytes:     conn.OriginalBytes + conn.ReverseBytes,
+		}
+		curConnections[key] = cur
+		if !c.initialized {
+			return nil
+		}
+		var sessions int64
+		inc := cur
+		last, exists := c.connections[key]
+		// A different start time, or cur.bytes < last.bytes, means that the connection was closed and a new
+		// connection with the same 5-tuple was created in-between two polls. In this case, cur is the delta to account.
+		if !exists || !cur.startTime.Equal(last.startTime) || cur.bytes < last.bytes {
+			sessions = 1
+		} else {
+			inc.packets = cur.packets - last.packets
+			inc.bytes = cur.bytes - last.bytes
+		}
+		if sessions == 0 && inc.bytes == 0 {
+			return nil
+		}
+		if conn.DestinationPodNamespace != "" {
+			addTrafficUp(&c.getOrCreateStats(conn.DestinationPodNamespace).ingress, sessions, inc)
+		}
+		if conn.SourcePodNamespace != "" {
+			addTrafficUp(&c.getOrCreateStats(conn.SourcePodNamespace).egress, sessions, inc)
+		}
+		return nil
+	})
+	c.connections = curConnections
+	c.initialized = true
+}
+
+func (c *NamespaceTrafficCollector) getOrCreateStats(namespace string) *namespaceTrafficStats {
+	stats, exists := c.namespaceStats[namespace]
+	if !exists {
+		stats = new(namespaceTrafficStats)
+		c.namespaceStats[namespace] = stats
+	}
+	return stats
+}
+
+func addTrafficUp(stats *statsv1alpha1.TrafficStats, sessions int64, inc connectionCounters) {
+	stats.Sessions += sessions
+	stats.Packets += int64(inc.packets)
+	stats.Bytes += int64(inc.bytes)
+}
+
+// getNamespaceTrafficStats returns a copy of the cumulative traffic stats of each Namespace.
+func (c *NamespaceTrafficCollector) getNamespaceTrafficStats() map[string]*namespaceTrafficStats {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	statsMap := make(map[string]*namespaceTrafficStats, len(c.namespaceStats))
+	for namespace, stats := range c.namespaceStats {
+		statsCopy := *stats
+		statsMap[namespace] = &statsCopy
+	}
+	return statsMap
+}
@@ -0,0 +1,176 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"antrea.io/antrea/pkg/agent/flowexporter"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+type fakeConnectionStore struct {
+	connections []*flowexporter.Connection
+}
+
+func (s *fakeConnectionStore) ForAllConnectionsDo(callback flowexporter.ConnectionMapCallBack) error {
+	for _, conn := range s.connections {
+		if err := callback(flowexporter.NewConnectionKey(conn), conn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var testStartTime = time.Now()
+
+func newTestConnection(srcIP, srcNamespace, dstIP, dstNamespace string, srcPort uint16, packets, bytes uint64) *flowexporter.Connection {
+	return &flowexporter.Connection{
+		FlowKey: flowexporter.Tuple{
+			SourceAddress:      netip.MustParseAddr(srcIP),
+			DestinationAddress: netip.MustParseAddr(dstIP),
+			Protocol:           6,
+			SourcePort:         srcPort,
+			DestinationPort:    80,
+		},
+		StartTime:               testStartTime,
+		SourcePodNamespace:      srcNamespace,
+		DestinationPodNamespace: dstNamespace,
+		OriginalPackets:         packets,
+		OriginalBytes:           bytes,
+		ReversePackets:          packets,
+		ReverseBytes:            bytes,
+	}
+}
+
+func TestNamespaceTrafficCollectorPoll(t *testing.T) {
+	connStore := &fakeConnectionStore{}
+	c := NewNamespaceTrafficCollector(connStore)
+
+	// The connections existing at the first poll are only used as the base.
+	connStore.connections = []*flowexporter.Connection{
+		newTestConnection("10.10.0.2", "ns1", "10.10.0.3", "ns2", 30000, 5, 500),
+	}
+	c.poll()
+	assert.Empty(t, c.getNamespaceTrafficStats())
+
+	connStore.connections = []*flowexporter.Connection{
+		// Existing connection from ns1 to ns2.
+		newTestConnection("10.10.0.2", "ns1", "10.10.0.3", "ns2", 30000, 7, 700),
+		// New connection from ns2 to an external IP.
+		newTestConnection("10.10.0.3", "ns2", "8.8.8.8", "", 30001, 1, 100),
+		// New connection from an external IP to ns1.
+		newTestConnection("192.168.1.1", "", "10.10.0.2", "ns1", 30002, 2, 200),
+	}
+	c.poll()
+	assert.Equal(t, map[string]*namespaceTrafficStats{
+		"ns1": {
+			ingress: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 4, Bytes: 400},
+			egress:  statsv1alpha1.TrafficStats{Packets: 4, Bytes: 400},
+		},
+		"ns2": {
+			ingress: statsv1alpha1.TrafficStats{Packets: 4, Bytes: 400},
+			egress:  statsv1alpha1.TrafficStats{Sessions: 1, Packets: 2, Bytes: 200},
+		},
+	}, c.getNamespaceTrafficStats())
+
+	reopenedConn := newTestConnection("10.10.0.2", "ns1", "10.10.0.3", "ns2", 30000, 10, 1000)
+	reopenedConn.StartTime = testStartTime.Add(time.Minute)
+	connStore.connections = []*flowexporter.Connection{
+		// The connection was closed and a new connection with the same 5-tuple was created.
+		reopenedConn,
+		// Unchanged connection.
+		newTestConnection("10.10.0.3", "ns2", "8.8.8.8", "", 30001, 1, 100),
+	}
+	c.poll()
+	assert.Equal(t, map[string]*namespaceTrafficStats{
+		"ns1": {
+			ingress: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 4, Bytes: 400},
+			egress:  statsv1alpha1.TrafficStats{Sessions: 1, Packets: 24, Bytes: 2400},
+		},
+		"ns2": {
+			ingress: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 24, Bytes: 2400},
+			egress:  statsv1alpha1.TrafficStats{Sessions: 1, Packets: 2, Bytes: 200},
+		},
+	}, c.getNamespaceTrafficStats())
+}
+
+func TestCalculateNamespaceDiff(t *testing.T) {
+	tests := []struct {
+		name              string
+		lastStats         map[string]*namespaceTrafficStats
+		curStats          map[string]*namespaceTrafficStats
+		expectedStatsList []cpv1beta.NamespaceTrafficStats
+	}{
+		{
+			name: "new Namespace and existing Namespace",
+			lastStats: map[string]*namespaceTrafficStats{
+				"ns1": {
+					ingress: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 2, Bytes: 100},
+				},
+			},
+			curStats: map[string]*namespaceTrafficStats{
+				"ns1": {
+					ingress: statsv1alpha1.TrafficStats{Sessions: 2, Packets: 5, Bytes: 300},
+					egress:  statsv1alpha1.TrafficStats{Sessions: 1, Packets: 1, Bytes: 60},
+				},
+				"ns2": {
+					egress: statsv1alpha1.TrafficStats{Sessions: 3, Packets: 6, Bytes: 600},
+				},
+			},
+			expectedStatsList: []cpv1beta.NamespaceTrafficStats{
+				{
+					Namespace: "ns1",
+					Ingress:   statsv1alpha1.TrafficStats{Sessions: 1, Packets: 3, Bytes: 200},
+					Egress:    statsv1alpha1.TrafficStats{Sessions: 1, Packets: 1, Bytes: 60},
+				},
+				{
+					Namespace: "ns2",
+					Egress:    statsv1alpha1.TrafficStats{Sessions: 3, Packets: 6, Bytes: 600},
+				},
+			},
+		},
+		{
+			name: "unchanged Namespace",
+			lastStats: map[string]*namespaceTrafficStats{
+				"ns1": {
+					ingress: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 2, Bytes: 100},
+				},
+			},
+			curStats: map[string]*namespaceTrafficStats{
+				"ns1": {
+					ingress: statsv1alpha1.TrafficStats{Sessions: 1, Packets: 2, Bytes: 100},
+				},
+			},
+			expectedStatsList: []cpv1beta.NamespaceTrafficStats{},
+		},
+		{
+			name:      "no Namespace",
+			lastStats: map[string]*namespaceTrafficStats{},
+			curStats:  map[string]*namespaceTrafficStats{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualStatsList := calculateNamespaceDiff(tt.curStats, tt.lastStats)
+			assert.ElementsMatch(t, tt.expectedStatsList, actualStatsList)
+		})
+	}
+}
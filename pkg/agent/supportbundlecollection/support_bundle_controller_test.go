@@ -170,6 +170,13 @@ func TestSupportBundleCollectionAdd(t *testing.T) {
 			uploader:                &testUploader{},
 			expectedSyncErr:         "failed to generate support bundle: failed to dump goroutine Pprof",
 		},
+		{
+			name:                    "SupportBundleCollection failed to dump captured profiles",
+			supportBundleCollection: generateSupportbundleCollection("supportBundle16", "sftp://10.220.175.92:22/root/supportbundle", nil),
+			agentDumper:             &mockAgentDumper{dumpCapturedProfilesErr: fmt.Errorf("failed to dump captured profiles")},
+			uploader:                &testUploader{},
+			expectedSyncErr:         "failed to generate support bundle: failed to dump captured profiles",
+		},
 		{
 			name:                    "SupportBundleCollection failed to dump groups",
 			supportBundleCollection: generateSupportbundleCollection("supportBundle13", "sftp://10.220.175.92:22/root/supportbundle", nil),
@@ -294,6 +301,7 @@ type mockAgentDumper struct {
 	dumpNetworkPolicyResourcesErr error
 	dumpHeapPprofErr              error
 	dumpGoroutinePprofErr         error
+	dumpCapturedProfilesErr       error
 	dumpOVSPortsErr               error
 	dumpMemberlistErr             error
 }
@@ -330,6 +338,10 @@ func (d *mockAgentDumper) DumpGoroutinePprof(basedir string) error {
 	return d.dumpGoroutinePprofErr
 }
 
+func (d *mockAgentDumper) DumpCapturedProfiles(basedir string) error {
+	return d.dumpCapturedProfilesErr
+}
+
 func (d *mockAgentDumper) DumpOVSPorts(basedir string) error {
 	return d.dumpOVSPortsErr
 }
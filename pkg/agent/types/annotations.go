@@ -21,6 +21,15 @@ const (
 	// NodeTransportAddressAnnotationKey represents the key of the interface's IP addresses on which the Node transfers Pod traffic in the Annotations of the Node.
 	NodeTransportAddressAnnotationKey string = "node.antrea.io/transport-addresses"
 
+	// NodeTunnelAddressAnnotationKey represents the key of the IP addresses which should be used as the tunnel
+	// destination of the Node in the Annotations of the Node. Unlike NodeTransportAddressAnnotationKey, it is set by
+	// users rather than antrea-agent.
+	NodeTunnelAddressAnnotationKey string = "node.antrea.io/tunnel-address"
+
+	// NodeMultipathAddressAnnotationKey represents the key of the IP addresses of the additional uplinks through which
+	// the Node can be reached in noEncap mode in the Annotations of the Node. It is set by antrea-agent.
+	NodeMultipathAddressAnnotationKey string = "node.antrea.io/multipath-addresses"
+
 	// NodeWireGuardPublicAnnotationKey represents the key of the Node's WireGuard public key in the Annotations of the Node.
 	NodeWireGuardPublicAnnotationKey string = "node.antrea.io/wireguard-public-key"
 
@@ -30,6 +39,23 @@ const (
 	// NodeBGPRouterIDAnnotationKey represents the key of the Node's BGP router ID in the Annotations of the Node.
 	NodeBGPRouterIDAnnotationKey string = "node.antrea.io/bgp-router-id"
 
+	// NodeExternalTrafficSNATIPPoolAnnotationKey represents the key of the ExternalIPPool from which the external traffic
+	// SNAT IP of the Node should be allocated in the Annotations of the Node. It is set by antrea-agent.
+	NodeExternalTrafficSNATIPPoolAnnotationKey string = "node.antrea.io/external-traffic-snat-ip-pool"
+
+	// NodeExternalTrafficSNATIPAnnotationKey represents the key of the Node's external traffic SNAT IP in the
+	// Annotations of the Node. It is set by antrea-controller after allocating the IP from the ExternalIPPool.
+	NodeExternalTrafficSNATIPAnnotationKey string = "node.antrea.io/external-traffic-snat-ip"
+
+	// NamespaceTunnelVNIAnnotationKey is the key of the Namespace annotation that specifies the tunnel ID (the VNI of
+	// Geneve and VXLAN) of the tunneled traffic of the Pods in the Namespace.
+	NamespaceTunnelVNIAnnotationKey string = "namespace.antrea.io/tunnel-vni"
+
+	// PodDNSResolversAnnotationKey is the key of the Pod annotation that specifies the resolvers to which the Pod is
+	// allowed to send DNS queries, as a comma-separated list of IPs in which "cluster" stands for the cluster DNS
+	// resolvers. The DNS queries sent to other resolvers are dropped.
+	PodDNSResolversAnnotationKey string = "pod.antrea.io/dns-resolvers"
+
 	// ServiceExternalIPPoolAnnotationKey is the key of the Service annotation that specifies the Service's desired external IP pool.
 	ServiceExternalIPPoolAnnotationKey string = "service.antrea.io/external-ip-pool"
 
@@ -39,6 +65,18 @@ const (
 	// ServiceLoadBalancerModeAnnotationKey is the key of the Service annotation that specifies the Service's load balancer mode.
 	ServiceLoadBalancerModeAnnotationKey string = "service.antrea.io/load-balancer-mode"
 
+	// ServiceHairpinModeAnnotationKey is the key of the Service annotation that specifies how the Service's hairpin connections are handled.
+	ServiceHairpinModeAnnotationKey string = "service.antrea.io/hairpin-mode"
+
+	// ServiceConnectionRateLimitAnnotationKey is the key of the Service annotation that specifies the maximum rate of new connections per second to the Service on each Node.
+	ServiceConnectionRateLimitAnnotationKey string = "service.antrea.io/connection-rate-limit"
+
+	// ServiceMaxConnectionsAnnotationKey is the key of the Service annotation that specifies the maximum number of concurrent connections to the Service on each Node.
+	ServiceMaxConnectionsAnnotationKey string = "service.antrea.io/max-connections"
+
+	// ServiceProbeAnnotationKey is the key of the Service annotation that specifies whether the Service is probed by the service reachability prober of antrea-agent.
+	ServiceProbeAnnotationKey string = "service.antrea.io/probe"
+
 	// L7FlowExporterAnnotationKey is the key of the L7 network flow export annotation that enables L7 network flow export for annotated Pod or Namespace based on the value of annotation which is direction of traffic.
 	L7FlowExporterAnnotationKey string = "visibility.antrea.io/l7-export"
 )
@@ -0,0 +1,46 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// DatapathEntryType is the type of a datapath entry compared between the desired and the realized state.
+type DatapathEntryType string
+
+const (
+	DatapathEntryFlow  DatapathEntryType = "Flow"
+	DatapathEntryGroup DatapathEntryType = "Group"
+	DatapathEntryRoute DatapathEntryType = "Route"
+)
+
+// DatapathDiffState describes how a realized datapath entry differs from the desired one.
+type DatapathDiffState string
+
+const (
+	// DatapathDiffMissing means that the entry is expected by the agent but absent from the datapath.
+	DatapathDiffMissing DatapathDiffState = "Missing"
+	// DatapathDiffUnexpected means that the entry is present in the datapath but not expected by the agent.
+	DatapathDiffUnexpected DatapathDiffState = "Unexpected"
+	// DatapathDiffModified means that the entry is present in the datapath but with a different content.
+	DatapathDiffModified DatapathDiffState = "Modified"
+)
+
+// DatapathEntryDiff is a discrepancy between the desired and the realized state of a datapath entry.
+type DatapathEntryDiff struct {
+	Type  DatapathEntryType
+	State DatapathDiffState
+	// Desired is the entry expected by the agent. It is empty when State is DatapathDiffUnexpected.
+	Desired string
+	// Actual is the entry present in the datapath. It is empty when State is DatapathDiffMissing.
+	Actual string
+}
@@ -18,11 +18,16 @@ const (
 	// HostLocalSourceBit is the bit of the iptables fwmark space to mark locally generated packets.
 	// Value must be within the range [0, 31], and should not conflict with bits for other purposes.
 	HostLocalSourceBit = 31
+	// HostNetworkPodTrafficBit is the bit of the iptables fwmark space to mark ingress packets destined to the ports
+	// of hostNetwork Pods, so that Node NetworkPolicy rules can tell them apart from packets destined to the Node OS.
+	HostNetworkPodTrafficBit = 30
 )
 
 var (
 	// HostLocalSourceMark is the mark generated from HostLocalSourceBit.
 	HostLocalSourceMark = uint32(1 << HostLocalSourceBit)
+	// HostNetworkPodTrafficMark is the mark generated from HostNetworkPodTrafficBit.
+	HostNetworkPodTrafficMark = uint32(1 << HostNetworkPodTrafficBit)
 
 	// SNATIPMarkMask is the bits of packet mark that stores the ID of the
 	// SNAT IP for a "Pod -> external" egress packet, that is to be SNAT'd.
@@ -29,6 +29,8 @@ type DnsCacheEntry struct {
 	FQDNName       string
 	IPAddress      net.IP
 	ExpirationTime time.Time
+	// Static indicates that the IP has been configured statically for the FQDN, in which case it never expires.
+	Static bool
 }
 
 type MatchKey struct {
@@ -94,7 +96,9 @@ type NodePolicyRule struct {
 	ServiceIPTRules []string
 	CoreIPTChain    string
 	CoreIPTRules    []string
-	IsIPv6          bool
+	// HostPortIPTRules are the iptables rules installed in the chain for hostPort traffic, if the rule applies to it.
+	HostPortIPTRules []string
+	IsIPv6           bool
 }
 
 // PolicyRule groups configurations to set up conjunctive match for egress/ingress policy rules.
@@ -117,7 +121,7 @@ type PolicyRule struct {
 
 // IsAntreaNetworkPolicyRule returns if a PolicyRule is created for Antrea NetworkPolicy types.
 func (r *PolicyRule) IsAntreaNetworkPolicyRule() bool {
-	return r.PolicyRef.Type != v1beta2.K8sNetworkPolicy
+	return !v1beta2.HasK8sNetworkPolicySemantics(r.PolicyRef)
 }
 
 // Priority is a struct that is composed of Antrea NetworkPolicy priority, rule priority and Tier priority.
@@ -173,6 +177,13 @@ func (m *RuleMetric) Merge(m1 *RuleMetric) {
 	m.Sessions += m1.Sessions
 }
 
+// RuleHitStats is the traffic metric of a NetworkPolicy rule along with the last time the rule was hit.
+type RuleHitStats struct {
+	RuleMetric
+	// LastHitTime is zero if no packet has matched the rule.
+	LastHitTime time.Time
+}
+
 // A BitRange is a representation of a range of values from base value with a
 // bitmask applied.
 type BitRange struct {
@@ -37,6 +37,12 @@ type ServiceConfig struct {
 	IsNested bool
 	// IsDSR indicates that whether the Service works in Direct Server Return mode.
 	IsDSR bool
+	// PreserveHairpinClientIP indicates that whether the client IP of the Service's hairpin connections initiated
+	// through the Antrea gateway is preserved, instead of being SNAT'd with a virtual IP.
+	PreserveHairpinClientIP bool
+	// ConnectionRateMeterID is the ID of the OpenFlow meter used to limit the rate of new connections to the Service.
+	// 0 means the rate of new connections is not limited.
+	ConnectionRateMeterID uint32
 }
 
 func (c *ServiceConfig) TrafficPolicyGroupID() openflow.GroupIDType {
@@ -180,6 +180,35 @@ func (b *iptablesRuleBuilder) MatchEstablishedOrRelated() IPTablesRuleBuilder {
 	return b
 }
 
+func (b *iptablesRuleBuilder) MatchCTState(states ...string) IPTablesRuleBuilder {
+	if len(states) == 0 {
+		return b
+	}
+	matchStr := fmt.Sprintf("-m conntrack --ctstate %s", strings.Join(states, ","))
+	b.writeSpec(matchStr)
+	return b
+}
+
+func (b *iptablesRuleBuilder) MatchCTOrigDstPort(port *intstr.IntOrString, endPort *int32) IPTablesRuleBuilder {
+	if port == nil {
+		return b
+	}
+	var matchStr string
+	if endPort != nil {
+		matchStr = fmt.Sprintf("-m conntrack --ctorigdstport %s:%d", port.String(), *endPort)
+	} else {
+		matchStr = fmt.Sprintf("-m conntrack --ctorigdstport %s", port.String())
+	}
+	b.writeSpec(matchStr)
+	return b
+}
+
+func (b *iptablesRuleBuilder) MatchMark(mark, mask uint32) IPTablesRuleBuilder {
+	matchStr := fmt.Sprintf("-m mark --mark %#x/%#x", mark, mask)
+	b.writeSpec(matchStr)
+	return b
+}
+
 func (b *iptablesRuleBuilder) MatchInputInterface(interfaceName string) IPTablesRuleBuilder {
 	if interfaceName == "" {
 		return b
@@ -222,6 +251,12 @@ func (b *iptablesRuleBuilder) SetTargetDNATToDst(dnatIP string, dnatPort *int32)
 	return b
 }
 
+func (b *iptablesRuleBuilder) SetTargetOrMark(mark uint32) IPTablesRuleBuilder {
+	specStr := fmt.Sprintf("--or-mark %#x", mark)
+	b.writeSpec(specStr)
+	return b
+}
+
 func (b *iptablesRuleBuilder) SetComment(comment string) IPTablesRuleBuilder {
 	if comment == "" {
 		return b
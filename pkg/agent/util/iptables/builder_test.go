@@ -151,6 +151,42 @@ func TestBuilders(t *testing.T) {
 			},
 			expected: `-A PREROUTING -s 192.168.77.100 -d 10.96.0.10 -p tcp --dport 8080 -j DNAT --to-destination 10.10.0.2:40000`,
 		},
+		{
+			name:  "Mark TCP destination 8080 in INPUT",
+			chain: InputChain,
+			buildFunc: func(builder IPTablesRuleBuilder) IPTablesRule {
+				return builder.MatchTransProtocol(ProtocolTCP).
+					MatchPortDst(port8080, nil).
+					SetComment("Mark TCP 8080").
+					SetTarget(MarkTarget).
+					SetTargetOrMark(0x40000000).
+					Done()
+			},
+			expected: `-A INPUT -p tcp --dport 8080 -m comment --comment "Mark TCP 8080" -j MARK --or-mark 0x40000000`,
+		},
+		{
+			name:  "Accept marked packets in INPUT",
+			chain: InputChain,
+			buildFunc: func(builder IPTablesRuleBuilder) IPTablesRule {
+				return builder.MatchMark(0, 0x40000000).
+					SetTarget(AcceptTarget).
+					Done()
+			},
+			expected: `-A INPUT -m mark --mark 0x0/0x40000000 -j ACCEPT`,
+		},
+		{
+			name:  "Drop new DNAT'd packets with original destination 137-139 in FORWARD",
+			chain: ForwardChain,
+			buildFunc: func(builder IPTablesRuleBuilder) IPTablesRule {
+				return builder.MatchCTState("NEW").
+					MatchCTState("DNAT").
+					MatchTransProtocol(ProtocolUDP).
+					MatchCTOrigDstPort(port137, &port139).
+					SetTarget(DropTarget).
+					Done()
+			},
+			expected: `-A FORWARD -m conntrack --ctstate NEW -m conntrack --ctstate DNAT -p udp -m conntrack --ctorigdstport 137:139 -j DROP`,
+		},
 	}
 
 	for _, tc := range testCases {
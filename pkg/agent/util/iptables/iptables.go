@@ -132,11 +132,15 @@ type IPTablesRuleBuilder interface {
 	MatchPortSrc(port, endPort *int32) IPTablesRuleBuilder
 	MatchICMP(icmpType, icmpCode *int32, ipProtocol Protocol) IPTablesRuleBuilder
 	MatchEstablishedOrRelated() IPTablesRuleBuilder
+	MatchCTState(states ...string) IPTablesRuleBuilder
+	MatchCTOrigDstPort(port *intstr.IntOrString, endPort *int32) IPTablesRuleBuilder
+	MatchMark(mark, mask uint32) IPTablesRuleBuilder
 	MatchInputInterface(interfaceName string) IPTablesRuleBuilder
 	MatchOutputInterface(interfaceName string) IPTablesRuleBuilder
 	SetLogPrefix(prefix string) IPTablesRuleBuilder
 	SetTarget(target string) IPTablesRuleBuilder
 	SetTargetDNATToDst(dnatIP string, dnatPort *int32) IPTablesRuleBuilder
+	SetTargetOrMark(mark uint32) IPTablesRuleBuilder
 	SetComment(comment string) IPTablesRuleBuilder
 	CopyBuilder() IPTablesRuleBuilder
 	Done() IPTablesRule
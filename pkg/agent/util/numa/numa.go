@@ -0,0 +1,83 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package numa provides helpers to discover the NUMA topology of the Node and
+// to manage the CPU affinity of OS threads.
+package numa
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ParseCPUList parses a CPU list in the Linux kernel format (e.g. "0-3,8,10-11")
+// and returns the sorted list of CPU IDs without duplicates.
+func ParseCPUList(cpuList string) ([]int, error) {
+	cpuList = strings.TrimSpace(cpuList)
+	if cpuList == "" {
+		return nil, nil
+	}
+	cpuSet := map[int]struct{}{}
+	for _, part := range strings.Split(cpuList, ",") {
+		part = strings.TrimSpace(part)
+		first, last, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(first)
+		if err != nil || start < 0 {
+			return nil, fmt.Errorf("invalid CPU list %q: invalid CPU %q", cpuList, first)
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(last)
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid CPU list %q: invalid CPU range %q", cpuList, part)
+			}
+		}
+		for cpu := start; cpu <= end; cpu++ {
+			cpuSet[cpu] = struct{}{}
+		}
+	}
+	cpus := make([]int, 0, len(cpuSet))
+	for cpu := range cpuSet {
+		cpus = append(cpus, cpu)
+	}
+	sort.Ints(cpus)
+	return cpus, nil
+}
+
+// FormatCPUMask returns the hexadecimal CPU mask of the provided CPUs, in the
+// format expected by OVS for other_config:pmd-cpu-mask (e.g. "0xf" for CPUs 0-3).
+func FormatCPUMask(cpus []int) string {
+	mask := new(big.Int)
+	for _, cpu := range cpus {
+		mask.SetBit(mask, cpu, 1)
+	}
+	return "0x" + mask.Text(16)
+}
+
+// IsSubset returns true if all CPUs in cpus are also in allowedCPUs.
+func IsSubset(cpus, allowedCPUs []int) bool {
+	allowed := make(map[int]struct{}, len(allowedCPUs))
+	for _, cpu := range allowedCPUs {
+		allowed[cpu] = struct{}{}
+	}
+	for _, cpu := range cpus {
+		if _, ok := allowed[cpu]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package numa
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+var sysfsPath = "/sys"
+
+// InterfaceNUMANode returns the NUMA node of the device backing the provided
+// network interface. -1 is returned if the device is not attached to a specific
+// NUMA node (e.g. virtual devices or single-node systems).
+func InterfaceNUMANode(ifName string) (int, error) {
+	data, err := os.ReadFile(filepath.Join(sysfsPath, "class/net", ifName, "device/numa_node"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return -1, nil
+		}
+		return -1, fmt.Errorf("failed to read NUMA node of interface %s: %w", ifName, err)
+	}
+	node, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return -1, fmt.Errorf("invalid NUMA node of interface %s: %w", ifName, err)
+	}
+	return node, nil
+}
+
+// NodeCPUs returns the CPUs which belong to the provided NUMA node.
+func NodeCPUs(node int) ([]int, error) {
+	data, err := os.ReadFile(filepath.Join(sysfsPath, "devices/system/node", fmt.Sprintf("node%d", node), "cpulist"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CPUs of NUMA node %d: %w", node, err)
+	}
+	return ParseCPUList(string(data))
+}
+
+// SetCurrentThreadAffinity sets the CPU affinity of the calling OS thread.
+// Callers are expected to lock the goroutine to the thread with
+// runtime.LockOSThread first.
+func SetCurrentThreadAffinity(cpus []int) error {
+	var cpuSet unix.CPUSet
+	for _, cpu := range cpus {
+		cpuSet.Set(cpu)
+	}
+	return unix.SchedSetaffinity(0, &cpuSet)
+}
+
+// CurrentThreadCPUs returns the CPUs the calling OS thread is allowed to run on.
+func CurrentThreadCPUs() ([]int, error) {
+	var cpuSet unix.CPUSet
+	if err := unix.SchedGetaffinity(0, &cpuSet); err != nil {
+		return nil, err
+	}
+	var cpus []int
+	for cpu := 0; cpu < len(cpuSet)*64 && len(cpus) < cpuSet.Count(); cpu++ {
+		if cpuSet.IsSet(cpu) {
+			cpus = append(cpus, cpu)
+		}
+	}
+	return cpus, nil
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package numa
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInterfaceNUMANodeCPUs(t *testing.T) {
+	dir := t.TempDir()
+	defer func(orig string) { sysfsPath = orig }(sysfsPath)
+	sysfsPath = dir
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "class/net/eth0/device"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "class/net/eth0/device/numa_node"), []byte("1\n"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "devices/system/node/node1"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "devices/system/node/node1/cpulist"), []byte("4-7\n"), 0644))
+
+	node, err := InterfaceNUMANode("eth0")
+	require.NoError(t, err)
+	assert.Equal(t, 1, node)
+	cpus, err := NodeCPUs(node)
+	require.NoError(t, err)
+	assert.Equal(t, []int{4, 5, 6, 7}, cpus)
+
+	// Virtual devices are not attached to a NUMA node.
+	node, err = InterfaceNUMANode("antrea-gw0")
+	require.NoError(t, err)
+	assert.Equal(t, -1, node)
+
+	_, err = NodeCPUs(0)
+	assert.Error(t, err)
+}
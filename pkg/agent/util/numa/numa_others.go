@@ -0,0 +1,40 @@
+//go:build !linux
+// +build !linux
+
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package numa
+
+import (
+	"errors"
+)
+
+var errNotSupported = errors.New("NUMA-aware CPU affinity is not supported on this platform")
+
+func InterfaceNUMANode(ifName string) (int, error) {
+	return -1, errNotSupported
+}
+
+func NodeCPUs(node int) ([]int, error) {
+	return nil, errNotSupported
+}
+
+func SetCurrentThreadAffinity(cpus []int) error {
+	return errNotSupported
+}
+
+func CurrentThreadCPUs() ([]int, error) {
+	return nil, errNotSupported
+}
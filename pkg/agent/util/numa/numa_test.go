@@ -0,0 +1,77 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package numa
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCPUList(t *testing.T) {
+	tests := []struct {
+		name         string
+		cpuList      string
+		expectedCPUs []int
+		expectedErr  string
+	}{
+		{
+			name:    "empty",
+			cpuList: "",
+		},
+		{
+			name:         "single CPU",
+			cpuList:      "3\n",
+			expectedCPUs: []int{3},
+		},
+		{
+			name:         "ranges and CPUs",
+			cpuList:      "8-9,0-2,4,2",
+			expectedCPUs: []int{0, 1, 2, 4, 8, 9},
+		},
+		{
+			name:        "invalid CPU",
+			cpuList:     "0,a",
+			expectedErr: "invalid CPU \"a\"",
+		},
+		{
+			name:        "invalid range",
+			cpuList:     "4-2",
+			expectedErr: "invalid CPU range \"4-2\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cpus, err := ParseCPUList(tt.cpuList)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCPUs, cpus)
+			}
+		})
+	}
+}
+
+func TestFormatCPUMask(t *testing.T) {
+	assert.Equal(t, "0x0", FormatCPUMask(nil))
+	assert.Equal(t, "0xf", FormatCPUMask([]int{0, 1, 2, 3}))
+	assert.Equal(t, "0x10000000000000001", FormatCPUMask([]int{0, 64}))
+}
+
+func TestIsSubset(t *testing.T) {
+	assert.True(t, IsSubset([]int{1, 2}, []int{0, 1, 2, 3}))
+	assert.False(t, IsSubset([]int{1, 4}, []int{0, 1, 2, 3}))
+}
@@ -30,6 +30,8 @@ type Interface interface {
 
 	ReplaceNetNeighbor(neighbor *Neighbor) error
 
+	GetNetNeighbor(neighbor *Neighbor) ([]Neighbor, error)
+
 	AddNetNat(netNatName string, subnetCIDR *net.IPNet) error
 
 	AddNetNatStaticMapping(mapping *NetNatStaticMapping) error
@@ -50,6 +52,10 @@ type Interface interface {
 
 	RemoveNetAdapterIPAddress(adapterName string, ipAddr net.IP) error
 
+	AddNetAdapterSkipAsSourceIPAddress(adapterName string, ipConfig *net.IPNet) error
+
+	GetNetAdapterSkipAsSourceIPAddresses(adapterName string) ([]net.IP, error)
+
 	RenameNetAdapter(oriName string, newName string) error
 
 	SetNetAdapterMTU(adapterName string, mtu int) error
@@ -137,6 +137,38 @@ func (h *Handle) AddNetAdapterIPAddress(adapterName string, ipConfig *net.IPNet,
 	return nil
 }
 
+// AddNetAdapterSkipAsSourceIPAddress adds the specified IP address to the specified network adapter with SkipAsSource
+// set, so that the host never selects it as the source address of its own traffic.
+func (h *Handle) AddNetAdapterSkipAsSourceIPAddress(adapterName string, ipConfig *net.IPNet) error {
+	prefixLength, _ := ipConfig.Mask.Size()
+	cmd := fmt.Sprintf(`New-NetIPAddress -InterfaceAlias "%s" -IPAddress %s -PrefixLength %d -SkipAsSource $true`, adapterName, ipConfig.IP.String(), prefixLength)
+	_, err := runCommand(cmd)
+	// If the address already exists, ignore the error.
+	if err != nil && !strings.Contains(err.Error(), "already exists") {
+		return err
+	}
+	return nil
+}
+
+// GetNetAdapterSkipAsSourceIPAddresses returns the IP addresses which are configured on the specified network adapter
+// with SkipAsSource set.
+func (h *Handle) GetNetAdapterSkipAsSourceIPAddresses(adapterName string) ([]net.IP, error) {
+	cmd := fmt.Sprintf(`Get-NetIPAddress -InterfaceAlias "%s" -SkipAsSource $true | Format-Table -Property IPAddress -HideTableHeaders`, adapterName)
+	out, err := runCommand(cmd)
+	if err != nil && !strings.Contains(err.Error(), "No matching MSFT_NetIPAddress objects") {
+		return nil, err
+	}
+	var ips []net.IP
+	for _, items := range parseCmdResult(out, 1) {
+		ip := net.ParseIP(items[0])
+		if ip == nil {
+			return nil, fmt.Errorf("failed to parse the IPAddress '%s'", items[0])
+		}
+		ips = append(ips, ip)
+	}
+	return ips, nil
+}
+
 // RemoveNetAdapterIPAddress removes the specified IP address from the specified network adapter.
 func (h *Handle) RemoveNetAdapterIPAddress(adapterName string, ipAddr net.IP) error {
 	cmd := fmt.Sprintf(`Remove-NetIPAddress -InterfaceAlias "%s" -IPAddress %s -Confirm:$false`, adapterName, ipAddr.String())
@@ -544,6 +576,11 @@ func removeNetNeighbor(neighbor *Neighbor) error {
 	return err
 }
 
+// GetNetNeighbor returns the neighbor cache entries matching the LinkIndex and IPAddress of the provided neighbor.
+func (h *Handle) GetNetNeighbor(neighbor *Neighbor) ([]Neighbor, error) {
+	return getNetNeighbor(neighbor)
+}
+
 func (h *Handle) ReplaceNetNeighbor(neighbor *Neighbor) error {
 	neighbors, err := getNetNeighbor(neighbor)
 	if err != nil {
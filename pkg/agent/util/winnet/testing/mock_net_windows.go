@@ -70,6 +70,20 @@ func (mr *MockInterfaceMockRecorder) AddNetAdapterIPAddress(adapterName, ipConfi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetAdapterIPAddress", reflect.TypeOf((*MockInterface)(nil).AddNetAdapterIPAddress), adapterName, ipConfig, gateway)
 }
 
+// AddNetAdapterSkipAsSourceIPAddress mocks base method.
+func (m *MockInterface) AddNetAdapterSkipAsSourceIPAddress(adapterName string, ipConfig *net.IPNet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetAdapterSkipAsSourceIPAddress", adapterName, ipConfig)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNetAdapterSkipAsSourceIPAddress indicates an expected call of AddNetAdapterSkipAsSourceIPAddress.
+func (mr *MockInterfaceMockRecorder) AddNetAdapterSkipAsSourceIPAddress(adapterName, ipConfig any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetAdapterSkipAsSourceIPAddress", reflect.TypeOf((*MockInterface)(nil).AddNetAdapterSkipAsSourceIPAddress), adapterName, ipConfig)
+}
+
 // AddNetNat mocks base method.
 func (m *MockInterface) AddNetNat(netNatName string, subnetCIDR *net.IPNet) error {
 	m.ctrl.T.Helper()
@@ -197,6 +211,36 @@ func (mr *MockInterfaceMockRecorder) GetDNServersByNetAdapterIndex(adapterIndex
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDNServersByNetAdapterIndex", reflect.TypeOf((*MockInterface)(nil).GetDNServersByNetAdapterIndex), adapterIndex)
 }
 
+// GetNetAdapterSkipAsSourceIPAddresses mocks base method.
+func (m *MockInterface) GetNetAdapterSkipAsSourceIPAddresses(adapterName string) ([]net.IP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetAdapterSkipAsSourceIPAddresses", adapterName)
+	ret0, _ := ret[0].([]net.IP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetAdapterSkipAsSourceIPAddresses indicates an expected call of GetNetAdapterSkipAsSourceIPAddresses.
+func (mr *MockInterfaceMockRecorder) GetNetAdapterSkipAsSourceIPAddresses(adapterName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetAdapterSkipAsSourceIPAddresses", reflect.TypeOf((*MockInterface)(nil).GetNetAdapterSkipAsSourceIPAddresses), adapterName)
+}
+
+// GetNetNeighbor mocks base method.
+func (m *MockInterface) GetNetNeighbor(neighbor *winnet.Neighbor) ([]winnet.Neighbor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetNeighbor", neighbor)
+	ret0, _ := ret[0].([]winnet.Neighbor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetNeighbor indicates an expected call of GetNetNeighbor.
+func (mr *MockInterfaceMockRecorder) GetNetNeighbor(neighbor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetNeighbor", reflect.TypeOf((*MockInterface)(nil).GetNetNeighbor), neighbor)
+}
+
 // GetVMSwitchNetAdapterName mocks base method.
 func (m *MockInterface) GetVMSwitchNetAdapterName(vmSwitch string) (string, error) {
 	m.ctrl.T.Helper()
@@ -20,11 +20,15 @@ import (
 	agentapis "antrea.io/antrea/pkg/agent/apis"
 	fallbackversion "antrea.io/antrea/pkg/antctl/fallback/version"
 	checkcluster "antrea.io/antrea/pkg/antctl/raw/check/cluster"
+	checkconnectivity "antrea.io/antrea/pkg/antctl/raw/check/connectivity"
 	checkinstallation "antrea.io/antrea/pkg/antctl/raw/check/installation"
+	rawconfig "antrea.io/antrea/pkg/antctl/raw/config"
+	"antrea.io/antrea/pkg/antctl/raw/effectiveconfig"
 	"antrea.io/antrea/pkg/antctl/raw/featuregates"
 	"antrea.io/antrea/pkg/antctl/raw/multicluster"
 	"antrea.io/antrea/pkg/antctl/raw/packetcapture"
 	"antrea.io/antrea/pkg/antctl/raw/proxy"
+	"antrea.io/antrea/pkg/antctl/raw/rollout"
 	"antrea.io/antrea/pkg/antctl/raw/set"
 	"antrea.io/antrea/pkg/antctl/raw/supportbundle"
 	"antrea.io/antrea/pkg/antctl/raw/traceflow"
@@ -110,6 +114,31 @@ $ antctl get podmulticaststats pod -n namespace`,
 
 			transformedResponse: reflect.TypeOf(agentapis.MulticastResponse{}),
 		},
+		{
+			use:     "multicastgroups",
+			aliases: []string{"multicastgroup", "mcg"},
+			short:   "Show multicast group statistics",
+			long:    "Show the receivers, senders and traffic statistics of multicast groups on the local Node",
+			example: `  Show statistics of all multicast groups on the Node
+  $ antctl get multicastgroups
+  Show statistics of a given multicast group
+  $ antctl get multicastgroups 225.1.2.3`,
+			commandGroup: get,
+			agentEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path:       "/multicastgroups",
+					outputType: multiple,
+					params: []flagInfo{
+						{
+							name:  "name",
+							usage: "Retrieve the statistics of a multicast group by its IP.",
+							arg:   true,
+						},
+					},
+				},
+			},
+			transformedResponse: reflect.TypeOf(agentapis.MulticastGroupResponse{}),
+		},
 		{
 			use:   "log-level",
 			short: "Show or set log verbosity level",
@@ -182,7 +211,11 @@ $ antctl get podmulticaststats pod -n namespace`,
   Get the list of control plane NetworkPolicies with a specific source Type (supported by agent only)
   $ antctl get networkpolicy -T acnp
   Get the list of control plane NetworkPolicies applied to a Pod (supported by agent only)
-  $ antctl get networkpolicy -p ns1/pod1`,
+  $ antctl get networkpolicy -p ns1/pod1
+  Get the rules applied to a Pod, in the order in which they are evaluated (supported by agent only)
+  $ antctl get networkpolicy -p ns1/pod1 --effective
+  Get the rules applied to a Pod, and flag the rules which determine the action for TCP port 80 (supported by agent only)
+  $ antctl get networkpolicy -p ns1/pod1 --effective --port TCP/80`,
 			commandGroup: get,
 			controllerEndpoint: &endpoint{
 				resourceEndpoint: &resourceEndpoint{
@@ -217,10 +250,19 @@ $ antctl get podmulticaststats pod -n namespace`,
 						},
 						{
 							name:            "type",
-							usage:           "Get NetworkPolicies with specific type. Type refers to the type of its source NetworkPolicy: K8sNP, ACNP, ANNP, BANP or ANP",
+							usage:           "Get NetworkPolicies with specific type. Type refers to the type of its source NetworkPolicy: K8sNP, ACNP, ANNP, BANP, ANP or NSP",
 							shorthand:       "T",
 							supportedValues: []string{"K8sNP", "ACNP", "ANNP", "BANP", "ANP"},
 						},
+						{
+							name:   "effective",
+							usage:  "Print the rules applied to the Pod, including the implicit ones, in the order in which they are evaluated for each direction. Requires the pod option.",
+							isBool: true,
+						},
+						{
+							name:  "port",
+							usage: "Flag the rules which determine the action for the traffic to the provided port, when used with the effective option. Port format is [protocol/]port, the protocol defaults to TCP. The peers of the traffic are not known: rules whose peers are restricted are flagged as peer-dependent.",
+						},
 					}, getSortByFlag()),
 					outputType: multiple,
 				},
@@ -379,7 +421,9 @@ $ antctl get podmulticaststats pod -n namespace`,
   Dump OVS groups
   $ antctl get ovsflows -G 10,20
   Dump all OVS groups
-  $ antctl get ovsflows -G all`,
+  $ antctl get ovsflows -G all
+  Dump OVS flows of a flow Table, annotated with the Pods, NetworkPolicy rules and Services they refer to
+  $ antctl get ovsflows -T IngressRule --decode`,
 			agentEndpoint: &endpoint{
 				nonResourceEndpoint: &nonResourceEndpoint{
 					path: "/ovsflows",
@@ -406,8 +450,8 @@ $ antctl get podmulticaststats pod -n namespace`,
 						},
 						{
 							name:            "type",
-							usage:           "NetworkPolicy type. Valid types are K8sNP, ACNP, ANNP, BANP or ANP.",
-							supportedValues: []string{"K8sNP", "ACNP", "ANNP", "BANP", "ANP"},
+							usage:           "NetworkPolicy type. Valid types are K8sNP, ACNP, ANNP, BANP, ANP or NSP.",
+							supportedValues: []string{"K8sNP", "ACNP", "ANNP", "BANP", "ANP", "NSP"},
 						},
 						{
 							name:      "table",
@@ -424,6 +468,11 @@ $ antctl get podmulticaststats pod -n namespace`,
 							usage:     "Comma separated OVS group IDs. Use 'all' to dump all groups",
 							shorthand: "G",
 						},
+						{
+							name:   "decode",
+							usage:  "Annotate the dumped flows and groups with the Pods, NetworkPolicy rules and Services referenced by their OpenFlow ports, conjunction IDs and group IDs",
+							isBool: true,
+						},
 					},
 					outputType: multiple,
 				},
@@ -486,6 +535,21 @@ $ antctl get podmulticaststats pod -n namespace`,
 			commandGroup:        flat,
 			transformedResponse: reflect.TypeOf(""),
 		},
+		{
+			use:   "diff",
+			short: "Compare the desired and the realized datapath state",
+			long:  "Compare the OVS flows, OVS groups and routes expected by the Antrea agent with the ones realized in OVS and in the host network stack, and print the discrepancies.",
+			example: `  Print the discrepancies between the desired and the realized datapath state
+  $ antctl datapath diff`,
+			agentEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path:       "/datapathdiff",
+					outputType: multiple,
+				},
+			},
+			commandGroup:        datapath,
+			transformedResponse: reflect.TypeOf(agentapis.DatapathDiffResponse{}),
+		},
 		{ // TODO: implement as a "rawCommand" (see supportbundle) so that the command can be run out-of-cluster
 			use:     "endpoint",
 			aliases: []string{"endpoints"},
@@ -753,6 +817,47 @@ $ antctl get podmulticaststats pod -n namespace`,
 			commandGroup:        get,
 			transformedResponse: reflect.TypeOf(agentapis.FQDNCacheResponse{}),
 		},
+		{
+			use:   "flows",
+			short: "Print connections tracked by the Flow Exporter",
+			long:  "Print the connections currently tracked by the Flow Exporter of the local Antrea agent, including their Pods, Service, NetworkPolicies and statistics",
+			example: `  Get all the connections tracked by the Flow Exporter
+  $ antctl get flows
+  Get the connections of Pods in a Namespace
+  $ antctl get flows -n default
+  Get the connections of a Pod
+  $ antctl get flows -n default --pod web-0
+  Get the TCP connections matching a NetworkPolicy, refreshing the output every 2 seconds
+  $ antctl get flows --policy default/allow-web --protocol tcp --watch`,
+			agentEndpoint: &endpoint{
+				nonResourceEndpoint: &nonResourceEndpoint{
+					path: "/flows",
+					params: []flagInfo{
+						{
+							name:      "namespace",
+							usage:     "Get connections whose source or destination Pod is in the Namespace",
+							shorthand: "n",
+						},
+						{
+							name:  "pod",
+							usage: "Get connections whose source or destination is the Pod. It must be specified with --namespace",
+						},
+						{
+							name:  "policy",
+							usage: "Get connections matching the NetworkPolicy, specified by name or \"<namespace>/<name>\"",
+						},
+						{
+							name:  "protocol",
+							usage: "Get connections with the protocol (tcp, udp, icmp, igmp or ipv6-icmp)",
+						},
+					},
+					outputType: multiple,
+				},
+			},
+			commandGroup:        get,
+			watchable:           true,
+			transformedResponse: reflect.TypeOf(agentapis.FlowResponse{}),
+		},
 	},
 	rawCommands: []rawCommand{
 		{
@@ -767,6 +872,12 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportController: false,
 			commandGroup:      check,
 		},
+		{
+			cobraCommand:      checkconnectivity.Command(),
+			supportAgent:      false,
+			supportController: false,
+			commandGroup:      check,
+		},
 		{
 			cobraCommand:      supportbundle.Command,
 			supportAgent:      true,
@@ -787,12 +898,29 @@ $ antctl get podmulticaststats pod -n namespace`,
 			supportAgent:      false,
 			supportController: true,
 		},
+		{
+			cobraCommand:      rollout.Command,
+			supportAgent:      false,
+			supportController: true,
+		},
 		{
 			cobraCommand:      featuregates.Command,
 			supportAgent:      true,
 			supportController: true,
 			commandGroup:      get,
 		},
+		{
+			cobraCommand:      effectiveconfig.Command,
+			supportAgent:      true,
+			supportController: true,
+			commandGroup:      get,
+		},
+		{
+			cobraCommand:      rawconfig.ValidateCmd,
+			supportAgent:      false,
+			supportController: false,
+			commandGroup:      config,
+		},
 		{
 			cobraCommand:      multicluster.GetCmd,
 			supportAgent:      false,
@@ -16,11 +16,13 @@ package antctl
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,6 +36,9 @@ import (
 
 type formatterType string
 
+// watchInterval is the interval between two outputs of a command run with the "--watch" flag.
+var watchInterval = 2 * time.Second
+
 const (
 	jsonFormatter  formatterType = "json"
 	yamlFormatter  formatterType = "yaml"
@@ -71,6 +76,8 @@ const (
 	mc
 	upgrade
 	check
+	datapath
+	config
 )
 
 var groupCommands = map[commandGroup]*cobra.Command{
@@ -98,6 +105,16 @@ var groupCommands = map[commandGroup]*cobra.Command{
 		Use:   "check",
 		Short: "Performs pre and post installation checks",
 	},
+	datapath: {
+		Use:   "datapath",
+		Short: "Sub-commands for datapath inspection",
+		Long:  "Sub-commands for datapath inspection",
+	},
+	config: {
+		Use:   "config",
+		Short: "Sub-commands for Antrea configuration files",
+		Long:  "Sub-commands for Antrea configuration files",
+	},
 }
 
 type endpointResponder interface {
@@ -231,6 +248,9 @@ type commandDefinition struct {
 	// response struct of the handler, but it is still needed to guide the formatter.
 	// It should always be filled.
 	transformedResponse reflect.Type
+	// watchable indicates whether the command supports the "--watch" flag, with which the response is requested and
+	// output again every watchInterval until the command is interrupted.
+	watchable bool
 }
 
 func (cd *commandDefinition) namespaced() bool {
@@ -537,28 +557,52 @@ func (cd *commandDefinition) newCommandRunE(c AntctlClient, out io.Writer) func(
 			return err
 		}
 
-		resp, requestErr := c.request(&requestOption{
-			commandDefinition: cd,
-			kubeconfig:        kubeconfigPath,
-			args:              argMap,
-			timeout:           timeout,
-			server:            server,
-		})
-		if requestErr != nil {
-			fallback := cd.getRequestErrorFallback()
-			if fallback == nil {
-				return requestErr
+		run := func() error {
+			resp, requestErr := c.request(&requestOption{
+				commandDefinition: cd,
+				kubeconfig:        kubeconfigPath,
+				args:              argMap,
+				timeout:           timeout,
+				server:            server,
+			})
+			if requestErr != nil {
+				fallback := cd.getRequestErrorFallback()
+				if fallback == nil {
+					return requestErr
+				}
+				resp, err = fallback()
+				if err != nil {
+					return err
+				}
 			}
-			resp, err = fallback()
-			if err != nil {
+			isSingle := cd.getEndpoint().OutputType() != multiple && (cd.getEndpoint().OutputType() == single || argGet)
+			if err := cd.output(resp, out, formatterType(outputFormat), isSingle, argMap); err != nil {
 				return err
 			}
+			return requestErr
 		}
-		isSingle := cd.getEndpoint().OutputType() != multiple && (cd.getEndpoint().OutputType() == single || argGet)
-		if err := cd.output(resp, out, formatterType(outputFormat), isSingle, argMap); err != nil {
-			return err
+		var watch bool
+		if cd.watchable {
+			watch, _ = cmd.Flags().GetBool("watch")
+		}
+		if !watch {
+			return run()
+		}
+		ctx := cmd.Context()
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		for {
+			if err := run(); err != nil {
+				return err
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(watchInterval):
+			}
+			fmt.Fprintln(out)
 		}
-		return requestErr
 	}
 }
 
@@ -583,6 +627,9 @@ func (cd *commandDefinition) applyFlagsToCommand(cmd *cobra.Command) {
 	if !hasArg {
 		cmd.Args = cobra.NoArgs
 	}
+	if cd.watchable {
+		cmd.Flags().BoolP("watch", "w", false, fmt.Sprintf("Output again every %v until interrupted.", watchInterval))
+	}
 	switch cd.commandGroup {
 	case get:
 		cmd.Flags().StringP("output", "o", "table", "output format: json|table|yaml|raw")
@@ -675,6 +675,26 @@ func TestCollectFlags(t *testing.T) {
 			expected: map[string]string{"name": "test1", "namespace": ""},
 			args:     []string{"test1", "test2"},
 		},
+		{
+			name: "Command for agent supports watch",
+			mode: "agent",
+			cd: &commandDefinition{
+				use: "flows",
+				agentEndpoint: &endpoint{
+					nonResourceEndpoint: &nonResourceEndpoint{
+						params: []flagInfo{
+							{
+								name:         "protocol",
+								defaultValue: "tcp",
+								usage:        "Protocol of the connections",
+							},
+						},
+					},
+				},
+				watchable: true,
+			},
+			expected: map[string]string{"protocol": "tcp"},
+		},
 		{
 			name: "Command for controller defines non-resource endpoint",
 			mode: "controller",
@@ -65,7 +65,8 @@ func (cl *commandList) applyToRootCommand(root *cobra.Command, client AntctlClie
 			(runtime.Mode == runtime.ModeFlowAggregator && cmd.supportFlowAggregator) ||
 			(!runtime.InPod && cmd.commandGroup == mc) ||
 			(!runtime.InPod && cmd.commandGroup == upgrade) ||
-			(!runtime.InPod && cmd.commandGroup == check) {
+			(!runtime.InPod && cmd.commandGroup == check) ||
+			cmd.commandGroup == config {
 			if groupCommand, ok := groupCommands[cmd.commandGroup]; ok {
 				groupCommand.AddCommand(cmd.cobraCommand)
 			} else {
@@ -0,0 +1,434 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	"antrea.io/antrea/pkg/antctl/raw/check"
+)
+
+func Command() *cobra.Command {
+	o := newOptions()
+	command := &cobra.Command{
+		Use:   "connectivity",
+		Short: "Runs a matrix of connectivity checks across Nodes",
+		Long: `Deploy ephemeral probe Pods on all Linux Nodes, run Pod-to-Pod, Pod-to-Service, Pod-to-Node and
+Pod-to-external connectivity checks from each of them, and print a pass/fail report. For each failed
+check, the OVS flow which dropped the probe is reported when it can be determined.`,
+		Example: `  Check connectivity in a cluster where Antrea is running in kube-system
+  $ antctl check connectivity
+  Check connectivity without Pod-to-external checks
+  $ antctl check connectivity --external-target ""`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(o)
+		},
+	}
+	command.Flags().StringVarP(&o.antreaNamespace, "namespace", "n", o.antreaNamespace, "Configure Namespace in which Antrea is running")
+	command.Flags().StringVar(&o.testImage, "test-image", o.testImage, "Container image override for the probe Pods")
+	command.Flags().StringVar(&o.externalTarget, "external-target", o.externalTarget, "Target <host>:<port> of the Pod-to-external checks, skipped if empty")
+	command.Flags().IntVar(&o.nodePort, "node-port", o.nodePort, "TCP port used by the Pod-to-Node checks, which must be open on all Nodes")
+	return command
+}
+
+type options struct {
+	antreaNamespace string
+	// Container image for the probe Pods.
+	testImage      string
+	externalTarget string
+	nodePort       int
+}
+
+func newOptions() *options {
+	return &options{
+		antreaNamespace: "kube-system",
+		testImage:       check.DefaultTestImage,
+		externalTarget:  "api.github.com:80",
+		// The kubelet port.
+		nodePort: 10250,
+	}
+}
+
+const (
+	testNamespacePrefix = "antrea-test"
+	probeName           = "connectivity-probe"
+	probePort           = 80
+	agentDaemonSetName  = "antrea-agent"
+	agentContainerName  = "antrea-agent"
+	podReadyTimeout     = 2 * time.Minute
+	// Maximum number of probes run in parallel.
+	probeWorkers = 8
+)
+
+type probeKind string
+
+const (
+	podToPod      probeKind = "Pod-to-Pod"
+	podToService  probeKind = "Pod-to-Service"
+	podToNode     probeKind = "Pod-to-Node"
+	podToExternal probeKind = "Pod-to-external"
+)
+
+type probe struct {
+	kind   probeKind
+	source *corev1.Pod
+	// A human-readable description of the destination.
+	destination string
+	// The IP or host name the probe connects to.
+	target string
+	port   int
+}
+
+type probeResult struct {
+	probe
+	err error
+	// The OVS flow which dropped the probe, if the probe failed and the flow could be determined.
+	droppedBy string
+}
+
+type testContext struct {
+	check.Logger
+	client          kubernetes.Interface
+	config          *rest.Config
+	clusterName     string
+	antreaNamespace string
+	namespace       string
+	options         *options
+	probePods       []corev1.Pod
+	probeService    *corev1.Service
+	nodes           []corev1.Node
+}
+
+func Run(o *options) error {
+	client, config, clusterName, err := check.NewClient()
+	if err != nil {
+		return fmt.Errorf("unable to create Kubernetes client: %w", err)
+	}
+	ctx := context.Background()
+	testContext := &testContext{
+		Logger:          check.NewLogger(fmt.Sprintf("[%s] ", clusterName)),
+		client:          client,
+		config:          config,
+		clusterName:     clusterName,
+		antreaNamespace: o.antreaNamespace,
+		namespace:       check.GenerateRandomNamespace(testNamespacePrefix),
+		options:         o,
+	}
+	defer check.Teardown(ctx, testContext.Logger, testContext.client, testContext.namespace)
+	if err := testContext.setup(ctx); err != nil {
+		return err
+	}
+	probes, err := buildProbes(testContext.probePods, testContext.probeService, testContext.nodes, o.externalTarget, o.nodePort)
+	if err != nil {
+		return err
+	}
+	testContext.Log("Running %d connectivity checks...", len(probes))
+	results := testContext.runProbes(ctx, probes)
+	numFailure := printReport(os.Stdout, results)
+	testContext.Log("Test finished: %v checks succeeded, %v checks failed", len(results)-numFailure, numFailure)
+	if numFailure > 0 {
+		return fmt.Errorf("%v/%v connectivity checks failed", numFailure, len(results))
+	}
+	return nil
+}
+
+func newProbeDaemonSet(image string) *appsv1.DaemonSet {
+	labels := map[string]string{"app": "antrea", "component": "connectivity-checker", "name": probeName}
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   probeName,
+			Labels: labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:            probeName,
+							Image:           image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Command:         []string{"nc", "-l", fmt.Sprint(probePort), "-k"},
+							Ports:           []corev1.ContainerPort{{ContainerPort: probePort}},
+						},
+					},
+					NodeSelector: map[string]string{
+						"kubernetes.io/os": "linux",
+					},
+					// Run the probe Pods on all Nodes, including control-plane Nodes.
+					Tolerations: []corev1.Toleration{
+						{
+							Operator: corev1.TolerationOpExists,
+							Effect:   corev1.TaintEffectNoSchedule,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (t *testContext) setup(ctx context.Context) error {
+	t.Log("Test starting....")
+	if _, err := t.client.AppsV1().DaemonSets(t.antreaNamespace).Get(ctx, agentDaemonSetName, metav1.GetOptions{}); err != nil {
+		return fmt.Errorf("unable to determine status of Antrea DaemonSet: %w", err)
+	}
+	t.Log("Creating Namespace %s for connectivity checks...", t.namespace)
+	_, err := t.client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: t.namespace, Labels: map[string]string{"app": "antrea", "component": "connectivity-checker"}}}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to create Namespace %s: %w", t.namespace, err)
+	}
+	t.Log("Deploying probe DaemonSet %s...", probeName)
+	if _, err := t.client.AppsV1().DaemonSets(t.namespace).Create(ctx, newProbeDaemonSet(t.options.testImage), metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("unable to create DaemonSet %s: %w", probeName, err)
+	}
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: probeName},
+		Spec: corev1.ServiceSpec{
+			Type:           corev1.ServiceTypeClusterIP,
+			Ports:          []corev1.ServicePort{{Name: probeName, Port: probePort}},
+			Selector:       map[string]string{"name": probeName},
+			IPFamilyPolicy: ptr.To(corev1.IPFamilyPolicyPreferDualStack),
+		},
+	}
+	if t.probeService, err = t.client.CoreV1().Services(t.namespace).Create(ctx, svc, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("unable to create Service %s: %w", probeName, err)
+	}
+	t.Log("Waiting for DaemonSet %s to become ready...", probeName)
+	if err := wait.PollUntilContextTimeout(ctx, time.Second, podReadyTimeout, false, func(ctx context.Context) (bool, error) {
+		ds, err := t.client.AppsV1().DaemonSets(t.namespace).Get(ctx, probeName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		return ds.Status.DesiredNumberScheduled > 0 && ds.Status.NumberReady == ds.Status.DesiredNumberScheduled, nil
+	}); err != nil {
+		return fmt.Errorf("waiting for DaemonSet %s to become ready has been interrupted: %w", probeName, err)
+	}
+	podList, err := t.client.CoreV1().Pods(t.namespace).List(ctx, metav1.ListOptions{LabelSelector: "name=" + probeName})
+	if err != nil {
+		return fmt.Errorf("unable to list probe Pods: %w", err)
+	}
+	t.probePods = podList.Items
+	nodeList, err := t.client.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: "kubernetes.io/os=linux"})
+	if err != nil {
+		return fmt.Errorf("unable to list Nodes: %w", err)
+	}
+	t.nodes = nodeList.Items
+	t.Log("Deployed %d probe Pods on %d Nodes", len(t.probePods), len(t.nodes))
+	return nil
+}
+
+// buildProbes returns the matrix of connectivity checks to run from each probe Pod.
+func buildProbes(pods []corev1.Pod, service *corev1.Service, nodes []corev1.Node, externalTarget string, nodePort int) ([]probe, error) {
+	var externalHost string
+	var externalPort int
+	if externalTarget != "" {
+		host, port, err := net.SplitHostPort(externalTarget)
+		if err != nil {
+			return nil, fmt.Errorf("invalid external target %s: %w", externalTarget, err)
+		}
+		if _, err := fmt.Sscan(port, &externalPort); err != nil {
+			return nil, fmt.Errorf("invalid port in external target %s: %w", externalTarget, err)
+		}
+		externalHost = host
+	}
+	var probes []probe
+	for i := range pods {
+		src := &pods[i]
+		for j := range pods {
+			dst := &pods[j]
+			if dst.Name == src.Name {
+				continue
+			}
+			for _, podIP := range dst.Status.PodIPs {
+				probes = append(probes, probe{
+					kind:        podToPod,
+					source:      src,
+					destination: fmt.Sprintf("%s (%s)", dst.Name, dst.Spec.NodeName),
+					target:      podIP.IP,
+					port:        probePort,
+				})
+			}
+		}
+		if service != nil {
+			for _, clusterIP := range service.Spec.ClusterIPs {
+				probes = append(probes, probe{
+					kind:        podToService,
+					source:      src,
+					destination: service.Name,
+					target:      clusterIP,
+					port:        probePort,
+				})
+			}
+		}
+		for _, node := range nodes {
+			for _, address := range node.Status.Addresses {
+				if address.Type != corev1.NodeInternalIP {
+					continue
+				}
+				probes = append(probes, probe{
+					kind:        podToNode,
+					source:      src,
+					destination: node.Name,
+					target:      address.Address,
+					port:        nodePort,
+				})
+			}
+		}
+		if externalHost != "" {
+			probes = append(probes, probe{
+				kind:        podToExternal,
+				source:      src,
+				destination: externalHost,
+				target:      externalHost,
+				port:        externalPort,
+			})
+		}
+	}
+	return probes, nil
+}
+
+func (t *testContext) runProbes(ctx context.Context, probes []probe) []probeResult {
+	results := make([]probeResult, len(probes))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, probeWorkers)
+	for i := range probes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i] = t.runProbe(ctx, probes[i])
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+func (t *testContext) runProbe(ctx context.Context, p probe) probeResult {
+	result := probeResult{probe: p}
+	cmd := []string{"nc", p.target, fmt.Sprint(p.port), "--wait=3s", "-vz"}
+	_, stderr, err := raw.ExecInPod(ctx, t.client, t.config, t.namespace, p.source.Name, "", cmd)
+	if err == nil {
+		return result
+	}
+	if stderr = strings.TrimSpace(stderr); stderr != "" {
+		err = fmt.Errorf("%w: %s", err, stderr)
+	}
+	result.err = err
+	// Only IP destinations can be traced in OVS.
+	if net.ParseIP(p.target) != nil {
+		droppedBy, err := t.traceDroppedFlow(ctx, p)
+		if err != nil {
+			t.Warning("Unable to trace %s check from %s to %s: %v", p.kind, p.source.Name, p.destination, err)
+		}
+		result.droppedBy = droppedBy
+	}
+	return result
+}
+
+// traceDroppedFlow traces the probe with "antctl trace-packet" in the Antrea Agent running on the source Node, and
+// returns the OVS flow which dropped it, or an empty string if the packet was not dropped by OVS.
+func (t *testContext) traceDroppedFlow(ctx context.Context, p probe) (string, error) {
+	agentPods, err := t.client.CoreV1().Pods(t.antreaNamespace).List(ctx, metav1.ListOptions{
+		LabelSelector: "app=antrea,component=antrea-agent",
+		FieldSelector: "spec.nodeName=" + p.source.Spec.NodeName,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to list Antrea Agent Pods: %w", err)
+	}
+	if len(agentPods.Items) == 0 {
+		return "", fmt.Errorf("no Antrea Agent Pod found on Node %s", p.source.Spec.NodeName)
+	}
+	protocol := "tcp"
+	if net.ParseIP(p.target).To4() == nil {
+		protocol = "tcp6"
+	}
+	cmd := []string{"antctl", "trace-packet", "-S", t.namespace + "/" + p.source.Name, "-D", p.target, "-f", fmt.Sprintf("%s,tcp_dst=%d", protocol, p.port)}
+	stdout, stderr, err := raw.ExecInPod(ctx, t.client, t.config, t.antreaNamespace, agentPods.Items[0].Name, agentContainerName, cmd)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr))
+	}
+	return parseDroppedFlow(stdout), nil
+}
+
+var (
+	datapathActionsRegex = regexp.MustCompile(`(?m)^Datapath actions: (.*)$`)
+	flowRegex            = regexp.MustCompile(`^\s*[\w-]+\. \S`)
+)
+
+// parseDroppedFlow returns the last OVS flow traversed by the packet according to the provided "ofproto/trace" output
+// if the packet is dropped, or an empty string otherwise.
+func parseDroppedFlow(trace string) string {
+	matches := datapathActionsRegex.FindStringSubmatch(trace)
+	if matches == nil || strings.TrimSpace(matches[1]) != "drop" {
+		return ""
+	}
+	trace, _, _ = strings.Cut(trace, "\nFinal flow:")
+	var lastFlow string
+	for _, line := range strings.Split(trace, "\n") {
+		if flowRegex.MatchString(line) {
+			lastFlow = strings.TrimSpace(line)
+		}
+	}
+	return lastFlow
+}
+
+// printReport prints a pass/fail report of the connectivity checks, and returns the number of failed checks.
+func printReport(out io.Writer, results []probeResult) int {
+	numFailure := 0
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHECK\tSOURCE\tDESTINATION\tTARGET\tRESULT\tDROPPED-BY")
+	for _, r := range results {
+		status, droppedBy := "PASS", ""
+		if r.err != nil {
+			numFailure++
+			status, droppedBy = "FAIL", "N/A"
+			if r.droppedBy != "" {
+				droppedBy = r.droppedBy
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s (%s)\t%s\t%s\t%s\t%s\n", r.kind, r.source.Name, r.source.Spec.NodeName, r.destination,
+			net.JoinHostPort(r.target, fmt.Sprint(r.port)), status, droppedBy)
+	}
+	w.Flush()
+	return numFailure
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package connectivity
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newProbePod(name, nodeName, ip string) corev1.Pod {
+	return corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+		Status:     corev1.PodStatus{PodIPs: []corev1.PodIP{{IP: ip}}},
+	}
+}
+
+func TestBuildProbes(t *testing.T) {
+	pods := []corev1.Pod{
+		newProbePod("probe-a", "node-a", "10.10.0.2"),
+		newProbePod("probe-b", "node-b", "10.10.1.2"),
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: probeName},
+		Spec:       corev1.ServiceSpec{ClusterIPs: []string{"10.96.0.10"}},
+	}
+	nodes := []corev1.Node{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-a"},
+			Status: corev1.NodeStatus{Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeHostName, Address: "node-a"},
+				{Type: corev1.NodeInternalIP, Address: "172.18.0.2"},
+			}},
+		},
+	}
+
+	probes, err := buildProbes(pods, service, nodes, "example.com:443", 10250)
+	require.NoError(t, err)
+	var got []string
+	for _, p := range probes {
+		got = append(got, fmt.Sprintf("%s %s -> %s %s:%d", p.kind, p.source.Name, p.destination, p.target, p.port))
+	}
+	assert.Equal(t, []string{
+		"Pod-to-Pod probe-a -> probe-b (node-b) 10.10.1.2:80",
+		"Pod-to-Service probe-a -> connectivity-probe 10.96.0.10:80",
+		"Pod-to-Node probe-a -> node-a 172.18.0.2:10250",
+		"Pod-to-external probe-a -> example.com example.com:443",
+		"Pod-to-Pod probe-b -> probe-a (node-a) 10.10.0.2:80",
+		"Pod-to-Service probe-b -> connectivity-probe 10.96.0.10:80",
+		"Pod-to-Node probe-b -> node-a 172.18.0.2:10250",
+		"Pod-to-external probe-b -> example.com example.com:443",
+	}, got)
+
+	probes, err = buildProbes(pods, nil, nil, "", 10250)
+	require.NoError(t, err)
+	assert.Len(t, probes, 2)
+
+	_, err = buildProbes(pods, nil, nil, "example.com", 10250)
+	assert.ErrorContains(t, err, "invalid external target example.com")
+}
+
+func TestParseDroppedFlow(t *testing.T) {
+	droppedTrace := `Flow: tcp,in_port=3,nw_src=10.10.0.2,nw_dst=10.10.1.2,tp_dst=80
+
+bridge("br-int")
+----------------
+ 0. in_port=3, priority 190, cookie 0x1030000000000
+    set_field:0x3/0xf->reg0
+    goto_table:1
+ 1. priority 0, cookie 0x1000000000000
+    goto_table:2
+12. ip,nw_dst=10.10.1.2, priority 200, cookie 0x1050000000000
+    drop
+
+Final flow: unchanged
+Megaflow: recirc_id=0,eth,ip,in_port=3,nw_frag=no
+Datapath actions: drop
+`
+	assert.Equal(t, "12. ip,nw_dst=10.10.1.2, priority 200, cookie 0x1050000000000", parseDroppedFlow(droppedTrace))
+
+	forwardedTrace := `Flow: tcp,in_port=3,nw_src=10.10.0.2,nw_dst=10.10.1.2,tp_dst=80
+
+bridge("br-int")
+----------------
+ 0. in_port=3, priority 190, cookie 0x1030000000000
+    output:2
+
+Final flow: unchanged
+Megaflow: recirc_id=0,eth,ip,in_port=3,nw_frag=no
+Datapath actions: 2
+`
+	assert.Empty(t, parseDroppedFlow(forwardedTrace))
+}
+
+func TestPrintReport(t *testing.T) {
+	pod := newProbePod("probe-a", "node-a", "10.10.0.2")
+	results := []probeResult{
+		{probe: probe{kind: podToService, source: &pod, destination: probeName, target: "10.96.0.10", port: 80}},
+		{probe: probe{kind: podToNode, source: &pod, destination: "node-b", target: "172.18.0.3", port: 10250}, err: fmt.Errorf("timeout"), droppedBy: "12. ip, priority 200"},
+		{probe: probe{kind: podToExternal, source: &pod, destination: "example.com", target: "example.com", port: 443}, err: fmt.Errorf("timeout")},
+	}
+	var b bytes.Buffer
+	assert.Equal(t, 2, printReport(&b, results))
+	expected := "CHECK            SOURCE            DESTINATION         TARGET            RESULT  DROPPED-BY\n" +
+		"Pod-to-Service   probe-a (node-a)  connectivity-probe  10.96.0.10:80     PASS    \n" +
+		"Pod-to-Node      probe-a (node-a)  node-b              172.18.0.3:10250  FAIL    12. ip, priority 200\n" +
+		"Pod-to-external  probe-a (node-a)  example.com         example.com:443   FAIL    N/A\n"
+	assert.Equal(t, expected, b.String())
+}
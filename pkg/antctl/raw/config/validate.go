@@ -0,0 +1,260 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/component-base/featuregate"
+
+	agentconfig "antrea.io/antrea/pkg/agent/config"
+	agentoptions "antrea.io/antrea/pkg/config/agent"
+	controlleroptions "antrea.io/antrea/pkg/config/controller"
+	"antrea.io/antrea/pkg/features"
+)
+
+const (
+	componentAgent      = "agent"
+	componentController = "controller"
+)
+
+var validateOpts = &struct {
+	file      string
+	component string
+}{}
+
+var ValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Validate an Antrea configuration file",
+	Long: "Validate an Antrea Agent or Controller configuration file without deploying it. Malformed values, unknown " +
+		"options and feature gates, as well as options which are inconsistent with the configured feature gates are reported.",
+	Example: `  Validate an Antrea Agent configuration file
+  $ antctl config validate -f antrea-agent.conf
+  Validate an Antrea Controller configuration file whose name does not identify the component
+  $ antctl config validate -f controller.yaml --component controller`,
+	Args: cobra.NoArgs,
+	RunE: validateRunE,
+}
+
+func init() {
+	ValidateCmd.Flags().StringVarP(&validateOpts.file, "file", "f", "", "Path to the configuration file to validate")
+	ValidateCmd.Flags().StringVar(&validateOpts.component, "component", "", "Component the configuration file is for, one of agent or controller. Inferred from the file name if not set")
+	ValidateCmd.MarkFlagRequired("file")
+}
+
+type validationResult struct {
+	errors   []string
+	warnings []string
+}
+
+func (r *validationResult) addError(format string, a ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, a...))
+}
+
+func (r *validationResult) addWarning(format string, a ...interface{}) {
+	r.warnings = append(r.warnings, fmt.Sprintf(format, a...))
+}
+
+func validateRunE(cmd *cobra.Command, _ []string) error {
+	component, err := resolveComponent(validateOpts.file, validateOpts.component)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(validateOpts.file)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	var result *validationResult
+	if component == componentAgent {
+		result = validateAgentConfig(data)
+	} else {
+		result = validateControllerConfig(data)
+	}
+
+	out := cmd.OutOrStdout()
+	for _, warning := range result.warnings {
+		fmt.Fprintf(out, "WARNING: %s\n", warning)
+	}
+	for _, err := range result.errors {
+		fmt.Fprintf(out, "ERROR: %s\n", err)
+	}
+	if len(result.errors) > 0 {
+		return fmt.Errorf("config file %s is invalid: %d error(s) found", validateOpts.file, len(result.errors))
+	}
+	fmt.Fprintf(out, "Config file %s is valid for the Antrea %s\n", validateOpts.file, component)
+	return nil
+}
+
+func resolveComponent(file, component string) (string, error) {
+	switch component {
+	case componentAgent, componentController:
+		return component, nil
+	case "":
+	default:
+		return "", fmt.Errorf("unsupported component %s, must be one of %s and %s", component, componentAgent, componentController)
+	}
+	name := filepath.Base(file)
+	if strings.Contains(name, "antrea-agent") {
+		return componentAgent, nil
+	}
+	if strings.Contains(name, "antrea-controller") {
+		return componentController, nil
+	}
+	return "", fmt.Errorf("cannot infer the component from the file name %s, please specify it with --component", name)
+}
+
+// decodeConfig decodes the configuration the same way as the Antrea components do: unknown options
+// are ignored, so they are only reported as warnings, while malformed values cause an error.
+func decodeConfig(data []byte, config interface{}, result *validationResult) bool {
+	strictErr := yaml.UnmarshalStrict(data, config)
+	if strictErr == nil {
+		return true
+	}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		if e, ok := err.(*yaml.TypeError); ok {
+			for _, msg := range e.Errors {
+				result.addError("%s", msg)
+			}
+		} else {
+			result.addError("failed to decode config file: %v", err)
+		}
+		return false
+	}
+	if e, ok := strictErr.(*yaml.TypeError); ok {
+		for _, msg := range e.Errors {
+			result.addWarning("%s, the option will be ignored", msg)
+		}
+	}
+	return true
+}
+
+// resolveFeatureGates validates the configured feature gates and returns the state of all the
+// feature gates once the defaults have been applied.
+func resolveFeatureGates(configured map[string]bool, componentGates sets.Set[featuregate.Feature], component string, result *validationResult) map[featuregate.Feature]bool {
+	enabled := make(map[featuregate.Feature]bool, len(features.DefaultAntreaFeatureGates))
+	for feature, spec := range features.DefaultAntreaFeatureGates {
+		enabled[feature] = spec.Default
+	}
+	names := make([]string, 0, len(configured))
+	for name := range configured {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := configured[name]
+		feature := featuregate.Feature(name)
+		spec, ok := features.DefaultAntreaFeatureGates[feature]
+		if !ok {
+			result.addError("unrecognized feature gate: %s", name)
+			continue
+		}
+		if spec.LockToDefault && value != spec.Default {
+			result.addError("cannot set feature gate %s to %t, feature is locked to %t", name, value, spec.Default)
+			continue
+		}
+		if spec.PreRelease == featuregate.Deprecated {
+			result.addWarning("feature gate %s is deprecated and will be removed in a future release", name)
+		}
+		if !componentGates.Has(feature) {
+			result.addWarning("feature gate %s does not apply to the Antrea %s and will be ignored", name, component)
+		}
+		enabled[feature] = value
+	}
+	return enabled
+}
+
+func warnIfIgnored(set bool, option string, feature featuregate.Feature, enabled map[featuregate.Feature]bool, result *validationResult) {
+	if set && !enabled[feature] {
+		result.addWarning("%s is set but will be ignored because feature gate %s is disabled", option, feature)
+	}
+}
+
+func validateAgentConfig(data []byte) *validationResult {
+	result := &validationResult{}
+	var c agentoptions.AgentConfig
+	if !decodeConfig(data, &c, result) {
+		return result
+	}
+	enabled := resolveFeatureGates(c.FeatureGates, features.AgentGates, componentAgent, result)
+
+	switch c.NodeType {
+	case "", agentconfig.K8sNode.String():
+	case agentconfig.ExternalNode.String():
+		if !enabled[features.ExternalNode] {
+			result.addError("nodeType %s requires feature gate %s to be enabled", c.NodeType, features.ExternalNode)
+		}
+	default:
+		result.addError("unsupported nodeType %s", c.NodeType)
+	}
+	if c.TrafficEncapMode != "" {
+		if ok, _ := agentconfig.GetTrafficEncapModeFromStr(c.TrafficEncapMode); !ok {
+			result.addError("TrafficEncapMode %s is unknown", c.TrafficEncapMode)
+		}
+	}
+	if c.TrafficEncryptionMode != "" {
+		if ok, _ := agentconfig.GetTrafficEncryptionModeFromStr(c.TrafficEncryptionMode); !ok {
+			result.addError("TrafficEncryptionMode %s is unknown", c.TrafficEncryptionMode)
+		}
+	}
+	if c.IPsec.AuthenticationMode != "" {
+		ok, mode := agentconfig.GetIPsecAuthenticationModeFromStr(c.IPsec.AuthenticationMode)
+		if !ok {
+			result.addError("IPsec AuthenticationMode %s is unknown", c.IPsec.AuthenticationMode)
+		} else if mode == agentconfig.IPsecAuthenticationModeCert && !enabled[features.IPsecCertAuth] {
+			result.addError("IPsec AuthenticationMode %s requires feature gate %s to be enabled", c.IPsec.AuthenticationMode, features.IPsecCertAuth)
+		}
+	}
+	if c.AntreaProxy.DefaultLoadBalancerMode != "" {
+		ok, mode := agentconfig.GetLoadBalancerModeFromStr(c.AntreaProxy.DefaultLoadBalancerMode)
+		if !ok {
+			result.addError("LoadBalancerMode %s is unknown", c.AntreaProxy.DefaultLoadBalancerMode)
+		} else if mode == agentconfig.LoadBalancerModeDSR && !enabled[features.LoadBalancerModeDSR] {
+			result.addError("LoadBalancerMode DSR requires feature gate %s to be enabled", features.LoadBalancerModeDSR)
+		}
+	}
+	if c.EnableBridgingMode && !enabled[features.AntreaIPAM] {
+		result.addError("AntreaIPAM feature gate must be enabled to configure bridging mode")
+	}
+
+	warnIfIgnored(c.FlowExporter.Enable, "flowExporter.enable", features.FlowExporter, enabled, result)
+	warnIfIgnored(c.Multicast.Enable, "multicast.enable", features.Multicast, enabled, result)
+	warnIfIgnored(c.NodePortLocal.Enable, "nodePortLocal.enable", features.NodePortLocal, enabled, result)
+	warnIfIgnored(c.Multicluster.EnableGateway, "multicluster.enableGateway", features.Multicluster, enabled, result)
+	warnIfIgnored(c.Multicluster.EnableStretchedNetworkPolicy, "multicluster.enableStretchedNetworkPolicy", features.Multicluster, enabled, result)
+	warnIfIgnored(len(c.SecondaryNetwork.OVSBridges) > 0, "secondaryNetwork.ovsBridges", features.SecondaryNetwork, enabled, result)
+	warnIfIgnored(len(c.Egress.ExceptCIDRs) > 0, "egress.exceptCIDRs", features.Egress, enabled, result)
+	warnIfIgnored(c.Egress.MaxEgressIPsPerNode > 0, "egress.maxEgressIPsPerNode", features.Egress, enabled, result)
+	return result
+}
+
+func validateControllerConfig(data []byte) *validationResult {
+	result := &validationResult{}
+	var c controlleroptions.ControllerConfig
+	if !decodeConfig(data, &c, result) {
+		return result
+	}
+	enabled := resolveFeatureGates(c.FeatureGates, features.ControllerGates, componentController, result)
+
+	warnIfIgnored(c.NodeIPAM.EnableNodeIPAM, "nodeIPAM.enableNodeIPAM", features.NodeIPAM, enabled, result)
+	warnIfIgnored(c.Multicluster.EnableStretchedNetworkPolicy, "multicluster.enableStretchedNetworkPolicy", features.Multicluster, enabled, result)
+	return result
+}
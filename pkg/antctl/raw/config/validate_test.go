@@ -0,0 +1,266 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveComponent(t *testing.T) {
+	tests := []struct {
+		name              string
+		file              string
+		component         string
+		expectedComponent string
+		expectedErr       string
+	}{
+		{
+			name:              "agent file",
+			file:              "/etc/antrea/antrea-agent.conf",
+			expectedComponent: componentAgent,
+		},
+		{
+			name:              "controller file",
+			file:              "antrea-controller.conf",
+			expectedComponent: componentController,
+		},
+		{
+			name:              "component overrides file name",
+			file:              "antrea-agent.conf",
+			component:         componentController,
+			expectedComponent: componentController,
+		},
+		{
+			name:        "unknown file name",
+			file:        "config.yaml",
+			expectedErr: "cannot infer the component from the file name config.yaml, please specify it with --component",
+		},
+		{
+			name:        "unsupported component",
+			file:        "antrea-agent.conf",
+			component:   "flow-aggregator",
+			expectedErr: "unsupported component flow-aggregator, must be one of agent and controller",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			component, err := resolveComponent(tt.file, tt.component)
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedComponent, component)
+			}
+		})
+	}
+}
+
+func TestValidateAgentConfig(t *testing.T) {
+	tests := []struct {
+		name             string
+		data             string
+		expectedErrors   []string
+		expectedWarnings []string
+	}{
+		{
+			name: "valid config",
+			data: `
+featureGates:
+  Multicast: true
+trafficEncapMode: encap
+antreaProxy:
+  defaultLoadBalancerMode: nat
+multicast:
+  enable: true
+`,
+		},
+		{
+			name: "malformed value",
+			data: `
+trafficEncapMode: encap
+tunnelPort: abc
+`,
+			expectedErrors: []string{"line 3: cannot unmarshal !!str `abc` into int32"},
+		},
+		{
+			name: "unknown option",
+			data: `
+trafficEncapMode: encap
+foo: bar
+`,
+			expectedWarnings: []string{"line 3: field foo not found in type agent.AgentConfig, the option will be ignored"},
+		},
+		{
+			name: "invalid feature gates",
+			data: `
+featureGates:
+  Foo: true
+  NodeIPAM: true
+`,
+			expectedErrors:   []string{"unrecognized feature gate: Foo"},
+			expectedWarnings: []string{"feature gate NodeIPAM does not apply to the Antrea agent and will be ignored"},
+		},
+		{
+			name: "options requiring disabled feature gates",
+			data: `
+nodeType: externalNode
+ipsec:
+  authenticationMode: cert
+antreaProxy:
+  defaultLoadBalancerMode: dsr
+enableBridgingMode: true
+`,
+			expectedErrors: []string{
+				"nodeType externalNode requires feature gate ExternalNode to be enabled",
+				"IPsec AuthenticationMode cert requires feature gate IPsecCertAuth to be enabled",
+				"LoadBalancerMode DSR requires feature gate LoadBalancerModeDSR to be enabled",
+				"AntreaIPAM feature gate must be enabled to configure bridging mode",
+			},
+		},
+		{
+			name: "options requiring enabled feature gates",
+			data: `
+featureGates:
+  ExternalNode: true
+  IPsecCertAuth: true
+  LoadBalancerModeDSR: true
+  AntreaIPAM: true
+nodeType: externalNode
+ipsec:
+  authenticationMode: cert
+antreaProxy:
+  defaultLoadBalancerMode: dsr
+enableBridgingMode: true
+`,
+		},
+		{
+			name: "unknown modes",
+			data: `
+nodeType: vm
+trafficEncapMode: foo
+trafficEncryptionMode: bar
+`,
+			expectedErrors: []string{
+				"unsupported nodeType vm",
+				"TrafficEncapMode foo is unknown",
+				"TrafficEncryptionMode bar is unknown",
+			},
+		},
+		{
+			name: "ignored options",
+			data: `
+featureGates:
+  Multicast: false
+  Egress: false
+flowExporter:
+  enable: true
+multicast:
+  enable: true
+egress:
+  exceptCIDRs:
+  - 10.0.0.0/8
+`,
+			expectedWarnings: []string{
+				"flowExporter.enable is set but will be ignored because feature gate FlowExporter is disabled",
+				"multicast.enable is set but will be ignored because feature gate Multicast is disabled",
+				"egress.exceptCIDRs is set but will be ignored because feature gate Egress is disabled",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validateAgentConfig([]byte(tt.data))
+			assert.Equal(t, tt.expectedErrors, result.errors)
+			assert.Equal(t, tt.expectedWarnings, result.warnings)
+		})
+	}
+}
+
+func TestValidateControllerConfig(t *testing.T) {
+	data := `
+featureGates:
+  NodeIPAM: false
+  Multicluster: false
+  FlowExporter: true
+nodeIPAM:
+  enableNodeIPAM: true
+multicluster:
+  enableStretchedNetworkPolicy: true
+`
+	result := validateControllerConfig([]byte(data))
+	assert.Empty(t, result.errors)
+	assert.Equal(t, []string{
+		"feature gate FlowExporter does not apply to the Antrea controller and will be ignored",
+		"nodeIPAM.enableNodeIPAM is set but will be ignored because feature gate NodeIPAM is disabled",
+		"multicluster.enableStretchedNetworkPolicy is set but will be ignored because feature gate Multicluster is disabled",
+	}, result.warnings)
+}
+
+func TestValidateRunE(t *testing.T) {
+	dir := t.TempDir()
+	validFile := filepath.Join(dir, "antrea-agent.conf")
+	require.NoError(t, os.WriteFile(validFile, []byte("featureGates:\n  AntreaProxy: true\n"), 0644))
+	invalidFile := filepath.Join(dir, "antrea-controller.conf")
+	require.NoError(t, os.WriteFile(invalidFile, []byte("featureGates:\n  Foo: true\n"), 0644))
+
+	tests := []struct {
+		name           string
+		file           string
+		expectedOutput string
+		expectedErr    string
+	}{
+		{
+			name:           "valid file",
+			file:           validFile,
+			expectedOutput: "Config file " + validFile + " is valid for the Antrea agent\n",
+		},
+		{
+			name:           "invalid file",
+			file:           invalidFile,
+			expectedOutput: "ERROR: unrecognized feature gate: Foo\n",
+			expectedErr:    "config file " + invalidFile + " is invalid: 1 error(s) found",
+		},
+		{
+			name:        "missing file",
+			file:        filepath.Join(dir, "antrea-agent-missing.conf"),
+			expectedErr: "failed to read config file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			validateOpts.file = tt.file
+			validateOpts.component = ""
+			defer func() {
+				validateOpts.file = ""
+			}()
+			var buf bytes.Buffer
+			ValidateCmd.SetOut(&buf)
+			err := validateRunE(ValidateCmd, nil)
+			if tt.expectedErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedOutput, buf.String())
+		})
+	}
+}
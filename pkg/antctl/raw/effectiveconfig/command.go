@@ -0,0 +1,152 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package effectiveconfig
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	"antrea.io/antrea/pkg/antctl/runtime"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+)
+
+var Command *cobra.Command
+var getClients = getConfigAndClients
+var getRestClient = getRestClientByMode
+
+var option = &struct {
+	insecure bool
+	output   string
+}{}
+
+func init() {
+	Command = &cobra.Command{
+		Use:   "effective-config",
+		Short: "Print the effective configuration of Antrea",
+		Example: `  Print the effective configuration in YAML
+  $ antctl get effective-config
+  Print the effective configuration in JSON
+  $ antctl get effective-config -o json`,
+		Args: cobra.NoArgs,
+	}
+	if runtime.Mode == runtime.ModeAgent {
+		Command.RunE = agentRunE
+		Command.Long = "Print the effective configuration of the Antrea Agent, with the defaults applied and the resolved feature gates"
+	} else if runtime.Mode == runtime.ModeController && runtime.InPod {
+		Command.RunE = controllerLocalRunE
+		Command.Long = "Print the effective configuration of the Antrea Controller, with the defaults applied and the resolved feature gates"
+	} else if runtime.Mode == runtime.ModeController && !runtime.InPod {
+		Command.Long = "Print the effective configuration of the Antrea Controller, with the defaults applied and the resolved feature gates"
+		Command.Flags().BoolVar(&option.insecure, "insecure", false, "Skip TLS verification when connecting to Antrea API.")
+		Command.RunE = controllerRemoteRunE
+	}
+	Command.Flags().StringVarP(&option.output, "output", "o", "yaml", "Output format, one of yaml and json")
+}
+
+func agentRunE(cmd *cobra.Command, _ []string) error {
+	return effectiveConfigRequest(cmd, runtime.ModeAgent)
+}
+
+func controllerLocalRunE(cmd *cobra.Command, _ []string) error {
+	return effectiveConfigRequest(cmd, runtime.ModeController)
+}
+
+func controllerRemoteRunE(cmd *cobra.Command, _ []string) error {
+	return effectiveConfigRequest(cmd, "remote")
+}
+
+func effectiveConfigRequest(cmd *cobra.Command, mode string) error {
+	if option.output != "yaml" && option.output != "json" {
+		return fmt.Errorf("unsupported output format %s, must be one of yaml and json", option.output)
+	}
+	ctx := cmd.Context()
+	kubeconfig, k8sClientset, antreaClientset, err := getClients(cmd)
+	if err != nil {
+		return err
+	}
+
+	client, err := getRestClient(ctx, kubeconfig, k8sClientset, antreaClientset, mode)
+	if err != nil {
+		return err
+	}
+
+	u := url.URL{Path: "/effectiveconfig"}
+	resp, err := client.Get().RequestURI(u.RequestURI()).DoRaw(context.TODO())
+	if err != nil {
+		return fmt.Errorf("error when requesting effective configuration: %w", err)
+	}
+	if option.output == "json" {
+		data, err := yaml.YAMLToJSON(resp)
+		if err != nil {
+			return fmt.Errorf("failed to convert effective configuration to JSON: %w", err)
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, data, "", "  "); err != nil {
+			return fmt.Errorf("failed to format effective configuration: %w", err)
+		}
+		buf.WriteByte('\n')
+		resp = buf.Bytes()
+	}
+	_, err = cmd.OutOrStdout().Write(resp)
+	return err
+}
+
+func getConfigAndClients(cmd *cobra.Command) (*rest.Config, kubernetes.Interface, antrea.Interface, error) {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if server, _ := Command.Flags().GetString("server"); server != "" {
+		kubeconfig.Host = server
+	}
+	k8sClientset, antreaClientset, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+	return kubeconfig, k8sClientset, antreaClientset, nil
+}
+
+func getRestClientByMode(ctx context.Context, kubeconfig *rest.Config, k8sClientset kubernetes.Interface, antreaClientset antrea.Interface, mode string) (*rest.RESTClient, error) {
+	cfg := rest.CopyConfig(kubeconfig)
+	cfg.GroupVersion = &schema.GroupVersion{Group: "", Version: ""}
+	var err error
+	var client *rest.RESTClient
+	switch mode {
+	case runtime.ModeAgent, runtime.ModeController:
+		raw.SetupLocalKubeconfig(cfg)
+		client, err = rest.RESTClientFor(cfg)
+	case "remote":
+		var controllerClientCfg *rest.Config
+		controllerClientCfg, err = raw.CreateControllerClientCfg(ctx, k8sClientset, antreaClientset, cfg, option.insecure)
+		if err != nil {
+			return nil, fmt.Errorf("error when creating controller client config: %w", err)
+		}
+		client, err = rest.RESTClientFor(controllerClientCfg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rest client: %w", err)
+	}
+	return client, nil
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package effectiveconfig
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/rest/fake"
+
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+	"antrea.io/antrea/pkg/client/clientset/versioned/scheme"
+)
+
+var clientConfig = &rest.Config{
+	APIPath: "/effectiveconfig",
+	ContentConfig: rest.ContentConfig{
+		NegotiatedSerializer: scheme.Codecs,
+		GroupVersion:         &appsv1.SchemeGroupVersion,
+	},
+}
+
+func TestGetEffectiveConfig(t *testing.T) {
+	response := []byte(`featureGates:
+  AntreaProxy: true
+  Egress: true
+trafficEncapMode: encap
+apiPort: 10350
+`)
+	tests := []struct {
+		name           string
+		runE           func(cmd *cobra.Command, args []string) error
+		output         string
+		statusCode     int
+		expectedOutput string
+		expectedErr    string
+	}{
+		{
+			name:           "get effective-config in agent Pod",
+			runE:           agentRunE,
+			output:         "yaml",
+			statusCode:     http.StatusOK,
+			expectedOutput: string(response),
+		},
+		{
+			name:       "get effective-config from remote controller in JSON",
+			runE:       controllerRemoteRunE,
+			output:     "json",
+			statusCode: http.StatusOK,
+			expectedOutput: `{
+  "apiPort": 10350,
+  "featureGates": {
+    "AntreaProxy": true,
+    "Egress": true
+  },
+  "trafficEncapMode": "encap"
+}
+`,
+		},
+		{
+			name:        "unsupported output format",
+			runE:        controllerLocalRunE,
+			output:      "table",
+			expectedErr: "unsupported output format table, must be one of yaml and json",
+		},
+		{
+			name:        "effective configuration not available",
+			runE:        controllerLocalRunE,
+			output:      "yaml",
+			statusCode:  http.StatusServiceUnavailable,
+			expectedErr: "error when requesting effective configuration",
+		},
+	}
+
+	getClients = func(cmd *cobra.Command) (*rest.Config, kubernetes.Interface, antrea.Interface, error) {
+		return clientConfig, nil, nil, nil
+	}
+	defer func() {
+		getClients = getConfigAndClients
+		getRestClient = getRestClientByMode
+		option.output = "yaml"
+	}()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getRestClient = getFakeFunc(tt.statusCode, response)
+			option.output = tt.output
+			buf := new(bytes.Buffer)
+			Command.SetOut(buf)
+			Command.SetErr(buf)
+
+			err := tt.runE(Command, nil)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedOutput, buf.String())
+			}
+		})
+	}
+}
+
+func getFakeFunc(statusCode int, response []byte) func(ctx context.Context, kubeconfig *rest.Config, k8sClientset kubernetes.Interface, antreaClientset antrea.Interface, mode string) (*rest.RESTClient, error) {
+	restClient, _ := rest.RESTClientFor(clientConfig)
+	return func(ctx context.Context, kubeconfig *rest.Config, k8sClientset kubernetes.Interface, antreaClientset antrea.Interface, mode string) (*rest.RESTClient, error) {
+		fakeHttpClient := fake.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: statusCode, Body: io.NopCloser(bytes.NewBuffer(response))}, nil
+		})
+		restClient.Client = fakeHttpClient
+		return restClient, nil
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"antrea.io/antrea/pkg/antctl/raw"
+	"antrea.io/antrea/pkg/apis"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+)
+
+var Command *cobra.Command
+
+var getClient = getAntreaClient
+
+func init() {
+	Command = &cobra.Command{
+		Use:   "rollout",
+		Short: "Manage the rollout of Antrea ClusterNetworkPolicies",
+		Long: fmt.Sprintf("Manage the rollout of Antrea ClusterNetworkPolicies annotated with %q, "+
+			"which are rolled out to canary Nodes first.", apis.RolloutCanaryNodeSelectorAnnotationKey),
+	}
+	Command.AddCommand(&cobra.Command{
+		Use:   "pause NAME",
+		Short: "Pause the rollout of an Antrea ClusterNetworkPolicy",
+		Long:  "Pause the rollout of an Antrea ClusterNetworkPolicy: its changes are not rolled out beyond the canary Nodes until the rollout is resumed.",
+		Example: `  Pause the rollout of ClusterNetworkPolicy acnp1
+  $ antctl rollout pause acnp1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setPaused(cmd, args[0], true)
+		},
+	})
+	Command.AddCommand(&cobra.Command{
+		Use:   "resume NAME",
+		Short: "Resume the rollout of an Antrea ClusterNetworkPolicy",
+		Long:  "Resume the paused rollout of an Antrea ClusterNetworkPolicy.",
+		Example: `  Resume the rollout of ClusterNetworkPolicy acnp1
+  $ antctl rollout resume acnp1`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return setPaused(cmd, args[0], false)
+		},
+	})
+}
+
+func getAntreaClient(cmd *cobra.Command) (antrea.Interface, error) {
+	kubeconfig, err := raw.ResolveKubeconfig(cmd)
+	if err != nil {
+		return nil, err
+	}
+	_, client, err := raw.SetupClients(kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %w", err)
+	}
+	return client, nil
+}
+
+func setPaused(cmd *cobra.Command, name string, paused bool) error {
+	client, err := getClient(cmd)
+	if err != nil {
+		return err
+	}
+	// Setting the annotation to null removes it.
+	var value *string
+	if paused {
+		value = new(string)
+		*value = "true"
+	}
+	patch, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]*string{
+				apis.RolloutPausedAnnotationKey: value,
+			},
+		},
+	})
+	if _, err := client.CrdV1beta1().ClusterNetworkPolicies().Patch(cmd.Context(), name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error when patching ClusterNetworkPolicy %s: %w", name, err)
+	}
+	if paused {
+		fmt.Fprintf(cmd.OutOrStdout(), "Rollout of ClusterNetworkPolicy %s paused\n", name)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "Rollout of ClusterNetworkPolicy %s resumed\n", name)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"antrea.io/antrea/pkg/apis"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antrea "antrea.io/antrea/pkg/client/clientset/versioned"
+	antreafakeclient "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+)
+
+func TestPauseAndResume(t *testing.T) {
+	acnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "acnp1",
+			Annotations: map[string]string{
+				apis.RolloutCanaryNodeSelectorAnnotationKey: "canary=true",
+			},
+		},
+	}
+	client := antreafakeclient.NewSimpleClientset(acnp)
+	getClient = func(cmd *cobra.Command) (antrea.Interface, error) {
+		return client, nil
+	}
+	defer func() {
+		getClient = getAntreaClient
+	}()
+
+	run := func(args ...string) string {
+		var buf bytes.Buffer
+		Command.SetOut(&buf)
+		Command.SetArgs(args)
+		require.NoError(t, Command.ExecuteContext(context.Background()))
+		return buf.String()
+	}
+	getAnnotations := func() map[string]string {
+		acnp, err := client.CrdV1beta1().ClusterNetworkPolicies().Get(context.Background(), "acnp1", metav1.GetOptions{})
+		require.NoError(t, err)
+		return acnp.Annotations
+	}
+
+	assert.Equal(t, "Rollout of ClusterNetworkPolicy acnp1 paused\n", run("pause", "acnp1"))
+	assert.Equal(t, map[string]string{
+		apis.RolloutCanaryNodeSelectorAnnotationKey: "canary=true",
+		apis.RolloutPausedAnnotationKey:             "true",
+	}, getAnnotations())
+
+	assert.Equal(t, "Rollout of ClusterNetworkPolicy acnp1 resumed\n", run("resume", "acnp1"))
+	assert.Equal(t, map[string]string{
+		apis.RolloutCanaryNodeSelectorAnnotationKey: "canary=true",
+	}, getAnnotations())
+
+	Command.SetArgs([]string{"pause", "acnp2"})
+	assert.ErrorContains(t, Command.ExecuteContext(context.Background()), "error when patching ClusterNetworkPolicy acnp2")
+}
@@ -0,0 +1,419 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"antrea.io/antrea/pkg/antctl/transform/common"
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+const (
+	// implicitK8sIsolationRule is the name of the implicit rule which drops the traffic not
+	// allowed by the K8s NetworkPolicies applied to a Pod.
+	implicitK8sIsolationRule = "K8sNetworkPolicyIsolation"
+	// implicitDefaultRule is the name of the implicit rule which allows the traffic not matched
+	// by any other rule.
+	implicitDefaultRule = "DefaultAllow"
+
+	// Values of the WINNER column, when a port is provided.
+	winnerYes     = "yes"
+	winnerPartial = "peer-dependent"
+	winnerPass    = "pass"
+)
+
+// EffectiveRuleResponse describes a rule applied to a Pod, for the output of
+// "antctl get networkpolicy --effective".
+type EffectiveRuleResponse struct {
+	// Order is the position of the rule in the evaluation order of its direction, starting at 1.
+	Order     int                `json:"order"`
+	Direction cpv1beta.Direction `json:"direction"`
+	// Policy is the source of the rule, empty for implicit rules.
+	Policy         string   `json:"policy,omitempty"`
+	PolicyName     string   `json:"policyName,omitempty"`
+	TierPriority   *int32   `json:"tierPriority,omitempty"`
+	PolicyPriority *float64 `json:"policyPriority,omitempty"`
+	Rule           string   `json:"rule"`
+	Action         string   `json:"action"`
+	Peers          string   `json:"peers"`
+	Ports          string   `json:"ports"`
+	// Winner is only set when a port is provided. It is "yes" for the rule which determines the
+	// action for all the traffic to the port in this direction, "peer-dependent" for the rules
+	// which come before it and determine the action for the traffic of some peers only, and
+	// "pass" for the Pass rules delegating the decision to lower tiers.
+	Winner string `json:"winner,omitempty"`
+	// Implicit is true for the rules which are not part of any policy.
+	Implicit bool `json:"implicit,omitempty"`
+}
+
+// portFilter is the port provided by the user to determine the winning rules.
+type portFilter struct {
+	protocol cpv1beta.Protocol
+	port     int32
+}
+
+func parsePortFilter(s string) (*portFilter, error) {
+	protocol := cpv1beta.ProtocolTCP
+	portStr := s
+	if protoStr, p, found := strings.Cut(s, "/"); found {
+		protocol = cpv1beta.Protocol(strings.ToUpper(protoStr))
+		portStr = p
+		switch protocol {
+		case cpv1beta.ProtocolTCP, cpv1beta.ProtocolUDP, cpv1beta.ProtocolSCTP:
+		default:
+			return nil, fmt.Errorf("invalid port %s: protocol must be one of TCP, UDP or SCTP", s)
+		}
+	}
+	port, err := strconv.ParseUint(portStr, 10, 16)
+	if err != nil || port == 0 {
+		return nil, fmt.Errorf("invalid port %s: expected format is [protocol/]port", s)
+	}
+	return &portFilter{protocol: protocol, port: int32(port)}, nil
+}
+
+// matches returns whether the traffic to the port is matched by the provided services. A rule
+// without any service matches all traffic. Named ports are not resolved and never match.
+func (f *portFilter) matches(services []cpv1beta.Service) bool {
+	if len(services) == 0 {
+		return true
+	}
+	for _, s := range services {
+		protocol := cpv1beta.ProtocolTCP
+		if s.Protocol != nil {
+			protocol = *s.Protocol
+		}
+		if protocol != f.protocol {
+			continue
+		}
+		if s.Port == nil {
+			return true
+		}
+		if s.Port.Type != intstr.Int {
+			continue
+		}
+		endPort := s.Port.IntVal
+		if s.EndPort != nil {
+			endPort = *s.EndPort
+		}
+		if f.port >= s.Port.IntVal && f.port <= endPort {
+			return true
+		}
+	}
+	return false
+}
+
+// effectiveRule is a rule applied to the Pod, with the information required to sort it.
+type effectiveRule struct {
+	policy *cpv1beta.NetworkPolicy
+	rule   *cpv1beta.NetworkPolicyRule
+	index  int
+	// category is the stage of the evaluation to which the rule belongs.
+	category ruleCategory
+}
+
+type ruleCategory int
+
+const (
+	// Rules of Antrea-native policies and AdminNetworkPolicies in tiers evaluated before K8s
+	// NetworkPolicies.
+	categoryAntreaNative ruleCategory = iota
+	categoryK8sNetworkPolicy
+	categoryK8sIsolation
+	// Rules of Antrea-native policies and BaselineAdminNetworkPolicies in the baseline tier.
+	categoryBaseline
+	categoryDefault
+)
+
+func getRuleCategory(policy *cpv1beta.NetworkPolicy) ruleCategory {
+	if policy.TierPriority == nil {
+		return categoryK8sNetworkPolicy
+	}
+	if *policy.TierPriority >= crdv1beta1.BaselineTierPriority {
+		return categoryBaseline
+	}
+	return categoryAntreaNative
+}
+
+func lessEffectiveRule(a, b *effectiveRule) bool {
+	if a.category != b.category {
+		return a.category < b.category
+	}
+	if a.policy == nil || b.policy == nil {
+		return false
+	}
+	if a.category != categoryK8sNetworkPolicy {
+		if *a.policy.TierPriority != *b.policy.TierPriority {
+			return *a.policy.TierPriority < *b.policy.TierPriority
+		}
+		pa, pb := a.policy.Priority, b.policy.Priority
+		if pa != nil && pb != nil && *pa != *pb {
+			return *pa < *pb
+		}
+	}
+	if a.policy.Name != b.policy.Name {
+		return a.policy.Name < b.policy.Name
+	}
+	if a.rule.Priority != b.rule.Priority {
+		return a.rule.Priority < b.rule.Priority
+	}
+	return a.index < b.index
+}
+
+// effectiveTransform returns the rules of the provided NetworkPolicies, which are all applied to
+// the same Pod, in the order in which they are evaluated for each direction, including the
+// implicit rules. If a port is provided, the rules which determine the action for the traffic
+// to this port are flagged.
+func effectiveTransform(policies []cpv1beta.NetworkPolicy, opts map[string]string) (interface{}, error) {
+	if opts["pod"] == "" {
+		return nil, fmt.Errorf("pod must be provided when using effective")
+	}
+	var filter *portFilter
+	if port := opts["port"]; port != "" {
+		var err error
+		if filter, err = parsePortFilter(port); err != nil {
+			return nil, err
+		}
+	}
+	rulesByDirection := map[cpv1beta.Direction][]*effectiveRule{}
+	for i := range policies {
+		policy := &policies[i]
+		for j := range policy.Rules {
+			rule := &policy.Rules[j]
+			rulesByDirection[rule.Direction] = append(rulesByDirection[rule.Direction], &effectiveRule{
+				policy:   policy,
+				rule:     rule,
+				index:    j,
+				category: getRuleCategory(policy),
+			})
+		}
+	}
+	var result []EffectiveRuleResponse
+	for _, direction := range []cpv1beta.Direction{cpv1beta.DirectionIn, cpv1beta.DirectionOut} {
+		rules := rulesByDirection[direction]
+		for _, r := range rules {
+			// Traffic in a direction is isolated as soon as a K8s NetworkPolicy has a rule
+			// in that direction.
+			if r.category == categoryK8sNetworkPolicy {
+				rules = append(rules, &effectiveRule{category: categoryK8sIsolation})
+				break
+			}
+		}
+		rules = append(rules, &effectiveRule{category: categoryDefault})
+		sort.SliceStable(rules, func(i, j int) bool {
+			return lessEffectiveRule(rules[i], rules[j])
+		})
+		responses := make([]EffectiveRuleResponse, 0, len(rules))
+		for i, r := range rules {
+			responses = append(responses, newEffectiveRuleResponse(i+1, direction, r))
+		}
+		if filter != nil {
+			setWinners(responses, rules, filter)
+		}
+		result = append(result, responses...)
+	}
+	return result, nil
+}
+
+func newEffectiveRuleResponse(order int, direction cpv1beta.Direction, r *effectiveRule) EffectiveRuleResponse {
+	resp := EffectiveRuleResponse{
+		Order:     order,
+		Direction: direction,
+		Peers:     "*",
+		Ports:     "*",
+	}
+	switch r.category {
+	case categoryK8sIsolation:
+		resp.Rule = implicitK8sIsolationRule
+		resp.Action = "Drop"
+		resp.Implicit = true
+		return resp
+	case categoryDefault:
+		resp.Rule = implicitDefaultRule
+		resp.Action = string(crdv1beta1.RuleActionAllow)
+		resp.Implicit = true
+		return resp
+	}
+	if r.policy.SourceRef != nil {
+		resp.Policy = r.policy.SourceRef.ToString()
+	}
+	resp.PolicyName = r.policy.Name
+	resp.TierPriority = r.policy.TierPriority
+	resp.PolicyPriority = r.policy.Priority
+	resp.Rule = r.rule.Name
+	if resp.Rule == "" {
+		resp.Rule = strconv.Itoa(r.index)
+	}
+	resp.Action = string(crdv1beta1.RuleActionAllow)
+	if r.rule.Action != nil {
+		resp.Action = string(*r.rule.Action)
+	}
+	resp.Peers = peerToString(rulePeer(r.rule))
+	resp.Ports = servicesToString(r.rule.Services)
+	return resp
+}
+
+// setWinners flags the rules which determine the action for the traffic to the provided port.
+// The peers of the traffic are not known, so a rule whose peers are restricted only determines
+// the action for the traffic of these peers.
+func setWinners(responses []EffectiveRuleResponse, rules []*effectiveRule, filter *portFilter) {
+	skipUntil := categoryAntreaNative
+	for i, r := range rules {
+		if r.category < skipUntil {
+			continue
+		}
+		if r.category == categoryK8sIsolation || r.category == categoryDefault {
+			responses[i].Winner = winnerYes
+			return
+		}
+		if !filter.matches(r.rule.Services) {
+			continue
+		}
+		if !peerMatchesAll(rulePeer(r.rule)) {
+			responses[i].Winner = winnerPartial
+			continue
+		}
+		if r.rule.Action != nil && *r.rule.Action == crdv1beta1.RuleActionPass {
+			// Pass skips the remaining Antrea-native rules, and delegates the decision to
+			// the K8s NetworkPolicies, or to the default rule when used in the baseline
+			// tier.
+			responses[i].Winner = winnerPass
+			if r.category == categoryBaseline {
+				skipUntil = categoryDefault
+			} else {
+				skipUntil = categoryK8sNetworkPolicy
+			}
+			continue
+		}
+		responses[i].Winner = winnerYes
+		return
+	}
+}
+
+func rulePeer(rule *cpv1beta.NetworkPolicyRule) *cpv1beta.NetworkPolicyPeer {
+	if rule.Direction == cpv1beta.DirectionIn {
+		return &rule.From
+	}
+	return &rule.To
+}
+
+// peerMatchesAll returns whether the peer matches all addresses, which is how rules without
+// any peer are represented in the control plane.
+func peerMatchesAll(peer *cpv1beta.NetworkPolicyPeer) bool {
+	if len(peer.AddressGroups) > 0 || len(peer.FQDNs) > 0 || len(peer.ToServices) > 0 || len(peer.LabelIdentities) > 0 {
+		return false
+	}
+	var matchAllIPv4, matchAllIPv6 bool
+	for _, b := range peer.IPBlocks {
+		if b.CIDR.PrefixLength != 0 || len(b.Except) > 0 {
+			continue
+		}
+		if net.IP(b.CIDR.IP).To4() != nil {
+			matchAllIPv4 = true
+		} else {
+			matchAllIPv6 = true
+		}
+	}
+	return matchAllIPv4 && matchAllIPv6
+}
+
+func peerToString(peer *cpv1beta.NetworkPolicyPeer) string {
+	if peerMatchesAll(peer) {
+		return "*"
+	}
+	var items []string
+	for _, ag := range peer.AddressGroups {
+		items = append(items, "AddressGroup:"+ag)
+	}
+	for _, b := range peer.IPBlocks {
+		s := fmt.Sprintf("%s/%d", net.IP(b.CIDR.IP).String(), b.CIDR.PrefixLength)
+		if len(b.Except) > 0 {
+			var except []string
+			for _, e := range b.Except {
+				except = append(except, fmt.Sprintf("%s/%d", net.IP(e.IP).String(), e.PrefixLength))
+			}
+			s += fmt.Sprintf(" except %s", strings.Join(except, ","))
+		}
+		items = append(items, s)
+	}
+	for _, fqdn := range peer.FQDNs {
+		items = append(items, "FQDN:"+fqdn)
+	}
+	for _, svc := range peer.ToServices {
+		items = append(items, fmt.Sprintf("Service:%s/%s", svc.Namespace, svc.Name))
+	}
+	for _, id := range peer.LabelIdentities {
+		items = append(items, "LabelIdentity:"+strconv.FormatUint(uint64(id), 10))
+	}
+	if len(items) == 0 {
+		return "<none>"
+	}
+	return strings.Join(items, ",")
+}
+
+func servicesToString(services []cpv1beta.Service) string {
+	if len(services) == 0 {
+		return "*"
+	}
+	var items []string
+	for _, s := range services {
+		protocol := cpv1beta.ProtocolTCP
+		if s.Protocol != nil {
+			protocol = *s.Protocol
+		}
+		switch {
+		case s.Port == nil:
+			items = append(items, string(protocol))
+		case s.EndPort != nil:
+			items = append(items, fmt.Sprintf("%s/%s-%d", protocol, s.Port.String(), *s.EndPort))
+		default:
+			items = append(items, fmt.Sprintf("%s/%s", protocol, s.Port.String()))
+		}
+	}
+	return strings.Join(items, ",")
+}
+
+var _ common.TableOutput = new(EffectiveRuleResponse)
+
+func (r EffectiveRuleResponse) GetTableHeader() []string {
+	return []string{"ORDER", "DIRECTION", "POLICY", "TIER-PRIORITY", "PRIORITY", "RULE", "ACTION", "PEERS", "PORTS", "WINNER"}
+}
+
+func (r EffectiveRuleResponse) GetTableRow(maxColumnLength int) []string {
+	peers := r.Peers
+	if maxColumnLength > 0 && len(peers) > maxColumnLength {
+		peers = peers[:maxColumnLength] + "..."
+	}
+	policy := r.Policy
+	if r.Implicit {
+		policy = "<implicit>"
+	}
+	return []string{
+		strconv.Itoa(r.Order), string(r.Direction), policy,
+		priorityToString(r.TierPriority), priorityToString(r.PolicyPriority),
+		r.Rule, r.Action, peers, r.Ports, r.Winner,
+	}
+}
+
+func (r EffectiveRuleResponse) SortRows() bool {
+	return false
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	cpv1beta "antrea.io/antrea/pkg/apis/controlplane/v1beta2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+)
+
+var matchAllPeer = cpv1beta.NetworkPolicyPeer{
+	IPBlocks: []cpv1beta.IPBlock{
+		{CIDR: cpv1beta.IPNet{IP: cpv1beta.IPAddress(net.IPv4zero), PrefixLength: 0}},
+		{CIDR: cpv1beta.IPNet{IP: cpv1beta.IPAddress(net.IPv6zero), PrefixLength: 0}},
+	},
+}
+
+func tcpPort(port int32) []cpv1beta.Service {
+	return []cpv1beta.Service{{Protocol: ptr.To(cpv1beta.ProtocolTCP), Port: ptr.To(intstr.FromInt32(port))}}
+}
+
+func TestEffectiveTransform(t *testing.T) {
+	policies := []cpv1beta.NetworkPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "uid-k8s"},
+			SourceRef:  &cpv1beta.NetworkPolicyReference{Type: cpv1beta.K8sNetworkPolicy, Namespace: "ns1", Name: "allow-web"},
+			Rules: []cpv1beta.NetworkPolicyRule{
+				{Direction: cpv1beta.DirectionIn, From: cpv1beta.NetworkPolicyPeer{AddressGroups: []string{"ag-web"}}, Services: tcpPort(80)},
+			},
+		},
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "uid-baseline"},
+			SourceRef:    &cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaClusterNetworkPolicy, Name: "baseline-drop"},
+			TierPriority: ptr.To(crdv1beta1.BaselineTierPriority),
+			Priority:     ptr.To[float64](1),
+			Rules: []cpv1beta.NetworkPolicyRule{
+				{Direction: cpv1beta.DirectionOut, Name: "drop-all", To: matchAllPeer, Action: ptr.To(crdv1beta1.RuleActionDrop)},
+			},
+		},
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "uid-app"},
+			SourceRef:    &cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaNetworkPolicy, Namespace: "ns1", Name: "app"},
+			TierPriority: ptr.To(crdv1beta1.DefaultTierPriority),
+			Priority:     ptr.To[float64](5),
+			Rules: []cpv1beta.NetworkPolicyRule{
+				{Direction: cpv1beta.DirectionIn, Name: "pass-80", From: matchAllPeer, Services: tcpPort(80), Action: ptr.To(crdv1beta1.RuleActionPass), Priority: 1},
+				{Direction: cpv1beta.DirectionIn, Name: "drop-db", From: matchAllPeer, Services: tcpPort(5432), Action: ptr.To(crdv1beta1.RuleActionDrop), Priority: 0},
+			},
+		},
+		{
+			ObjectMeta:   metav1.ObjectMeta{Name: "uid-security"},
+			SourceRef:    &cpv1beta.NetworkPolicyReference{Type: cpv1beta.AntreaClusterNetworkPolicy, Name: "security"},
+			TierPriority: ptr.To[int32](50),
+			Priority:     ptr.To[float64](10),
+			Rules: []cpv1beta.NetworkPolicyRule{
+				{
+					Direction: cpv1beta.DirectionIn,
+					Name:      "allow-monitoring",
+					From:      cpv1beta.NetworkPolicyPeer{IPBlocks: []cpv1beta.IPBlock{{CIDR: cpv1beta.IPNet{IP: cpv1beta.IPAddress(net.ParseIP("10.0.0.0")), PrefixLength: 8}}}},
+					Action:    ptr.To(crdv1beta1.RuleActionAllow),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		opts          map[string]string
+		expectedRows  []string
+		expectedError string
+	}{
+		{
+			name: "without port",
+			opts: map[string]string{"effective": "", "pod": "ns1/pod1"},
+			expectedRows: []string{
+				"1 In AntreaClusterNetworkPolicy:security 50 10 allow-monitoring Allow 10.0.0.0/8 * ",
+				"2 In AntreaNetworkPolicy:ns1/app 250 5 drop-db Drop * TCP/5432 ",
+				"3 In AntreaNetworkPolicy:ns1/app 250 5 pass-80 Pass * TCP/80 ",
+				"4 In K8sNetworkPolicy:ns1/allow-web   0 Allow AddressGroup:ag-web TCP/80 ",
+				"5 In <implicit>   K8sNetworkPolicyIsolation Drop * * ",
+				"6 In <implicit>   DefaultAllow Allow * * ",
+				"1 Out AntreaClusterNetworkPolicy:baseline-drop 253 1 drop-all Drop * * ",
+				"2 Out <implicit>   DefaultAllow Allow * * ",
+			},
+		},
+		{
+			name: "with port",
+			opts: map[string]string{"effective": "", "pod": "ns1/pod1", "port": "80"},
+			expectedRows: []string{
+				"1 In AntreaClusterNetworkPolicy:security 50 10 allow-monitoring Allow 10.0.0.0/8 * peer-dependent",
+				"2 In AntreaNetworkPolicy:ns1/app 250 5 drop-db Drop * TCP/5432 ",
+				"3 In AntreaNetworkPolicy:ns1/app 250 5 pass-80 Pass * TCP/80 pass",
+				"4 In K8sNetworkPolicy:ns1/allow-web   0 Allow AddressGroup:ag-web TCP/80 peer-dependent",
+				"5 In <implicit>   K8sNetworkPolicyIsolation Drop * * yes",
+				"6 In <implicit>   DefaultAllow Allow * * ",
+				"1 Out AntreaClusterNetworkPolicy:baseline-drop 253 1 drop-all Drop * * yes",
+				"2 Out <implicit>   DefaultAllow Allow * * ",
+			},
+		},
+		{
+			name: "with other port",
+			opts: map[string]string{"effective": "", "pod": "ns1/pod1", "port": "udp/5432"},
+			expectedRows: []string{
+				"1 In AntreaClusterNetworkPolicy:security 50 10 allow-monitoring Allow 10.0.0.0/8 * peer-dependent",
+				"2 In AntreaNetworkPolicy:ns1/app 250 5 drop-db Drop * TCP/5432 ",
+				"3 In AntreaNetworkPolicy:ns1/app 250 5 pass-80 Pass * TCP/80 ",
+				"4 In K8sNetworkPolicy:ns1/allow-web   0 Allow AddressGroup:ag-web TCP/80 ",
+				"5 In <implicit>   K8sNetworkPolicyIsolation Drop * * yes",
+				"6 In <implicit>   DefaultAllow Allow * * ",
+				"1 Out AntreaClusterNetworkPolicy:baseline-drop 253 1 drop-all Drop * * yes",
+				"2 Out <implicit>   DefaultAllow Allow * * ",
+			},
+		},
+		{
+			name:          "without pod",
+			opts:          map[string]string{"effective": ""},
+			expectedError: "pod must be provided when using effective",
+		},
+		{
+			name:          "invalid port",
+			opts:          map[string]string{"effective": "", "pod": "ns1/pod1", "port": "icmp/8"},
+			expectedError: "invalid port icmp/8: protocol must be one of TCP, UDP or SCTP",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := effectiveTransform(policies, tt.opts)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			var rows []string
+			for _, r := range result.([]EffectiveRuleResponse) {
+				row := r.GetTableRow(0)
+				rows = append(rows, fmt.Sprintf("%s %s %s %s %s %s %s %s %s %s", row[0], row[1], row[2], row[3], row[4], row[5], row[6], row[7], row[8], row[9]))
+			}
+			assert.Equal(t, tt.expectedRows, rows)
+		})
+	}
+}
+
+func TestEffectiveTransformNoPolicy(t *testing.T) {
+	result, err := effectiveTransform(nil, map[string]string{"effective": "", "pod": "ns1/pod1", "port": "TCP/443"})
+	require.NoError(t, err)
+	assert.Equal(t, []EffectiveRuleResponse{
+		{Order: 1, Direction: cpv1beta.DirectionIn, Rule: implicitDefaultRule, Action: "Allow", Peers: "*", Ports: "*", Winner: winnerYes, Implicit: true},
+		{Order: 1, Direction: cpv1beta.DirectionOut, Rule: implicitDefaultRule, Action: "Allow", Peers: "*", Ports: "*", Winner: winnerYes, Implicit: true},
+	}, result)
+}
+
+func TestPortFilterMatches(t *testing.T) {
+	filter := &portFilter{protocol: cpv1beta.ProtocolTCP, port: 8080}
+	assert.True(t, filter.matches(nil))
+	assert.True(t, filter.matches([]cpv1beta.Service{{}}))
+	assert.True(t, filter.matches([]cpv1beta.Service{{Protocol: ptr.To(cpv1beta.ProtocolTCP), Port: ptr.To(intstr.FromInt32(8000)), EndPort: ptr.To[int32](9000)}}))
+	assert.False(t, filter.matches([]cpv1beta.Service{{Protocol: ptr.To(cpv1beta.ProtocolUDP)}}))
+	assert.False(t, filter.matches([]cpv1beta.Service{{Port: ptr.To(intstr.FromString("http"))}}))
+	assert.False(t, filter.matches(tcpPort(80)))
+}
@@ -58,6 +58,9 @@ func listTransform(l interface{}, opts map[string]string) (interface{}, error) {
 		sortByEffectivePriority,
 	}
 	policyList := l.(*cpv1beta.NetworkPolicyList)
+	if _, ok := opts["effective"]; ok {
+		return effectiveTransform(policyList.Items, opts)
+	}
 	if len(policyList.Items) == 0 {
 		return "", nil
 	}
@@ -0,0 +1,65 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apis
+
+const (
+	// RolloutCanaryNodeSelectorAnnotationKey can be added to a ClusterNetworkPolicy to roll out its
+	// new generations to the Nodes selected by the label selector first.
+	RolloutCanaryNodeSelectorAnnotationKey = "networkpolicy.antrea.io/rollout-canary-node-selector"
+	// RolloutObservationPeriodAnnotationKey can be added to a ClusterNetworkPolicy to set how long
+	// to wait after a generation is realized on all the canary Nodes before rolling it out to all
+	// the Nodes.
+	RolloutObservationPeriodAnnotationKey = "networkpolicy.antrea.io/rollout-observation-period"
+	// RolloutPausedAnnotationKey can be set to "true" to pause the rollout of a ClusterNetworkPolicy:
+	// its current generation is not rolled out to other Nodes until the annotation is removed.
+	RolloutPausedAnnotationKey = "networkpolicy.antrea.io/rollout-paused"
+	// BreakGlassRulesAnnotationKey can be added to a ClusterNetworkPolicy to temporarily bypass some
+	// of its Drop and Reject rules, identified by a comma-separated list of rule names. It must be set
+	// with BreakGlassExpiryAnnotationKey.
+	BreakGlassRulesAnnotationKey = "networkpolicy.antrea.io/break-glass-rules"
+	// BreakGlassExpiryAnnotationKey sets the time, in RFC 3339 format, at which the rules bypassed
+	// with BreakGlassRulesAnnotationKey are enforced again.
+	BreakGlassExpiryAnnotationKey = "networkpolicy.antrea.io/break-glass-expiry"
+	// BreakGlassActivatedAnnotationKey is set by antrea-controller on a ClusterNetworkPolicy to the
+	// value of BreakGlassExpiryAnnotationKey once the activation of the break-glass has been recorded,
+	// so that it is not recorded again after antrea-controller restarts.
+	BreakGlassActivatedAnnotationKey = "networkpolicy.antrea.io/break-glass-activated"
+	// PodEgressIPAnnotationKey can be added to a Pod to request a specific EgressIP from the
+	// ExternalIPPool set with PodEgressExternalIPPoolAnnotationKey, used to SNAT the egress traffic
+	// of the Pod.
+	PodEgressIPAnnotationKey = "egress.antrea.io/ip"
+	// PodEgressExternalIPPoolAnnotationKey sets the name of the ExternalIPPool from which the IP
+	// requested with PodEgressIPAnnotationKey is allocated.
+	PodEgressExternalIPPoolAnnotationKey = "egress.antrea.io/external-ip-pool"
+	// PodEgressAnnotationKey is set by antrea-controller on a Pod to the name of the Egress created
+	// for the IP requested with PodEgressIPAnnotationKey, once the IP has been allocated.
+	PodEgressAnnotationKey = "egress.antrea.io/egress"
+	// EgressPodAnnotationKey is set by antrea-controller on the Egresses it creates for the IPs
+	// requested with PodEgressIPAnnotationKey, to the "<namespace>/<name>" of the Pod.
+	EgressPodAnnotationKey = "egress.antrea.io/pod"
+	// WatchResumedAnnotationKey is set by antrea-controller on the first bookmark event of a watch of the
+	// controlplane API which resumes a previous watch, to indicate that the following events are the ones
+	// generated since the previous watch, instead of the whole set of objects.
+	WatchResumedAnnotationKey = "controlplane.antrea.io/watch-resumed"
+	// NodeDrainingAnnotationKey is set by antrea-controller on the Nodes which are cordoned, e.g. to be drained.
+	// antrea-agent no longer assigns the Egress IPs and the Service external IPs to these Nodes, so that they are
+	// moved to other Nodes before the Nodes are shut down.
+	NodeDrainingAnnotationKey = "node.antrea.io/draining"
+	// PodNetworkPoliciesAnnotationKey is set by antrea-controller on the Pods which include the
+	// PodNetworkPoliciesRealizedCondition in their readinessGates, to the JSON-encoded list of
+	// PodNetworkPolicy computed for the Pod. antrea-agent only sets the condition once it has
+	// realized all of them.
+	PodNetworkPoliciesAnnotationKey = "networkpolicy.antrea.io/pod-network-policies"
+)
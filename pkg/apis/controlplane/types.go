@@ -202,6 +202,10 @@ const (
 	AntreaNetworkPolicy        NetworkPolicyType = "AntreaNetworkPolicy"
 	AdminNetworkPolicy         NetworkPolicyType = "AdminNetworkPolicy"
 	BaselineAdminNetworkPolicy NetworkPolicyType = "BaselineAdminNetworkPolicy"
+	// NamespaceSecurityPolicy is the type of the NetworkPolicies generated by antrea-controller to isolate the
+	// Namespaces labeled with "policy.antrea.io/isolation: strict". They have the semantics of K8s NetworkPolicies,
+	// and are reported by the NamespaceSecurityPolicy resource of the Namespace.
+	NamespaceSecurityPolicy NetworkPolicyType = "NamespaceSecurityPolicy"
 )
 
 type NetworkPolicyReference struct {
@@ -277,6 +281,10 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string
+	// NodeTrafficTypes is a list of categories of traffic destined to the Node that this rule applies to.
+	// It is only set for ingress rules of policies applied to Nodes. An empty list means the Node OS and
+	// hostNetwork Pods.
+	NodeTrafficTypes []crdv1beta1.NodeTrafficType
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -328,8 +336,9 @@ type Service struct {
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
-	HTTP *HTTPProtocol
-	TLS  *TLSProtocol
+	HTTP  *HTTPProtocol
+	TLS   *TLSProtocol
+	Kafka *KafkaProtocol
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All
@@ -353,6 +362,16 @@ type TLSProtocol struct {
 	SNI string
 }
 
+// KafkaProtocol matches Kafka requests with specific role and topic. All fields could be used alone or together.
+// If all fields are not provided, it matches all Kafka requests.
+type KafkaProtocol struct {
+	// Role represents the role of the Kafka client, which determines the Kafka requests to match.
+	// It could be produce or consume.
+	Role string
+	// Topic represents the name of the Kafka topic to match.
+	Topic string
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could contain one of the subfields or a combination of them.
 type NetworkPolicyPeer struct {
@@ -406,6 +425,18 @@ type NodeStatsSummary struct {
 	AntreaNetworkPolicies []NetworkPolicyStats
 	// Multicast group information from the Node.
 	Multicast []MulticastGroupInfo
+	// The traffic stats of the local Pods collected from the Node, grouped by Namespace.
+	Namespaces []NamespaceTrafficStats
+}
+
+// NamespaceTrafficStats contains the traffic stats of the local Pods of a Namespace, for a given Node.
+type NamespaceTrafficStats struct {
+	// The name of the Namespace.
+	Namespace string
+	// The traffic stats of the connections initiated towards the local Pods of the Namespace.
+	Ingress statsv1alpha1.TrafficStats
+	// The traffic stats of the connections initiated by the local Pods of the Namespace.
+	Egress statsv1alpha1.TrafficStats
 }
 
 // MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.
@@ -692,10 +692,38 @@ func (m *IPNet) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_IPNet proto.InternalMessageInfo
 
+func (m *KafkaProtocol) Reset()      { *m = KafkaProtocol{} }
+func (*KafkaProtocol) ProtoMessage() {}
+func (*KafkaProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+}
+func (m *KafkaProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KafkaProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KafkaProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KafkaProtocol.Merge(m, src)
+}
+func (m *KafkaProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *KafkaProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_KafkaProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KafkaProtocol proto.InternalMessageInfo
+
 func (m *L7Protocol) Reset()      { *m = L7Protocol{} }
 func (*L7Protocol) ProtoMessage() {}
 func (*L7Protocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{23}
+	return fileDescriptor_fbaa7d016762fa1d, []int{24}
 }
 func (m *L7Protocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,7 +751,7 @@ var xxx_messageInfo_L7Protocol proto.InternalMessageInfo
 func (m *MulticastGroupInfo) Reset()      { *m = MulticastGroupInfo{} }
 func (*MulticastGroupInfo) ProtoMessage() {}
 func (*MulticastGroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{24}
+	return fileDescriptor_fbaa7d016762fa1d, []int{25}
 }
 func (m *MulticastGroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +779,7 @@ var xxx_messageInfo_MulticastGroupInfo proto.InternalMessageInfo
 func (m *NamedPort) Reset()      { *m = NamedPort{} }
 func (*NamedPort) ProtoMessage() {}
 func (*NamedPort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{25}
+	return fileDescriptor_fbaa7d016762fa1d, []int{26}
 }
 func (m *NamedPort) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -776,10 +804,38 @@ func (m *NamedPort) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NamedPort proto.InternalMessageInfo
 
+func (m *NamespaceTrafficStats) Reset()      { *m = NamespaceTrafficStats{} }
+func (*NamespaceTrafficStats) ProtoMessage() {}
+func (*NamespaceTrafficStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+}
+func (m *NamespaceTrafficStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceTrafficStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespaceTrafficStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTrafficStats.Merge(m, src)
+}
+func (m *NamespaceTrafficStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceTrafficStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTrafficStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceTrafficStats proto.InternalMessageInfo
+
 func (m *NetworkPolicy) Reset()      { *m = NetworkPolicy{} }
 func (*NetworkPolicy) ProtoMessage() {}
 func (*NetworkPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{26}
+	return fileDescriptor_fbaa7d016762fa1d, []int{28}
 }
 func (m *NetworkPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +863,7 @@ var xxx_messageInfo_NetworkPolicy proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluation) Reset()      { *m = NetworkPolicyEvaluation{} }
 func (*NetworkPolicyEvaluation) ProtoMessage() {}
 func (*NetworkPolicyEvaluation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{27}
+	return fileDescriptor_fbaa7d016762fa1d, []int{29}
 }
 func (m *NetworkPolicyEvaluation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +891,7 @@ var xxx_messageInfo_NetworkPolicyEvaluation proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationRequest) Reset()      { *m = NetworkPolicyEvaluationRequest{} }
 func (*NetworkPolicyEvaluationRequest) ProtoMessage() {}
 func (*NetworkPolicyEvaluationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{28}
+	return fileDescriptor_fbaa7d016762fa1d, []int{30}
 }
 func (m *NetworkPolicyEvaluationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +919,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationRequest proto.InternalMessageInfo
 func (m *NetworkPolicyEvaluationResponse) Reset()      { *m = NetworkPolicyEvaluationResponse{} }
 func (*NetworkPolicyEvaluationResponse) ProtoMessage() {}
 func (*NetworkPolicyEvaluationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{29}
+	return fileDescriptor_fbaa7d016762fa1d, []int{31}
 }
 func (m *NetworkPolicyEvaluationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +947,7 @@ var xxx_messageInfo_NetworkPolicyEvaluationResponse proto.InternalMessageInfo
 func (m *NetworkPolicyList) Reset()      { *m = NetworkPolicyList{} }
 func (*NetworkPolicyList) ProtoMessage() {}
 func (*NetworkPolicyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{30}
+	return fileDescriptor_fbaa7d016762fa1d, []int{32}
 }
 func (m *NetworkPolicyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +975,7 @@ var xxx_messageInfo_NetworkPolicyList proto.InternalMessageInfo
 func (m *NetworkPolicyNodeStatus) Reset()      { *m = NetworkPolicyNodeStatus{} }
 func (*NetworkPolicyNodeStatus) ProtoMessage() {}
 func (*NetworkPolicyNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{31}
+	return fileDescriptor_fbaa7d016762fa1d, []int{33}
 }
 func (m *NetworkPolicyNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1003,7 @@ var xxx_messageInfo_NetworkPolicyNodeStatus proto.InternalMessageInfo
 func (m *NetworkPolicyPeer) Reset()      { *m = NetworkPolicyPeer{} }
 func (*NetworkPolicyPeer) ProtoMessage() {}
 func (*NetworkPolicyPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{32}
+	return fileDescriptor_fbaa7d016762fa1d, []int{34}
 }
 func (m *NetworkPolicyPeer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1031,7 @@ var xxx_messageInfo_NetworkPolicyPeer proto.InternalMessageInfo
 func (m *NetworkPolicyReference) Reset()      { *m = NetworkPolicyReference{} }
 func (*NetworkPolicyReference) ProtoMessage() {}
 func (*NetworkPolicyReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{33}
+	return fileDescriptor_fbaa7d016762fa1d, []int{35}
 }
 func (m *NetworkPolicyReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1059,7 @@ var xxx_messageInfo_NetworkPolicyReference proto.InternalMessageInfo
 func (m *NetworkPolicyRule) Reset()      { *m = NetworkPolicyRule{} }
 func (*NetworkPolicyRule) ProtoMessage() {}
 func (*NetworkPolicyRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{34}
+	return fileDescriptor_fbaa7d016762fa1d, []int{36}
 }
 func (m *NetworkPolicyRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1087,7 @@ var xxx_messageInfo_NetworkPolicyRule proto.InternalMessageInfo
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{35}
+	return fileDescriptor_fbaa7d016762fa1d, []int{37}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1115,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatus) Reset()      { *m = NetworkPolicyStatus{} }
 func (*NetworkPolicyStatus) ProtoMessage() {}
 func (*NetworkPolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{36}
+	return fileDescriptor_fbaa7d016762fa1d, []int{38}
 }
 func (m *NetworkPolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1143,7 @@ var xxx_messageInfo_NetworkPolicyStatus proto.InternalMessageInfo
 func (m *NodeReference) Reset()      { *m = NodeReference{} }
 func (*NodeReference) ProtoMessage() {}
 func (*NodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{37}
+	return fileDescriptor_fbaa7d016762fa1d, []int{39}
 }
 func (m *NodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1171,7 @@ var xxx_messageInfo_NodeReference proto.InternalMessageInfo
 func (m *NodeStatsSummary) Reset()      { *m = NodeStatsSummary{} }
 func (*NodeStatsSummary) ProtoMessage() {}
 func (*NodeStatsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{38}
+	return fileDescriptor_fbaa7d016762fa1d, []int{40}
 }
 func (m *NodeStatsSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1199,7 @@ var xxx_messageInfo_NodeStatsSummary proto.InternalMessageInfo
 func (m *PaginationGetOptions) Reset()      { *m = PaginationGetOptions{} }
 func (*PaginationGetOptions) ProtoMessage() {}
 func (*PaginationGetOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{39}
+	return fileDescriptor_fbaa7d016762fa1d, []int{41}
 }
 func (m *PaginationGetOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1227,7 @@ var xxx_messageInfo_PaginationGetOptions proto.InternalMessageInfo
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{40}
+	return fileDescriptor_fbaa7d016762fa1d, []int{42}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1255,7 @@ var xxx_messageInfo_PodReference proto.InternalMessageInfo
 func (m *RuleRef) Reset()      { *m = RuleRef{} }
 func (*RuleRef) ProtoMessage() {}
 func (*RuleRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{41}
+	return fileDescriptor_fbaa7d016762fa1d, []int{43}
 }
 func (m *RuleRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,7 +1283,7 @@ var xxx_messageInfo_RuleRef proto.InternalMessageInfo
 func (m *Service) Reset()      { *m = Service{} }
 func (*Service) ProtoMessage() {}
 func (*Service) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{42}
+	return fileDescriptor_fbaa7d016762fa1d, []int{44}
 }
 func (m *Service) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1255,7 +1311,7 @@ var xxx_messageInfo_Service proto.InternalMessageInfo
 func (m *ServiceReference) Reset()      { *m = ServiceReference{} }
 func (*ServiceReference) ProtoMessage() {}
 func (*ServiceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{43}
+	return fileDescriptor_fbaa7d016762fa1d, []int{45}
 }
 func (m *ServiceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1339,7 @@ var xxx_messageInfo_ServiceReference proto.InternalMessageInfo
 func (m *SupportBundleCollection) Reset()      { *m = SupportBundleCollection{} }
 func (*SupportBundleCollection) ProtoMessage() {}
 func (*SupportBundleCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{44}
+	return fileDescriptor_fbaa7d016762fa1d, []int{46}
 }
 func (m *SupportBundleCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1367,7 @@ var xxx_messageInfo_SupportBundleCollection proto.InternalMessageInfo
 func (m *SupportBundleCollectionList) Reset()      { *m = SupportBundleCollectionList{} }
 func (*SupportBundleCollectionList) ProtoMessage() {}
 func (*SupportBundleCollectionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{45}
+	return fileDescriptor_fbaa7d016762fa1d, []int{47}
 }
 func (m *SupportBundleCollectionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1395,7 @@ var xxx_messageInfo_SupportBundleCollectionList proto.InternalMessageInfo
 func (m *SupportBundleCollectionNodeStatus) Reset()      { *m = SupportBundleCollectionNodeStatus{} }
 func (*SupportBundleCollectionNodeStatus) ProtoMessage() {}
 func (*SupportBundleCollectionNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{46}
+	return fileDescriptor_fbaa7d016762fa1d, []int{48}
 }
 func (m *SupportBundleCollectionNodeStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,7 +1423,7 @@ var xxx_messageInfo_SupportBundleCollectionNodeStatus proto.InternalMessageInfo
 func (m *SupportBundleCollectionStatus) Reset()      { *m = SupportBundleCollectionStatus{} }
 func (*SupportBundleCollectionStatus) ProtoMessage() {}
 func (*SupportBundleCollectionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{47}
+	return fileDescriptor_fbaa7d016762fa1d, []int{49}
 }
 func (m *SupportBundleCollectionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,7 +1451,7 @@ var xxx_messageInfo_SupportBundleCollectionStatus proto.InternalMessageInfo
 func (m *TLSProtocol) Reset()      { *m = TLSProtocol{} }
 func (*TLSProtocol) ProtoMessage() {}
 func (*TLSProtocol) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fbaa7d016762fa1d, []int{48}
+	return fileDescriptor_fbaa7d016762fa1d, []int{50}
 }
 func (m *TLSProtocol) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1444,9 +1500,11 @@ func init() {
 	proto.RegisterType((*IPBlock)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPBlock")
 	proto.RegisterType((*IPGroupAssociation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPGroupAssociation")
 	proto.RegisterType((*IPNet)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.IPNet")
+	proto.RegisterType((*KafkaProtocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.KafkaProtocol")
 	proto.RegisterType((*L7Protocol)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.L7Protocol")
 	proto.RegisterType((*MulticastGroupInfo)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.MulticastGroupInfo")
 	proto.RegisterType((*NamedPort)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NamedPort")
+	proto.RegisterType((*NamespaceTrafficStats)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NamespaceTrafficStats")
 	proto.RegisterType((*NetworkPolicy)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicy")
 	proto.RegisterType((*NetworkPolicyEvaluation)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyEvaluation")
 	proto.RegisterType((*NetworkPolicyEvaluationRequest)(nil), "antrea_io.antrea.pkg.apis.controlplane.v1beta2.NetworkPolicyEvaluationRequest")
@@ -1477,200 +1535,209 @@ func init() {
 }
 
 var fileDescriptor_fbaa7d016762fa1d = []byte{
-	// 3077 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x1c, 0xc7,
-	0xb1, 0x9a, 0xfd, 0x90, 0xdc, 0xda, 0x25, 0x45, 0x35, 0x6d, 0x8b, 0xcf, 0xb6, 0x48, 0x79, 0xfc,
-	0x9e, 0xa1, 0x17, 0x38, 0x4b, 0x8b, 0xb1, 0x2d, 0xc5, 0x3f, 0x84, 0x4b, 0x51, 0xf4, 0x26, 0x14,
-	0xb5, 0x6e, 0xd2, 0x36, 0x62, 0xc7, 0x8e, 0x87, 0x33, 0xbd, 0xcb, 0xb1, 0x66, 0x67, 0x46, 0xdd,
-	0x3d, 0xb4, 0xe4, 0x43, 0xe0, 0x20, 0xc9, 0xc1, 0xf9, 0x39, 0xc8, 0x25, 0xf0, 0x2d, 0xb7, 0x5c,
-	0x72, 0xcb, 0xcd, 0xa7, 0xf8, 0x10, 0xc0, 0x47, 0x07, 0x41, 0x10, 0x9f, 0x88, 0x98, 0x41, 0x1c,
-	0xf8, 0x60, 0x04, 0xc8, 0x2d, 0x0a, 0x02, 0x04, 0xfd, 0x99, 0xdf, 0x2e, 0x57, 0xd4, 0x92, 0x14,
-	0x13, 0xc4, 0x3a, 0x71, 0xa7, 0xaa, 0xba, 0xaa, 0xba, 0xab, 0xba, 0xeb, 0xd3, 0x4d, 0x78, 0xc6,
-	0xf2, 0x39, 0x25, 0x56, 0xdd, 0x0d, 0xe6, 0xd4, 0xaf, 0xb9, 0xf0, 0x4a, 0x67, 0xce, 0x0a, 0x5d,
-	0x36, 0x67, 0x07, 0x3e, 0xa7, 0x81, 0x17, 0x7a, 0x96, 0x4f, 0xe6, 0xb6, 0xce, 0x6e, 0x10, 0x6e,
-	0xcd, 0xcf, 0x75, 0x88, 0x4f, 0xa8, 0xc5, 0x89, 0x53, 0x0f, 0x69, 0xc0, 0x03, 0x54, 0x57, 0xa3,
-	0xbe, 0xe9, 0x06, 0xfa, 0x57, 0x3d, 0xbc, 0xd2, 0xa9, 0x8b, 0xf1, 0xf5, 0xec, 0xf8, 0xba, 0x1e,
-	0x7f, 0xef, 0xf9, 0xc1, 0xf2, 0x18, 0xb7, 0x38, 0x9b, 0xdb, 0x3a, 0x6b, 0x79, 0xe1, 0xa6, 0x75,
-	0xb6, 0x57, 0xd2, 0xbd, 0x5f, 0xec, 0xb8, 0x7c, 0x33, 0xda, 0xa8, 0xdb, 0x41, 0x77, 0xae, 0x13,
-	0x74, 0x82, 0x39, 0x09, 0xde, 0x88, 0xda, 0xf2, 0x4b, 0x7e, 0xc8, 0x5f, 0x9a, 0xfc, 0xd1, 0x2b,
-	0xe7, 0x99, 0x94, 0x12, 0xba, 0x5d, 0xcb, 0xde, 0x74, 0x7d, 0x42, 0xaf, 0xa7, 0xb2, 0xba, 0x84,
-	0x5b, 0x73, 0x5b, 0xfd, 0x42, 0xe6, 0x06, 0x8d, 0xa2, 0x91, 0xcf, 0xdd, 0x2e, 0xe9, 0x1b, 0xf0,
-	0xf8, 0x5e, 0x03, 0x98, 0xbd, 0x49, 0xba, 0x56, 0xdf, 0xb8, 0x2f, 0x0d, 0x1a, 0x17, 0x71, 0xd7,
-	0x9b, 0x73, 0x7d, 0xce, 0x38, 0xed, 0x1d, 0x64, 0xfe, 0xc5, 0x80, 0xda, 0x82, 0xe3, 0x50, 0xc2,
-	0xd8, 0x32, 0x0d, 0xa2, 0x10, 0xbd, 0x06, 0x63, 0x62, 0x26, 0x8e, 0xc5, 0xad, 0x69, 0xe3, 0xb4,
-	0x71, 0xa6, 0x3a, 0xff, 0x48, 0x5d, 0x31, 0xae, 0x67, 0x19, 0xa7, 0x36, 0x11, 0xd4, 0xf5, 0xad,
-	0xb3, 0xf5, 0xcb, 0x1b, 0xaf, 0x13, 0x9b, 0x5f, 0x22, 0xdc, 0x6a, 0xa0, 0x0f, 0xb6, 0x67, 0x8f,
-	0xed, 0x6c, 0xcf, 0x42, 0x0a, 0xc3, 0x09, 0x57, 0x14, 0x41, 0xad, 0x23, 0x44, 0x5d, 0x22, 0xdd,
-	0x0d, 0x42, 0xd9, 0x74, 0xe1, 0x74, 0xf1, 0x4c, 0x75, 0xfe, 0xc9, 0x21, 0xcd, 0x5e, 0x5f, 0x4e,
-	0x79, 0x34, 0xee, 0xd2, 0x02, 0x6b, 0x19, 0x20, 0xc3, 0x39, 0x31, 0xe6, 0xef, 0x0c, 0x98, 0xcc,
-	0xce, 0x74, 0xc5, 0x65, 0x1c, 0x7d, 0xa3, 0x6f, 0xb6, 0xf5, 0x5b, 0x9b, 0xad, 0x18, 0x2d, 0xe7,
-	0x3a, 0xa9, 0x45, 0x8f, 0xc5, 0x90, 0xcc, 0x4c, 0x2d, 0x28, 0xbb, 0x9c, 0x74, 0xe3, 0x29, 0x3e,
-	0x35, 0xec, 0x14, 0xb3, 0xea, 0x36, 0xc6, 0xb5, 0xa0, 0x72, 0x53, 0xb0, 0xc4, 0x8a, 0xb3, 0xf9,
-	0x76, 0x11, 0x4e, 0x64, 0xc9, 0x5a, 0x16, 0xb7, 0x37, 0x8f, 0xc0, 0x88, 0xdf, 0x35, 0xe0, 0x84,
-	0xe5, 0x38, 0xc4, 0x59, 0x3e, 0x64, 0x53, 0xfe, 0x8f, 0x16, 0x2b, 0x66, 0x95, 0xe7, 0x8e, 0xfb,
-	0x05, 0xa2, 0xef, 0x1b, 0x30, 0x45, 0x49, 0x37, 0xd8, 0xea, 0x51, 0xa4, 0x78, 0x70, 0x45, 0xee,
-	0xd3, 0x8a, 0x4c, 0xe1, 0x7e, 0xfe, 0x78, 0x37, 0xa1, 0xe6, 0xa7, 0x06, 0x4c, 0x2c, 0x84, 0xa1,
-	0xe7, 0x12, 0x67, 0x3d, 0xf8, 0x2f, 0xdf, 0x4d, 0x7f, 0x30, 0x00, 0xe5, 0xe7, 0x7a, 0x04, 0xfb,
-	0xc9, 0xce, 0xef, 0xa7, 0x67, 0x86, 0xde, 0x4f, 0x39, 0x85, 0x07, 0xec, 0xa8, 0x1f, 0x14, 0x61,
-	0x2a, 0x4f, 0x78, 0x67, 0x4f, 0xfd, 0xfb, 0xf6, 0xd4, 0x55, 0x98, 0x6a, 0x58, 0xcc, 0xb5, 0x17,
-	0x22, 0xbe, 0x49, 0x7c, 0xee, 0xda, 0x16, 0x77, 0x03, 0x1f, 0x3d, 0x0c, 0x63, 0x11, 0x23, 0xd4,
-	0xb7, 0xba, 0x44, 0x1a, 0xa3, 0x92, 0xfa, 0xcd, 0xf3, 0x1a, 0x8e, 0x13, 0x0a, 0x41, 0x1d, 0x5a,
-	0x8c, 0xbd, 0x11, 0x50, 0x67, 0xba, 0x90, 0xa7, 0x6e, 0x69, 0x38, 0x4e, 0x28, 0xcc, 0xd7, 0x61,
-	0xb2, 0x11, 0xf9, 0x8e, 0x47, 0x2e, 0xba, 0x1e, 0x59, 0x23, 0x74, 0x8b, 0x50, 0x74, 0x0a, 0x8a,
-	0x11, 0xf5, 0xb4, 0xa8, 0xaa, 0x1e, 0x5c, 0x7c, 0x1e, 0xaf, 0x60, 0x01, 0x47, 0xe7, 0x60, 0x7c,
-	0x33, 0x60, 0xbc, 0x15, 0x6d, 0x78, 0xae, 0xfd, 0x35, 0x72, 0x5d, 0x4a, 0xa9, 0x35, 0x4e, 0xec,
-	0x6c, 0xcf, 0x8e, 0x3f, 0x9b, 0x45, 0xe0, 0x3c, 0x9d, 0xf9, 0x4e, 0x01, 0x4e, 0x29, 0x61, 0x4a,
-	0x90, 0x98, 0xe6, 0x62, 0xe0, 0xb7, 0xdd, 0x4e, 0x44, 0xd5, 0x4c, 0x1f, 0x83, 0xea, 0x06, 0xb1,
-	0x28, 0xa1, 0xeb, 0xc1, 0x15, 0xe2, 0x6b, 0x0d, 0xa6, 0xb4, 0x06, 0xd5, 0x46, 0x8a, 0xc2, 0x59,
-	0x3a, 0xf4, 0x10, 0x8c, 0x58, 0xa1, 0x1b, 0xab, 0x52, 0x69, 0x4c, 0xe8, 0x11, 0x23, 0x0b, 0xad,
-	0xa6, 0xd0, 0x43, 0x63, 0xd1, 0x8f, 0x0d, 0x98, 0xda, 0xe8, 0x5f, 0xe0, 0xe9, 0xa2, 0xf4, 0xf0,
-	0xc5, 0x61, 0x8d, 0xbd, 0x8b, 0xad, 0x1a, 0x27, 0x85, 0xc1, 0x77, 0x41, 0xe0, 0xdd, 0x04, 0x9b,
-	0x3f, 0x2f, 0xc1, 0xd4, 0xa2, 0x17, 0x31, 0x4e, 0x68, 0xce, 0x2b, 0x6f, 0xff, 0xf6, 0xfb, 0xb6,
-	0x01, 0x93, 0xa4, 0xdd, 0x26, 0x36, 0x77, 0xb7, 0xc8, 0x21, 0xee, 0xbe, 0x69, 0x2d, 0x75, 0x72,
-	0xa9, 0x87, 0x39, 0xee, 0x13, 0x87, 0xbe, 0x05, 0x27, 0x12, 0x58, 0xb3, 0xd5, 0xf0, 0x02, 0xfb,
-	0x4a, 0xbc, 0xf1, 0x1e, 0x1b, 0x56, 0x87, 0x66, 0x6b, 0x95, 0xf0, 0x74, 0xef, 0x2f, 0xf5, 0xf2,
-	0xc5, 0xfd, 0xa2, 0xd0, 0x79, 0xa8, 0xf1, 0x80, 0x5b, 0x5e, 0x3c, 0xfd, 0xd2, 0x69, 0xe3, 0x4c,
-	0x31, 0x0d, 0x08, 0xeb, 0x19, 0x1c, 0xce, 0x51, 0xa2, 0x79, 0x00, 0xf9, 0xdd, 0xb2, 0x3a, 0x84,
-	0x4d, 0x97, 0xe5, 0xb8, 0x64, 0xbd, 0xd7, 0x13, 0x0c, 0xce, 0x50, 0x09, 0xdf, 0xb6, 0x23, 0x4a,
-	0x89, 0xcf, 0xc5, 0xf7, 0xf4, 0x88, 0x1c, 0x94, 0xf8, 0xf6, 0x62, 0x8a, 0xc2, 0x59, 0x3a, 0xf3,
-	0x13, 0x03, 0xaa, 0x4b, 0x9d, 0xcf, 0x41, 0xca, 0xfa, 0x5b, 0x03, 0x8e, 0x67, 0x26, 0x7a, 0x04,
-	0x11, 0xf6, 0xb5, 0x7c, 0x84, 0x1d, 0x7a, 0x86, 0x19, 0x6d, 0x07, 0x84, 0xd7, 0x1f, 0x16, 0x61,
-	0x32, 0x43, 0xa5, 0x62, 0xab, 0x03, 0x10, 0x24, 0xeb, 0x7e, 0xa8, 0x36, 0xcc, 0xf0, 0xbd, 0x13,
-	0x5f, 0x77, 0x89, 0xaf, 0x16, 0x8c, 0x2c, 0xf9, 0xdc, 0xe5, 0xd7, 0xd1, 0x8b, 0x50, 0x0c, 0x03,
-	0x47, 0x2f, 0xfe, 0xd0, 0xa5, 0x4a, 0x2b, 0x70, 0x30, 0x69, 0x13, 0x4a, 0x7c, 0x9b, 0x34, 0x46,
-	0x45, 0x70, 0x14, 0x10, 0xc1, 0xd1, 0xf4, 0xe0, 0xe4, 0xd2, 0x35, 0x2e, 0x42, 0xb1, 0xa7, 0x44,
-	0x25, 0x84, 0xe8, 0x34, 0x94, 0x32, 0x21, 0xbc, 0xa6, 0xb5, 0x2f, 0xad, 0x8a, 0xf0, 0x2d, 0x31,
-	0x68, 0x0e, 0x2a, 0xe2, 0x2f, 0x0b, 0x2d, 0x9b, 0xe8, 0x50, 0x76, 0x42, 0x93, 0x55, 0x56, 0x63,
-	0x04, 0x4e, 0x69, 0xcc, 0x7f, 0x18, 0x30, 0x29, 0x67, 0xb8, 0xc0, 0x58, 0x60, 0xbb, 0x2a, 0x88,
-	0x1e, 0x49, 0xee, 0x36, 0x69, 0x69, 0x89, 0x7a, 0x89, 0xf7, 0x9d, 0xa6, 0xca, 0xd1, 0xe9, 0x6a,
-	0x26, 0xf1, 0x63, 0xa1, 0x87, 0x3f, 0xee, 0x93, 0x68, 0xbe, 0x57, 0x82, 0x6a, 0xc6, 0xbe, 0xb7,
-	0xcd, 0xa8, 0xe8, 0x3b, 0x06, 0x4c, 0x90, 0x9c, 0x55, 0xa5, 0x75, 0xaa, 0xf3, 0xcb, 0x43, 0x1f,
-	0x19, 0xbb, 0xfb, 0x46, 0x03, 0xed, 0x6c, 0xcf, 0x4e, 0xf4, 0x20, 0x7b, 0x44, 0xa2, 0x87, 0xa0,
-	0xe8, 0x86, 0x6a, 0xe7, 0xd4, 0x1a, 0x77, 0x09, 0x05, 0x9b, 0x2d, 0x76, 0x63, 0x7b, 0xb6, 0xd2,
-	0x6c, 0xe9, 0xa2, 0x18, 0x0b, 0x02, 0xf4, 0x2a, 0x94, 0xc3, 0x80, 0x72, 0x11, 0xcf, 0x84, 0x45,
-	0xbe, 0x3c, 0xac, 0x8e, 0xc2, 0xd3, 0x9c, 0x56, 0x40, 0x79, 0x7a, 0xa8, 0x89, 0x2f, 0x86, 0x15,
-	0x5b, 0xf4, 0x32, 0x94, 0xfc, 0xc0, 0x21, 0x32, 0xec, 0x55, 0xe7, 0x9f, 0x1e, 0x9a, 0x7d, 0xe0,
-	0x90, 0x74, 0xe2, 0x63, 0x72, 0x0b, 0x08, 0x90, 0x64, 0x8a, 0x3a, 0x30, 0xca, 0x08, 0xdd, 0x72,
-	0x6d, 0x15, 0x21, 0xab, 0xf3, 0x5f, 0x19, 0x96, 0xff, 0x9a, 0x1a, 0x9e, 0x8a, 0xa8, 0xee, 0x6c,
-	0xcf, 0x8e, 0xc6, 0xd0, 0x98, 0xbb, 0xf9, 0x6e, 0x09, 0x6a, 0x77, 0x72, 0xae, 0x3b, 0x39, 0xd7,
-	0x6e, 0x39, 0xd7, 0x2f, 0x0c, 0x98, 0xc8, 0x9f, 0x4b, 0xf9, 0xa3, 0xd9, 0xd8, 0xfb, 0x68, 0x4e,
-	0x4e, 0xfb, 0xc2, 0xc0, 0xd3, 0xbe, 0x01, 0xc5, 0xc8, 0x75, 0x64, 0xf1, 0x51, 0x69, 0x3c, 0x92,
-	0x94, 0x59, 0xcd, 0x0b, 0x37, 0xb6, 0x67, 0x1f, 0x18, 0xd4, 0xde, 0xe4, 0xd7, 0x43, 0xc2, 0xea,
-	0xcf, 0x37, 0x2f, 0x60, 0x31, 0xd8, 0x7c, 0x13, 0x6a, 0xcf, 0xae, 0xaf, 0xb7, 0x5a, 0x34, 0xe0,
-	0x81, 0x1d, 0x78, 0x42, 0xaa, 0xa8, 0xb9, 0x7a, 0x63, 0x8c, 0x28, 0xcb, 0xb0, 0xc4, 0x88, 0x5a,
-	0xa9, 0x4b, 0xf8, 0x66, 0xe0, 0xf4, 0xd6, 0x4a, 0x97, 0x24, 0x14, 0x6b, 0xac, 0xe0, 0x14, 0x5a,
-	0x7c, 0x53, 0xab, 0x97, 0x70, 0x6a, 0x59, 0x7c, 0x13, 0x4b, 0x8c, 0xf9, 0xbe, 0x01, 0xa3, 0xda,
-	0xae, 0xe8, 0x45, 0x28, 0xd9, 0xae, 0x43, 0xf5, 0xc6, 0xd9, 0xa7, 0x27, 0x25, 0x42, 0x16, 0x9b,
-	0x17, 0x30, 0x96, 0x0c, 0xd1, 0x2b, 0x30, 0x42, 0xae, 0xd9, 0x24, 0xe4, 0x7a, 0xa3, 0xec, 0x93,
-	0x75, 0x32, 0xcb, 0x25, 0xc9, 0x0c, 0x6b, 0xa6, 0xe6, 0x3f, 0x0d, 0x40, 0xcd, 0xd6, 0xe7, 0x37,
-	0x84, 0xb6, 0xa1, 0x2c, 0x17, 0x08, 0x3d, 0x08, 0x05, 0x37, 0x94, 0x73, 0xad, 0x35, 0xa6, 0x76,
-	0xb6, 0x67, 0x0b, 0xcd, 0x56, 0x3e, 0xb4, 0x14, 0xdc, 0x50, 0x6c, 0xde, 0x90, 0x92, 0xb6, 0x7b,
-	0x6d, 0x85, 0xf8, 0x1d, 0xbe, 0x29, 0x3d, 0xa8, 0x9c, 0x6e, 0xde, 0x56, 0x06, 0x87, 0x73, 0x94,
-	0xe6, 0xaf, 0x0d, 0x80, 0x95, 0x73, 0x89, 0x9b, 0xbe, 0x04, 0xa5, 0x4d, 0xce, 0xc3, 0xfd, 0x86,
-	0xea, 0xac, 0xcb, 0xab, 0x08, 0x22, 0x20, 0x58, 0xf2, 0x44, 0x2f, 0x40, 0x91, 0x7b, 0x4c, 0x07,
-	0xe8, 0xa1, 0xcf, 0xd5, 0xf5, 0x95, 0xb5, 0x84, 0xb3, 0x4c, 0x02, 0xd6, 0x57, 0xd6, 0xb0, 0x60,
-	0x68, 0xbe, 0x6b, 0x00, 0xba, 0x14, 0x79, 0xa2, 0x76, 0x67, 0x5c, 0x2e, 0x5f, 0xd3, 0x6f, 0x07,
-	0xe8, 0x41, 0x28, 0xcb, 0x32, 0x46, 0x6f, 0xb9, 0x24, 0x64, 0x2a, 0xa3, 0x28, 0x1c, 0x7a, 0x15,
-	0x4a, 0x61, 0xe0, 0xec, 0xbb, 0x35, 0x9e, 0x4b, 0x4d, 0xd2, 0xad, 0x18, 0x38, 0x0c, 0x4b, 0xbe,
-	0xe6, 0xdb, 0x06, 0x54, 0x92, 0xb0, 0x2d, 0xb7, 0x6e, 0x40, 0xd5, 0x21, 0x50, 0xce, 0xd2, 0x53,
-	0x8e, 0x25, 0xe6, 0x16, 0x0e, 0xa7, 0xf3, 0x30, 0x16, 0xea, 0x75, 0xd0, 0x47, 0xc0, 0xfd, 0x49,
-	0x17, 0x49, 0xc3, 0x6f, 0x64, 0x7e, 0xe3, 0x84, 0xda, 0xfc, 0xac, 0x08, 0xe3, 0xab, 0x84, 0xbf,
-	0x11, 0xd0, 0x2b, 0xad, 0xc0, 0x73, 0xed, 0xeb, 0x47, 0xb0, 0x9b, 0xda, 0x50, 0xa6, 0x91, 0x47,
-	0xe2, 0x05, 0x5e, 0x18, 0x3a, 0x27, 0xc9, 0xea, 0x8b, 0x23, 0x8f, 0xa4, 0x76, 0x14, 0x5f, 0x0c,
-	0x2b, 0xf6, 0xe8, 0x69, 0x38, 0x6e, 0xe5, 0xba, 0xa5, 0x2a, 0x76, 0x56, 0xe4, 0x96, 0x39, 0x9e,
-	0x6f, 0xa4, 0x32, 0xdc, 0x4b, 0x8b, 0xce, 0x88, 0x45, 0x75, 0x03, 0x2a, 0x12, 0x48, 0x11, 0xf8,
-	0x8c, 0x46, 0x4d, 0x2d, 0xa8, 0x82, 0xe1, 0x04, 0x8b, 0x1e, 0x85, 0x1a, 0x77, 0x09, 0x8d, 0x31,
-	0x32, 0xdc, 0x95, 0x1b, 0x93, 0x32, 0x44, 0x66, 0xe0, 0x38, 0x47, 0x85, 0x18, 0x54, 0x58, 0x10,
-	0x51, 0x99, 0xfc, 0xe8, 0xf4, 0xe9, 0xe2, 0xc1, 0x96, 0x22, 0xf1, 0xba, 0x71, 0x11, 0xe8, 0xd6,
-	0x62, 0xe6, 0x38, 0x95, 0x63, 0x7e, 0x56, 0x80, 0x93, 0xb9, 0x41, 0x4b, 0x5b, 0x96, 0x17, 0xf5,
-	0x9f, 0xa3, 0xc5, 0xdb, 0xd4, 0xac, 0x18, 0xa5, 0xe4, 0x6a, 0x44, 0x74, 0xcc, 0xab, 0xce, 0xaf,
-	0x1e, 0x68, 0xc2, 0xa9, 0xee, 0x58, 0x71, 0x55, 0xd9, 0xa3, 0xfe, 0xc0, 0xb1, 0x2c, 0x74, 0x1d,
-	0xc6, 0x28, 0x61, 0x61, 0xe0, 0x33, 0xa2, 0x4f, 0x9a, 0xcb, 0x87, 0x26, 0x57, 0xb1, 0x55, 0xae,
-	0x11, 0x7f, 0xe1, 0x44, 0x9c, 0xf9, 0x57, 0x03, 0x66, 0x6e, 0xae, 0x33, 0x7a, 0x15, 0x46, 0x94,
-	0x7d, 0xf4, 0x9a, 0x3c, 0x3e, 0x74, 0x99, 0x22, 0x2b, 0x8e, 0x34, 0x6a, 0x6a, 0xc3, 0x6b, 0xae,
-	0xa8, 0x0b, 0x55, 0x87, 0x30, 0xee, 0xfa, 0xaa, 0x7d, 0x5a, 0x38, 0x90, 0x90, 0x24, 0x1d, 0xbb,
-	0x90, 0xb2, 0xc4, 0x59, 0xfe, 0xe6, 0xaf, 0x0a, 0x30, 0xbb, 0xc7, 0x6a, 0x89, 0x12, 0x6d, 0xdc,
-	0xcf, 0xd2, 0xe8, 0xa9, 0x1f, 0x96, 0xff, 0xdf, 0xad, 0xb5, 0xcc, 0x1f, 0x6d, 0x38, 0x2f, 0x53,
-	0x64, 0x89, 0xe2, 0xa0, 0x68, 0xfa, 0x0e, 0xb9, 0xa6, 0xa3, 0x63, 0x92, 0x25, 0xe2, 0x18, 0x81,
-	0x53, 0x1a, 0xf4, 0x75, 0x28, 0x89, 0x0f, 0xbd, 0x39, 0xce, 0x0d, 0xab, 0xac, 0xe0, 0x89, 0x49,
-	0x3b, 0x3d, 0xc1, 0x25, 0x40, 0xb2, 0x34, 0x7f, 0x6f, 0xc0, 0x89, 0x9c, 0xb2, 0x47, 0xd0, 0x51,
-	0xdb, 0xc8, 0x77, 0xd4, 0x9e, 0x3e, 0xd0, 0xe2, 0x0f, 0xe8, 0xa9, 0xfd, 0xcd, 0xe8, 0x39, 0x6f,
-	0x44, 0xf5, 0xb8, 0xc6, 0x2d, 0x1e, 0x31, 0xf4, 0x30, 0x8c, 0x89, 0x2a, 0x72, 0x75, 0x97, 0x9b,
-	0x92, 0x55, 0x0d, 0xc7, 0x09, 0x85, 0xa8, 0x28, 0xf4, 0x0b, 0x81, 0xd8, 0x8b, 0x33, 0x15, 0xc5,
-	0x72, 0x82, 0xc1, 0x19, 0x2a, 0xf4, 0x55, 0x40, 0x94, 0x58, 0x9e, 0xfb, 0xa6, 0xfc, 0xbc, 0x68,
-	0xb9, 0x5e, 0x44, 0x95, 0xf9, 0xc6, 0x1a, 0xf7, 0xea, 0xb1, 0x08, 0xf7, 0x51, 0xe0, 0x5d, 0x46,
-	0xa1, 0xff, 0x87, 0xd1, 0x2e, 0x61, 0x4c, 0x54, 0x26, 0x25, 0xa9, 0xec, 0x71, 0xcd, 0x60, 0xf4,
-	0x92, 0x02, 0xe3, 0x18, 0x2f, 0x6f, 0xbe, 0x73, 0x93, 0x6e, 0x11, 0x42, 0xd1, 0x39, 0x18, 0xb7,
-	0x32, 0xd7, 0xe1, 0x6c, 0xda, 0x90, 0xc1, 0x48, 0xde, 0xc4, 0x64, 0xef, 0xc9, 0x19, 0xce, 0xd3,
-	0x21, 0x02, 0x63, 0x6e, 0xa8, 0x8b, 0x3f, 0x65, 0xaa, 0x73, 0xc3, 0xe7, 0xd5, 0x72, 0x7c, 0xba,
-	0xc0, 0x49, 0xd5, 0x97, 0xb0, 0x46, 0xb3, 0x50, 0x6e, 0x5f, 0x75, 0xfc, 0x38, 0x48, 0x56, 0x84,
-	0x2d, 0x2f, 0x3e, 0x77, 0x61, 0x95, 0x61, 0x05, 0x47, 0x5c, 0xd4, 0x74, 0xba, 0x34, 0x8f, 0xfb,
-	0x15, 0x07, 0x2f, 0xf8, 0x33, 0x55, 0x61, 0xcc, 0x1b, 0x67, 0xe4, 0x88, 0x28, 0xee, 0x59, 0x1b,
-	0xc4, 0x6b, 0x3a, 0x44, 0x1c, 0x41, 0xae, 0x2c, 0x27, 0x8b, 0x67, 0xc6, 0x55, 0x14, 0x5f, 0xc9,
-	0xa3, 0x70, 0x2f, 0xad, 0xf9, 0x89, 0x01, 0xf7, 0xec, 0x7e, 0x4a, 0xa0, 0xc7, 0xa0, 0x24, 0x0a,
-	0x34, 0xed, 0x7b, 0x0f, 0xc4, 0xbb, 0x72, 0xfd, 0x7a, 0x48, 0x6e, 0x6c, 0xcf, 0xe6, 0x2d, 0x28,
-	0x80, 0x58, 0x92, 0x0f, 0xdd, 0xf7, 0x4b, 0xf2, 0xb7, 0xe2, 0x5e, 0xc5, 0x65, 0xe9, 0x20, 0xc5,
-	0xe5, 0xfb, 0x23, 0x3d, 0x4e, 0x27, 0x4e, 0x17, 0xf4, 0x14, 0x54, 0x1c, 0x97, 0x8a, 0xb2, 0x3e,
-	0x88, 0x6f, 0xe8, 0x66, 0x62, 0x65, 0x2f, 0xc4, 0x88, 0x1b, 0xd9, 0x0f, 0x9c, 0x0e, 0x40, 0x36,
-	0x94, 0xda, 0x34, 0xe8, 0xea, 0x98, 0x71, 0xb0, 0x44, 0x4d, 0xec, 0x81, 0x74, 0xf2, 0x17, 0x69,
-	0xd0, 0xc5, 0x92, 0x39, 0x7a, 0x05, 0x0a, 0x3c, 0xd0, 0x67, 0xea, 0x21, 0x88, 0x00, 0x2d, 0xa2,
-	0xb0, 0x1e, 0xe0, 0x02, 0x0f, 0xc4, 0xee, 0x61, 0x79, 0x9f, 0x3d, 0xb7, 0x4f, 0x9f, 0x4d, 0x77,
-	0x4f, 0xe2, 0xa8, 0x09, 0x6b, 0x79, 0x91, 0xdb, 0x93, 0xff, 0xa5, 0x29, 0x78, 0x5f, 0xc6, 0xf8,
-	0x02, 0x8c, 0x58, 0xca, 0x26, 0x23, 0xd2, 0x26, 0xcf, 0xc8, 0xfb, 0xcf, 0xd8, 0x18, 0x8f, 0xdc,
-	0xe4, 0x99, 0x1a, 0x75, 0xf4, 0xeb, 0xb4, 0xb3, 0x32, 0x9e, 0xa8, 0x31, 0x58, 0x73, 0x43, 0x4f,
-	0xc2, 0x38, 0xf1, 0xad, 0x0d, 0x8f, 0xac, 0x04, 0x9d, 0x8e, 0xeb, 0x77, 0xa6, 0x47, 0xe5, 0x59,
-	0x97, 0xc4, 0xc3, 0xa5, 0x2c, 0x12, 0xe7, 0x69, 0x77, 0xcb, 0x97, 0xc7, 0x86, 0xc8, 0x97, 0x63,
-	0x37, 0xaf, 0x0c, 0x74, 0xf3, 0xab, 0x50, 0xf5, 0x92, 0xb2, 0x92, 0x4d, 0x83, 0xb4, 0xc6, 0x13,
-	0xc3, 0x5a, 0x23, 0xad, 0x4c, 0xd3, 0x6c, 0x24, 0x85, 0x31, 0x9c, 0x95, 0x21, 0xcc, 0xe2, 0x05,
-	0x1d, 0x79, 0x4a, 0x4c, 0x57, 0xf3, 0x31, 0x66, 0x45, 0xc3, 0x71, 0x42, 0x61, 0xbe, 0x53, 0x04,
-	0x94, 0xf3, 0x28, 0x11, 0xa9, 0xd8, 0x7f, 0x48, 0xba, 0x12, 0x42, 0x8d, 0x53, 0xab, 0xdd, 0x76,
-	0x6d, 0xa9, 0xd5, 0x2d, 0x24, 0x72, 0xf2, 0x8d, 0x61, 0x3d, 0x7e, 0x63, 0x58, 0x5f, 0xcf, 0x8c,
-	0xce, 0x34, 0xf1, 0x32, 0x50, 0x9c, 0x93, 0x80, 0xde, 0x32, 0x60, 0x52, 0x64, 0x27, 0x59, 0x12,
-	0xdd, 0x7e, 0x7c, 0xe2, 0xd6, 0xc5, 0xe2, 0x1e, 0x0e, 0x69, 0xcb, 0xa3, 0x17, 0x83, 0xfb, 0xa4,
-	0x99, 0x7f, 0x36, 0x60, 0xaa, 0xcf, 0x22, 0xd1, 0x51, 0xf4, 0x7f, 0x3d, 0x28, 0x8b, 0xdc, 0x23,
-	0x0e, 0xb9, 0xcb, 0x07, 0xb2, 0x75, 0x9a, 0xf5, 0xa4, 0x79, 0x92, 0x80, 0x31, 0xac, 0x84, 0x98,
-	0x67, 0x61, 0x3c, 0xd7, 0x6a, 0xdf, 0xfb, 0xfe, 0xc9, 0x7c, 0xaf, 0x0c, 0x93, 0x31, 0x5f, 0xb6,
-	0x16, 0x75, 0xbb, 0x16, 0x3d, 0x8a, 0xea, 0xfd, 0x7b, 0x06, 0x1c, 0xcf, 0x3a, 0xa6, 0x9b, 0x2c,
-	0x51, 0xe3, 0x40, 0x4b, 0xa4, 0x7c, 0xe3, 0xa4, 0x96, 0x7d, 0x7c, 0x35, 0x2f, 0x02, 0xf7, 0xca,
-	0x44, 0xbf, 0x34, 0xe0, 0x7e, 0x25, 0x45, 0xbf, 0xc9, 0xe8, 0x19, 0xa1, 0x1d, 0xf5, 0x30, 0x94,
-	0xfa, 0x5f, 0xad, 0xd4, 0xfd, 0x0b, 0x37, 0x91, 0x87, 0x6f, 0xaa, 0x0d, 0xfa, 0x99, 0x01, 0x77,
-	0x2b, 0x82, 0x5e, 0x3d, 0x4b, 0x87, 0xa6, 0xe7, 0x29, 0xad, 0xe7, 0xdd, 0x0b, 0xbb, 0x09, 0xc2,
-	0xbb, 0xcb, 0x47, 0x0c, 0x2a, 0xdd, 0xb8, 0x53, 0x26, 0x53, 0xab, 0x7d, 0x28, 0xd3, 0xdf, 0x6a,
-	0x4b, 0x73, 0xa2, 0x04, 0x87, 0x53, 0x39, 0xe6, 0x2b, 0x70, 0x57, 0xcb, 0xea, 0xe8, 0x9a, 0x71,
-	0x99, 0xf0, 0xcb, 0xa1, 0xf8, 0xc1, 0x54, 0x23, 0xbb, 0xa3, 0xdc, 0xbe, 0x98, 0x6d, 0x64, 0x77,
-	0x08, 0x96, 0x18, 0xf4, 0x20, 0x94, 0x3d, 0xb7, 0xeb, 0x72, 0x5d, 0x02, 0x24, 0xdb, 0x69, 0x45,
-	0x00, 0xb1, 0xc2, 0x99, 0x16, 0xd4, 0xb2, 0x6d, 0xb8, 0xdb, 0x71, 0x9b, 0xfb, 0xbe, 0x01, 0xa3,
-	0xba, 0xa2, 0x3b, 0x60, 0x96, 0xb5, 0x77, 0x7f, 0x2f, 0x4d, 0x17, 0x8a, 0x87, 0x99, 0x2e, 0x98,
-	0xbf, 0x29, 0x42, 0x7c, 0xd7, 0x86, 0x1e, 0xcd, 0xf4, 0x10, 0xd5, 0x14, 0xa6, 0xf7, 0xee, 0x1f,
-	0xa2, 0x55, 0xdd, 0xbd, 0x2c, 0xec, 0x71, 0xd6, 0x44, 0xdc, 0xf5, 0xea, 0xea, 0xa1, 0x77, 0xbd,
-	0xe9, 0xf3, 0xcb, 0x74, 0x8d, 0x53, 0xd7, 0xef, 0xa8, 0x7e, 0x70, 0xa6, 0xd7, 0xf9, 0x7f, 0x30,
-	0x4a, 0x7c, 0xd9, 0x18, 0x95, 0x53, 0x2d, 0xab, 0x8e, 0xce, 0x92, 0x02, 0xe1, 0x18, 0x87, 0xce,
-	0xc0, 0x98, 0x6b, 0x77, 0x43, 0x91, 0x95, 0xcb, 0xac, 0xb9, 0xac, 0x1a, 0x30, 0xcd, 0xc5, 0x4b,
-	0x2d, 0x99, 0xa9, 0x27, 0xd8, 0x98, 0x72, 0x31, 0xbe, 0x03, 0xcd, 0x50, 0x0a, 0x18, 0x4e, 0xb0,
-	0x92, 0xb2, 0xa3, 0x79, 0x8e, 0x64, 0x28, 0x97, 0x13, 0x9e, 0x1a, 0x8b, 0xce, 0xeb, 0x37, 0x37,
-	0xba, 0x6a, 0x93, 0x49, 0x56, 0xa5, 0xe7, 0xd9, 0x4c, 0xdc, 0x89, 0xcf, 0x51, 0x8a, 0xe9, 0x31,
-	0x6a, 0xcb, 0xe9, 0x8d, 0xa5, 0xd3, 0x5b, 0x53, 0x20, 0x1c, 0xe3, 0x50, 0x1d, 0x80, 0x51, 0x5b,
-	0xcf, 0x5a, 0x26, 0x54, 0xe5, 0xc6, 0x84, 0x38, 0x91, 0xd7, 0x12, 0x28, 0xce, 0x50, 0x98, 0x04,
-	0x26, 0x7b, 0xeb, 0xaa, 0xdb, 0xe1, 0xf2, 0xef, 0x94, 0xe0, 0xe4, 0x5a, 0x14, 0x0a, 0x43, 0xa9,
-	0x97, 0x81, 0x8b, 0x81, 0xe7, 0x69, 0x27, 0xbe, 0xfd, 0x81, 0xe7, 0x65, 0xa8, 0x90, 0x6b, 0xa1,
-	0x4b, 0x89, 0xb3, 0x10, 0xfb, 0xdb, 0x17, 0x6e, 0x4d, 0xc4, 0xba, 0xdb, 0x25, 0xe9, 0xd4, 0x96,
-	0x62, 0x26, 0x38, 0xe5, 0x27, 0xd6, 0x82, 0xb9, 0xbe, 0x4d, 0x04, 0xa9, 0xde, 0x64, 0xc9, 0x80,
-	0xb5, 0x18, 0x81, 0x53, 0x1a, 0x51, 0x0c, 0xb7, 0x93, 0x47, 0x98, 0xd2, 0x07, 0xf7, 0x51, 0x0c,
-	0xf7, 0x3e, 0xe6, 0x4c, 0x57, 0x20, 0x85, 0xe1, 0x8c, 0x1c, 0xf4, 0x23, 0x03, 0x26, 0xac, 0xfc,
-	0x73, 0x48, 0x75, 0xb1, 0x7f, 0x69, 0x7f, 0xa2, 0x07, 0x3c, 0xed, 0x6c, 0xdc, 0xa3, 0xf5, 0x98,
-	0xe8, 0x79, 0x17, 0xd9, 0x23, 0xdc, 0xfc, 0xd4, 0x80, 0xfb, 0x06, 0x78, 0xc4, 0x11, 0x34, 0xb0,
-	0xbc, 0x7c, 0x03, 0x6b, 0xe8, 0x14, 0x6d, 0x80, 0xe6, 0x03, 0x5a, 0x59, 0x3f, 0x2d, 0xc0, 0x03,
-	0x03, 0x46, 0xec, 0xbb, 0xa9, 0xf5, 0x24, 0x8c, 0xc7, 0xbf, 0xb3, 0xdb, 0x30, 0x2d, 0x08, 0xb2,
-	0x48, 0x9c, 0xa7, 0x8d, 0x45, 0xc9, 0x03, 0xab, 0xd8, 0x2f, 0x4a, 0x1d, 0x5a, 0x31, 0x85, 0xf0,
-	0x70, 0x3b, 0xe8, 0x86, 0x1e, 0xe1, 0x44, 0x75, 0x1a, 0xc6, 0x52, 0x0f, 0x5f, 0x8c, 0x11, 0x38,
-	0xa5, 0x11, 0x81, 0x96, 0x50, 0x1a, 0x50, 0xe9, 0x61, 0x99, 0xbb, 0xb2, 0x25, 0x01, 0xc4, 0x0a,
-	0x67, 0xfe, 0xdd, 0x80, 0x53, 0x03, 0x16, 0xe5, 0xc8, 0x32, 0xf5, 0xad, 0x7c, 0xa6, 0xfe, 0xdc,
-	0x21, 0xb9, 0xc1, 0x9e, 0x39, 0xfb, 0xc3, 0x50, 0xcd, 0x5c, 0x40, 0xa2, 0x53, 0x50, 0x64, 0xbe,
-	0xdb, 0xfb, 0x10, 0x7b, 0x6d, 0xb5, 0x89, 0x05, 0xbc, 0xb1, 0xfe, 0xc1, 0xc7, 0x33, 0xc7, 0x3e,
-	0xfc, 0x78, 0xe6, 0xd8, 0x47, 0x1f, 0xcf, 0x1c, 0x7b, 0x6b, 0x67, 0xc6, 0xf8, 0x60, 0x67, 0xc6,
-	0xf8, 0x70, 0x67, 0xc6, 0xf8, 0x68, 0x67, 0xc6, 0xf8, 0xe3, 0xce, 0x8c, 0xf1, 0x93, 0x3f, 0xcd,
-	0x1c, 0x7b, 0xa9, 0x3e, 0xdc, 0x7f, 0xa8, 0xfd, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x53, 0x7e, 0xc9,
-	0xb1, 0xd2, 0x36, 0x00, 0x00,
+	// 3217 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x1b, 0x4b, 0x6c, 0x24, 0x47,
+	0x35, 0x3d, 0x1f, 0xdb, 0x53, 0x33, 0xde, 0xf5, 0x96, 0xb3, 0x59, 0x93, 0x64, 0x77, 0x93, 0xe6,
+	0xa3, 0x80, 0xc2, 0x38, 0x6b, 0xb2, 0xd9, 0x25, 0x3f, 0xe1, 0xf6, 0x7a, 0x9d, 0x21, 0xb6, 0x77,
+	0x52, 0x9e, 0x04, 0x91, 0x10, 0x48, 0x7b, 0xba, 0x66, 0xdc, 0x71, 0xcf, 0x74, 0x6f, 0x7f, 0x9c,
+	0xdd, 0x1c, 0x50, 0x10, 0x70, 0x80, 0x00, 0x41, 0x5c, 0x50, 0x6e, 0xb9, 0x71, 0xe1, 0x86, 0xb8,
+	0x20, 0x2e, 0x1c, 0x90, 0x72, 0x0c, 0x42, 0x88, 0x9c, 0x22, 0x08, 0x02, 0xc4, 0x21, 0x42, 0xe2,
+	0x46, 0x10, 0x12, 0xf5, 0xaa, 0xaa, 0xbb, 0xab, 0x7b, 0x66, 0xd6, 0x3b, 0x1e, 0xaf, 0x41, 0x64,
+	0x0f, 0x63, 0x4f, 0xbf, 0xf7, 0xea, 0xbd, 0xaa, 0x7a, 0xef, 0xd5, 0xfb, 0x54, 0x0f, 0x7a, 0xd2,
+	0xec, 0x87, 0x3e, 0x35, 0xeb, 0xb6, 0xbb, 0x28, 0xbe, 0x2d, 0x7a, 0xbb, 0xdd, 0x45, 0xd3, 0xb3,
+	0x83, 0xc5, 0xb6, 0xcb, 0x00, 0xae, 0xe3, 0x39, 0x66, 0x9f, 0x2e, 0xee, 0x9d, 0xdb, 0xa6, 0xa1,
+	0xb9, 0xb4, 0xd8, 0xa5, 0x7d, 0xea, 0x9b, 0x21, 0xb5, 0xea, 0x9e, 0xef, 0x86, 0x2e, 0xae, 0x8b,
+	0x51, 0x5f, 0xb3, 0x5d, 0xf9, 0xad, 0xce, 0xc6, 0xd7, 0x61, 0x7c, 0x5d, 0x1d, 0x5f, 0x97, 0xe3,
+	0xef, 0xbe, 0x38, 0x5a, 0x5e, 0x10, 0x9a, 0x61, 0xc0, 0x04, 0x99, 0x8e, 0xb7, 0x63, 0x9e, 0xcb,
+	0x4b, 0xba, 0xfb, 0xb3, 0x5d, 0x3b, 0xdc, 0x89, 0xb6, 0x19, 0xdb, 0xde, 0x62, 0xd7, 0xed, 0xba,
+	0x8b, 0x1c, 0xbc, 0x1d, 0x75, 0xf8, 0x13, 0x7f, 0xe0, 0xdf, 0x24, 0xf9, 0xc3, 0xbb, 0x17, 0x03,
+	0x2e, 0xc5, 0xb3, 0x7b, 0x66, 0x7b, 0xc7, 0x66, 0xcc, 0xae, 0xa7, 0xb2, 0x7a, 0x6c, 0x32, 0x4c,
+	0xd4, 0x80, 0x90, 0xc5, 0x51, 0xa3, 0xfc, 0xa8, 0x1f, 0xda, 0x3d, 0x3a, 0x30, 0xe0, 0x91, 0xfd,
+	0x06, 0x04, 0xed, 0x1d, 0xda, 0x33, 0x07, 0xc6, 0x7d, 0x6e, 0xd4, 0xb8, 0x28, 0xb4, 0x9d, 0x45,
+	0xbb, 0x1f, 0x06, 0xa1, 0x9f, 0x1f, 0xa4, 0xff, 0x55, 0x43, 0xb5, 0x65, 0xcb, 0xf2, 0x69, 0x10,
+	0xac, 0xf9, 0x6e, 0xe4, 0xe1, 0x97, 0xd0, 0x0c, 0xac, 0xc4, 0x32, 0x43, 0x73, 0x41, 0xbb, 0x4f,
+	0x7b, 0xa0, 0xba, 0xf4, 0x50, 0x5d, 0x30, 0xae, 0xab, 0x8c, 0x53, 0x9d, 0x00, 0x35, 0xd3, 0x45,
+	0xfd, 0xca, 0xf6, 0xcb, 0xb4, 0x1d, 0x6e, 0xb0, 0x27, 0x03, 0xbf, 0xfd, 0xde, 0xd9, 0x3b, 0xde,
+	0x7f, 0xef, 0x2c, 0x4a, 0x61, 0x24, 0xe1, 0x8a, 0x23, 0x54, 0xeb, 0x82, 0xa8, 0x0d, 0xda, 0xdb,
+	0xa6, 0x7e, 0xb0, 0x50, 0xb8, 0xaf, 0xc8, 0xa4, 0x3c, 0x36, 0xa6, 0xda, 0xeb, 0x6b, 0x29, 0x0f,
+	0xe3, 0x4e, 0x29, 0xb0, 0xa6, 0x00, 0x03, 0x92, 0x11, 0xa3, 0xff, 0x56, 0x43, 0x73, 0xea, 0x4a,
+	0xd7, 0xed, 0x20, 0xc4, 0x5f, 0x19, 0x58, 0x6d, 0xfd, 0xe6, 0x56, 0x0b, 0xa3, 0xf9, 0x5a, 0xe7,
+	0xa4, 0xe8, 0x99, 0x18, 0xa2, 0xac, 0xd4, 0x44, 0x65, 0x3b, 0xa4, 0xbd, 0x78, 0x89, 0x8f, 0x8f,
+	0xbb, 0x44, 0x75, 0xba, 0xc6, 0xac, 0x14, 0x54, 0x6e, 0x00, 0x4b, 0x22, 0x38, 0xeb, 0xdf, 0x29,
+	0xa2, 0x13, 0x2a, 0x59, 0xd3, 0x0c, 0xdb, 0x3b, 0x47, 0xa0, 0xc4, 0x6f, 0x69, 0xe8, 0x84, 0x69,
+	0x59, 0xd4, 0x5a, 0x3b, 0x64, 0x55, 0x7e, 0x4c, 0x8a, 0x85, 0x55, 0x65, 0xb9, 0x93, 0x41, 0x81,
+	0xf8, 0xbb, 0x1a, 0x9a, 0xf7, 0x69, 0xcf, 0xdd, 0xcb, 0x4d, 0xa4, 0x38, 0xf9, 0x44, 0xee, 0x91,
+	0x13, 0x99, 0x27, 0x83, 0xfc, 0xc9, 0x30, 0xa1, 0xfa, 0xdf, 0x34, 0x74, 0x6c, 0xd9, 0xf3, 0x1c,
+	0x9b, 0x5a, 0x2d, 0xf7, 0xff, 0xdc, 0x9b, 0x7e, 0xaf, 0x21, 0x9c, 0x5d, 0xeb, 0x11, 0xf8, 0x53,
+	0x3b, 0xeb, 0x4f, 0x4f, 0x8e, 0xed, 0x4f, 0x99, 0x09, 0x8f, 0xf0, 0xa8, 0xd7, 0x8b, 0x68, 0x3e,
+	0x4b, 0x78, 0xdb, 0xa7, 0xfe, 0x7b, 0x3e, 0x75, 0x15, 0xcd, 0x1b, 0x66, 0x60, 0xb7, 0x97, 0xa3,
+	0x70, 0x87, 0xb2, 0xf0, 0xd7, 0x36, 0x43, 0xdb, 0xed, 0xe3, 0x07, 0xd1, 0x4c, 0x14, 0x50, 0xbf,
+	0x6f, 0xf6, 0x28, 0x57, 0x46, 0x25, 0xb5, 0x9b, 0x67, 0x25, 0x9c, 0x24, 0x14, 0x40, 0xed, 0x99,
+	0x41, 0xf0, 0x8a, 0xeb, 0x5b, 0x6c, 0x3b, 0x33, 0xd4, 0x4d, 0x09, 0x27, 0x09, 0x85, 0xfe, 0x32,
+	0x9a, 0x33, 0xa2, 0xbe, 0xe5, 0xd0, 0xcb, 0xb6, 0x43, 0xb7, 0xa8, 0xbf, 0x47, 0x7d, 0x7c, 0x1a,
+	0x15, 0x23, 0xdf, 0x91, 0xa2, 0xaa, 0x72, 0x70, 0xf1, 0x59, 0xb2, 0x4e, 0x00, 0x8e, 0x2f, 0xa0,
+	0xd9, 0x1d, 0x37, 0x08, 0x9b, 0xd1, 0xb6, 0x63, 0xb7, 0x9f, 0xa6, 0xd7, 0xb9, 0x94, 0x9a, 0x71,
+	0x82, 0x11, 0xcd, 0x3e, 0xa5, 0x22, 0x48, 0x96, 0x4e, 0x7f, 0xa3, 0x80, 0x4e, 0x0b, 0x61, 0x42,
+	0x10, 0x2c, 0x73, 0xc5, 0xed, 0x77, 0xec, 0x6e, 0xe4, 0x8b, 0x95, 0x9e, 0x47, 0xd5, 0x6d, 0x6a,
+	0xfa, 0xd4, 0x6f, 0xb9, 0xbb, 0xb4, 0x2f, 0x67, 0x30, 0x2f, 0x67, 0x50, 0x35, 0x52, 0x14, 0x51,
+	0xe9, 0xf0, 0xa7, 0xd0, 0x14, 0x53, 0x49, 0x3c, 0x95, 0x8a, 0x71, 0x4c, 0x8e, 0x98, 0x5a, 0x6e,
+	0x36, 0x60, 0x1e, 0x12, 0x8b, 0x7f, 0xc0, 0x94, 0xbd, 0x3d, 0xb8, 0xc1, 0x4c, 0xd9, 0x60, 0xe1,
+	0x2b, 0xe3, 0x2a, 0x7b, 0x88, 0xae, 0x8c, 0x53, 0xa0, 0xf0, 0x21, 0x08, 0x32, 0x4c, 0xb0, 0xfe,
+	0x56, 0x09, 0xcd, 0xaf, 0x38, 0x51, 0x10, 0x52, 0x3f, 0x63, 0x95, 0xb7, 0xde, 0xfd, 0xbe, 0xc1,
+	0x12, 0x04, 0xda, 0xe9, 0x30, 0x84, 0xbd, 0x47, 0x0f, 0xd1, 0xfb, 0x16, 0xa4, 0xd4, 0xb9, 0xd5,
+	0x1c, 0x73, 0x32, 0x20, 0x0e, 0x7f, 0x1d, 0x9d, 0x48, 0x60, 0x8d, 0xa6, 0xe1, 0xb8, 0xed, 0xdd,
+	0xd8, 0xf1, 0xce, 0x8f, 0x3b, 0x87, 0x46, 0x73, 0x93, 0x86, 0xa9, 0xef, 0xaf, 0xe6, 0xf9, 0x92,
+	0x41, 0x51, 0xf8, 0x22, 0xaa, 0x85, 0x6e, 0x68, 0x3a, 0xf1, 0xf2, 0x4b, 0x6c, 0xa7, 0x8b, 0x69,
+	0x40, 0x68, 0x29, 0x38, 0x92, 0xa1, 0xc4, 0x4b, 0x08, 0xf1, 0xe7, 0xa6, 0xd9, 0xa5, 0xc1, 0x42,
+	0x99, 0x8f, 0x4b, 0xf6, 0xbb, 0x95, 0x60, 0x88, 0x42, 0x05, 0xb6, 0xdd, 0x8e, 0x7c, 0x9f, 0x69,
+	0x1f, 0x9e, 0x17, 0xa6, 0xf8, 0xa0, 0xc4, 0xb6, 0x57, 0x52, 0x14, 0x51, 0xe9, 0xf4, 0xbf, 0x68,
+	0xa8, 0xba, 0xda, 0xfd, 0x08, 0xa4, 0xac, 0xbf, 0xd1, 0xd0, 0x71, 0x65, 0xa1, 0x47, 0x10, 0x61,
+	0x5f, 0xca, 0x46, 0xd8, 0xb1, 0x57, 0xa8, 0xcc, 0x76, 0x44, 0x78, 0xfd, 0x5e, 0x11, 0xcd, 0x29,
+	0x54, 0x22, 0xb6, 0x5a, 0x08, 0xb9, 0xc9, 0xbe, 0x1f, 0xaa, 0x0e, 0x15, 0xbe, 0xb7, 0xe3, 0xeb,
+	0x90, 0xf8, 0x6a, 0xa2, 0xa9, 0x55, 0x76, 0xf8, 0x86, 0xd7, 0xf1, 0x97, 0x50, 0xd1, 0x73, 0x2d,
+	0xb9, 0xf9, 0x63, 0x97, 0x2a, 0x4d, 0xd7, 0x22, 0xb4, 0x43, 0x99, 0x8f, 0xb6, 0xa9, 0x31, 0x0d,
+	0xc1, 0x11, 0x20, 0xc0, 0x51, 0x77, 0xd0, 0xa9, 0xd5, 0x6b, 0x21, 0x84, 0x62, 0x47, 0x88, 0x4a,
+	0x08, 0xf1, 0x7d, 0xa8, 0xa4, 0x84, 0xf0, 0x9a, 0x9c, 0x7d, 0x69, 0x13, 0xc2, 0x37, 0xc7, 0xe0,
+	0x45, 0x54, 0x81, 0xff, 0x81, 0x67, 0xb6, 0xa9, 0x0c, 0x65, 0x27, 0x24, 0x59, 0x65, 0x33, 0x46,
+	0x90, 0x94, 0x46, 0xff, 0x17, 0x3b, 0xc5, 0xf9, 0x0a, 0x97, 0x83, 0xc0, 0x6d, 0xdb, 0x22, 0x88,
+	0x1e, 0x49, 0xee, 0x36, 0x67, 0x4a, 0x89, 0x72, 0x8b, 0x0f, 0x9c, 0xa6, 0xf2, 0xd1, 0xe9, 0x6e,
+	0x26, 0xf1, 0x63, 0x39, 0xc7, 0x9f, 0x0c, 0x48, 0xd4, 0x7f, 0x51, 0x42, 0x55, 0x45, 0xbf, 0xb7,
+	0x4c, 0xa9, 0xf8, 0x9b, 0xac, 0xd6, 0xa1, 0x19, 0xad, 0x72, 0xed, 0x54, 0x97, 0xd6, 0xc6, 0x3e,
+	0x32, 0x86, 0xdb, 0x86, 0x81, 0x99, 0xbc, 0x63, 0x39, 0x64, 0x4e, 0x24, 0xcb, 0x72, 0x8a, 0xb6,
+	0x27, 0x3c, 0xa7, 0x66, 0xdc, 0x09, 0x13, 0x6c, 0x34, 0x83, 0x0f, 0x99, 0x69, 0x34, 0x9a, 0xb2,
+	0x28, 0x26, 0x40, 0x80, 0xbf, 0x8a, 0xca, 0x9e, 0xeb, 0x87, 0x10, 0xcf, 0x40, 0x23, 0x9f, 0x1f,
+	0x77, 0x8e, 0x60, 0x69, 0x56, 0x93, 0x71, 0x48, 0x0f, 0x35, 0x78, 0x62, 0x87, 0x1a, 0x67, 0x8b,
+	0x5f, 0x60, 0x76, 0xec, 0x5a, 0x94, 0x87, 0xbd, 0xea, 0xd2, 0x13, 0x63, 0xb3, 0x67, 0x63, 0xd3,
+	0x85, 0xcf, 0x70, 0x17, 0x00, 0x10, 0x67, 0x8a, 0xbb, 0x68, 0x9a, 0x25, 0xb2, 0x7b, 0x76, 0x5b,
+	0x44, 0xc8, 0xea, 0xd2, 0x17, 0xc6, 0xe5, 0xbf, 0x25, 0x86, 0xa7, 0x22, 0xaa, 0x4c, 0xc4, 0x74,
+	0x0c, 0x8d, 0xb9, 0xeb, 0x6f, 0x96, 0x50, 0xed, 0x76, 0xce, 0x75, 0x3b, 0xe7, 0x1a, 0x96, 0x73,
+	0xfd, 0x84, 0xf9, 0x7b, 0xf6, 0x5c, 0xca, 0x1e, 0xcd, 0xda, 0xfe, 0x47, 0x73, 0x72, 0xda, 0x17,
+	0x46, 0x9e, 0xf6, 0x06, 0x2b, 0xb3, 0x6c, 0x8b, 0x17, 0x1f, 0x15, 0xe3, 0xa1, 0xa4, 0xcc, 0x6a,
+	0x5c, 0x62, 0x3e, 0x7d, 0xff, 0xa8, 0xf6, 0x66, 0x78, 0xdd, 0xa3, 0x41, 0x9d, 0x11, 0x11, 0x18,
+	0xac, 0xbf, 0x8a, 0x6a, 0x4f, 0xb5, 0x5a, 0xcd, 0x26, 0xb4, 0x37, 0xdb, 0xae, 0x03, 0x52, 0xa1,
+	0xe6, 0xca, 0xc7, 0x18, 0x28, 0xcb, 0x08, 0xc7, 0x40, 0xad, 0xc4, 0x0c, 0x72, 0xc7, 0xb5, 0xf2,
+	0xb5, 0xd2, 0x06, 0x87, 0x12, 0x89, 0x05, 0x4e, 0x9e, 0x19, 0xee, 0xc8, 0xe9, 0x25, 0x9c, 0x58,
+	0x0a, 0xb3, 0x43, 0x38, 0x46, 0xff, 0x95, 0x86, 0xa6, 0xa5, 0x5e, 0xd9, 0xd1, 0x5b, 0x6a, 0xdb,
+	0x96, 0x2f, 0x1d, 0xe7, 0x80, 0x96, 0x94, 0x08, 0x59, 0x61, 0xcb, 0x23, 0x9c, 0x21, 0x7e, 0x11,
+	0x4d, 0xd1, 0x6b, 0x6d, 0xea, 0x85, 0xd2, 0x51, 0x0e, 0xc8, 0x3a, 0x59, 0xe5, 0x2a, 0x67, 0x46,
+	0x24, 0x53, 0xfd, 0xdf, 0x1a, 0xc2, 0x8d, 0xe6, 0x47, 0x37, 0x84, 0x76, 0x50, 0x99, 0x6f, 0x10,
+	0xfe, 0x38, 0x2a, 0xd8, 0x1e, 0x5f, 0x6b, 0xcd, 0x98, 0x67, 0x83, 0x0b, 0x8d, 0x66, 0x36, 0xb4,
+	0x30, 0x34, 0x38, 0xaf, 0xe7, 0xd3, 0x8e, 0x7d, 0x6d, 0x9d, 0xf6, 0xbb, 0xcc, 0x36, 0xc0, 0x82,
+	0xca, 0xa9, 0xf3, 0x36, 0x15, 0x1c, 0xc9, 0x50, 0xea, 0xcf, 0xa1, 0xd9, 0xa7, 0xcd, 0xce, 0xae,
+	0xa9, 0x1a, 0x2a, 0x5b, 0xc7, 0x40, 0x32, 0x44, 0x18, 0x8c, 0x70, 0x0c, 0x9b, 0x51, 0x39, 0x74,
+	0x3d, 0xbb, 0x2d, 0xed, 0x34, 0x89, 0x45, 0x2d, 0x00, 0x12, 0x81, 0xd3, 0xdf, 0x2a, 0x20, 0xb4,
+	0x7e, 0x21, 0xe1, 0xfa, 0x3c, 0x33, 0xff, 0x30, 0xf4, 0x0e, 0x9a, 0x02, 0xa8, 0xae, 0x24, 0x22,
+	0x13, 0x40, 0x08, 0xe7, 0x89, 0x9f, 0x43, 0xc5, 0xd0, 0x09, 0x64, 0xe0, 0x1f, 0xfb, 0xbc, 0x6e,
+	0xad, 0x6f, 0x25, 0x9c, 0x79, 0x72, 0xc1, 0x00, 0x04, 0x18, 0x42, 0xb8, 0xde, 0x85, 0xad, 0x91,
+	0x5d, 0x88, 0xb1, 0xe3, 0x69, 0x66, 0x5f, 0x8d, 0x0a, 0x6c, 0x11, 0x07, 0x11, 0xc1, 0x56, 0x7f,
+	0x93, 0x99, 0xf8, 0x46, 0xe4, 0x40, 0xcf, 0x21, 0x08, 0xb9, 0xda, 0x1b, 0xfd, 0x8e, 0x0b, 0xdb,
+	0xcb, 0xcb, 0x2f, 0xa9, 0x81, 0x64, 0x7b, 0x85, 0x31, 0x09, 0x1c, 0x9b, 0x5b, 0x89, 0xe5, 0x3f,
+	0x07, 0x6e, 0xe9, 0x67, 0x52, 0xaa, 0xf4, 0x08, 0x61, 0x1c, 0x09, 0xe7, 0xab, 0x7f, 0x47, 0x43,
+	0x95, 0x24, 0xdd, 0xe0, 0x47, 0x0e, 0xfb, 0xcf, 0x67, 0x54, 0x56, 0xe9, 0xfd, 0x90, 0x70, 0xcc,
+	0x4d, 0x1c, 0xaa, 0x17, 0xd1, 0x8c, 0x27, 0xf7, 0x42, 0x1e, 0x5d, 0xf7, 0x26, 0xdd, 0x2f, 0x09,
+	0xff, 0x50, 0xf9, 0x4e, 0x12, 0x6a, 0xfd, 0xf5, 0x02, 0x3a, 0x99, 0x9c, 0xe4, 0x2d, 0xdf, 0xec,
+	0x74, 0xec, 0xf6, 0x16, 0x5c, 0xa9, 0x8d, 0x7f, 0xf6, 0x9b, 0x68, 0xda, 0xee, 0xf3, 0xb2, 0x4f,
+	0x9a, 0xcb, 0x23, 0x37, 0xd8, 0x39, 0x7e, 0x6d, 0x57, 0x8f, 0xaf, 0xed, 0xea, 0xaa, 0x64, 0xe3,
+	0xb8, 0x14, 0x33, 0xdd, 0x10, 0xec, 0x48, 0xcc, 0x97, 0x69, 0x66, 0x8a, 0x0a, 0x09, 0xc5, 0x89,
+	0x24, 0xa4, 0x07, 0xa3, 0x10, 0x20, 0xb9, 0xea, 0x1f, 0x14, 0xd1, 0x2c, 0x3b, 0x17, 0x5e, 0x71,
+	0xfd, 0xdd, 0xa6, 0xeb, 0xd8, 0xed, 0xeb, 0x47, 0x70, 0x26, 0xb2, 0xc3, 0xc8, 0x8f, 0x1c, 0x1a,
+	0x9b, 0xdb, 0xf2, 0xd8, 0x99, 0xa5, 0x3a, 0x5f, 0xc2, 0x38, 0xa5, 0x56, 0x0d, 0x4f, 0x2c, 0x81,
+	0xe5, 0xec, 0xf1, 0x13, 0xe8, 0xb8, 0x99, 0xe9, 0x79, 0x8b, 0x0c, 0xa8, 0xc2, 0x0f, 0xbe, 0xe3,
+	0xd9, 0x76, 0x78, 0x40, 0xf2, 0xb4, 0xf8, 0x01, 0x30, 0x31, 0xdb, 0xf5, 0xa1, 0x0c, 0x80, 0xf4,
+	0x45, 0x33, 0x6a, 0xc2, 0xbc, 0x04, 0x8c, 0x24, 0x58, 0xfc, 0x30, 0x4b, 0x76, 0x6c, 0xea, 0xc7,
+	0x18, 0x9e, 0xb4, 0x94, 0x8d, 0x39, 0x9e, 0xe8, 0x28, 0x70, 0x92, 0xa1, 0xc2, 0x01, 0xaa, 0x04,
+	0x6e, 0xe4, 0xf3, 0x14, 0x56, 0x26, 0xc1, 0x97, 0x27, 0xdb, 0x8a, 0xc4, 0x07, 0x67, 0xc1, 0x64,
+	0xb7, 0x62, 0xe6, 0x24, 0x95, 0xa3, 0x7f, 0x50, 0x40, 0xa7, 0x32, 0x83, 0x56, 0xf7, 0x4c, 0x27,
+	0x1a, 0x8c, 0x86, 0xc5, 0x5b, 0xd4, 0x72, 0x9a, 0xf6, 0xe9, 0xd5, 0x88, 0xca, 0xcc, 0xa5, 0xba,
+	0xb4, 0x39, 0xd1, 0x82, 0xd3, 0xb9, 0x13, 0xc1, 0x55, 0xd4, 0x00, 0xf2, 0x81, 0xc4, 0xb2, 0xf0,
+	0x75, 0x34, 0xc3, 0x8c, 0xdd, 0x73, 0xfb, 0x01, 0x95, 0x8e, 0x7a, 0xe5, 0xd0, 0xe4, 0x0a, 0xb6,
+	0xc2, 0x34, 0xe2, 0x27, 0x92, 0x88, 0xd3, 0xff, 0xae, 0xa1, 0x33, 0x37, 0x9e, 0x33, 0xb8, 0xb8,
+	0xd0, 0x8f, 0xdc, 0x93, 0x47, 0xc6, 0x2e, 0x36, 0x79, 0xdd, 0x98, 0xba, 0xb8, 0x54, 0xbc, 0xe4,
+	0x8a, 0x7b, 0xa8, 0x6a, 0x31, 0x39, 0x76, 0x5f, 0x34, 0xc1, 0x0b, 0x13, 0x09, 0x49, 0x92, 0xea,
+	0x4b, 0x29, 0x4b, 0xa2, 0xf2, 0xd7, 0x7f, 0x56, 0x40, 0x67, 0xf7, 0xd9, 0x2d, 0x28, 0xb4, 0x67,
+	0xfb, 0x2a, 0x8d, 0x5c, 0xfa, 0x61, 0xd9, 0xff, 0x49, 0x39, 0xcb, 0xec, 0xd1, 0x46, 0xb2, 0x32,
+	0xe1, 0xbc, 0x87, 0x83, 0xa2, 0xd1, 0xb7, 0xe8, 0x35, 0x99, 0xe3, 0x24, 0xe7, 0x3d, 0x89, 0x11,
+	0x24, 0xa5, 0xc1, 0x5f, 0x66, 0xc9, 0x0c, 0x7b, 0x90, 0xce, 0x71, 0x61, 0xdc, 0xc9, 0x02, 0x4f,
+	0x36, 0x47, 0x25, 0x0b, 0x8a, 0x78, 0x16, 0xc4, 0xfe, 0xea, 0xbf, 0xd3, 0xd0, 0x89, 0xcc, 0x64,
+	0x8f, 0xa0, 0x2f, 0xba, 0x9d, 0xed, 0x8b, 0x3e, 0x31, 0xd1, 0xe6, 0x8f, 0xe8, 0x8c, 0xfe, 0x43,
+	0xcb, 0x9d, 0x37, 0xd0, 0x03, 0x80, 0x90, 0x14, 0x05, 0x70, 0x83, 0x05, 0xbd, 0x80, 0xcd, 0x21,
+	0xf7, 0x5d, 0x9b, 0x12, 0x4e, 0x12, 0x0a, 0xa8, 0x0b, 0xe5, 0x7b, 0x1e, 0xb1, 0x15, 0x2b, 0x75,
+	0xe1, 0x5a, 0x82, 0x21, 0x0a, 0x15, 0xfe, 0x22, 0xc2, 0x6c, 0x19, 0x8e, 0xfd, 0x2a, 0x7f, 0xbc,
+	0x6c, 0xda, 0x4e, 0xe4, 0x0b, 0xf5, 0xcd, 0x18, 0x77, 0xcb, 0xb1, 0x98, 0x0c, 0x50, 0x90, 0x21,
+	0xa3, 0xf0, 0xa7, 0xd1, 0x34, 0x8b, 0xfb, 0x01, 0xd4, 0x97, 0x25, 0x3e, 0xd9, 0x24, 0x68, 0x6f,
+	0x08, 0x30, 0x89, 0xf1, 0xfc, 0xfd, 0x85, 0xcc, 0xa2, 0x9b, 0x94, 0xfa, 0x70, 0x9f, 0x66, 0x2a,
+	0x2f, 0x35, 0x04, 0x6c, 0xcd, 0x10, 0x8c, 0xf8, 0x7d, 0x9a, 0xfa, 0xb6, 0x43, 0x40, 0xb2, 0x74,
+	0x98, 0xa2, 0x19, 0xdb, 0x93, 0x25, 0xbc, 0x50, 0xd5, 0x85, 0xf1, 0xab, 0x23, 0x3e, 0x3e, 0xdd,
+	0xe0, 0xa4, 0x76, 0x4f, 0x58, 0xe3, 0xb3, 0xa8, 0xdc, 0xb9, 0x6a, 0xf5, 0xe3, 0x20, 0xc9, 0x33,
+	0xcc, 0xcb, 0xcf, 0x5c, 0xda, 0x64, 0xba, 0xe4, 0x70, 0x1c, 0x42, 0x65, 0x2e, 0x1b, 0x2c, 0x71,
+	0xd7, 0x69, 0xf2, 0xb6, 0x8d, 0x52, 0xdb, 0xc7, 0xbc, 0x89, 0x22, 0x07, 0xa2, 0xb8, 0x63, 0x6e,
+	0x53, 0xa7, 0x61, 0xc1, 0x85, 0x1a, 0x8b, 0xa0, 0xd0, 0x14, 0x28, 0x3e, 0x30, 0x2b, 0xa2, 0xf8,
+	0x7a, 0x16, 0x45, 0xf2, 0xb4, 0x70, 0xaf, 0x72, 0xd7, 0xf0, 0x53, 0x02, 0x9f, 0x47, 0x25, 0x28,
+	0xb3, 0xa5, 0xed, 0xdd, 0x1f, 0x7b, 0x65, 0x8b, 0xc1, 0x58, 0xee, 0x98, 0xd5, 0x20, 0x00, 0x09,
+	0x27, 0x1f, 0xbb, 0x7b, 0x9b, 0x64, 0xb3, 0xc5, 0xfd, 0x5a, 0x04, 0xa5, 0x49, 0x5a, 0x04, 0xbf,
+	0x9c, 0xce, 0x19, 0x1d, 0x9c, 0x2e, 0xf8, 0x71, 0x54, 0xb1, 0x6c, 0x1f, 0x9a, 0x33, 0x6e, 0x7c,
+	0xcf, 0x7a, 0x26, 0x9e, 0xec, 0xa5, 0x18, 0xf1, 0xa1, 0xfa, 0x40, 0xd2, 0x01, 0xb8, 0x8d, 0x4a,
+	0x1d, 0xdf, 0xed, 0xc9, 0x98, 0x31, 0x59, 0xa2, 0x06, 0x3e, 0x90, 0x2e, 0xfe, 0x32, 0x63, 0x4b,
+	0x38, 0x73, 0x56, 0xfa, 0x17, 0x42, 0x57, 0x9e, 0xa9, 0x87, 0x20, 0x02, 0x49, 0x11, 0x85, 0x96,
+	0x4b, 0x18, 0x63, 0xf0, 0x9e, 0x20, 0x6b, 0xb3, 0x17, 0x0e, 0x68, 0xb3, 0xa9, 0xf7, 0x24, 0x86,
+	0x9a, 0xb0, 0xe6, 0xd7, 0xf1, 0xb9, 0xfc, 0x2f, 0x2d, 0x48, 0x06, 0x32, 0xc6, 0xe7, 0xd0, 0x94,
+	0x29, 0x74, 0x32, 0xc5, 0x75, 0xf2, 0x24, 0xbf, 0xc5, 0x8e, 0x95, 0xf1, 0xd0, 0x0d, 0x5e, 0x36,
+	0xf4, 0x2d, 0xf9, 0x8e, 0xe1, 0x39, 0x1e, 0x4f, 0xc4, 0x18, 0x22, 0xb9, 0xe1, 0xc7, 0xd0, 0x2c,
+	0xed, 0x9b, 0xdb, 0x0e, 0x5d, 0x77, 0xbb, 0x5d, 0x56, 0x43, 0x2c, 0x4c, 0xf3, 0xb3, 0x2e, 0x89,
+	0x87, 0xab, 0x2a, 0x92, 0x64, 0x69, 0x87, 0xe5, 0xcb, 0x33, 0x63, 0xe4, 0xcb, 0xb1, 0x99, 0x57,
+	0x46, 0x9a, 0xf9, 0x55, 0x54, 0x75, 0x92, 0x22, 0x3e, 0x58, 0x40, 0x5c, 0x1b, 0x8f, 0x8e, 0xab,
+	0x8d, 0xb4, 0x0f, 0x90, 0x66, 0x23, 0x29, 0x2c, 0x20, 0xaa, 0x0c, 0x50, 0x8b, 0xe3, 0x76, 0xf9,
+	0x29, 0xb1, 0x50, 0xcd, 0xc6, 0x98, 0x75, 0x09, 0x27, 0x09, 0x05, 0x4b, 0x14, 0xe7, 0x20, 0xde,
+	0xc8, 0xca, 0x09, 0x7c, 0x3e, 0x58, 0xa8, 0xf1, 0x2d, 0xd8, 0x80, 0x46, 0xcb, 0x66, 0x0e, 0xc7,
+	0x54, 0x75, 0xfe, 0xe6, 0x54, 0x95, 0x1b, 0x49, 0x06, 0xc4, 0xe8, 0x6f, 0x14, 0x11, 0xce, 0x18,
+	0xb3, 0xa8, 0x49, 0xff, 0x37, 0x32, 0x25, 0x8f, 0x15, 0x38, 0x4a, 0x35, 0x39, 0x61, 0xb5, 0x9b,
+	0x76, 0x81, 0x15, 0x28, 0xc9, 0x48, 0xc0, 0xaf, 0x69, 0x68, 0x0e, 0x12, 0x23, 0x95, 0x44, 0xf6,
+	0xaf, 0x1f, 0xbd, 0x79, 0xb1, 0x24, 0xc7, 0x21, 0xed, 0x99, 0xe5, 0x31, 0x64, 0x40, 0x9a, 0xfe,
+	0x67, 0x0d, 0xcd, 0x0f, 0x68, 0x24, 0x3a, 0x8a, 0x0b, 0x04, 0x07, 0x95, 0xc1, 0x3e, 0xe2, 0x68,
+	0xbf, 0x36, 0x91, 0xae, 0xd3, 0x84, 0x2b, 0x4d, 0xd1, 0x00, 0xc6, 0xc2, 0x3a, 0x17, 0xa2, 0x9f,
+	0x43, 0xb3, 0x99, 0xbb, 0x9a, 0xfd, 0x2f, 0x30, 0xf5, 0x9f, 0x4f, 0xa1, 0xb9, 0x98, 0x6f, 0xb0,
+	0x15, 0xf5, 0x7a, 0xa6, 0x7f, 0x14, 0x8d, 0x83, 0x6f, 0x6b, 0xe8, 0xb8, 0x6a, 0x98, 0x76, 0xb2,
+	0x45, 0xc6, 0x44, 0x5b, 0x24, 0x6c, 0xe3, 0x94, 0x94, 0x7d, 0x7c, 0x33, 0x2b, 0x82, 0xe4, 0x65,
+	0xe2, 0x9f, 0x6a, 0xe8, 0x5e, 0x21, 0x45, 0xbe, 0xd4, 0x93, 0x1b, 0x21, 0x0d, 0xf5, 0x30, 0x26,
+	0xf5, 0x09, 0x39, 0xa9, 0x7b, 0x97, 0x6f, 0x20, 0x8f, 0xdc, 0x70, 0x36, 0xf8, 0xc7, 0x1a, 0x3a,
+	0x29, 0x08, 0xf2, 0xf3, 0x2c, 0x1d, 0xda, 0x3c, 0x4f, 0xcb, 0x79, 0x9e, 0x5c, 0x1e, 0x26, 0x88,
+	0x0c, 0x97, 0x0f, 0x2d, 0x90, 0x5e, 0xdc, 0xb2, 0xe4, 0x59, 0xdd, 0x01, 0x26, 0x33, 0xd8, 0xf3,
+	0x4c, 0xd3, 0xb1, 0x04, 0x47, 0x52, 0x39, 0xec, 0x90, 0x47, 0x49, 0x6e, 0x16, 0xb0, 0xf8, 0x0b,
+	0x52, 0x57, 0x0f, 0x72, 0x79, 0x3a, 0xd0, 0x41, 0x4c, 0xcd, 0x37, 0x41, 0xb3, 0x5c, 0x36, 0x15,
+	0xa6, 0xbf, 0x88, 0xee, 0x6c, 0x9a, 0x5d, 0x59, 0x29, 0xaf, 0xd1, 0xf0, 0x8a, 0x07, 0x5f, 0x02,
+	0x71, 0x09, 0xd3, 0x15, 0x1e, 0x57, 0x54, 0x2f, 0x61, 0x58, 0x55, 0xc1, 0x31, 0xd0, 0xc6, 0x75,
+	0xec, 0x9e, 0x1d, 0xca, 0xc2, 0x27, 0xf1, 0xe4, 0x75, 0x00, 0x12, 0x81, 0xd3, 0x4d, 0x54, 0x53,
+	0x5b, 0xb1, 0xb7, 0xe2, 0x4d, 0x04, 0xb8, 0x0c, 0x92, 0x75, 0xec, 0x84, 0xb9, 0xe5, 0xfe, 0x3d,
+	0xde, 0x34, 0x49, 0x2a, 0x1e, 0x66, 0x92, 0xa4, 0xff, 0xba, 0x88, 0xe2, 0x7b, 0x62, 0xfc, 0xb0,
+	0xd2, 0x47, 0x16, 0x4b, 0x58, 0xd8, 0xbf, 0x87, 0x8c, 0x37, 0x65, 0x07, 0xbb, 0xb0, 0xcf, 0x31,
+	0x07, 0x3f, 0x52, 0xa8, 0x8b, 0x1f, 0x29, 0xd4, 0x1b, 0xfd, 0xf0, 0x8a, 0xbf, 0x15, 0xfa, 0x2c,
+	0xd3, 0x12, 0x77, 0x0e, 0x4a, 0xbf, 0xfb, 0x93, 0x68, 0x9a, 0xf6, 0x79, 0x73, 0x9c, 0x2f, 0xb5,
+	0x2c, 0xfa, 0x58, 0xab, 0x02, 0x44, 0x62, 0x1c, 0x74, 0x24, 0xed, 0x76, 0xcf, 0x83, 0x84, 0x81,
+	0xd7, 0x0a, 0x65, 0xd1, 0x76, 0x6a, 0xac, 0x6c, 0x34, 0x79, 0x56, 0x91, 0x60, 0x63, 0xca, 0x95,
+	0xf8, 0xfe, 0x5e, 0xa1, 0x04, 0x18, 0x49, 0xb0, 0x9c, 0xb2, 0x2b, 0x79, 0x4e, 0x29, 0x94, 0x6b,
+	0x09, 0x4f, 0x89, 0x85, 0x5b, 0x21, 0x7e, 0x5b, 0x20, 0x6b, 0x55, 0x9e, 0x5a, 0x56, 0x72, 0xaf,
+	0x7c, 0xc5, 0xb7, 0x48, 0x19, 0x4a, 0x58, 0x5e, 0xe0, 0xb7, 0xf9, 0xf2, 0x66, 0xd2, 0xe5, 0x6d,
+	0x09, 0x10, 0x89, 0x71, 0xb8, 0x8e, 0x10, 0xfb, 0x2a, 0x57, 0xcd, 0xd3, 0xc8, 0xb2, 0x71, 0x0c,
+	0xbc, 0x69, 0x2b, 0x81, 0x12, 0x85, 0x42, 0xa7, 0x68, 0x2e, 0x5f, 0x4d, 0xde, 0x0a, 0x93, 0x7f,
+	0xa3, 0x84, 0x4e, 0x6d, 0x45, 0x1e, 0x28, 0x4a, 0xbc, 0xd5, 0xba, 0xe2, 0x3a, 0x8e, 0x34, 0xe2,
+	0x5b, 0x1f, 0xf3, 0x5e, 0x40, 0x15, 0x7a, 0xcd, 0x63, 0x5e, 0x63, 0x2d, 0xc7, 0xf6, 0xf6, 0x99,
+	0x9b, 0x13, 0xd1, 0xb2, 0x7b, 0x34, 0x5d, 0xda, 0x6a, 0xcc, 0x84, 0xa4, 0xfc, 0x60, 0x2f, 0x02,
+	0x9b, 0x6d, 0x1b, 0x90, 0x4a, 0x27, 0x4b, 0x06, 0x6c, 0xc5, 0x08, 0x92, 0xd2, 0x40, 0x0b, 0xa0,
+	0x93, 0xbc, 0x40, 0xcc, 0x6d, 0xf0, 0x00, 0x2d, 0x80, 0xfc, 0x8b, 0xc8, 0xe9, 0x0e, 0xa4, 0x30,
+	0xa2, 0xc8, 0xc1, 0xdf, 0xd7, 0xd0, 0x31, 0x33, 0xfb, 0x2a, 0xaf, 0x78, 0x29, 0x65, 0xe3, 0x60,
+	0xa2, 0x47, 0xbc, 0x96, 0x6c, 0xdc, 0x25, 0xe7, 0x71, 0x2c, 0xf7, 0x4e, 0x6f, 0x4e, 0x38, 0xfc,
+	0x26, 0xe2, 0x9e, 0x11, 0x16, 0x71, 0x04, 0x6d, 0x3b, 0x27, 0xdb, 0xb6, 0x1b, 0x3b, 0x3b, 0x1c,
+	0x31, 0xf3, 0x11, 0x0d, 0xbc, 0x1f, 0x15, 0xd0, 0xfd, 0x23, 0x46, 0x1c, 0xb8, 0x95, 0xc7, 0xaa,
+	0xd4, 0xf8, 0xbb, 0xea, 0x86, 0x69, 0x2d, 0xa2, 0x22, 0x49, 0x96, 0x36, 0x16, 0xc5, 0x0f, 0xac,
+	0xe2, 0xa0, 0x28, 0x71, 0x68, 0xc5, 0x14, 0x60, 0xe1, 0x6d, 0xb7, 0xe7, 0x39, 0x34, 0xa4, 0xa2,
+	0xbf, 0x32, 0x93, 0x5a, 0xf8, 0x4a, 0x8c, 0x20, 0x29, 0x0d, 0x04, 0x5a, 0xea, 0xfb, 0xae, 0xcf,
+	0x2d, 0x4c, 0xb9, 0x2f, 0x5d, 0x05, 0x20, 0x11, 0x38, 0xfd, 0x9f, 0x1a, 0x3a, 0x3d, 0x62, 0x53,
+	0x8e, 0xac, 0x48, 0xd8, 0xcb, 0x16, 0x09, 0xcf, 0x1c, 0x92, 0x19, 0xec, 0x5b, 0x2e, 0x3c, 0x88,
+	0xaa, 0xca, 0x25, 0x37, 0xfc, 0x88, 0x20, 0xe8, 0xdb, 0xf9, 0x1f, 0x11, 0x6c, 0x6d, 0x36, 0x08,
+	0xc0, 0x8d, 0xd6, 0xdb, 0x7f, 0x3c, 0x73, 0xc7, 0x3b, 0xec, 0xf3, 0x2e, 0xfb, 0xbc, 0xf6, 0xfe,
+	0x19, 0xed, 0x6d, 0xf6, 0x79, 0x87, 0x7d, 0xde, 0x65, 0x9f, 0x3f, 0xb0, 0xcf, 0x0f, 0xff, 0x74,
+	0xe6, 0x8e, 0xe7, 0xeb, 0xe3, 0xfd, 0xba, 0xf2, 0x3f, 0xd3, 0x6d, 0x0c, 0x2d, 0x8e, 0x39, 0x00,
+	0x00,
 }
 
 func (m *AddressGroup) Marshal() (dAtA []byte, err error) {
@@ -2803,6 +2870,39 @@ func (m *IPNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KafkaProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KafkaProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KafkaProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Topic)
+	copy(dAtA[i:], m.Topic)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Topic)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *L7Protocol) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2823,6 +2923,18 @@ func (m *L7Protocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Kafka != nil {
+		{
+			size, err := m.Kafka.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.TLS != nil {
 		{
 			size, err := m.TLS.MarshalToSizedBuffer(dAtA[:i])
@@ -2928,6 +3040,54 @@ func (m *NamedPort) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceTrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Ingress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *NetworkPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3383,6 +3543,15 @@ func (m *NetworkPolicyRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NodeTrafficTypes) > 0 {
+		for iNdEx := len(m.NodeTrafficTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NodeTrafficTypes[iNdEx])
+			copy(dAtA[i:], m.NodeTrafficTypes[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeTrafficTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	i -= len(m.LogLabel)
 	copy(dAtA[i:], m.LogLabel)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LogLabel)))
@@ -3628,6 +3797,20 @@ func (m *NodeStatsSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Namespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Multicast) > 0 {
 		for iNdEx := len(m.Multicast) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4587,6 +4770,19 @@ func (m *IPNet) Size() (n int) {
 	return n
 }
 
+func (m *KafkaProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Topic)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *L7Protocol) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4601,6 +4797,10 @@ func (m *L7Protocol) Size() (n int) {
 		l = m.TLS.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Kafka != nil {
+		l = m.Kafka.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -4635,6 +4835,21 @@ func (m *NamedPort) Size() (n int) {
 	return n
 }
 
+func (m *NamespaceTrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Ingress.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Egress.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *NetworkPolicy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4841,6 +5056,12 @@ func (m *NetworkPolicyRule) Size() (n int) {
 	}
 	l = len(m.LogLabel)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.NodeTrafficTypes) > 0 {
+		for _, s := range m.NodeTrafficTypes {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4923,6 +5144,12 @@ func (m *NodeStatsSummary) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if len(m.Namespaces) > 0 {
+		for _, e := range m.Namespaces {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -5475,6 +5702,17 @@ func (this *IPNet) String() string {
 	}, "")
 	return s
 }
+func (this *KafkaProtocol) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KafkaProtocol{`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Topic:` + fmt.Sprintf("%v", this.Topic) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *L7Protocol) String() string {
 	if this == nil {
 		return "nil"
@@ -5482,6 +5720,7 @@ func (this *L7Protocol) String() string {
 	s := strings.Join([]string{`&L7Protocol{`,
 		`HTTP:` + strings.Replace(this.HTTP.String(), "HTTPProtocol", "HTTPProtocol", 1) + `,`,
 		`TLS:` + strings.Replace(this.TLS.String(), "TLSProtocol", "TLSProtocol", 1) + `,`,
+		`Kafka:` + strings.Replace(this.Kafka.String(), "KafkaProtocol", "KafkaProtocol", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5514,6 +5753,18 @@ func (this *NamedPort) String() string {
 	}, "")
 	return s
 }
+func (this *NamespaceTrafficStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceTrafficStats{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Ingress:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Ingress), "TrafficStats", "v1alpha1.TrafficStats", 1), `&`, ``, 1) + `,`,
+		`Egress:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Egress), "TrafficStats", "v1alpha1.TrafficStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NetworkPolicy) String() string {
 	if this == nil {
 		return "nil"
@@ -5661,6 +5912,7 @@ func (this *NetworkPolicyRule) String() string {
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`L7Protocols:` + repeatedStringForL7Protocols + `,`,
 		`LogLabel:` + fmt.Sprintf("%v", this.LogLabel) + `,`,
+		`NodeTrafficTypes:` + fmt.Sprintf("%v", this.NodeTrafficTypes) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5732,12 +5984,18 @@ func (this *NodeStatsSummary) String() string {
 		repeatedStringForMulticast += strings.Replace(strings.Replace(f.String(), "MulticastGroupInfo", "MulticastGroupInfo", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForMulticast += "}"
+	repeatedStringForNamespaces := "[]NamespaceTrafficStats{"
+	for _, f := range this.Namespaces {
+		repeatedStringForNamespaces += strings.Replace(strings.Replace(f.String(), "NamespaceTrafficStats", "NamespaceTrafficStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForNamespaces += "}"
 	s := strings.Join([]string{`&NodeStatsSummary{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`NetworkPolicies:` + repeatedStringForNetworkPolicies + `,`,
 		`AntreaClusterNetworkPolicies:` + repeatedStringForAntreaClusterNetworkPolicies + `,`,
 		`AntreaNetworkPolicies:` + repeatedStringForAntreaNetworkPolicies + `,`,
 		`Multicast:` + repeatedStringForMulticast + `,`,
+		`Namespaces:` + repeatedStringForNamespaces + `,`,
 		`}`,
 	}, "")
 	return s
@@ -9040,7 +9298,7 @@ func (m *IPNet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *L7Protocol) Unmarshal(dAtA []byte) error {
+func (m *KafkaProtocol) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9063,17 +9321,17 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: L7Protocol: wiretype end group for non-group")
+			return fmt.Errorf("proto: KafkaProtocol: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: L7Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KafkaProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9083,33 +9341,29 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HTTP == nil {
-				m.HTTP = &HTTPProtocol{}
-			}
-			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9119,26 +9373,180 @@ func (m *L7Protocol) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TLS == nil {
-				m.TLS = &TLSProtocol{}
-			}
-			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Topic = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *L7Protocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: L7Protocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: L7Protocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HTTPProtocol{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSProtocol{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kafka", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kafka == nil {
+				m.Kafka = &KafkaProtocol{}
+			}
+			if err := m.Kafka.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -9411,6 +9819,154 @@ func (m *NamedPort) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NamespaceTrafficStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceTrafficStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceTrafficStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ingress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *NetworkPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11103,6 +11659,38 @@ func (m *NetworkPolicyRule) Unmarshal(dAtA []byte) error {
 			}
 			m.LogLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTrafficTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeTrafficTypes = append(m.NodeTrafficTypes, antrea_io_antrea_pkg_apis_crd_v1beta1.NodeTrafficType(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11671,6 +12259,40 @@ func (m *NodeStatsSummary) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespaces = append(m.Namespaces, NamespaceTrafficStats{})
+			if err := m.Namespaces[len(m.Namespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -28,3 +28,14 @@ func (r *NetworkPolicyReference) ToString() string {
 func IsSourceAntreaNativePolicy(npRef *NetworkPolicyReference) bool {
 	return npRef.Type == AntreaClusterNetworkPolicy || npRef.Type == AntreaNetworkPolicy
 }
+
+// HasK8sNetworkPolicySemantics returns whether the policy has the semantics of a K8s NetworkPolicy: its rules only
+// allow traffic, and the Pods it applies to are isolated for the directions of its rules.
+func HasK8sNetworkPolicySemantics(npRef *NetworkPolicyReference) bool {
+	return npRef.Type == K8sNetworkPolicy || npRef.Type == NamespaceSecurityPolicy
+}
+
+// IsRealizationStatusReported returns whether antrea-agents report the realization status of the policy.
+func IsRealizationStatusReported(npRef *NetworkPolicyReference) bool {
+	return IsSourceAntreaNativePolicy(npRef) || npRef.Type == NamespaceSecurityPolicy
+}
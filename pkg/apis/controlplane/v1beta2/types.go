@@ -200,6 +200,10 @@ const (
 	AntreaNetworkPolicy        NetworkPolicyType = "AntreaNetworkPolicy"
 	AdminNetworkPolicy         NetworkPolicyType = "AdminNetworkPolicy"
 	BaselineAdminNetworkPolicy NetworkPolicyType = "BaselineAdminNetworkPolicy"
+	// NamespaceSecurityPolicy is the type of the NetworkPolicies generated by antrea-controller to isolate the
+	// Namespaces labeled with "policy.antrea.io/isolation: strict". They have the semantics of K8s NetworkPolicies,
+	// and are reported by the NamespaceSecurityPolicy resource of the Namespace.
+	NamespaceSecurityPolicy NetworkPolicyType = "NamespaceSecurityPolicy"
 )
 
 type NetworkPolicyReference struct {
@@ -277,6 +281,10 @@ type NetworkPolicyRule struct {
 	L7Protocols []L7Protocol `json:"l7Protocols,omitempty" protobuf:"bytes,10,rep,name=l7Protocols"`
 	// LogLabel is a user-defined arbitrary string which will be printed in the NetworkPolicy logs.
 	LogLabel string `json:"logLabel,omitempty" protobuf:"bytes,11,opt,name=logLabel"`
+	// NodeTrafficTypes is a list of categories of traffic destined to the Node that this rule applies to.
+	// It is only set for ingress rules of policies applied to Nodes. An empty list means the Node OS and
+	// hostNetwork Pods.
+	NodeTrafficTypes []crdv1beta1.NodeTrafficType `json:"nodeTrafficTypes,omitempty" protobuf:"bytes,12,rep,name=nodeTrafficTypes,casttype=antrea.io/antrea/pkg/apis/crd/v1beta1.NodeTrafficType"`
 }
 
 // Protocol defines network protocols supported for things like container ports.
@@ -328,8 +336,9 @@ type Service struct {
 
 // L7Protocol defines application layer protocol to match.
 type L7Protocol struct {
-	HTTP *HTTPProtocol `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
-	TLS  *TLSProtocol  `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	HTTP  *HTTPProtocol  `json:"http,omitempty" protobuf:"bytes,1,opt,name=http"`
+	TLS   *TLSProtocol   `json:"tls,omitempty" protobuf:"bytes,2,opt,name=tls"`
+	Kafka *KafkaProtocol `json:"kafka,omitempty" protobuf:"bytes,3,opt,name=kafka"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -352,6 +361,16 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty" protobuf:"bytes,1,opt,name=sni"`
 }
 
+// KafkaProtocol matches Kafka requests with specific role and topic. All fields could be used alone or together.
+// If all fields are not provided, it matches all Kafka requests.
+type KafkaProtocol struct {
+	// Role represents the role of the Kafka client, which determines the Kafka requests to match.
+	// It could be produce or consume.
+	Role string `json:"role,omitempty" protobuf:"bytes,1,opt,name=role"`
+	// Topic represents the name of the Kafka topic to match.
+	Topic string `json:"topic,omitempty" protobuf:"bytes,2,opt,name=topic"`
+}
+
 // NetworkPolicyPeer describes a peer of NetworkPolicyRules.
 // It could be a list of names of AddressGroups and/or a list of IPBlock.
 type NetworkPolicyPeer struct {
@@ -408,6 +427,18 @@ type NodeStatsSummary struct {
 	AntreaNetworkPolicies []NetworkPolicyStats `json:"antreaNetworkPolicies,omitempty" protobuf:"bytes,4,rep,name=antreaNetworkPolicies"`
 	// Multicast group information collected from the Node.
 	Multicast []MulticastGroupInfo `json:"multicast,omitempty" protobuf:"bytes,5,rep,name=multicast"`
+	// The traffic stats of the local Pods collected from the Node, grouped by Namespace.
+	Namespaces []NamespaceTrafficStats `json:"namespaces,omitempty" protobuf:"bytes,6,rep,name=namespaces"`
+}
+
+// NamespaceTrafficStats contains the traffic stats of the local Pods of a Namespace, for a given Node.
+type NamespaceTrafficStats struct {
+	// The name of the Namespace.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// The traffic stats of the connections initiated towards the local Pods of the Namespace.
+	Ingress statsv1alpha1.TrafficStats `json:"ingress,omitempty" protobuf:"bytes,2,opt,name=ingress"`
+	// The traffic stats of the connections initiated by the local Pods of the Namespace.
+	Egress statsv1alpha1.TrafficStats `json:"egress,omitempty" protobuf:"bytes,3,opt,name=egress"`
 }
 
 // MulticastGroupInfo contains the list of Pods that have joined a multicast group, for a given Node.
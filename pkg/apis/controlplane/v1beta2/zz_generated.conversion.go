@@ -269,6 +269,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KafkaProtocol)(nil), (*controlplane.KafkaProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(a.(*KafkaProtocol), b.(*controlplane.KafkaProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.KafkaProtocol)(nil), (*KafkaProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(a.(*controlplane.KafkaProtocol), b.(*KafkaProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*L7Protocol)(nil), (*controlplane.L7Protocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(a.(*L7Protocol), b.(*controlplane.L7Protocol), scope)
 	}); err != nil {
@@ -299,6 +309,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceTrafficStats)(nil), (*controlplane.NamespaceTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_NamespaceTrafficStats_To_controlplane_NamespaceTrafficStats(a.(*NamespaceTrafficStats), b.(*controlplane.NamespaceTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*controlplane.NamespaceTrafficStats)(nil), (*NamespaceTrafficStats)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_controlplane_NamespaceTrafficStats_To_v1beta2_NamespaceTrafficStats(a.(*controlplane.NamespaceTrafficStats), b.(*NamespaceTrafficStats), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkPolicy)(nil), (*controlplane.NetworkPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_NetworkPolicy_To_controlplane_NetworkPolicy(a.(*NetworkPolicy), b.(*controlplane.NetworkPolicy), scope)
 	}); err != nil {
@@ -1081,9 +1101,32 @@ func Convert_controlplane_IPNet_To_v1beta2_IPNet(in *controlplane.IPNet, out *IP
 	return autoConvert_controlplane_IPNet_To_v1beta2_IPNet(in, out, s)
 }
 
+func autoConvert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in *KafkaProtocol, out *controlplane.KafkaProtocol, s conversion.Scope) error {
+	out.Role = in.Role
+	out.Topic = in.Topic
+	return nil
+}
+
+// Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol is an autogenerated conversion function.
+func Convert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in *KafkaProtocol, out *controlplane.KafkaProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta2_KafkaProtocol_To_controlplane_KafkaProtocol(in, out, s)
+}
+
+func autoConvert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in *controlplane.KafkaProtocol, out *KafkaProtocol, s conversion.Scope) error {
+	out.Role = in.Role
+	out.Topic = in.Topic
+	return nil
+}
+
+// Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol is an autogenerated conversion function.
+func Convert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in *controlplane.KafkaProtocol, out *KafkaProtocol, s conversion.Scope) error {
+	return autoConvert_controlplane_KafkaProtocol_To_v1beta2_KafkaProtocol(in, out, s)
+}
+
 func autoConvert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *controlplane.L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*controlplane.HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*controlplane.TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.Kafka = (*controlplane.KafkaProtocol)(unsafe.Pointer(in.Kafka))
 	return nil
 }
 
@@ -1095,6 +1138,7 @@ func Convert_v1beta2_L7Protocol_To_controlplane_L7Protocol(in *L7Protocol, out *
 func autoConvert_controlplane_L7Protocol_To_v1beta2_L7Protocol(in *controlplane.L7Protocol, out *L7Protocol, s conversion.Scope) error {
 	out.HTTP = (*HTTPProtocol)(unsafe.Pointer(in.HTTP))
 	out.TLS = (*TLSProtocol)(unsafe.Pointer(in.TLS))
+	out.Kafka = (*KafkaProtocol)(unsafe.Pointer(in.Kafka))
 	return nil
 }
 
@@ -1149,6 +1193,30 @@ func Convert_controlplane_NamedPort_To_v1beta2_NamedPort(in *controlplane.NamedP
 	return autoConvert_controlplane_NamedPort_To_v1beta2_NamedPort(in, out, s)
 }
 
+func autoConvert_v1beta2_NamespaceTrafficStats_To_controlplane_NamespaceTrafficStats(in *NamespaceTrafficStats, out *controlplane.NamespaceTrafficStats, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Ingress = in.Ingress
+	out.Egress = in.Egress
+	return nil
+}
+
+// Convert_v1beta2_NamespaceTrafficStats_To_controlplane_NamespaceTrafficStats is an autogenerated conversion function.
+func Convert_v1beta2_NamespaceTrafficStats_To_controlplane_NamespaceTrafficStats(in *NamespaceTrafficStats, out *controlplane.NamespaceTrafficStats, s conversion.Scope) error {
+	return autoConvert_v1beta2_NamespaceTrafficStats_To_controlplane_NamespaceTrafficStats(in, out, s)
+}
+
+func autoConvert_controlplane_NamespaceTrafficStats_To_v1beta2_NamespaceTrafficStats(in *controlplane.NamespaceTrafficStats, out *NamespaceTrafficStats, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Ingress = in.Ingress
+	out.Egress = in.Egress
+	return nil
+}
+
+// Convert_controlplane_NamespaceTrafficStats_To_v1beta2_NamespaceTrafficStats is an autogenerated conversion function.
+func Convert_controlplane_NamespaceTrafficStats_To_v1beta2_NamespaceTrafficStats(in *controlplane.NamespaceTrafficStats, out *NamespaceTrafficStats, s conversion.Scope) error {
+	return autoConvert_controlplane_NamespaceTrafficStats_To_v1beta2_NamespaceTrafficStats(in, out, s)
+}
+
 func autoConvert_v1beta2_NetworkPolicy_To_controlplane_NetworkPolicy(in *NetworkPolicy, out *controlplane.NetworkPolicy, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if in.Rules != nil {
@@ -1433,6 +1501,7 @@ func autoConvert_v1beta2_NetworkPolicyRule_To_controlplane_NetworkPolicyRule(in
 	out.Name = in.Name
 	out.L7Protocols = *(*[]controlplane.L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.NodeTrafficTypes = *(*[]v1beta1.NodeTrafficType)(unsafe.Pointer(&in.NodeTrafficTypes))
 	return nil
 }
 
@@ -1467,6 +1536,7 @@ func autoConvert_controlplane_NetworkPolicyRule_To_v1beta2_NetworkPolicyRule(in
 	out.AppliedToGroups = *(*[]string)(unsafe.Pointer(&in.AppliedToGroups))
 	out.L7Protocols = *(*[]L7Protocol)(unsafe.Pointer(&in.L7Protocols))
 	out.LogLabel = in.LogLabel
+	out.NodeTrafficTypes = *(*[]v1beta1.NodeTrafficType)(unsafe.Pointer(&in.NodeTrafficTypes))
 	return nil
 }
 
@@ -1551,6 +1621,7 @@ func autoConvert_v1beta2_NodeStatsSummary_To_controlplane_NodeStatsSummary(in *N
 	out.AntreaClusterNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.AntreaClusterNetworkPolicies))
 	out.AntreaNetworkPolicies = *(*[]controlplane.NetworkPolicyStats)(unsafe.Pointer(&in.AntreaNetworkPolicies))
 	out.Multicast = *(*[]controlplane.MulticastGroupInfo)(unsafe.Pointer(&in.Multicast))
+	out.Namespaces = *(*[]controlplane.NamespaceTrafficStats)(unsafe.Pointer(&in.Namespaces))
 	return nil
 }
 
@@ -1565,6 +1636,7 @@ func autoConvert_controlplane_NodeStatsSummary_To_v1beta2_NodeStatsSummary(in *c
 	out.AntreaClusterNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.AntreaClusterNetworkPolicies))
 	out.AntreaNetworkPolicies = *(*[]NetworkPolicyStats)(unsafe.Pointer(&in.AntreaNetworkPolicies))
 	out.Multicast = *(*[]MulticastGroupInfo)(unsafe.Pointer(&in.Multicast))
+	out.Namespaces = *(*[]NamespaceTrafficStats)(unsafe.Pointer(&in.Namespaces))
 	return nil
 }
 
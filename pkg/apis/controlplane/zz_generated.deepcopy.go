@@ -730,6 +730,22 @@ func (in *IPNet) DeepCopy() *IPNet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaProtocol) DeepCopyInto(out *KafkaProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaProtocol.
+func (in *KafkaProtocol) DeepCopy() *KafkaProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *L7Protocol) DeepCopyInto(out *L7Protocol) {
 	*out = *in
@@ -743,6 +759,11 @@ func (in *L7Protocol) DeepCopyInto(out *L7Protocol) {
 		*out = new(TLSProtocol)
 		**out = **in
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaProtocol)
+		**out = **in
+	}
 	return
 }
 
@@ -793,6 +814,24 @@ func (in *NamedPort) DeepCopy() *NamedPort {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceTrafficStats) DeepCopyInto(out *NamespaceTrafficStats) {
+	*out = *in
+	out.Ingress = in.Ingress
+	out.Egress = in.Egress
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceTrafficStats.
+func (in *NamespaceTrafficStats) DeepCopy() *NamespaceTrafficStats {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceTrafficStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
 	*out = *in
@@ -1055,6 +1094,11 @@ func (in *NetworkPolicyRule) DeepCopyInto(out *NetworkPolicyRule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeTrafficTypes != nil {
+		in, out := &in.NodeTrafficTypes, &out.NodeTrafficTypes
+		*out = make([]v1beta1.NodeTrafficType, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1171,6 +1215,11 @@ func (in *NodeStatsSummary) DeepCopyInto(out *NodeStatsSummary) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]NamespaceTrafficStats, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
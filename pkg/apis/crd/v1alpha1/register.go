@@ -59,6 +59,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&BGPPolicyList{},
 		&PacketCapture{},
 		&PacketCaptureList{},
+		&NamespaceSecurityPolicy{},
+		&NamespaceSecurityPolicyList{},
 	)
 
 	metav1.AddToGroupVersion(
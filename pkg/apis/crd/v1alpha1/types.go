@@ -447,12 +447,19 @@ type CaptureConfig struct {
 // PacketCaptureFileServer specifies the PacketCapture file server information.
 type PacketCaptureFileServer struct {
 	// The URL of the file server. It is set with format: scheme://host[:port][/path],
-	// e.g., https://api.example.com:8443/v1/packets/. Currently only `sftp` protocol is supported.
+	// e.g., sftp://10.0.0.1:22/upload. The `sftp` and `s3` protocols are supported. For `s3`, the
+	// URL is set with format: s3://bucket[/prefix].
 	URL string `json:"url"`
 	// HostPublicKey specifies the only host public key that will be accepted when connecting to
 	// the file server. If omitted, any host key will be accepted, which is not recommended.
 	// For SFTP, the key must be formatted for use in the SSH wire protocol according to RFC 4253, section 6.6.
 	HostPublicKey []byte `json:"hostPublicKey,omitempty"`
+	// Endpoint is the URL of an S3-compatible object storage service, e.g. https://minio.example.com:9000.
+	// It is only used with the `s3` protocol. If omitted, AWS S3 is used.
+	Endpoint string `json:"endpoint,omitempty"`
+	// Region is the region of the bucket. It is only used with the `s3` protocol. If omitted, defaults
+	// to us-east-1.
+	Region string `json:"region,omitempty"`
 }
 
 type CaptureDirection string
@@ -514,3 +521,69 @@ type PacketCaptureCondition struct {
 	Reason             string                     `json:"reason"`
 	Message            string                     `json:"message"`
 }
+
+const (
+	// NamespaceIsolationLabelKey is the label of Namespaces which sets their isolation mode.
+	NamespaceIsolationLabelKey = "policy.antrea.io/isolation"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceSecurityPolicy reports the security policy enforced by Antrea for a Namespace. It is
+// managed by antrea-controller for the Namespaces with the "policy.antrea.io/isolation" label.
+type NamespaceSecurityPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NamespaceSecurityPolicySpec   `json:"spec"`
+	Status NamespaceSecurityPolicyStatus `json:"status"`
+}
+
+type NamespaceIsolationMode string
+
+const (
+	// NamespaceIsolationStrict denies all the ingress and egress traffic of the Pods in the
+	// Namespace, except the DNS queries to kube-dns, unless it is allowed by another policy.
+	NamespaceIsolationStrict NamespaceIsolationMode = "strict"
+)
+
+type NamespaceSecurityPolicySpec struct {
+	// Isolation is the isolation mode of the Namespace, as set by its "policy.antrea.io/isolation" label.
+	Isolation NamespaceIsolationMode `json:"isolation"`
+}
+
+type NamespaceSecurityPolicyPhase string
+
+const (
+	// NamespaceSecurityPolicyRealizing means the policy is being realized on the Nodes running Pods
+	// of the Namespace.
+	NamespaceSecurityPolicyRealizing NamespaceSecurityPolicyPhase = "Realizing"
+	// NamespaceSecurityPolicyEnforced means the policy has been realized on all the Nodes running
+	// Pods of the Namespace.
+	NamespaceSecurityPolicyEnforced NamespaceSecurityPolicyPhase = "Enforced"
+	// NamespaceSecurityPolicyFailed means the policy could not be computed, or could not be realized
+	// on some Nodes.
+	NamespaceSecurityPolicyFailed NamespaceSecurityPolicyPhase = "Failed"
+)
+
+type NamespaceSecurityPolicyStatus struct {
+	Phase NamespaceSecurityPolicyPhase `json:"phase,omitempty"`
+	// Message explains the phase if the policy could not be enforced.
+	Message string `json:"message,omitempty"`
+	// CurrentNodesRealized is the number of Nodes which have realized the policy.
+	CurrentNodesRealized int32 `json:"currentNodesRealized,omitempty"`
+	// DesiredNodesRealized is the number of Nodes running Pods of the Namespace, which must realize
+	// the policy.
+	DesiredNodesRealized int32 `json:"desiredNodesRealized,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type NamespaceSecurityPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []NamespaceSecurityPolicy `json:"items"`
+}
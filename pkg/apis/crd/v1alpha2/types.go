@@ -276,6 +276,20 @@ type TrafficControlPort struct {
 	GRE *GRETunnel `json:"gre,omitempty"`
 	// ERSPAN represents a ERSPAN tunnel.
 	ERSPAN *ERSPANTunnel `json:"erspan,omitempty"`
+	// Pod represents the OVS port of a Pod running on the same Node. It can only be used as the target port of the
+	// Mirror action.
+	Pod *PodPort `json:"pod,omitempty"`
+}
+
+// PodPort represents the OVS port of a Pod selected by label, e.g. a Pod of an IDS DaemonSet. On each Node, Antrea
+// Agent resolves the port of a selected Pod running on this Node, and updates it when the Pod is recreated. Traffic
+// is not mirrored on Nodes where no selected Pod is running.
+type PodPort struct {
+	// The Namespace of the Pod.
+	Namespace string `json:"namespace"`
+	// Select the Pod in the Namespace. If multiple Pods running on the same Node are selected, the one with the
+	// smallest name is used.
+	PodSelector metav1.LabelSelector `json:"podSelector"`
 }
 
 // OVSInternalPort represents an OVS internal port. Antrea will create the port if it doesn't exist.
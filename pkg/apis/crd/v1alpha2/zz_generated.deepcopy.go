@@ -459,6 +459,23 @@ func (in *PodOwner) DeepCopy() *PodOwner {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodPort) DeepCopyInto(out *PodPort) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodPort.
+func (in *PodPort) DeepCopy() *PodPort {
+	if in == nil {
+		return nil
+	}
+	out := new(PodPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatefulSetOwner) DeepCopyInto(out *StatefulSetOwner) {
 	*out = *in
@@ -602,6 +619,11 @@ func (in *TrafficControlPort) DeepCopyInto(out *TrafficControlPort) {
 		*out = new(ERSPANTunnel)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pod != nil {
+		in, out := &in.Pod, &out.Pod
+		*out = new(PodPort)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
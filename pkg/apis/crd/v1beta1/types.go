@@ -50,6 +50,9 @@ type AntreaAgentInfo struct {
 	APICABundle []byte `json:"apiCABundle,omitempty"`
 	// The port range used by NodePortLocal
 	NodePortLocalPortRange string `json:"nodePortLocalPortRange,omitempty"`
+	// The results of the startup-time checks of the kernel features required by the enabled
+	// Antrea features
+	KernelFeatureChecks []KernelFeatureCheck `json:"kernelFeatureChecks,omitempty"`
 }
 
 type OVSInfo struct {
@@ -70,6 +73,10 @@ const (
 	OVSDBConnectionUp AgentConditionType = "OVSDBConnectionUp"
 	// OpenflowConnectionUp is used to mark Openflow connection status.
 	OpenflowConnectionUp AgentConditionType = "OpenflowConnectionUp"
+	// GatewayConfigIntact is used to mark whether the MAC address and the IP addresses of the host gateway interface
+	// are the ones configured by Agent, or could be restored after being changed on the host. The datapath is broken
+	// when it is False.
+	GatewayConfigIntact AgentConditionType = "GatewayConfigIntact"
 )
 
 type AgentCondition struct {
@@ -85,6 +92,17 @@ type AgentCondition struct {
 	Message string `json:"message,omitempty"`
 }
 
+type KernelFeatureCheck struct {
+	// Name of the kernel feature, e.g. WireGuard
+	Name string `json:"name"`
+	// The Antrea feature or configuration which requires the kernel feature
+	RequiredBy string `json:"requiredBy,omitempty"`
+	// Whether the kernel feature is supported
+	Supported bool `json:"supported"`
+	// Human readable message explaining why the kernel feature is not supported
+	Message string `json:"message,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 type AntreaAgentInfoList struct {
@@ -229,6 +247,38 @@ type ExternalIPPoolSpec struct {
 	SubnetInfo *SubnetInfo `json:"subnetInfo,omitempty"`
 	// The Nodes that the external IPs can be assigned to. If empty, it means all Nodes.
 	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+	// The announcement of the IPs of this IP pool to the neighbors of the Nodes they are assigned to. If not set, the
+	// IPs are announced with gratuitous ARP (IPv4) and unsolicited Neighbor Advertisement (IPv6).
+	Announcement *IPAnnouncement `json:"announcement,omitempty"`
+}
+
+type IPAnnouncementMode string
+
+const (
+	// IPAnnouncementModeGARP announces the IPs with gratuitous ARP (IPv4) and unsolicited Neighbor Advertisement
+	// (IPv6), using the MAC address of the Node's interface.
+	IPAnnouncementModeGARP IPAnnouncementMode = "GARP"
+	// IPAnnouncementModeVRRP announces each IP as the virtual IP of a VRRP virtual router, using the virtual MAC
+	// address of the virtual router, which moves with the IP when it fails over to another Node.
+	IPAnnouncementModeVRRP IPAnnouncementMode = "VRRP"
+)
+
+// IPAnnouncement specifies how the IPs of an ExternalIPPool are announced.
+type IPAnnouncement struct {
+	// The announcement mode, GARP or VRRP. Default is GARP.
+	Mode IPAnnouncementMode `json:"mode,omitempty"`
+	// The VRRP parameters. It must be set when Mode is VRRP.
+	VRRP *VRRPAnnouncement `json:"vrrp,omitempty"`
+}
+
+// VRRPAnnouncement specifies the VRRP virtual routers used to announce the IPs of an ExternalIPPool.
+type VRRPAnnouncement struct {
+	// The Virtual Router ID of the first IP of the pool, 1~255. The IPs of the pool are mapped to consecutive Virtual
+	// Router IDs in the order of the IP ranges, so the pool cannot contain more IPs than the remaining Virtual Router
+	// IDs.
+	VirtualRouterIDBase int32 `json:"virtualRouterIDBase"`
+	// The interval between VRRP advertisements in centiseconds, 1~4095. Default is 100 (1 second).
+	AdvertisementInterval int32 `json:"advertisementInterval,omitempty"`
 }
 
 // IPRange is a set of contiguous IP addresses, represented by a CIDR or a pair of start and end IPs.
@@ -272,6 +322,13 @@ type IPPoolUsage struct {
 	Used int `json:"used"`
 }
 
+type IPPoolNamespaceUsage struct {
+	// Name of the Namespace.
+	Namespace string `json:"namespace"`
+	// Number of IPs allocated or reserved for the Pods and StatefulSets of the Namespace.
+	Used int `json:"used"`
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -296,11 +353,22 @@ type IPPoolSpec struct {
 	IPRanges []IPRange `json:"ipRanges"`
 	// The Subnet info of this IP pool. All the IP ranges in the IP pool should share the same subnet attributes.
 	SubnetInfo SubnetInfo `json:"subnetInfo"`
+	// Select the Namespaces whose Pods and StatefulSets can be allocated IPs from this IP pool through the IPPool
+	// annotations. If not set, the IP pool can be used by all Namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// The maximum number of IPs that can be allocated or reserved from this IP pool for the Pods and StatefulSets of
+	// a single Namespace. 0 means no limit.
+	// +optional
+	MaxIPsPerNamespace int32 `json:"maxIPsPerNamespace,omitempty"`
 }
 
 type IPPoolStatus struct {
 	IPAddresses []IPAddressState `json:"ipAddresses,omitempty"`
 	Usage       IPPoolUsage      `json:"usage,omitempty"`
+	// Usage of each Namespace which has IPs allocated or reserved from this IP pool. It is only reported when
+	// MaxIPsPerNamespace is set.
+	NamespaceUsages []IPPoolNamespaceUsage `json:"namespaceUsages,omitempty"`
 }
 
 type IPAddressPhase string
@@ -611,8 +679,30 @@ type Rule struct {
 	// conjunction with NetworkPolicySpec/ClusterNetworkPolicySpec.AppliedTo.
 	// +optional
 	AppliedTo []AppliedTo `json:"appliedTo,omitempty"`
+	// NodeTrafficTypes restricts the rule to the given categories of traffic
+	// destined to the Nodes selected by AppliedTo. It can only be set for ingress
+	// rules of ClusterNetworkPolicies applied to Nodes. If this field is empty,
+	// the rule matches traffic destined to the Node OS and to hostNetwork Pods,
+	// but not traffic forwarded to Pods through a hostPort.
+	// +optional
+	NodeTrafficTypes []NodeTrafficType `json:"nodeTrafficTypes,omitempty"`
 }
 
+// NodeTrafficType is a category of traffic destined to a Node.
+type NodeTrafficType string
+
+const (
+	// NodeTrafficTypeNodeOS is traffic destined to processes running in the Node
+	// OS itself, excluding hostNetwork Pods.
+	NodeTrafficTypeNodeOS NodeTrafficType = "NodeOS"
+	// NodeTrafficTypeHostNetworkPod is traffic destined to a container port
+	// declared by a hostNetwork Pod running on the Node.
+	NodeTrafficTypeHostNetworkPod NodeTrafficType = "HostNetworkPod"
+	// NodeTrafficTypeHostPort is traffic destined to a hostPort of the Node,
+	// which is DNAT'd and forwarded to a Pod running on the Node.
+	NodeTrafficTypeHostPort NodeTrafficType = "HostPort"
+)
+
 // NetworkPolicyPeer describes the grouping selector of workloads.
 type NetworkPolicyPeer struct {
 	// IPBlock describes the IPAddresses/IPBlocks that is matched in to/from.
@@ -910,8 +1000,9 @@ type IGMPProtocol struct {
 }
 
 type L7Protocol struct {
-	HTTP *HTTPProtocol `json:"http,omitempty"`
-	TLS  *TLSProtocol  `json:"tls,omitempty"`
+	HTTP  *HTTPProtocol  `json:"http,omitempty"`
+	TLS   *TLSProtocol   `json:"tls,omitempty"`
+	Kafka *KafkaProtocol `json:"kafka,omitempty"`
 }
 
 // HTTPProtocol matches HTTP requests with specific host, method, and path. All fields could be used alone or together.
@@ -934,6 +1025,16 @@ type TLSProtocol struct {
 	SNI string `json:"sni,omitempty"`
 }
 
+// KafkaProtocol matches Kafka requests with specific role and topic. All fields could be used alone or together.
+// If all fields are not provided, it matches all Kafka requests.
+type KafkaProtocol struct {
+	// Role represents the role of the Kafka client, which determines the Kafka requests to match.
+	// It could be produce or consume.
+	Role string `json:"role,omitempty"`
+	// Topic represents the name of the Kafka topic to match.
+	Topic string `json:"topic,omitempty"`
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -961,6 +1062,9 @@ type EgressStatus struct {
 	EgressIP string `json:"egressIP"`
 
 	Conditions []EgressCondition `json:"conditions,omitempty"`
+	// FailoverHistory records the most recent changes of the Node that holds the Egress IP, the
+	// latest one last.
+	FailoverHistory []EgressFailover `json:"failoverHistory,omitempty"`
 }
 
 type EgressConditionType string
@@ -972,6 +1076,12 @@ const (
 	// IPAssigned means the Egress has been assigned to a Node.
 	// It is not applicable for Egresses with empty ExternalIPPool.
 	IPAssigned EgressConditionType = "IPAssigned"
+	// IPConflict means the IP of the Egress is also allocated to another consumer of ExternalIPPools, e.g. a
+	// LoadBalancer Service.
+	IPConflict EgressConditionType = "IPConflict"
+	// NoSchedulableNode means none of the Nodes selected by the ExternalIPPool of the Egress can hold the
+	// Egress IP, e.g. because they are all unreachable or have reached their Egress IP capacity.
+	NoSchedulableNode EgressConditionType = "NoSchedulableNode"
 )
 
 type EgressCondition struct {
@@ -982,6 +1092,17 @@ type EgressCondition struct {
 	Message            string                 `json:"message,omitempty"`
 }
 
+// EgressFailover records a change of the Node that holds the Egress IP.
+type EgressFailover struct {
+	// FromNode is the Node that held the Egress IP before the failover.
+	FromNode string `json:"fromNode"`
+	// ToNode is the Node that holds the Egress IP after the failover. It is empty if no Node could
+	// take over the Egress IP.
+	ToNode string `json:"toNode,omitempty"`
+	// Time is the time when the failover was observed.
+	Time metav1.Time `json:"time"`
+}
+
 // EgressSpec defines the desired state for Egress.
 type EgressSpec struct {
 	// AppliedTo selects Pods to which the Egress will be applied.
@@ -1171,8 +1292,10 @@ type IPv6Header struct {
 // TransportHeader describes spec of a TransportHeader.
 type TransportHeader struct {
 	ICMP *ICMPEchoRequestHeader `json:"icmp,omitempty" yaml:"icmp,omitempty"`
-	UDP  *UDPHeader             `json:"udp,omitempty" yaml:"udp,omitempty"`
-	TCP  *TCPHeader             `json:"tcp,omitempty" yaml:"tcp,omitempty"`
+	// NDP is an ICMPv6 Neighbor Discovery message header. It can only be used with IPv6.
+	NDP *NDPHeader `json:"ndp,omitempty" yaml:"ndp,omitempty"`
+	UDP *UDPHeader `json:"udp,omitempty" yaml:"udp,omitempty"`
+	TCP *TCPHeader `json:"tcp,omitempty" yaml:"tcp,omitempty"`
 }
 
 // ICMPEchoRequestHeader describes spec of an ICMP echo request header.
@@ -1183,12 +1306,37 @@ type ICMPEchoRequestHeader struct {
 	Sequence int32 `json:"sequence,omitempty"`
 }
 
+type NDPMessageType string
+
+const (
+	NDPNeighborSolicitation  NDPMessageType = "NeighborSolicitation"
+	NDPNeighborAdvertisement NDPMessageType = "NeighborAdvertisement"
+)
+
+// NDPHeader describes spec of an ICMPv6 Neighbor Discovery message header.
+type NDPHeader struct {
+	// Type is the type of the Neighbor Discovery message. The target address of
+	// the message is the destination IP.
+	Type NDPMessageType `json:"type"`
+}
+
 // UDPHeader describes spec of a UDP header.
 type UDPHeader struct {
 	// SrcPort is the source port.
 	SrcPort int32 `json:"srcPort,omitempty"`
-	// DstPort is the destination port.
+	// DstPort is the destination port. It defaults to 53 when DNS is set.
 	DstPort int32 `json:"dstPort,omitempty"`
+	// DNS is the DNS query carried by the UDP packet.
+	DNS *DNSQuery `json:"dns,omitempty" yaml:"dns,omitempty"`
+}
+
+// DNSQuery describes a DNS query.
+type DNSQuery struct {
+	// QueryName is the domain name to query.
+	QueryName string `json:"queryName"`
+	// QueryType is the type of the query, "A" or "AAAA". It defaults to "AAAA"
+	// for IPv6 packets and to "A" otherwise.
+	QueryType string `json:"queryType,omitempty"`
 }
 
 // TCPHeader describes spec of a TCP header.
@@ -1275,6 +1423,37 @@ type Observation struct {
 	EgressNode string `json:"egressNode,omitempty" yaml:"egressNode,omitempty"`
 	// SrcPodIP is the IP of source Pod.
 	SrcPodIP string `json:"srcPodIP,omitempty" yaml:"srcPodIP,omitempty"`
+	// NetworkPolicyRuleStats is the hit statistics of the NetworkPolicy rule on the Node when the packet is observed.
+	NetworkPolicyRuleStats *NetworkPolicyRuleStats `json:"networkPolicyRuleStats,omitempty" yaml:"networkPolicyRuleStats,omitempty"`
+	// InterfaceStats is the counters of the HNS endpoint of the Pod when the packet is delivered to a Pod on a Windows
+	// Node. Packets can be dropped by the HNS endpoint after being delivered by OVS.
+	InterfaceStats *InterfaceStats `json:"interfaceStats,omitempty" yaml:"interfaceStats,omitempty"`
+}
+
+// InterfaceStats is the counters of the HNS endpoint of a Pod on a Windows Node, which accumulate since the endpoint
+// was created.
+type InterfaceStats struct {
+	// PacketsReceived is the number of packets received by the Pod.
+	PacketsReceived int64 `json:"packetsReceived,omitempty" yaml:"packetsReceived,omitempty"`
+	// PacketsSent is the number of packets sent by the Pod.
+	PacketsSent int64 `json:"packetsSent,omitempty" yaml:"packetsSent,omitempty"`
+	// DroppedPacketsIncoming is the number of incoming packets dropped by the HNS endpoint.
+	DroppedPacketsIncoming int64 `json:"droppedPacketsIncoming,omitempty" yaml:"droppedPacketsIncoming,omitempty"`
+	// DroppedPacketsOutgoing is the number of outgoing packets dropped by the HNS endpoint.
+	DroppedPacketsOutgoing int64 `json:"droppedPacketsOutgoing,omitempty" yaml:"droppedPacketsOutgoing,omitempty"`
+}
+
+// NetworkPolicyRuleStats is the hit statistics of a NetworkPolicy rule on a Node, which accumulate since the rule was
+// realized on the Node.
+type NetworkPolicyRuleStats struct {
+	// Packets is the number of packets matching the rule.
+	Packets int64 `json:"packets,omitempty" yaml:"packets,omitempty"`
+	// Bytes is the number of bytes matching the rule.
+	Bytes int64 `json:"bytes,omitempty" yaml:"bytes,omitempty"`
+	// Sessions is the number of sessions matching the rule.
+	Sessions int64 `json:"sessions,omitempty" yaml:"sessions,omitempty"`
+	// LastHitTime is the last time a packet matched the rule. It's not set if no packet has matched the rule.
+	LastHitTime *metav1.Time `json:"lastHitTime,omitempty" yaml:"lastHitTime,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
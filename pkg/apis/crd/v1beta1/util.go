@@ -14,6 +14,13 @@
 
 package v1beta1
 
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+	"net/netip"
+)
+
 func GetEgressCondition(conditions []EgressCondition, conditionType EgressConditionType) *EgressCondition {
 	for idx := range conditions {
 		c := &conditions[idx]
@@ -38,3 +45,88 @@ func CompareSubnetInfo(a, b *SubnetInfo, ignoringGateway bool) bool {
 	}
 	return a.VLAN == b.VLAN && a.PrefixLength == b.PrefixLength
 }
+
+// maxVRRPVirtualRouterID is the largest Virtual Router ID allowed by VRRP.
+const maxVRRPVirtualRouterID = 255
+
+// GetVRRPVirtualRouterID returns the VRRP Virtual Router ID of the IP if the ExternalIPPool announces its IPs with
+// VRRP and the IP belongs to the pool. The IPs of the pool are mapped to consecutive Virtual Router IDs starting from
+// the base, in the order of the IP ranges.
+func GetVRRPVirtualRouterID(pool *ExternalIPPool, ip netip.Addr) (int32, bool) {
+	announcement := pool.Spec.Announcement
+	if announcement == nil || announcement.Mode != IPAnnouncementModeVRRP || announcement.VRRP == nil {
+		return 0, false
+	}
+	id := uint64(announcement.VRRP.VirtualRouterIDBase)
+	for _, ipRange := range pool.Spec.IPRanges {
+		start, end, ok := ParseIPRange(ipRange)
+		if !ok {
+			return 0, false
+		}
+		if start.Is4() == ip.Is4() && start.Compare(ip) <= 0 && ip.Compare(end) <= 0 {
+			id += addrDistance(start, ip)
+			if id > maxVRRPVirtualRouterID {
+				return 0, false
+			}
+			return int32(id), true
+		}
+		// The pool is too large to be announced with VRRP, which is rejected by the validation.
+		size := addrDistance(start, end)
+		if size >= maxVRRPVirtualRouterID {
+			return 0, false
+		}
+		id += size + 1
+	}
+	return 0, false
+}
+
+// GetIPRangeSize returns the number of IPs in the IPRange, saturated at math.MaxUint64.
+func GetIPRangeSize(ipRange IPRange) (uint64, bool) {
+	start, end, ok := ParseIPRange(ipRange)
+	if !ok {
+		return 0, false
+	}
+	size := addrDistance(start, end)
+	if size == math.MaxUint64 {
+		return size, true
+	}
+	return size + 1, true
+}
+
+// ParseIPRange returns the first and the last IPs of the IPRange.
+func ParseIPRange(ipRange IPRange) (netip.Addr, netip.Addr, bool) {
+	if ipRange.CIDR != "" {
+		prefix, err := netip.ParsePrefix(ipRange.CIDR)
+		if err != nil {
+			return netip.Addr{}, netip.Addr{}, false
+		}
+		start := prefix.Masked().Addr()
+		end := start.AsSlice()
+		for i := prefix.Bits(); i < start.BitLen(); i++ {
+			end[i/8] |= 0x80 >> (i % 8)
+		}
+		endAddr, _ := netip.AddrFromSlice(end)
+		return start, endAddr, true
+	}
+	start, err := netip.ParseAddr(ipRange.Start)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, false
+	}
+	end, err := netip.ParseAddr(ipRange.End)
+	if err != nil {
+		return netip.Addr{}, netip.Addr{}, false
+	}
+	return start, end, true
+}
+
+// addrDistance returns the number of IPs from one IP to another IP of the same family, saturated at math.MaxUint64.
+func addrDistance(from, to netip.Addr) uint64 {
+	a, b := from.As16(), to.As16()
+	fromHigh, fromLow := binary.BigEndian.Uint64(a[:8]), binary.BigEndian.Uint64(a[8:])
+	toHigh, toLow := binary.BigEndian.Uint64(b[:8]), binary.BigEndian.Uint64(b[8:])
+	low, borrow := bits.Sub64(toLow, fromLow, 0)
+	if toHigh-fromHigh-borrow != 0 {
+		return math.MaxUint64
+	}
+	return low
+}
@@ -0,0 +1,114 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"math"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetVRRPVirtualRouterID(t *testing.T) {
+	vrrpPool := &ExternalIPPool{
+		Spec: ExternalIPPoolSpec{
+			IPRanges: []IPRange{
+				{CIDR: "10.10.10.0/30"},
+				{Start: "10.10.20.10", End: "10.10.20.12"},
+				{CIDR: "2021:1::/126"},
+			},
+			Announcement: &IPAnnouncement{
+				Mode: IPAnnouncementModeVRRP,
+				VRRP: &VRRPAnnouncement{VirtualRouterIDBase: 10},
+			},
+		},
+	}
+	garpPool := vrrpPool.DeepCopy()
+	garpPool.Spec.Announcement = nil
+	largePool := vrrpPool.DeepCopy()
+	largePool.Spec.IPRanges = []IPRange{{CIDR: "2021:2::/64"}, {CIDR: "10.10.30.0/24"}}
+
+	tests := []struct {
+		name       string
+		pool       *ExternalIPPool
+		ip         string
+		expectedID int32
+		expectedOK bool
+	}{
+		{
+			name:       "first IP of the first range",
+			pool:       vrrpPool,
+			ip:         "10.10.10.0",
+			expectedID: 10,
+			expectedOK: true,
+		},
+		{
+			name:       "IP of the second range",
+			pool:       vrrpPool,
+			ip:         "10.10.20.11",
+			expectedID: 15,
+			expectedOK: true,
+		},
+		{
+			name:       "IPv6 IP of the third range",
+			pool:       vrrpPool,
+			ip:         "2021:1::3",
+			expectedID: 20,
+			expectedOK: true,
+		},
+		{
+			name: "IP not in the pool",
+			pool: vrrpPool,
+			ip:   "10.10.20.13",
+		},
+		{
+			name: "pool without VRRP announcement",
+			pool: garpPool,
+			ip:   "10.10.10.0",
+		},
+		{
+			name: "IP after a range too large",
+			pool: largePool,
+			ip:   "10.10.30.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := GetVRRPVirtualRouterID(tt.pool, netip.MustParseAddr(tt.ip))
+			assert.Equal(t, tt.expectedOK, ok)
+			assert.Equal(t, tt.expectedID, id)
+		})
+	}
+}
+
+func TestGetIPRangeSize(t *testing.T) {
+	tests := []struct {
+		ipRange      IPRange
+		expectedSize uint64
+	}{
+		{ipRange: IPRange{CIDR: "10.10.10.0/24"}, expectedSize: 256},
+		{ipRange: IPRange{CIDR: "10.10.10.1/32"}, expectedSize: 1},
+		{ipRange: IPRange{Start: "10.10.10.1", End: "10.10.10.20"}, expectedSize: 20},
+		{ipRange: IPRange{CIDR: "2021:1::/120"}, expectedSize: 256},
+		{ipRange: IPRange{CIDR: "2021:1::/64"}, expectedSize: math.MaxUint64},
+		{ipRange: IPRange{CIDR: "2021:1::/32"}, expectedSize: math.MaxUint64},
+	}
+	for _, tt := range tests {
+		size, ok := GetIPRangeSize(tt.ipRange)
+		assert.True(t, ok)
+		assert.Equal(t, tt.expectedSize, size, "Unexpected size of IPRange %v", tt.ipRange)
+	}
+}
@@ -69,6 +69,11 @@ func (in *AntreaAgentInfo) DeepCopyInto(out *AntreaAgentInfo) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.KernelFeatureChecks != nil {
+		in, out := &in.KernelFeatureChecks, &out.KernelFeatureChecks
+		*out = make([]KernelFeatureCheck, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -431,6 +436,22 @@ func (in *ControllerCondition) DeepCopy() *ControllerCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSQuery) DeepCopyInto(out *DNSQuery) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSQuery.
+func (in *DNSQuery) DeepCopy() *DNSQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Destination) DeepCopyInto(out *Destination) {
 	*out = *in
@@ -492,6 +513,23 @@ func (in *EgressCondition) DeepCopy() *EgressCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressFailover) DeepCopyInto(out *EgressFailover) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressFailover.
+func (in *EgressFailover) DeepCopy() *EgressFailover {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressFailover)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EgressList) DeepCopyInto(out *EgressList) {
 	*out = *in
@@ -567,6 +605,13 @@ func (in *EgressStatus) DeepCopyInto(out *EgressStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FailoverHistory != nil {
+		in, out := &in.FailoverHistory, &out.FailoverHistory
+		*out = make([]EgressFailover, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -655,6 +700,11 @@ func (in *ExternalIPPoolSpec) DeepCopyInto(out *ExternalIPPoolSpec) {
 		**out = **in
 	}
 	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	if in.Announcement != nil {
+		in, out := &in.Announcement, &out.Announcement
+		*out = new(IPAnnouncement)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -956,6 +1006,27 @@ func (in *IPAddressState) DeepCopy() *IPAddressState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAnnouncement) DeepCopyInto(out *IPAnnouncement) {
+	*out = *in
+	if in.VRRP != nil {
+		in, out := &in.VRRP, &out.VRRP
+		*out = new(VRRPAnnouncement)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAnnouncement.
+func (in *IPAnnouncement) DeepCopy() *IPAnnouncement {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAnnouncement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPBlock) DeepCopyInto(out *IPBlock) {
 	*out = *in
@@ -1054,6 +1125,22 @@ func (in *IPPoolList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPPoolNamespaceUsage) DeepCopyInto(out *IPPoolNamespaceUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPoolNamespaceUsage.
+func (in *IPPoolNamespaceUsage) DeepCopy() *IPPoolNamespaceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(IPPoolNamespaceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
 	*out = *in
@@ -1063,6 +1150,11 @@ func (in *IPPoolSpec) DeepCopyInto(out *IPPoolSpec) {
 		copy(*out, *in)
 	}
 	out.SubnetInfo = in.SubnetInfo
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1087,6 +1179,11 @@ func (in *IPPoolStatus) DeepCopyInto(out *IPPoolStatus) {
 		}
 	}
 	out.Usage = in.Usage
+	if in.NamespaceUsages != nil {
+		in, out := &in.NamespaceUsages, &out.NamespaceUsages
+		*out = make([]IPPoolNamespaceUsage, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1153,6 +1250,54 @@ func (in *IPv6Header) DeepCopy() *IPv6Header {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceStats) DeepCopyInto(out *InterfaceStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceStats.
+func (in *InterfaceStats) DeepCopy() *InterfaceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaProtocol) DeepCopyInto(out *KafkaProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaProtocol.
+func (in *KafkaProtocol) DeepCopy() *KafkaProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelFeatureCheck) DeepCopyInto(out *KernelFeatureCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelFeatureCheck.
+func (in *KernelFeatureCheck) DeepCopy() *KernelFeatureCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelFeatureCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *L7Protocol) DeepCopyInto(out *L7Protocol) {
 	*out = *in
@@ -1166,6 +1311,11 @@ func (in *L7Protocol) DeepCopyInto(out *L7Protocol) {
 		*out = new(TLSProtocol)
 		**out = **in
 	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(KafkaProtocol)
+		**out = **in
+	}
 	return
 }
 
@@ -1179,6 +1329,22 @@ func (in *L7Protocol) DeepCopy() *L7Protocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NDPHeader) DeepCopyInto(out *NDPHeader) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NDPHeader.
+func (in *NDPHeader) DeepCopy() *NDPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(NDPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
 	*out = *in
@@ -1407,6 +1573,26 @@ func (in *NetworkPolicyProtocol) DeepCopy() *NetworkPolicyProtocol {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyRuleStats) DeepCopyInto(out *NetworkPolicyRuleStats) {
+	*out = *in
+	if in.LastHitTime != nil {
+		in, out := &in.LastHitTime, &out.LastHitTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyRuleStats.
+func (in *NetworkPolicyRuleStats) DeepCopy() *NetworkPolicyRuleStats {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyRuleStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicySpec) DeepCopyInto(out *NetworkPolicySpec) {
 	*out = *in
@@ -1473,7 +1659,9 @@ func (in *NodeResult) DeepCopyInto(out *NodeResult) {
 	if in.Observations != nil {
 		in, out := &in.Observations, &out.Observations
 		*out = make([]Observation, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -1514,6 +1702,16 @@ func (in *OVSInfo) DeepCopy() *OVSInfo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Observation) DeepCopyInto(out *Observation) {
 	*out = *in
+	if in.NetworkPolicyRuleStats != nil {
+		in, out := &in.NetworkPolicyRuleStats, &out.NetworkPolicyRuleStats
+		*out = new(NetworkPolicyRuleStats)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InterfaceStats != nil {
+		in, out := &in.InterfaceStats, &out.InterfaceStats
+		*out = new(InterfaceStats)
+		**out = **in
+	}
 	return
 }
 
@@ -1662,6 +1860,11 @@ func (in *Rule) DeepCopyInto(out *Rule) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodeTrafficTypes != nil {
+		in, out := &in.NodeTrafficTypes, &out.NodeTrafficTypes
+		*out = make([]NodeTrafficType, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1956,10 +2159,15 @@ func (in *TransportHeader) DeepCopyInto(out *TransportHeader) {
 		*out = new(ICMPEchoRequestHeader)
 		**out = **in
 	}
+	if in.NDP != nil {
+		in, out := &in.NDP, &out.NDP
+		*out = new(NDPHeader)
+		**out = **in
+	}
 	if in.UDP != nil {
 		in, out := &in.UDP, &out.UDP
 		*out = new(UDPHeader)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.TCP != nil {
 		in, out := &in.TCP, &out.TCP
@@ -1982,6 +2190,11 @@ func (in *TransportHeader) DeepCopy() *TransportHeader {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UDPHeader) DeepCopyInto(out *UDPHeader) {
 	*out = *in
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(DNSQuery)
+		**out = **in
+	}
 	return
 }
 
@@ -1994,3 +2207,19 @@ func (in *UDPHeader) DeepCopy() *UDPHeader {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VRRPAnnouncement) DeepCopyInto(out *VRRPAnnouncement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VRRPAnnouncement.
+func (in *VRRPAnnouncement) DeepCopy() *VRRPAnnouncement {
+	if in == nil {
+		return nil
+	}
+	out := new(VRRPAnnouncement)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apis
+
+// PodNetworkPoliciesRealizedCondition is the type of the Pod condition set by antrea-agent once all the
+// NetworkPolicies applied to the Pod have been realized. Pods can include it in their readinessGates so that they
+// are not considered ready before their NetworkPolicies are enforced.
+const PodNetworkPoliciesRealizedCondition = "antrea.io/network-policies-realized"
+
+// PodNetworkPolicy describes an internal NetworkPolicy applied to a Pod, as computed by antrea-controller. It is
+// stored in the PodNetworkPoliciesAnnotationKey annotation of the Pod.
+type PodNetworkPolicy struct {
+	// UID of the internal NetworkPolicy.
+	UID string `json:"uid"`
+	// Generation of the internal NetworkPolicy which is disseminated to the Node of the Pod.
+	Generation int64 `json:"generation"`
+	// AppliedToGroups are the names of the AppliedToGroups of the NetworkPolicy which include the Pod.
+	AppliedToGroups []string `json:"appliedToGroups"`
+}
@@ -209,10 +209,66 @@ func (m *MulticastGroupList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MulticastGroupList proto.InternalMessageInfo
 
+func (m *NamespaceTrafficStats) Reset()      { *m = NamespaceTrafficStats{} }
+func (*NamespaceTrafficStats) ProtoMessage() {}
+func (*NamespaceTrafficStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{6}
+}
+func (m *NamespaceTrafficStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceTrafficStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespaceTrafficStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTrafficStats.Merge(m, src)
+}
+func (m *NamespaceTrafficStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceTrafficStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTrafficStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceTrafficStats proto.InternalMessageInfo
+
+func (m *NamespaceTrafficStatsList) Reset()      { *m = NamespaceTrafficStatsList{} }
+func (*NamespaceTrafficStatsList) ProtoMessage() {}
+func (*NamespaceTrafficStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{7}
+}
+func (m *NamespaceTrafficStatsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceTrafficStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespaceTrafficStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceTrafficStatsList.Merge(m, src)
+}
+func (m *NamespaceTrafficStatsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceTrafficStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceTrafficStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceTrafficStatsList proto.InternalMessageInfo
+
 func (m *NetworkPolicyStats) Reset()      { *m = NetworkPolicyStats{} }
 func (*NetworkPolicyStats) ProtoMessage() {}
 func (*NetworkPolicyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{6}
+	return fileDescriptor_91b517c6fa558473, []int{8}
 }
 func (m *NetworkPolicyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,7 +296,7 @@ var xxx_messageInfo_NetworkPolicyStats proto.InternalMessageInfo
 func (m *NetworkPolicyStatsList) Reset()      { *m = NetworkPolicyStatsList{} }
 func (*NetworkPolicyStatsList) ProtoMessage() {}
 func (*NetworkPolicyStatsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{7}
+	return fileDescriptor_91b517c6fa558473, []int{9}
 }
 func (m *NetworkPolicyStatsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,10 +321,66 @@ func (m *NetworkPolicyStatsList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NetworkPolicyStatsList proto.InternalMessageInfo
 
+func (m *NodeInterfaceStats) Reset()      { *m = NodeInterfaceStats{} }
+func (*NodeInterfaceStats) ProtoMessage() {}
+func (*NodeInterfaceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{10}
+}
+func (m *NodeInterfaceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeInterfaceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeInterfaceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInterfaceStats.Merge(m, src)
+}
+func (m *NodeInterfaceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeInterfaceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInterfaceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInterfaceStats proto.InternalMessageInfo
+
+func (m *NodeInterfaceStatsList) Reset()      { *m = NodeInterfaceStatsList{} }
+func (*NodeInterfaceStatsList) ProtoMessage() {}
+func (*NodeInterfaceStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{11}
+}
+func (m *NodeInterfaceStatsList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeInterfaceStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NodeInterfaceStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInterfaceStatsList.Merge(m, src)
+}
+func (m *NodeInterfaceStatsList) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeInterfaceStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInterfaceStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInterfaceStatsList proto.InternalMessageInfo
+
 func (m *NodeLatencyStats) Reset()      { *m = NodeLatencyStats{} }
 func (*NodeLatencyStats) ProtoMessage() {}
 func (*NodeLatencyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{8}
+	return fileDescriptor_91b517c6fa558473, []int{12}
 }
 func (m *NodeLatencyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -296,7 +408,7 @@ var xxx_messageInfo_NodeLatencyStats proto.InternalMessageInfo
 func (m *NodeLatencyStatsList) Reset()      { *m = NodeLatencyStatsList{} }
 func (*NodeLatencyStatsList) ProtoMessage() {}
 func (*NodeLatencyStatsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{9}
+	return fileDescriptor_91b517c6fa558473, []int{13}
 }
 func (m *NodeLatencyStatsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,7 +436,7 @@ var xxx_messageInfo_NodeLatencyStatsList proto.InternalMessageInfo
 func (m *PeerNodeLatencyStats) Reset()      { *m = PeerNodeLatencyStats{} }
 func (*PeerNodeLatencyStats) ProtoMessage() {}
 func (*PeerNodeLatencyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{10}
+	return fileDescriptor_91b517c6fa558473, []int{14}
 }
 func (m *PeerNodeLatencyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,10 +461,38 @@ func (m *PeerNodeLatencyStats) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PeerNodeLatencyStats proto.InternalMessageInfo
 
+func (m *PodInterfaceStats) Reset()      { *m = PodInterfaceStats{} }
+func (*PodInterfaceStats) ProtoMessage() {}
+func (*PodInterfaceStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91b517c6fa558473, []int{15}
+}
+func (m *PodInterfaceStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PodInterfaceStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PodInterfaceStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodInterfaceStats.Merge(m, src)
+}
+func (m *PodInterfaceStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodInterfaceStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodInterfaceStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodInterfaceStats proto.InternalMessageInfo
+
 func (m *PodReference) Reset()      { *m = PodReference{} }
 func (*PodReference) ProtoMessage() {}
 func (*PodReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{11}
+	return fileDescriptor_91b517c6fa558473, []int{16}
 }
 func (m *PodReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -380,7 +520,7 @@ var xxx_messageInfo_PodReference proto.InternalMessageInfo
 func (m *RuleTrafficStats) Reset()      { *m = RuleTrafficStats{} }
 func (*RuleTrafficStats) ProtoMessage() {}
 func (*RuleTrafficStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{12}
+	return fileDescriptor_91b517c6fa558473, []int{17}
 }
 func (m *RuleTrafficStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +548,7 @@ var xxx_messageInfo_RuleTrafficStats proto.InternalMessageInfo
 func (m *TargetIPLatencyStats) Reset()      { *m = TargetIPLatencyStats{} }
 func (*TargetIPLatencyStats) ProtoMessage() {}
 func (*TargetIPLatencyStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{13}
+	return fileDescriptor_91b517c6fa558473, []int{18}
 }
 func (m *TargetIPLatencyStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -436,7 +576,7 @@ var xxx_messageInfo_TargetIPLatencyStats proto.InternalMessageInfo
 func (m *TrafficStats) Reset()      { *m = TrafficStats{} }
 func (*TrafficStats) ProtoMessage() {}
 func (*TrafficStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91b517c6fa558473, []int{14}
+	return fileDescriptor_91b517c6fa558473, []int{19}
 }
 func (m *TrafficStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,11 +608,16 @@ func init() {
 	proto.RegisterType((*AntreaNetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.AntreaNetworkPolicyStatsList")
 	proto.RegisterType((*MulticastGroup)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.MulticastGroup")
 	proto.RegisterType((*MulticastGroupList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.MulticastGroupList")
+	proto.RegisterType((*NamespaceTrafficStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NamespaceTrafficStats")
+	proto.RegisterType((*NamespaceTrafficStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NamespaceTrafficStatsList")
 	proto.RegisterType((*NetworkPolicyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NetworkPolicyStats")
 	proto.RegisterType((*NetworkPolicyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NetworkPolicyStatsList")
+	proto.RegisterType((*NodeInterfaceStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NodeInterfaceStats")
+	proto.RegisterType((*NodeInterfaceStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NodeInterfaceStatsList")
 	proto.RegisterType((*NodeLatencyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NodeLatencyStats")
 	proto.RegisterType((*NodeLatencyStatsList)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.NodeLatencyStatsList")
 	proto.RegisterType((*PeerNodeLatencyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.PeerNodeLatencyStats")
+	proto.RegisterType((*PodInterfaceStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.PodInterfaceStats")
 	proto.RegisterType((*PodReference)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.PodReference")
 	proto.RegisterType((*RuleTrafficStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.RuleTrafficStats")
 	proto.RegisterType((*TargetIPLatencyStats)(nil), "antrea_io.antrea.pkg.apis.stats.v1alpha1.TargetIPLatencyStats")
@@ -484,64 +629,84 @@ func init() {
 }
 
 var fileDescriptor_91b517c6fa558473 = []byte{
-	// 906 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0x24, 0xad, 0xb6, 0x9d, 0x0d, 0x50, 0xac, 0x0a, 0x45, 0xd1, 0xca, 0xad, 0xbc, 0x97,
-	0x80, 0xc0, 0xa6, 0x2b, 0xb4, 0xaa, 0x10, 0x42, 0xc2, 0x1c, 0x50, 0xa5, 0x36, 0x44, 0xd3, 0x1c,
-	0x10, 0x02, 0x2d, 0x13, 0xfb, 0xd5, 0x35, 0x89, 0x3d, 0x96, 0x67, 0x5c, 0xd4, 0xdb, 0xde, 0xb8,
-	0x70, 0xd8, 0xbf, 0x82, 0xbf, 0xa5, 0xc7, 0xe5, 0x80, 0x58, 0x2e, 0x2b, 0x1a, 0x84, 0xc4, 0x15,
-	0xc1, 0x81, 0x23, 0x9a, 0xb1, 0x1d, 0xc7, 0x89, 0x4b, 0x1d, 0xad, 0x14, 0x0e, 0xec, 0xa9, 0xf6,
-	0xfb, 0xf1, 0x7d, 0xef, 0xbd, 0xef, 0xcd, 0xb8, 0xc1, 0x87, 0x34, 0x14, 0x31, 0x50, 0xd3, 0x67,
-	0x56, 0xfa, 0x64, 0x45, 0x63, 0xcf, 0xa2, 0x91, 0xcf, 0x2d, 0x2e, 0xa8, 0xe0, 0xd6, 0xc5, 0x01,
-	0x9d, 0x44, 0xe7, 0xf4, 0xc0, 0xf2, 0x20, 0x84, 0x98, 0x0a, 0x70, 0xcd, 0x28, 0x66, 0x82, 0x69,
-	0xbd, 0x34, 0xfe, 0x91, 0xcf, 0xcc, 0x0c, 0x23, 0x1a, 0x7b, 0xa6, 0xcc, 0x34, 0x55, 0xa6, 0x99,
-	0x67, 0x76, 0xdf, 0xf1, 0x7c, 0x71, 0x9e, 0x8c, 0x4c, 0x87, 0x05, 0x96, 0xc7, 0x3c, 0x66, 0x29,
-	0x80, 0x51, 0x72, 0xa6, 0xde, 0xd4, 0x8b, 0x7a, 0x4a, 0x81, 0xbb, 0xef, 0x8d, 0x0f, 0xb9, 0xaa,
-	0x27, 0xf2, 0x03, 0xea, 0x9c, 0xfb, 0x21, 0xc4, 0x97, 0x45, 0x55, 0x01, 0x08, 0x6a, 0x5d, 0x2c,
-	0x95, 0xd3, 0xb5, 0x6e, 0xca, 0x8a, 0x93, 0x50, 0xf8, 0x01, 0x2c, 0x25, 0x3c, 0xbc, 0x2d, 0x81,
-	0x3b, 0xe7, 0x10, 0xd0, 0xc5, 0x3c, 0xe3, 0xef, 0x26, 0xde, 0xfb, 0x48, 0x35, 0xfc, 0xf1, 0x24,
-	0xe1, 0x02, 0xe2, 0x3e, 0x88, 0x6f, 0x58, 0x3c, 0x1e, 0xb0, 0x89, 0xef, 0x5c, 0x9e, 0xca, 0xd6,
-	0xb5, 0xaf, 0xf0, 0x96, 0xac, 0xd3, 0xa5, 0x82, 0x76, 0xd0, 0x3e, 0xea, 0xdd, 0x7d, 0xf0, 0xae,
-	0x99, 0xd2, 0x99, 0xf3, 0x74, 0xc5, 0xc4, 0x64, 0xb4, 0x79, 0x71, 0x60, 0x7e, 0x3a, 0xfa, 0x1a,
-	0x1c, 0x71, 0x02, 0x82, 0xda, 0xda, 0xd5, 0xf3, 0xbd, 0xc6, 0xf4, 0xf9, 0x1e, 0x2e, 0x6c, 0x64,
-	0x86, 0xaa, 0x45, 0xb8, 0x2d, 0x62, 0x7a, 0x76, 0xe6, 0x3b, 0x8a, 0xb1, 0xd3, 0x54, 0x2c, 0x0f,
-	0xcd, 0xba, 0xa2, 0x98, 0xc3, 0xb9, 0x6c, 0x7b, 0x37, 0xe3, 0x6a, 0xcf, 0x5b, 0x49, 0x89, 0x41,
-	0x7b, 0x8c, 0xf0, 0x4e, 0x9c, 0x4c, 0x60, 0x3e, 0xa4, 0xd3, 0xda, 0x6f, 0xf5, 0xee, 0x3e, 0x78,
-	0xbf, 0x3e, 0x2d, 0x59, 0x40, 0xb0, 0x3b, 0x19, 0xf5, 0xce, 0xa2, 0x87, 0x2c, 0xb1, 0x19, 0x7f,
-	0x22, 0x7c, 0xff, 0x96, 0xd1, 0x1f, 0xfb, 0x5c, 0x68, 0x5f, 0x2c, 0x8d, 0xdf, 0xac, 0x37, 0x7e,
-	0x99, 0xad, 0x86, 0xbf, 0x93, 0x55, 0xb5, 0x95, 0x5b, 0xe6, 0x46, 0x1f, 0xe2, 0x4d, 0x5f, 0x40,
-	0x20, 0x67, 0x2e, 0x9b, 0x3f, 0xaa, 0xdf, 0xfc, 0x2d, 0xb5, 0xdb, 0xaf, 0x64, 0xac, 0x9b, 0x47,
-	0x12, 0x9f, 0xa4, 0x34, 0xc6, 0x1f, 0x4d, 0xdc, 0x49, 0x33, 0x5f, 0x6e, 0xda, 0xba, 0x36, 0xed,
-	0x37, 0x84, 0xef, 0xdd, 0x34, 0xf3, 0x35, 0xac, 0x98, 0x57, 0x5e, 0x31, 0x7b, 0xd5, 0x15, 0xab,
-	0xbf, 0x5b, 0x08, 0xbf, 0x7a, 0x92, 0x4c, 0x84, 0xef, 0x50, 0x2e, 0x3e, 0x89, 0x59, 0x12, 0xad,
-	0x61, 0xa3, 0xee, 0xe3, 0x4d, 0x4f, 0x52, 0xa9, 0x55, 0xda, 0x2e, 0x2a, 0x53, 0xfc, 0x24, 0xf5,
-	0x69, 0x9f, 0xe1, 0x8d, 0x88, 0xb9, 0xb9, 0xee, 0x2b, 0xac, 0xdb, 0x80, 0xb9, 0x04, 0xce, 0x20,
-	0x86, 0xd0, 0x01, 0xbb, 0x9d, 0x61, 0x6f, 0x0c, 0x98, 0xcb, 0x89, 0x42, 0x34, 0x7e, 0x40, 0x58,
-	0x2b, 0xf7, 0xbc, 0x06, 0x45, 0xbf, 0x2c, 0x2b, 0x7a, 0x58, 0xbf, 0x9f, 0x72, 0xa9, 0x37, 0xe8,
-	0xf8, 0x3b, 0xc2, 0xda, 0xff, 0xe3, 0x76, 0x30, 0x7e, 0x46, 0xf8, 0x8d, 0xff, 0xe4, 0x50, 0xd2,
-	0xb2, 0x84, 0x1f, 0xd4, 0xef, 0xb1, 0xf6, 0x71, 0xfc, 0xb6, 0x89, 0x77, 0xfa, 0xcc, 0x85, 0x63,
-	0x2a, 0x20, 0x5c, 0x9f, 0x88, 0x4f, 0x10, 0xde, 0x8d, 0x00, 0xe2, 0x45, 0xea, 0xac, 0xd3, 0x0f,
-	0x57, 0x38, 0x7c, 0x15, 0x28, 0xf6, 0xbd, 0x8c, 0x7c, 0xb7, 0xca, 0x4b, 0x2a, 0x99, 0x8d, 0x1f,
-	0x11, 0xde, 0x5d, 0x34, 0xae, 0x41, 0xe3, 0x47, 0x65, 0x8d, 0x57, 0xf8, 0xdc, 0x2c, 0x75, 0x5d,
-	0xad, 0xf0, 0x4f, 0x08, 0x57, 0x8e, 0x41, 0x7b, 0x1b, 0x6f, 0x85, 0xcc, 0x85, 0x3e, 0x0d, 0x40,
-	0xf5, 0xb5, 0x5d, 0xd4, 0xd9, 0xcf, 0xec, 0x64, 0x16, 0xa1, 0x14, 0x13, 0x34, 0xf6, 0x40, 0x1c,
-	0x0d, 0x5e, 0x4c, 0xb1, 0x61, 0x05, 0x4a, 0xa1, 0x58, 0x95, 0x97, 0x54, 0x32, 0x1b, 0x14, 0xb7,
-	0xe7, 0xaf, 0x5e, 0x6d, 0x1f, 0x6f, 0x84, 0x45, 0x33, 0xb3, 0x8b, 0x58, 0x35, 0xa2, 0x3c, 0x9a,
-	0x85, 0xb7, 0xe5, 0x5f, 0x1e, 0x51, 0x07, 0xb2, 0x6f, 0xc1, 0xeb, 0x59, 0xd8, 0x76, 0x3f, 0x77,
-	0x90, 0x22, 0xc6, 0xf8, 0x1e, 0xe1, 0xa5, 0x8f, 0x77, 0x0d, 0x9e, 0xf5, 0xdf, 0x51, 0x7f, 0x35,
-	0x71, 0xe5, 0xe8, 0xa4, 0xca, 0xf9, 0xf0, 0x16, 0x55, 0xce, 0xe3, 0xc9, 0x2c, 0x42, 0x73, 0x71,
-	0x7b, 0x42, 0xb9, 0x38, 0x85, 0xd0, 0x1d, 0xfa, 0x01, 0x64, 0x85, 0xbf, 0x55, 0x6f, 0xdf, 0x65,
-	0x46, 0x51, 0xec, 0xf1, 0x1c, 0x0e, 0x29, 0xa1, 0xe6, 0x2c, 0x04, 0x9c, 0x0b, 0xc5, 0xd2, 0x7a,
-	0x31, 0x96, 0x1c, 0x87, 0x94, 0x50, 0xb5, 0x11, 0xee, 0xca, 0xf7, 0x13, 0xa0, 0x3c, 0x89, 0xc1,
-	0x25, 0xc3, 0x61, 0x9f, 0x86, 0x8c, 0x83, 0xc3, 0x42, 0x97, 0x77, 0x36, 0xf6, 0x51, 0xaf, 0x65,
-	0x1b, 0x19, 0x4e, 0xf7, 0xf8, 0xc6, 0x48, 0xf2, 0x2f, 0x28, 0xc6, 0x77, 0x08, 0x97, 0x54, 0xd1,
-	0xde, 0xc4, 0x77, 0x22, 0xea, 0x8c, 0x41, 0x70, 0x35, 0xed, 0x96, 0xfd, 0x5a, 0xc6, 0x70, 0x67,
-	0x90, 0x9a, 0x49, 0xee, 0x97, 0xff, 0x94, 0x8c, 0x2e, 0x05, 0xa4, 0xdb, 0xd1, 0x2a, 0x4e, 0xaf,
-	0x2d, 0x8d, 0x24, 0xf5, 0x49, 0xf9, 0x38, 0x70, 0xee, 0xb3, 0x90, 0xab, 0x31, 0xb5, 0x0a, 0xf9,
-	0x4e, 0x33, 0x3b, 0x99, 0x45, 0xd8, 0xfd, 0xab, 0x6b, 0xbd, 0xf1, 0xf4, 0x5a, 0x6f, 0x3c, 0xbb,
-	0xd6, 0x1b, 0x8f, 0xa7, 0x3a, 0xba, 0x9a, 0xea, 0xe8, 0xe9, 0x54, 0x47, 0xcf, 0xa6, 0x3a, 0xfa,
-	0x65, 0xaa, 0xa3, 0x27, 0xbf, 0xea, 0x8d, 0xcf, 0x7b, 0x75, 0x7f, 0x81, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x1b, 0x44, 0xff, 0x68, 0xac, 0x0f, 0x00, 0x00,
+	// 1218 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xed, 0x58, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x77, 0x37, 0x7f, 0x76, 0x76, 0xd3, 0x24, 0x26, 0x0d, 0xdb, 0xa8, 0x4a, 0x22, 0xf7,
+	0x12, 0x10, 0x78, 0x69, 0x04, 0x55, 0xd4, 0x22, 0x50, 0x4d, 0x2b, 0xb4, 0x52, 0xb2, 0x5d, 0x4d,
+	0xb6, 0x08, 0xa1, 0x96, 0x32, 0x6b, 0xcf, 0x3a, 0x26, 0xbb, 0xb6, 0x65, 0xcf, 0x06, 0xe5, 0xd6,
+	0x03, 0x12, 0x17, 0x0e, 0xfd, 0x14, 0x7c, 0x0a, 0x3e, 0x40, 0x8e, 0xe5, 0x80, 0x28, 0x97, 0x02,
+	0x45, 0x48, 0x5c, 0x51, 0x39, 0x70, 0x41, 0x62, 0x66, 0x3c, 0x5e, 0xdb, 0xbb, 0xde, 0xc6, 0x4b,
+	0x24, 0x83, 0x04, 0x07, 0x4b, 0xeb, 0x79, 0x7f, 0x7e, 0xef, 0xbd, 0xdf, 0x7b, 0xe3, 0x99, 0x05,
+	0xbb, 0xc8, 0x26, 0x1e, 0x46, 0xaa, 0xe5, 0xd4, 0x83, 0x5f, 0x75, 0xf7, 0xc8, 0xac, 0x23, 0xd7,
+	0xf2, 0xeb, 0x3e, 0x41, 0xc4, 0xaf, 0x1f, 0x5f, 0x45, 0x3d, 0xf7, 0x10, 0x5d, 0xad, 0x9b, 0xd8,
+	0xc6, 0x1e, 0x22, 0xd8, 0x50, 0x5d, 0xcf, 0x21, 0x8e, 0xbc, 0x1d, 0xe8, 0x3f, 0xb0, 0x1c, 0x55,
+	0xf8, 0xa0, 0x96, 0x2a, 0xb3, 0x54, 0xb9, 0xa5, 0x1a, 0x5a, 0xae, 0xbf, 0x6e, 0x5a, 0xe4, 0x70,
+	0xd0, 0x51, 0x75, 0xa7, 0x5f, 0x37, 0x1d, 0xd3, 0xa9, 0x73, 0x07, 0x9d, 0x41, 0x97, 0xbf, 0xf1,
+	0x17, 0xfe, 0x2b, 0x70, 0xbc, 0xfe, 0xe6, 0xd1, 0xae, 0xcf, 0xe3, 0x71, 0xad, 0x3e, 0xd2, 0x0f,
+	0x2d, 0x0a, 0x7b, 0x12, 0x45, 0xd5, 0xc7, 0x04, 0xd1, 0xa0, 0x46, 0xc3, 0x59, 0xaf, 0x4f, 0xb2,
+	0xf2, 0x06, 0x36, 0xb1, 0xfa, 0x78, 0xcc, 0xe0, 0xda, 0x59, 0x06, 0xbe, 0x7e, 0x88, 0xfb, 0x68,
+	0xd4, 0x4e, 0xf9, 0xa3, 0x00, 0x36, 0x6f, 0xf2, 0x84, 0xdf, 0xeb, 0x0d, 0x7c, 0x82, 0xbd, 0x26,
+	0x26, 0x9f, 0x39, 0xde, 0x51, 0xcb, 0xe9, 0x59, 0xfa, 0xc9, 0x01, 0x4b, 0x5d, 0xfe, 0x04, 0x2c,
+	0xb0, 0x38, 0x0d, 0x44, 0x50, 0x4d, 0xda, 0x92, 0xb6, 0x2b, 0x3b, 0x6f, 0xa8, 0x01, 0x9c, 0x1a,
+	0x87, 0x8b, 0x2a, 0xc6, 0xb4, 0x69, 0xc1, 0xd4, 0x3b, 0x9d, 0x4f, 0xb1, 0x4e, 0xf6, 0xe9, 0x9b,
+	0x26, 0x9f, 0x3e, 0xdd, 0x9c, 0x79, 0xf6, 0x74, 0x13, 0x44, 0x6b, 0x70, 0xe8, 0x55, 0x76, 0x41,
+	0x95, 0x78, 0xa8, 0xdb, 0xb5, 0x74, 0x8e, 0x58, 0x2b, 0x70, 0x94, 0x6b, 0x6a, 0x56, 0x52, 0xd4,
+	0x76, 0xcc, 0x5a, 0x5b, 0x15, 0x58, 0xd5, 0xf8, 0x2a, 0x4c, 0x20, 0xc8, 0x0f, 0x25, 0xb0, 0xec,
+	0x0d, 0x7a, 0x38, 0xae, 0x52, 0x2b, 0x6e, 0x15, 0x29, 0xec, 0xf5, 0xec, 0xb0, 0x70, 0xc4, 0x83,
+	0x56, 0x13, 0xd0, 0xcb, 0xa3, 0x12, 0x38, 0x86, 0xa6, 0x3c, 0x97, 0xc0, 0x95, 0x33, 0x4a, 0xbf,
+	0x67, 0xf9, 0x44, 0xbe, 0x37, 0x56, 0x7e, 0x35, 0x5b, 0xf9, 0x99, 0x35, 0x2f, 0xfe, 0xb2, 0x88,
+	0x6a, 0x21, 0x5c, 0x89, 0x95, 0xde, 0x06, 0xb3, 0x16, 0xc1, 0x7d, 0x56, 0x73, 0x96, 0x7c, 0x23,
+	0x7b, 0xf2, 0x67, 0xc4, 0xae, 0x2d, 0x0a, 0xd4, 0xd9, 0x06, 0xf3, 0x0f, 0x03, 0x18, 0xe5, 0xb7,
+	0x02, 0xa8, 0x05, 0x96, 0xff, 0x77, 0x5a, 0x5e, 0x9d, 0xf6, 0x8b, 0x04, 0x2e, 0x4f, 0xaa, 0x79,
+	0x0e, 0x2d, 0x66, 0x26, 0x5b, 0x4c, 0x9b, 0xb6, 0xc5, 0xb2, 0xf7, 0x96, 0x04, 0x2e, 0xec, 0x0f,
+	0x7a, 0xc4, 0xd2, 0x91, 0x4f, 0xde, 0xf7, 0x9c, 0x81, 0x9b, 0x43, 0x47, 0x5d, 0x01, 0xb3, 0x26,
+	0x83, 0xe2, 0xad, 0x54, 0x8e, 0x22, 0xe3, 0xf8, 0x30, 0x90, 0xc9, 0x1f, 0x82, 0x92, 0xeb, 0x18,
+	0x21, 0xef, 0x53, 0xb4, 0x5b, 0xcb, 0x31, 0x20, 0xee, 0x62, 0x0f, 0xdb, 0x3a, 0xd6, 0xaa, 0xc2,
+	0x77, 0x89, 0xae, 0xfa, 0x90, 0x7b, 0x54, 0xbe, 0x91, 0x80, 0x9c, 0xcc, 0x39, 0x07, 0x46, 0xef,
+	0x27, 0x19, 0xdd, 0xcd, 0x9e, 0x4f, 0x32, 0xd4, 0x09, 0x3c, 0x7e, 0x5d, 0x00, 0x17, 0x9b, 0xa8,
+	0x8f, 0x7d, 0x17, 0xe9, 0x89, 0x4e, 0xce, 0x81, 0x4e, 0x04, 0xe6, 0x2d, 0xdb, 0xf4, 0xb0, 0x7f,
+	0xde, 0xbd, 0x61, 0x49, 0xc0, 0xcc, 0x37, 0x02, 0x77, 0x30, 0xf4, 0x2b, 0x7f, 0x0c, 0xe6, 0x70,
+	0x80, 0x50, 0x3c, 0x17, 0xc2, 0x05, 0x81, 0x30, 0x77, 0x3b, 0x00, 0x10, 0x5e, 0x95, 0x1f, 0x24,
+	0x70, 0x29, 0xb5, 0x7c, 0x39, 0x74, 0x86, 0x91, 0xec, 0x8c, 0x77, 0xb3, 0xa7, 0x96, 0x1a, 0xf1,
+	0x84, 0x06, 0xf9, 0x95, 0x36, 0xfd, 0x7f, 0xe3, 0xf3, 0xa1, 0x7c, 0x2f, 0x81, 0xb5, 0x7f, 0x64,
+	0xd7, 0x46, 0x49, 0x26, 0xdf, 0x9e, 0x82, 0xc9, 0xac, 0xfb, 0xf5, 0x9f, 0x8c, 0x46, 0xc7, 0xc0,
+	0x0d, 0x9b, 0x9e, 0x20, 0xba, 0x94, 0xfa, 0xbc, 0x68, 0xfc, 0x5c, 0x02, 0x2b, 0x74, 0xf7, 0x4c,
+	0xe2, 0x8a, 0x44, 0x6f, 0x4c, 0xb5, 0x39, 0x27, 0x5d, 0x68, 0x97, 0x04, 0xec, 0xca, 0x98, 0x08,
+	0x8e, 0x03, 0x06, 0xdc, 0x8e, 0xe5, 0xff, 0xef, 0xe6, 0x76, 0x2c, 0xdc, 0x09, 0xdc, 0x7e, 0x51,
+	0x00, 0xcb, 0x4c, 0x79, 0x8f, 0x5e, 0x36, 0xec, 0xfc, 0x06, 0xf4, 0x91, 0x04, 0x56, 0x5d, 0x4c,
+	0xcf, 0xa3, 0x23, 0xd0, 0x22, 0xd3, 0x77, 0xa6, 0x20, 0x37, 0xc5, 0x8b, 0x76, 0x59, 0x80, 0xaf,
+	0xa6, 0x49, 0x61, 0x2a, 0xb2, 0xf2, 0x2d, 0x0d, 0x69, 0x74, 0x31, 0x07, 0x8e, 0x1f, 0x24, 0x39,
+	0xbe, 0x3e, 0x1d, 0xc7, 0x89, 0xac, 0xd3, 0x19, 0xfe, 0x8e, 0xe6, 0x95, 0x56, 0x06, 0xf9, 0x35,
+	0xb0, 0x60, 0xd3, 0x35, 0xb6, 0xa1, 0xf3, 0xbc, 0xca, 0x51, 0x9c, 0x4d, 0xb1, 0x0e, 0x87, 0x1a,
+	0x9c, 0x31, 0x82, 0x3c, 0x13, 0x93, 0x46, 0xeb, 0x7c, 0x8c, 0xb5, 0x53, 0xbc, 0x44, 0x8c, 0xa5,
+	0x49, 0x61, 0x2a, 0xb2, 0xf2, 0xbc, 0x04, 0xc6, 0x07, 0x58, 0xbe, 0x0b, 0x8a, 0x74, 0x84, 0x05,
+	0x53, 0x7f, 0xf7, 0x08, 0x57, 0x11, 0xe1, 0x14, 0xd9, 0x2a, 0xf3, 0x27, 0xdf, 0x00, 0x8b, 0x56,
+	0x08, 0xc4, 0x4b, 0x16, 0x9c, 0x23, 0x2f, 0x0a, 0xc5, 0xc5, 0x46, 0x5c, 0x08, 0x93, 0xba, 0xf2,
+	0x0e, 0x00, 0xd8, 0x36, 0x5c, 0x87, 0xae, 0x36, 0x6e, 0xf1, 0xe3, 0x44, 0x39, 0x1a, 0x90, 0xdb,
+	0x43, 0x09, 0x8c, 0x69, 0xc9, 0x37, 0xc1, 0x12, 0xfd, 0xcc, 0x1e, 0x61, 0x9a, 0x3e, 0xd6, 0xb1,
+	0x75, 0x8c, 0x8d, 0x5a, 0x89, 0x1a, 0x16, 0xb5, 0x97, 0x85, 0xe1, 0x52, 0x2b, 0x29, 0x86, 0xa3,
+	0xfa, 0xf2, 0x5b, 0xa0, 0x22, 0x96, 0x0e, 0xb0, 0x4d, 0x6a, 0xb3, 0xdc, 0xfc, 0x25, 0x61, 0x5e,
+	0x69, 0x45, 0x22, 0x18, 0xd7, 0x63, 0xa9, 0x76, 0x4e, 0x08, 0x8e, 0x70, 0xe7, 0xb8, 0xe1, 0x30,
+	0x55, 0x2d, 0x2e, 0x84, 0x49, 0x5d, 0xb9, 0x0e, 0xca, 0x7c, 0x81, 0x23, 0xce, 0x73, 0xc3, 0x15,
+	0x61, 0x58, 0xd6, 0x42, 0x01, 0x8c, 0x74, 0xe4, 0x0f, 0xc0, 0x9a, 0xe1, 0x39, 0xae, 0x8b, 0x0d,
+	0x11, 0x50, 0xc3, 0xd6, 0x9d, 0x3e, 0x3d, 0x84, 0xd5, 0x16, 0xb8, 0xf5, 0x86, 0xb0, 0x5e, 0xbb,
+	0x95, 0xaa, 0x05, 0x27, 0x58, 0x8f, 0xfb, 0xbd, 0x33, 0x20, 0xa6, 0xc3, 0xfc, 0x96, 0x5f, 0xe4,
+	0x37, 0xd4, 0x82, 0x13, 0xac, 0x15, 0x04, 0xaa, 0xf1, 0x56, 0x91, 0xb7, 0x40, 0xc9, 0x8e, 0x46,
+	0x68, 0x78, 0xf6, 0xe7, 0x6d, 0xc0, 0x25, 0xac, 0x24, 0x76, 0x78, 0x6a, 0x12, 0x6d, 0x33, 0x2c,
+	0xc9, 0xf0, 0x38, 0x05, 0x23, 0x1d, 0xe5, 0x2b, 0x7a, 0x17, 0x1d, 0xbd, 0x2f, 0x66, 0xc0, 0xc9,
+	0xff, 0xd4, 0xf3, 0x7b, 0x01, 0xa4, 0x0e, 0x2c, 0xdb, 0x5b, 0xc2, 0x91, 0x1d, 0xdd, 0x5b, 0x42,
+	0x7d, 0x38, 0xd4, 0xa0, 0xa7, 0xd1, 0x6a, 0x8f, 0xde, 0x35, 0x68, 0x3b, 0x18, 0x6d, 0x4b, 0x8c,
+	0x56, 0x65, 0xe7, 0xd5, 0x6c, 0xbb, 0x2c, 0xb3, 0x88, 0x82, 0xdd, 0x8b, 0xf9, 0x81, 0x09, 0xaf,
+	0x21, 0x0a, 0xed, 0xd4, 0x63, 0x8e, 0x52, 0x3c, 0x1f, 0x4a, 0xe8, 0x07, 0x26, 0xbc, 0xca, 0x1d,
+	0xb0, 0xce, 0xde, 0xf7, 0x31, 0xf2, 0x07, 0x1e, 0x1d, 0x8f, 0x76, 0xbb, 0x89, 0x6c, 0xc7, 0xc7,
+	0xba, 0x63, 0xd3, 0x8b, 0x65, 0x30, 0xc1, 0x8a, 0xf0, 0xb3, 0xbe, 0x37, 0x51, 0x13, 0xbe, 0xc0,
+	0x8b, 0xf2, 0xa5, 0x04, 0x12, 0xac, 0xc8, 0xaf, 0x80, 0x79, 0x31, 0xc0, 0xbc, 0xda, 0xc5, 0xe8,
+	0x56, 0x13, 0xee, 0x11, 0xa1, 0x9c, 0xdd, 0x83, 0xf9, 0xec, 0xf1, 0x22, 0x17, 0xa3, 0x6f, 0x46,
+	0x30, 0xd4, 0x81, 0x8c, 0xd1, 0xe7, 0xd3, 0x2b, 0x8a, 0xe5, 0xd8, 0xc1, 0xe5, 0xa7, 0x18, 0xd1,
+	0x77, 0x20, 0xd6, 0xe1, 0x50, 0x43, 0x6b, 0x9e, 0xfe, 0xb4, 0x31, 0xf3, 0x98, 0x3e, 0x4f, 0xe8,
+	0xf3, 0xf0, 0xd9, 0x86, 0x74, 0x4a, 0x9f, 0xc7, 0xf4, 0x79, 0x42, 0x9f, 0x1f, 0xe9, 0xf3, 0xe8,
+	0xe7, 0x8d, 0x99, 0x8f, 0xb6, 0xb3, 0xfe, 0xe9, 0xfb, 0x17, 0x62, 0x0c, 0xc6, 0x1a, 0x1f, 0x16,
+	0x00, 0x00,
 }
 
 func (m *AntreaClusterNetworkPolicyStats) Marshal() (dAtA []byte, err error) {
@@ -851,7 +1016,7 @@ func (m *MulticastGroupList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NetworkPolicyStats) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceTrafficStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -861,18 +1026,28 @@ func (m *NetworkPolicyStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceTrafficStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Egress.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Ingress.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -894,7 +1069,7 @@ func (m *NetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
+func (m *NamespaceTrafficStatsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -904,12 +1079,12 @@ func (m *NetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
+func (m *NamespaceTrafficStatsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NamespaceTrafficStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -941,7 +1116,7 @@ func (m *NetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeLatencyStats) Marshal() (dAtA []byte, err error) {
+func (m *NetworkPolicyStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -951,30 +1126,26 @@ func (m *NodeLatencyStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeLatencyStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *NetworkPolicyStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NetworkPolicyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PeerNodeLatencyStats) > 0 {
-		for iNdEx := len(m.PeerNodeLatencyStats) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PeerNodeLatencyStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -988,7 +1159,7 @@ func (m *NodeLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeLatencyStatsList) Marshal() (dAtA []byte, err error) {
+func (m *NetworkPolicyStatsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -998,12 +1169,12 @@ func (m *NodeLatencyStatsList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeLatencyStatsList) MarshalTo(dAtA []byte) (int, error) {
+func (m *NetworkPolicyStatsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeLatencyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NetworkPolicyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1035,7 +1206,7 @@ func (m *NodeLatencyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PeerNodeLatencyStats) Marshal() (dAtA []byte, err error) {
+func (m *NodeInterfaceStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1045,20 +1216,20 @@ func (m *PeerNodeLatencyStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PeerNodeLatencyStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeInterfaceStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PeerNodeLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeInterfaceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TargetIPLatencyStats) > 0 {
-		for iNdEx := len(m.TargetIPLatencyStats) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.PodInterfaceStats) > 0 {
+		for iNdEx := len(m.PodInterfaceStats) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.TargetIPLatencyStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.PodInterfaceStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -1069,15 +1240,20 @@ func (m *PeerNodeLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	i -= len(m.NodeName)
-	copy(dAtA[i:], m.NodeName)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeName)))
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PodReference) Marshal() (dAtA []byte, err error) {
+func (m *NodeInterfaceStatsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1087,30 +1263,44 @@ func (m *PodReference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodReference) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeInterfaceStatsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeInterfaceStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Namespace)
-	copy(dAtA[i:], m.Namespace)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *RuleTrafficStats) Marshal() (dAtA []byte, err error) {
+func (m *NodeLatencyStats) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1120,18 +1310,32 @@ func (m *RuleTrafficStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RuleTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeLatencyStats) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RuleTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PeerNodeLatencyStats) > 0 {
+		for iNdEx := len(m.PeerNodeLatencyStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeerNodeLatencyStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
-		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1139,16 +1343,11 @@ func (m *RuleTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *TargetIPLatencyStats) Marshal() (dAtA []byte, err error) {
+func (m *NodeLatencyStatsList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1158,31 +1357,32 @@ func (m *TargetIPLatencyStats) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TargetIPLatencyStats) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeLatencyStatsList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TargetIPLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeLatencyStatsList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.LastMeasuredRTTNanoseconds))
-	i--
-	dAtA[i] = 0x20
-	{
-		size, err := m.LastRecvTime.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
 	{
-		size, err := m.LastSendTime.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1190,8 +1390,228 @@ func (m *TargetIPLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.TargetIP)
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PeerNodeLatencyStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeerNodeLatencyStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeerNodeLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetIPLatencyStats) > 0 {
+		for iNdEx := len(m.TargetIPLatencyStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TargetIPLatencyStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.NodeName)
+	copy(dAtA[i:], m.NodeName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.NodeName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PodInterfaceStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PodInterfaceStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodInterfaceStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DroppedPacketsOutgoing))
+	i--
+	dAtA[i] = 0x48
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DroppedPacketsIncoming))
+	i--
+	dAtA[i] = 0x40
+	i = encodeVarintGenerated(dAtA, i, uint64(m.BytesSent))
+	i--
+	dAtA[i] = 0x38
+	i = encodeVarintGenerated(dAtA, i, uint64(m.BytesReceived))
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PacketsSent))
+	i--
+	dAtA[i] = 0x28
+	i = encodeVarintGenerated(dAtA, i, uint64(m.PacketsReceived))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.EndpointID)
+	copy(dAtA[i:], m.EndpointID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.EndpointID)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.InterfaceName)
+	copy(dAtA[i:], m.InterfaceName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.InterfaceName)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Pod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PodReference) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PodReference) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RuleTrafficStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RuleTrafficStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RuleTrafficStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TrafficStats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TargetIPLatencyStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TargetIPLatencyStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TargetIPLatencyStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.LastMeasuredRTTNanoseconds))
+	i--
+	dAtA[i] = 0x20
+	{
+		size, err := m.LastRecvTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LastSendTime.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.TargetIP)
 	copy(dAtA[i:], m.TargetIP)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TargetIP)))
 	i--
@@ -1350,7 +1770,7 @@ func (m *MulticastGroupList) Size() (n int) {
 	return n
 }
 
-func (m *NetworkPolicyStats) Size() (n int) {
+func (m *NamespaceTrafficStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1358,12 +1778,14 @@ func (m *NetworkPolicyStats) Size() (n int) {
 	_ = l
 	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.TrafficStats.Size()
+	l = m.Ingress.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Egress.Size()
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *NetworkPolicyStatsList) Size() (n int) {
+func (m *NamespaceTrafficStatsList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1380,7 +1802,7 @@ func (m *NetworkPolicyStatsList) Size() (n int) {
 	return n
 }
 
-func (m *NodeLatencyStats) Size() (n int) {
+func (m *NetworkPolicyStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1388,16 +1810,12 @@ func (m *NodeLatencyStats) Size() (n int) {
 	_ = l
 	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.PeerNodeLatencyStats) > 0 {
-		for _, e := range m.PeerNodeLatencyStats {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *NodeLatencyStatsList) Size() (n int) {
+func (m *NetworkPolicyStatsList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1414,16 +1832,16 @@ func (m *NodeLatencyStatsList) Size() (n int) {
 	return n
 }
 
-func (m *PeerNodeLatencyStats) Size() (n int) {
+func (m *NodeInterfaceStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.NodeName)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.TargetIPLatencyStats) > 0 {
-		for _, e := range m.TargetIPLatencyStats {
+	if len(m.PodInterfaceStats) > 0 {
+		for _, e := range m.PodInterfaceStats {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -1431,47 +1849,136 @@ func (m *PeerNodeLatencyStats) Size() (n int) {
 	return n
 }
 
-func (m *PodReference) Size() (n int) {
+func (m *NodeInterfaceStatsList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Namespace)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *RuleTrafficStats) Size() (n int) {
+func (m *NodeLatencyStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.TrafficStats.Size()
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.PeerNodeLatencyStats) > 0 {
+		for _, e := range m.PeerNodeLatencyStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *TargetIPLatencyStats) Size() (n int) {
+func (m *NodeLatencyStatsList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.TargetIP)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastSendTime.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.LastRecvTime.Size()
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.LastMeasuredRTTNanoseconds))
-	return n
-}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PeerNodeLatencyStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.TargetIPLatencyStats) > 0 {
+		for _, e := range m.TargetIPLatencyStats {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PodInterfaceStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pod.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.InterfaceName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.EndpointID)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.PacketsReceived))
+	n += 1 + sovGenerated(uint64(m.PacketsSent))
+	n += 1 + sovGenerated(uint64(m.BytesReceived))
+	n += 1 + sovGenerated(uint64(m.BytesSent))
+	n += 1 + sovGenerated(uint64(m.DroppedPacketsIncoming))
+	n += 1 + sovGenerated(uint64(m.DroppedPacketsOutgoing))
+	return n
+}
+
+func (m *PodReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RuleTrafficStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.TrafficStats.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *TargetIPLatencyStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetIP)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastSendTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.LastRecvTime.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.LastMeasuredRTTNanoseconds))
+	return n
+}
 
 func (m *TrafficStats) Size() (n int) {
 	if m == nil {
@@ -1590,6 +2097,34 @@ func (this *MulticastGroupList) String() string {
 	}, "")
 	return s
 }
+func (this *NamespaceTrafficStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespaceTrafficStats{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Ingress:` + strings.Replace(strings.Replace(this.Ingress.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`Egress:` + strings.Replace(strings.Replace(this.Egress.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NamespaceTrafficStatsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]NamespaceTrafficStats{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "NamespaceTrafficStats", "NamespaceTrafficStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&NamespaceTrafficStatsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NetworkPolicyStats) String() string {
 	if this == nil {
 		return "nil"
@@ -1617,6 +2152,38 @@ func (this *NetworkPolicyStatsList) String() string {
 	}, "")
 	return s
 }
+func (this *NodeInterfaceStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPodInterfaceStats := "[]PodInterfaceStats{"
+	for _, f := range this.PodInterfaceStats {
+		repeatedStringForPodInterfaceStats += strings.Replace(strings.Replace(f.String(), "PodInterfaceStats", "PodInterfaceStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPodInterfaceStats += "}"
+	s := strings.Join([]string{`&NodeInterfaceStats{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`PodInterfaceStats:` + repeatedStringForPodInterfaceStats + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *NodeInterfaceStatsList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]NodeInterfaceStats{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "NodeInterfaceStats", "NodeInterfaceStats", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&NodeInterfaceStatsList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NodeLatencyStats) String() string {
 	if this == nil {
 		return "nil"
@@ -1665,6 +2232,24 @@ func (this *PeerNodeLatencyStats) String() string {
 	}, "")
 	return s
 }
+func (this *PodInterfaceStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PodInterfaceStats{`,
+		`Pod:` + strings.Replace(strings.Replace(this.Pod.String(), "PodReference", "PodReference", 1), `&`, ``, 1) + `,`,
+		`InterfaceName:` + fmt.Sprintf("%v", this.InterfaceName) + `,`,
+		`EndpointID:` + fmt.Sprintf("%v", this.EndpointID) + `,`,
+		`PacketsReceived:` + fmt.Sprintf("%v", this.PacketsReceived) + `,`,
+		`PacketsSent:` + fmt.Sprintf("%v", this.PacketsSent) + `,`,
+		`BytesReceived:` + fmt.Sprintf("%v", this.BytesReceived) + `,`,
+		`BytesSent:` + fmt.Sprintf("%v", this.BytesSent) + `,`,
+		`DroppedPacketsIncoming:` + fmt.Sprintf("%v", this.DroppedPacketsIncoming) + `,`,
+		`DroppedPacketsOutgoing:` + fmt.Sprintf("%v", this.DroppedPacketsOutgoing) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PodReference) String() string {
 	if this == nil {
 		return "nil"
@@ -1680,47 +2265,847 @@ func (this *RuleTrafficStats) String() string {
 	if this == nil {
 		return "nil"
 	}
-	s := strings.Join([]string{`&RuleTrafficStats{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *TargetIPLatencyStats) String() string {
-	if this == nil {
-		return "nil"
+	s := strings.Join([]string{`&RuleTrafficStats{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`TrafficStats:` + strings.Replace(strings.Replace(this.TrafficStats.String(), "TrafficStats", "TrafficStats", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TargetIPLatencyStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TargetIPLatencyStats{`,
+		`TargetIP:` + fmt.Sprintf("%v", this.TargetIP) + `,`,
+		`LastSendTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastSendTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`LastRecvTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastRecvTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`LastMeasuredRTTNanoseconds:` + fmt.Sprintf("%v", this.LastMeasuredRTTNanoseconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TrafficStats) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TrafficStats{`,
+		`Packets:` + fmt.Sprintf("%v", this.Packets) + `,`,
+		`Bytes:` + fmt.Sprintf("%v", this.Bytes) + `,`,
+		`Sessions:` + fmt.Sprintf("%v", this.Sessions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleTrafficStats = append(m.RuleTrafficStats, RuleTrafficStats{})
+			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AntreaClusterNetworkPolicyStats{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntreaNetworkPolicyStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntreaNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuleTrafficStats = append(m.RuleTrafficStats, RuleTrafficStats{})
+			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, AntreaNetworkPolicyStats{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MulticastGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MulticastGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MulticastGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pods = append(m.Pods, PodReference{})
+			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	s := strings.Join([]string{`&TargetIPLatencyStats{`,
-		`TargetIP:` + fmt.Sprintf("%v", this.TargetIP) + `,`,
-		`LastSendTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastSendTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`LastRecvTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.LastRecvTime), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`LastMeasuredRTTNanoseconds:` + fmt.Sprintf("%v", this.LastMeasuredRTTNanoseconds) + `,`,
-		`}`,
-	}, "")
-	return s
+	return nil
 }
-func (this *TrafficStats) String() string {
-	if this == nil {
-		return "nil"
+func (m *MulticastGroupList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MulticastGroupList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MulticastGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, MulticastGroup{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&TrafficStats{`,
-		`Packets:` + fmt.Sprintf("%v", this.Packets) + `,`,
-		`Bytes:` + fmt.Sprintf("%v", this.Bytes) + `,`,
-		`Sessions:` + fmt.Sprintf("%v", this.Sessions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+func (m *NamespaceTrafficStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1743,10 +3128,10 @@ func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceTrafficStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceTrafficStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1784,7 +3169,7 @@ func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1811,13 +3196,13 @@ func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Ingress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Egress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1844,8 +3229,7 @@ func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RuleTrafficStats = append(m.RuleTrafficStats, RuleTrafficStats{})
-			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Egress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1870,7 +3254,7 @@ func (m *AntreaClusterNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+func (m *NamespaceTrafficStatsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1893,10 +3277,10 @@ func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespaceTrafficStatsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaClusterNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespaceTrafficStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1961,7 +3345,7 @@ func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AntreaClusterNetworkPolicyStats{})
+			m.Items = append(m.Items, NamespaceTrafficStats{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1987,7 +3371,7 @@ func (m *AntreaClusterNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
+func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2010,10 +3394,10 @@ func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkPolicyStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2082,40 +3466,6 @@ func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RuleTrafficStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RuleTrafficStats = append(m.RuleTrafficStats, RuleTrafficStats{})
-			if err := m.RuleTrafficStats[len(m.RuleTrafficStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -2137,7 +3487,7 @@ func (m *AntreaNetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+func (m *NetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2160,10 +3510,10 @@ func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: NetworkPolicyStatsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AntreaNetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2228,7 +3578,7 @@ func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, AntreaNetworkPolicyStats{})
+			m.Items = append(m.Items, NetworkPolicyStats{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2254,7 +3604,7 @@ func (m *AntreaNetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MulticastGroup) Unmarshal(dAtA []byte) error {
+func (m *NodeInterfaceStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2277,10 +3627,10 @@ func (m *MulticastGroup) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MulticastGroup: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeInterfaceStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MulticastGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeInterfaceStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2318,39 +3668,7 @@ func (m *MulticastGroup) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Group = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pods", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodInterfaceStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2377,8 +3695,8 @@ func (m *MulticastGroup) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pods = append(m.Pods, PodReference{})
-			if err := m.Pods[len(m.Pods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PodInterfaceStats = append(m.PodInterfaceStats, PodInterfaceStats{})
+			if err := m.PodInterfaceStats[len(m.PodInterfaceStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2403,7 +3721,7 @@ func (m *MulticastGroup) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MulticastGroupList) Unmarshal(dAtA []byte) error {
+func (m *NodeInterfaceStatsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2426,10 +3744,10 @@ func (m *MulticastGroupList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MulticastGroupList: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeInterfaceStatsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MulticastGroupList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeInterfaceStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2494,7 +3812,7 @@ func (m *MulticastGroupList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, MulticastGroup{})
+			m.Items = append(m.Items, NodeInterfaceStats{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2520,7 +3838,7 @@ func (m *MulticastGroupList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
+func (m *NodeLatencyStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2543,10 +3861,10 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeLatencyStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeLatencyStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2584,7 +3902,7 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerNodeLatencyStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2611,7 +3929,8 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TrafficStats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PeerNodeLatencyStats = append(m.PeerNodeLatencyStats, PeerNodeLatencyStats{})
+			if err := m.PeerNodeLatencyStats[len(m.PeerNodeLatencyStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2636,7 +3955,7 @@ func (m *NetworkPolicyStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
+func (m *NodeLatencyStatsList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2659,10 +3978,10 @@ func (m *NetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NetworkPolicyStatsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeLatencyStatsList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NetworkPolicyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeLatencyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2727,7 +4046,7 @@ func (m *NetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, NetworkPolicyStats{})
+			m.Items = append(m.Items, NodeLatencyStats{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2753,7 +4072,7 @@ func (m *NetworkPolicyStatsList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeLatencyStats) Unmarshal(dAtA []byte) error {
+func (m *PeerNodeLatencyStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2776,17 +4095,17 @@ func (m *NodeLatencyStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeLatencyStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: PeerNodeLatencyStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeLatencyStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PeerNodeLatencyStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2796,28 +4115,27 @@ func (m *NodeLatencyStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerNodeLatencyStats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetIPLatencyStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2844,8 +4162,8 @@ func (m *NodeLatencyStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PeerNodeLatencyStats = append(m.PeerNodeLatencyStats, PeerNodeLatencyStats{})
-			if err := m.PeerNodeLatencyStats[len(m.PeerNodeLatencyStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TargetIPLatencyStats = append(m.TargetIPLatencyStats, TargetIPLatencyStats{})
+			if err := m.TargetIPLatencyStats[len(m.TargetIPLatencyStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2870,7 +4188,7 @@ func (m *NodeLatencyStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeLatencyStatsList) Unmarshal(dAtA []byte) error {
+func (m *PodInterfaceStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2893,15 +4211,15 @@ func (m *NodeLatencyStatsList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeLatencyStatsList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PodInterfaceStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeLatencyStatsList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PodInterfaceStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2928,15 +4246,15 @@ func (m *NodeLatencyStatsList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterfaceName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -2946,79 +4264,27 @@ func (m *NodeLatencyStatsList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, NodeLatencyStats{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InterfaceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PeerNodeLatencyStats) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PeerNodeLatencyStats: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PeerNodeLatencyStats: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndpointID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3046,13 +4312,13 @@ func (m *PeerNodeLatencyStats) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.EndpointID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetIPLatencyStats", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsReceived", wireType)
 			}
-			var msglen int
+			m.PacketsReceived = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3062,26 +4328,106 @@ func (m *PeerNodeLatencyStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PacketsReceived |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketsSent", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.PacketsSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketsSent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesReceived", wireType)
 			}
-			m.TargetIPLatencyStats = append(m.TargetIPLatencyStats, TargetIPLatencyStats{})
-			if err := m.TargetIPLatencyStats[len(m.TargetIPLatencyStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BytesReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesReceived |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesSent", wireType)
+			}
+			m.BytesSent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesSent |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedPacketsIncoming", wireType)
+			}
+			m.DroppedPacketsIncoming = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedPacketsIncoming |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedPacketsOutgoing", wireType)
+			}
+			m.DroppedPacketsOutgoing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedPacketsOutgoing |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
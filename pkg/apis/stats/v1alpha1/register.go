@@ -51,6 +51,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&MulticastGroupList{},
 		&NodeLatencyStats{},
 		&NodeLatencyStatsList{},
+		&NodeInterfaceStats{},
+		&NodeInterfaceStatsList{},
+		&NamespaceTrafficStats{},
+		&NamespaceTrafficStatsList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -192,3 +192,84 @@ type NodeLatencyStatsList struct {
 	// The list of NodeLatencyStats.
 	Items []NodeLatencyStats `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +resourceName=nodeinterfacestats
+// +genclient:onlyVerbs=create,delete,get,list
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeInterfaceStats contains the traffic counters of the Pod interfaces collected by the Agent on a specific Node.
+// It is currently only reported by Windows Nodes, for which the counters are read from the HNS endpoints of the Pods.
+type NodeInterfaceStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// The list of PodInterfaceStats.
+	PodInterfaceStats []PodInterfaceStats `json:"podInterfaceStats,omitempty" protobuf:"bytes,2,rep,name=podInterfaceStats"`
+}
+
+// PodInterfaceStats contains the traffic counters of a Pod interface. The counters are cumulative since the creation
+// of the interface.
+type PodInterfaceStats struct {
+	// The Pod which the interface belongs to.
+	Pod PodReference `json:"pod,omitempty" protobuf:"bytes,1,opt,name=pod"`
+	// The name of the interface.
+	InterfaceName string `json:"interfaceName,omitempty" protobuf:"bytes,2,opt,name=interfaceName"`
+	// The ID of the HNS endpoint backing the interface.
+	EndpointID string `json:"endpointID,omitempty" protobuf:"bytes,3,opt,name=endpointID"`
+	// The number of packets received by the Pod.
+	PacketsReceived int64 `json:"packetsReceived,omitempty" protobuf:"varint,4,opt,name=packetsReceived"`
+	// The number of packets sent by the Pod.
+	PacketsSent int64 `json:"packetsSent,omitempty" protobuf:"varint,5,opt,name=packetsSent"`
+	// The number of bytes received by the Pod.
+	BytesReceived int64 `json:"bytesReceived,omitempty" protobuf:"varint,6,opt,name=bytesReceived"`
+	// The number of bytes sent by the Pod.
+	BytesSent int64 `json:"bytesSent,omitempty" protobuf:"varint,7,opt,name=bytesSent"`
+	// The number of incoming packets dropped by the HNS endpoint, e.g. by HNS ACL policies.
+	DroppedPacketsIncoming int64 `json:"droppedPacketsIncoming,omitempty" protobuf:"varint,8,opt,name=droppedPacketsIncoming"`
+	// The number of outgoing packets dropped by the HNS endpoint, e.g. by HNS ACL policies.
+	DroppedPacketsOutgoing int64 `json:"droppedPacketsOutgoing,omitempty" protobuf:"varint,9,opt,name=droppedPacketsOutgoing"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NodeInterfaceStatsList is a list of NodeInterfaceStats objects.
+type NodeInterfaceStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// The list of NodeInterfaceStats.
+	Items []NodeInterfaceStats `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +resourceName=namespacetrafficstats
+// +genclient:readonly
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTrafficStats is the traffic summary of the Pods of a Namespace, aggregated from the stats reported by all
+// the Agents. The name of the object is the name of the Namespace.
+type NamespaceTrafficStats struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// The traffic stats of the connections initiated towards the Pods of the Namespace. Sessions is the number of
+	// connections, and Packets and Bytes include both directions of the connections.
+	Ingress TrafficStats `json:"ingress,omitempty" protobuf:"bytes,2,opt,name=ingress"`
+	// The traffic stats of the connections initiated by the Pods of the Namespace. Sessions is the number of
+	// connections, and Packets and Bytes include both directions of the connections.
+	Egress TrafficStats `json:"egress,omitempty" protobuf:"bytes,3,opt,name=egress"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceTrafficStatsList is a list of NamespaceTrafficStats objects.
+type NamespaceTrafficStatsList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// The list of NamespaceTrafficStats.
+	Items []NamespaceTrafficStats `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -39,6 +39,7 @@ import (
 	systeminstall "antrea.io/antrea/pkg/apis/system/install"
 	system "antrea.io/antrea/pkg/apis/system/v1beta1"
 	"antrea.io/antrea/pkg/apiserver/certificate"
+	"antrea.io/antrea/pkg/apiserver/handlers/effectiveconfig"
 	"antrea.io/antrea/pkg/apiserver/handlers/endpoint"
 	"antrea.io/antrea/pkg/apiserver/handlers/featuregates"
 	"antrea.io/antrea/pkg/apiserver/handlers/loglevel"
@@ -57,14 +58,18 @@ import (
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreaclusternetworkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/antreanetworkpolicystats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/multicastgroup"
+	"antrea.io/antrea/pkg/apiserver/registry/stats/namespacetrafficstats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/networkpolicystats"
+	"antrea.io/antrea/pkg/apiserver/registry/stats/nodeinterfacestats"
 	"antrea.io/antrea/pkg/apiserver/registry/stats/nodelatencystats"
 	"antrea.io/antrea/pkg/apiserver/registry/system/controllerinfo"
 	"antrea.io/antrea/pkg/apiserver/registry/system/supportbundle"
 	"antrea.io/antrea/pkg/apiserver/storage"
 	crdv1a2informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha2"
+	controllerconfig "antrea.io/antrea/pkg/config/controller"
 	"antrea.io/antrea/pkg/controller/egress"
 	"antrea.io/antrea/pkg/controller/externalippool"
+	"antrea.io/antrea/pkg/controller/externalnode"
 	"antrea.io/antrea/pkg/controller/ipam"
 	controllernetworkpolicy "antrea.io/antrea/pkg/controller/networkpolicy"
 	"antrea.io/antrea/pkg/controller/querier"
@@ -122,6 +127,8 @@ type ExtraConfig struct {
 	networkPolicyStatusController *controllernetworkpolicy.StatusController
 	bundleCollectionController    *controllerbundlecollection.Controller
 	traceflowController           *traceflow.Controller
+	externalNodeController        *externalnode.ExternalNodeController
+	effectiveConfig               *controllerconfig.ControllerConfig
 }
 
 // Config defines the config for Antrea apiserver.
@@ -166,7 +173,9 @@ func NewConfig(
 	egressController *egress.EgressController,
 	externalIPPoolController *externalippool.ExternalIPPoolController,
 	bundleCollectionController *controllerbundlecollection.Controller,
-	traceflowController *traceflow.Controller) *Config {
+	traceflowController *traceflow.Controller,
+	externalNodeController *externalnode.ExternalNodeController,
+	effectiveConfig *controllerconfig.ControllerConfig) *Config {
 	return &Config{
 		genericConfig: genericConfig,
 		extraConfig: ExtraConfig{
@@ -188,6 +197,8 @@ func NewConfig(
 			externalIPPoolController:      externalIPPoolController,
 			bundleCollectionController:    bundleCollectionController,
 			traceflowController:           traceflowController,
+			externalNodeController:        externalNodeController,
+			effectiveConfig:               effectiveConfig,
 		},
 	}
 }
@@ -241,7 +252,9 @@ func installAPIGroup(s *APIServer, c completedConfig) error {
 	statsStorage["antreaclusternetworkpolicystats"] = antreaclusternetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["antreanetworkpolicystats"] = antreanetworkpolicystats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["multicastgroups"] = multicastgroup.NewREST(c.extraConfig.statsAggregator)
+	statsStorage["namespacetrafficstats"] = namespacetrafficstats.NewREST(c.extraConfig.statsAggregator)
 	statsStorage["nodelatencystats"] = nodelatencystats.NewREST()
+	statsStorage["nodeinterfacestats"] = nodeinterfacestats.NewREST()
 	statsGroup.VersionedResourcesStorageMap["v1alpha1"] = statsStorage
 
 	groups := []*genericapiserver.APIGroupInfo{&cpGroup, &systemGroup, &statsGroup}
@@ -296,6 +309,7 @@ func CleanupDeprecatedAPIServices(aggregatorClient clientset.Interface) error {
 func installHandlers(c *ExtraConfig, s *genericapiserver.GenericAPIServer) {
 	s.Handler.NonGoRestfulMux.HandleFunc("/loglevel", loglevel.HandleFunc())
 	s.Handler.NonGoRestfulMux.HandleFunc("/featuregates", featuregates.HandleFunc(c.k8sClient))
+	s.Handler.NonGoRestfulMux.HandleFunc("/effectiveconfig", effectiveconfig.HandleFunc(c.effectiveConfig))
 	s.Handler.NonGoRestfulMux.HandleFunc("/endpoint", endpoint.HandleFunc(c.endpointQuerier))
 	// Webhook to mutate Namespace labels and add its metadata.name as a label
 	s.Handler.NonGoRestfulMux.HandleFunc("/mutate/namespace", webhook.HandleMutationLabels())
@@ -337,6 +351,8 @@ func installHandlers(c *ExtraConfig, s *genericapiserver.GenericAPIServer) {
 
 	if features.DefaultFeatureGate.Enabled(features.Egress) {
 		s.Handler.NonGoRestfulMux.HandleFunc("/validate/egress", webhook.HandlerForValidateFunc(c.egressController.ValidateEgress))
+		s.Handler.NonGoRestfulMux.HandleFunc("/validate/pod", webhook.HandlerForValidateFunc(c.egressController.ValidatePod))
+		s.Handler.NonGoRestfulMux.HandleFunc("/validate/namespace", webhook.HandlerForValidateFunc(c.egressController.ValidateNamespace))
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.AntreaIPAM) || features.DefaultFeatureGate.Enabled(features.SecondaryNetwork) {
@@ -351,6 +367,10 @@ func installHandlers(c *ExtraConfig, s *genericapiserver.GenericAPIServer) {
 	if features.DefaultFeatureGate.Enabled(features.Traceflow) {
 		s.Handler.NonGoRestfulMux.HandleFunc("/validate/traceflow", webhook.HandlerForValidateFunc(c.traceflowController.Validate))
 	}
+
+	if features.DefaultFeatureGate.Enabled(features.ExternalNode) {
+		s.Handler.NonGoRestfulMux.HandleFunc("/validate/externalnode", webhook.HandlerForValidateFunc(c.externalNodeController.Validate))
+	}
 }
 
 func DefaultCAConfig() *certificate.CAConfig {
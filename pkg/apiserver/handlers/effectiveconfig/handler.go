@@ -0,0 +1,43 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package effectiveconfig
+
+import (
+	"net/http"
+	"reflect"
+
+	"gopkg.in/yaml.v2"
+	"k8s.io/klog/v2"
+)
+
+// HandleFunc returns the function which can handle queries issued by the 'antctl get effective-config' command. The
+// handler function renders the effective configuration of the component, i.e. the configuration loaded from its
+// configuration file with the defaults applied, in the YAML format of the configuration file.
+func HandleFunc(config interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if config == nil || reflect.ValueOf(config).IsNil() {
+			http.Error(w, "effective configuration is not available", http.StatusServiceUnavailable)
+			return
+		}
+		data, err := yaml.Marshal(config)
+		if err != nil {
+			http.Error(w, "Failed to encode effective configuration: "+err.Error(), http.StatusInternalServerError)
+			klog.ErrorS(err, "Failed to encode effective configuration")
+			return
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write(data)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package effectiveconfig
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	controllerconfig "antrea.io/antrea/pkg/config/controller"
+)
+
+func TestHandleFunc(t *testing.T) {
+	selfSignedCert := true
+	tests := []struct {
+		name           string
+		config         *controllerconfig.ControllerConfig
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "effective configuration",
+			config: &controllerconfig.ControllerConfig{
+				FeatureGates:   map[string]bool{"Traceflow": true, "Multicast": false},
+				APIPort:        10349,
+				SelfSignedCert: &selfSignedCert,
+				NodeIPAM: controllerconfig.NodeIPAMConfig{
+					EnableNodeIPAM: true,
+					ClusterCIDRs:   []string{"10.10.0.0/16"},
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: `featureGates:
+  Multicast: false
+  Traceflow: true
+clientConnection:
+  kubeconfig: ""
+  acceptcontenttypes: ""
+  contenttype: ""
+  qps: 0
+  burst: 0
+apiPort: 10349
+selfSignedCert: true
+nodeIPAM:
+  enableNodeIPAM: true
+  clusterCIDRs:
+  - 10.10.0.0/16
+ipsecCSRSigner: {}
+`,
+		},
+		{
+			name:           "effective configuration not available",
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   "effective configuration is not available\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := HandleFunc(tt.config)
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			require.NoError(t, err)
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tt.expectedStatus, recorder.Code)
+			assert.Equal(t, tt.expectedBody, recorder.Body.String())
+		})
+	}
+}
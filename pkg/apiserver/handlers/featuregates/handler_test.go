@@ -65,12 +65,14 @@ func Test_getGatesResponse(t *testing.T) {
 				{Component: "agent", Name: "EndpointSlice", Status: "Enabled", Version: "GA"},
 				{Component: "agent", Name: "ExternalNode", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent", Name: "FlowExporter", Status: "Disabled", Version: "ALPHA"},
+				{Component: "agent", Name: "HostPort", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent", Name: "IPsecCertAuth", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent", Name: "L7FlowExporter", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent", Name: "L7NetworkPolicy", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent", Name: "LoadBalancerModeDSR", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent", Name: "Multicast", Status: multicastStatus, Version: "BETA"},
 				{Component: "agent", Name: "Multicluster", Status: "Disabled", Version: "ALPHA"},
+				{Component: "agent", Name: "NamespaceTrafficStats", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent", Name: "NetworkPolicyStats", Status: "Enabled", Version: "BETA"},
 				{Component: "agent", Name: "NodeLatencyMonitor", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent", Name: "NodeNetworkPolicy", Status: "Disabled", Version: "ALPHA"},
@@ -110,9 +112,11 @@ func Test_getGatesWindowsResponse(t *testing.T) {
 			want: []apis.FeatureGateResponse{
 				{Component: "agent-windows", Name: "AntreaPolicy", Status: "Disabled", Version: "BETA"},
 				{Component: "agent-windows", Name: "AntreaProxy", Status: "Enabled", Version: "GA"},
+				{Component: "agent-windows", Name: "Egress", Status: "Enabled", Version: "BETA"},
 				{Component: "agent-windows", Name: "EndpointSlice", Status: "Enabled", Version: "GA"},
 				{Component: "agent-windows", Name: "ExternalNode", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent-windows", Name: "FlowExporter", Status: "Disabled", Version: "ALPHA"},
+				{Component: "agent-windows", Name: "IPsecCertAuth", Status: "Disabled", Version: "ALPHA"},
 				{Component: "agent-windows", Name: "NetworkPolicyStats", Status: "Enabled", Version: "BETA"},
 				{Component: "agent-windows", Name: "NodePortLocal", Status: "Enabled", Version: "GA"},
 				{Component: "agent-windows", Name: "ServiceTrafficDistribution", Status: "Enabled", Version: "BETA"},
@@ -207,6 +211,8 @@ func Test_getControllerGatesResponse(t *testing.T) {
 				{Component: "controller", Name: "L7NetworkPolicy", Status: "Disabled", Version: "ALPHA"},
 				{Component: "controller", Name: "Multicast", Status: multicastStatus, Version: "BETA"},
 				{Component: "controller", Name: "Multicluster", Status: "Disabled", Version: "ALPHA"},
+				{Component: "controller", Name: "NamespaceSecurityPolicy", Status: "Disabled", Version: "ALPHA"},
+				{Component: "controller", Name: "NamespaceTrafficStats", Status: "Disabled", Version: "ALPHA"},
 				{Component: "controller", Name: "NetworkPolicyStats", Status: "Enabled", Version: "BETA"},
 				{Component: "controller", Name: "NodeIPAM", Status: "Enabled", Version: "BETA"},
 				{Component: "controller", Name: "ServiceExternalIP", Status: serviceExternalIPStatus, Version: "BETA"},
@@ -230,7 +236,6 @@ func init() {
 	cleanupStaleUDPSvcConntrackStatus = "Enabled"
 	serviceExternalIPStatus = "Enabled"
 	if runtime.IsWindowsPlatform() {
-		egressStatus = "Disabled"
 		egressSeparateSubnetStatus = "Disabled"
 		multicastStatus = "Disabled"
 		cleanupStaleUDPSvcConntrackStatus = "Disabled"
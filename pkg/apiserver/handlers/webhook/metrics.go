@@ -0,0 +1,46 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"strconv"
+	"time"
+
+	admv1 "k8s.io/api/admission/v1"
+
+	"antrea.io/antrea/pkg/controller/metrics"
+)
+
+const (
+	webhookValidation = "validation"
+	webhookMutation   = "mutation"
+)
+
+// trackAdmission counts the admission request as in flight for the webhook, and returns a function which must be
+// called once the request has been processed, to record its duration.
+func trackAdmission(webhook string) func(ar *admv1.AdmissionReview, resp *admv1.AdmissionResponse) {
+	start := time.Now()
+	metrics.AdmissionWebhookRequestsInFlight.WithLabelValues(webhook).Inc()
+	return func(ar *admv1.AdmissionReview, resp *admv1.AdmissionResponse) {
+		metrics.AdmissionWebhookRequestsInFlight.WithLabelValues(webhook).Dec()
+		var kind, operation string
+		if ar.Request != nil {
+			kind = ar.Request.Kind.Kind
+			operation = string(ar.Request.Operation)
+		}
+		allowed := resp != nil && resp.Allowed
+		metrics.AdmissionWebhookDuration.WithLabelValues(webhook, kind, operation, strconv.FormatBool(allowed)).Observe(time.Since(start).Seconds())
+	}
+}
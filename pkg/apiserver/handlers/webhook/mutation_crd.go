@@ -45,6 +45,7 @@ func HandleMutationNetworkPolicy(m *networkpolicy.NetworkPolicyMutator) http.Han
 			http.Error(w, "invalid Content-Type, expected `application/json`", http.StatusUnsupportedMediaType)
 			return
 		}
+		observeAdmission := trackAdmission(webhookMutation)
 		var admissionResponse *admv1.AdmissionResponse
 		ar := admv1.AdmissionReview{}
 		ar.TypeMeta.Kind = "AdmissionReview"
@@ -55,6 +56,7 @@ func HandleMutationNetworkPolicy(m *networkpolicy.NetworkPolicyMutator) http.Han
 		} else {
 			admissionResponse = m.Mutate(&ar)
 		}
+		observeAdmission(&ar, admissionResponse)
 		aReview := admv1.AdmissionReview{}
 		aReview.TypeMeta.Kind = "AdmissionReview"
 		aReview.TypeMeta.APIVersion = "admission.k8s.io/v1"
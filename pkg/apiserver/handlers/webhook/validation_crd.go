@@ -46,6 +46,7 @@ func HandlerForValidateFunc(fn validateFunc) http.HandlerFunc {
 			http.Error(w, "invalid Content-Type, expected `application/json`", http.StatusUnsupportedMediaType)
 			return
 		}
+		observeAdmission := trackAdmission(webhookValidation)
 		var admissionResponse *admv1.AdmissionResponse
 		ar := admv1.AdmissionReview{}
 		ar.TypeMeta.Kind = "AdmissionReview"
@@ -60,6 +61,7 @@ func HandlerForValidateFunc(fn validateFunc) http.HandlerFunc {
 		} else {
 			admissionResponse = fn(&ar)
 		}
+		observeAdmission(&ar, admissionResponse)
 		aReview := admv1.AdmissionReview{}
 		aReview.TypeMeta.Kind = "AdmissionReview"
 		aReview.TypeMeta.APIVersion = "admission.k8s.io/v1"
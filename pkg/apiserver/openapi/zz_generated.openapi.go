@@ -52,9 +52,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPBlock":                           schema_pkg_apis_controlplane_v1beta2_IPBlock(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPGroupAssociation":                schema_pkg_apis_controlplane_v1beta2_IPGroupAssociation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.IPNet":                             schema_pkg_apis_controlplane_v1beta2_IPNet(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol":                     schema_pkg_apis_controlplane_v1beta2_KafkaProtocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.L7Protocol":                        schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo":                schema_pkg_apis_controlplane_v1beta2_MulticastGroupInfo(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamedPort":                         schema_pkg_apis_controlplane_v1beta2_NamedPort(ref),
+		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamespaceTrafficStats":             schema_pkg_apis_controlplane_v1beta2_NamespaceTrafficStats(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicy":                     schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluation":           schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluation(ref),
 		"antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyEvaluationRequest":    schema_pkg_apis_controlplane_v1beta2_NetworkPolicyEvaluationRequest(ref),
@@ -91,9 +93,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicyList":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicyList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ClusterNetworkPolicySpec":                   schema_pkg_apis_crd_v1beta1_ClusterNetworkPolicySpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.ControllerCondition":                        schema_pkg_apis_crd_v1beta1_ControllerCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSQuery":                                   schema_pkg_apis_crd_v1beta1_DNSQuery(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Destination":                                schema_pkg_apis_crd_v1beta1_Destination(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.Egress":                                     schema_pkg_apis_crd_v1beta1_Egress(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition":                            schema_pkg_apis_crd_v1beta1_EgressCondition(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressFailover":                             schema_pkg_apis_crd_v1beta1_EgressFailover(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressList":                                 schema_pkg_apis_crd_v1beta1_EgressList(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressSpec":                                 schema_pkg_apis_crd_v1beta1_EgressSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressStatus":                               schema_pkg_apis_crd_v1beta1_EgressStatus(ref),
@@ -112,16 +116,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IGMPProtocol":                               schema_pkg_apis_crd_v1beta1_IGMPProtocol(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPAddressOwner":                             schema_pkg_apis_crd_v1beta1_IPAddressOwner(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPAddressState":                             schema_pkg_apis_crd_v1beta1_IPAddressState(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPAnnouncement":                             schema_pkg_apis_crd_v1beta1_IPAnnouncement(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPBlock":                                    schema_pkg_apis_crd_v1beta1_IPBlock(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPHeader":                                   schema_pkg_apis_crd_v1beta1_IPHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPool":                                     schema_pkg_apis_crd_v1beta1_IPPool(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolList":                                 schema_pkg_apis_crd_v1beta1_IPPoolList(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolNamespaceUsage":                       schema_pkg_apis_crd_v1beta1_IPPoolNamespaceUsage(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolSpec":                                 schema_pkg_apis_crd_v1beta1_IPPoolSpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolStatus":                               schema_pkg_apis_crd_v1beta1_IPPoolStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage":                                schema_pkg_apis_crd_v1beta1_IPPoolUsage(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange":                                    schema_pkg_apis_crd_v1beta1_IPRange(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.IPv6Header":                                 schema_pkg_apis_crd_v1beta1_IPv6Header(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.InterfaceStats":                             schema_pkg_apis_crd_v1beta1_InterfaceStats(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.KernelFeatureCheck":                         schema_pkg_apis_crd_v1beta1_KernelFeatureCheck(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.L7Protocol":                                 schema_pkg_apis_crd_v1beta1_L7Protocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.NDPHeader":                                  schema_pkg_apis_crd_v1beta1_NDPHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NamespacedName":                             schema_pkg_apis_crd_v1beta1_NamespacedName(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicy":                              schema_pkg_apis_crd_v1beta1_NetworkPolicy(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyCondition":                     schema_pkg_apis_crd_v1beta1_NetworkPolicyCondition(ref),
@@ -130,6 +139,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPeer":                          schema_pkg_apis_crd_v1beta1_NetworkPolicyPeer(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyPort":                          schema_pkg_apis_crd_v1beta1_NetworkPolicyPort(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyProtocol":                      schema_pkg_apis_crd_v1beta1_NetworkPolicyProtocol(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyRuleStats":                     schema_pkg_apis_crd_v1beta1_NetworkPolicyRuleStats(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicySpec":                          schema_pkg_apis_crd_v1beta1_NetworkPolicySpec(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyStatus":                        schema_pkg_apis_crd_v1beta1_NetworkPolicyStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.NodeResult":                                 schema_pkg_apis_crd_v1beta1_NodeResult(ref),
@@ -154,17 +164,23 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TraceflowStatus":                            schema_pkg_apis_crd_v1beta1_TraceflowStatus(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.TransportHeader":                            schema_pkg_apis_crd_v1beta1_TransportHeader(ref),
 		"antrea.io/antrea/pkg/apis/crd/v1beta1.UDPHeader":                                  schema_pkg_apis_crd_v1beta1_UDPHeader(ref),
+		"antrea.io/antrea/pkg/apis/crd/v1beta1.VRRPAnnouncement":                           schema_pkg_apis_crd_v1beta1_VRRPAnnouncement(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaClusterNetworkPolicyStats":         schema_pkg_apis_stats_v1alpha1_AntreaClusterNetworkPolicyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaClusterNetworkPolicyStatsList":     schema_pkg_apis_stats_v1alpha1_AntreaClusterNetworkPolicyStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaNetworkPolicyStats":                schema_pkg_apis_stats_v1alpha1_AntreaNetworkPolicyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.AntreaNetworkPolicyStatsList":            schema_pkg_apis_stats_v1alpha1_AntreaNetworkPolicyStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.MulticastGroup":                          schema_pkg_apis_stats_v1alpha1_MulticastGroup(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.MulticastGroupList":                      schema_pkg_apis_stats_v1alpha1_MulticastGroupList(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NamespaceTrafficStats":                   schema_pkg_apis_stats_v1alpha1_NamespaceTrafficStats(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NamespaceTrafficStatsList":               schema_pkg_apis_stats_v1alpha1_NamespaceTrafficStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NetworkPolicyStats":                      schema_pkg_apis_stats_v1alpha1_NetworkPolicyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NetworkPolicyStatsList":                  schema_pkg_apis_stats_v1alpha1_NetworkPolicyStatsList(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NodeInterfaceStats":                      schema_pkg_apis_stats_v1alpha1_NodeInterfaceStats(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NodeInterfaceStatsList":                  schema_pkg_apis_stats_v1alpha1_NodeInterfaceStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NodeLatencyStats":                        schema_pkg_apis_stats_v1alpha1_NodeLatencyStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.NodeLatencyStatsList":                    schema_pkg_apis_stats_v1alpha1_NodeLatencyStatsList(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.PeerNodeLatencyStats":                    schema_pkg_apis_stats_v1alpha1_PeerNodeLatencyStats(ref),
+		"antrea.io/antrea/pkg/apis/stats/v1alpha1.PodInterfaceStats":                       schema_pkg_apis_stats_v1alpha1_PodInterfaceStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.PodReference":                            schema_pkg_apis_stats_v1alpha1_PodReference(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.RuleTrafficStats":                        schema_pkg_apis_stats_v1alpha1_RuleTrafficStats(ref),
 		"antrea.io/antrea/pkg/apis/stats/v1alpha1.TargetIPLatencyStats":                    schema_pkg_apis_stats_v1alpha1_TargetIPLatencyStats(ref),
@@ -1516,6 +1532,33 @@ func schema_pkg_apis_controlplane_v1beta2_IPNet(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_KafkaProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KafkaProtocol matches Kafka requests with specific role and topic. All fields could be used alone or together. If all fields are not provided, it matches all Kafka requests.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"role": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Role represents the role of the Kafka client, which determines the Kafka requests to match. It could be produce or consume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topic": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topic represents the name of the Kafka topic to match.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1533,11 +1576,16 @@ func schema_pkg_apis_controlplane_v1beta2_L7Protocol(ref common.ReferenceCallbac
 							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"),
 						},
 					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.HTTPProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.KafkaProtocol", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.TLSProtocol"},
 	}
 }
 
@@ -1611,6 +1659,42 @@ func schema_pkg_apis_controlplane_v1beta2_NamedPort(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_controlplane_v1beta2_NamespaceTrafficStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceTrafficStats contains the traffic stats of the local Pods of a Namespace, for a given Node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the Namespace.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The traffic stats of the connections initiated towards the local Pods of the Namespace.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
+						},
+					},
+					"egress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The traffic stats of the connections initiated by the local Pods of the Namespace.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"},
+	}
+}
+
 func schema_pkg_apis_controlplane_v1beta2_NetworkPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -2130,6 +2214,21 @@ func schema_pkg_apis_controlplane_v1beta2_NetworkPolicyRule(ref common.Reference
 							Format:      "",
 						},
 					},
+					"nodeTrafficTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeTrafficTypes is a list of categories of traffic destined to the Node that this rule applies to. It is only set for ingress rules of policies applied to Nodes. An empty list means the Node OS and hostNetwork Pods.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"enableLogging"},
 			},
@@ -2334,11 +2433,25 @@ func schema_pkg_apis_controlplane_v1beta2_NodeStatsSummary(ref common.ReferenceC
 							},
 						},
 					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The traffic stats of the local Pods collected from the Node, grouped by Namespace.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamespaceTrafficStats"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/controlplane/v1beta2.MulticastGroupInfo", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NamespaceTrafficStats", "antrea.io/antrea/pkg/apis/controlplane/v1beta2.NetworkPolicyStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -2934,11 +3047,25 @@ func schema_pkg_apis_crd_v1beta1_AntreaAgentInfo(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
+					"kernelFeatureChecks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The results of the startup-time checks of the kernel features required by the enabled Antrea features",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.KernelFeatureCheck"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.AgentCondition", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyControllerInfo", "antrea.io/antrea/pkg/apis/crd/v1beta1.OVSInfo", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.AgentCondition", "antrea.io/antrea/pkg/apis/crd/v1beta1.KernelFeatureCheck", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyControllerInfo", "antrea.io/antrea/pkg/apis/crd/v1beta1.OVSInfo", "k8s.io/api/core/v1.ObjectReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -3541,6 +3668,35 @@ func schema_pkg_apis_crd_v1beta1_ControllerCondition(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_DNSQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSQuery describes a DNS query.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"queryName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryName is the domain name to query.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"queryType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueryType is the type of the query, \"A\" or \"AAAA\". It defaults to \"AAAA\" for IPv6 packets and to \"A\" otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"queryName"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_Destination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3676,6 +3832,43 @@ func schema_pkg_apis_crd_v1beta1_EgressCondition(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_EgressFailover(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressFailover records a change of the Node that holds the Egress IP.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fromNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FromNode is the Node that held the Egress IP before the failover.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"toNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ToNode is the Node that holds the Egress IP after the failover. It is empty if no Node could take over the Egress IP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"time": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time is the time when the failover was observed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"fromNode", "time"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_EgressList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3833,12 +4026,26 @@ func schema_pkg_apis_crd_v1beta1_EgressStatus(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"failoverHistory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailoverHistory records the most recent changes of the Node that holds the Egress IP, the latest one last.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.EgressFailover"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"egressNode", "egressIP"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.EgressCondition", "antrea.io/antrea/pkg/apis/crd/v1beta1.EgressFailover"},
 	}
 }
 
@@ -3974,12 +4181,18 @@ func schema_pkg_apis_crd_v1beta1_ExternalIPPoolSpec(ref common.ReferenceCallback
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
+					"announcement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The announcement of the IPs of this IP pool to the neighbors of the Nodes they are assigned to. If not set, the IPs are announced with gratuitous ARP (IPv4) and unsolicited Neighbor Advertisement (IPv6).",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IPAnnouncement"),
+						},
+					},
 				},
 				Required: []string{"ipRanges", "nodeSelector"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange", "antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPAnnouncement", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange", "antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -4403,6 +4616,34 @@ func schema_pkg_apis_crd_v1beta1_IPAddressState(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_IPAnnouncement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPAnnouncement specifies how the IPs of an ExternalIPPool are announced.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The announcement mode, GARP or VRRP. Default is GARP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vrrp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The VRRP parameters. It must be set when Mode is VRRP.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.VRRPAnnouncement"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.VRRPAnnouncement"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_IPBlock(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4573,6 +4814,35 @@ func schema_pkg_apis_crd_v1beta1_IPPoolList(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_IPPoolNamespaceUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Namespace.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Number of IPs allocated or reserved for the Pods and StatefulSets of the Namespace.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"namespace", "used"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_IPPoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4600,12 +4870,25 @@ func schema_pkg_apis_crd_v1beta1_IPPoolSpec(ref common.ReferenceCallback) common
 							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo"),
 						},
 					},
+					"namespaceSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Select the Namespaces whose Pods and StatefulSets can be allocated IPs from this IP pool through the IPPool annotations. If not set, the IP pool can be used by all Namespaces.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"maxIPsPerNamespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of IPs that can be allocated or reserved from this IP pool for the Pods and StatefulSets of a single Namespace. 0 means no limit.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"ipRanges", "subnetInfo"},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange", "antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPRange", "antrea.io/antrea/pkg/apis/crd/v1beta1.SubnetInfo", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -4634,11 +4917,25 @@ func schema_pkg_apis_crd_v1beta1_IPPoolStatus(ref common.ReferenceCallback) comm
 							Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage"),
 						},
 					},
+					"namespaceUsages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usage of each Namespace which has IPs allocated or reserved from this IP pool. It is only reported when MaxIPsPerNamespace is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolNamespaceUsage"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPAddressState", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.IPAddressState", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolNamespaceUsage", "antrea.io/antrea/pkg/apis/crd/v1beta1.IPPoolUsage"},
 	}
 }
 
@@ -4732,42 +5029,148 @@ func schema_pkg_apis_crd_v1beta1_IPv6Header(ref common.ReferenceCallback) common
 	}
 }
 
-func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_crd_v1beta1_InterfaceStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "InterfaceStats is the counters of the HNS endpoint of a Pod on a Windows Node, which accumulate since the endpoint was created.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"http": {
+					"packetsReceived": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol"),
+							Description: "PacketsReceived is the number of packets received by the Pod.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"tls": {
+					"packetsSent": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"),
+							Description: "PacketsSent is the number of packets sent by the Pod.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"droppedPacketsIncoming": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DroppedPacketsIncoming is the number of incoming packets dropped by the HNS endpoint.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"droppedPacketsOutgoing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DroppedPacketsOutgoing is the number of outgoing packets dropped by the HNS endpoint.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
 	}
 }
 
-func schema_pkg_apis_crd_v1beta1_NamespacedName(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_crd_v1beta1_KernelFeatureCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespacedName refers to a Namespace scoped resource. All fields must be used together.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
+							Description: "Name of the kernel feature, e.g. WireGuard",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requiredBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Antrea feature or configuration which requires the kernel feature",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"supported": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether the kernel feature is supported",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Human readable message explaining why the kernel feature is not supported",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "supported"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_L7Protocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.HTTPProtocol", "antrea.io/antrea/pkg/apis/crd/v1beta1.TLSProtocol"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_NDPHeader(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NDPHeader describes spec of an ICMPv6 Neighbor Discovery message header.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the Neighbor Discovery message. The target address of the message is the destination IP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_NamespacedName(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespacedName refers to a Namespace scoped resource. All fields must be used together.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
 					},
 					"namespace": {
 						SchemaProps: spec.SchemaProps{
@@ -5114,6 +5517,48 @@ func schema_pkg_apis_crd_v1beta1_NetworkPolicyProtocol(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_crd_v1beta1_NetworkPolicyRuleStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NetworkPolicyRuleStats is the hit statistics of a NetworkPolicy rule on a Node, which accumulate since the rule was realized on the Node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"packets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Packets is the number of packets matching the rule.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"bytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Bytes is the number of bytes matching the rule.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sessions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sessions is the number of sessions matching the rule.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastHitTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHitTime is the last time a packet matched the rule. It's not set if no packet has matched the rule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_crd_v1beta1_NetworkPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5449,9 +5894,23 @@ func schema_pkg_apis_crd_v1beta1_Observation(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"networkPolicyRuleStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPolicyRuleStats is the hit statistics of the NetworkPolicy rule on the Node when the packet is observed.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyRuleStats"),
+						},
+					},
+					"interfaceStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InterfaceStats is the counters of the HNS endpoint of the Pod when the packet is delivered to a Pod on a Windows Node. Packets can be dropped by the HNS endpoint after being delivered by OVS.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.InterfaceStats"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.InterfaceStats", "antrea.io/antrea/pkg/apis/crd/v1beta1.NetworkPolicyRuleStats"},
 	}
 }
 
@@ -5747,6 +6206,21 @@ func schema_pkg_apis_crd_v1beta1_Rule(ref common.ReferenceCallback) common.OpenA
 							},
 						},
 					},
+					"nodeTrafficTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeTrafficTypes restricts the rule to the given categories of traffic destined to the Nodes selected by AppliedTo. It can only be set for ingress rules of ClusterNetworkPolicies applied to Nodes. If this field is empty, the rule matches traffic destined to the Node OS and to hostNetwork Pods, but not traffic forwarded to Pods through a hostPort.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"action"},
 			},
@@ -6258,6 +6732,12 @@ func schema_pkg_apis_crd_v1beta1_TransportHeader(ref common.ReferenceCallback) c
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader"),
 						},
 					},
+					"ndp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NDP is an ICMPv6 Neighbor Discovery message header. It can only be used with IPv6.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.NDPHeader"),
+						},
+					},
 					"udp": {
 						SchemaProps: spec.SchemaProps{
 							Ref: ref("antrea.io/antrea/pkg/apis/crd/v1beta1.UDPHeader"),
@@ -6272,7 +6752,7 @@ func schema_pkg_apis_crd_v1beta1_TransportHeader(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.TCPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.UDPHeader"},
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.ICMPEchoRequestHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.NDPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.TCPHeader", "antrea.io/antrea/pkg/apis/crd/v1beta1.UDPHeader"},
 	}
 }
 
@@ -6292,14 +6772,51 @@ func schema_pkg_apis_crd_v1beta1_UDPHeader(ref common.ReferenceCallback) common.
 					},
 					"dstPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DstPort is the destination port.",
+							Description: "DstPort is the destination port. It defaults to 53 when DNS is set.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNS is the DNS query carried by the UDP packet.",
+							Ref:         ref("antrea.io/antrea/pkg/apis/crd/v1beta1.DNSQuery"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/crd/v1beta1.DNSQuery"},
+	}
+}
+
+func schema_pkg_apis_crd_v1beta1_VRRPAnnouncement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VRRPAnnouncement specifies the VRRP virtual routers used to announce the IPs of an ExternalIPPool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"virtualRouterIDBase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Virtual Router ID of the first IP of the pool, 1~255. The IPs of the pool are mapped to consecutive Virtual Router IDs in the order of the IP ranges, so the pool cannot contain more IPs than the remaining Virtual Router IDs.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"advertisementInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The interval between VRRP advertisements in centiseconds, 1~4095. Default is 100 (1 second).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"virtualRouterIDBase"},
+			},
+		},
 	}
 }
 
@@ -6622,6 +7139,105 @@ func schema_pkg_apis_stats_v1alpha1_MulticastGroupList(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_stats_v1alpha1_NamespaceTrafficStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceTrafficStats is the traffic summary of the Pods of a Namespace, aggregated from the stats reported by all the Agents. The name of the object is the name of the Namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The traffic stats of the connections initiated towards the Pods of the Namespace. Sessions is the number of connections, and Packets and Bytes include both directions of the connections.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
+						},
+					},
+					"egress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The traffic stats of the connections initiated by the Pods of the Namespace. Sessions is the number of connections, and Packets and Bytes include both directions of the connections.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.TrafficStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_stats_v1alpha1_NamespaceTrafficStatsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceTrafficStatsList is a list of NamespaceTrafficStats objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The list of NamespaceTrafficStats.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.NamespaceTrafficStats"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.NamespaceTrafficStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_stats_v1alpha1_NetworkPolicyStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6714,6 +7330,105 @@ func schema_pkg_apis_stats_v1alpha1_NetworkPolicyStatsList(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_stats_v1alpha1_NodeInterfaceStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeInterfaceStats contains the traffic counters of the Pod interfaces collected by the Agent on a specific Node. It is currently only reported by Windows Nodes, for which the counters are read from the HNS endpoints of the Pods.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"podInterfaceStats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The list of PodInterfaceStats.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.PodInterfaceStats"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.PodInterfaceStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_stats_v1alpha1_NodeInterfaceStatsList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeInterfaceStatsList is a list of NodeInterfaceStats objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The list of NodeInterfaceStats.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.NodeInterfaceStats"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.NodeInterfaceStats", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
 func schema_pkg_apis_stats_v1alpha1_NodeLatencyStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6849,6 +7564,84 @@ func schema_pkg_apis_stats_v1alpha1_PeerNodeLatencyStats(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_stats_v1alpha1_PodInterfaceStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PodInterfaceStats contains the traffic counters of a Pod interface. The counters are cumulative since the creation of the interface.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Pod which the interface belongs to.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("antrea.io/antrea/pkg/apis/stats/v1alpha1.PodReference"),
+						},
+					},
+					"interfaceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the interface.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpointID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The ID of the HNS endpoint backing the interface.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"packetsReceived": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of packets received by the Pod.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"packetsSent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of packets sent by the Pod.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"bytesReceived": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of bytes received by the Pod.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"bytesSent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of bytes sent by the Pod.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"droppedPacketsIncoming": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of incoming packets dropped by the HNS endpoint, e.g. by HNS ACL policies.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"droppedPacketsOutgoing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The number of outgoing packets dropped by the HNS endpoint, e.g. by HNS ACL policies.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"antrea.io/antrea/pkg/apis/stats/v1alpha1.PodReference"},
+	}
+}
+
 func schema_pkg_apis_stats_v1alpha1_PodReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -99,7 +99,7 @@ func (r *REST) NamespaceScoped() bool {
 
 func (r *REST) Watch(ctx context.Context, options *internalversion.ListOptions) (watch.Interface, error) {
 	key, label, field := networkpolicy.GetSelectors(options)
-	return r.appliedToGroupStore.Watch(ctx, key, label, field)
+	return r.appliedToGroupStore.WatchWithOptions(ctx, key, label, field, networkpolicy.GetWatchOptions(options))
 }
 
 func (r *REST) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
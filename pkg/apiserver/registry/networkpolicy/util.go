@@ -18,6 +18,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+
+	"antrea.io/antrea/pkg/apiserver/storage"
 )
 
 // GetSelectors extracts label selector, field selector, and key selector from the provided options.
@@ -33,3 +35,14 @@ func GetSelectors(options *internalversion.ListOptions) (string, labels.Selector
 	key, _ := field.RequiresExactMatch("metadata.name")
 	return key, label, field
 }
+
+// GetWatchOptions extracts the options to resume a watch and receive bookmark events from the provided options.
+func GetWatchOptions(options *internalversion.ListOptions) storage.WatchOptions {
+	if options == nil {
+		return storage.WatchOptions{}
+	}
+	return storage.WatchOptions{
+		ResourceVersion: options.ResourceVersion,
+		AllowBookmarks:  options.AllowWatchBookmarks,
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacetrafficstats
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+)
+
+var (
+	tableColumnDefinitions = []metav1.TableColumnDefinition{
+		{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+		{Name: "Ingress Sessions", Type: "integer", Description: "The count of the connections initiated towards the Pods of the Namespace."},
+		{Name: "Ingress Bytes", Type: "integer", Description: "The bytes count of the connections initiated towards the Pods of the Namespace."},
+		{Name: "Egress Sessions", Type: "integer", Description: "The count of the connections initiated by the Pods of the Namespace."},
+		{Name: "Egress Bytes", Type: "integer", Description: "The bytes count of the connections initiated by the Pods of the Namespace."},
+		{Name: "Created At", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+	}
+)
+
+type REST struct {
+	statsProvider statsProvider
+}
+
+// NewREST returns a REST object that will work against API services.
+func NewREST(p statsProvider) *REST {
+	return &REST{p}
+}
+
+var (
+	_ rest.Storage              = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.Getter               = &REST{}
+	_ rest.Lister               = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+type statsProvider interface {
+	ListNamespaceTrafficStats() []statsv1alpha1.NamespaceTrafficStats
+
+	GetNamespaceTrafficStats(name string) (*statsv1alpha1.NamespaceTrafficStats, bool)
+}
+
+func (r *REST) New() runtime.Object {
+	return &statsv1alpha1.NamespaceTrafficStats{}
+}
+
+func (r *REST) Destroy() {
+}
+
+func (r *REST) NewList() runtime.Object {
+	return &statsv1alpha1.NamespaceTrafficStatsList{}
+}
+
+func (r *REST) List(ctx context.Context, options *internalversion.ListOptions) (runtime.Object, error) {
+	if !features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		return &statsv1alpha1.NamespaceTrafficStatsList{}, nil
+	}
+	if !features.DefaultFeatureGate.Enabled(features.NamespaceTrafficStats) {
+		return &statsv1alpha1.NamespaceTrafficStatsList{}, nil
+	}
+	labelSelector := labels.Everything()
+	if options != nil && options.LabelSelector != nil {
+		labelSelector = options.LabelSelector
+	}
+	stats := r.statsProvider.ListNamespaceTrafficStats()
+	items := make([]statsv1alpha1.NamespaceTrafficStats, 0, len(stats))
+	for i := range stats {
+		if labelSelector.Matches(labels.Set(stats[i].Labels)) {
+			items = append(items, stats[i])
+		}
+	}
+	metricList := &statsv1alpha1.NamespaceTrafficStatsList{
+		Items: items,
+	}
+	return metricList, nil
+}
+
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	if !features.DefaultFeatureGate.Enabled(features.NetworkPolicyStats) {
+		return &statsv1alpha1.NamespaceTrafficStats{}, nil
+	}
+	if !features.DefaultFeatureGate.Enabled(features.NamespaceTrafficStats) {
+		return &statsv1alpha1.NamespaceTrafficStats{}, nil
+	}
+	metric, exists := r.statsProvider.GetNamespaceTrafficStats(name)
+	if !exists {
+		return nil, errors.NewNotFound(statsv1alpha1.Resource("namespacetrafficstats"), name)
+	}
+	return metric, nil
+}
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+func formatTimestamp(t metav1.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func (r *REST) ConvertToTable(ctx context.Context, obj runtime.Object, tableOptions runtime.Object) (*metav1.Table, error) {
+	table := &metav1.Table{
+		ColumnDefinitions: tableColumnDefinitions,
+	}
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+		table.RemainingItemCount = m.GetRemainingItemCount()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	var err error
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, m metav1.Object, name, age string) ([]interface{}, error) {
+		stats := obj.(*statsv1alpha1.NamespaceTrafficStats)
+		return []interface{}{name, stats.Ingress.Sessions, stats.Ingress.Bytes, stats.Egress.Sessions, stats.Egress.Bytes, formatTimestamp(m.GetCreationTimestamp())}, nil
+	})
+	return table, err
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+func (r *REST) GetSingularName() string {
+	return "namespacetrafficstats"
+}
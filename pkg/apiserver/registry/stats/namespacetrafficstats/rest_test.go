@@ -0,0 +1,326 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespacetrafficstats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+	"antrea.io/antrea/pkg/features"
+)
+
+type fakeStatsProvider struct {
+	stats map[string]statsv1alpha1.NamespaceTrafficStats
+}
+
+func (p *fakeStatsProvider) ListNamespaceTrafficStats() []statsv1alpha1.NamespaceTrafficStats {
+	list := make([]statsv1alpha1.NamespaceTrafficStats, 0, len(p.stats))
+	for _, m := range p.stats {
+		list = append(list, m)
+	}
+	return list
+}
+
+func (p *fakeStatsProvider) GetNamespaceTrafficStats(name string) (*statsv1alpha1.NamespaceTrafficStats, bool) {
+	m, exists := p.stats[name]
+	if !exists {
+		return nil, false
+	}
+	return &m, true
+}
+
+func TestREST(t *testing.T) {
+	r := NewREST(nil)
+	assert.Equal(t, &statsv1alpha1.NamespaceTrafficStats{}, r.New())
+	assert.Equal(t, &statsv1alpha1.NamespaceTrafficStatsList{}, r.NewList())
+	assert.False(t, r.NamespaceScoped())
+}
+
+func TestRESTGet(t *testing.T) {
+	tests := []struct {
+		name                         string
+		networkPolicyStatsEnabled    bool
+		namespaceTrafficStatsEnabled bool
+		stats                        map[string]statsv1alpha1.NamespaceTrafficStats
+		namespace                    string
+		expectedObj                  runtime.Object
+		expectedErr                  bool
+	}{
+		{
+			name:                         "NetworkPolicyStats feature disabled",
+			networkPolicyStatsEnabled:    false,
+			namespaceTrafficStatsEnabled: true,
+			expectedObj:                  &statsv1alpha1.NamespaceTrafficStats{},
+			expectedErr:                  false,
+		},
+		{
+			name:                         "NamespaceTrafficStats feature disabled",
+			networkPolicyStatsEnabled:    true,
+			namespaceTrafficStatsEnabled: false,
+			expectedObj:                  &statsv1alpha1.NamespaceTrafficStats{},
+			expectedErr:                  false,
+		},
+		{
+			name:                         "Namespace not found",
+			networkPolicyStatsEnabled:    true,
+			namespaceTrafficStatsEnabled: true,
+			stats: map[string]statsv1alpha1.NamespaceTrafficStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+			},
+			namespace:   "bar",
+			expectedErr: true,
+		},
+		{
+			name:                         "Namespace found",
+			networkPolicyStatsEnabled:    true,
+			namespaceTrafficStatsEnabled: true,
+			stats: map[string]statsv1alpha1.NamespaceTrafficStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+			},
+			namespace: "foo",
+			expectedObj: &statsv1alpha1.NamespaceTrafficStats{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "foo",
+				},
+			},
+			expectedErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, tt.networkPolicyStatsEnabled)
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NamespaceTrafficStats, tt.namespaceTrafficStatsEnabled)
+
+			r := &REST{
+				statsProvider: &fakeStatsProvider{stats: tt.stats},
+			}
+			actualObj, err := r.Get(context.TODO(), tt.namespace, &metav1.GetOptions{})
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedObj, actualObj)
+		})
+	}
+}
+
+func TestRESTList(t *testing.T) {
+	tests := []struct {
+		name                         string
+		networkPolicyStatsEnabled    bool
+		namespaceTrafficStatsEnabled bool
+		labelSelector                labels.Selector
+		stats                        map[string]statsv1alpha1.NamespaceTrafficStats
+		expectedObj                  runtime.Object
+		expectedErr                  bool
+	}{
+		{
+			name:                         "NetworkPolicyStats feature disabled",
+			networkPolicyStatsEnabled:    false,
+			namespaceTrafficStatsEnabled: true,
+			expectedObj:                  &statsv1alpha1.NamespaceTrafficStatsList{},
+			expectedErr:                  false,
+		},
+		{
+			name:                         "NamespaceTrafficStats feature disabled",
+			networkPolicyStatsEnabled:    true,
+			namespaceTrafficStatsEnabled: false,
+			expectedObj:                  &statsv1alpha1.NamespaceTrafficStatsList{},
+			expectedErr:                  false,
+		},
+		{
+			name:                         "empty stats",
+			networkPolicyStatsEnabled:    true,
+			namespaceTrafficStatsEnabled: true,
+			stats:                        map[string]statsv1alpha1.NamespaceTrafficStats{},
+			expectedObj: &statsv1alpha1.NamespaceTrafficStatsList{
+				Items: []statsv1alpha1.NamespaceTrafficStats{},
+			},
+			expectedErr: false,
+		},
+		{
+			name:                         "a few stats",
+			networkPolicyStatsEnabled:    true,
+			namespaceTrafficStatsEnabled: true,
+			stats: map[string]statsv1alpha1.NamespaceTrafficStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+				"bar": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "bar",
+					},
+				},
+			},
+			expectedObj: &statsv1alpha1.NamespaceTrafficStatsList{
+				Items: []statsv1alpha1.NamespaceTrafficStats{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "foo",
+						},
+					},
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "bar",
+						},
+					},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name:                         "label selector selecting nothing",
+			networkPolicyStatsEnabled:    true,
+			namespaceTrafficStatsEnabled: true,
+			labelSelector:                labels.Nothing(),
+			stats: map[string]statsv1alpha1.NamespaceTrafficStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+			},
+			expectedObj: &statsv1alpha1.NamespaceTrafficStatsList{
+				Items: []statsv1alpha1.NamespaceTrafficStats{},
+			},
+			expectedErr: false,
+		},
+		{
+			name:                         "label selector selecting everything",
+			networkPolicyStatsEnabled:    true,
+			namespaceTrafficStatsEnabled: true,
+			labelSelector:                labels.Everything(),
+			stats: map[string]statsv1alpha1.NamespaceTrafficStats{
+				"foo": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "foo",
+					},
+				},
+			},
+			expectedObj: &statsv1alpha1.NamespaceTrafficStatsList{
+				Items: []statsv1alpha1.NamespaceTrafficStats{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "foo",
+						},
+					},
+				},
+			},
+			expectedErr: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NetworkPolicyStats, tt.networkPolicyStatsEnabled)
+			featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NamespaceTrafficStats, tt.namespaceTrafficStatsEnabled)
+
+			r := &REST{
+				statsProvider: &fakeStatsProvider{stats: tt.stats},
+			}
+			actualObj, err := r.List(context.TODO(), &internalversion.ListOptions{LabelSelector: tt.labelSelector})
+			if tt.expectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			if tt.expectedObj == nil {
+				assert.Nil(t, actualObj)
+			} else {
+				assert.ElementsMatch(t, tt.expectedObj.(*statsv1alpha1.NamespaceTrafficStatsList).Items, actualObj.(*statsv1alpha1.NamespaceTrafficStatsList).Items)
+			}
+		})
+	}
+}
+
+func TestRESTConvertToTable(t *testing.T) {
+	stats := &statsv1alpha1.NamespaceTrafficStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "foo",
+			CreationTimestamp: metav1.Time{Time: time.Now()},
+		},
+		Ingress: statsv1alpha1.TrafficStats{
+			Packets:  10,
+			Bytes:    2000,
+			Sessions: 5,
+		},
+		Egress: statsv1alpha1.TrafficStats{
+			Packets:  4,
+			Bytes:    300,
+			Sessions: 2,
+		},
+	}
+	expectedFormattedCreationTimestamp := stats.CreationTimestamp.UTC().Format(time.RFC3339)
+	tests := []struct {
+		name          string
+		object        runtime.Object
+		expectedTable *metav1.Table
+	}{
+		{
+			name:   "one object",
+			object: stats,
+			expectedTable: &metav1.Table{
+				ColumnDefinitions: tableColumnDefinitions,
+				Rows: []metav1.TableRow{
+					{
+						Cells:  []interface{}{"foo", int64(5), int64(2000), int64(2), int64(300), expectedFormattedCreationTimestamp},
+						Object: runtime.RawExtension{Object: stats},
+					},
+				},
+			},
+		},
+		{
+			name:   "multiple objects",
+			object: &statsv1alpha1.NamespaceTrafficStatsList{Items: []statsv1alpha1.NamespaceTrafficStats{*stats}},
+			expectedTable: &metav1.Table{
+				ColumnDefinitions: tableColumnDefinitions,
+				Rows: []metav1.TableRow{
+					{
+						Cells:  []interface{}{"foo", int64(5), int64(2000), int64(2), int64(300), expectedFormattedCreationTimestamp},
+						Object: runtime.RawExtension{Object: stats},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &REST{}
+			actualTable, err := r.ConvertToTable(context.TODO(), tt.object, &metav1.TableOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedTable, actualTable)
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeinterfacestats
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	statsv1alpha1 "antrea.io/antrea/pkg/apis/stats/v1alpha1"
+)
+
+var (
+	pod1Stats = statsv1alpha1.PodInterfaceStats{
+		Pod:                    statsv1alpha1.PodReference{Name: "pod1", Namespace: "ns1"},
+		InterfaceName:          "pod1-6631b7",
+		EndpointID:             "5b9f4c3e-5f4b-4e1b-9d77-0c6f3b1a2d01",
+		PacketsReceived:        10,
+		PacketsSent:            20,
+		BytesReceived:          1000,
+		BytesSent:              2000,
+		DroppedPacketsIncoming: 1,
+		DroppedPacketsOutgoing: 2,
+	}
+	pod2Stats = statsv1alpha1.PodInterfaceStats{
+		Pod:                    statsv1alpha1.PodReference{Name: "pod2", Namespace: "ns1"},
+		InterfaceName:          "pod2-0f3e5a",
+		EndpointID:             "9a1c2d3e-7b6a-4f5e-8d9c-1b2a3c4d5e02",
+		PacketsReceived:        30,
+		PacketsSent:            40,
+		BytesReceived:          3000,
+		BytesSent:              4000,
+		DroppedPacketsIncoming: 3,
+		DroppedPacketsOutgoing: 0,
+	}
+)
+
+func TestREST(t *testing.T) {
+	r := NewREST()
+	assert.Equal(t, &statsv1alpha1.NodeInterfaceStats{}, r.New())
+	assert.Equal(t, &statsv1alpha1.NodeInterfaceStatsList{}, r.NewList())
+	assert.False(t, r.NamespaceScoped())
+}
+
+func TestRESTCreateGetListDelete(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	fakeClock := clocktesting.NewFakeClock(now)
+	r := newRESTWithClock(fakeClock)
+
+	summary := &statsv1alpha1.NodeInterfaceStats{
+		ObjectMeta:        metav1.ObjectMeta{Name: "node1"},
+		PodInterfaceStats: []statsv1alpha1.PodInterfaceStats{pod1Stats},
+	}
+	expectedObj := &statsv1alpha1.NodeInterfaceStats{
+		ObjectMeta:        metav1.ObjectMeta{Name: "node1", CreationTimestamp: metav1.Time{Time: now}},
+		PodInterfaceStats: []statsv1alpha1.PodInterfaceStats{pod1Stats},
+	}
+	obj, err := r.Create(ctx, summary, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, expectedObj, obj)
+
+	// Creating the object again replaces the stats but keeps the original creation timestamp.
+	fakeClock.Step(time.Minute)
+	summary = &statsv1alpha1.NodeInterfaceStats{
+		ObjectMeta:        metav1.ObjectMeta{Name: "node1", CreationTimestamp: metav1.Time{Time: now}},
+		PodInterfaceStats: []statsv1alpha1.PodInterfaceStats{pod1Stats, pod2Stats},
+	}
+	_, err = r.Create(ctx, summary, nil, nil)
+	require.NoError(t, err)
+	obj, err = r.Get(ctx, "node1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, summary, obj)
+
+	objs, err := r.List(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, &statsv1alpha1.NodeInterfaceStatsList{Items: []statsv1alpha1.NodeInterfaceStats{*summary}}, objs)
+
+	_, err = r.Get(ctx, "node2", nil)
+	assert.EqualError(t, err, errors.NewNotFound(statsv1alpha1.Resource("nodeinterfacestats"), "node2").Error())
+
+	obj, deleted, err := r.Delete(ctx, "node1", nil, nil)
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	assert.Equal(t, summary, obj)
+	_, _, err = r.Delete(ctx, "node1", nil, nil)
+	assert.EqualError(t, err, errors.NewNotFound(statsv1alpha1.Resource("nodeinterfacestats"), "node1").Error())
+}
+
+func TestRESTConvertToTable(t *testing.T) {
+	summary := &statsv1alpha1.NodeInterfaceStats{
+		ObjectMeta:        metav1.ObjectMeta{Name: "node1"},
+		PodInterfaceStats: []statsv1alpha1.PodInterfaceStats{pod1Stats, pod2Stats},
+	}
+	expectedCells := []interface{}{"node1", 2, int64(4000), int64(6000), int64(6)}
+
+	r := NewREST()
+	obj, err := r.ConvertToTable(context.Background(), summary, nil)
+	require.NoError(t, err)
+	assert.Equal(t, expectedCells, obj.Rows[0].Cells)
+}
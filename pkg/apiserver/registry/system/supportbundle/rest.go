@@ -272,6 +272,7 @@ func (r *supportBundleREST) collectAgent(ctx context.Context, since string) (*sy
 		dumper.DumpAgentInfo,
 		dumper.DumpHeapPprof,
 		dumper.DumpGoroutinePprof,
+		dumper.DumpCapturedProfiles,
 		dumper.DumpOVSPorts,
 		dumper.DumpMemberlist,
 	)
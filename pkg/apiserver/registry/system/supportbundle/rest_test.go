@@ -241,6 +241,10 @@ func (f *fakeAgentDumper) DumpGoroutinePprof(basedir string) error {
 	return f.returnErr
 }
 
+func (f *fakeAgentDumper) DumpCapturedProfiles(basedir string) error {
+	return f.returnErr
+}
+
 func (f *fakeAgentDumper) DumpOVSPorts(basedir string) error {
 	return f.returnErr
 }
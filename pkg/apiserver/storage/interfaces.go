@@ -46,6 +46,17 @@ type InternalEvent interface {
 	GetResourceVersion() uint64
 }
 
+// WatchOptions are the options of a watch.
+type WatchOptions struct {
+	// ResourceVersion is the resourceVersion of the last bookmark event received by the client in a previous watch.
+	// If it is set and the store still has all the events generated after it, the watch is resumed: only these events
+	// are sent instead of the whole set of objects.
+	ResourceVersion string
+	// AllowBookmarks indicates whether the client wants to receive bookmark events carrying the latest resourceVersion
+	// as the watch progresses, which can be used to resume the watch later.
+	AllowBookmarks bool
+}
+
 // GenEventFunc generates InternalEvent from the add/update/delete of an object.
 // Only a single InternalEvent will be generated for each add/update/delete, and the InternalEvent itself should be
 // immutable during its conversion to *watch.Event.
@@ -79,6 +90,9 @@ type Interface interface {
 	// Watch starts watching with the specified key and selectors. Events will be sent to the returned watch.Interface.
 	Watch(ctx context.Context, key string, labelSelector labels.Selector, fieldSelector fields.Selector) (watch.Interface, error)
 
+	// WatchWithOptions is like Watch, but supports resuming a previous watch and receiving bookmark events.
+	WatchWithOptions(ctx context.Context, key string, labelSelector labels.Selector, fieldSelector fields.Selector, options WatchOptions) (watch.Interface, error)
+
 	// GetWatchersNum gets the number of watchers for the store.
 	GetWatchersNum() int
 
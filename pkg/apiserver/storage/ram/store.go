@@ -17,6 +17,9 @@ package ram
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,6 +41,8 @@ const (
 	// watcherAddTimeout is the timeout of sending one event to all watchers.
 	// Watchers whose buffer can't be available in it will be terminated.
 	watcherAddTimeout = 50 * time.Millisecond
+	// eventHistorySize is the number of the latest events kept to resume watches.
+	eventHistorySize = 1000
 )
 
 type watchersMap map[int]*storeWatcher
@@ -67,6 +72,13 @@ type store struct {
 
 	// resourceVersion up to which the store has generated.
 	resourceVersion uint64
+	// epoch identifies this instance of the store in the resourceVersions sent to clients, so that the
+	// resourceVersions of another instance, e.g. before antrea-controller restarted, are not used to resume watches.
+	epoch string
+	// history keeps the latest eventHistorySize events, ordered by resourceVersion, to resume watches.
+	history []antreastorage.InternalEvent
+	// historyStart is the resourceVersion after which all the events are in history.
+	historyStart uint64
 	// watcherIdx is the index that will be allocated to next watcher and used as key in watchersMap
 	// so that a watcher can be deleted from the map according to its index later.
 	watcherIdx int
@@ -97,6 +109,7 @@ func newStoreWithClock(keyFunc cache.KeyFunc, indexers cache.Indexers, genEventF
 		selectFunc:   selectorFunc,
 		timer:        timer,
 		newFunc:      newFunc,
+		epoch:        strconv.FormatInt(clock.Now().UnixNano(), 36),
 	}
 
 	go s.dispatchEvents()
@@ -118,7 +131,48 @@ func (s *store) nextResourceVersion() uint64 {
 	return s.resourceVersion
 }
 
+// formatResourceVersion returns the resourceVersion sent to clients for the provided internal resourceVersion.
+func (s *store) formatResourceVersion(resourceVersion uint64) string {
+	return s.epoch + "." + strconv.FormatUint(resourceVersion, 10)
+}
+
+// parseResourceVersion returns the internal resourceVersion for a resourceVersion sent to clients. It returns false if
+// the resourceVersion is invalid or was generated by another instance of the store.
+func (s *store) parseResourceVersion(resourceVersion string) (uint64, bool) {
+	epoch, version, found := strings.Cut(resourceVersion, ".")
+	if !found || epoch != s.epoch {
+		return 0, false
+	}
+	rv, err := strconv.ParseUint(version, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return rv, true
+}
+
+// eventsSince returns the events generated after the provided resourceVersion. It returns false if some of these
+// events are no longer in the history. It must be called while holding a lock on eventMutex.
+func (s *store) eventsSince(resourceVersion uint64) ([]antreastorage.InternalEvent, bool) {
+	if resourceVersion < s.historyStart || resourceVersion > s.resourceVersion {
+		return nil, false
+	}
+	// Not every resourceVersion has an event, e.g. when an object is updated without any change.
+	i := sort.Search(len(s.history), func(i int) bool {
+		return s.history[i].GetResourceVersion() > resourceVersion
+	})
+	events := make([]antreastorage.InternalEvent, len(s.history)-i)
+	copy(events, s.history[i:])
+	return events, true
+}
+
+// processEvent records the event in the history and sends it to the incoming channel. It must be called while
+// holding a lock on eventMutex.
 func (s *store) processEvent(event antreastorage.InternalEvent) {
+	s.history = append(s.history, event)
+	if len(s.history) > eventHistorySize {
+		s.historyStart = s.history[0].GetResourceVersion()
+		s.history = s.history[1:]
+	}
 	if curLen := int64(len(s.incoming)); s.incomingHWM.Update(curLen) {
 		// Monitor if this gets backed up, and how much.
 		klog.V(1).Infof("%v objects queued in incoming channel", curLen)
@@ -228,6 +282,13 @@ func (s *store) Delete(key string) error {
 
 // Watch creates a watcher based on the key, label selector and field selector.
 func (s *store) Watch(ctx context.Context, key string, labelSelector labels.Selector, fieldSelector fields.Selector) (watch.Interface, error) {
+	return s.WatchWithOptions(ctx, key, labelSelector, fieldSelector, antreastorage.WatchOptions{})
+}
+
+// WatchWithOptions creates a watcher based on the key, label selector, field selector and watch options. If the watch
+// can be resumed from options.ResourceVersion, only the events generated after it are sent to the watcher, otherwise
+// all the objects selected by the watcher are sent as init events.
+func (s *store) WatchWithOptions(ctx context.Context, key string, labelSelector labels.Selector, fieldSelector fields.Selector, options antreastorage.WatchOptions) (watch.Interface, error) {
 	if s.genEventFunc == nil {
 		return nil, fmt.Errorf("genEventFunc must be set to support watching")
 	}
@@ -242,21 +303,33 @@ func (s *store) Watch(ctx context.Context, key string, labelSelector labels.Sele
 		Field: fieldSelector,
 	}
 
-	allObjects := s.storage.List()
-	initEvents := make([]antreastorage.InternalEvent, 0, len(allObjects))
-	for _, obj := range allObjects {
-		// Objects retrieved from storage have been verified with keyFunc when they are inserted.
-		key, _ := s.keyFunc(obj)
-		// Check whether the watcher is interested in this object, don't generate an initEvent if not.
-		if s.selectFunc != nil && !s.selectFunc(selectors, key, obj) {
-			continue
+	var initEvents []antreastorage.InternalEvent
+	resumed := false
+	if options.ResourceVersion != "" {
+		if resourceVersion, ok := s.parseResourceVersion(options.ResourceVersion); ok {
+			initEvents, resumed = s.eventsSince(resourceVersion)
 		}
+		if !resumed {
+			klog.V(2).InfoS("Cannot resume watch, sending all objects", "resourceVersion", options.ResourceVersion)
+		}
+	}
+	if !resumed {
+		allObjects := s.storage.List()
+		initEvents = make([]antreastorage.InternalEvent, 0, len(allObjects))
+		for _, obj := range allObjects {
+			// Objects retrieved from storage have been verified with keyFunc when they are inserted.
+			key, _ := s.keyFunc(obj)
+			// Check whether the watcher is interested in this object, don't generate an initEvent if not.
+			if s.selectFunc != nil && !s.selectFunc(selectors, key, obj) {
+				continue
+			}
 
-		event, err := s.genEventFunc(key, nil, obj, s.resourceVersion)
-		if err != nil {
-			return nil, err
+			event, err := s.genEventFunc(key, nil, obj, s.resourceVersion)
+			if err != nil {
+				return nil, err
+			}
+			initEvents = append(initEvents, event)
 		}
-		initEvents = append(initEvents, event)
 	}
 
 	watcher := func() *storeWatcher {
@@ -264,13 +337,17 @@ func (s *store) Watch(ctx context.Context, key string, labelSelector labels.Sele
 		defer s.watcherMutex.Unlock()
 
 		w := newStoreWatcher(watcherChanSize, selectors, forgetWatcher(s, s.watcherIdx), s.newFunc)
+		w.resumed = resumed
+		if options.AllowBookmarks {
+			w.formatResourceVersion = s.formatResourceVersion
+		}
 		s.watchers[s.watcherIdx] = w
 		s.watcherIdx++
 		return w
 	}()
 
 	// Specify current resourceVersion so that old events that were currently buffered in incoming channel won't be
-	// delivered to the watcher twice when initEvents already have them.
+	// delivered to the watcher twice when initEvents already have them, or when they are replayed to resume the watch.
 	go watcher.process(ctx, initEvents, s.resourceVersion)
 	return watcher, nil
 }
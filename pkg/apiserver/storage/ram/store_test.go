@@ -32,6 +32,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	clocktesting "k8s.io/utils/clock/testing"
 
+	"antrea.io/antrea/pkg/apis"
 	antreastorage "antrea.io/antrea/pkg/apiserver/storage"
 )
 
@@ -543,3 +544,94 @@ func TestRamStoreWatchTimeout(t *testing.T) {
 
 	assert.Equal(t, 1, store.GetWatchersNum(), "Unexpected watchers number")
 }
+
+func TestRamStoreWatchResume(t *testing.T) {
+	store := NewStore(cache.MetaNamespaceKeyFunc, cache.Indexers{}, testGenEvent, testSelectFunc, func() runtime.Object { return new(v1.Pod) })
+	newPod := func(name, app string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"app": app}}}
+	}
+	// receiveUntilBookmark returns the events received before the next bookmark event, and the bookmark event.
+	receiveUntilBookmark := func(t *testing.T, w watch.Interface) ([]watch.Event, *v1.Pod) {
+		var events []watch.Event
+		for {
+			select {
+			case event := <-w.ResultChan():
+				if event.Type == watch.Bookmark {
+					return events, event.Object.(*v1.Pod)
+				}
+				events = append(events, event)
+			case <-time.After(time.Second):
+				t.Fatalf("Timed out waiting for bookmark event, received events: %v", events)
+			}
+		}
+	}
+	// receiveUntilResourceVersion returns the events received before the bookmark event with the provided
+	// resourceVersion. The watcher may send other bookmark events before it, depending on when the events are
+	// processed.
+	receiveUntilResourceVersion := func(t *testing.T, w watch.Interface, resourceVersion string) []watch.Event {
+		var allEvents []watch.Event
+		for {
+			events, bookmark := receiveUntilBookmark(t, w)
+			allEvents = append(allEvents, events...)
+			if bookmark.ResourceVersion == resourceVersion {
+				return allEvents
+			}
+		}
+	}
+
+	store.Create(newPod("pod1", "nginx1"))
+	w1, err := store.WatchWithOptions(context.Background(), "", labels.Everything(), fields.Everything(), antreastorage.WatchOptions{AllowBookmarks: true})
+	assert.NoError(t, err)
+	events, bookmark := receiveUntilBookmark(t, w1)
+	assert.Equal(t, []watch.Event{{Type: watch.Added, Object: newPod("pod1", "nginx1")}}, events)
+	assert.Equal(t, store.formatResourceVersion(1), bookmark.ResourceVersion)
+
+	store.Create(newPod("pod2", "nginx1"))
+	store.Update(newPod("pod1", "nginx2"))
+	events = receiveUntilResourceVersion(t, w1, store.formatResourceVersion(3))
+	assert.Equal(t, []watch.Event{
+		{Type: watch.Added, Object: newPod("pod2", "nginx1")},
+		{Type: watch.Modified, Object: newPod("pod1", "nginx2")},
+	}, events)
+	w1.Stop()
+
+	// Only the events generated since the previous watch are sent when resuming it.
+	store.Delete("pod2")
+	store.Create(newPod("pod3", "nginx1"))
+	w2, err := store.WatchWithOptions(context.Background(), "", labels.Everything(), fields.Everything(), antreastorage.WatchOptions{ResourceVersion: store.formatResourceVersion(3), AllowBookmarks: true})
+	assert.NoError(t, err)
+	events, bookmark = receiveUntilBookmark(t, w2)
+	assert.Empty(t, events)
+	assert.Equal(t, "true", bookmark.Annotations[apis.WatchResumedAnnotationKey])
+	events = receiveUntilResourceVersion(t, w2, store.formatResourceVersion(5))
+	assert.Equal(t, []watch.Event{
+		{Type: watch.Deleted, Object: newPod("pod2", "nginx1")},
+		{Type: watch.Added, Object: newPod("pod3", "nginx1")},
+	}, events)
+	w2.Stop()
+
+	expectedInitEvents := []watch.Event{
+		{Type: watch.Added, Object: newPod("pod1", "nginx2")},
+		{Type: watch.Added, Object: newPod("pod3", "nginx1")},
+	}
+	// The resourceVersion of another instance of the store cannot be used to resume the watch.
+	w3, err := store.WatchWithOptions(context.Background(), "", labels.Everything(), fields.Everything(), antreastorage.WatchOptions{ResourceVersion: "other.3", AllowBookmarks: true})
+	assert.NoError(t, err)
+	events, bookmark = receiveUntilBookmark(t, w3)
+	assert.ElementsMatch(t, expectedInitEvents, events)
+	assert.Empty(t, bookmark.Annotations)
+	assert.Equal(t, store.formatResourceVersion(5), bookmark.ResourceVersion)
+	w3.Stop()
+
+	// The watch cannot be resumed once the events generated since the previous watch are no longer in the history.
+	for i := 0; i < eventHistorySize; i++ {
+		store.Update(newPod("pod1", fmt.Sprintf("nginx%d", i%2)))
+	}
+	store.Update(newPod("pod1", "nginx2"))
+	w4, err := store.WatchWithOptions(context.Background(), "", labels.Everything(), fields.Everything(), antreastorage.WatchOptions{ResourceVersion: store.formatResourceVersion(5), AllowBookmarks: true})
+	assert.NoError(t, err)
+	events, bookmark = receiveUntilBookmark(t, w4)
+	assert.ElementsMatch(t, expectedInitEvents, events)
+	assert.Empty(t, bookmark.Annotations)
+	w4.Stop()
+}
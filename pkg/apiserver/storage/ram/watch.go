@@ -18,14 +18,20 @@ import (
 	"context"
 	"sync"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/clock"
 
+	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/apiserver/storage"
 )
 
+// maxSkippedEventsBeforeBookmark is the number of events a watcher is not interested in after which a bookmark event
+// is sent to it, so that the resourceVersion known by the client stays within the history of the store.
+const maxSkippedEventsBeforeBookmark = eventHistorySize / 2
+
 type bookmarkEvent struct {
 	resourceVersion uint64
 	object          runtime.Object
@@ -54,6 +60,12 @@ type storeWatcher struct {
 	stopOnce sync.Once
 	// newFunc is a function that creates new empty object of this type.
 	newFunc func() runtime.Object
+	// resumed indicates whether the watch resumes a previous one, in which case the init events are the events
+	// generated since then instead of the whole set of objects.
+	resumed bool
+	// formatResourceVersion formats the resourceVersion of bookmark events. If nil, the client doesn't want bookmark
+	// events other than the one indicating the end of init events, and the resourceVersion is not set.
+	formatResourceVersion func(resourceVersion uint64) string
 }
 
 func newStoreWatcher(chanSize int, selectors *storage.Selectors, forget func(), newFunc func() runtime.Object) *storeWatcher {
@@ -100,21 +112,56 @@ func (w *storeWatcher) add(event storage.InternalEvent, timer clock.Timer) bool
 	}
 }
 
+// newBookmarkEvent returns a bookmark event carrying the provided resourceVersion, if the client wants it.
+func (w *storeWatcher) newBookmarkEvent(resourceVersion uint64) *bookmarkEvent {
+	obj := w.newFunc()
+	if w.formatResourceVersion != nil {
+		if accessor, err := meta.Accessor(obj); err == nil {
+			accessor.SetResourceVersion(w.formatResourceVersion(resourceVersion))
+		}
+	}
+	return &bookmarkEvent{resourceVersion, obj}
+}
+
+// newResumedBookmarkEvent returns a bookmark event annotated to indicate that the watch resumes a previous one. It
+// doesn't carry a resourceVersion as the client already knows the one it resumes the watch from.
+func (w *storeWatcher) newResumedBookmarkEvent() *bookmarkEvent {
+	obj := w.newFunc()
+	if accessor, err := meta.Accessor(obj); err == nil {
+		accessor.SetAnnotations(map[string]string{apis.WatchResumedAnnotationKey: "true"})
+	}
+	return &bookmarkEvent{0, obj}
+}
+
 // process first sends initEvents and then keeps sending events got from channel input
 // if they are newer than the specified resourceVersion.
 func (w *storeWatcher) process(ctx context.Context, initEvents []storage.InternalEvent, resourceVersion uint64) {
-	for _, event := range initEvents {
-		w.sendWatchEvent(event, true)
+	if w.resumed {
+		// Send a bookmark event annotated as resumed first, so that the client knows the following events are the ones
+		// generated since its previous watch, and must not replace its objects.
+		w.sendWatchEvent(w.newResumedBookmarkEvent(), true)
+		for _, event := range initEvents {
+			w.sendWatchEvent(event, false)
+		}
+		if w.formatResourceVersion != nil {
+			w.sendWatchEvent(w.newBookmarkEvent(resourceVersion), false)
+		}
+	} else {
+		for _, event := range initEvents {
+			w.sendWatchEvent(event, true)
+		}
+		// Send a bookmark event to indicate the end of initEvents. This is an
+		// unusual way to use the bookmark event, as it is meant to be used to
+		// refresh the last resource version of a client. Clients which don't
+		// resume watches need a way to know what the initial set of objects
+		// is, so that stale objects whose delete events were missed by the
+		// client (because the watch was down) can be deleted.
+		w.sendWatchEvent(w.newBookmarkEvent(resourceVersion), true)
 	}
-	// Send a dummy bookmark event to indicate the end of initEvents. This is
-	// an unusual way to use the bookmark event, as it is meant to be used to
-	// refresh the last resource version of a client. In Antrea we do not use
-	// resource version when restarting a watch, but we need a way to
-	// communicate to clients what the initial set of objects is, so that
-	// stale objects whose delete events were missed by the client (because
-	// the watch was down) can be deleted.
-	w.sendWatchEvent(&bookmarkEvent{resourceVersion, w.newFunc()}, true)
 	defer close(w.result)
+	// sentEvents and skippedEvents are the numbers of events sent to the client and skipped since the last bookmark
+	// event.
+	sentEvents, skippedEvents := 0, 0
 	for {
 		select {
 		case event, ok := <-w.input:
@@ -122,8 +169,19 @@ func (w *storeWatcher) process(ctx context.Context, initEvents []storage.Interna
 				klog.V(4).Info("The input channel has been closed, stopping process for watcher")
 				return
 			}
-			if event.GetResourceVersion() > resourceVersion {
-				w.sendWatchEvent(event, false)
+			if event.GetResourceVersion() <= resourceVersion {
+				continue
+			}
+			if w.sendWatchEvent(event, false) {
+				sentEvents++
+			} else {
+				skippedEvents++
+			}
+			// Send a bookmark event once all the pending events have been processed, so that the client can resume
+			// the watch from the last event it received.
+			if w.formatResourceVersion != nil && len(w.input) == 0 && (sentEvents > 0 || skippedEvents >= maxSkippedEventsBeforeBookmark) {
+				w.sendWatchEvent(w.newBookmarkEvent(event.GetResourceVersion()), false)
+				sentEvents, skippedEvents = 0, 0
 			}
 		case <-ctx.Done():
 			klog.V(4).Info("The context has been canceled, stopping process for watcher")
@@ -133,17 +191,17 @@ func (w *storeWatcher) process(ctx context.Context, initEvents []storage.Interna
 }
 
 // sendWatchEvent converts an InternalEvent to watch.Event based on the watcher's selectors.
-// It sends the converted event to result channel, if not nil.
-func (w *storeWatcher) sendWatchEvent(event storage.InternalEvent, isInitEvent bool) {
+// It sends the converted event to result channel, if not nil, and returns whether the watcher is interested in it.
+func (w *storeWatcher) sendWatchEvent(event storage.InternalEvent, isInitEvent bool) bool {
 	watchEvent := event.ToWatchEvent(w.selectors, isInitEvent)
 	if watchEvent == nil {
 		// Watcher is not interested in that object.
-		return
+		return false
 	}
 
 	select {
 	case <-w.done:
-		return
+		return true
 	default:
 	}
 
@@ -151,6 +209,7 @@ func (w *storeWatcher) sendWatchEvent(event storage.InternalEvent, isInitEvent b
 	case w.result <- *watchEvent:
 	case <-w.done:
 	}
+	return true
 }
 
 // ResultChan returns the channel for outgoing events to the client.
@@ -28,6 +28,7 @@ type CrdV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	BGPPoliciesGetter
 	ExternalNodesGetter
+	NamespaceSecurityPoliciesGetter
 	NodeLatencyMonitorsGetter
 	PacketCapturesGetter
 	SupportBundleCollectionsGetter
@@ -46,6 +47,10 @@ func (c *CrdV1alpha1Client) ExternalNodes(namespace string) ExternalNodeInterfac
 	return newExternalNodes(c, namespace)
 }
 
+func (c *CrdV1alpha1Client) NamespaceSecurityPolicies(namespace string) NamespaceSecurityPolicyInterface {
+	return newNamespaceSecurityPolicies(c, namespace)
+}
+
 func (c *CrdV1alpha1Client) NodeLatencyMonitors() NodeLatencyMonitorInterface {
 	return newNodeLatencyMonitors(c)
 }
@@ -34,6 +34,10 @@ func (c *FakeCrdV1alpha1) ExternalNodes(namespace string) v1alpha1.ExternalNodeI
 	return &FakeExternalNodes{c, namespace}
 }
 
+func (c *FakeCrdV1alpha1) NamespaceSecurityPolicies(namespace string) v1alpha1.NamespaceSecurityPolicyInterface {
+	return &FakeNamespaceSecurityPolicies{c, namespace}
+}
+
 func (c *FakeCrdV1alpha1) NodeLatencyMonitors() v1alpha1.NodeLatencyMonitorInterface {
 	return &FakeNodeLatencyMonitors{c}
 }
@@ -20,6 +20,8 @@ type BGPPolicyExpansion interface{}
 
 type ExternalNodeExpansion interface{}
 
+type NamespaceSecurityPolicyExpansion interface{}
+
 type NodeLatencyMonitorExpansion interface{}
 
 type PacketCaptureExpansion interface{}
@@ -38,10 +38,18 @@ func (c *FakeStatsV1alpha1) MulticastGroups() v1alpha1.MulticastGroupInterface {
 	return &FakeMulticastGroups{c}
 }
 
+func (c *FakeStatsV1alpha1) NamespaceTrafficStats() v1alpha1.NamespaceTrafficStatsInterface {
+	return &FakeNamespaceTrafficStats{c}
+}
+
 func (c *FakeStatsV1alpha1) NetworkPolicyStats(namespace string) v1alpha1.NetworkPolicyStatsInterface {
 	return &FakeNetworkPolicyStats{c, namespace}
 }
 
+func (c *FakeStatsV1alpha1) NodeInterfaceStats() v1alpha1.NodeInterfaceStatsInterface {
+	return &FakeNodeInterfaceStats{c}
+}
+
 func (c *FakeStatsV1alpha1) NodeLatencyStats() v1alpha1.NodeLatencyStatsInterface {
 	return &FakeNodeLatencyStats{c}
 }
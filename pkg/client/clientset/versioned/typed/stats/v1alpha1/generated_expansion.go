@@ -22,6 +22,10 @@ type AntreaNetworkPolicyStatsExpansion interface{}
 
 type MulticastGroupExpansion interface{}
 
+type NamespaceTrafficStatsExpansion interface{}
+
 type NetworkPolicyStatsExpansion interface{}
 
+type NodeInterfaceStatsExpansion interface{}
+
 type NodeLatencyStatsExpansion interface{}
@@ -29,7 +29,9 @@ type StatsV1alpha1Interface interface {
 	AntreaClusterNetworkPolicyStatsGetter
 	AntreaNetworkPolicyStatsGetter
 	MulticastGroupsGetter
+	NamespaceTrafficStatsGetter
 	NetworkPolicyStatsGetter
+	NodeInterfaceStatsGetter
 	NodeLatencyStatsGetter
 }
 
@@ -50,10 +52,18 @@ func (c *StatsV1alpha1Client) MulticastGroups() MulticastGroupInterface {
 	return newMulticastGroups(c)
 }
 
+func (c *StatsV1alpha1Client) NamespaceTrafficStats() NamespaceTrafficStatsInterface {
+	return newNamespaceTrafficStats(c)
+}
+
 func (c *StatsV1alpha1Client) NetworkPolicyStats(namespace string) NetworkPolicyStatsInterface {
 	return newNetworkPolicyStats(c, namespace)
 }
 
+func (c *StatsV1alpha1Client) NodeInterfaceStats() NodeInterfaceStatsInterface {
+	return newNodeInterfaceStats(c)
+}
+
 func (c *StatsV1alpha1Client) NodeLatencyStats() NodeLatencyStatsInterface {
 	return newNodeLatencyStats(c)
 }
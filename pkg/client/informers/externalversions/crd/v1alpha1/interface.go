@@ -26,6 +26,8 @@ type Interface interface {
 	BGPPolicies() BGPPolicyInformer
 	// ExternalNodes returns a ExternalNodeInformer.
 	ExternalNodes() ExternalNodeInformer
+	// NamespaceSecurityPolicies returns a NamespaceSecurityPolicyInformer.
+	NamespaceSecurityPolicies() NamespaceSecurityPolicyInformer
 	// NodeLatencyMonitors returns a NodeLatencyMonitorInformer.
 	NodeLatencyMonitors() NodeLatencyMonitorInformer
 	// PacketCaptures returns a PacketCaptureInformer.
@@ -55,6 +57,11 @@ func (v *version) ExternalNodes() ExternalNodeInformer {
 	return &externalNodeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NamespaceSecurityPolicies returns a NamespaceSecurityPolicyInformer.
+func (v *version) NamespaceSecurityPolicies() NamespaceSecurityPolicyInformer {
+	return &namespaceSecurityPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // NodeLatencyMonitors returns a NodeLatencyMonitorInformer.
 func (v *version) NodeLatencyMonitors() NodeLatencyMonitorInformer {
 	return &nodeLatencyMonitorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -28,6 +28,14 @@ type ExternalNodeListerExpansion interface{}
 // ExternalNodeNamespaceLister.
 type ExternalNodeNamespaceListerExpansion interface{}
 
+// NamespaceSecurityPolicyListerExpansion allows custom methods to be added to
+// NamespaceSecurityPolicyLister.
+type NamespaceSecurityPolicyListerExpansion interface{}
+
+// NamespaceSecurityPolicyNamespaceListerExpansion allows custom methods to be added to
+// NamespaceSecurityPolicyNamespaceLister.
+type NamespaceSecurityPolicyNamespaceListerExpansion interface{}
+
 // NodeLatencyMonitorListerExpansion allows custom methods to be added to
 // NodeLatencyMonitorLister.
 type NodeLatencyMonitorListerExpansion interface{}
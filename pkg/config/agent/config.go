@@ -82,6 +82,12 @@ type AgentConfig struct {
 	// Default is false. It should only be set to true when you are using an unpatched Linux kernel and observing poor
 	// transfer performance.
 	TunnelCsum bool `yaml:"tunnelCsum,omitempty"`
+	// Enable setting the tunnel ID, i.e. the VNI of Geneve and VXLAN, of the tunneled traffic of Pods according to the
+	// "namespace.antrea.io/tunnel-vni" annotation of their Namespaces, so that the devices of the underlay network and
+	// observability tools can attribute overlay traffic to tenants. It only supports the Geneve and VXLAN tunnel types,
+	// and cannot be used together with traffic encryption or Multi-cluster StretchedNetworkPolicy, which rely on the
+	// tunnel ID for other purposes. Default is false.
+	EnableNamespaceTunnelVNI bool `yaml:"enableNamespaceTunnelVNI,omitempty"`
 	// Default MTU to use for the host gateway interface and the network interface of each Pod.
 	// If omitted, antrea-agent will discover the MTU of the Node's primary interface and
 	// also adjust MTU to accommodate for tunnel encapsulation overhead (if applicable).
@@ -163,6 +169,21 @@ type AgentConfig struct {
 	// The Cluster administrators should configure this value, ideally setting it to be equal to or greater than the maximum TTL
 	// value of the application's DNS cache.
 	FQDNCacheMinTTL int `yaml:"fqdnCacheMinTTL,omitempty"`
+	// The duration in seconds for which an IP resolved for a FQDN is still allowed after it has expired and is no
+	// longer included in the DNS responses for the FQDN, so that the connections of applications which have not
+	// refreshed their DNS cache yet are not denied.
+	// Defaults to 0.
+	FQDNCacheStaleTTL int `yaml:"fqdnCacheStaleTTL,omitempty"`
+	// Static IPs for FQDNs, which are always considered resolved for the FQDN by FQDN policy rules, in addition to
+	// the IPs resolved from DNS responses. The keys must be FQDNs without wildcard.
+	FQDNStaticIPs map[string][]string `yaml:"fqdnStaticIPs,omitempty"`
+	// Enable setting the "antrea.io/network-policies-realized" condition of the Pods which include it in their
+	// readinessGates, once all the NetworkPolicies applied to them have been realized by the agent.
+	// Defaults to false.
+	EnablePolicyReadinessGate bool `yaml:"enablePolicyReadinessGate,omitempty"`
+	// DNSResolverRestriction configures the restriction of the DNS queries of the Pods annotated with
+	// "pod.antrea.io/dns-resolvers" to the resolvers allowed by the annotation.
+	DNSResolverRestriction DNSResolverRestrictionConfig `yaml:"dnsResolverRestriction,omitempty"`
 	// Cipher suites to use.
 	TLSCipherSuites string `yaml:"tlsCipherSuites,omitempty"`
 	// TLS min version.
@@ -208,6 +229,111 @@ type AgentConfig struct {
 	// second(pps) and the burst size will be automatically set to twice the rate.
 	// When the rate and burst size are exceeded, new packets will be dropped.
 	PacketInRate int `yaml:"packetInRate,omitempty"`
+	// CPUAffinity configures NUMA-aware CPU placement for the OVS datapath threads and the
+	// antrea-agent packet-in processing threads. Linux only.
+	CPUAffinity CPUAffinityConfig `yaml:"cpuAffinity,omitempty"`
+	// SelfProfiling configures the automatic capture of CPU and heap profiles of antrea-agent
+	// when its resource usage exceeds the configured thresholds.
+	SelfProfiling SelfProfilingConfig `yaml:"selfProfiling,omitempty"`
+	// ServiceProbe configures the periodic probing of Services from the Node, with the results
+	// exported as Prometheus metrics.
+	ServiceProbe ServiceProbeConfig `yaml:"serviceProbe,omitempty"`
+	// FastpathQueue configures the device plugin which exposes the "antrea.io/fastpath-queue"
+	// resource, used by Pods to cap the rate of the traffic they send. Linux only.
+	FastpathQueue FastpathQueueConfig `yaml:"fastpathQueue,omitempty"`
+	// MultipathRouting configures ECMP routing of the Pod traffic to peer Nodes through multiple
+	// uplinks in noEncap mode. Linux only.
+	MultipathRouting MultipathRoutingConfig `yaml:"multipathRouting,omitempty"`
+}
+
+type DNSResolverRestrictionConfig struct {
+	// Enable dropping the DNS queries, over both UDP and TCP, sent by the Pods annotated with
+	// "pod.antrea.io/dns-resolvers" to other resolvers than the ones allowed by the annotation.
+	// Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// The IPs of the cluster DNS resolvers, which are allowed for the Pods whose annotation
+	// includes "cluster". Defaults to the ClusterIP of the kube-dns Service.
+	ClusterResolvers []string `yaml:"clusterResolvers,omitempty"`
+}
+
+type MultipathRoutingConfig struct {
+	// Enable ECMP routes to the peer Nodes reachable through multiple uplinks. Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// The names of the uplink interfaces of the Node, in addition to the transport interface,
+	// through which the peer Nodes can be reached. A path through an uplink is used for a peer
+	// Node if the peer Node has an address in the subnet of the uplink.
+	UplinkInterfaces []string `yaml:"uplinkInterfaces,omitempty"`
+	// The interval between two consecutive probes of the paths to the peer Nodes. A path is
+	// removed from the routes after 3 consecutive failed probes, and restored after a successful
+	// probe. Defaults to "5s".
+	HealthCheckInterval string `yaml:"healthCheckInterval,omitempty"`
+}
+
+type FastpathQueueConfig struct {
+	// Enable the device plugin. Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// The number of "antrea.io/fastpath-queue" units advertised to kubelet. Defaults to 8.
+	Count int `yaml:"count,omitempty"`
+	// The rate of the traffic sent by a Pod, per unit allocated to it, e.g. "1G" for 1 Gbps.
+	// Defaults to "1G".
+	Rate string `yaml:"rate,omitempty"`
+	// The burst size of the traffic sent by a Pod, per unit allocated to it, e.g. "100M" for
+	// 100 Mb. Defaults to "100M".
+	Burst string `yaml:"burst,omitempty"`
+}
+
+type ServiceProbeConfig struct {
+	// Enable the Service reachability prober. Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// The Services to probe, in the "<namespace>/<name>" format. The Services annotated with
+	// "service.antrea.io/probe: true" are probed as well.
+	Services []string `yaml:"services,omitempty"`
+	// The interval between two rounds of probes. Defaults to "30s".
+	Interval string `yaml:"interval,omitempty"`
+	// The timeout of a single probe. Defaults to "5s".
+	Timeout string `yaml:"timeout,omitempty"`
+	// The path of a network namespace (e.g. "/var/run/netns/probe") from which the Services are
+	// also probed, in addition to the Node network namespace. Linux only. Defaults to "", which
+	// means the Services are only probed from the Node network namespace.
+	NetworkNamespace string `yaml:"networkNamespace,omitempty"`
+}
+
+type SelfProfilingConfig struct {
+	// Enable the automatic capture of profiles. Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// The CPU usage of antrea-agent, as a percentage of one CPU core, above which profiles are
+	// captured. Defaults to 200.
+	CPUThreshold int `yaml:"cpuThreshold,omitempty"`
+	// The memory usage of antrea-agent in MiB, above which profiles are captured. Defaults to 1024.
+	MemoryThreshold int `yaml:"memoryThreshold,omitempty"`
+	// The interval at which the resource usage of antrea-agent is checked. Defaults to "10s".
+	CheckInterval string `yaml:"checkInterval,omitempty"`
+	// The duration of the captured CPU profiles. Defaults to "30s".
+	CPUProfileDuration string `yaml:"cpuProfileDuration,omitempty"`
+	// The minimum interval between two captures. Defaults to "1h".
+	MinCaptureInterval string `yaml:"minCaptureInterval,omitempty"`
+	// The maximum number of profiles of each kind retained on the Node. The retained profiles
+	// are included in the support bundles collected from antrea-agent. Defaults to 3.
+	MaxProfiles int `yaml:"maxProfiles,omitempty"`
+}
+
+type CPUAffinityConfig struct {
+	// Enable NUMA-aware CPU affinity management. Defaults to false.
+	Enable bool `yaml:"enable,omitempty"`
+	// The CPUs on which OVS PMD threads can run, set as other_config:pmd-cpu-mask of OVS. It only
+	// takes effect with the netdev (userspace) datapath. It can be a CPU list in the Linux format
+	// (e.g. "2-5,8"), or "auto" to use the CPUs of the NUMA node of the transport interface.
+	// Defaults to "", which means OVS will not be configured.
+	OVSPMDCPUs string `yaml:"ovsPMDCPUs,omitempty"`
+	// The number of OVS handler threads, set as other_config:n-handler-threads of OVS.
+	// Defaults to 0, which means OVS will choose the number based on the number of CPUs.
+	OVSHandlerThreads int `yaml:"ovsHandlerThreads,omitempty"`
+	// The number of OVS revalidator threads, set as other_config:n-revalidator-threads of OVS.
+	// Defaults to 0, which means OVS will choose the number based on the number of CPUs.
+	OVSRevalidatorThreads int `yaml:"ovsRevalidatorThreads,omitempty"`
+	// Pin the threads processing packet-in messages to the CPUs of the NUMA node of the transport
+	// interface. Defaults to false.
+	AlignPacketInWithNIC bool `yaml:"alignPacketInWithNIC,omitempty"`
 }
 
 type AntreaProxyConfig struct {
@@ -247,6 +373,18 @@ type AntreaProxyConfig struct {
 	// conditions between kube-proxy and Antrea proxy, with both trying to bind to the same addresses, when proxyAll
 	// is enabled while kube-proxy has not been removed.
 	DisableServiceHealthCheckServer bool `yaml:"disableServiceHealthCheckServer,omitempty"`
+	// Reject new TCP and UDP connections to the IPs in the Service CIDR which are not allocated to any Service, by
+	// replying with a TCP RST or an ICMP unreachable message, instead of dropping the packets silently. The Service
+	// CIDR is discovered from the ClusterIPs of existing Services. This option cannot be used along with skipServices
+	// or serviceProxyName, as the ClusterIPs of the Services ignored by AntreaProxy would be rejected. Defaults to
+	// false.
+	RejectUnallocatedClusterIPs bool `yaml:"rejectUnallocatedClusterIPs,omitempty"`
+	// The name of the ExternalIPPool from which a dedicated IP is allocated to the Node by antrea-controller, to SNAT
+	// the external traffic of Services with externalTrafficPolicy Cluster when it is forwarded to an Endpoint on
+	// another Node, so that the Endpoints can distinguish such traffic from the traffic originated from Nodes. If it
+	// is empty, or until the IP is allocated, the IP of the Antrea gateway interface is used. It requires the encap
+	// mode, and the Egress or ServiceExternalIP feature to be enabled in antrea-controller.
+	ExternalTrafficSNATIPPool string `yaml:"externalTrafficSNATIPPool,omitempty"`
 }
 
 type WireGuardConfig struct {
@@ -279,7 +417,9 @@ type FlowExporterConfig struct {
 	// wrapped with []. When the collector is running in-cluster as a Service, set
 	// <HOST> to <Service namespace>/<Service name>. For example,
 	// "flow-aggregator/flow-aggregator" can be provided to connect to the Antrea
-	// Flow Aggregator Service.
+	// Flow Aggregator Service. If the Service is headless, e.g.
+	// "flow-aggregator/flow-aggregator-headless" when the Flow Aggregator is
+	// sharded, one of its endpoints is selected based on the Node name.
 	// If PORT is empty, we default to 4739, the standard IPFIX port.
 	// If no PROTO is given, we consider "tcp" as default. We support "tcp" and
 	// "udp" L4 transport protocols.
@@ -306,6 +446,78 @@ type FlowExporterConfig struct {
 	// Defaults to "15s". Valid time units are "ns", "us" (or "µs"), "ms", "s",
 	// "m", "h".
 	IdleFlowExportTimeout string `yaml:"idleFlowExportTimeout,omitempty"`
+	// Provide rules to override the active and idle flow export timeouts for
+	// some traffic classes, e.g. to export short-lived DNS connections quickly
+	// while reducing the number of records exported for long-lived database
+	// connections. Rules are evaluated in order and the first rule matching a
+	// connection is used. Connections which do not match any rule use
+	// activeFlowExportTimeout and idleFlowExportTimeout.
+	TimeoutRules []FlowExportTimeoutRule `yaml:"timeoutRules,omitempty"`
+	// Only export the flows which matched a NetworkPolicy rule, whether it
+	// allowed or denied them, along with the references of the policies. All
+	// the other flows are dropped by the agent, which can reduce the volume of
+	// exported records significantly when only policy enforcement is audited.
+	// Defaults to false.
+	PolicyFlowsOnly bool `yaml:"policyFlowsOnly,omitempty"`
+	// SPIFFE enables using an X.509 SVID issued by a SPIFFE implementation (e.g.
+	// SPIRE) to authenticate to the flow aggregator when the "tls" protocol is
+	// used, instead of the client certificate generated by the flow aggregator.
+	SPIFFE FlowExporterSPIFFEConfig `yaml:"spiffe,omitempty"`
+	// OTLP enables exporting flow records directly to an OpenTelemetry collector
+	// using OTLP over gRPC, instead of exporting IPFIX records to the collector
+	// configured with flowCollectorAddr.
+	OTLP FlowExporterOTLPConfig `yaml:"otlp,omitempty"`
+}
+
+type FlowExporterOTLPConfig struct {
+	// Enable exporting flow records as OTLP log records.
+	Enable bool `yaml:"enable,omitempty"`
+	// Provide the address of the OTLP gRPC endpoint of the OpenTelemetry
+	// collector as a string with format <HOST>[:<PORT>]. When the collector is
+	// running in-cluster as a Service, set <HOST> to <Service namespace>/<Service
+	// name>. If PORT is empty, we default to 4317, the standard OTLP gRPC port.
+	Endpoint string `yaml:"endpoint,omitempty"`
+	// Connect to the collector without TLS. When TLS is used, the certificate of
+	// the collector is verified using the system root CAs.
+	// Defaults to false.
+	Insecure bool `yaml:"insecure,omitempty"`
+	// Attach the labels of the source and destination Pods to the exported
+	// records, as "source.k8s.pod.label.<key>" and
+	// "destination.k8s.pod.label.<key>" attributes.
+	// Defaults to false.
+	PodLabels bool `yaml:"podLabels,omitempty"`
+}
+
+type FlowExporterSPIFFEConfig struct {
+	// Enable using an X.509 SVID and the trust bundle of the SPIFFE trust domain
+	// to connect to the flow aggregator.
+	Enable bool `yaml:"enable,omitempty"`
+	// The directory in which the X.509 SVID (svid.pem), its private key
+	// (svid_key.pem) and the trust bundle (svid_bundle.pem) are written and kept
+	// up-to-date, e.g. by a spiffe-helper sidecar. The connection
+	// to the flow aggregator is re-established when the SVID is rotated.
+	// Defaults to "/run/spiffe/certs".
+	CertDir string `yaml:"certDir,omitempty"`
+	// The SPIFFE IDs the flow aggregator is authorized to have. An ID without
+	// path, e.g. "spiffe://example.org", authorizes all the IDs of the trust
+	// domain. At least one ID must be provided.
+	AuthorizedServerIDs []string `yaml:"authorizedServerIDs,omitempty"`
+}
+
+type FlowExportTimeoutRule struct {
+	// The protocol of the connections matched by the rule: "TCP", "UDP" or
+	// "SCTP". All protocols are matched if it is empty.
+	Protocol string `yaml:"protocol,omitempty"`
+	// The destination ports of the connections matched by the rule. For
+	// connections to Services, both the Service port and the Endpoint port are
+	// considered. All ports are matched if it is empty.
+	DestinationPorts []int `yaml:"destinationPorts,omitempty"`
+	// The active flow export timeout of the matched connections. Defaults to
+	// activeFlowExportTimeout.
+	ActiveFlowExportTimeout string `yaml:"activeFlowExportTimeout,omitempty"`
+	// The idle flow export timeout of the matched connections. Defaults to
+	// idleFlowExportTimeout.
+	IdleFlowExportTimeout string `yaml:"idleFlowExportTimeout,omitempty"`
 }
 
 type MulticastConfig struct {
@@ -334,6 +546,12 @@ type EgressConfig struct {
 	// same value as for the top-level snatFullyRandomPorts configuration, but this field can be
 	// used as an override.
 	SNATFullyRandomPorts *bool `yaml:"snatFullyRandomPorts,omitempty"`
+	// The period after an Egress IP is assigned to a Node during which it is kept on this Node, as long as the Node is
+	// still eligible, even if the consistent hash selects another Node (e.g. when agents restart). Assignments are read
+	// from the Egress status, so all agents make the same decision. This prevents Egress IPs from moving between Nodes
+	// when many agents restart at the same time, e.g. during upgrades. Valid time units are
+	// "ns", "us" (or "µs"), "ms", "s", "m", "h". Defaults to "0s", which disables it.
+	AssignmentStabilizationWindow string `yaml:"assignmentStabilizationWindow,omitempty"`
 }
 
 type IPsecConfig struct {
@@ -341,6 +559,33 @@ type IPsecConfig struct {
 	// - psk (default): Use pre-shared key (PSK) for IKE authentication.
 	// - cert:          Use CA-signed certificates for IKE authentication.
 	AuthenticationMode string `yaml:"authenticationMode,omitempty"`
+	// The source of the pre-shared keys (PSKs) when authenticationMode is "psk". It has the following options:
+	// - env (default): Use a single cluster-wide PSK, passed to Antrea Agent through the ANTREA_IPSEC_PSK
+	//                  environment variable.
+	// - secret:        Use per-Node-pair PSKs stored in the "antrea-ipsec-psks" Secret in the Antrea Namespace.
+	// - vault:         Use per-Node-pair PSKs stored in a HashiCorp Vault KV version 2 secret.
+	PSKSource string `yaml:"pskSource,omitempty"`
+	// The label of Nodes identifying their zone, used to look up the PSK of a Node pair when pskSource is
+	// "secret" or "vault". Defaults to "topology.kubernetes.io/zone".
+	PSKZoneLabel string `yaml:"pskZoneLabel,omitempty"`
+	// The configuration of the Vault server storing the PSKs when pskSource is "vault".
+	Vault IPsecVaultConfig `yaml:"vault,omitempty"`
+}
+
+type IPsecVaultConfig struct {
+	// The address of the Vault server, e.g. "https://vault.example.com:8200".
+	Address string `yaml:"address,omitempty"`
+	// The mount path of the KV version 2 secrets engine. Defaults to "secret".
+	MountPath string `yaml:"mountPath,omitempty"`
+	// The path of the secret storing the PSKs, relative to the mount path.
+	Path string `yaml:"path,omitempty"`
+	// The file containing the token used to authenticate to the Vault server. It is read again every time the
+	// PSKs are refreshed, so that the token can be renewed.
+	TokenFile string `yaml:"tokenFile,omitempty"`
+	// The file containing the CA certificate used to verify the Vault server. If empty, the system CAs are used.
+	CACertFile string `yaml:"caCertFile,omitempty"`
+	// The interval at which the PSKs are read from the Vault server. Defaults to "1m".
+	RefreshInterval string `yaml:"refreshInterval,omitempty"`
 }
 
 type MulticlusterConfig struct {
@@ -375,6 +620,22 @@ type ExternalNodeConfig struct {
 	// direction (ingress|egress), protocol(tcp/udp/icmp/ip), remote CIDR, dst port (ICMP doesn't require),
 	// It is used only when NodeType is externalNode.
 	PolicyBypassRules []PolicyBypassRule `yaml:"policyBypassRules,omitempty"`
+	// Self-registration of the ExternalNode by antrea-agent.
+	// It is used only when NodeType is externalNode.
+	Registration ExternalNodeRegistrationConfig `yaml:"registration,omitempty"`
+}
+
+type ExternalNodeRegistrationConfig struct {
+	// Enable antrea-agent to create the ExternalNode for the VM or baremetal server if it does not exist.
+	// The created ExternalNode is realized only after it is approved according to the approval policy of
+	// antrea-controller.
+	Enable bool `yaml:"enable,omitempty"`
+	// The name of the network interface to be guarded by Antrea NetworkPolicy. Its IPv4 addresses are used
+	// as the IPs of the ExternalNode interface. It must be provided when registration is enabled.
+	Interface string `yaml:"interface,omitempty"`
+	// The labels of the created ExternalNode. Label values are Go templates which may reference the
+	// following fields: {{.NodeName}}, {{.Hostname}} and {{.OS}}.
+	Labels map[string]string `yaml:"labels,omitempty"`
 }
 
 type PolicyBypassRule struct {
@@ -400,11 +661,27 @@ type AuditLoggingConfig struct {
 	MaxAge *int32 `yaml:"maxAge,omitempty"`
 	// Compress enables gzip compression on rotated files. Defaults to true.
 	Compress *bool `yaml:"compress,omitempty"`
+	// Format is the format of audit logs. Supported values are "text" and "json". Defaults to "text".
+	Format string `yaml:"format,omitempty"`
+	// SamplingRate means that only 1 out of SamplingRate packets is logged for each rule. Defaults to 1, i.e. all
+	// packets are logged.
+	SamplingRate int32 `yaml:"samplingRate,omitempty"`
+	// RuleSamplingRates overrides SamplingRate for the rules with the given log labels. The keys are log labels and
+	// the values are sampling rates.
+	RuleSamplingRates map[string]int32 `yaml:"ruleSamplingRates,omitempty"`
+	// Destination is where audit logs are written to. Supported values are "file", "unixSocket" and "syslog".
+	// Defaults to "file". The rotation options only apply to "file".
+	Destination string `yaml:"destination,omitempty"`
+	// Address is the path of the Unix domain socket when Destination is "unixSocket", or the address of the syslog
+	// endpoint in the "<network>://<address>" form when Destination is "syslog", e.g. "udp://10.0.0.1:514" or
+	// "unixgram:///dev/log".
+	Address string `yaml:"address,omitempty"`
 }
 
 type SecondaryNetworkConfig struct {
-	// Configuration of OVS bridges for secondary networks. At the moment, only a
-	// single OVS bridge is supported.
+	// Configuration of OVS bridges for secondary networks. The VLAN networks use
+	// the first bridge, unless another one is specified in their
+	// NetworkAttachmentDefinitions.
 	OVSBridges []OVSBridgeConfig `yaml:"ovsBridges,omitempty"`
 }
 
@@ -23,7 +23,8 @@ type NodeIPAMConfig struct {
 	// Defaults to false.
 	EnableNodeIPAM bool `yaml:"enableNodeIPAM,omitempty"`
 	// CIDR ranges for Pods in cluster. String array containing single CIDR range, or multiple ranges. The CIDRs could
-	// be either IPv4 or IPv6. At most one CIDR may be specified for each IP family. Value ignored when EnableNodeIPAM
+	// be either IPv4 or IPv6. The first CIDR of each IP family is used first, the following CIDRs of the same family are
+	// used once the previous ones are exhausted. CIDRs can be appended at runtime. Value ignored when EnableNodeIPAM
 	// is false.
 	ClusterCIDRs []string `yaml:"clusterCIDRs,omitempty"`
 	// CIDR ranges for Services in cluster. It is not necessary to specify it when there is no overlap with clusterCIDRs.
@@ -69,12 +70,93 @@ type ControllerConfig struct {
 	// does not run NodeIPAMController (replaced by Antrea NodeIPAM).
 	// Defaults to "". It must be a host string, a host:port pair, or a URL to the base of the apiserver.
 	KubeAPIServerOverride string `yaml:"kubeAPIServerOverride,omitempty"`
+	// The target duration for realizing an Antrea-native policy update on all the Nodes it spans, measured from the
+	// time antrea-controller observes the new generation of the policy. A Warning Event is emitted for the policies
+	// which are not realized within this duration.
+	// Defaults to "", which means SLO breaches are not reported.
+	NetworkPolicyRealizationSLO string `yaml:"networkPolicyRealizationSLO,omitempty"`
 	// NodeIPAM Configuration
 	NodeIPAM NodeIPAMConfig `yaml:"nodeIPAM"`
 	// IPsec CSR signer configuration
 	IPsecCSRSignerConfig IPsecCSRSignerConfig `yaml:"ipsecCSRSigner"`
 	// Multicluster configuration options.
 	Multicluster MulticlusterConfig `yaml:"multicluster,omitempty"`
+	// ExternalNode configuration options.
+	ExternalNode ExternalNodeConfig `yaml:"externalNode,omitempty"`
+	// ClusterGroupWebhook configuration options.
+	ClusterGroupWebhook ClusterGroupWebhookConfig `yaml:"clusterGroupWebhook,omitempty"`
+	// ACNPExemption configuration options.
+	ACNPExemption ACNPExemptionConfig `yaml:"acnpExemption,omitempty"`
+	// PodEgressIP configuration options.
+	PodEgressIP PodEgressIPConfig `yaml:"podEgressIP,omitempty"`
+	// NodeDrain configuration options.
+	NodeDrain NodeDrainConfig `yaml:"nodeDrain,omitempty"`
+}
+
+type NodeDrainConfig struct {
+	// Enable marking the Nodes which are cordoned, e.g. with "kubectl drain", with the "node.antrea.io/draining"
+	// annotation. antrea-agent then moves the Egress IPs and the Service external IPs off these Nodes right away,
+	// instead of waiting for the Nodes to be detected as failed once they are shut down. It requires the Egress or
+	// the ServiceExternalIP feature gate.
+	Enable bool `yaml:"enable,omitempty"`
+}
+
+type PodEgressIPConfig struct {
+	// Enable requesting a specific EgressIP for a Pod with the "egress.antrea.io/ip" and
+	// "egress.antrea.io/external-ip-pool" annotations. antrea-controller creates an Egress for each such Pod, and the
+	// name of the Egress is set in the "egress.antrea.io/egress" annotation of the Pod once the IP is allocated. It
+	// requires the Egress feature gate.
+	Enable bool `yaml:"enable,omitempty"`
+	// The ExternalIPPools the Pods in each Namespace are allowed to request an EgressIP from, keyed by the name of the
+	// Namespace. Requests from the Pods in the Namespaces which are not listed are rejected.
+	AllowedExternalIPPools map[string][]string `yaml:"allowedExternalIPPools,omitempty"`
+}
+
+type ACNPExemptionConfig struct {
+	// The names of the Namespaces which are never selected by the appliedTo of the ClusterNetworkPolicies in the
+	// Tiers below, e.g. to prevent a policy from breaking the cluster by isolating the system components.
+	Namespaces []string `yaml:"namespaces,omitempty"`
+	// A label selector in the "kubectl --selector" format (e.g. "antrea.io/acnp-exempt=true") selecting additional
+	// exempt Namespaces.
+	NamespaceSelector string `yaml:"namespaceSelector,omitempty"`
+	// The names of the Tiers whose ClusterNetworkPolicies are not applied to the exempt Namespaces. If empty, the
+	// ClusterNetworkPolicies of all Tiers are affected.
+	Tiers []string `yaml:"tiers,omitempty"`
+}
+
+type ClusterGroupWebhookConfig struct {
+	// Enable pushing the membership changes of ClusterGroups (IPs added and removed) to an external webhook, e.g.
+	// to keep the allow-lists of on-premises firewalls and API gateways in sync with the cluster. A bearer token
+	// can be provided in the "token" key of the "antrea-clustergroup-webhook" Secret, in the Namespace of
+	// antrea-controller.
+	Enable bool `yaml:"enable,omitempty"`
+	// The URL to which the membership updates are POSTed. It must use the https scheme.
+	URL string `yaml:"url,omitempty"`
+	// The names of the ClusterGroups whose membership is pushed. If empty, the membership of all ClusterGroups is
+	// pushed.
+	ClusterGroups []string `yaml:"clusterGroups,omitempty"`
+	// The PEM-encoded CA bundle used to verify the certificate of the webhook server. If empty, the system trust
+	// store is used.
+	CABundle string `yaml:"caBundle,omitempty"`
+	// The timeout of each request to the webhook. Defaults to "10s".
+	Timeout string `yaml:"timeout,omitempty"`
+	// The interval at which the full membership of all ClusterGroups is pushed again, even if it has not changed,
+	// so that the external systems can recover from lost updates. Set it to "0s" to disable resyncing.
+	// Defaults to "10m".
+	ResyncInterval string `yaml:"resyncInterval,omitempty"`
+}
+
+type ExternalNodeConfig struct {
+	// Determines how ExternalNodes are approved before they are realized. The approval state is recorded with
+	// the "externalnode.antrea.io/registration-state" annotation, which is set to "Pending" by antrea-agent
+	// when it registers its own ExternalNode. It has the following options:
+	// - Auto (default): ExternalNodes are approved if all their IPs are in AutoApprovalCIDRs. The others are
+	//   pending until approved manually.
+	// - Manual:         ExternalNodes must be approved manually by setting the annotation to "Approved".
+	// ExternalNodes with the annotation set to "Rejected" are never realized.
+	ApprovalMode string `yaml:"approvalMode,omitempty"`
+	// The CIDRs used to approve ExternalNodes in Auto mode. If empty, all ExternalNodes are approved in Auto mode.
+	AutoApprovalCIDRs []string `yaml:"autoApprovalCIDRs,omitempty"`
 }
 
 type MulticlusterConfig struct {
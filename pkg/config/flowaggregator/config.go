@@ -51,11 +51,25 @@ type FlowAggregatorConfig struct {
 	// Defaults to "90s". Valid time units are "ns", "us" (or "µs"), "ms", "s",
 	// "m", "h".
 	InactiveFlowRecordTimeout string `yaml:"inactiveFlowRecordTimeout,omitempty"`
+	// Provide the maximum number of flows stored by the flow aggregator in Aggregate mode. When
+	// the limit is reached, records for new flows are dropped, or spilled to disk if FlowSpill is
+	// enabled, until stored flows expire, while records for stored flows are still aggregated. This bounds memory usage, e.g., when flow
+	// records cannot be exported because the collector is unavailable. Dropped records are
+	// counted in the record metrics. Defaults to 0, which means no limit.
+	MaxFlows int `yaml:"maxFlows,omitempty"`
+	// FlowSpill contains configuration options for spilling the records for new flows to disk
+	// when maxFlows is reached, instead of dropping them.
+	FlowSpill FlowSpillConfig `yaml:"flowSpill,omitempty"`
 	// Transport protocol over which the aggregator collects IPFIX records from all Agents.
 	// Defaults to "tls"
 	AggregatorTransportProtocol AggregatorTransportProtocol `yaml:"aggregatorTransportProtocol,omitempty"`
 	// Provide an extra DNS name or IP address of flow aggregator for generating TLS certificate.
 	FlowAggregatorAddress string `yaml:"flowAggregatorAddress,omitempty"`
+	// SPIFFE contains configuration options for using an X.509 SVID issued by a SPIFFE implementation
+	// (e.g. SPIRE) when aggregatorTransportProtocol is "tls", instead of self-signed certificates.
+	SPIFFE SPIFFEConfig `yaml:"spiffe,omitempty"`
+	// Sharding contains configuration options for running multiple replicas of the flow aggregator.
+	Sharding ShardingConfig `yaml:"sharding,omitempty"`
 	// RecordContents enables configuring some fields in the flow records. Fields can be
 	// excluded to reduce record size.
 	RecordContents RecordContentsConfig `yaml:"recordContents,omitempty"`
@@ -69,10 +83,57 @@ type FlowAggregatorConfig struct {
 	S3Uploader S3UploaderConfig `yaml:"s3Uploader,omitempty"`
 	// FlowLogger contains configuration options for writing flow records to a local log file.
 	FlowLogger FlowLoggerConfig `yaml:"flowLogger,omitempty"`
+	// Sinks contains additional sinks to which flow records are exported, alongside the ones
+	// configured above. Each sink has its own configuration and filters, which makes it possible
+	// to export different subsets of flow records to different consumers, or to export flow
+	// records to multiple sinks of the same type.
+	Sinks []SinkConfig `yaml:"sinks,omitempty"`
 }
 
 type RecordContentsConfig struct {
 	PodLabels bool `yaml:"podLabels,omitempty"`
+	// PodOwners determines whether the kind and name of the workload (e.g. Deployment,
+	// StatefulSet) owning the source and destination Pods are included in flow records.
+	// This only applies to the ClickHouse, S3 and log exporters.
+	PodOwners bool `yaml:"podOwners,omitempty"`
+}
+
+type SPIFFEConfig struct {
+	// Enable is the switch to enable using an X.509 SVID to authenticate the flow aggregator, and
+	// the trust bundle of the SPIFFE trust domain to authenticate the flow exporters.
+	Enable bool `yaml:"enable,omitempty"`
+	// CertDir is the directory in which the X.509 SVID (svid.pem), its private key (svid_key.pem)
+	// and the trust bundle (svid_bundle.pem) are written and kept up-to-date, e.g. by a spiffe-helper
+	// sidecar. Defaults to "/run/spiffe/certs".
+	CertDir string `yaml:"certDir,omitempty"`
+	// AuthorizedClientIDs are the SPIFFE IDs the flow exporters are authorized to have. An ID without
+	// path, e.g. "spiffe://example.org", authorizes all the IDs of the trust domain. When empty, any
+	// flow exporter with an SVID signed by the trust bundle is accepted.
+	AuthorizedClientIDs []string `yaml:"authorizedClientIDs,omitempty"`
+}
+
+type FlowSpillConfig struct {
+	// Enable is the switch to enable spilling the records for new flows to disk when maxFlows is
+	// reached. Spilled records are handed over to the aggregation process in order once stored
+	// flows expire. It requires maxFlows to be set.
+	Enable bool `yaml:"enable,omitempty"`
+	// MaxSizeMiB is the maximum size of the spilled records, in MiB. When it is reached, the least
+	// recently spilled records are evicted, so that the flows which have not been updated for the
+	// longest time are dropped first. Defaults to 256.
+	MaxSizeMiB int `yaml:"maxSizeMiB,omitempty"`
+}
+
+type ShardingConfig struct {
+	// Enable is the switch to enable running multiple replicas of the flow aggregator in Aggregate
+	// mode. Flow exporters select a replica by consistent hashing of their Node name when the flow
+	// aggregator address is a headless Service, and the records of inter-Node flows are forwarded
+	// to the replica which owns the flow, so that the records from the source and destination Nodes
+	// are still aggregated.
+	Enable bool `yaml:"enable,omitempty"`
+	// HeadlessService is the name of the headless Service selecting the replicas of the flow
+	// aggregator, in the Namespace of the flow aggregator. It is used to discover the replicas.
+	// Defaults to "flow-aggregator-headless".
+	HeadlessService string `yaml:"headlessService,omitempty"`
 }
 
 type APIServerConfig struct {
@@ -83,6 +144,27 @@ type APIServerConfig struct {
 	TLSCipherSuites string `yaml:"tlsCipherSuites,omitempty"`
 	// TLS min version.
 	TLSMinVersion string `yaml:"tlsMinVersion,omitempty"`
+	// Consumers restricts the flow records returned by the flowrecords API to some of the
+	// authenticated users, e.g. the ServiceAccounts whose tokens are used by the consumers of
+	// different teams. Each of these users is only returned the flow records of its Namespaces,
+	// and cannot get the record metrics. When consumers are configured, the other users are
+	// denied unless they are listed in Admins.
+	Consumers []APIConsumerConfig `yaml:"consumers,omitempty"`
+	// Admins is the list of the authenticated users which are returned all flow records and the
+	// record metrics when Consumers is not empty. The antctl running in the flow-aggregator Pod
+	// is always allowed.
+	Admins []string `yaml:"admins,omitempty"`
+}
+
+// APIConsumerConfig maps an authenticated user of the flowrecords API to the Namespaces it is allowed to see.
+type APIConsumerConfig struct {
+	// User is the name of the authenticated user, e.g.
+	// "system:serviceaccount:team-a:flow-consumer" for the tokens of the flow-consumer
+	// ServiceAccount in the team-a Namespace.
+	User string `yaml:"user"`
+	// Namespaces is the allow-list of Namespaces of the user. Only the flow records whose source
+	// or destination Pod is in one of these Namespaces are returned to the user.
+	Namespaces []string `yaml:"namespaces"`
 }
 
 type FlowCollectorConfig struct {
@@ -129,6 +211,27 @@ type ClickHouseConfig struct {
 	// Defaults to "8s". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
 	// Min value allowed is "1s".
 	CommitInterval string `yaml:"commitInterval,omitempty"`
+	// MaxBatchSize is the maximum number of flow records committed to DB in a single batch.
+	// When the number of buffered records reaches it, they are committed without waiting for
+	// the end of CommitInterval. Defaults to 0, which means no limit.
+	MaxBatchSize int `yaml:"maxBatchSize,omitempty"`
+	// TableName is the name of the table to which flow records are written. Defaults to "flows".
+	TableName string `yaml:"tableName,omitempty"`
+	// Columns is the list of columns written for each flow record. When empty, all the columns
+	// of the default "flows" table schema are written. The Pod owner columns require
+	// recordContents.podOwners to be enabled.
+	Columns []string `yaml:"columns,omitempty"`
+	// CreateTable determines whether the Flow Aggregator creates the table when it doesn't exist,
+	// using the configured columns, PartitionBy and TTL. Defaults to false, in which case the
+	// table must be created beforehand, e.g., by the Antrea flow-visibility chart.
+	CreateTable bool `yaml:"createTable,omitempty"`
+	// PartitionBy is the partition key expression used when creating the table, e.g.,
+	// "toYYYYMMDD(timeInserted)". Only used when CreateTable is true. Defaults to no partitioning.
+	PartitionBy string `yaml:"partitionBy,omitempty"`
+	// TTL is the time-to-live of flow records in the table, after which they are deleted by
+	// ClickHouse. Only used when CreateTable is true, in which case the TTL of an existing table
+	// is updated to match it. Defaults to "", which means no TTL. Valid time units are "s", "m", "h".
+	TTL string `yaml:"ttl,omitempty"`
 	// TLS configuration options, when using TLS to connect to the ClickHouse service.
 	TLS TLSConfig `yaml:"tls,omitempty"`
 }
@@ -201,6 +304,39 @@ type FlowLoggerConfig struct {
 	PrettyPrint *bool `yaml:"prettyPrint,omitempty"`
 }
 
+type SinkType string
+
+const (
+	SinkTypeIPFIX      SinkType = "IPFIX"
+	SinkTypeClickHouse SinkType = "ClickHouse"
+	SinkTypeS3         SinkType = "S3"
+	SinkTypeLog        SinkType = "Log"
+)
+
+type SinkConfig struct {
+	// Name uniquely identifies the sink. It is required.
+	Name string `yaml:"name"`
+	// Type is the type of the sink. Must be one of "IPFIX", "ClickHouse", "S3" or "Log". The
+	// field with the same name as the configuration section of the corresponding exporter
+	// (flowCollector, clickHouse, s3Uploader or flowLogger) must be provided, the "enable"
+	// field of that section is ignored.
+	Type SinkType `yaml:"type"`
+	// Filters can be used to select which flow records to export to the sink. The provided
+	// filters are OR-ed to determine whether a specific flow should be exported. By default,
+	// all flows are exported.
+	Filters []FlowFilter `yaml:"filters,omitempty"`
+	// FlowCollector contains the configuration of a sink of type IPFIX.
+	FlowCollector *FlowCollectorConfig `yaml:"flowCollector,omitempty"`
+	// ClickHouse contains the configuration of a sink of type ClickHouse.
+	ClickHouse *ClickHouseConfig `yaml:"clickHouse,omitempty"`
+	// S3Uploader contains the configuration of a sink of type S3.
+	S3Uploader *S3UploaderConfig `yaml:"s3Uploader,omitempty"`
+	// FlowLogger contains the configuration of a sink of type Log. If the path is omitted, it
+	// defaults to the antrea-flows-<name>.log file in the operating system's default directory
+	// for temporary files.
+	FlowLogger *FlowLoggerConfig `yaml:"flowLogger,omitempty"`
+}
+
 type NetworkPolicyRuleAction string
 
 const (
@@ -218,4 +354,9 @@ type FlowFilter struct {
 	// EgressNetworkPolicyRuleActions supports filtering based on the action name for the egress
 	// policy rule applied to the flow. By default, all actions are considered.
 	EgressNetworkPolicyRuleActions []NetworkPolicyRuleAction `yaml:"egressNetworkPolicyRuleActions,omitempty"`
+	// Namespaces supports filtering based on the Namespaces of the source and destination Pods
+	// of the flow: the flow is selected if either of them is in one of the provided Namespaces.
+	// This can be used to only export the flows of some teams to their own sinks. By default, all
+	// Namespaces are considered.
+	Namespaces []string `yaml:"namespaces,omitempty"`
 }
@@ -37,6 +37,8 @@ const (
 	DefaultClickHouseCommitInterval = "8s"
 	MinClickHouseCommitInterval     = 1 * time.Second
 	DefaultClickHouseDatabaseUrl    = "tcp://clickhouse-clickhouse.flow-visibility.svc:9000"
+	DefaultClickHouseTableName      = "flows"
+	MinClickHouseTTL                = 1 * time.Minute
 
 	DefaultS3Region            = "us-west-2"
 	DefaultS3RecordFormat      = "CSV"
@@ -47,6 +49,12 @@ const (
 	DefaultLoggerMaxSize      = 100
 	DefaultLoggerMaxBackups   = 3
 	DefaultLoggerRecordFormat = "CSV"
+
+	DefaultSPIFFECertDir = "/run/spiffe/certs"
+
+	DefaultShardingHeadlessService = "flow-aggregator-headless"
+
+	DefaultFlowSpillMaxSizeMiB = 256
 )
 
 func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
@@ -62,6 +70,15 @@ func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
 	if flowAggregatorConf.AggregatorTransportProtocol == "" {
 		flowAggregatorConf.AggregatorTransportProtocol = DefaultAggregatorTransportProtocol
 	}
+	if flowAggregatorConf.SPIFFE.Enable && flowAggregatorConf.SPIFFE.CertDir == "" {
+		flowAggregatorConf.SPIFFE.CertDir = DefaultSPIFFECertDir
+	}
+	if flowAggregatorConf.FlowSpill.Enable && flowAggregatorConf.FlowSpill.MaxSizeMiB == 0 {
+		flowAggregatorConf.FlowSpill.MaxSizeMiB = DefaultFlowSpillMaxSizeMiB
+	}
+	if flowAggregatorConf.Sharding.Enable && flowAggregatorConf.Sharding.HeadlessService == "" {
+		flowAggregatorConf.Sharding.HeadlessService = DefaultShardingHeadlessService
+	}
 	if flowAggregatorConf.APIServer.APIPort == 0 {
 		flowAggregatorConf.APIServer.APIPort = apis.FlowAggregatorAPIPort
 	}
@@ -84,6 +101,9 @@ func SetConfigDefaults(flowAggregatorConf *FlowAggregatorConfig) {
 	if flowAggregatorConf.ClickHouse.CommitInterval == "" {
 		flowAggregatorConf.ClickHouse.CommitInterval = DefaultClickHouseCommitInterval
 	}
+	if flowAggregatorConf.ClickHouse.TableName == "" {
+		flowAggregatorConf.ClickHouse.TableName = DefaultClickHouseTableName
+	}
 	if flowAggregatorConf.S3Uploader.Compress == nil {
 		flowAggregatorConf.S3Uploader.Compress = new(bool)
 		*flowAggregatorConf.S3Uploader.Compress = true
@@ -0,0 +1,305 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroupwebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+)
+
+const (
+	controllerName = "ClusterGroupWebhookController"
+	// How long to wait before retrying to push the membership of a ClusterGroup.
+	minRetryDelay = 5 * time.Second
+	maxRetryDelay = 300 * time.Second
+	// TokenSecretName is the name of the Secret, in the Namespace of antrea-controller, which holds the bearer token
+	// used to authenticate to the webhook. The Secret is optional.
+	TokenSecretName = "antrea-clustergroup-webhook"
+	// TokenSecretKey is the key of the bearer token in the Secret.
+	TokenSecretKey = "token"
+)
+
+// GroupMembersQuerier provides the members of the ClusterGroups, and notifies their changes.
+type GroupMembersQuerier interface {
+	GetGroupMembers(name string) (controlplane.GroupMemberSet, []controlplane.IPBlock, error)
+	AddGroupEventHandler(handler func(key string))
+}
+
+// MembershipUpdate is the payload POSTed to the webhook when the membership of a ClusterGroup changes.
+type MembershipUpdate struct {
+	// ClusterGroup is the name of the ClusterGroup.
+	ClusterGroup string `json:"clusterGroup"`
+	// Deleted is true if the ClusterGroup has been deleted, in which case Addresses is empty.
+	Deleted bool `json:"deleted,omitempty"`
+	// Addresses are all the current IP addresses and CIDRs of the ClusterGroup members, sorted.
+	Addresses []string `json:"addresses"`
+	// Added are the addresses which have been added since the last update, sorted.
+	Added []string `json:"added,omitempty"`
+	// Removed are the addresses which have been removed since the last update, sorted.
+	Removed []string `json:"removed,omitempty"`
+	// Timestamp is the time at which the update was generated.
+	Timestamp metav1.Time `json:"timestamp"`
+}
+
+// Config is the configuration of the webhook.
+type Config struct {
+	// URL is the URL to which the membership updates are POSTed.
+	URL string
+	// ClusterGroups are the names of the ClusterGroups whose membership is pushed. All ClusterGroups if empty.
+	ClusterGroups []string
+	// CABundle is the PEM-encoded CA bundle used to verify the certificate of the webhook server. The system trust
+	// store is used if empty.
+	CABundle []byte
+	// Timeout is the timeout of each request.
+	Timeout time.Duration
+	// ResyncInterval is the interval at which the full membership of all ClusterGroups is pushed again, even if it
+	// has not changed, so that the external systems can recover from missed or lost updates.
+	ResyncInterval time.Duration
+}
+
+// Controller pushes the membership changes of ClusterGroups to an external webhook, so that external systems such as
+// on-premises firewalls and API gateways can keep their allow-lists in sync with the cluster.
+type Controller struct {
+	config        Config
+	clusterGroups sets.Set[string]
+	httpClient    *http.Client
+	kubeClient    kubernetes.Interface
+	namespace     string
+	querier       GroupMembersQuerier
+
+	queue workqueue.TypedRateLimitingInterface[string]
+
+	// mutex protects pushedAddresses and resyncGroups.
+	mutex sync.Mutex
+	// pushedAddresses are the addresses of each ClusterGroup known by the webhook.
+	pushedAddresses map[string]sets.Set[string]
+	// resyncGroups are the ClusterGroups whose membership must be pushed even if it has not changed.
+	resyncGroups sets.Set[string]
+}
+
+// NewController returns a new *Controller. namespace is the Namespace of the Secret holding the bearer token.
+func NewController(kubeClient kubernetes.Interface, querier GroupMembersQuerier, namespace string, config Config) (*Controller, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(config.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(config.CABundle) {
+			return nil, fmt.Errorf("no valid certificate in the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	c := &Controller{
+		config:     config,
+		httpClient: &http.Client{Timeout: config.Timeout, Transport: &http.Transport{TLSClientConfig: tlsConfig}},
+		kubeClient: kubeClient,
+		namespace:  namespace,
+		querier:    querier,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "clusterGroupWebhook",
+			},
+		),
+		pushedAddresses: map[string]sets.Set[string]{},
+		resyncGroups:    sets.New[string](),
+	}
+	if len(config.ClusterGroups) > 0 {
+		c.clusterGroups = sets.New[string](config.ClusterGroups...)
+	}
+	querier.AddGroupEventHandler(c.enqueueGroup)
+	return c, nil
+}
+
+func (c *Controller) enqueueGroup(key string) {
+	// Namespaced Groups are identified by their Namespace and name.
+	if strings.Contains(key, "/") {
+		return
+	}
+	if c.clusterGroups != nil && !c.clusterGroups.Has(key) {
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run begins pushing the membership changes of ClusterGroups until stopCh is closed.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting", "controllerName", controllerName, "url", c.config.URL)
+	defer klog.InfoS("Shutting down", "controllerName", controllerName)
+
+	if c.config.ResyncInterval > 0 {
+		go wait.Until(c.resync, c.config.ResyncInterval, stopCh)
+	}
+	// A single worker is used so that the updates of a ClusterGroup are pushed in order.
+	go wait.Until(c.worker, time.Second, stopCh)
+	<-stopCh
+}
+
+// resync enqueues all the ClusterGroups known by the webhook, to push their full membership again.
+func (c *Controller) resync() {
+	c.mutex.Lock()
+	for name := range c.pushedAddresses {
+		c.resyncGroups.Insert(name)
+	}
+	groups := c.resyncGroups.UnsortedList()
+	c.mutex.Unlock()
+	for _, name := range groups {
+		c.queue.Add(name)
+	}
+}
+
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.syncGroup(key); err != nil {
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Failed to push ClusterGroup membership, retrying", "ClusterGroup", key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+// getAddresses returns the IP addresses and CIDRs of the members of a ClusterGroup. The second return value is false
+// if the ClusterGroup doesn't exist.
+func (c *Controller) getAddresses(name string) (sets.Set[string], bool) {
+	members, ipBlocks, err := c.querier.GetGroupMembers(name)
+	if err != nil {
+		return nil, false
+	}
+	addresses := sets.New[string]()
+	for _, member := range members {
+		for _, ip := range member.IPs {
+			addresses.Insert(ip.String())
+		}
+	}
+	for _, ipBlock := range ipBlocks {
+		addresses.Insert(ipBlock.CIDR.String())
+	}
+	return addresses, true
+}
+
+func (c *Controller) syncGroup(name string) error {
+	addresses, exists := c.getAddresses(name)
+
+	c.mutex.Lock()
+	pushed, known := c.pushedAddresses[name]
+	resync := c.resyncGroups.Has(name)
+	c.mutex.Unlock()
+
+	if !exists && !known {
+		return nil
+	}
+	update := &MembershipUpdate{
+		ClusterGroup: name,
+		Deleted:      !exists,
+		Addresses:    sets.List(addresses),
+		Added:        sets.List(addresses.Difference(pushed)),
+		Removed:      sets.List(pushed.Difference(addresses)),
+		Timestamp:    metav1.Now(),
+	}
+	if known && !resync && len(update.Added) == 0 && len(update.Removed) == 0 {
+		return nil
+	}
+	if update.Addresses == nil {
+		update.Addresses = []string{}
+	}
+	if err := c.push(update); err != nil {
+		return err
+	}
+	klog.V(2).InfoS("Pushed ClusterGroup membership", "ClusterGroup", name, "added", len(update.Added), "removed", len(update.Removed), "deleted", update.Deleted)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if exists {
+		c.pushedAddresses[name] = addresses
+	} else {
+		delete(c.pushedAddresses, name)
+	}
+	c.resyncGroups.Delete(name)
+	return nil
+}
+
+// getToken returns the bearer token stored in the optional token Secret.
+func (c *Controller) getToken(ctx context.Context) (string, error) {
+	secret, err := c.kubeClient.CoreV1().Secrets(c.namespace).Get(ctx, TokenSecretName, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("error getting Secret %s/%s: %w", c.namespace, TokenSecretName, err)
+	}
+	return strings.TrimSpace(string(secret.Data[TokenSecretKey])), nil
+}
+
+func (c *Controller) push(update *MembershipUpdate) error {
+	ctx, cancel := context.WithTimeout(context.Background(), c.config.Timeout)
+	defer cancel()
+	body, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status code %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clustergroupwebhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+)
+
+type fakeGroupMembersQuerier struct {
+	mutex    sync.Mutex
+	members  map[string]controlplane.GroupMemberSet
+	ipBlocks map[string][]controlplane.IPBlock
+	handlers []func(key string)
+}
+
+func newFakeGroupMembersQuerier() *fakeGroupMembersQuerier {
+	return &fakeGroupMembersQuerier{
+		members:  map[string]controlplane.GroupMemberSet{},
+		ipBlocks: map[string][]controlplane.IPBlock{},
+	}
+}
+
+func (q *fakeGroupMembersQuerier) GetGroupMembers(name string) (controlplane.GroupMemberSet, []controlplane.IPBlock, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	members, hasMembers := q.members[name]
+	ipBlocks, hasIPBlocks := q.ipBlocks[name]
+	if !hasMembers && !hasIPBlocks {
+		return nil, nil, fmt.Errorf("no internal Group with name %s is found", name)
+	}
+	return members, ipBlocks, nil
+}
+
+func (q *fakeGroupMembersQuerier) AddGroupEventHandler(handler func(key string)) {
+	q.handlers = append(q.handlers, handler)
+}
+
+func (q *fakeGroupMembersQuerier) setMembers(name string, ips ...string) {
+	q.mutex.Lock()
+	members := controlplane.GroupMemberSet{}
+	for _, ip := range ips {
+		members.Insert(&controlplane.GroupMember{
+			Pod: &controlplane.PodReference{Name: ip, Namespace: "ns1"},
+			IPs: []controlplane.IPAddress{controlplane.IPAddress(net.ParseIP(ip))},
+		})
+	}
+	q.members[name] = members
+	q.mutex.Unlock()
+	q.notify(name)
+}
+
+func (q *fakeGroupMembersQuerier) setIPBlocks(name string, cidrs ...string) {
+	q.mutex.Lock()
+	var ipBlocks []controlplane.IPBlock
+	for _, cidr := range cidrs {
+		_, ipNet, _ := net.ParseCIDR(cidr)
+		prefixLength, _ := ipNet.Mask.Size()
+		ipBlocks = append(ipBlocks, controlplane.IPBlock{
+			CIDR: controlplane.IPNet{IP: controlplane.IPAddress(ipNet.IP), PrefixLength: int32(prefixLength)},
+		})
+	}
+	q.ipBlocks[name] = ipBlocks
+	q.mutex.Unlock()
+	q.notify(name)
+}
+
+func (q *fakeGroupMembersQuerier) deleteGroup(name string) {
+	q.mutex.Lock()
+	delete(q.members, name)
+	delete(q.ipBlocks, name)
+	q.mutex.Unlock()
+	q.notify(name)
+}
+
+func (q *fakeGroupMembersQuerier) notify(key string) {
+	for _, handler := range q.handlers {
+		handler(key)
+	}
+}
+
+type fakeWebhook struct {
+	*httptest.Server
+	mutex          sync.Mutex
+	updates        []MembershipUpdate
+	authorizations []string
+	statusCode     int
+}
+
+func newFakeWebhook() *fakeWebhook {
+	w := &fakeWebhook{statusCode: http.StatusOK}
+	w.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		if w.statusCode != http.StatusOK {
+			rw.WriteHeader(w.statusCode)
+			return
+		}
+		var update MembershipUpdate
+		if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.updates = append(w.updates, update)
+		w.authorizations = append(w.authorizations, r.Header.Get("Authorization"))
+	}))
+	return w
+}
+
+func (w *fakeWebhook) getUpdates() []MembershipUpdate {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	var updates []MembershipUpdate
+	for _, update := range w.updates {
+		// Ignore the timestamps when comparing updates.
+		update.Timestamp = metav1.Time{}
+		updates = append(updates, update)
+	}
+	return updates
+}
+
+func (w *fakeWebhook) setStatusCode(statusCode int) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.statusCode = statusCode
+}
+
+func newTestController(t *testing.T, url string, clusterGroups []string, objects ...runtime.Object) (*Controller, *fakeGroupMembersQuerier) {
+	kubeClient := fake.NewSimpleClientset(objects...)
+	querier := newFakeGroupMembersQuerier()
+	c, err := NewController(kubeClient, querier, "kube-system", Config{
+		URL:           url,
+		ClusterGroups: clusterGroups,
+		Timeout:       time.Second,
+	})
+	require.NoError(t, err)
+	return c, querier
+}
+
+func TestSyncGroup(t *testing.T) {
+	webhook := newFakeWebhook()
+	defer webhook.Close()
+	c, querier := newTestController(t, webhook.URL, nil)
+
+	querier.setMembers("cg1", "10.0.0.1", "10.0.0.2")
+	require.NoError(t, c.syncGroup("cg1"))
+	// Nothing is pushed if the membership has not changed.
+	require.NoError(t, c.syncGroup("cg1"))
+	querier.setMembers("cg1", "10.0.0.2", "10.0.0.3")
+	require.NoError(t, c.syncGroup("cg1"))
+	querier.setIPBlocks("cg2", "192.168.0.0/24")
+	require.NoError(t, c.syncGroup("cg2"))
+	querier.deleteGroup("cg1")
+	require.NoError(t, c.syncGroup("cg1"))
+	// Nothing is pushed for a ClusterGroup which is unknown to the webhook.
+	require.NoError(t, c.syncGroup("cg3"))
+
+	expectedUpdates := []MembershipUpdate{
+		{
+			ClusterGroup: "cg1",
+			Addresses:    []string{"10.0.0.1", "10.0.0.2"},
+			Added:        []string{"10.0.0.1", "10.0.0.2"},
+		},
+		{
+			ClusterGroup: "cg1",
+			Addresses:    []string{"10.0.0.2", "10.0.0.3"},
+			Added:        []string{"10.0.0.3"},
+			Removed:      []string{"10.0.0.1"},
+		},
+		{
+			ClusterGroup: "cg2",
+			Addresses:    []string{"192.168.0.0/24"},
+			Added:        []string{"192.168.0.0/24"},
+		},
+		{
+			ClusterGroup: "cg1",
+			Deleted:      true,
+			Addresses:    []string{},
+			Removed:      []string{"10.0.0.2", "10.0.0.3"},
+		},
+	}
+	assert.Equal(t, expectedUpdates, webhook.getUpdates())
+	assert.Equal(t, []string{"", "", "", ""}, webhook.authorizations)
+}
+
+func TestSyncGroupFailure(t *testing.T) {
+	webhook := newFakeWebhook()
+	defer webhook.Close()
+	c, querier := newTestController(t, webhook.URL, nil)
+
+	webhook.setStatusCode(http.StatusServiceUnavailable)
+	querier.setMembers("cg1", "10.0.0.1")
+	assert.ErrorContains(t, c.syncGroup("cg1"), "webhook returned status code 503")
+
+	// The addresses which have not been pushed successfully are pushed again.
+	webhook.setStatusCode(http.StatusOK)
+	require.NoError(t, c.syncGroup("cg1"))
+	assert.Equal(t, []MembershipUpdate{
+		{
+			ClusterGroup: "cg1",
+			Addresses:    []string{"10.0.0.1"},
+			Added:        []string{"10.0.0.1"},
+		},
+	}, webhook.getUpdates())
+}
+
+func TestResync(t *testing.T) {
+	webhook := newFakeWebhook()
+	defer webhook.Close()
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: TokenSecretName, Namespace: "kube-system"},
+		Data:       map[string][]byte{TokenSecretKey: []byte("secret-token\n")},
+	}
+	c, querier := newTestController(t, webhook.URL, []string{"cg1"}, secret)
+
+	querier.setMembers("cg1", "10.0.0.1")
+	querier.setMembers("cg2", "10.0.0.2")
+	querier.setMembers("ns1/g1", "10.0.0.3")
+	// Only cg1 is enqueued, as cg2 is not selected and ns1/g1 is a namespaced Group.
+	require.Equal(t, 1, c.queue.Len())
+	require.True(t, c.processNextWorkItem())
+
+	c.resync()
+	require.Equal(t, 1, c.queue.Len())
+	require.True(t, c.processNextWorkItem())
+
+	expectedUpdate := MembershipUpdate{
+		ClusterGroup: "cg1",
+		Addresses:    []string{"10.0.0.1"},
+		Added:        []string{"10.0.0.1"},
+	}
+	resyncUpdate := MembershipUpdate{
+		ClusterGroup: "cg1",
+		Addresses:    []string{"10.0.0.1"},
+	}
+	assert.Equal(t, []MembershipUpdate{expectedUpdate, resyncUpdate}, webhook.getUpdates())
+	assert.Equal(t, []string{"Bearer secret-token", "Bearer secret-token"}, webhook.authorizations)
+}
+
+func TestNewControllerInvalidCABundle(t *testing.T) {
+	_, err := NewController(fake.NewSimpleClientset(), newFakeGroupMembersQuerier(), "kube-system", Config{
+		URL:      "https://webhook.example.com",
+		CABundle: []byte("invalid"),
+	})
+	assert.EqualError(t, err, "no valid certificate in the CA bundle")
+}
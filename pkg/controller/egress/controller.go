@@ -17,6 +17,7 @@ package egress
 import (
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"net"
 	"reflect"
@@ -30,11 +31,16 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
 
+	npltypes "antrea.io/antrea/pkg/agent/nodeportlocal/types"
+	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/apis/controlplane"
 	egressv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/apiserver/storage"
@@ -60,6 +66,7 @@ const (
 	egressGroupType grouping.GroupType = "egressGroup"
 
 	externalIPPoolIndex = "externalIPPool"
+	podEgressIndex      = "podEgress"
 )
 
 // ipAllocation contains the IP and the IP Pool which allocates it.
@@ -70,6 +77,7 @@ type ipAllocation struct {
 
 // EgressController is responsible for synchronizing the EgressGroups selected by Egresses.
 type EgressController struct {
+	client    kubernetes.Interface
 	crdClient clientset.Interface
 
 	externalIPAllocator externalippool.ExternalIPAllocator
@@ -92,15 +100,33 @@ type EgressController struct {
 	groupingInterface grouping.Interface
 	// Added as a member to the struct to allow injection for testing.
 	groupingInterfaceSynced func() bool
+	// podLister and namespaceLister are used to get the NodePortLocal annotations of Pods and Namespaces.
+	podLister             corelisters.PodLister
+	podListerSynced       cache.InformerSynced
+	namespaceLister       corelisters.NamespaceLister
+	namespaceListerSynced cache.InformerSynced
+
+	// podEgressIPEnabled indicates whether Pods can request an EgressIP with annotations.
+	podEgressIPEnabled bool
+	// podEgressIPAllowedPools maps the names of the Namespaces to the ExternalIPPools their Pods are allowed to request
+	// an EgressIP from. Pods in the Namespaces not in the map can't request any EgressIP.
+	podEgressIPAllowedPools map[string]sets.Set[string]
+	// podQueue maintains the keys of the Pods whose requested EgressIPs need to be synced.
+	podQueue workqueue.TypedRateLimitingInterface[string]
 }
 
 // NewEgressController returns a new *EgressController.
-func NewEgressController(crdClient clientset.Interface,
+func NewEgressController(client kubernetes.Interface,
+	crdClient clientset.Interface,
 	groupingInterface grouping.Interface,
 	egressInformer egressinformers.EgressInformer,
 	externalIPAllocator externalippool.ExternalIPAllocator,
-	egressGroupStore storage.Interface) *EgressController {
+	egressGroupStore storage.Interface,
+	podInformer coreinformers.PodInformer,
+	namespaceInformer coreinformers.NamespaceInformer,
+	podEgressIPEnabled bool) *EgressController {
 	c := &EgressController{
+		client:             client,
 		crdClient:          crdClient,
 		egressInformer:     egressInformer,
 		egressLister:       egressInformer.Lister(),
@@ -117,6 +143,17 @@ func NewEgressController(crdClient clientset.Interface,
 		groupingInterfaceSynced: groupingInterface.HasSynced,
 		ipAllocationMap:         map[string]*ipAllocation{},
 		externalIPAllocator:     externalIPAllocator,
+		podLister:               podInformer.Lister(),
+		podListerSynced:         podInformer.Informer().HasSynced,
+		namespaceLister:         namespaceInformer.Lister(),
+		namespaceListerSynced:   namespaceInformer.Informer().HasSynced,
+		podEgressIPEnabled:      podEgressIPEnabled,
+		podQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "podEgress",
+			},
+		),
 	}
 	// Add handlers for Group events and Egress events.
 	c.groupingInterface.AddEventHandler(egressGroupType, c.enqueueEgressGroup)
@@ -145,20 +182,71 @@ func NewEgressController(crdClient clientset.Interface,
 		}
 		return externalIPPools, nil
 	}})
+	// podEgressIndex will be used to get the Egresses created for the EgressIP requested by a given Pod.
+	egressInformer.Informer().AddIndexers(cache.Indexers{podEgressIndex: func(obj interface{}) ([]string, error) {
+		egress, ok := obj.(*egressv1beta1.Egress)
+		if !ok {
+			return nil, fmt.Errorf("obj is not Egress: %+v", obj)
+		}
+		if !isPodEgress(egress) {
+			return nil, nil
+		}
+		return []string{egress.Annotations[apis.EgressPodAnnotationKey]}, nil
+	}})
 	c.externalIPAllocator.AddEventHandler(func(ipPool string) {
 		c.enqueueEgresses(ipPool)
 	})
+	// The grouping interface doesn't notify annotation changes, the Pod and Namespace events are watched to handle the
+	// changes of the NodePortLocal annotations, which may exclude Pods from Egresses.
+	podInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: c.updatePod,
+		},
+		resyncPeriod,
+	)
+	if podEgressIPEnabled {
+		podInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    c.addPodForPodEgress,
+				UpdateFunc: c.updatePodForPodEgress,
+				DeleteFunc: c.deletePodForPodEgress,
+			},
+			resyncPeriod,
+		)
+	}
+	namespaceInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: c.updateNamespace,
+		},
+		resyncPeriod,
+	)
 	return c
 }
 
+// SetPodEgressIPAllowedPools sets the ExternalIPPools the Pods in each Namespace are allowed to request an EgressIP
+// from. It must be called before the controller is started.
+func (c *EgressController) SetPodEgressIPAllowedPools(allowedPools map[string][]string) {
+	c.podEgressIPAllowedPools = make(map[string]sets.Set[string], len(allowedPools))
+	for namespace, pools := range allowedPools {
+		c.podEgressIPAllowedPools[namespace] = sets.New[string](pools...)
+	}
+}
+
+// isPodEgressIPPoolAllowed returns whether the Pods in the Namespace are allowed to request an EgressIP from the
+// ExternalIPPool.
+func (c *EgressController) isPodEgressIPPoolAllowed(namespace, pool string) bool {
+	return c.podEgressIPAllowedPools[namespace].Has(pool)
+}
+
 // Run begins watching and syncing of the EgressController.
 func (c *EgressController) Run(stopCh <-chan struct{}) {
 	defer c.queue.ShutDown()
+	defer c.podQueue.ShutDown()
 
 	klog.InfoS("Starting", "controller", controllerName)
 	defer klog.InfoS("Shutting down", "controller", controllerName)
 
-	cacheSyncs := []cache.InformerSynced{c.egressListerSynced, c.groupingInterfaceSynced, c.externalIPAllocator.HasSynced}
+	cacheSyncs := []cache.InformerSynced{c.egressListerSynced, c.groupingInterfaceSynced, c.externalIPAllocator.HasSynced, c.podListerSynced, c.namespaceListerSynced}
 	if !cache.WaitForNamedCacheSync(controllerName, stopCh, cacheSyncs...) {
 		return
 	}
@@ -167,6 +255,18 @@ func (c *EgressController) Run(stopCh <-chan struct{}) {
 	for i := 0; i < defaultWorkers; i++ {
 		go wait.Until(c.egressGroupWorker, time.Second, stopCh)
 	}
+	if c.podEgressIPEnabled {
+		// Sync the Pods of the existing Pod Egresses, to delete the Egresses of the Pods which have been deleted, or
+		// whose annotations have been removed, while antrea-controller was not running.
+		for _, egress := range egresses {
+			if isPodEgress(egress) {
+				c.podQueue.Add(egress.Annotations[apis.EgressPodAnnotationKey])
+			}
+		}
+		for i := 0; i < defaultWorkers; i++ {
+			go wait.Until(c.podEgressWorker, time.Second, stopCh)
+		}
+	}
 	<-stopCh
 }
 
@@ -180,12 +280,9 @@ func (c *EgressController) restoreIPAllocations(egresses []*egressv1beta1.Egress
 		}
 		ip := net.ParseIP(egress.Spec.EgressIP)
 		allocation := externalippool.IPAllocation{
-			ObjectReference: v1.ObjectReference{
-				Name: egress.Name,
-				Kind: egress.Kind,
-			},
-			IPPoolName: egress.Spec.ExternalIPPool,
-			IP:         ip,
+			ObjectReference: egressOwnerReference(egress.Name),
+			IPPoolName:      egress.Spec.ExternalIPPool,
+			IP:              ip,
 		}
 		previousIPAllocations = append(previousIPAllocations, allocation)
 	}
@@ -268,9 +365,11 @@ func (c *EgressController) syncEgressIP(egress *egressv1beta1.Egress) (net.IP, *
 		c.releaseEgressIP(egress.Name, prevIP, prevIPPool)
 	}
 
-	// Skip allocating EgressIP if ExternalIPPool is not specified and return whatever user specifies.
+	// Skip allocating EgressIP if ExternalIPPool is not specified and return whatever user specifies, after checking
+	// it's not allocated to another consumer of ExternalIPPools.
 	if egress.Spec.ExternalIPPool == "" {
-		return net.ParseIP(egress.Spec.EgressIP), egress, nil
+		ip := net.ParseIP(egress.Spec.EgressIP)
+		return ip, egress, c.checkStaticEgressIP(ip)
 	}
 
 	if !c.externalIPAllocator.IPPoolExists(egress.Spec.ExternalIPPool) {
@@ -291,13 +390,13 @@ func (c *EgressController) syncEgressIP(egress *egressv1beta1.Egress) (net.IP, *
 	// TODO: Use validation webhook to ensure the requested IP matches the pool.
 	if egress.Spec.EgressIP != "" {
 		ip = net.ParseIP(egress.Spec.EgressIP)
-		if err := c.externalIPAllocator.UpdateIPAllocation(egress.Spec.ExternalIPPool, ip); err != nil {
-			return nil, egress, fmt.Errorf("error when allocating IP %v for Egress %s from ExternalIPPool %s: %v", ip, egress.Name, egress.Spec.ExternalIPPool, err)
+		if err := c.externalIPAllocator.UpdateIPAllocation(egress.Spec.ExternalIPPool, ip, egressOwnerReference(egress.Name)); err != nil {
+			return nil, egress, fmt.Errorf("error when allocating IP %v for Egress %s from ExternalIPPool %s: %w", ip, egress.Name, egress.Spec.ExternalIPPool, err)
 		}
 	} else {
 		var err error
 		// User doesn't specify the Egress IP, allocate one.
-		if ip, err = c.externalIPAllocator.AllocateIPFromPool(egress.Spec.ExternalIPPool); err != nil {
+		if ip, err = c.externalIPAllocator.AllocateIPFromPool(egress.Spec.ExternalIPPool, egressOwnerReference(egress.Name)); err != nil {
 			return nil, egress, err
 		}
 		if updatedEgress, err := c.updateEgressIP(egress, ip.String()); err != nil {
@@ -315,6 +414,25 @@ func (c *EgressController) syncEgressIP(egress *egressv1beta1.Egress) (net.IP, *
 	return ip, egress, nil
 }
 
+// checkStaticEgressIP returns an *externalippool.IPConflictError if the EgressIP which is not allocated from any
+// ExternalIPPool has been allocated to another consumer of ExternalIPPools.
+func (c *EgressController) checkStaticEgressIP(ip net.IP) error {
+	if ip == nil {
+		return nil
+	}
+	if owner, allocated := c.externalIPAllocator.GetIPOwner(ip); allocated {
+		return &externalippool.IPConflictError{IP: ip, Owner: owner}
+	}
+	return nil
+}
+
+func egressOwnerReference(egressName string) v1.ObjectReference {
+	return v1.ObjectReference{
+		Kind: "Egress",
+		Name: egressName,
+	}
+}
+
 // updateEgressIP updates the Egress's EgressIP in Kubernetes API.
 func (c *EgressController) updateEgressIP(egress *egressv1beta1.Egress, ip string) (*egressv1beta1.Egress, error) {
 	var egressIPPtr *string
@@ -371,28 +489,51 @@ func (c *EgressController) syncEgress(key string) error {
 	}
 
 	_, egress, err = c.syncEgressIP(egress)
-	c.updateEgressAllocatedCondition(egress, err)
-	if err != nil {
+	c.updateEgressConditions(egress, err)
+	// A conflict of a static EgressIP is only reported, the Egress is still realized as the IP is not managed by
+	// Antrea. The error is returned at the end to check the conflict again later.
+	var ipConflictErr *externalippool.IPConflictError
+	staticIPConflict := egress.Spec.ExternalIPPool == "" && stderrors.As(err, &ipConflictErr)
+	if err != nil && !staticIPConflict {
 		return err
 	}
 
 	egressGroupObj, found, _ := c.egressGroupStore.Get(key)
 	if !found {
 		klog.V(2).InfoS("EgressGroup %s not found", "name", key)
-		return nil
+		return err
 	}
 
 	nodeNames := sets.Set[string]{}
 	podNum := 0
 	memberSetByNode := make(map[string]controlplane.GroupMemberSet)
 	egressGroup := egressGroupObj.(*antreatypes.EgressGroup)
-	pods, _ := c.groupingInterface.GetEntities(egressGroupType, key)
+	namespaceWide := isNamespaceWideEgress(egress)
+	podEgress := isPodEgress(egress)
+	var pods []*v1.Pod
+	if podEgress {
+		pods = c.getPodOfPodEgress(egress)
+	} else {
+		pods, _ = c.groupingInterface.GetEntities(egressGroupType, key)
+	}
 	for _, pod := range pods {
 		// Ignore Pod if it's not scheduled or is already terminated. And Egress does not support HostNetwork Pods, so also ignore
 		// Pod if it's HostNetwork Pod.
 		if pod.Spec.NodeName == "" || pod.Spec.HostNetwork || k8s.IsPodTerminated(pod) {
 			continue
 		}
+		// Egresses selecting Pods with a podSelector take precedence over namespace-wide Egresses.
+		if namespaceWide && c.isPodSelectedByPodSpecificEgress(pod) {
+			continue
+		}
+		// The Egress created for the EgressIP requested by a Pod takes precedence over the other Egresses.
+		if !podEgress && c.hasPodEgress(pod) {
+			continue
+		}
+		// Pods exposed via NodePortLocal don't use Egresses if their NodePortLocal egress mode says so.
+		if c.isPodExcludedByNodePortLocal(pod) {
+			continue
+		}
 		podNum++
 		podSet := memberSetByNode[pod.Spec.NodeName]
 		if podSet == nil {
@@ -417,7 +558,141 @@ func (c *EgressController) syncEgress(key string) error {
 	}
 	klog.V(2).InfoS("Updating existing EgressGroup", "name", key, "podNum", podNum, "nodeNum", nodeNames.Len())
 	c.egressGroupStore.Update(updatedEgressGroup)
-	return nil
+	if !namespaceWide {
+		// The namespace-wide Egresses selecting the previous or current members of this Egress may need to add or
+		// remove them.
+		c.enqueueNamespaceWideEgresses(groupMemberKeys(egressGroup).Union(groupMemberKeys(updatedEgressGroup)))
+	}
+	return err
+}
+
+// isNamespaceWideEgress returns whether the Egress selects all Pods in the Namespaces selected by its
+// namespaceSelector.
+func isNamespaceWideEgress(egress *egressv1beta1.Egress) bool {
+	return egress.Spec.AppliedTo.PodSelector == nil && egress.Spec.AppliedTo.NamespaceSelector != nil
+}
+
+// isPodSelectedByPodSpecificEgress returns whether the Pod is selected by any Egress with a podSelector.
+func (c *EgressController) isPodSelectedByPodSpecificEgress(pod *v1.Pod) bool {
+	groups, _ := c.groupingInterface.GetGroupsForPod(pod.Namespace, pod.Name)
+	for _, name := range groups[egressGroupType] {
+		egress, err := c.egressLister.Get(name)
+		if err != nil {
+			continue
+		}
+		if !isNamespaceWideEgress(egress) {
+			return true
+		}
+	}
+	return false
+}
+
+// isPodExcludedByNodePortLocal returns whether the Pod is exposed via NodePortLocal and its NodePortLocal egress mode
+// is PreferNodePortLocal, in which case the Pod must not be a member of any Egress.
+func (c *EgressController) isPodExcludedByNodePortLocal(pod *v1.Pod) bool {
+	// The Pods returned by the grouping interface may not have the latest annotations as annotation changes are not
+	// tracked by it.
+	if latestPod, err := c.podLister.Pods(pod.Namespace).Get(pod.Name); err == nil {
+		pod = latestPod
+	}
+	if _, exposed := pod.Annotations[npltypes.NPLAnnotationKey]; !exposed {
+		return false
+	}
+	return c.getNodePortLocalEgressMode(pod) == npltypes.EgressModePreferNodePortLocal
+}
+
+// getNodePortLocalEgressMode returns the NodePortLocal egress mode of the Pod. The annotation of the Pod takes
+// precedence over the annotation of its Namespace. An invalid annotation is ignored.
+func (c *EgressController) getNodePortLocalEgressMode(pod *v1.Pod) npltypes.EgressMode {
+	if value, exists := pod.Annotations[npltypes.NPLEgressModeAnnotationKey]; exists {
+		mode, err := npltypes.ParseEgressMode(value)
+		if err == nil {
+			return mode
+		}
+		klog.ErrorS(err, "Ignored invalid NodePortLocal egress mode of Pod", "pod", klog.KObj(pod))
+	}
+	namespace, err := c.namespaceLister.Get(pod.Namespace)
+	if err != nil {
+		return npltypes.EgressModeCoexist
+	}
+	mode, err := npltypes.ParseEgressMode(namespace.Annotations[npltypes.NPLEgressModeAnnotationKey])
+	if err != nil {
+		klog.ErrorS(err, "Ignored invalid NodePortLocal egress mode of Namespace", "namespace", namespace.Name)
+		return npltypes.EgressModeCoexist
+	}
+	return mode
+}
+
+// updatePod processes Pod UPDATE events and enqueues the Egresses selecting the Pod if the NodePortLocal annotations
+// of the Pod have changed.
+func (c *EgressController) updatePod(old, cur interface{}) {
+	oldPod := old.(*v1.Pod)
+	curPod := cur.(*v1.Pod)
+	_, oldExposed := oldPod.Annotations[npltypes.NPLAnnotationKey]
+	_, curExposed := curPod.Annotations[npltypes.NPLAnnotationKey]
+	if oldExposed == curExposed &&
+		oldPod.Annotations[npltypes.NPLEgressModeAnnotationKey] == curPod.Annotations[npltypes.NPLEgressModeAnnotationKey] {
+		return
+	}
+	groups, _ := c.groupingInterface.GetGroupsForPod(curPod.Namespace, curPod.Name)
+	for _, egressName := range groups[egressGroupType] {
+		c.queue.Add(egressName)
+	}
+}
+
+// updateNamespace processes Namespace UPDATE events and enqueues all Egresses if the NodePortLocal egress mode of
+// the Namespace has changed.
+func (c *EgressController) updateNamespace(old, cur interface{}) {
+	oldNamespace := old.(*v1.Namespace)
+	curNamespace := cur.(*v1.Namespace)
+	if oldNamespace.Annotations[npltypes.NPLEgressModeAnnotationKey] == curNamespace.Annotations[npltypes.NPLEgressModeAnnotationKey] {
+		return
+	}
+	egresses, _ := c.egressLister.List(labels.Everything())
+	for _, egress := range egresses {
+		c.queue.Add(egress.Name)
+	}
+}
+
+// enqueueNamespaceWideEgresses enqueues the namespace-wide Egresses selecting any of the given Pods.
+func (c *EgressController) enqueueNamespaceWideEgresses(podKeys sets.Set[string]) {
+	for podKey := range podKeys {
+		namespace, name := k8s.SplitNamespacedName(podKey)
+		groups, _ := c.groupingInterface.GetGroupsForPod(namespace, name)
+		for _, egressName := range groups[egressGroupType] {
+			egress, err := c.egressLister.Get(egressName)
+			if err != nil {
+				continue
+			}
+			if isNamespaceWideEgress(egress) {
+				c.queue.Add(egressName)
+			}
+		}
+	}
+}
+
+// groupMemberKeys returns the keys of the Pods in the EgressGroup.
+func groupMemberKeys(egressGroup *antreatypes.EgressGroup) sets.Set[string] {
+	keys := sets.New[string]()
+	for _, memberSet := range egressGroup.GroupMemberByNode {
+		for _, member := range memberSet {
+			keys.Insert(k8s.NamespacedName(member.Pod.Namespace, member.Pod.Name))
+		}
+	}
+	return keys
+}
+
+// enqueueNamespaceWideEgressesOfGroup enqueues the namespace-wide Egresses selecting the current members of the
+// EgressGroup, if the Egress is not namespace-wide itself.
+func (c *EgressController) enqueueNamespaceWideEgressesOfGroup(egress *egressv1beta1.Egress) {
+	if isNamespaceWideEgress(egress) {
+		return
+	}
+	egressGroupObj, found, _ := c.egressGroupStore.Get(egress.Name)
+	if !found {
+		return
+	}
+	c.enqueueNamespaceWideEgresses(groupMemberKeys(egressGroupObj.(*antreatypes.EgressGroup)))
 }
 
 func (c *EgressController) enqueueEgressGroup(key string) {
@@ -435,6 +710,12 @@ func (c *EgressController) addEgress(obj interface{}) {
 		UID:  egress.UID,
 	}
 	c.egressGroupStore.Create(egressGroup)
+	if isPodEgress(egress) {
+		// The Egress only selects the Pod it was created for, which must be removed from the other Egresses.
+		c.enqueueEgressesOfPodEgress(egress)
+		c.queue.Add(egress.Name)
+		return
+	}
 	// Register the group to the grouping interface.
 	groupSelector := antreatypes.NewGroupSelector("", egress.Spec.AppliedTo.PodSelector, egress.Spec.AppliedTo.NamespaceSelector, nil, nil)
 	c.groupingInterface.AddGroup(egressGroupType, egress.Name, groupSelector)
@@ -446,11 +727,18 @@ func (c *EgressController) updateEgress(old, cur interface{}) {
 	oldEgress := old.(*egressv1beta1.Egress)
 	curEgress := cur.(*egressv1beta1.Egress)
 	klog.InfoS("Processing Egress UPDATE event", "egress", curEgress.Name, "selector", curEgress.Spec.AppliedTo)
-	// TODO: Define custom Equal function to be more efficient.
-	if !reflect.DeepEqual(oldEgress.Spec.AppliedTo, curEgress.Spec.AppliedTo) {
+	if isPodEgress(curEgress) {
+		// The annotation of the Pod reflects whether its requested EgressIP is allocated.
+		if c.podEgressIPEnabled {
+			c.podQueue.Add(curEgress.Annotations[apis.EgressPodAnnotationKey])
+		}
+	} else if !reflect.DeepEqual(oldEgress.Spec.AppliedTo, curEgress.Spec.AppliedTo) {
+		// TODO: Define custom Equal function to be more efficient.
 		// Update the group's selector in the grouping interface.
 		groupSelector := antreatypes.NewGroupSelector("", curEgress.Spec.AppliedTo.PodSelector, curEgress.Spec.AppliedTo.NamespaceSelector, nil, nil)
 		c.groupingInterface.AddGroup(egressGroupType, curEgress.Name, groupSelector)
+		// The Egress may no longer take precedence over the namespace-wide Egresses selecting its current members.
+		c.enqueueNamespaceWideEgressesOfGroup(oldEgress)
 	}
 	if oldEgress.GetGeneration() != curEgress.GetGeneration() {
 		c.queue.Add(curEgress.Name)
@@ -461,9 +749,19 @@ func (c *EgressController) updateEgress(old, cur interface{}) {
 func (c *EgressController) deleteEgress(obj interface{}) {
 	egress := obj.(*egressv1beta1.Egress)
 	klog.InfoS("Processing Egress DELETE event", "egress", egress.Name)
+	if isPodEgress(egress) {
+		// The other Egresses selecting the Pod may need to take it over.
+		c.enqueueEgressesOfPodEgress(egress)
+		if c.podEgressIPEnabled {
+			c.podQueue.Add(egress.Annotations[apis.EgressPodAnnotationKey])
+		}
+	} else {
+		// Unregister the group from the grouping interface.
+		c.groupingInterface.DeleteGroup(egressGroupType, egress.Name)
+		// The namespace-wide Egresses selecting the members of the Egress may need to take them over.
+		c.enqueueNamespaceWideEgressesOfGroup(egress)
+	}
 	c.egressGroupStore.Delete(egress.Name)
-	// Unregister the group from the grouping interface.
-	c.groupingInterface.DeleteGroup(egressGroupType, egress.Name)
 	c.queue.Add(egress.Name)
 }
 
@@ -476,11 +774,15 @@ func (c *EgressController) enqueueEgresses(poolName string) {
 	}
 }
 
-func (c *EgressController) updateEgressAllocatedCondition(egress *egressv1beta1.Egress, err error) {
-	var desiredCondition *egressv1beta1.EgressCondition
+// updateEgressConditions updates the IPAllocated and IPConflict conditions of the Egress according to the error of
+// syncing its EgressIP.
+func (c *EgressController) updateEgressConditions(egress *egressv1beta1.Egress, err error) {
+	var ipConflictErr *externalippool.IPConflictError
+	isIPConflict := stderrors.As(err, &ipConflictErr)
+	desiredConditions := map[egressv1beta1.EgressConditionType]*egressv1beta1.EgressCondition{}
 	if egress.Spec.ExternalIPPool != "" {
 		if err == nil {
-			desiredCondition = &egressv1beta1.EgressCondition{
+			desiredConditions[egressv1beta1.IPAllocated] = &egressv1beta1.EgressCondition{
 				Type:               egressv1beta1.IPAllocated,
 				Status:             v1.ConditionTrue,
 				Reason:             "Allocated",
@@ -488,31 +790,54 @@ func (c *EgressController) updateEgressAllocatedCondition(egress *egressv1beta1.
 				LastTransitionTime: metav1.Now(),
 			}
 		} else {
-			desiredCondition = &egressv1beta1.EgressCondition{
+			reason := "AllocationError"
+			if isIPConflict {
+				reason = "IPConflict"
+			}
+			desiredConditions[egressv1beta1.IPAllocated] = &egressv1beta1.EgressCondition{
 				Type:               egressv1beta1.IPAllocated,
 				Status:             v1.ConditionFalse,
-				Reason:             "AllocationError",
+				Reason:             reason,
 				Message:            fmt.Sprintf("Cannot allocate EgressIP from ExternalIPPool: %v", err),
 				LastTransitionTime: metav1.Now(),
 			}
 		}
 	}
+	if isIPConflict {
+		desiredConditions[egressv1beta1.IPConflict] = &egressv1beta1.EgressCondition{
+			Type:               egressv1beta1.IPConflict,
+			Status:             v1.ConditionTrue,
+			Reason:             fmt.Sprintf("AllocatedTo%s", ipConflictErr.Owner.Kind),
+			Message:            ipConflictErr.Error(),
+			LastTransitionTime: metav1.Now(),
+		}
+	}
+	// The condition types managed by the controller, the others are managed by agents and must be kept.
+	conditionTypes := []egressv1beta1.EgressConditionType{egressv1beta1.IPAllocated, egressv1beta1.IPConflict}
 
 	toUpdate := egress.DeepCopy()
 	var updateErr, getErr error
 	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		actualCondition := egressv1beta1.GetEgressCondition(toUpdate.Status.Conditions, egressv1beta1.IPAllocated)
-		if compareConditionIgnoringTimestamp(actualCondition, desiredCondition) {
+		changed := false
+		for _, conditionType := range conditionTypes {
+			actualCondition := egressv1beta1.GetEgressCondition(toUpdate.Status.Conditions, conditionType)
+			if !compareConditionIgnoringTimestamp(actualCondition, desiredConditions[conditionType]) {
+				changed = true
+			}
+		}
+		if !changed {
 			return nil
 		}
 		var newConditions []egressv1beta1.EgressCondition
 		for _, c := range toUpdate.Status.Conditions {
-			if c.Type != egressv1beta1.IPAllocated {
+			if c.Type != egressv1beta1.IPAllocated && c.Type != egressv1beta1.IPConflict {
 				newConditions = append(newConditions, c)
 			}
 		}
-		if desiredCondition != nil {
-			newConditions = append(newConditions, *desiredCondition)
+		for _, conditionType := range conditionTypes {
+			if desiredCondition := desiredConditions[conditionType]; desiredCondition != nil {
+				newConditions = append(newConditions, *desiredCondition)
+			}
 		}
 		toUpdate.Status.Conditions = newConditions
 		_, updateErr = c.crdClient.CrdV1beta1().Egresses().UpdateStatus(context.TODO(), toUpdate, metav1.UpdateOptions{})
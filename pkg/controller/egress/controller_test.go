@@ -25,10 +25,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/informers"
@@ -37,6 +39,8 @@ import (
 	k8stesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
+	npltypes "antrea.io/antrea/pkg/agent/nodeportlocal/types"
+	"antrea.io/antrea/pkg/apis"
 	"antrea.io/antrea/pkg/apis/controlplane"
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/client/clientset/versioned"
@@ -45,6 +49,7 @@ import (
 	"antrea.io/antrea/pkg/controller/egress/store"
 	"antrea.io/antrea/pkg/controller/externalippool"
 	"antrea.io/antrea/pkg/controller/grouping"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
 	"antrea.io/antrea/pkg/util/k8s"
 )
 
@@ -190,7 +195,8 @@ func newController(objects, crdObjects []runtime.Object) *egressController {
 		informerFactory.Core().V1().Pods(),
 		informerFactory.Core().V1().Namespaces(),
 		crdInformerFactory.Crd().V1alpha2().ExternalEntities())
-	controller := NewEgressController(crdClient, groupEntityIndex, egressInformer, externalIPAllocator, egressGroupStore)
+	controller := NewEgressController(client, crdClient, groupEntityIndex, egressInformer, externalIPAllocator, egressGroupStore,
+		informerFactory.Core().V1().Pods(), informerFactory.Core().V1().Namespaces(), true)
 	return &egressController{
 		controller,
 		client,
@@ -543,6 +549,222 @@ func TestUpdateEgress(t *testing.T) {
 	checkExternalIPPoolUsed(t, controller, eipFoo2.Name, 0)
 }
 
+func TestNamespaceWideEgressPrecedence(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	controller := newController([]runtime.Object{nsDefault, podFoo1, podFoo2, podBar1}, nil)
+	controller.informerFactory.Start(stopCh)
+	controller.crdInformerFactory.Start(stopCh)
+	controller.informerFactory.WaitForCacheSync(stopCh)
+	controller.crdInformerFactory.WaitForCacheSync(stopCh)
+	go controller.externalIPAllocator.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
+	go controller.groupingInterface.Run(stopCh)
+	go controller.groupingController.Run(stopCh)
+	go controller.Run(stopCh)
+
+	getGroupMembers := func(name string) sets.Set[string] {
+		obj, found, _ := controller.egressGroupStore.Get(name)
+		if !found {
+			return nil
+		}
+		return groupMemberKeys(obj.(*antreatypes.EgressGroup))
+	}
+	allPods := sets.New[string]("default/podFoo1", "default/podFoo2", "default/podBar1")
+
+	egressA := newEgress("egressA", "1.1.1.1", "", nil, &metav1.LabelSelector{MatchLabels: nsDefault.Labels}, nil)
+	_, err := controller.crdClient.CrdV1beta1().Egresses().Create(context.TODO(), egressA, metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, allPods, getGroupMembers(egressA.Name))
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// The Egress selecting Pods with a podSelector takes precedence over the namespace-wide Egress.
+	egressB := newEgress("egressB", "1.1.1.2", "", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, nil)
+	_, err = controller.crdClient.CrdV1beta1().Egresses().Create(context.TODO(), egressB, metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, sets.New[string]("default/podFoo1", "default/podFoo2"), getGroupMembers(egressB.Name))
+		assert.Equal(c, sets.New[string]("default/podBar1"), getGroupMembers(egressA.Name))
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// The namespace-wide Egress takes over the Pods which are no longer selected by the other Egress.
+	updatedPodFoo2 := podFoo2.DeepCopy()
+	updatedPodFoo2.Labels = map[string]string{"app": "bar"}
+	_, err = controller.client.CoreV1().Pods(podFoo2.Namespace).Update(context.TODO(), updatedPodFoo2, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, sets.New[string]("default/podFoo1"), getGroupMembers(egressB.Name))
+		assert.Equal(c, sets.New[string]("default/podFoo2", "default/podBar1"), getGroupMembers(egressA.Name))
+	}, 2*time.Second, 50*time.Millisecond)
+
+	require.NoError(t, controller.crdClient.CrdV1beta1().Egresses().Delete(context.TODO(), egressB.Name, metav1.DeleteOptions{}))
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, allPods, getGroupMembers(egressA.Name))
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func TestNodePortLocalEgressMode(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	podFoo1Exposed := podFoo1.DeepCopy()
+	podFoo1Exposed.Annotations = map[string]string{npltypes.NPLAnnotationKey: "[]"}
+	podFoo2Exposed := podFoo2.DeepCopy()
+	podFoo2Exposed.Annotations = map[string]string{
+		npltypes.NPLAnnotationKey:           "[]",
+		npltypes.NPLEgressModeAnnotationKey: string(npltypes.EgressModePreferNodePortLocal),
+	}
+	controller := newController([]runtime.Object{nsDefault, podFoo1Exposed, podFoo2Exposed, podBar1}, nil)
+	controller.informerFactory.Start(stopCh)
+	controller.crdInformerFactory.Start(stopCh)
+	controller.informerFactory.WaitForCacheSync(stopCh)
+	controller.crdInformerFactory.WaitForCacheSync(stopCh)
+	go controller.externalIPAllocator.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
+	go controller.groupingInterface.Run(stopCh)
+	go controller.groupingController.Run(stopCh)
+	go controller.Run(stopCh)
+
+	getGroupMembers := func(name string) sets.Set[string] {
+		obj, found, _ := controller.egressGroupStore.Get(name)
+		if !found {
+			return nil
+		}
+		return groupMemberKeys(obj.(*antreatypes.EgressGroup))
+	}
+
+	// podFoo2 is exposed via NodePortLocal and prefers NodePortLocal.
+	egress := newEgress("egressA", "1.1.1.1", "", nil, &metav1.LabelSelector{MatchLabels: nsDefault.Labels}, nil)
+	_, err := controller.crdClient.CrdV1beta1().Egresses().Create(context.TODO(), egress, metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, sets.New[string]("default/podFoo1", "default/podBar1"), getGroupMembers(egress.Name))
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// The Namespace annotation applies to podFoo1, but not to podBar1 which is not exposed via NodePortLocal.
+	updatedNamespace := nsDefault.DeepCopy()
+	updatedNamespace.Annotations = map[string]string{npltypes.NPLEgressModeAnnotationKey: string(npltypes.EgressModePreferNodePortLocal)}
+	_, err = controller.client.CoreV1().Namespaces().Update(context.TODO(), updatedNamespace, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, sets.New[string]("default/podBar1"), getGroupMembers(egress.Name))
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// The Pod annotation takes precedence over the Namespace annotation, and an invalid Pod annotation is ignored.
+	updatedPodFoo1 := podFoo1Exposed.DeepCopy()
+	updatedPodFoo1.Annotations[npltypes.NPLEgressModeAnnotationKey] = string(npltypes.EgressModeCoexist)
+	_, err = controller.client.CoreV1().Pods(podFoo1.Namespace).Update(context.TODO(), updatedPodFoo1, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	updatedPodFoo2 := podFoo2Exposed.DeepCopy()
+	updatedPodFoo2.Annotations[npltypes.NPLEgressModeAnnotationKey] = "invalid"
+	_, err = controller.client.CoreV1().Pods(podFoo2.Namespace).Update(context.TODO(), updatedPodFoo2, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, sets.New[string]("default/podFoo1", "default/podBar1"), getGroupMembers(egress.Name))
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// podFoo2 is no longer exposed via NodePortLocal.
+	delete(updatedPodFoo2.Annotations, npltypes.NPLAnnotationKey)
+	_, err = controller.client.CoreV1().Pods(podFoo2.Namespace).Update(context.TODO(), updatedPodFoo2, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, sets.New[string]("default/podFoo1", "default/podFoo2", "default/podBar1"), getGroupMembers(egress.Name))
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func TestPodEgressIP(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	podFoo1WithUID := podFoo1.DeepCopy()
+	podFoo1WithUID.UID = "uid-foo1"
+	controller := newController([]runtime.Object{nsDefault, podFoo1WithUID, podFoo2}, []runtime.Object{eipFoo1})
+	controller.SetPodEgressIPAllowedPools(map[string][]string{nsDefault.Name: {eipFoo1.Name}})
+	controller.informerFactory.Start(stopCh)
+	controller.crdInformerFactory.Start(stopCh)
+	controller.informerFactory.WaitForCacheSync(stopCh)
+	controller.crdInformerFactory.WaitForCacheSync(stopCh)
+	go controller.externalIPAllocator.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
+	go controller.groupingInterface.Run(stopCh)
+	go controller.groupingController.Run(stopCh)
+	go controller.Run(stopCh)
+
+	getGroupMembers := func(name string) sets.Set[string] {
+		obj, found, _ := controller.egressGroupStore.Get(name)
+		if !found {
+			return nil
+		}
+		return groupMemberKeys(obj.(*antreatypes.EgressGroup))
+	}
+
+	egress := newEgress("egressA", "1.1.1.1", "", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, nil, nil)
+	_, err := controller.crdClient.CrdV1beta1().Egresses().Create(context.TODO(), egress, metav1.CreateOptions{})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, sets.New[string]("default/podFoo1", "default/podFoo2"), getGroupMembers(egress.Name))
+	}, 2*time.Second, 50*time.Millisecond)
+
+	// The EgressIP requested by podFoo2 is ignored as its Namespace is not allowed to use the ExternalIPPool.
+	updatedPodFoo2 := podFoo2.DeepCopy()
+	updatedPodFoo2.Annotations = map[string]string{
+		apis.PodEgressIPAnnotationKey:             "2.2.2.10",
+		apis.PodEgressExternalIPPoolAnnotationKey: eipFoo2.Name,
+	}
+	_, err = controller.client.CoreV1().Pods(podFoo2.Namespace).Update(context.TODO(), updatedPodFoo2, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	// An Egress is created for the EgressIP requested by podFoo1, which takes precedence over the other Egress.
+	updatedPodFoo1 := podFoo1WithUID.DeepCopy()
+	updatedPodFoo1.Annotations = map[string]string{
+		apis.PodEgressIPAnnotationKey:             "1.1.1.10",
+		apis.PodEgressExternalIPPoolAnnotationKey: eipFoo1.Name,
+	}
+	_, err = controller.client.CoreV1().Pods(podFoo1.Namespace).Update(context.TODO(), updatedPodFoo1, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	podEgressName := "pod-uid-foo1"
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		podEgress, err := controller.crdClient.CrdV1beta1().Egresses().Get(context.TODO(), podEgressName, metav1.GetOptions{})
+		if !assert.NoError(c, err) {
+			return
+		}
+		assert.Equal(c, "1.1.1.10", podEgress.Spec.EgressIP)
+		assert.Equal(c, eipFoo1.Name, podEgress.Spec.ExternalIPPool)
+		assert.Equal(c, "default/podFoo1", podEgress.Annotations[apis.EgressPodAnnotationKey])
+		assert.Equal(c, sets.New[string]("default/podFoo1"), getGroupMembers(podEgressName))
+		assert.Equal(c, sets.New[string]("default/podFoo2"), getGroupMembers(egress.Name))
+		pod, err := controller.client.CoreV1().Pods(podFoo1.Namespace).Get(context.TODO(), podFoo1.Name, metav1.GetOptions{})
+		if !assert.NoError(c, err) {
+			return
+		}
+		assert.Equal(c, podEgressName, pod.Annotations[apis.PodEgressAnnotationKey])
+		egresses, err := controller.crdClient.CrdV1beta1().Egresses().List(context.TODO(), metav1.ListOptions{})
+		if !assert.NoError(c, err) {
+			return
+		}
+		assert.Len(c, egresses.Items, 2)
+	}, 2*time.Second, 50*time.Millisecond)
+	checkExternalIPPoolUsed(t, controller, eipFoo1.Name, 1)
+
+	// The Egress is deleted and the IP is released once the Pod no longer requests it.
+	updatedPodFoo1, err = controller.client.CoreV1().Pods(podFoo1.Namespace).Get(context.TODO(), podFoo1.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	delete(updatedPodFoo1.Annotations, apis.PodEgressIPAnnotationKey)
+	delete(updatedPodFoo1.Annotations, apis.PodEgressExternalIPPoolAnnotationKey)
+	_, err = controller.client.CoreV1().Pods(podFoo1.Namespace).Update(context.TODO(), updatedPodFoo1, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		_, err := controller.crdClient.CrdV1beta1().Egresses().Get(context.TODO(), podEgressName, metav1.GetOptions{})
+		assert.True(c, errors.IsNotFound(err))
+		assert.Equal(c, sets.New[string]("default/podFoo1", "default/podFoo2"), getGroupMembers(egress.Name))
+		pod, err := controller.client.CoreV1().Pods(podFoo1.Namespace).Get(context.TODO(), podFoo1.Name, metav1.GetOptions{})
+		if !assert.NoError(c, err) {
+			return
+		}
+		assert.NotContains(c, pod.Annotations, apis.PodEgressAnnotationKey)
+	}, 2*time.Second, 50*time.Millisecond)
+	checkExternalIPPoolUsed(t, controller, eipFoo1.Name, 0)
+}
+
 // TestRecreateExternalIPPoolWithNewRange tests the case where an ExternalIPPool is deleted, then
 // immediately recreated with a different IP range. Specifically we test the scenario where
 // syncEgress / syncEgressIP are called only once because the DELETE and CREATE events are merged in
@@ -788,6 +1010,29 @@ func TestSyncEgressIP(t *testing.T) {
 			expectedExternalIPPoolUsed: 0,
 			expectErr:                  false,
 		},
+		{
+			name: "Egress with empty ExternalIPPool and conflicting EgressIP",
+			existingEgresses: []*v1beta1.Egress{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+					Spec: v1beta1.EgressSpec{
+						EgressIP:       "1.1.1.2",
+						ExternalIPPool: "ipPoolA",
+					},
+				},
+			},
+			existingExternalIPPool: newExternalIPPool("ipPoolA", "1.1.1.0/24", "", ""),
+			inputEgress: &v1beta1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressB", UID: "uidB"},
+				Spec: v1beta1.EgressSpec{
+					EgressIP: "1.1.1.2",
+				},
+			},
+			// The static EgressIP is still returned, the conflict is only reported.
+			expectedEgressIP:           "1.1.1.2",
+			expectedExternalIPPoolUsed: 1,
+			expectErr:                  true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -829,7 +1074,7 @@ func checkExternalIPPoolUsed(t *testing.T, controller *egressController, poolNam
 	assert.NoError(t, err)
 }
 
-func TestUpdateEgressAllocatedCondition(t *testing.T) {
+func TestUpdateEgressConditions(t *testing.T) {
 	tests := []struct {
 		name           string
 		inputEgress    *v1beta1.Egress
@@ -882,6 +1127,65 @@ func TestUpdateEgressAllocatedCondition(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "specifying IP conflicts with Service",
+			inputEgress: &v1beta1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1beta1.EgressSpec{
+					EgressIP:       "1.1.1.1",
+					ExternalIPPool: "pool1",
+				},
+			},
+			inputErr: fmt.Errorf("error when allocating IP: %w", &externalippool.IPConflictError{
+				IP:    net.ParseIP("1.1.1.1"),
+				Owner: v1.ObjectReference{Kind: "Service", Namespace: "ns1", Name: "svc1"},
+			}),
+			expectedStatus: v1beta1.EgressStatus{
+				Conditions: []v1beta1.EgressCondition{
+					{Type: v1beta1.IPAllocated, Status: v1.ConditionFalse, Reason: "IPConflict", Message: "Cannot allocate EgressIP from ExternalIPPool: error when allocating IP: IP 1.1.1.1 is already allocated to Service ns1/svc1"},
+					{Type: v1beta1.IPConflict, Status: v1.ConditionTrue, Reason: "AllocatedToService", Message: "IP 1.1.1.1 is already allocated to Service ns1/svc1"},
+				},
+			},
+		},
+		{
+			name: "static IP conflicts with Service",
+			inputEgress: &v1beta1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1beta1.EgressSpec{
+					EgressIP: "1.1.1.1",
+				},
+				Status: v1beta1.EgressStatus{
+					Conditions: []v1beta1.EgressCondition{
+						{Type: v1beta1.IPAssigned, Status: v1.ConditionTrue, Reason: "Assigned", Message: "EgressIP is successfully assigned to EgressNode"},
+					},
+				},
+			},
+			inputErr: &externalippool.IPConflictError{
+				IP:    net.ParseIP("1.1.1.1"),
+				Owner: v1.ObjectReference{Kind: "Service", Namespace: "ns1", Name: "svc1"},
+			},
+			expectedStatus: v1beta1.EgressStatus{
+				Conditions: []v1beta1.EgressCondition{
+					{Type: v1beta1.IPAssigned, Status: v1.ConditionTrue, Reason: "Assigned", Message: "EgressIP is successfully assigned to EgressNode"},
+					{Type: v1beta1.IPConflict, Status: v1.ConditionTrue, Reason: "AllocatedToService", Message: "IP 1.1.1.1 is already allocated to Service ns1/svc1"},
+				},
+			},
+		},
+		{
+			name: "resolving conflict succeeds",
+			inputEgress: &v1beta1.Egress{
+				ObjectMeta: metav1.ObjectMeta{Name: "egressA", UID: "uidA"},
+				Spec: v1beta1.EgressSpec{
+					EgressIP: "1.1.1.1",
+				},
+				Status: v1beta1.EgressStatus{
+					Conditions: []v1beta1.EgressCondition{
+						{Type: v1beta1.IPConflict, Status: v1.ConditionTrue, Reason: "AllocatedToService", Message: "IP 1.1.1.1 is already allocated to Service ns1/svc1"},
+					},
+				},
+			},
+			expectedStatus: v1beta1.EgressStatus{},
+		},
 		{
 			name: "updating condition succeeds",
 			inputEgress: &v1beta1.Egress{
@@ -927,7 +1231,7 @@ func TestUpdateEgressAllocatedCondition(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			controller := newController(nil, []runtime.Object{tt.inputEgress})
-			controller.updateEgressAllocatedCondition(tt.inputEgress, tt.inputErr)
+			controller.updateEgressConditions(tt.inputEgress, tt.inputErr)
 			gotEgress, err := controller.crdClient.CrdV1beta1().Egresses().Get(context.TODO(), tt.inputEgress.Name, metav1.GetOptions{})
 			require.NoError(t, err)
 			assert.True(t, k8s.SemanticIgnoringTime.DeepEqual(tt.expectedStatus, gotEgress.Status), "Expected:\n%v\ngot:\n%v", tt.expectedStatus, gotEgress.Status)
@@ -0,0 +1,282 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package egress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis"
+	egressv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+// podEgressNamePrefix is the prefix of the names of the Egresses created for the EgressIPs requested by Pods.
+const podEgressNamePrefix = "pod-"
+
+// podEgressName returns the name of the Egress created for the EgressIP requested by the Pod. The UID of the Pod is
+// used so that a recreated Pod with the same name doesn't reuse the Egress of the previous one.
+func podEgressName(pod *v1.Pod) string {
+	return podEgressNamePrefix + string(pod.UID)
+}
+
+// isPodEgress returns whether the Egress was created for the EgressIP requested by a Pod.
+func isPodEgress(egress *egressv1beta1.Egress) bool {
+	_, exists := egress.Annotations[apis.EgressPodAnnotationKey]
+	return exists
+}
+
+// getPodEgressRequest returns the EgressIP and the ExternalIPPool requested by the Pod with annotations. requested is
+// false if either of the annotations is not set.
+func getPodEgressRequest(pod *v1.Pod) (ip string, pool string, requested bool) {
+	ip, ipExists := pod.Annotations[apis.PodEgressIPAnnotationKey]
+	pool, poolExists := pod.Annotations[apis.PodEgressExternalIPPoolAnnotationKey]
+	if !ipExists || !poolExists {
+		return "", "", false
+	}
+	return ip, pool, true
+}
+
+// newPodEgress returns the Egress applying the EgressIP requested by the Pod to it.
+func newPodEgress(pod *v1.Pod, ip, pool string) *egressv1beta1.Egress {
+	return &egressv1beta1.Egress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: podEgressName(pod),
+			Annotations: map[string]string{
+				apis.EgressPodAnnotationKey: k8s.NamespacedName(pod.Namespace, pod.Name),
+			},
+		},
+		Spec: egressv1beta1.EgressSpec{
+			EgressIP:       ip,
+			ExternalIPPool: pool,
+		},
+	}
+}
+
+// getPodOfPodEgress returns the Pod selected by the Egress created for the EgressIP requested by the Pod, if it still
+// exists.
+func (c *EgressController) getPodOfPodEgress(egress *egressv1beta1.Egress) []*v1.Pod {
+	namespace, name := k8s.SplitNamespacedName(egress.Annotations[apis.EgressPodAnnotationKey])
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil || podEgressName(pod) != egress.Name {
+		return nil
+	}
+	return []*v1.Pod{pod}
+}
+
+// hasPodEgress returns whether an Egress has been created for the EgressIP requested by the Pod.
+func (c *EgressController) hasPodEgress(pod *v1.Pod) bool {
+	egress, err := c.egressLister.Get(podEgressName(pod))
+	if err != nil {
+		return false
+	}
+	return egress.Annotations[apis.EgressPodAnnotationKey] == k8s.NamespacedName(pod.Namespace, pod.Name)
+}
+
+// enqueueEgressesOfPodEgress enqueues the other Egresses selecting the Pod of the provided Egress.
+func (c *EgressController) enqueueEgressesOfPodEgress(egress *egressv1beta1.Egress) {
+	namespace, name := k8s.SplitNamespacedName(egress.Annotations[apis.EgressPodAnnotationKey])
+	groups, _ := c.groupingInterface.GetGroupsForPod(namespace, name)
+	for _, egressName := range groups[egressGroupType] {
+		c.queue.Add(egressName)
+	}
+}
+
+// isPodEgressRelevant returns whether the Pod requests an EgressIP or has been assigned one.
+func isPodEgressRelevant(pod *v1.Pod) bool {
+	_, _, requested := getPodEgressRequest(pod)
+	_, assigned := pod.Annotations[apis.PodEgressAnnotationKey]
+	return requested || assigned
+}
+
+func (c *EgressController) addPodForPodEgress(obj interface{}) {
+	pod := obj.(*v1.Pod)
+	if !isPodEgressRelevant(pod) {
+		return
+	}
+	c.podQueue.Add(k8s.NamespacedName(pod.Namespace, pod.Name))
+}
+
+func (c *EgressController) updatePodForPodEgress(old, cur interface{}) {
+	oldPod := old.(*v1.Pod)
+	curPod := cur.(*v1.Pod)
+	if !isPodEgressRelevant(oldPod) && !isPodEgressRelevant(curPod) {
+		return
+	}
+	oldIP, oldPool, oldRequested := getPodEgressRequest(oldPod)
+	curIP, curPool, curRequested := getPodEgressRequest(curPod)
+	if oldIP == curIP && oldPool == curPool && oldRequested == curRequested &&
+		oldPod.Annotations[apis.PodEgressAnnotationKey] == curPod.Annotations[apis.PodEgressAnnotationKey] {
+		return
+	}
+	c.podQueue.Add(k8s.NamespacedName(curPod.Namespace, curPod.Name))
+}
+
+func (c *EgressController) deletePodForPodEgress(obj interface{}) {
+	pod, ok := obj.(*v1.Pod)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Received unexpected object: %v", obj)
+			return
+		}
+		pod, ok = tombstone.Obj.(*v1.Pod)
+		if !ok {
+			klog.Errorf("DeletedFinalStateUnknown contains non-Pod object: %v", tombstone.Obj)
+			return
+		}
+	}
+	if !isPodEgressRelevant(pod) {
+		return
+	}
+	c.podQueue.Add(k8s.NamespacedName(pod.Namespace, pod.Name))
+}
+
+func (c *EgressController) podEgressWorker() {
+	for c.processNextPodEgressWorkItem() {
+	}
+}
+
+func (c *EgressController) processNextPodEgressWorkItem() bool {
+	key, quit := c.podQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.podQueue.Done(key)
+
+	if err := c.syncPodEgress(key); err != nil {
+		// Put the item back on the workqueue to handle any transient errors.
+		c.podQueue.AddRateLimited(key)
+		klog.ErrorS(err, "Failed to sync Egress of Pod", "pod", key)
+		return true
+	}
+	c.podQueue.Forget(key)
+	return true
+}
+
+// syncPodEgress creates, updates or deletes the Egress applying the EgressIP requested by the Pod, and sets the name
+// of the Egress in the annotation of the Pod once the EgressIP is allocated.
+func (c *EgressController) syncPodEgress(key string) error {
+	startTime := time.Now()
+	defer func() {
+		d := time.Since(startTime)
+		klog.V(2).InfoS("Finished syncing Egress of Pod", "pod", key, "duration", d)
+	}()
+
+	namespace, name := k8s.SplitNamespacedName(key)
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		pod = nil
+	}
+	var ip, pool string
+	requested := false
+	if pod != nil {
+		ip, pool, requested = getPodEgressRequest(pod)
+		// The validating webhook may not have been called for the Pod, e.g. if it was unavailable or the Pod was
+		// created before the allowed ExternalIPPools were changed, so the request is checked again here.
+		if requested && !c.isPodEgressIPPoolAllowed(pod.Namespace, pool) {
+			klog.InfoS("Ignoring EgressIP requested by Pod from an ExternalIPPool not allowed for its Namespace", "pod", key, "externalIPPool", pool)
+			requested = false
+		}
+	}
+
+	// Delete the Egresses which are no longer requested, e.g. because the Pod has been deleted or recreated.
+	objects, _ := c.egressIndexer.ByIndex(podEgressIndex, key)
+	for _, obj := range objects {
+		egress := obj.(*egressv1beta1.Egress)
+		if requested && egress.Name == podEgressName(pod) {
+			continue
+		}
+		if err := c.crdClient.CrdV1beta1().Egresses().Delete(context.TODO(), egress.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("error when deleting Egress %s of Pod %s: %w", egress.Name, key, err)
+		}
+		klog.InfoS("Deleted Egress of Pod", "egress", egress.Name, "pod", key)
+	}
+	if pod == nil {
+		return nil
+	}
+	if !requested {
+		return c.updatePodEgressAnnotation(pod, "")
+	}
+
+	egress, err := c.egressLister.Get(podEgressName(pod))
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+		// The annotation of the Pod will be set when the Egress is updated with its conditions.
+		egress = newPodEgress(pod, ip, pool)
+		if _, err := c.crdClient.CrdV1beta1().Egresses().Create(context.TODO(), egress, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("error when creating Egress %s of Pod %s: %w", egress.Name, key, err)
+		}
+		klog.InfoS("Created Egress of Pod", "egress", egress.Name, "pod", key, "ip", ip, "pool", pool)
+		return c.updatePodEgressAnnotation(pod, "")
+	}
+	if egress.Spec.EgressIP != ip || egress.Spec.ExternalIPPool != pool {
+		toUpdate := egress.DeepCopy()
+		toUpdate.Spec.EgressIP = ip
+		toUpdate.Spec.ExternalIPPool = pool
+		if _, err := c.crdClient.CrdV1beta1().Egresses().Update(context.TODO(), toUpdate, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error when updating Egress %s of Pod %s: %w", egress.Name, key, err)
+		}
+		klog.InfoS("Updated Egress of Pod", "egress", egress.Name, "pod", key, "ip", ip, "pool", pool)
+		return c.updatePodEgressAnnotation(pod, "")
+	}
+	allocated := false
+	if condition := egressv1beta1.GetEgressCondition(egress.Status.Conditions, egressv1beta1.IPAllocated); condition != nil {
+		allocated = condition.Status == v1.ConditionTrue
+	}
+	if !allocated {
+		return c.updatePodEgressAnnotation(pod, "")
+	}
+	return c.updatePodEgressAnnotation(pod, egress.Name)
+}
+
+// updatePodEgressAnnotation sets the annotation of the Pod to the name of the Egress applying its requested EgressIP,
+// or removes it if egressName is empty.
+func (c *EgressController) updatePodEgressAnnotation(pod *v1.Pod, egressName string) error {
+	value, exists := pod.Annotations[apis.PodEgressAnnotationKey]
+	if (egressName == "" && !exists) || (egressName != "" && value == egressName) {
+		return nil
+	}
+	var valuePtr *string
+	if egressName != "" {
+		valuePtr = &egressName
+	}
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]*string{
+				apis.PodEgressAnnotationKey: valuePtr,
+			},
+		},
+	}
+	patchBytes, _ := json.Marshal(patch)
+	if _, err := c.client.CoreV1().Pods(pod.Namespace).Patch(context.TODO(), pod.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error when updating annotation of Pod %s/%s: %w", pod.Namespace, pod.Name, err)
+	}
+	return nil
+}
@@ -18,13 +18,21 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"reflect"
 
 	admv1 "k8s.io/api/admission/v1"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
+	npltypes "antrea.io/antrea/pkg/agent/nodeportlocal/types"
+	"antrea.io/antrea/pkg/apis"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/externalippool"
 )
 
 func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.AdmissionResponse {
@@ -54,6 +62,14 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 		if len(newEgress.Spec.ExternalIPPools) > 0 {
 			return false, "spec.externalIPPools is not supported yet"
 		}
+		// Reject a namespace-wide Egress which may select the same Namespaces as another namespace-wide Egress, as it
+		// would be undetermined which of them applies to the Pods. Namespaces can be created or relabeled at any time,
+		// so the selectors are compared instead of the Namespaces they currently select.
+		if isNamespaceWideEgress(newEgress) && !reflect.DeepEqual(oldEgress.Spec.AppliedTo, newEgress.Spec.AppliedTo) {
+			if conflictingEgress := c.getOverlappingNamespaceWideEgress(newEgress); conflictingEgress != "" {
+				return false, fmt.Sprintf("Egress %s may select all Pods in the same Namespaces as the namespaceSelector", conflictingEgress)
+			}
+		}
 		// Validate Egress trafficShaping
 		if newEgress.Spec.Bandwidth != nil {
 			_, err := resource.ParseQuantity(newEgress.Spec.Bandwidth.Rate)
@@ -69,19 +85,28 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 		if newEgress.Spec.EgressIP == oldEgress.Spec.EgressIP && newEgress.Spec.ExternalIPPool == oldEgress.Spec.ExternalIPPool {
 			return true, ""
 		}
-		// Only validate whether the specified Egress IP is in the Pool when they are both set.
-		if newEgress.Spec.EgressIP == "" || newEgress.Spec.ExternalIPPool == "" {
+		if newEgress.Spec.EgressIP == "" {
 			return true, ""
 		}
 		ip := net.ParseIP(newEgress.Spec.EgressIP)
-		if ip == nil {
-			return false, fmt.Sprintf("IP %s is not valid", newEgress.Spec.EgressIP)
-		}
-		if !c.externalIPAllocator.IPPoolExists(newEgress.Spec.ExternalIPPool) {
-			return false, fmt.Sprintf("ExternalIPPool %s does not exist", newEgress.Spec.ExternalIPPool)
+		// Only validate whether the specified Egress IP is in the Pool when they are both set.
+		if newEgress.Spec.ExternalIPPool != "" {
+			if ip == nil {
+				return false, fmt.Sprintf("IP %s is not valid", newEgress.Spec.EgressIP)
+			}
+			if !c.externalIPAllocator.IPPoolExists(newEgress.Spec.ExternalIPPool) {
+				return false, fmt.Sprintf("ExternalIPPool %s does not exist", newEgress.Spec.ExternalIPPool)
+			}
+			if !c.externalIPAllocator.IPPoolHasIP(newEgress.Spec.ExternalIPPool, ip) {
+				return false, fmt.Sprintf("IP %s is not within the IP range", newEgress.Spec.EgressIP)
+			}
 		}
-		if !c.externalIPAllocator.IPPoolHasIP(newEgress.Spec.ExternalIPPool, ip) {
-			return false, fmt.Sprintf("IP %s is not within the IP range", newEgress.Spec.EgressIP)
+		// Reject the Egress IP if it has been allocated to another consumer of ExternalIPPools, e.g. a LoadBalancer
+		// Service, as the IP cannot be used by both of them.
+		if ip != nil {
+			if owner, allocated := c.externalIPAllocator.GetIPOwner(ip); allocated && owner != egressOwnerReference(newEgress.Name) {
+				return false, (&externalippool.IPConflictError{IP: ip, Owner: owner}).Error()
+			}
 		}
 		return true, ""
 	}
@@ -110,6 +135,231 @@ func (c *EgressController) ValidateEgress(review *admv1.AdmissionReview) *admv1.
 	}
 }
 
+// ValidatePod validates the NodePortLocal egress mode and the EgressIP requested by a Pod with annotations.
+func (c *EgressController) ValidatePod(review *admv1.AdmissionReview) *admv1.AdmissionResponse {
+	var result *metav1.Status
+	var msg string
+	allowed := true
+
+	klog.V(2).Info("Validating Pod", "request", review.Request)
+	var newObj, oldObj v1.Pod
+	if review.Request.Object.Raw != nil {
+		if err := json.Unmarshal(review.Request.Object.Raw, &newObj); err != nil {
+			klog.ErrorS(err, "Error de-serializing current Pod")
+			return newAdmissionResponseForErr(err)
+		}
+	}
+	if review.Request.OldObject.Raw != nil {
+		if err := json.Unmarshal(review.Request.OldObject.Raw, &oldObj); err != nil {
+			klog.ErrorS(err, "Error de-serializing old Pod")
+			return newAdmissionResponseForErr(err)
+		}
+	}
+
+	shouldAllow := func(oldPod, newPod *v1.Pod) (bool, string) {
+		if msg := validateNodePortLocalEgressMode(oldPod.Annotations, newPod.Annotations); msg != "" {
+			return false, msg
+		}
+		if !c.podEgressIPEnabled {
+			return true, ""
+		}
+		newIP, newIPExists := newPod.Annotations[apis.PodEgressIPAnnotationKey]
+		newPool, newPoolExists := newPod.Annotations[apis.PodEgressExternalIPPoolAnnotationKey]
+		// Allow it if the requested EgressIP and ExternalIPPool don't change.
+		if newIP == oldPod.Annotations[apis.PodEgressIPAnnotationKey] && newPool == oldPod.Annotations[apis.PodEgressExternalIPPoolAnnotationKey] {
+			return true, ""
+		}
+		if !newIPExists && !newPoolExists {
+			return true, ""
+		}
+		if !newIPExists || !newPoolExists {
+			return false, fmt.Sprintf("Annotations %s and %s must be set together", apis.PodEgressIPAnnotationKey, apis.PodEgressExternalIPPoolAnnotationKey)
+		}
+		ip := net.ParseIP(newIP)
+		if ip == nil {
+			return false, fmt.Sprintf("IP %s is not valid", newIP)
+		}
+		if !c.externalIPAllocator.IPPoolExists(newPool) {
+			return false, fmt.Sprintf("ExternalIPPool %s does not exist", newPool)
+		}
+		// The Namespace of the Pod may be unset in the object of a CREATE request.
+		namespace := newPod.Namespace
+		if namespace == "" {
+			namespace = review.Request.Namespace
+		}
+		if !c.isPodEgressIPPoolAllowed(namespace, newPool) {
+			return false, fmt.Sprintf("Pods in Namespace %s are not allowed to request an EgressIP from ExternalIPPool %s", namespace, newPool)
+		}
+		if !c.externalIPAllocator.IPPoolHasIP(newPool, ip) {
+			return false, fmt.Sprintf("IP %s is not within the IP range", newIP)
+		}
+		// Reject the IP if it has been allocated to another consumer of ExternalIPPools, including the Egresses of
+		// other Pods.
+		if owner, allocated := c.externalIPAllocator.GetIPOwner(ip); allocated && owner != egressOwnerReference(podEgressName(newPod)) {
+			return false, (&externalippool.IPConflictError{IP: ip, Owner: owner}).Error()
+		}
+		return true, ""
+	}
+
+	switch review.Request.Operation {
+	case admv1.Create:
+		klog.V(2).Info("Validating CREATE request for Pod")
+		allowed, msg = shouldAllow(&oldObj, &newObj)
+	case admv1.Update:
+		klog.V(2).Info("Validating UPDATE request for Pod")
+		allowed, msg = shouldAllow(&oldObj, &newObj)
+	}
+
+	if msg != "" {
+		result = &metav1.Status{
+			Message: msg,
+		}
+	}
+	return &admv1.AdmissionResponse{
+		Allowed: allowed,
+		Result:  result,
+	}
+}
+
+// ValidateNamespace validates the NodePortLocal egress mode of a Namespace.
+func (c *EgressController) ValidateNamespace(review *admv1.AdmissionReview) *admv1.AdmissionResponse {
+	var result *metav1.Status
+	var msg string
+
+	klog.V(2).Info("Validating Namespace", "request", review.Request)
+	var newObj, oldObj v1.Namespace
+	if review.Request.Object.Raw != nil {
+		if err := json.Unmarshal(review.Request.Object.Raw, &newObj); err != nil {
+			klog.ErrorS(err, "Error de-serializing current Namespace")
+			return newAdmissionResponseForErr(err)
+		}
+	}
+	if review.Request.OldObject.Raw != nil {
+		if err := json.Unmarshal(review.Request.OldObject.Raw, &oldObj); err != nil {
+			klog.ErrorS(err, "Error de-serializing old Namespace")
+			return newAdmissionResponseForErr(err)
+		}
+	}
+
+	switch review.Request.Operation {
+	case admv1.Create:
+		klog.V(2).Info("Validating CREATE request for Namespace")
+		msg = validateNodePortLocalEgressMode(oldObj.Annotations, newObj.Annotations)
+	case admv1.Update:
+		klog.V(2).Info("Validating UPDATE request for Namespace")
+		msg = validateNodePortLocalEgressMode(oldObj.Annotations, newObj.Annotations)
+	}
+	allowed := msg == ""
+
+	if msg != "" {
+		result = &metav1.Status{
+			Message: msg,
+		}
+	}
+	return &admv1.AdmissionResponse{
+		Allowed: allowed,
+		Result:  result,
+	}
+}
+
+// validateNodePortLocalEgressMode returns an error message if the NodePortLocal egress mode annotation is changed to
+// an invalid value. An unchanged annotation is allowed, so that the objects annotated before the validation was
+// enforced can still be updated.
+func validateNodePortLocalEgressMode(oldAnnotations, newAnnotations map[string]string) string {
+	value, exists := newAnnotations[npltypes.NPLEgressModeAnnotationKey]
+	if !exists {
+		return ""
+	}
+	if oldValue, oldExists := oldAnnotations[npltypes.NPLEgressModeAnnotationKey]; oldExists && oldValue == value {
+		return ""
+	}
+	if _, err := npltypes.ParseEgressMode(value); err != nil {
+		return err.Error()
+	}
+	return ""
+}
+
+// getOverlappingNamespaceWideEgress returns the name of another namespace-wide Egress whose namespaceSelector may
+// select the same Namespaces as the provided one.
+func (c *EgressController) getOverlappingNamespaceWideEgress(egress *crdv1beta1.Egress) string {
+	egresses, _ := c.egressLister.List(labels.Everything())
+	for _, other := range egresses {
+		if other.Name == egress.Name || !isNamespaceWideEgress(other) {
+			continue
+		}
+		if selectorsMayOverlap(egress.Spec.AppliedTo.NamespaceSelector, other.Spec.AppliedTo.NamespaceSelector) {
+			return other.Name
+		}
+	}
+	return ""
+}
+
+// labelConstraint is the combination of the requirements of label selectors on a label key.
+type labelConstraint struct {
+	// allowed is the set of values the label can have, nil means any value.
+	allowed      sets.Set[string]
+	excluded     sets.Set[string]
+	exists       bool
+	doesNotExist bool
+}
+
+func (lc *labelConstraint) add(r labels.Requirement) {
+	switch r.Operator() {
+	case selection.In, selection.Equals, selection.DoubleEquals:
+		values := sets.New[string](r.Values().UnsortedList()...)
+		if lc.allowed == nil {
+			lc.allowed = values
+		} else {
+			lc.allowed = lc.allowed.Intersection(values)
+		}
+		lc.exists = true
+	case selection.NotIn, selection.NotEquals:
+		lc.excluded = lc.excluded.Union(sets.New[string](r.Values().UnsortedList()...))
+	case selection.Exists:
+		lc.exists = true
+	case selection.DoesNotExist:
+		lc.doesNotExist = true
+	}
+}
+
+func (lc *labelConstraint) satisfiable() bool {
+	if lc.exists && lc.doesNotExist {
+		return false
+	}
+	if lc.allowed != nil && lc.allowed.Difference(lc.excluded).Len() == 0 {
+		return false
+	}
+	return true
+}
+
+// selectorsMayOverlap returns whether some set of labels may be selected by both label selectors. The selectors only
+// cannot overlap when their requirements on some label key contradict each other. Invalid selectors are considered
+// as overlapping.
+func selectorsMayOverlap(a, b *metav1.LabelSelector) bool {
+	constraints := map[string]*labelConstraint{}
+	for _, selector := range []*metav1.LabelSelector{a, b} {
+		s, err := metav1.LabelSelectorAsSelector(selector)
+		if err != nil {
+			return true
+		}
+		requirements, _ := s.Requirements()
+		for _, r := range requirements {
+			lc, exists := constraints[r.Key()]
+			if !exists {
+				lc = &labelConstraint{}
+				constraints[r.Key()] = lc
+			}
+			lc.add(r)
+		}
+	}
+	for _, lc := range constraints {
+		if !lc.satisfiable() {
+			return false
+		}
+	}
+	return true
+}
+
 func newAdmissionResponseForErr(err error) *admv1.AdmissionResponse {
 	return &admv1.AdmissionResponse{
 		Result: &metav1.Status{
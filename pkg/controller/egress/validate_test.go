@@ -16,16 +16,21 @@ package egress
 
 import (
 	"encoding/json"
+	"net"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	admv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
 
+	npltypes "antrea.io/antrea/pkg/agent/nodeportlocal/types"
+	"antrea.io/antrea/pkg/apis"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	"antrea.io/antrea/pkg/controller/externalippool"
 )
 
 func marshal(object runtime.Object) []byte {
@@ -51,6 +56,8 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 	tests := []struct {
 		name                   string
 		existingExternalIPPool *crdv1beta1.ExternalIPPool
+		existingIPAllocations  []externalippool.IPAllocation
+		existingEgresses       []*crdv1beta1.Egress
 		request                *admv1.AdmissionRequest
 		expectedResponse       *admv1.AdmissionResponse
 	}{
@@ -121,6 +128,50 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 			},
 			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
 		},
+		{
+			name:                   "Requesting IP allocated to a Service should not be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			existingIPAllocations: []externalippool.IPAllocation{
+				{
+					ObjectReference: corev1.ObjectReference{Kind: "Service", Namespace: "ns1", Name: "svc1"},
+					IPPoolName:      "bar",
+					IP:              net.ParseIP("10.10.10.1"),
+				},
+			},
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newEgress("foo", "10.10.10.1", "bar", nil, nil, nil))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.10.1 is already allocated to Service ns1/svc1",
+				},
+			},
+		},
+		{
+			name:                   "Specifying static IP allocated to a Service should not be allowed",
+			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+			existingIPAllocations: []externalippool.IPAllocation{
+				{
+					ObjectReference: corev1.ObjectReference{Kind: "Service", Namespace: "ns1", Name: "svc1"},
+					IPPoolName:      "bar",
+					IP:              net.ParseIP("10.10.10.1"),
+				},
+			},
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newEgress("foo", "10.10.10.1", "", nil, nil, nil))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.10.1 is already allocated to Service ns1/svc1",
+				},
+			},
+		},
 		{
 			name:                   "Updating podSelector should be allowed",
 			existingExternalIPPool: newExternalIPPool("bar", "10.10.10.0/24", "", ""),
@@ -190,6 +241,69 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:             "Creating a namespace-wide Egress selecting the same Namespaces as another one should not be allowed",
+			existingEgresses: []*crdv1beta1.Egress{newEgress("bar", "10.10.10.2", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil)},
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newEgress("foo", "10.10.10.1", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "Egress bar may select all Pods in the same Namespaces as the namespaceSelector",
+				},
+			},
+		},
+		{
+			name:             "Creating a namespace-wide Egress overlapping with another one should not be allowed",
+			existingEgresses: []*crdv1beta1.Egress{newEgress("bar", "10.10.10.2", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil)},
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newEgress("foo", "10.10.10.1", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"team": "db"}}, nil))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "Egress bar may select all Pods in the same Namespaces as the namespaceSelector",
+				},
+			},
+		},
+		{
+			name:             "Creating a namespace-wide Egress disjoint from another one should be allowed",
+			existingEgresses: []*crdv1beta1.Egress{newEgress("bar", "10.10.10.2", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil)},
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newEgress("foo", "10.10.10.1", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}, nil))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name:             "Creating an Egress with a podSelector overlapping with a namespace-wide Egress should be allowed",
+			existingEgresses: []*crdv1beta1.Egress{newEgress("bar", "10.10.10.2", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil)},
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "CREATE",
+				Object: runtime.RawExtension{Raw: marshal(newEgress("foo", "10.10.10.1", "",
+					&metav1.LabelSelector{MatchLabels: map[string]string{"app": "db"}},
+					&metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name:             "Updating a namespace-wide Egress without changing its appliedTo should be allowed",
+			existingEgresses: []*crdv1beta1.Egress{newEgress("bar", "10.10.10.2", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil)},
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newEgress("foo", "10.10.10.1", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil))},
+				Object:    runtime.RawExtension{Raw: marshal(newEgress("foo", "10.10.10.3", "", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -199,6 +313,9 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 			if tt.existingExternalIPPool != nil {
 				objs = append(objs, tt.existingExternalIPPool)
 			}
+			for _, egress := range tt.existingEgresses {
+				objs = append(objs, egress)
+			}
 			controller := newController(nil, objs)
 			controller.informerFactory.Start(stopCh)
 			controller.crdInformerFactory.Start(stopCh)
@@ -206,7 +323,7 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 			controller.crdInformerFactory.WaitForCacheSync(stopCh)
 			go controller.externalIPAllocator.Run(stopCh)
 			require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
-			controller.externalIPAllocator.RestoreIPAllocations(nil)
+			controller.externalIPAllocator.RestoreIPAllocations(tt.existingIPAllocations)
 			review := &admv1.AdmissionReview{
 				Request: tt.request,
 			}
@@ -215,3 +332,336 @@ func TestEgressControllerValidateEgress(t *testing.T) {
 		})
 	}
 }
+
+func TestEgressControllerValidatePod(t *testing.T) {
+	newPodWithAnnotations := func(ip, pool string) *corev1.Pod {
+		pod := newPod("default", "foo", nil, node1, "", false)
+		pod.UID = "uid-foo"
+		pod.Annotations = map[string]string{}
+		if ip != "" {
+			pod.Annotations[apis.PodEgressIPAnnotationKey] = ip
+		}
+		if pool != "" {
+			pod.Annotations[apis.PodEgressExternalIPPoolAnnotationKey] = pool
+		}
+		return pod
+	}
+	newPodWithNPLEgressMode := func(mode string) *corev1.Pod {
+		pod := newPodWithAnnotations("", "")
+		pod.Annotations[npltypes.NPLEgressModeAnnotationKey] = mode
+		return pod
+	}
+	tests := []struct {
+		name                  string
+		existingIPAllocations []externalippool.IPAllocation
+		request               *admv1.AdmissionRequest
+		expectedResponse      *admv1.AdmissionResponse
+	}{
+		{
+			name: "Pod without annotations should be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithAnnotations("", ""))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Requesting IP in range should be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithAnnotations("10.10.10.1", "bar"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Requesting IP without ExternalIPPool should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithAnnotations("10.10.10.1", ""))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "Annotations egress.antrea.io/ip and egress.antrea.io/external-ip-pool must be set together",
+				},
+			},
+		},
+		{
+			name: "Requesting invalid IP should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithAnnotations("10.10.10.300", "bar"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.10.300 is not valid",
+				},
+			},
+		},
+		{
+			name: "Requesting IP from non-existing ExternalIPPool should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithAnnotations("10.10.10.1", "nonExistingPool"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "ExternalIPPool nonExistingPool does not exist",
+				},
+			},
+		},
+		{
+			name: "Requesting IP from ExternalIPPool not allowed for the Namespace should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithAnnotations("10.10.20.1", "qux"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "Pods in Namespace default are not allowed to request an EgressIP from ExternalIPPool qux",
+				},
+			},
+		},
+		{
+			name: "Requesting IP for Pod without Namespace should use the Namespace of the request",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Namespace: "other",
+				Object: runtime.RawExtension{Raw: marshal(func() *corev1.Pod {
+					pod := newPodWithAnnotations("10.10.10.1", "bar")
+					pod.Namespace = ""
+					return pod
+				}())},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "Pods in Namespace other are not allowed to request an EgressIP from ExternalIPPool bar",
+				},
+			},
+		},
+		{
+			name: "Requesting IP out of range should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newPodWithAnnotations("", ""))},
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithAnnotations("10.10.11.1", "bar"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.11.1 is not within the IP range",
+				},
+			},
+		},
+		{
+			name: "Requesting IP allocated to another Pod should not be allowed",
+			existingIPAllocations: []externalippool.IPAllocation{
+				{
+					ObjectReference: corev1.ObjectReference{Kind: "Egress", Name: "pod-uid-bar"},
+					IPPoolName:      "bar",
+					IP:              net.ParseIP("10.10.10.1"),
+				},
+			},
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithAnnotations("10.10.10.1", "bar"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IP 10.10.10.1 is already allocated to Egress pod-uid-bar",
+				},
+			},
+		},
+		{
+			name: "Keeping IP allocated to the Pod should be allowed",
+			existingIPAllocations: []externalippool.IPAllocation{
+				{
+					ObjectReference: corev1.ObjectReference{Kind: "Egress", Name: "pod-uid-foo"},
+					IPPoolName:      "bar",
+					IP:              net.ParseIP("10.10.10.1"),
+				},
+			},
+			request: &admv1.AdmissionRequest{
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newPodWithAnnotations("10.10.10.1", "bar"))},
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithAnnotations("10.10.10.1", "bar"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Valid NodePortLocal egress mode should be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithNPLEgressMode("PreferNodePortLocal"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Invalid NodePortLocal egress mode should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newPodWithAnnotations("", ""))},
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithNPLEgressMode("Prefer"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: `invalid value "Prefer" for annotation nodeportlocal.antrea.io/egress-mode, supported values are Coexist and PreferNodePortLocal`,
+				},
+			},
+		},
+		{
+			name: "Unchanged invalid NodePortLocal egress mode should be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newPodWithNPLEgressMode("Prefer"))},
+				Object:    runtime.RawExtension{Raw: marshal(newPodWithNPLEgressMode("Prefer"))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			controller := newController(nil, []runtime.Object{
+				newExternalIPPool("bar", "10.10.10.0/24", "", ""),
+				newExternalIPPool("qux", "10.10.20.0/24", "", ""),
+			})
+			controller.SetPodEgressIPAllowedPools(map[string][]string{"default": {"bar"}})
+			controller.informerFactory.Start(stopCh)
+			controller.crdInformerFactory.Start(stopCh)
+			controller.informerFactory.WaitForCacheSync(stopCh)
+			controller.crdInformerFactory.WaitForCacheSync(stopCh)
+			go controller.externalIPAllocator.Run(stopCh)
+			require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
+			controller.externalIPAllocator.RestoreIPAllocations(tt.existingIPAllocations)
+			review := &admv1.AdmissionReview{
+				Request: tt.request,
+			}
+			gotResponse := controller.ValidatePod(review)
+			assert.Equal(t, tt.expectedResponse, gotResponse)
+		})
+	}
+}
+
+func TestEgressControllerValidateNamespace(t *testing.T) {
+	newNamespace := func(annotations map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "web", Annotations: annotations}}
+	}
+	tests := []struct {
+		name             string
+		request          *admv1.AdmissionRequest
+		expectedResponse *admv1.AdmissionResponse
+	}{
+		{
+			name: "Namespace without annotations should be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newNamespace(nil))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Valid NodePortLocal egress mode should be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newNamespace(nil))},
+				Object:    runtime.RawExtension{Raw: marshal(newNamespace(map[string]string{npltypes.NPLEgressModeAnnotationKey: "Coexist"}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Invalid NodePortLocal egress mode should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Operation: "CREATE",
+				Object:    runtime.RawExtension{Raw: marshal(newNamespace(map[string]string{npltypes.NPLEgressModeAnnotationKey: "Prefer"}))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: `invalid value "Prefer" for annotation nodeportlocal.antrea.io/egress-mode, supported values are Coexist and PreferNodePortLocal`,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := newController(nil, nil)
+			review := &admv1.AdmissionReview{
+				Request: tt.request,
+			}
+			gotResponse := controller.ValidateNamespace(review)
+			assert.Equal(t, tt.expectedResponse, gotResponse)
+		})
+	}
+}
+
+func TestSelectorsMayOverlap(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        *metav1.LabelSelector
+		b        *metav1.LabelSelector
+		expected bool
+	}{
+		{
+			name:     "same labels",
+			a:        &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			b:        &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			expected: true,
+		},
+		{
+			name:     "different keys",
+			a:        &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			b:        &metav1.LabelSelector{MatchLabels: map[string]string{"team": "db"}},
+			expected: true,
+		},
+		{
+			name:     "empty selector",
+			a:        &metav1.LabelSelector{},
+			b:        &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			expected: true,
+		},
+		{
+			name:     "different values",
+			a:        &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			b:        &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}},
+			expected: false,
+		},
+		{
+			name:     "intersecting In",
+			a:        &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpIn, Values: []string{"prod", "staging"}}}},
+			b:        &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpIn, Values: []string{"staging", "dev"}}}},
+			expected: true,
+		},
+		{
+			name:     "excluded value",
+			a:        &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			b:        &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"prod"}}}},
+			expected: false,
+		},
+		{
+			name:     "other value not excluded",
+			a:        &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			b:        &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"dev"}}}},
+			expected: true,
+		},
+		{
+			name:     "label does not exist",
+			a:        &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			b:        &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "env", Operator: metav1.LabelSelectorOpDoesNotExist}}},
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, selectorsMayOverlap(tt.a, tt.b))
+			assert.Equal(t, tt.expected, selectorsMayOverlap(tt.b, tt.a))
+		})
+	}
+}
@@ -69,6 +69,22 @@ type IPAllocation struct {
 	IP net.IP
 }
 
+// IPConflictError is returned when an IP is requested by a consumer while it is already allocated to another
+// consumer, e.g. when a Service requests an IP which has been allocated to an Egress.
+type IPConflictError struct {
+	IP net.IP
+	// Owner is the consumer to which the IP is allocated. Its Name may be empty if the IP is shared by multiple
+	// consumers of the same kind.
+	Owner corev1.ObjectReference
+}
+
+func (e *IPConflictError) Error() string {
+	if e.Owner.Name == "" {
+		return fmt.Sprintf("IP %s is already allocated to another %s", e.IP, e.Owner.Kind)
+	}
+	return fmt.Sprintf("IP %s is already allocated to %s %s", e.IP, e.Owner.Kind, klog.KRef(e.Owner.Namespace, e.Owner.Name))
+}
+
 // ExternalIPPoolEventHandler defines a consumer to subscribe for external ExternalIPPool events.
 type ExternalIPPoolEventHandler func(externalIPPool string)
 
@@ -79,14 +95,17 @@ type ExternalIPAllocator interface {
 	// RestoreIPAllocations is used to restore the previous allocated IPs after controller restarts. It will return the
 	// succeeded IP Allocations.
 	RestoreIPAllocations(allocations []IPAllocation) []IPAllocation
-	// AllocateIPFromPool allocates an IP from the given IP pool.
-	AllocateIPFromPool(externalIPPool string) (net.IP, error)
+	// AllocateIPFromPool allocates an IP from the given IP pool to the given owner.
+	AllocateIPFromPool(externalIPPool string, owner corev1.ObjectReference) (net.IP, error)
 	// IPPoolExists checks whether the IP pool exists.
 	IPPoolExists(externalIPPool string) bool
 	// IPPoolHasIP checks whether the IP pool contains the given IP.
 	IPPoolHasIP(externalIPPool string, ip net.IP) bool
-	// UpdateIPAllocation marks the IP in the specified ExternalIPPool as occupied.
-	UpdateIPAllocation(externalIPPool string, ip net.IP) error
+	// UpdateIPAllocation marks the IP in the specified ExternalIPPool as occupied by the given owner.
+	// It returns an *IPConflictError if the IP is already allocated to another owner.
+	UpdateIPAllocation(externalIPPool string, ip net.IP, owner corev1.ObjectReference) error
+	// GetIPOwner returns the owner of the IP if it is allocated from any ExternalIPPool.
+	GetIPOwner(ip net.IP) (corev1.ObjectReference, bool)
 	// ReleaseIP releases the IP to the IP pool.
 	// It returns ErrExternalIPPoolNotFound if the externalIPPool does not exist.
 	// Any other error indicates that the IP was not allocated, or is not currently allocated.
@@ -106,7 +125,11 @@ type ExternalIPPoolController struct {
 	externalIPPoolListerSynced cache.InformerSynced
 
 	// ipAllocatorMap is a map from ExternalIPPool name to MultiIPAllocator.
-	ipAllocatorMap   map[string]ipallocator.MultiIPAllocator
+	ipAllocatorMap map[string]ipallocator.MultiIPAllocator
+	// ipOwnerMap is a map from allocated IP to its owner and the ExternalIPPool it's allocated from. It is used to
+	// report conflicts between the consumers of ExternalIPPools, e.g. Egresses and Services. ExternalIPPools cannot
+	// overlap, so an IP can be allocated from at most one ExternalIPPool.
+	ipOwnerMap       map[string]ipOwner
 	ipAllocatorMutex sync.RWMutex
 
 	// ipAllocatorInitialized stores a boolean value, which tracks if the ipAllocatorMap has been initialized
@@ -121,6 +144,12 @@ type ExternalIPPoolController struct {
 	queue workqueue.TypedRateLimitingInterface[string]
 }
 
+// ipOwner contains the owner of an allocated IP and the IP Pool which allocates it.
+type ipOwner struct {
+	owner  corev1.ObjectReference
+	ipPool string
+}
+
 // NewExternalIPPoolController returns a new *ExternalIPPoolController.
 func NewExternalIPPoolController(crdClient clientset.Interface, externalIPPoolInformer antreainformers.ExternalIPPoolInformer) *ExternalIPPoolController {
 	c := &ExternalIPPoolController{
@@ -135,6 +164,7 @@ func NewExternalIPPoolController(crdClient clientset.Interface, externalIPPoolIn
 		),
 		ipAllocatorInitialized: &atomic.Value{},
 		ipAllocatorMap:         make(map[string]ipallocator.MultiIPAllocator),
+		ipOwnerMap:             make(map[string]ipOwner),
 	}
 	externalIPPoolInformer.Informer().AddEventHandlerWithResyncPeriod(
 		cache.ResourceEventHandlerFuncs{
@@ -160,7 +190,7 @@ func (c *ExternalIPPoolController) AddEventHandler(handler ExternalIPPoolEventHa
 func (c *ExternalIPPoolController) RestoreIPAllocations(allocations []IPAllocation) []IPAllocation {
 	var succeeded []IPAllocation
 	for _, allocation := range allocations {
-		if err := c.UpdateIPAllocation(allocation.IPPoolName, allocation.IP); err != nil {
+		if err := c.UpdateIPAllocation(allocation.IPPoolName, allocation.IP, allocation.ObjectReference); err != nil {
 			klog.ErrorS(err, "Failed to restore IP allocation", "ip", allocation.IP, "ipPool", allocation.IPPoolName)
 		} else {
 			succeeded = append(succeeded, allocation)
@@ -262,6 +292,31 @@ func (c *ExternalIPPoolController) deleteIPAllocator(poolName string) {
 	c.ipAllocatorMutex.Lock()
 	defer c.ipAllocatorMutex.Unlock()
 	delete(c.ipAllocatorMap, poolName)
+	for ip, owner := range c.ipOwnerMap {
+		if owner.ipPool == poolName {
+			delete(c.ipOwnerMap, ip)
+		}
+	}
+}
+
+func (c *ExternalIPPoolController) setIPOwner(poolName string, ip net.IP, owner corev1.ObjectReference) {
+	c.ipAllocatorMutex.Lock()
+	defer c.ipAllocatorMutex.Unlock()
+	c.ipOwnerMap[ip.String()] = ipOwner{owner: owner, ipPool: poolName}
+}
+
+func (c *ExternalIPPoolController) deleteIPOwner(ip net.IP) {
+	c.ipAllocatorMutex.Lock()
+	defer c.ipAllocatorMutex.Unlock()
+	delete(c.ipOwnerMap, ip.String())
+}
+
+// GetIPOwner returns the owner of the IP if it is allocated from any ExternalIPPool.
+func (c *ExternalIPPoolController) GetIPOwner(ip net.IP) (corev1.ObjectReference, bool) {
+	c.ipAllocatorMutex.RLock()
+	defer c.ipAllocatorMutex.RUnlock()
+	owner, exists := c.ipOwnerMap[ip.String()]
+	return owner.owner, exists
 }
 
 // getIPAllocator gets the IP allocator of the given IP pool.
@@ -272,8 +327,8 @@ func (c *ExternalIPPoolController) getIPAllocator(poolName string) (ipallocator.
 	return ipAllocator, exists
 }
 
-// AllocateIPFromPool allocates an IP from the the given IP pool.
-func (c *ExternalIPPoolController) AllocateIPFromPool(ipPoolName string) (net.IP, error) {
+// AllocateIPFromPool allocates an IP from the the given IP pool to the given owner.
+func (c *ExternalIPPoolController) AllocateIPFromPool(ipPoolName string, owner corev1.ObjectReference) (net.IP, error) {
 	c.handlersWaitGroup.Wait()
 	ipAllocator, exists := c.getIPAllocator(ipPoolName)
 	if !exists {
@@ -283,20 +338,25 @@ func (c *ExternalIPPoolController) AllocateIPFromPool(ipPoolName string) (net.IP
 	if err != nil {
 		return ip, err
 	}
+	c.setIPOwner(ipPoolName, ip, owner)
 	c.queue.Add(ipPoolName)
 	return ip, nil
 }
 
-// UpdateIPAllocation sets the IP in the specified ExternalIPPool.
-func (c *ExternalIPPoolController) UpdateIPAllocation(poolName string, ip net.IP) error {
+// UpdateIPAllocation sets the IP in the specified ExternalIPPool as occupied by the given owner.
+func (c *ExternalIPPoolController) UpdateIPAllocation(poolName string, ip net.IP, owner corev1.ObjectReference) error {
 	ipAllocator, exists := c.getIPAllocator(poolName)
 	if !exists {
 		return ErrExternalIPPoolNotFound
 	}
+	if currentOwner, allocated := c.GetIPOwner(ip); allocated {
+		return &IPConflictError{IP: ip, Owner: currentOwner}
+	}
 	err := ipAllocator.AllocateIP(ip)
 	if err != nil {
 		return err
 	}
+	c.setIPOwner(poolName, ip, owner)
 	c.queue.Add(poolName)
 	return nil
 }
@@ -349,6 +409,7 @@ func (c *ExternalIPPoolController) ReleaseIP(poolName string, ip net.IP) error {
 	if err := allocator.Release(ip); err != nil {
 		return err
 	}
+	c.deleteIPOwner(ip)
 	c.queue.Add(poolName)
 	return nil
 }
@@ -141,9 +141,9 @@ func TestAllocateIPFromPool(t *testing.T) {
 			go controller.Run(stopCh)
 			require.True(t, cache.WaitForCacheSync(stopCh, controller.HasSynced))
 			for _, alloc := range tt.allocatedIP {
-				require.NoError(t, controller.UpdateIPAllocation(alloc.pool, net.ParseIP(alloc.ip)))
+				require.NoError(t, controller.UpdateIPAllocation(alloc.pool, net.ParseIP(alloc.ip), v1.ObjectReference{}))
 			}
-			ipGot, err := controller.AllocateIPFromPool(tt.allocateFrom)
+			ipGot, err := controller.AllocateIPFromPool(tt.allocateFrom, v1.ObjectReference{})
 			assert.Equal(t, tt.expectError, err != nil)
 			assert.Equal(t, net.ParseIP(tt.expectedIP), ipGot)
 			for idx, pool := range tt.ipPools {
@@ -219,7 +219,7 @@ func TestReleaseIP(t *testing.T) {
 			go controller.Run(stopCh)
 			require.True(t, cache.WaitForCacheSync(stopCh, controller.HasSynced))
 			for _, alloc := range tt.allocatedIP {
-				require.NoError(t, controller.UpdateIPAllocation(alloc.pool, net.ParseIP(alloc.ip)))
+				require.NoError(t, controller.UpdateIPAllocation(alloc.pool, net.ParseIP(alloc.ip), v1.ObjectReference{}))
 			}
 			err := controller.ReleaseIP(tt.ipPoolToRelease, net.ParseIP(tt.ipToRelease))
 			assert.Equal(t, tt.expectError, err != nil)
@@ -230,6 +230,45 @@ func TestReleaseIP(t *testing.T) {
 	}
 }
 
+func TestIPOwner(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	controller := newController([]runtime.Object{newExternalIPPool("eip1", "", "10.10.10.2", "10.10.10.3")})
+	controller.crdInformerFactory.Start(stopCh)
+	controller.crdInformerFactory.WaitForCacheSync(stopCh)
+	go controller.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, controller.HasSynced))
+
+	egressRef := v1.ObjectReference{Kind: "Egress", Name: "egress-1"}
+	serviceRef := v1.ObjectReference{Kind: "Service", Namespace: "ns1", Name: "svc-1"}
+	ip := net.ParseIP("10.10.10.3")
+	require.NoError(t, controller.UpdateIPAllocation("eip1", ip, egressRef))
+	owner, exists := controller.GetIPOwner(ip)
+	assert.True(t, exists)
+	assert.Equal(t, egressRef, owner)
+
+	err := controller.UpdateIPAllocation("eip1", ip, serviceRef)
+	var conflictErr *IPConflictError
+	require.ErrorAs(t, err, &conflictErr)
+	assert.Equal(t, egressRef, conflictErr.Owner)
+	assert.EqualError(t, err, "IP 10.10.10.3 is already allocated to Egress egress-1")
+
+	allocatedIP, err := controller.AllocateIPFromPool("eip1", serviceRef)
+	require.NoError(t, err)
+	owner, exists = controller.GetIPOwner(allocatedIP)
+	assert.True(t, exists)
+	assert.Equal(t, serviceRef, owner)
+
+	require.NoError(t, controller.ReleaseIP("eip1", ip))
+	_, exists = controller.GetIPOwner(ip)
+	assert.False(t, exists)
+	require.NoError(t, controller.UpdateIPAllocation("eip1", ip, serviceRef))
+
+	controller.deleteIPAllocator("eip1")
+	_, exists = controller.GetIPOwner(ip)
+	assert.False(t, exists)
+}
+
 func TestCreateOrUpdateIPAllocator(t *testing.T) {
 	stopCh := make(chan struct{})
 	defer close(stopCh)
@@ -340,7 +379,7 @@ func TestConsumersRestoreIPAllocation(t *testing.T) {
 		}
 		restored := controller.RestoreIPAllocations(allocatedIPs)
 		assert.Equal(t, allocatedIPs, restored)
-		ip, err := controller.AllocateIPFromPool("eip1")
+		ip, err := controller.AllocateIPFromPool("eip1", v1.ObjectReference{})
 		assert.NoError(t, err)
 		allocatedIPCh <- ip.String()
 	}()
@@ -353,7 +392,7 @@ func TestConsumersRestoreIPAllocation(t *testing.T) {
 		}
 		restored := controller.RestoreIPAllocations(allocatedIPs)
 		assert.Equal(t, allocatedIPs, restored)
-		ip, err := controller.AllocateIPFromPool("eip1")
+		ip, err := controller.AllocateIPFromPool("eip1", v1.ObjectReference{})
 		assert.NoError(t, err)
 		allocatedIPCh <- ip.String()
 	}()
@@ -621,7 +660,7 @@ func TestExternalIPPoolController_RestoreIPAllocations(t *testing.T) {
 			go controller.Run(stopCh)
 			require.True(t, cache.WaitForCacheSync(stopCh, controller.HasSynced))
 			for _, alloc := range tt.allocations {
-				err := controller.UpdateIPAllocation(alloc.IPPoolName, alloc.IP)
+				err := controller.UpdateIPAllocation(alloc.IPPoolName, alloc.IP, alloc.ObjectReference)
 				require.NoError(t, err)
 			}
 			succeeded := controller.RestoreIPAllocations(tt.allocationsToRestore)
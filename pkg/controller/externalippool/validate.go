@@ -17,7 +17,9 @@ package externalippool
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/netip"
+	"reflect"
 
 	admv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -61,11 +63,30 @@ func (c *ExternalIPPoolController) ValidateExternalIPPool(review *admv1.Admissio
 		if msg, allowed = validateIPRangesAndSubnetInfo(newObj, externalIPPools); !allowed {
 			break
 		}
+		if msg, allowed = validateAnnouncement(newObj, externalIPPools); !allowed {
+			break
+		}
 	case admv1.Update:
 		klog.V(2).Info("Validating UPDATE request for ExternalIPPool")
 		if msg, allowed = validateIPRangesAndSubnetInfo(newObj, externalIPPools); !allowed {
 			break
 		}
+		if msg, allowed = validateAnnouncement(newObj, externalIPPools); !allowed {
+			break
+		}
+		if !reflect.DeepEqual(oldObj.Spec.Announcement, newObj.Spec.Announcement) {
+			allowed = false
+			msg = "announcement cannot be updated"
+			break
+		}
+		// The Virtual Router IDs of the IPs are derived from their positions in the IP ranges, new IP ranges can only
+		// be appended to keep them unchanged.
+		if isVRRPAnnouncement(newObj.Spec.Announcement) && (len(newObj.Spec.IPRanges) < len(oldObj.Spec.IPRanges) ||
+			!reflect.DeepEqual(oldObj.Spec.IPRanges, newObj.Spec.IPRanges[:len(oldObj.Spec.IPRanges)])) {
+			allowed = false
+			msg = "IPRanges of an ExternalIPPool announced with VRRP can only be appended"
+			break
+		}
 		oldIPRangeSet := getIPRangeSet(oldObj.Spec.IPRanges)
 		newIPRangeSet := getIPRangeSet(newObj.Spec.IPRanges)
 		deletedIPRanges := oldIPRangeSet.Difference(newIPRangeSet)
@@ -195,6 +216,69 @@ func validateIPRangesAndSubnetInfo(externalIPPool crdv1beta1.ExternalIPPool, exi
 	return "", true
 }
 
+func isVRRPAnnouncement(announcement *crdv1beta1.IPAnnouncement) bool {
+	return announcement != nil && announcement.Mode == crdv1beta1.IPAnnouncementModeVRRP
+}
+
+// getVRRPVirtualRouterIDs returns the first and the last Virtual Router IDs used by the ExternalIPPool announced with
+// VRRP.
+func getVRRPVirtualRouterIDs(externalIPPool *crdv1beta1.ExternalIPPool) (int32, int32) {
+	var size uint64
+	for _, ipRange := range externalIPPool.Spec.IPRanges {
+		rangeSize, _ := crdv1beta1.GetIPRangeSize(ipRange)
+		size += min(rangeSize, math.MaxUint16)
+	}
+	base := externalIPPool.Spec.Announcement.VRRP.VirtualRouterIDBase
+	return base, base + int32(min(size, math.MaxUint16)) - 1
+}
+
+func validateAnnouncement(externalIPPool crdv1beta1.ExternalIPPool, existingExternalIPPools []*crdv1beta1.ExternalIPPool) (string, bool) {
+	announcement := externalIPPool.Spec.Announcement
+	if announcement == nil {
+		return "", true
+	}
+	switch announcement.Mode {
+	case "", crdv1beta1.IPAnnouncementModeGARP:
+		if announcement.VRRP != nil {
+			return "vrrp can only be set when the announcement mode is VRRP", false
+		}
+		return "", true
+	case crdv1beta1.IPAnnouncementModeVRRP:
+	default:
+		return fmt.Sprintf("invalid announcement mode %s", announcement.Mode), false
+	}
+
+	vrrp := announcement.VRRP
+	if vrrp == nil {
+		return "vrrp must be set when the announcement mode is VRRP", false
+	}
+	if vrrp.VirtualRouterIDBase < 1 || vrrp.VirtualRouterIDBase > 255 {
+		return fmt.Sprintf("invalid virtualRouterIDBase %d, it must be between 1 and 255", vrrp.VirtualRouterIDBase), false
+	}
+	if vrrp.AdvertisementInterval < 0 || vrrp.AdvertisementInterval > 4095 {
+		return fmt.Sprintf("invalid advertisementInterval %d, it must be between 1 and 4095", vrrp.AdvertisementInterval), false
+	}
+	// The VRRP virtual routers are configured on MACVLAN interfaces of the Node's transport interface, which cannot
+	// tag the traffic.
+	if externalIPPool.Spec.SubnetInfo != nil && externalIPPool.Spec.SubnetInfo.VLAN != 0 {
+		return "VLAN is not supported when the announcement mode is VRRP", false
+	}
+	first, last := getVRRPVirtualRouterIDs(&externalIPPool)
+	if last > 255 {
+		return fmt.Sprintf("the IP pool contains more IPs than the %d Virtual Router IDs starting from %d", 256-first, first), false
+	}
+	for _, pool := range existingExternalIPPools {
+		if pool.Name == externalIPPool.Name || !isVRRPAnnouncement(pool.Spec.Announcement) || pool.Spec.Announcement.VRRP == nil {
+			continue
+		}
+		poolFirst, poolLast := getVRRPVirtualRouterIDs(pool)
+		if first <= poolLast && last >= poolFirst {
+			return fmt.Sprintf("Virtual Router IDs %d-%d overlap with Virtual Router IDs %d-%d of pool %s", first, last, poolFirst, poolLast, pool.Name), false
+		}
+	}
+	return "", true
+}
+
 func parseIPRangeCIDR(cidrStr string) (netip.Prefix, string) {
 	var cidr netip.Prefix
 	var err error
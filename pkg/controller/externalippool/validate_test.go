@@ -38,6 +38,15 @@ func mutateExternalIPPool(pool *crdv1b1.ExternalIPPool, mutate func(*crdv1b1.Ext
 	return pool
 }
 
+func newVRRPExternalIPPool(name, cidr, start, end string, virtualRouterIDBase int32) *crdv1b1.ExternalIPPool {
+	return mutateExternalIPPool(newExternalIPPool(name, cidr, start, end), func(pool *crdv1b1.ExternalIPPool) {
+		pool.Spec.Announcement = &crdv1b1.IPAnnouncement{
+			Mode: crdv1b1.IPAnnouncementModeVRRP,
+			VRRP: &crdv1b1.VRRPAnnouncement{VirtualRouterIDBase: virtualRouterIDBase},
+		}
+	})
+}
+
 func TestControllerValidateExternalIPPool(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -109,6 +118,46 @@ func TestControllerValidateExternalIPPool(t *testing.T) {
 			},
 			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
 		},
+		{
+			name: "Updating announcement should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newExternalIPPool("foo", "10.10.10.0/28", "", ""))},
+				Object:    runtime.RawExtension{Raw: marshal(newVRRPExternalIPPool("foo", "10.10.10.0/28", "", "", 1))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "announcement cannot be updated",
+				},
+			},
+		},
+		{
+			name: "Appending IPRange to pool announced with VRRP should be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newVRRPExternalIPPool("foo", "10.10.10.0/28", "", "", 1))},
+				Object:    runtime.RawExtension{Raw: marshal(newVRRPExternalIPPool("foo", "10.10.10.0/28", "10.10.20.1", "10.10.20.2", 1))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{Allowed: true},
+		},
+		{
+			name: "Inserting IPRange to pool announced with VRRP should not be allowed",
+			request: &admv1.AdmissionRequest{
+				Name:      "foo",
+				Operation: "UPDATE",
+				OldObject: runtime.RawExtension{Raw: marshal(newVRRPExternalIPPool("foo", "", "10.10.20.1", "10.10.20.2", 1))},
+				Object:    runtime.RawExtension{Raw: marshal(newVRRPExternalIPPool("foo", "10.10.10.0/28", "10.10.20.1", "10.10.20.2", 1))},
+			},
+			expectedResponse: &admv1.AdmissionResponse{
+				Allowed: false,
+				Result: &metav1.Status{
+					Message: "IPRanges of an ExternalIPPool announced with VRRP can only be appended",
+				},
+			},
+		},
 		{
 			name: "DELETE operation should be allowed",
 			request: &admv1.AdmissionRequest{
@@ -374,6 +423,108 @@ func TestValidateIPRangesAndSubnetInfo(t *testing.T) {
 	}
 }
 
+func TestValidateAnnouncement(t *testing.T) {
+	testCases := []struct {
+		name                    string
+		externalIPPool          *crdv1b1.ExternalIPPool
+		existingExternalIPPools []*crdv1b1.ExternalIPPool
+		errMsg                  string
+	}{
+		{
+			name:           "no announcement",
+			externalIPPool: newExternalIPPool("foo", "10.10.10.0/24", "", ""),
+		},
+		{
+			name: "GARP announcement",
+			externalIPPool: mutateExternalIPPool(newExternalIPPool("foo", "10.10.10.0/24", "", ""), func(pool *crdv1b1.ExternalIPPool) {
+				pool.Spec.Announcement = &crdv1b1.IPAnnouncement{Mode: crdv1b1.IPAnnouncementModeGARP}
+			}),
+		},
+		{
+			name: "invalid announcement mode",
+			externalIPPool: mutateExternalIPPool(newExternalIPPool("foo", "10.10.10.0/24", "", ""), func(pool *crdv1b1.ExternalIPPool) {
+				pool.Spec.Announcement = &crdv1b1.IPAnnouncement{Mode: "BGP"}
+			}),
+			errMsg: "invalid announcement mode BGP",
+		},
+		{
+			name: "vrrp set with GARP announcement",
+			externalIPPool: mutateExternalIPPool(newVRRPExternalIPPool("foo", "10.10.10.0/24", "", "", 1), func(pool *crdv1b1.ExternalIPPool) {
+				pool.Spec.Announcement.Mode = crdv1b1.IPAnnouncementModeGARP
+			}),
+			errMsg: "vrrp can only be set when the announcement mode is VRRP",
+		},
+		{
+			name: "vrrp not set with VRRP announcement",
+			externalIPPool: mutateExternalIPPool(newExternalIPPool("foo", "10.10.10.0/24", "", ""), func(pool *crdv1b1.ExternalIPPool) {
+				pool.Spec.Announcement = &crdv1b1.IPAnnouncement{Mode: crdv1b1.IPAnnouncementModeVRRP}
+			}),
+			errMsg: "vrrp must be set when the announcement mode is VRRP",
+		},
+		{
+			name:           "invalid virtualRouterIDBase",
+			externalIPPool: newVRRPExternalIPPool("foo", "10.10.10.0/24", "", "", 0),
+			errMsg:         "invalid virtualRouterIDBase 0, it must be between 1 and 255",
+		},
+		{
+			name: "invalid advertisementInterval",
+			externalIPPool: mutateExternalIPPool(newVRRPExternalIPPool("foo", "10.10.10.0/28", "", "", 1), func(pool *crdv1b1.ExternalIPPool) {
+				pool.Spec.Announcement.VRRP.AdvertisementInterval = 4096
+			}),
+			errMsg: "invalid advertisementInterval 4096, it must be between 1 and 4095",
+		},
+		{
+			name: "VLAN with VRRP announcement",
+			externalIPPool: mutateExternalIPPool(newVRRPExternalIPPool("foo", "10.10.10.0/28", "", "", 1), func(pool *crdv1b1.ExternalIPPool) {
+				pool.Spec.SubnetInfo = &crdv1b1.SubnetInfo{
+					Gateway:      "10.10.0.1",
+					PrefixLength: 16,
+					VLAN:         2,
+				}
+			}),
+			errMsg: "VLAN is not supported when the announcement mode is VRRP",
+		},
+		{
+			name:           "too many IPs for Virtual Router IDs",
+			externalIPPool: newVRRPExternalIPPool("foo", "10.10.10.0/24", "", "", 1),
+			errMsg:         "the IP pool contains more IPs than the 255 Virtual Router IDs starting from 1",
+		},
+		{
+			name:           "too many IPv6 IPs for Virtual Router IDs",
+			externalIPPool: newVRRPExternalIPPool("foo", "2021:1::/64", "", "", 1),
+			errMsg:         "the IP pool contains more IPs than the 255 Virtual Router IDs starting from 1",
+		},
+		{
+			name:           "valid VRRP announcement",
+			externalIPPool: newVRRPExternalIPPool("foo", "10.10.10.0/28", "10.10.20.1", "10.10.20.239", 1),
+		},
+		{
+			name:           "Virtual Router IDs overlap with other pool",
+			externalIPPool: newVRRPExternalIPPool("foo", "10.10.10.0/28", "", "", 10),
+			existingExternalIPPools: []*crdv1b1.ExternalIPPool{
+				newVRRPExternalIPPool("bar", "10.10.20.0/28", "", "", 20),
+				newVRRPExternalIPPool("baz", "10.10.30.0/28", "", "", 25),
+			},
+			errMsg: "Virtual Router IDs 10-25 overlap with Virtual Router IDs 20-35 of pool bar",
+		},
+		{
+			name:           "Virtual Router IDs don't overlap with other pool",
+			externalIPPool: newVRRPExternalIPPool("foo", "10.10.10.0/28", "", "", 10),
+			existingExternalIPPools: []*crdv1b1.ExternalIPPool{
+				newExternalIPPool("bar", "10.10.20.0/28", "", ""),
+				newVRRPExternalIPPool("baz", "10.10.30.0/28", "", "", 26),
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			errMsg, result := validateAnnouncement(*testCase.externalIPPool, testCase.existingExternalIPPools)
+			assert.Equal(t, testCase.errMsg, errMsg)
+			assert.Equal(t, testCase.errMsg == "", result)
+		})
+	}
+}
+
 func TestParseIPRangeCIDR(t *testing.T) {
 	testCases := []struct {
 		name   string
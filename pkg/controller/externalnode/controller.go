@@ -16,7 +16,9 @@ package externalnode
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net"
 	"reflect"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
@@ -57,8 +60,28 @@ var (
 	splitKeyFunc = cache.SplitMetaNamespaceKey
 )
 
+// ApprovalMode determines how ExternalNodes are approved before they are realized.
+type ApprovalMode string
+
+const (
+	// ApprovalModeAuto approves ExternalNodes whose interface IPs are all in the auto-approval CIDRs.
+	ApprovalModeAuto ApprovalMode = "Auto"
+	// ApprovalModeManual requires ExternalNodes to be approved by an administrator.
+	ApprovalModeManual ApprovalMode = "Manual"
+)
+
+// ApprovalPolicy is the policy used to approve ExternalNodes, including the ones registered by antrea-agent.
+type ApprovalPolicy struct {
+	Mode ApprovalMode
+	// AutoApprovalCIDRs restricts the ExternalNodes approved in Auto mode. If empty, all ExternalNodes are
+	// approved in Auto mode.
+	AutoApprovalCIDRs []*net.IPNet
+}
+
 type ExternalNodeController struct {
-	crdClient clientset.Interface
+	kubeClient     kubernetes.Interface
+	crdClient      clientset.Interface
+	approvalPolicy ApprovalPolicy
 
 	externalNodeInformer     externalnodeinformers.ExternalNodeInformer
 	externalNodeLister       externalnodelisters.ExternalNodeLister
@@ -73,10 +96,12 @@ type ExternalNodeController struct {
 	queue workqueue.TypedRateLimitingInterface[string]
 }
 
-func NewExternalNodeController(crdClient clientset.Interface, externalNodeInformer externalnodeinformers.ExternalNodeInformer,
-	externalEntityInformer externalentityinformers.ExternalEntityInformer) *ExternalNodeController {
+func NewExternalNodeController(kubeClient kubernetes.Interface, crdClient clientset.Interface, externalNodeInformer externalnodeinformers.ExternalNodeInformer,
+	externalEntityInformer externalentityinformers.ExternalEntityInformer, approvalPolicy ApprovalPolicy) *ExternalNodeController {
 	c := &ExternalNodeController{
-		crdClient: crdClient,
+		kubeClient:     kubeClient,
+		crdClient:      crdClient,
+		approvalPolicy: approvalPolicy,
 
 		externalNodeInformer:     externalNodeInformer,
 		externalNodeLister:       externalNodeInformer.Lister(),
@@ -154,6 +179,14 @@ func (c *ExternalNodeController) reconcileExternalNodes() error {
 	}
 	enUIDEENameMap := make(map[types.UID]string)
 	for _, en := range externalNodes {
+		approved, err := c.checkApproval(en)
+		if err != nil {
+			return err
+		}
+		// The ExternalEntity of an ExternalNode which is not approved is cleaned up as a stale one.
+		if !approved {
+			continue
+		}
 		if err = c.addExternalNode(en); err != nil {
 			return err
 		}
@@ -218,6 +251,14 @@ func (c *ExternalNodeController) syncExternalNode(key string) error {
 	if errors.IsNotFound(err) {
 		return c.deleteExternalNode(namespace, name)
 	}
+	approved, err := c.checkApproval(en)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		klog.InfoS("ExternalNode is not approved, skip realizing it", "ExternalNode", klog.KObj(en), "state", externalnode.GetRegistrationState(en))
+		return c.deleteExternalNode(namespace, name)
+	}
 
 	preEn, exists, _ := c.syncedExternalNode.GetByKey(key)
 	if !exists {
@@ -227,6 +268,62 @@ func (c *ExternalNodeController) syncExternalNode(key string) error {
 	}
 }
 
+// checkApproval returns whether the ExternalNode is approved to be realized. A pending ExternalNode which is
+// approved by the Auto approval mode is annotated as approved, so that the registering antrea-agent can proceed.
+func (c *ExternalNodeController) checkApproval(en *v1alpha1.ExternalNode) (bool, error) {
+	state := externalnode.GetRegistrationState(en)
+	switch state {
+	case externalnode.RegistrationStateApproved:
+		return true, nil
+	case externalnode.RegistrationStateRejected:
+		return false, nil
+	}
+	if c.approvalPolicy.Mode != ApprovalModeAuto || !c.matchAutoApprovalCIDRs(en) {
+		return false, nil
+	}
+	if state != "" {
+		patch, _ := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{
+					externalnode.RegistrationStateAnnotationKey: externalnode.RegistrationStateApproved,
+				},
+			},
+		})
+		if _, err := c.crdClient.CrdV1alpha1().ExternalNodes(en.Namespace).Patch(context.TODO(), en.Name, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			return false, fmt.Errorf("failed to approve ExternalNode %s/%s: %w", en.Namespace, en.Name, err)
+		}
+		klog.InfoS("Approved ExternalNode", "ExternalNode", klog.KObj(en))
+	}
+	return true, nil
+}
+
+// matchAutoApprovalCIDRs returns whether all IPs of the ExternalNode interface are in the auto-approval CIDRs.
+func (c *ExternalNodeController) matchAutoApprovalCIDRs(en *v1alpha1.ExternalNode) bool {
+	if len(c.approvalPolicy.AutoApprovalCIDRs) == 0 {
+		return true
+	}
+	if len(en.Spec.Interfaces) == 0 || len(en.Spec.Interfaces[0].IPs) == 0 {
+		return false
+	}
+	for _, ipStr := range en.Spec.Interfaces[0].IPs {
+		ip := net.ParseIP(ipStr)
+		if ip == nil {
+			return false
+		}
+		matched := false
+		for _, cidr := range c.approvalPolicy.AutoApprovalCIDRs {
+			if cidr.Contains(ip) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
 // addExternalNode creates ExternalEntity for each NetworkInterface in the ExternalNode.
 // Only one interface is supported for now and there should be one ExternalEntity generated for one ExternalNode.
 func (c *ExternalNodeController) addExternalNode(en *v1alpha1.ExternalNode) error {
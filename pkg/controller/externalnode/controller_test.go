@@ -17,6 +17,7 @@ package externalnode
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 	"testing"
 	"time"
@@ -27,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
 	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
@@ -34,6 +36,7 @@ import (
 	"antrea.io/antrea/pkg/client/clientset/versioned"
 	fakeclientset "antrea.io/antrea/pkg/client/clientset/versioned/fake"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	"antrea.io/antrea/pkg/util/externalnode"
 )
 
 var (
@@ -642,6 +645,84 @@ func TestUpdateExternalEntity(t *testing.T) {
 	})
 }
 
+func TestCheckApproval(t *testing.T) {
+	_, cidr, _ := net.ParseCIDR("172.16.0.0/16")
+	genExternalNode := func(ips []string, state string) *v1alpha1.ExternalNode {
+		en := &v1alpha1.ExternalNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "ns1"},
+			Spec: v1alpha1.ExternalNodeSpec{
+				Interfaces: []v1alpha1.NetworkInterface{{IPs: ips}},
+			},
+		}
+		if state != "" {
+			en.Annotations = map[string]string{externalnode.RegistrationStateAnnotationKey: state}
+		}
+		return en
+	}
+	for _, tc := range []struct {
+		name             string
+		approvalPolicy   ApprovalPolicy
+		externalNode     *v1alpha1.ExternalNode
+		expectedApproved bool
+		expectedState    string
+	}{
+		{
+			name:             "auto without CIDRs",
+			approvalPolicy:   ApprovalPolicy{Mode: ApprovalModeAuto},
+			externalNode:     genExternalNode([]string{"1.1.1.2"}, ""),
+			expectedApproved: true,
+		},
+		{
+			name:             "auto approve pending",
+			approvalPolicy:   ApprovalPolicy{Mode: ApprovalModeAuto, AutoApprovalCIDRs: []*net.IPNet{cidr}},
+			externalNode:     genExternalNode([]string{"172.16.100.3"}, externalnode.RegistrationStatePending),
+			expectedApproved: true,
+			expectedState:    externalnode.RegistrationStateApproved,
+		},
+		{
+			name:           "auto not matching CIDRs",
+			approvalPolicy: ApprovalPolicy{Mode: ApprovalModeAuto, AutoApprovalCIDRs: []*net.IPNet{cidr}},
+			externalNode:   genExternalNode([]string{"172.16.100.3", "1.1.1.2"}, externalnode.RegistrationStatePending),
+			expectedState:  externalnode.RegistrationStatePending,
+		},
+		{
+			name:           "auto rejected",
+			approvalPolicy: ApprovalPolicy{Mode: ApprovalModeAuto},
+			externalNode:   genExternalNode([]string{"172.16.100.3"}, externalnode.RegistrationStateRejected),
+			expectedState:  externalnode.RegistrationStateRejected,
+		},
+		{
+			name:           "manual pending",
+			approvalPolicy: ApprovalPolicy{Mode: ApprovalModeManual},
+			externalNode:   genExternalNode([]string{"172.16.100.3"}, externalnode.RegistrationStatePending),
+			expectedState:  externalnode.RegistrationStatePending,
+		},
+		{
+			name:           "manual without annotation",
+			approvalPolicy: ApprovalPolicy{Mode: ApprovalModeManual},
+			externalNode:   genExternalNode([]string{"172.16.100.3"}, ""),
+		},
+		{
+			name:             "manual approved",
+			approvalPolicy:   ApprovalPolicy{Mode: ApprovalModeManual},
+			externalNode:     genExternalNode([]string{"172.16.100.3"}, externalnode.RegistrationStateApproved),
+			expectedApproved: true,
+			expectedState:    externalnode.RegistrationStateApproved,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			controller := newExternalNodeController([]runtime.Object{tc.externalNode})
+			controller.approvalPolicy = tc.approvalPolicy
+			approved, err := controller.checkApproval(tc.externalNode)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedApproved, approved)
+			en, err := controller.crdClient.CrdV1alpha1().ExternalNodes(tc.externalNode.Namespace).Get(context.TODO(), tc.externalNode.Name, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedState, externalnode.GetRegistrationState(en))
+		})
+	}
+}
+
 func checkExternalEntityExists(crdClient versioned.Interface, ee *v1alpha2.ExternalEntity) (bool, error) {
 	entity, getErr := crdClient.CrdV1alpha2().ExternalEntities(ee.Namespace).Get(context.TODO(), ee.Name, metav1.GetOptions{})
 	if getErr != nil {
@@ -661,5 +742,5 @@ func newExternalNodeController(objects []runtime.Object) *ExternalNodeController
 	informerFactory = crdinformers.NewSharedInformerFactory(crdClient, resyncPeriod)
 	externalNodeInformer := informerFactory.Crd().V1alpha1().ExternalNodes()
 	externalEntityInformer := informerFactory.Crd().V1alpha2().ExternalEntities()
-	return NewExternalNodeController(crdClient, externalNodeInformer, externalEntityInformer)
+	return NewExternalNodeController(fake.NewSimpleClientset(), crdClient, externalNodeInformer, externalEntityInformer, ApprovalPolicy{Mode: ApprovalModeAuto})
 }
@@ -0,0 +1,123 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalnode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	admv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	"antrea.io/antrea/pkg/util/env"
+	"antrea.io/antrea/pkg/util/externalnode"
+)
+
+// approveVerb is the verb a user must be authorized for on an ExternalNode to set its registration state.
+const approveVerb = "approve"
+
+// Validate validates the registration state of ExternalNodes. The registration state can only be set to a value
+// other than Pending, or changed after creation, by antrea-controller or by users authorized for the "approve" verb
+// on the ExternalNode. This prevents an antrea-agent which registers its own ExternalNode from approving itself.
+func (c *ExternalNodeController) Validate(review *admv1.AdmissionReview) *admv1.AdmissionResponse {
+	newResponse := func(allowed bool, deniedReason string) *admv1.AdmissionResponse {
+		resp := &admv1.AdmissionResponse{
+			UID:     review.Request.UID,
+			Allowed: allowed,
+		}
+		if !allowed {
+			resp.Result = &metav1.Status{
+				Message: deniedReason,
+			}
+		}
+		return resp
+	}
+
+	klog.V(2).InfoS("Validating ExternalNode", "request", review.Request)
+
+	var newObj, oldObj v1alpha1.ExternalNode
+	if review.Request.Object.Raw != nil {
+		if err := json.Unmarshal(review.Request.Object.Raw, &newObj); err != nil {
+			klog.ErrorS(err, "Error de-serializing current ExternalNode")
+			return newResponse(false, err.Error())
+		}
+	}
+	if review.Request.OldObject.Raw != nil {
+		if err := json.Unmarshal(review.Request.OldObject.Raw, &oldObj); err != nil {
+			klog.ErrorS(err, "Error de-serializing old ExternalNode")
+			return newResponse(false, err.Error())
+		}
+	}
+
+	newState := externalnode.GetRegistrationState(&newObj)
+	switch review.Request.Operation {
+	case admv1.Create:
+		// An ExternalNode can always be created without a registration state or as pending.
+		if newState == "" || newState == externalnode.RegistrationStatePending {
+			return newResponse(true, "")
+		}
+	case admv1.Update:
+		if newState == externalnode.GetRegistrationState(&oldObj) {
+			return newResponse(true, "")
+		}
+	default:
+		return newResponse(true, "")
+	}
+	allowed, err := c.isAuthorizedToApprove(&newObj, review.Request.UserInfo)
+	if err != nil {
+		return newResponse(false, fmt.Sprintf("failed to check authorization for annotation %s: %v", externalnode.RegistrationStateAnnotationKey, err))
+	}
+	if !allowed {
+		return newResponse(false, fmt.Sprintf("user %s is not authorized to %s ExternalNode %s/%s", review.Request.UserInfo.Username, approveVerb, newObj.Namespace, newObj.Name))
+	}
+	return newResponse(true, "")
+}
+
+func (c *ExternalNodeController) isAuthorizedToApprove(en *v1alpha1.ExternalNode, userInfo authenticationv1.UserInfo) (bool, error) {
+	// antrea-controller approves the ExternalNodes matching the Auto approval policy.
+	if serviceaccount.MatchesUsername(env.GetAntreaNamespace(), env.GetAntreaControllerServiceAccount(), userInfo.Username) {
+		return true, nil
+	}
+	extra := make(map[string]authorizationv1.ExtraValue, len(userInfo.Extra))
+	for k, value := range userInfo.Extra {
+		extra[k] = authorizationv1.ExtraValue(value)
+	}
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   userInfo.Username,
+			Groups: userInfo.Groups,
+			UID:    userInfo.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: en.Namespace,
+				Verb:      approveVerb,
+				Group:     v1alpha1.SchemeGroupVersion.Group,
+				Resource:  "externalnodes",
+				Name:      en.Name,
+			},
+		},
+	}
+	resp, err := c.kubeClient.AuthorizationV1().SubjectAccessReviews().Create(context.TODO(), sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return resp.Status.Allowed, nil
+}
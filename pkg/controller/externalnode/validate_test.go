@@ -0,0 +1,154 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externalnode
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	admv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	"antrea.io/antrea/pkg/util/externalnode"
+)
+
+func TestValidate(t *testing.T) {
+	newExternalNode := func(state string) *v1alpha1.ExternalNode {
+		en := &v1alpha1.ExternalNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "vm1", Namespace: "vm-ns"},
+		}
+		if state != "" {
+			en.Annotations = map[string]string{externalnode.RegistrationStateAnnotationKey: state}
+		}
+		return en
+	}
+	agentUser := authenticationv1.UserInfo{Username: "system:bootstrap:abcdef", Groups: []string{"system:bootstrappers:antrea-vm-agent"}}
+	adminUser := authenticationv1.UserInfo{Username: "admin"}
+	controllerUser := authenticationv1.UserInfo{Username: "system:serviceaccount:kube-system:antrea-controller"}
+
+	tests := []struct {
+		name            string
+		operation       admv1.Operation
+		oldExternalNode *v1alpha1.ExternalNode
+		newExternalNode *v1alpha1.ExternalNode
+		userInfo        authenticationv1.UserInfo
+		expectedAllowed bool
+		expectedReason  string
+	}{
+		{
+			name:            "agent creates pending ExternalNode",
+			operation:       admv1.Create,
+			newExternalNode: newExternalNode(externalnode.RegistrationStatePending),
+			userInfo:        agentUser,
+			expectedAllowed: true,
+		},
+		{
+			name:            "agent creates ExternalNode without state",
+			operation:       admv1.Create,
+			newExternalNode: newExternalNode(""),
+			userInfo:        agentUser,
+			expectedAllowed: true,
+		},
+		{
+			name:            "agent creates approved ExternalNode",
+			operation:       admv1.Create,
+			newExternalNode: newExternalNode(externalnode.RegistrationStateApproved),
+			userInfo:        agentUser,
+			expectedAllowed: false,
+			expectedReason:  "user system:bootstrap:abcdef is not authorized to approve ExternalNode vm-ns/vm1",
+		},
+		{
+			name:            "agent approves ExternalNode",
+			operation:       admv1.Update,
+			oldExternalNode: newExternalNode(externalnode.RegistrationStatePending),
+			newExternalNode: newExternalNode(externalnode.RegistrationStateApproved),
+			userInfo:        agentUser,
+			expectedAllowed: false,
+			expectedReason:  "user system:bootstrap:abcdef is not authorized to approve ExternalNode vm-ns/vm1",
+		},
+		{
+			name:            "agent removes rejected state",
+			operation:       admv1.Update,
+			oldExternalNode: newExternalNode(externalnode.RegistrationStateRejected),
+			newExternalNode: newExternalNode(""),
+			userInfo:        agentUser,
+			expectedAllowed: false,
+			expectedReason:  "user system:bootstrap:abcdef is not authorized to approve ExternalNode vm-ns/vm1",
+		},
+		{
+			name:            "agent updates ExternalNode without changing state",
+			operation:       admv1.Update,
+			oldExternalNode: newExternalNode(externalnode.RegistrationStateApproved),
+			newExternalNode: newExternalNode(externalnode.RegistrationStateApproved),
+			userInfo:        agentUser,
+			expectedAllowed: true,
+		},
+		{
+			name:            "admin approves ExternalNode",
+			operation:       admv1.Update,
+			oldExternalNode: newExternalNode(externalnode.RegistrationStatePending),
+			newExternalNode: newExternalNode(externalnode.RegistrationStateApproved),
+			userInfo:        adminUser,
+			expectedAllowed: true,
+		},
+		{
+			name:            "admin creates approved ExternalNode",
+			operation:       admv1.Create,
+			newExternalNode: newExternalNode(externalnode.RegistrationStateApproved),
+			userInfo:        adminUser,
+			expectedAllowed: true,
+		},
+		{
+			name:            "controller approves ExternalNode",
+			operation:       admv1.Update,
+			oldExternalNode: newExternalNode(externalnode.RegistrationStatePending),
+			newExternalNode: newExternalNode(externalnode.RegistrationStateApproved),
+			userInfo:        controllerUser,
+			expectedAllowed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newExternalNodeController(nil)
+			c.kubeClient.(*fake.Clientset).PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+				sar.Status.Allowed = sar.Spec.User == "admin" && sar.Spec.ResourceAttributes.Verb == approveVerb
+				return true, sar, nil
+			})
+			review := &admv1.AdmissionReview{
+				Request: &admv1.AdmissionRequest{
+					Operation: tt.operation,
+					UserInfo:  tt.userInfo,
+				},
+			}
+			review.Request.Object.Raw, _ = json.Marshal(tt.newExternalNode)
+			if tt.oldExternalNode != nil {
+				review.Request.OldObject.Raw, _ = json.Marshal(tt.oldExternalNode)
+			}
+			resp := c.Validate(review)
+			assert.Equal(t, tt.expectedAllowed, resp.Allowed)
+			if tt.expectedReason != "" {
+				assert.Equal(t, tt.expectedReason, resp.Result.Message)
+			}
+		})
+	}
+}
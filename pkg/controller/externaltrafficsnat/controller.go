@@ -0,0 +1,378 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externaltrafficsnat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	antreaagenttypes "antrea.io/antrea/pkg/agent/types"
+	"antrea.io/antrea/pkg/controller/externalippool"
+)
+
+const (
+	controllerName = "ExternalTrafficSNATController"
+	// Set resyncPeriod to 0 to disable resyncing.
+	resyncPeriod time.Duration = 0
+	// How long to wait before retrying the processing of a Node change.
+	minRetryDelay = 5 * time.Second
+	maxRetryDelay = 300 * time.Second
+	// Default number of workers processing a Node change.
+	defaultWorkers = 4
+
+	// externalIPPoolIndex is an index of nodeInformer.
+	externalIPPoolIndex = "externalIPPool"
+
+	nodeKind = "Node"
+)
+
+// ipAllocation contains the IP and the IP Pool which allocates it.
+type ipAllocation struct {
+	ip     net.IP
+	ipPool string
+}
+
+// ExternalTrafficSNATController allocates an IP from an ExternalIPPool to each Node which requests one with the
+// node.antrea.io/external-traffic-snat-ip-pool annotation, and reports it with the
+// node.antrea.io/external-traffic-snat-ip annotation. antrea-agent uses the IP, instead of the Node IP, as the source
+// IP of the external Service traffic it forwards to remote Endpoints.
+type ExternalTrafficSNATController struct {
+	externalIPAllocator externalippool.ExternalIPAllocator
+	client              clientset.Interface
+
+	// ipAllocations caches the IP and the IP Pool which allocates it for each Node.
+	ipAllocations     map[string]*ipAllocation
+	ipAllocationMutex sync.RWMutex
+
+	nodeInformer     cache.SharedIndexInformer
+	nodeLister       corelisters.NodeLister
+	nodeListerSynced cache.InformerSynced
+	// queue maintains the Node names that need to be synced.
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+func NewExternalTrafficSNATController(
+	client clientset.Interface,
+	nodeInformer coreinformers.NodeInformer,
+	externalIPAllocator externalippool.ExternalIPAllocator,
+) *ExternalTrafficSNATController {
+	c := &ExternalTrafficSNATController{
+		client: client,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "externalTrafficSNAT",
+			},
+		),
+		nodeInformer:        nodeInformer.Informer(),
+		nodeLister:          nodeInformer.Lister(),
+		nodeListerSynced:    nodeInformer.Informer().HasSynced,
+		externalIPAllocator: externalIPAllocator,
+		ipAllocations:       map[string]*ipAllocation{},
+	}
+
+	c.nodeInformer.AddIndexers(cache.Indexers{
+		externalIPPoolIndex: func(obj interface{}) ([]string, error) {
+			node, ok := obj.(*corev1.Node)
+			if !ok {
+				return nil, fmt.Errorf("obj is not Node: %+v", obj)
+			}
+			eipName := getNodeSNATIPPool(node)
+			if eipName == "" {
+				return nil, nil
+			}
+			return []string{eipName}, nil
+		},
+	})
+
+	c.nodeInformer.AddEventHandlerWithResyncPeriod(
+		cache.FilteringResourceEventHandler{
+			FilterFunc: func(obj interface{}) bool {
+				node, ok := obj.(*corev1.Node)
+				if ok {
+					return nodeRequiresSync(node)
+				}
+				if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+					if cast, ok := tombstone.Obj.(*corev1.Node); ok {
+						return nodeRequiresSync(cast)
+					}
+				}
+				return false
+			},
+			Handler: cache.ResourceEventHandlerFuncs{
+				AddFunc: c.enqueueNode,
+				UpdateFunc: func(_, obj interface{}) {
+					c.enqueueNode(obj)
+				},
+				DeleteFunc: c.enqueueNode,
+			},
+		},
+		resyncPeriod,
+	)
+
+	c.externalIPAllocator.AddEventHandler(c.enqueueNodesByExternalIPPool)
+	return c
+}
+
+// nodeRequiresSync returns whether the Node requests a SNAT IP or has one assigned.
+func nodeRequiresSync(node *corev1.Node) bool {
+	return getNodeSNATIPPool(node) != "" || getNodeSNATIP(node) != ""
+}
+
+func (c *ExternalTrafficSNATController) enqueueNode(obj interface{}) {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		deletedState, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Received unexpected object: %v", obj)
+			return
+		}
+		node, ok = deletedState.Obj.(*corev1.Node)
+		if !ok {
+			klog.Errorf("DeletedFinalStateUnknown contains non-Node object: %v", deletedState.Obj)
+			return
+		}
+	}
+	c.queue.Add(node.Name)
+}
+
+// enqueueNodesByExternalIPPool enqueues all Nodes that refer to the provided ExternalIPPool.
+func (c *ExternalTrafficSNATController) enqueueNodesByExternalIPPool(eipName string) {
+	objects, _ := c.nodeInformer.GetIndexer().ByIndex(externalIPPoolIndex, eipName)
+	for _, object := range objects {
+		c.enqueueNode(object)
+	}
+}
+
+// Run will create defaultWorkers workers (go routines) which will process the Node events from the workqueue.
+func (c *ExternalTrafficSNATController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.Infof("Starting %s", controllerName)
+	defer klog.Infof("Shutting down %s", controllerName)
+
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.nodeListerSynced, c.externalIPAllocator.HasSynced) {
+		return
+	}
+
+	nodes, _ := c.nodeLister.List(labels.Everything())
+	c.restoreIPAllocations(nodes)
+
+	for i := 0; i < defaultWorkers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+// restoreIPAllocations restores the SNAT IPs of Nodes and records the successful ones in ipAllocations. The Nodes
+// whose IP cannot be restored will get a new IP allocated when they are synced.
+func (c *ExternalTrafficSNATController) restoreIPAllocations(nodes []*corev1.Node) {
+	var requestedIPAllocations []externalippool.IPAllocation
+	for _, node := range nodes {
+		ipPool := getNodeSNATIPPool(node)
+		ip := net.ParseIP(getNodeSNATIP(node))
+		if ipPool == "" || ip == nil {
+			continue
+		}
+		requestedIPAllocations = append(requestedIPAllocations, externalippool.IPAllocation{
+			ObjectReference: nodeOwnerReference(node.Name),
+			IPPoolName:      ipPool,
+			IP:              ip,
+		})
+	}
+	// RestoreIPAllocations must be called even if there is no allocation to restore, to let the allocator know the
+	// consumer has been initialized.
+	succeededAllocations := c.externalIPAllocator.RestoreIPAllocations(requestedIPAllocations)
+
+	c.ipAllocationMutex.Lock()
+	defer c.ipAllocationMutex.Unlock()
+	for _, allocation := range succeededAllocations {
+		c.ipAllocations[allocation.ObjectReference.Name] = &ipAllocation{
+			ip:     allocation.IP,
+			ipPool: allocation.IPPoolName,
+		}
+		klog.InfoS("Restored external traffic SNAT IP", "node", allocation.ObjectReference.Name, "ip", allocation.IP, "pool", allocation.IPPoolName)
+	}
+}
+
+// worker is a long-running function that will continually call the processNextWorkItem function in
+// order to read and process a message on the workqueue.
+func (c *ExternalTrafficSNATController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *ExternalTrafficSNATController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+	if err := c.syncNode(key); err == nil {
+		// If no error occurs we Forget this item so it does not get queued again until
+		// another change happens.
+		c.queue.Forget(key)
+	} else {
+		// Put the item back on the workqueue to handle any transient errors.
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Error syncing Node, requeuing", "node", key)
+	}
+	return true
+}
+
+func (c *ExternalTrafficSNATController) getIPAllocation(nodeName string) (*ipAllocation, bool) {
+	c.ipAllocationMutex.RLock()
+	defer c.ipAllocationMutex.RUnlock()
+	allocation, exists := c.ipAllocations[nodeName]
+	return allocation, exists
+}
+
+func (c *ExternalTrafficSNATController) releaseIP(nodeName string) error {
+	c.ipAllocationMutex.Lock()
+	defer c.ipAllocationMutex.Unlock()
+	allocation, exists := c.ipAllocations[nodeName]
+	if !exists {
+		return nil
+	}
+	if err := c.externalIPAllocator.ReleaseIP(allocation.ipPool, allocation.ip); err != nil {
+		if err != externalippool.ErrExternalIPPoolNotFound {
+			return fmt.Errorf("error when releasing IP %s to ExternalIPPool %s: %w", allocation.ip, allocation.ipPool, err)
+		}
+		// Ignore the error since the ExternalIPPool could be deleted.
+		klog.InfoS("ExternalIPPool of the external traffic SNAT IP no longer exists", "node", nodeName, "ip", allocation.ip, "pool", allocation.ipPool)
+	} else {
+		klog.InfoS("Released external traffic SNAT IP", "node", nodeName, "ip", allocation.ip, "pool", allocation.ipPool)
+	}
+	delete(c.ipAllocations, nodeName)
+	return nil
+}
+
+func (c *ExternalTrafficSNATController) allocateIP(nodeName string, ipPool string) (net.IP, error) {
+	c.ipAllocationMutex.Lock()
+	defer c.ipAllocationMutex.Unlock()
+	ip, err := c.externalIPAllocator.AllocateIPFromPool(ipPool, nodeOwnerReference(nodeName))
+	if err != nil {
+		return nil, fmt.Errorf("error when allocating IP from ExternalIPPool %s for Node %s: %w", ipPool, nodeName, err)
+	}
+	klog.InfoS("Allocated external traffic SNAT IP", "node", nodeName, "ip", ip, "pool", ipPool)
+	c.ipAllocations[nodeName] = &ipAllocation{ip: ip, ipPool: ipPool}
+	return ip, nil
+}
+
+func (c *ExternalTrafficSNATController) syncNode(nodeName string) error {
+	startTime := time.Now()
+	defer func() {
+		klog.V(4).InfoS("Finished syncing Node for external traffic SNAT IP", "node", nodeName, "durationTime", time.Since(startTime))
+	}()
+
+	node, err := c.nodeLister.Get(nodeName)
+	if err != nil {
+		if apimachineryerrors.IsNotFound(err) {
+			return c.releaseIP(nodeName)
+		}
+		return err
+	}
+
+	currentIPPool := getNodeSNATIPPool(node)
+	prevAllocation, allocationExists := c.getIPAllocation(nodeName)
+	if allocationExists &&
+		currentIPPool == prevAllocation.ipPool &&
+		c.externalIPAllocator.IPPoolHasIP(currentIPPool, prevAllocation.ip) {
+		// Ensure the annotation in Kubernetes API matches the cache.
+		return c.updateNodeSNATIP(node, prevAllocation.ip)
+	}
+
+	// The ExternalIPPool changes or no longer contains the IP. Delete the previous allocation.
+	if err := c.releaseIP(nodeName); err != nil {
+		return err
+	}
+
+	if currentIPPool == "" || !c.externalIPAllocator.IPPoolExists(currentIPPool) {
+		if currentIPPool != "" {
+			klog.InfoS("ExternalIPPool requested for external traffic SNAT IP does not exist", "node", nodeName, "pool", currentIPPool)
+		}
+		return c.updateNodeSNATIP(node, nil)
+	}
+
+	ip, err := c.allocateIP(nodeName, currentIPPool)
+	if err != nil {
+		return err
+	}
+	if err := c.updateNodeSNATIP(node, ip); err != nil {
+		// Release the IP so that it is not leaked if the Node is deleted before the next retry.
+		if releaseErr := c.releaseIP(nodeName); releaseErr != nil {
+			klog.ErrorS(releaseErr, "Failed to release external traffic SNAT IP", "node", nodeName)
+		}
+		return err
+	}
+	return nil
+}
+
+// updateNodeSNATIP updates the SNAT IP annotation of the Node in Kubernetes API. The annotation is removed if ip is
+// nil.
+func (c *ExternalTrafficSNATController) updateNodeSNATIP(node *corev1.Node, ip net.IP) error {
+	var value interface{}
+	if ip != nil {
+		if ip.String() == getNodeSNATIP(node) {
+			return nil
+		}
+		value = ip.String()
+	} else if getNodeSNATIP(node) == "" {
+		return nil
+	}
+	patch, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				antreaagenttypes.NodeExternalTrafficSNATIPAnnotationKey: value,
+			},
+		},
+	})
+	if _, err := c.client.CoreV1().Nodes().Patch(context.TODO(), node.Name, apimachinerytypes.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error when updating external traffic SNAT IP of Node %s: %w", node.Name, err)
+	}
+	return nil
+}
+
+func nodeOwnerReference(nodeName string) corev1.ObjectReference {
+	return corev1.ObjectReference{
+		Kind: nodeKind,
+		Name: nodeName,
+	}
+}
+
+func getNodeSNATIPPool(node *corev1.Node) string {
+	return node.Annotations[antreaagenttypes.NodeExternalTrafficSNATIPPoolAnnotationKey]
+}
+
+func getNodeSNATIP(node *corev1.Node) string {
+	return node.Annotations[antreaagenttypes.NodeExternalTrafficSNATIPAnnotationKey]
+}
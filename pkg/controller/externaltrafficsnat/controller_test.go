@@ -0,0 +1,177 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externaltrafficsnat
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	antreaagenttypes "antrea.io/antrea/pkg/agent/types"
+	antreacrds "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	fakeversioned "antrea.io/antrea/pkg/client/clientset/versioned/fake"
+	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions"
+	"antrea.io/antrea/pkg/controller/externalippool"
+)
+
+type fakeController struct {
+	*ExternalTrafficSNATController
+	client              kubernetes.Interface
+	informerFactory     informers.SharedInformerFactory
+	crdInformerFactory  crdinformers.SharedInformerFactory
+	externalIPAllocator *externalippool.ExternalIPPoolController
+}
+
+func newExternalIPPool(name, start, end string) *antreacrds.ExternalIPPool {
+	return &antreacrds.ExternalIPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: antreacrds.ExternalIPPoolSpec{
+			IPRanges: []antreacrds.IPRange{{Start: start, End: end}},
+		},
+	}
+}
+
+func newNode(name, ipPool, ip string) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Annotations: map[string]string{},
+		},
+	}
+	if ipPool != "" {
+		node.Annotations[antreaagenttypes.NodeExternalTrafficSNATIPPoolAnnotationKey] = ipPool
+	}
+	if ip != "" {
+		node.Annotations[antreaagenttypes.NodeExternalTrafficSNATIPAnnotationKey] = ip
+	}
+	return node
+}
+
+func newController(objects, crdObjects []runtime.Object) *fakeController {
+	client := fake.NewSimpleClientset(objects...)
+	crdClient := fakeversioned.NewSimpleClientset(crdObjects...)
+	informerFactory := informers.NewSharedInformerFactory(client, resyncPeriod)
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, resyncPeriod)
+	externalIPPoolController := externalippool.NewExternalIPPoolController(crdClient, crdInformerFactory.Crd().V1beta1().ExternalIPPools())
+	controller := NewExternalTrafficSNATController(client, informerFactory.Core().V1().Nodes(), externalIPPoolController)
+	return &fakeController{
+		ExternalTrafficSNATController: controller,
+		client:                        client,
+		informerFactory:               informerFactory,
+		crdInformerFactory:            crdInformerFactory,
+		externalIPAllocator:           externalIPPoolController,
+	}
+}
+
+func (c *fakeController) start(t *testing.T, stopCh <-chan struct{}) {
+	c.informerFactory.Start(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+	go c.externalIPAllocator.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, c.externalIPAllocator.HasSynced))
+	go c.Run(stopCh)
+}
+
+func (c *fakeController) expectNodeSNATIP(t *testing.T, nodeName string, expectedIP string) {
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		node, err := c.client.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, expectedIP, getNodeSNATIP(node))
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func TestExternalTrafficSNATIPAllocation(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	node1 := newNode("node1", "eip1", "")
+	node2 := newNode("node2", "", "")
+	controller := newController([]runtime.Object{node1, node2}, []runtime.Object{
+		newExternalIPPool("eip1", "1.1.1.1", "1.1.1.2"),
+		newExternalIPPool("eip2", "2.2.2.1", "2.2.2.1"),
+	})
+	controller.start(t, stopCh)
+
+	// The IP is allocated to node1.
+	controller.expectNodeSNATIP(t, "node1", "1.1.1.1")
+	controller.expectNodeSNATIP(t, "node2", "")
+
+	// node2 requests an IP from the same pool.
+	node2 = newNode("node2", "eip1", "")
+	_, err := controller.client.CoreV1().Nodes().Update(context.TODO(), node2, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	controller.expectNodeSNATIP(t, "node2", "1.1.1.2")
+
+	// node1 changes to another pool, the previous IP is released.
+	node1, err = controller.client.CoreV1().Nodes().Get(context.TODO(), "node1", metav1.GetOptions{})
+	require.NoError(t, err)
+	node1.Annotations[antreaagenttypes.NodeExternalTrafficSNATIPPoolAnnotationKey] = "eip2"
+	_, err = controller.client.CoreV1().Nodes().Update(context.TODO(), node1, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	controller.expectNodeSNATIP(t, "node1", "2.2.2.1")
+	_, allocated := controller.externalIPAllocator.GetIPOwner(net.ParseIP("1.1.1.1"))
+	assert.False(t, allocated)
+
+	// node2 no longer requests an IP, the annotation is removed and the IP is released.
+	node2, err = controller.client.CoreV1().Nodes().Get(context.TODO(), "node2", metav1.GetOptions{})
+	require.NoError(t, err)
+	delete(node2.Annotations, antreaagenttypes.NodeExternalTrafficSNATIPPoolAnnotationKey)
+	_, err = controller.client.CoreV1().Nodes().Update(context.TODO(), node2, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	controller.expectNodeSNATIP(t, "node2", "")
+	_, allocated = controller.externalIPAllocator.GetIPOwner(net.ParseIP("1.1.1.2"))
+	assert.False(t, allocated)
+
+	// node1 is deleted, the IP is released.
+	require.NoError(t, controller.client.CoreV1().Nodes().Delete(context.TODO(), "node1", metav1.DeleteOptions{}))
+	assert.Eventually(t, func() bool {
+		_, allocated := controller.externalIPAllocator.GetIPOwner(net.ParseIP("2.2.2.1"))
+		return !allocated
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func TestExternalTrafficSNATIPRestart(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	// node1 already has the second IP of the pool assigned, it should keep it.
+	node1 := newNode("node1", "eip1", "1.1.1.2")
+	// node2 has an IP which is not in the pool, it should get a new one.
+	node2 := newNode("node2", "eip1", "3.3.3.3")
+	// node3 has an IP from a pool it no longer requests, the annotation should be removed.
+	node3 := newNode("node3", "", "1.1.1.1")
+	controller := newController([]runtime.Object{node1, node2, node3}, []runtime.Object{
+		newExternalIPPool("eip1", "1.1.1.1", "1.1.1.2"),
+	})
+	controller.start(t, stopCh)
+
+	controller.expectNodeSNATIP(t, "node1", "1.1.1.2")
+	controller.expectNodeSNATIP(t, "node2", "1.1.1.1")
+	controller.expectNodeSNATIP(t, "node3", "")
+	owner, _ := controller.externalIPAllocator.GetIPOwner(net.ParseIP("1.1.1.2"))
+	assert.Equal(t, corev1.ObjectReference{Kind: "Node", Name: "node1"}, owner)
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grouping
+
+import "math/bits"
+
+// bitmap is a set of uint32 IDs stored as 64-bit words. Only the non-zero words are stored, so that the memory used by
+// a bitmap is proportional to the number of IDs it contains rather than to the largest ID. This matters when there are
+// a large number of sparse bitmaps, like the posting lists of unique labels.
+type bitmap struct {
+	words map[uint32]uint64
+	count int
+}
+
+func newBitmap() *bitmap {
+	return &bitmap{words: map[uint32]uint64{}}
+}
+
+func (b *bitmap) add(id uint32) {
+	w, mask := id>>6, uint64(1)<<(id&63)
+	if b.words[w]&mask == 0 {
+		b.words[w] |= mask
+		b.count++
+	}
+}
+
+func (b *bitmap) remove(id uint32) {
+	w, mask := id>>6, uint64(1)<<(id&63)
+	word := b.words[w]
+	if word&mask == 0 {
+		return
+	}
+	word &^= mask
+	if word == 0 {
+		delete(b.words, w)
+	} else {
+		b.words[w] = word
+	}
+	b.count--
+}
+
+func (b *bitmap) has(id uint32) bool {
+	return b.words[id>>6]&(uint64(1)<<(id&63)) != 0
+}
+
+func (b *bitmap) len() int {
+	return b.count
+}
+
+// union adds the IDs of other to b.
+func (b *bitmap) union(other *bitmap) {
+	for w, word := range other.words {
+		old := b.words[w]
+		merged := old | word
+		b.words[w] = merged
+		b.count += bits.OnesCount64(merged) - bits.OnesCount64(old)
+	}
+}
+
+// intersection returns a new bitmap containing the IDs in both b and other. Its cost is proportional to the number of
+// words of the smaller bitmap.
+func (b *bitmap) intersection(other *bitmap) *bitmap {
+	small, large := b, other
+	if len(large.words) < len(small.words) {
+		small, large = large, small
+	}
+	result := newBitmap()
+	for w, word := range small.words {
+		if common := word & large.words[w]; common != 0 {
+			result.words[w] = common
+			result.count += bits.OnesCount64(common)
+		}
+	}
+	return result
+}
+
+// forEach calls fn with each ID in the bitmap, in no particular order.
+func (b *bitmap) forEach(fn func(id uint32)) {
+	for w, word := range b.words {
+		for word != 0 {
+			fn(w<<6 | uint32(bits.TrailingZeros64(word)))
+			word &= word - 1
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grouping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func bitmapIDs(b *bitmap) []uint32 {
+	var ids []uint32
+	b.forEach(func(id uint32) {
+		ids = append(ids, id)
+	})
+	return ids
+}
+
+func newBitmapWithIDs(ids ...uint32) *bitmap {
+	b := newBitmap()
+	for _, id := range ids {
+		b.add(id)
+	}
+	return b
+}
+
+func TestBitmap(t *testing.T) {
+	b := newBitmapWithIDs(0, 63, 64, 1000000, 64)
+	assert.Equal(t, 4, b.len())
+	assert.True(t, b.has(63))
+	assert.True(t, b.has(1000000))
+	assert.False(t, b.has(1))
+	assert.ElementsMatch(t, []uint32{0, 63, 64, 1000000}, bitmapIDs(b))
+	// Only the non-zero words are stored.
+	assert.Len(t, b.words, 3)
+
+	b.remove(64)
+	b.remove(65)
+	assert.Equal(t, 3, b.len())
+	assert.Len(t, b.words, 2)
+	assert.ElementsMatch(t, []uint32{0, 63, 1000000}, bitmapIDs(b))
+}
+
+func TestBitmapUnion(t *testing.T) {
+	b := newBitmapWithIDs(1, 2, 200)
+	b.union(newBitmapWithIDs(2, 3, 300))
+	assert.Equal(t, 5, b.len())
+	assert.ElementsMatch(t, []uint32{1, 2, 3, 200, 300}, bitmapIDs(b))
+}
+
+func TestBitmapIntersection(t *testing.T) {
+	a := newBitmapWithIDs(1, 2, 200, 300)
+	b := newBitmapWithIDs(2, 3, 300, 400, 500)
+	result := a.intersection(b)
+	assert.Equal(t, 2, result.len())
+	assert.ElementsMatch(t, []uint32{2, 300}, bitmapIDs(result))
+	assert.Equal(t, 0, a.intersection(newBitmapWithIDs(3, 400)).len())
+	// The operands are not modified.
+	assert.Equal(t, 4, a.len())
+	assert.Equal(t, 5, b.len())
+}
@@ -176,6 +176,9 @@ type GroupEntityIndex struct {
 	// labelItemIndex is nested map from entityType to Namespace to keys of labelItems.
 	// It's used to filter potential labelItems when matching a Namespace scoped selectorItem.
 	labelItemIndex map[entityType]map[string]sets.Set[string]
+	// labelPostingIndex is a map from entityType to an inverted index of labelItems.
+	// It's used to filter potential labelItems when matching a selectorItem with label requirements.
+	labelPostingIndex map[entityType]*labelIndex
 
 	// groupItems stores all groupItems.
 	groupItems map[string]*groupItem
@@ -186,6 +189,9 @@ type GroupEntityIndex struct {
 	// It's used to filter potential selectorItems when matching an labelItem.
 	// Cluster scoped selectorItems are stored under empty Namespace "".
 	selectorItemIndex map[entityType]map[string]sets.Set[string]
+	// selectorAnchorIndex is a map from entityType to an inverted index of selectorItems.
+	// It's used to filter potential selectorItems when matching a new labelItem.
+	selectorAnchorIndex map[entityType]*selectorIndex
 
 	// namespaceLabels stores label sets of all Namespaces.
 	namespaceLabels map[string]labels.Set
@@ -207,16 +213,18 @@ func NewGroupEntityIndex() *GroupEntityIndex {
 	synced := &atomic.Value{}
 	synced.Store(false)
 	index := &GroupEntityIndex{
-		entityItems:       map[string]*entityItem{},
-		groupItems:        map[string]*groupItem{},
-		labelItems:        map[string]*labelItem{},
-		labelItemIndex:    map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}},
-		selectorItems:     map[string]*selectorItem{},
-		selectorItemIndex: map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}},
-		namespaceLabels:   map[string]labels.Set{},
-		eventHandlers:     map[GroupType][]eventHandler{},
-		eventChan:         make(chan string, eventChanSize),
-		synced:            synced,
+		entityItems:         map[string]*entityItem{},
+		groupItems:          map[string]*groupItem{},
+		labelItems:          map[string]*labelItem{},
+		labelItemIndex:      map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}},
+		labelPostingIndex:   map[entityType]*labelIndex{podEntityType: newLabelIndex(), externalEntityType: newLabelIndex()},
+		selectorItems:       map[string]*selectorItem{},
+		selectorItemIndex:   map[entityType]map[string]sets.Set[string]{podEntityType: {}, externalEntityType: {}},
+		selectorAnchorIndex: map[entityType]*selectorIndex{podEntityType: newSelectorIndex(), externalEntityType: newSelectorIndex()},
+		namespaceLabels:     map[string]labels.Set{},
+		eventHandlers:       map[GroupType][]eventHandler{},
+		eventChan:           make(chan string, eventChanSize),
+		synced:              synced,
 	}
 	return index
 }
@@ -351,6 +359,7 @@ func (i *GroupEntityIndex) deleteEntityFromLabelItem(label, entity string) *labe
 	if len(i.labelItemIndex[lItem.entityType][lItem.namespace]) == 0 {
 		delete(i.labelItemIndex[lItem.entityType], lItem.namespace)
 	}
+	i.labelPostingIndex[lItem.entityType].delete(label, lItem)
 
 	// Delete the labelItem from matched selectorItems.
 	for selector := range lItem.selectorItemKeys {
@@ -379,24 +388,19 @@ func (i *GroupEntityIndex) createLabelItem(entityType entityType, eItem *entityI
 		i.labelItemIndex[entityType][lItem.namespace] = labelItemKeys
 	}
 	labelItemKeys.Insert(eItem.labelItemKey)
+	i.labelPostingIndex[entityType].add(eItem.labelItemKey, lItem)
 
-	// Scan potential selectorItems and associate the new labelItem with the matched ones.
-	scanSelectorItems := func(selectorItemKeys sets.Set[string]) {
-		for sKey := range selectorItemKeys {
-			sItem := i.selectorItems[sKey]
-			matched := i.match(lItem.entityType, lItem.labels, lItem.namespace, sItem.selector)
-			if matched {
-				sItem.labelItemKeys.Insert(eItem.labelItemKey)
-				lItem.selectorItemKeys.Insert(sKey)
-			}
+	// Scan potential selectorItems and associate the new labelItem with the matched ones. Only the selectorItems in the
+	// same Namespace and the cluster scoped selectorItems, which are anchored by one of the labels or are unanchored,
+	// may match the labelItem.
+	for sKey := range i.selectorAnchorIndex[entityType].getSelectorItems(lItem.namespace, lItem.labels) {
+		sItem := i.selectorItems[sKey]
+		matched := i.match(lItem.entityType, lItem.labels, lItem.namespace, sItem.selector)
+		if matched {
+			sItem.labelItemKeys.Insert(eItem.labelItemKey)
+			lItem.selectorItemKeys.Insert(sKey)
 		}
 	}
-	// SelectorItems in the same Namespace may match the labelItem.
-	localSelectorItemKeys := i.selectorItemIndex[entityType][eItem.entity.GetNamespace()]
-	scanSelectorItems(localSelectorItemKeys)
-	// Cluster scoped selectorItems may match the labelItem.
-	clusterSelectorItemKeys := i.selectorItemIndex[entityType][emptyNamespace]
-	scanSelectorItems(clusterSelectorItemKeys)
 	return lItem
 }
 
@@ -518,14 +522,12 @@ func (i *GroupEntityIndex) deleteGroupFromSelectorItem(sKey, gKey string) *selec
 	delete(i.selectorItems, sKey)
 
 	// Delete it from the selectorItemIndex.
-	entityType := podEntityType
-	if sItem.selector.ExternalEntitySelector != nil {
-		entityType = externalEntityType
-	}
+	entityType, objSelector := getObjectSelector(sItem.selector)
 	i.selectorItemIndex[entityType][sItem.selector.Namespace].Delete(sKey)
 	if len(i.selectorItemIndex[entityType][sItem.selector.Namespace]) == 0 {
 		delete(i.selectorItemIndex[entityType], sItem.selector.Namespace)
 	}
+	i.selectorAnchorIndex[entityType].delete(sKey, sItem.selector.Namespace, objSelector)
 
 	// Delete the selectorItem from matched labelItems.
 	for lKey := range sItem.labelItemKeys {
@@ -546,34 +548,40 @@ func (i *GroupEntityIndex) createSelectorItem(gItem *groupItem) *selectorItem {
 	// Create the selectorItem.
 	i.selectorItems[gItem.selectorItemKey] = sItem
 	// Add it to the selectorItemIndex.
-	entityType := podEntityType
-	if gItem.selector.ExternalEntitySelector != nil {
-		entityType = externalEntityType
-	}
+	entityType, objSelector := getObjectSelector(gItem.selector)
 	selectorItemKeys, exists := i.selectorItemIndex[entityType][sItem.selector.Namespace]
 	if !exists {
 		selectorItemKeys = sets.New[string]()
 		i.selectorItemIndex[entityType][sItem.selector.Namespace] = selectorItemKeys
 	}
 	selectorItemKeys.Insert(gItem.selectorItemKey)
+	i.selectorAnchorIndex[entityType].add(gItem.selectorItemKey, sItem.selector.Namespace, objSelector)
 
-	// Scan potential labelItems and associates the new selectorItem with the matched ones.
+	// Scan potential labelItems and associates the new selectorItem with the matched ones. The labelItems are
+	// filtered by the labelPostingIndex with the Namespaces and the requirements of the selector.
+	var labelItemKeys []string
 	if sItem.selector.Namespace != "" {
 		// The selector is Namespace scoped, it can only match labelItems in this Namespace.
-		labelItemKeys := i.labelItemIndex[entityType][sItem.selector.Namespace]
-		i.scanLabelItems(labelItemKeys, sItem)
+		labelItemKeys = i.labelPostingIndex[entityType].getLabelItems(false, []string{sItem.selector.Namespace}, objSelector)
 	} else if sItem.selector.NamespaceSelector != nil && !sItem.selector.NamespaceSelector.Empty() {
 		// The selector is Cluster scoped and has non-empty NamespaceSelector, scan labelItems in a Namespace only if
 		// the Namespace's labels match.
+		var namespaces []string
 		for namespace, namespaceLabel := range i.namespaceLabels {
 			if sItem.selector.NamespaceSelector.Matches(namespaceLabel) {
-				i.scanLabelItems(i.labelItemIndex[entityType][namespace], sItem)
+				namespaces = append(namespaces, namespace)
 			}
 		}
+		labelItemKeys = i.labelPostingIndex[entityType].getLabelItems(false, namespaces, objSelector)
 	} else {
 		// The selector is Cluster scoped and match all Namespaces.
-		for _, labelItemKeys := range i.labelItemIndex[entityType] {
-			i.scanLabelItems(labelItemKeys, sItem)
+		labelItemKeys = i.labelPostingIndex[entityType].getLabelItems(true, nil, objSelector)
+	}
+	for _, lKey := range labelItemKeys {
+		lItem := i.labelItems[lKey]
+		if i.match(lItem.entityType, lItem.labels, lItem.namespace, sItem.selector) {
+			sItem.labelItemKeys.Insert(lKey)
+			lItem.selectorItemKeys.Insert(gItem.selectorItemKey)
 		}
 	}
 	return sItem
@@ -740,6 +748,15 @@ func (i *GroupEntityIndex) match(entityType entityType, label labels.Set, namesp
 	return false
 }
 
+// getObjectSelector returns the type of the entities selected by the group selector and the label selector of the
+// entities.
+func getObjectSelector(sel *types.GroupSelector) (entityType, labels.Selector) {
+	if sel.ExternalEntitySelector != nil {
+		return externalEntityType, sel.ExternalEntitySelector
+	}
+	return podEntityType, sel.PodSelector
+}
+
 func entityAttrsUpdated(oldEntity, newEntity metav1.Object) bool {
 	switch oldValue := oldEntity.(type) {
 	case *v1.Pod:
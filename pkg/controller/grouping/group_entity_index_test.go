@@ -15,6 +15,7 @@
 package grouping
 
 import (
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -350,6 +351,8 @@ func TestGroupEntityIndexDeleteGroup(t *testing.T) {
 	assert.Empty(t, index.selectorItems)
 	assert.Empty(t, index.selectorItemIndex[podEntityType])
 	assert.Empty(t, index.selectorItemIndex[externalEntityType])
+	assert.Empty(t, index.selectorAnchorIndex[podEntityType].anchoredSelectors)
+	assert.Empty(t, index.selectorAnchorIndex[externalEntityType].anchoredSelectors)
 	for _, lItem := range index.labelItems {
 		assert.Empty(t, lItem.selectorItemKeys)
 	}
@@ -397,6 +400,10 @@ func TestGroupEntityIndexDeleteEntity(t *testing.T) {
 	assert.Empty(t, index.labelItems)
 	assert.Empty(t, index.labelItemIndex[podEntityType])
 	assert.Empty(t, index.labelItemIndex[externalEntityType])
+	assert.Empty(t, index.labelPostingIndex[podEntityType].ids)
+	assert.Empty(t, index.labelPostingIndex[podEntityType].labelPostings)
+	assert.Empty(t, index.labelPostingIndex[externalEntityType].ids)
+	assert.Empty(t, index.labelPostingIndex[externalEntityType].labelPostings)
 	for _, sItem := range index.selectorItems {
 		assert.Empty(t, sItem.labelItemKeys)
 	}
@@ -564,3 +571,102 @@ func TestGroupEntityIndexEventHandlers(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupEntityIndexLabelRequirements(t *testing.T) {
+	pods := []*v1.Pod{
+		newPod("default", "web1", map[string]string{"app": "web", "tier": "frontend"}),
+		newPod("default", "web2", map[string]string{"app": "web", "tier": "frontend", "canary": "true"}),
+		newPod("default", "db", map[string]string{"app": "db", "tier": "backend"}),
+		newPod("default", "job", map[string]string{"job": "backup"}),
+		newPod("other", "web", map[string]string{"app": "web"}),
+		newPod("other", "cache", map[string]string{"app": "cache", "tier": "backend"}),
+	}
+	newGroup := func(name, namespace string, podSelector, namespaceSelector *metav1.LabelSelector) *group {
+		return &group{groupType: groupType1, groupName: name, groupSelector: types.NewGroupSelector(namespace, podSelector, namespaceSelector, nil, nil)}
+	}
+	expression := func(key string, operator metav1.LabelSelectorOperator, values ...string) *metav1.LabelSelector {
+		return &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: key, Operator: operator, Values: values}}}
+	}
+	groups := []*group{
+		newGroup("web", "default", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}, nil),
+		newGroup("frontend-canary", "default", &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend", "canary": "true"}}, nil),
+		newGroup("web-or-cache", "", expression("app", metav1.LabelSelectorOpIn, "web", "cache"), nil),
+		newGroup("not-web", "", expression("app", metav1.LabelSelectorOpNotIn, "web"), nil),
+		newGroup("tier", "", expression("tier", metav1.LabelSelectorOpExists), &metav1.LabelSelector{MatchLabels: nsOther.Labels}),
+		newGroup("no-tier", "default", expression("tier", metav1.LabelSelectorOpDoesNotExist), nil),
+		newGroup("default", "default", nil, nil),
+	}
+	expectedPods := map[string][]string{
+		"web":             {"default/web1", "default/web2"},
+		"frontend-canary": {"default/web2"},
+		"web-or-cache":    {"default/web1", "default/web2", "other/web", "other/cache"},
+		"not-web":         {"default/db", "default/job", "other/cache"},
+		"tier":            {"other/cache"},
+		"no-tier":         {"default/job"},
+		"default":         {"default/web1", "default/web2", "default/db", "default/job"},
+	}
+
+	// The selected Pods must be the same regardless of whether the groups or the Pods are added first.
+	for _, groupsFirst := range []bool{true, false} {
+		index := NewGroupEntityIndex()
+		index.AddNamespace(nsDefault)
+		index.AddNamespace(nsOther)
+		addGroups := func() {
+			for _, g := range groups {
+				index.AddGroup(g.groupType, g.groupName, g.groupSelector)
+			}
+		}
+		if groupsFirst {
+			addGroups()
+		}
+		for _, pod := range pods {
+			index.AddPod(pod)
+		}
+		if !groupsFirst {
+			addGroups()
+		}
+		for _, g := range groups {
+			actualPods, _ := index.GetEntities(g.groupType, g.groupName)
+			var actualPodNames []string
+			for _, pod := range actualPods {
+				actualPodNames = append(actualPodNames, pod.Namespace+"/"+pod.Name)
+			}
+			assert.ElementsMatch(t, expectedPods[g.groupName], actualPodNames, "groupsFirst: %t, group: %s", groupsFirst, g.groupName)
+		}
+	}
+}
+
+// BenchmarkGroupEntityIndexAddDeletePod measures the cost of adding and deleting a Pod with unique labels when there
+// are a large number of unique labels and selectors in the index. It should not depend on the number of existing
+// labels and selectors. Its current result is like:
+// BenchmarkGroupEntityIndexAddDeletePod/1000-labels         	  103467	     10875 ns/op
+// BenchmarkGroupEntityIndexAddDeletePod/10000-labels        	  132834	     13361 ns/op
+// BenchmarkGroupEntityIndexAddDeletePod/100000-labels       	  137976	      9729 ns/op
+func BenchmarkGroupEntityIndexAddDeletePod(b *testing.B) {
+	for _, numLabels := range []int{1000, 10000, 100000} {
+		stopCh := make(chan struct{})
+		index := NewGroupEntityIndex()
+		go index.Run(stopCh)
+		index.AddNamespace(nsDefault)
+		index.AddNamespace(nsOther)
+		for i := 0; i < numLabels; i++ {
+			namespace := "default"
+			if i%2 == 1 {
+				namespace = "other"
+			}
+			app := fmt.Sprintf("app-%d", i)
+			index.AddPod(newPod(namespace, fmt.Sprintf("pod-%d", i), map[string]string{"app": app, "tier": "frontend"}))
+			index.AddGroup(groupType1, fmt.Sprintf("group-%d", i), types.NewGroupSelector(namespace, &metav1.LabelSelector{MatchLabels: map[string]string{"app": app}}, nil, nil, nil))
+			index.AddGroup(groupType1, fmt.Sprintf("cluster-group-%d", i), types.NewGroupSelector("", &metav1.LabelSelector{MatchLabels: map[string]string{"app": app, "tier": "frontend"}}, nil, nil, nil))
+		}
+		pod := newPod("default", "new-pod", map[string]string{"app": "app-0", "tier": "frontend", "instance": "new"})
+
+		b.Run(fmt.Sprintf("%d-labels", numLabels), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				index.AddPod(pod)
+				index.DeletePod(pod)
+			}
+		})
+		close(stopCh)
+	}
+}
@@ -0,0 +1,292 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grouping
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// labelIndex is an inverted index of the labelItems of an entity type. Each labelItem is assigned a numeric ID, and
+// each label, label key and Namespace is mapped to a bitmap of the IDs of the labelItems having it (its posting list).
+// The labelItems which may be selected by a label selector can then be obtained by intersecting the posting lists of
+// its requirements, instead of matching the selector against all the labelItems.
+type labelIndex struct {
+	// ids maps the keys of the labelItems to their IDs.
+	ids map[string]uint32
+	// keys maps the IDs of the labelItems to their keys. The IDs of deleted labelItems are reused.
+	keys    []string
+	freeIDs []uint32
+	// labelPostings maps each label in the "key=value" format to the IDs of the labelItems having it.
+	labelPostings map[string]*bitmap
+	// keyPostings maps each label key to the IDs of the labelItems having it.
+	keyPostings map[string]*bitmap
+	// namespacePostings maps each Namespace to the IDs of the labelItems in it.
+	namespacePostings map[string]*bitmap
+}
+
+func newLabelIndex() *labelIndex {
+	return &labelIndex{
+		ids:               map[string]uint32{},
+		labelPostings:     map[string]*bitmap{},
+		keyPostings:       map[string]*bitmap{},
+		namespacePostings: map[string]*bitmap{},
+	}
+}
+
+func labelString(key, value string) string {
+	return key + "=" + value
+}
+
+func addPosting(postings map[string]*bitmap, term string, id uint32) {
+	posting, exists := postings[term]
+	if !exists {
+		posting = newBitmap()
+		postings[term] = posting
+	}
+	posting.add(id)
+}
+
+func removePosting(postings map[string]*bitmap, term string, id uint32) {
+	posting, exists := postings[term]
+	if !exists {
+		return
+	}
+	posting.remove(id)
+	if posting.len() == 0 {
+		delete(postings, term)
+	}
+}
+
+func (x *labelIndex) add(lKey string, lItem *labelItem) {
+	if _, exists := x.ids[lKey]; exists {
+		return
+	}
+	var id uint32
+	if n := len(x.freeIDs); n > 0 {
+		id = x.freeIDs[n-1]
+		x.freeIDs = x.freeIDs[:n-1]
+		x.keys[id] = lKey
+	} else {
+		id = uint32(len(x.keys))
+		x.keys = append(x.keys, lKey)
+	}
+	x.ids[lKey] = id
+	addPosting(x.namespacePostings, lItem.namespace, id)
+	for key, value := range lItem.labels {
+		addPosting(x.labelPostings, labelString(key, value), id)
+		addPosting(x.keyPostings, key, id)
+	}
+}
+
+func (x *labelIndex) delete(lKey string, lItem *labelItem) {
+	id, exists := x.ids[lKey]
+	if !exists {
+		return
+	}
+	removePosting(x.namespacePostings, lItem.namespace, id)
+	for key, value := range lItem.labels {
+		removePosting(x.labelPostings, labelString(key, value), id)
+		removePosting(x.keyPostings, key, id)
+	}
+	delete(x.ids, lKey)
+	x.keys[id] = ""
+	x.freeIDs = append(x.freeIDs, id)
+}
+
+// getLabelItems returns the keys of the labelItems in the given Namespaces, or in all Namespaces if allNamespaces is
+// true, which may be selected by the label selector. Only the Equals, In and Exists requirements are used to narrow
+// down the labelItems, so the returned labelItems must still be matched against the selector.
+func (x *labelIndex) getLabelItems(allNamespaces bool, namespaces []string, selector labels.Selector) []string {
+	var postings []*bitmap
+	if !allNamespaces {
+		scope := newBitmap()
+		for _, namespace := range namespaces {
+			if posting, exists := x.namespacePostings[namespace]; exists {
+				scope.union(posting)
+			}
+		}
+		postings = append(postings, scope)
+	}
+	if selector != nil {
+		requirements, _ := selector.Requirements()
+		for _, r := range requirements {
+			switch r.Operator() {
+			case selection.Equals, selection.DoubleEquals, selection.In:
+				values := r.ValuesUnsorted()
+				if len(values) == 1 {
+					postings = append(postings, x.labelPostings[labelString(r.Key(), values[0])])
+				} else {
+					// The labelItems must have any of the labels.
+					posting := newBitmap()
+					for _, value := range values {
+						if p, exists := x.labelPostings[labelString(r.Key(), value)]; exists {
+							posting.union(p)
+						}
+					}
+					postings = append(postings, posting)
+				}
+			case selection.Exists:
+				postings = append(postings, x.keyPostings[r.Key()])
+			}
+		}
+	}
+	if len(postings) == 0 {
+		lKeys := make([]string, 0, len(x.ids))
+		for lKey := range x.ids {
+			lKeys = append(lKeys, lKey)
+		}
+		return lKeys
+	}
+	for _, posting := range postings {
+		// There is no labelItem with the required label or label key.
+		if posting == nil || posting.len() == 0 {
+			return nil
+		}
+	}
+	// Intersect the posting lists from the smallest one, so that the cost is bounded by the size of the smallest one.
+	sort.Slice(postings, func(a, b int) bool {
+		return postings[a].len() < postings[b].len()
+	})
+	result := postings[0]
+	for _, posting := range postings[1:] {
+		result = result.intersection(posting)
+		if result.len() == 0 {
+			return nil
+		}
+	}
+	lKeys := make([]string, 0, result.len())
+	result.forEach(func(id uint32) {
+		lKeys = append(lKeys, x.keys[id])
+	})
+	return lKeys
+}
+
+// selectorIndex is an inverted index of the selectorItems of an entity type, used to find the selectorItems which may
+// select a new labelItem. Each selectorItem is anchored by the labels or the label key of one of its requirements,
+// which the labels it selects must have. The selectorItems without such requirement are unanchored, and are matched
+// against every new labelItem in their Namespaces.
+type selectorIndex struct {
+	// anchoredSelectors maps each Namespace and label ("<namespace>/<key>=<value>") or label key
+	// ("<namespace>/<key>") to the keys of the selectorItems anchored by it. Cluster scoped selectorItems are stored
+	// under empty Namespace "".
+	anchoredSelectors map[string]sets.Set[string]
+	// unanchoredSelectors maps each Namespace to the keys of the unanchored selectorItems in it.
+	unanchoredSelectors map[string]sets.Set[string]
+}
+
+func newSelectorIndex() *selectorIndex {
+	return &selectorIndex{
+		anchoredSelectors:   map[string]sets.Set[string]{},
+		unanchoredSelectors: map[string]sets.Set[string]{},
+	}
+}
+
+// getSelectorAnchors returns the anchors of a label selector in a Namespace. The labels of an Equals or In
+// requirement are preferred to the label key of an Exists requirement, as they are usually more selective.
+func getSelectorAnchors(namespace string, selector labels.Selector) []string {
+	if selector == nil {
+		return nil
+	}
+	requirements, _ := selector.Requirements()
+	var keyAnchor string
+	for _, r := range requirements {
+		switch r.Operator() {
+		case selection.Equals, selection.DoubleEquals, selection.In:
+			values := r.ValuesUnsorted()
+			anchors := make([]string, 0, len(values))
+			for _, value := range values {
+				anchors = append(anchors, namespace+"/"+labelString(r.Key(), value))
+			}
+			return anchors
+		case selection.Exists:
+			if keyAnchor == "" {
+				keyAnchor = namespace + "/" + r.Key()
+			}
+		}
+	}
+	if keyAnchor != "" {
+		return []string{keyAnchor}
+	}
+	return nil
+}
+
+func (x *selectorIndex) add(sKey, namespace string, selector labels.Selector) {
+	anchors := getSelectorAnchors(namespace, selector)
+	if len(anchors) == 0 {
+		insertSelector(x.unanchoredSelectors, namespace, sKey)
+		return
+	}
+	for _, anchor := range anchors {
+		insertSelector(x.anchoredSelectors, anchor, sKey)
+	}
+}
+
+func (x *selectorIndex) delete(sKey, namespace string, selector labels.Selector) {
+	anchors := getSelectorAnchors(namespace, selector)
+	if len(anchors) == 0 {
+		deleteSelector(x.unanchoredSelectors, namespace, sKey)
+		return
+	}
+	for _, anchor := range anchors {
+		deleteSelector(x.anchoredSelectors, anchor, sKey)
+	}
+}
+
+func insertSelector(selectors map[string]sets.Set[string], term, sKey string) {
+	sKeys, exists := selectors[term]
+	if !exists {
+		sKeys = sets.New[string]()
+		selectors[term] = sKeys
+	}
+	sKeys.Insert(sKey)
+}
+
+func deleteSelector(selectors map[string]sets.Set[string], term, sKey string) {
+	sKeys, exists := selectors[term]
+	if !exists {
+		return
+	}
+	sKeys.Delete(sKey)
+	if len(sKeys) == 0 {
+		delete(selectors, term)
+	}
+}
+
+// getSelectorItems returns the keys of the selectorItems in the Namespace and the cluster scoped selectorItems which
+// may select a labelItem with the given labels in the Namespace. The cost is proportional to the number of labels
+// and the number of returned selectorItems, regardless of the total number of selectorItems and labelItems.
+func (x *selectorIndex) getSelectorItems(namespace string, labelSet labels.Set) sets.Set[string] {
+	sKeys := sets.New[string]()
+	insert := func(keys sets.Set[string]) {
+		for sKey := range keys {
+			sKeys.Insert(sKey)
+		}
+	}
+	for _, ns := range []string{namespace, emptyNamespace} {
+		insert(x.unanchoredSelectors[ns])
+		for key, value := range labelSet {
+			insert(x.anchoredSelectors[ns+"/"+labelString(key, value)])
+			insert(x.anchoredSelectors[ns+"/"+key])
+		}
+		if namespace == emptyNamespace {
+			break
+		}
+	}
+	return sKeys
+}
@@ -0,0 +1,160 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grouping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func mustParseSelector(t *testing.T, selector string) labels.Selector {
+	s, err := labels.Parse(selector)
+	require.NoError(t, err)
+	return s
+}
+
+func TestLabelIndexGetLabelItems(t *testing.T) {
+	lItems := map[string]*labelItem{
+		"default/web":      {namespace: "default", labels: labels.Set{"app": "web", "tier": "frontend"}},
+		"default/db":       {namespace: "default", labels: labels.Set{"app": "db", "tier": "backend"}},
+		"default/job":      {namespace: "default", labels: labels.Set{"job": "backup"}},
+		"other/web":        {namespace: "other", labels: labels.Set{"app": "web"}},
+		"other/cache":      {namespace: "other", labels: labels.Set{"app": "cache", "tier": "backend"}},
+		"kube-system/dns":  {namespace: "kube-system", labels: labels.Set{"k8s-app": "kube-dns"}},
+		"kube-system/none": {namespace: "kube-system", labels: labels.Set{}},
+	}
+	index := newLabelIndex()
+	for lKey, lItem := range lItems {
+		index.add(lKey, lItem)
+	}
+	allKeys := sets.List(sets.KeySet(lItems))
+
+	tests := []struct {
+		name          string
+		allNamespaces bool
+		namespaces    []string
+		selector      string
+		expected      []string
+	}{
+		{
+			name:          "equals in all Namespaces",
+			allNamespaces: true,
+			selector:      "app=web",
+			expected:      []string{"default/web", "other/web"},
+		},
+		{
+			name:       "equals in a Namespace",
+			namespaces: []string{"other"},
+			selector:   "app=web",
+			expected:   []string{"other/web"},
+		},
+		{
+			name:          "multiple requirements",
+			allNamespaces: true,
+			selector:      "tier=backend,app",
+			expected:      []string{"default/db", "other/cache"},
+		},
+		{
+			name:          "in",
+			allNamespaces: true,
+			selector:      "app in (db,cache,unknown)",
+			expected:      []string{"default/db", "other/cache"},
+		},
+		{
+			name:          "exists",
+			allNamespaces: true,
+			selector:      "tier",
+			expected:      []string{"default/web", "default/db", "other/cache"},
+		},
+		{
+			name:          "unknown label",
+			allNamespaces: true,
+			selector:      "app=unknown",
+		},
+		{
+			name:       "no Namespace",
+			namespaces: []string{},
+			selector:   "app=web",
+		},
+		{
+			name:       "not narrowing requirements",
+			namespaces: []string{"default", "kube-system"},
+			selector:   "app!=web,!job",
+			expected:   []string{"default/web", "default/db", "default/job", "kube-system/dns", "kube-system/none"},
+		},
+		{
+			name:          "everything",
+			allNamespaces: true,
+			selector:      "",
+			expected:      allKeys,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := index.getLabelItems(tt.allNamespaces, tt.namespaces, mustParseSelector(t, tt.selector))
+			assert.ElementsMatch(t, tt.expected, actual)
+		})
+	}
+
+	// The IDs of deleted labelItems are reused.
+	index.delete("default/web", lItems["default/web"])
+	index.delete("default/web", lItems["default/web"])
+	assert.ElementsMatch(t, []string{"other/web"}, index.getLabelItems(true, nil, mustParseSelector(t, "app=web")))
+	assert.NotContains(t, index.labelPostings, "tier=frontend")
+	index.add("default/web2", &labelItem{namespace: "default", labels: labels.Set{"app": "web"}})
+	assert.Len(t, index.keys, len(lItems))
+	assert.ElementsMatch(t, []string{"default/web2", "other/web"}, index.getLabelItems(true, nil, mustParseSelector(t, "app=web")))
+}
+
+func TestSelectorIndexGetSelectorItems(t *testing.T) {
+	index := newSelectorIndex()
+	selectors := []struct {
+		key       string
+		namespace string
+		selector  labels.Selector
+	}{
+		{key: "default/web", namespace: "default", selector: mustParseSelector(t, "app=web")},
+		{key: "default/web-or-db", namespace: "default", selector: mustParseSelector(t, "tier,app in (web,db)")},
+		{key: "default/tier", namespace: "default", selector: mustParseSelector(t, "tier,app!=db")},
+		{key: "default/all", namespace: "default", selector: nil},
+		{key: "default/not-web", namespace: "default", selector: mustParseSelector(t, "app notin (web)")},
+		{key: "other/web", namespace: "other", selector: mustParseSelector(t, "app=web")},
+		{key: "other/all", namespace: "other", selector: labels.Everything()},
+		{key: "cluster/web", namespace: "", selector: mustParseSelector(t, "app=web")},
+		{key: "cluster/db", namespace: "", selector: mustParseSelector(t, "app=db")},
+	}
+	for _, s := range selectors {
+		index.add(s.key, s.namespace, s.selector)
+	}
+
+	assert.Equal(t, sets.New[string]("default/web", "default/web-or-db", "default/tier", "default/all", "default/not-web", "cluster/web"),
+		index.getSelectorItems("default", labels.Set{"app": "web", "tier": "frontend"}))
+	assert.Equal(t, sets.New[string]("default/all", "default/not-web"),
+		index.getSelectorItems("default", labels.Set{"job": "backup"}))
+	assert.Equal(t, sets.New[string]("other/all", "cluster/db"),
+		index.getSelectorItems("other", labels.Set{"app": "db"}))
+	assert.Equal(t, sets.New[string](),
+		index.getSelectorItems("kube-system", labels.Set{"k8s-app": "kube-dns"}))
+
+	for _, s := range selectors {
+		index.delete(s.key, s.namespace, s.selector)
+	}
+	assert.Empty(t, index.anchoredSelectors)
+	assert.Empty(t, index.unanchoredSelectors)
+}
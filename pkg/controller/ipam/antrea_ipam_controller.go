@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"time"
 
@@ -336,6 +337,13 @@ func (c *AntreaIPAMController) preallocateIPPoolForStatefulSet(ss *appsv1.Statef
 	if err != nil {
 		return fmt.Errorf("failed to find IP Pool %s: %s", ipPoolName, err)
 	}
+	namespace, err := c.namespaceLister.Get(ss.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get Namespace %s: %s", ss.Namespace, err)
+	}
+	if err := allocator.CheckNamespace(namespace); err != nil {
+		return err
+	}
 
 	size := int(*ss.Spec.Replicas)
 	// Note that AllocateStatefulSet would not preallocate IPs if this StatefulSet is already present
@@ -414,9 +422,10 @@ func (c *AntreaIPAMController) updateIPPoolCounters(poolName string) error {
 
 	// Used is gathered from IP allocation status within the CRD - as it can be set by each one of the agents
 	used := len(ipPool.Status.IPAddresses)
+	namespaceUsages := poolallocator.GetNamespaceUsages(ipPool)
 
 	// If update has no effect, exit
-	if ipPool.Status.Usage.Used == used && ipPool.Status.Usage.Total == total {
+	if ipPool.Status.Usage.Used == used && ipPool.Status.Usage.Total == total && slices.Equal(ipPool.Status.NamespaceUsages, namespaceUsages) {
 		return nil
 	}
 
@@ -426,6 +435,8 @@ func (c *AntreaIPAMController) updateIPPoolCounters(poolName string) error {
 				"used":  used,
 				"total": total,
 			},
+			// A null value removes the field.
+			"namespaceUsages": namespaceUsages,
 		},
 	})
 
@@ -143,6 +143,8 @@ func TestStatefulSetLifecycle(t *testing.T) {
 		name                string
 		dedicatedPool       bool
 		replicas            int32
+		namespaceSelector   *metav1.LabelSelector
+		maxIPsPerNamespace  int32
 		expectAllocatedSize int
 	}{
 		{
@@ -169,6 +171,27 @@ func TestStatefulSetLifecycle(t *testing.T) {
 			replicas:            20,
 			expectAllocatedSize: 0,
 		},
+		{
+			name:                "Within Namespace quota",
+			dedicatedPool:       true,
+			replicas:            5,
+			maxIPsPerNamespace:  5,
+			expectAllocatedSize: 5,
+		},
+		{
+			name:                "Exceeding Namespace quota",
+			dedicatedPool:       true,
+			replicas:            6,
+			maxIPsPerNamespace:  5,
+			expectAllocatedSize: 0,
+		},
+		{
+			name:                "Namespace not selected",
+			dedicatedPool:       true,
+			replicas:            5,
+			namespaceSelector:   &metav1.LabelSelector{MatchLabels: map[string]string{"team": "blue"}},
+			expectAllocatedSize: 0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -178,6 +201,8 @@ func TestStatefulSetLifecycle(t *testing.T) {
 			defer close(stopCh)
 
 			namespace, pool, statefulSet := initTestObjects(!tt.dedicatedPool, tt.dedicatedPool, tt.replicas)
+			pool.Spec.NamespaceSelector = tt.namespaceSelector
+			pool.Spec.MaxIPsPerNamespace = tt.maxIPsPerNamespace
 			controller := newFakeAntreaIPAMController(pool, namespace, statefulSet)
 			controller.informerFactory.Start(stopCh)
 			controller.crdInformerFactory.Start(stopCh)
@@ -198,6 +223,43 @@ func TestStatefulSetLifecycle(t *testing.T) {
 	}
 }
 
+func TestUpdateIPPoolCounters(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	namespace, pool, statefulSet := initTestObjects(true, false, 0)
+	pool.Spec.MaxIPsPerNamespace = 10
+	pool.Status.IPAddresses = []crdv1b1.IPAddressState{
+		{
+			IPAddress: "10.2.2.100",
+			Phase:     crdv1b1.IPAddressPhaseAllocated,
+			Owner:     crdv1b1.IPAddressOwner{Pod: &crdv1b1.PodOwner{Name: "pod1", Namespace: "ns1"}},
+		},
+		{
+			IPAddress: "10.2.2.101",
+			Phase:     crdv1b1.IPAddressPhaseReserved,
+			Owner:     crdv1b1.IPAddressOwner{StatefulSet: &crdv1b1.StatefulSetOwner{Name: "sts", Namespace: "ns2"}},
+		},
+		{
+			IPAddress: "10.2.2.102",
+			Phase:     crdv1b1.IPAddressPhaseAllocated,
+			Owner:     crdv1b1.IPAddressOwner{Pod: &crdv1b1.PodOwner{Name: "pod2", Namespace: "ns1"}},
+		},
+	}
+	controller := newFakeAntreaIPAMController(pool, namespace, statefulSet)
+	controller.crdInformerFactory.Start(stopCh)
+	controller.crdInformerFactory.WaitForCacheSync(stopCh)
+
+	require.NoError(t, controller.updateIPPoolCounters(pool.Name))
+	updatedPool, err := controller.fakeCRDClient.CrdV1beta1().IPPools().Get(context.TODO(), pool.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, crdv1b1.IPPoolUsage{Total: 11, Used: 3}, updatedPool.Status.Usage)
+	assert.Equal(t, []crdv1b1.IPPoolNamespaceUsage{
+		{Namespace: "ns1", Used: 2},
+		{Namespace: "ns2", Used: 1},
+	}, updatedPool.Status.NamespaceUsages)
+}
+
 // Test for cleanup on controller startup: stale addresses that belong no StatefulSet objects
 // that no longer exist should be cleaned up.
 func TestReleaseStaleAddresses(t *testing.T) {
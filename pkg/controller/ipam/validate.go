@@ -52,6 +52,11 @@ func ValidateIPPool(review *admv1.AdmissionReview) *admv1.AdmissionResponse {
 	case admv1.Create:
 		klog.V(2).Info("Validating CREATE request for IPPool")
 
+		allowed, msg = validateNamespaceRestriction(newObj.Spec)
+		if !allowed {
+			return validationResult(allowed, msg)
+		}
+
 		// Validate individual ranges
 		for _, r := range newObj.Spec.IPRanges {
 			allowed, msg = validateIPRange(r, newObj.Spec.SubnetInfo)
@@ -71,6 +76,11 @@ func ValidateIPPool(review *admv1.AdmissionReview) *admv1.AdmissionResponse {
 		}
 	case admv1.Update:
 		klog.V(2).Info("Validating UPDATE request for IPPool")
+
+		allowed, msg = validateNamespaceRestriction(newObj.Spec)
+		if !allowed {
+			return validationResult(allowed, msg)
+		}
 		deletedIPRanges := getIPRangeDifference(oldObj.Spec.IPRanges, newObj.Spec.IPRanges)
 		if len(deletedIPRanges) > 0 {
 			msg = fmt.Sprintf("existing IPRanges %s cannot be updated or deleted", humanReadableIPRanges(deletedIPRanges))
@@ -243,6 +253,20 @@ func validateIPRange(r crdv1beta1.IPRange, subnetInfo crdv1beta1.SubnetInfo) (bo
 	return true, ""
 }
 
+// validateNamespaceRestriction validates the namespaceSelector and the maxIPsPerNamespace of an IPPool. Updating them
+// is allowed: the IPs which have already been allocated are not affected, only new allocations are.
+func validateNamespaceRestriction(spec crdv1beta1.IPPoolSpec) (bool, string) {
+	if spec.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector); err != nil {
+			return false, fmt.Sprintf("Invalid namespaceSelector: %v", err)
+		}
+	}
+	if spec.MaxIPsPerNamespace < 0 {
+		return false, fmt.Sprintf("Invalid maxIPsPerNamespace %d", spec.MaxIPsPerNamespace)
+	}
+	return true, ""
+}
+
 func newAdmissionResponseForErr(err error) *admv1.AdmissionResponse {
 	return &admv1.AdmissionResponse{
 		Result: &metav1.Status{
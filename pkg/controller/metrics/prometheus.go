@@ -117,6 +117,36 @@ var (
 		Help:           "The total number of actual status updates performed for Antrea ClusterNetworkPolicy Custom Resources",
 		StabilityLevel: metrics.ALPHA,
 	})
+	NetworkPolicyRealizationDuration = metrics.NewHistogramVec(&metrics.HistogramOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "network_policy_realization_duration_seconds",
+		Help:           "The duration from antrea-controller observing a new generation of an Antrea-native policy to the policy being realized on all the Nodes it spans",
+		Buckets:        []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300},
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"policy_type"})
+	NetworkPolicyRealizationSLOBreaches = metrics.NewCounterVec(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "network_policy_realization_slo_breaches",
+		Help:           "The total number of Antrea-native policy generations which were not realized on all the Nodes they span within the configured SLO",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"policy_type"})
+	AdmissionWebhookDuration = metrics.NewHistogramVec(&metrics.HistogramOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "admission_webhook_duration_seconds",
+		Help:           "The duration of processing admission requests in the Antrea validating and mutating webhooks",
+		Buckets:        []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1},
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"webhook", "kind", "operation", "allowed"})
+	AdmissionWebhookRequestsInFlight = metrics.NewGaugeVec(&metrics.GaugeOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemController,
+		Name:           "admission_webhook_requests_in_flight",
+		Help:           "The number of admission requests being processed by the Antrea validating and mutating webhooks",
+		StabilityLevel: metrics.ALPHA,
+	}, []string{"webhook"})
 )
 
 // Initialize Prometheus metrics collection.
@@ -156,4 +186,23 @@ func InitializePrometheusMetrics() {
 	if err := legacyregistry.Register(AntreaClusterNetworkPolicyStatusUpdates); err != nil {
 		klog.Errorf("Failed to register antrea_controller_acnp_status_updates with Prometheus: %s", err.Error())
 	}
+	if err := legacyregistry.Register(NetworkPolicyRealizationDuration); err != nil {
+		klog.Errorf("Failed to register antrea_controller_network_policy_realization_duration_seconds with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(NetworkPolicyRealizationSLOBreaches); err != nil {
+		klog.Errorf("Failed to register antrea_controller_network_policy_realization_slo_breaches with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(AdmissionWebhookDuration); err != nil {
+		klog.Errorf("Failed to register antrea_controller_admission_webhook_duration_seconds with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(AdmissionWebhookRequestsInFlight); err != nil {
+		klog.Errorf("Failed to register antrea_controller_admission_webhook_requests_in_flight with Prometheus: %s", err.Error())
+	}
+}
+
+// RegisterCollector registers a custom collector computing metrics when they are scraped.
+func RegisterCollector(collector metrics.StableCollector) {
+	if err := legacyregistry.CustomRegister(collector); err != nil {
+		klog.ErrorS(err, "Failed to register custom collector with Prometheus")
+	}
 }
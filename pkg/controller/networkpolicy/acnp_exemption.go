@@ -0,0 +1,319 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+// ACNPExemptionConfig configures the Namespaces which are never selected by the appliedTo of ClusterNetworkPolicies,
+// to prevent a policy from breaking the cluster by isolating the system components.
+type ACNPExemptionConfig struct {
+	// Namespaces are the names of the exempt Namespaces.
+	Namespaces []string
+	// NamespaceSelector selects additional exempt Namespaces by their labels. It can be nil.
+	NamespaceSelector labels.Selector
+	// Tiers are the names of the Tiers whose ClusterNetworkPolicies are not applied to the exempt Namespaces. If
+	// empty, the ClusterNetworkPolicies of all Tiers are affected.
+	Tiers []string
+}
+
+type acnpExemption struct {
+	namespaces        sets.Set[string]
+	namespaceSelector labels.Selector
+	tiers             sets.Set[string]
+}
+
+// SetACNPExemption sets the Namespaces exempt from ClusterNetworkPolicies. It must be called before the controller is
+// started.
+func (n *NetworkPolicyController) SetACNPExemption(config ACNPExemptionConfig) {
+	if len(config.Namespaces) == 0 && config.NamespaceSelector == nil {
+		n.acnpExemption = nil
+		return
+	}
+	tiers := sets.New[string]()
+	for _, tier := range config.Tiers {
+		tiers.Insert(normalizeTierName(tier))
+	}
+	n.acnpExemption = &acnpExemption{
+		namespaces:        sets.New[string](config.Namespaces...),
+		namespaceSelector: config.NamespaceSelector,
+		tiers:             tiers,
+	}
+}
+
+// normalizeTierName returns the name of the Tier resource referenced by the tier of an Antrea-native policy.
+func normalizeTierName(tier string) string {
+	if tier == "" {
+		return defaultTierName
+	}
+	// Policies created before the static Tiers were deprecated may refer to them with their capitalized names.
+	if staticTierSet.Has(tier) {
+		return strings.ToLower(tier)
+	}
+	return tier
+}
+
+func (e *acnpExemption) appliesToTier(tier string) bool {
+	return e.tiers.Len() == 0 || e.tiers.Has(normalizeTierName(tier))
+}
+
+func (e *acnpExemption) isNamespaceExempt(namespace *v1.Namespace) bool {
+	if namespace == nil {
+		return false
+	}
+	return e.namespaces.Has(namespace.Name) ||
+		(e.namespaceSelector != nil && e.namespaceSelector.Matches(labels.Set(namespace.Labels)))
+}
+
+// getExemptNamespaces returns the names of the exempt Namespaces. The Namespaces configured by name are included even
+// if they don't exist, so that the policies don't need to be processed again when they are created.
+func (n *NetworkPolicyController) getExemptNamespaces() sets.Set[string] {
+	namespaces := n.acnpExemption.namespaces.Clone()
+	if n.acnpExemption.namespaceSelector != nil {
+		selected, _ := n.namespaceLister.List(n.acnpExemption.namespaceSelector)
+		for _, namespace := range selected {
+			namespaces.Insert(namespace.Name)
+		}
+	}
+	return namespaces
+}
+
+// exemptAppliedToGroup returns an AppliedToGroup selecting the same workloads as the provided one, except the ones in
+// the exempt Namespaces. It returns nil if the provided AppliedToGroup only selects workloads in exempt Namespaces.
+// The members of ClusterGroups are computed independently of the policies using them, so for an AppliedToGroup derived
+// from a ClusterGroup, a distinct AppliedToGroup is returned, which excludes the exempt Namespaces from the members of
+// the ClusterGroup.
+func exemptAppliedToGroup(atg *antreatypes.AppliedToGroup, exemptNamespaces sets.Set[string]) *antreatypes.AppliedToGroup {
+	if atg.Service != nil {
+		if exemptNamespaces.Has(atg.Service.Namespace) {
+			return nil
+		}
+		return atg
+	}
+	if atg.SourceGroup != "" {
+		appliedToGroupUID := getNormalizedUID(fmt.Sprintf("%s/exempt=%s", atg.SourceGroup, strings.Join(sets.List(exemptNamespaces), ",")))
+		return &antreatypes.AppliedToGroup{
+			Name:             appliedToGroupUID,
+			UID:              types.UID(appliedToGroupUID),
+			SourceGroup:      atg.SourceGroup,
+			ExemptNamespaces: exemptNamespaces,
+		}
+	}
+	if atg.Selector == nil || atg.Selector.NodeSelector != nil {
+		return atg
+	}
+	selector := atg.Selector
+	if selector.Namespace != "" {
+		if exemptNamespaces.Has(selector.Namespace) {
+			return nil
+		}
+		return atg
+	}
+	requirement, err := labels.NewRequirement(v1.LabelMetadataName, selection.NotIn, sets.List(exemptNamespaces))
+	if err != nil {
+		klog.ErrorS(err, "Failed to exclude exempt Namespaces from AppliedToGroup", "AppliedToGroup", atg.Name)
+		return atg
+	}
+	nsSelector := selector.NamespaceSelector
+	if nsSelector == nil {
+		nsSelector = labels.Everything()
+	}
+	groupSelector := &antreatypes.GroupSelector{
+		PodSelector:            selector.PodSelector,
+		NamespaceSelector:      nsSelector.Add(*requirement),
+		ExternalEntitySelector: selector.ExternalEntitySelector,
+	}
+	groupSelector.NormalizedName = antreatypes.GenerateNormalizedName("", groupSelector.PodSelector,
+		groupSelector.NamespaceSelector, groupSelector.ExternalEntitySelector, nil)
+	appliedToGroupUID := getNormalizedUID(groupSelector.NormalizedName)
+	return &antreatypes.AppliedToGroup{
+		Name:     appliedToGroupUID,
+		UID:      types.UID(appliedToGroupUID),
+		Selector: groupSelector,
+	}
+}
+
+// excludeExemptNamespaces returns the workloads which are not in the exempt Namespaces.
+func excludeExemptNamespaces(pods []*v1.Pod, ees []*v1alpha2.ExternalEntity, exemptNamespaces sets.Set[string]) ([]*v1.Pod, []*v1alpha2.ExternalEntity) {
+	var filteredPods []*v1.Pod
+	for _, pod := range pods {
+		if !exemptNamespaces.Has(pod.Namespace) {
+			filteredPods = append(filteredPods, pod)
+		}
+	}
+	var filteredEEs []*v1alpha2.ExternalEntity
+	for _, ee := range ees {
+		if !exemptNamespaces.Has(ee.Namespace) {
+			filteredEEs = append(filteredEEs, ee)
+		}
+	}
+	return filteredPods, filteredEEs
+}
+
+// applyACNPExemption excludes the exempt Namespaces from the AppliedToGroups of the new internal NetworkPolicy of a
+// ClusterNetworkPolicy in an exempt Tier. The rules which only applied to exempt Namespaces are removed, as well as
+// the groups which are no longer used by the internal NetworkPolicy.
+func (n *NetworkPolicyController) applyACNPExemption(
+	acnp *crdv1beta1.ClusterNetworkPolicy,
+	newNP *antreatypes.NetworkPolicy,
+	appliedToGroups map[string]*antreatypes.AppliedToGroup,
+	addressGroups map[string]*antreatypes.AddressGroup,
+) {
+	if n.acnpExemption == nil || !n.acnpExemption.appliesToTier(acnp.Spec.Tier) {
+		return
+	}
+	exemptNamespaces := n.getExemptNamespaces()
+	if exemptNamespaces.Len() == 0 {
+		return
+	}
+	replacements := map[string]*antreatypes.AppliedToGroup{}
+	for name, atg := range appliedToGroups {
+		if exemptATG := exemptAppliedToGroup(atg, exemptNamespaces); exemptATG != atg {
+			replacements[name] = exemptATG
+		}
+	}
+	if len(replacements) == 0 {
+		return
+	}
+	for name, atg := range replacements {
+		delete(appliedToGroups, name)
+		if atg != nil {
+			appliedToGroups[atg.Name] = atg
+		}
+	}
+	replaceNames := func(names []string) []string {
+		newNames := make([]string, 0, len(names))
+		seen := sets.New[string]()
+		for _, name := range names {
+			if atg, replaced := replacements[name]; replaced {
+				if atg == nil {
+					continue
+				}
+				name = atg.Name
+			}
+			if !seen.Has(name) {
+				seen.Insert(name)
+				newNames = append(newNames, name)
+			}
+		}
+		return newNames
+	}
+
+	rules := make([]controlplane.NetworkPolicyRule, 0, len(newNP.Rules))
+	for _, rule := range newNP.Rules {
+		if len(rule.AppliedToGroups) > 0 {
+			rule.AppliedToGroups = replaceNames(rule.AppliedToGroups)
+			if len(rule.AppliedToGroups) == 0 {
+				// The rule only applied to exempt Namespaces. It cannot be kept without AppliedToGroups, as it would
+				// then apply to the AppliedToGroups of the policy.
+				continue
+			}
+		}
+		rules = append(rules, rule)
+	}
+	newNP.Rules = rules
+	if newNP.AppliedToPerRule {
+		ruleAppliedToGroups := sets.New[string]()
+		for _, rule := range rules {
+			ruleAppliedToGroups.Insert(rule.AppliedToGroups...)
+		}
+		newNP.AppliedToGroups = sets.List(ruleAppliedToGroups)
+	} else {
+		newNP.AppliedToGroups = replaceNames(newNP.AppliedToGroups)
+	}
+	pruneUnusedGroups(newNP, appliedToGroups, addressGroups)
+	klog.V(2).InfoS("Excluded exempt Namespaces from ClusterNetworkPolicy", "name", acnp.Name, "namespaces", sets.List(exemptNamespaces))
+}
+
+// enqueueACNPsForNamespaceExemption enqueues the ClusterNetworkPolicies in exempt Tiers when a Namespace starts or
+// stops being exempt because of its labels, as the Namespaces they cannot apply to must be computed again. Either
+// Namespace can be nil when the Namespace is created or deleted.
+func (n *NetworkPolicyController) enqueueACNPsForNamespaceExemption(oldNamespace, curNamespace *v1.Namespace) {
+	e := n.acnpExemption
+	// The Namespaces configured by name are always exempt, whether they exist or not.
+	if e == nil || e.namespaceSelector == nil {
+		return
+	}
+	if e.isNamespaceExempt(oldNamespace) == e.isNamespaceExempt(curNamespace) {
+		return
+	}
+	acnps, _ := n.acnpLister.List(labels.Everything())
+	for _, acnp := range acnps {
+		if e.appliesToTier(acnp.Spec.Tier) {
+			n.enqueueInternalNetworkPolicy(getACNPReference(acnp))
+		}
+	}
+}
+
+// checkACNPExemption returns a warning if the appliedTo of a ClusterNetworkPolicy in an exempt Tier selects exempt
+// Namespaces, as the policy will not be applied to them.
+func (v *antreaPolicyValidator) checkACNPExemption(acnp *crdv1beta1.ClusterNetworkPolicy) []string {
+	n := v.networkPolicyController
+	if n.acnpExemption == nil || !n.acnpExemption.appliesToTier(acnp.Spec.Tier) {
+		return nil
+	}
+	exemptNamespaces := n.getExemptNamespaces()
+	appliedTos := acnp.Spec.AppliedTo
+	for _, rule := range append(acnp.Spec.Ingress, acnp.Spec.Egress...) {
+		appliedTos = append(appliedTos, rule.AppliedTo...)
+	}
+	matchedNamespaces := sets.New[string]()
+	for _, at := range appliedTos {
+		switch {
+		case at.NodeSelector != nil, at.Group != "":
+			continue
+		case at.Service != nil:
+			if exemptNamespaces.Has(at.Service.Namespace) {
+				matchedNamespaces.Insert(at.Service.Namespace)
+			}
+		case at.ServiceAccount != nil:
+			if exemptNamespaces.Has(at.ServiceAccount.Namespace) {
+				matchedNamespaces.Insert(at.ServiceAccount.Namespace)
+			}
+		default:
+			nsSelector := labels.Everything()
+			if at.NamespaceSelector != nil {
+				var err error
+				if nsSelector, err = metav1.LabelSelectorAsSelector(at.NamespaceSelector); err != nil {
+					continue
+				}
+			}
+			for name := range exemptNamespaces {
+				if namespace, err := n.namespaceLister.Get(name); err == nil && nsSelector.Matches(labels.Set(namespace.Labels)) {
+					matchedNamespaces.Insert(name)
+				}
+			}
+		}
+	}
+	if matchedNamespaces.Len() == 0 {
+		return nil
+	}
+	return []string{fmt.Sprintf("the policy selects exempt Namespaces %v, it will not be applied to them", sets.List(matchedNamespaces))}
+}
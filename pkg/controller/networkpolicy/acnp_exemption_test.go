@@ -0,0 +1,229 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/apis/crd/v1alpha2"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+var (
+	exemptNamespacesSelector = &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: v1.LabelMetadataName, Operator: metav1.LabelSelectorOpNotIn, Values: []string{"antrea", "kube-system"}},
+		},
+	}
+	webPodSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}
+)
+
+func newExemptionController(t *testing.T) *networkPolicyController {
+	_, c := newController(nil, nil)
+	c.namespaceStore.Add(&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "kube-system", Labels: map[string]string{v1.LabelMetadataName: "kube-system"}}})
+	c.namespaceStore.Add(&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "antrea", Labels: map[string]string{v1.LabelMetadataName: "antrea", "exempt": "true"}}})
+	c.namespaceStore.Add(&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{v1.LabelMetadataName: "default"}}})
+	selector, err := labels.Parse("exempt=true")
+	require.NoError(t, err)
+	c.SetACNPExemption(ACNPExemptionConfig{
+		Namespaces:        []string{"kube-system"},
+		NamespaceSelector: selector,
+		Tiers:             []string{"SecurityOps"},
+	})
+	return c
+}
+
+func TestExemptAppliedToGroup(t *testing.T) {
+	_, c := newController(nil, nil)
+	exemptNamespaces := sets.New[string]("antrea", "kube-system")
+	tests := []struct {
+		name        string
+		atg         *antreatypes.AppliedToGroup
+		expectedATG *antreatypes.AppliedToGroup
+	}{
+		{
+			name:        "Service in exempt Namespace",
+			atg:         c.createAppliedToGroupForService(&crdv1beta1.NamespacedName{Namespace: "kube-system", Name: "svc1"}),
+			expectedATG: nil,
+		},
+		{
+			name:        "Service in other Namespace",
+			atg:         c.createAppliedToGroupForService(&crdv1beta1.NamespacedName{Namespace: "default", Name: "svc1"}),
+			expectedATG: c.createAppliedToGroupForService(&crdv1beta1.NamespacedName{Namespace: "default", Name: "svc1"}),
+		},
+		{
+			name:        "ServiceAccount in exempt Namespace",
+			atg:         c.createAppliedToGroup("antrea", serviceAccountNameToPodSelector("sa1"), nil, nil, nil),
+			expectedATG: nil,
+		},
+		{
+			name:        "Pods in all Namespaces",
+			atg:         c.createAppliedToGroup("", webPodSelector, nil, nil, nil),
+			expectedATG: c.createAppliedToGroup("", webPodSelector, exemptNamespacesSelector, nil, nil),
+		},
+		{
+			name: "Pods in selected Namespaces",
+			atg:  c.createAppliedToGroup("", webPodSelector, &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}}, nil, nil),
+			expectedATG: c.createAppliedToGroup("", webPodSelector, &metav1.LabelSelector{
+				MatchLabels:      map[string]string{"env": "prod"},
+				MatchExpressions: exemptNamespacesSelector.MatchExpressions,
+			}, nil, nil),
+		},
+		{
+			name:        "Nodes",
+			atg:         c.createAppliedToGroup("", nil, nil, nil, &metav1.LabelSelector{}),
+			expectedATG: c.createAppliedToGroup("", nil, nil, nil, &metav1.LabelSelector{}),
+		},
+		{
+			name: "ClusterGroup",
+			atg:  &antreatypes.AppliedToGroup{Name: "cg1", SourceGroup: "cg1"},
+			expectedATG: &antreatypes.AppliedToGroup{
+				Name:             getNormalizedUID("cg1/exempt=antrea,kube-system"),
+				SourceGroup:      "cg1",
+				ExemptNamespaces: exemptNamespaces,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			atg := exemptAppliedToGroup(tt.atg, exemptNamespaces)
+			if tt.expectedATG == nil {
+				assert.Nil(t, atg)
+				return
+			}
+			require.NotNil(t, atg)
+			assert.Equal(t, tt.expectedATG.Name, atg.Name)
+			assert.Equal(t, tt.expectedATG.SourceGroup, atg.SourceGroup)
+			assert.Equal(t, tt.expectedATG.Service, atg.Service)
+			assert.Equal(t, tt.expectedATG.ExemptNamespaces, atg.ExemptNamespaces)
+		})
+	}
+}
+
+func TestExcludeExemptNamespaces(t *testing.T) {
+	pod1 := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "pod1"}}
+	pod2 := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod2"}}
+	ee1 := &v1alpha2.ExternalEntity{ObjectMeta: metav1.ObjectMeta{Namespace: "antrea", Name: "ee1"}}
+	ee2 := &v1alpha2.ExternalEntity{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ee2"}}
+	pods, ees := excludeExemptNamespaces([]*v1.Pod{pod1, pod2}, []*v1alpha2.ExternalEntity{ee1, ee2}, sets.New[string]("antrea", "kube-system"))
+	assert.Equal(t, []*v1.Pod{pod2}, pods)
+	assert.Equal(t, []*v1alpha2.ExternalEntity{ee2}, ees)
+}
+
+func TestApplyACNPExemption(t *testing.T) {
+	c := newExemptionController(t)
+	allowAction := crdv1beta1.RuleActionAllow
+	saATG := c.createAppliedToGroup("kube-system", serviceAccountNameToPodSelector("sa1"), nil, nil, nil)
+	webATG := c.createAppliedToGroup("", webPodSelector, nil, nil, nil)
+	exemptWebATG := c.createAppliedToGroup("", webPodSelector, exemptNamespacesSelector, nil, nil)
+	newInternalNP := func() (*antreatypes.NetworkPolicy, map[string]*antreatypes.AppliedToGroup, map[string]*antreatypes.AddressGroup) {
+		np := &antreatypes.NetworkPolicy{
+			Name: "uid1",
+			Rules: []controlplane.NetworkPolicyRule{
+				{Name: "rule1", Direction: controlplane.DirectionIn, From: controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag1"}}, AppliedToGroups: []string{saATG.Name}, Action: &allowAction},
+				{Name: "rule2", Direction: controlplane.DirectionIn, From: controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag2"}}, AppliedToGroups: []string{webATG.Name}, Action: &allowAction},
+			},
+			AppliedToGroups:  []string{saATG.Name, webATG.Name},
+			AppliedToPerRule: true,
+		}
+		appliedToGroups := map[string]*antreatypes.AppliedToGroup{saATG.Name: saATG, webATG.Name: webATG}
+		addressGroups := map[string]*antreatypes.AddressGroup{"ag1": {Name: "ag1"}, "ag2": {Name: "ag2"}}
+		return np, appliedToGroups, addressGroups
+	}
+	newACNP := func(tier string) *crdv1beta1.ClusterNetworkPolicy {
+		return &crdv1beta1.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "acnp1", UID: "uid1"},
+			Spec:       crdv1beta1.ClusterNetworkPolicySpec{Tier: tier},
+		}
+	}
+
+	// The policies in other Tiers are not affected.
+	np, appliedToGroups, addressGroups := newInternalNP()
+	c.applyACNPExemption(newACNP("application"), np, appliedToGroups, addressGroups)
+	expectedNP, _, _ := newInternalNP()
+	assert.Equal(t, expectedNP, np)
+	assert.Len(t, appliedToGroups, 2)
+	assert.Len(t, addressGroups, 2)
+
+	// The rule only applied to an exempt Namespace is removed, and the other AppliedToGroup excludes the exempt
+	// Namespaces, including the ones selected by labels.
+	np, appliedToGroups, addressGroups = newInternalNP()
+	c.applyACNPExemption(newACNP("securityops"), np, appliedToGroups, addressGroups)
+	require.Len(t, np.Rules, 1)
+	assert.Equal(t, "rule2", np.Rules[0].Name)
+	assert.Equal(t, []string{exemptWebATG.Name}, np.Rules[0].AppliedToGroups)
+	assert.Equal(t, []string{exemptWebATG.Name}, np.AppliedToGroups)
+	assert.Equal(t, sets.New[string](exemptWebATG.Name), sets.KeySet(appliedToGroups))
+	assert.Equal(t, exemptWebATG.Selector.NormalizedName, appliedToGroups[exemptWebATG.Name].Selector.NormalizedName)
+	assert.Equal(t, sets.New[string]("ag2"), sets.KeySet(addressGroups))
+}
+
+func TestCheckACNPExemption(t *testing.T) {
+	c := newExemptionController(t)
+	v := &antreaPolicyValidator{networkPolicyController: c.NetworkPolicyController}
+	tests := []struct {
+		name             string
+		tier             string
+		appliedTo        []crdv1beta1.AppliedTo
+		expectedWarnings []string
+	}{
+		{
+			name:      "other Tier",
+			tier:      "application",
+			appliedTo: []crdv1beta1.AppliedTo{{PodSelector: webPodSelector}},
+		},
+		{
+			name:             "all Namespaces",
+			tier:             "securityops",
+			appliedTo:        []crdv1beta1.AppliedTo{{PodSelector: webPodSelector}},
+			expectedWarnings: []string{"the policy selects exempt Namespaces [antrea kube-system], it will not be applied to them"},
+		},
+		{
+			name: "selected Namespaces",
+			tier: "securityops",
+			appliedTo: []crdv1beta1.AppliedTo{
+				{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"exempt": "true"}}},
+				{ServiceAccount: &crdv1beta1.NamespacedName{Namespace: "default", Name: "sa1"}},
+			},
+			expectedWarnings: []string{"the policy selects exempt Namespaces [antrea], it will not be applied to them"},
+		},
+		{
+			name: "no exempt Namespace",
+			tier: "securityops",
+			appliedTo: []crdv1beta1.AppliedTo{
+				{NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{v1.LabelMetadataName: "default"}}},
+				{Service: &crdv1beta1.NamespacedName{Namespace: "default", Name: "svc1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			acnp := &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "acnp1"},
+				Spec:       crdv1beta1.ClusterNetworkPolicySpec{Tier: tt.tier, AppliedTo: tt.appliedTo},
+			}
+			assert.Equal(t, tt.expectedWarnings, v.checkACNPExemption(acnp))
+		})
+	}
+}
@@ -15,6 +15,11 @@
 package networkpolicy
 
 import (
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
@@ -137,19 +142,49 @@ func (n *NetworkPolicyController) deleteBANP(old interface{}) {
 	n.enqueueInternalNetworkPolicy(getBANPReference(banp))
 }
 
+// peerHasNamespaceLabelRule returns whether an AdminNetworkPolicyPeer selects Namespaces by
+// advanced Namespace selection (sameLabels and notSameLabels)
+func peerHasNamespaceLabelRule(peer v1alpha1.AdminNetworkPolicyPeer) bool {
+	var namespaces *v1alpha1.NamespacedPeer
+	if peer.Namespaces != nil {
+		namespaces = peer.Namespaces
+	} else if peer.Pods != nil {
+		namespaces = &peer.Pods.Namespaces
+	}
+	return namespaces != nil && (len(namespaces.SameLabels) > 0 || len(namespaces.NotSameLabels) > 0)
+}
+
+// peerNamespaceLabels returns the sameLabels or notSameLabels keys of an AdminNetworkPolicyPeer,
+// and whether they are notSameLabels keys.
+func peerNamespaceLabels(peer v1alpha1.AdminNetworkPolicyPeer) ([]string, bool) {
+	var namespaces *v1alpha1.NamespacedPeer
+	if peer.Namespaces != nil {
+		namespaces = peer.Namespaces
+	} else if peer.Pods != nil {
+		namespaces = &peer.Pods.Namespaces
+	}
+	if namespaces == nil {
+		return nil, false
+	}
+	if len(namespaces.NotSameLabels) > 0 {
+		return namespaces.NotSameLabels, true
+	}
+	return namespaces.SameLabels, false
+}
+
 // anpHasNamespaceLabelRule returns whether an AdminNetworkPolicy has rules defined by
 // advanced Namespace selection (sameLabels and notSameLabels)
 func anpHasNamespaceLabelRule(anp *v1alpha1.AdminNetworkPolicy) bool {
 	for _, ingress := range anp.Spec.Ingress {
 		for _, peer := range ingress.From {
-			if peer.Namespaces != nil && (len(peer.Namespaces.SameLabels) > 0 || len(peer.Namespaces.NotSameLabels) > 0) {
+			if peerHasNamespaceLabelRule(peer) {
 				return true
 			}
 		}
 	}
 	for _, egress := range anp.Spec.Egress {
 		for _, peer := range egress.To {
-			if peer.Namespaces != nil && (len(peer.Namespaces.SameLabels) > 0 || len(peer.Namespaces.NotSameLabels) > 0) {
+			if peerHasNamespaceLabelRule(peer) {
 				return true
 			}
 		}
@@ -162,14 +197,14 @@ func anpHasNamespaceLabelRule(anp *v1alpha1.AdminNetworkPolicy) bool {
 func banpHasNamespaceLabelRule(banp *v1alpha1.BaselineAdminNetworkPolicy) bool {
 	for _, ingress := range banp.Spec.Ingress {
 		for _, peer := range ingress.From {
-			if peer.Namespaces != nil && (len(peer.Namespaces.SameLabels) > 0 || len(peer.Namespaces.NotSameLabels) > 0) {
+			if peerHasNamespaceLabelRule(peer) {
 				return true
 			}
 		}
 	}
 	for _, egress := range banp.Spec.Egress {
 		for _, peer := range egress.To {
-			if peer.Namespaces != nil && (len(peer.Namespaces.SameLabels) > 0 || len(peer.Namespaces.NotSameLabels) > 0) {
+			if peerHasNamespaceLabelRule(peer) {
 				return true
 			}
 		}
@@ -177,6 +212,24 @@ func banpHasNamespaceLabelRule(banp *v1alpha1.BaselineAdminNetworkPolicy) bool {
 	return false
 }
 
+// enqueueAdminNPsWithNamespaceLabelRules triggers all AdminNetworkPolicies and BaselineAdminNetworkPolicies
+// that have rules defined by advanced Namespace selection to be re-processed, as the peers of these rules
+// depend on the labels of the Namespaces selected by the subject.
+func (n *NetworkPolicyController) enqueueAdminNPsWithNamespaceLabelRules() {
+	anps, _ := n.adminNetworkPolicyLister.List(labels.Everything())
+	for _, anp := range anps {
+		if anpHasNamespaceLabelRule(anp) {
+			n.enqueueInternalNetworkPolicy(getAdminNPReference(anp))
+		}
+	}
+	banps, _ := n.banpLister.List(labels.Everything())
+	for _, banp := range banps {
+		if banpHasNamespaceLabelRule(banp) {
+			n.enqueueInternalNetworkPolicy(getBANPReference(banp))
+		}
+	}
+}
+
 // toAntreaServicesForPolicyCRD processes ports field for ANPs/BANPs and returns the translated
 // Antrea Services.
 func toAntreaServicesForPolicyCRD(npPorts []v1alpha1.AdminNetworkPolicyPort) []controlplane.Service {
@@ -239,6 +292,102 @@ func (n *NetworkPolicyController) toAntreaPeerForPolicyCRD(peers []v1alpha1.Admi
 	}, addressGroups
 }
 
+// namespaceLabelsPeer is the Antrea NetworkPolicyPeer computed for a group of subject Namespaces which
+// have the same values for the sameLabels or notSameLabels keys of an AdminNetworkPolicyPeer.
+type namespaceLabelsPeer struct {
+	peer            *controlplane.NetworkPolicyPeer
+	appliedToGroups []*antreatypes.AppliedToGroup
+	addressGroups   []*antreatypes.AddressGroup
+}
+
+// convertNotSameLabelsToSelectors creates the LabelSelectors selecting the Namespaces which have all
+// the label keys, with a different value than the expected one for at least one of them. As label
+// selectors cannot express the disjunction, one LabelSelector is created for each label key.
+func convertNotSameLabelsToSelectors(labelKeys []string, labelValues string) []*metav1.LabelSelector {
+	labelValuesSep := strings.Split(labelValues, labelValueSeparator)
+	selectors := make([]*metav1.LabelSelector, 0, len(labelKeys))
+	for i := range labelKeys {
+		var requirements []metav1.LabelSelectorRequirement
+		for _, key := range labelKeys {
+			requirements = append(requirements, metav1.LabelSelectorRequirement{
+				Key:      key,
+				Operator: metav1.LabelSelectorOpExists,
+			})
+		}
+		requirements = append(requirements, metav1.LabelSelectorRequirement{
+			Key:      labelKeys[i],
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   []string{labelValuesSep[i]},
+		})
+		selectors = append(selectors, &metav1.LabelSelector{MatchExpressions: requirements})
+	}
+	return selectors
+}
+
+// toAntreaPeersForNamespaceLabels processes AdminNetworkPolicyPeers defined by sameLabels or
+// notSameLabels. The affected Namespaces of the subject are grouped by their values for the label
+// keys, and for each group, it yields a peer selecting the Namespaces with the same values (for
+// sameLabels) or with a different value for at least one of the keys (for notSameLabels), along
+// with the AppliedToGroups of the Namespaces in the group. Namespaces of the subject which don't
+// have all the label keys are not affected by the peer.
+func (n *NetworkPolicyController) toAntreaPeersForNamespaceLabels(peers []v1alpha1.AdminNetworkPolicyPeer,
+	atgPerAffectedNS map[string]*antreatypes.AppliedToGroup,
+	labelsPerAffectedNS map[string]labels.Set) []namespaceLabelsPeer {
+	var nsLabelsPeers []namespaceLabelsPeer
+	for _, peer := range peers {
+		labelKeys, notSame := peerNamespaceLabels(peer)
+		if len(labelKeys) == 0 {
+			continue
+		}
+		var podSelector *metav1.LabelSelector
+		if peer.Pods != nil {
+			podSelector = &peer.Pods.PodSelector
+		}
+		nsGroupByLabelVal := groupNamespacesByLabelValue(labelsPerAffectedNS, labelKeys)
+		for _, labelValues := range sets.List(sets.KeySet(nsGroupByLabelVal)) {
+			var addressGroups []*antreatypes.AddressGroup
+			if notSame {
+				for _, nsSelector := range convertNotSameLabelsToSelectors(labelKeys, labelValues) {
+					addressGroups = append(addressGroups, n.createAddressGroup("", podSelector, nsSelector, nil, nil))
+				}
+			} else {
+				nsSelForSameLabels := convertSameLabelsToSelector(labelKeys, labelValues)
+				addressGroups = append(addressGroups, n.createAddressGroup("", podSelector, nsSelForSameLabels, nil, nil))
+			}
+			groupedNamespaces := nsGroupByLabelVal[labelValues]
+			sort.Strings(groupedNamespaces)
+			var atgs []*antreatypes.AppliedToGroup
+			for _, ns := range groupedNamespaces {
+				atgs = append(atgs, atgPerAffectedNS[ns])
+			}
+			nsLabelsPeers = append(nsLabelsPeers, namespaceLabelsPeer{
+				peer:            &controlplane.NetworkPolicyPeer{AddressGroups: getAddressGroupNames(addressGroups)},
+				appliedToGroups: atgs,
+				addressGroups:   addressGroups,
+			})
+		}
+	}
+	return nsLabelsPeers
+}
+
+// getAffectedNamespacesForSubject computes the Namespaces currently affected by the AdminNetworkPolicySubject,
+// and returns an AppliedToGroup for each of these Namespaces, along with their labels.
+func (n *NetworkPolicyController) getAffectedNamespacesForSubject(subject v1alpha1.AdminNetworkPolicySubject) (map[string]*antreatypes.AppliedToGroup, map[string]labels.Set) {
+	var podSelector *metav1.LabelSelector
+	labelsPerAffectedNS := map[string]labels.Set{}
+	if subject.Pods != nil {
+		podSelector = &subject.Pods.PodSelector
+		labelsPerAffectedNS = n.getAffectedNamespacesForAppliedTo(antreacrd.AppliedTo{NamespaceSelector: &subject.Pods.NamespaceSelector})
+	} else if subject.Namespaces != nil {
+		labelsPerAffectedNS = n.getAffectedNamespacesForAppliedTo(antreacrd.AppliedTo{NamespaceSelector: subject.Namespaces})
+	}
+	atgPerAffectedNS := map[string]*antreatypes.AppliedToGroup{}
+	for ns := range labelsPerAffectedNS {
+		atgPerAffectedNS[ns] = n.createAppliedToGroup(ns, podSelector, nil, nil, nil)
+	}
+	return atgPerAffectedNS, labelsPerAffectedNS
+}
+
 // processClusterSubject processes AdminNetworkPolicySubject and yield Antrea AppliedToGroups.
 func (n *NetworkPolicyController) processClusterSubject(subject v1alpha1.AdminNetworkPolicySubject) []*antreatypes.AppliedToGroup {
 	var appliedToGroups []*antreatypes.AppliedToGroup
@@ -269,48 +418,87 @@ func (n *NetworkPolicyController) processAdminNetworkPolicy(anp *v1alpha1.AdminN
 	appliedToGroups := map[string]*antreatypes.AppliedToGroup{}
 	addressGroups := map[string]*antreatypes.AddressGroup{}
 	var rules []controlplane.NetworkPolicyRule
+	// If the policy has rules defined by advanced Namespace selection, it is converted to an appliedTo
+	// per rule policy: rules with cluster scoped peers are applied to the subject, while rules with
+	// per-namespace peers are applied to the subject Namespaces they are computed for.
+	var subjectATGs []*antreatypes.AppliedToGroup
+	var atgPerAffectedNS map[string]*antreatypes.AppliedToGroup
+	var labelsPerAffectedNS map[string]labels.Set
+	if appliedToPerRule {
+		subjectATGs = n.processClusterSubject(anp.Spec.Subject)
+		appliedToGroups = mergeAppliedToGroups(appliedToGroups, subjectATGs...)
+		atgPerAffectedNS, labelsPerAffectedNS = n.getAffectedNamespacesForSubject(anp.Spec.Subject)
+	}
 
 	for idx, anpIngressRule := range anp.Spec.Ingress {
 		var services []controlplane.Service
 		if anpIngressRule.Ports != nil {
 			services = toAntreaServicesForPolicyCRD(*anpIngressRule.Ports)
 		}
-		clusterPeers, _ := splitPolicyPeerByScope(anpIngressRule.From)
+		clusterPeers, perNSLabelPeers := splitPolicyPeerByScope(anpIngressRule.From)
 		if len(clusterPeers) > 0 {
 			peer, ags := n.toAntreaPeerForPolicyCRD(clusterPeers)
 			rule := controlplane.NetworkPolicyRule{
-				Direction: controlplane.DirectionIn,
-				From:      *peer,
-				Services:  services,
-				Name:      anpIngressRule.Name,
-				Action:    anpActionToCRDAction(anpIngressRule.Action),
-				Priority:  int32(idx),
+				Direction:       controlplane.DirectionIn,
+				From:            *peer,
+				Services:        services,
+				Name:            anpIngressRule.Name,
+				Action:          anpActionToCRDAction(anpIngressRule.Action),
+				Priority:        int32(idx),
+				AppliedToGroups: getAppliedToGroupNames(subjectATGs),
 			}
 			rules = append(rules, rule)
 			addressGroups = mergeAddressGroups(addressGroups, ags...)
 		}
-		//TODO: implement SameLabels and NotSameLabels for per NS label ingress peers
+		for _, p := range n.toAntreaPeersForNamespaceLabels(perNSLabelPeers, atgPerAffectedNS, labelsPerAffectedNS) {
+			rule := controlplane.NetworkPolicyRule{
+				Direction:       controlplane.DirectionIn,
+				From:            *p.peer,
+				Services:        services,
+				Name:            anpIngressRule.Name,
+				Action:          anpActionToCRDAction(anpIngressRule.Action),
+				Priority:        int32(idx),
+				AppliedToGroups: getAppliedToGroupNames(p.appliedToGroups),
+			}
+			rules = append(rules, rule)
+			addressGroups = mergeAddressGroups(addressGroups, p.addressGroups...)
+			appliedToGroups = mergeAppliedToGroups(appliedToGroups, p.appliedToGroups...)
+		}
 	}
 	for idx, anpEgressRule := range anp.Spec.Egress {
 		var services []controlplane.Service
 		if anpEgressRule.Ports != nil {
 			services = toAntreaServicesForPolicyCRD(*anpEgressRule.Ports)
 		}
-		clusterPeers, _ := splitPolicyPeerByScope(anpEgressRule.To)
+		clusterPeers, perNSLabelPeers := splitPolicyPeerByScope(anpEgressRule.To)
 		if len(clusterPeers) > 0 {
 			peer, ags := n.toAntreaPeerForPolicyCRD(clusterPeers)
 			rule := controlplane.NetworkPolicyRule{
-				Direction: controlplane.DirectionOut,
-				To:        *peer,
-				Services:  services,
-				Name:      anpEgressRule.Name,
-				Action:    anpActionToCRDAction(anpEgressRule.Action),
-				Priority:  int32(idx),
+				Direction:       controlplane.DirectionOut,
+				To:              *peer,
+				Services:        services,
+				Name:            anpEgressRule.Name,
+				Action:          anpActionToCRDAction(anpEgressRule.Action),
+				Priority:        int32(idx),
+				AppliedToGroups: getAppliedToGroupNames(subjectATGs),
 			}
 			rules = append(rules, rule)
 			addressGroups = mergeAddressGroups(addressGroups, ags...)
 		}
-		//TODO: implement SameLabels and NotSameLabels for per NS label egress peers
+		for _, p := range n.toAntreaPeersForNamespaceLabels(perNSLabelPeers, atgPerAffectedNS, labelsPerAffectedNS) {
+			rule := controlplane.NetworkPolicyRule{
+				Direction:       controlplane.DirectionOut,
+				To:              *p.peer,
+				Services:        services,
+				Name:            anpEgressRule.Name,
+				Action:          anpActionToCRDAction(anpEgressRule.Action),
+				Priority:        int32(idx),
+				AppliedToGroups: getAppliedToGroupNames(p.appliedToGroups),
+			}
+			rules = append(rules, rule)
+			addressGroups = mergeAddressGroups(addressGroups, p.addressGroups...)
+			appliedToGroups = mergeAppliedToGroups(appliedToGroups, p.appliedToGroups...)
+		}
 	}
 	priority := float64(anp.Spec.Priority)
 	if !appliedToPerRule {
@@ -339,48 +527,87 @@ func (n *NetworkPolicyController) processBaselineAdminNetworkPolicy(banp *v1alph
 	appliedToGroups := map[string]*antreatypes.AppliedToGroup{}
 	addressGroups := map[string]*antreatypes.AddressGroup{}
 	var rules []controlplane.NetworkPolicyRule
+	// If the policy has rules defined by advanced Namespace selection, it is converted to an appliedTo
+	// per rule policy: rules with cluster scoped peers are applied to the subject, while rules with
+	// per-namespace peers are applied to the subject Namespaces they are computed for.
+	var subjectATGs []*antreatypes.AppliedToGroup
+	var atgPerAffectedNS map[string]*antreatypes.AppliedToGroup
+	var labelsPerAffectedNS map[string]labels.Set
+	if appliedToPerRule {
+		subjectATGs = n.processClusterSubject(banp.Spec.Subject)
+		appliedToGroups = mergeAppliedToGroups(appliedToGroups, subjectATGs...)
+		atgPerAffectedNS, labelsPerAffectedNS = n.getAffectedNamespacesForSubject(banp.Spec.Subject)
+	}
 
 	for idx, banpIngressRule := range banp.Spec.Ingress {
 		var services []controlplane.Service
 		if banpIngressRule.Ports != nil {
 			services = toAntreaServicesForPolicyCRD(*banpIngressRule.Ports)
 		}
-		clusterPeers, _ := splitPolicyPeerByScope(banpIngressRule.From)
+		clusterPeers, perNSLabelPeers := splitPolicyPeerByScope(banpIngressRule.From)
 		if len(clusterPeers) > 0 {
 			peer, ags := n.toAntreaPeerForPolicyCRD(clusterPeers)
 			rule := controlplane.NetworkPolicyRule{
-				Direction: controlplane.DirectionIn,
-				From:      *peer,
-				Services:  services,
-				Name:      banpIngressRule.Name,
-				Action:    banpActionToCRDAction(banpIngressRule.Action),
-				Priority:  int32(idx),
+				Direction:       controlplane.DirectionIn,
+				From:            *peer,
+				Services:        services,
+				Name:            banpIngressRule.Name,
+				Action:          banpActionToCRDAction(banpIngressRule.Action),
+				Priority:        int32(idx),
+				AppliedToGroups: getAppliedToGroupNames(subjectATGs),
 			}
 			rules = append(rules, rule)
 			addressGroups = mergeAddressGroups(addressGroups, ags...)
 		}
-		//TODO: implement SameLabels and NotSameLabels for per NS label ingress peers
+		for _, p := range n.toAntreaPeersForNamespaceLabels(perNSLabelPeers, atgPerAffectedNS, labelsPerAffectedNS) {
+			rule := controlplane.NetworkPolicyRule{
+				Direction:       controlplane.DirectionIn,
+				From:            *p.peer,
+				Services:        services,
+				Name:            banpIngressRule.Name,
+				Action:          banpActionToCRDAction(banpIngressRule.Action),
+				Priority:        int32(idx),
+				AppliedToGroups: getAppliedToGroupNames(p.appliedToGroups),
+			}
+			rules = append(rules, rule)
+			addressGroups = mergeAddressGroups(addressGroups, p.addressGroups...)
+			appliedToGroups = mergeAppliedToGroups(appliedToGroups, p.appliedToGroups...)
+		}
 	}
 	for idx, banpEgressRule := range banp.Spec.Egress {
 		var services []controlplane.Service
 		if banpEgressRule.Ports != nil {
 			services = toAntreaServicesForPolicyCRD(*banpEgressRule.Ports)
 		}
-		clusterPeers, _ := splitPolicyPeerByScope(banpEgressRule.To)
+		clusterPeers, perNSLabelPeers := splitPolicyPeerByScope(banpEgressRule.To)
 		if len(clusterPeers) > 0 {
 			peer, ags := n.toAntreaPeerForPolicyCRD(clusterPeers)
 			rule := controlplane.NetworkPolicyRule{
-				Direction: controlplane.DirectionOut,
-				To:        *peer,
-				Services:  services,
-				Name:      banpEgressRule.Name,
-				Action:    banpActionToCRDAction(banpEgressRule.Action),
-				Priority:  int32(idx),
+				Direction:       controlplane.DirectionOut,
+				To:              *peer,
+				Services:        services,
+				Name:            banpEgressRule.Name,
+				Action:          banpActionToCRDAction(banpEgressRule.Action),
+				Priority:        int32(idx),
+				AppliedToGroups: getAppliedToGroupNames(subjectATGs),
 			}
 			rules = append(rules, rule)
 			addressGroups = mergeAddressGroups(addressGroups, ags...)
 		}
-		//TODO: implement SameLabels and NotSameLabels for per NS label egress peers
+		for _, p := range n.toAntreaPeersForNamespaceLabels(perNSLabelPeers, atgPerAffectedNS, labelsPerAffectedNS) {
+			rule := controlplane.NetworkPolicyRule{
+				Direction:       controlplane.DirectionOut,
+				To:              *p.peer,
+				Services:        services,
+				Name:            banpEgressRule.Name,
+				Action:          banpActionToCRDAction(banpEgressRule.Action),
+				Priority:        int32(idx),
+				AppliedToGroups: getAppliedToGroupNames(p.appliedToGroups),
+			}
+			rules = append(rules, rule)
+			addressGroups = mergeAddressGroups(addressGroups, p.addressGroups...)
+			appliedToGroups = mergeAppliedToGroups(appliedToGroups, p.appliedToGroups...)
+		}
 	}
 	if !appliedToPerRule {
 		appliedToGroups = mergeAppliedToGroups(appliedToGroups, n.processClusterSubject(banp.Spec.Subject)...)
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
@@ -372,7 +373,6 @@ func TestProcessAdminNetworkPolicy(t *testing.T) {
 			expectedAddressGroups:   1,
 		},
 		{
-			// TODO: when sameLabels and notSameLabels is supported, this test need to be modified
 			name: "with-same-label-namespaces-selection",
 			inputPolicy: &v1alpha1.AdminNetworkPolicy{
 				ObjectMeta: metav1.ObjectMeta{Name: "anpE", UID: "uidE"},
@@ -393,6 +393,18 @@ func TestProcessAdminNetworkPolicy(t *testing.T) {
 							},
 						},
 					},
+					Egress: []v1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Action: v1alpha1.AdminNetworkPolicyRuleActionDeny,
+							To: []v1alpha1.AdminNetworkPolicyPeer{
+								{
+									Namespaces: &v1alpha1.NamespacedPeer{
+										NamespaceSelector: &selectorC,
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 			expectedPolicy: &antreatypes.NetworkPolicy{
@@ -403,20 +415,142 @@ func TestProcessAdminNetworkPolicy(t *testing.T) {
 					Name: "anpE",
 					UID:  "uidE",
 				},
-				Priority:         &p10,
-				TierPriority:     &adminNetworkPolicyTierPriority,
-				Rules:            []controlplane.NetworkPolicyRule{},
-				AppliedToGroups:  []string{},
+				Priority:     &p10,
+				TierPriority: &adminNetworkPolicyTierPriority,
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "test"}}, nil, nil).NormalizedName)},
+						},
+						Priority: 0,
+						Action:   &allowAction,
+						AppliedToGroups: []string{
+							getNormalizedUID(antreatypes.NewGroupSelector("nsA", nil, nil, nil, nil).NormalizedName),
+							getNormalizedUID(antreatypes.NewGroupSelector("nsB", nil, nil, nil, nil).NormalizedName),
+						},
+					},
+					{
+						Direction: controlplane.DirectionIn,
+						From: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "prod"}}, nil, nil).NormalizedName)},
+						},
+						Priority:        0,
+						Action:          &allowAction,
+						AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("nsC", nil, nil, nil, nil).NormalizedName)},
+					},
+					{
+						Direction: controlplane.DirectionOut,
+						To: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorC, nil, nil).NormalizedName)},
+						},
+						Priority:        0,
+						Action:          &dropAction,
+						AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorA, nil, nil).NormalizedName)},
+					},
+				},
+				AppliedToGroups: []string{
+					getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorA, nil, nil).NormalizedName),
+					getNormalizedUID(antreatypes.NewGroupSelector("nsA", nil, nil, nil, nil).NormalizedName),
+					getNormalizedUID(antreatypes.NewGroupSelector("nsB", nil, nil, nil, nil).NormalizedName),
+					getNormalizedUID(antreatypes.NewGroupSelector("nsC", nil, nil, nil, nil).NormalizedName),
+				},
 				AppliedToPerRule: true,
 			},
-			expectedAppliedToGroups: 0,
-			expectedAddressGroups:   0,
+			expectedAppliedToGroups: 4,
+			expectedAddressGroups:   3,
+		},
+		{
+			name: "with-not-same-label-namespaces-selection",
+			inputPolicy: &v1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anpF", UID: "uidF"},
+				Spec: v1alpha1.AdminNetworkPolicySpec{
+					Subject: v1alpha1.AdminNetworkPolicySubject{
+						Namespaces: &selectorA,
+					},
+					Priority: 10,
+					Egress: []v1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Action: v1alpha1.AdminNetworkPolicyRuleActionDeny,
+							To: []v1alpha1.AdminNetworkPolicyPeer{
+								{
+									Pods: &v1alpha1.NamespacedPodPeer{
+										Namespaces: v1alpha1.NamespacedPeer{
+											NotSameLabels: []string{"foo1", "purpose"},
+										},
+										PodSelector: selectorB,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidF",
+				Name: "uidF",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type: controlplane.AdminNetworkPolicy,
+					Name: "anpF",
+					UID:  "uidF",
+				},
+				Priority:     &p10,
+				TierPriority: &adminNetworkPolicyTierPriority,
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionOut,
+						To: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{
+								getNormalizedUID(antreatypes.NewGroupSelector("", &selectorB, notSameLabelsSelector("foo1", "bar1"), nil, nil).NormalizedName),
+								getNormalizedUID(antreatypes.NewGroupSelector("", &selectorB, notSameLabelsSelector("purpose", "prod"), nil, nil).NormalizedName),
+							},
+						},
+						Priority:        0,
+						Action:          &dropAction,
+						AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("nsC", nil, nil, nil, nil).NormalizedName)},
+					},
+					{
+						Direction: controlplane.DirectionOut,
+						To: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{
+								getNormalizedUID(antreatypes.NewGroupSelector("", &selectorB, notSameLabelsSelector("foo1", "bar1"), nil, nil).NormalizedName),
+								getNormalizedUID(antreatypes.NewGroupSelector("", &selectorB, notSameLabelsSelector("purpose", "test"), nil, nil).NormalizedName),
+							},
+						},
+						Priority: 0,
+						Action:   &dropAction,
+						AppliedToGroups: []string{
+							getNormalizedUID(antreatypes.NewGroupSelector("nsA", nil, nil, nil, nil).NormalizedName),
+							getNormalizedUID(antreatypes.NewGroupSelector("nsB", nil, nil, nil, nil).NormalizedName),
+						},
+					},
+				},
+				AppliedToGroups: []string{
+					getNormalizedUID(antreatypes.NewGroupSelector("", nil, &selectorA, nil, nil).NormalizedName),
+					getNormalizedUID(antreatypes.NewGroupSelector("nsA", nil, nil, nil, nil).NormalizedName),
+					getNormalizedUID(antreatypes.NewGroupSelector("nsB", nil, nil, nil, nil).NormalizedName),
+					getNormalizedUID(antreatypes.NewGroupSelector("nsC", nil, nil, nil, nil).NormalizedName),
+				},
+				AppliedToPerRule: true,
+			},
+			expectedAppliedToGroups: 4,
+			expectedAddressGroups:   3,
 		},
 	}
+	// Namespaces selected by the subject of the policies with sameLabels and notSameLabels rules.
+	namespaces := []*v1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "nsA", Labels: map[string]string{"foo1": "bar1", "purpose": "test"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "nsB", Labels: map[string]string{"foo1": "bar1", "purpose": "test"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "nsC", Labels: map[string]string{"foo1": "bar1", "purpose": "prod"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "nsD", Labels: map[string]string{"foo2": "bar2", "purpose": "test"}}},
+	}
 	featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.AdminNetworkPolicy, true)
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			_, c := newController(nil, nil)
+			for _, ns := range namespaces {
+				c.namespaceStore.Add(ns)
+			}
 			actualPolicy, actualAppliedToGroups, actualAddressGroups := c.processAdminNetworkPolicy(tt.inputPolicy)
 			assert.Equal(t, tt.expectedPolicy.UID, actualPolicy.UID)
 			assert.Equal(t, tt.expectedPolicy.Name, actualPolicy.Name)
@@ -432,6 +566,18 @@ func TestProcessAdminNetworkPolicy(t *testing.T) {
 	}
 }
 
+// notSameLabelsSelector returns the Namespace selector computed for the "foo1" and "purpose" notSameLabels
+// keys, which selects the Namespaces with a different value for the given key.
+func notSameLabelsSelector(key, value string) *metav1.LabelSelector {
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: "foo1", Operator: metav1.LabelSelectorOpExists},
+			{Key: "purpose", Operator: metav1.LabelSelectorOpExists},
+			{Key: key, Operator: metav1.LabelSelectorOpNotIn, Values: []string{value}},
+		},
+	}
+}
+
 func TestProcessBaselineAdminNetworkPolicy(t *testing.T) {
 	tests := []struct {
 		name                    string
@@ -0,0 +1,314 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+const (
+	// maxBreakGlassDuration is the maximum duration for which the rules of a ClusterNetworkPolicy can be bypassed.
+	maxBreakGlassDuration = 24 * time.Hour
+	// breakGlassVerb is the verb a user must be authorized for on a ClusterNetworkPolicy to bypass its rules.
+	breakGlassVerb = "break-glass"
+)
+
+// breakGlass is the set of rules of a ClusterNetworkPolicy which are bypassed until the expiry time, configured with
+// annotations.
+type breakGlass struct {
+	ruleNames sets.Set[string]
+	expiry    time.Time
+}
+
+// getBreakGlass parses the break-glass annotations of a ClusterNetworkPolicy. It returns nil if no rule is bypassed.
+func getBreakGlass(annotations map[string]string) (*breakGlass, error) {
+	rules, hasRules := annotations[apis.BreakGlassRulesAnnotationKey]
+	expiry, hasExpiry := annotations[apis.BreakGlassExpiryAnnotationKey]
+	if !hasRules && !hasExpiry {
+		return nil, nil
+	}
+	if !hasRules || !hasExpiry {
+		return nil, fmt.Errorf("annotations %s and %s must be set together", apis.BreakGlassRulesAnnotationKey, apis.BreakGlassExpiryAnnotationKey)
+	}
+	ruleNames := sets.New[string]()
+	for _, name := range strings.Split(rules, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			ruleNames.Insert(name)
+		}
+	}
+	if ruleNames.Len() == 0 {
+		return nil, fmt.Errorf("invalid value for annotation %s: at least one rule name must be provided", apis.BreakGlassRulesAnnotationKey)
+	}
+	expiryTime, err := time.Parse(time.RFC3339, expiry)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for annotation %s: %w", apis.BreakGlassExpiryAnnotationKey, err)
+	}
+	return &breakGlass{ruleNames: ruleNames, expiry: expiryTime}, nil
+}
+
+func isDenyAction(action *crdv1beta1.RuleAction) bool {
+	return action != nil && (*action == crdv1beta1.RuleActionDrop || *action == crdv1beta1.RuleActionReject)
+}
+
+// applyBreakGlass removes the Drop and Reject rules bypassed by the break-glass annotations of the ClusterNetworkPolicy
+// from the new internal NetworkPolicy, as well as the groups which are no longer used by it. It returns the duration
+// after which the internal NetworkPolicy must be synced again to enforce the rules again, or 0 if no rule is bypassed.
+// An audit event is recorded for the ClusterNetworkPolicy when rules start to be bypassed and when they are enforced
+// again.
+func (n *NetworkPolicyController) applyBreakGlass(
+	acnp *crdv1beta1.ClusterNetworkPolicy,
+	newNP *antreatypes.NetworkPolicy,
+	appliedToGroups map[string]*antreatypes.AppliedToGroup,
+	addressGroups map[string]*antreatypes.AddressGroup,
+) time.Duration {
+	bg, err := getBreakGlass(acnp.Annotations)
+	if err != nil {
+		klog.ErrorS(err, "Ignoring break-glass annotations of ClusterNetworkPolicy", "name", acnp.Name)
+	}
+	if bg == nil {
+		n.deleteBreakGlassExpiry(acnp, newNP.Name, false)
+		return 0
+	}
+	now := n.clock.Now()
+	if !now.Before(bg.expiry) {
+		n.deleteBreakGlassExpiry(acnp, newNP.Name, true)
+		return 0
+	}
+
+	rules := make([]controlplane.NetworkPolicyRule, 0, len(newNP.Rules))
+	bypassedRules := sets.New[string]()
+	for _, rule := range newNP.Rules {
+		if bg.ruleNames.Has(rule.Name) && isDenyAction(rule.Action) {
+			bypassedRules.Insert(rule.Name)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	newNP.Rules = rules
+	if newNP.AppliedToPerRule {
+		ruleAppliedToGroups := sets.New[string]()
+		for _, rule := range rules {
+			ruleAppliedToGroups.Insert(rule.AppliedToGroups...)
+		}
+		newNP.AppliedToGroups = sets.List(ruleAppliedToGroups)
+	}
+	pruneUnusedGroups(newNP, appliedToGroups, addressGroups)
+
+	if n.recordBreakGlassActivation(acnp, newNP.Name, bg) {
+		klog.InfoS("Bypassing rules of ClusterNetworkPolicy", "name", acnp.Name, "rules", sets.List(bypassedRules), "expiry", bg.expiry)
+		n.eventRecorder.Eventf(getACNPObjectReference(acnp), corev1.EventTypeWarning, "BreakGlassActivated",
+			"Rules %v are bypassed until %s", sets.List(bypassedRules), bg.expiry.Format(time.RFC3339))
+		// The activation is persisted in the ClusterNetworkPolicy, so that it is not recorded again after a restart.
+		n.patchBreakGlassActivated(acnp, acnp.Annotations[apis.BreakGlassExpiryAnnotationKey])
+	}
+	return bg.expiry.Sub(now)
+}
+
+// recordBreakGlassActivation remembers the expiry of the break-glass of an internal NetworkPolicy, and returns whether
+// its activation must be recorded, i.e. whether it has not been recorded yet by this process or, according to the
+// BreakGlassActivatedAnnotationKey annotation, by a previous one.
+func (n *NetworkPolicyController) recordBreakGlassActivation(acnp *crdv1beta1.ClusterNetworkPolicy, name string, bg *breakGlass) bool {
+	n.breakGlassExpiriesLock.Lock()
+	defer n.breakGlassExpiriesLock.Unlock()
+	if expiry, exists := n.breakGlassExpiries[name]; exists && expiry.Equal(bg.expiry) {
+		return false
+	}
+	n.breakGlassExpiries[name] = bg.expiry
+	return acnp.Annotations[apis.BreakGlassActivatedAnnotationKey] != acnp.Annotations[apis.BreakGlassExpiryAnnotationKey]
+}
+
+// deleteBreakGlassExpiry forgets the break-glass of an internal NetworkPolicy, recording an audit event for the
+// ClusterNetworkPolicy if its rules were bypassed. The end of the break-glass is also recorded if it was activated
+// before a restart, according to the BreakGlassActivatedAnnotationKey annotation.
+func (n *NetworkPolicyController) deleteBreakGlassExpiry(acnp *crdv1beta1.ClusterNetworkPolicy, name string, expired bool) {
+	if acnp == nil {
+		n.breakGlassExpiriesLock.Lock()
+		defer n.breakGlassExpiriesLock.Unlock()
+		delete(n.breakGlassExpiries, name)
+		return
+	}
+	_, activated := acnp.Annotations[apis.BreakGlassActivatedAnnotationKey]
+	if !n.recordBreakGlassEnd(name, activated) {
+		return
+	}
+	reason := "BreakGlassRevoked"
+	if expired {
+		reason = "BreakGlassExpired"
+	}
+	klog.InfoS("Enforcing bypassed rules of ClusterNetworkPolicy again", "name", acnp.Name, "reason", reason)
+	n.eventRecorder.Event(getACNPObjectReference(acnp), corev1.EventTypeNormal, reason, "All rules are enforced again")
+	if activated {
+		n.patchBreakGlassActivated(acnp, nil)
+	}
+}
+
+// recordBreakGlassEnd returns whether the end of the break-glass of an internal NetworkPolicy must be recorded, i.e.
+// whether its activation has been recorded by this process or, according to the BreakGlassActivatedAnnotationKey
+// annotation, by a previous one, and its end has not been recorded yet. A zero expiry is kept for the internal
+// NetworkPolicy once the end has been recorded, as the annotation may only be removed later.
+func (n *NetworkPolicyController) recordBreakGlassEnd(name string, activated bool) bool {
+	n.breakGlassExpiriesLock.Lock()
+	defer n.breakGlassExpiriesLock.Unlock()
+	expiry, exists := n.breakGlassExpiries[name]
+	if !exists && !activated {
+		return false
+	}
+	n.breakGlassExpiries[name] = time.Time{}
+	return !exists || !expiry.IsZero()
+}
+
+// patchBreakGlassActivated sets the BreakGlassActivatedAnnotationKey annotation of the ClusterNetworkPolicy to the
+// provided value, or removes it if the value is nil.
+func (n *NetworkPolicyController) patchBreakGlassActivated(acnp *crdv1beta1.ClusterNetworkPolicy, value interface{}) {
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				apis.BreakGlassActivatedAnnotationKey: value,
+			},
+		},
+	}
+	patchBytes, _ := json.Marshal(patch)
+	if _, err := n.crdClient.CrdV1beta1().ClusterNetworkPolicies().Patch(context.TODO(), acnp.Name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		klog.ErrorS(err, "Failed to update break-glass activation of ClusterNetworkPolicy", "name", acnp.Name)
+	}
+}
+
+func getACNPObjectReference(acnp *crdv1beta1.ClusterNetworkPolicy) *corev1.ObjectReference {
+	return &corev1.ObjectReference{
+		APIVersion: crdv1beta1.SchemeGroupVersion.String(),
+		Kind:       "ClusterNetworkPolicy",
+		Name:       acnp.Name,
+		UID:        acnp.UID,
+	}
+}
+
+// validateBreakGlass validates the break-glass annotations of ClusterNetworkPolicies. When they are added or updated,
+// the bypassed rules must be Drop or Reject rules of the policy, and the expiry must be within maxBreakGlassDuration.
+// The user must be authorized for the "break-glass" verb on the ClusterNetworkPolicy whenever the rules which are
+// bypassed change, i.e. when the annotations are added or updated, or when the spec adds or updates a rule whose name
+// is bypassed.
+func (v *antreaPolicyValidator) validateBreakGlass(curObj, oldObj interface{}, userInfo authenticationv1.UserInfo) (string, bool) {
+	acnp, ok := curObj.(*crdv1beta1.ClusterNetworkPolicy)
+	if !ok {
+		return "", true
+	}
+	bg, err := getBreakGlass(acnp.Annotations)
+	if err != nil {
+		return err.Error(), false
+	}
+	if bg == nil {
+		return "", true
+	}
+	oldACNP, _ := oldObj.(*crdv1beta1.ClusterNetworkPolicy)
+	annotationsChanged := oldACNP == nil ||
+		oldACNP.Annotations[apis.BreakGlassRulesAnnotationKey] != acnp.Annotations[apis.BreakGlassRulesAnnotationKey] ||
+		oldACNP.Annotations[apis.BreakGlassExpiryAnnotationKey] != acnp.Annotations[apis.BreakGlassExpiryAnnotationKey]
+	now := v.networkPolicyController.clock.Now()
+	if annotationsChanged {
+		if !bg.expiry.After(now) || bg.expiry.Sub(now) > maxBreakGlassDuration {
+			return fmt.Sprintf("annotation %s must be a time in the next %v", apis.BreakGlassExpiryAnnotationKey, maxBreakGlassDuration), false
+		}
+		denyRules := sets.New[string]()
+		for _, rule := range append(acnp.Spec.Ingress, acnp.Spec.Egress...) {
+			if isDenyAction(rule.Action) {
+				denyRules.Insert(rule.Name)
+			}
+		}
+		if unknownRules := bg.ruleNames.Difference(denyRules); unknownRules.Len() > 0 {
+			return fmt.Sprintf("rules %v are not Drop or Reject rules of the policy", sets.List(unknownRules)), false
+		}
+	} else {
+		// The rules are no longer bypassed once the break-glass has expired.
+		if !bg.expiry.After(now) {
+			return "", true
+		}
+		// Authorization is not required again if the bypassed rules are unchanged.
+		oldBypassedRules := getBypassedRules(oldACNP, bg)
+		bypassedRulesChanged := false
+		for name, rule := range getBypassedRules(acnp, bg) {
+			if oldRule, exists := oldBypassedRules[name]; !exists || !reflect.DeepEqual(oldRule, rule) {
+				bypassedRulesChanged = true
+				break
+			}
+		}
+		if !bypassedRulesChanged {
+			return "", true
+		}
+	}
+	allowed, err := v.isAuthorizedToBreakGlass(acnp, userInfo)
+	if err != nil {
+		return fmt.Sprintf("failed to check authorization for annotation %s: %v", apis.BreakGlassRulesAnnotationKey, err), false
+	}
+	if !allowed {
+		return fmt.Sprintf("user %s is not authorized to %s ClusterNetworkPolicy %s", userInfo.Username, breakGlassVerb, acnp.Name), false
+	}
+	klog.InfoS("Authorized break-glass for ClusterNetworkPolicy", "name", acnp.Name, "user", userInfo.Username, "rules", sets.List(bg.ruleNames), "expiry", bg.expiry)
+	return "", true
+}
+
+// getBypassedRules returns the Drop and Reject rules of the ClusterNetworkPolicy bypassed by the break-glass, by name.
+func getBypassedRules(acnp *crdv1beta1.ClusterNetworkPolicy, bg *breakGlass) map[string]crdv1beta1.Rule {
+	rules := map[string]crdv1beta1.Rule{}
+	for _, rule := range append(acnp.Spec.Ingress, acnp.Spec.Egress...) {
+		if bg.ruleNames.Has(rule.Name) && isDenyAction(rule.Action) {
+			rules[rule.Name] = rule
+		}
+	}
+	return rules
+}
+
+func (v *antreaPolicyValidator) isAuthorizedToBreakGlass(acnp *crdv1beta1.ClusterNetworkPolicy, userInfo authenticationv1.UserInfo) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(userInfo.Extra))
+	for k, value := range userInfo.Extra {
+		extra[k] = authorizationv1.ExtraValue(value)
+	}
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   userInfo.Username,
+			Groups: userInfo.Groups,
+			UID:    userInfo.UID,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Verb:     breakGlassVerb,
+				Group:    crdv1beta1.SchemeGroupVersion.Group,
+				Resource: "clusternetworkpolicies",
+				Name:     acnp.Name,
+			},
+		},
+	}
+	resp, err := v.networkPolicyController.kubeClient.AuthorizationV1().SubjectAccessReviews().Create(context.TODO(), sar, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+	return resp.Status.Allowed, nil
+}
@@ -0,0 +1,329 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func TestGetBreakGlass(t *testing.T) {
+	expiry := "2025-01-01T10:00:00Z"
+	expiryTime, _ := time.Parse(time.RFC3339, expiry)
+	tests := []struct {
+		name               string
+		annotations        map[string]string
+		expectedBreakGlass *breakGlass
+		expectedErr        string
+	}{
+		{
+			name:        "no annotation",
+			annotations: map[string]string{"foo": "bar"},
+		},
+		{
+			name: "valid annotations",
+			annotations: map[string]string{
+				apis.BreakGlassRulesAnnotationKey:  "rule1, rule2,",
+				apis.BreakGlassExpiryAnnotationKey: expiry,
+			},
+			expectedBreakGlass: &breakGlass{ruleNames: sets.New[string]("rule1", "rule2"), expiry: expiryTime},
+		},
+		{
+			name:        "missing expiry",
+			annotations: map[string]string{apis.BreakGlassRulesAnnotationKey: "rule1"},
+			expectedErr: "must be set together",
+		},
+		{
+			name:        "missing rules",
+			annotations: map[string]string{apis.BreakGlassExpiryAnnotationKey: expiry},
+			expectedErr: "must be set together",
+		},
+		{
+			name: "empty rules",
+			annotations: map[string]string{
+				apis.BreakGlassRulesAnnotationKey:  " , ",
+				apis.BreakGlassExpiryAnnotationKey: expiry,
+			},
+			expectedErr: "at least one rule name must be provided",
+		},
+		{
+			name: "invalid expiry",
+			annotations: map[string]string{
+				apis.BreakGlassRulesAnnotationKey:  "rule1",
+				apis.BreakGlassExpiryAnnotationKey: "1h",
+			},
+			expectedErr: "invalid value for annotation networkpolicy.antrea.io/break-glass-expiry",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bg, err := getBreakGlass(tt.annotations)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedBreakGlass, bg)
+		})
+	}
+}
+
+func TestApplyBreakGlass(t *testing.T) {
+	_, c := newController(nil, nil)
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	c.clock = fakeClock
+	fakeRecorder := record.NewFakeRecorder(10)
+	c.eventRecorder = fakeRecorder
+
+	dropAction := crdv1beta1.RuleActionDrop
+	allowAction := crdv1beta1.RuleActionAllow
+	newInternalNP := func() (*antreatypes.NetworkPolicy, map[string]*antreatypes.AppliedToGroup, map[string]*antreatypes.AddressGroup) {
+		np := &antreatypes.NetworkPolicy{
+			Name: "uid1",
+			Rules: []controlplane.NetworkPolicyRule{
+				{Name: "deny", Direction: controlplane.DirectionOut, To: controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag1"}}, AppliedToGroups: []string{"atg1"}, Action: &dropAction},
+				{Name: "allow", Direction: controlplane.DirectionOut, To: controlplane.NetworkPolicyPeer{AddressGroups: []string{"ag2"}}, AppliedToGroups: []string{"atg2"}, Action: &allowAction},
+			},
+			AppliedToGroups:  []string{"atg1", "atg2"},
+			AppliedToPerRule: true,
+		}
+		appliedToGroups := map[string]*antreatypes.AppliedToGroup{"atg1": {Name: "atg1"}, "atg2": {Name: "atg2"}}
+		addressGroups := map[string]*antreatypes.AddressGroup{"ag1": {Name: "ag1"}, "ag2": {Name: "ag2"}}
+		return np, appliedToGroups, addressGroups
+	}
+	acnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "acnp1",
+			UID:  "uid1",
+			Annotations: map[string]string{
+				// The Allow rule cannot be bypassed.
+				apis.BreakGlassRulesAnnotationKey:  "deny,allow",
+				apis.BreakGlassExpiryAnnotationKey: fakeClock.Now().Add(time.Hour).Format(time.RFC3339),
+			},
+		},
+	}
+	expiry, _ := time.Parse(time.RFC3339, acnp.Annotations[apis.BreakGlassExpiryAnnotationKey])
+	_, err := c.crdClient.CrdV1beta1().ClusterNetworkPolicies().Create(context.TODO(), acnp, metav1.CreateOptions{})
+	require.NoError(t, err)
+	getACNP := func() *crdv1beta1.ClusterNetworkPolicy {
+		acnp, err := c.crdClient.CrdV1beta1().ClusterNetworkPolicies().Get(context.TODO(), "acnp1", metav1.GetOptions{})
+		require.NoError(t, err)
+		return acnp
+	}
+
+	// The Drop rule and the groups only used by it are removed.
+	np, appliedToGroups, addressGroups := newInternalNP()
+	assert.Equal(t, expiry.Sub(fakeClock.Now()), c.applyBreakGlass(acnp, np, appliedToGroups, addressGroups))
+	require.Len(t, np.Rules, 1)
+	assert.Equal(t, "allow", np.Rules[0].Name)
+	assert.Equal(t, []string{"atg2"}, np.AppliedToGroups)
+	assert.Equal(t, sets.New[string]("atg2"), sets.KeySet(appliedToGroups))
+	assert.Equal(t, sets.New[string]("ag2"), sets.KeySet(addressGroups))
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, "BreakGlassActivated")
+	// The activation is persisted in the ClusterNetworkPolicy.
+	activatedACNP := getACNP()
+	assert.Equal(t, acnp.Annotations[apis.BreakGlassExpiryAnnotationKey], activatedACNP.Annotations[apis.BreakGlassActivatedAnnotationKey])
+
+	// The activation is only recorded once.
+	fakeClock.Step(time.Minute)
+	np, appliedToGroups, addressGroups = newInternalNP()
+	assert.Equal(t, expiry.Sub(fakeClock.Now()), c.applyBreakGlass(acnp, np, appliedToGroups, addressGroups))
+	assert.Len(t, np.Rules, 1)
+	assert.Empty(t, fakeRecorder.Events)
+
+	// The activation is not recorded again after a restart.
+	c.breakGlassExpiries = map[string]time.Time{}
+	np, appliedToGroups, addressGroups = newInternalNP()
+	assert.Equal(t, expiry.Sub(fakeClock.Now()), c.applyBreakGlass(activatedACNP, np, appliedToGroups, addressGroups))
+	assert.Len(t, np.Rules, 1)
+	assert.Empty(t, fakeRecorder.Events)
+
+	// The rules are enforced again after the expiry, and the activation is removed from the ClusterNetworkPolicy.
+	fakeClock.Step(time.Hour)
+	np, appliedToGroups, addressGroups = newInternalNP()
+	assert.Equal(t, time.Duration(0), c.applyBreakGlass(activatedACNP, np, appliedToGroups, addressGroups))
+	assert.Len(t, np.Rules, 2)
+	assert.Len(t, appliedToGroups, 2)
+	assert.Len(t, addressGroups, 2)
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, "BreakGlassExpired")
+	assert.NotContains(t, getACNP().Annotations, apis.BreakGlassActivatedAnnotationKey)
+
+	// The expiry is only recorded once, even if the removal of the activation has not been observed yet.
+	np, appliedToGroups, addressGroups = newInternalNP()
+	assert.Equal(t, time.Duration(0), c.applyBreakGlass(activatedACNP, np, appliedToGroups, addressGroups))
+	assert.Empty(t, fakeRecorder.Events)
+	np, appliedToGroups, addressGroups = newInternalNP()
+	assert.Equal(t, time.Duration(0), c.applyBreakGlass(getACNP(), np, appliedToGroups, addressGroups))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// The expiry is recorded after a restart if the activation was not removed.
+	c.breakGlassExpiries = map[string]time.Time{}
+	np, appliedToGroups, addressGroups = newInternalNP()
+	assert.Equal(t, time.Duration(0), c.applyBreakGlass(activatedACNP, np, appliedToGroups, addressGroups))
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Contains(t, <-fakeRecorder.Events, "BreakGlassExpired")
+}
+
+func TestValidateBreakGlass(t *testing.T) {
+	client, c := newController(nil, nil)
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	c.clock = fakeClock
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		sar := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		sar.Status.Allowed = sar.Spec.User == "admin" && sar.Spec.ResourceAttributes.Verb == breakGlassVerb
+		return true, sar, nil
+	})
+	v := &antreaPolicyValidator{networkPolicyController: c.NetworkPolicyController}
+
+	dropAction := crdv1beta1.RuleActionDrop
+	rejectAction := crdv1beta1.RuleActionReject
+	allowAction := crdv1beta1.RuleActionAllow
+	newACNP := func(annotations map[string]string) *crdv1beta1.ClusterNetworkPolicy {
+		return &crdv1beta1.ClusterNetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "acnp1", Annotations: annotations},
+			Spec: crdv1beta1.ClusterNetworkPolicySpec{
+				Ingress: []crdv1beta1.Rule{{Name: "deny", Action: &dropAction}},
+				Egress:  []crdv1beta1.Rule{{Name: "allow", Action: &allowAction}},
+			},
+		}
+	}
+	newACNPWithRules := func(annotations map[string]string, rules ...crdv1beta1.Rule) *crdv1beta1.ClusterNetworkPolicy {
+		acnp := newACNP(annotations)
+		for _, rule := range rules {
+			if rule.Name == "deny" {
+				acnp.Spec.Ingress = nil
+			}
+			acnp.Spec.Ingress = append(acnp.Spec.Ingress, rule)
+		}
+		return acnp
+	}
+	newAnnotations := func(rules string, expiry time.Duration) map[string]string {
+		return map[string]string{
+			apis.BreakGlassRulesAnnotationKey:  rules,
+			apis.BreakGlassExpiryAnnotationKey: fakeClock.Now().Add(expiry).Format(time.RFC3339),
+		}
+	}
+	admin := authenticationv1.UserInfo{Username: "admin"}
+	tests := []struct {
+		name           string
+		curObj         *crdv1beta1.ClusterNetworkPolicy
+		oldObj         *crdv1beta1.ClusterNetworkPolicy
+		userInfo       authenticationv1.UserInfo
+		expectedReason string
+	}{
+		{
+			name:     "no annotation",
+			curObj:   newACNP(nil),
+			userInfo: authenticationv1.UserInfo{Username: "user"},
+		},
+		{
+			name:     "authorized user",
+			curObj:   newACNP(newAnnotations("deny", time.Hour)),
+			userInfo: admin,
+		},
+		{
+			name:           "unauthorized user",
+			curObj:         newACNP(newAnnotations("deny", time.Hour)),
+			userInfo:       authenticationv1.UserInfo{Username: "user"},
+			expectedReason: "user user is not authorized to break-glass ClusterNetworkPolicy acnp1",
+		},
+		{
+			name:     "unchanged annotations",
+			curObj:   newACNP(newAnnotations("deny", time.Hour)),
+			oldObj:   newACNP(newAnnotations("deny", time.Hour)),
+			userInfo: authenticationv1.UserInfo{Username: "user"},
+		},
+		{
+			name:           "unchanged annotations with new bypassed rule",
+			curObj:         newACNPWithRules(newAnnotations("deny,deny2", time.Hour), crdv1beta1.Rule{Name: "deny2", Action: &dropAction}),
+			oldObj:         newACNP(newAnnotations("deny,deny2", time.Hour)),
+			userInfo:       authenticationv1.UserInfo{Username: "user"},
+			expectedReason: "user user is not authorized to break-glass ClusterNetworkPolicy acnp1",
+		},
+		{
+			name:           "unchanged annotations with updated bypassed rule",
+			curObj:         newACNPWithRules(newAnnotations("deny", time.Hour), crdv1beta1.Rule{Name: "deny", Action: &rejectAction}),
+			oldObj:         newACNP(newAnnotations("deny", time.Hour)),
+			userInfo:       authenticationv1.UserInfo{Username: "user"},
+			expectedReason: "user user is not authorized to break-glass ClusterNetworkPolicy acnp1",
+		},
+		{
+			name:     "unchanged annotations with new bypassed rule by authorized user",
+			curObj:   newACNPWithRules(newAnnotations("deny,deny2", time.Hour), crdv1beta1.Rule{Name: "deny2", Action: &dropAction}),
+			oldObj:   newACNP(newAnnotations("deny,deny2", time.Hour)),
+			userInfo: admin,
+		},
+		{
+			name:     "unchanged annotations with updated rule not bypassed",
+			curObj:   newACNPWithRules(newAnnotations("deny", time.Hour), crdv1beta1.Rule{Name: "deny2", Action: &dropAction}),
+			oldObj:   newACNP(newAnnotations("deny", time.Hour)),
+			userInfo: authenticationv1.UserInfo{Username: "user"},
+		},
+		{
+			name:     "unchanged expired annotations with new bypassed rule",
+			curObj:   newACNPWithRules(newAnnotations("deny,deny2", -time.Hour), crdv1beta1.Rule{Name: "deny2", Action: &dropAction}),
+			oldObj:   newACNP(newAnnotations("deny,deny2", -time.Hour)),
+			userInfo: authenticationv1.UserInfo{Username: "user"},
+		},
+		{
+			name:           "allow rule",
+			curObj:         newACNP(newAnnotations("deny,allow", time.Hour)),
+			userInfo:       admin,
+			expectedReason: "rules [allow] are not Drop or Reject rules of the policy",
+		},
+		{
+			name:           "expiry in the past",
+			curObj:         newACNP(newAnnotations("deny", -time.Hour)),
+			userInfo:       admin,
+			expectedReason: "annotation networkpolicy.antrea.io/break-glass-expiry must be a time in the next 24h0m0s",
+		},
+		{
+			name:           "expiry too late",
+			curObj:         newACNP(newAnnotations("deny", 25*time.Hour)),
+			userInfo:       admin,
+			expectedReason: "annotation networkpolicy.antrea.io/break-glass-expiry must be a time in the next 24h0m0s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var oldObj interface{}
+			if tt.oldObj != nil {
+				oldObj = tt.oldObj
+			}
+			reason, allowed := v.validateBreakGlass(tt.curObj, oldObj, tt.userInfo)
+			assert.Equal(t, tt.expectedReason, reason)
+			assert.Equal(t, tt.expectedReason == "", allowed)
+		})
+	}
+}
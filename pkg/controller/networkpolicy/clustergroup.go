@@ -418,6 +418,19 @@ func (c *NetworkPolicyController) getParentGroups(grpName string) []antreatypes.
 	return groups
 }
 
+// AddGroupEventHandler registers a handler which is called with the key of a ClusterGroup/Group (the name of a
+// ClusterGroup, or the Namespace and name of a Group) whenever it is synced, i.e. when it is created, updated or
+// deleted, or when its members or the members of its child groups change. It must be called before Run.
+func (c *NetworkPolicyController) AddGroupEventHandler(handler func(key string)) {
+	c.groupEventHandlers = append(c.groupEventHandlers, handler)
+}
+
+func (c *NetworkPolicyController) notifyGroupEventHandlers(key string) {
+	for _, handler := range c.groupEventHandlers {
+		handler(key)
+	}
+}
+
 // GetGroupMembers returns the current members of a ClusterGroup/Group.
 // If the ClusterGroup/Group is defined with IPBlocks, the returned members will be []controlplane.IPBlock.
 // Otherwise, the returned members will be of type controlplane.GroupMemberSet.
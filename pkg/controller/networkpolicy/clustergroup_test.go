@@ -1159,6 +1159,28 @@ func TestSyncInternalGroup(t *testing.T) {
 	require.False(t, exists, "The AddressGroup for the ClusterGroup should be deleted when it's no longer referenced by any ClusterNetworkPolicy")
 }
 
+func TestGroupEventHandler(t *testing.T) {
+	cg := &crdv1beta1.ClusterGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "cgA", UID: "uidA"},
+		Spec:       crdv1beta1.GroupSpec{NamespaceSelector: &selectorA},
+	}
+	_, npc := newControllerWithoutEventHandler(nil, nil)
+	var keys []string
+	npc.AddGroupEventHandler(func(key string) {
+		keys = append(keys, key)
+	})
+
+	npc.addClusterGroup(cg)
+	require.NoError(t, npc.syncInternalGroup(internalGroupKeyFunc(cg)))
+	assert.Equal(t, []string{"cgA"}, keys)
+
+	npc.deleteClusterGroup(cg)
+	require.NoError(t, npc.syncInternalGroup(internalGroupKeyFunc(cg)))
+	assert.Equal(t, []string{"cgA", "cgA"}, keys)
+	_, _, err := npc.GetGroupMembers("cgA")
+	assert.Error(t, err)
+}
+
 func TestGetClusterGroupSourceRef(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -31,6 +31,7 @@ import (
 	"antrea.io/antrea/pkg/controller/grouping"
 	"antrea.io/antrea/pkg/controller/networkpolicy/store"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/util/k8s"
 	utilsets "antrea.io/antrea/pkg/util/sets"
 )
@@ -197,6 +198,10 @@ func (n *NetworkPolicyController) addNamespace(obj interface{}) {
 			n.enqueueInternalNetworkPolicy(getACNPReference(cnp))
 		}
 	}
+	n.enqueueACNPsForNamespaceExemption(nil, namespace)
+	if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+		n.enqueueAdminNPsWithNamespaceLabelRules()
+	}
 }
 
 // updateNamespace receives Namespace UPDATE events and triggers all ClusterNetworkPolicies that have a
@@ -222,6 +227,10 @@ func (n *NetworkPolicyController) updateNamespace(oldObj, curObj interface{}) {
 				n.enqueueInternalNetworkPolicy(getACNPReference(cnp))
 			}
 		}
+		n.enqueueACNPsForNamespaceExemption(oldNamespace, curNamespace)
+		if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+			n.enqueueAdminNPsWithNamespaceLabelRules()
+		}
 	}
 
 	if oldNamespace.Annotations[EnableNPLoggingAnnotationKey] != curNamespace.Annotations[EnableNPLoggingAnnotationKey] {
@@ -257,6 +266,10 @@ func (n *NetworkPolicyController) deleteNamespace(old interface{}) {
 			n.enqueueInternalNetworkPolicy(getACNPReference(cnp))
 		}
 	}
+	n.enqueueACNPsForNamespaceExemption(namespace, nil)
+	if features.DefaultFeatureGate.Enabled(features.AdminNetworkPolicy) {
+		n.enqueueAdminNPsWithNamespaceLabelRules()
+	}
 }
 
 func (c *NetworkPolicyController) filterAGsFromNodeLabels(node *v1.Node) sets.Set[string] {
@@ -408,15 +421,16 @@ func (n *NetworkPolicyController) processClusterNetworkPolicy(cnp *crdv1beta1.Cl
 			priority := int32(idx)
 			addRule := func(peer *controlplane.NetworkPolicyPeer, ruleAddressGroups []*antreatypes.AddressGroup, dir controlplane.Direction, ruleAppliedTos []*antreatypes.AppliedToGroup) {
 				rule := controlplane.NetworkPolicyRule{
-					Direction:       dir,
-					Services:        services,
-					Name:            cnpRule.Name,
-					Action:          cnpRule.Action,
-					Priority:        priority,
-					EnableLogging:   cnpRule.EnableLogging,
-					AppliedToGroups: getAppliedToGroupNames(ruleAppliedTos),
-					L7Protocols:     toAntreaL7ProtocolsForCRD(cnpRule.L7Protocols),
-					LogLabel:        cnpRule.LogLabel,
+					Direction:        dir,
+					Services:         services,
+					Name:             cnpRule.Name,
+					Action:           cnpRule.Action,
+					Priority:         priority,
+					EnableLogging:    cnpRule.EnableLogging,
+					AppliedToGroups:  getAppliedToGroupNames(ruleAppliedTos),
+					L7Protocols:      toAntreaL7ProtocolsForCRD(cnpRule.L7Protocols),
+					LogLabel:         cnpRule.LogLabel,
+					NodeTrafficTypes: cnpRule.NodeTrafficTypes,
 				}
 				switch dir {
 				case controlplane.DirectionIn:
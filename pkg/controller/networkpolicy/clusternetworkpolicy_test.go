@@ -1250,6 +1250,60 @@ func TestProcessClusterNetworkPolicy(t *testing.T) {
 			expectedAppliedToGroups: 1,
 			expectedAddressGroups:   1,
 		},
+		{
+			name: "service-account-to-service-account-egress-rule",
+			inputPolicy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "cnpSA", UID: "uidSA"},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							ServiceAccount: &crdv1beta1.NamespacedName{
+								Name:      saA.Name,
+								Namespace: saA.Namespace,
+							},
+						},
+					},
+					Priority: p10,
+					Egress: []crdv1beta1.Rule{
+						{
+							To: []crdv1beta1.NetworkPolicyPeer{
+								{
+									ServiceAccount: &crdv1beta1.NamespacedName{
+										Name:      "saB",
+										Namespace: "nsB",
+									},
+								},
+							},
+							Action: &allowAction,
+						},
+					},
+				},
+			},
+			expectedPolicy: &antreatypes.NetworkPolicy{
+				UID:  "uidSA",
+				Name: "uidSA",
+				SourceRef: &controlplane.NetworkPolicyReference{
+					Type: controlplane.AntreaClusterNetworkPolicy,
+					Name: "cnpSA",
+					UID:  "uidSA",
+				},
+				Priority:     &p10,
+				TierPriority: ptr.To(crdv1beta1.DefaultTierPriority),
+				Rules: []controlplane.NetworkPolicyRule{
+					{
+						Direction: controlplane.DirectionOut,
+						To: controlplane.NetworkPolicyPeer{
+							AddressGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("nsB", serviceAccountNameToPodSelector("saB"), nil, nil, nil).NormalizedName)},
+						},
+						Priority: 0,
+						Action:   &allowAction,
+					},
+				},
+				AppliedToGroups: []string{getNormalizedUID(antreatypes.NewGroupSelector("nsA", &selectorD, nil, nil, nil).NormalizedName)},
+			},
+			expectedAppliedToGroups: 1,
+			expectedAddressGroups:   1,
+		},
 		{
 			name: "rule-applied-to-with-service-account-namespaced-name",
 			inputPolicy: &crdv1beta1.ClusterNetworkPolicy{
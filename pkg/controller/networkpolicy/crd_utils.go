@@ -119,8 +119,9 @@ func toAntreaL7ProtocolsForCRD(l7Protocols []crdv1beta1.L7Protocol) []controlpla
 	var antreaL7Protocols []controlplane.L7Protocol
 	for _, l7p := range l7Protocols {
 		antreaL7Protocols = append(antreaL7Protocols, controlplane.L7Protocol{
-			HTTP: (*controlplane.HTTPProtocol)(l7p.HTTP),
-			TLS:  (*controlplane.TLSProtocol)(l7p.TLS),
+			HTTP:  (*controlplane.HTTPProtocol)(l7p.HTTP),
+			TLS:   (*controlplane.TLSProtocol)(l7p.TLS),
+			Kafka: (*controlplane.KafkaProtocol)(l7p.Kafka),
 		})
 	}
 	return antreaL7Protocols
@@ -251,7 +252,7 @@ func (n *NetworkPolicyController) toAntreaPeerForCRD(peers []crdv1beta1.NetworkP
 	}
 	return &controlplane.NetworkPolicyPeer{
 		AddressGroups:   getAddressGroupNames(addressGroups),
-		IPBlocks:        ipBlocks,
+		IPBlocks:        compileIPBlocks(ipBlocks),
 		FQDNs:           fqdns,
 		LabelIdentities: labelIdentities,
 	}, addressGroups, clusterSetScopeSelectorKeys
@@ -394,6 +395,7 @@ func getNormalizedNameForSelector(sel *antreatypes.GroupSelector) string {
 }
 
 func (n *NetworkPolicyController) syncInternalGroup(key string) error {
+	defer n.notifyGroupEventHandlers(key)
 	defer n.triggerANNPUpdates(key)
 	defer n.triggerCNPUpdates(key)
 	defer n.triggerParentGroupUpdates(key)
@@ -412,3 +414,20 @@ func (n *NetworkPolicyController) syncInternalGroup(key string) error {
 	}
 	return n.syncInternalClusterGroup(grp)
 }
+
+// pruneUnusedGroups removes the AppliedToGroups and AddressGroups which are no longer used by the internal
+// NetworkPolicy, after some of its rules or AppliedToGroups have been removed.
+func pruneUnusedGroups(np *antreatypes.NetworkPolicy, appliedToGroups map[string]*antreatypes.AppliedToGroup, addressGroups map[string]*antreatypes.AddressGroup) {
+	usedAppliedToGroups := np.GetAppliedToGroups()
+	for name := range appliedToGroups {
+		if !usedAppliedToGroups.Has(name) {
+			delete(appliedToGroups, name)
+		}
+	}
+	usedAddressGroups := np.GetAddressGroups()
+	for name := range addressGroups {
+		if !usedAddressGroups.Has(name) {
+			delete(addressGroups, name)
+		}
+	}
+}
@@ -187,7 +187,7 @@ func processEndpointAppliedRules(appliedPolicies []*antreatypes.NetworkPolicy, i
 	isolationRules := make([]*antreatypes.RuleInfo, 0)
 	for _, internalPolicy := range appliedPolicies {
 		policyUIDs.Insert(internalPolicy.SourceRef.UID)
-		if internalPolicy.SourceRef.Type == controlplane.K8sNetworkPolicy {
+		if controlplane.HasK8sNetworkPolicySemantics(internalPolicy.SourceRef) {
 			// check if the Kubernetes NetworkPolicy creates ingress or egress isolationRules
 			for _, rule := range internalPolicy.Rules {
 				if rule.Direction == controlplane.DirectionIn && !isSourceEndpoint {
@@ -277,7 +277,7 @@ func predictEndpointsRules(srcEndpointRules, dstEndpointRules *antreatypes.Endpo
 		}
 		if isPass(commonRule.Rule) {
 			for _, rule := range commonRules[1:] {
-				if rule.Policy.SourceRef.Type == controlplane.K8sNetworkPolicy ||
+				if controlplane.HasK8sNetworkPolicySemantics(rule.Policy.SourceRef) ||
 					(rule.Policy.TierPriority != nil && *rule.Policy.TierPriority == crdv1beta1.BaselineTierPriority && !isPass(rule.Rule)) {
 					commonRule = rule
 					break
@@ -0,0 +1,134 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	kmetrics "k8s.io/component-base/metrics"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+var (
+	networkPolicyLabels = []string{"name", "policy_type", "policy_namespace", "policy_name"}
+	groupLabels         = []string{"name", "source_group"}
+
+	networkPolicySpanNodesDesc = kmetrics.NewDesc(
+		"antrea_controller_network_policy_span_nodes",
+		"The number of Nodes spanned by an internal NetworkPolicy",
+		networkPolicyLabels, nil, kmetrics.ALPHA, "")
+	networkPolicyRealizedNodesDesc = kmetrics.NewDesc(
+		"antrea_controller_network_policy_realized_nodes",
+		"The number of Nodes which have realized the current generation of an internal NetworkPolicy of an Antrea-native policy",
+		networkPolicyLabels, nil, kmetrics.ALPHA, "")
+	networkPolicyFailedNodesDesc = kmetrics.NewDesc(
+		"antrea_controller_network_policy_failed_nodes",
+		"The number of Nodes which have failed to realize the current generation of an internal NetworkPolicy of an Antrea-native policy",
+		networkPolicyLabels, nil, kmetrics.ALPHA, "")
+	networkPolicyRealizedDesc = kmetrics.NewDesc(
+		"antrea_controller_network_policy_realized",
+		"Whether the current generation of an internal NetworkPolicy of an Antrea-native policy is realized on all the Nodes it spans (1) or not (0)",
+		networkPolicyLabels, nil, kmetrics.ALPHA, "")
+	addressGroupMembersDesc = kmetrics.NewDesc(
+		"antrea_controller_address_group_members",
+		"The number of members of an AddressGroup",
+		groupLabels, nil, kmetrics.ALPHA, "")
+	addressGroupSpanNodesDesc = kmetrics.NewDesc(
+		"antrea_controller_address_group_span_nodes",
+		"The number of Nodes spanned by an AddressGroup",
+		groupLabels, nil, kmetrics.ALPHA, "")
+	appliedToGroupMembersDesc = kmetrics.NewDesc(
+		"antrea_controller_applied_to_group_members",
+		"The number of members of an AppliedToGroup",
+		groupLabels, nil, kmetrics.ALPHA, "")
+	appliedToGroupSpanNodesDesc = kmetrics.NewDesc(
+		"antrea_controller_applied_to_group_span_nodes",
+		"The number of Nodes spanned by an AppliedToGroup",
+		groupLabels, nil, kmetrics.ALPHA, "")
+)
+
+// networkPolicyObjectCollector exports metrics describing each internal NetworkPolicy, AddressGroup and
+// AppliedToGroup computed by the NetworkPolicyController. The metrics are computed from the stores when they are
+// scraped, so that no state needs to be cleaned up when the objects are deleted.
+type networkPolicyObjectCollector struct {
+	kmetrics.BaseStableCollector
+
+	networkPolicyController *NetworkPolicyController
+	// statusController provides the realization status of Antrea-native policies. It is nil when the AntreaPolicy
+	// feature is disabled.
+	statusController *StatusController
+}
+
+// NewNetworkPolicyObjectCollector returns a collector exporting metrics for the internal NetworkPolicies,
+// AddressGroups and AppliedToGroups. statusController can be nil, in which case no realization metrics are exported.
+func NewNetworkPolicyObjectCollector(networkPolicyController *NetworkPolicyController, statusController *StatusController) kmetrics.StableCollector {
+	return &networkPolicyObjectCollector{
+		networkPolicyController: networkPolicyController,
+		statusController:        statusController,
+	}
+}
+
+func (c *networkPolicyObjectCollector) DescribeWithStability(ch chan<- *kmetrics.Desc) {
+	ch <- networkPolicySpanNodesDesc
+	ch <- networkPolicyRealizedNodesDesc
+	ch <- networkPolicyFailedNodesDesc
+	ch <- networkPolicyRealizedDesc
+	ch <- addressGroupMembersDesc
+	ch <- addressGroupSpanNodesDesc
+	ch <- appliedToGroupMembersDesc
+	ch <- appliedToGroupSpanNodesDesc
+}
+
+func (c *networkPolicyObjectCollector) CollectWithStability(ch chan<- kmetrics.Metric) {
+	for _, obj := range c.networkPolicyController.internalNetworkPolicyStore.List() {
+		c.collectNetworkPolicy(ch, obj.(*antreatypes.NetworkPolicy))
+	}
+	for _, obj := range c.networkPolicyController.addressGroupStore.List() {
+		ag := obj.(*antreatypes.AddressGroup)
+		ch <- kmetrics.NewLazyConstMetric(addressGroupMembersDesc, kmetrics.GaugeValue, float64(len(ag.GroupMembers)), ag.Name, ag.SourceGroup)
+		ch <- kmetrics.NewLazyConstMetric(addressGroupSpanNodesDesc, kmetrics.GaugeValue, float64(len(ag.NodeNames)), ag.Name, ag.SourceGroup)
+	}
+	for _, obj := range c.networkPolicyController.appliedToGroupStore.List() {
+		atg := obj.(*antreatypes.AppliedToGroup)
+		members := 0
+		for _, memberSet := range atg.GroupMemberByNode {
+			members += len(memberSet)
+		}
+		ch <- kmetrics.NewLazyConstMetric(appliedToGroupMembersDesc, kmetrics.GaugeValue, float64(members), atg.Name, atg.SourceGroup)
+		ch <- kmetrics.NewLazyConstMetric(appliedToGroupSpanNodesDesc, kmetrics.GaugeValue, float64(len(atg.NodeNames)), atg.Name, atg.SourceGroup)
+	}
+}
+
+func (c *networkPolicyObjectCollector) collectNetworkPolicy(ch chan<- kmetrics.Metric, np *antreatypes.NetworkPolicy) {
+	var policyType, policyNamespace, policyName string
+	if np.SourceRef != nil {
+		policyType, policyNamespace, policyName = string(np.SourceRef.Type), np.SourceRef.Namespace, np.SourceRef.Name
+	}
+	labelValues := []string{np.Name, policyType, policyNamespace, policyName}
+	ch <- kmetrics.NewLazyConstMetric(networkPolicySpanNodesDesc, kmetrics.GaugeValue, float64(len(np.NodeNames)), labelValues...)
+	// Only antrea-agents report the realization status of Antrea-native policies.
+	if c.statusController == nil || np.SourceRef == nil || !controlplane.IsSourceAntreaNativePolicy(np.SourceRef) {
+		return
+	}
+	realizedNodes, failedNodes := c.statusController.countRealizedNodes(np.Name, np.Generation, np.NodeNames)
+	ch <- kmetrics.NewLazyConstMetric(networkPolicyRealizedNodesDesc, kmetrics.GaugeValue, float64(realizedNodes), labelValues...)
+	ch <- kmetrics.NewLazyConstMetric(networkPolicyFailedNodesDesc, kmetrics.GaugeValue, float64(failedNodes), labelValues...)
+	// A NetworkPolicy which has not been processed once, or which failed to be processed, is not realized.
+	realized := 0.0
+	if np.SyncError == nil && np.NodeNames != nil && realizedNodes == len(np.NodeNames) {
+		realized = 1
+	}
+	ch <- kmetrics.NewLazyConstMetric(networkPolicyRealizedDesc, kmetrics.GaugeValue, realized, labelValues...)
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/component-base/metrics/testutil"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func TestNetworkPolicyObjectCollector(t *testing.T) {
+	_, c := newController(nil, nil)
+	pod1 := &controlplane.GroupMember{Pod: &controlplane.PodReference{Name: "pod1", Namespace: "ns1"}}
+	pod2 := &controlplane.GroupMember{Pod: &controlplane.PodReference{Name: "pod2", Namespace: "ns1"}}
+	pod3 := &controlplane.GroupMember{Pod: &controlplane.PodReference{Name: "pod3", Namespace: "ns1"}}
+	require.NoError(t, c.appliedToGroupStore.Create(&antreatypes.AppliedToGroup{
+		Name:     "atg1",
+		SpanMeta: antreatypes.SpanMeta{NodeNames: sets.New[string]("node1", "node2")},
+		GroupMemberByNode: map[string]controlplane.GroupMemberSet{
+			"node1": controlplane.NewGroupMemberSet(pod1, pod2),
+			"node2": controlplane.NewGroupMemberSet(pod3),
+		},
+	}))
+	require.NoError(t, c.addressGroupStore.Create(&antreatypes.AddressGroup{
+		Name:         "ag1",
+		SourceGroup:  "cg1",
+		SpanMeta:     antreatypes.SpanMeta{NodeNames: sets.New[string]("node1", "node2")},
+		GroupMembers: controlplane.NewGroupMemberSet(pod1, pod2, pod3),
+	}))
+	require.NoError(t, c.internalNetworkPolicyStore.Create(&antreatypes.NetworkPolicy{
+		Name:            "uid1",
+		Generation:      2,
+		SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.AntreaClusterNetworkPolicy, Name: "acnp1", UID: "uid1"},
+		AppliedToGroups: []string{"atg1"},
+		SpanMeta:        antreatypes.SpanMeta{NodeNames: sets.New[string]("node1", "node2", "node3")},
+	}))
+	require.NoError(t, c.internalNetworkPolicyStore.Create(&antreatypes.NetworkPolicy{
+		Name:            "uid2",
+		Generation:      1,
+		SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "ns1", Name: "np1", UID: "uid2"},
+		AppliedToGroups: []string{"atg1"},
+		SpanMeta:        antreatypes.SpanMeta{NodeNames: sets.New[string]("node1", "node2")},
+	}))
+	statusController := &StatusController{
+		statuses: map[string]map[string]*controlplane.NetworkPolicyNodeStatus{
+			"uid1": {
+				"node1": {NodeName: "node1", Generation: 2},
+				"node2": {NodeName: "node2", Generation: 2, RealizationFailure: true},
+				// The status of a previous generation is ignored.
+				"node3": {NodeName: "node3", Generation: 1},
+				// The status of a Node which is no longer spanned is ignored.
+				"node4": {NodeName: "node4", Generation: 2},
+			},
+		},
+	}
+
+	// A collector can only be registered once, so a new one is created for each comparison.
+	expected := `
+# HELP antrea_controller_address_group_members [ALPHA] The number of members of an AddressGroup
+# TYPE antrea_controller_address_group_members gauge
+antrea_controller_address_group_members{name="ag1",source_group="cg1"} 3
+# HELP antrea_controller_address_group_span_nodes [ALPHA] The number of Nodes spanned by an AddressGroup
+# TYPE antrea_controller_address_group_span_nodes gauge
+antrea_controller_address_group_span_nodes{name="ag1",source_group="cg1"} 2
+# HELP antrea_controller_applied_to_group_members [ALPHA] The number of members of an AppliedToGroup
+# TYPE antrea_controller_applied_to_group_members gauge
+antrea_controller_applied_to_group_members{name="atg1",source_group=""} 3
+# HELP antrea_controller_applied_to_group_span_nodes [ALPHA] The number of Nodes spanned by an AppliedToGroup
+# TYPE antrea_controller_applied_to_group_span_nodes gauge
+antrea_controller_applied_to_group_span_nodes{name="atg1",source_group=""} 2
+# HELP antrea_controller_network_policy_failed_nodes [ALPHA] The number of Nodes which have failed to realize the current generation of an internal NetworkPolicy of an Antrea-native policy
+# TYPE antrea_controller_network_policy_failed_nodes gauge
+antrea_controller_network_policy_failed_nodes{name="uid1",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy"} 1
+# HELP antrea_controller_network_policy_realized [ALPHA] Whether the current generation of an internal NetworkPolicy of an Antrea-native policy is realized on all the Nodes it spans (1) or not (0)
+# TYPE antrea_controller_network_policy_realized gauge
+antrea_controller_network_policy_realized{name="uid1",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy"} 0
+# HELP antrea_controller_network_policy_realized_nodes [ALPHA] The number of Nodes which have realized the current generation of an internal NetworkPolicy of an Antrea-native policy
+# TYPE antrea_controller_network_policy_realized_nodes gauge
+antrea_controller_network_policy_realized_nodes{name="uid1",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy"} 1
+# HELP antrea_controller_network_policy_span_nodes [ALPHA] The number of Nodes spanned by an internal NetworkPolicy
+# TYPE antrea_controller_network_policy_span_nodes gauge
+antrea_controller_network_policy_span_nodes{name="uid1",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy"} 3
+antrea_controller_network_policy_span_nodes{name="uid2",policy_name="np1",policy_namespace="ns1",policy_type="K8sNetworkPolicy"} 2
+`
+	assert.NoError(t, testutil.CustomCollectAndCompare(NewNetworkPolicyObjectCollector(c.NetworkPolicyController, statusController), strings.NewReader(expected)))
+
+	// The policy is realized once all the Nodes it spans report the current generation.
+	statusController.statuses["uid1"]["node2"].RealizationFailure = false
+	statusController.statuses["uid1"]["node3"].Generation = 2
+	expected = `
+# HELP antrea_controller_network_policy_realized [ALPHA] Whether the current generation of an internal NetworkPolicy of an Antrea-native policy is realized on all the Nodes it spans (1) or not (0)
+# TYPE antrea_controller_network_policy_realized gauge
+antrea_controller_network_policy_realized{name="uid1",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy"} 1
+`
+	assert.NoError(t, testutil.CustomCollectAndCompare(NewNetworkPolicyObjectCollector(c.NetworkPolicyController, statusController), strings.NewReader(expected),
+		"antrea_controller_network_policy_realized"))
+
+	// No realization metrics are exported without a StatusController.
+	expected = `
+# HELP antrea_controller_network_policy_span_nodes [ALPHA] The number of Nodes spanned by an internal NetworkPolicy
+# TYPE antrea_controller_network_policy_span_nodes gauge
+antrea_controller_network_policy_span_nodes{name="uid1",policy_name="acnp1",policy_namespace="",policy_type="AntreaClusterNetworkPolicy"} 3
+antrea_controller_network_policy_span_nodes{name="uid2",policy_name="np1",policy_namespace="ns1",policy_type="K8sNetworkPolicy"} 2
+`
+	assert.NoError(t, testutil.CustomCollectAndCompare(NewNetworkPolicyObjectCollector(c.NetworkPolicyController, nil), strings.NewReader(expected),
+		"antrea_controller_network_policy_span_nodes", "antrea_controller_network_policy_realized"))
+}
@@ -0,0 +1,208 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+)
+
+const (
+	// namespaceIsolationPolicyName is the name of the internal NetworkPolicy isolating a Namespace
+	// labeled with "policy.antrea.io/isolation: strict".
+	namespaceIsolationPolicyName = "antrea-namespace-isolation"
+	// namespaceSecurityPolicyName is the name of the NamespaceSecurityPolicy reporting the
+	// isolation status of a Namespace.
+	namespaceSecurityPolicyName = "isolation"
+)
+
+var (
+	dnsPort        = intstr.FromInt32(53)
+	dnsProtocolUDP = v1.ProtocolUDP
+	dnsProtocolTCP = v1.ProtocolTCP
+)
+
+func isNamespaceIsolated(namespace *v1.Namespace) bool {
+	return namespace.Labels[crdv1alpha1.NamespaceIsolationLabelKey] == string(crdv1alpha1.NamespaceIsolationStrict)
+}
+
+// namespaceIsolationPolicyUID returns the UID of the internal NetworkPolicy isolating the provided
+// Namespace. It is derived from the Namespace name so that it can be computed after the Namespace
+// is deleted.
+func namespaceIsolationPolicyUID(namespace string) types.UID {
+	return types.UID(getNormalizedUID("namespace-isolation/" + namespace))
+}
+
+// getNamespaceIsolationPolicyReference returns the reference to the NamespaceSecurityPolicy of the provided
+// Namespace, which is the source of its isolation policy.
+func getNamespaceIsolationPolicyReference(namespace string) *controlplane.NetworkPolicyReference {
+	return &controlplane.NetworkPolicyReference{
+		Type:      controlplane.NamespaceSecurityPolicy,
+		Namespace: namespace,
+		Name:      namespaceSecurityPolicyName,
+		UID:       namespaceIsolationPolicyUID(namespace),
+	}
+}
+
+// getNamespaceIsolationPolicy returns the K8s NetworkPolicy enforcing the isolation of the provided
+// Namespace, or nil if the Namespace is not isolated. The NetworkPolicy denies all ingress and
+// egress traffic of the Pods in the Namespace, except for the DNS queries sent to kube-dns.
+func (n *NetworkPolicyController) getNamespaceIsolationPolicy(namespace string) *networkingv1.NetworkPolicy {
+	ns, err := n.namespaceLister.Get(namespace)
+	if err != nil || !isNamespaceIsolated(ns) {
+		return nil
+	}
+	return &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  namespace,
+			Name:       namespaceIsolationPolicyName,
+			UID:        namespaceIsolationPolicyUID(namespace),
+			Generation: 1,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Egress: []networkingv1.NetworkPolicyEgressRule{
+				{
+					Ports: []networkingv1.NetworkPolicyPort{
+						{Protocol: &dnsProtocolUDP, Port: &dnsPort},
+						{Protocol: &dnsProtocolTCP, Port: &dnsPort},
+					},
+					To: []networkingv1.NetworkPolicyPeer{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{v1.LabelMetadataName: metav1.NamespaceSystem},
+							},
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"k8s-app": "kube-dns"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ensureNamespaceSecurityPolicy creates the NamespaceSecurityPolicy of an isolated Namespace if it doesn't exist.
+// Its status is updated by the StatusController according to the realization of the isolation policy.
+func (n *NetworkPolicyController) ensureNamespaceSecurityPolicy(namespace string) error {
+	_, err := n.nspLister.NamespaceSecurityPolicies(namespace).Get(namespaceSecurityPolicyName)
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return fmt.Errorf("error when getting NamespaceSecurityPolicy of Namespace %s: %w", namespace, err)
+	}
+	klog.V(2).InfoS("Creating NamespaceSecurityPolicy", "namespace", namespace)
+	nsp := &crdv1alpha1.NamespaceSecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: namespaceSecurityPolicyName},
+		Spec:       crdv1alpha1.NamespaceSecurityPolicySpec{Isolation: crdv1alpha1.NamespaceIsolationStrict},
+	}
+	_, err = n.crdClient.CrdV1alpha1().NamespaceSecurityPolicies(namespace).Create(context.TODO(), nsp, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("error when creating NamespaceSecurityPolicy of Namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
+// deleteNamespaceSecurityPolicy deletes the NamespaceSecurityPolicy of a Namespace which is no
+// longer isolated.
+func (n *NetworkPolicyController) deleteNamespaceSecurityPolicy(namespace string) error {
+	if _, err := n.nspLister.NamespaceSecurityPolicies(namespace).Get(namespaceSecurityPolicyName); errors.IsNotFound(err) {
+		return nil
+	}
+	err := n.crdClient.CrdV1alpha1().NamespaceSecurityPolicies(namespace).Delete(context.TODO(), namespaceSecurityPolicyName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("error when deleting NamespaceSecurityPolicy of Namespace %s: %w", namespace, err)
+	}
+	return nil
+}
+
+// addIsolatedNamespace receives Namespace ADD events and triggers the isolation policy of the
+// Namespace to be processed if the Namespace is isolated.
+func (n *NetworkPolicyController) addIsolatedNamespace(obj interface{}) {
+	namespace := obj.(*v1.Namespace)
+	if isNamespaceIsolated(namespace) {
+		n.enqueueInternalNetworkPolicy(getNamespaceIsolationPolicyReference(namespace.Name))
+	}
+}
+
+// updateIsolatedNamespace receives Namespace UPDATE events and triggers the isolation policy of the
+// Namespace to be re-processed if the isolation label changes, or if the logging annotation of an
+// isolated Namespace changes.
+func (n *NetworkPolicyController) updateIsolatedNamespace(oldObj, curObj interface{}) {
+	oldNamespace, curNamespace := oldObj.(*v1.Namespace), curObj.(*v1.Namespace)
+	oldIsolated, curIsolated := isNamespaceIsolated(oldNamespace), isNamespaceIsolated(curNamespace)
+	if oldIsolated != curIsolated ||
+		(curIsolated && oldNamespace.Annotations[EnableNPLoggingAnnotationKey] != curNamespace.Annotations[EnableNPLoggingAnnotationKey]) {
+		n.enqueueInternalNetworkPolicy(getNamespaceIsolationPolicyReference(curNamespace.Name))
+	}
+}
+
+// deleteIsolatedNamespace receives Namespace DELETE events and triggers the isolation policy of the
+// Namespace to be deleted if the Namespace was isolated.
+func (n *NetworkPolicyController) deleteIsolatedNamespace(old interface{}) {
+	namespace, ok := old.(*v1.Namespace)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting Namespace, invalid type: %v", old)
+			return
+		}
+		namespace, ok = tombstone.Obj.(*v1.Namespace)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting Namespace, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	if isNamespaceIsolated(namespace) {
+		n.enqueueInternalNetworkPolicy(getNamespaceIsolationPolicyReference(namespace.Name))
+	}
+}
+
+// deleteNamespaceSecurityPolicyEvent receives NamespaceSecurityPolicy DELETE events and triggers the isolation policy
+// of the Namespace to be re-processed, so that the NamespaceSecurityPolicy is created again if the Namespace is still
+// isolated.
+func (n *NetworkPolicyController) deleteNamespaceSecurityPolicyEvent(old interface{}) {
+	nsp, ok := old.(*crdv1alpha1.NamespaceSecurityPolicy)
+	if !ok {
+		tombstone, ok := old.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting NamespaceSecurityPolicy, invalid type: %v", old)
+			return
+		}
+		nsp, ok = tombstone.Obj.(*crdv1alpha1.NamespaceSecurityPolicy)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting NamespaceSecurityPolicy, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	if nsp.Name == namespaceSecurityPolicyName {
+		n.enqueueInternalNetworkPolicy(getNamespaceIsolationPolicyReference(nsp.Namespace))
+	}
+}
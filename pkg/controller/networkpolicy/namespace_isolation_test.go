@@ -0,0 +1,151 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func TestSyncNamespaceIsolationPolicy(t *testing.T) {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "ns1",
+			Labels: map[string]string{crdv1alpha1.NamespaceIsolationLabelKey: "strict"},
+		},
+	}
+	client, c := newController([]runtime.Object{ns}, nil)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.crdInformerFactory.Start(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+	c.crdInformerFactory.WaitForCacheSync(stopCh)
+
+	key := getNamespaceIsolationPolicyReference("ns1")
+	require.NoError(t, c.syncInternalNetworkPolicy(key))
+	obj, exists, _ := c.internalNetworkPolicyStore.Get(string(key.UID))
+	require.True(t, exists)
+	internalNP := obj.(*antreatypes.NetworkPolicy)
+	assert.Equal(t, key, internalNP.SourceRef)
+	require.Len(t, internalNP.Rules, 2)
+	assert.Equal(t, controlplane.DirectionOut, internalNP.Rules[0].Direction)
+	assert.Len(t, internalNP.Rules[0].Services, 2)
+	assert.Len(t, internalNP.Rules[0].To.AddressGroups, 1)
+	assert.Equal(t, controlplane.DirectionIn, internalNP.Rules[1].Direction)
+	assert.Empty(t, internalNP.Rules[1].From.AddressGroups)
+
+	nsp, err := c.crdClient.CrdV1alpha1().NamespaceSecurityPolicies("ns1").Get(context.TODO(), namespaceSecurityPolicyName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, crdv1alpha1.NamespaceIsolationStrict, nsp.Spec.Isolation)
+	// The status is only set by the StatusController once the isolation policy is realized.
+	assert.Empty(t, nsp.Status.Phase)
+	require.Eventually(t, func() bool {
+		_, err := c.nspLister.NamespaceSecurityPolicies("ns1").Get(namespaceSecurityPolicyName)
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	// The isolation policy and the NamespaceSecurityPolicy are deleted when the label is removed.
+	updatedNS := ns.DeepCopy()
+	updatedNS.Labels = nil
+	_, err = client.CoreV1().Namespaces().Update(context.TODO(), updatedNS, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		ns, err := c.namespaceLister.Get("ns1")
+		return err == nil && !isNamespaceIsolated(ns)
+	}, time.Second, 10*time.Millisecond)
+	require.NoError(t, c.syncInternalNetworkPolicy(key))
+	_, exists, _ = c.internalNetworkPolicyStore.Get(string(key.UID))
+	assert.False(t, exists)
+	_, err = c.crdClient.CrdV1alpha1().NamespaceSecurityPolicies("ns1").Get(context.TODO(), namespaceSecurityPolicyName, metav1.GetOptions{})
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestDeleteNamespaceSecurityPolicyEvent(t *testing.T) {
+	_, c := newController(nil, nil)
+	c.deleteNamespaceSecurityPolicyEvent(&crdv1alpha1.NamespaceSecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: namespaceSecurityPolicyName},
+	})
+	require.Equal(t, 1, c.internalNetworkPolicyQueue.Len())
+	key, _ := c.internalNetworkPolicyQueue.Get()
+	assert.Equal(t, *getNamespaceIsolationPolicyReference("ns1"), key)
+	assert.Equal(t, controlplane.NamespaceSecurityPolicy, key.Type)
+}
+
+func TestUpdateIsolatedNamespace(t *testing.T) {
+	newNamespace := func(isolation string, annotations map[string]string) *corev1.Namespace {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", Annotations: annotations}}
+		if isolation != "" {
+			ns.Labels = map[string]string{crdv1alpha1.NamespaceIsolationLabelKey: isolation}
+		}
+		return ns
+	}
+	loggingEnabled := map[string]string{EnableNPLoggingAnnotationKey: "true"}
+	tests := []struct {
+		name            string
+		oldNamespace    *corev1.Namespace
+		curNamespace    *corev1.Namespace
+		expectedEnqueue bool
+	}{
+		{
+			name:            "isolation added",
+			oldNamespace:    newNamespace("", nil),
+			curNamespace:    newNamespace("strict", nil),
+			expectedEnqueue: true,
+		},
+		{
+			name:            "isolation removed",
+			oldNamespace:    newNamespace("strict", nil),
+			curNamespace:    newNamespace("none", nil),
+			expectedEnqueue: true,
+		},
+		{
+			name:            "logging enabled for isolated Namespace",
+			oldNamespace:    newNamespace("strict", nil),
+			curNamespace:    newNamespace("strict", loggingEnabled),
+			expectedEnqueue: true,
+		},
+		{
+			name:         "logging enabled for non-isolated Namespace",
+			oldNamespace: newNamespace("", nil),
+			curNamespace: newNamespace("", loggingEnabled),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, c := newController(nil, nil)
+			c.updateIsolatedNamespace(tt.oldNamespace, tt.curNamespace)
+			if !tt.expectedEnqueue {
+				assert.Equal(t, 0, c.internalNetworkPolicyQueue.Len())
+				return
+			}
+			require.Equal(t, 1, c.internalNetworkPolicyQueue.Len())
+			key, _ := c.internalNetworkPolicyQueue.Get()
+			assert.Equal(t, *getNamespaceIsolationPolicyReference("ns1"), key)
+		})
+	}
+}
@@ -38,11 +38,15 @@ import (
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 	networkinglisters "k8s.io/client-go/listers/networking/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 	policyinformers "sigs.k8s.io/network-policy-api/pkg/client/informers/externalversions/apis/v1alpha1"
 	policylisters "sigs.k8s.io/network-policy-api/pkg/client/listers/apis/v1alpha1"
 
@@ -51,7 +55,9 @@ import (
 	secv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/apiserver/storage"
 	"antrea.io/antrea/pkg/client/clientset/versioned"
+	crdv1a1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
 	crdv1b1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
+	crdv1a1listers "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	crdv1b1listers "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/controller/grouping"
 	"antrea.io/antrea/pkg/controller/labelidentity"
@@ -60,6 +66,7 @@ import (
 	antreatypes "antrea.io/antrea/pkg/controller/types"
 	"antrea.io/antrea/pkg/features"
 	"antrea.io/antrea/pkg/util/externalnode"
+	utilip "antrea.io/antrea/pkg/util/ip"
 	"antrea.io/antrea/pkg/util/k8s"
 	utilsets "antrea.io/antrea/pkg/util/sets"
 )
@@ -96,6 +103,10 @@ const (
 	perNamespaceRuleIndex      = "hasPerNamespaceRule"
 	namespaceRuleLabelKeyIndex = "namespaceRuleLabelKeys"
 	indexValueTrue             = "true"
+
+	// ipBlockCompileThreshold is the number of IPBlocks in a NetworkPolicyPeer above which the IPBlocks are
+	// compiled into a de-duplicated and aggregated set of CIDRs, see compileIPBlocks.
+	ipBlockCompileThreshold = 32
 )
 
 var (
@@ -205,6 +216,13 @@ type NetworkPolicyController struct {
 	// once.
 	grpListerSynced cache.InformerSynced
 
+	// nspLister is able to list/get NamespaceSecurityPolicies and is populated by the shared informer passed to
+	// NewNetworkPolicyController.
+	nspLister crdv1a1listers.NamespaceSecurityPolicyLister
+	// nspListerSynced is a function which returns true if the NamespaceSecurityPolicy shared informer has been
+	// synced at least once.
+	nspListerSynced cache.InformerSynced
+
 	adminNetworkPolicyInformer policyinformers.AdminNetworkPolicyInformer
 	// adminNetworkPolicyLister is able to list/get AdminNetworkPolicy objects.
 	adminNetworkPolicyLister policylisters.AdminNetworkPolicyLister
@@ -250,6 +268,9 @@ type NetworkPolicyController struct {
 	// The typical subscribers of AppliedToGroup are NetworkPolicies.
 	appliedToGroupNotifier *notifier
 
+	// groupEventHandlers are called with the key of an internal Group whenever it is synced.
+	groupEventHandlers []func(key string)
+
 	groupingInterface grouping.Interface
 	// Added as a member to the struct to allow injection for testing.
 	groupingInterfaceSynced func() bool
@@ -261,6 +282,27 @@ type NetworkPolicyController struct {
 	// heartbeatCh is an internal channel for testing. It's used to know whether all tasks have been
 	// processed, and to count executions of each function.
 	heartbeatCh chan heartbeat
+
+	// policyRealizationQuerier is used to check the realization of the generations being rolled out
+	// to canary Nodes. It can be nil, in which case only the observation period is respected.
+	policyRealizationQuerier PolicyRealizationQuerier
+	// rolloutTrackers tracks the ongoing canary rollouts. The keys are the internal NetworkPolicy names.
+	rolloutTrackers     map[string]*rolloutTracker
+	rolloutTrackersLock sync.Mutex
+	// breakGlassExpiries stores the expiry of the break-glass of the internal NetworkPolicies whose rules are
+	// bypassed. The keys are the internal NetworkPolicy names.
+	breakGlassExpiries     map[string]time.Time
+	breakGlassExpiriesLock sync.Mutex
+	eventBroadcaster       record.EventBroadcaster
+	eventRecorder          record.EventRecorder
+	// startTime is the time when the controller was created. Only the ClusterNetworkPolicies created
+	// after it are rolled out when they are created.
+	startTime time.Time
+	clock     clock.Clock
+
+	// acnpExemption is the set of Namespaces which are never selected by the appliedTo of the ClusterNetworkPolicies
+	// in some Tiers. It is nil if no Namespace is exempt.
+	acnpExemption *acnpExemption
 }
 
 type heartbeat struct {
@@ -410,11 +452,13 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 	tierInformer crdv1b1informers.TierInformer,
 	cgInformer crdv1b1informers.ClusterGroupInformer,
 	grpInformer crdv1b1informers.GroupInformer,
+	nspInformer crdv1a1informers.NamespaceSecurityPolicyInformer,
 	addressGroupStore storage.Interface,
 	appliedToGroupStore storage.Interface,
 	internalNetworkPolicyStore storage.Interface,
 	internalGroupStore storage.Interface,
 	stretchedNPEnabled bool) *NetworkPolicyController {
+	eventBroadcaster := record.NewBroadcaster()
 	n := &NetworkPolicyController{
 		kubeClient:                     kubeClient,
 		crdClient:                      crdClient,
@@ -460,6 +504,12 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 		labelIdentityInterface:  labelIdentityInterface,
 		stretchNPEnabled:        stretchedNPEnabled,
 		appliedToGroupNotifier:  newNotifier(),
+		rolloutTrackers:         map[string]*rolloutTracker{},
+		breakGlassExpiries:      map[string]time.Time{},
+		eventBroadcaster:        eventBroadcaster,
+		eventRecorder:           eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: controllerName}),
+		startTime:               time.Now(),
+		clock:                   clock.RealClock{},
 	}
 	n.groupingInterface.AddEventHandler(appliedToGroupType, n.enqueueAppliedToGroup)
 	n.groupingInterface.AddEventHandler(addressGroupType, n.enqueueAddressGroup)
@@ -495,6 +545,25 @@ func NewNetworkPolicyController(kubeClient clientset.Interface,
 			resyncPeriod,
 		)
 	}
+	if features.DefaultFeatureGate.Enabled(features.NamespaceSecurityPolicy) {
+		n.namespaceInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    n.addIsolatedNamespace,
+				UpdateFunc: n.updateIsolatedNamespace,
+				DeleteFunc: n.deleteIsolatedNamespace,
+			},
+			resyncPeriod,
+		)
+		n.nspLister = nspInformer.Lister()
+		n.nspListerSynced = nspInformer.Informer().HasSynced
+		// The NamespaceSecurityPolicy of an isolated Namespace is created again if it is deleted by accident.
+		nspInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				DeleteFunc: n.deleteNamespaceSecurityPolicyEvent,
+			},
+			resyncPeriod,
+		)
+	}
 	// Register Informer and add handlers for AntreaPolicy events only if the feature is enabled.
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		n.serviceInformer = serviceInformer
@@ -703,6 +772,59 @@ func toAntreaIPBlock(ipBlock *networkingv1.IPBlock) (*controlplane.IPBlock, erro
 	return antreaIPBlock, nil
 }
 
+// compileIPBlocks compiles a large list of IPBlocks into a list of IPBlocks without Except, whose CIDRs are the
+// smallest set of CIDRs covering the same addresses. The "except" CIDRs are subtracted from the CIDR of each IPBlock,
+// then the resulting CIDRs are de-duplicated and the adjacent ones are aggregated. It reduces the size of the internal
+// NetworkPolicy distributed to antrea-agents as well as the number of addresses the antrea-agents have to compute and
+// install flows for. Lists with no more than ipBlockCompileThreshold IPBlocks are returned as they are.
+func compileIPBlocks(ipBlocks []controlplane.IPBlock) []controlplane.IPBlock {
+	if len(ipBlocks) <= ipBlockCompileThreshold {
+		return ipBlocks
+	}
+	toNetIPNet := func(ipNet controlplane.IPNet) *net.IPNet {
+		ip := net.IP(ipNet.IP)
+		bits := 128
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 32
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(int(ipNet.PrefixLength), bits)}
+	}
+	var cidrs []*net.IPNet
+	for _, ipBlock := range ipBlocks {
+		cidr := toNetIPNet(ipBlock.CIDR)
+		if len(ipBlock.Except) == 0 {
+			cidrs = append(cidrs, cidr)
+			continue
+		}
+		excepts := make([]*net.IPNet, 0, len(ipBlock.Except))
+		for _, except := range ipBlock.Except {
+			excepts = append(excepts, toNetIPNet(except))
+		}
+		diffCIDRs, err := utilip.DiffFromCIDRs(cidr, excepts)
+		if err != nil {
+			// The IPBlocks have been validated, this should not happen. Keep the IPBlocks as they are so that
+			// antrea-agents can still handle them.
+			klog.ErrorS(err, "Failed to compile IPBlocks, leaving them uncompiled", "count", len(ipBlocks))
+			return ipBlocks
+		}
+		cidrs = append(cidrs, diffCIDRs...)
+	}
+	aggregated := utilip.AggregateCIDRs(cidrs)
+	compiled := make([]controlplane.IPBlock, 0, len(aggregated))
+	for _, cidr := range aggregated {
+		prefixLength, _ := cidr.Mask.Size()
+		compiled = append(compiled, controlplane.IPBlock{
+			CIDR: controlplane.IPNet{
+				IP:           controlplane.IPAddress(cidr.IP.To16()),
+				PrefixLength: int32(prefixLength),
+			},
+		})
+	}
+	klog.V(4).InfoS("Compiled IPBlocks", "before", len(ipBlocks), "after", len(compiled))
+	return compiled
+}
+
 // processNetworkPolicy creates an internal NetworkPolicy instance corresponding
 // to the networkingv1.NetworkPolicy object. This method does not commit the
 // internal NetworkPolicy in store, instead returns an instance to the caller
@@ -830,7 +952,7 @@ func (n *NetworkPolicyController) toAntreaPeer(peers []networkingv1.NetworkPolic
 			addressGroups = append(addressGroups, addressGroup)
 		}
 	}
-	return &controlplane.NetworkPolicyPeer{AddressGroups: getAddressGroupNames(addressGroups), IPBlocks: ipBlocks}, addressGroups
+	return &controlplane.NetworkPolicyPeer{AddressGroups: getAddressGroupNames(addressGroups), IPBlocks: compileIPBlocks(ipBlocks)}, addressGroups
 }
 
 // addNetworkPolicy receives NetworkPolicy ADD events and creates resources
@@ -969,10 +1091,19 @@ func (n *NetworkPolicyController) Run(stopCh <-chan struct{}) {
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		cacheSyncs = append(cacheSyncs, n.acnpListerSynced, n.annpListerSynced, n.cgListerSynced)
 	}
+	if features.DefaultFeatureGate.Enabled(features.NamespaceSecurityPolicy) {
+		cacheSyncs = append(cacheSyncs, n.nspListerSynced)
+	}
 	if !cache.WaitForNamedCacheSync(controllerName, stopCh, cacheSyncs...) {
 		return
 	}
 
+	n.eventBroadcaster.StartStructuredLogging(0)
+	n.eventBroadcaster.StartRecordingToSink(&typedv1.EventSinkImpl{
+		Interface: n.kubeClient.CoreV1().Events(""),
+	})
+	defer n.eventBroadcaster.Shutdown()
+
 	for i := 0; i < defaultWorkers; i++ {
 		go wait.Until(n.appliedToGroupWorker, time.Second, stopCh)
 		go wait.Until(n.addressGroupWorker, time.Second, stopCh)
@@ -1335,11 +1466,12 @@ func (n *NetworkPolicyController) syncAppliedToGroup(key string) error {
 		if err != nil {
 			klog.ErrorS(err, "Error when getting AppliedTo workloads for AppliedToGroup", "AppliedToGroup", appliedToGroup.Name)
 			updatedAppliedToGroup = &antreatypes.AppliedToGroup{
-				UID:         appliedToGroup.UID,
-				Name:        appliedToGroup.Name,
-				Selector:    appliedToGroup.Selector,
-				SourceGroup: appliedToGroup.SourceGroup,
-				SyncError:   err,
+				UID:              appliedToGroup.UID,
+				Name:             appliedToGroup.Name,
+				Selector:         appliedToGroup.Selector,
+				SourceGroup:      appliedToGroup.SourceGroup,
+				ExemptNamespaces: appliedToGroup.ExemptNamespaces,
+				SyncError:        err,
 			}
 		} else {
 			scheduledPodNum, scheduledExtEntityNum := 0, 0
@@ -1388,6 +1520,7 @@ func (n *NetworkPolicyController) syncAppliedToGroup(key string) error {
 				Name:              appliedToGroup.Name,
 				Selector:          appliedToGroup.Selector,
 				SourceGroup:       appliedToGroup.SourceGroup,
+				ExemptNamespaces:  appliedToGroup.ExemptNamespaces,
 				GroupMemberByNode: memberSetByNode,
 				SpanMeta:          antreatypes.SpanMeta{NodeNames: appGroupNodeNames},
 			}
@@ -1411,7 +1544,11 @@ func (n *NetworkPolicyController) getAppliedToWorkloads(g *antreatypes.AppliedTo
 		if found {
 			grp := group.(*antreatypes.Group)
 			pods, ees, err := n.getInternalGroupWorkloads(grp)
-			return pods, ees, nil, err
+			if err != nil || g.ExemptNamespaces.Len() == 0 {
+				return pods, ees, nil, err
+			}
+			pods, ees = excludeExemptNamespaces(pods, ees, g.ExemptNamespaces)
+			return pods, ees, nil, nil
 		}
 		// The internal Group doesn't exist yet or has been deleted. The AppliedToGroup selects nothing at the moment.
 		// Once the internalGroup is created, the AppliedToGroup will be resynced.
@@ -1509,6 +1646,11 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 	var newInternalNetworkPolicy *antreatypes.NetworkPolicy
 	var newAppliedToGroups map[string]*antreatypes.AppliedToGroup
 	var newAddressGroups map[string]*antreatypes.AddressGroup
+	// rolloutACNP is the ClusterNetworkPolicy whose rollout strategy and break-glass apply to the internal
+	// NetworkPolicy.
+	var rolloutACNP *secv1beta1.ClusterNetworkPolicy
+	// isolatedNamespace is the Namespace isolated by the internal NetworkPolicy, if any.
+	var isolatedNamespace string
 
 	switch key.Type {
 	case controlplane.AntreaClusterNetworkPolicy:
@@ -1521,6 +1663,8 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 			return nil
 		}
 		newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups = n.processClusterNetworkPolicy(acnp)
+		n.applyACNPExemption(acnp, newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups)
+		rolloutACNP = acnp
 	case controlplane.AntreaNetworkPolicy:
 		annp, err := n.annpLister.NetworkPolicies(key.Namespace).Get(key.Name)
 		if err != nil || annp.UID != key.UID {
@@ -1535,6 +1679,17 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 			return nil
 		}
 		newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups = n.processNetworkPolicy(knp)
+	case controlplane.NamespaceSecurityPolicy:
+		// The NetworkPolicy isolating a Namespace is not a K8s NetworkPolicy resource, it is generated from the
+		// Namespace labels and reported by the NamespaceSecurityPolicy of the Namespace.
+		knp := n.getNamespaceIsolationPolicy(key.Namespace)
+		if knp == nil {
+			n.deleteInternalNetworkPolicy(internalNetworkPolicyName)
+			return n.deleteNamespaceSecurityPolicy(key.Namespace)
+		}
+		newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups = n.processNetworkPolicy(knp)
+		newInternalNetworkPolicy.SourceRef = getNamespaceIsolationPolicyReference(key.Namespace)
+		isolatedNamespace = key.Namespace
 	case controlplane.AdminNetworkPolicy:
 		anp, err := n.adminNetworkPolicyLister.Get(key.Name)
 		if err != nil || anp.UID != key.UID {
@@ -1551,6 +1706,12 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 		newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups = n.processBaselineAdminNetworkPolicy(banp)
 	}
 
+	// The rules bypassed by a break-glass are enforced again when the NetworkPolicy is synced after the expiry.
+	var breakGlassRequeueAfter time.Duration
+	if rolloutACNP != nil {
+		breakGlassRequeueAfter = n.applyBreakGlass(rolloutACNP, newInternalNetworkPolicy, newAppliedToGroups, newAddressGroups)
+	}
+
 	// The NetworkPolicy must subscribe to the updates of AppliedToGroups before calculating span based on them,
 	// otherwise the calculated span may be outdated as AppliedToGroups can be updated concurrently and the
 	// NetworkPolicy wouldn't be notified.
@@ -1560,23 +1721,8 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 		})
 	}
 
-	newNodeNames, err := func() (sets.Set[string], error) {
-		nodeNames := sets.New[string]()
-		// Calculate the set of Node names based on the span of the
-		// AppliedToGroups referenced by this NetworkPolicy.
-		for appliedToGroupName := range newAppliedToGroups {
-			appGroupObj, found, _ := n.appliedToGroupStore.Get(appliedToGroupName)
-			if !found {
-				continue
-			}
-			appGroup := appGroupObj.(*antreatypes.AppliedToGroup)
-			if appGroup.SyncError != nil {
-				return nil, appGroup.SyncError
-			}
-			utilsets.MergeString(nodeNames, appGroup.SpanMeta.NodeNames)
-		}
-		return nodeNames, nil
-	}()
+	// Calculate the set of Node names based on the span of the AppliedToGroups referenced by this NetworkPolicy.
+	newNodeNames, err := n.getAppliedToGroupsSpan(sets.KeySet(newAppliedToGroups))
 	if err != nil {
 		klog.ErrorS(err, "Error when processing AppliedToGroups for internal NetworkPolicy", "key", key)
 		newInternalNetworkPolicy.SyncError = err
@@ -1590,6 +1736,11 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 		oldInternalNetworkPolicy = oldInternalNetworkPolicyObj.(*antreatypes.NetworkPolicy)
 	}
 
+	rollingOut := false
+	if rolloutACNP != nil && newInternalNetworkPolicy.SyncError == nil {
+		rollingOut = n.applyRollout(rolloutACNP, newInternalNetworkPolicy, oldInternalNetworkPolicy)
+	}
+
 	// appliedToGroupsToSync tracks new AppliedToGroups created by this NetworkPolicy.
 	appliedToGroupsToSync := sets.New[string]()
 
@@ -1659,7 +1810,10 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 	}
 	var addressGroupsToSync sets.Set[string]
 	newAddressGroupNames := sets.KeySet(newAddressGroups)
-	if !newNodeNames.Equal(oldNodeNames) {
+	if newInternalNetworkPolicy.Rollout != nil && newInternalNetworkPolicy.Rollout.PreviousVersion != nil {
+		newAddressGroupNames = newAddressGroupNames.Union(newInternalNetworkPolicy.Rollout.PreviousVersion.GetAddressGroups())
+	}
+	if !newInternalNetworkPolicy.NodeNames.Equal(oldNodeNames) {
 		addressGroupsToSync = oldAddressGroupNames.Union(newAddressGroupNames)
 		klog.V(4).InfoS("Internal NetworkPolicy's Node span changed, enqueuing all related AddressGroups", "NetworkPolicy", key, "AddressGroups", addressGroupsToSync)
 	} else {
@@ -1669,15 +1823,44 @@ func (n *NetworkPolicyController) syncInternalNetworkPolicy(key *controlplane.Ne
 	for addressGroup := range addressGroupsToSync {
 		n.enqueueAddressGroup(addressGroup)
 	}
-	// Unsubscribe to the updates of the stale AppliedToGroups.
+	// Unsubscribe to the updates of the stale AppliedToGroups. The AppliedToGroups of the previous
+	// version are still used during a rollout.
+	newAppliedToGroupNames := newInternalNetworkPolicy.GetAppliedToGroups()
 	for name := range oldAppliedToGroupNames {
-		if _, exists := newAppliedToGroups[name]; !exists {
+		if _, exists := newAppliedToGroups[name]; !exists && !newAppliedToGroupNames.Has(name) {
 			n.appliedToGroupNotifier.unsubscribe(name, internalNetworkPolicyName)
 		}
 	}
+	// Check the progress of the rollout later.
+	if rollingOut {
+		n.internalNetworkPolicyQueue.AddAfter(*key, rolloutCheckInterval)
+	}
+	if breakGlassRequeueAfter > 0 {
+		n.internalNetworkPolicyQueue.AddAfter(*key, breakGlassRequeueAfter)
+	}
+	if isolatedNamespace != "" {
+		return n.ensureNamespaceSecurityPolicy(isolatedNamespace)
+	}
 	return nil
 }
 
+// getAppliedToGroupsSpan returns the union of the spans of the provided AppliedToGroups.
+func (n *NetworkPolicyController) getAppliedToGroupsSpan(appliedToGroupNames sets.Set[string]) (sets.Set[string], error) {
+	nodeNames := sets.New[string]()
+	for appliedToGroupName := range appliedToGroupNames {
+		appGroupObj, found, _ := n.appliedToGroupStore.Get(appliedToGroupName)
+		if !found {
+			continue
+		}
+		appGroup := appGroupObj.(*antreatypes.AppliedToGroup)
+		if appGroup.SyncError != nil {
+			return nil, appGroup.SyncError
+		}
+		utilsets.MergeString(nodeNames, appGroup.SpanMeta.NodeNames)
+	}
+	return nodeNames, nil
+}
+
 // deleteInternalNetworkPolicy deletes the internal NetworkPolicy and the referenced AppliedToGroups and AddressGroups
 // if they are no longer referenced by any NetworkPolicy. They need to be updated atomically to avoid race conditions
 // between workers that process multiple NetworkPolicies.
@@ -1691,12 +1874,14 @@ func (n *NetworkPolicyController) deleteInternalNetworkPolicy(name string) {
 	}
 	internalNetworkPolicy := obj.(*antreatypes.NetworkPolicy)
 	n.internalNetworkPolicyStore.Delete(internalNetworkPolicy.Name)
+	n.deleteRolloutTracker(internalNetworkPolicy.Name)
+	n.deleteBreakGlassExpiry(nil, internalNetworkPolicy.Name, false)
 	n.cleanupOrphanGroups(internalNetworkPolicy)
 	// Unsubscribe to the updates of the AppliedToGroups.
 	for appliedToGroup := range internalNetworkPolicy.GetAppliedToGroups() {
 		n.appliedToGroupNotifier.unsubscribe(appliedToGroup, name)
 	}
-	if n.stretchNPEnabled && internalNetworkPolicy.SourceRef.Type != controlplane.K8sNetworkPolicy {
+	if n.stretchNPEnabled && !controlplane.HasK8sNetworkPolicySemantics(internalNetworkPolicy.SourceRef) {
 		n.labelIdentityInterface.DeletePolicySelectors(internalNetworkPolicy.Name)
 	}
 	// Enqueue AddressGroups previously used by this NetworkPolicy as their span may change due to the removal.
@@ -145,6 +145,7 @@ func newController(k8sObjects, crdObjects []runtime.Object) (*fake.Clientset, *n
 		crdInformerFactory.Crd().V1beta1().Tiers(),
 		cgInformer,
 		gInformer,
+		crdInformerFactory.Crd().V1alpha1().NamespaceSecurityPolicies(),
 		addressGroupStore,
 		appliedToGroupStore,
 		internalNetworkPolicyStore,
@@ -161,6 +162,8 @@ func newController(k8sObjects, crdObjects []runtime.Object) (*fake.Clientset, *n
 	npController.cgListerSynced = alwaysReady
 	npController.serviceLister = informerFactory.Core().V1().Services().Lister()
 	npController.serviceListerSynced = alwaysReady
+	npController.nspLister = crdInformerFactory.Crd().V1alpha1().NamespaceSecurityPolicies().Lister()
+	npController.nspListerSynced = alwaysReady
 	return client, &networkPolicyController{
 		npController,
 		informerFactory.Core().V1().Namespaces().Informer().GetStore(),
@@ -1717,6 +1720,43 @@ func TestToAntreaIPBlock(t *testing.T) {
 	}
 }
 
+func TestCompileIPBlocks(t *testing.T) {
+	newIPBlock := func(cidr string, excepts ...string) controlplane.IPBlock {
+		ipNet, _ := cidrStrToIPNet(cidr)
+		ipBlock := controlplane.IPBlock{CIDR: *ipNet}
+		for _, except := range excepts {
+			exceptNet, _ := cidrStrToIPNet(except)
+			ipBlock.Except = append(ipBlock.Except, *exceptNet)
+		}
+		return ipBlock
+	}
+	// Below the threshold, the IPBlocks are kept as they are.
+	smallIPBlocks := []controlplane.IPBlock{newIPBlock("10.0.0.0/25"), newIPBlock("10.0.0.128/25")}
+	assert.Equal(t, smallIPBlocks, compileIPBlocks(smallIPBlocks))
+
+	var ipBlocks []controlplane.IPBlock
+	// 10.0.0.0/24 - 10.0.63.0/24 can be aggregated to 10.0.0.0/18, and each of them is duplicated once.
+	for i := 0; i < 64; i++ {
+		ipBlocks = append(ipBlocks, newIPBlock(fmt.Sprintf("10.0.%d.0/24", i)), newIPBlock(fmt.Sprintf("10.0.%d.0/24", i)))
+	}
+	// Covered by 10.0.0.0/18.
+	ipBlocks = append(ipBlocks, newIPBlock("10.0.1.1/32"))
+	ipBlocks = append(ipBlocks, newIPBlock("192.168.0.0/16", "192.168.128.0/17", "192.168.0.0/24"))
+	ipBlocks = append(ipBlocks, newIPBlock("fd00::/64"), newIPBlock("fd00:0:0:1::/64"))
+	expected := []controlplane.IPBlock{
+		newIPBlock("10.0.0.0/18"),
+		newIPBlock("192.168.1.0/24"),
+		newIPBlock("192.168.2.0/23"),
+		newIPBlock("192.168.4.0/22"),
+		newIPBlock("192.168.8.0/21"),
+		newIPBlock("192.168.16.0/20"),
+		newIPBlock("192.168.32.0/19"),
+		newIPBlock("192.168.64.0/18"),
+		newIPBlock("fd00::/63"),
+	}
+	assert.Equal(t, expected, compileIPBlocks(ipBlocks))
+}
+
 func TestToAntreaPeer(t *testing.T) {
 	testNPObj := &networkingv1.NetworkPolicy{
 		ObjectMeta: metav1.ObjectMeta{
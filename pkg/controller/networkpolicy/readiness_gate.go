@@ -0,0 +1,260 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/apis/controlplane"
+	"antrea.io/antrea/pkg/controller/networkpolicy/store"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/util/k8s"
+)
+
+const (
+	podReadinessGateControllerName = "PodReadinessGateController"
+	// How long to wait before computing the NetworkPolicies of a Pod again, until antrea-agent sets the
+	// PodNetworkPoliciesRealizedCondition condition of the Pod.
+	podReadinessGateRecheckInterval = 5 * time.Second
+)
+
+// PodReadinessGateController sets the PodNetworkPoliciesAnnotationKey annotation of the Pods which include the
+// PodNetworkPoliciesRealizedCondition in their readinessGates, to the NetworkPolicies applied to them and
+// disseminated to their Nodes. antrea-agent cannot know about the NetworkPolicies which have not been disseminated
+// yet, so it waits for the annotation and only sets the condition once it has realized all the NetworkPolicies
+// listed in it.
+type PodReadinessGateController struct {
+	kubeClient              clientset.Interface
+	podLister               corelisters.PodLister
+	podListerSynced         cache.InformerSynced
+	networkPolicyController *NetworkPolicyController
+	queue                   workqueue.TypedRateLimitingInterface[string]
+}
+
+// NewPodReadinessGateController returns a new *PodReadinessGateController.
+func NewPodReadinessGateController(kubeClient clientset.Interface,
+	podInformer coreinformers.PodInformer,
+	networkPolicyController *NetworkPolicyController) *PodReadinessGateController {
+	c := &PodReadinessGateController{
+		kubeClient:              kubeClient,
+		podLister:               podInformer.Lister(),
+		podListerSynced:         podInformer.Informer().HasSynced,
+		networkPolicyController: networkPolicyController,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "podReadinessGate",
+			},
+		),
+	}
+	podInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueuePod,
+			UpdateFunc: func(_, newObj interface{}) { c.enqueuePod(newObj) },
+		},
+		resyncPeriod,
+	)
+	return c
+}
+
+func (c *PodReadinessGateController) enqueuePod(obj interface{}) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok || !podRequiresNetworkPolicies(pod) {
+		return
+	}
+	c.queue.Add(k8s.NamespacedName(pod.Namespace, pod.Name))
+}
+
+// podRequiresNetworkPolicies returns whether the Pod has the readiness gate, is scheduled, and its condition is
+// not true yet. NetworkPolicies are not enforced for hostNetwork Pods, for which antrea-agent sets the condition
+// immediately.
+func podRequiresNetworkPolicies(pod *corev1.Pod) bool {
+	if pod.Spec.NodeName == "" || pod.Spec.HostNetwork || pod.DeletionTimestamp != nil || k8s.IsPodTerminated(pod) {
+		return false
+	}
+	hasReadinessGate := false
+	for _, gate := range pod.Spec.ReadinessGates {
+		if gate.ConditionType == apis.PodNetworkPoliciesRealizedCondition {
+			hasReadinessGate = true
+			break
+		}
+	}
+	if !hasReadinessGate {
+		return false
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == apis.PodNetworkPoliciesRealizedCondition {
+			return condition.Status != corev1.ConditionTrue
+		}
+	}
+	return true
+}
+
+func (c *PodReadinessGateController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting controller", "name", podReadinessGateControllerName)
+	defer klog.InfoS("Shutting down controller", "name", podReadinessGateControllerName)
+
+	cacheSyncs := []cache.InformerSynced{c.podListerSynced, c.networkPolicyController.groupingInterfaceSynced}
+	if !cache.WaitForNamedCacheSync(podReadinessGateControllerName, stopCh, cacheSyncs...) {
+		return
+	}
+	go wait.Until(c.worker, time.Second, stopCh)
+	<-stopCh
+}
+
+func (c *PodReadinessGateController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *PodReadinessGateController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.syncPod(key); err != nil {
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Failed to sync NetworkPolicies of Pod", "pod", key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+func (c *PodReadinessGateController) syncPod(key string) error {
+	namespace, name := k8s.SplitNamespacedName(key)
+	pod, err := c.podLister.Pods(namespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !podRequiresNetworkPolicies(pod) {
+		return nil
+	}
+	// The NetworkPolicies applied to the Pod can change until antrea-agent sets the condition, e.g. when a
+	// NetworkPolicy is deleted, in which case antrea-agent would wait for it forever, so they are computed again
+	// periodically.
+	defer c.queue.AddAfter(key, podReadinessGateRecheckInterval)
+	policies, synced := c.networkPolicyController.getPodNetworkPolicies(pod)
+	if !synced {
+		klog.V(2).InfoS("NetworkPolicies of Pod are not synced yet", "pod", key)
+		return nil
+	}
+	policiesBytes, err := json.Marshal(policies)
+	if err != nil {
+		return err
+	}
+	value := string(policiesBytes)
+	if pod.Annotations[apis.PodNetworkPoliciesAnnotationKey] == value {
+		return nil
+	}
+	patch := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				apis.PodNetworkPoliciesAnnotationKey: value,
+			},
+		},
+	}
+	patchBytes, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	if _, err := c.kubeClient.CoreV1().Pods(namespace).Patch(context.TODO(), name, types.MergePatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error when updating annotation of Pod %s: %w", key, err)
+	}
+	klog.V(2).InfoS("Set NetworkPolicies of Pod", "pod", key, "policies", len(policies))
+	return nil
+}
+
+// getPodNetworkPolicies returns the versions of the internal NetworkPolicies applied to the Pod which are
+// disseminated to its Node, along with the AppliedToGroups including the Pod. The second return value is false if
+// the AppliedToGroups or the NetworkPolicies have not been synced with the Pod yet, in which case the result would be
+// incomplete.
+func (n *NetworkPolicyController) getPodNetworkPolicies(pod *corev1.Pod) ([]apis.PodNetworkPolicy, bool) {
+	groups, exists := n.groupingInterface.GetGroupsForPod(pod.Namespace, pod.Name)
+	if !exists {
+		return nil, false
+	}
+	// The AppliedToGroups created for ClusterGroups and Groups have the key of the internal Group as name, and their
+	// members include the members of the child Groups.
+	groupKeys := sets.New[string](groups[appliedToGroupType]...)
+	for _, key := range groups[internalGroupType] {
+		groupKeys.Insert(key)
+		for _, parent := range n.getParentGroups(key) {
+			groupKeys.Insert(parent.SourceReference.ToGroupName())
+		}
+	}
+	member := &controlplane.GroupMember{Pod: &controlplane.PodReference{Name: pod.Name, Namespace: pod.Namespace}}
+	policies := map[types.UID]*apis.PodNetworkPolicy{}
+	for _, key := range sets.List(groupKeys) {
+		obj, found, _ := n.appliedToGroupStore.Get(key)
+		if !found {
+			continue
+		}
+		if !obj.(*antreatypes.AppliedToGroup).GroupMemberByNode[pod.Spec.NodeName].Has(member) {
+			return nil, false
+		}
+		objs, _ := n.internalNetworkPolicyStore.GetByIndex(store.AppliedToGroupIndex, key)
+		for _, obj := range objs {
+			internalPolicy := obj.(*antreatypes.NetworkPolicy)
+			policy := internalPolicy.GetVersionForNode(pod.Spec.NodeName)
+			// The span of the NetworkPolicy has not been updated with the Node of the Pod yet.
+			if policy == nil {
+				return nil, false
+			}
+			podPolicy, exists := policies[internalPolicy.UID]
+			if !exists {
+				podPolicy = &apis.PodNetworkPolicy{UID: string(internalPolicy.UID), Generation: policy.Generation}
+				policies[internalPolicy.UID] = podPolicy
+			}
+			podPolicy.AppliedToGroups = append(podPolicy.AppliedToGroups, key)
+		}
+	}
+	result := make([]apis.PodNetworkPolicy, 0, len(policies))
+	for _, policy := range policies {
+		result = append(result, *policy)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].UID < result[j].UID
+	})
+	return result, true
+}
@@ -0,0 +1,155 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/apis/controlplane"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+func newReadinessGatePod(name, nodeName string, conditions ...corev1.PodCondition) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: name, Labels: map[string]string{"app": "web"}},
+		Spec: corev1.PodSpec{
+			NodeName:       nodeName,
+			ReadinessGates: []corev1.PodReadinessGate{{ConditionType: apis.PodNetworkPoliciesRealizedCondition}},
+		},
+		Status: corev1.PodStatus{Conditions: conditions},
+	}
+}
+
+func TestPodRequiresNetworkPolicies(t *testing.T) {
+	hostNetworkPod := newReadinessGatePod("pod1", "node1")
+	hostNetworkPod.Spec.HostNetwork = true
+	tests := []struct {
+		name     string
+		pod      *corev1.Pod
+		expected bool
+	}{
+		{
+			name:     "without readiness gate",
+			pod:      &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "pod1"}, Spec: corev1.PodSpec{NodeName: "node1"}},
+			expected: false,
+		},
+		{
+			name:     "not scheduled",
+			pod:      newReadinessGatePod("pod1", ""),
+			expected: false,
+		},
+		{
+			name:     "hostNetwork",
+			pod:      hostNetworkPod,
+			expected: false,
+		},
+		{
+			name:     "without condition",
+			pod:      newReadinessGatePod("pod1", "node1"),
+			expected: true,
+		},
+		{
+			name:     "condition true",
+			pod:      newReadinessGatePod("pod1", "node1", corev1.PodCondition{Type: apis.PodNetworkPoliciesRealizedCondition, Status: corev1.ConditionTrue}),
+			expected: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, podRequiresNetworkPolicies(tt.pod))
+		})
+	}
+}
+
+func TestPodReadinessGateControllerSyncPod(t *testing.T) {
+	pod := newReadinessGatePod("pod1", "node1")
+	client, npc := newController([]runtime.Object{pod}, nil)
+	c := NewPodReadinessGateController(client, npc.informerFactory.Core().V1().Pods(), npc.NetworkPolicyController)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	npc.informerFactory.Start(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, c.podListerSynced))
+
+	selector := antreatypes.NewGroupSelector("ns1", &metav1.LabelSelector{MatchLabels: map[string]string{"app": "web"}}, nil, nil, nil)
+	npc.groupingInterface.AddGroup(appliedToGroupType, "atg1", selector)
+	npc.groupingInterface.AddPod(pod)
+	getAnnotation := func() (string, bool) {
+		pod, err := client.CoreV1().Pods("ns1").Get(context.TODO(), "pod1", metav1.GetOptions{})
+		require.NoError(t, err)
+		value, exists := pod.Annotations[apis.PodNetworkPoliciesAnnotationKey]
+		return value, exists
+	}
+
+	// The annotation is not set before the AppliedToGroup includes the Pod.
+	atg := &antreatypes.AppliedToGroup{
+		Name:              "atg1",
+		UID:               "atg1",
+		Selector:          selector,
+		SpanMeta:          antreatypes.SpanMeta{NodeNames: sets.New[string]()},
+		GroupMemberByNode: map[string]controlplane.GroupMemberSet{},
+	}
+	require.NoError(t, npc.appliedToGroupStore.Create(atg))
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	_, exists := getAnnotation()
+	assert.False(t, exists)
+
+	// The annotation is not set before the NetworkPolicy is disseminated to the Node of the Pod.
+	atg = &antreatypes.AppliedToGroup{
+		Name:     "atg1",
+		UID:      "atg1",
+		Selector: selector,
+		SpanMeta: antreatypes.SpanMeta{NodeNames: sets.New[string]("node1")},
+		GroupMemberByNode: map[string]controlplane.GroupMemberSet{
+			"node1": controlplane.NewGroupMemberSet(&controlplane.GroupMember{Pod: &controlplane.PodReference{Name: "pod1", Namespace: "ns1"}}),
+		},
+	}
+	require.NoError(t, npc.appliedToGroupStore.Update(atg))
+	policy := &antreatypes.NetworkPolicy{
+		Name:            "uid1",
+		UID:             "uid1",
+		Generation:      2,
+		SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "ns1", Name: "np1", UID: "uid1"},
+		AppliedToGroups: []string{"atg1"},
+		SpanMeta:        antreatypes.SpanMeta{NodeNames: sets.New[string]()},
+	}
+	require.NoError(t, npc.internalNetworkPolicyStore.Create(policy))
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	_, exists = getAnnotation()
+	assert.False(t, exists)
+
+	policy = &antreatypes.NetworkPolicy{
+		Name:            "uid1",
+		UID:             "uid1",
+		Generation:      2,
+		SourceRef:       &controlplane.NetworkPolicyReference{Type: controlplane.K8sNetworkPolicy, Namespace: "ns1", Name: "np1", UID: "uid1"},
+		AppliedToGroups: []string{"atg1"},
+		SpanMeta:        antreatypes.SpanMeta{NodeNames: sets.New[string]("node1")},
+	}
+	require.NoError(t, npc.internalNetworkPolicyStore.Update(policy))
+	require.NoError(t, c.syncPod("ns1/pod1"))
+	value, exists := getAnnotation()
+	assert.True(t, exists)
+	assert.Equal(t, `[{"uid":"uid1","generation":2,"appliedToGroups":["atg1"]}]`, value)
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+const (
+	defaultRolloutObservationPeriod = 5 * time.Minute
+	// rolloutCheckInterval is the interval at which the progress of ongoing rollouts is checked.
+	rolloutCheckInterval = 10 * time.Second
+)
+
+// PolicyRealizationQuerier knows whether a generation of an internal NetworkPolicy has been
+// realized on Nodes.
+type PolicyRealizationQuerier interface {
+	// IsRealizedOnNodes returns whether the provided generation of the internal NetworkPolicy has
+	// been successfully realized on all the provided Nodes.
+	IsRealizedOnNodes(name string, generation int64, nodeNames sets.Set[string]) bool
+}
+
+// rolloutStrategy is the rollout strategy of a ClusterNetworkPolicy, configured with annotations.
+type rolloutStrategy struct {
+	canaryNodeSelector labels.Selector
+	observationPeriod  time.Duration
+	paused             bool
+}
+
+// rolloutTracker tracks the rollout of a generation of a NetworkPolicy.
+type rolloutTracker struct {
+	generation int64
+	// canaryRealizedTime is the time when the generation was observed realized on all the canary
+	// Nodes. It is zero if the generation has not been realized yet.
+	canaryRealizedTime time.Time
+}
+
+// getRolloutStrategy parses the rollout annotations of a ClusterNetworkPolicy. It returns nil if no
+// canary Nodes are configured.
+func getRolloutStrategy(annotations map[string]string) (*rolloutStrategy, error) {
+	selector, ok := annotations[apis.RolloutCanaryNodeSelectorAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	canaryNodeSelector, err := labels.Parse(selector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for annotation %s: %w", apis.RolloutCanaryNodeSelectorAnnotationKey, err)
+	}
+	if canaryNodeSelector.Empty() {
+		return nil, fmt.Errorf("invalid value for annotation %s: the selector must not be empty", apis.RolloutCanaryNodeSelectorAnnotationKey)
+	}
+	strategy := &rolloutStrategy{
+		canaryNodeSelector: canaryNodeSelector,
+		observationPeriod:  defaultRolloutObservationPeriod,
+	}
+	if value, ok := annotations[apis.RolloutObservationPeriodAnnotationKey]; ok {
+		observationPeriod, err := time.ParseDuration(value)
+		if err != nil || observationPeriod < 0 {
+			return nil, fmt.Errorf("invalid value for annotation %s: %s", apis.RolloutObservationPeriodAnnotationKey, value)
+		}
+		strategy.observationPeriod = observationPeriod
+	}
+	if value, ok := annotations[apis.RolloutPausedAnnotationKey]; ok {
+		paused, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for annotation %s: %s", apis.RolloutPausedAnnotationKey, value)
+		}
+		strategy.paused = paused
+	}
+	return strategy, nil
+}
+
+// SetPolicyRealizationQuerier sets the PolicyRealizationQuerier used to check the realization of
+// the generations rolled out to canary Nodes.
+func (n *NetworkPolicyController) SetPolicyRealizationQuerier(querier PolicyRealizationQuerier) {
+	n.policyRealizationQuerier = querier
+}
+
+// applyRollout sets the Rollout field of the new internal NetworkPolicy if its generation must
+// only be sent to the canary Nodes for now, while the other Nodes keep the previous version. It
+// returns whether the rollout is still in progress, in which case the NetworkPolicy must be synced
+// again later to check the progress.
+//
+// The rollout progress is not persisted: when antrea-controller restarts, ongoing rollouts are
+// completed, and only ClusterNetworkPolicies created after the restart are rolled out on creation.
+func (n *NetworkPolicyController) applyRollout(acnp *crdv1beta1.ClusterNetworkPolicy, newNP, oldNP *antreatypes.NetworkPolicy) bool {
+	strategy, err := getRolloutStrategy(acnp.Annotations)
+	if err != nil {
+		klog.ErrorS(err, "Ignoring rollout strategy of ClusterNetworkPolicy", "name", acnp.Name)
+	}
+	if strategy == nil {
+		n.deleteRolloutTracker(newNP.Name)
+		return false
+	}
+
+	var previousNP *antreatypes.NetworkPolicy
+	switch {
+	case oldNP == nil:
+		// The ClusterNetworkPolicies which existed before antrea-controller started have been
+		// sent to all Nodes already.
+		if !acnp.CreationTimestamp.Time.After(n.startTime) {
+			return false
+		}
+	case oldNP.Rollout != nil:
+		// A rollout is in progress, the other Nodes keep the version they have.
+		previousNP = oldNP.Rollout.PreviousVersion
+	case oldNP.Generation == newNP.Generation:
+		// The generation has been rolled out to all Nodes.
+		return false
+	case oldNP.SyncError == nil:
+		previousNP = oldNP
+	}
+
+	tracker := n.getRolloutTracker(newNP.Name, newNP.Generation)
+	canaryNodes := n.getCanaryNodeNames(strategy.canaryNodeSelector)
+	canaryNodeNames := canaryNodes.Intersection(newNP.NodeNames)
+	if tracker.canaryRealizedTime.IsZero() && n.isRealizedOnNodes(newNP, canaryNodeNames) {
+		klog.InfoS("NetworkPolicy realized on canary Nodes", "policy", newNP.SourceRef.ToString(), "generation", newNP.Generation, "canaryNodes", canaryNodeNames.Len())
+		tracker.canaryRealizedTime = n.clock.Now()
+	}
+	if !strategy.paused && !tracker.canaryRealizedTime.IsZero() && n.clock.Since(tracker.canaryRealizedTime) >= strategy.observationPeriod {
+		klog.InfoS("Rolling out NetworkPolicy to all Nodes", "policy", newNP.SourceRef.ToString(), "generation", newNP.Generation)
+		n.deleteRolloutTracker(newNP.Name)
+		return false
+	}
+
+	nodeNames := canaryNodeNames.Clone()
+	if previousNP != nil {
+		previousSpan, err := n.getAppliedToGroupsSpan(sets.New[string](previousNP.AppliedToGroups...))
+		if err != nil {
+			// Keep the span of the previous version if it cannot be calculated.
+			previousSpan = previousNP.NodeNames
+		}
+		previousVersion := *previousNP
+		previousVersion.Rollout = nil
+		previousVersion.NodeNames = previousSpan.Difference(canaryNodes)
+		previousNP = &previousVersion
+		nodeNames = nodeNames.Union(previousNP.NodeNames)
+	}
+	newNP.NodeNames = nodeNames
+	newNP.Rollout = &antreatypes.NetworkPolicyRollout{
+		CanaryNodeNames: canaryNodeNames,
+		PreviousVersion: previousNP,
+	}
+	return true
+}
+
+func (n *NetworkPolicyController) getCanaryNodeNames(selector labels.Selector) sets.Set[string] {
+	nodeNames := sets.New[string]()
+	nodes, _ := n.nodeLister.List(selector)
+	for _, node := range nodes {
+		nodeNames.Insert(node.Name)
+	}
+	return nodeNames
+}
+
+func (n *NetworkPolicyController) isRealizedOnNodes(internalNP *antreatypes.NetworkPolicy, nodeNames sets.Set[string]) bool {
+	if n.policyRealizationQuerier == nil {
+		return true
+	}
+	return n.policyRealizationQuerier.IsRealizedOnNodes(internalNP.Name, internalNP.Generation, nodeNames)
+}
+
+// getRolloutTracker returns the rolloutTracker of the provided generation of a NetworkPolicy,
+// replacing the tracker of a previous generation if any.
+func (n *NetworkPolicyController) getRolloutTracker(name string, generation int64) *rolloutTracker {
+	n.rolloutTrackersLock.Lock()
+	defer n.rolloutTrackersLock.Unlock()
+	tracker, exists := n.rolloutTrackers[name]
+	if !exists || tracker.generation != generation {
+		tracker = &rolloutTracker{generation: generation}
+		n.rolloutTrackers[name] = tracker
+	}
+	return tracker
+}
+
+func (n *NetworkPolicyController) deleteRolloutTracker(name string) {
+	n.rolloutTrackersLock.Lock()
+	defer n.rolloutTrackersLock.Unlock()
+	delete(n.rolloutTrackers, name)
+}
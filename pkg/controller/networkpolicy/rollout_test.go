@@ -0,0 +1,226 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkpolicy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	"antrea.io/antrea/pkg/apis"
+	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
+	antreatypes "antrea.io/antrea/pkg/controller/types"
+)
+
+type fakePolicyRealizationQuerier struct {
+	realized bool
+}
+
+func (q *fakePolicyRealizationQuerier) IsRealizedOnNodes(name string, generation int64, nodeNames sets.Set[string]) bool {
+	return q.realized
+}
+
+func TestGetRolloutStrategy(t *testing.T) {
+	tests := []struct {
+		name             string
+		annotations      map[string]string
+		expectedStrategy bool
+		expectedPeriod   time.Duration
+		expectedPaused   bool
+		expectedErr      string
+	}{
+		{
+			name:        "no annotation",
+			annotations: map[string]string{"foo": "bar"},
+		},
+		{
+			name:             "default observation period",
+			annotations:      map[string]string{apis.RolloutCanaryNodeSelectorAnnotationKey: "canary=true"},
+			expectedStrategy: true,
+			expectedPeriod:   defaultRolloutObservationPeriod,
+		},
+		{
+			name: "paused with observation period",
+			annotations: map[string]string{
+				apis.RolloutCanaryNodeSelectorAnnotationKey: "canary=true",
+				apis.RolloutObservationPeriodAnnotationKey:  "30s",
+				apis.RolloutPausedAnnotationKey:             "true",
+			},
+			expectedStrategy: true,
+			expectedPeriod:   30 * time.Second,
+			expectedPaused:   true,
+		},
+		{
+			name:        "empty selector",
+			annotations: map[string]string{apis.RolloutCanaryNodeSelectorAnnotationKey: ""},
+			expectedErr: "the selector must not be empty",
+		},
+		{
+			name:        "invalid selector",
+			annotations: map[string]string{apis.RolloutCanaryNodeSelectorAnnotationKey: "canary in (true"},
+			expectedErr: "invalid value for annotation " + apis.RolloutCanaryNodeSelectorAnnotationKey,
+		},
+		{
+			name: "invalid observation period",
+			annotations: map[string]string{
+				apis.RolloutCanaryNodeSelectorAnnotationKey: "canary=true",
+				apis.RolloutObservationPeriodAnnotationKey:  "-1m",
+			},
+			expectedErr: "invalid value for annotation " + apis.RolloutObservationPeriodAnnotationKey,
+		},
+		{
+			name: "invalid paused value",
+			annotations: map[string]string{
+				apis.RolloutCanaryNodeSelectorAnnotationKey: "canary=true",
+				apis.RolloutPausedAnnotationKey:             "yes",
+			},
+			expectedErr: "invalid value for annotation " + apis.RolloutPausedAnnotationKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategy, err := getRolloutStrategy(tt.annotations)
+			if tt.expectedErr != "" {
+				assert.ErrorContains(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			if !tt.expectedStrategy {
+				assert.Nil(t, strategy)
+				return
+			}
+			require.NotNil(t, strategy)
+			assert.Equal(t, tt.expectedPeriod, strategy.observationPeriod)
+			assert.Equal(t, tt.expectedPaused, strategy.paused)
+		})
+	}
+}
+
+func TestApplyRollout(t *testing.T) {
+	newNode := func(name string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	nodes := []runtime.Object{
+		newNode("node1", map[string]string{"canary": "true"}),
+		newNode("node2", nil),
+		newNode("node3", nil),
+	}
+	_, c := newController(nodes, nil)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	c.informerFactory.Start(stopCh)
+	c.informerFactory.WaitForCacheSync(stopCh)
+
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	c.clock = fakeClock
+	c.startTime = fakeClock.Now()
+	querier := &fakePolicyRealizationQuerier{}
+	c.SetPolicyRealizationQuerier(querier)
+
+	allNodes := sets.New[string]("node1", "node2", "node3")
+	c.appliedToGroupStore.Create(&antreatypes.AppliedToGroup{
+		Name:     "atg1",
+		SpanMeta: antreatypes.SpanMeta{NodeNames: allNodes},
+	})
+	newInternalNP := func(generation int64) *antreatypes.NetworkPolicy {
+		return &antreatypes.NetworkPolicy{
+			Name:       "uid1",
+			Generation: generation,
+			SourceRef: &controlplane.NetworkPolicyReference{
+				Type: controlplane.AntreaClusterNetworkPolicy,
+				Name: "acnp1",
+				UID:  "uid1",
+			},
+			AppliedToGroups: []string{"atg1"},
+			SpanMeta:        antreatypes.SpanMeta{NodeNames: allNodes.Clone()},
+		}
+	}
+	acnp := &crdv1beta1.ClusterNetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "acnp1",
+			UID:               "uid1",
+			CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+			Annotations: map[string]string{
+				apis.RolloutCanaryNodeSelectorAnnotationKey: "canary=true",
+				apis.RolloutObservationPeriodAnnotationKey:  "1m",
+			},
+		},
+	}
+
+	// A ClusterNetworkPolicy created before the controller started is not rolled out.
+	np1 := newInternalNP(1)
+	assert.False(t, c.applyRollout(acnp, np1, nil))
+	assert.Nil(t, np1.Rollout)
+
+	// The new generation is only sent to the canary Node while it is not realized.
+	np2 := newInternalNP(2)
+	assert.True(t, c.applyRollout(acnp, np2, np1))
+	require.NotNil(t, np2.Rollout)
+	assert.Equal(t, allNodes, np2.NodeNames)
+	assert.Equal(t, sets.New[string]("node1"), np2.Rollout.CanaryNodeNames)
+	require.NotNil(t, np2.Rollout.PreviousVersion)
+	assert.Equal(t, int64(1), np2.Rollout.PreviousVersion.Generation)
+	assert.Equal(t, sets.New[string]("node2", "node3"), np2.Rollout.PreviousVersion.NodeNames)
+	assert.Equal(t, np2, np2.GetVersionForNode("node1"))
+	assert.Equal(t, np2.Rollout.PreviousVersion, np2.GetVersionForNode("node2"))
+
+	// The observation period starts when the new generation is realized on the canary Node.
+	querier.realized = true
+	fakeClock.Step(time.Hour)
+	np2Resynced := newInternalNP(2)
+	assert.True(t, c.applyRollout(acnp, np2Resynced, np2))
+	assert.Equal(t, int64(1), np2Resynced.Rollout.PreviousVersion.Generation)
+
+	// The rollout doesn't proceed while it is paused.
+	fakeClock.Step(time.Minute)
+	acnp.Annotations[apis.RolloutPausedAnnotationKey] = "true"
+	np2Paused := newInternalNP(2)
+	assert.True(t, c.applyRollout(acnp, np2Paused, np2Resynced))
+	require.NotNil(t, np2Paused.Rollout)
+
+	// The new generation is sent to all Nodes once the rollout is resumed.
+	delete(acnp.Annotations, apis.RolloutPausedAnnotationKey)
+	np2Completed := newInternalNP(2)
+	assert.False(t, c.applyRollout(acnp, np2Completed, np2Paused))
+	assert.Nil(t, np2Completed.Rollout)
+	assert.Equal(t, allNodes, np2Completed.NodeNames)
+	assert.False(t, c.applyRollout(acnp, newInternalNP(2), np2Completed))
+	assert.Empty(t, c.rolloutTrackers)
+
+	// A ClusterNetworkPolicy created after the controller started is only sent to the canary
+	// Node first.
+	querier.realized = false
+	acnp.CreationTimestamp = metav1.NewTime(fakeClock.Now())
+	np1 = newInternalNP(1)
+	assert.True(t, c.applyRollout(acnp, np1, nil))
+	assert.Equal(t, sets.New[string]("node1"), np1.NodeNames)
+	assert.Nil(t, np1.Rollout.PreviousVersion)
+	assert.Nil(t, np1.GetVersionForNode("node2"))
+
+	// The rollout is stopped when the annotation is removed.
+	delete(acnp.Annotations, apis.RolloutCanaryNodeSelectorAnnotationKey)
+	np1 = newInternalNP(1)
+	assert.False(t, c.applyRollout(acnp, np1, nil))
+	assert.Nil(t, np1.Rollout)
+	assert.Empty(t, c.rolloutTrackers)
+}
@@ -22,25 +22,36 @@ import (
 	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	clientset "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	typedv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/apiserver/storage"
 	antreaclientset "antrea.io/antrea/pkg/client/clientset/versioned"
+	crdv1a1informers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1alpha1"
 	crdinformers "antrea.io/antrea/pkg/client/informers/externalversions/crd/v1beta1"
+	crdv1a1listers "antrea.io/antrea/pkg/client/listers/crd/v1alpha1"
 	crdlisters "antrea.io/antrea/pkg/client/listers/crd/v1beta1"
 	"antrea.io/antrea/pkg/controller/metrics"
 	antreatypes "antrea.io/antrea/pkg/controller/types"
+	"antrea.io/antrea/pkg/features"
 )
 
 const (
@@ -54,7 +65,8 @@ var (
 	maxConditionMessageLength = 256
 )
 
-// StatusController is responsible for synchronizing the status of Antrea ClusterNetworkPolicy and Antrea NetworkPolicy.
+// StatusController is responsible for synchronizing the status of Antrea ClusterNetworkPolicy, Antrea NetworkPolicy and
+// NamespaceSecurityPolicy.
 type StatusController struct {
 	// npControlInterface knows how to update Antrea NetworkPolicy status.
 	npControlInterface networkPolicyControlInterface
@@ -75,15 +87,44 @@ type StatusController struct {
 	acnpListerSynced cache.InformerSynced
 	// annpListerSynced is a function which returns true if the AntreaNetworkPolicies shared informer has been synced at least once.
 	annpListerSynced cache.InformerSynced
+	// nspListerSynced is a function which returns true if the NamespaceSecurityPolicies shared informer has been synced
+	// at least once. It is nil if the NamespaceSecurityPolicy feature is disabled.
+	nspListerSynced cache.InformerSynced
+
+	// realizationSLO is the target duration for realizing a generation of a policy on all the Nodes it spans. 0 means
+	// SLO breaches are not reported.
+	realizationSLO time.Duration
+	// realizationTrackers tracks the realization of the latest generation of each policy. The keys are the
+	// NetworkPolicy keys.
+	realizationTrackers     map[string]*realizationTracker
+	realizationTrackersLock sync.Mutex
+	clock                   clock.Clock
+
+	kubeClient       clientset.Interface
+	eventBroadcaster record.EventBroadcaster
+	eventRecorder    record.EventRecorder
 }
 
-func NewStatusController(antreaClient antreaclientset.Interface, internalNetworkPolicyStore storage.Interface, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer) *StatusController {
+// realizationTracker tracks the realization of a generation of a policy.
+type realizationTracker struct {
+	generation int64
+	// startTime is the time when the generation was observed by the StatusController.
+	startTime time.Time
+	// realized indicates whether the generation has been realized on all the Nodes it spans.
+	realized bool
+	// sloBreached indicates whether the SLO breach of the generation has been reported.
+	sloBreached bool
+}
+
+func NewStatusController(kubeClient clientset.Interface, antreaClient antreaclientset.Interface, internalNetworkPolicyStore storage.Interface, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer, nspInformer crdv1a1informers.NamespaceSecurityPolicyInformer, realizationSLO time.Duration) *StatusController {
+	eventBroadcaster := record.NewBroadcaster()
+	npControl := &networkPolicyControl{
+		antreaClient: antreaClient,
+		annpLister:   annpInformer.Lister(),
+		acnpLister:   acnpInformer.Lister(),
+	}
 	c := &StatusController{
-		npControlInterface: &networkPolicyControl{
-			antreaClient: antreaClient,
-			annpLister:   annpInformer.Lister(),
-			acnpLister:   acnpInformer.Lister(),
-		},
+		npControlInterface: npControl,
 		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
 			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
 			workqueue.TypedRateLimitingQueueConfig[string]{
@@ -94,6 +135,12 @@ func NewStatusController(antreaClient antreaclientset.Interface, internalNetwork
 		statuses:                   map[string]map[string]*controlplane.NetworkPolicyNodeStatus{},
 		acnpListerSynced:           acnpInformer.Informer().HasSynced,
 		annpListerSynced:           annpInformer.Informer().HasSynced,
+		realizationSLO:             realizationSLO,
+		realizationTrackers:        map[string]*realizationTracker{},
+		clock:                      clock.RealClock{},
+		kubeClient:                 kubeClient,
+		eventBroadcaster:           eventBroadcaster,
+		eventRecorder:              eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: statusControllerName}),
 	}
 	// To save a "GET" query before each update, UpdateAntreaClusterNetworkPolicyStatus treats the cache of Lister as
 	// the state of kube-apiserver. In some cases the cache may not be in sync, then we might skip updating a policy's
@@ -114,6 +161,19 @@ func NewStatusController(antreaClient antreaclientset.Interface, internalNetwork
 		},
 		resyncPeriod,
 	)
+	if features.DefaultFeatureGate.Enabled(features.NamespaceSecurityPolicy) {
+		npControl.nspLister = nspInformer.Lister()
+		c.nspListerSynced = nspInformer.Informer().HasSynced
+		// The NamespaceSecurityPolicy of a Namespace is created by the NetworkPolicyController after its isolation
+		// policy, so its status is synced again when it is added.
+		nspInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    c.addNSP,
+				UpdateFunc: c.updateNSP,
+			},
+			resyncPeriod,
+		)
+	}
 	return c
 }
 
@@ -137,6 +197,20 @@ func (c *StatusController) updateANNP(old, cur interface{}) {
 	c.queue.Add(key)
 }
 
+func (c *StatusController) addNSP(obj interface{}) {
+	nsp := obj.(*crdv1alpha1.NamespaceSecurityPolicy)
+	c.queue.Add(string(namespaceIsolationPolicyUID(nsp.Namespace)))
+}
+
+func (c *StatusController) updateNSP(old, cur interface{}) {
+	curNSP := cur.(*crdv1alpha1.NamespaceSecurityPolicy)
+	oldNSP := old.(*crdv1alpha1.NamespaceSecurityPolicy)
+	if oldNSP.Status == curNSP.Status {
+		return
+	}
+	c.queue.Add(string(namespaceIsolationPolicyUID(curNSP.Namespace)))
+}
+
 func (c *StatusController) UpdateStatus(status *controlplane.NetworkPolicyStatus) error {
 	key := status.Name
 	_, found, _ := c.internalNetworkPolicyStore.Get(key)
@@ -174,12 +248,114 @@ func (c *StatusController) getNodeStatuses(key string) []*controlplane.NetworkPo
 	return statuses
 }
 
+// IsRealizedOnNodes implements PolicyRealizationQuerier.
+func (c *StatusController) IsRealizedOnNodes(name string, generation int64, nodeNames sets.Set[string]) bool {
+	c.statusesLock.RLock()
+	defer c.statusesLock.RUnlock()
+	statusPerNode := c.statuses[name]
+	for nodeName := range nodeNames {
+		status, exists := statusPerNode[nodeName]
+		if !exists || status.Generation != generation || status.RealizationFailure {
+			return false
+		}
+	}
+	return true
+}
+
+// countRealizedNodes returns the number of Nodes among the provided ones which have realized the provided generation of
+// a NetworkPolicy, and the number of Nodes which have failed to realize it.
+func (c *StatusController) countRealizedNodes(name string, generation int64, nodeNames sets.Set[string]) (int, int) {
+	c.statusesLock.RLock()
+	defer c.statusesLock.RUnlock()
+	realizedNodes, failedNodes := 0, 0
+	for nodeName, status := range c.statuses[name] {
+		if !nodeNames.Has(nodeName) || status.Generation != generation {
+			continue
+		}
+		if status.RealizationFailure {
+			failedNodes++
+		} else {
+			realizedNodes++
+		}
+	}
+	return realizedNodes, failedNodes
+}
+
 func (c *StatusController) clearStatuses(key string) {
 	c.statusesLock.Lock()
 	defer c.statusesLock.Unlock()
 	delete(c.statuses, key)
 }
 
+func (c *StatusController) clearRealizationTracker(key string) {
+	c.realizationTrackersLock.Lock()
+	defer c.realizationTrackersLock.Unlock()
+	delete(c.realizationTrackers, key)
+}
+
+// trackRealization records the realization progress of the current generation of the provided policy. The duration
+// between the generation being observed and it being realized on all the Nodes it spans is exported as a metric. If
+// the generation is not realized within the realization SLO, a Warning Event is emitted for the policy, once.
+func (c *StatusController) trackRealization(key string, internalNP *antreatypes.NetworkPolicy, currentNodes, desiredNodes int, realized bool) {
+	c.realizationTrackersLock.Lock()
+	defer c.realizationTrackersLock.Unlock()
+	now := c.clock.Now()
+	tracker, exists := c.realizationTrackers[key]
+	if !exists || tracker.generation != internalNP.Generation {
+		tracker = &realizationTracker{generation: internalNP.Generation, startTime: now}
+		c.realizationTrackers[key] = tracker
+		if c.realizationSLO > 0 && !realized {
+			// Resync the policy when the SLO expires, in case no status is reported by antrea-agents until then.
+			c.queue.AddAfter(key, c.realizationSLO)
+		}
+	}
+	if tracker.realized {
+		return
+	}
+	policyType := realizationMetricPolicyType(internalNP.SourceRef.Type)
+	elapsed := now.Sub(tracker.startTime)
+	if realized {
+		tracker.realized = true
+		metrics.NetworkPolicyRealizationDuration.WithLabelValues(policyType).Observe(elapsed.Seconds())
+		klog.V(2).InfoS("NetworkPolicy realized on all Nodes", "policy", internalNP.SourceRef.ToString(), "generation", internalNP.Generation, "duration", elapsed)
+		return
+	}
+	if c.realizationSLO == 0 || tracker.sloBreached || elapsed < c.realizationSLO {
+		return
+	}
+	tracker.sloBreached = true
+	metrics.NetworkPolicyRealizationSLOBreaches.WithLabelValues(policyType).Inc()
+	ref := &corev1.ObjectReference{
+		APIVersion: crdv1beta1.SchemeGroupVersion.String(),
+		Namespace:  internalNP.SourceRef.Namespace,
+		Name:       internalNP.SourceRef.Name,
+		UID:        internalNP.SourceRef.UID,
+	}
+	switch internalNP.SourceRef.Type {
+	case controlplane.AntreaNetworkPolicy:
+		ref.Kind = "NetworkPolicy"
+	case controlplane.NamespaceSecurityPolicy:
+		ref.APIVersion = crdv1alpha1.SchemeGroupVersion.String()
+		ref.Kind = "NamespaceSecurityPolicy"
+		// The UID of the reference is derived from the Namespace, not the UID of the NamespaceSecurityPolicy.
+		ref.UID = ""
+	default:
+		ref.Kind = "ClusterNetworkPolicy"
+	}
+	c.eventRecorder.Eventf(ref, corev1.EventTypeWarning, "RealizationSLOBreached",
+		"Generation %d is not realized on all Nodes within %v, %d/%d Nodes realized", internalNP.Generation, c.realizationSLO, currentNodes, desiredNodes)
+}
+
+func realizationMetricPolicyType(policyType controlplane.NetworkPolicyType) string {
+	switch policyType {
+	case controlplane.AntreaNetworkPolicy:
+		return "annp"
+	case controlplane.NamespaceSecurityPolicy:
+		return "nsp"
+	}
+	return "acnp"
+}
+
 func (c *StatusController) deleteNodeStatus(key string, nodeName string) {
 	c.statusesLock.Lock()
 	defer c.statusesLock.Unlock()
@@ -197,10 +373,20 @@ func (c *StatusController) Run(stopCh <-chan struct{}) {
 	klog.Infof("Starting %s", statusControllerName)
 	defer klog.Infof("Shutting down %s", statusControllerName)
 
-	if !cache.WaitForNamedCacheSync(statusControllerName, stopCh, c.acnpListerSynced, c.annpListerSynced) {
+	cacheSyncs := []cache.InformerSynced{c.acnpListerSynced, c.annpListerSynced}
+	if c.nspListerSynced != nil {
+		cacheSyncs = append(cacheSyncs, c.nspListerSynced)
+	}
+	if !cache.WaitForNamedCacheSync(statusControllerName, stopCh, cacheSyncs...) {
 		return
 	}
 
+	c.eventBroadcaster.StartStructuredLogging(0)
+	c.eventBroadcaster.StartRecordingToSink(&typedv1.EventSinkImpl{
+		Interface: c.kubeClient.CoreV1().Events(""),
+	})
+	defer c.eventBroadcaster.Shutdown()
+
 	go wait.NonSlidingUntil(c.watchInternalNetworkPolicy, 5*time.Second, stopCh)
 
 	for i := 0; i < defaultWorkers; i++ {
@@ -227,7 +413,7 @@ func (c *StatusController) watchInternalNetworkPolicy() {
 			continue
 		}
 		np := event.Object.(*controlplane.NetworkPolicy)
-		if !controlplane.IsSourceAntreaNativePolicy(np.SourceRef) {
+		if !controlplane.IsRealizationStatusReported(np.SourceRef) {
 			continue
 		}
 		c.queue.Add(np.Name)
@@ -271,9 +457,13 @@ func (c *StatusController) syncHandler(key string) error {
 	if !found {
 		// It has been deleted, cleaning its statuses.
 		c.clearStatuses(key)
+		c.clearRealizationTracker(key)
 		return nil
 	}
 	internalNP := internalNPObj.(*antreatypes.NetworkPolicy)
+	if internalNP.SourceRef.Type == controlplane.NamespaceSecurityPolicy {
+		return c.syncNamespaceSecurityPolicyStatus(key, internalNP)
+	}
 
 	updateStatus := func(phase crdv1beta1.NetworkPolicyPhase, currentNodes, desiredNodes int, conditions []crdv1beta1.NetworkPolicyCondition) error {
 		status := &crdv1beta1.NetworkPolicyStatus{
@@ -294,12 +484,14 @@ func (c *StatusController) syncHandler(key string) error {
 	// It means the NetworkPolicy has been processed, and marked as unrealizable. It will enter unrealizable phase
 	// instead of being further realized. Antrea-agents will not process further.
 	if internalNP.SyncError != nil {
+		c.clearRealizationTracker(key)
 		return updateStatus(crdv1beta1.NetworkPolicyPending, 0, 0, conditions)
 	}
 
 	// It means the NetworkPolicy hasn't been processed once. Set it to Pending to differentiate from NetworkPolicies
 	// that spans 0 Node.
 	if internalNP.SpanMeta.NodeNames == nil {
+		c.trackRealization(key, internalNP, 0, 0, false)
 		return updateStatus(crdv1beta1.NetworkPolicyPending, 0, 0, conditions)
 	}
 
@@ -342,21 +534,78 @@ func (c *StatusController) syncHandler(key string) error {
 	} else if currentNodes+len(failedNodes) == desiredNodes {
 		phase = crdv1beta1.NetworkPolicyFailed
 	}
+	c.trackRealization(key, internalNP, currentNodes, desiredNodes, phase == crdv1beta1.NetworkPolicyRealized)
 
 	return updateStatus(phase, currentNodes, desiredNodes, conditions)
 }
 
+// syncNamespaceSecurityPolicyStatus syncs the status of the NamespaceSecurityPolicy of a Namespace with the realization
+// of its isolation policy. The isolation is only reported as enforced once it has been realized on all the Nodes
+// running Pods of the Namespace.
+func (c *StatusController) syncNamespaceSecurityPolicyStatus(key string, internalNP *antreatypes.NetworkPolicy) error {
+	namespace := internalNP.SourceRef.Namespace
+	if internalNP.SyncError != nil {
+		c.clearRealizationTracker(key)
+		return c.npControlInterface.UpdateNamespaceSecurityPolicyStatus(namespace, &crdv1alpha1.NamespaceSecurityPolicyStatus{
+			Phase:   crdv1alpha1.NamespaceSecurityPolicyFailed,
+			Message: internalNP.SyncError.Error(),
+		})
+	}
+	if internalNP.SpanMeta.NodeNames == nil {
+		c.trackRealization(key, internalNP, 0, 0, false)
+		return c.npControlInterface.UpdateNamespaceSecurityPolicyStatus(namespace, &crdv1alpha1.NamespaceSecurityPolicyStatus{
+			Phase: crdv1alpha1.NamespaceSecurityPolicyRealizing,
+		})
+	}
+
+	desiredNodes := len(internalNP.SpanMeta.NodeNames)
+	currentNodes := 0
+	failedNodes := make([]string, 0)
+	for _, status := range c.getNodeStatuses(key) {
+		if !internalNP.NodeNames.Has(status.NodeName) {
+			c.deleteNodeStatus(key, status.NodeName)
+			continue
+		}
+		if status.Generation == internalNP.Generation {
+			if !status.RealizationFailure {
+				currentNodes += 1
+			} else {
+				failedNodes = append(failedNodes, fmt.Sprintf(`"%s":"%s"`, status.NodeName, status.Message))
+			}
+		}
+	}
+	status := &crdv1alpha1.NamespaceSecurityPolicyStatus{
+		Phase:                crdv1alpha1.NamespaceSecurityPolicyRealizing,
+		CurrentNodesRealized: int32(currentNodes),
+		DesiredNodesRealized: int32(desiredNodes),
+	}
+	if currentNodes == desiredNodes {
+		status.Phase = crdv1alpha1.NamespaceSecurityPolicyEnforced
+	} else if currentNodes+len(failedNodes) == desiredNodes {
+		sort.Strings(failedNodes)
+		status.Phase = crdv1alpha1.NamespaceSecurityPolicyFailed
+		status.Message = fmt.Sprintf("Failed Nodes count %d: %s", len(failedNodes), strings.Join(failedNodes, ", "))
+		if len(status.Message) > maxConditionMessageLength {
+			status.Message = fmt.Sprintf("%s...", status.Message[:maxConditionMessageLength])
+		}
+	}
+	c.trackRealization(key, internalNP, currentNodes, desiredNodes, status.Phase == crdv1alpha1.NamespaceSecurityPolicyEnforced)
+	return c.npControlInterface.UpdateNamespaceSecurityPolicyStatus(namespace, status)
+}
+
 // networkPolicyControlInterface is an interface that knows how to update Antrea NetworkPolicy status.
 // It's created as an interface to allow testing.
 type networkPolicyControlInterface interface {
 	UpdateAntreaNetworkPolicyStatus(namespace, name string, status *crdv1beta1.NetworkPolicyStatus) error
 	UpdateAntreaClusterNetworkPolicyStatus(name string, status *crdv1beta1.NetworkPolicyStatus) error
+	UpdateNamespaceSecurityPolicyStatus(namespace string, status *crdv1alpha1.NamespaceSecurityPolicyStatus) error
 }
 
 type networkPolicyControl struct {
 	antreaClient antreaclientset.Interface
 	acnpLister   crdlisters.ClusterNetworkPolicyLister
 	annpLister   crdlisters.NetworkPolicyLister
+	nspLister    crdv1a1listers.NamespaceSecurityPolicyLister
 }
 
 func (c *networkPolicyControl) UpdateAntreaNetworkPolicyStatus(namespace, name string, status *crdv1beta1.NetworkPolicyStatus) error {
@@ -424,6 +673,38 @@ func (c *networkPolicyControl) UpdateAntreaClusterNetworkPolicyStatus(name strin
 	return updateErr
 }
 
+func (c *networkPolicyControl) UpdateNamespaceSecurityPolicyStatus(namespace string, status *crdv1alpha1.NamespaceSecurityPolicyStatus) error {
+	nsp, err := c.nspLister.NamespaceSecurityPolicies(namespace).Get(namespaceSecurityPolicyName)
+	if err != nil {
+		// The status is synced again when the NamespaceSecurityPolicy is created.
+		klog.InfoS("Didn't find the NamespaceSecurityPolicy, skip updating status", "namespace", namespace)
+		return nil
+	}
+	if nsp.Status == *status {
+		return nil
+	}
+
+	toUpdate := nsp.DeepCopy()
+
+	var updateErr, getErr error
+	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		toUpdate.Status = *status
+		klog.V(2).InfoS("Updating NamespaceSecurityPolicy", "NamespaceSecurityPolicy", klog.KObj(toUpdate))
+		_, updateErr := c.antreaClient.CrdV1alpha1().NamespaceSecurityPolicies(namespace).UpdateStatus(context.TODO(), toUpdate, v1.UpdateOptions{})
+		if updateErr != nil && errors.IsConflict(updateErr) {
+			if toUpdate, getErr = c.antreaClient.CrdV1alpha1().NamespaceSecurityPolicies(namespace).Get(context.TODO(), namespaceSecurityPolicyName, v1.GetOptions{}); getErr != nil {
+				return getErr
+			}
+		}
+		// Return the error from UPDATE.
+		return updateErr
+	}); err != nil {
+		return err
+	}
+	klog.V(2).InfoS("Updated NamespaceSecurityPolicy", "NamespaceSecurityPolicy", klog.KObj(toUpdate))
+	return updateErr
+}
+
 // GenerateNetworkPolicyCondition generates conditions based on the given error type.
 // Error of nil type means the NetworkPolicyCondition status is True.
 // Supports ErrNetworkPolicyAppliedToUnsupportedGroup error.
@@ -21,12 +21,17 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
+	clocktesting "k8s.io/utils/clock/testing"
 
 	"antrea.io/antrea/pkg/apis/controlplane"
+	crdv1alpha1 "antrea.io/antrea/pkg/apis/crd/v1alpha1"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/apiserver/storage"
 	antreaclientset "antrea.io/antrea/pkg/client/clientset/versioned"
@@ -40,6 +45,7 @@ type fakeNetworkPolicyControl struct {
 	sync.Mutex
 	annpStatus *crdv1beta1.NetworkPolicyStatus
 	acnpStatus *crdv1beta1.NetworkPolicyStatus
+	nspStatus  *crdv1alpha1.NamespaceSecurityPolicyStatus
 }
 
 func (c *fakeNetworkPolicyControl) UpdateAntreaNetworkPolicyStatus(namespace, name string, status *crdv1beta1.NetworkPolicyStatus) error {
@@ -56,6 +62,19 @@ func (c *fakeNetworkPolicyControl) UpdateAntreaClusterNetworkPolicyStatus(name s
 	return nil
 }
 
+func (c *fakeNetworkPolicyControl) UpdateNamespaceSecurityPolicyStatus(namespace string, status *crdv1alpha1.NamespaceSecurityPolicyStatus) error {
+	c.Lock()
+	defer c.Unlock()
+	c.nspStatus = status
+	return nil
+}
+
+func (c *fakeNetworkPolicyControl) getNamespaceSecurityPolicyStatus() *crdv1alpha1.NamespaceSecurityPolicyStatus {
+	c.Lock()
+	defer c.Unlock()
+	return c.nspStatus
+}
+
 func (c *fakeNetworkPolicyControl) getAntreaNetworkPolicyStatus() *crdv1beta1.NetworkPolicyStatus {
 	c.Lock()
 	defer c.Unlock()
@@ -89,6 +108,11 @@ func newTestStatusController(initialObjects ...runtime.Object) (*StatusControlle
 		statuses:                   map[string]map[string]*controlplane.NetworkPolicyNodeStatus{},
 		acnpListerSynced:           acnpInformer.Informer().HasSynced,
 		annpListerSynced:           annpInformer.Informer().HasSynced,
+		realizationTrackers:        map[string]*realizationTracker{},
+		clock:                      clocktesting.NewFakeClock(time.Now()),
+		kubeClient:                 fake.NewSimpleClientset(),
+		eventBroadcaster:           record.NewBroadcaster(),
+		eventRecorder:              record.NewFakeRecorder(100),
 	}
 	return statusController, antreaClientset, antreaInformerFactory, networkPolicyStore, networkPolicyControl
 }
@@ -312,6 +336,73 @@ func TestCreateAntreaNetworkPolicy(t *testing.T) {
 	}
 }
 
+func TestSyncNamespaceSecurityPolicyStatus(t *testing.T) {
+	ref := getNamespaceIsolationPolicyReference("ns1")
+	key := string(ref.UID)
+	tests := []struct {
+		name                         string
+		networkPolicy                *types.NetworkPolicy
+		collectedNetworkPolicyStatus []*controlplane.NetworkPolicyStatus
+		expectedStatus               *crdv1alpha1.NamespaceSecurityPolicyStatus
+	}{
+		{
+			name:           "not processed",
+			networkPolicy:  &types.NetworkPolicy{Name: key, Generation: 1, SourceRef: ref},
+			expectedStatus: &crdv1alpha1.NamespaceSecurityPolicyStatus{Phase: crdv1alpha1.NamespaceSecurityPolicyRealizing},
+		},
+		{
+			name:          "partially realized",
+			networkPolicy: newInternalNetworkPolicy(key, 1, []string{"node1", "node2"}, ref),
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus(key, "node1", 1, ""),
+			},
+			expectedStatus: &crdv1alpha1.NamespaceSecurityPolicyStatus{
+				Phase:                crdv1alpha1.NamespaceSecurityPolicyRealizing,
+				CurrentNodesRealized: 1,
+				DesiredNodesRealized: 2,
+			},
+		},
+		{
+			name:          "entirely realized",
+			networkPolicy: newInternalNetworkPolicy(key, 1, []string{"node1", "node2"}, ref),
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus(key, "node1", 1, ""),
+				newNetworkPolicyStatus(key, "node2", 1, ""),
+			},
+			expectedStatus: &crdv1alpha1.NamespaceSecurityPolicyStatus{
+				Phase:                crdv1alpha1.NamespaceSecurityPolicyEnforced,
+				CurrentNodesRealized: 2,
+				DesiredNodesRealized: 2,
+			},
+		},
+		{
+			name:          "failed realized",
+			networkPolicy: newInternalNetworkPolicy(key, 1, []string{"node1", "node2"}, ref),
+			collectedNetworkPolicyStatus: []*controlplane.NetworkPolicyStatus{
+				newNetworkPolicyStatus(key, "node1", 1, "agent failure"),
+				newNetworkPolicyStatus(key, "node2", 1, ""),
+			},
+			expectedStatus: &crdv1alpha1.NamespaceSecurityPolicyStatus{
+				Phase:                crdv1alpha1.NamespaceSecurityPolicyFailed,
+				Message:              `Failed Nodes count 1: "node1":"agent failure"`,
+				CurrentNodesRealized: 1,
+				DesiredNodesRealized: 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusController, _, _, networkPolicyStore, networkPolicyControl := newTestStatusController()
+			require.NoError(t, networkPolicyStore.Create(tt.networkPolicy))
+			for _, status := range tt.collectedNetworkPolicyStatus {
+				require.NoError(t, statusController.UpdateStatus(status))
+			}
+			require.NoError(t, statusController.syncHandler(key))
+			assert.Equal(t, tt.expectedStatus, networkPolicyControl.getNamespaceSecurityPolicyStatus())
+		})
+	}
+}
+
 func TestUpdateAntreaNetworkPolicy(t *testing.T) {
 	annp1 := newInternalNetworkPolicy("annp1", 1, []string{"node1", "node2"}, newAntreaNetworkPolicyReference("ns1", "annp1"))
 	acnp1 := newInternalNetworkPolicy("acnp1", 2, []string{"node3", "node4", "node5"}, newAntreaClusterNetworkPolicyReference("acnp1"))
@@ -392,6 +483,52 @@ func TestDeleteAntreaNetworkPolicy(t *testing.T) {
 	assert.Empty(t, statusController.getNodeStatuses(initialNetworkPolicy.Name))
 }
 
+func TestNetworkPolicyRealizationTracking(t *testing.T) {
+	statusController, _, _, networkPolicyStore, _ := newTestStatusController()
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	fakeRecorder := record.NewFakeRecorder(10)
+	statusController.clock = fakeClock
+	statusController.eventRecorder = fakeRecorder
+	statusController.realizationSLO = 10 * time.Second
+
+	acnp1 := newInternalNetworkPolicy("acnp1", 1, []string{"node1", "node2"}, newAntreaClusterNetworkPolicyReference("acnp1"))
+	networkPolicyStore.Create(acnp1)
+	assert.NoError(t, statusController.syncHandler("acnp1"))
+	tracker := statusController.realizationTrackers["acnp1"]
+	assert.Equal(t, &realizationTracker{generation: 1, startTime: fakeClock.Now()}, tracker)
+	startTime := tracker.startTime
+
+	// Generation 1 is realized on all Nodes before the SLO expires.
+	fakeClock.Step(2 * time.Second)
+	statusController.UpdateStatus(newNetworkPolicyStatus("acnp1", "node1", 1, ""))
+	statusController.UpdateStatus(newNetworkPolicyStatus("acnp1", "node2", 1, ""))
+	assert.NoError(t, statusController.syncHandler("acnp1"))
+	assert.Equal(t, &realizationTracker{generation: 1, startTime: startTime, realized: true}, statusController.realizationTrackers["acnp1"])
+
+	// Generation 2 is only realized on node1 when the SLO expires.
+	fakeClock.Step(time.Second)
+	acnp1 = newInternalNetworkPolicy("acnp1", 2, []string{"node1", "node2"}, newAntreaClusterNetworkPolicyReference("acnp1"))
+	networkPolicyStore.Update(acnp1)
+	assert.NoError(t, statusController.syncHandler("acnp1"))
+	startTime = fakeClock.Now()
+	statusController.UpdateStatus(newNetworkPolicyStatus("acnp1", "node1", 2, ""))
+	fakeClock.Step(10 * time.Second)
+	assert.NoError(t, statusController.syncHandler("acnp1"))
+	assert.Equal(t, &realizationTracker{generation: 2, startTime: startTime, sloBreached: true}, statusController.realizationTrackers["acnp1"])
+	require.Len(t, fakeRecorder.Events, 1)
+	assert.Equal(t, "Warning RealizationSLOBreached Generation 2 is not realized on all Nodes within 10s, 1/2 Nodes realized", <-fakeRecorder.Events)
+
+	// The SLO breach is reported only once per generation.
+	fakeClock.Step(10 * time.Second)
+	assert.NoError(t, statusController.syncHandler("acnp1"))
+	assert.Empty(t, fakeRecorder.Events)
+
+	// The tracker is removed with the policy.
+	networkPolicyStore.Delete("acnp1")
+	assert.NoError(t, statusController.syncHandler("acnp1"))
+	assert.NotContains(t, statusController.realizationTrackers, "acnp1")
+}
+
 // BenchmarkSyncHandler benchmarks syncHandler when the policy spans 1000 Nodes. Its current result is:
 // 70024 ns/op            8338 B/op          8 allocs/op
 func BenchmarkSyncHandler(b *testing.B) {
@@ -19,6 +19,7 @@ import (
 	"reflect"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/tools/cache"
 
@@ -44,6 +45,9 @@ type networkPolicyEvent struct {
 	// The previous version of the transferred NetworkPolicy, which will be used in Deleted events.
 	// Note that only metadata will be set in Deleted events for efficiency.
 	PrevObject *controlplane.NetworkPolicy
+	// The transferred form of the version kept on non-canary Nodes when the current version of
+	// the stored NetworkPolicy is being rolled out.
+	CurrRolloutPreviousObject *controlplane.NetworkPolicy
 	// The key of this NetworkPolicy.
 	Key             string
 	ResourceVersion uint64
@@ -54,6 +58,9 @@ type networkPolicyEvent struct {
 // 2. Modified event will be generated if the Selectors was and is interested in the object.
 // 3. Deleted event will be generated if the Selectors was interested in the object but is not now.
 func (event *networkPolicyEvent) ToWatchEvent(selectors *storage.Selectors, isInitEvent bool) *watch.Event {
+	if nodeName, found := selectors.Field.RequiresExactMatch("nodeName"); found && (isRollingOut(event.PrevPolicy) || isRollingOut(event.CurrPolicy)) {
+		return event.toNodeWatchEvent(selectors, nodeName, isInitEvent)
+	}
 	prevObjSelected, currObjSelected := isSelected(event.Key, event.PrevPolicy, event.CurrPolicy, selectors, isInitEvent)
 
 	switch {
@@ -69,6 +76,43 @@ func (event *networkPolicyEvent) ToWatchEvent(selectors *storage.Selectors, isIn
 	return nil
 }
 
+func isRollingOut(policy *types.NetworkPolicy) bool {
+	return policy != nil && policy.Rollout != nil
+}
+
+// toNodeWatchEvent converts the networkPolicyEvent to *watch.Event for a given Node when the
+// NetworkPolicy is being rolled out. Each version of the NetworkPolicy is only sent to the Nodes it
+// should be realized on, see NetworkPolicy.GetVersionForNode.
+func (event *networkPolicyEvent) toNodeWatchEvent(selectors *storage.Selectors, nodeName string, isInitEvent bool) *watch.Event {
+	if selectors.Key != "" && event.Key != selectors.Key {
+		return nil
+	}
+	var prevVersion, currVersion *types.NetworkPolicy
+	if event.PrevPolicy != nil && !isInitEvent {
+		prevVersion = event.PrevPolicy.GetVersionForNode(nodeName)
+	}
+	var currObject *controlplane.NetworkPolicy
+	if event.CurrPolicy != nil {
+		currVersion = event.CurrPolicy.GetVersionForNode(nodeName)
+		if currVersion == event.CurrPolicy {
+			currObject = event.CurrObject
+		} else if currVersion != nil {
+			currObject = event.CurrRolloutPreviousObject
+		}
+	}
+
+	switch {
+	case currVersion == nil && prevVersion == nil:
+		return nil
+	case currVersion != nil && prevVersion == nil:
+		return &watch.Event{Type: watch.Added, Object: currObject}
+	case currVersion != nil && prevVersion != nil:
+		return &watch.Event{Type: watch.Modified, Object: currObject}
+	default:
+		return &watch.Event{Type: watch.Deleted, Object: event.PrevObject}
+	}
+}
+
 func (event *networkPolicyEvent) GetResourceVersion() uint64 {
 	return event.ResourceVersion
 }
@@ -94,6 +138,10 @@ func genNetworkPolicyEvent(key string, prevObj, currObj interface{}, rv uint64)
 		event.CurrPolicy = currObj.(*types.NetworkPolicy)
 		event.CurrObject = new(controlplane.NetworkPolicy)
 		ToNetworkPolicyMsg(event.CurrPolicy, event.CurrObject, true)
+		if event.CurrPolicy.Rollout != nil && event.CurrPolicy.Rollout.PreviousVersion != nil {
+			event.CurrRolloutPreviousObject = new(controlplane.NetworkPolicy)
+			ToNetworkPolicyMsg(event.CurrPolicy.Rollout.PreviousVersion, event.CurrRolloutPreviousObject, true)
+		}
 	}
 
 	return event, nil
@@ -142,6 +190,10 @@ func NewNetworkPolicyStore() storage.Interface {
 			if !ok {
 				return []string{}, nil
 			}
+			// The groups of the previous version must be kept during a rollout.
+			if fp.Rollout != nil && fp.Rollout.PreviousVersion != nil {
+				return sets.List(fp.GetAppliedToGroups()), nil
+			}
 			if len(fp.AppliedToGroups) == 0 {
 				return []string{}, nil
 			}
@@ -153,6 +205,9 @@ func NewNetworkPolicyStore() storage.Interface {
 			if !ok {
 				return []string{}, nil
 			}
+			if fp.Rollout != nil && fp.Rollout.PreviousVersion != nil {
+				return sets.List(fp.GetAddressGroups()), nil
+			}
 			if len(fp.Rules) == 0 {
 				return []string{}, nil
 			}
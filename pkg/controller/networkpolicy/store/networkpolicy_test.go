@@ -74,6 +74,17 @@ func TestWatchNetworkPolicyEvent(t *testing.T) {
 		}},
 		AppliedToGroups: []string{"appliedToGroup1"},
 	}
+	// policyV3 is rolled out to node1 first, while node2 keeps policyV1.
+	policyV1OnNode2 := *policyV1
+	policyV1OnNode2.NodeNames = sets.New[string]("node2")
+	policyV3RollingOut := *policyV3
+	policyV3RollingOut.NodeNames = sets.New[string]("node1", "node2")
+	policyV3RollingOut.Rollout = &types.NetworkPolicyRollout{
+		CanaryNodeNames: sets.New[string]("node1"),
+		PreviousVersion: &policyV1OnNode2,
+	}
+	policyV3RolledOut := *policyV3
+	policyV3RolledOut.NodeNames = sets.New[string]("node1", "node2")
 
 	testCases := map[string]struct {
 		fieldSelector fields.Selector
@@ -138,6 +149,69 @@ func TestWatchNetworkPolicyEvent(t *testing.T) {
 				}},
 			},
 		},
+		"node-scoped-watcher-canary-node": {
+			fieldSelector: fields.SelectorFromSet(fields.Set{"nodeName": "node1"}),
+			operations: func(store storage.Interface) {
+				store.Create(policyV1)
+				// This should be seen as a modified event as node1 is a canary Node.
+				store.Update(&policyV3RollingOut)
+				// This should be seen as a modified event although nothing changes for node1.
+				store.Update(&policyV3RolledOut)
+			},
+			expected: []watch.Event{
+				{Type: watch.Bookmark, Object: &controlplane.NetworkPolicy{}},
+				{Type: watch.Added, Object: &controlplane.NetworkPolicy{
+					ObjectMeta:      metav1.ObjectMeta{Name: "bar"},
+					SourceRef:       &npRef,
+					Rules:           policyV1.Rules,
+					AppliedToGroups: policyV1.AppliedToGroups,
+				}},
+				{Type: watch.Modified, Object: &controlplane.NetworkPolicy{
+					ObjectMeta:      metav1.ObjectMeta{Name: "bar"},
+					SourceRef:       &npRef,
+					Rules:           policyV3.Rules,
+					AppliedToGroups: policyV3.AppliedToGroups,
+				}},
+				{Type: watch.Modified, Object: &controlplane.NetworkPolicy{
+					ObjectMeta:      metav1.ObjectMeta{Name: "bar"},
+					SourceRef:       &npRef,
+					Rules:           policyV3.Rules,
+					AppliedToGroups: policyV3.AppliedToGroups,
+				}},
+			},
+		},
+		"node-scoped-watcher-non-canary-node": {
+			fieldSelector: fields.SelectorFromSet(fields.Set{"nodeName": "node2"}),
+			operations: func(store storage.Interface) {
+				store.Create(policyV1)
+				// This should be seen as a modified event carrying the previous version as node2
+				// is not a canary Node.
+				store.Update(&policyV3RollingOut)
+				// This should be seen as a modified event as the rollout completes.
+				store.Update(&policyV3RolledOut)
+			},
+			expected: []watch.Event{
+				{Type: watch.Bookmark, Object: &controlplane.NetworkPolicy{}},
+				{Type: watch.Added, Object: &controlplane.NetworkPolicy{
+					ObjectMeta:      metav1.ObjectMeta{Name: "bar"},
+					SourceRef:       &npRef,
+					Rules:           policyV1.Rules,
+					AppliedToGroups: policyV1.AppliedToGroups,
+				}},
+				{Type: watch.Modified, Object: &controlplane.NetworkPolicy{
+					ObjectMeta:      metav1.ObjectMeta{Name: "bar"},
+					SourceRef:       &npRef,
+					Rules:           policyV1.Rules,
+					AppliedToGroups: policyV1.AppliedToGroups,
+				}},
+				{Type: watch.Modified, Object: &controlplane.NetworkPolicy{
+					ObjectMeta:      metav1.ObjectMeta{Name: "bar"},
+					SourceRef:       &npRef,
+					Rules:           policyV3.Rules,
+					AppliedToGroups: policyV3.AppliedToGroups,
+				}},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 		t.Run(name, func(t *testing.T) {
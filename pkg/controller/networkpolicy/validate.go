@@ -20,12 +20,14 @@ import (
 	"net"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
 	admv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -518,6 +520,9 @@ func GetAdmissionResponseForErr(err error) *admv1.AdmissionResponse {
 
 // createValidate validates the CREATE events of Antrea-native policies,
 func (v *antreaPolicyValidator) createValidate(curObj interface{}, userInfo authenticationv1.UserInfo) ([]string, string, bool) {
+	if reason, allowed := v.validateBreakGlass(curObj, nil, userInfo); !allowed {
+		return nil, reason, allowed
+	}
 	return v.validatePolicy(curObj)
 }
 
@@ -539,7 +544,11 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 		egress = curObj.Spec.Egress
 		specAppliedTo = curObj.Spec.AppliedTo
 	}
-	reason, allowed := v.validateTierForPolicy(tier)
+	reason, allowed := validateRolloutStrategy(curObj)
+	if !allowed {
+		return warnings, reason, allowed
+	}
+	reason, allowed = v.validateTierForPolicy(tier)
 	if !allowed {
 		return warnings, reason, allowed
 	}
@@ -578,10 +587,17 @@ func (v *antreaPolicyValidator) validatePolicy(curObj interface{}) ([]string, st
 	if !allowed {
 		return warnings, reason, allowed
 	}
+	reason, allowed = v.validateNodeTrafficTypes(specAppliedTo, ingress, egress)
+	if !allowed {
+		return warnings, reason, allowed
+	}
 	if err := v.validatePort(ingress, egress); err != nil {
 		return warnings, err.Error(), false
 	}
 	warnings = append(warnings, v.checkLogLabel(specAppliedTo, ingress, egress)...)
+	if acnp, ok := curObj.(*crdv1beta1.ClusterNetworkPolicy); ok {
+		warnings = append(warnings, v.checkACNPExemption(acnp)...)
+	}
 	return warnings, "", true
 }
 
@@ -947,22 +963,80 @@ func (v *antreaPolicyValidator) validateL7Protocols(ingressRules, egressRules []
 		if len(r.ToServices) != 0 {
 			return "layer 7 protocols can not be used with toServices", false
 		}
-		haveHTTP := false
+		haveHTTP, haveTLS, haveKafka := false, false, false
 		for _, p := range r.L7Protocols {
 			if p.HTTP != nil {
 				haveHTTP = true
 			}
+			if p.TLS != nil {
+				haveTLS = true
+			}
+			if p.Kafka != nil {
+				haveKafka = true
+			}
+		}
+		// The L7 engine rejects the Kafka requests which are not allowed instead of allowing the connections, so the
+		// connections of other protocols would be rejected.
+		if haveKafka && (haveHTTP || haveTLS) {
+			return "Kafka protocol can not be used with other layer 7 protocols", false
 		}
 		for _, port := range r.Ports {
-			if haveHTTP && (port.Protocol != nil && *port.Protocol != v1.ProtocolTCP) {
-				return "HTTP protocol can only be used when layer 4 protocol is TCP or unset", false
+			if port.Protocol != nil && *port.Protocol != v1.ProtocolTCP {
+				if haveHTTP {
+					return "HTTP protocol can only be used when layer 4 protocol is TCP or unset", false
+				}
+				if haveKafka {
+					return "Kafka protocol can only be used when layer 4 protocol is TCP or unset", false
+				}
 			}
 		}
 		for _, protocol := range r.Protocols {
-			if haveHTTP && (protocol.IGMP != nil || protocol.ICMP != nil) {
-				return "HTTP protocol can not be used with protocol IGMP or ICMP", false
+			if protocol.IGMP != nil || protocol.ICMP != nil {
+				if haveHTTP {
+					return "HTTP protocol can not be used with protocol IGMP or ICMP", false
+				}
+				if haveKafka {
+					return "Kafka protocol can not be used with protocol IGMP or ICMP", false
+				}
+			}
+		}
+	}
+	return "", true
+}
+
+// validateNodeTrafficTypes validates the nodeTrafficTypes field set in Antrea-native policy rules. It can only be
+// set for ingress rules applied to Nodes, and each traffic type can only be listed once.
+func (v *antreaPolicyValidator) validateNodeTrafficTypes(specAppliedTo []crdv1beta1.AppliedTo, ingressRules, egressRules []crdv1beta1.Rule) (string, bool) {
+	for _, r := range egressRules {
+		if len(r.NodeTrafficTypes) > 0 {
+			return "nodeTrafficTypes can only be set for ingress rules", false
+		}
+	}
+	for _, r := range ingressRules {
+		if len(r.NodeTrafficTypes) == 0 {
+			continue
+		}
+		appliedTo := specAppliedTo
+		if len(r.AppliedTo) > 0 {
+			appliedTo = r.AppliedTo
+		}
+		for _, at := range appliedTo {
+			if at.NodeSelector == nil {
+				return "nodeTrafficTypes can only be set for rules applied to Nodes", false
 			}
 		}
+		trafficTypes := sets.New[crdv1beta1.NodeTrafficType]()
+		for _, t := range r.NodeTrafficTypes {
+			switch t {
+			case crdv1beta1.NodeTrafficTypeNodeOS, crdv1beta1.NodeTrafficTypeHostNetworkPod, crdv1beta1.NodeTrafficTypeHostPort:
+			default:
+				return fmt.Sprintf("invalid nodeTrafficType %s", t), false
+			}
+			if trafficTypes.Has(t) {
+				return fmt.Sprintf("duplicate nodeTrafficType %s", t), false
+			}
+			trafficTypes.Insert(t)
+		}
 	}
 	return "", true
 }
@@ -981,9 +1055,59 @@ func (v *antreaPolicyValidator) validateFQDNSelectors(egressRules []crdv1beta1.R
 
 // updateValidate validates the UPDATE events of Antrea-native policies.
 func (v *antreaPolicyValidator) updateValidate(curObj, oldObj interface{}, userInfo authenticationv1.UserInfo) ([]string, string, bool) {
+	// The rollout strategy and break-glass are configured with annotations, so they must be validated even if the spec
+	// is unchanged.
+	if reason, allowed := validateRolloutStrategy(curObj); !allowed {
+		return nil, reason, allowed
+	}
+	if reason, allowed := v.validateBreakGlass(curObj, oldObj, userInfo); !allowed {
+		return nil, reason, allowed
+	}
+	if specUnchanged(curObj, oldObj) {
+		return nil, "", true
+	}
 	return v.validatePolicy(curObj)
 }
 
+// validateRolloutStrategy validates the rollout annotations of ClusterNetworkPolicies.
+func validateRolloutStrategy(curObj interface{}) (string, bool) {
+	acnp, ok := curObj.(*crdv1beta1.ClusterNetworkPolicy)
+	if !ok {
+		return "", true
+	}
+	if _, err := getRolloutStrategy(acnp.Annotations); err != nil {
+		return err.Error(), false
+	}
+	return "", true
+}
+
+// specUnchanged returns whether an update doesn't change the spec of a policy or a Group. As the spec of the existing
+// object has already been validated, it doesn't need to be validated again. This is common when many policies and
+// Groups are re-applied at once, e.g. during GitOps syncs, with only their metadata changed.
+func specUnchanged(curObj, oldObj interface{}) bool {
+	switch curObj := curObj.(type) {
+	case *crdv1beta1.ClusterNetworkPolicy:
+		oldObj, ok := oldObj.(*crdv1beta1.ClusterNetworkPolicy)
+		return ok && oldObj != nil && apiequality.Semantic.DeepEqual(curObj.Spec, oldObj.Spec)
+	case *crdv1beta1.NetworkPolicy:
+		oldObj, ok := oldObj.(*crdv1beta1.NetworkPolicy)
+		return ok && oldObj != nil && apiequality.Semantic.DeepEqual(curObj.Spec, oldObj.Spec)
+	case *v1alpha1.AdminNetworkPolicy:
+		oldObj, ok := oldObj.(*v1alpha1.AdminNetworkPolicy)
+		return ok && oldObj != nil && apiequality.Semantic.DeepEqual(curObj.Spec, oldObj.Spec)
+	case *v1alpha1.BaselineAdminNetworkPolicy:
+		oldObj, ok := oldObj.(*v1alpha1.BaselineAdminNetworkPolicy)
+		return ok && oldObj != nil && apiequality.Semantic.DeepEqual(curObj.Spec, oldObj.Spec)
+	case *crdv1beta1.ClusterGroup:
+		oldObj, ok := oldObj.(*crdv1beta1.ClusterGroup)
+		return ok && oldObj != nil && apiequality.Semantic.DeepEqual(curObj.Spec, oldObj.Spec)
+	case *crdv1beta1.Group:
+		oldObj, ok := oldObj.(*crdv1beta1.Group)
+		return ok && oldObj != nil && apiequality.Semantic.DeepEqual(curObj.Spec, oldObj.Spec)
+	}
+	return false
+}
+
 // deleteValidate validates the DELETE events of Antrea-native policies.
 func (v *antreaPolicyValidator) deleteValidate(oldObj interface{}, userInfo authenticationv1.UserInfo) (string, bool) {
 	return "", true
@@ -991,7 +1115,9 @@ func (v *antreaPolicyValidator) deleteValidate(oldObj interface{}, userInfo auth
 
 // createValidate validates the CREATE events of Tier resources.
 func (t *tierValidator) createValidate(curObj interface{}, userInfo authenticationv1.UserInfo) ([]string, string, bool) {
-	if len(t.networkPolicyController.tierInformer.Informer().GetIndexer().ListIndexFuncValues(PriorityIndex)) >= maxSupportedTiers {
+	// The priorities of the existing Tiers are retrieved from the index once, and used for both checks below.
+	existingPriorities := t.networkPolicyController.tierInformer.Informer().GetIndexer().ListIndexFuncValues(PriorityIndex)
+	if len(existingPriorities) >= maxSupportedTiers {
 		return nil, fmt.Sprintf("maximum number of Tiers supported: %d", maxSupportedTiers), false
 	}
 	curTier := curObj.(*crdv1beta1.Tier)
@@ -1000,8 +1126,7 @@ func (t *tierValidator) createValidate(curObj interface{}, userInfo authenticati
 		return nil, fmt.Sprintf("tier %s priority %d is reserved", curTier.Name, curTier.Spec.Priority), false
 	}
 	// Tier priority must not overlap existing tier's priority
-	trs, err := t.networkPolicyController.tierInformer.Informer().GetIndexer().ByIndex(PriorityIndex, strconv.FormatInt(int64(curTier.Spec.Priority), 10))
-	if err != nil || len(trs) > 0 {
+	if slices.Contains(existingPriorities, strconv.FormatInt(int64(curTier.Spec.Priority), 10)) {
 		return nil, fmt.Sprintf("tier %s priority %d overlaps with existing Tier", curTier.Name, curTier.Spec.Priority), false
 	}
 	return nil, "", true
@@ -1032,12 +1157,12 @@ func (t *tierValidator) deleteValidate(oldObj interface{}, userInfo authenticati
 	if reservedTierNames.Has(oldTier.Name) {
 		return fmt.Sprintf("cannot delete reserved tier %s", oldTier.Name), false
 	}
-	// Tier with existing ACNPs/ANNPs cannot be deleted.
-	acnps, err := t.networkPolicyController.acnpInformer.Informer().GetIndexer().ByIndex(TierIndex, oldTier.Name)
+	// Tier with existing ACNPs/ANNPs cannot be deleted. Only the keys of the policies are needed to count them.
+	acnps, err := t.networkPolicyController.acnpInformer.Informer().GetIndexer().IndexKeys(TierIndex, oldTier.Name)
 	if err != nil || len(acnps) > 0 {
 		return fmt.Sprintf("tier %s is referenced by %d Antrea ClusterNetworkPolicies", oldTier.Name, len(acnps)), false
 	}
-	annps, err := t.networkPolicyController.annpInformer.Informer().GetIndexer().ByIndex(TierIndex, oldTier.Name)
+	annps, err := t.networkPolicyController.annpInformer.Informer().GetIndexer().IndexKeys(TierIndex, oldTier.Name)
 	if err != nil || len(annps) > 0 {
 		return fmt.Sprintf("tier %s is referenced by %d Antrea NetworkPolicies", oldTier.Name, len(annps)), false
 	}
@@ -1177,6 +1302,9 @@ func (g *groupValidator) createValidate(curObj interface{}, userInfo authenticat
 
 // updateValidate validates the UPDATE events of Group, ClusterGroup resources.
 func (g *groupValidator) updateValidate(curObj, oldObj interface{}, userInfo authenticationv1.UserInfo) ([]string, string, bool) {
+	if specUnchanged(curObj, oldObj) {
+		return nil, "", true
+	}
 	return g.validateGroup(curObj)
 }
 
@@ -1198,16 +1326,57 @@ func (g *groupValidator) deleteValidate(oldObj interface{}, userInfo authenticat
 	return "", true
 }
 
+// validateAdminNPPeers ensures that the label keys of the sameLabels and notSameLabels rules in
+// the AdminNetworkPolicyPeers are valid.
+func validateAdminNPPeers(peers []v1alpha1.AdminNetworkPolicyPeer) (string, bool) {
+	for _, peer := range peers {
+		var namespaces *v1alpha1.NamespacedPeer
+		if peer.Namespaces != nil {
+			namespaces = peer.Namespaces
+		} else if peer.Pods != nil {
+			namespaces = &peer.Pods.Namespaces
+		}
+		if namespaces == nil {
+			continue
+		}
+		for _, k := range namespaces.SameLabels {
+			if err := validation.IsQualifiedName(k); err != nil {
+				return fmt.Sprintf("Invalid label key in sameLabels rule: %s", k), false
+			}
+		}
+		for _, k := range namespaces.NotSameLabels {
+			if err := validation.IsQualifiedName(k); err != nil {
+				return fmt.Sprintf("Invalid label key in notSameLabels rule: %s", k), false
+			}
+		}
+	}
+	return "", true
+}
+
 func (a *adminPolicyValidator) validateAdminNP(anp *v1alpha1.AdminNetworkPolicy) (string, bool) {
-	if anpHasNamespaceLabelRule(anp) {
-		return "SameLabels and NotSameLabels namespace selection are not yet supported by Antrea", false
+	for _, rule := range anp.Spec.Ingress {
+		if reason, allowed := validateAdminNPPeers(rule.From); !allowed {
+			return reason, allowed
+		}
+	}
+	for _, rule := range anp.Spec.Egress {
+		if reason, allowed := validateAdminNPPeers(rule.To); !allowed {
+			return reason, allowed
+		}
 	}
 	return "", true
 }
 
 func (a *adminPolicyValidator) validateBANP(banp *v1alpha1.BaselineAdminNetworkPolicy) (string, bool) {
-	if banpHasNamespaceLabelRule(banp) {
-		return "SameLabels and NotSameLabels namespace selection are not yet supported by Antrea", false
+	for _, rule := range banp.Spec.Ingress {
+		if reason, allowed := validateAdminNPPeers(rule.From); !allowed {
+			return reason, allowed
+		}
+	}
+	for _, rule := range banp.Spec.Egress {
+		if reason, allowed := validateAdminNPPeers(rule.To); !allowed {
+			return reason, allowed
+		}
 	}
 	return "", true
 }
@@ -1225,6 +1394,9 @@ func (a *adminPolicyValidator) createValidate(curObj interface{}, userInfo authe
 }
 
 func (a *adminPolicyValidator) updateValidate(curObj, oldObj interface{}, userInfo authenticationv1.UserInfo) ([]string, string, bool) {
+	if specUnchanged(curObj, oldObj) {
+		return nil, "", true
+	}
 	return a.createValidate(curObj, userInfo)
 }
 
@@ -26,6 +26,7 @@ import (
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
 	"sigs.k8s.io/network-policy-api/apis/v1alpha1"
 
+	"antrea.io/antrea/pkg/apis"
 	crdv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/features"
 )
@@ -526,6 +527,106 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "",
 		},
+		{
+			name: "acnp-appliedto-node-with-node-traffic-types",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-appliedto-node-with-node-traffic-types",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo2": "bar2"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:           &allowAction,
+							NodeTrafficTypes: []crdv1beta1.NodeTrafficType{crdv1beta1.NodeTrafficTypeHostNetworkPod, crdv1beta1.NodeTrafficTypeHostPort},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "",
+		},
+		{
+			name: "acnp-node-traffic-types-in-egress-rule",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-node-traffic-types-in-egress-rule",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo2": "bar2"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action:           &allowAction,
+							NodeTrafficTypes: []crdv1beta1.NodeTrafficType{crdv1beta1.NodeTrafficTypeNodeOS},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "nodeTrafficTypes can only be set for ingress rules",
+		},
+		{
+			name: "acnp-node-traffic-types-appliedto-pod",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-node-traffic-types-appliedto-pod",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:           &allowAction,
+							NodeTrafficTypes: []crdv1beta1.NodeTrafficType{crdv1beta1.NodeTrafficTypeNodeOS},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "nodeTrafficTypes can only be set for rules applied to Nodes",
+		},
+		{
+			name: "acnp-duplicate-node-traffic-types",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-duplicate-node-traffic-types",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NodeSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo2": "bar2"},
+							},
+						},
+					},
+					Ingress: []crdv1beta1.Rule{
+						{
+							Action:           &allowAction,
+							NodeTrafficTypes: []crdv1beta1.NodeTrafficType{crdv1beta1.NodeTrafficTypeHostPort, crdv1beta1.NodeTrafficTypeHostPort},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "duplicate nodeTrafficType HostPort",
+		},
 		{
 			name: "acnp-appliedto-node-with-loglabel",
 			policy: &crdv1beta1.ClusterNetworkPolicy{
@@ -1763,6 +1864,79 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "HTTP protocol can not be used with protocol IGMP or ICMP",
 		},
+		{
+			name:         "acnp-l7protocols-Kafka-used-with-UDP",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							Ports: []crdv1beta1.NetworkPolicyPort{
+								{
+									Protocol: &k8sProtocolUDP,
+								},
+							},
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										Role:  "produce",
+										Topic: "orders",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "Kafka protocol can only be used when layer 4 protocol is TCP or unset",
+		},
+		{
+			name:         "acnp-kafka-used-with-http",
+			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "egress-rule-l7protocols",
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							NamespaceSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo1": "bar1"},
+							},
+						},
+					},
+					Egress: []crdv1beta1.Rule{
+						{
+							Action: &allowAction,
+							L7Protocols: []crdv1beta1.L7Protocol{
+								{
+									Kafka: &crdv1beta1.KafkaProtocol{
+										Role: "consume",
+									},
+								},
+								{
+									HTTP: &crdv1beta1.HTTPProtocol{},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "Kafka protocol can not be used with other layer 7 protocols",
+		},
 		{
 			name:         "acnp-l7protocols-used-with-toService",
 			featureGates: map[featuregate.Feature]bool{features.L7NetworkPolicy: true},
@@ -1924,6 +2098,29 @@ func TestValidateAntreaClusterNetworkPolicy(t *testing.T) {
 			operation:      admv1.Create,
 			expectedReason: "protocol IGMP does not support Pass or Reject",
 		},
+		{
+			name: "acnp-invalid-rollout-observation-period",
+			policy: &crdv1beta1.ClusterNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "acnp-invalid-rollout-observation-period",
+					Annotations: map[string]string{
+						apis.RolloutCanaryNodeSelectorAnnotationKey: "canary=true",
+						apis.RolloutObservationPeriodAnnotationKey:  "5 minutes",
+					},
+				},
+				Spec: crdv1beta1.ClusterNetworkPolicySpec{
+					AppliedTo: []crdv1beta1.AppliedTo{
+						{
+							PodSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{"foo": "bar"},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "invalid value for annotation networkpolicy.antrea.io/rollout-observation-period: 5 minutes",
+		},
 		// Update use same validate function as create. Only provide one update case here.
 		{
 			name: "acnp-non-existent-tier",
@@ -2072,6 +2269,71 @@ func TestValidateAntreaNetworkPolicy(t *testing.T) {
 	}
 }
 
+func TestValidateAntreaPolicyUnchangedSpec(t *testing.T) {
+	oldPolicy := &crdv1beta1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "non-existent-tier",
+			Namespace: "x",
+		},
+		Spec: crdv1beta1.NetworkPolicySpec{
+			AppliedTo: []crdv1beta1.AppliedTo{
+				{
+					PodSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"foo": "bar"},
+					},
+				},
+			},
+			Tier: "non-existent-tier",
+		},
+	}
+	_, controller := newController(nil, nil)
+	validator := NewNetworkPolicyValidator(controller.NetworkPolicyController)
+
+	// Updates that only change the metadata of a policy are allowed without validating the spec again.
+	curPolicy := oldPolicy.DeepCopy()
+	curPolicy.Labels = map[string]string{"app": "gitops"}
+	_, actualReason, allowed := validator.validateAntreaPolicy(curPolicy, oldPolicy, admv1.Update, authenticationv1.UserInfo{})
+	assert.Empty(t, actualReason)
+	assert.True(t, allowed)
+
+	// Updates that change the spec are validated.
+	curPolicy.Spec.Priority = 10
+	_, actualReason, allowed = validator.validateAntreaPolicy(curPolicy, oldPolicy, admv1.Update, authenticationv1.UserInfo{})
+	assert.Equal(t, "tier non-existent-tier does not exist", actualReason)
+	assert.False(t, allowed)
+}
+
+func TestValidateAntreaGroupUnchangedSpec(t *testing.T) {
+	oldCG := &crdv1beta1.ClusterGroup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cg-two-fields-set",
+		},
+		Spec: crdv1beta1.GroupSpec{
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"foo": "bar"},
+			},
+			ExternalEntitySelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"foo": "bar"},
+			},
+		},
+	}
+	_, controller := newController(nil, nil)
+	validator := NewNetworkPolicyValidator(controller.NetworkPolicyController)
+
+	// Updates that only change the metadata of a ClusterGroup are allowed without validating the spec again.
+	curCG := oldCG.DeepCopy()
+	curCG.Labels = map[string]string{"app": "gitops"}
+	_, actualReason, allowed := validator.validateAntreaGroup(curCG, oldCG, admv1.Update, authenticationv1.UserInfo{})
+	assert.Empty(t, actualReason)
+	assert.True(t, allowed)
+
+	// Updates that change the spec are validated.
+	curCG.Spec.PodSelector.MatchLabels["foo"] = "baz"
+	_, actualReason, allowed = validator.validateAntreaGroup(curCG, oldCG, admv1.Update, authenticationv1.UserInfo{})
+	assert.Equal(t, "At most one of podSelector, externalEntitySelector, serviceReference, ipBlock, ipBlocks or childGroups can be set for a ClusterGroup", actualReason)
+	assert.False(t, allowed)
+}
+
 func TestValidateAntreaClusterGroup(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -2919,7 +3181,7 @@ func TestValidateAdminNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "SameLabels and NotSameLabels namespace selection are not yet supported by Antrea",
+			expectedReason: "",
 		},
 		{
 			name: "anp-update-to-same-labels-rule",
@@ -2945,7 +3207,7 @@ func TestValidateAdminNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Update,
-			expectedReason: "SameLabels and NotSameLabels namespace selection are not yet supported by Antrea",
+			expectedReason: "",
 		},
 		{
 			name: "anp-has-not-same-labels-rule",
@@ -2971,7 +3233,7 @@ func TestValidateAdminNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "SameLabels and NotSameLabels namespace selection are not yet supported by Antrea",
+			expectedReason: "",
 		},
 		{
 			name: "banp-has-same-labels-rule",
@@ -3011,7 +3273,7 @@ func TestValidateAdminNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "SameLabels and NotSameLabels namespace selection are not yet supported by Antrea",
+			expectedReason: "",
 		},
 		{
 			name: "banp-update-to-same-labels-rule",
@@ -3036,7 +3298,7 @@ func TestValidateAdminNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Update,
-			expectedReason: "SameLabels and NotSameLabels namespace selection are not yet supported by Antrea",
+			expectedReason: "",
 		},
 		{
 			name: "banp-has-not-same-labels-rule",
@@ -3061,7 +3323,61 @@ func TestValidateAdminNetworkPolicy(t *testing.T) {
 				},
 			},
 			operation:      admv1.Create,
-			expectedReason: "SameLabels and NotSameLabels namespace selection are not yet supported by Antrea",
+			expectedReason: "",
+		},
+		{
+			name: "anp-has-invalid-same-labels-key",
+			policy: &v1alpha1.AdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anpA", UID: "uidA"},
+				Spec: v1alpha1.AdminNetworkPolicySpec{
+					Subject: v1alpha1.AdminNetworkPolicySubject{
+						Namespaces: &selectorA,
+					},
+					Priority: 10,
+					Egress: []v1alpha1.AdminNetworkPolicyEgressRule{
+						{
+							Action: v1alpha1.AdminNetworkPolicyRuleActionAllow,
+							To: []v1alpha1.AdminNetworkPolicyPeer{
+								{
+									Pods: &v1alpha1.NamespacedPodPeer{
+										Namespaces: v1alpha1.NamespacedPeer{
+											SameLabels: []string{"&illegalKey"},
+										},
+										PodSelector: selectorB,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "Invalid label key in sameLabels rule: &illegalKey",
+		},
+		{
+			name: "banp-has-invalid-not-same-labels-key",
+			policy: &v1alpha1.BaselineAdminNetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "anpA", UID: "uidA"},
+				Spec: v1alpha1.BaselineAdminNetworkPolicySpec{
+					Subject: v1alpha1.AdminNetworkPolicySubject{
+						Namespaces: &selectorA,
+					},
+					Ingress: []v1alpha1.BaselineAdminNetworkPolicyIngressRule{
+						{
+							Action: v1alpha1.BaselineAdminNetworkPolicyRuleActionAllow,
+							From: []v1alpha1.AdminNetworkPolicyPeer{
+								{
+									Namespaces: &v1alpha1.NamespacedPeer{
+										NotSameLabels: []string{"labelA", "&illegalKey"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			operation:      admv1.Create,
+			expectedReason: "Invalid label key in notSameLabels rule: &illegalKey",
 		},
 	}
 	for _, tt := range tests {
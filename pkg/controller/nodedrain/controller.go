@@ -0,0 +1,188 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodedrain
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coreinformers "k8s.io/client-go/informers/core/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/apis"
+)
+
+const (
+	controllerName = "NodeDrainController"
+	// Set resyncPeriod to 0 to disable resyncing.
+	resyncPeriod time.Duration = 0
+	// How long to wait before retrying the processing of a Node change.
+	minRetryDelay = 5 * time.Second
+	maxRetryDelay = 300 * time.Second
+	// Default number of workers processing a Node change.
+	defaultWorkers = 4
+)
+
+// NodeDrainController marks the Nodes which are cordoned with the node.antrea.io/draining annotation, and removes the
+// annotation when they are uncordoned. antrea-agent moves the Egress IPs and the Service external IPs off the marked
+// Nodes as soon as the annotation is set, instead of waiting for the Nodes to be detected as failed by memberlist once
+// their antrea-agent is shut down.
+type NodeDrainController struct {
+	client           clientset.Interface
+	nodeLister       corelisters.NodeLister
+	nodeListerSynced cache.InformerSynced
+	// queue maintains the Node names that need to be synced.
+	queue workqueue.TypedRateLimitingInterface[string]
+}
+
+func NewNodeDrainController(client clientset.Interface, nodeInformer coreinformers.NodeInformer) *NodeDrainController {
+	c := &NodeDrainController{
+		client:           client,
+		nodeLister:       nodeInformer.Lister(),
+		nodeListerSynced: nodeInformer.Informer().HasSynced,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[string](minRetryDelay, maxRetryDelay),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "nodeDrain",
+			},
+		),
+	}
+	nodeInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.FilteringResourceEventHandler{
+			FilterFunc: func(obj interface{}) bool {
+				node, ok := obj.(*corev1.Node)
+				return ok && nodeRequiresSync(node)
+			},
+			Handler: cache.ResourceEventHandlerFuncs{
+				AddFunc: c.enqueueNode,
+				UpdateFunc: func(_, obj interface{}) {
+					c.enqueueNode(obj)
+				},
+			},
+		},
+		resyncPeriod,
+	)
+	return c
+}
+
+// isNodeDraining returns whether the Node is cordoned, which is the first step of draining a Node.
+func isNodeDraining(node *corev1.Node) bool {
+	return node.Spec.Unschedulable
+}
+
+func hasDrainingAnnotation(node *corev1.Node) bool {
+	_, exists := node.Annotations[apis.NodeDrainingAnnotationKey]
+	return exists
+}
+
+// nodeRequiresSync returns whether the annotation of the Node doesn't match its draining state.
+func nodeRequiresSync(node *corev1.Node) bool {
+	return isNodeDraining(node) != hasDrainingAnnotation(node)
+}
+
+func (c *NodeDrainController) enqueueNode(obj interface{}) {
+	node := obj.(*corev1.Node)
+	c.queue.Add(node.Name)
+}
+
+// Run will create defaultWorkers workers (go routines) which will process the Node events from the workqueue.
+func (c *NodeDrainController) Run(stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.InfoS("Starting controller", "controller", controllerName)
+	defer klog.InfoS("Shutting down controller", "controller", controllerName)
+
+	if !cache.WaitForNamedCacheSync(controllerName, stopCh, c.nodeListerSynced) {
+		return
+	}
+
+	for i := 0; i < defaultWorkers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+	<-stopCh
+}
+
+// worker is a long-running function that will continually call the processNextWorkItem function in
+// order to read and process a message on the workqueue.
+func (c *NodeDrainController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *NodeDrainController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+	if err := c.syncNode(key); err == nil {
+		// If no error occurs we Forget this item so it does not get queued again until
+		// another change happens.
+		c.queue.Forget(key)
+	} else {
+		// Put the item back on the workqueue to handle any transient errors.
+		c.queue.AddRateLimited(key)
+		klog.ErrorS(err, "Error syncing Node, requeuing", "node", key)
+	}
+	return true
+}
+
+func (c *NodeDrainController) syncNode(nodeName string) error {
+	node, err := c.nodeLister.Get(nodeName)
+	if err != nil {
+		if apimachineryerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !nodeRequiresSync(node) {
+		return nil
+	}
+	// A null value removes the annotation with a merge patch.
+	var value interface{}
+	if isNodeDraining(node) {
+		value = "true"
+	}
+	patch, _ := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{
+				apis.NodeDrainingAnnotationKey: value,
+			},
+		},
+	})
+	if _, err := c.client.CoreV1().Nodes().Patch(context.TODO(), node.Name, apimachinerytypes.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		if apimachineryerrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("error when updating draining annotation of Node %s: %w", node.Name, err)
+	}
+	if value != nil {
+		klog.InfoS("Node is cordoned, marked it as draining", "node", node.Name)
+	} else {
+		klog.InfoS("Node is uncordoned, unmarked it as draining", "node", node.Name)
+	}
+	return nil
+}
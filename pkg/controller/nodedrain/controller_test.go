@@ -0,0 +1,101 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodedrain
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/apis"
+)
+
+type fakeController struct {
+	*NodeDrainController
+	client          kubernetes.Interface
+	informerFactory informers.SharedInformerFactory
+}
+
+func newController(objects ...runtime.Object) *fakeController {
+	client := fake.NewSimpleClientset(objects...)
+	informerFactory := informers.NewSharedInformerFactory(client, resyncPeriod)
+	controller := NewNodeDrainController(client, informerFactory.Core().V1().Nodes())
+	return &fakeController{
+		NodeDrainController: controller,
+		client:              client,
+		informerFactory:     informerFactory,
+	}
+}
+
+func newNode(name string, unschedulable, annotated bool) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       corev1.NodeSpec{Unschedulable: unschedulable},
+	}
+	if annotated {
+		node.Annotations = map[string]string{apis.NodeDrainingAnnotationKey: "true"}
+	}
+	return node
+}
+
+func (c *fakeController) expectNodeDraining(t *testing.T, nodeName string, expected bool) {
+	assert.EventuallyWithT(t, func(t *assert.CollectT) {
+		node, err := c.client.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, expected, hasDrainingAnnotation(node))
+	}, 2*time.Second, 50*time.Millisecond)
+}
+
+func TestNodeDrain(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	controller := newController(
+		newNode("node1", false, false),
+		newNode("node2", true, false),
+		// The annotation of a Node uncordoned while antrea-controller was down is removed.
+		newNode("node3", false, true),
+	)
+	controller.informerFactory.Start(stopCh)
+	controller.informerFactory.WaitForCacheSync(stopCh)
+	go controller.Run(stopCh)
+
+	controller.expectNodeDraining(t, "node1", false)
+	controller.expectNodeDraining(t, "node2", true)
+	controller.expectNodeDraining(t, "node3", false)
+
+	// Cordon node1.
+	node1 := newNode("node1", true, false)
+	_, err := controller.client.CoreV1().Nodes().Update(context.TODO(), node1, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	controller.expectNodeDraining(t, "node1", true)
+
+	// Uncordon node2.
+	node2, err := controller.client.CoreV1().Nodes().Get(context.TODO(), "node2", metav1.GetOptions{})
+	require.NoError(t, err)
+	node2.Spec.Unschedulable = false
+	_, err = controller.client.CoreV1().Nodes().Update(context.TODO(), node2, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	controller.expectNodeDraining(t, "node2", false)
+}
@@ -16,6 +16,7 @@ package serviceexternalip
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
@@ -25,6 +26,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apimachineryerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	apimachinerytypes "k8s.io/apimachinery/pkg/types"
@@ -56,6 +58,12 @@ const (
 	externalIPPoolIndex = "externalIPPool"
 	// ipIndex is an index of ipAllocations.
 	ipIndex = "ip"
+
+	serviceKind = "Service"
+
+	// IPConflictConditionType is the type of the Service condition reporting that the requested external IP is
+	// allocated to another consumer of ExternalIPPools, e.g. an Egress.
+	IPConflictConditionType = "antrea.io/IPConflict"
 )
 
 // ipAllocation contains the IP and the IP Pool which allocates it.
@@ -244,10 +252,11 @@ func (c *ServiceExternalIPController) restoreIPAllocations(services []*corev1.Se
 			continue
 		}
 		knownIPsByPool[ipPool].Insert(ip)
-		// We don't set ObjectReference here as it might be shared between multiple Services.
+		// We don't set the name in ObjectReference here as it might be shared between multiple Services.
 		allocation := externalippool.IPAllocation{
-			IPPoolName: ipPool,
-			IP:         parsedIP,
+			ObjectReference: corev1.ObjectReference{Kind: serviceKind},
+			IPPoolName:      ipPool,
+			IP:              parsedIP,
 		}
 		requestedIPAllocations = append(requestedIPAllocations, allocation)
 	}
@@ -370,7 +379,7 @@ func (c *ServiceExternalIPController) allocateExternalIP(service apimachinerytyp
 
 	// Allocate IP from ExternalIPPool.
 	if requestedIP == "" {
-		ip, err := c.externalIPAllocator.AllocateIPFromPool(pool)
+		ip, err := c.externalIPAllocator.AllocateIPFromPool(pool, serviceOwnerReference(service, allowSharedIP))
 		if err != nil {
 			return nil, fmt.Errorf("error when allocating IP from ExternalIPPool %s for Service %s: %v", pool, service, err)
 		}
@@ -408,14 +417,27 @@ func (c *ServiceExternalIPController) allocateExternalIP(service apimachinerytyp
 	}
 
 	// The requested IP is not used yet, allocate it.
-	if err := c.externalIPAllocator.UpdateIPAllocation(pool, ip); err != nil {
-		return nil, fmt.Errorf("error when allocating IP %s from ExternalIPPool %s for Service %s: %v", requestedIP, pool, service, err)
+	if err := c.externalIPAllocator.UpdateIPAllocation(pool, ip, serviceOwnerReference(service, allowSharedIP)); err != nil {
+		return nil, fmt.Errorf("error when allocating IP %s from ExternalIPPool %s for Service %s: %w", requestedIP, pool, service, err)
 	}
 	klog.InfoS("Requested external IP for Service", "service", service, "externalIPPool", pool, "ip", ip)
 	c.addIPAllocationLocked(service, pool, ip, allowSharedIP)
 	return ip, nil
 }
 
+// serviceOwnerReference returns the owner of the IP allocated to the Service. The name is not set if the IP may be
+// shared with other Services, as the IP is not released when its first owner is deleted.
+func serviceOwnerReference(service apimachinerytypes.NamespacedName, sharable bool) corev1.ObjectReference {
+	if sharable {
+		return corev1.ObjectReference{Kind: serviceKind}
+	}
+	return corev1.ObjectReference{
+		Kind:      serviceKind,
+		Namespace: service.Namespace,
+		Name:      service.Name,
+	}
+}
+
 func (c *ServiceExternalIPController) getExternalIPAllocation(service apimachinerytypes.NamespacedName) (*ipAllocation, bool) {
 	c.ipAllocationMutex.RLock()
 	defer c.ipAllocationMutex.RUnlock()
@@ -522,6 +544,13 @@ func (c *ServiceExternalIPController) syncService(key apimachinerytypes.Namespac
 
 	newExternalIP, err := c.allocateExternalIP(key, currentIPPool, service.Spec.LoadBalancerIP, allowSharedIP)
 	if err != nil {
+		// Report the conflict in the Service status, the Service will be retried until the conflict is resolved.
+		var ipConflictErr *externalippool.IPConflictError
+		if errors.As(err, &ipConflictErr) {
+			if updateErr := c.updateServiceStatus(service, nil, ipConflictErr); updateErr != nil {
+				return updateErr
+			}
+		}
 		return err
 	}
 	if err := c.updateServiceLoadBalancerIP(service, newExternalIP); err != nil {
@@ -530,8 +559,14 @@ func (c *ServiceExternalIPController) syncService(key apimachinerytypes.Namespac
 	return nil
 }
 
-// updateService updates the Service status in Kubernetes API.
+// updateServiceLoadBalancerIP updates the Service LoadBalancer IP in Kubernetes API.
 func (c *ServiceExternalIPController) updateServiceLoadBalancerIP(svc *corev1.Service, ip net.IP) error {
+	return c.updateServiceStatus(svc, ip, nil)
+}
+
+// updateServiceStatus updates the Service LoadBalancer IP and the IPConflict condition in Kubernetes API. The
+// condition is removed if ipConflictErr is nil.
+func (c *ServiceExternalIPController) updateServiceStatus(svc *corev1.Service, ip net.IP, ipConflictErr *externalippool.IPConflictError) error {
 	expectedLoadBalancerStatus := corev1.LoadBalancerStatus{}
 	if ip != nil {
 		expectedLoadBalancerStatus.Ingress = append(expectedLoadBalancerStatus.Ingress, corev1.LoadBalancerIngress{IP: ip.String()})
@@ -539,7 +574,18 @@ func (c *ServiceExternalIPController) updateServiceLoadBalancerIP(svc *corev1.Se
 	toUpdate := svc.DeepCopy()
 	var updateErr, getErr error
 	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
-		if reflect.DeepEqual(expectedLoadBalancerStatus, toUpdate.Status.LoadBalancer) {
+		var conditionChanged bool
+		if ipConflictErr != nil {
+			conditionChanged = meta.SetStatusCondition(&toUpdate.Status.Conditions, metav1.Condition{
+				Type:    IPConflictConditionType,
+				Status:  metav1.ConditionTrue,
+				Reason:  fmt.Sprintf("AllocatedTo%s", ipConflictErr.Owner.Kind),
+				Message: ipConflictErr.Error(),
+			})
+		} else {
+			conditionChanged = meta.RemoveStatusCondition(&toUpdate.Status.Conditions, IPConflictConditionType)
+		}
+		if !conditionChanged && reflect.DeepEqual(expectedLoadBalancerStatus, toUpdate.Status.LoadBalancer) {
 			return nil
 		}
 		toUpdate.Status.LoadBalancer = expectedLoadBalancerStatus
@@ -23,8 +23,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	apimachinerytypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
@@ -485,6 +487,49 @@ func TestSyncService(t *testing.T) {
 	})
 }
 
+func TestSyncServiceIPConflict(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	service := newService("svc1", "ns1", corev1.ServiceTypeLoadBalancer, "1.2.3.4", "eip1")
+	eip1 := newExternalIPPool("eip1", "", "1.2.3.4", "1.2.3.5")
+	controller := newController([]runtime.Object{service}, []runtime.Object{eip1})
+	controller.informerFactory.Start(stopCh)
+	controller.crdInformerFactory.Start(stopCh)
+	controller.informerFactory.WaitForCacheSync(stopCh)
+	controller.crdInformerFactory.WaitForCacheSync(stopCh)
+	go controller.externalIPAllocator.Run(stopCh)
+	require.True(t, cache.WaitForCacheSync(stopCh, controller.externalIPAllocator.HasSynced))
+
+	// The requested IP is allocated to an Egress.
+	ip := net.ParseIP("1.2.3.4")
+	require.NoError(t, controller.externalIPAllocator.UpdateIPAllocation("eip1", ip, corev1.ObjectReference{Kind: "Egress", Name: "egress1"}))
+
+	key := apimachinerytypes.NamespacedName{Namespace: service.Namespace, Name: service.Name}
+	err := controller.syncService(key)
+	var ipConflictErr *externalippool.IPConflictError
+	require.ErrorAs(t, err, &ipConflictErr)
+	svc, err := controller.client.CoreV1().Services(service.Namespace).Get(context.TODO(), service.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, svc.Status.LoadBalancer.Ingress)
+	condition := meta.FindStatusCondition(svc.Status.Conditions, IPConflictConditionType)
+	require.NotNil(t, condition)
+	assert.Equal(t, metav1.ConditionTrue, condition.Status)
+	assert.Equal(t, "AllocatedToEgress", condition.Reason)
+	assert.Equal(t, "IP 1.2.3.4 is already allocated to Egress egress1", condition.Message)
+
+	// The conflict is resolved after the Egress releases the IP.
+	require.NoError(t, controller.externalIPAllocator.ReleaseIP("eip1", ip))
+	require.NoError(t, controller.syncService(key))
+	svc, err = controller.client.CoreV1().Services(service.Namespace).Get(context.TODO(), service.Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3.4", getServiceExternalIP(svc))
+	assert.Nil(t, meta.FindStatusCondition(svc.Status.Conditions, IPConflictConditionType))
+	owner, allocated := controller.externalIPAllocator.GetIPOwner(ip)
+	assert.True(t, allocated)
+	assert.Equal(t, corev1.ObjectReference{Kind: "Service", Namespace: "ns1", Name: "svc1"}, owner)
+}
+
 func checkForServiceExternalIP(t *testing.T, controller *loadBalancerController, name, namespace, expectedExternalIP string) {
 	t.Helper()
 	assert.Eventually(t, func() bool {
@@ -19,10 +19,12 @@ import (
 	"sync"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	networkinginformers "k8s.io/client-go/informers/networking/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
@@ -47,6 +49,7 @@ const (
 // - pkg/apiserver/registry/stats/antreaclusternetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/antreanetworkpolicystats.statsProvider
 // - pkg/apiserver/registry/stats/multicastgroup.statsProvider
+// - pkg/apiserver/registry/stats/namespacetrafficstats.statsProvider
 type Aggregator struct {
 	// networkPolicyStats caches the statistics of K8s NetworkPolicies collected from the antrea-agents.
 	networkPolicyStats cache.Indexer
@@ -54,6 +57,8 @@ type Aggregator struct {
 	antreaClusterNetworkPolicyStats cache.Indexer
 	// antreaNetworkPolicyStats caches the statistics of Antrea NetworkPolicies collected from the antrea-agents.
 	antreaNetworkPolicyStats cache.Indexer
+	// namespaceTrafficStats caches the traffic statistics of Namespaces collected from the antrea-agents.
+	namespaceTrafficStats cache.Indexer
 	// groupNodePodsMap caches the information of Pods in a Node that have joined multicast groups collected from the antrea-agents.
 	// The map can be interpreted as
 	// map[IP of multicast group]map[name of node]list of PodReference.
@@ -67,6 +72,8 @@ type Aggregator struct {
 	acnpListerSynced cache.InformerSynced
 	// annpListerSynced is a function which returns true if the Antrea NetworkPolicy shared informer has been synced at least once.
 	annpListerSynced cache.InformerSynced
+	// namespaceListerSynced is a function which returns true if the Namespace shared informer has been synced at least once.
+	namespaceListerSynced cache.InformerSynced
 }
 
 // uidIndexFunc is an index function that indexes based on an object's UID.
@@ -78,7 +85,7 @@ func uidIndexFunc(obj interface{}) ([]string, error) {
 	return []string{string(meta.GetUID())}, nil
 }
 
-func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer) *Aggregator {
+func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInformer, acnpInformer crdinformers.ClusterNetworkPolicyInformer, annpInformer crdinformers.NetworkPolicyInformer, namespaceInformer coreinformers.NamespaceInformer) *Aggregator {
 	aggregator := &Aggregator{
 		networkPolicyStats: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc, uidIndex: uidIndexFunc}),
 		dataCh:             make(chan *controlplane.NodeStatsSummary, 1000),
@@ -121,6 +128,21 @@ func NewAggregator(networkPolicyInformer networkinginformers.NetworkPolicyInform
 			0,
 		)
 	}
+	// Register Informer and add handlers for Namespace events only if the feature is enabled.
+	// They are the source of truth of the NamespaceTrafficStats, i.e., a NamespaceTrafficStats is present only if the
+	// corresponding Namespace is present.
+	if features.DefaultFeatureGate.Enabled(features.NamespaceTrafficStats) {
+		aggregator.namespaceTrafficStats = cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+		aggregator.namespaceListerSynced = namespaceInformer.Informer().HasSynced
+		namespaceInformer.Informer().AddEventHandlerWithResyncPeriod(
+			cache.ResourceEventHandlerFuncs{
+				AddFunc:    aggregator.addNamespace,
+				DeleteFunc: aggregator.deleteNamespace,
+			},
+			// Set resyncPeriod to 0 to disable resyncing.
+			0,
+		)
+	}
 	if features.DefaultFeatureGate.Enabled(features.Multicast) {
 		aggregator.groupNodePodsMap = make(map[string]map[string][]statsv1alpha1.PodReference)
 	}
@@ -248,6 +270,62 @@ func (a *Aggregator) deleteANNP(obj interface{}) {
 	a.antreaNetworkPolicyStats.Delete(stats)
 }
 
+// addNamespace handles Namespace ADD events and creates corresponding NamespaceTrafficStats objects.
+func (a *Aggregator) addNamespace(obj interface{}) {
+	namespace := obj.(*corev1.Namespace)
+	stats := &statsv1alpha1.NamespaceTrafficStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace.Name,
+			UID:  namespace.UID,
+			// To indicate the duration that the stats covers, the CreationTimestamp is set to the time that the stats
+			// start, instead of the CreationTimestamp of the Namespace.
+			CreationTimestamp: metav1.Time{Time: time.Now()},
+		},
+	}
+	a.namespaceTrafficStats.Add(stats)
+}
+
+// deleteNamespace handles Namespace DELETE events and deletes corresponding NamespaceTrafficStats objects.
+func (a *Aggregator) deleteNamespace(obj interface{}) {
+	namespace, ok := obj.(*corev1.Namespace)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			klog.Errorf("Error decoding object when deleting Namespace, invalid type: %v", obj)
+			return
+		}
+		namespace, ok = tombstone.Obj.(*corev1.Namespace)
+		if !ok {
+			klog.Errorf("Error decoding object tombstone when deleting Namespace, invalid type: %v", tombstone.Obj)
+			return
+		}
+	}
+	stats := &statsv1alpha1.NamespaceTrafficStats{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace.Name,
+			UID:  namespace.UID,
+		},
+	}
+	a.namespaceTrafficStats.Delete(stats)
+}
+
+func (a *Aggregator) ListNamespaceTrafficStats() []statsv1alpha1.NamespaceTrafficStats {
+	objs := a.namespaceTrafficStats.List()
+	stats := make([]statsv1alpha1.NamespaceTrafficStats, len(objs))
+	for i, obj := range objs {
+		stats[i] = *(obj.(*statsv1alpha1.NamespaceTrafficStats))
+	}
+	return stats
+}
+
+func (a *Aggregator) GetNamespaceTrafficStats(name string) (*statsv1alpha1.NamespaceTrafficStats, bool) {
+	obj, exists, _ := a.namespaceTrafficStats.GetByKey(name)
+	if !exists {
+		return nil, false
+	}
+	return obj.(*statsv1alpha1.NamespaceTrafficStats), true
+}
+
 func (a *Aggregator) ListAntreaClusterNetworkPolicyStats() []statsv1alpha1.AntreaClusterNetworkPolicyStats {
 	objs := a.antreaClusterNetworkPolicyStats.List()
 	stats := make([]statsv1alpha1.AntreaClusterNetworkPolicyStats, len(objs))
@@ -356,6 +434,9 @@ func (a *Aggregator) Run(stopCh <-chan struct{}) {
 	if features.DefaultFeatureGate.Enabled(features.AntreaPolicy) {
 		cacheSyncs = append(cacheSyncs, a.acnpListerSynced, a.annpListerSynced)
 	}
+	if features.DefaultFeatureGate.Enabled(features.NamespaceTrafficStats) {
+		cacheSyncs = append(cacheSyncs, a.namespaceListerSynced)
+	}
 	if !cache.WaitForNamedCacheSync("stats aggregator", stopCh, cacheSyncs...) {
 		return
 	}
@@ -445,6 +526,20 @@ func (a *Aggregator) doCollect(summary *controlplane.NodeStatsSummary) {
 			}
 		}
 	}
+	if features.DefaultFeatureGate.Enabled(features.NamespaceTrafficStats) {
+		for idx := range summary.Namespaces {
+			stats := &summary.Namespaces[idx]
+			// The Namespace might have been removed, skip processing it if missing.
+			obj, exists, _ := a.namespaceTrafficStats.GetByKey(stats.Namespace)
+			if exists {
+				// The object returned by cache is supposed to be read only, create a new object and update it.
+				curStats := obj.(*statsv1alpha1.NamespaceTrafficStats).DeepCopy()
+				addUp(&curStats.Ingress, &stats.Ingress)
+				addUp(&curStats.Egress, &stats.Egress)
+				a.namespaceTrafficStats.Update(curStats)
+			}
+		}
+	}
 }
 
 func addUp(stats *statsv1alpha1.TrafficStats, inc *statsv1alpha1.TrafficStats) {
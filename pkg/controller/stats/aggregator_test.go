@@ -21,6 +21,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -522,7 +523,7 @@ func TestAggregatorCollectListGet(t *testing.T) {
 			informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 			crdClient := fakeversioned.NewSimpleClientset(append(tt.existingAntreaClusterNetworkPolicies, tt.existingAntreaNetworkPolicies...)...)
 			crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies())
+			a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), informerFactory.Core().V1().Namespaces())
 			informerFactory.Start(stopCh)
 			crdInformerFactory.Start(stopCh)
 			expectedPolicyCount := len(tt.expectedNetworkPolicyStats) + len(tt.expectedAntreaClusterNetworkPolicyStats) + len(tt.expectedAntreaNetworkPolicyStats)
@@ -561,7 +562,7 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
 	crdClient := fakeversioned.NewSimpleClientset(acnp1, annp1)
 	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
-	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies())
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), informerFactory.Core().V1().Namespaces())
 	informerFactory.Start(stopCh)
 	crdInformerFactory.Start(stopCh)
 
@@ -627,3 +628,75 @@ func TestDeleteNetworkPolicy(t *testing.T) {
 	})
 	assert.NoError(t, err)
 }
+
+func TestAggregatorNamespaceTrafficStats(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, features.DefaultFeatureGate, features.NamespaceTrafficStats, true)
+
+	ns1 := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns1", UID: "uid7"}}
+	ns2 := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "ns2", UID: "uid8"}}
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	client := fake.NewSimpleClientset(ns1, ns2)
+	informerFactory := informers.NewSharedInformerFactory(client, 12*time.Hour)
+	crdClient := fakeversioned.NewSimpleClientset()
+	crdInformerFactory := crdinformers.NewSharedInformerFactory(crdClient, 12*time.Hour)
+	a := NewAggregator(informerFactory.Networking().V1().NetworkPolicies(), crdInformerFactory.Crd().V1beta1().ClusterNetworkPolicies(), crdInformerFactory.Crd().V1beta1().NetworkPolicies(), informerFactory.Core().V1().Namespaces())
+	informerFactory.Start(stopCh)
+	crdInformerFactory.Start(stopCh)
+	err := wait.PollUntilContextTimeout(context.Background(), 100*time.Millisecond, time.Second, true, func(ctx context.Context) (done bool, err error) {
+		return len(a.ListNamespaceTrafficStats()) == 2, nil
+	})
+	require.NoError(t, err, "Timeout while waiting for Add events to be processed by Aggregator")
+
+	summaries := []*controlplane.NodeStatsSummary{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+			Namespaces: []controlplane.NamespaceTrafficStats{
+				{
+					Namespace: ns1.Name,
+					Ingress:   statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1},
+					Egress:    statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2, Sessions: 2},
+				},
+				{
+					// The Namespace doesn't exist, its stats should be ignored.
+					Namespace: "ns3",
+					Ingress:   statsv1alpha1.TrafficStats{Bytes: 10, Packets: 1, Sessions: 1},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+			Namespaces: []controlplane.NamespaceTrafficStats{
+				{
+					Namespace: ns1.Name,
+					Ingress:   statsv1alpha1.TrafficStats{Bytes: 30, Packets: 3, Sessions: 1},
+				},
+				{
+					Namespace: ns2.Name,
+					Egress:    statsv1alpha1.TrafficStats{Bytes: 50, Packets: 5, Sessions: 1},
+				},
+			},
+		},
+	}
+	runWrapper(t, a, 0, summaries)
+
+	require.Len(t, a.ListNamespaceTrafficStats(), 2)
+	_, exists := a.GetNamespaceTrafficStats("ns3")
+	assert.False(t, exists)
+	stats, exists := a.GetNamespaceTrafficStats(ns1.Name)
+	require.True(t, exists)
+	assert.Equal(t, statsv1alpha1.TrafficStats{Bytes: 40, Packets: 4, Sessions: 2}, stats.Ingress)
+	assert.Equal(t, statsv1alpha1.TrafficStats{Bytes: 20, Packets: 2, Sessions: 2}, stats.Egress)
+	stats, exists = a.GetNamespaceTrafficStats(ns2.Name)
+	require.True(t, exists)
+	assert.Equal(t, statsv1alpha1.TrafficStats{}, stats.Ingress)
+	assert.Equal(t, statsv1alpha1.TrafficStats{Bytes: 50, Packets: 5, Sessions: 1}, stats.Egress)
+
+	client.CoreV1().Namespaces().Delete(context.TODO(), ns1.Name, metav1.DeleteOptions{})
+	// Event handlers are asynchronous, it's supposed to finish very soon.
+	err = wait.PollUntilContextTimeout(context.Background(), 100*time.Millisecond, time.Second, true, func(ctx context.Context) (done bool, err error) {
+		_, exists := a.GetNamespaceTrafficStats(ns1.Name)
+		return !exists, nil
+	})
+	assert.NoError(t, err)
+}
@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/miekg/dns"
 	admv1 "k8s.io/api/admission/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -86,5 +87,25 @@ func (c *Controller) validate(tf *crdv1beta1.Traceflow) (allowed bool, deniedRea
 	if tf.Spec.Source.Pod == "" && tf.Spec.Destination.Pod == "" {
 		return false, fmt.Sprintf("Traceflow %s has neither source nor destination Pod specified", tf.Name)
 	}
+	transportHeader := tf.Spec.Packet.TransportHeader
+	if transportHeader.UDP != nil && transportHeader.UDP.DNS != nil {
+		if tf.Spec.LiveTraffic {
+			return false, "DNS query is not supported in live-traffic Traceflow"
+		}
+		if _, ok := dns.IsDomainName(transportHeader.UDP.DNS.QueryName); !ok {
+			return false, fmt.Sprintf("invalid DNS query name %s", transportHeader.UDP.DNS.QueryName)
+		}
+	}
+	if transportHeader.NDP != nil {
+		if tf.Spec.LiveTraffic {
+			return false, "NDP is not supported in live-traffic Traceflow"
+		}
+		if tf.Spec.Packet.IPv6Header == nil {
+			return false, "NDP requires the IPv6 header to be specified"
+		}
+		if transportHeader.TCP != nil || transportHeader.UDP != nil || transportHeader.ICMP != nil {
+			return false, "NDP cannot be specified with another transport header"
+		}
+	}
 	return true, ""
 }
@@ -82,6 +82,72 @@ func TestControllerValidate(t *testing.T) {
 			},
 			deniedReason: "using hostNetwork Pod as source in non-live-traffic Traceflow is not supported",
 		},
+		{
+			name: "DNS query is not supported in live-traffic Traceflow",
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Destination: crdv1beta1.Destination{Namespace: "test-ns", Pod: "test-pod"},
+				LiveTraffic: true,
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{
+						UDP: &crdv1beta1.UDPHeader{DNS: &crdv1beta1.DNSQuery{QueryName: "antrea.io"}},
+					},
+				},
+			},
+			deniedReason: "DNS query is not supported in live-traffic Traceflow",
+		},
+		{
+			name: "Invalid DNS query name",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{
+						UDP: &crdv1beta1.UDPHeader{DNS: &crdv1beta1.DNSQuery{QueryName: "antrea..io"}},
+					},
+				},
+			},
+			deniedReason: "invalid DNS query name antrea..io",
+		},
+		{
+			name: "NDP requires IPv6",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source: crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Packet: crdv1beta1.Packet{
+					TransportHeader: crdv1beta1.TransportHeader{
+						NDP: &crdv1beta1.NDPHeader{Type: crdv1beta1.NDPNeighborSolicitation},
+					},
+				},
+			},
+			deniedReason: "NDP requires the IPv6 header to be specified",
+		},
+		{
+			name: "Valid NDP request",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "test-pod"},
+				},
+			},
+			newSpec: &crdv1beta1.TraceflowSpec{
+				Source:      crdv1beta1.Source{Namespace: "test-ns", Pod: "test-pod"},
+				Destination: crdv1beta1.Destination{IP: "fd00:10::2"},
+				Packet: crdv1beta1.Packet{
+					IPv6Header: &crdv1beta1.IPv6Header{},
+					TransportHeader: crdv1beta1.TransportHeader{
+						NDP: &crdv1beta1.NDPHeader{Type: crdv1beta1.NDPNeighborAdvertisement},
+					},
+				},
+			},
+			allowed: true,
+		},
 		{
 			name: "Valid request",
 			pods: []*v1.Pod{
@@ -58,6 +58,9 @@ type AppliedToGroup struct {
 	Service *controlplane.ServiceReference
 	// SourceGroup refers to the ClusterGroup or Group the AppliedToGroup is derived from.
 	SourceGroup string
+	// ExemptNamespaces can only be set along with SourceGroup. The workloads in these Namespaces are excluded from the
+	// members of the ClusterGroup, as they are exempt from the ClusterNetworkPolicies using the AppliedToGroup.
+	ExemptNamespaces sets.Set[string]
 
 	// GroupMemberByNode is a mapping from nodeName to a set of GroupMembers on the Node,
 	// either GroupMembers or ExternalEntity on the external node.
@@ -118,21 +121,58 @@ type NetworkPolicy struct {
 	AppliedToPerRule bool
 	// SyncError is the Error encountered when syncing this NetworkPolicy.
 	SyncError error
+	// Rollout is set when this generation of the NetworkPolicy is being rolled out to a subset
+	// of Nodes first. When it is set, NodeNames includes all the Nodes which receive any version
+	// of the NetworkPolicy.
+	Rollout *NetworkPolicyRollout
 }
 
-// GetAddressGroups returns AddressGroups used by this NetworkPolicy.
+// NetworkPolicyRollout describes an ongoing canary rollout of a NetworkPolicy generation.
+type NetworkPolicyRollout struct {
+	// CanaryNodeNames is the set of Nodes which receive the new generation of the NetworkPolicy.
+	CanaryNodeNames sets.Set[string]
+	// PreviousVersion is the version of the NetworkPolicy which is kept on the other Nodes until
+	// the rollout completes. It is nil if the NetworkPolicy did not exist before the rollout.
+	PreviousVersion *NetworkPolicy
+}
+
+// GetVersionForNode returns the version of the NetworkPolicy which should be realized on the
+// provided Node, or nil if the NetworkPolicy should not be realized on it.
+func (p *NetworkPolicy) GetVersionForNode(nodeName string) *NetworkPolicy {
+	if p.Rollout == nil || p.Rollout.CanaryNodeNames.Has(nodeName) {
+		if p.NodeNames.Has(nodeName) {
+			return p
+		}
+		return nil
+	}
+	if p.Rollout.PreviousVersion != nil && p.Rollout.PreviousVersion.NodeNames.Has(nodeName) {
+		return p.Rollout.PreviousVersion
+	}
+	return nil
+}
+
+// GetAddressGroups returns AddressGroups used by this NetworkPolicy, including the ones used by
+// the previous version during a rollout.
 func (p *NetworkPolicy) GetAddressGroups() sets.Set[string] {
 	addressGroups := sets.New[string]()
 	for _, rule := range p.Rules {
 		addressGroups.Insert(rule.From.AddressGroups...)
 		addressGroups.Insert(rule.To.AddressGroups...)
 	}
+	if p.Rollout != nil && p.Rollout.PreviousVersion != nil {
+		addressGroups = addressGroups.Union(p.Rollout.PreviousVersion.GetAddressGroups())
+	}
 	return addressGroups
 }
 
-// GetAppliedToGroups returns AppliedToGroups used by this NetworkPolicy.
+// GetAppliedToGroups returns AppliedToGroups used by this NetworkPolicy, including the ones used
+// by the previous version during a rollout.
 func (p *NetworkPolicy) GetAppliedToGroups() sets.Set[string] {
-	return sets.New[string](p.AppliedToGroups...)
+	appliedToGroups := sets.New[string](p.AppliedToGroups...)
+	if p.Rollout != nil && p.Rollout.PreviousVersion != nil {
+		appliedToGroups.Insert(p.Rollout.PreviousVersion.AppliedToGroups...)
+	}
+	return appliedToGroups
 }
 
 // RuleInfo stores the original NetworkPolicy info, index of this rule in the NetworkPolicy
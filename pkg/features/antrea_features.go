@@ -57,7 +57,7 @@ const (
 
 	// alpha: v1.13
 	// beta: v2.1
-	// Enable support for cleaning up stale UDP Service conntrack connections in AntreaProxy.
+	// Enable support for cleaning up stale UDP and SCTP Service conntrack connections in AntreaProxy.
 	CleanupStaleUDPSvcConntrack featuregate.Feature = "CleanupStaleUDPSvcConntrack"
 
 	// alpha: v0.8
@@ -179,6 +179,22 @@ const (
 	// Allow users to initiate BGP process on selected Kubernetes Nodes and advertise Service IPs, Pod IPs and Egress
 	// IPs to remote BGP peers.
 	BGPPolicy featuregate.Feature = "BGPPolicy"
+
+	// alpha: v2.4
+	// Enable native support for the hostPort of Pod containers in Antrea Agent, so that the portmap
+	// CNI plugin does not need to be chained with the Antrea CNI plugin.
+	HostPort featuregate.Feature = "HostPort"
+
+	// alpha: v2.4
+	// Enable the default-deny isolation of the Namespaces labeled with "policy.antrea.io/isolation: strict",
+	// and report the isolation status of these Namespaces with NamespaceSecurityPolicy resources.
+	NamespaceSecurityPolicy featuregate.Feature = "NamespaceSecurityPolicy"
+
+	// alpha: v2.4
+	// Enable the collection of the traffic stats of the local Pods by Namespace in Antrea Agent, and the
+	// aggregation of these stats into NamespaceTrafficStats resources in Antrea Controller. It requires
+	// NetworkPolicyStats to be enabled.
+	NamespaceTrafficStats featuregate.Feature = "NamespaceTrafficStats"
 )
 
 var (
@@ -224,6 +240,9 @@ var (
 		NodeNetworkPolicy:           {Default: false, PreRelease: featuregate.Alpha},
 		L7FlowExporter:              {Default: false, PreRelease: featuregate.Alpha},
 		NodeLatencyMonitor:          {Default: false, PreRelease: featuregate.Alpha},
+		HostPort:                    {Default: false, PreRelease: featuregate.Alpha},
+		NamespaceSecurityPolicy:     {Default: false, PreRelease: featuregate.Alpha},
+		NamespaceTrafficStats:       {Default: false, PreRelease: featuregate.Alpha},
 	}
 
 	// AgentGates consists of all known feature gates for the Antrea Agent.
@@ -258,6 +277,8 @@ var (
 		NodeNetworkPolicy,
 		L7FlowExporter,
 		NodeLatencyMonitor,
+		HostPort,
+		NamespaceTrafficStats,
 	)
 
 	// ControllerGates consists of all known feature gates for the Antrea Controller.
@@ -271,6 +292,8 @@ var (
 		L7NetworkPolicy,
 		Multicast,
 		Multicluster,
+		NamespaceSecurityPolicy,
+		NamespaceTrafficStats,
 		NetworkPolicyStats,
 		NodeIPAM,
 		ServiceExternalIP,
@@ -289,7 +312,6 @@ var (
 	// can have different FeatureSpecs between Linux and Windows, we should
 	// still define a separate defaultAntreaFeatureGates map for Windows.
 	unsupportedFeaturesOnWindows = map[featuregate.Feature]struct{}{
-		Egress:     {},
 		AntreaIPAM: {},
 		// BGPPolicy feature is not validated on Windows yet. This can be removed
 		// in the future if it's fully tested on Windows.
@@ -297,7 +319,6 @@ var (
 		Multicast:         {},
 		SecondaryNetwork:  {},
 		ServiceExternalIP: {},
-		IPsecCertAuth:     {},
 		// Multicluster feature is not validated on Windows yet. This can be removed
 		// in the future if it's fully tested on Windows.
 		Multicluster:                {},
@@ -310,6 +331,8 @@ var (
 		L7FlowExporter:              {},
 		NodeLatencyMonitor:          {},
 		PacketCapture:               {},
+		HostPort:                    {},
+		NamespaceTrafficStats:       {},
 	}
 	// supportedFeaturesOnExternalNode records the features supported on an external
 	// Node. Antrea Agent checks the enabled features if it is running on an
@@ -322,6 +345,13 @@ var (
 		L7NetworkPolicy:         {},
 		AdminNetworkPolicy:      {},
 	}
+	// reconfigurableAgentFeatures records the features which can be enabled or disabled at runtime by updating the
+	// feature gates in the Antrea Agent configuration file, without restarting Antrea Agent.
+	reconfigurableAgentFeatures = map[featuregate.Feature]struct{}{
+		FlowExporter:       {},
+		NetworkPolicyStats: {},
+		NodePortLocal:      {},
+	}
 )
 
 func init() {
@@ -359,6 +389,12 @@ func SupportedOnExternalNode(feature featuregate.Feature) bool {
 	return exists
 }
 
+// ReconfigurableOnAgent checks whether a feature can be enabled or disabled at runtime on Antrea Agent.
+func ReconfigurableOnAgent(feature featuregate.Feature) bool {
+	_, exists := reconfigurableAgentFeatures[feature]
+	return exists
+}
+
 func GetVersion(version string) string {
 	if version == "" {
 		version = "GA"
@@ -372,3 +408,13 @@ func GetStatus(status bool) string {
 	}
 	return "Disabled"
 }
+
+// GetEffectiveFeatureGates returns the state of the given features, with the configured values applied to their
+// defaults.
+func GetEffectiveFeatureGates(gates sets.Set[featuregate.Feature]) map[string]bool {
+	states := make(map[string]bool, len(gates))
+	for feature := range gates {
+		states[string(feature)] = DefaultFeatureGate.Enabled(feature)
+	}
+	return states
+}
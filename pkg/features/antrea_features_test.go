@@ -18,7 +18,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/component-base/featuregate"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
 )
 
 func TestSupportedOnWindows(t *testing.T) {
@@ -34,7 +36,7 @@ func TestSupportedOnWindows(t *testing.T) {
 		},
 		{
 			name:     "Feature unsupported on Windows Node",
-			feature:  Egress,
+			feature:  AntreaIPAM,
 			expected: false,
 		},
 		{
@@ -83,6 +85,14 @@ func TestSupportedOnExternalNode(t *testing.T) {
 	}
 }
 
+func TestReconfigurableOnAgent(t *testing.T) {
+	assert.True(t, ReconfigurableOnAgent(NodePortLocal))
+	assert.False(t, ReconfigurableOnAgent(AntreaProxy))
+	for f := range reconfigurableAgentFeatures {
+		assert.True(t, AgentGates.Has(f), "Reconfigurable feature %s is not an Agent feature", f)
+	}
+}
+
 func TestDefaultAntreaFeatureGates(t *testing.T) {
 	for df := range DefaultAntreaFeatureGates {
 		if !AgentGates.Has(df) && !ControllerGates.Has(df) {
@@ -90,3 +100,11 @@ func TestDefaultAntreaFeatureGates(t *testing.T) {
 		}
 	}
 }
+
+func TestGetEffectiveFeatureGates(t *testing.T) {
+	featuregatetesting.SetFeatureGateDuringTest(t, DefaultFeatureGate, Multicast, false)
+	featuregatetesting.SetFeatureGateDuringTest(t, DefaultFeatureGate, Egress, true)
+	gates := GetEffectiveFeatureGates(sets.New[featuregate.Feature](Multicast, Egress))
+	assert.Equal(t, map[string]bool{"Multicast": false, "Egress": true}, gates)
+	assert.Len(t, GetEffectiveFeatureGates(ControllerGates), ControllerGates.Len())
+}
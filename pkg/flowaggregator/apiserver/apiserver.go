@@ -32,6 +32,8 @@ import (
 	"antrea.io/antrea/pkg/apis"
 	systeminstall "antrea.io/antrea/pkg/apis/system/install"
 	"antrea.io/antrea/pkg/apiserver/handlers/loglevel"
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/apiserver/consumers"
 	"antrea.io/antrea/pkg/flowaggregator/apiserver/handlers/flowrecords"
 	"antrea.io/antrea/pkg/flowaggregator/apiserver/handlers/recordmetrics"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
@@ -68,14 +70,14 @@ func (s *flowAggregatorAPIServer) Run(ctx context.Context) error {
 	return s.GenericAPIServer.PrepareRun().RunWithContext(ctx)
 }
 
-func installHandlers(s *genericapiserver.GenericAPIServer, faq querier.FlowAggregatorQuerier) {
-	s.Handler.NonGoRestfulMux.HandleFunc("/flowrecords", flowrecords.HandleFunc(faq))
-	s.Handler.NonGoRestfulMux.HandleFunc("/recordmetrics", recordmetrics.HandleFunc(faq))
+func installHandlers(s *genericapiserver.GenericAPIServer, faq querier.FlowAggregatorQuerier, scopes *consumers.Scopes) {
+	s.Handler.NonGoRestfulMux.HandleFunc("/flowrecords", flowrecords.HandleFunc(faq, scopes))
+	s.Handler.NonGoRestfulMux.HandleFunc("/recordmetrics", recordmetrics.HandleFunc(faq, scopes))
 	s.Handler.NonGoRestfulMux.HandleFunc("/loglevel", loglevel.HandleFunc())
 }
 
 // New creates an APIServer for running in flow aggregator.
-func New(faq querier.FlowAggregatorQuerier, bindPort int, cipherSuites []uint16, tlsMinVersion uint16) (*flowAggregatorAPIServer, error) {
+func New(faq querier.FlowAggregatorQuerier, bindPort int, cipherSuites []uint16, tlsMinVersion uint16, apiConsumers []flowaggregatorconfig.APIConsumerConfig, admins []string) (*flowAggregatorAPIServer, error) {
 	cfg, err := newConfig(bindPort)
 	if err != nil {
 		return nil, err
@@ -86,7 +88,7 @@ func New(faq querier.FlowAggregatorQuerier, bindPort int, cipherSuites []uint16,
 	}
 	s.SecureServingInfo.CipherSuites = cipherSuites
 	s.SecureServingInfo.MinTLSVersion = tlsMinVersion
-	installHandlers(s, faq)
+	installHandlers(s, faq, consumers.NewScopes(apiConsumers, admins))
 	return &flowAggregatorAPIServer{GenericAPIServer: s}, nil
 }
 
@@ -0,0 +1,67 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consumers
+
+import (
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+)
+
+// Scopes knows which flow records each user of the Flow Aggregator API is allowed to see.
+type Scopes struct {
+	// admins are the users allowed to see all flow records and the record metrics.
+	admins sets.Set[string]
+	// namespaces maps the consumers to the Namespaces of the flow records they are allowed to see.
+	namespaces map[string]sets.Set[string]
+}
+
+// NewScopes returns the Scopes of the provided consumers and admins. If there is no consumer, all users are admins.
+// Otherwise, the users which are neither consumers nor admins are denied, except for the loopback user of the
+// APIServer, which is used by the antctl running in the flow-aggregator Pod.
+func NewScopes(consumers []flowaggregatorconfig.APIConsumerConfig, admins []string) *Scopes {
+	if len(consumers) == 0 {
+		return &Scopes{}
+	}
+	s := &Scopes{
+		admins:     sets.New[string](admins...).Insert(user.APIServerUser),
+		namespaces: make(map[string]sets.Set[string], len(consumers)),
+	}
+	for _, consumer := range consumers {
+		s.namespaces[consumer.User] = sets.New[string](consumer.Namespaces...)
+	}
+	return s
+}
+
+// Get returns the Namespaces of the flow records the user of the request is allowed to see. all is true if the user
+// is allowed to see all flow records, in which case namespaces is nil. allowed is false if the user is denied.
+func (s *Scopes) Get(r *http.Request) (namespaces sets.Set[string], all bool, allowed bool) {
+	if s == nil || s.namespaces == nil {
+		return nil, true, true
+	}
+	u, ok := request.UserFrom(r.Context())
+	if !ok {
+		return nil, false, false
+	}
+	if s.admins.Has(u.GetName()) {
+		return nil, true, true
+	}
+	namespaces, ok = s.namespaces[u.GetName()]
+	return namespaces, false, ok
+}
@@ -0,0 +1,97 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package consumers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+)
+
+func TestScopesGet(t *testing.T) {
+	apiConsumers := []flowaggregatorconfig.APIConsumerConfig{
+		{
+			User:       "system:serviceaccount:team-a:flow-consumer",
+			Namespaces: []string{"team-a-frontend", "team-a-backend"},
+		},
+	}
+	admins := []string{"system:serviceaccount:flow-aggregator:flow-aggregator"}
+	tests := []struct {
+		name               string
+		apiConsumers       []flowaggregatorconfig.APIConsumerConfig
+		user               user.Info
+		expectedNamespaces sets.Set[string]
+		expectedAll        bool
+		expectedAllowed    bool
+	}{
+		{
+			name:            "no consumers",
+			user:            &user.DefaultInfo{Name: "system:serviceaccount:team-b:flow-consumer"},
+			expectedAll:     true,
+			expectedAllowed: true,
+		},
+		{
+			name:               "consumer",
+			apiConsumers:       apiConsumers,
+			user:               &user.DefaultInfo{Name: "system:serviceaccount:team-a:flow-consumer"},
+			expectedNamespaces: sets.New[string]("team-a-frontend", "team-a-backend"),
+			expectedAllowed:    true,
+		},
+		{
+			name:            "admin",
+			apiConsumers:    apiConsumers,
+			user:            &user.DefaultInfo{Name: "system:serviceaccount:flow-aggregator:flow-aggregator"},
+			expectedAll:     true,
+			expectedAllowed: true,
+		},
+		{
+			name:            "loopback user",
+			apiConsumers:    apiConsumers,
+			user:            &user.DefaultInfo{Name: user.APIServerUser},
+			expectedAll:     true,
+			expectedAllowed: true,
+		},
+		{
+			name:         "unlisted user",
+			apiConsumers: apiConsumers,
+			user:         &user.DefaultInfo{Name: "system:serviceaccount:team-b:flow-consumer"},
+		},
+		{
+			name:         "unauthenticated request",
+			apiConsumers: apiConsumers,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scopes := NewScopes(tt.apiConsumers, admins)
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			require.NoError(t, err)
+			if tt.user != nil {
+				req = req.WithContext(request.WithUser(req.Context(), tt.user))
+			}
+			namespaces, all, allowed := scopes.Get(req)
+			assert.Equal(t, tt.expectedNamespaces, namespaces)
+			assert.Equal(t, tt.expectedAll, all)
+			assert.Equal(t, tt.expectedAllowed, allowed)
+		})
+	}
+}
@@ -20,14 +20,32 @@ import (
 	"strconv"
 
 	"github.com/vmware/go-ipfix/pkg/intermediate"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"antrea.io/antrea/pkg/flowaggregator/apis"
+	"antrea.io/antrea/pkg/flowaggregator/apiserver/consumers"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
 )
 
-// HandleFunc returns the function which can handle the /flowrecords API request.
-func HandleFunc(faq querier.FlowAggregatorQuerier) http.HandlerFunc {
+// recordInNamespaces returns whether the source or destination Pod of the flow record is in one of the Namespaces.
+func recordInNamespaces(record map[string]interface{}, namespaces sets.Set[string]) bool {
+	for _, key := range []string{"sourcePodNamespace", "destinationPodNamespace"} {
+		if namespace, ok := record[key].(string); ok && namespaces.Has(namespace) {
+			return true
+		}
+	}
+	return false
+}
+
+// HandleFunc returns the function which can handle the /flowrecords API request. The consumers are only returned the
+// flow records of their Namespaces.
+func HandleFunc(faq querier.FlowAggregatorQuerier, scopes *consumers.Scopes) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		namespaces, all, allowed := scopes.Get(r)
+		if !allowed {
+			http.Error(w, "User is not allowed to get flow records", http.StatusForbidden)
+			return
+		}
 		var resps []apis.FlowRecordsResponse
 		sourceAddress := r.URL.Query().Get("srcip")
 		destinationAddress := r.URL.Query().Get("dstip")
@@ -69,6 +87,9 @@ func HandleFunc(faq querier.FlowAggregatorQuerier) http.HandlerFunc {
 		}
 		records := faq.GetFlowRecords(flowKey)
 		for _, record := range records {
+			if !all && !recordInNamespaces(record, namespaces) {
+				continue
+			}
 			resps = append(resps, record)
 		}
 		err := json.NewEncoder(w).Encode(resps)
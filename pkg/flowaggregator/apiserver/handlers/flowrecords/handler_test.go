@@ -21,10 +21,15 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/vmware/go-ipfix/pkg/intermediate"
 	"go.uber.org/mock/gomock"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
 
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
 	"antrea.io/antrea/pkg/flowaggregator/apis"
+	"antrea.io/antrea/pkg/flowaggregator/apiserver/consumers"
 	queriertest "antrea.io/antrea/pkg/flowaggregator/querier/testing"
 )
 
@@ -151,7 +156,7 @@ func TestGetFlowRecordsQuery(t *testing.T) {
 			faq := queriertest.NewMockFlowAggregatorQuerier(ctrl)
 			faq.EXPECT().GetFlowRecords(tc.flowKey).Return(tc.records).AnyTimes()
 
-			handler := HandleFunc(faq)
+			handler := HandleFunc(faq, nil)
 			req, err := http.NewRequest(http.MethodGet, tc.query, nil)
 			assert.Nil(t, err)
 			recorder := httptest.NewRecorder()
@@ -174,3 +179,70 @@ func TestGetFlowRecordsQuery(t *testing.T) {
 	}
 
 }
+
+func TestGetFlowRecordsConsumers(t *testing.T) {
+	scopes := consumers.NewScopes([]flowaggregatorconfig.APIConsumerConfig{
+		{
+			User:       "system:serviceaccount:test-namespace-a:flow-consumer",
+			Namespaces: []string{"test-namespace-a"},
+		},
+		{
+			User:       "system:serviceaccount:test-namespace-d:flow-consumer",
+			Namespaces: []string{"test-namespace-d", "test-namespace-e"},
+		},
+	}, []string{"system:serviceaccount:flow-aggregator:flow-aggregator"})
+	testCases := []struct {
+		name             string
+		user             string
+		expectedStatus   int
+		expectedResponse []apis.FlowRecordsResponse
+	}{
+		{
+			name:             "consumer of a Namespace",
+			user:             "system:serviceaccount:test-namespace-a:flow-consumer",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.FlowRecordsResponse{record1, record2},
+		},
+		{
+			name:             "consumer of multiple Namespaces",
+			user:             "system:serviceaccount:test-namespace-d:flow-consumer",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.FlowRecordsResponse{record3},
+		},
+		{
+			name:             "admin",
+			user:             "system:serviceaccount:flow-aggregator:flow-aggregator",
+			expectedStatus:   http.StatusOK,
+			expectedResponse: []apis.FlowRecordsResponse{record1, record2, record3},
+		},
+		{
+			name:           "unlisted user",
+			user:           "system:serviceaccount:test-namespace-b:flow-consumer",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			faq := queriertest.NewMockFlowAggregatorQuerier(ctrl)
+			if tc.expectedStatus == http.StatusOK {
+				faq.EXPECT().GetFlowRecords(nil).Return([]map[string]interface{}{record1, record2, record3})
+			}
+
+			handler := HandleFunc(faq, scopes)
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			require.NoError(t, err)
+			req = req.WithContext(request.WithUser(req.Context(), &user.DefaultInfo{Name: tc.user}))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+
+			if tc.expectedStatus == http.StatusOK {
+				var received []apis.FlowRecordsResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &received))
+				assert.ElementsMatch(t, tc.expectedResponse, received)
+			}
+		})
+	}
+}
@@ -21,16 +21,23 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/flowaggregator/apis"
+	"antrea.io/antrea/pkg/flowaggregator/apiserver/consumers"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
 )
 
-// HandleFunc returns the function which can handle the /recordmetrics API request.
-func HandleFunc(faq querier.FlowAggregatorQuerier) http.HandlerFunc {
+// HandleFunc returns the function which can handle the /recordmetrics API request. The metrics are about all flow
+// records, so they are not returned to the consumers which are only allowed to see some Namespaces.
+func HandleFunc(faq querier.FlowAggregatorQuerier, scopes *consumers.Scopes) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if _, all, _ := scopes.Get(r); !all {
+			http.Error(w, "User is not allowed to get record metrics", http.StatusForbidden)
+			return
+		}
 		metrics := faq.GetRecordMetrics()
 		metricsResponse := apis.RecordMetricsResponse{
 			NumRecordsExported:     metrics.NumRecordsExported,
 			NumRecordsReceived:     metrics.NumRecordsReceived,
+			NumRecordsDropped:      metrics.NumRecordsDropped,
 			NumFlows:               metrics.NumFlows,
 			NumConnToCollector:     metrics.NumConnToCollector,
 			WithClickHouseExporter: metrics.WithClickHouseExporter,
@@ -21,9 +21,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
 
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
 	"antrea.io/antrea/pkg/flowaggregator/apis"
+	"antrea.io/antrea/pkg/flowaggregator/apiserver/consumers"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
 	queriertest "antrea.io/antrea/pkg/flowaggregator/querier/testing"
 )
@@ -34,6 +39,7 @@ func TestRecordMetricsQuery(t *testing.T) {
 	faq.EXPECT().GetRecordMetrics().Return(querier.Metrics{
 		NumRecordsExported:     20,
 		NumRecordsReceived:     15,
+		NumRecordsDropped:      5,
 		NumFlows:               30,
 		NumConnToCollector:     1,
 		WithClickHouseExporter: true,
@@ -42,7 +48,7 @@ func TestRecordMetricsQuery(t *testing.T) {
 		WithIPFIXExporter:      true,
 	})
 
-	handler := HandleFunc(faq)
+	handler := HandleFunc(faq, nil)
 	req, err := http.NewRequest(http.MethodGet, "", nil)
 	assert.Nil(t, err)
 	recorder := httptest.NewRecorder()
@@ -55,6 +61,7 @@ func TestRecordMetricsQuery(t *testing.T) {
 	assert.Equal(t, apis.RecordMetricsResponse{
 		NumRecordsExported:     20,
 		NumRecordsReceived:     15,
+		NumRecordsDropped:      5,
 		NumFlows:               30,
 		NumConnToCollector:     1,
 		WithClickHouseExporter: true,
@@ -63,6 +70,53 @@ func TestRecordMetricsQuery(t *testing.T) {
 		WithIPFIXExporter:      true,
 	}, received)
 
-	assert.Equal(t, received.GetTableRow(0), []string{"20", "15", "30", "1", "true", "true", "true", "true"})
+	assert.Equal(t, received.GetTableRow(0), []string{"20", "15", "5", "30", "1", "true", "true", "true", "true"})
+}
+
+func TestRecordMetricsQueryConsumers(t *testing.T) {
+	scopes := consumers.NewScopes([]flowaggregatorconfig.APIConsumerConfig{
+		{
+			User:       "system:serviceaccount:test-namespace-a:flow-consumer",
+			Namespaces: []string{"test-namespace-a"},
+		},
+	}, []string{"system:serviceaccount:flow-aggregator:flow-aggregator"})
+	testCases := []struct {
+		name           string
+		user           string
+		expectedStatus int
+	}{
+		{
+			name:           "admin",
+			user:           "system:serviceaccount:flow-aggregator:flow-aggregator",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "consumer",
+			user:           "system:serviceaccount:test-namespace-a:flow-consumer",
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "unlisted user",
+			user:           "system:serviceaccount:test-namespace-b:flow-consumer",
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	ctrl := gomock.NewController(t)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			faq := queriertest.NewMockFlowAggregatorQuerier(ctrl)
+			if tc.expectedStatus == http.StatusOK {
+				faq.EXPECT().GetRecordMetrics().Return(querier.Metrics{})
+			}
 
+			handler := HandleFunc(faq, scopes)
+			req, err := http.NewRequest(http.MethodGet, "", nil)
+			require.NoError(t, err)
+			req = req.WithContext(request.WithUser(req.Context(), &user.DefaultInfo{Name: tc.user}))
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, req)
+			assert.Equal(t, tc.expectedStatus, recorder.Code)
+		})
+	}
 }
@@ -27,13 +27,16 @@ import (
 	"net"
 	"time"
 
+	ipfixexporter "github.com/vmware/go-ipfix/pkg/exporter"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/util/env"
+	"antrea.io/antrea/pkg/util/spiffe"
 )
 
 const (
@@ -43,12 +46,40 @@ const (
 	CAConfigMapKey  = "ca.crt"
 	// #nosec G101: false positive triggered by variable name which includes "Secret"
 	ClientSecretName = "flow-aggregator-client-tls"
-	ServiceName      = "flow-aggregator"
+	// CASecretName is the name of the Secret storing the CA certificate and key shared by the replicas of a sharded
+	// flow aggregator.
+	// #nosec G101: false positive triggered by variable name which includes "Secret"
+	CASecretName = "flow-aggregator-ca-tls"
+	ServiceName  = "flow-aggregator"
+
+	// caSecretNextCertKey and caSecretNextKeyKey are the keys of the next shared CA certificate and key in the CA
+	// Secret.
+	caSecretNextCertKey = "next.crt"
+	caSecretNextKeyKey  = "next.key"
+	// caSecretPreviousCertKey is the key of the previous shared CA certificate in the CA Secret.
+	caSecretPreviousCertKey = "previous.crt"
 )
 
 var (
 	validFrom = time.Now().Add(-time.Hour) // valid an hour earlier to avoid flakes due to clock skew
 	maxAge    = time.Hour * 24 * 365       // one year self-signed certs
+
+	// svidCheckInterval is the interval at which the SVID used by the collecting process is checked for rotation.
+	svidCheckInterval = time.Minute
+	// exitOnSVIDRotation is called when the SVID used by the collecting process has been rotated.
+	exitOnSVIDRotation = func() {
+		klog.FlushAndExit(klog.ExitFlushTimeout, 0)
+	}
+
+	// sharedCARotationOverlap is how long the next CA certificate shared by the replicas of a sharded flow aggregator
+	// is trusted before it replaces the current one, and how long before the current one expires it is replaced.
+	sharedCARotationOverlap = 30 * 24 * time.Hour
+	// sharedCACheckInterval is the interval at which the shared CA certificates are checked for rotation.
+	sharedCACheckInterval = time.Hour
+	// exitOnSharedCARotation is called when the shared CA certificates have been rotated.
+	exitOnSharedCARotation = func() {
+		klog.FlushAndExit(klog.ExitFlushTimeout, 0)
+	}
 )
 
 func getFlowAggregatorNamespace() string {
@@ -60,13 +91,17 @@ func getFlowAggregatorNamespace() string {
 }
 
 func generateCACertKey() (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
+	return generateCACertKeyValidFrom(validFrom)
+}
+
+func generateCACertKeyValidFrom(notBefore time.Time) (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
 	cert := &x509.Certificate{
 		SerialNumber: big.NewInt(1),
 		Subject: pkix.Name{
 			CommonName: fmt.Sprintf("flow-aggregator-ca@%d", time.Now().Unix()),
 		},
-		NotBefore:             validFrom,
-		NotAfter:              validFrom.Add(maxAge),
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(maxAge),
 		IsCA:                  true,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
 		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
@@ -91,12 +126,203 @@ func generateCACertKey() (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
 	return cert, caKey, caPEM.Bytes(), err
 }
 
-func getFlowAggregatorServerNames() []string {
+// caCertKey is a CA certificate and key shared by the replicas of a sharded flow aggregator.
+type caCertKey struct {
+	cert    *x509.Certificate
+	key     *rsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func newCACertKey(now time.Time) (*caCertKey, error) {
+	// The CA certificate is valid an hour earlier to avoid flakes due to clock skew.
+	cert, key, certPEM, err := generateCACertKeyValidFrom(now.Add(-time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(key),
+	})
+	return &caCertKey{cert: cert, key: key, certPEM: certPEM, keyPEM: keyPEM}, nil
+}
+
+func parseCACertKeyPEM(certPEM, keyPEM []byte) (*caCertKey, error) {
+	cert, key, err := parseCACertKey(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &caCertKey{cert: cert, key: key, certPEM: certPEM, keyPEM: keyPEM}, nil
+}
+
+// sharedCA holds the CA certificates shared by the replicas of a sharded flow aggregator. current is used to sign the
+// certificates of the replicas and of the flow exporters. next and previous are only trusted, before current is
+// replaced with next, and after current has been replaced until it expires, respectively.
+type sharedCA struct {
+	current         *caCertKey
+	next            *caCertKey
+	previousCertPEM []byte
+}
+
+// bundlePEM returns the CA certificates which must be trusted.
+func (ca *sharedCA) bundlePEM() []byte {
+	bundle := bytes.Clone(ca.current.certPEM)
+	if ca.next != nil {
+		bundle = append(bundle, ca.next.certPEM...)
+	}
+	return append(bundle, ca.previousCertPEM...)
+}
+
+func (ca *sharedCA) secretData() map[string][]byte {
+	data := map[string][]byte{
+		v1.TLSCertKey:       ca.current.certPEM,
+		v1.TLSPrivateKeyKey: ca.current.keyPEM,
+	}
+	if ca.next != nil {
+		data[caSecretNextCertKey] = ca.next.certPEM
+		data[caSecretNextKeyKey] = ca.next.keyPEM
+	}
+	if ca.previousCertPEM != nil {
+		data[caSecretPreviousCertKey] = ca.previousCertPEM
+	}
+	return data
+}
+
+// rotateSharedCA returns the shared CA certificates stored in the data of the CA Secret, after rotating them if needed,
+// and whether they have been changed. The next CA certificate is generated 2*sharedCARotationOverlap before the current
+// one expires, and replaces it sharedCARotationOverlap before it expires. This way, the replicas and the flow exporters
+// trust the next CA certificate before it is used to sign certificates, and still trust the previous one after.
+func rotateSharedCA(data map[string][]byte, now time.Time) (*sharedCA, bool, error) {
+	ca := &sharedCA{}
+	changed := false
+	current, err := parseCACertKeyPEM(data[v1.TLSCertKey], data[v1.TLSPrivateKeyKey])
+	if err == nil && now.Before(current.cert.NotAfter) {
+		ca.current = current
+	} else if data[v1.TLSCertKey] != nil {
+		klog.InfoS("CA certificate shared by FlowAggregator replicas is invalid or expired", "secret", CASecretName, "err", err)
+	}
+	if next, err := parseCACertKeyPEM(data[caSecretNextCertKey], data[caSecretNextKeyKey]); err == nil && now.Before(next.cert.NotAfter) {
+		ca.next = next
+	} else if data[caSecretNextCertKey] != nil {
+		changed = true
+	}
+	if previous, err := parseCertPEM(data[caSecretPreviousCertKey]); err == nil && now.Before(previous.NotAfter) {
+		ca.previousCertPEM = data[caSecretPreviousCertKey]
+	} else if data[caSecretPreviousCertKey] != nil {
+		changed = true
+	}
+
+	if ca.current == nil {
+		if ca.next != nil {
+			ca.current, ca.next = ca.next, nil
+		} else if ca.current, err = newCACertKey(now); err != nil {
+			return nil, false, err
+		}
+		klog.InfoS("Using new CA certificate shared by FlowAggregator replicas", "secret", CASecretName)
+		changed = true
+	} else if ca.next != nil && ca.current.cert.NotAfter.Sub(now) <= sharedCARotationOverlap {
+		klog.InfoS("Replacing CA certificate shared by FlowAggregator replicas with the next one", "secret", CASecretName)
+		ca.previousCertPEM = ca.current.certPEM
+		ca.current, ca.next = ca.next, nil
+		changed = true
+	}
+	if ca.next == nil && ca.current.cert.NotAfter.Sub(now) <= 2*sharedCARotationOverlap {
+		klog.InfoS("Generating next CA certificate shared by FlowAggregator replicas", "secret", CASecretName)
+		if ca.next, err = newCACertKey(now); err != nil {
+			return nil, false, err
+		}
+		changed = true
+	}
+	return ca, changed, nil
+}
+
+// getOrCreateSharedCACertKey returns the CA certificate and key stored in the CA Secret, so that all the replicas of a
+// sharded flow aggregator trust each other and the flow exporters trust all of them, and the PEM bundle of the CA
+// certificates which must be trusted. They are generated and stored by the first replica which starts, and rotated
+// with an overlap by rotateSharedCA.
+func getOrCreateSharedCACertKey(k8sClient kubernetes.Interface) (*x509.Certificate, *rsa.PrivateKey, []byte, error) {
 	namespace := getFlowAggregatorNamespace()
-	return []string{ServiceName + "." + namespace + ".svc"}
+	var ca *sharedCA
+	// Another replica may store or rotate the CA certificates concurrently, in which case they are read again.
+	if err := retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return errors.IsAlreadyExists(err) || errors.IsConflict(err)
+	}, func() error {
+		secret, err := k8sClient.CoreV1().Secrets(namespace).Get(context.TODO(), CASecretName, metav1.GetOptions{})
+		exists := true
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return fmt.Errorf("error getting Secret %s: %w", CASecretName, err)
+			}
+			exists = false
+			secret = &v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      CASecretName,
+					Namespace: namespace,
+					Labels: map[string]string{
+						"app": "flow-aggregator",
+					},
+				},
+				Type: v1.SecretTypeTLS,
+			}
+		}
+		var changed bool
+		ca, changed, err = rotateSharedCA(secret.Data, time.Now())
+		if err != nil || !changed {
+			return err
+		}
+		secret.Data = ca.secretData()
+		if exists {
+			_, err = k8sClient.CoreV1().Secrets(namespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+		} else {
+			_, err = k8sClient.CoreV1().Secrets(namespace).Create(context.TODO(), secret, metav1.CreateOptions{})
+		}
+		return err
+	}); err != nil {
+		return nil, nil, nil, fmt.Errorf("error storing CA certificate in Secret %s: %w", CASecretName, err)
+	}
+	return ca.current.cert, ca.current.key, ca.bundlePEM(), nil
+}
+
+func parseCertPEM(certPEM []byte) (*x509.Certificate, error) {
+	certBlock, _ := pem.Decode(certPEM)
+	if certBlock == nil {
+		return nil, fmt.Errorf("failed to decode certificate")
+	}
+	return x509.ParseCertificate(certBlock.Bytes)
+}
+
+func parseCACertKey(certPEM, keyPEM []byte) (*x509.Certificate, *rsa.PrivateKey, error) {
+	certBlock, _ := pem.Decode(certPEM)
+	if certBlock == nil {
+		return nil, nil, fmt.Errorf("failed to decode CA certificate")
+	}
+	caCert, err := x509.ParseCertificate(certBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+	keyBlock, _ := pem.Decode(keyPEM)
+	if keyBlock == nil {
+		return nil, nil, fmt.Errorf("failed to decode CA key")
+	}
+	caKey, err := x509.ParsePKCS1PrivateKey(keyBlock.Bytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CA key: %w", err)
+	}
+	return caCert, caKey, nil
 }
 
-func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer bool, flowAggregatorAddress string) ([]byte, []byte, error) {
+// getFlowAggregatorServerNames returns the DNS names of the flow aggregator, including the DNS name of the headless
+// Service selecting its replicas if it is sharded.
+func getFlowAggregatorServerNames(headlessService string) []string {
+	namespace := getFlowAggregatorNamespace()
+	serverNames := []string{ServiceName + "." + namespace + ".svc"}
+	if headlessService != "" {
+		serverNames = append(serverNames, headlessService+"."+namespace+".svc")
+	}
+	return serverNames
+}
+
+func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer bool, flowAggregatorAddress string, headlessService string) ([]byte, []byte, error) {
 	var cert *x509.Certificate
 	if isServer {
 		cert = &x509.Certificate{
@@ -108,7 +334,7 @@ func generateCertKey(caCert *x509.Certificate, caKey *rsa.PrivateKey, isServer b
 			NotAfter:    validFrom.Add(maxAge),
 			ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
 			KeyUsage:    x509.KeyUsageDigitalSignature,
-			DNSNames:    getFlowAggregatorServerNames(),
+			DNSNames:    getFlowAggregatorServerNames(headlessService),
 		}
 		if flowAggregatorAddress != "" {
 			if ip := net.ParseIP(flowAggregatorAddress); ip != nil {
@@ -221,3 +447,108 @@ func syncCAAndClientCert(caCert, clientCert, clientKey []byte, k8sClient kuberne
 	}
 	return nil
 }
+
+// getCollectorCertificates returns the CA certificate used to authenticate the flow exporters, and the certificate and
+// key used by the collecting process. When SPIFFE is enabled, they are the trust bundle and the X.509 SVID of the flow
+// aggregator. Otherwise, self-signed certificates are generated, and the CA certificate and a client certificate are
+// synced to the ConfigMap and Secret read by the flow exporters. When sharding is enabled, the CA is shared by all the
+// replicas, and the client certificate is also used to forward records to the other replicas.
+func (fa *flowAggregator) getCollectorCertificates() ([]byte, []byte, []byte, error) {
+	if fa.spiffeCertDir != "" {
+		source, err := spiffe.LoadX509Source(fa.spiffeCertDir)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("error when loading SVID: %w", err)
+		}
+		klog.InfoS("Using SVID for the collecting process", "spiffeID", source.ID)
+		fa.spiffeSource = source
+		return source.BundlePEM, source.CertPEM, source.KeyPEM, nil
+	}
+	var parentCert *x509.Certificate
+	var privateKey *rsa.PrivateKey
+	var caCert []byte
+	var err error
+	if fa.shardingHeadlessService != "" {
+		parentCert, privateKey, caCert, err = getOrCreateSharedCACertKey(fa.k8sClient)
+	} else {
+		parentCert, privateKey, caCert, err = generateCACertKey()
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error when generating CA certificate: %v", err)
+	}
+	serverCert, serverKey, err := generateCertKey(parentCert, privateKey, true, fa.flowAggregatorAddress, fa.shardingHeadlessService)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error when creating server certificate: %v", err)
+	}
+
+	clientCert, clientKey, err := generateCertKey(parentCert, privateKey, false, "", "")
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error when creating client certificate: %v", err)
+	}
+	err = syncCAAndClientCert(caCert, clientCert, clientKey, fa.k8sClient)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error when synchronizing client certificate: %v", err)
+	}
+	if fa.shardingHeadlessService != "" {
+		fa.sharedCAPEM = caCert
+		fa.peerTLSConfig = &ipfixexporter.ExporterTLSClientConfig{
+			ServerName: fa.shardingHeadlessService + "." + getFlowAggregatorNamespace() + ".svc",
+			CAData:     caCert,
+			CertData:   clientCert,
+			KeyData:    clientKey,
+		}
+	}
+	return caCert, serverCert, serverKey, nil
+}
+
+// watchSVID checks periodically whether the SVID used by the collecting process has been rotated, until stopCh is
+// closed. As the collecting process cannot update its certificate while running, the flow aggregator exits when it
+// happens, to be restarted with the new SVID before the previous one expires.
+func (fa *flowAggregator) watchSVID(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(svidCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			source, err := spiffe.LoadX509Source(fa.spiffeCertDir)
+			if err != nil {
+				klog.ErrorS(err, "Failed to load SVID")
+				continue
+			}
+			if !source.Equal(fa.spiffeSource) {
+				klog.InfoS("SVID has been rotated, restarting flow aggregator", "spiffeID", source.ID)
+				exitOnSVIDRotation()
+				// Keep watching if the flow aggregator has not exited, so that the next rotation is handled too.
+				fa.spiffeSource = source
+			}
+		}
+	}
+}
+
+// watchSharedCA checks periodically whether the CA certificates shared by the replicas of a sharded flow aggregator
+// must be rotated or have been rotated by another replica, until stopCh is closed. As the collecting process cannot
+// update its certificates while running, the flow aggregator exits when the trusted CA certificates change, to be
+// restarted with certificates signed by the current CA and trusting all the CA certificates in use.
+func (fa *flowAggregator) watchSharedCA(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(sharedCACheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			_, _, caPEM, err := getOrCreateSharedCACertKey(fa.k8sClient)
+			if err != nil {
+				klog.ErrorS(err, "Failed to check CA certificates shared by FlowAggregator replicas")
+				continue
+			}
+			if !bytes.Equal(caPEM, fa.sharedCAPEM) {
+				klog.InfoS("CA certificates shared by FlowAggregator replicas have been rotated, restarting flow aggregator")
+				exitOnSharedCARotation()
+				// Keep watching if the flow aggregator has not exited, so that the next rotation is handled too.
+				fa.sharedCAPEM = caPEM
+			}
+		}
+	}
+}
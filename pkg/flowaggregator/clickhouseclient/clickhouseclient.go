@@ -21,12 +21,12 @@ import (
 	"database/sql"
 	"fmt"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/gammazero/deque"
-	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
@@ -96,6 +96,25 @@ const (
                            ?, ?, ?, ?, ?)`
 )
 
+// insertQueryWithPodOwners extends insertQuery with the Pod owner columns. These columns are only
+// written when recordContents.podOwners is enabled, so that the default configuration keeps working
+// with existing ClickHouse schemas.
+var insertQueryWithPodOwners = strings.NewReplacer(
+	"egressNodeName)", `egressNodeName,
+                   sourcePodOwnerKind,
+                   sourcePodOwnerName,
+                   destinationPodOwnerKind,
+                   destinationPodOwnerName)`,
+	"?, ?, ?, ?, ?)", "?, ?, ?, ?, ?, ?, ?, ?, ?)",
+).Replace(insertQuery)
+
+func getInsertQuery(includePodOwners bool) string {
+	if includePodOwners {
+		return insertQueryWithPodOwners
+	}
+	return insertQuery
+}
+
 // PrepareClickHouseConnection is used for unit testing
 var PrepareClickHouseConnection = prepareConnection
 
@@ -118,7 +137,11 @@ type ClickHouseExportProcess struct {
 	// exportWg is to ensure that all messages have been flushed from the queue when we stop
 	exportWg sync.WaitGroup
 	// commitTicker is a ticker, containing a channel used to trigger batchCommitAll() for every commitInterval period
-	commitTicker         *time.Ticker
+	commitTicker *time.Ticker
+	// commitCh is used to trigger batchCommitAll() before the end of the commitInterval period,
+	// when the number of cached records reaches maxBatchSize.
+	commitCh             chan struct{}
+	maxBatchSize         int
 	exportProcessRunning bool
 	// mutex protects configuration state from concurrent access
 	mutex       sync.Mutex
@@ -136,6 +159,25 @@ type ClickHouseConfig struct {
 	CACert             bool
 	InsecureSkipVerify bool
 	Certificate        []byte
+	// IncludePodOwners determines whether the Pod owner columns are written.
+	IncludePodOwners bool
+	// TableName is the name of the table to which flow records are written. It defaults to
+	// DefaultTableName.
+	TableName string
+	// Columns is the list of columns written for each flow record. All the columns are written
+	// when it is empty.
+	Columns []string
+	// MaxBatchSize is the maximum number of records committed in a single INSERT query. Records
+	// are committed before the end of the CommitInterval period once it is reached. There is no
+	// limit when it is 0.
+	MaxBatchSize int
+	// CreateTable determines whether the table is created when it doesn't exist yet.
+	CreateTable bool
+	// PartitionBy is the partition key used when creating the table.
+	PartitionBy string
+	// TTL is the time-to-live of flow records in the table, which is managed by the Flow
+	// Aggregator when CreateTable is true. Records are never expired when it is 0.
+	TTL time.Duration
 }
 
 func NewClickHouseClient(config ClickHouseConfig, clusterUUID string) (*ClickHouseExportProcess, error) {
@@ -149,23 +191,29 @@ func NewClickHouseClient(config ClickHouseConfig, clusterUUID string) (*ClickHou
 	}
 
 	chClient := &ClickHouseExportProcess{
-		db:          connect,
-		config:      config,
-		queueSize:   maxQueueSize,
-		clusterUUID: clusterUUID,
+		db:           connect,
+		config:       config,
+		queueSize:    maxQueueSize,
+		clusterUUID:  clusterUUID,
+		commitCh:     make(chan struct{}, 1),
+		maxBatchSize: config.MaxBatchSize,
 	}
 	return chClient, nil
 }
 
-func (ch *ClickHouseExportProcess) CacheRecord(record ipfixentities.Record) {
-	chRow := flowrecord.GetFlowRecord(record)
-
+func (ch *ClickHouseExportProcess) CacheRecord(chRow *flowrecord.FlowRecord) {
 	ch.dequeMutex.Lock()
 	defer ch.dequeMutex.Unlock()
 	for ch.deque.Len() >= ch.queueSize {
 		ch.deque.PopFront()
 	}
 	ch.deque.PushBack(chRow)
+	if ch.maxBatchSize > 0 && ch.deque.Len() >= ch.maxBatchSize {
+		select {
+		case ch.commitCh <- struct{}{}:
+		default:
+		}
+	}
 }
 
 func (ch *ClickHouseExportProcess) Start() {
@@ -234,6 +282,11 @@ func (ch *ClickHouseExportProcess) flowRecordPeriodicCommit() {
 			if err == nil {
 				committedRec += committed
 			}
+		case <-ch.commitCh:
+			committed, err := ch.batchCommitAll(ctx)
+			if err == nil {
+				committedRec += committed
+			}
 		case <-logTicker.C:
 			klog.V(4).InfoS("Total number of records committed to DB", "count", committedRec)
 			committedRec = 0
@@ -241,10 +294,29 @@ func (ch *ClickHouseExportProcess) flowRecordPeriodicCommit() {
 	}
 }
 
-// batchCommitAll commits all flow records cached in local deque in one INSERT query.
-// Returns the number of records successfully committed, and error if encountered.
-// Cached records will be removed only after successful commit.
+// batchCommitAll commits all flow records cached in local deque, in INSERT queries of at most
+// MaxBatchSize records. Returns the number of records successfully committed, and error if
+// encountered.
 func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, error) {
+	ch.dequeMutex.Lock()
+	// Records cached while committing may be left for the next commit.
+	currSize := ch.deque.Len()
+	ch.dequeMutex.Unlock()
+	committed := 0
+	for committed < currSize {
+		n, err := ch.batchCommit(ctx)
+		committed += n
+		if err != nil || n == 0 {
+			return committed, err
+		}
+	}
+	return committed, nil
+}
+
+// batchCommit commits flow records cached in local deque in one INSERT query, up to
+// MaxBatchSize records. Returns the number of records successfully committed, and error if
+// encountered. Cached records will be removed only after successful commit.
+func (ch *ClickHouseExportProcess) batchCommit(ctx context.Context) (int, error) {
 	ch.dequeMutex.Lock()
 	currSize := ch.deque.Len()
 	ch.dequeMutex.Unlock()
@@ -257,7 +329,7 @@ func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, err
 	// start new connection
 	tx, err := ch.db.BeginTx(ctx, nil)
 	if err == nil {
-		stmt, err = tx.PrepareContext(ctx, insertQuery)
+		stmt, err = tx.PrepareContext(ctx, getInsertQueryForConfig(&ch.config))
 	}
 	if err != nil {
 		klog.ErrorS(err, "Error when preparing insert statement")
@@ -269,69 +341,19 @@ func (ch *ClickHouseExportProcess) batchCommitAll(ctx context.Context) (int, err
 	ch.dequeMutex.Lock()
 	// currSize could have increased due to CacheRecord being called in between.
 	currSize = ch.deque.Len()
+	if ch.config.MaxBatchSize > 0 && currSize > ch.config.MaxBatchSize {
+		currSize = ch.config.MaxBatchSize
+	}
 	recordsToExport := make([]*flowrecord.FlowRecord, 0, currSize)
 	for range currSize {
 		recordsToExport = append(recordsToExport, ch.deque.PopFront())
 	}
 	ch.dequeMutex.Unlock()
 
+	cols := getColumns(&ch.config)
 	for _, record := range recordsToExport {
-		_, err := stmt.ExecContext(
-			ctx,
-			record.FlowStartSeconds,
-			record.FlowEndSeconds,
-			record.FlowEndSecondsFromSourceNode,
-			record.FlowEndSecondsFromDestinationNode,
-			record.FlowEndReason,
-			record.SourceIP,
-			record.DestinationIP,
-			record.SourceTransportPort,
-			record.DestinationTransportPort,
-			record.ProtocolIdentifier,
-			record.PacketTotalCount,
-			record.OctetTotalCount,
-			record.PacketDeltaCount,
-			record.OctetDeltaCount,
-			record.ReversePacketTotalCount,
-			record.ReverseOctetTotalCount,
-			record.ReversePacketDeltaCount,
-			record.ReverseOctetDeltaCount,
-			record.SourcePodName,
-			record.SourcePodNamespace,
-			record.SourceNodeName,
-			record.DestinationPodName,
-			record.DestinationPodNamespace,
-			record.DestinationNodeName,
-			record.DestinationClusterIP,
-			record.DestinationServicePort,
-			record.DestinationServicePortName,
-			record.IngressNetworkPolicyName,
-			record.IngressNetworkPolicyNamespace,
-			record.IngressNetworkPolicyRuleName,
-			record.IngressNetworkPolicyRuleAction,
-			record.IngressNetworkPolicyType,
-			record.EgressNetworkPolicyName,
-			record.EgressNetworkPolicyNamespace,
-			record.EgressNetworkPolicyRuleName,
-			record.EgressNetworkPolicyRuleAction,
-			record.EgressNetworkPolicyType,
-			record.TcpState,
-			record.FlowType,
-			record.SourcePodLabels,
-			record.DestinationPodLabels,
-			record.Throughput,
-			record.ReverseThroughput,
-			record.ThroughputFromSourceNode,
-			record.ThroughputFromDestinationNode,
-			record.ReverseThroughputFromSourceNode,
-			record.ReverseThroughputFromDestinationNode,
-			ch.clusterUUID,
-			record.EgressName,
-			record.EgressIP,
-			record.AppProtocolName,
-			record.HttpVals,
-			record.EgressNodeName,
-		)
+		args := getRecordArgs(cols, record, ch.clusterUUID)
+		_, err := stmt.ExecContext(ctx, args...)
 
 		if err != nil {
 			klog.ErrorS(err, "Error when adding record")
@@ -369,10 +391,15 @@ func prepareConnection(config ClickHouseConfig) (*sql.DB, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error when connecting to ClickHouse, %w", err)
 	}
+	if config.CreateTable {
+		if err := createTable(connect, &config); err != nil {
+			return nil, err
+		}
+	}
 	// Test open Transaction
 	tx, err := connect.Begin()
 	if err == nil {
-		_, err = tx.Prepare(insertQuery)
+		_, err = tx.Prepare(getInsertQueryForConfig(&config))
 	}
 	if err != nil {
 		return nil, fmt.Errorf("error when preparing insert statement, %v", err)
@@ -381,6 +408,20 @@ func prepareConnection(config ClickHouseConfig) (*sql.DB, error) {
 	return connect, err
 }
 
+// createTable creates the table to which flow records are written if it doesn't exist yet, and
+// updates its TTL otherwise, so that a TTL change in the configuration applies to existing tables.
+func createTable(connect *sql.DB, config *ClickHouseConfig) error {
+	if _, err := connect.Exec(getCreateTableQuery(config)); err != nil {
+		return fmt.Errorf("error when creating table %s: %w", getTableName(config), err)
+	}
+	if config.TTL > 0 {
+		if _, err := connect.Exec(getModifyTTLQuery(config)); err != nil {
+			return fmt.Errorf("error when updating TTL of table %s: %w", getTableName(config), err)
+		}
+	}
+	return nil
+}
+
 func (ch *ClickHouseExportProcess) UpdateCH(config ClickHouseConfig, connect *sql.DB) {
 	ch.stopExportProcess(false) // do not flush the queue
 	defer ch.startExportProcess()
@@ -388,6 +429,9 @@ func (ch *ClickHouseExportProcess) UpdateCH(config ClickHouseConfig, connect *sq
 	defer ch.mutex.Unlock()
 	ch.config = config
 	ch.db = connect
+	ch.dequeMutex.Lock()
+	defer ch.dequeMutex.Unlock()
+	ch.maxBatchSize = config.MaxBatchSize
 }
 
 func (ch *ClickHouseExportProcess) GetCommitInterval() time.Duration {
@@ -19,6 +19,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -52,14 +53,14 @@ func TestCacheRecord(t *testing.T) {
 	// First call. only populate row.
 	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, true)
-	chExportProc.CacheRecord(mockRecord)
+	chExportProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 	assert.Equal(t, 1, chExportProc.deque.Len())
 	assert.Equal(t, "10.10.0.79", chExportProc.deque.At(0).SourceIP)
 
 	// Second call. discard prev row and add new row.
 	mockRecord = ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, false)
-	chExportProc.CacheRecord(mockRecord)
+	chExportProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 	assert.Equal(t, 1, chExportProc.deque.Len())
 	assert.Equal(t, "2001:0:3238:dfe1:63::fefb", chExportProc.deque.At(0).SourceIP)
 }
@@ -152,9 +153,11 @@ func TestBatchCommitAllMultiRecord(t *testing.T) {
 
 	chExportProc := &ClickHouseExportProcess{
 		db:        db,
+		config:    ClickHouseConfig{IncludePodOwners: true},
 		queueSize: maxQueueSize,
 	}
 	recordRow := flowrecord.FlowRecord{}
+	// All FlowRecord fields are written, as well as the clusterUUID.
 	fieldCount := reflect.TypeOf(recordRow).NumField() + 1
 	argList := make([]driver.Value, fieldCount)
 	for i := 0; i < len(argList); i++ {
@@ -162,7 +165,7 @@ func TestBatchCommitAllMultiRecord(t *testing.T) {
 	}
 
 	mock.ExpectBegin()
-	expected := mock.ExpectPrepare(insertQuery)
+	expected := mock.ExpectPrepare(insertQueryWithPodOwners)
 	for i := 0; i < 10; i++ {
 		chExportProc.deque.PushBack(&recordRow)
 		expected.ExpectExec().WithArgs(argList...).WillReturnResult(sqlmock.NewResult(int64(i), 1))
@@ -176,6 +179,48 @@ func TestBatchCommitAllMultiRecord(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet(), "unfulfilled expectations for db sql operation")
 }
 
+func TestBatchCommitAllMaxBatchSize(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	require.NoError(t, err, "error when opening a stub database connection")
+	defer db.Close()
+
+	config := ClickHouseConfig{
+		TableName:    "flows_v2",
+		Columns:      []string{"sourceIP", "clusterUUID"},
+		MaxBatchSize: 4,
+	}
+	chExportProc := &ClickHouseExportProcess{
+		db:           db,
+		config:       config,
+		queueSize:    maxQueueSize,
+		clusterUUID:  fakeClusterUUID,
+		commitCh:     make(chan struct{}, 1),
+		maxBatchSize: config.MaxBatchSize,
+	}
+	recordRow := flowrecord.FlowRecord{SourceIP: "10.10.0.1"}
+	for i := 0; i < 10; i++ {
+		chExportProc.CacheRecord(&recordRow)
+	}
+	// A commit is triggered once the number of cached records reaches MaxBatchSize.
+	assert.Len(t, chExportProc.commitCh, 1)
+
+	// Records are committed in batches of at most MaxBatchSize records.
+	for _, batchSize := range []int{4, 4, 2} {
+		mock.ExpectBegin()
+		expected := mock.ExpectPrepare("INSERT INTO flows_v2 (sourceIP, clusterUUID) VALUES (?, ?)")
+		for i := 0; i < batchSize; i++ {
+			expected.ExpectExec().WithArgs("10.10.0.1", fakeClusterUUID).WillReturnResult(sqlmock.NewResult(int64(i), 1))
+		}
+		mock.ExpectCommit()
+	}
+
+	count, err := chExportProc.batchCommitAll(context.Background())
+	assert.NoError(t, err, "error occurred when committing record with mock sql db")
+	assert.Equal(t, 10, count)
+	assert.Equal(t, 0, chExportProc.deque.Len())
+	assert.NoError(t, mock.ExpectationsWereMet(), "unfulfilled expectations for db sql operation")
+}
+
 func TestBatchCommitAllError(t *testing.T) {
 	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
 	require.NoError(t, err, "error when opening a stub database connection")
@@ -187,7 +232,8 @@ func TestBatchCommitAllError(t *testing.T) {
 	}
 	recordRow := flowrecord.FlowRecord{}
 	chExportProc.deque.PushBack(&recordRow)
-	fieldCount := reflect.TypeOf(recordRow).NumField() + 1
+	// The 4 Pod owner fields are not written by default, but the clusterUUID is.
+	fieldCount := reflect.TypeOf(recordRow).NumField() - 4 + 1
 	argList := make([]driver.Value, fieldCount)
 	for i := 0; i < len(argList); i++ {
 		argList[i] = sqlmock.AnyArg()
@@ -205,6 +251,16 @@ func TestBatchCommitAllError(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet(), "unfulfilled expectations for db sql operation")
 }
 
+func TestGetInsertQuery(t *testing.T) {
+	assert.Equal(t, insertQuery, getInsertQuery(false))
+	query := getInsertQuery(true)
+	for _, column := range []string{"sourcePodOwnerKind", "sourcePodOwnerName", "destinationPodOwnerKind", "destinationPodOwnerName"} {
+		assert.Contains(t, query, column)
+		assert.NotContains(t, insertQuery, column)
+	}
+	assert.Equal(t, strings.Count(insertQuery, "?")+4, strings.Count(query, "?"))
+}
+
 func TestPushRecordsToFrontOfQueue(t *testing.T) {
 	chExportProc := &ClickHouseExportProcess{
 		queueSize: 4,
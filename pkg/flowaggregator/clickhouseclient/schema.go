@@ -0,0 +1,227 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouseclient
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+)
+
+const (
+	// DefaultTableName is the name of the table to which flow records are written by default.
+	DefaultTableName = "flows"
+	// timeInsertedColumn is set by ClickHouse when a record is inserted, it is never written by
+	// the Flow Aggregator. It is used as the sorting key and as the TTL reference.
+	timeInsertedColumn = "timeInserted"
+)
+
+type column struct {
+	name   string
+	chType string
+	value  func(record *flowrecord.FlowRecord, clusterUUID string) any
+}
+
+// columns are all the columns which can be written by the Flow Aggregator, in the order in
+// which they are written.
+var columns = []column{
+	{"flowStartSeconds", "DateTime", func(r *flowrecord.FlowRecord, _ string) any { return r.FlowStartSeconds }},
+	{"flowEndSeconds", "DateTime", func(r *flowrecord.FlowRecord, _ string) any { return r.FlowEndSeconds }},
+	{"flowEndSecondsFromSourceNode", "DateTime", func(r *flowrecord.FlowRecord, _ string) any { return r.FlowEndSecondsFromSourceNode }},
+	{"flowEndSecondsFromDestinationNode", "DateTime", func(r *flowrecord.FlowRecord, _ string) any { return r.FlowEndSecondsFromDestinationNode }},
+	{"flowEndReason", "UInt8", func(r *flowrecord.FlowRecord, _ string) any { return r.FlowEndReason }},
+	{"sourceIP", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.SourceIP }},
+	{"destinationIP", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationIP }},
+	{"sourceTransportPort", "UInt16", func(r *flowrecord.FlowRecord, _ string) any { return r.SourceTransportPort }},
+	{"destinationTransportPort", "UInt16", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationTransportPort }},
+	{"protocolIdentifier", "UInt8", func(r *flowrecord.FlowRecord, _ string) any { return r.ProtocolIdentifier }},
+	{"packetTotalCount", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.PacketTotalCount }},
+	{"octetTotalCount", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.OctetTotalCount }},
+	{"packetDeltaCount", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.PacketDeltaCount }},
+	{"octetDeltaCount", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.OctetDeltaCount }},
+	{"reversePacketTotalCount", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.ReversePacketTotalCount }},
+	{"reverseOctetTotalCount", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.ReverseOctetTotalCount }},
+	{"reversePacketDeltaCount", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.ReversePacketDeltaCount }},
+	{"reverseOctetDeltaCount", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.ReverseOctetDeltaCount }},
+	{"sourcePodName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.SourcePodName }},
+	{"sourcePodNamespace", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.SourcePodNamespace }},
+	{"sourceNodeName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.SourceNodeName }},
+	{"destinationPodName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationPodName }},
+	{"destinationPodNamespace", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationPodNamespace }},
+	{"destinationNodeName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationNodeName }},
+	{"destinationClusterIP", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationClusterIP }},
+	{"destinationServicePort", "UInt16", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationServicePort }},
+	{"destinationServicePortName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationServicePortName }},
+	{"ingressNetworkPolicyName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.IngressNetworkPolicyName }},
+	{"ingressNetworkPolicyNamespace", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.IngressNetworkPolicyNamespace }},
+	{"ingressNetworkPolicyRuleName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.IngressNetworkPolicyRuleName }},
+	{"ingressNetworkPolicyRuleAction", "UInt8", func(r *flowrecord.FlowRecord, _ string) any { return r.IngressNetworkPolicyRuleAction }},
+	{"ingressNetworkPolicyType", "UInt8", func(r *flowrecord.FlowRecord, _ string) any { return r.IngressNetworkPolicyType }},
+	{"egressNetworkPolicyName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.EgressNetworkPolicyName }},
+	{"egressNetworkPolicyNamespace", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.EgressNetworkPolicyNamespace }},
+	{"egressNetworkPolicyRuleName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.EgressNetworkPolicyRuleName }},
+	{"egressNetworkPolicyRuleAction", "UInt8", func(r *flowrecord.FlowRecord, _ string) any { return r.EgressNetworkPolicyRuleAction }},
+	{"egressNetworkPolicyType", "UInt8", func(r *flowrecord.FlowRecord, _ string) any { return r.EgressNetworkPolicyType }},
+	{"tcpState", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.TcpState }},
+	{"flowType", "UInt8", func(r *flowrecord.FlowRecord, _ string) any { return r.FlowType }},
+	{"sourcePodLabels", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.SourcePodLabels }},
+	{"destinationPodLabels", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationPodLabels }},
+	{"throughput", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.Throughput }},
+	{"reverseThroughput", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.ReverseThroughput }},
+	{"throughputFromSourceNode", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.ThroughputFromSourceNode }},
+	{"throughputFromDestinationNode", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.ThroughputFromDestinationNode }},
+	{"reverseThroughputFromSourceNode", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.ReverseThroughputFromSourceNode }},
+	{"reverseThroughputFromDestinationNode", "UInt64", func(r *flowrecord.FlowRecord, _ string) any { return r.ReverseThroughputFromDestinationNode }},
+	{"clusterUUID", "String", func(_ *flowrecord.FlowRecord, clusterUUID string) any { return clusterUUID }},
+	{"egressName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.EgressName }},
+	{"egressIP", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.EgressIP }},
+	{"appProtocolName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.AppProtocolName }},
+	{"httpVals", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.HttpVals }},
+	{"egressNodeName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.EgressNodeName }},
+	{"sourcePodOwnerKind", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.SourcePodOwnerKind }},
+	{"sourcePodOwnerName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.SourcePodOwnerName }},
+	{"destinationPodOwnerKind", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationPodOwnerKind }},
+	{"destinationPodOwnerName", "String", func(r *flowrecord.FlowRecord, _ string) any { return r.DestinationPodOwnerName }},
+}
+
+var (
+	columnsByName = func() map[string]*column {
+		m := make(map[string]*column, len(columns))
+		for i := range columns {
+			m[columns[i].name] = &columns[i]
+		}
+		return m
+	}()
+	// podOwnerColumns are only written by default when IncludePodOwners is true.
+	podOwnerColumns   = sets.New[string]("sourcePodOwnerKind", "sourcePodOwnerName", "destinationPodOwnerKind", "destinationPodOwnerName")
+	identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+
+// ValidateColumns validates a user-provided list of columns to write to ClickHouse.
+func ValidateColumns(names []string) error {
+	seen := sets.New[string]()
+	for _, name := range names {
+		if _, ok := columnsByName[name]; !ok {
+			return fmt.Errorf("unknown ClickHouse column %q", name)
+		}
+		if seen.Has(name) {
+			return fmt.Errorf("duplicate ClickHouse column %q", name)
+		}
+		seen.Insert(name)
+	}
+	return nil
+}
+
+// HasPodOwnerColumns returns whether a user-provided list of columns includes any of the Pod
+// owner columns.
+func HasPodOwnerColumns(names []string) bool {
+	return podOwnerColumns.HasAny(names...)
+}
+
+// ValidateTableName validates the name of the table to which flow records are written.
+func ValidateTableName(name string) error {
+	if !identifierPattern.MatchString(name) {
+		return fmt.Errorf("invalid ClickHouse table name %q", name)
+	}
+	return nil
+}
+
+// getColumns returns the columns written for the provided configuration: the configured
+// columns if any, or all the columns otherwise. In the latter case, the Pod owner columns are
+// only included when IncludePodOwners is true.
+func getColumns(config *ClickHouseConfig) []*column {
+	var result []*column
+	if len(config.Columns) > 0 {
+		for _, name := range config.Columns {
+			result = append(result, columnsByName[name])
+		}
+		return result
+	}
+	for i := range columns {
+		if !config.IncludePodOwners && podOwnerColumns.Has(columns[i].name) {
+			continue
+		}
+		result = append(result, &columns[i])
+	}
+	return result
+}
+
+func getTableName(config *ClickHouseConfig) string {
+	if config.TableName == "" {
+		return DefaultTableName
+	}
+	return config.TableName
+}
+
+// getInsertQueryForConfig returns the query used to insert flow records. The historical
+// queries are kept for the default schema.
+func getInsertQueryForConfig(config *ClickHouseConfig) string {
+	if len(config.Columns) == 0 && getTableName(config) == DefaultTableName {
+		return getInsertQuery(config.IncludePodOwners)
+	}
+	cols := getColumns(config)
+	names := make([]string, 0, len(cols))
+	for _, c := range cols {
+		names = append(names, c.name)
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(cols)), ", ")
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", getTableName(config), strings.Join(names, ", "), placeholders)
+}
+
+// getCreateTableQuery returns the query used to create the table if it doesn't exist yet.
+func getCreateTableQuery(config *ClickHouseConfig) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "CREATE TABLE IF NOT EXISTS %s (\n", getTableName(config))
+	fmt.Fprintf(&b, "    %s DateTime DEFAULT now()", timeInsertedColumn)
+	orderBy := []string{timeInsertedColumn}
+	for _, c := range getColumns(config) {
+		fmt.Fprintf(&b, ",\n    %s %s", c.name, c.chType)
+		if c.name == "flowEndSeconds" {
+			orderBy = append(orderBy, c.name)
+		}
+	}
+	b.WriteString("\n) ENGINE = MergeTree\n")
+	if config.PartitionBy != "" {
+		fmt.Fprintf(&b, "PARTITION BY %s\n", config.PartitionBy)
+	}
+	fmt.Fprintf(&b, "ORDER BY (%s)", strings.Join(orderBy, ", "))
+	if config.TTL > 0 {
+		fmt.Fprintf(&b, "\n%s", ttlExpression(config.TTL))
+	}
+	return b.String()
+}
+
+// getModifyTTLQuery returns the query used to update the TTL of an existing table.
+func getModifyTTLQuery(config *ClickHouseConfig) string {
+	return fmt.Sprintf("ALTER TABLE %s MODIFY %s", getTableName(config), ttlExpression(config.TTL))
+}
+
+func ttlExpression(ttl time.Duration) string {
+	return fmt.Sprintf("TTL %s + INTERVAL %d SECOND", timeInsertedColumn, int64(ttl.Seconds()))
+}
+
+// getRecordArgs returns the values of the provided columns for a flow record.
+func getRecordArgs(cols []*column, record *flowrecord.FlowRecord, clusterUUID string) []any {
+	args := make([]any, 0, len(cols))
+	for _, c := range cols {
+		args = append(args, c.value(record, clusterUUID))
+	}
+	return args
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clickhouseclient
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+)
+
+func TestColumnsMatchInsertQuery(t *testing.T) {
+	// All FlowRecord fields are written, as well as the clusterUUID.
+	assert.Len(t, columns, reflect.TypeOf(flowrecord.FlowRecord{}).NumField()+1)
+	columnList := regexp.MustCompile(`(?s)\((.*?)\)`).FindStringSubmatch(insertQueryWithPodOwners)[1]
+	var names []string
+	for _, name := range strings.Split(columnList, ",") {
+		names = append(names, strings.TrimSpace(name))
+	}
+	var expected []string
+	for _, c := range columns {
+		expected = append(expected, c.name)
+	}
+	assert.Equal(t, expected, names)
+}
+
+func TestValidateColumns(t *testing.T) {
+	assert.NoError(t, ValidateColumns(nil))
+	assert.NoError(t, ValidateColumns([]string{"sourceIP", "destinationIP", "flowEndSeconds"}))
+	assert.EqualError(t, ValidateColumns([]string{"sourceIP", "foo"}), `unknown ClickHouse column "foo"`)
+	assert.EqualError(t, ValidateColumns([]string{"sourceIP", "sourceIP"}), `duplicate ClickHouse column "sourceIP"`)
+	assert.True(t, HasPodOwnerColumns([]string{"sourceIP", "sourcePodOwnerName"}))
+	assert.False(t, HasPodOwnerColumns([]string{"sourceIP"}))
+}
+
+func TestValidateTableName(t *testing.T) {
+	assert.NoError(t, ValidateTableName("flows"))
+	assert.NoError(t, ValidateTableName("flows_local_2"))
+	assert.Error(t, ValidateTableName(""))
+	assert.Error(t, ValidateTableName("flows; DROP TABLE flows"))
+	assert.Error(t, ValidateTableName("1flows"))
+}
+
+func TestGetInsertQueryForConfig(t *testing.T) {
+	assert.Equal(t, insertQuery, getInsertQueryForConfig(&ClickHouseConfig{}))
+	assert.Equal(t, insertQueryWithPodOwners, getInsertQueryForConfig(&ClickHouseConfig{TableName: DefaultTableName, IncludePodOwners: true}))
+	assert.Equal(t, "INSERT INTO flows_v2 (flowEndSeconds, sourceIP, clusterUUID) VALUES (?, ?, ?)",
+		getInsertQueryForConfig(&ClickHouseConfig{TableName: "flows_v2", Columns: []string{"flowEndSeconds", "sourceIP", "clusterUUID"}}))
+	query := getInsertQueryForConfig(&ClickHouseConfig{TableName: "flows_v2"})
+	assert.Equal(t, len(columns)-4, strings.Count(query, "?"))
+}
+
+func TestGetCreateTableQuery(t *testing.T) {
+	config := &ClickHouseConfig{
+		TableName:   "flows_v2",
+		Columns:     []string{"flowEndSeconds", "sourceIP", "destinationTransportPort", "octetDeltaCount"},
+		PartitionBy: "toYYYYMMDD(timeInserted)",
+		TTL:         12 * time.Hour,
+	}
+	assert.Equal(t, `CREATE TABLE IF NOT EXISTS flows_v2 (
+    timeInserted DateTime DEFAULT now(),
+    flowEndSeconds DateTime,
+    sourceIP String,
+    destinationTransportPort UInt16,
+    octetDeltaCount UInt64
+) ENGINE = MergeTree
+PARTITION BY toYYYYMMDD(timeInserted)
+ORDER BY (timeInserted, flowEndSeconds)
+TTL timeInserted + INTERVAL 43200 SECOND`, getCreateTableQuery(config))
+	assert.Equal(t, "ALTER TABLE flows_v2 MODIFY TTL timeInserted + INTERVAL 43200 SECOND", getModifyTTLQuery(config))
+
+	config = &ClickHouseConfig{Columns: []string{"sourceIP"}}
+	assert.Equal(t, `CREATE TABLE IF NOT EXISTS flows (
+    timeInserted DateTime DEFAULT now(),
+    sourceIP String
+) ENGINE = MergeTree
+ORDER BY (timeInserted)`, getCreateTableQuery(config))
+}
+
+func TestGetRecordArgs(t *testing.T) {
+	record := &flowrecord.FlowRecord{SourceIP: "10.10.0.1", DestinationTransportPort: 80}
+	config := &ClickHouseConfig{Columns: []string{"destinationTransportPort", "clusterUUID", "sourceIP"}}
+	assert.Equal(t, []any{uint16(80), "uuid", "10.10.0.1"}, getRecordArgs(getColumns(config), record, "uuid"))
+}
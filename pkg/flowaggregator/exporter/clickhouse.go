@@ -28,12 +28,15 @@ import (
 	"k8s.io/klog/v2"
 
 	"antrea.io/antrea/pkg/flowaggregator/clickhouseclient"
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
 	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/util/podstore"
 )
 
 type ClickHouseExporter struct {
 	chConfig        *clickhouseclient.ClickHouseConfig
 	chExportProcess *clickhouseclient.ClickHouseExportProcess
+	podStore        podstore.Interface
 }
 
 const (
@@ -54,13 +57,21 @@ func buildClickHouseConfig(opt *options.Options) clickhouseclient.ClickHouseConf
 		CommitInterval:     opt.ClickHouseCommitInterval,
 		CACert:             opt.Config.ClickHouse.TLS.CACert,
 		InsecureSkipVerify: opt.Config.ClickHouse.TLS.InsecureSkipVerify,
+		IncludePodOwners:   opt.Config.RecordContents.PodOwners,
+		TableName:          opt.Config.ClickHouse.TableName,
+		Columns:            opt.Config.ClickHouse.Columns,
+		MaxBatchSize:       opt.Config.ClickHouse.MaxBatchSize,
+		CreateTable:        opt.Config.ClickHouse.CreateTable,
+		PartitionBy:        opt.Config.ClickHouse.PartitionBy,
+		TTL:                opt.ClickHouseTTL,
 	}
 }
 
-func NewClickHouseExporter(clusterUUID uuid.UUID, opt *options.Options) (*ClickHouseExporter, error) {
+func NewClickHouseExporter(clusterUUID uuid.UUID, opt *options.Options, podStore podstore.Interface) (*ClickHouseExporter, error) {
 	chConfig := buildClickHouseConfig(opt)
 	klog.InfoS("ClickHouse configuration", "database", chConfig.Database, "databaseURL", chConfig.DatabaseURL, "debug", chConfig.Debug,
-		"compress", *chConfig.Compress, "commitInterval", chConfig.CommitInterval, "insecureSkipVerify", chConfig.InsecureSkipVerify, "caCert", chConfig.CACert)
+		"compress", *chConfig.Compress, "commitInterval", chConfig.CommitInterval, "insecureSkipVerify", chConfig.InsecureSkipVerify, "caCert", chConfig.CACert,
+		"tableName", chConfig.TableName, "maxBatchSize", chConfig.MaxBatchSize, "createTable", chConfig.CreateTable, "ttl", chConfig.TTL)
 	var errMessage error
 	if chConfig.CACert {
 		err := wait.PollUntilContextTimeout(context.TODO(), DefaultInterval, Timeout, false, func(ctx context.Context) (bool, error) {
@@ -84,11 +95,16 @@ func NewClickHouseExporter(clusterUUID uuid.UUID, opt *options.Options) (*ClickH
 	return &ClickHouseExporter{
 		chConfig:        &chConfig,
 		chExportProcess: chExportProcess,
+		podStore:        podStore,
 	}, nil
 }
 
 func (e *ClickHouseExporter) AddRecord(record ipfixentities.Record, isRecordIPv6 bool) error {
-	e.chExportProcess.CacheRecord(record)
+	r := flowrecord.GetFlowRecord(record)
+	if e.chExportProcess.GetClickHouseConfig().IncludePodOwners {
+		fillPodOwners(r, e.podStore)
+	}
+	e.chExportProcess.CacheRecord(r)
 	return nil
 }
 
@@ -0,0 +1,96 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"math"
+	"slices"
+
+	"github.com/vmware/go-ipfix/pkg/registry"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+)
+
+type flowFilter struct {
+	IngressNetworkPolicyRuleActions []uint8
+	EgressNetworkPolicyRuleActions  []uint8
+	Namespaces                      []string
+}
+
+// FlowFilters is a compiled list of flow filters. A record matches the list if it matches any of
+// the filters, or if the list is empty.
+type FlowFilters []flowFilter
+
+func ruleActionToUint8(a flowaggregatorconfig.NetworkPolicyRuleAction) uint8 {
+	switch a {
+	case flowaggregatorconfig.NetworkPolicyRuleActionNone:
+		return registry.NetworkPolicyRuleActionNoAction
+	case flowaggregatorconfig.NetworkPolicyRuleActionAllow:
+		return registry.NetworkPolicyRuleActionAllow
+	case flowaggregatorconfig.NetworkPolicyRuleActionDrop:
+		return registry.NetworkPolicyRuleActionDrop
+	case flowaggregatorconfig.NetworkPolicyRuleActionReject:
+		return registry.NetworkPolicyRuleActionReject
+	default: // invalid case
+		return math.MaxUint8
+	}
+}
+
+// NewFlowFilters compiles the provided filter configuration.
+func NewFlowFilters(filters []flowaggregatorconfig.FlowFilter) FlowFilters {
+	convertFilter := func(in *flowaggregatorconfig.FlowFilter) flowFilter {
+		ingressNetworkPolicyRuleActions := make([]uint8, len(in.IngressNetworkPolicyRuleActions))
+		for idx, a := range in.IngressNetworkPolicyRuleActions {
+			ingressNetworkPolicyRuleActions[idx] = ruleActionToUint8(a)
+		}
+		egressNetworkPolicyRuleActions := make([]uint8, len(in.EgressNetworkPolicyRuleActions))
+		for idx, a := range in.EgressNetworkPolicyRuleActions {
+			egressNetworkPolicyRuleActions[idx] = ruleActionToUint8(a)
+		}
+		return flowFilter{
+			IngressNetworkPolicyRuleActions: ingressNetworkPolicyRuleActions,
+			EgressNetworkPolicyRuleActions:  egressNetworkPolicyRuleActions,
+			Namespaces:                      slices.Clone(in.Namespaces),
+		}
+	}
+	flowFilters := make(FlowFilters, 0, len(filters))
+	for idx := range filters {
+		flowFilters = append(flowFilters, convertFilter(&filters[idx]))
+	}
+	return flowFilters
+}
+
+// Match returns whether the record matches the filters.
+func (f FlowFilters) Match(r *flowrecord.FlowRecord) bool {
+	if len(f) == 0 {
+		return true
+	}
+	for idx := range f {
+		filter := &f[idx]
+		if len(filter.IngressNetworkPolicyRuleActions) > 0 && !slices.Contains(filter.IngressNetworkPolicyRuleActions, r.IngressNetworkPolicyRuleAction) {
+			continue
+		}
+		if len(filter.EgressNetworkPolicyRuleActions) > 0 && !slices.Contains(filter.EgressNetworkPolicyRuleActions, r.EgressNetworkPolicyRuleAction) {
+			continue
+		}
+		if len(filter.Namespaces) > 0 && !slices.Contains(filter.Namespaces, r.SourcePodNamespace) && !slices.Contains(filter.Namespaces, r.DestinationPodNamespace) {
+			continue
+		}
+		// all conditions match
+		return true
+	}
+	return false
+}
@@ -15,77 +15,44 @@
 package exporter
 
 import (
-	"math"
 	"reflect"
-	"slices"
 	"sync"
 
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
-	"github.com/vmware/go-ipfix/pkg/registry"
 	"k8s.io/klog/v2"
 
 	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
 	"antrea.io/antrea/pkg/flowaggregator/flowlogger"
 	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
 	"antrea.io/antrea/pkg/flowaggregator/options"
+	"antrea.io/antrea/pkg/util/podstore"
 )
 
-type flowFilter struct {
-	IngressNetworkPolicyRuleActions []uint8
-	EgressNetworkPolicyRuleActions  []uint8
-}
-
 type LogExporter struct {
 	config     flowaggregatorconfig.FlowLoggerConfig
-	filters    []flowFilter
+	filters    FlowFilters
 	flowLogger *flowlogger.FlowLogger
 	stopCh     chan struct{}
 	wg         sync.WaitGroup
+	podStore   podstore.Interface
+	// includePodOwners determines whether the Pod owner fields are written.
+	includePodOwners bool
 }
 
-func NewLogExporter(opt *options.Options) (*LogExporter, error) {
+func NewLogExporter(opt *options.Options, podStore podstore.Interface) (*LogExporter, error) {
 	config := opt.Config.FlowLogger
 	klog.InfoS("FlowLogger configuration", "path", config.Path, "maxSize", config.MaxSize, "maxBackups", config.MaxBackups, "maxAge", config.MaxAge, "compress", *config.Compress, "prettyPrint", *config.PrettyPrint)
 	exporter := &LogExporter{
-		config: config,
+		config:           config,
+		podStore:         podStore,
+		includePodOwners: opt.Config.RecordContents.PodOwners,
 	}
 	exporter.buildFilters()
 	return exporter, nil
 }
 
 func (e *LogExporter) buildFilters() {
-	ruleActionToUint8 := func(a flowaggregatorconfig.NetworkPolicyRuleAction) uint8 {
-		switch a {
-		case flowaggregatorconfig.NetworkPolicyRuleActionNone:
-			return registry.NetworkPolicyRuleActionNoAction
-		case flowaggregatorconfig.NetworkPolicyRuleActionAllow:
-			return registry.NetworkPolicyRuleActionAllow
-		case flowaggregatorconfig.NetworkPolicyRuleActionDrop:
-			return registry.NetworkPolicyRuleActionDrop
-		case flowaggregatorconfig.NetworkPolicyRuleActionReject:
-			return registry.NetworkPolicyRuleActionReject
-		default: // invalid case
-			return math.MaxUint8
-		}
-	}
-	convertFilter := func(in *flowaggregatorconfig.FlowFilter) flowFilter {
-		ingressNetworkPolicyRuleActions := make([]uint8, len(in.IngressNetworkPolicyRuleActions))
-		for idx, a := range in.IngressNetworkPolicyRuleActions {
-			ingressNetworkPolicyRuleActions[idx] = ruleActionToUint8(a)
-		}
-		egressNetworkPolicyRuleActions := make([]uint8, len(in.EgressNetworkPolicyRuleActions))
-		for idx, a := range in.EgressNetworkPolicyRuleActions {
-			egressNetworkPolicyRuleActions[idx] = ruleActionToUint8(a)
-		}
-		return flowFilter{
-			IngressNetworkPolicyRuleActions: ingressNetworkPolicyRuleActions,
-			EgressNetworkPolicyRuleActions:  egressNetworkPolicyRuleActions,
-		}
-	}
-	e.filters = make([]flowFilter, 0, len(e.config.Filters))
-	for idx := range e.config.Filters {
-		e.filters = append(e.filters, convertFilter(&e.config.Filters[idx]))
-	}
+	e.filters = NewFlowFilters(e.config.Filters)
 }
 
 func (e *LogExporter) AddRecord(record ipfixentities.Record, isRecordIPv6 bool) error {
@@ -94,25 +61,14 @@ func (e *LogExporter) AddRecord(record ipfixentities.Record, isRecordIPv6 bool)
 		klog.V(5).InfoS("Ignoring record in FlowLogger because filters do not match")
 		return nil
 	}
-	return e.flowLogger.WriteRecord(r, *e.config.PrettyPrint)
+	if e.includePodOwners {
+		fillPodOwners(r, e.podStore)
+	}
+	return e.flowLogger.WriteRecord(r, *e.config.PrettyPrint, e.includePodOwners)
 }
 
 func (e *LogExporter) applyFilters(r *flowrecord.FlowRecord) bool {
-	if len(e.filters) == 0 {
-		return true
-	}
-	for idx := range e.filters {
-		filter := &e.filters[idx]
-		if len(filter.IngressNetworkPolicyRuleActions) > 0 && !slices.Contains(filter.IngressNetworkPolicyRuleActions, r.IngressNetworkPolicyRuleAction) {
-			continue
-		}
-		if len(filter.EgressNetworkPolicyRuleActions) > 0 && !slices.Contains(filter.EgressNetworkPolicyRuleActions, r.EgressNetworkPolicyRuleAction) {
-			continue
-		}
-		// both conditions match
-		return true
-	}
-	return false
+	return e.filters.Match(r)
 }
 
 func (e *LogExporter) Start() {
@@ -148,6 +104,10 @@ func (e *LogExporter) stop() {
 }
 
 func (e *LogExporter) UpdateOptions(opt *options.Options) {
+	if opt.Config.RecordContents.PodOwners != e.includePodOwners {
+		e.includePodOwners = opt.Config.RecordContents.PodOwners
+		klog.InfoS("Updated FlowLogger Pod owner fields", "includePodOwners", e.includePodOwners)
+	}
 	config := opt.Config.FlowLogger
 	if reflect.DeepEqual(e.config, config) {
 		return
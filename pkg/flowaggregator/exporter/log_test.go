@@ -67,7 +67,7 @@ func TestLog_UpdateOptions(t *testing.T) {
 	require.Equal(t, 0, countRecords(path1))
 	require.Equal(t, 0, countRecords(path2))
 
-	logExporter, _ := NewLogExporter(opt(path1))
+	logExporter, _ := NewLogExporter(opt(path1), nil)
 	logExporter.Start()
 	require.NoError(t, logExporter.AddRecord(mockRecord1, false))
 	logExporter.UpdateOptions(opt(path2))
@@ -105,6 +105,20 @@ func TestLog_Filters(t *testing.T) {
 			EgressNetworkPolicyRuleAction:  1,
 		},
 	}
+	fromNamespaceARec := &testRecord{
+		name: "from-namespace-a",
+		FlowRecord: &flowrecord.FlowRecord{
+			SourcePodNamespace:      "ns-a",
+			DestinationPodNamespace: "ns-b",
+		},
+	}
+	toNamespaceARec := &testRecord{
+		name: "to-namespace-a-dropped-by-egress",
+		FlowRecord: &flowrecord.FlowRecord{
+			DestinationPodNamespace:       "ns-a",
+			EgressNetworkPolicyRuleAction: 2,
+		},
+	}
 	testCases := []struct {
 		name        string
 		filters     []flowaggregatorconfig.FlowFilter
@@ -169,6 +183,33 @@ func TestLog_Filters(t *testing.T) {
 				allowedByBothRec:     false,
 			},
 		},
+		{
+			name: "namespaces",
+			filters: []flowaggregatorconfig.FlowFilter{
+				{
+					Namespaces: []string{"ns-a", "ns-c"},
+				},
+			},
+			testRecords: map[*testRecord]bool{
+				unprotectedRec:    false,
+				fromNamespaceARec: true,
+				toNamespaceARec:   true,
+			},
+		},
+		{
+			name: "namespaces and denied",
+			filters: []flowaggregatorconfig.FlowFilter{
+				{
+					EgressNetworkPolicyRuleActions: []flowaggregatorconfig.NetworkPolicyRuleAction{flowaggregatorconfig.NetworkPolicyRuleActionDrop},
+					Namespaces:                     []string{"ns-a"},
+				},
+			},
+			testRecords: map[*testRecord]bool{
+				droppedByEgressRec: false,
+				fromNamespaceARec:  false,
+				toNamespaceARec:    true,
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -184,7 +225,7 @@ func TestLog_Filters(t *testing.T) {
 					},
 				},
 			}
-			logExporter, _ := NewLogExporter(opt)
+			logExporter, _ := NewLogExporter(opt, nil)
 			for record, expected := range tc.testRecords {
 				assert.Equal(t, expected, logExporter.applyFilters(record.FlowRecord), "unexpected result for record %s", record.name)
 			}
@@ -0,0 +1,52 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	"antrea.io/antrea/pkg/util/k8s"
+	"antrea.io/antrea/pkg/util/podstore"
+)
+
+// fillPodOwners sets the kind and name of the workloads owning the source and destination Pods of
+// the flow record. The owner fields are left empty if the endpoint is not a Pod, or if the Pod
+// cannot be found in the PodStore.
+func fillPodOwners(r *flowrecord.FlowRecord, podStore podstore.Interface) {
+	if podStore == nil {
+		return
+	}
+	r.SourcePodOwnerKind, r.SourcePodOwnerName = getPodOwner(podStore, r.SourceIP, r.SourcePodNamespace, r.SourcePodName, r.FlowStartSeconds)
+	r.DestinationPodOwnerKind, r.DestinationPodOwnerName = getPodOwner(podStore, r.DestinationIP, r.DestinationPodNamespace, r.DestinationPodName, r.FlowStartSeconds)
+}
+
+func getPodOwner(podStore podstore.Interface, ip, podNamespace, podName string, startTime time.Time) (string, string) {
+	if podName == "" {
+		return "", ""
+	}
+	pod, ok := podStore.GetPodByIPAndTime(ip, startTime)
+	if !ok {
+		klog.V(4).InfoS("Cannot find Pod information when resolving Pod owner", "ip", ip, "startTime", startTime)
+		return "", ""
+	}
+	// The IP may have been reused, ignore the Pod if it does not match the flow record.
+	if pod.Namespace != podNamespace || pod.Name != podName {
+		return "", ""
+	}
+	return k8s.GetPodWorkload(pod)
+}
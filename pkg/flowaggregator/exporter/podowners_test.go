@@ -0,0 +1,162 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package exporter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	podstoretest "antrea.io/antrea/pkg/util/podstore/testing"
+)
+
+func TestFillPodOwners(t *testing.T) {
+	startTime := time.Unix(1637706961, 0)
+	clientPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns1",
+			Name:      "client-5d4f8b9c7-abcde",
+			Labels:    map[string]string{"pod-template-hash": "5d4f8b9c7"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       "client-5d4f8b9c7",
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+	serverPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns2",
+			Name:      "server-0",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "server",
+				Controller: ptr.To(true),
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		record         *flowrecord.FlowRecord
+		expectedRecord *flowrecord.FlowRecord
+		prepareMock    func(podStore *podstoretest.MockInterface)
+	}{
+		{
+			name: "Pod-to-Pod",
+			record: &flowrecord.FlowRecord{
+				FlowStartSeconds:        startTime,
+				SourceIP:                "10.10.0.1",
+				SourcePodNamespace:      "ns1",
+				SourcePodName:           "client-5d4f8b9c7-abcde",
+				DestinationIP:           "10.10.1.1",
+				DestinationPodNamespace: "ns2",
+				DestinationPodName:      "server-0",
+			},
+			expectedRecord: &flowrecord.FlowRecord{
+				FlowStartSeconds:        startTime,
+				SourceIP:                "10.10.0.1",
+				SourcePodNamespace:      "ns1",
+				SourcePodName:           "client-5d4f8b9c7-abcde",
+				DestinationIP:           "10.10.1.1",
+				DestinationPodNamespace: "ns2",
+				DestinationPodName:      "server-0",
+				SourcePodOwnerKind:      "Deployment",
+				SourcePodOwnerName:      "client",
+				DestinationPodOwnerKind: "StatefulSet",
+				DestinationPodOwnerName: "server",
+			},
+			prepareMock: func(podStore *podstoretest.MockInterface) {
+				podStore.EXPECT().GetPodByIPAndTime("10.10.0.1", startTime).Return(clientPod, true)
+				podStore.EXPECT().GetPodByIPAndTime("10.10.1.1", startTime).Return(serverPod, true)
+			},
+		},
+		{
+			name: "Pod-to-External",
+			record: &flowrecord.FlowRecord{
+				FlowStartSeconds:   startTime,
+				SourceIP:           "10.10.0.1",
+				SourcePodNamespace: "ns1",
+				SourcePodName:      "client-5d4f8b9c7-abcde",
+				DestinationIP:      "8.8.8.8",
+			},
+			expectedRecord: &flowrecord.FlowRecord{
+				FlowStartSeconds:   startTime,
+				SourceIP:           "10.10.0.1",
+				SourcePodNamespace: "ns1",
+				SourcePodName:      "client-5d4f8b9c7-abcde",
+				DestinationIP:      "8.8.8.8",
+				SourcePodOwnerKind: "Deployment",
+				SourcePodOwnerName: "client",
+			},
+			prepareMock: func(podStore *podstoretest.MockInterface) {
+				podStore.EXPECT().GetPodByIPAndTime("10.10.0.1", startTime).Return(clientPod, true)
+			},
+		},
+		{
+			name: "Pod not found",
+			record: &flowrecord.FlowRecord{
+				FlowStartSeconds:   startTime,
+				SourceIP:           "10.10.0.1",
+				SourcePodNamespace: "ns1",
+				SourcePodName:      "client-5d4f8b9c7-abcde",
+			},
+			expectedRecord: &flowrecord.FlowRecord{
+				FlowStartSeconds:   startTime,
+				SourceIP:           "10.10.0.1",
+				SourcePodNamespace: "ns1",
+				SourcePodName:      "client-5d4f8b9c7-abcde",
+			},
+			prepareMock: func(podStore *podstoretest.MockInterface) {
+				podStore.EXPECT().GetPodByIPAndTime("10.10.0.1", startTime).Return(nil, false)
+			},
+		},
+		{
+			name: "Pod does not match record",
+			record: &flowrecord.FlowRecord{
+				FlowStartSeconds:   startTime,
+				SourceIP:           "10.10.0.1",
+				SourcePodNamespace: "ns1",
+				SourcePodName:      "other",
+			},
+			expectedRecord: &flowrecord.FlowRecord{
+				FlowStartSeconds:   startTime,
+				SourceIP:           "10.10.0.1",
+				SourcePodNamespace: "ns1",
+				SourcePodName:      "other",
+			},
+			prepareMock: func(podStore *podstoretest.MockInterface) {
+				podStore.EXPECT().GetPodByIPAndTime("10.10.0.1", startTime).Return(clientPod, true)
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			podStore := podstoretest.NewMockInterface(ctrl)
+			tc.prepareMock(podStore)
+			fillPodOwners(tc.record, podStore)
+			assert.Equal(t, tc.expectedRecord, tc.record)
+		})
+	}
+}
@@ -19,23 +19,27 @@ import (
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	"k8s.io/klog/v2"
 
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
 	"antrea.io/antrea/pkg/flowaggregator/options"
 	"antrea.io/antrea/pkg/flowaggregator/s3uploader"
+	"antrea.io/antrea/pkg/util/podstore"
 )
 
 type S3Exporter struct {
 	s3Input         *s3uploader.S3Input
 	s3UploadProcess *s3uploader.S3UploadProcess
+	podStore        podstore.Interface
 }
 
 func buildS3Input(opt *options.Options) s3uploader.S3Input {
 	return s3uploader.S3Input{
-		Config:         opt.Config.S3Uploader,
-		UploadInterval: opt.S3UploadInterval,
+		Config:           opt.Config.S3Uploader,
+		UploadInterval:   opt.S3UploadInterval,
+		IncludePodOwners: opt.Config.RecordContents.PodOwners,
 	}
 }
 
-func NewS3Exporter(clusterUUID uuid.UUID, opt *options.Options) (*S3Exporter, error) {
+func NewS3Exporter(clusterUUID uuid.UUID, opt *options.Options, podStore podstore.Interface) (*S3Exporter, error) {
 	s3Input := buildS3Input(opt)
 	klog.InfoS("S3Uploader configuration", "bucketName", s3Input.Config.BucketName, "bucketPrefix", s3Input.Config.BucketPrefix, "region", s3Input.Config.Region, "recordFormat", s3Input.Config.RecordFormat, "compress", *s3Input.Config.Compress, "maxRecordsPerFile", s3Input.Config.MaxRecordsPerFile, "uploadInterval", s3Input.UploadInterval)
 	s3UploadProcess, err := s3uploader.NewS3UploadProcess(s3Input, clusterUUID.String())
@@ -45,11 +49,16 @@ func NewS3Exporter(clusterUUID uuid.UUID, opt *options.Options) (*S3Exporter, er
 	return &S3Exporter{
 		s3Input:         &s3Input,
 		s3UploadProcess: s3UploadProcess,
+		podStore:        podStore,
 	}, nil
 }
 
 func (e *S3Exporter) AddRecord(record ipfixentities.Record, isRecordIPv6 bool) error {
-	e.s3UploadProcess.CacheRecord(record)
+	r := flowrecord.GetFlowRecord(record)
+	if e.s3UploadProcess.GetIncludePodOwners() {
+		fillPodOwners(r, e.podStore)
+	}
+	e.s3UploadProcess.CacheRecord(r)
 	return nil
 }
 
@@ -64,6 +73,10 @@ func (e *S3Exporter) Stop() {
 func (e *S3Exporter) UpdateOptions(opt *options.Options) {
 	s3Input := buildS3Input(opt)
 	config := s3Input.Config
+	if s3Input.IncludePodOwners != e.s3UploadProcess.GetIncludePodOwners() {
+		e.s3UploadProcess.SetIncludePodOwners(s3Input.IncludePodOwners)
+		klog.InfoS("Updated S3Uploader Pod owner columns", "includePodOwners", s3Input.IncludePodOwners)
+	}
 	if config.BucketName == e.s3UploadProcess.GetBucketName() &&
 		config.BucketPrefix == e.s3UploadProcess.GetBucketPrefix() &&
 		config.Region == e.s3UploadProcess.GetRegion() &&
@@ -0,0 +1,148 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"sync/atomic"
+
+	"github.com/vmware/go-ipfix/pkg/entities"
+	ipfixintermediate "github.com/vmware/go-ipfix/pkg/intermediate"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/metrics"
+	"antrea.io/antrea/pkg/ipfix"
+)
+
+// flowLimiter bounds the number of flows stored by the aggregation process. When the limit is
+// reached, records for new flows are spilled to disk if spill is not nil, and dropped otherwise,
+// while records for flows which are already stored are still aggregated. Stored flows are removed
+// from the aggregation process when they expire (see activeFlowRecordTimeout and
+// inactiveFlowRecordTimeout), at which point spilled records are handed over to the aggregation
+// process, and new flows are accepted again once all spilled records have been handed over. This
+// ensures that memory usage remains bounded, e.g., when the flow collector is unavailable and
+// records cannot be exported. Note that the limit is enforced for each IPFIX message, so it can be
+// exceeded by the number of records in a single message.
+type flowLimiter struct {
+	maxFlows           int64
+	aggregationProcess ipfix.IPFIXAggregationProcess
+	// spill is only used by the preprocessor goroutine.
+	spill             *flowSpill
+	numRecordsDropped atomic.Int64
+}
+
+func newFlowLimiter(maxFlows int64, aggregationProcess ipfix.IPFIXAggregationProcess) *flowLimiter {
+	return &flowLimiter{
+		maxFlows:           maxFlows,
+		aggregationProcess: aggregationProcess,
+	}
+}
+
+// enableSpill spills the records for new flows to dir when the limit is reached, up to maxBytes.
+func (l *flowLimiter) enableSpill(dir string, maxBytes int64, infoElementsV4, infoElementsV6 []*entities.InfoElement) error {
+	spill, err := newFlowSpill(dir, maxBytes, infoElementsV4, infoElementsV6, l.dropRecords)
+	if err != nil {
+		return err
+	}
+	l.spill = spill
+	return nil
+}
+
+// isFull returns whether records for new flows cannot be handed over to the aggregation process,
+// either because it has reached the maximum number of flows, or because spilled records must be
+// handed over first.
+func (l *flowLimiter) isFull() bool {
+	if l.spill != nil && l.spill.len() > 0 {
+		return true
+	}
+	return l.aggregationProcess.GetNumFlows() >= l.maxFlows
+}
+
+// admit returns whether the record can be handed over to the aggregation process. It should only
+// be called when the limiter is full: only records for stored flows are admitted, and the other
+// ones are spilled or dropped. elements must be the elements of the record expected by the
+// preprocessor.
+func (l *flowLimiter) admit(record entities.Record, elements []entities.InfoElementWithValue, isIPv4 bool) bool {
+	flowKey, ok := getFlowKeyFromRecord(record, isIPv4)
+	if ok && len(l.aggregationProcess.GetRecords(flowKey)) > 0 {
+		return true
+	}
+	if l.spill == nil {
+		l.dropRecords(metrics.DropReasonMaxFlows, 1)
+		return false
+	}
+	if err := l.spill.add(elements, isIPv4); err != nil {
+		klog.ErrorS(err, "Failed to spill record")
+		l.dropRecords(metrics.DropReasonSpillError, 1)
+	}
+	return false
+}
+
+// nextSpilledRecords returns the spilled records which can be handed over to the aggregation
+// process, given the number of flows it currently stores. At most one record is returned for each
+// flow which can be added, as the number of flows is only updated once the records have been
+// aggregated.
+func (l *flowLimiter) nextSpilledRecords() []*spilledRecord {
+	if l.spill == nil {
+		return nil
+	}
+	available := l.maxFlows - l.aggregationProcess.GetNumFlows()
+	var records []*spilledRecord
+	for i := int64(0); i < available; i++ {
+		record := l.spill.next()
+		if record == nil {
+			break
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (l *flowLimiter) dropRecords(reason string, numRecords int64) {
+	if l.numRecordsDropped.Add(numRecords) == numRecords {
+		klog.InfoS("Maximum number of flows reached in the aggregation process, dropping records", "maxFlows", l.maxFlows, "reason", reason)
+	}
+	metrics.RecordsDropped.WithLabelValues(reason).Add(float64(numRecords))
+}
+
+func (l *flowLimiter) getNumRecordsDropped() int64 {
+	return l.numRecordsDropped.Load()
+}
+
+func getFlowKeyFromRecord(record entities.Record, isIPv4 bool) (*ipfixintermediate.FlowKey, bool) {
+	sourceAddressIE, destinationAddressIE := "sourceIPv4Address", "destinationIPv4Address"
+	if !isIPv4 {
+		sourceAddressIE, destinationAddressIE = "sourceIPv6Address", "destinationIPv6Address"
+	}
+	flowKey := &ipfixintermediate.FlowKey{}
+	for _, name := range []string{sourceAddressIE, destinationAddressIE, "protocolIdentifier", "sourceTransportPort", "destinationTransportPort"} {
+		element, _, exist := record.GetInfoElementWithValue(name)
+		if !exist {
+			return nil, false
+		}
+		switch name {
+		case sourceAddressIE:
+			flowKey.SourceAddress = element.GetIPAddressValue().String()
+		case destinationAddressIE:
+			flowKey.DestinationAddress = element.GetIPAddressValue().String()
+		case "protocolIdentifier":
+			flowKey.Protocol = element.GetUnsigned8Value()
+		case "sourceTransportPort":
+			flowKey.SourcePort = element.GetUnsigned16Value()
+		case "destinationTransportPort":
+			flowKey.DestinationPort = element.GetUnsigned16Value()
+		}
+	}
+	return flowKey, true
+}
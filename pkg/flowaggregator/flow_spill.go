@@ -0,0 +1,307 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/vmware/go-ipfix/pkg/entities"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/metrics"
+)
+
+const (
+	// flowSpillDir is the directory in which records are spilled, which is backed by an emptyDir volume.
+	flowSpillDir = "/var/lib/antrea/flow-aggregator/spill"
+	// flowSpillSegmentsPerMaxSize is the number of segments in a full spill, which determines how many records
+	// are evicted at once.
+	flowSpillSegmentsPerMaxSize = 16
+	minFlowSpillSegmentSize     = 64 * 1024
+	flowSpillFileSuffix         = ".spill"
+	// spilledRecordHeaderLength is the length of the header of a spilled record: the IP version (4 or 6) of
+	// the record, followed by the length of the encoded elements as an uint32.
+	spilledRecordHeaderLength = 5
+)
+
+type spilledRecord struct {
+	elements []entities.InfoElementWithValue
+	isIPv4   bool
+}
+
+type spillSegment struct {
+	path     string
+	numBytes int64
+	// numRecords is the number of records of the segment which have not been read yet.
+	numRecords int64
+}
+
+// flowSpill stores records on disk while the aggregation process is full, so that they can be handed over to the
+// aggregation process in order once stored flows expire, instead of being dropped. Records are appended to segment
+// files, and read from the oldest segment. When maxBytes is reached, the oldest segment is evicted. As the flows
+// which are still active keep getting records appended to the newest segment, the flows evicted first are the ones
+// which have not been updated for the longest time, which is equivalent to evicting spilled flows in LRU order.
+// The records are encoded with the IPFIX encoding of their elements, which are expected to be the ones the flow
+// aggregator expects from the flow exporters. flowSpill is not thread-safe: it is only used by the preprocessor.
+type flowSpill struct {
+	dir            string
+	maxBytes       int64
+	maxSegmentSize int64
+	infoElementsV4 []*entities.InfoElement
+	infoElementsV6 []*entities.InfoElement
+	// dropRecords is called with the number of records which are evicted or cannot be read back.
+	dropRecords func(reason string, numRecords int64)
+
+	// segments are ordered from the oldest to the newest one. Records are appended to the newest segment while
+	// writer is not nil, and read from the oldest segment.
+	segments      []*spillSegment
+	nextSegmentID uint64
+	writeFile     *os.File
+	writer        *bufio.Writer
+	readFile      *os.File
+	reader        *bufio.Reader
+	numBytes      int64
+	numRecords    int64
+	buffer        []byte
+}
+
+func newFlowSpill(dir string, maxBytes int64, infoElementsV4, infoElementsV6 []*entities.InfoElement, dropRecords func(string, int64)) (*flowSpill, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("error when creating directory for spilled records: %w", err)
+	}
+	// The records spilled before a restart are discarded, as the flows aggregated before the restart are lost as
+	// well.
+	oldFiles, err := filepath.Glob(filepath.Join(dir, "*"+flowSpillFileSuffix))
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range oldFiles {
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("error when removing spilled records: %w", err)
+		}
+	}
+	return &flowSpill{
+		dir:            dir,
+		maxBytes:       maxBytes,
+		maxSegmentSize: max(maxBytes/flowSpillSegmentsPerMaxSize, minFlowSpillSegmentSize),
+		infoElementsV4: infoElementsV4,
+		infoElementsV6: infoElementsV6,
+		dropRecords:    dropRecords,
+	}, nil
+}
+
+func (s *flowSpill) len() int64 {
+	return s.numRecords
+}
+
+// add spills the record, evicting the oldest segments if needed.
+func (s *flowSpill) add(elements []entities.InfoElementWithValue, isIPv4 bool) error {
+	var err error
+	ipVersion := byte(4)
+	if !isIPv4 {
+		ipVersion = 6
+	}
+	buffer := append(s.buffer[:0], ipVersion, 0, 0, 0, 0)
+	if buffer, err = entities.NewDataRecordFromElements(0, elements).AppendToBuffer(buffer); err != nil {
+		return fmt.Errorf("error when encoding record: %w", err)
+	}
+	s.buffer = buffer
+	binary.BigEndian.PutUint32(buffer[1:spilledRecordHeaderLength], uint32(len(buffer)-spilledRecordHeaderLength))
+	size := int64(len(buffer))
+	if size > s.maxBytes {
+		return fmt.Errorf("record of %d bytes exceeds the maximum size of spilled records", size)
+	}
+	for s.numBytes+size > s.maxBytes {
+		s.evictOldestSegment()
+	}
+	if s.writer == nil || s.segments[len(s.segments)-1].numBytes+size > s.maxSegmentSize {
+		if err := s.openSegment(); err != nil {
+			return err
+		}
+	}
+	if _, err := s.writer.Write(buffer); err != nil {
+		// The segment may be incomplete, so it is not written anymore.
+		s.closeWriter()
+		return fmt.Errorf("error when writing spilled record: %w", err)
+	}
+	segment := s.segments[len(s.segments)-1]
+	segment.numBytes += size
+	segment.numRecords++
+	s.numBytes += size
+	s.numRecords++
+	s.updateMetrics()
+	metrics.RecordsSpilled.Inc()
+	return nil
+}
+
+// next returns the oldest spilled record, or nil if there is none.
+func (s *flowSpill) next() *spilledRecord {
+	for len(s.segments) > 0 {
+		segment := s.segments[0]
+		if segment.numRecords == 0 {
+			// The only segment is still written, and all its records have been read.
+			if len(s.segments) == 1 && s.writer != nil {
+				return nil
+			}
+			s.removeOldestSegment()
+			continue
+		}
+		if s.reader == nil {
+			// The segment must be complete before it can be read.
+			if len(s.segments) == 1 && s.writer != nil {
+				s.closeWriter()
+			}
+			f, err := os.Open(segment.path)
+			if err != nil {
+				klog.ErrorS(err, "Failed to open file of spilled records", "path", segment.path)
+				s.dropRecords(metrics.DropReasonSpillError, segment.numRecords)
+				s.removeOldestSegment()
+				continue
+			}
+			s.readFile, s.reader = f, bufio.NewReader(f)
+		}
+		record, err := s.readRecord()
+		if err != nil {
+			klog.ErrorS(err, "Failed to read spilled record", "path", segment.path)
+			s.dropRecords(metrics.DropReasonSpillError, segment.numRecords)
+			s.removeOldestSegment()
+			continue
+		}
+		segment.numRecords--
+		s.numRecords--
+		s.updateMetrics()
+		return record
+	}
+	return nil
+}
+
+func (s *flowSpill) readRecord() (*spilledRecord, error) {
+	var header [spilledRecordHeaderLength]byte
+	if _, err := io.ReadFull(s.reader, header[:]); err != nil {
+		return nil, err
+	}
+	infoElements := s.infoElementsV4
+	switch header[0] {
+	case 4:
+	case 6:
+		infoElements = s.infoElementsV6
+	default:
+		return nil, fmt.Errorf("invalid IP version %d", header[0])
+	}
+	data := make([]byte, binary.BigEndian.Uint32(header[1:]))
+	if _, err := io.ReadFull(s.reader, data); err != nil {
+		return nil, err
+	}
+	elements, err := decodeSpilledElements(data, infoElements)
+	if err != nil {
+		return nil, err
+	}
+	return &spilledRecord{elements: elements, isIPv4: header[0] == 4}, nil
+}
+
+func decodeSpilledElements(data []byte, infoElements []*entities.InfoElement) ([]entities.InfoElementWithValue, error) {
+	buffer := bytes.NewBuffer(data)
+	elements := make([]entities.InfoElementWithValue, 0, len(infoElements))
+	for _, ie := range infoElements {
+		length := int(ie.Len)
+		if ie.Len == entities.VariableLength {
+			b, err := buffer.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			length = int(b)
+			if b == 255 {
+				if buffer.Len() < 2 {
+					return nil, io.ErrUnexpectedEOF
+				}
+				length = int(binary.BigEndian.Uint16(buffer.Next(2)))
+			}
+		}
+		if buffer.Len() < length {
+			return nil, io.ErrUnexpectedEOF
+		}
+		element, err := entities.DecodeAndCreateInfoElementWithValue(ie, buffer.Next(length))
+		if err != nil {
+			return nil, err
+		}
+		elements = append(elements, element)
+	}
+	if buffer.Len() > 0 {
+		return nil, errors.New("unexpected data after the last element")
+	}
+	return elements, nil
+}
+
+func (s *flowSpill) openSegment() error {
+	s.closeWriter()
+	path := filepath.Join(s.dir, fmt.Sprintf("%020d%s", s.nextSegmentID, flowSpillFileSuffix))
+	s.nextSegmentID++
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("error when creating file for spilled records: %w", err)
+	}
+	s.writeFile, s.writer = f, bufio.NewWriter(f)
+	s.segments = append(s.segments, &spillSegment{path: path})
+	return nil
+}
+
+func (s *flowSpill) closeWriter() {
+	if s.writer == nil {
+		return
+	}
+	if err := s.writer.Flush(); err != nil {
+		klog.ErrorS(err, "Failed to write spilled records", "path", s.writeFile.Name())
+	}
+	s.writeFile.Close()
+	s.writeFile, s.writer = nil, nil
+}
+
+func (s *flowSpill) evictOldestSegment() {
+	segment := s.segments[0]
+	if segment.numRecords > 0 {
+		s.dropRecords(metrics.DropReasonSpillEvicted, segment.numRecords)
+	}
+	s.removeOldestSegment()
+}
+
+func (s *flowSpill) removeOldestSegment() {
+	segment := s.segments[0]
+	if len(s.segments) == 1 {
+		s.closeWriter()
+	}
+	if s.reader != nil {
+		s.readFile.Close()
+		s.readFile, s.reader = nil, nil
+	}
+	if err := os.Remove(segment.path); err != nil && !os.IsNotExist(err) {
+		klog.ErrorS(err, "Failed to remove file of spilled records", "path", segment.path)
+	}
+	s.segments = s.segments[1:]
+	s.numBytes -= segment.numBytes
+	s.numRecords -= segment.numRecords
+	s.updateMetrics()
+}
+
+func (s *flowSpill) updateMetrics() {
+	metrics.SpilledRecords.Set(float64(s.numRecords))
+	metrics.SpilledBytes.Set(float64(s.numBytes))
+}
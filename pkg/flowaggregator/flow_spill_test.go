@@ -0,0 +1,125 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+
+	"antrea.io/antrea/pkg/flowaggregator/metrics"
+)
+
+var (
+	testSpillSourceIPv4AddressIE = ipfixentities.NewInfoElement("sourceIPv4Address", 8, ipfixentities.Ipv4Address, 0, 4)
+	testSpillSourceIPv6AddressIE = ipfixentities.NewInfoElement("sourceIPv6Address", 27, ipfixentities.Ipv6Address, 0, 16)
+	testSpillPacketTotalCountIE  = ipfixentities.NewInfoElement("packetTotalCount", 86, ipfixentities.Unsigned64, 0, 8)
+	testSpillSourcePodNameIE     = ipfixentities.NewInfoElement("sourcePodName", 101, ipfixentities.String, 56506, ipfixentities.VariableLength)
+)
+
+func newTestSpilledElements(isIPv4 bool, packetTotalCount uint64, podName string) []ipfixentities.InfoElementWithValue {
+	sourceAddressElement := ipfixentities.NewIPAddressInfoElement(testSpillSourceIPv4AddressIE, net.ParseIP("10.0.0.1"))
+	if !isIPv4 {
+		sourceAddressElement = ipfixentities.NewIPAddressInfoElement(testSpillSourceIPv6AddressIE, net.ParseIP("fd00::1"))
+	}
+	return []ipfixentities.InfoElementWithValue{
+		sourceAddressElement,
+		ipfixentities.NewUnsigned64InfoElement(testSpillPacketTotalCountIE, packetTotalCount),
+		ipfixentities.NewStringInfoElement(testSpillSourcePodNameIE, podName),
+	}
+}
+
+func newTestFlowSpill(t *testing.T, maxBytes int64) (*flowSpill, map[string]int64) {
+	infoElementsV4 := []*ipfixentities.InfoElement{testSpillSourceIPv4AddressIE, testSpillPacketTotalCountIE, testSpillSourcePodNameIE}
+	infoElementsV6 := []*ipfixentities.InfoElement{testSpillSourceIPv6AddressIE, testSpillPacketTotalCountIE, testSpillSourcePodNameIE}
+	numRecordsDropped := map[string]int64{}
+	s, err := newFlowSpill(t.TempDir(), maxBytes, infoElementsV4, infoElementsV6, func(reason string, numRecords int64) {
+		numRecordsDropped[reason] += numRecords
+	})
+	require.NoError(t, err)
+	return s, numRecordsDropped
+}
+
+func assertSpilledRecord(t *testing.T, record *spilledRecord, isIPv4 bool, packetTotalCount uint64, podName string) {
+	require.NotNil(t, record)
+	assert.Equal(t, isIPv4, record.isIPv4)
+	require.Len(t, record.elements, 3)
+	expectedSourceAddress := "10.0.0.1"
+	if !isIPv4 {
+		expectedSourceAddress = "fd00::1"
+	}
+	assert.Equal(t, expectedSourceAddress, record.elements[0].GetIPAddressValue().String())
+	assert.Equal(t, packetTotalCount, record.elements[1].GetUnsigned64Value())
+	assert.Equal(t, podName, record.elements[2].GetStringValue())
+}
+
+func TestFlowSpill(t *testing.T) {
+	s, numRecordsDropped := newTestFlowSpill(t, 1<<20)
+	longPodName := strings.Repeat("a", 300)
+	require.NoError(t, s.add(newTestSpilledElements(true, 1, "pod1"), true))
+	require.NoError(t, s.add(newTestSpilledElements(false, 2, longPodName), false))
+	assert.Equal(t, int64(2), s.len())
+
+	assertSpilledRecord(t, s.next(), true, 1, "pod1")
+	// Records spilled while the segment is read are appended to a new segment.
+	require.NoError(t, s.add(newTestSpilledElements(true, 3, ""), true))
+	assertSpilledRecord(t, s.next(), false, 2, longPodName)
+	assertSpilledRecord(t, s.next(), true, 3, "")
+	assert.Nil(t, s.next())
+	assert.Equal(t, int64(0), s.len())
+	assert.Empty(t, numRecordsDropped)
+
+	files, err := os.ReadDir(s.dir)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(files), 1)
+}
+
+func TestFlowSpillEviction(t *testing.T) {
+	elements := newTestSpilledElements(true, 1, "pod1")
+	recordSize := int64(spilledRecordHeaderLength + 4 + 8 + 1 + len("pod1"))
+	// Each segment holds 2 records, and the spill holds 2 segments.
+	s, numRecordsDropped := newTestFlowSpill(t, 4*recordSize)
+	s.maxSegmentSize = 2 * recordSize
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, s.add(newTestSpilledElements(true, uint64(i), "pod1"), true))
+	}
+	// The oldest segment is evicted when the fifth record is spilled.
+	assert.Equal(t, int64(3), s.len())
+	assert.Equal(t, map[string]int64{metrics.DropReasonSpillEvicted: 2}, numRecordsDropped)
+	for i := 3; i <= 5; i++ {
+		assertSpilledRecord(t, s.next(), true, uint64(i), "pod1")
+	}
+	assert.Nil(t, s.next())
+
+	err := s.add(append(elements, ipfixentities.NewStringInfoElement(testSpillSourcePodNameIE, strings.Repeat("a", 100))), true)
+	assert.ErrorContains(t, err, "exceeds the maximum size of spilled records")
+}
+
+func TestFlowSpillRemovesOldFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "00000000000000000000.spill"), []byte("foo"), 0600))
+	s, err := newFlowSpill(dir, 1<<20, nil, nil, func(string, int64) {})
+	require.NoError(t, err)
+	assert.Nil(t, s.next())
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, files)
+}
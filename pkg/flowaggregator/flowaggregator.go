@@ -16,11 +16,13 @@ package flowaggregator
 
 import (
 	"bytes"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"net"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sync"
 	"time"
 
@@ -28,6 +30,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/vmware/go-ipfix/pkg/collector"
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixexporter "github.com/vmware/go-ipfix/pkg/exporter"
 	ipfixintermediate "github.com/vmware/go-ipfix/pkg/intermediate"
 	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
 	"k8s.io/client-go/kubernetes"
@@ -39,7 +42,9 @@ import (
 	"antrea.io/antrea/pkg/flowaggregator/options"
 	"antrea.io/antrea/pkg/flowaggregator/querier"
 	"antrea.io/antrea/pkg/ipfix"
+	"antrea.io/antrea/pkg/util/env"
 	"antrea.io/antrea/pkg/util/podstore"
+	"antrea.io/antrea/pkg/util/spiffe"
 )
 
 var (
@@ -90,14 +95,14 @@ var (
 	newIPFIXExporter = func(clusterUUID uuid.UUID, opt *options.Options, registry ipfix.IPFIXRegistry) exporter.Interface {
 		return exporter.NewIPFIXExporter(clusterUUID, opt, registry)
 	}
-	newClickHouseExporter = func(clusterUUID uuid.UUID, opt *options.Options) (exporter.Interface, error) {
-		return exporter.NewClickHouseExporter(clusterUUID, opt)
+	newClickHouseExporter = func(clusterUUID uuid.UUID, opt *options.Options, podStore podstore.Interface) (exporter.Interface, error) {
+		return exporter.NewClickHouseExporter(clusterUUID, opt, podStore)
 	}
-	newS3Exporter = func(clusterUUID uuid.UUID, opt *options.Options) (exporter.Interface, error) {
-		return exporter.NewS3Exporter(clusterUUID, opt)
+	newS3Exporter = func(clusterUUID uuid.UUID, opt *options.Options, podStore podstore.Interface) (exporter.Interface, error) {
+		return exporter.NewS3Exporter(clusterUUID, opt, podStore)
 	}
-	newLogExporter = func(opt *options.Options) (exporter.Interface, error) {
-		return exporter.NewLogExporter(opt)
+	newLogExporter = func(opt *options.Options, podStore podstore.Interface) (exporter.Interface, error) {
+		return exporter.NewLogExporter(opt, podStore)
 	}
 )
 
@@ -125,8 +130,30 @@ type flowAggregator struct {
 	clickHouseExporter          exporter.Interface
 	s3Exporter                  exporter.Interface
 	logExporter                 exporter.Interface
+	sinks                       map[string]*sink
 	logTickerDuration           time.Duration
 	preprocessorOutCh           chan *ipfixentities.Message
+	// shardingHeadlessService is the name of the headless Service selecting the replicas of the flow aggregator, if
+	// sharding is enabled.
+	shardingHeadlessService string
+	// shardRouter forwards the records of the flows owned by other replicas, if sharding is enabled. It reads the
+	// output of the preprocessor, and its output is read by the aggregation process.
+	shardRouter      *shardRouter
+	shardRouterOutCh chan *ipfixentities.Message
+	infoElementsIPv4 []*ipfixentities.InfoElement
+	infoElementsIPv6 []*ipfixentities.InfoElement
+	peerTLSConfig    *ipfixexporter.ExporterTLSClientConfig
+	// sharedCAPEM holds the CA certificates shared by the replicas which are currently trusted, if sharding is enabled
+	// with TLS.
+	sharedCAPEM []byte
+	// spiffeCertDir is the directory of the X.509 SVID used by the collecting process, if SPIFFE is enabled.
+	spiffeCertDir string
+	// spiffeSource holds the X.509 SVID currently used by the collecting process.
+	spiffeSource *spiffe.X509Source
+	// spiffeAuthorizedClientIDs are the SPIFFE IDs the flow exporters are authorized to have, if restricted.
+	spiffeAuthorizedClientIDs []string
+	// spiffeRelay terminates the TLS connections from the flow exporters when spiffeAuthorizedClientIDs is set.
+	spiffeRelay *spiffeRelay
 }
 
 func NewFlowAggregator(
@@ -182,34 +209,55 @@ func NewFlowAggregator(
 		// We support buffering a small amount of messages.
 		preprocessorOutCh: make(chan *ipfixentities.Message, 16),
 	}
+	if opt.Config.SPIFFE.Enable {
+		fa.spiffeCertDir = opt.Config.SPIFFE.CertDir
+		fa.spiffeAuthorizedClientIDs = opt.Config.SPIFFE.AuthorizedClientIDs
+	}
+	if opt.Config.Sharding.Enable {
+		fa.shardingHeadlessService = opt.Config.Sharding.HeadlessService
+	}
 	if err := fa.InitCollectingProcess(); err != nil {
 		return nil, fmt.Errorf("error when creating collecting process: %w", err)
 	}
 	if err := fa.InitPreprocessor(); err != nil {
 		return nil, fmt.Errorf("error when creating preprocessor: %w", err)
 	}
+	if fa.shardingHeadlessService != "" {
+		if err := fa.InitShardRouter(); err != nil {
+			return nil, fmt.Errorf("error when creating shard router: %w", err)
+		}
+	}
 	if opt.AggregatorMode == flowaggregatorconfig.AggregatorModeAggregate {
 		if err := fa.InitAggregationProcess(); err != nil {
 			return nil, fmt.Errorf("error when creating aggregation process: %w", err)
 		}
+		if opt.Config.MaxFlows > 0 {
+			flowLimiter := newFlowLimiter(int64(opt.Config.MaxFlows), fa.aggregationProcess)
+			if opt.Config.FlowSpill.Enable {
+				if err := flowLimiter.enableSpill(flowSpillDir, int64(opt.Config.FlowSpill.MaxSizeMiB)<<20, fa.infoElementsIPv4, fa.infoElementsIPv6); err != nil {
+					return nil, fmt.Errorf("error when enabling flow spill: %w", err)
+				}
+			}
+			fa.preprocessor.flowLimiter = flowLimiter
+		}
 	}
 	if opt.Config.ClickHouse.Enable {
 		var err error
-		fa.clickHouseExporter, err = newClickHouseExporter(clusterUUID, opt)
+		fa.clickHouseExporter, err = newClickHouseExporter(clusterUUID, opt, podStore)
 		if err != nil {
 			return nil, fmt.Errorf("error when creating ClickHouse export process: %v", err)
 		}
 	}
 	if opt.Config.S3Uploader.Enable {
 		var err error
-		fa.s3Exporter, err = newS3Exporter(clusterUUID, opt)
+		fa.s3Exporter, err = newS3Exporter(clusterUUID, opt, podStore)
 		if err != nil {
 			return nil, fmt.Errorf("error when creating S3 export process: %v", err)
 		}
 	}
 	if opt.Config.FlowLogger.Enable {
 		var err error
-		fa.logExporter, err = newLogExporter(opt)
+		fa.logExporter, err = newLogExporter(opt, podStore)
 		if err != nil {
 			return nil, fmt.Errorf("error when creating log export process: %v", err)
 		}
@@ -217,6 +265,9 @@ func NewFlowAggregator(
 	if opt.Config.FlowCollector.Enable {
 		fa.ipfixExporter = newIPFIXExporter(clusterUUID, opt, registry)
 	}
+	if err := fa.initSinks(opt.Sinks); err != nil {
+		return nil, err
+	}
 	klog.InfoS("FlowAggregator initialized", "mode", opt.AggregatorMode)
 	return fa, nil
 }
@@ -225,22 +276,9 @@ func (fa *flowAggregator) InitCollectingProcess() error {
 	var cpInput collector.CollectorInput
 	switch fa.aggregatorTransportProtocol {
 	case flowaggregatorconfig.AggregatorTransportProtocolTLS:
-		parentCert, privateKey, caCert, err := generateCACertKey()
-		if err != nil {
-			return fmt.Errorf("error when generating CA certificate: %v", err)
-		}
-		serverCert, serverKey, err := generateCertKey(parentCert, privateKey, true, fa.flowAggregatorAddress)
-		if err != nil {
-			return fmt.Errorf("error when creating server certificate: %v", err)
-		}
-
-		clientCert, clientKey, err := generateCertKey(parentCert, privateKey, false, "")
+		caCert, serverCert, serverKey, err := fa.getCollectorCertificates()
 		if err != nil {
-			return fmt.Errorf("error when creating client certificate: %v", err)
-		}
-		err = syncCAAndClientCert(caCert, clientCert, clientKey, fa.k8sClient)
-		if err != nil {
-			return fmt.Errorf("error when synchronizing client certificate: %v", err)
+			return err
 		}
 		cpInput = collector.CollectorInput{
 			Address:       collectorAddress,
@@ -281,9 +319,29 @@ func (fa *flowAggregator) InitCollectingProcess() error {
 	// Tell the collector to accept IEs which are not part of the IPFIX registry (hardcoded in
 	// the go-ipfix library). The preprocessor will take care of removing these elements.
 	cpInput.DecodingMode = collector.DecodingModeLenientKeepUnknown
-	var err error
-	fa.collectingProcess, err = collector.InitCollectingProcess(cpInput)
-	return err
+	var relayTLSConfig *tls.Config
+	if cpInput.IsEncrypted && fa.spiffeSource != nil && len(fa.spiffeAuthorizedClientIDs) > 0 {
+		// The collecting process cannot verify the SPIFFE IDs of the flow exporters, so the TLS connections are
+		// terminated by spiffeRelay, which forwards them to the collecting process listening on the loopback
+		// interface.
+		var err error
+		relayTLSConfig, err = fa.spiffeSource.ServerTLSConfig(fa.spiffeAuthorizedClientIDs)
+		if err != nil {
+			return err
+		}
+		cpInput.Address = spiffeRelayCollectorAddress
+		cpInput.IsEncrypted = false
+		cpInput.CACert, cpInput.ServerCert, cpInput.ServerKey = nil, nil, nil
+	}
+	cp, err := collector.InitCollectingProcess(cpInput)
+	if err != nil {
+		return err
+	}
+	fa.collectingProcess = cp
+	if relayTLSConfig != nil {
+		fa.spiffeRelay = newSPIFFERelay(collectorAddress, relayTLSConfig, cp.GetAddress)
+	}
+	return nil
 }
 
 func (fa *flowAggregator) InitPreprocessor() error {
@@ -336,14 +394,39 @@ func (fa *flowAggregator) InitPreprocessor() error {
 	if err != nil {
 		return err
 	}
+	fa.infoElementsIPv4, fa.infoElementsIPv6 = infoElementsIPv4, infoElementsIPv6
 	fa.preprocessor, err = newPreprocessor(infoElementsIPv4, infoElementsIPv6, fa.collectingProcess.GetMsgChan(), fa.preprocessorOutCh)
 	return err
 }
 
+// InitShardRouter creates the shard router of a replica of a sharded flow aggregator. The records of the flows owned
+// by other replicas are forwarded to them using the transport protocol used by the flow exporters.
+func (fa *flowAggregator) InitShardRouter() error {
+	localAddress := env.GetPodIP()
+	if localAddress == "" {
+		return fmt.Errorf("IP address of the FlowAggregator Pod is required for sharding")
+	}
+	protocol := tcpTransport
+	if fa.aggregatorTransportProtocol == flowaggregatorconfig.AggregatorTransportProtocolUDP {
+		protocol = udpTransport
+	}
+	newForwarder := func(address string) recordForwarder {
+		return newIPFIXRecordForwarder(address, localAddress, protocol, fa.peerTLSConfig, fa.infoElementsIPv4, fa.infoElementsIPv6)
+	}
+	// We support buffering a small amount of messages.
+	fa.shardRouterOutCh = make(chan *ipfixentities.Message, 16)
+	fa.shardRouter = newShardRouter(fa.k8sClient, getFlowAggregatorNamespace(), fa.shardingHeadlessService, localAddress, newForwarder, fa.preprocessorOutCh, fa.shardRouterOutCh)
+	return nil
+}
+
 func (fa *flowAggregator) InitAggregationProcess() error {
 	var err error
+	messageChan := fa.preprocessorOutCh
+	if fa.shardRouter != nil {
+		messageChan = fa.shardRouterOutCh
+	}
 	apInput := ipfixintermediate.AggregationInput{
-		MessageChan:           fa.preprocessorOutCh,
+		MessageChan:           messageChan,
 		WorkerNum:             aggregationWorkerNum,
 		CorrelateFields:       correlateFields,
 		ActiveExpiryTimeout:   fa.activeFlowRecordTimeout,
@@ -371,6 +454,13 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 		defer ipfixProcessesWg.Done()
 		fa.preprocessor.Run(stopCh)
 	}()
+	if fa.shardRouter != nil {
+		ipfixProcessesWg.Add(1)
+		go func() {
+			defer ipfixProcessesWg.Done()
+			fa.shardRouter.Run(stopCh)
+		}()
+	}
 	if fa.aggregationProcess != nil {
 		ipfixProcessesWg.Add(1)
 		go func() {
@@ -393,6 +483,7 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 	if fa.logExporter != nil {
 		fa.logExporter.Start()
 	}
+	fa.startSinks()
 
 	wg.Add(1)
 	go func() {
@@ -423,6 +514,27 @@ func (fa *flowAggregator) Run(stopCh <-chan struct{}) {
 		// in practice, this should only happen during unit tests.
 		fa.configWatcher.Close()
 	}()
+	if fa.spiffeSource != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fa.watchSVID(stopCh)
+		}()
+	}
+	if fa.sharedCAPEM != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fa.watchSharedCA(stopCh)
+		}()
+	}
+	if fa.spiffeRelay != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fa.spiffeRelay.Run(stopCh)
+		}()
+	}
 	<-stopCh
 	// Wait for fa.podStore.Run, fa.flowExportLoop and fa.watchConfiguration to return.
 	wg.Wait()
@@ -456,6 +568,7 @@ func (fa *flowAggregator) flowExportLoop(stopCh <-chan struct{}) {
 		if fa.logExporter != nil {
 			fa.logExporter.Stop()
 		}
+		fa.stopSinks()
 	}()
 	switch fa.aggregatorMode {
 	case flowaggregatorconfig.AggregatorModeAggregate:
@@ -680,6 +793,9 @@ func (fa *flowAggregator) sendRecord(record ipfixentities.Record, isRecordIPv6 b
 			return err
 		}
 	}
+	if err := fa.sendRecordToSinks(record, isRecordIPv6); err != nil {
+		return err
+	}
 	fa.numRecordsExported = fa.numRecordsExported + 1
 	return nil
 }
@@ -691,7 +807,7 @@ func (fa *flowAggregator) flushExporters() error {
 		}
 	}
 	// Other exporters don't leverage Flush for now, so we skip them.
-	return nil
+	return fa.flushSinks()
 }
 
 func (fa *flowAggregator) sendAggregatedRecord(key ipfixintermediate.FlowKey, record *ipfixintermediate.AggregationFlowRecord) error {
@@ -902,10 +1018,22 @@ func (fa *flowAggregator) getNumFlows() int64 {
 	return 0
 }
 
+func (fa *flowAggregator) getNumRecordsDropped() int64 {
+	var numRecordsDropped int64
+	if fa.preprocessor != nil && fa.preprocessor.flowLimiter != nil {
+		numRecordsDropped += fa.preprocessor.flowLimiter.getNumRecordsDropped()
+	}
+	if fa.shardRouter != nil {
+		numRecordsDropped += fa.shardRouter.numRecordsDropped.Load()
+	}
+	return numRecordsDropped
+}
+
 func (fa *flowAggregator) GetRecordMetrics() querier.Metrics {
 	return querier.Metrics{
 		NumRecordsExported:     fa.numRecordsExported,
 		NumRecordsReceived:     fa.collectingProcess.GetNumRecordsReceived(),
+		NumRecordsDropped:      fa.getNumRecordsDropped(),
 		NumFlows:               fa.getNumFlows(),
 		NumConnToCollector:     fa.collectingProcess.GetNumConnToCollector(),
 		WithClickHouseExporter: fa.clickHouseExporter != nil,
@@ -994,7 +1122,7 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 		if fa.clickHouseExporter == nil {
 			klog.InfoS("Enabling ClickHouse")
 			var err error
-			fa.clickHouseExporter, err = newClickHouseExporter(fa.clusterUUID, opt)
+			fa.clickHouseExporter, err = newClickHouseExporter(fa.clusterUUID, opt, fa.podStore)
 			if err != nil {
 				klog.ErrorS(err, "Error when creating ClickHouse export process")
 				return
@@ -1016,7 +1144,7 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 		if fa.s3Exporter == nil {
 			klog.InfoS("Enabling S3Uploader")
 			var err error
-			fa.s3Exporter, err = newS3Exporter(fa.clusterUUID, opt)
+			fa.s3Exporter, err = newS3Exporter(fa.clusterUUID, opt, fa.podStore)
 			if err != nil {
 				klog.ErrorS(err, "Error when creating S3 export process")
 				return
@@ -1038,7 +1166,7 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 		if fa.logExporter == nil {
 			klog.InfoS("Enabling FlowLogger")
 			var err error
-			fa.logExporter, err = newLogExporter(opt)
+			fa.logExporter, err = newLogExporter(opt, fa.podStore)
 			if err != nil {
 				klog.ErrorS(err, "Error when creating log export process")
 				return
@@ -1056,12 +1184,13 @@ func (fa *flowAggregator) updateFlowAggregator(opt *options.Options) {
 			klog.InfoS("Disabled FlowLogger")
 		}
 	}
+	fa.updateSinks(opt.Sinks)
 	if opt.Config.RecordContents.PodLabels != fa.includePodLabels {
 		fa.includePodLabels = opt.Config.RecordContents.PodLabels
 		klog.InfoS("Updated recordContents.podLabels configuration", "value", fa.includePodLabels)
 	}
 	var unsupportedUpdates []string
-	if opt.Config.APIServer != fa.APIServer {
+	if !reflect.DeepEqual(opt.Config.APIServer, fa.APIServer) {
 		unsupportedUpdates = append(unsupportedUpdates, "apiServer")
 	}
 	if opt.ActiveFlowRecordTimeout != fa.activeFlowRecordTimeout {
@@ -16,6 +16,8 @@ package flowaggregator
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
 	"net"
 	"os"
 	"path/filepath"
@@ -46,7 +48,9 @@ import (
 	"antrea.io/antrea/pkg/flowaggregator/querier"
 	"antrea.io/antrea/pkg/ipfix"
 	ipfixtesting "antrea.io/antrea/pkg/ipfix/testing"
+	"antrea.io/antrea/pkg/util/podstore"
 	podstoretest "antrea.io/antrea/pkg/util/podstore/testing"
+	spiffetesting "antrea.io/antrea/pkg/util/spiffe/testing"
 )
 
 const (
@@ -477,19 +481,19 @@ func mockExporters(t *testing.T, ctrl *gomock.Controller, expectedClusterUUID *u
 		}
 		return mockIPFIXExporter
 	}
-	newClickHouseExporter = func(clusterUUID uuid.UUID, opts *options.Options) (exporter.Interface, error) {
+	newClickHouseExporter = func(clusterUUID uuid.UUID, opts *options.Options, podStore podstore.Interface) (exporter.Interface, error) {
 		if expectedClusterUUID != nil {
 			assert.Equal(t, *expectedClusterUUID, clusterUUID)
 		}
 		return mockClickHouseExporter, nil
 	}
-	newS3Exporter = func(clusterUUID uuid.UUID, opts *options.Options) (exporter.Interface, error) {
+	newS3Exporter = func(clusterUUID uuid.UUID, opts *options.Options, podStore podstore.Interface) (exporter.Interface, error) {
 		if expectedClusterUUID != nil {
 			assert.Equal(t, *expectedClusterUUID, clusterUUID)
 		}
 		return mockS3Exporter, nil
 	}
-	newLogExporter = func(opt *options.Options) (exporter.Interface, error) {
+	newLogExporter = func(opt *options.Options, podStore podstore.Interface) (exporter.Interface, error) {
 		return mockLogExporter, nil
 	}
 
@@ -1021,6 +1025,106 @@ func TestFlowAggregator_InitCollectingProcess(t *testing.T) {
 	}
 }
 
+func TestFlowAggregator_InitCollectingProcessWithSPIFFE(t *testing.T) {
+	ca, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	certDir := t.TempDir()
+	k8sClient := fake.NewSimpleClientset()
+	fa := &flowAggregator{
+		aggregatorTransportProtocol: flowaggregatorconfig.AggregatorTransportProtocolTLS,
+		k8sClient:                   k8sClient,
+		spiffeCertDir:               certDir,
+	}
+	require.ErrorContains(t, fa.InitCollectingProcess(), "error when loading SVID")
+
+	require.NoError(t, ca.WriteX509Source(certDir, "spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator"))
+	require.NoError(t, fa.InitCollectingProcess())
+	require.NotNil(t, fa.spiffeSource)
+	assert.Equal(t, "spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator", fa.spiffeSource.ID)
+	// The CA certificate and the client certificate are not synced when SPIFFE is enabled.
+	configMaps, err := k8sClient.CoreV1().ConfigMaps(getFlowAggregatorNamespace()).List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, configMaps.Items)
+}
+
+func TestFlowAggregator_watchSVID(t *testing.T) {
+	ca, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	certDir := t.TempDir()
+	require.NoError(t, ca.WriteX509Source(certDir, "spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator"))
+	fa := &flowAggregator{
+		aggregatorTransportProtocol: flowaggregatorconfig.AggregatorTransportProtocolTLS,
+		k8sClient:                   fake.NewSimpleClientset(),
+		spiffeCertDir:               certDir,
+	}
+	require.NoError(t, fa.InitCollectingProcess())
+
+	prevSVIDCheckInterval, prevExitOnSVIDRotation := svidCheckInterval, exitOnSVIDRotation
+	defer func() {
+		svidCheckInterval, exitOnSVIDRotation = prevSVIDCheckInterval, prevExitOnSVIDRotation
+	}()
+	svidCheckInterval = 10 * time.Millisecond
+	exitCh := make(chan struct{}, 10)
+	exitOnSVIDRotation = func() {
+		exitCh <- struct{}{}
+	}
+	stopCh := make(chan struct{})
+	watchDoneCh := make(chan struct{})
+	go func() {
+		defer close(watchDoneCh)
+		fa.watchSVID(stopCh)
+	}()
+
+	select {
+	case <-exitCh:
+		t.Fatal("Flow aggregator should not exit when the SVID is not rotated")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// The SVID keeps being watched after a rotation, until stopCh is closed.
+	for i := 0; i < 2; i++ {
+		require.NoError(t, ca.WriteX509Source(certDir, "spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator"))
+		select {
+		case <-exitCh:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Flow aggregator should exit when the SVID is rotated")
+		}
+	}
+	close(stopCh)
+	select {
+	case <-watchDoneCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watchSVID should return when stopCh is closed")
+	}
+}
+
+func TestFlowAggregator_InitCollectingProcessWithAuthorizedClientIDs(t *testing.T) {
+	ca, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	certDir := t.TempDir()
+	require.NoError(t, ca.WriteX509Source(certDir, "spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator"))
+	fa := &flowAggregator{
+		aggregatorTransportProtocol: flowaggregatorconfig.AggregatorTransportProtocolTLS,
+		k8sClient:                   fake.NewSimpleClientset(),
+		spiffeCertDir:               certDir,
+		spiffeAuthorizedClientIDs:   []string{"spiffe://example.org/ns/kube-system/sa/antrea-agent"},
+	}
+	require.NoError(t, fa.InitCollectingProcess())
+	require.NotNil(t, fa.spiffeRelay)
+	// The collecting process listens on the loopback interface without TLS, and the relay listens on the address of
+	// the collecting process.
+	assert.Equal(t, collectorAddress, fa.spiffeRelay.address)
+	assert.Equal(t, tls.RequireAnyClientCert, fa.spiffeRelay.tlsConfig.ClientAuth)
+
+	fa = &flowAggregator{
+		aggregatorTransportProtocol: flowaggregatorconfig.AggregatorTransportProtocolTLS,
+		k8sClient:                   fake.NewSimpleClientset(),
+		spiffeCertDir:               certDir,
+	}
+	require.NoError(t, fa.InitCollectingProcess())
+	assert.Nil(t, fa.spiffeRelay)
+}
+
 func TestFlowAggregator_InitAggregationProcess(t *testing.T) {
 	fa := &flowAggregator{
 		activeFlowRecordTimeout:     testActiveTimeout,
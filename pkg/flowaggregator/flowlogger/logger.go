@@ -69,7 +69,7 @@ func (fl *FlowLogger) Close() {
 	fl.logger.Close()
 }
 
-func (fl *FlowLogger) WriteRecord(r *flowrecord.FlowRecord, prettyPrint bool) error {
+func (fl *FlowLogger) WriteRecord(r *flowrecord.FlowRecord, prettyPrint bool, includePodOwners bool) error {
 	var protocolID string
 	var ingressNetworkPolicyRuleAction, ingressNetworkPolicyType string
 	var egressNetworkPolicyRuleAction, egressNetworkPolicyType string
@@ -120,6 +120,14 @@ func (fl *FlowLogger) WriteRecord(r *flowrecord.FlowRecord, prettyPrint bool) er
 		r.HttpVals,
 		r.EgressNodeName,
 	}
+	if includePodOwners {
+		fields = append(fields,
+			r.SourcePodOwnerKind,
+			r.SourcePodOwnerName,
+			r.DestinationPodOwnerKind,
+			r.DestinationPodOwnerName,
+		)
+	}
 
 	str := strings.Join(fields, ",")
 
@@ -65,23 +65,29 @@ func TestWriteRecord(t *testing.T) {
 	record := flowrecordtesting.PrepareTestFlowRecord()
 
 	testCases := []struct {
-		prettyPrint bool
-		expected    string
+		prettyPrint      bool
+		includePodOwners bool
+		expected         string
 	}{
 		{
 			prettyPrint: true,
-			expected:    "1637706961,1637706973,10.10.0.79,10.10.0.80,44752,5201,TCP,perftest-a,antrea-test,k8s-node-control-plane,perftest-b,antrea-test-b,k8s-node-control-plane-b,10.10.1.10,5202,perftest,test-flow-aggregator-networkpolicy-ingress-allow,antrea-test-ns,test-flow-aggregator-networkpolicy-rule,Drop,K8sNetworkPolicy,test-flow-aggregator-networkpolicy-egress-allow,antrea-test-ns-e,test-flow-aggregator-networkpolicy-rule-e,Invalid,Invalid,test-egress,172.18.0.1,http,mockHttpString,test-egress-node",
+			expected:    "1637706961,1637706973,10.10.0.79,10.10.0.80,44752,5201,TCP,perftest-a,antrea-test,k8s-node-control-plane,perftest-b,antrea-test-b,k8s-node-control-plane-b,10.10.1.10,5202,perftest,test-flow-aggregator-networkpolicy-ingress-allow,antrea-test-ns,test-flow-aggregator-networkpolicy-rule,Drop,K8sNetworkPolicy,test-flow-aggregator-networkpolicy-egress-allow,antrea-test-ns-e,test-flow-aggregator-networkpolicy-rule-e,Invalid,Invalid,test-egress,172.18.0.1,http,mockHttpString,test-egress-node\n",
 		},
 		{
 			prettyPrint: false,
-			expected:    "1637706961,1637706973,10.10.0.79,10.10.0.80,44752,5201,6,perftest-a,antrea-test,k8s-node-control-plane,perftest-b,antrea-test-b,k8s-node-control-plane-b,10.10.1.10,5202,perftest,test-flow-aggregator-networkpolicy-ingress-allow,antrea-test-ns,test-flow-aggregator-networkpolicy-rule,2,1,test-flow-aggregator-networkpolicy-egress-allow,antrea-test-ns-e,test-flow-aggregator-networkpolicy-rule-e,5,4,test-egress,172.18.0.1,http,mockHttpString,test-egress-node",
+			expected:    "1637706961,1637706973,10.10.0.79,10.10.0.80,44752,5201,6,perftest-a,antrea-test,k8s-node-control-plane,perftest-b,antrea-test-b,k8s-node-control-plane-b,10.10.1.10,5202,perftest,test-flow-aggregator-networkpolicy-ingress-allow,antrea-test-ns,test-flow-aggregator-networkpolicy-rule,2,1,test-flow-aggregator-networkpolicy-egress-allow,antrea-test-ns-e,test-flow-aggregator-networkpolicy-rule-e,5,4,test-egress,172.18.0.1,http,mockHttpString,test-egress-node\n",
+		},
+		{
+			prettyPrint:      false,
+			includePodOwners: true,
+			expected:         "1637706961,1637706973,10.10.0.79,10.10.0.80,44752,5201,6,perftest-a,antrea-test,k8s-node-control-plane,perftest-b,antrea-test-b,k8s-node-control-plane-b,10.10.1.10,5202,perftest,test-flow-aggregator-networkpolicy-ingress-allow,antrea-test-ns,test-flow-aggregator-networkpolicy-rule,2,1,test-flow-aggregator-networkpolicy-egress-allow,antrea-test-ns-e,test-flow-aggregator-networkpolicy-rule-e,5,4,test-egress,172.18.0.1,http,mockHttpString,test-egress-node,Deployment,perftest-a-deployment,StatefulSet,perftest-b-statefulset\n",
 		},
 	}
 
 	for _, tc := range testCases {
-		t.Run(fmt.Sprintf("pretty print: %t", tc.prettyPrint), func(t *testing.T) {
+		t.Run(fmt.Sprintf("pretty print: %t, include Pod owners: %t", tc.prettyPrint, tc.includePodOwners), func(t *testing.T) {
 			flowLogger, b := getTestFlowLogger(MaxLatency)
-			err := flowLogger.WriteRecord(record, tc.prettyPrint)
+			err := flowLogger.WriteRecord(record, tc.prettyPrint, tc.includePodOwners)
 			require.NoError(t, err)
 			flowLogger.Flush()
 			assert.Contains(t, b.String(), tc.expected)
@@ -92,7 +98,7 @@ func TestWriteRecord(t *testing.T) {
 func TestFlushLoop(t *testing.T) {
 	flowLogger, b := getTestFlowLogger(100 * time.Millisecond)
 	record := flowrecordtesting.PrepareTestFlowRecord()
-	err := flowLogger.WriteRecord(record, false)
+	err := flowLogger.WriteRecord(record, false, false)
 	require.NoError(t, err)
 	assert.Equal(t, 0, b.Len())
 	stopCh := make(chan struct{})
@@ -73,6 +73,10 @@ type FlowRecord struct {
 	AppProtocolName                      string
 	HttpVals                             string
 	EgressNodeName                       string
+	SourcePodOwnerKind                   string
+	SourcePodOwnerName                   string
+	DestinationPodOwnerKind              string
+	DestinationPodOwnerName              string
 }
 
 // GetFlowRecord converts ipfixentities.Record to FlowRecord
@@ -75,5 +75,9 @@ func PrepareTestFlowRecord() *flowrecord.FlowRecord {
 		AppProtocolName:                      "http",
 		HttpVals:                             "mockHttpString",
 		EgressNodeName:                       "test-egress-node",
+		SourcePodOwnerKind:                   "Deployment",
+		SourcePodOwnerName:                   "perftest-a-deployment",
+		DestinationPodOwnerKind:              "StatefulSet",
+		DestinationPodOwnerName:              "perftest-b-statefulset",
 	}
 }
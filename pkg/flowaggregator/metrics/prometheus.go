@@ -0,0 +1,96 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	"k8s.io/klog/v2"
+)
+
+const (
+	metricNamespaceAntrea         = "antrea"
+	metricSubsystemFlowAggregator = "flow_aggregator"
+)
+
+// Reasons for which records are dropped by the flow aggregator, used as values of the "reason" label of
+// RecordsDropped.
+const (
+	// DropReasonMaxFlows means that the record was for a new flow while maxFlows was reached, and that
+	// flowSpill is not enabled.
+	DropReasonMaxFlows = "max_flows"
+	// DropReasonSpillEvicted means that the record was spilled to disk and evicted before it could be handed
+	// over to the aggregation process, because flowSpill.maxSizeMiB was reached.
+	DropReasonSpillEvicted = "spill_evicted"
+	// DropReasonSpillError means that the record could not be written to or read from disk.
+	DropReasonSpillError = "spill_error"
+	// DropReasonForwardQueueFull means that the record could not be queued to be forwarded to the replica owning
+	// its flow, because the queue of the replica was full.
+	DropReasonForwardQueueFull = "forward_queue_full"
+	// DropReasonForwardError means that the record could not be forwarded to the replica owning its flow.
+	DropReasonForwardError = "forward_error"
+)
+
+var (
+	RecordsDropped = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Namespace:      metricNamespaceAntrea,
+			Subsystem:      metricSubsystemFlowAggregator,
+			Name:           "records_dropped_total",
+			Help:           "Number of flow records dropped by the flow aggregator, by reason.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"reason"},
+	)
+	RecordsSpilled = metrics.NewCounter(&metrics.CounterOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemFlowAggregator,
+		Name:           "records_spilled_total",
+		Help:           "Number of flow records spilled to disk because the maximum number of flows was reached.",
+		StabilityLevel: metrics.ALPHA,
+	})
+	SpilledRecords = metrics.NewGauge(&metrics.GaugeOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemFlowAggregator,
+		Name:           "spilled_records",
+		Help:           "Number of flow records spilled to disk which have not been handed over to the aggregation process yet.",
+		StabilityLevel: metrics.ALPHA,
+	})
+	SpilledBytes = metrics.NewGauge(&metrics.GaugeOpts{
+		Namespace:      metricNamespaceAntrea,
+		Subsystem:      metricSubsystemFlowAggregator,
+		Name:           "spilled_bytes",
+		Help:           "Size of the files in which flow records are spilled.",
+		StabilityLevel: metrics.ALPHA,
+	})
+)
+
+// InitializePrometheusMetrics registers the metrics of the flow aggregator, which are exposed by its API server.
+func InitializePrometheusMetrics() {
+	klog.Info("Initializing prometheus metrics")
+
+	if err := legacyregistry.Register(RecordsDropped); err != nil {
+		klog.Errorf("Failed to register antrea_flow_aggregator_records_dropped_total with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(RecordsSpilled); err != nil {
+		klog.Errorf("Failed to register antrea_flow_aggregator_records_spilled_total with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(SpilledRecords); err != nil {
+		klog.Errorf("Failed to register antrea_flow_aggregator_spilled_records with Prometheus: %s", err.Error())
+	}
+	if err := legacyregistry.Register(SpilledBytes); err != nil {
+		klog.Errorf("Failed to register antrea_flow_aggregator_spilled_bytes with Prometheus: %s", err.Error())
+	}
+}
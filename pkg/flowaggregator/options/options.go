@@ -17,12 +17,17 @@ package options
 import (
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"time"
 
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
 
 	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/clickhouseclient"
 	"antrea.io/antrea/pkg/util/flowexport"
+	"antrea.io/antrea/pkg/util/spiffe"
 	"antrea.io/antrea/pkg/util/yaml"
 )
 
@@ -45,8 +50,21 @@ type Options struct {
 	TemplateRefreshTimeout time.Duration
 	// clickHouseCommitInterval flow records batch commit interval to clickhouse in the flow aggregator
 	ClickHouseCommitInterval time.Duration
+	// Time-to-live of flow records in the ClickHouse table
+	ClickHouseTTL time.Duration
 	// Flow records batch upload interval from flow aggregator to S3 bucket
 	S3UploadInterval time.Duration
+	// Options of the additional sinks, in the order in which they are configured
+	Sinks []*SinkOptions
+}
+
+// SinkOptions contains the options of an additional sink.
+type SinkOptions struct {
+	// The configuration of the sink
+	Config *flowaggregatorconfig.SinkConfig
+	// The options used to create the exporter of the sink. Only the configuration section
+	// corresponding to the sink type is enabled, and it is set to the configuration of the sink.
+	ExporterOptions *Options
 }
 
 func LoadConfig(configBytes []byte) (*Options, error) {
@@ -55,14 +73,7 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 		return nil, fmt.Errorf("failed to unmarshal FlowAggregator config from ConfigMap: %v", err)
 	}
 	flowaggregatorconfig.SetConfigDefaults(opt.Config)
-	// Validate all the required options.
-	if opt.Config.FlowCollector.Enable && opt.Config.FlowCollector.Address == "" {
-		return nil, fmt.Errorf("external flow collector enabled without providing address")
-	}
-	if opt.Config.S3Uploader.Enable && opt.Config.S3Uploader.BucketName == "" {
-		return nil, fmt.Errorf("s3Uploader enabled without specifying bucket name")
-	}
-	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable && !opt.Config.S3Uploader.Enable && !opt.Config.FlowLogger.Enable {
+	if !opt.Config.FlowCollector.Enable && !opt.Config.ClickHouse.Enable && !opt.Config.S3Uploader.Enable && !opt.Config.FlowLogger.Enable && len(opt.Config.Sinks) == 0 {
 		klog.InfoS("No collector / sink has been configured, so no flow data will be exported")
 	}
 	// Validate common parameters
@@ -84,42 +95,130 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	if err != nil {
 		return nil, err
 	}
+	if opt.Config.MaxFlows < 0 {
+		return nil, fmt.Errorf("maxFlows %d cannot be negative", opt.Config.MaxFlows)
+	}
+	if opt.Config.FlowSpill.Enable {
+		if opt.AggregatorMode != flowaggregatorconfig.AggregatorModeAggregate || opt.Config.MaxFlows == 0 {
+			return nil, fmt.Errorf("flowSpill can only be enabled when maxFlows is set in Aggregate mode")
+		}
+		if opt.Config.FlowSpill.MaxSizeMiB < 0 {
+			return nil, fmt.Errorf("flowSpill.maxSizeMiB %d cannot be negative", opt.Config.FlowSpill.MaxSizeMiB)
+		}
+	}
 	opt.AggregatorTransportProtocol, err = flowexport.ParseTransportProtocol(opt.Config.AggregatorTransportProtocol)
 	if err != nil {
 		return nil, err
 	}
+	if opt.Config.SPIFFE.Enable && opt.AggregatorTransportProtocol != flowaggregatorconfig.AggregatorTransportProtocolTLS {
+		return nil, fmt.Errorf("SPIFFE can only be enabled when aggregatorTransportProtocol is TLS")
+	}
+	if len(opt.Config.SPIFFE.AuthorizedClientIDs) > 0 {
+		if !opt.Config.SPIFFE.Enable {
+			return nil, fmt.Errorf("authorizedClientIDs can only be set when SPIFFE is enabled")
+		}
+		// The connections from the flow exporters are relayed to the collecting process, which cannot know the
+		// address of the flow exporters anymore.
+		if opt.AggregatorMode == flowaggregatorconfig.AggregatorModeProxy {
+			return nil, fmt.Errorf("authorizedClientIDs is not supported in Proxy mode")
+		}
+		for _, id := range opt.Config.SPIFFE.AuthorizedClientIDs {
+			if err := spiffe.ValidateID(id); err != nil {
+				return nil, fmt.Errorf("invalid authorizedClientIDs: %w", err)
+			}
+		}
+	}
+	if opt.Config.Sharding.Enable {
+		if opt.AggregatorMode != flowaggregatorconfig.AggregatorModeAggregate {
+			return nil, fmt.Errorf("sharding is only supported in Aggregate mode")
+		}
+		if opt.Config.SPIFFE.Enable {
+			return nil, fmt.Errorf("sharding is not supported when SPIFFE is enabled")
+		}
+	}
+	consumerUsers := sets.New[string]()
+	for _, consumer := range opt.Config.APIServer.Consumers {
+		if consumer.User == "" {
+			return nil, fmt.Errorf("user of API consumer cannot be empty")
+		}
+		if consumerUsers.Has(consumer.User) {
+			return nil, fmt.Errorf("duplicate API consumer %s", consumer.User)
+		}
+		consumerUsers.Insert(consumer.User)
+		if len(consumer.Namespaces) == 0 {
+			return nil, fmt.Errorf("namespaces of API consumer %s cannot be empty", consumer.User)
+		}
+	}
+	for _, admin := range opt.Config.APIServer.Admins {
+		if consumerUsers.Has(admin) {
+			return nil, fmt.Errorf("API admin %s cannot be an API consumer", admin)
+		}
+	}
+	if err := validateExporters(&opt); err != nil {
+		return nil, err
+	}
+	sinkNames := sets.New[string]()
+	for i := range opt.Config.Sinks {
+		sinkConfig := &opt.Config.Sinks[i]
+		if sinkConfig.Name == "" {
+			return nil, fmt.Errorf("sink name cannot be empty")
+		}
+		if sinkNames.Has(sinkConfig.Name) {
+			return nil, fmt.Errorf("duplicate sink name %s", sinkConfig.Name)
+		}
+		sinkNames.Insert(sinkConfig.Name)
+		sinkOpt, err := newSinkOptions(&opt, sinkConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sink %s: %w", sinkConfig.Name, err)
+		}
+		opt.Sinks = append(opt.Sinks, sinkOpt)
+	}
+	return &opt, nil
+}
+
+// validateExporters validates the configuration of the enabled exporters and parses their
+// parameters into opt.
+func validateExporters(opt *Options) error {
+	// Validate all the required options.
+	if opt.Config.FlowCollector.Enable && opt.Config.FlowCollector.Address == "" {
+		return fmt.Errorf("external flow collector enabled without providing address")
+	}
+	if opt.Config.S3Uploader.Enable && opt.Config.S3Uploader.BucketName == "" {
+		return fmt.Errorf("s3Uploader enabled without specifying bucket name")
+	}
+	var err error
 	// Validate flow collector specific parameters
 	if opt.Config.FlowCollector.Enable {
 		host, port, proto, err := flowexport.ParseFlowCollectorAddr(
 			opt.Config.FlowCollector.Address, flowaggregatorconfig.DefaultExternalFlowCollectorPort,
 			flowaggregatorconfig.DefaultExternalFlowCollectorTransport)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		opt.ExternalFlowCollectorAddr = net.JoinHostPort(host, port)
 		opt.ExternalFlowCollectorProto = proto
 
 		if opt.Config.FlowCollector.RecordFormat != "IPFIX" && opt.Config.FlowCollector.RecordFormat != "JSON" {
-			return nil, fmt.Errorf("record format %s is not supported", opt.Config.FlowCollector.RecordFormat)
+			return fmt.Errorf("record format %s is not supported", opt.Config.FlowCollector.RecordFormat)
 		}
 
 		opt.TemplateRefreshTimeout, err = time.ParseDuration(opt.Config.FlowCollector.TemplateRefreshTimeout)
 		if err != nil {
-			return nil, fmt.Errorf("templateRefreshTimeout is not a valid duration: %w", err)
+			return fmt.Errorf("templateRefreshTimeout is not a valid duration: %w", err)
 		}
 		if opt.TemplateRefreshTimeout < 0 {
-			return nil, fmt.Errorf("templateRefreshTimeout cannot be a negative duration")
+			return fmt.Errorf("templateRefreshTimeout cannot be a negative duration")
 		}
 
 		if opt.Config.FlowCollector.MaxIPFIXMsgSize < 0 {
-			return nil, fmt.Errorf("maxIPFIXMsgSize cannot be negative")
+			return fmt.Errorf("maxIPFIXMsgSize cannot be negative")
 		}
 		if opt.Config.FlowCollector.MaxIPFIXMsgSize > 0 {
 			if opt.Config.FlowCollector.MaxIPFIXMsgSize < flowaggregatorconfig.MinValidIPFIXMsgSize {
-				return nil, fmt.Errorf("maxIPFIXMsgSize cannot be smaller than the minimum valid IPFIX mesage size %d", flowaggregatorconfig.MinValidIPFIXMsgSize)
+				return fmt.Errorf("maxIPFIXMsgSize cannot be smaller than the minimum valid IPFIX mesage size %d", flowaggregatorconfig.MinValidIPFIXMsgSize)
 			}
 			if opt.Config.FlowCollector.MaxIPFIXMsgSize > flowaggregatorconfig.MaxValidIPFIXMsgSize {
-				return nil, fmt.Errorf("maxIPFIXMsgSize cannot be greater than the maximum valid IPFIX mesage size %d", flowaggregatorconfig.MaxValidIPFIXMsgSize)
+				return fmt.Errorf("maxIPFIXMsgSize cannot be greater than the maximum valid IPFIX mesage size %d", flowaggregatorconfig.MaxValidIPFIXMsgSize)
 			}
 		}
 	}
@@ -127,32 +226,116 @@ func LoadConfig(configBytes []byte) (*Options, error) {
 	if opt.Config.ClickHouse.Enable {
 		opt.ClickHouseCommitInterval, err = time.ParseDuration(opt.Config.ClickHouse.CommitInterval)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if opt.ClickHouseCommitInterval < flowaggregatorconfig.MinClickHouseCommitInterval {
-			return nil, fmt.Errorf("commitInterval %s is too small: shortest supported interval is %v",
+			return fmt.Errorf("commitInterval %s is too small: shortest supported interval is %v",
 				opt.Config.ClickHouse.CommitInterval, flowaggregatorconfig.MinClickHouseCommitInterval)
 		}
+		if opt.Config.ClickHouse.MaxBatchSize < 0 {
+			return fmt.Errorf("maxBatchSize %d cannot be negative", opt.Config.ClickHouse.MaxBatchSize)
+		}
+		if err := clickhouseclient.ValidateTableName(opt.Config.ClickHouse.TableName); err != nil {
+			return err
+		}
+		if err := clickhouseclient.ValidateColumns(opt.Config.ClickHouse.Columns); err != nil {
+			return err
+		}
+		if !opt.Config.RecordContents.PodOwners && clickhouseclient.HasPodOwnerColumns(opt.Config.ClickHouse.Columns) {
+			return fmt.Errorf("columns for Pod owners require recordContents.podOwners to be enabled")
+		}
+		opt.ClickHouseTTL = 0
+		if opt.Config.ClickHouse.TTL != "" {
+			opt.ClickHouseTTL, err = time.ParseDuration(opt.Config.ClickHouse.TTL)
+			if err != nil {
+				return err
+			}
+			if opt.ClickHouseTTL < flowaggregatorconfig.MinClickHouseTTL {
+				return fmt.Errorf("ttl %s is too small: shortest supported TTL is %v",
+					opt.Config.ClickHouse.TTL, flowaggregatorconfig.MinClickHouseTTL)
+			}
+		}
 	}
 	// Validate S3Uploader specific parameters
 	if opt.Config.S3Uploader.Enable {
 		if opt.Config.S3Uploader.RecordFormat != "CSV" {
-			return nil, fmt.Errorf("record format %s is not supported", opt.Config.S3Uploader.RecordFormat)
+			return fmt.Errorf("record format %s is not supported", opt.Config.S3Uploader.RecordFormat)
 		}
 		opt.S3UploadInterval, err = time.ParseDuration(opt.Config.S3Uploader.UploadInterval)
 		if err != nil {
-			return nil, err
+			return err
 		}
 		if opt.S3UploadInterval < flowaggregatorconfig.MinS3CommitInterval {
-			return nil, fmt.Errorf("uploadInterval %s is too small: shortest supported interval is %v",
+			return fmt.Errorf("uploadInterval %s is too small: shortest supported interval is %v",
 				opt.Config.S3Uploader.UploadInterval, flowaggregatorconfig.MinS3CommitInterval)
 		}
 	}
 	// Validate FlowLogger specific parameters
 	if opt.Config.FlowLogger.Enable {
 		if opt.Config.FlowLogger.RecordFormat != "CSV" {
-			return nil, fmt.Errorf("record format %s is not supported", opt.Config.FlowLogger.RecordFormat)
+			return fmt.Errorf("record format %s is not supported", opt.Config.FlowLogger.RecordFormat)
 		}
 	}
-	return &opt, nil
+	return nil
+}
+
+// newSinkOptions returns the options of the provided sink. The exporter options are derived from
+// opt: the configuration sections of all exporters are disabled, except for the one corresponding
+// to the sink type, which is set to the configuration of the sink.
+func newSinkOptions(opt *Options, sinkConfig *flowaggregatorconfig.SinkConfig) (*SinkOptions, error) {
+	config := *opt.Config
+	config.FlowCollector = flowaggregatorconfig.FlowCollectorConfig{}
+	config.ClickHouse = flowaggregatorconfig.ClickHouseConfig{}
+	config.S3Uploader = flowaggregatorconfig.S3UploaderConfig{}
+	config.FlowLogger = flowaggregatorconfig.FlowLoggerConfig{}
+	config.Sinks = nil
+	switch sinkConfig.Type {
+	case flowaggregatorconfig.SinkTypeIPFIX:
+		if sinkConfig.FlowCollector == nil {
+			return nil, fmt.Errorf("flowCollector must be provided for sink type %s", sinkConfig.Type)
+		}
+		config.FlowCollector = *sinkConfig.FlowCollector
+		config.FlowCollector.Enable = true
+	case flowaggregatorconfig.SinkTypeClickHouse:
+		if sinkConfig.ClickHouse == nil {
+			return nil, fmt.Errorf("clickHouse must be provided for sink type %s", sinkConfig.Type)
+		}
+		config.ClickHouse = *sinkConfig.ClickHouse
+		config.ClickHouse.Enable = true
+	case flowaggregatorconfig.SinkTypeS3:
+		if sinkConfig.S3Uploader == nil {
+			return nil, fmt.Errorf("s3Uploader must be provided for sink type %s", sinkConfig.Type)
+		}
+		config.S3Uploader = *sinkConfig.S3Uploader
+		config.S3Uploader.Enable = true
+	case flowaggregatorconfig.SinkTypeLog:
+		if sinkConfig.FlowLogger == nil {
+			return nil, fmt.Errorf("flowLogger must be provided for sink type %s", sinkConfig.Type)
+		}
+		config.FlowLogger = *sinkConfig.FlowLogger
+		config.FlowLogger.Enable = true
+		if config.FlowLogger.Path == "" {
+			config.FlowLogger.Path = filepath.Join(os.TempDir(), fmt.Sprintf("antrea-flows-%s.log", sinkConfig.Name))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported sink type %s", sinkConfig.Type)
+	}
+	flowaggregatorconfig.SetConfigDefaults(&config)
+	if opt.AggregatorMode == flowaggregatorconfig.AggregatorModeProxy && sinkConfig.Type != flowaggregatorconfig.SinkTypeIPFIX {
+		return nil, fmt.Errorf("only sinks of type %s are supported in Proxy mode", flowaggregatorconfig.SinkTypeIPFIX)
+	}
+	exporterOpt := &Options{
+		Config:                      &config,
+		AggregatorMode:              opt.AggregatorMode,
+		ActiveFlowRecordTimeout:     opt.ActiveFlowRecordTimeout,
+		InactiveFlowRecordTimeout:   opt.InactiveFlowRecordTimeout,
+		AggregatorTransportProtocol: opt.AggregatorTransportProtocol,
+	}
+	if err := validateExporters(exporterOpt); err != nil {
+		return nil, err
+	}
+	return &SinkOptions{
+		Config:          sinkConfig,
+		ExporterOptions: exporterOpt,
+	}, nil
 }
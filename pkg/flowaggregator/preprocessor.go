@@ -17,6 +17,7 @@ package flowaggregator
 import (
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/vmware/go-ipfix/pkg/entities"
 	"k8s.io/klog/v2"
@@ -40,8 +41,16 @@ type preprocessor struct {
 
 	defaultElementsWithValueV4 []entities.InfoElementWithValue
 	defaultElementsWithValueV6 []entities.InfoElementWithValue
+
+	// flowLimiter is used to spill or drop records for new flows when the aggregation process is
+	// full. It is nil when the number of flows is not limited.
+	flowLimiter *flowLimiter
 }
 
+// spillReplayInterval is the interval at which the preprocessor checks whether spilled records can
+// be handed over to the aggregation process.
+const spillReplayInterval = time.Second
+
 func makeDefaultElementWithValue(ie *entities.InfoElement) (entities.InfoElementWithValue, error) {
 	switch ie.DataType {
 	case entities.OctetArray:
@@ -120,10 +129,18 @@ func newPreprocessor(infoElementsV4, infoElementsV6 []*entities.InfoElement, inC
 }
 
 func (p *preprocessor) Run(stopCh <-chan struct{}) {
+	var replayCh <-chan time.Time
+	if p.flowLimiter != nil && p.flowLimiter.spill != nil {
+		ticker := time.NewTicker(spillReplayInterval)
+		defer ticker.Stop()
+		replayCh = ticker.C
+	}
 	for {
 		select {
 		case <-stopCh:
 			return
+		case <-replayCh:
+			p.replaySpilledRecords()
 		case msg, ok := <-p.inCh:
 			if !ok {
 				return
@@ -157,8 +174,9 @@ func (p *preprocessor) processMsg(msg *entities.Message) {
 	if !isIPv4 {
 		expectedElements = p.expectedElementsV6
 	}
+	limitFlows := p.flowLimiter != nil && p.flowLimiter.isFull()
 	// Fast path: everything matches so we can just forward the message as is.
-	if numElements == expectedElements {
+	if numElements == expectedElements && !limitFlows {
 		p.outCh <- msg
 		return
 	}
@@ -168,6 +186,7 @@ func (p *preprocessor) processMsg(msg *entities.Message) {
 		klog.ErrorS(err, "Failed to prepare modified set")
 		return
 	}
+	numRecords := 0
 	for _, record := range records {
 		elementList := record.GetOrderedElementList()
 		if numElements > expectedElements {
@@ -176,8 +195,8 @@ func (p *preprocessor) processMsg(msg *entities.Message) {
 			}
 			// Creating a new Record seems like the best option here. By using
 			// AddRecordV2, we should minimize the number of allocations required.
-			newSet.AddRecordV2(elementList[:expectedElements], 0)
-		} else {
+			elementList = elementList[:expectedElements]
+		} else if numElements < expectedElements {
 			if klog.V(5).Enabled() {
 				klog.InfoS("Record received from exporter is missing information elements, adding fields with zero values", "expectedElements", expectedElements, "receivedElements", numElements)
 			}
@@ -186,11 +205,50 @@ func (p *preprocessor) processMsg(msg *entities.Message) {
 			} else {
 				elementList = append(elementList, p.defaultElementsWithValueV6[numElements:]...)
 			}
-			newSet.AddRecordV2(elementList, 0)
 		}
+		if limitFlows && !p.flowLimiter.admit(record, elementList, isIPv4) {
+			continue
+		}
+		numRecords++
+		newSet.AddRecordV2(elementList, 0)
+	}
+	if numRecords == 0 {
+		return
 	}
 	// This will overwrite the existing set with the new one.
 	// Note that the message length will no longer be correct, but this should not matter.
 	msg.AddSet(newSet)
 	p.outCh <- msg
 }
+
+// replaySpilledRecords hands over the spilled records to the aggregation process, as long as it is
+// not full. Records are sent in one message per IP family.
+func (p *preprocessor) replaySpilledRecords() {
+	records := p.flowLimiter.nextSpilledRecords()
+	if len(records) == 0 {
+		return
+	}
+	var setV4, setV6 entities.Set
+	for _, record := range records {
+		set := &setV4
+		if !record.isIPv4 {
+			set = &setV6
+		}
+		if *set == nil {
+			*set = entities.NewSet(true)
+			if err := (*set).PrepareSet(entities.Data, 0); err != nil {
+				klog.ErrorS(err, "Failed to prepare set for spilled records")
+				return
+			}
+		}
+		(*set).AddRecordV2(record.elements, 0)
+	}
+	for _, set := range []entities.Set{setV4, setV6} {
+		if set == nil {
+			continue
+		}
+		msg := entities.NewMessage(true)
+		msg.AddSet(set)
+		p.outCh <- msg
+	}
+}
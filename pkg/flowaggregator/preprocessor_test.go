@@ -21,6 +21,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixintermediate "github.com/vmware/go-ipfix/pkg/intermediate"
+	"go.uber.org/mock/gomock"
+
+	ipfixtesting "antrea.io/antrea/pkg/ipfix/testing"
 )
 
 func TestPreprocessorProcessMsg(t *testing.T) {
@@ -113,3 +117,150 @@ func TestPreprocessorProcessMsg(t *testing.T) {
 		testIPFamily(t, iesWithValueIPv6)
 	})
 }
+
+func TestPreprocessorFlowLimiter(t *testing.T) {
+	const testTemplateID = 256
+	sourceIPv4AddressIE := ipfixentities.NewInfoElement("sourceIPv4Address", 8, 18, 0, 4)
+	destinationIPv4AddressIE := ipfixentities.NewInfoElement("destinationIPv4Address", 12, 18, 0, 4)
+	protocolIdentifierIE := ipfixentities.NewInfoElement("protocolIdentifier", 4, 1, 0, 1)
+	sourceTransportPortIE := ipfixentities.NewInfoElement("sourceTransportPort", 7, 2, 0, 2)
+	destinationTransportPortIE := ipfixentities.NewInfoElement("destinationTransportPort", 11, 2, 0, 2)
+	ies := []*ipfixentities.InfoElement{sourceIPv4AddressIE, destinationIPv4AddressIE, protocolIdentifierIE, sourceTransportPortIE, destinationTransportPortIE}
+
+	getIEsWithValue := func(destinationIP string) []ipfixentities.InfoElementWithValue {
+		return []ipfixentities.InfoElementWithValue{
+			ipfixentities.NewIPAddressInfoElement(sourceIPv4AddressIE, net.ParseIP("1.1.1.1")),
+			ipfixentities.NewIPAddressInfoElement(destinationIPv4AddressIE, net.ParseIP(destinationIP)),
+			ipfixentities.NewUnsigned8InfoElement(protocolIdentifierIE, 6),
+			ipfixentities.NewUnsigned16InfoElement(sourceTransportPortIE, 1234),
+			ipfixentities.NewUnsigned16InfoElement(destinationTransportPortIE, 80),
+		}
+	}
+	storedFlowKey := &ipfixintermediate.FlowKey{
+		SourceAddress:      "1.1.1.1",
+		DestinationAddress: "1.1.2.1",
+		Protocol:           6,
+		SourcePort:         1234,
+		DestinationPort:    80,
+	}
+	newFlowKey := &ipfixintermediate.FlowKey{
+		SourceAddress:      "1.1.1.1",
+		DestinationAddress: "1.1.2.2",
+		Protocol:           6,
+		SourcePort:         1234,
+		DestinationPort:    80,
+	}
+	getTestMsg := func() *ipfixentities.Message {
+		s := ipfixentities.NewSet(true)
+		require.NoError(t, s.PrepareSet(ipfixentities.Data, testTemplateID))
+		require.NoError(t, s.AddRecordV2(getIEsWithValue("1.1.2.1"), testTemplateID))
+		require.NoError(t, s.AddRecordV2(getIEsWithValue("1.1.2.2"), testTemplateID))
+		msg := ipfixentities.NewMessage(true)
+		msg.AddSet(s)
+		return msg
+	}
+
+	testCases := []struct {
+		name                      string
+		numFlows                  int64
+		newFlowStored             bool
+		expectedDestinationIPs    []string
+		expectedNumRecordsDropped int64
+	}{
+		{
+			name:                   "limit not reached",
+			numFlows:               1,
+			expectedDestinationIPs: []string{"1.1.2.1", "1.1.2.2"},
+		},
+		{
+			name:                      "limit reached",
+			numFlows:                  2,
+			expectedDestinationIPs:    []string{"1.1.2.1"},
+			expectedNumRecordsDropped: 1,
+		},
+		{
+			name:                   "limit reached with all flows stored",
+			numFlows:               2,
+			newFlowStored:          true,
+			expectedDestinationIPs: []string{"1.1.2.1", "1.1.2.2"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAggregationProcess := ipfixtesting.NewMockIPFIXAggregationProcess(ctrl)
+			mockAggregationProcess.EXPECT().GetNumFlows().Return(tc.numFlows)
+			if tc.numFlows >= 2 {
+				mockAggregationProcess.EXPECT().GetRecords(storedFlowKey).Return([]map[string]interface{}{{}})
+				var newFlowRecords []map[string]interface{}
+				if tc.newFlowStored {
+					newFlowRecords = []map[string]interface{}{{}}
+				}
+				mockAggregationProcess.EXPECT().GetRecords(newFlowKey).Return(newFlowRecords)
+			}
+			outCh := make(chan *ipfixentities.Message, 1)
+			p, err := newPreprocessor(ies, ies, nil, outCh)
+			require.NoError(t, err)
+			p.flowLimiter = newFlowLimiter(2, mockAggregationProcess)
+			p.processMsg(getTestMsg())
+			m := <-outCh
+			var destinationIPs []string
+			for _, r := range m.GetSet().GetRecords() {
+				ie, _, exist := r.GetInfoElementWithValue("destinationIPv4Address")
+				require.True(t, exist)
+				destinationIPs = append(destinationIPs, ie.GetIPAddressValue().String())
+			}
+			assert.Equal(t, tc.expectedDestinationIPs, destinationIPs)
+			assert.Equal(t, tc.expectedNumRecordsDropped, p.flowLimiter.getNumRecordsDropped())
+		})
+	}
+
+	t.Run("all records dropped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockAggregationProcess := ipfixtesting.NewMockIPFIXAggregationProcess(ctrl)
+		mockAggregationProcess.EXPECT().GetNumFlows().Return(int64(2))
+		mockAggregationProcess.EXPECT().GetRecords(gomock.Any()).Return(nil).Times(2)
+		outCh := make(chan *ipfixentities.Message, 1)
+		p, err := newPreprocessor(ies, ies, nil, outCh)
+		require.NoError(t, err)
+		p.flowLimiter = newFlowLimiter(2, mockAggregationProcess)
+		p.processMsg(getTestMsg())
+		assert.Empty(t, outCh)
+		assert.Equal(t, int64(2), p.flowLimiter.getNumRecordsDropped())
+	})
+
+	t.Run("records spilled", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockAggregationProcess := ipfixtesting.NewMockIPFIXAggregationProcess(ctrl)
+		outCh := make(chan *ipfixentities.Message, 1)
+		p, err := newPreprocessor(ies, ies, nil, outCh)
+		require.NoError(t, err)
+		p.flowLimiter = newFlowLimiter(2, mockAggregationProcess)
+		require.NoError(t, p.flowLimiter.enableSpill(t.TempDir(), 1<<20, ies, ies))
+
+		// The record for the new flow is spilled instead of being dropped.
+		mockAggregationProcess.EXPECT().GetNumFlows().Return(int64(2))
+		mockAggregationProcess.EXPECT().GetRecords(storedFlowKey).Return([]map[string]interface{}{{}})
+		mockAggregationProcess.EXPECT().GetRecords(newFlowKey).Return(nil)
+		p.processMsg(getTestMsg())
+		m := <-outCh
+		assert.Len(t, m.GetSet().GetRecords(), 1)
+		assert.Equal(t, int64(0), p.flowLimiter.getNumRecordsDropped())
+		assert.Equal(t, int64(1), p.flowLimiter.spill.len())
+
+		// Spilled records are not handed over while the aggregation process is full.
+		mockAggregationProcess.EXPECT().GetNumFlows().Return(int64(2))
+		p.replaySpilledRecords()
+		assert.Empty(t, outCh)
+
+		mockAggregationProcess.EXPECT().GetNumFlows().Return(int64(1))
+		p.replaySpilledRecords()
+		m = <-outCh
+		records := m.GetSet().GetRecords()
+		require.Len(t, records, 1)
+		ie, _, exist := records[0].GetInfoElementWithValue("destinationIPv4Address")
+		require.True(t, exist)
+		assert.Equal(t, "1.1.2.2", ie.GetIPAddressValue().String())
+		assert.Equal(t, int64(0), p.flowLimiter.spill.len())
+	})
+}
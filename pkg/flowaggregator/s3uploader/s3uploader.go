@@ -28,7 +28,6 @@ import (
 	awsconfig "github.com/aws/aws-sdk-go-v2/config"
 	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
-	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
 	"k8s.io/klog/v2"
 
 	config "antrea.io/antrea/pkg/config/flowaggregator"
@@ -53,6 +52,9 @@ type S3UploadProcess struct {
 	region           string
 	compress         bool
 	maxRecordPerFile int32
+	// includePodOwners determines whether the Pod owner columns are written. It is protected by
+	// queueMutex.
+	includePodOwners bool
 	// uploadInterval is the interval between batch uploads
 	uploadInterval time.Duration
 	// uploadTicker is a ticker, containing a channel used to trigger batchUploadAll() for every uploadInterval period
@@ -88,6 +90,8 @@ type S3UploadProcess struct {
 type S3Input struct {
 	Config         config.S3UploaderConfig
 	UploadInterval time.Duration
+	// IncludePodOwners determines whether the Pod owner columns are written.
+	IncludePodOwners bool
 }
 
 // Define a wrapper interface S3UploaderAPI to assist unit testing.
@@ -144,6 +148,7 @@ func NewS3UploadProcess(input S3Input, clusterUUID string) (*S3UploadProcess, er
 		region:           region,
 		compress:         *config.Compress,
 		maxRecordPerFile: config.MaxRecordsPerFile,
+		includePodOwners: input.IncludePodOwners,
 		uploadInterval:   input.UploadInterval,
 		currentBuffer:    buf,
 		bufferQueue:      make([]*bytes.Buffer, 0),
@@ -214,8 +219,21 @@ func (p *S3UploadProcess) SetUploadInterval(uploadInterval time.Duration) {
 	}
 }
 
-func (p *S3UploadProcess) CacheRecord(record ipfixentities.Record) {
-	r := flowrecord.GetFlowRecord(record)
+func (p *S3UploadProcess) GetIncludePodOwners() bool {
+	p.queueMutex.Lock()
+	defer p.queueMutex.Unlock()
+	return p.includePodOwners
+}
+
+// SetIncludePodOwners updates whether the Pod owner columns are written. It only affects records
+// cached after the call.
+func (p *S3UploadProcess) SetIncludePodOwners(includePodOwners bool) {
+	p.queueMutex.Lock()
+	defer p.queueMutex.Unlock()
+	p.includePodOwners = includePodOwners
+}
+
+func (p *S3UploadProcess) CacheRecord(r *flowrecord.FlowRecord) {
 	p.queueMutex.Lock()
 	defer p.queueMutex.Unlock()
 	p.writeRecordToBuffer(r)
@@ -331,7 +349,7 @@ func (p *S3UploadProcess) writeRecordToBuffer(record *flowrecord.FlowRecord) {
 	if p.compress {
 		writer = p.gzipWriter
 	}
-	writeRecord(writer, record, p.clusterUUID)
+	writeRecord(writer, record, p.clusterUUID, p.includePodOwners)
 	io.WriteString(writer, "\n")
 	p.cachedRecordCount += 1
 }
@@ -380,7 +398,7 @@ func randSeq(randSrc *rand.Rand, n int) string {
 	return string(b)
 }
 
-func writeRecord(w io.Writer, r *flowrecord.FlowRecord, clusterUUID string) {
+func writeRecord(w io.Writer, r *flowrecord.FlowRecord, clusterUUID string, includePodOwners bool) {
 	io.WriteString(w, fmt.Sprintf("%d", r.FlowStartSeconds.Unix()))
 	io.WriteString(w, ",")
 	io.WriteString(w, fmt.Sprintf("%d", r.FlowEndSeconds.Unix()))
@@ -490,4 +508,14 @@ func writeRecord(w io.Writer, r *flowrecord.FlowRecord, clusterUUID string) {
 	io.WriteString(w, r.HttpVals)
 	io.WriteString(w, ",")
 	io.WriteString(w, r.EgressNodeName)
+	if includePodOwners {
+		io.WriteString(w, ",")
+		io.WriteString(w, r.SourcePodOwnerKind)
+		io.WriteString(w, ",")
+		io.WriteString(w, r.SourcePodOwnerName)
+		io.WriteString(w, ",")
+		io.WriteString(w, r.DestinationPodOwnerKind)
+		io.WriteString(w, ",")
+		io.WriteString(w, r.DestinationPodOwnerName)
+	}
 }
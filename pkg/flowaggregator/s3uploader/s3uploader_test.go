@@ -32,6 +32,8 @@ import (
 	"github.com/vmware/go-ipfix/pkg/registry"
 	"go.uber.org/mock/gomock"
 
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	flowrecordtesting "antrea.io/antrea/pkg/flowaggregator/flowrecord/testing"
 	s3uploadertesting "antrea.io/antrea/pkg/flowaggregator/s3uploader/testing"
 	flowaggregatortesting "antrea.io/antrea/pkg/flowaggregator/testing"
 )
@@ -79,7 +81,7 @@ func TestCacheRecord(t *testing.T) {
 	// First call, cache the record in currentBuffer.
 	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, true)
-	s3UploadProc.CacheRecord(mockRecord)
+	s3UploadProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 	assert.Equal(t, int32(1), s3UploadProc.cachedRecordCount)
 	currentBuffer := strings.TrimRight(s3UploadProc.currentBuffer.String(), "\n")
 	assert.Equal(t, strings.Split(currentBuffer, ",")[:50], strings.Split(recordStrIPv4, ",")[:50])
@@ -88,7 +90,7 @@ func TestCacheRecord(t *testing.T) {
 	// Second call, reach currentBuffer max size, add the currentBuffer to bufferQueue.
 	mockRecord = ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, false)
-	s3UploadProc.CacheRecord(mockRecord)
+	s3UploadProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 	assert.Equal(t, 1, len(s3UploadProc.bufferQueue))
 	buf := s3UploadProc.bufferQueue[0]
 	currentBuf := strings.TrimRight(strings.Split(buf.String(), "\n")[1], "\n")
@@ -98,6 +100,17 @@ func TestCacheRecord(t *testing.T) {
 	assert.Equal(t, "", s3UploadProc.currentBuffer.String())
 }
 
+func TestWriteRecordPodOwners(t *testing.T) {
+	record := flowrecordtesting.PrepareTestFlowRecord()
+	var buf bytes.Buffer
+	writeRecord(&buf, record, fakeClusterUUID, false)
+	assert.True(t, strings.HasSuffix(buf.String(), ",test-egress-node"))
+
+	buf.Reset()
+	writeRecord(&buf, record, fakeClusterUUID, true)
+	assert.True(t, strings.HasSuffix(buf.String(), ",test-egress-node,Deployment,perftest-a-deployment,StatefulSet,perftest-b-statefulset"))
+}
+
 func TestBatchUploadAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockS3Uploader := s3uploadertesting.NewMockS3UploaderAPI(ctrl)
@@ -117,7 +130,7 @@ func TestBatchUploadAll(t *testing.T) {
 	}
 	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, true)
-	s3UploadProc.CacheRecord(mockRecord)
+	s3UploadProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 	assert.EqualValues(t, 1, s3UploadProc.cachedRecordCount)
 
 	err := s3UploadProc.batchUploadAll(ctx)
@@ -150,10 +163,10 @@ func TestBatchUploadAllPartialSuccess(t *testing.T) {
 	}
 	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, true)
-	s3UploadProc.CacheRecord(mockRecord)
+	s3UploadProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 	mockRecord = ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, true)
-	s3UploadProc.CacheRecord(mockRecord)
+	s3UploadProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 
 	err := s3UploadProc.batchUploadAll(ctx)
 	assert.Equal(t, 0, len(s3UploadProc.bufferQueue))
@@ -183,7 +196,7 @@ func TestBatchUploadAllError(t *testing.T) {
 
 	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, true)
-	s3UploadProc.CacheRecord(mockRecord)
+	s3UploadProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 	assert.EqualValues(t, 1, s3UploadProc.cachedRecordCount)
 
 	// It is expected to fail when calling uploadFile, as the correct S3 bucket
@@ -223,7 +236,7 @@ func TestFlowRecordPeriodicCommit(t *testing.T) {
 	}
 	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, true)
-	s3UploadProc.CacheRecord(mockRecord)
+	s3UploadProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 	assert.EqualValues(t, 1, s3UploadProc.cachedRecordCount)
 
 	s3UploadProc.startExportProcess()
@@ -263,7 +276,7 @@ func TestFlushCacheOnStop(t *testing.T) {
 	}
 	mockRecord := ipfixentitiestesting.NewMockRecord(ctrl)
 	flowaggregatortesting.PrepareMockIpfixRecord(mockRecord, true)
-	s3UploadProc.CacheRecord(mockRecord)
+	s3UploadProc.CacheRecord(flowrecord.GetFlowRecord(mockRecord))
 	assert.EqualValues(t, 1, s3UploadProc.cachedRecordCount)
 
 	s3UploadProc.startExportProcess()
@@ -0,0 +1,491 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/vmware/go-ipfix/pkg/entities"
+	ipfixexporter "github.com/vmware/go-ipfix/pkg/exporter"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	discoveryinformers "k8s.io/client-go/informers/discovery/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+
+	"antrea.io/antrea/pkg/flowaggregator/metrics"
+	"antrea.io/antrea/pkg/ipfix"
+	"antrea.io/antrea/pkg/util/flowexport"
+)
+
+const (
+	// forwardQueueSize is the maximum number of records queued for each replica. Records are dropped when the queue
+	// of a replica is full, e.g. when the replica is unreachable.
+	forwardQueueSize = 4096
+	// maxForwardBatchSize is the maximum number of records forwarded to a replica in a single set.
+	maxForwardBatchSize = 64
+)
+
+// forwardBackoff is the backoff applied after a batch of records could not be forwarded to a replica. It is meant to be
+// overridden for testing.
+var forwardBackoff = wait.Backoff{
+	Duration: time.Second,
+	Factor:   2,
+	Jitter:   0.1,
+	Steps:    math.MaxInt32,
+	Cap:      30 * time.Second,
+}
+
+// recordForwarder forwards records to another replica of the flow aggregator.
+type recordForwarder interface {
+	// forward forwards a batch of records of the same IP family.
+	forward(records []entities.Record, isIPv4 bool) error
+	close()
+}
+
+// shardRouter sits between the preprocessor and the aggregation process of a replica of a sharded
+// flow aggregator. Flow exporters select a replica by consistent hashing of their Node name, so the
+// records exported by the source and destination Nodes of an inter-Node flow are usually received
+// by different replicas. To aggregate them, each inter-Node flow is owned by the replica selected
+// by consistent hashing of its flow key, and the records of the flows owned by other replicas are
+// forwarded to them over IPFIX. The records of the other flow types are exported by a single Node,
+// and are always handed over to the local aggregation process, as are the records forwarded by
+// other replicas, even if the replicas don't agree on the owner of a flow while they are scaled.
+type shardRouter struct {
+	inCh  <-chan *entities.Message
+	outCh chan<- *entities.Message
+
+	// localAddress is the IP address of this replica.
+	localAddress          string
+	endpointSliceInformer cache.SharedIndexInformer
+
+	replicasMutex sync.RWMutex
+	// replicas are the sorted IP addresses of the ready replicas, among which flows are sharded.
+	replicas []string
+	// peers are the IP addresses of all the replicas, including the ones which are not ready.
+	peers sets.Set[string]
+
+	// newForwarder creates a forwarder to the replica with the provided IP address. It is meant to
+	// be overridden for testing.
+	newForwarder func(address string) recordForwarder
+	// forwardQueues holds the queue of the records forwarded to each replica. It is only accessed by the
+	// goroutine running processMsg.
+	forwardQueues map[string]*forwardQueue
+
+	numRecordsForwarded atomic.Int64
+	numRecordsDropped   atomic.Int64
+}
+
+func newShardRouter(
+	k8sClient kubernetes.Interface,
+	namespace string,
+	headlessService string,
+	localAddress string,
+	newForwarder func(address string) recordForwarder,
+	inCh <-chan *entities.Message,
+	outCh chan<- *entities.Message,
+) *shardRouter {
+	r := &shardRouter{
+		inCh:         inCh,
+		outCh:        outCh,
+		localAddress: localAddress,
+		endpointSliceInformer: discoveryinformers.NewFilteredEndpointSliceInformer(k8sClient, namespace, 0, cache.Indexers{}, func(options *metav1.ListOptions) {
+			options.LabelSelector = fmt.Sprintf("%s=%s", discoveryv1.LabelServiceName, headlessService)
+		}),
+		peers:         sets.New[string](),
+		newForwarder:  newForwarder,
+		forwardQueues: make(map[string]*forwardQueue),
+	}
+	r.endpointSliceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { r.updateReplicas() },
+		UpdateFunc: func(_, _ interface{}) { r.updateReplicas() },
+		DeleteFunc: func(_ interface{}) { r.updateReplicas() },
+	})
+	return r
+}
+
+func (r *shardRouter) updateReplicas() {
+	replicas := sets.New[string]()
+	peers := sets.New[string]()
+	for _, obj := range r.endpointSliceInformer.GetStore().List() {
+		endpointSlice := obj.(*discoveryv1.EndpointSlice)
+		for _, endpoint := range endpointSlice.Endpoints {
+			if len(endpoint.Addresses) == 0 {
+				continue
+			}
+			address := endpoint.Addresses[0]
+			peers.Insert(address)
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				replicas.Insert(address)
+			}
+		}
+	}
+	r.replicasMutex.Lock()
+	defer r.replicasMutex.Unlock()
+	r.replicas = sets.List(replicas)
+	r.peers = peers
+	klog.V(2).InfoS("Updated FlowAggregator replicas", "replicas", r.replicas)
+}
+
+func (r *shardRouter) getReplicas() ([]string, sets.Set[string]) {
+	r.replicasMutex.RLock()
+	defer r.replicasMutex.RUnlock()
+	return r.replicas, r.peers
+}
+
+func (r *shardRouter) Run(stopCh <-chan struct{}) {
+	defer func() {
+		for _, q := range r.forwardQueues {
+			q.stop()
+		}
+	}()
+	go r.endpointSliceInformer.Run(stopCh)
+	if !cache.WaitForNamedCacheSync("FlowAggregatorShardRouter", stopCh, r.endpointSliceInformer.HasSynced) {
+		return
+	}
+	for {
+		select {
+		case <-stopCh:
+			return
+		case msg, ok := <-r.inCh:
+			if !ok {
+				return
+			}
+			r.processMsg(msg)
+		}
+	}
+}
+
+// getOwner returns the replica which owns the flow of the record, or an empty string if the record
+// should be aggregated locally.
+func getOwner(record entities.Record, isIPv4 bool, replicas []string) string {
+	flowTypeElement, _, exist := record.GetInfoElementWithValue("flowType")
+	if !exist || flowTypeElement.GetUnsigned8Value() != ipfixregistry.FlowTypeInterNode {
+		return ""
+	}
+	flowKey, ok := getFlowKeyFromRecord(record, isIPv4)
+	if !ok {
+		return ""
+	}
+	key := fmt.Sprintf("%s/%s/%d/%d/%d", flowKey.SourceAddress, flowKey.DestinationAddress, flowKey.Protocol, flowKey.SourcePort, flowKey.DestinationPort)
+	return flowexport.SelectShard(key, replicas)
+}
+
+func (r *shardRouter) processMsg(msg *entities.Message) {
+	replicas, peers := r.getReplicas()
+	r.stopRemovedForwardQueues(peers)
+	set := msg.GetSet()
+	records := set.GetRecords()
+	if set.GetSetType() != entities.Data || len(records) == 0 || len(replicas) == 0 || peers.Has(msg.GetExportAddress()) {
+		r.outCh <- msg
+		return
+	}
+	isIPv4 := isRecordIPv4(records[0])
+	localRecords := make([]entities.Record, 0, len(records))
+	for _, record := range records {
+		owner := getOwner(record, isIPv4, replicas)
+		if owner == "" || owner == r.localAddress {
+			localRecords = append(localRecords, record)
+			continue
+		}
+		r.forward(owner, record, isIPv4)
+	}
+	if len(localRecords) == len(records) {
+		r.outCh <- msg
+		return
+	}
+	if len(localRecords) == 0 {
+		return
+	}
+	newSet := entities.NewSet(true)
+	// Set templateID to 0, as done by the preprocessor when it modifies a set.
+	if err := newSet.PrepareSet(entities.Data, 0); err != nil {
+		klog.ErrorS(err, "Failed to prepare modified set")
+		return
+	}
+	for _, record := range localRecords {
+		newSet.AddRecordV2(record.GetOrderedElementList(), 0)
+	}
+	msg.AddSet(newSet)
+	r.outCh <- msg
+}
+
+// stopRemovedForwardQueues stops forwarding records to the replicas which have been removed.
+func (r *shardRouter) stopRemovedForwardQueues(peers sets.Set[string]) {
+	for address, q := range r.forwardQueues {
+		if !peers.Has(address) {
+			q.stop()
+			delete(r.forwardQueues, address)
+		}
+	}
+}
+
+// forward queues the record for the replica without blocking, so that a slow or unreachable replica doesn't delay the
+// records of the other replicas, nor the records aggregated locally.
+func (r *shardRouter) forward(address string, record entities.Record, isIPv4 bool) {
+	q, ok := r.forwardQueues[address]
+	if !ok {
+		q = newForwardQueue(address, r.newForwarder(address), r)
+		r.forwardQueues[address] = q
+		go q.run()
+	}
+	select {
+	case q.recordCh <- forwardedRecord{record: record, isIPv4: isIPv4}:
+	default:
+		r.dropRecords(address, metrics.DropReasonForwardQueueFull, 1, nil)
+	}
+}
+
+func (r *shardRouter) dropRecords(address string, reason string, numRecords int64, err error) {
+	if r.numRecordsDropped.Add(numRecords) == numRecords {
+		klog.ErrorS(err, "Failed to forward records to FlowAggregator replica, dropping them", "replica", address, "reason", reason)
+	} else {
+		klog.V(2).ErrorS(err, "Failed to forward records to FlowAggregator replica, dropping them", "replica", address, "reason", reason)
+	}
+	metrics.RecordsDropped.WithLabelValues(reason).Add(float64(numRecords))
+}
+
+type forwardedRecord struct {
+	record entities.Record
+	isIPv4 bool
+}
+
+// forwardQueue forwards the records queued for a replica asynchronously, in batches. When a batch cannot be forwarded,
+// it is dropped and the next batch is forwarded after a backoff, during which the records keep being queued.
+type forwardQueue struct {
+	address   string
+	forwarder recordForwarder
+	router    *shardRouter
+	recordCh  chan forwardedRecord
+	stopCh    chan struct{}
+	doneCh    chan struct{}
+}
+
+func newForwardQueue(address string, forwarder recordForwarder, router *shardRouter) *forwardQueue {
+	return &forwardQueue{
+		address:   address,
+		forwarder: forwarder,
+		router:    router,
+		recordCh:  make(chan forwardedRecord, forwardQueueSize),
+		stopCh:    make(chan struct{}),
+		doneCh:    make(chan struct{}),
+	}
+}
+
+func (q *forwardQueue) run() {
+	defer close(q.doneCh)
+	defer q.forwarder.close()
+	backoff := forwardBackoff
+	for {
+		var batch []forwardedRecord
+		select {
+		case <-q.stopCh:
+			return
+		case record := <-q.recordCh:
+			batch = append(batch, record)
+		}
+		// Add the records which are already queued to the batch, without waiting for more.
+	batchLoop:
+		for len(batch) < maxForwardBatchSize {
+			select {
+			case record := <-q.recordCh:
+				batch = append(batch, record)
+			default:
+				break batchLoop
+			}
+		}
+		if err := q.forwardBatch(batch); err != nil {
+			select {
+			case <-q.stopCh:
+				return
+			case <-time.After(backoff.Step()):
+			}
+			continue
+		}
+		backoff = forwardBackoff
+	}
+}
+
+// forwardBatch forwards the records of each IP family in a single set.
+func (q *forwardQueue) forwardBatch(batch []forwardedRecord) error {
+	var recordsIPv4, recordsIPv6 []entities.Record
+	for _, r := range batch {
+		if r.isIPv4 {
+			recordsIPv4 = append(recordsIPv4, r.record)
+		} else {
+			recordsIPv6 = append(recordsIPv6, r.record)
+		}
+	}
+	var lastErr error
+	for _, records := range []struct {
+		records []entities.Record
+		isIPv4  bool
+	}{{recordsIPv4, true}, {recordsIPv6, false}} {
+		if len(records.records) == 0 {
+			continue
+		}
+		if err := q.forwarder.forward(records.records, records.isIPv4); err != nil {
+			q.router.dropRecords(q.address, metrics.DropReasonForwardError, int64(len(records.records)), err)
+			lastErr = err
+			continue
+		}
+		q.router.numRecordsForwarded.Add(int64(len(records.records)))
+	}
+	return lastErr
+}
+
+// stop stops forwarding records, drops the records which are still queued, and closes the forwarder.
+func (q *forwardQueue) stop() {
+	close(q.stopCh)
+	<-q.doneCh
+}
+
+// ipfixRecordForwarder forwards records to another replica over IPFIX, using the same transport
+// protocol as the flow exporters. The records have already been processed by the preprocessor, so
+// they match the templates built from the Information Elements expected by the preprocessor.
+type ipfixRecordForwarder struct {
+	input            ipfixexporter.ExporterInput
+	infoElementsIPv4 []*entities.InfoElement
+	infoElementsIPv6 []*entities.InfoElement
+	// initExportingProcess is meant to be overridden for testing.
+	initExportingProcess func(input ipfixexporter.ExporterInput) (ipfix.IPFIXExportingProcess, error)
+	process              ipfix.IPFIXExportingProcess
+	templateIDv4         uint16
+	templateIDv6         uint16
+	set                  entities.Set
+}
+
+func genReplicaObservationDomainID(localAddress string) uint32 {
+	h := fnv.New32()
+	h.Write([]byte(localAddress))
+	return h.Sum32()
+}
+
+func newIPFIXRecordForwarder(
+	address string,
+	localAddress string,
+	protocol string,
+	tlsConfig *ipfixexporter.ExporterTLSClientConfig,
+	infoElementsIPv4, infoElementsIPv6 []*entities.InfoElement,
+) *ipfixRecordForwarder {
+	input := ipfixexporter.ExporterInput{
+		CollectorAddress:    net.JoinHostPort(address, "4739"),
+		CollectorProtocol:   protocol,
+		ObservationDomainID: genReplicaObservationDomainID(localAddress),
+		// TempRefTimeout specifies how often the exporting process should send the template again.
+		// It is only relevant when using the UDP protocol. We use 0 to tell the go-ipfix library to
+		// use the default value.
+		TempRefTimeout: 0,
+		IsIPv6:         net.ParseIP(address).To4() == nil,
+	}
+	if tlsConfig != nil {
+		input.TLSClientConfig = &ipfixexporter.ExporterTLSClientConfig{
+			ServerName: tlsConfig.ServerName,
+			CAData:     tlsConfig.CAData,
+			CertData:   tlsConfig.CertData,
+			KeyData:    tlsConfig.KeyData,
+		}
+	}
+	return &ipfixRecordForwarder{
+		input:            input,
+		infoElementsIPv4: infoElementsIPv4,
+		infoElementsIPv6: infoElementsIPv6,
+		initExportingProcess: func(input ipfixexporter.ExporterInput) (ipfix.IPFIXExportingProcess, error) {
+			return ipfixexporter.InitExportingProcess(input)
+		},
+		set: entities.NewSet(false),
+	}
+}
+
+func (f *ipfixRecordForwarder) sendTemplateSet(templateID uint16, infoElements []*entities.InfoElement) error {
+	elements := make([]entities.InfoElementWithValue, 0, len(infoElements))
+	for _, ie := range infoElements {
+		element, err := entities.DecodeAndCreateInfoElementWithValue(ie, nil)
+		if err != nil {
+			return fmt.Errorf("error when creating information element: %w", err)
+		}
+		elements = append(elements, element)
+	}
+	f.set.ResetSet()
+	if err := f.set.PrepareSet(entities.Template, templateID); err != nil {
+		return err
+	}
+	if err := f.set.AddRecordV2(elements, templateID); err != nil {
+		return fmt.Errorf("error when adding record to template set: %w", err)
+	}
+	if _, err := f.process.SendSet(f.set); err != nil {
+		return fmt.Errorf("error when sending template set: %w", err)
+	}
+	return nil
+}
+
+func (f *ipfixRecordForwarder) connect() error {
+	process, err := f.initExportingProcess(f.input)
+	if err != nil {
+		return fmt.Errorf("error when connecting to FlowAggregator replica: %w", err)
+	}
+	f.process = process
+	f.templateIDv4 = f.process.NewTemplateID()
+	f.templateIDv6 = f.process.NewTemplateID()
+	if err := f.sendTemplateSet(f.templateIDv4, f.infoElementsIPv4); err != nil {
+		return err
+	}
+	return f.sendTemplateSet(f.templateIDv6, f.infoElementsIPv6)
+}
+
+func (f *ipfixRecordForwarder) forward(records []entities.Record, isIPv4 bool) error {
+	if f.process == nil {
+		if err := f.connect(); err != nil {
+			f.close()
+			return err
+		}
+	}
+	templateID := f.templateIDv4
+	if !isIPv4 {
+		templateID = f.templateIDv6
+	}
+	f.set.ResetSet()
+	if err := f.set.PrepareSet(entities.Data, templateID); err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := f.set.AddRecordV2(record.GetOrderedElementList(), templateID); err != nil {
+			return fmt.Errorf("error when adding record to data set: %w", err)
+		}
+	}
+	if _, err := f.process.SendSet(f.set); err != nil {
+		// The connection will be re-established when forwarding the next record.
+		f.close()
+		return fmt.Errorf("error when sending data set: %w", err)
+	}
+	return nil
+}
+
+func (f *ipfixRecordForwarder) close() {
+	if f.process != nil {
+		f.process.CloseConnToCollector()
+		f.process = nil
+	}
+}
@@ -0,0 +1,406 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	ipfixregistry "github.com/vmware/go-ipfix/pkg/registry"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"antrea.io/antrea/pkg/util/flowexport"
+)
+
+type fakeRecordForwarder struct {
+	mutex   sync.Mutex
+	records []ipfixentities.Record
+	batches int
+	err     error
+	closed  bool
+}
+
+func (f *fakeRecordForwarder) forward(records []ipfixentities.Record, isIPv4 bool) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.batches++
+	if f.err != nil {
+		return f.err
+	}
+	f.records = append(f.records, records...)
+	return nil
+}
+
+func (f *fakeRecordForwarder) close() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.closed = true
+}
+
+func (f *fakeRecordForwarder) getRecords() []ipfixentities.Record {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.records
+}
+
+func (f *fakeRecordForwarder) getBatches() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	return f.batches
+}
+
+func (f *fakeRecordForwarder) setErr(err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.err = err
+}
+
+func TestShardRouterProcessMsg(t *testing.T) {
+	const (
+		testTemplateID = 256
+		localAddress   = "10.10.0.11"
+		peerAddress    = "10.10.0.12"
+	)
+	sourceIPv4AddressIE := ipfixentities.NewInfoElement("sourceIPv4Address", 8, 18, 0, 4)
+	destinationIPv4AddressIE := ipfixentities.NewInfoElement("destinationIPv4Address", 12, 18, 0, 4)
+	protocolIdentifierIE := ipfixentities.NewInfoElement("protocolIdentifier", 4, 1, 0, 1)
+	sourceTransportPortIE := ipfixentities.NewInfoElement("sourceTransportPort", 7, 2, 0, 2)
+	destinationTransportPortIE := ipfixentities.NewInfoElement("destinationTransportPort", 11, 2, 0, 2)
+	flowTypeIE := ipfixentities.NewInfoElement("flowType", 137, 1, ipfixregistry.AntreaEnterpriseID, 1)
+	replicas := []string{localAddress, peerAddress}
+
+	getIEsWithValue := func(sourcePort uint16, flowType uint8) []ipfixentities.InfoElementWithValue {
+		return []ipfixentities.InfoElementWithValue{
+			ipfixentities.NewIPAddressInfoElement(sourceIPv4AddressIE, net.ParseIP("1.1.1.1")),
+			ipfixentities.NewIPAddressInfoElement(destinationIPv4AddressIE, net.ParseIP("1.1.2.1")),
+			ipfixentities.NewUnsigned8InfoElement(protocolIdentifierIE, 6),
+			ipfixentities.NewUnsigned16InfoElement(sourceTransportPortIE, sourcePort),
+			ipfixentities.NewUnsigned16InfoElement(destinationTransportPortIE, 80),
+			ipfixentities.NewUnsigned8InfoElement(flowTypeIE, flowType),
+		}
+	}
+	// findSourcePort returns a source port for which the flow is owned by the provided replica.
+	findSourcePort := func(owner string) uint16 {
+		for port := uint16(1024); ; port++ {
+			if flowexport.SelectShard(fmt.Sprintf("1.1.1.1/1.1.2.1/6/%d/80", port), replicas) == owner {
+				return port
+			}
+		}
+	}
+	localPort := findSourcePort(localAddress)
+	peerPort := findSourcePort(peerAddress)
+
+	getTestMsg := func(exportAddress string, iesWithValue ...[]ipfixentities.InfoElementWithValue) *ipfixentities.Message {
+		s := ipfixentities.NewSet(true)
+		require.NoError(t, s.PrepareSet(ipfixentities.Data, testTemplateID))
+		for _, ies := range iesWithValue {
+			require.NoError(t, s.AddRecordV2(ies, testTemplateID))
+		}
+		msg := ipfixentities.NewMessage(true)
+		msg.SetExportAddress(exportAddress)
+		msg.AddSet(s)
+		return msg
+	}
+
+	testCases := []struct {
+		name              string
+		replicas          []string
+		msg               *ipfixentities.Message
+		expectedLocal     [][]ipfixentities.InfoElementWithValue
+		expectedForwarded [][]ipfixentities.InfoElementWithValue
+	}{
+		{
+			name:          "inter-Node flow owned by local replica",
+			replicas:      replicas,
+			msg:           getTestMsg("192.168.0.1", getIEsWithValue(localPort, ipfixregistry.FlowTypeInterNode)),
+			expectedLocal: [][]ipfixentities.InfoElementWithValue{getIEsWithValue(localPort, ipfixregistry.FlowTypeInterNode)},
+		},
+		{
+			name:              "inter-Node flow owned by other replica",
+			replicas:          replicas,
+			msg:               getTestMsg("192.168.0.1", getIEsWithValue(peerPort, ipfixregistry.FlowTypeInterNode)),
+			expectedForwarded: [][]ipfixentities.InfoElementWithValue{getIEsWithValue(peerPort, ipfixregistry.FlowTypeInterNode)},
+		},
+		{
+			name:     "inter-Node flows owned by both replicas",
+			replicas: replicas,
+			msg: getTestMsg("192.168.0.1",
+				getIEsWithValue(localPort, ipfixregistry.FlowTypeInterNode),
+				getIEsWithValue(peerPort, ipfixregistry.FlowTypeInterNode)),
+			expectedLocal:     [][]ipfixentities.InfoElementWithValue{getIEsWithValue(localPort, ipfixregistry.FlowTypeInterNode)},
+			expectedForwarded: [][]ipfixentities.InfoElementWithValue{getIEsWithValue(peerPort, ipfixregistry.FlowTypeInterNode)},
+		},
+		{
+			name:          "intra-Node flow",
+			replicas:      replicas,
+			msg:           getTestMsg("192.168.0.1", getIEsWithValue(peerPort, ipfixregistry.FlowTypeIntraNode)),
+			expectedLocal: [][]ipfixentities.InfoElementWithValue{getIEsWithValue(peerPort, ipfixregistry.FlowTypeIntraNode)},
+		},
+		{
+			name:          "record forwarded by other replica",
+			replicas:      replicas,
+			msg:           getTestMsg(peerAddress, getIEsWithValue(peerPort, ipfixregistry.FlowTypeInterNode)),
+			expectedLocal: [][]ipfixentities.InfoElementWithValue{getIEsWithValue(peerPort, ipfixregistry.FlowTypeInterNode)},
+		},
+		{
+			name:          "no ready replica",
+			msg:           getTestMsg("192.168.0.1", getIEsWithValue(peerPort, ipfixregistry.FlowTypeInterNode)),
+			expectedLocal: [][]ipfixentities.InfoElementWithValue{getIEsWithValue(peerPort, ipfixregistry.FlowTypeInterNode)},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Buffered channel with capacity 1 to hold the output message generated by processMsg.
+			outCh := make(chan *ipfixentities.Message, 1)
+			forwarder := &fakeRecordForwarder{}
+			r := &shardRouter{
+				outCh:        outCh,
+				localAddress: localAddress,
+				replicas:     tc.replicas,
+				peers:        sets.New[string](replicas...),
+				newForwarder: func(address string) recordForwarder {
+					assert.Equal(t, peerAddress, address)
+					return forwarder
+				},
+				forwardQueues: make(map[string]*forwardQueue),
+			}
+			defer func() {
+				for _, q := range r.forwardQueues {
+					q.stop()
+				}
+			}()
+			r.processMsg(tc.msg)
+
+			var localIEs [][]ipfixentities.InfoElementWithValue
+			select {
+			case m := <-outCh:
+				for _, record := range m.GetSet().GetRecords() {
+					localIEs = append(localIEs, record.GetOrderedElementList())
+				}
+			default:
+			}
+			assert.Equal(t, tc.expectedLocal, localIEs)
+			// Records are forwarded asynchronously.
+			assert.EventuallyWithT(t, func(c *assert.CollectT) {
+				var forwardedIEs [][]ipfixentities.InfoElementWithValue
+				for _, record := range forwarder.getRecords() {
+					forwardedIEs = append(forwardedIEs, record.GetOrderedElementList())
+				}
+				assert.Equal(c, tc.expectedForwarded, forwardedIEs)
+				assert.Equal(c, int64(len(tc.expectedForwarded)), r.numRecordsForwarded.Load())
+			}, 2*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestForwardQueue(t *testing.T) {
+	const peerAddress = "10.10.0.12"
+	prevForwardBackoff := forwardBackoff
+	defer func() {
+		forwardBackoff = prevForwardBackoff
+	}()
+	forwardBackoff.Duration = 200 * time.Millisecond
+	forwardBackoff.Jitter = 0
+
+	newRecord := func() ipfixentities.Record {
+		return ipfixentities.NewDataRecordFromElements(256, nil)
+	}
+	forwarder := &fakeRecordForwarder{}
+	r := &shardRouter{
+		newForwarder:  func(address string) recordForwarder { return forwarder },
+		forwardQueues: make(map[string]*forwardQueue),
+	}
+	r.forward(peerAddress, newRecord(), true)
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Len(c, forwarder.getRecords(), 1)
+	}, 2*time.Second, 10*time.Millisecond)
+
+	// A batch which cannot be forwarded is dropped, and the next one is only forwarded after a backoff.
+	forwarder.setErr(fmt.Errorf("connection refused"))
+	r.forward(peerAddress, newRecord(), true)
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, int64(1), r.numRecordsDropped.Load())
+	}, 2*time.Second, 10*time.Millisecond)
+	forwarder.setErr(nil)
+	r.forward(peerAddress, newRecord(), true)
+	r.forward(peerAddress, newRecord(), false)
+	time.Sleep(50 * time.Millisecond)
+	assert.Len(t, forwarder.getRecords(), 1)
+	// The records queued during the backoff are forwarded in a single batch per IP family.
+	batches := forwarder.getBatches()
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Len(c, forwarder.getRecords(), 3)
+	}, 2*time.Second, 10*time.Millisecond)
+	assert.Equal(t, batches+2, forwarder.getBatches())
+	assert.Equal(t, int64(3), r.numRecordsForwarded.Load())
+
+	// Records are dropped when the queue of the replica is full.
+	forwarder.setErr(fmt.Errorf("connection refused"))
+	r.forward(peerAddress, newRecord(), true)
+	require.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, int64(2), r.numRecordsDropped.Load())
+	}, 2*time.Second, 10*time.Millisecond)
+	for i := 0; i < forwardQueueSize+10; i++ {
+		r.forward(peerAddress, newRecord(), true)
+	}
+	assert.Equal(t, int64(12), r.numRecordsDropped.Load())
+
+	// The queue is stopped and the forwarder is closed when the replica is removed.
+	r.stopRemovedForwardQueues(sets.New[string]())
+	assert.Empty(t, r.forwardQueues)
+	assert.True(t, forwarder.closed)
+}
+
+func TestGetOrCreateSharedCACertKey(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+	caCert, caKey, caPEM, err := getOrCreateSharedCACertKey(k8sClient)
+	require.NoError(t, err)
+	secret, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(context.TODO(), CASecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, caPEM, secret.Data[v1.TLSCertKey])
+
+	// Another replica uses the same CA certificate and key.
+	otherCACert, otherCAKey, otherCAPEM, err := getOrCreateSharedCACertKey(k8sClient)
+	require.NoError(t, err)
+	assert.Equal(t, caPEM, otherCAPEM)
+	assert.True(t, caKey.Equal(otherCAKey))
+	assert.Equal(t, caCert.Subject.CommonName, otherCACert.Subject.CommonName)
+
+	// The server certificate of a replica is verified with the shared CA certificate, using the DNS name of the
+	// headless Service.
+	serverCertPEM, _, err := generateCertKey(otherCACert, otherCAKey, true, "", "flow-aggregator-headless")
+	require.NoError(t, err)
+	block, _ := pem.Decode(serverCertPEM)
+	require.NotNil(t, block)
+	serverCert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(caPEM))
+	_, err = serverCert.Verify(x509.VerifyOptions{
+		DNSName: "flow-aggregator-headless.flow-aggregator.svc",
+		Roots:   roots,
+	})
+	assert.NoError(t, err)
+}
+
+func TestRotateSharedCA(t *testing.T) {
+	now := time.Now()
+	ca, changed, err := rotateSharedCA(nil, now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	require.NotNil(t, ca.current)
+	assert.Nil(t, ca.next)
+	assert.Nil(t, ca.previousCertPEM)
+	assert.Equal(t, ca.current.certPEM, ca.bundlePEM())
+	current := ca.current
+
+	ca, changed, err = rotateSharedCA(ca.secretData(), now)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, current.certPEM, ca.current.certPEM)
+
+	// The next CA certificate is trusted before it replaces the current one.
+	now = current.cert.NotAfter.Add(-2*sharedCARotationOverlap + time.Hour)
+	ca, changed, err = rotateSharedCA(ca.secretData(), now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, current.certPEM, ca.current.certPEM)
+	require.NotNil(t, ca.next)
+	assert.Equal(t, append(bytes.Clone(current.certPEM), ca.next.certPEM...), ca.bundlePEM())
+	next := ca.next
+
+	// The previous CA certificate is still trusted after it has been replaced, until it expires.
+	now = current.cert.NotAfter.Add(-sharedCARotationOverlap + time.Hour)
+	ca, changed, err = rotateSharedCA(ca.secretData(), now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, next.certPEM, ca.current.certPEM)
+	assert.Nil(t, ca.next)
+	assert.Equal(t, current.certPEM, ca.previousCertPEM)
+	assert.Equal(t, append(bytes.Clone(next.certPEM), current.certPEM...), ca.bundlePEM())
+
+	now = current.cert.NotAfter.Add(time.Hour)
+	ca, changed, err = rotateSharedCA(ca.secretData(), now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, next.certPEM, ca.current.certPEM)
+	assert.Nil(t, ca.previousCertPEM)
+	assert.Equal(t, next.certPEM, ca.bundlePEM())
+}
+
+func TestFlowAggregator_watchSharedCA(t *testing.T) {
+	k8sClient := fake.NewSimpleClientset()
+	_, _, caPEM, err := getOrCreateSharedCACertKey(k8sClient)
+	require.NoError(t, err)
+	fa := &flowAggregator{
+		k8sClient:   k8sClient,
+		sharedCAPEM: caPEM,
+	}
+
+	prevSharedCACheckInterval, prevExitOnSharedCARotation := sharedCACheckInterval, exitOnSharedCARotation
+	defer func() {
+		sharedCACheckInterval, exitOnSharedCARotation = prevSharedCACheckInterval, prevExitOnSharedCARotation
+	}()
+	sharedCACheckInterval = 10 * time.Millisecond
+	exitCh := make(chan struct{}, 10)
+	exitOnSharedCARotation = func() {
+		exitCh <- struct{}{}
+	}
+	stopCh := make(chan struct{})
+	watchDoneCh := make(chan struct{})
+	go func() {
+		defer close(watchDoneCh)
+		fa.watchSharedCA(stopCh)
+	}()
+
+	select {
+	case <-exitCh:
+		t.Fatal("Flow aggregator should not exit when the shared CA is not rotated")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Another replica rotates the shared CA.
+	ca, _, err := rotateSharedCA(nil, time.Now())
+	require.NoError(t, err)
+	secret, err := k8sClient.CoreV1().Secrets(DefaultNamespace).Get(context.TODO(), CASecretName, metav1.GetOptions{})
+	require.NoError(t, err)
+	secret.Data = ca.secretData()
+	_, err = k8sClient.CoreV1().Secrets(DefaultNamespace).Update(context.TODO(), secret, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	select {
+	case <-exitCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Flow aggregator should exit when the shared CA is rotated")
+	}
+	close(stopCh)
+	select {
+	case <-watchDoneCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("watchSharedCA should return when stopCh is closed")
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"fmt"
+
+	ipfixentities "github.com/vmware/go-ipfix/pkg/entities"
+	"k8s.io/klog/v2"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/exporter"
+	"antrea.io/antrea/pkg/flowaggregator/flowrecord"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+// sink is an exporter configured through the sinks list of the FlowAggregator configuration. Each
+// sink has its own exporter configuration and its own filters.
+type sink struct {
+	opt      *options.SinkOptions
+	exporter exporter.Interface
+	filters  exporter.FlowFilters
+}
+
+func (fa *flowAggregator) newSink(opt *options.SinkOptions) (*sink, error) {
+	var exp exporter.Interface
+	var err error
+	exporterOpt := opt.ExporterOptions
+	switch opt.Config.Type {
+	case flowaggregatorconfig.SinkTypeIPFIX:
+		exp = newIPFIXExporter(fa.clusterUUID, exporterOpt, fa.registry)
+	case flowaggregatorconfig.SinkTypeClickHouse:
+		exp, err = newClickHouseExporter(fa.clusterUUID, exporterOpt, fa.podStore)
+	case flowaggregatorconfig.SinkTypeS3:
+		exp, err = newS3Exporter(fa.clusterUUID, exporterOpt, fa.podStore)
+	case flowaggregatorconfig.SinkTypeLog:
+		exp, err = newLogExporter(exporterOpt, fa.podStore)
+	default:
+		return nil, fmt.Errorf("unsupported sink type %s", opt.Config.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error when creating export process for sink %s: %w", opt.Config.Name, err)
+	}
+	return &sink{
+		opt:      opt,
+		exporter: exp,
+		filters:  exporter.NewFlowFilters(opt.Config.Filters),
+	}, nil
+}
+
+func (fa *flowAggregator) initSinks(sinkOpts []*options.SinkOptions) error {
+	fa.sinks = make(map[string]*sink, len(sinkOpts))
+	for _, sinkOpt := range sinkOpts {
+		s, err := fa.newSink(sinkOpt)
+		if err != nil {
+			return err
+		}
+		fa.sinks[sinkOpt.Config.Name] = s
+	}
+	return nil
+}
+
+func (fa *flowAggregator) startSinks() {
+	for _, s := range fa.sinks {
+		s.exporter.Start()
+	}
+}
+
+func (fa *flowAggregator) stopSinks() {
+	for _, s := range fa.sinks {
+		s.exporter.Stop()
+	}
+}
+
+func (fa *flowAggregator) sendRecordToSinks(record ipfixentities.Record, isRecordIPv6 bool) error {
+	// The FlowRecord is only computed if at least one sink has filters.
+	var r *flowrecord.FlowRecord
+	for name, s := range fa.sinks {
+		if len(s.filters) > 0 {
+			if r == nil {
+				r = flowrecord.GetFlowRecord(record)
+			}
+			if !s.filters.Match(r) {
+				klog.V(5).InfoS("Ignoring record in sink because filters do not match", "sink", name)
+				continue
+			}
+		}
+		if err := s.exporter.AddRecord(record, isRecordIPv6); err != nil {
+			return fmt.Errorf("error when exporting record to sink %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (fa *flowAggregator) flushSinks() error {
+	for name, s := range fa.sinks {
+		// Other exporters don't leverage Flush for now, so we skip them.
+		if s.opt.Config.Type != flowaggregatorconfig.SinkTypeIPFIX {
+			continue
+		}
+		if err := s.exporter.Flush(); err != nil {
+			return fmt.Errorf("error when flushing sink %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// updateSinks reconciles the running sinks with the provided sink options: sinks which are no
+// longer configured are stopped, new sinks are started, and existing sinks are updated. A sink
+// whose type has changed is re-created.
+func (fa *flowAggregator) updateSinks(sinkOpts []*options.SinkOptions) {
+	if fa.sinks == nil {
+		fa.sinks = make(map[string]*sink, len(sinkOpts))
+	}
+	desired := make(map[string]*options.SinkOptions, len(sinkOpts))
+	for _, sinkOpt := range sinkOpts {
+		desired[sinkOpt.Config.Name] = sinkOpt
+	}
+	for name, s := range fa.sinks {
+		if sinkOpt, ok := desired[name]; ok && sinkOpt.Config.Type == s.opt.Config.Type {
+			continue
+		}
+		klog.InfoS("Disabling sink", "sink", name, "type", s.opt.Config.Type)
+		s.exporter.Stop()
+		delete(fa.sinks, name)
+	}
+	for _, sinkOpt := range sinkOpts {
+		name := sinkOpt.Config.Name
+		if s, ok := fa.sinks[name]; ok {
+			s.exporter.UpdateOptions(sinkOpt.ExporterOptions)
+			s.opt = sinkOpt
+			s.filters = exporter.NewFlowFilters(sinkOpt.Config.Filters)
+			continue
+		}
+		klog.InfoS("Enabling sink", "sink", name, "type", sinkOpt.Config.Type)
+		s, err := fa.newSink(sinkOpt)
+		if err != nil {
+			klog.ErrorS(err, "Error when enabling sink", "sink", name)
+			continue
+		}
+		s.exporter.Start()
+		fa.sinks[name] = s
+	}
+}
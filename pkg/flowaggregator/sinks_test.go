@@ -0,0 +1,96 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	flowaggregatorconfig "antrea.io/antrea/pkg/config/flowaggregator"
+	"antrea.io/antrea/pkg/flowaggregator/options"
+)
+
+func newTestSinkOptions(name string, sinkType flowaggregatorconfig.SinkType) *options.SinkOptions {
+	return &options.SinkOptions{
+		Config: &flowaggregatorconfig.SinkConfig{
+			Name: name,
+			Type: sinkType,
+		},
+		ExporterOptions: &options.Options{
+			Config: &flowaggregatorconfig.FlowAggregatorConfig{},
+		},
+	}
+}
+
+func TestFlowAggregator_updateSinks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockIPFIXExporter, mockClickHouseExporter, _, mockLogExporter := mockExporters(t, ctrl, nil)
+
+	fa := &flowAggregator{}
+
+	collector1 := newTestSinkOptions("collector1", flowaggregatorconfig.SinkTypeIPFIX)
+	log1 := newTestSinkOptions("log1", flowaggregatorconfig.SinkTypeLog)
+	mockIPFIXExporter.EXPECT().Start()
+	mockLogExporter.EXPECT().Start()
+	fa.updateSinks([]*options.SinkOptions{collector1, log1})
+	require.Len(t, fa.sinks, 2)
+
+	mockIPFIXExporter.EXPECT().Flush()
+	require.NoError(t, fa.flushSinks())
+
+	// Update collector1 and change the type of log1, which requires re-creating the exporter.
+	collector1 = newTestSinkOptions("collector1", flowaggregatorconfig.SinkTypeIPFIX)
+	log1 = newTestSinkOptions("log1", flowaggregatorconfig.SinkTypeClickHouse)
+	mockIPFIXExporter.EXPECT().UpdateOptions(collector1.ExporterOptions)
+	mockLogExporter.EXPECT().Stop()
+	mockClickHouseExporter.EXPECT().Start()
+	fa.updateSinks([]*options.SinkOptions{collector1, log1})
+	require.Len(t, fa.sinks, 2)
+	assert.Same(t, collector1, fa.sinks["collector1"].opt)
+	assert.Equal(t, flowaggregatorconfig.SinkTypeClickHouse, fa.sinks["log1"].opt.Config.Type)
+
+	mockIPFIXExporter.EXPECT().Stop()
+	mockClickHouseExporter.EXPECT().Stop()
+	fa.updateSinks(nil)
+	assert.Empty(t, fa.sinks)
+}
+
+func TestFlowAggregator_initSinks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockIPFIXExporter, _, mockS3Exporter, _ := mockExporters(t, ctrl, nil)
+
+	fa := &flowAggregator{}
+	require.NoError(t, fa.initSinks([]*options.SinkOptions{
+		newTestSinkOptions("collector1", flowaggregatorconfig.SinkTypeIPFIX),
+		newTestSinkOptions("s3", flowaggregatorconfig.SinkTypeS3),
+	}))
+	require.Len(t, fa.sinks, 2)
+	assert.Equal(t, mockIPFIXExporter, fa.sinks["collector1"].exporter)
+	assert.Equal(t, mockS3Exporter, fa.sinks["s3"].exporter)
+
+	mockIPFIXExporter.EXPECT().Start()
+	mockS3Exporter.EXPECT().Start()
+	fa.startSinks()
+	mockIPFIXExporter.EXPECT().Stop()
+	mockS3Exporter.EXPECT().Stop()
+	fa.stopSinks()
+
+	assert.ErrorContains(t, fa.initSinks([]*options.SinkOptions{
+		newTestSinkOptions("kafka", flowaggregatorconfig.SinkType("Kafka")),
+	}), "unsupported sink type Kafka")
+}
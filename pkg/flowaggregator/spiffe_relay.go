@@ -0,0 +1,120 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// spiffeRelayCollectorAddress is the address of the collecting process when the connections from the flow exporters
+	// are terminated by spiffeRelay.
+	spiffeRelayCollectorAddress = "127.0.0.1:0"
+	spiffeRelayHandshakeTimeout = 10 * time.Second
+)
+
+// spiffeRelay terminates the TLS connections from the flow exporters when their SPIFFE IDs must be authorized, as the
+// collecting process can only verify that the client certificates are signed by the trust bundle. A connection is
+// accepted only if the flow exporter presents an SVID with an authorized SPIFFE ID, which is verified during the TLS
+// handshake, and its content is then forwarded to the collecting process listening on the loopback interface.
+type spiffeRelay struct {
+	address   string
+	tlsConfig *tls.Config
+	// getCollectorAddress returns the address of the collecting process, which is nil until it is listening.
+	getCollectorAddress func() net.Addr
+}
+
+func newSPIFFERelay(address string, tlsConfig *tls.Config, getCollectorAddress func() net.Addr) *spiffeRelay {
+	return &spiffeRelay{
+		address:             address,
+		tlsConfig:           tlsConfig,
+		getCollectorAddress: getCollectorAddress,
+	}
+}
+
+func (r *spiffeRelay) Run(stopCh <-chan struct{}) {
+	listener, err := tls.Listen("tcp", r.address, r.tlsConfig)
+	if err != nil {
+		klog.ErrorS(err, "Failed to start SPIFFE relay for the collecting process", "address", r.address)
+		return
+	}
+	klog.InfoS("Started SPIFFE relay for the collecting process", "address", listener.Addr())
+	var wg sync.WaitGroup
+	go func() {
+		<-stopCh
+		listener.Close()
+	}()
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			select {
+			case <-stopCh:
+			default:
+				klog.ErrorS(err, "Failed to accept connection, stopping SPIFFE relay")
+			}
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.relay(conn.(*tls.Conn), stopCh)
+		}()
+	}
+	wg.Wait()
+}
+
+func (r *spiffeRelay) relay(conn *tls.Conn, stopCh <-chan struct{}) {
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), spiffeRelayHandshakeTimeout)
+	defer cancel()
+	// The SPIFFE ID of the flow exporter is verified during the handshake, before any record is forwarded.
+	if err := conn.HandshakeContext(ctx); err != nil {
+		klog.ErrorS(err, "Rejected connection from flow exporter", "address", conn.RemoteAddr())
+		return
+	}
+	collectorAddress := r.getCollectorAddress()
+	if collectorAddress == nil {
+		klog.InfoS("Collecting process is not ready, closing connection from flow exporter", "address", conn.RemoteAddr())
+		return
+	}
+	collectorConn, err := net.Dial(collectorAddress.Network(), collectorAddress.String())
+	if err != nil {
+		klog.ErrorS(err, "Failed to connect to the collecting process")
+		return
+	}
+	defer collectorConn.Close()
+	doneCh := make(chan struct{}, 2)
+	go func() {
+		io.Copy(collectorConn, conn)
+		doneCh <- struct{}{}
+	}()
+	go func() {
+		io.Copy(conn, collectorConn)
+		doneCh <- struct{}{}
+	}()
+	// Both connections are closed when either side closes its connection, or when the relay is stopped, which
+	// terminates the other copy.
+	select {
+	case <-doneCh:
+	case <-stopCh:
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flowaggregator
+
+import (
+	"crypto/tls"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/util/spiffe"
+	spiffetesting "antrea.io/antrea/pkg/util/spiffe/testing"
+)
+
+func TestSPIFFERelay(t *testing.T) {
+	ca, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	serverCertPEM, serverKeyPEM, err := ca.NewSVID("spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator")
+	require.NoError(t, err)
+	source := &spiffe.X509Source{CertPEM: serverCertPEM, KeyPEM: serverKeyPEM, BundlePEM: ca.BundlePEM}
+	tlsConfig, err := source.ServerTLSConfig([]string{"spiffe://example.org/ns/kube-system/sa/antrea-agent"})
+	require.NoError(t, err)
+
+	// The collecting process is replaced with a listener echoing the data it receives.
+	collectorListener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer collectorListener.Close()
+	go func() {
+		for {
+			conn, err := collectorListener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	// Reserve a port for the relay.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	relayAddress := l.Addr().String()
+	l.Close()
+	relay := newSPIFFERelay(relayAddress, tlsConfig, collectorListener.Addr)
+	stopCh := make(chan struct{})
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		relay.Run(stopCh)
+	}()
+
+	// Wait for the relay to listen.
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", relayAddress)
+		if err != nil {
+			return false
+		}
+		conn.Close()
+		return true
+	}, 5*time.Second, 50*time.Millisecond)
+	dial := func(id string) (*tls.Conn, error) {
+		certPEM, keyPEM, err := ca.NewSVID(id)
+		require.NoError(t, err)
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		require.NoError(t, err)
+		return tls.Dial("tcp", relayAddress, &tls.Config{
+			Certificates:       []tls.Certificate{cert},
+			InsecureSkipVerify: true, // #nosec G402: only the client certificate is tested.
+		})
+	}
+
+	conn, err := dial("spiffe://example.org/ns/kube-system/sa/antrea-agent")
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("ipfix"))
+	require.NoError(t, err)
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ipfix", string(buf))
+	conn.Close()
+
+	// The connection from an unauthorized flow exporter is closed before any data is forwarded.
+	conn, err = dial("spiffe://example.org/ns/default/sa/default")
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("ipfix"))
+	if err == nil {
+		_, err = conn.Read(buf)
+	}
+	assert.ErrorContains(t, err, "bad certificate")
+
+	close(stopCh)
+	select {
+	case <-doneCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("SPIFFE relay should stop when stopCh is closed")
+	}
+}
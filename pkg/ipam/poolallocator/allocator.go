@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"sort"
 
 	"antrea.io/antrea/pkg/apis/crd/v1beta1"
 	crdclientset "antrea.io/antrea/pkg/client/clientset/versioned"
@@ -26,7 +27,9 @@ import (
 	"antrea.io/antrea/pkg/ipam/ipallocator"
 	iputil "antrea.io/antrea/pkg/util/ip"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	utilnet "k8s.io/utils/net"
@@ -311,6 +314,9 @@ func (a *IPPoolAllocator) AllocateIP(ip net.IP, state v1beta1.IPAddressPhase, ow
 			// Failed to find matching range
 			return fmt.Errorf("IP %v does not belong to IP pool %s", ip, a.ipPoolName)
 		}
+		if err := checkNamespaceQuota(ipPool, ownerNamespace(owner), 1); err != nil {
+			return err
+		}
 
 		subnetInfo = &ipPool.Spec.SubnetInfo
 		err = a.appendPoolUsage(ipPool, ip, state, owner)
@@ -350,6 +356,9 @@ func (a *IPPoolAllocator) AllocateNext(state v1beta1.IPAddressPhase, owner v1bet
 		if err != nil {
 			return err
 		}
+		if err := checkNamespaceQuota(ipPool, ownerNamespace(owner), 1); err != nil {
+			return err
+		}
 
 		index := len(allocators)
 		for i, allocator := range allocators {
@@ -450,6 +459,9 @@ func (a *IPPoolAllocator) AllocateStatefulSet(namespace, name string, size int,
 				return fmt.Errorf("StatefulSet %s/%s is already present in IPPool %s", namespace, name, ipPool.Name)
 			}
 		}
+		if err := checkNamespaceQuota(ipPool, namespace, size); err != nil {
+			return err
+		}
 
 		var ips []net.IP
 		if size == 1 && ip != nil {
@@ -635,4 +647,80 @@ func (a IPPoolAllocator) Total() int {
 func (a *IPPoolAllocator) updateUsage(ipPool *v1beta1.IPPool) {
 	ipPool.Status.Usage.Total = a.Total()
 	ipPool.Status.Usage.Used = len(ipPool.Status.IPAddresses)
+	ipPool.Status.NamespaceUsages = GetNamespaceUsages(ipPool)
+}
+
+// CheckNamespace returns an error if the provided Namespace is not selected by the NamespaceSelector of the IPPool,
+// i.e. the IPPool cannot be used by the Pods and StatefulSets of the Namespace.
+func (a *IPPoolAllocator) CheckNamespace(namespace *corev1.Namespace) error {
+	ipPool, err := a.getPool()
+	if err != nil {
+		return err
+	}
+	if ipPool.Spec.NamespaceSelector == nil {
+		return nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ipPool.Spec.NamespaceSelector)
+	if err != nil {
+		return fmt.Errorf("invalid namespaceSelector of IPPool %s: %v", a.ipPoolName, err)
+	}
+	if !selector.Matches(labels.Set(namespace.Labels)) {
+		return fmt.Errorf("IPPool %s cannot be used by Namespace %s", a.ipPoolName, namespace.Name)
+	}
+	return nil
+}
+
+// ownerNamespace returns the Namespace of the Pod or StatefulSet owning an IP.
+func ownerNamespace(owner v1beta1.IPAddressOwner) string {
+	if owner.Pod != nil {
+		return owner.Pod.Namespace
+	}
+	if owner.StatefulSet != nil {
+		return owner.StatefulSet.Namespace
+	}
+	return ""
+}
+
+// checkNamespaceQuota returns an error if allocating or reserving the provided number of IPs for the provided
+// Namespace would exceed the MaxIPsPerNamespace of the IPPool.
+func checkNamespaceQuota(ipPool *v1beta1.IPPool, namespace string, count int) error {
+	maxIPs := int(ipPool.Spec.MaxIPsPerNamespace)
+	if maxIPs <= 0 || namespace == "" {
+		return nil
+	}
+	used := 0
+	for _, ip := range ipPool.Status.IPAddresses {
+		if ownerNamespace(ip.Owner) == namespace {
+			used++
+		}
+	}
+	if used+count > maxIPs {
+		return fmt.Errorf("failed to allocate %d IP(s): Namespace %s has %d IP(s) allocated from IPPool %s, which allows at most %d per Namespace", count, namespace, used, ipPool.Name, maxIPs)
+	}
+	return nil
+}
+
+// GetNamespaceUsages returns the number of IPs allocated or reserved for each Namespace from the IPPool, sorted by
+// Namespace. It returns nil if MaxIPsPerNamespace is not set for the IPPool.
+func GetNamespaceUsages(ipPool *v1beta1.IPPool) []v1beta1.IPPoolNamespaceUsage {
+	if ipPool.Spec.MaxIPsPerNamespace <= 0 {
+		return nil
+	}
+	usedByNamespace := map[string]int{}
+	for _, ip := range ipPool.Status.IPAddresses {
+		if namespace := ownerNamespace(ip.Owner); namespace != "" {
+			usedByNamespace[namespace]++
+		}
+	}
+	if len(usedByNamespace) == 0 {
+		return nil
+	}
+	usages := make([]v1beta1.IPPoolNamespaceUsage, 0, len(usedByNamespace))
+	for namespace, used := range usedByNamespace {
+		usages = append(usages, v1beta1.IPPoolNamespaceUsage{Namespace: namespace, Used: used})
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].Namespace < usages[j].Namespace
+	})
+	return usages
 }
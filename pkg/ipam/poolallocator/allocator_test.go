@@ -29,6 +29,7 @@ import (
 	informers "antrea.io/antrea/pkg/client/informers/externalversions"
 	fakepoolclient "antrea.io/antrea/pkg/ipam/poolallocator/testing"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/cache"
@@ -430,3 +431,89 @@ func TestAllocateReleaseStatefulSet(t *testing.T) {
 	err = allocator.AllocateStatefulSet(testNamespace, setName, 1, net.ParseIP("10.2.3.103"))
 	require.Error(t, err)
 }
+
+func TestAllocateWithNamespaceQuota(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	poolName := uuid.New().String()
+	otherNamespace := "other"
+	ipRange := crdv1b1.IPRange{
+		Start: "10.2.2.100",
+		End:   "10.2.2.120",
+	}
+	subnetInfo := crdv1b1.SubnetInfo{
+		Gateway:      "10.2.2.1",
+		PrefixLength: 24,
+	}
+
+	pool := crdv1b1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: poolName},
+		Spec: crdv1b1.IPPoolSpec{
+			IPRanges:           []crdv1b1.IPRange{ipRange},
+			SubnetInfo:         subnetInfo,
+			MaxIPsPerNamespace: 3,
+		},
+	}
+
+	allocator := newTestIPPoolAllocator(&pool, stopCh)
+	require.NotNil(t, allocator)
+
+	// Reserved IPs are accounted in the quota of the Namespace.
+	err := allocator.AllocateStatefulSet(testNamespace, "fakeSet", 2, nil)
+	require.NoError(t, err)
+	validateAllocationSequence(t, allocator, subnetInfo, []string{"10.2.2.102"})
+
+	_, _, err = allocator.AllocateNext(crdv1b1.IPAddressPhaseAllocated, crdv1b1.IPAddressOwner{
+		Pod: &crdv1b1.PodOwner{Name: "fakePod4", Namespace: testNamespace, ContainerID: uuid.New().String()},
+	})
+	assert.ErrorContains(t, err, "Namespace test has 3 IP(s) allocated from IPPool")
+	_, err = allocator.AllocateIP(net.ParseIP("10.2.2.110"), crdv1b1.IPAddressPhaseAllocated, crdv1b1.IPAddressOwner{
+		Pod: &crdv1b1.PodOwner{Name: "fakePod5", Namespace: testNamespace, ContainerID: uuid.New().String()},
+	})
+	assert.ErrorContains(t, err, "Namespace test has 3 IP(s) allocated from IPPool")
+
+	// Other Namespaces have their own quota.
+	err = allocator.AllocateStatefulSet(otherNamespace, "fakeSet", 4, nil)
+	assert.ErrorContains(t, err, "failed to allocate 4 IP(s): Namespace other has 0 IP(s) allocated from IPPool")
+	ip, _, err := allocator.AllocateNext(crdv1b1.IPAddressPhaseAllocated, crdv1b1.IPAddressOwner{
+		Pod: &crdv1b1.PodOwner{Name: "fakePod", Namespace: otherNamespace, ContainerID: uuid.New().String()},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, net.ParseIP("10.2.2.103"), ip)
+
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		ipPool, err := allocator.getPool()
+		require.NoError(c, err)
+		assert.Equal(c, []crdv1b1.IPPoolNamespaceUsage{
+			{Namespace: otherNamespace, Used: 1},
+			{Namespace: testNamespace, Used: 3},
+		}, ipPool.Status.NamespaceUsages)
+	}, time.Second, 50*time.Millisecond)
+}
+
+func TestCheckNamespace(t *testing.T) {
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	pool := crdv1b1.IPPool{
+		ObjectMeta: metav1.ObjectMeta{Name: uuid.New().String()},
+		Spec: crdv1b1.IPPoolSpec{
+			IPRanges:   []crdv1b1.IPRange{{CIDR: "10.2.2.0/24"}},
+			SubnetInfo: crdv1b1.SubnetInfo{Gateway: "10.2.2.1", PrefixLength: 24},
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"team": "blue"},
+			},
+		},
+	}
+
+	allocator := newTestIPPoolAllocator(&pool, stopCh)
+	require.NotNil(t, allocator)
+
+	assert.NoError(t, allocator.CheckNamespace(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "blue", Labels: map[string]string{"team": "blue"}},
+	}))
+	assert.EqualError(t, allocator.CheckNamespace(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "red", Labels: map[string]string{"team": "red"}},
+	}), fmt.Sprintf("IPPool %s cannot be used by Namespace red", pool.Name))
+}
@@ -152,7 +152,7 @@ func newAgentMonitor(crdClient *fakeclientset.Clientset, t *testing.T) *agentMon
 	networkPolicyInfoQuerier.EXPECT().GetAddressGroupNum().Return(30).AnyTimes()
 	networkPolicyInfoQuerier.EXPECT().GetControllerConnectionStatus().Return(true).AnyTimes()
 
-	querier := querier.NewAgentQuerier(nodeConfig, nil, interfaceStore, client, ofClient, ovsBridgeClient, nil, networkPolicyInfoQuerier, 10349, "", nil, nil, nil)
+	querier := querier.NewAgentQuerier(nodeConfig, nil, interfaceStore, client, ofClient, nil, ovsBridgeClient, nil, networkPolicyInfoQuerier, 10349, "", nil, nil, nil, nil)
 
 	return NewAgentMonitor(crdClient, querier, fakeCertData)
 }
@@ -72,6 +72,7 @@ func newControllerMonitor(crdClient *fakeclientset.Clientset) *fakeController {
 	tierInformer := crdInformerFactory.Crd().V1beta1().Tiers()
 	cgInformer := crdInformerFactory.Crd().V1beta1().ClusterGroups()
 	grpInformer := crdInformerFactory.Crd().V1beta1().Groups()
+	nspInformer := crdInformerFactory.Crd().V1alpha1().NamespaceSecurityPolicies()
 	externalNodeInformer := crdInformerFactory.Crd().V1alpha1().ExternalNodes()
 
 	addressGroupStore := store.NewAddressGroupStore()
@@ -96,6 +97,7 @@ func newControllerMonitor(crdClient *fakeclientset.Clientset) *fakeController {
 		tierInformer,
 		cgInformer,
 		grpInformer,
+		nspInformer,
 		addressGroupStore,
 		appliedToGroupStore,
 		networkPolicyStore,
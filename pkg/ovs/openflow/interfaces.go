@@ -120,6 +120,9 @@ type Bridge interface {
 	// DumpFlows queries the Openflow entries from OFSwitch. The filter of the query is Openflow cookieID; the result is
 	// a map from flow cookieID to FlowStates.
 	DumpFlows(cookieID, cookieMask uint64) (map[uint64]*FlowStates, error)
+	// DumpFlowMods queries the Openflow entries from OFSwitch using cookieID as filter, and returns them as FlowMod
+	// messages, so that they can be compared with the FlowMod messages generated by the agent.
+	DumpFlowMods(cookieID, cookieMask uint64) ([]*openflow15.FlowMod, error)
 	// DeleteFlowsByCookie removes Openflow entries from OFSwitch. The removed Openflow entries use the specific CookieID.
 	DeleteFlowsByCookie(cookieID, cookieMask uint64) error
 	// AddFlowsInBundle syncs multiple Openflow entries in a single transaction. This operation could add new flows in
@@ -465,6 +468,11 @@ type Packet struct {
 	ICMPCode        uint8
 	ICMPEchoID      uint16
 	ICMPEchoSeq     uint16
+	// ICMPData is the ICMP message body following the checksum field. If it is empty, the body is
+	// built from ICMPEchoID and ICMPEchoSeq.
+	ICMPData []byte
+	// UDPData is the UDP payload.
+	UDPData []byte
 }
 
 // RegField specifies a bit range of a register. regID is the register number, and rng is the range of bits
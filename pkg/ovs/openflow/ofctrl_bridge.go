@@ -441,6 +441,26 @@ func (b *OFBridge) DumpFlows(cookieID, cookieMask uint64) (map[uint64]*FlowState
 	return parseFlowStats(ofStats), nil
 }
 
+// DumpFlowMods queries the Openflow entries from OFSwitch using cookieID as filter, and returns them as FlowMod
+// messages. Only the fields used to identify a flow and its actions are set in the returned messages.
+func (b *OFBridge) DumpFlowMods(cookieID, cookieMask uint64) ([]*openflow15.FlowMod, error) {
+	ofStats, err := b.ofSwitch.DumpFlowStats(cookieID, &cookieMask, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	flowMods := make([]*openflow15.FlowMod, 0, len(ofStats))
+	for _, stat := range ofStats {
+		flowMod := openflow15.NewFlowMod()
+		flowMod.TableId = stat.TableId
+		flowMod.Priority = stat.Priority
+		flowMod.Cookie = stat.Cookie
+		flowMod.Match = stat.Match
+		flowMod.Instructions = stat.Instructions
+		flowMods = append(flowMods, flowMod)
+	}
+	return flowMods, nil
+}
+
 // DeleteFlowsByCookie removes Openflow entries from OFSwitch. The removed Openflow entries use the specific CookieID.
 func (b *OFBridge) DeleteFlowsByCookie(cookieID, cookieMask uint64) error {
 	flowMod := openflow15.NewFlowMod()
@@ -172,6 +172,21 @@ func (mr *MockBridgeMockRecorder) Disconnect() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Disconnect", reflect.TypeOf((*MockBridge)(nil).Disconnect))
 }
 
+// DumpFlowMods mocks base method.
+func (m *MockBridge) DumpFlowMods(cookieID, cookieMask uint64) ([]*openflow15.FlowMod, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DumpFlowMods", cookieID, cookieMask)
+	ret0, _ := ret[0].([]*openflow15.FlowMod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DumpFlowMods indicates an expected call of DumpFlowMods.
+func (mr *MockBridgeMockRecorder) DumpFlowMods(cookieID, cookieMask any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DumpFlowMods", reflect.TypeOf((*MockBridge)(nil).DumpFlowMods), cookieID, cookieMask)
+}
+
 // DumpFlows mocks base method.
 func (m *MockBridge) DumpFlows(cookieID, cookieMask uint64) (map[uint64]*openflow.FlowStates, error) {
 	m.ctrl.T.Helper()
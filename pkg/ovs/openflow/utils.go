@@ -1223,6 +1223,11 @@ func FlowModToString(flowMod *openflow15.FlowMod) string {
 	return fmt.Sprintf("%s, %s %s", getFlowModBaseString(flowMod), getFlowModMatch(flowMod), getFlowModAction(flowMod))
 }
 
+// FlowModActionString returns the string representation of the actions of the flowMod.
+func FlowModActionString(flowMod *openflow15.FlowMod) string {
+	return getFlowModAction(flowMod)
+}
+
 func FlowModMatchString(flowMod *openflow15.FlowMod, omitFields ...string) string {
 	flowModMatch := getFlowModMatch(flowMod)
 	if len(omitFields) == 0 {
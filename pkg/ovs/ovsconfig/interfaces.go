@@ -84,4 +84,5 @@ type OVSBridgeClient interface {
 	SetPortExternalIDs(portName string, externalIDs map[string]interface{}) Error
 	GetPortExternalIDs(portName string) (map[string]string, Error)
 	SetInterfaceMAC(name string, mac net.HardwareAddr) Error
+	SetInterfaceIngressPolicing(name string, rate, burst int) Error
 }
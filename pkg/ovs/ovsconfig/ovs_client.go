@@ -30,6 +30,7 @@ const defaultOVSDBFile = "db.sock"
 
 type OVSBridge struct {
 	ovsdb                    *ovsdb.OVSDB
+	session                  *ovsdbSession
 	name                     string
 	datapathType             OVSDatapathType
 	uuid                     string
@@ -108,6 +109,7 @@ func WithRequiredPortExternalIDs(keys ...string) OVSBridgeOption {
 func NewOVSBridge(bridgeName string, ovsDatapathType OVSDatapathType, ovsdb *ovsdb.OVSDB, options ...OVSBridgeOption) OVSBridgeClient {
 	br := &OVSBridge{
 		ovsdb:        ovsdb,
+		session:      getOVSDBSession(ovsdb),
 		name:         bridgeName,
 		datapathType: ovsDatapathType,
 	}
@@ -150,7 +152,7 @@ func (br *OVSBridge) lookupByName() (bool, Error) {
 		Columns: []string{"_uuid"},
 		Where:   [][]interface{}{{"name", "==", br.name}},
 	})
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return false, NewTransactionError(err, temporary)
@@ -176,7 +178,7 @@ func (br *OVSBridge) updateBridgeConfiguration() Error {
 			"datapath_type": br.datapathType,
 		},
 	})
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -206,7 +208,7 @@ func (br *OVSBridge) create() Error {
 		Mutations: [][]interface{}{{"bridges", "insert", mutateSet}},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -226,7 +228,7 @@ func (br *OVSBridge) Delete() Error {
 		Mutations: [][]interface{}{{"bridges", "delete", mutateSet}},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -243,7 +245,7 @@ func (br *OVSBridge) GetExternalIDs() (map[string]string, Error) {
 		Where:   [][]interface{}{{"name", "==", br.name}},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return nil, NewTransactionError(err, temporary)
@@ -264,7 +266,7 @@ func (br *OVSBridge) SetExternalIDs(externalIDs map[string]interface{}) Error {
 		},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -287,7 +289,7 @@ func (br *OVSBridge) SetDatapathID(datapathID string) Error {
 			"other_config": helpers.MakeOVSDBMap(otherConfig),
 		},
 	})
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed", err)
 		return NewTransactionError(err, temporary)
@@ -303,7 +305,7 @@ func (br *OVSBridge) GetDatapathID() (string, Error) {
 		Where:   [][]interface{}{{"name", "==", br.name}},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return "", NewTransactionError(err, temporary)
@@ -337,7 +339,7 @@ func (br *OVSBridge) WaitForDatapathID(timeout time.Duration) (string, Error) {
 		Where:   [][]interface{}{{"name", "==", br.name}},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return "", NewTransactionError(err, temporary)
@@ -365,7 +367,7 @@ func (br *OVSBridge) GetPortUUIDList() ([]string, Error) {
 		Where:   [][]interface{}{{"name", "==", br.name}},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return nil, NewTransactionError(err, temporary)
@@ -386,7 +388,7 @@ func (br *OVSBridge) DeletePorts(portUUIDList []string) Error {
 		Mutations: [][]interface{}{{"ports", "delete", mutateSet}},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -406,7 +408,7 @@ func (br *OVSBridge) DeletePort(portUUID string) Error {
 		Mutations: [][]interface{}{{"ports", "delete", mutateSet}},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -523,7 +525,7 @@ func (br *OVSBridge) GetInterfaceOptions(name string) (map[string]string, Error)
 		Columns: []string{"options"},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return nil, NewTransactionError(err, temporary)
@@ -545,7 +547,7 @@ func (br *OVSBridge) SetInterfaceOptions(name string, options map[string]interfa
 		},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -664,7 +666,7 @@ func (br *OVSBridge) createPort(name, ifName, ifType string, ofPortRequest int32
 		Where:     [][]interface{}{{"name", "==", br.name}},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return "", NewTransactionError(err, temporary)
@@ -709,7 +711,7 @@ func (br *OVSBridge) GetOFPort(ifName string, waitUntilValid bool) (int32, Error
 		Where:   [][]interface{}{{"name", "==", ifName}},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return 0, NewTransactionError(err, temporary)
@@ -787,7 +789,7 @@ func (br *OVSBridge) GetPortData(portUUID, ifName string) (*OVSPortData, Error)
 		Where:   [][]interface{}{{"name", "==", ifName}},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return nil, NewTransactionError(err, temporary)
@@ -839,7 +841,7 @@ func (br *OVSBridge) GetPortList() ([]OVSPortData, Error) {
 		Columns: []string{"_uuid", "type", "name", "ofport", "options", "mac"},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return nil, NewTransactionError(err, temporary)
@@ -886,7 +888,7 @@ func (br *OVSBridge) GetOVSVersion() (string, Error) {
 		Columns: []string{"ovs_version"},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
@@ -929,7 +931,7 @@ func (br *OVSBridge) AddOVSOtherConfig(configs map[string]interface{}) Error {
 		Mutations: [][]interface{}{{"other_config", "insert", mutateSet}},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -945,7 +947,7 @@ func (br *OVSBridge) GetOVSOtherConfig() (map[string]string, Error) {
 		Columns: []string{"other_config"},
 	})
 
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return nil, NewTransactionError(err, temporary)
@@ -977,7 +979,7 @@ func (br *OVSBridge) UpdateOVSOtherConfig(configs map[string]interface{}) Error
 			{"other_config", "delete", deleteSet},
 			{"other_config", "insert", insertSet},
 		}})
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -1011,7 +1013,7 @@ func (br *OVSBridge) DeleteOVSOtherConfig(configs map[string]interface{}) Error
 		},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -1033,7 +1035,7 @@ func (br *OVSBridge) AddBridgeOtherConfig(configs map[string]interface{}) Error
 		Where:     [][]interface{}{{"name", "==", br.name}},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -1099,7 +1101,7 @@ func (br *OVSBridge) SetPortExternalIDs(portName string, externalIDs map[string]
 			"external_ids": helpers.MakeOVSDBMap(externalIDs),
 		},
 	})
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed", err)
 		return NewTransactionError(err, temporary)
@@ -1114,7 +1116,7 @@ func (br *OVSBridge) GetPortExternalIDs(portName string) (map[string]string, Err
 		Columns: []string{"external_ids"},
 		Where:   [][]interface{}{{"name", "==", portName}},
 	})
-	res, err, temporary := tx.Commit()
+	res, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed", err)
 		return nil, NewTransactionError(err, temporary)
@@ -1134,7 +1136,7 @@ func (br *OVSBridge) SetInterfaceMTU(name string, MTU int) error {
 		},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -1154,7 +1156,7 @@ func (br *OVSBridge) SetInterfaceMAC(name string, mac net.HardwareAddr) Error {
 		},
 	})
 
-	_, err, temporary := tx.Commit()
+	_, err, temporary := br.session.commit(tx)
 	if err != nil {
 		klog.Error("Transaction failed: ", err)
 		return NewTransactionError(err, temporary)
@@ -1163,3 +1165,26 @@ func (br *OVSBridge) SetInterfaceMAC(name string, mac net.HardwareAddr) Error {
 	return nil
 
 }
+
+// SetInterfaceIngressPolicing sets the maximum rate in kbps and the burst size in kb of the traffic received by OVS
+// from the interface. Traffic exceeding the rate is dropped by OVS. Setting rate to 0 disables the policing.
+func (br *OVSBridge) SetInterfaceIngressPolicing(name string, rate, burst int) Error {
+	tx := br.ovsdb.Transaction(openvSwitchSchema)
+
+	tx.Update(dbtransaction.Update{
+		Table: "Interface",
+		Where: [][]interface{}{{"name", "==", name}},
+		Row: map[string]interface{}{
+			"ingress_policing_rate":  rate,
+			"ingress_policing_burst": burst,
+		},
+	})
+
+	_, err, temporary := br.session.commit(tx)
+	if err != nil {
+		klog.Error("Transaction failed: ", err)
+		return NewTransactionError(err, temporary)
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovsconfig
+
+import (
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/TomCodeLV/OVSDB-golang-lib/pkg/dbtransaction"
+	"github.com/TomCodeLV/OVSDB-golang-lib/pkg/ovsdb"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+
+	"antrea.io/antrea/pkg/agent/metrics"
+)
+
+const (
+	// Maximum number of times a transaction is replayed after a connection failure.
+	maxTransactionReplays = 3
+	// How long a transaction is queued while reconnecting before it is rejected.
+	transactionQueueTimeout = 30 * time.Second
+	// Number of consecutive connection failures after which the circuit breaker is opened.
+	circuitBreakerFailureThreshold = 5
+	// How long transactions are rejected immediately once the circuit breaker is opened.
+	circuitBreakerOpenDuration = 10 * time.Second
+	// Interval between two attempts to reach the OVSDB server while reconnecting.
+	probeInterval = 1 * time.Second
+
+	// The errors returned by the OVSDB library when a call is made while the connection is down, and when the
+	// connection is closed while a call is in flight.
+	errMsgNoConnection     = "no connection"
+	errMsgConnectionClosed = "connection closed"
+)
+
+var (
+	errCircuitOpen      = errors.New("circuit breaker is open after repeated OVSDB connection failures")
+	errReconnectTimeout = errors.New("timed out waiting for the connection to the OVSDB server to be re-established")
+)
+
+type connectionState int
+
+const (
+	// Transactions are sent to the OVSDB server directly.
+	stateConnected connectionState = iota
+	// The connection has been lost. Transactions are queued until the connection is re-established, and then sent.
+	stateReconnecting
+	// The connection has failed repeatedly. Transactions are rejected immediately until circuitBreakerOpenDuration
+	// has elapsed, or until the connection is re-established.
+	stateCircuitOpen
+)
+
+func (s connectionState) String() string {
+	switch s {
+	case stateConnected:
+		return "Connected"
+	case stateReconnecting:
+		return "Reconnecting"
+	case stateCircuitOpen:
+		return "CircuitOpen"
+	}
+	return "Unknown"
+}
+
+// ovsdbSession tracks the state of the connection to the OVSDB server, as observed by the transactions committed
+// through it. The OVSDB library reconnects automatically, but transactions committed while the connection is down
+// fail, which used to leave the callers in their own retry loops. The session queues these transactions until the
+// connection is back and replays them when it is safe to do so.
+type ovsdbSession struct {
+	clock clock.Clock
+	// probe returns nil if the OVSDB server can be reached.
+	probe func() error
+
+	mutex sync.Mutex
+	state connectionState
+	// reconnected is closed when the connection is re-established. It is nil in the Connected state.
+	reconnected         chan struct{}
+	consecutiveFailures int
+	circuitOpenUntil    time.Time
+	probing             bool
+}
+
+var (
+	sessionsMutex sync.Mutex
+	// sessions are indexed by OVSDB connection, as a connection can be shared by several OVSBridge clients.
+	sessions = map[*ovsdb.OVSDB]*ovsdbSession{}
+)
+
+func getOVSDBSession(db *ovsdb.OVSDB) *ovsdbSession {
+	sessionsMutex.Lock()
+	defer sessionsMutex.Unlock()
+	if session, ok := sessions[db]; ok {
+		return session
+	}
+	session := newOVSDBSession(clock.RealClock{}, func() error {
+		_, err := db.Call("echo", []interface{}{"antrea"}, nil)
+		return err
+	})
+	sessions[db] = session
+	return session
+}
+
+func newOVSDBSession(clock clock.Clock, probe func() error) *ovsdbSession {
+	return &ovsdbSession{
+		clock: clock,
+		probe: probe,
+		state: stateConnected,
+	}
+}
+
+// commit commits the transaction and has the same return values as dbtransaction.Transaction.Commit. If the
+// transaction fails because of a connection error, it is replayed once the connection is re-established, as long as
+// it has not been sent to the OVSDB server or only reads data.
+func (s *ovsdbSession) commit(tx *dbtransaction.Transaction) (dbtransaction.Transact, error, bool) {
+	for attempt := 0; ; attempt++ {
+		if err := s.waitConnected(); err != nil {
+			return nil, err, true
+		}
+		res, err, temporary := tx.Commit()
+		if err == nil || !isConnectionError(err) {
+			// Any response means that the OVSDB server is reachable, even if the transaction failed.
+			s.onConnected()
+			return res, err, temporary
+		}
+		s.onConnectionError(err)
+		if attempt >= maxTransactionReplays || !isReplayable(tx, err) {
+			return nil, err, temporary
+		}
+		klog.InfoS("Replaying OVSDB transaction after connection failure", "attempt", attempt+1, "err", err)
+		metrics.OVSDBTransactionReplayCount.Inc()
+	}
+}
+
+// waitConnected returns immediately in the Connected state, queues the caller until the connection is re-established
+// in the Reconnecting state, and rejects the caller in the CircuitOpen state.
+func (s *ovsdbSession) waitConnected() error {
+	s.mutex.Lock()
+	if s.state == stateCircuitOpen {
+		if s.clock.Now().Before(s.circuitOpenUntil) {
+			s.mutex.Unlock()
+			metrics.OVSDBTransactionRejectedCount.Inc()
+			return errCircuitOpen
+		}
+		// Let the transactions be queued again. As the failure count is not reset, the circuit breaker is opened
+		// again on the next failure.
+		s.setState(stateReconnecting)
+	}
+	if s.state == stateConnected {
+		s.mutex.Unlock()
+		return nil
+	}
+	reconnected := s.reconnected
+	s.mutex.Unlock()
+
+	select {
+	case <-reconnected:
+		return nil
+	case <-s.clock.After(transactionQueueTimeout):
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.recordFailure()
+		metrics.OVSDBTransactionRejectedCount.Inc()
+		return errReconnectTimeout
+	}
+}
+
+func (s *ovsdbSession) onConnected() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.consecutiveFailures = 0
+	if s.state == stateConnected {
+		return
+	}
+	klog.InfoS("Connection to OVSDB server re-established")
+	s.setState(stateConnected)
+	close(s.reconnected)
+	s.reconnected = nil
+}
+
+func (s *ovsdbSession) onConnectionError(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.state == stateConnected {
+		klog.ErrorS(err, "Lost connection to OVSDB server, queuing transactions until it is re-established")
+	}
+	s.recordFailure()
+}
+
+// recordFailure must be called with the mutex held.
+func (s *ovsdbSession) recordFailure() {
+	s.consecutiveFailures++
+	if s.state == stateConnected {
+		s.reconnected = make(chan struct{})
+		s.setState(stateReconnecting)
+	}
+	if s.consecutiveFailures >= circuitBreakerFailureThreshold && s.state != stateCircuitOpen {
+		klog.InfoS("Opening OVSDB circuit breaker after repeated connection failures", "failures", s.consecutiveFailures, "duration", circuitBreakerOpenDuration)
+		s.circuitOpenUntil = s.clock.Now().Add(circuitBreakerOpenDuration)
+		s.setState(stateCircuitOpen)
+		metrics.OVSDBCircuitBreakerOpenCount.Inc()
+	}
+	if !s.probing {
+		s.probing = true
+		go s.runProbe()
+	}
+}
+
+func (s *ovsdbSession) setState(state connectionState) {
+	klog.V(2).InfoS("OVSDB connection state changed", "from", s.state, "to", state)
+	s.state = state
+	metrics.OVSDBConnectionState.Set(float64(state))
+}
+
+// runProbe tries to reach the OVSDB server until it succeeds, and then marks the session as connected.
+func (s *ovsdbSession) runProbe() {
+	defer func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		s.probing = false
+	}()
+	for {
+		if err := s.probe(); err == nil {
+			s.onConnected()
+			return
+		}
+		<-s.clock.After(probeInterval)
+	}
+}
+
+func isConnectionError(err error) bool {
+	if msg := err.Error(); msg == errMsgNoConnection || msg == errMsgConnectionClosed {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed)
+}
+
+// isReplayable returns whether it is safe to commit the transaction again after it failed with the provided connection
+// error. A transaction which failed before being sent can always be replayed. Otherwise, the transaction may have been
+// applied by the OVSDB server before the connection was lost, so it is only replayed if it doesn't modify any data.
+func isReplayable(tx *dbtransaction.Transaction, err error) bool {
+	if err.Error() == errMsgNoConnection {
+		return true
+	}
+	for _, action := range tx.Actions {
+		op, ok := action.(map[string]interface{})
+		if !ok || op["op"] != "select" {
+			return false
+		}
+	}
+	return true
+}
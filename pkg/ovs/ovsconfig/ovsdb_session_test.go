@@ -0,0 +1,181 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ovsconfig
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TomCodeLV/OVSDB-golang-lib/pkg/dbtransaction"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// fakeOVSDB returns the provided errors for the first calls, and succeeds afterwards.
+type fakeOVSDB struct {
+	mutex  sync.Mutex
+	errors []error
+	calls  int
+}
+
+func (db *fakeOVSDB) Call(method string, args interface{}, id *uint64) (json.RawMessage, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	db.calls++
+	if len(db.errors) > 0 {
+		err := db.errors[0]
+		db.errors = db.errors[1:]
+		return nil, err
+	}
+	return json.RawMessage(`[{}]`), nil
+}
+
+func (db *fakeOVSDB) Notify(method string, args interface{}) error {
+	return nil
+}
+
+func (db *fakeOVSDB) getCalls() int {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+	return db.calls
+}
+
+func newSelectTransaction(db *fakeOVSDB) *dbtransaction.Transaction {
+	tx := &dbtransaction.Transaction{OVSDB: db, Schema: openvSwitchSchema}
+	tx.Select(dbtransaction.Select{Table: "Bridge", Columns: []string{"_uuid"}})
+	return tx
+}
+
+func newUpdateTransaction(db *fakeOVSDB) *dbtransaction.Transaction {
+	tx := &dbtransaction.Transaction{OVSDB: db, Schema: openvSwitchSchema}
+	tx.Update(dbtransaction.Update{Table: "Bridge", Row: map[string]interface{}{"datapath_type": "system"}})
+	return tx
+}
+
+func (s *ovsdbSession) getState() connectionState {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.state
+}
+
+func TestSessionCommitReplay(t *testing.T) {
+	tests := []struct {
+		name          string
+		newTx         func(db *fakeOVSDB) *dbtransaction.Transaction
+		err           error
+		expectedErr   string
+		expectedCalls int
+	}{
+		{
+			name:          "not sent",
+			newTx:         newUpdateTransaction,
+			err:           errors.New(errMsgNoConnection),
+			expectedCalls: 2,
+		},
+		{
+			name:          "read-only transaction",
+			newTx:         newSelectTransaction,
+			err:           errors.New(errMsgConnectionClosed),
+			expectedCalls: 2,
+		},
+		{
+			name:          "write transaction possibly applied",
+			newTx:         newUpdateTransaction,
+			err:           errors.New(errMsgConnectionClosed),
+			expectedErr:   errMsgConnectionClosed,
+			expectedCalls: 1,
+		},
+		{
+			name:          "OVSDB error",
+			newTx:         newUpdateTransaction,
+			err:           errors.New("constraint violation"),
+			expectedErr:   "constraint violation",
+			expectedCalls: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &fakeOVSDB{errors: []error{tt.err}}
+			session := newOVSDBSession(clocktesting.NewFakeClock(time.Now()), func() error { return nil })
+			_, err, _ := session.commit(tt.newTx(db))
+			if tt.expectedErr != "" {
+				assert.EqualError(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCalls, db.getCalls())
+			assert.EventuallyWithT(t, func(c *assert.CollectT) {
+				assert.Equal(c, stateConnected, session.getState())
+			}, time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestSessionCircuitBreaker(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	probeCh := make(chan error)
+	session := newOVSDBSession(fakeClock, func() error { return <-probeCh })
+	db := &fakeOVSDB{}
+
+	for i := 0; i < circuitBreakerFailureThreshold; i++ {
+		session.onConnectionError(errors.New(errMsgConnectionClosed))
+	}
+	require.Equal(t, stateCircuitOpen, session.getState())
+
+	// Transactions are rejected without being sent while the circuit breaker is open.
+	_, err, temporary := session.commit(newUpdateTransaction(db))
+	assert.ErrorIs(t, err, errCircuitOpen)
+	assert.True(t, temporary)
+	assert.Equal(t, 0, db.getCalls())
+
+	// The circuit breaker is closed once the OVSDB server can be reached again.
+	probeCh <- nil
+	assert.EventuallyWithT(t, func(c *assert.CollectT) {
+		assert.Equal(c, stateConnected, session.getState())
+	}, time.Second, 10*time.Millisecond)
+	_, err, _ = session.commit(newUpdateTransaction(db))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, db.getCalls())
+}
+
+func TestSessionQueueTimeout(t *testing.T) {
+	fakeClock := clocktesting.NewFakeClock(time.Now())
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	// The probe blocks until the end of the test, like the OVSDB library does while the connection is down.
+	session := newOVSDBSession(fakeClock, func() error {
+		<-stopCh
+		return errors.New("unreachable")
+	})
+	db := &fakeOVSDB{}
+
+	session.onConnectionError(errors.New(errMsgConnectionClosed))
+	require.Equal(t, stateReconnecting, session.getState())
+
+	errCh := make(chan error)
+	go func() {
+		_, err, _ := session.commit(newUpdateTransaction(db))
+		errCh <- err
+	}()
+	// Wait for the transaction to be queued.
+	require.Eventually(t, fakeClock.HasWaiters, time.Second, 10*time.Millisecond)
+	fakeClock.Step(transactionQueueTimeout)
+	assert.ErrorIs(t, <-errCh, errReconnectTimeout)
+	assert.Equal(t, 0, db.getCalls())
+}
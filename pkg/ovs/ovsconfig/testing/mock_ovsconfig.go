@@ -450,6 +450,20 @@ func (mr *MockOVSBridgeClientMockRecorder) SetExternalIDs(externalIDs any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExternalIDs", reflect.TypeOf((*MockOVSBridgeClient)(nil).SetExternalIDs), externalIDs)
 }
 
+// SetInterfaceIngressPolicing mocks base method.
+func (m *MockOVSBridgeClient) SetInterfaceIngressPolicing(name string, rate, burst int) ovsconfig.Error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInterfaceIngressPolicing", name, rate, burst)
+	ret0, _ := ret[0].(ovsconfig.Error)
+	return ret0
+}
+
+// SetInterfaceIngressPolicing indicates an expected call of SetInterfaceIngressPolicing.
+func (mr *MockOVSBridgeClientMockRecorder) SetInterfaceIngressPolicing(name, rate, burst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInterfaceIngressPolicing", reflect.TypeOf((*MockOVSBridgeClient)(nil).SetInterfaceIngressPolicing), name, rate, burst)
+}
+
 // SetInterfaceMAC mocks base method.
 func (m *MockOVSBridgeClient) SetInterfaceMAC(name string, mac net.HardwareAddr) ovsconfig.Error {
 	m.ctrl.T.Helper()
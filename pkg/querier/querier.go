@@ -25,6 +25,7 @@ import (
 	"antrea.io/antrea/pkg/agent/apis"
 	"antrea.io/antrea/pkg/agent/bgp"
 	bgpcontroller "antrea.io/antrea/pkg/agent/controller/bgp"
+	"antrea.io/antrea/pkg/agent/flowexporter"
 	"antrea.io/antrea/pkg/agent/interfacestore"
 	"antrea.io/antrea/pkg/agent/multicast"
 	"antrea.io/antrea/pkg/agent/types"
@@ -61,6 +62,10 @@ type AgentMulticastInfoQuerier interface {
 	GetAllPodsStats() map[*interfacestore.InterfaceConfig]*multicast.PodTrafficStats
 	// GetPodStats gets multicast traffic statistics of a local Pod, specified by podName and podNamespace.
 	GetPodStats(podName string, podNamespace string) *multicast.PodTrafficStats
+	// GetAllGroupsStats gets the members and traffic statistics of all multicast groups.
+	GetAllGroupsStats() []*multicast.GroupTrafficStats
+	// GetGroupStats gets the members and traffic statistics of a multicast group, specified by the group IP.
+	GetGroupStats(group string) *multicast.GroupTrafficStats
 }
 
 type ControllerNetworkPolicyInfoQuerier interface {
@@ -109,6 +114,27 @@ type FQDNCacheFilter struct {
 	DomainRegex *regexp.Regexp
 }
 
+// AgentFlowInfoQuerier queries the connections tracked by the Flow Exporter.
+type AgentFlowInfoQuerier interface {
+	// GetConnections returns a copy of the connections in the connection stores of the Flow Exporter which match
+	// the filter.
+	GetConnections(filter *FlowQueryFilter) []flowexporter.Connection
+}
+
+// FlowQueryFilter is used to filter the connections returned by AgentFlowInfoQuerier.
+// An empty attribute, which won't be used as a condition, means match all.
+type FlowQueryFilter struct {
+	// The Namespace of the source or destination Pod of the connections.
+	Namespace string
+	// The name of the source or destination Pod of the connections. Namespace must be set along with it.
+	Pod string
+	// The name of the ingress or egress NetworkPolicy which the connections matched, optionally prefixed with its
+	// Namespace in the "<namespace>/<name>" format.
+	NetworkPolicy string
+	// The protocol identifier of the connections.
+	Protocol uint8
+}
+
 // NetworkPolicyQueryFilter is used to filter the result while retrieve network policy
 // An empty attribute, which won't be used as a condition, means match all.
 // e.g SourceType = "" means all type network policy will be retrieved
@@ -121,7 +147,7 @@ type NetworkPolicyQueryFilter struct {
 	SourceName string
 	// The namespace of the original Namespace that the internal NetworkPolicy is created for.
 	Namespace string
-	// The type of the original NetworkPolicy that the internal NetworkPolicy is created for.(K8sNP, ACNP, ANNP, ANP and BANP)
+	// The type of the original NetworkPolicy that the internal NetworkPolicy is created for.(K8sNP, ACNP, ANNP, ANP, BANP and NSP)
 	SourceType cpv1beta.NetworkPolicyType
 }
 
@@ -132,6 +158,7 @@ var NetworkPolicyTypeMap = map[string]cpv1beta.NetworkPolicyType{
 	"ANNP":  cpv1beta.AntreaNetworkPolicy,
 	"ANP":   cpv1beta.AdminNetworkPolicy,
 	"BANP":  cpv1beta.BaselineAdminNetworkPolicy,
+	"NSP":   cpv1beta.NamespaceSecurityPolicy,
 }
 
 func GetNetworkPolicyTypeShorthands() []string {
@@ -142,7 +169,7 @@ func GetNetworkPolicyTypeShorthands() []string {
 	return validTypes
 }
 
-var NamespaceScopedPolicyTypes = sets.New[string]("ANNP", "K8SNP")
+var NamespaceScopedPolicyTypes = sets.New[string]("ANNP", "K8SNP", "NSP")
 
 // ServiceExternalIPStatusQuerier queries the Service external IP status for debugging purposes.
 // Ideally, every Node should have consistent results eventually. This should only be used when
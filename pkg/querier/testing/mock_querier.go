@@ -14,11 +14,11 @@
 //
 
 // Code generated by MockGen. DO NOT EDIT.
-// Source: antrea.io/antrea/pkg/querier (interfaces: AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier,AgentBGPPolicyInfoQuerier)
+// Source: antrea.io/antrea/pkg/querier (interfaces: AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier,AgentBGPPolicyInfoQuerier,AgentFlowInfoQuerier)
 //
 // Generated by this command:
 //
-//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/querier/testing/mock_querier.go -package testing antrea.io/antrea/pkg/querier AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier,AgentBGPPolicyInfoQuerier
+//	mockgen -copyright_file hack/boilerplate/license_header.raw.txt -destination pkg/querier/testing/mock_querier.go -package testing antrea.io/antrea/pkg/querier AgentNetworkPolicyInfoQuerier,AgentMulticastInfoQuerier,EgressQuerier,AgentBGPPolicyInfoQuerier,AgentFlowInfoQuerier
 //
 
 // Package testing is a generated GoMock package.
@@ -30,6 +30,7 @@ import (
 
 	bgp "antrea.io/antrea/pkg/agent/bgp"
 	bgp0 "antrea.io/antrea/pkg/agent/controller/bgp"
+	flowexporter "antrea.io/antrea/pkg/agent/flowexporter"
 	interfacestore "antrea.io/antrea/pkg/agent/interfacestore"
 	multicast "antrea.io/antrea/pkg/agent/multicast"
 	types "antrea.io/antrea/pkg/agent/types"
@@ -256,6 +257,20 @@ func (mr *MockAgentMulticastInfoQuerierMockRecorder) CollectIGMPReportNPStats()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CollectIGMPReportNPStats", reflect.TypeOf((*MockAgentMulticastInfoQuerier)(nil).CollectIGMPReportNPStats))
 }
 
+// GetAllGroupsStats mocks base method.
+func (m *MockAgentMulticastInfoQuerier) GetAllGroupsStats() []*multicast.GroupTrafficStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllGroupsStats")
+	ret0, _ := ret[0].([]*multicast.GroupTrafficStats)
+	return ret0
+}
+
+// GetAllGroupsStats indicates an expected call of GetAllGroupsStats.
+func (mr *MockAgentMulticastInfoQuerierMockRecorder) GetAllGroupsStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllGroupsStats", reflect.TypeOf((*MockAgentMulticastInfoQuerier)(nil).GetAllGroupsStats))
+}
+
 // GetAllPodsStats mocks base method.
 func (m *MockAgentMulticastInfoQuerier) GetAllPodsStats() map[*interfacestore.InterfaceConfig]*multicast.PodTrafficStats {
 	m.ctrl.T.Helper()
@@ -284,6 +299,20 @@ func (mr *MockAgentMulticastInfoQuerierMockRecorder) GetGroupPods() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupPods", reflect.TypeOf((*MockAgentMulticastInfoQuerier)(nil).GetGroupPods))
 }
 
+// GetGroupStats mocks base method.
+func (m *MockAgentMulticastInfoQuerier) GetGroupStats(group string) *multicast.GroupTrafficStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupStats", group)
+	ret0, _ := ret[0].(*multicast.GroupTrafficStats)
+	return ret0
+}
+
+// GetGroupStats indicates an expected call of GetGroupStats.
+func (mr *MockAgentMulticastInfoQuerierMockRecorder) GetGroupStats(group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupStats", reflect.TypeOf((*MockAgentMulticastInfoQuerier)(nil).GetGroupStats), group)
+}
+
 // GetPodStats mocks base method.
 func (m *MockAgentMulticastInfoQuerier) GetPodStats(podName, podNamespace string) *multicast.PodTrafficStats {
 	m.ctrl.T.Helper()
@@ -424,3 +453,41 @@ func (mr *MockAgentBGPPolicyInfoQuerierMockRecorder) GetBGPRoutes(ctx any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBGPRoutes", reflect.TypeOf((*MockAgentBGPPolicyInfoQuerier)(nil).GetBGPRoutes), ctx)
 }
+
+// MockAgentFlowInfoQuerier is a mock of AgentFlowInfoQuerier interface.
+type MockAgentFlowInfoQuerier struct {
+	ctrl     *gomock.Controller
+	recorder *MockAgentFlowInfoQuerierMockRecorder
+	isgomock struct{}
+}
+
+// MockAgentFlowInfoQuerierMockRecorder is the mock recorder for MockAgentFlowInfoQuerier.
+type MockAgentFlowInfoQuerierMockRecorder struct {
+	mock *MockAgentFlowInfoQuerier
+}
+
+// NewMockAgentFlowInfoQuerier creates a new mock instance.
+func NewMockAgentFlowInfoQuerier(ctrl *gomock.Controller) *MockAgentFlowInfoQuerier {
+	mock := &MockAgentFlowInfoQuerier{ctrl: ctrl}
+	mock.recorder = &MockAgentFlowInfoQuerierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAgentFlowInfoQuerier) EXPECT() *MockAgentFlowInfoQuerierMockRecorder {
+	return m.recorder
+}
+
+// GetConnections mocks base method.
+func (m *MockAgentFlowInfoQuerier) GetConnections(filter *querier.FlowQueryFilter) []flowexporter.Connection {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConnections", filter)
+	ret0, _ := ret[0].([]flowexporter.Connection)
+	return ret0
+}
+
+// GetConnections indicates an expected call of GetConnections.
+func (mr *MockAgentFlowInfoQuerierMockRecorder) GetConnections(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConnections", reflect.TypeOf((*MockAgentFlowInfoQuerier)(nil).GetConnections), filter)
+}
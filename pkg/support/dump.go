@@ -30,6 +30,7 @@ import (
 	"gopkg.in/yaml.v2"
 	"k8s.io/utils/exec"
 
+	"antrea.io/antrea/pkg/agent/profiling"
 	agentquerier "antrea.io/antrea/pkg/agent/querier"
 	clusterinformationv1beta1 "antrea.io/antrea/pkg/apis/crd/v1beta1"
 	"antrea.io/antrea/pkg/ovs/ovsctl"
@@ -62,6 +63,10 @@ type AgentDumper interface {
 	DumpHeapPprof(basedir string) error
 	// DumpGoroutinePprof should create a pprof file of goroutine stacks of the agent.
 	DumpGoroutinePprof(basedir string) error
+	// DumpCapturedProfiles should copy the profiles captured automatically by the
+	// agent when its resource usage exceeded the configured thresholds under the
+	// basedir.
+	DumpCapturedProfiles(basedir string) error
 
 	// DumpOVSPorts should create file that contains OF port descriptions under the basedir.
 	DumpOVSPorts(basedir string) error
@@ -348,6 +353,15 @@ func (d *agentDumper) DumpGoroutinePprof(basedir string) error {
 	return DumpGoroutinePprof(d.fs, basedir)
 }
 
+func (d *agentDumper) DumpCapturedProfiles(basedir string) error {
+	profilesDir := profiling.ProfilesDir()
+	if exists, err := afero.DirExists(d.fs, profilesDir); err != nil || !exists {
+		// No profile has been captured, or self-profiling is not enabled.
+		return nil
+	}
+	return directoryCopy(d.fs, path.Join(basedir, "profiles"), profilesDir, "", nil)
+}
+
 func (d *agentDumper) DumpOVSPorts(basedir string) error {
 	portsDesc, err := d.ovsCtlClient.DumpPortsDesc()
 	if err != nil {
@@ -16,6 +16,7 @@ package support
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
@@ -27,6 +28,8 @@ import (
 	"gopkg.in/yaml.v2"
 	"k8s.io/utils/exec"
 	exectesting "k8s.io/utils/exec/testing"
+
+	"antrea.io/antrea/pkg/agent/profiling"
 )
 
 var baseDir = filepath.Join("dir1", "dir2")
@@ -203,3 +206,24 @@ func TestControllerDumpGoroutinePprof(t *testing.T) {
 	err := dumper.DumpGoroutinePprof(baseDir)
 	require.NoError(t, err)
 }
+
+func TestAgentDumpCapturedProfiles(t *testing.T) {
+	fs := afero.NewMemMapFs()
+	dumper := NewAgentDumper(fs, nil, nil, nil, nil, "", true, false)
+	// No profile has been captured.
+	require.NoError(t, dumper.DumpCapturedProfiles(baseDir))
+	ok, err := afero.DirExists(fs, filepath.Join(baseDir, "profiles"))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	profilesDir := profiling.ProfilesDir()
+	require.NoError(t, fs.MkdirAll(profilesDir, os.ModePerm))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(profilesDir, "cpu-20250601-100000.pprof"), []byte("cpu"), 0644))
+	require.NoError(t, afero.WriteFile(fs, filepath.Join(profilesDir, "heap-20250601-100000.pprof"), []byte("heap"), 0644))
+	require.NoError(t, dumper.DumpCapturedProfiles(baseDir))
+	for _, name := range []string{"cpu-20250601-100000.pprof", "heap-20250601-100000.pprof"} {
+		ok, err := afero.Exists(fs, filepath.Join(baseDir, "profiles", name))
+		require.NoError(t, err)
+		assert.True(t, ok)
+	}
+}
@@ -27,6 +27,7 @@ import (
 const (
 	NodeNameEnvKey        = "NODE_NAME"
 	podNameEnvKey         = "POD_NAME"
+	podIPEnvKey           = "POD_IP"
 	PodNamespaceEnvKey    = "POD_NAMESPACE"
 	svcAcctNameEnvKey     = "SERVICEACCOUNT_NAME"
 	antreaConfigMapEnvKey = "ANTREA_CONFIG_MAP_NAME"
@@ -72,6 +73,15 @@ func GetPodName() string {
 	return podName
 }
 
+// GetPodIP returns the IP address of the Pod where the code executes.
+func GetPodIP() string {
+	podIP := os.Getenv(podIPEnvKey)
+	if podIP == "" {
+		klog.Warningf("Environment variable %s not found", podIPEnvKey)
+	}
+	return podIP
+}
+
 // GetAntreaConfigMapName returns the configMap name of Antrea config.
 func GetAntreaConfigMapName() string {
 	configMapName := os.Getenv(antreaConfigMapEnvKey)
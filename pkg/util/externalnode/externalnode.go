@@ -28,6 +28,13 @@ import (
 const (
 	EntityOwnerKind = "ExternalNode"
 
+	// RegistrationStateAnnotationKey is the annotation that records whether an ExternalNode has been approved
+	// to be realized. antrea-agent sets it to RegistrationStatePending when it registers its own ExternalNode.
+	RegistrationStateAnnotationKey = "externalnode.antrea.io/registration-state"
+	RegistrationStatePending       = "Pending"
+	RegistrationStateApproved      = "Approved"
+	RegistrationStateRejected      = "Rejected"
+
 	interfaceNameLength = 5
 )
 
@@ -63,3 +70,9 @@ func GenerateEntityNodeKey(externalEntity *v1alpha2.ExternalEntity) string {
 	}
 	return entityNodeKey
 }
+
+// GetRegistrationState returns the registration state of the ExternalNode, or an empty string if the
+// ExternalNode does not carry the registration annotation.
+func GetRegistrationState(externalNode *v1alpha1.ExternalNode) string {
+	return externalNode.Annotations[RegistrationStateAnnotationKey]
+}
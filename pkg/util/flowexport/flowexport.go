@@ -16,6 +16,7 @@ package flowexport
 
 import (
 	"fmt"
+	"hash/fnv"
 	"regexp"
 	"strings"
 	"time"
@@ -83,3 +84,35 @@ func ParseTransportProtocol(transportProtocolInput flowaggregatorconfig.Aggregat
 	}
 	return upperProtocolInput, nil
 }
+
+// SelectShard returns the member which owns the key according to rendezvous (highest random weight)
+// hashing, or an empty string if there is no member. All the parties which have the same members
+// agree on the owner of a key, regardless of the order of the members, and adding or removing a
+// member only changes the owner of the keys owned by that member. It is used to shard flow records
+// across the replicas of the flow aggregator.
+func SelectShard(key string, members []string) string {
+	var owner string
+	var maxWeight uint64
+	for _, member := range members {
+		h := fnv.New64a()
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write([]byte(member))
+		weight := mix64(h.Sum64())
+		if owner == "" || weight > maxWeight || (weight == maxWeight && member < owner) {
+			owner, maxWeight = member, weight
+		}
+	}
+	return owner
+}
+
+// mix64 is the finalizer of the SplitMix64 generator, which spreads the FNV hashes of similar inputs
+// uniformly, as FNV alone is biased for inputs which only differ in their last bytes.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -169,3 +169,34 @@ func TestParseTransportProtocol(t *testing.T) {
 		}
 	}
 }
+
+func TestSelectShard(t *testing.T) {
+	assert.Empty(t, SelectShard("node1", nil))
+	assert.Equal(t, "10.0.0.1", SelectShard("node1", []string{"10.0.0.1"}))
+
+	members := []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}
+	reversedMembers := []string{"10.0.0.3", "10.0.0.2", "10.0.0.1"}
+	owners := make(map[string]string)
+	numKeysPerMember := make(map[string]int)
+	for i := 0; i < 3000; i++ {
+		key := fmt.Sprintf("node%d", i)
+		owner := SelectShard(key, members)
+		// The owner doesn't depend on the order of the members.
+		assert.Equal(t, owner, SelectShard(key, reversedMembers))
+		owners[key] = owner
+		numKeysPerMember[owner]++
+	}
+	// The keys are spread across all the members.
+	for _, member := range members {
+		assert.InDelta(t, 1000, numKeysPerMember[member], 200, "member %s", member)
+	}
+	// Removing a member only changes the owner of the keys it owned.
+	for key, owner := range owners {
+		newOwner := SelectShard(key, members[:2])
+		if owner == members[2] {
+			assert.NotEqual(t, members[2], newOwner)
+		} else {
+			assert.Equal(t, owner, newOwner)
+		}
+	}
+}
@@ -142,6 +142,62 @@ func MergeCIDRs(cidrBlocks []*net.IPNet) []*net.IPNet {
 	return cidrBlocks
 }
 
+// AggregateCIDRs returns the smallest set of CIDRs covering exactly the same addresses as the provided CIDRs.
+// Duplicated CIDRs and CIDRs covered by other CIDRs are removed, and adjacent CIDRs which form a bigger CIDR are
+// merged recursively, e.g. 10.0.0.0/25 and 10.0.0.128/25 are merged to 10.0.0.0/24. The returned CIDRs are sorted,
+// with IPv4 CIDRs first. The input CIDRs are not modified.
+func AggregateCIDRs(cidrs []*net.IPNet) []*net.IPNet {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		addr, ok := netip.AddrFromSlice(cidr.IP)
+		if !ok {
+			continue
+		}
+		ones, bits := cidr.Mask.Size()
+		if bits == V4BitLen {
+			addr = addr.Unmap()
+		}
+		prefixes = append(prefixes, netip.PrefixFrom(addr, ones).Masked())
+	}
+	// After sorting by address and then by prefix length, a CIDR can only be covered by a CIDR placed before it, and
+	// two CIDRs which can be merged are always consecutive once the CIDRs covered by others are removed.
+	sort.Slice(prefixes, func(i, j int) bool {
+		if c := prefixes[i].Addr().Compare(prefixes[j].Addr()); c != 0 {
+			return c < 0
+		}
+		return prefixes[i].Bits() < prefixes[j].Bits()
+	})
+	aggregated := make([]netip.Prefix, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		if len(aggregated) > 0 && aggregated[len(aggregated)-1].Overlaps(prefix) {
+			continue
+		}
+		aggregated = append(aggregated, prefix)
+		// Merge the last two CIDRs as long as they are the two halves of the same parent CIDR.
+		for len(aggregated) >= 2 {
+			last, prev := aggregated[len(aggregated)-1], aggregated[len(aggregated)-2]
+			if last.Bits() != prev.Bits() || last.Bits() == 0 {
+				break
+			}
+			parent := netip.PrefixFrom(prev.Addr(), prev.Bits()-1).Masked()
+			if parent.Addr() != prev.Addr() || !parent.Contains(last.Addr()) {
+				break
+			}
+			aggregated = aggregated[:len(aggregated)-2]
+			aggregated = append(aggregated, parent)
+		}
+	}
+	result := make([]*net.IPNet, 0, len(aggregated))
+	for _, prefix := range aggregated {
+		bits := V6BitLen
+		if prefix.Addr().Is4() {
+			bits = V4BitLen
+		}
+		result = append(result, &net.IPNet{IP: prefix.Addr().AsSlice(), Mask: net.CIDRMask(prefix.Bits(), bits)})
+	}
+	return result
+}
+
 // IPNetToNetIPNet converts Antrea IPNet to *net.IPNet.
 // Note that K8s allows non-standard CIDRs to be specified (e.g. 10.0.1.1/16, fe80::7015:efff:fe9a:146b/64). However,
 // OVS will report OFPBMC_BAD_WILDCARDS error if using them in the OpenFlow messages. The function will normalize the
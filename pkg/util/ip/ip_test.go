@@ -112,6 +112,63 @@ func TestMergeCIDRs(t *testing.T) {
 	assert.ElementsMatch(t, correctList4, ipNetList4)
 }
 
+func TestAggregateCIDRs(t *testing.T) {
+	tests := []struct {
+		name     string
+		cidrs    []string
+		expected []string
+	}{
+		{
+			name:     "empty",
+			cidrs:    []string{},
+			expected: []string{},
+		},
+		{
+			name:     "duplicated and covered CIDRs",
+			cidrs:    []string{"10.0.1.0/24", "10.0.0.0/16", "10.0.1.0/24", "10.0.2.3/32"},
+			expected: []string{"10.0.0.0/16"},
+		},
+		{
+			name:     "adjacent CIDRs",
+			cidrs:    []string{"10.0.0.128/25", "10.0.0.0/25", "10.0.1.0/24", "10.0.3.0/24"},
+			expected: []string{"10.0.0.0/23", "10.0.3.0/24"},
+		},
+		{
+			name:     "adjacent CIDRs with different parents",
+			cidrs:    []string{"10.0.1.0/24", "10.0.2.0/24"},
+			expected: []string{"10.0.1.0/24", "10.0.2.0/24"},
+		},
+		{
+			name:     "single IPs",
+			cidrs:    []string{"192.168.0.3/32", "192.168.0.0/32", "192.168.0.2/32", "192.168.0.1/32", "192.168.0.4/32"},
+			expected: []string{"192.168.0.0/30", "192.168.0.4/32"},
+		},
+		{
+			name:     "whole address space",
+			cidrs:    []string{"128.0.0.0/1", "0.0.0.0/1"},
+			expected: []string{"0.0.0.0/0"},
+		},
+		{
+			name:     "dual-stack",
+			cidrs:    []string{"fd00::/65", "10.0.0.0/9", "fd00:0:0:0:8000::/65", "10.128.0.0/9"},
+			expected: []string{"10.0.0.0/8", "fd00::/64"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cidrs := make([]*net.IPNet, 0, len(tt.cidrs))
+			for _, cidr := range tt.cidrs {
+				cidrs = append(cidrs, newCIDR(cidr))
+			}
+			expected := make([]*net.IPNet, 0, len(tt.expected))
+			for _, cidr := range tt.expected {
+				expected = append(expected, newCIDR(cidr))
+			}
+			assert.Equal(t, expected, AggregateCIDRs(cidrs))
+		})
+	}
+}
+
 func TestIPNetToNetIPNet(t *testing.T) {
 	tests := []struct {
 		name  string
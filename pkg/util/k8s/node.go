@@ -124,7 +124,7 @@ func GetNodeGatewayAddrs(node *v1.Node) (*ip.DualStackIPs, error) {
 
 // GetNodeAllAddrs gets all Node IPs from the Node.
 func GetNodeAllAddrs(node *v1.Node) (ips sets.Set[string], err error) {
-	var nodeIPs, gwIPs, transportAddrs *ip.DualStackIPs
+	var nodeIPs, gwIPs, transportAddrs, tunnelAddrs *ip.DualStackIPs
 	ips = sets.Set[string]{}
 	appendIP := func(dsIPs *ip.DualStackIPs) {
 		if dsIPs == nil {
@@ -153,6 +153,11 @@ func GetNodeAllAddrs(node *v1.Node) (ips sets.Set[string], err error) {
 	}
 	appendIP(transportAddrs)
 
+	if tunnelAddrs, err = GetNodeAddrsFromAnnotations(node, types.NodeTunnelAddressAnnotationKey); err != nil {
+		return
+	}
+	appendIP(tunnelAddrs)
+
 	return
 }
 
@@ -171,3 +176,46 @@ func GetNodeTransportAddrs(node *v1.Node) (*ip.DualStackIPs, error) {
 	}
 	return nodeAddrs, nil
 }
+
+// GetNodeTunnelAddrs gets the IPs which should be used as the tunnel destination of the Node. The IPs specified by
+// the tunnel address annotation take precedence over the transport IPs. If the annotation only specifies an IP of one
+// address family, the transport IP of the other address family is used.
+func GetNodeTunnelAddrs(node *v1.Node) (*ip.DualStackIPs, error) {
+	tunnelAddrs, err := GetNodeAddrsFromAnnotations(node, types.NodeTunnelAddressAnnotationKey)
+	if err != nil {
+		return nil, err
+	}
+	transportAddrs, err := GetNodeTransportAddrs(node)
+	if err != nil {
+		return nil, err
+	}
+	if tunnelAddrs == nil {
+		return transportAddrs, nil
+	}
+	if tunnelAddrs.IPv4 == nil {
+		tunnelAddrs.IPv4 = transportAddrs.IPv4
+	}
+	if tunnelAddrs.IPv6 == nil {
+		tunnelAddrs.IPv6 = transportAddrs.IPv6
+	}
+	return tunnelAddrs, nil
+}
+
+// GetNodeMultipathAddrs gets the IPs of the additional uplinks through which the Node can be reached in noEncap mode,
+// from the multipath address annotation set by antrea-agent. Unlike the other address annotations, it can include
+// multiple IPs of the same address family.
+func GetNodeMultipathAddrs(node *v1.Node) ([]net.IP, error) {
+	annotationAddrsStr := node.Annotations[types.NodeMultipathAddressAnnotationKey]
+	if annotationAddrsStr == "" {
+		return nil, nil
+	}
+	var ipAddrs []net.IP
+	for _, addr := range strings.Split(annotationAddrsStr, ",") {
+		ipAddr := net.ParseIP(addr)
+		if ipAddr == nil {
+			return nil, fmt.Errorf("invalid annotation for multipath addresses on Node %s: %s", node.Name, annotationAddrsStr)
+		}
+		ipAddrs = append(ipAddrs, ipAddr)
+	}
+	return ipAddrs, nil
+}
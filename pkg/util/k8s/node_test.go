@@ -475,3 +475,95 @@ func TestGetNodeAllAddrs(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNodeTunnelAddrs(t *testing.T) {
+	tests := []struct {
+		name         string
+		annotations  map[string]string
+		expectedAddr *ip.DualStackIPs
+		expectedErr  error
+	}{
+		{
+			name:         "Node without annotations",
+			expectedAddr: &ip.DualStackIPs{IPv4: net.ParseIP("10.176.10.10"), IPv6: net.ParseIP("fd00::10")},
+		},
+		{
+			name:         "Node with transport address annotation",
+			annotations:  map[string]string{types.NodeTransportAddressAnnotationKey: "172.16.0.1,1::1"},
+			expectedAddr: &ip.DualStackIPs{IPv4: net.ParseIP("172.16.0.1"), IPv6: net.ParseIP("1::1")},
+		},
+		{
+			name: "Node with tunnel address annotation",
+			annotations: map[string]string{
+				types.NodeTransportAddressAnnotationKey: "172.16.0.1,1::1",
+				types.NodeTunnelAddressAnnotationKey:    "192.168.100.1,2::1",
+			},
+			expectedAddr: &ip.DualStackIPs{IPv4: net.ParseIP("192.168.100.1"), IPv6: net.ParseIP("2::1")},
+		},
+		{
+			name:         "Node with IPv4 tunnel address annotation",
+			annotations:  map[string]string{types.NodeTunnelAddressAnnotationKey: "192.168.100.1"},
+			expectedAddr: &ip.DualStackIPs{IPv4: net.ParseIP("192.168.100.1"), IPv6: net.ParseIP("fd00::10")},
+		},
+		{
+			name:        "Node with invalid tunnel address annotation",
+			annotations: map[string]string{types.NodeTunnelAddressAnnotationKey: "x"},
+			expectedErr: fmt.Errorf("invalid annotation for ip-address on Node node0: x"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "node0",
+					Annotations: tt.annotations,
+				},
+				Status: corev1.NodeStatus{
+					Addresses: []corev1.NodeAddress{
+						{Type: corev1.NodeInternalIP, Address: "10.176.10.10"},
+						{Type: corev1.NodeInternalIP, Address: "fd00::10"},
+					},
+				},
+			}
+			addr, err := GetNodeTunnelAddrs(node)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedAddr, addr)
+		})
+	}
+}
+
+func TestGetNodeMultipathAddrs(t *testing.T) {
+	tests := []struct {
+		name          string
+		annotations   map[string]string
+		expectedAddrs []net.IP
+		expectedErr   error
+	}{
+		{
+			name: "Node without annotation",
+		},
+		{
+			name:          "Node with multipath address annotation",
+			annotations:   map[string]string{types.NodeMultipathAddressAnnotationKey: "172.16.0.1,172.17.0.1,1::1"},
+			expectedAddrs: []net.IP{net.ParseIP("172.16.0.1"), net.ParseIP("172.17.0.1"), net.ParseIP("1::1")},
+		},
+		{
+			name:        "Node with invalid multipath address annotation",
+			annotations: map[string]string{types.NodeMultipathAddressAnnotationKey: "172.16.0.1,x"},
+			expectedErr: fmt.Errorf("invalid annotation for multipath addresses on Node node0: 172.16.0.1,x"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "node0",
+					Annotations: tt.annotations,
+				},
+			}
+			addrs, err := GetNodeMultipathAddrs(node)
+			assert.Equal(t, tt.expectedErr, err)
+			assert.Equal(t, tt.expectedAddrs, addrs)
+		})
+	}
+}
@@ -14,7 +14,13 @@
 
 package k8s
 
-import v1 "k8s.io/api/core/v1"
+import (
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 // IsPodTerminated returns true if a pod is terminated, all containers are stopped and cannot ever regress.
 func IsPodTerminated(pod *v1.Pod) bool {
@@ -32,3 +38,23 @@ func GetPodContainerNames(pod *v1.Pod) []string {
 	}
 	return names
 }
+
+// GetPodWorkload returns the kind and name of the workload which manages a Pod, based on the
+// controller reference of the Pod. Pods created by a Deployment are reported as owned by the
+// Deployment rather than by the intermediate ReplicaSet: the Deployment name is derived from the
+// ReplicaSet name by removing the "pod-template-hash" suffix, so no additional lookup is needed.
+// Empty strings are returned if the Pod has no controller.
+func GetPodWorkload(pod *v1.Pod) (string, string) {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return "", ""
+	}
+	if ref.Kind == "ReplicaSet" && strings.HasPrefix(ref.APIVersion, appsv1.GroupName+"/") {
+		if hash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; ok && hash != "" {
+			if name, found := strings.CutSuffix(ref.Name, "-"+hash); found && name != "" {
+				return "Deployment", name
+			}
+		}
+	}
+	return ref.Kind, ref.Name
+}
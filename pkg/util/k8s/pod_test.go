@@ -0,0 +1,107 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8s
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestGetPodWorkload(t *testing.T) {
+	newPod := func(labels map[string]string, owners ...metav1.OwnerReference) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "pod",
+				Namespace:       "default",
+				Labels:          labels,
+				OwnerReferences: owners,
+			},
+		}
+	}
+	controllerRef := func(apiVersion, kind, name string) metav1.OwnerReference {
+		return metav1.OwnerReference{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Name:       name,
+			Controller: ptr.To(true),
+		}
+	}
+	tests := []struct {
+		name         string
+		pod          *corev1.Pod
+		expectedKind string
+		expectedName string
+	}{
+		{
+			name: "no owner",
+			pod:  newPod(nil),
+		},
+		{
+			name: "owner is not controller",
+			pod: newPod(nil, metav1.OwnerReference{
+				APIVersion: "apps/v1",
+				Kind:       "StatefulSet",
+				Name:       "web",
+			}),
+		},
+		{
+			name:         "Deployment",
+			pod:          newPod(map[string]string{"pod-template-hash": "5d4f8b9c7"}, controllerRef("apps/v1", "ReplicaSet", "web-5d4f8b9c7")),
+			expectedKind: "Deployment",
+			expectedName: "web",
+		},
+		{
+			name:         "standalone ReplicaSet",
+			pod:          newPod(nil, controllerRef("apps/v1", "ReplicaSet", "web-5d4f8b9c7")),
+			expectedKind: "ReplicaSet",
+			expectedName: "web-5d4f8b9c7",
+		},
+		{
+			name:         "ReplicaSet name without hash suffix",
+			pod:          newPod(map[string]string{"pod-template-hash": "5d4f8b9c7"}, controllerRef("apps/v1", "ReplicaSet", "web")),
+			expectedKind: "ReplicaSet",
+			expectedName: "web",
+		},
+		{
+			name:         "StatefulSet",
+			pod:          newPod(nil, controllerRef("apps/v1", "StatefulSet", "db")),
+			expectedKind: "StatefulSet",
+			expectedName: "db",
+		},
+		{
+			name:         "DaemonSet",
+			pod:          newPod(nil, controllerRef("apps/v1", "DaemonSet", "agent")),
+			expectedKind: "DaemonSet",
+			expectedName: "agent",
+		},
+		{
+			name:         "Job",
+			pod:          newPod(nil, controllerRef("batch/v1", "Job", "backup")),
+			expectedKind: "Job",
+			expectedName: "backup",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kind, name := GetPodWorkload(tt.pod)
+			assert.Equal(t, tt.expectedKind, kind)
+			assert.Equal(t, tt.expectedName, name)
+		})
+	}
+}
@@ -15,11 +15,16 @@
 package k8s
 
 import (
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/tools/cache"
 )
 
+// antreaPodConditionPrefix is the prefix of the Pod conditions set by Antrea.
+const antreaPodConditionPrefix = "antrea.io/"
+
 // NewTrimmer returns a cache.TransformFunc that can be used to trim objects stored in informers.
 // The function must be idempotent before client-go v0.31.0 to avoid a race condition happening when objects were
 // accessed during Resync operation, see https://github.com/kubernetes/kubernetes/issues/124337.
@@ -80,7 +85,14 @@ func TrimPod(obj interface{}) (interface{}, error) {
 	pod.Spec.Tolerations = nil
 	pod.Spec.ResourceClaims = nil
 
-	pod.Status.Conditions = nil
+	// Only keep the conditions set by Antrea, e.g. the condition of the NetworkPolicy readiness gate.
+	var conditions []corev1.PodCondition
+	for _, condition := range pod.Status.Conditions {
+		if strings.HasPrefix(string(condition.Type), antreaPodConditionPrefix) {
+			conditions = append(conditions, condition)
+		}
+	}
+	pod.Status.Conditions = conditions
 	pod.Status.StartTime = nil
 	pod.Status.InitContainerStatuses = nil
 	pod.Status.ContainerStatuses = nil
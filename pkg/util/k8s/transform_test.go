@@ -73,6 +73,10 @@ func TestTrimK8sObject(t *testing.T) {
 							Type:   corev1.PodReady,
 							Status: corev1.ConditionTrue,
 						},
+						{
+							Type:   "antrea.io/network-policies-realized",
+							Status: corev1.ConditionTrue,
+						},
 					},
 					PodIP: "1.2.3.4",
 					PodIPs: []corev1.PodIP{
@@ -102,6 +106,12 @@ func TestTrimK8sObject(t *testing.T) {
 					NodeName: "nodeA",
 				},
 				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{
+						{
+							Type:   "antrea.io/network-policies-realized",
+							Status: corev1.ConditionTrue,
+						},
+					},
 					PodIP: "1.2.3.4",
 					PodIPs: []corev1.PodIP{
 						{IP: "1.2.3.4"},
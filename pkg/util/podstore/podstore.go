@@ -42,6 +42,9 @@ type PodStore struct {
 	timestampMap map[types.UID]*podTimestamps
 	clock        clock.Clock
 	mutex        sync.RWMutex
+	podInformer  cache.SharedIndexInformer
+	// podHandler is the registration of the Pod event handler, which is removed when the PodStore stops.
+	podHandler cache.ResourceEventHandlerRegistration
 }
 
 type podTimestamps struct {
@@ -69,8 +72,9 @@ func NewPodStoreWithClock(podInformer cache.SharedIndexInformer, clock clock.Wit
 		clock:        clock,
 		timestampMap: map[types.UID]*podTimestamps{},
 		mutex:        sync.RWMutex{},
+		podInformer:  podInformer,
 	}
-	podInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+	s.podHandler, _ = podInformer.AddEventHandler(cache.FilteringResourceEventHandler{
 		// Ignore hostNetwork Pods
 		FilterFunc: func(obj interface{}) bool {
 			if pod, ok := obj.(*corev1.Pod); ok {
@@ -241,6 +245,12 @@ func (s *PodStore) Run(stopCh <-chan struct{}) {
 	defer s.podsToDelete.ShutDown()
 	go wait.Until(s.worker, time.Second, stopCh)
 	<-stopCh
+	// A stopped PodStore cannot be run again, so it no longer needs to receive Pod events.
+	if s.podHandler != nil {
+		if err := s.podInformer.RemoveEventHandler(s.podHandler); err != nil {
+			klog.ErrorS(err, "Failed to remove Pod event handler of PodStore")
+		}
+	}
 }
 
 // worker runs a worker thread that just dequeues item from deleteQueue and
@@ -0,0 +1,103 @@
+// Copyright 2025 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	s3manager "github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"k8s.io/klog/v2"
+)
+
+const defaultRegion = "us-east-1"
+
+// ParseS3UploadURL parses a URL with format s3://<bucket>[/<prefix>], and returns the bucket and the prefix.
+func ParseS3UploadURL(uploadURL string) (string, string, error) {
+	parsedURL, err := url.Parse(uploadURL)
+	if err != nil {
+		return "", "", err
+	}
+	if parsedURL.Scheme != "s3" {
+		return "", "", fmt.Errorf("not s3 protocol")
+	}
+	if parsedURL.Host == "" {
+		return "", "", fmt.Errorf("bucket name is missing")
+	}
+	return parsedURL.Host, strings.Trim(parsedURL.Path, "/"), nil
+}
+
+// Config is the configuration used to connect to the object storage service.
+type Config struct {
+	// Endpoint is the URL of an S3-compatible object storage service. If empty, AWS S3 is used.
+	Endpoint string
+	// Region is the region of the bucket. If empty, defaults to us-east-1.
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type Uploader interface {
+	// Upload uploads a file to the target s3 address using the provided config.
+	Upload(ctx context.Context, url string, fileName string, config *Config, outputFile io.Reader) error
+}
+
+type s3Uploader struct {
+}
+
+func NewUploader() Uploader {
+	return &s3Uploader{}
+}
+
+func (uploader *s3Uploader) Upload(ctx context.Context, url string, fileName string, config *Config, outputFile io.Reader) error {
+	// url should be like: s3://bucket/path
+	bucket, prefix, err := ParseS3UploadURL(url)
+	if err != nil {
+		return err
+	}
+	key := path.Join(prefix, fileName)
+
+	region := config.Region
+	if region == "" {
+		region = defaultRegion
+	}
+	options := s3.Options{
+		Region:      region,
+		Credentials: credentials.NewStaticCredentialsProvider(config.AccessKeyID, config.SecretAccessKey, ""),
+	}
+	if config.Endpoint != "" {
+		options.BaseEndpoint = aws.String(config.Endpoint)
+		// Most S3-compatible services don't support virtual-hosted-style requests.
+		options.UsePathStyle = true
+	}
+	// Failed requests are retried by the client itself.
+	client := s3.New(options)
+	if _, err := s3manager.NewUploader(client).Upload(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   outputFile,
+	}); err != nil {
+		return fmt.Errorf("error when uploading file to bucket %s: %w", bucket, err)
+	}
+	klog.InfoS("Successfully uploaded file to bucket", "bucket", bucket, "key", key)
+	return nil
+}
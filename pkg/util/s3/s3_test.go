@@ -0,0 +1,60 @@
+// Copyright 2025 Antrea Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package s3
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseS3UploadURL(t *testing.T) {
+	cases := []struct {
+		url            string
+		expectedError  string
+		expectedBucket string
+		expectedPrefix string
+	}{
+		{
+			url:            "s3://captures/antrea/node1/",
+			expectedBucket: "captures",
+			expectedPrefix: "antrea/node1",
+		},
+		{
+			url:            "s3://captures",
+			expectedBucket: "captures",
+		},
+		{
+			url:           "s3:///path",
+			expectedError: "bucket name is missing",
+		},
+		{
+			url:           "sftp://127.0.0.1:22/path",
+			expectedError: "not s3 protocol",
+		},
+	}
+
+	for _, tc := range cases {
+		bucket, prefix, err := ParseS3UploadURL(tc.url)
+		if tc.expectedError == "" {
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedBucket, bucket)
+			assert.Equal(t, tc.expectedPrefix, prefix)
+		} else {
+			assert.EqualError(t, err, tc.expectedError)
+		}
+	}
+}
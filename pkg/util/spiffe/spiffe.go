@@ -0,0 +1,246 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spiffe
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// The default names of the files written by spiffe-helper, which keeps them up-to-date with the X.509 SVID of the
+	// workload and the trust bundle of its trust domain.
+	SVIDFileName       = "svid.pem"
+	SVIDKeyFileName    = "svid_key.pem"
+	SVIDBundleFileName = "svid_bundle.pem"
+)
+
+// X509Source holds an X.509 SVID, its private key and the trust bundle used to verify the SVIDs of peers, in PEM
+// format.
+type X509Source struct {
+	CertPEM   []byte
+	KeyPEM    []byte
+	BundlePEM []byte
+	// ID is the SPIFFE ID of the SVID.
+	ID string
+}
+
+// LoadX509Source reads an X.509 SVID, its private key and the trust bundle from the provided directory.
+func LoadX509Source(dir string) (*X509Source, error) {
+	s := &X509Source{}
+	var err error
+	if s.CertPEM, err = os.ReadFile(filepath.Join(dir, SVIDFileName)); err != nil {
+		return nil, fmt.Errorf("error reading SVID: %w", err)
+	}
+	if s.KeyPEM, err = os.ReadFile(filepath.Join(dir, SVIDKeyFileName)); err != nil {
+		return nil, fmt.Errorf("error reading SVID key: %w", err)
+	}
+	if s.BundlePEM, err = os.ReadFile(filepath.Join(dir, SVIDBundleFileName)); err != nil {
+		return nil, fmt.Errorf("error reading trust bundle: %w", err)
+	}
+	certificate, err := tls.X509KeyPair(s.CertPEM, s.KeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SVID or SVID key: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid SVID: %w", err)
+	}
+	if s.ID, err = IDFromCertificate(leaf); err != nil {
+		return nil, err
+	}
+	if _, err := s.bundlePool(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Equal returns whether the two sources hold the same SVID and trust bundle.
+func (s *X509Source) Equal(other *X509Source) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+	return bytes.Equal(s.CertPEM, other.CertPEM) && bytes.Equal(s.KeyPEM, other.KeyPEM) && bytes.Equal(s.BundlePEM, other.BundlePEM)
+}
+
+func (s *X509Source) bundlePool() (*x509.CertPool, error) {
+	pool := x509.NewCertPool()
+	numCerts := 0
+	rest := s.BundlePEM
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate in trust bundle: %w", err)
+		}
+		pool.AddCert(cert)
+		numCerts++
+	}
+	if numCerts == 0 {
+		return nil, fmt.Errorf("no certificate in trust bundle")
+	}
+	return pool, nil
+}
+
+// IDFromCertificate returns the SPIFFE ID of an X.509 SVID, which must be its only URI SAN.
+func IDFromCertificate(cert *x509.Certificate) (string, error) {
+	if len(cert.URIs) != 1 {
+		return "", fmt.Errorf("an X.509 SVID must have exactly one URI SAN, got %d", len(cert.URIs))
+	}
+	id := cert.URIs[0].String()
+	if err := ValidateID(id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// ValidateID validates a SPIFFE ID, e.g. "spiffe://example.org/ns/kube-system/sa/antrea-agent". An ID without path,
+// e.g. "spiffe://example.org", identifies a trust domain.
+func ValidateID(id string) error {
+	u, err := url.Parse(id)
+	if err != nil {
+		return fmt.Errorf("invalid SPIFFE ID %q: %w", id, err)
+	}
+	if u.Scheme != "spiffe" || u.Host == "" || u.User != nil || u.Port() != "" || u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("invalid SPIFFE ID %q: it must be in the form spiffe://<trust domain>/<path>", id)
+	}
+	return nil
+}
+
+// AuthorizeID checks whether a SPIFFE ID is authorized, i.e. whether it is one of the authorized IDs or it belongs to
+// one of the authorized trust domains.
+func AuthorizeID(id string, authorizedIDs []string) error {
+	for _, authorizedID := range authorizedIDs {
+		if id == authorizedID {
+			return nil
+		}
+		// An authorized ID without path authorizes the whole trust domain.
+		if u, err := url.Parse(authorizedID); err == nil && strings.TrimSuffix(u.Path, "/") == "" && strings.HasPrefix(id, strings.TrimSuffix(authorizedID, "/")+"/") {
+			return nil
+		}
+	}
+	return fmt.Errorf("SPIFFE ID %s is not authorized", id)
+}
+
+// verifyPeerCertificate verifies that the certificate chain presented by a peer is signed by the trust bundle and
+// valid for the provided usage, and that the SPIFFE ID of the peer is authorized. It returns the leaf certificate.
+func verifyPeerCertificate(rawCerts [][]byte, roots *x509.CertPool, keyUsage x509.ExtKeyUsage, authorizedIDs []string) (*x509.Certificate, error) {
+	if len(rawCerts) == 0 {
+		return nil, fmt.Errorf("no certificate presented by peer")
+	}
+	certs := make([]*x509.Certificate, 0, len(rawCerts))
+	for _, rawCert := range rawCerts {
+		cert, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer certificate: %w", err)
+		}
+		certs = append(certs, cert)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{keyUsage},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to verify peer SVID: %w", err)
+	}
+	id, err := IDFromCertificate(certs[0])
+	if err != nil {
+		return nil, err
+	}
+	if err := AuthorizeID(id, authorizedIDs); err != nil {
+		return nil, err
+	}
+	return certs[0], nil
+}
+
+// ServerTLSConfig returns the configuration of a TLS server authenticating with the SVID of the source, which only
+// accepts the clients presenting an SVID signed by the trust bundle of the source, and whose SPIFFE ID is authorized.
+// The client SVID is verified during the handshake of each connection.
+func (s *X509Source) ServerTLSConfig(authorizedClientIDs []string) (*tls.Config, error) {
+	certificate, err := tls.X509KeyPair(s.CertPEM, s.KeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SVID or SVID key: %w", err)
+	}
+	roots, err := s.bundlePool()
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+		// The client certificate is verified in VerifyPeerCertificate, which also checks its SPIFFE ID.
+		ClientAuth: tls.RequireAnyClientCert,
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			_, err := verifyPeerCertificate(rawCerts, roots, x509.ExtKeyUsageClientAuth, authorizedClientIDs)
+			return err
+		},
+	}, nil
+}
+
+// VerifyServerID performs a TLS handshake with the server at the provided address, authenticating with the SVID of
+// the source, and checks that the server presents an SVID which is signed by the trust bundle of the source and whose
+// SPIFFE ID is authorized. It returns the verified server certificate in PEM format, which must be used as the only
+// trusted root certificate when connecting to the server afterwards: a connection can then only be established with
+// a server holding the private key of the verified SVID, even if the address now points to another server.
+func VerifyServerID(ctx context.Context, address string, source *X509Source, authorizedIDs []string) ([]byte, error) {
+	certificate, err := tls.X509KeyPair(source.CertPEM, source.KeyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SVID or SVID key: %w", err)
+	}
+	roots, err := source.bundlePool()
+	if err != nil {
+		return nil, err
+	}
+	var serverCert *x509.Certificate
+	config := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		MinVersion:   tls.VersionTLS12,
+		// The server certificate is verified in VerifyPeerCertificate, based on its SPIFFE ID instead of its DNS
+		// names.
+		InsecureSkipVerify: true, // #nosec G402: the server certificate is verified below.
+		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			cert, err := verifyPeerCertificate(rawCerts, roots, x509.ExtKeyUsageServerAuth, authorizedIDs)
+			if err != nil {
+				return err
+			}
+			serverCert = cert
+			return nil
+		},
+	}
+	dialer := &tls.Dialer{NetDialer: &net.Dialer{}, Config: config}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	conn.Close()
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: serverCert.Raw}), nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 Antrea Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spiffe_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"antrea.io/antrea/pkg/util/spiffe"
+	spiffetesting "antrea.io/antrea/pkg/util/spiffe/testing"
+)
+
+func writeSource(t *testing.T, certPEM, keyPEM, bundlePEM []byte) string {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, spiffe.SVIDFileName), certPEM, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, spiffe.SVIDKeyFileName), keyPEM, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, spiffe.SVIDBundleFileName), bundlePEM, 0600))
+	return dir
+}
+
+func TestLoadX509Source(t *testing.T) {
+	ca, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	dir := t.TempDir()
+	require.NoError(t, ca.WriteX509Source(dir, "spiffe://example.org/ns/kube-system/sa/antrea-agent"))
+	source, err := spiffe.LoadX509Source(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "spiffe://example.org/ns/kube-system/sa/antrea-agent", source.ID)
+	sameSource, err := spiffe.LoadX509Source(dir)
+	require.NoError(t, err)
+	assert.True(t, source.Equal(sameSource))
+	require.NoError(t, ca.WriteX509Source(dir, "spiffe://example.org/ns/kube-system/sa/antrea-agent"))
+	rotatedSource, err := spiffe.LoadX509Source(dir)
+	require.NoError(t, err)
+	assert.False(t, source.Equal(rotatedSource))
+
+	certPEM, keyPEM, err := ca.NewSVID("spiffe://example.org/ns/kube-system/sa/antrea-agent")
+	require.NoError(t, err)
+	_, otherKeyPEM, err := ca.NewSVID("spiffe://example.org/ns/kube-system/sa/antrea-agent")
+	require.NoError(t, err)
+	invalidIDCertPEM, invalidIDKeyPEM, err := ca.NewSVID("https://example.org/antrea-agent")
+	require.NoError(t, err)
+
+	_, err = spiffe.LoadX509Source(writeSource(t, invalidIDCertPEM, invalidIDKeyPEM, ca.BundlePEM))
+	assert.ErrorContains(t, err, "invalid SPIFFE ID")
+	_, err = spiffe.LoadX509Source(writeSource(t, certPEM, otherKeyPEM, ca.BundlePEM))
+	assert.ErrorContains(t, err, "invalid SVID or SVID key")
+	_, err = spiffe.LoadX509Source(writeSource(t, certPEM, keyPEM, []byte("")))
+	assert.ErrorContains(t, err, "no certificate in trust bundle")
+	_, err = spiffe.LoadX509Source(t.TempDir())
+	assert.ErrorContains(t, err, "error reading SVID")
+}
+
+func TestIDFromCertificate(t *testing.T) {
+	ca, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	certPEM, _, err := ca.NewSVID("spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator")
+	require.NoError(t, err)
+	block, _ := pem.Decode(certPEM)
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	id, err := spiffe.IDFromCertificate(cert)
+	require.NoError(t, err)
+	assert.Equal(t, "spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator", id)
+
+	cert.URIs = nil
+	_, err = spiffe.IDFromCertificate(cert)
+	assert.ErrorContains(t, err, "an X.509 SVID must have exactly one URI SAN, got 0")
+}
+
+func TestValidateID(t *testing.T) {
+	assert.NoError(t, spiffe.ValidateID("spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator"))
+	assert.NoError(t, spiffe.ValidateID("spiffe://example.org"))
+	assert.Error(t, spiffe.ValidateID("https://example.org/foo"))
+	assert.Error(t, spiffe.ValidateID("spiffe:///foo"))
+	assert.Error(t, spiffe.ValidateID("spiffe://example.org:8080/foo"))
+}
+
+func TestAuthorizeID(t *testing.T) {
+	authorizedIDs := []string{"spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator", "spiffe://other.org"}
+	assert.NoError(t, spiffe.AuthorizeID("spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator", authorizedIDs))
+	assert.NoError(t, spiffe.AuthorizeID("spiffe://other.org/ns/default/sa/default", authorizedIDs))
+	assert.Error(t, spiffe.AuthorizeID("spiffe://example.org/ns/default/sa/default", authorizedIDs))
+	assert.Error(t, spiffe.AuthorizeID("spiffe://other.org.evil/ns/default/sa/default", authorizedIDs))
+}
+
+func TestVerifyServerID(t *testing.T) {
+	ca, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	serverCertPEM, serverKeyPEM, err := ca.NewSVID("spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator")
+	require.NoError(t, err)
+	clientCertPEM, clientKeyPEM, err := ca.NewSVID("spiffe://example.org/ns/kube-system/sa/antrea-agent")
+	require.NoError(t, err)
+	serverCert, err := tls.X509KeyPair(serverCertPEM, serverKeyPEM)
+	require.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	require.True(t, clientCAs.AppendCertsFromPEM(ca.BundlePEM))
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{
+		Certificates: []tls.Certificate{serverCert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	})
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	source := &spiffe.X509Source{CertPEM: clientCertPEM, KeyPEM: clientKeyPEM, BundlePEM: ca.BundlePEM}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	address := listener.Addr().String()
+	pinnedCertPEM, err := spiffe.VerifyServerID(ctx, address, source, []string{"spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator"})
+	require.NoError(t, err)
+	// The returned certificate is the SVID of the server, which can be used as the only trusted root certificate to
+	// verify the server.
+	assert.Equal(t, serverCertPEM, pinnedCertPEM)
+	pinnedRoots := x509.NewCertPool()
+	require.True(t, pinnedRoots.AppendCertsFromPEM(pinnedCertPEM))
+	_, err = serverCert.Leaf.Verify(x509.VerifyOptions{Roots: pinnedRoots})
+	assert.NoError(t, err)
+	otherServerCertPEM, _, err := ca.NewSVID("spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator")
+	require.NoError(t, err)
+	block, _ := pem.Decode(otherServerCertPEM)
+	otherServerCert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	_, err = otherServerCert.Verify(x509.VerifyOptions{Roots: pinnedRoots})
+	assert.Error(t, err)
+	_, err = spiffe.VerifyServerID(ctx, address, source, []string{"spiffe://example.org"})
+	assert.NoError(t, err)
+	_, err = spiffe.VerifyServerID(ctx, address, source, []string{"spiffe://example.org/ns/default/sa/default"})
+	assert.ErrorContains(t, err, "is not authorized")
+
+	otherCA, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	otherSource := &spiffe.X509Source{CertPEM: clientCertPEM, KeyPEM: clientKeyPEM, BundlePEM: otherCA.BundlePEM}
+	_, err = spiffe.VerifyServerID(ctx, address, otherSource, []string{"spiffe://example.org"})
+	assert.ErrorContains(t, err, "failed to verify peer SVID")
+}
+
+func TestServerTLSConfig(t *testing.T) {
+	ca, err := spiffetesting.NewCA()
+	require.NoError(t, err)
+	serverCertPEM, serverKeyPEM, err := ca.NewSVID("spiffe://example.org/ns/flow-aggregator/sa/flow-aggregator")
+	require.NoError(t, err)
+	serverSource := &spiffe.X509Source{CertPEM: serverCertPEM, KeyPEM: serverKeyPEM, BundlePEM: ca.BundlePEM}
+	serverConfig, err := serverSource.ServerTLSConfig([]string{"spiffe://example.org/ns/kube-system/sa/antrea-agent"})
+	require.NoError(t, err)
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(ca.BundlePEM))
+	connect := func(id string) error {
+		certPEM, keyPEM, err := ca.NewSVID(id)
+		require.NoError(t, err)
+		cert, err := tls.X509KeyPair(certPEM, keyPEM)
+		require.NoError(t, err)
+		conn, err := tls.Dial("tcp", listener.Addr().String(), &tls.Config{
+			Certificates:       []tls.Certificate{cert},
+			RootCAs:            roots,
+			InsecureSkipVerify: true, // #nosec G402: only the client certificate is tested.
+		})
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// With TLS 1.3, the client certificate is rejected by the server after the client has completed the
+		// handshake, so the error is only returned by the first read.
+		_, err = conn.Read(make([]byte, 1))
+		return err
+	}
+	// The server closes the connection without error when the client is accepted.
+	assert.ErrorIs(t, connect("spiffe://example.org/ns/kube-system/sa/antrea-agent"), io.EOF)
+	assert.ErrorContains(t, connect("spiffe://example.org/ns/default/sa/default"), "bad certificate")
+}
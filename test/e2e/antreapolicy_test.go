@@ -3781,6 +3781,102 @@ func testServiceAccountSelector(t *testing.T, data *TestData) {
 	failOnError(k8sUtils.DeleteACNP(builder.Name), t)
 }
 
+func testServiceAccountSelectorEgress(t *testing.T, data *TestData) {
+	k8sUtils.CreateOrUpdateServiceAccount(k8sUtils.BuildServiceAccount("test-sa-client", getNS("x"), nil))
+	defer k8sUtils.DeleteServiceAccount(getNS("x"), "test-sa-client")
+	k8sUtils.CreateOrUpdateServiceAccount(k8sUtils.BuildServiceAccount("test-sa-server", getNS("y"), nil))
+	defer k8sUtils.DeleteServiceAccount(getNS("y"), "test-sa-server")
+
+	createNginxPodWithSAOnNode := func(name string, ns string, nodeName string, hostNetwork bool, serviceAccountName string) error {
+		return NewPodBuilder(name, ns, nginxImage).OnNode(nodeName).WithPorts([]v1.ContainerPort{
+			{
+				Name:          "http",
+				ContainerPort: 80,
+				Protocol:      v1.ProtocolTCP,
+			},
+		}).WithHostNetwork(hostNetwork).WithServiceAccountName(serviceAccountName).Create(data)
+	}
+	_, server0IP, cleanupFunc := createAndWaitForPodWithServiceAccount(t, data, createNginxPodWithSAOnNode, "server", controlPlaneNodeName(), getNS("y"), false, "test-sa-server")
+	defer cleanupFunc()
+
+	_, server1IP, cleanupFunc := createAndWaitForPodWithServiceAccount(t, data, createNginxPodWithSAOnNode, "server", controlPlaneNodeName(), getNS("y"), false, "default")
+	defer cleanupFunc()
+
+	clientName, _, cleanupFunc := createAndWaitForPodWithServiceAccount(t, data, data.createAgnhostPodWithSAOnNode, "client", controlPlaneNodeName(), getNS("x"), false, "test-sa-client")
+	defer cleanupFunc()
+
+	clientSA := &crdv1beta1.NamespacedName{
+		Name:      "test-sa-client",
+		Namespace: getNS("x"),
+	}
+	serverSA := &crdv1beta1.NamespacedName{
+		Name:      "test-sa-server",
+		Namespace: getNS("y"),
+	}
+
+	// The Pods of the client ServiceAccount can only talk to the Pods of the server ServiceAccount.
+	builder := &ClusterNetworkPolicySpecBuilder{}
+	builder = builder.SetName("acnp-service-account-egress").
+		SetPriority(1.0)
+	builder.Spec.AppliedTo = []crdv1beta1.AppliedTo{{ServiceAccount: clientSA}}
+	builder.AddEgress(ProtocolTCP, &p80, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, crdv1beta1.RuleActionAllow, "", "", serverSA)
+	builder.AddEgress(ProtocolTCP, &p80, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil,
+		nil, nil, nil, nil, crdv1beta1.RuleActionDrop, "", "", nil)
+
+	acnp := builder.Get()
+	_, err := k8sUtils.CreateOrUpdateACNP(acnp)
+	failOnError(err, t)
+	failOnError(waitForResourceReady(t, timeout, acnp), t)
+
+	var testcases []podToAddrTestStep
+	if clusterInfo.podV4NetworkCIDR != "" {
+		testcases = append(testcases, []podToAddrTestStep{
+			{
+				getPod("x", clientName),
+				server0IP.IPv4.String(),
+				80,
+				Connected,
+			},
+			{
+				getPod("x", clientName),
+				server1IP.IPv4.String(),
+				80,
+				Dropped,
+			},
+		}...)
+	}
+	if clusterInfo.podV6NetworkCIDR != "" {
+		testcases = append(testcases, []podToAddrTestStep{
+			{
+				getPod("x", clientName),
+				server0IP.IPv6.String(),
+				80,
+				Connected,
+			},
+			{
+				getPod("x", clientName),
+				server1IP.IPv6.String(),
+				80,
+				Dropped,
+			},
+		}...)
+	}
+
+	for _, tc := range testcases {
+		log.Tracef("Probing: %s -> %s:%d", tc.clientPod.PodName(), tc.destAddr, tc.destPort)
+		connectivity, err := k8sUtils.ProbeAddr(tc.clientPod.Namespace(), "antrea-e2e", tc.clientPod.PodName(), tc.destAddr, tc.destPort, ProtocolTCP, &tc.expectedConnectivity)
+		if err != nil {
+			t.Errorf("Failure -- could not complete probe: %v", err)
+		}
+		if connectivity != tc.expectedConnectivity {
+			t.Errorf("Failure -- wrong results for probe: Source %s/%s --> Dest %s:%d connectivity: %v, expected: %v",
+				tc.clientPod.Namespace(), tc.clientPod.PodName(), tc.destAddr, tc.destPort, connectivity, tc.expectedConnectivity)
+		}
+	}
+	failOnError(k8sUtils.DeleteACNP(builder.Name), t)
+}
+
 func testACNPNodeSelectorEgress(t *testing.T) {
 	builder := &ClusterNetworkPolicySpecBuilder{}
 	builder = builder.SetName("test-acnp-drop-egress-control-plane").
@@ -4671,6 +4767,7 @@ func TestAntreaPolicy(t *testing.T) {
 		t.Run("Case=ACNPFQDNPolicyTCP", func(t *testing.T) { testFQDNPolicyTCP(t) })
 		t.Run("Case=ACNPToServices", func(t *testing.T) { testToServices(t, data) })
 		t.Run("Case=ACNPServiceAccountSelector", func(t *testing.T) { testServiceAccountSelector(t, data) })
+		t.Run("Case=ACNPServiceAccountSelectorEgress", func(t *testing.T) { testServiceAccountSelectorEgress(t, data) })
 		t.Run("Case=ACNPNodeSelectorEgress", func(t *testing.T) { testACNPNodeSelectorEgress(t) })
 		t.Run("Case=ACNPNodeSelectorIngress", func(t *testing.T) { testACNPNodeSelectorIngress(t, data) })
 		t.Run("Case=ACNPICMPSupport", func(t *testing.T) { testACNPICMPSupport(t, data) })
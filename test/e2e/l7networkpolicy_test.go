@@ -17,6 +17,8 @@ package e2e
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -71,6 +73,9 @@ func TestL7NetworkPolicy(t *testing.T) {
 	t.Run("TLS", func(t *testing.T) {
 		testL7NetworkPolicyTLS(t, data)
 	})
+	t.Run("Kafka", func(t *testing.T) {
+		testL7NetworkPolicyKafka(t, data)
+	})
 	t.Run("Logging", func(t *testing.T) {
 		testL7NetworkPolicyLogging(t, data)
 	})
@@ -431,6 +436,93 @@ func testL7NetworkPolicyTLS(t *testing.T, data *TestData) {
 	probeL7NetworkPolicyTLS(t, data, clientPodName, serverIPs, serverNameBravo, true)
 }
 
+// kafkaRequest returns a Kafka request with the given API key, client ID and request body.
+func kafkaRequest(apiKey int16, clientID string, body []byte) []byte {
+	var request []byte
+	request = binary.BigEndian.AppendUint16(request, uint16(apiKey))
+	// The API version and the correlation ID.
+	request = binary.BigEndian.AppendUint16(request, 1)
+	request = binary.BigEndian.AppendUint32(request, 1)
+	request = binary.BigEndian.AppendUint16(request, uint16(len(clientID)))
+	request = append(request, clientID...)
+	request = append(request, body...)
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(request))), request...)
+}
+
+// kafkaTopicsBody returns the part of a Produce or Fetch request body listing the topic without partitions.
+func kafkaTopicsBody(topic string) []byte {
+	body := binary.BigEndian.AppendUint32(nil, 1)
+	body = binary.BigEndian.AppendUint16(body, uint16(len(topic)))
+	body = append(body, topic...)
+	return binary.BigEndian.AppendUint32(body, 0)
+}
+
+func testL7NetworkPolicyKafka(t *testing.T, data *TestData) {
+	clientPodName := "test-l7-kafka-client-selected"
+	clientPodLabels := map[string]string{"test-l7-kafka-e2e": "client"}
+	require.NoError(t, NewPodBuilder(clientPodName, data.testNamespace, ToolboxImage).OnNode(nodeName(0)).WithContainerName(toolboxContainerName).WithLabels(clientPodLabels).Create(data))
+	_, err := data.podWaitForIPs(defaultTimeout, clientPodName, data.testNamespace)
+	require.NoError(t, err, "Expected IP for Pod '%s'", clientPodName)
+
+	// The server doesn't implement the Kafka protocol, it prints the requests it receives to the logs.
+	serverPodName := "test-l7-kafka-server"
+	serverPodLabels := map[string]string{"test-l7-kafka-e2e": "server"}
+	cmd := []string{"nc", "-lk", "9092"}
+	require.NoError(t, NewPodBuilder(serverPodName, data.testNamespace, ToolboxImage).OnNode(nodeName(0)).WithContainerName(toolboxContainerName).WithCommand(cmd).WithLabels(serverPodLabels).Create(data))
+	podIPs, err := data.podWaitForIPs(defaultTimeout, serverPodName, data.testNamespace)
+	require.NoError(t, err, "Expected IP for Pod '%s'", serverPodName)
+
+	l7ProtocolAllowsProduceOrders := []crdv1beta1.L7Protocol{
+		{
+			Kafka: &crdv1beta1.KafkaProtocol{
+				Role:  "produce",
+				Topic: "orders",
+			},
+		},
+	}
+	policyAllowProduceOrders := "test-l7-kafka-allow-produce-orders"
+	createL7NetworkPolicy(t, data, false, policyAllowProduceOrders, 1, serverPodLabels, clientPodLabels, ProtocolTCP, 9092, l7ProtocolAllowsProduceOrders)
+	defer data.CRDClient.CrdV1beta1().NetworkPolicies(data.testNamespace).Delete(context.TODO(), policyAllowProduceOrders, metav1.DeleteOptions{})
+	time.Sleep(networkPolicyDelay)
+
+	// sendRequests sends the requests to the server in a single write, so that they are pipelined in the same TCP
+	// segment of the connection.
+	sendRequests := func(serverIP net.IP, requests ...[]byte) {
+		payload := base64.StdEncoding.EncodeToString(bytes.Join(requests, nil))
+		cmd := []string{"bash", "-c", fmt.Sprintf("echo %s | base64 -d | nc -w 1 %s 9092", payload, serverIP)}
+		data.RunCommandFromPod(data.testNamespace, clientPodName, toolboxContainerName, cmd)
+	}
+	serverReceived := func(clientID string) bool {
+		logs, err := data.GetPodLogs(context.TODO(), data.testNamespace, serverPodName, toolboxContainerName)
+		return err == nil && strings.Contains(logs, clientID)
+	}
+	metadata := binary.BigEndian.AppendUint32(nil, 0xffffffff)
+	produceOrders := append([]byte{0xff, 0xff, 0x00, 0x01, 0x00, 0x00, 0x03, 0xe8}, kafkaTopicsBody("orders")...)
+	fetchOrders := append([]byte{0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x64, 0x00, 0x00, 0x00, 0x01}, kafkaTopicsBody("orders")...)
+	produceSecrets := append([]byte{0xff, 0xff, 0x00, 0x01, 0x00, 0x00, 0x03, 0xe8}, kafkaTopicsBody("secrets")...)
+
+	for i, serverIP := range podIPs.AsSlice() {
+		// Every request in the connection is matched, not only the first one.
+		allowedClientID := fmt.Sprintf("allowed-pipelined-%d", i)
+		assert.Eventually(t, func() bool {
+			sendRequests(*serverIP, kafkaRequest(3, allowedClientID, metadata), kafkaRequest(0, allowedClientID, produceOrders))
+			return serverReceived(allowedClientID)
+		}, 10*time.Second, time.Second)
+
+		// The second request is not allowed, so the connection is rejected although the first request is allowed.
+		for name, deniedRequest := range map[string]func(clientID string) []byte{
+			"fetch":           func(clientID string) []byte { return kafkaRequest(1, clientID, fetchOrders) },
+			"produce-secrets": func(clientID string) []byte { return kafkaRequest(0, clientID, produceSecrets) },
+		} {
+			clientID := fmt.Sprintf("denied-%s-%d", name, i)
+			sendRequests(*serverIP, kafkaRequest(3, clientID, metadata), deniedRequest(clientID))
+			assert.Never(t, func() bool {
+				return serverReceived(clientID)
+			}, 5*time.Second, time.Second, "The pipelined request %s should be rejected", name)
+		}
+	}
+}
+
 func testL7NetworkPolicyLogging(t *testing.T, data *TestData) {
 	l7LoggingNode := nodeName(0)
 
@@ -38,6 +38,7 @@ import (
 	"github.com/containernetworking/plugins/plugins/ipam/host-local/backend/disk"
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/vishvananda/netlink"
@@ -581,6 +582,7 @@ func newTester() *cmdAddDelTester {
 		false, false, false, false, &config.NetworkConfig{InterfaceMTU: 1450},
 		tester.podNetworkWait.Increment(),
 		tester.flowRestoreCompleteWait,
+		afero.NewMemMapFs(),
 	)
 	tester.server.Initialize(ovsServiceMock, ofServiceMock, ifaceStore, channel.NewSubscribableChannel("PodUpdate", 100))
 	ctx := context.Background()
@@ -748,7 +750,7 @@ func setupChainTest(
 			k8sFake.NewSimpleClientset(),
 			routeMock,
 			true, false, false, false, &config.NetworkConfig{InterfaceMTU: 1450},
-			podNetworkWait, flowRestoreCompleteWait)
+			podNetworkWait, flowRestoreCompleteWait, afero.NewMemMapFs())
 	} else {
 		server = inServer
 	}
@@ -940,6 +942,7 @@ func TestCNIServerGCForHostLocalIPAM(t *testing.T) {
 		routeMock,
 		false, false, false, false, &config.NetworkConfig{InterfaceMTU: 1450},
 		podNetworkWait, flowRestoreCompleteWait,
+		afero.NewMemMapFs(),
 	)
 
 	// call Initialize, which will run reconciliation and perform host-local IPAM garbage collection
@@ -31,6 +31,7 @@ limitations under the License.
 Modifies:
 - Disable CloudAllocatorType support
 - Remove cloud argument from New()
+- Add AddClusterCIDRs() to CIDRAllocator and ExpansionCIDRs to CIDRAllocatorParams
 */
 
 package ipam
@@ -106,6 +107,9 @@ type CIDRAllocator interface {
 	AllocateOrOccupyCIDR(node *v1.Node) error
 	// ReleaseCIDR releases the CIDR of the removed node
 	ReleaseCIDR(node *v1.Node) error
+	// AddClusterCIDRs adds cidrs to allocate node CIDRs from once the
+	// cluster cidrs of the same IP family are exhausted.
+	AddClusterCIDRs(cidrs []*net.IPNet) error
 	// Run starts all the working logic of the allocator.
 	Run(stopCh <-chan struct{})
 }
@@ -121,6 +125,9 @@ type CIDRAllocatorParams struct {
 	SecondaryServiceCIDR *net.IPNet
 	// NodeCIDRMaskSizes is list of node cidr mask sizes
 	NodeCIDRMaskSizes []int
+	// ExpansionCIDRs is list of additional cluster cidrs, each of them is
+	// used after the cluster cidr of the same IP family is exhausted
+	ExpansionCIDRs []*net.IPNet
 }
 
 // New creates a new CIDR range allocator.
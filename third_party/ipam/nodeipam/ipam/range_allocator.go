@@ -32,6 +32,8 @@ Modifies:
 - Replace k8s.io/kubernetes/pkg/controller/nodeipam/ipam import with
  antrea.io/antrea/third_party/nodeipam/ipam
 - Remove recorder from rangeAllocator type, NewCIDRRangeAllocator(), RecordNodeStatusChange() calls
+- Support adding cluster CIDRs at runtime: each cluster CIDR index is backed by a list of cidrSets, the
+  primary one first, followed by the expansion ranges added with AddClusterCIDRs()
 */
 
 package ipam
@@ -43,6 +45,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -51,6 +54,7 @@ import (
 	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
+	netutils "k8s.io/utils/net"
 
 	nodeutil "antrea.io/antrea/third_party/ipam/controller_util_node"
 	"antrea.io/antrea/third_party/ipam/nodeipam/ipam/cidrset"
@@ -68,8 +72,15 @@ type rangeAllocator struct {
 	client clientset.Interface
 	// cluster cidrs as passed in during controller creation
 	clusterCIDRs []*net.IPNet
-	// for each entry in clusterCIDRs we maintain a list of what is used and what is not
-	cidrSets []*cidrset.CidrSet
+	// node cidr mask sizes, mapped to clusterCIDRs by index
+	nodeCIDRMaskSizes []int
+	// service cidrs which must be filtered out from any cluster cidr, including expansion ones
+	serviceCIDRs []*net.IPNet
+	// for each entry in clusterCIDRs we maintain a list of what is used and what is not. The
+	// first cidrSet of each entry is the one for the cluster cidr itself, the following ones
+	// are for the expansion cidrs of the same IP family added at runtime.
+	cidrSetsLock sync.RWMutex
+	cidrSets     [][]clusterCIDRSet
 	// nodeLister is able to list/get nodes and is populated by the shared informer passed to controller
 	nodeLister corelisters.NodeLister
 	// nodesSynced returns true if the node shared informer has been synced at least once.
@@ -82,6 +93,12 @@ type rangeAllocator struct {
 	nodesInProcessing sets.Set[string]
 }
 
+// clusterCIDRSet associates a cidrSet with the cluster cidr it was created for.
+type clusterCIDRSet struct {
+	cidr *net.IPNet
+	*cidrset.CidrSet
+}
+
 // NewCIDRRangeAllocator returns a CIDRAllocator to allocate CIDRs for node (one from each of clusterCIDRs)
 // Caller must ensure subNetMaskSize is not less than cluster CIDR mask size.
 // Caller must always pass in a list of existing nodes so the new allocator.
@@ -96,18 +113,19 @@ func NewCIDRRangeAllocator(client clientset.Interface, nodeInformer informers.No
 
 	// create a cidrSet for each cidr we operate on
 	// cidrSet are mapped to clusterCIDR by index
-	cidrSets := make([]*cidrset.CidrSet, len(allocatorParams.ClusterCIDRs))
+	cidrSets := make([][]clusterCIDRSet, len(allocatorParams.ClusterCIDRs))
 	for idx, cidr := range allocatorParams.ClusterCIDRs {
 		cidrSet, err := cidrset.NewCIDRSet(cidr, allocatorParams.NodeCIDRMaskSizes[idx])
 		if err != nil {
 			return nil, err
 		}
-		cidrSets[idx] = cidrSet
+		cidrSets[idx] = []clusterCIDRSet{{cidr: cidr, CidrSet: cidrSet}}
 	}
 
 	ra := &rangeAllocator{
 		client:                client,
 		clusterCIDRs:          allocatorParams.ClusterCIDRs,
+		nodeCIDRMaskSizes:     allocatorParams.NodeCIDRMaskSizes,
 		cidrSets:              cidrSets,
 		nodeLister:            nodeInformer.Lister(),
 		nodesSynced:           nodeInformer.Informer().HasSynced,
@@ -116,17 +134,25 @@ func NewCIDRRangeAllocator(client clientset.Interface, nodeInformer informers.No
 	}
 
 	if allocatorParams.ServiceCIDR != nil {
+		ra.serviceCIDRs = append(ra.serviceCIDRs, allocatorParams.ServiceCIDR)
 		ra.filterOutServiceRange(allocatorParams.ServiceCIDR)
 	} else {
 		klog.V(0).Info("No Service CIDR provided. Skipping filtering out service addresses.")
 	}
 
 	if allocatorParams.SecondaryServiceCIDR != nil {
+		ra.serviceCIDRs = append(ra.serviceCIDRs, allocatorParams.SecondaryServiceCIDR)
 		ra.filterOutServiceRange(allocatorParams.SecondaryServiceCIDR)
 	} else {
 		klog.V(0).Info("No Secondary Service CIDR provided. Skipping filtering out secondary service addresses.")
 	}
 
+	if len(allocatorParams.ExpansionCIDRs) > 0 {
+		if err := ra.addClusterCIDRs(allocatorParams.ExpansionCIDRs); err != nil {
+			return nil, err
+		}
+	}
+
 	if nodeList != nil {
 		for _, node := range nodeList.Items {
 			if len(node.Spec.PodCIDRs) == 0 {
@@ -242,11 +268,12 @@ func (r *rangeAllocator) occupyCIDRs(node *v1.Node) error {
 		// If node has a pre allocate cidr that does not exist in our cidrs.
 		// This will happen if cluster went from dualstack(multi cidrs) to non-dualstack
 		// then we have now way of locking it
-		if idx >= len(r.cidrSets) {
-			return fmt.Errorf("node:%s has an allocated cidr: %v at index:%v that does not exist in cluster cidrs configuration", node.Name, cidr, idx)
+		cidrSet, err := r.cidrSetFor(idx, podCIDR)
+		if err != nil {
+			return fmt.Errorf("node:%s has an allocated cidr: %v at index:%v that does not exist in cluster cidrs configuration: %v", node.Name, cidr, idx, err)
 		}
 
-		if err := r.cidrSets[idx].Occupy(podCIDR); err != nil {
+		if err := cidrSet.Occupy(podCIDR); err != nil {
 			return fmt.Errorf("failed to mark cidr[%v] at idx [%v] as occupied for node: %v: %v", podCIDR, idx, node.Name, err)
 		}
 	}
@@ -271,12 +298,16 @@ func (r *rangeAllocator) AllocateOrOccupyCIDR(node *v1.Node) error {
 	// allocate and queue the assignment
 	allocated := nodeReservedCIDRs{
 		nodeName:       node.Name,
-		allocatedCIDRs: make([]*net.IPNet, len(r.cidrSets)),
+		allocatedCIDRs: make([]*net.IPNet, len(r.clusterCIDRs)),
 	}
 
-	for idx := range r.cidrSets {
-		podCIDR, err := r.cidrSets[idx].AllocateNext()
+	for idx := range r.clusterCIDRs {
+		podCIDR, err := r.allocateNext(idx)
 		if err != nil {
+			// Return the CIDRs which have been reserved for the other IP families.
+			for i := 0; i < idx; i++ {
+				r.releaseCIDR(i, allocated.allocatedCIDRs[i])
+			}
 			r.removeNodeFromProcessing(node.Name)
 			nodeutil.RecordNodeStatusChange(node, "CIDRNotAvailable")
 			return fmt.Errorf("failed to allocate cidr from cluster cidr at idx:%v: %v", idx, err)
@@ -305,12 +336,13 @@ func (r *rangeAllocator) ReleaseCIDR(node *v1.Node) error {
 		// If node has a pre allocate cidr that does not exist in our cidrs.
 		// This will happen if cluster went from dualstack(multi cidrs) to non-dualstack
 		// then we have now way of locking it
-		if idx >= len(r.cidrSets) {
-			return fmt.Errorf("node:%s has an allocated cidr: %v at index:%v that does not exist in cluster cidrs configuration", node.Name, cidr, idx)
+		cidrSet, err := r.cidrSetFor(idx, podCIDR)
+		if err != nil {
+			return fmt.Errorf("node:%s has an allocated cidr: %v at index:%v that does not exist in cluster cidrs configuration: %v", node.Name, cidr, idx, err)
 		}
 
 		klog.V(4).Infof("release CIDR %s for node:%v", cidr, node.Name)
-		if err = r.cidrSets[idx].Release(podCIDR); err != nil {
+		if err = cidrSet.Release(podCIDR); err != nil {
 			return fmt.Errorf("error when releasing CIDR %v: %v", cidr, err)
 		}
 	}
@@ -332,12 +364,129 @@ func (r *rangeAllocator) filterOutServiceRange(serviceCIDR *net.IPNet) {
 		}
 
 		// at this point, len(cidrSet) == len(clusterCidr)
-		if err := r.cidrSets[idx].Occupy(serviceCIDR); err != nil {
+		if err := r.cidrSets[idx][0].Occupy(serviceCIDR); err != nil {
 			klog.Errorf("Error filtering out service cidr out cluster cidr:%v (index:%v) %v: %v", cidr, idx, serviceCIDR, err)
 		}
 	}
 }
 
+// AddClusterCIDRs adds expansion cidrs to the allocator. Each expansion cidr is attached to the
+// cluster cidr of the same IP family, and is used to allocate Node cidrs once the cluster cidr and
+// the previously added expansion cidrs are exhausted. Existing allocations are not affected. The
+// Nodes still waiting for a cidr are processed again, as the new cidrs may satisfy them.
+func (r *rangeAllocator) AddClusterCIDRs(cidrs []*net.IPNet) error {
+	if err := r.addClusterCIDRs(cidrs); err != nil {
+		return err
+	}
+	nodes, err := r.nodeLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("failed to list Nodes: %v", err)
+	}
+	for _, node := range nodes {
+		if len(node.Spec.PodCIDRs) > 0 {
+			continue
+		}
+		if err := r.AllocateOrOccupyCIDR(node); err != nil {
+			klog.Errorf("Failed to allocate CIDR for Node %v after adding cluster CIDRs: %v", node.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *rangeAllocator) addClusterCIDRs(cidrs []*net.IPNet) error {
+	r.cidrSetsLock.Lock()
+	defer r.cidrSetsLock.Unlock()
+	for _, cidr := range cidrs {
+		idx := -1
+		for i, clusterCIDR := range r.clusterCIDRs {
+			if netutils.IsIPv6CIDR(clusterCIDR) == netutils.IsIPv6CIDR(cidr) {
+				idx = i
+				break
+			}
+		}
+		if idx == -1 {
+			return fmt.Errorf("cidr %v does not match the IP family of any cluster cidr", cidr)
+		}
+		if r.hasCIDRSet(idx, cidr) {
+			klog.V(2).Infof("Cluster CIDR %v is already in use, skipping it", cidr)
+			continue
+		}
+		for _, cidrSet := range r.cidrSets[idx] {
+			existing := cidrSet.cidr
+			if existing.Contains(cidr.IP) || cidr.Contains(existing.IP) {
+				return fmt.Errorf("cidr %v overlaps with cluster cidr %v", cidr, existing)
+			}
+		}
+		if maskSize, _ := cidr.Mask.Size(); maskSize > r.nodeCIDRMaskSizes[idx] {
+			return fmt.Errorf("mask size of cidr %v must be less than or equal to the node cidr mask size %v", cidr, r.nodeCIDRMaskSizes[idx])
+		}
+		cidrSet, err := cidrset.NewCIDRSet(cidr, r.nodeCIDRMaskSizes[idx])
+		if err != nil {
+			return fmt.Errorf("failed to create cidr set for cidr %v: %v", cidr, err)
+		}
+		for _, serviceCIDR := range r.serviceCIDRs {
+			if !cidr.Contains(serviceCIDR.IP.Mask(cidr.Mask)) && !serviceCIDR.Contains(cidr.IP.Mask(serviceCIDR.Mask)) {
+				continue
+			}
+			if err := cidrSet.Occupy(serviceCIDR); err != nil {
+				klog.Errorf("Error filtering out service cidr out cluster cidr:%v %v: %v", cidr, serviceCIDR, err)
+			}
+		}
+		r.cidrSets[idx] = append(r.cidrSets[idx], clusterCIDRSet{cidr: cidr, CidrSet: cidrSet})
+		klog.Infof("Added cluster CIDR %v at index %v for Node CIDR allocation", cidr, idx)
+	}
+	return nil
+}
+
+// hasCIDRSet must be called with cidrSetsLock held.
+func (r *rangeAllocator) hasCIDRSet(idx int, cidr *net.IPNet) bool {
+	for _, cidrSet := range r.cidrSets[idx] {
+		if cidrSet.cidr.String() == cidr.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// cidrSetFor returns the cidrSet at index idx which contains the provided Node cidr.
+func (r *rangeAllocator) cidrSetFor(idx int, podCIDR *net.IPNet) (*cidrset.CidrSet, error) {
+	r.cidrSetsLock.RLock()
+	defer r.cidrSetsLock.RUnlock()
+	if idx >= len(r.cidrSets) {
+		return nil, fmt.Errorf("index %v is out of range", idx)
+	}
+	for _, cidrSet := range r.cidrSets[idx] {
+		if cidrSet.cidr.Contains(podCIDR.IP) {
+			return cidrSet.CidrSet, nil
+		}
+	}
+	return nil, fmt.Errorf("cidr %v is not contained in any cluster cidr", podCIDR)
+}
+
+// allocateNext allocates a Node cidr from the first cidrSet at index idx which is not full.
+func (r *rangeAllocator) allocateNext(idx int) (*net.IPNet, error) {
+	r.cidrSetsLock.RLock()
+	defer r.cidrSetsLock.RUnlock()
+	var err error
+	for _, cidrSet := range r.cidrSets[idx] {
+		var podCIDR *net.IPNet
+		if podCIDR, err = cidrSet.AllocateNext(); err == nil {
+			return podCIDR, nil
+		}
+	}
+	return nil, err
+}
+
+func (r *rangeAllocator) releaseCIDR(idx int, cidr *net.IPNet) {
+	cidrSet, err := r.cidrSetFor(idx, cidr)
+	if err == nil {
+		err = cidrSet.Release(cidr)
+	}
+	if err != nil {
+		klog.Errorf("Error when releasing CIDR idx:%v value: %v err:%v", idx, cidr, err)
+	}
+}
+
 // updateCIDRsAllocation assigns CIDR to Node and sends an update to the API server.
 func (r *rangeAllocator) updateCIDRsAllocation(data nodeReservedCIDRs) error {
 	var err error
@@ -371,9 +520,7 @@ func (r *rangeAllocator) updateCIDRsAllocation(data nodeReservedCIDRs) error {
 	if len(node.Spec.PodCIDRs) != 0 {
 		klog.Errorf("Node %v already has a CIDR allocated %v. Releasing the new one.", node.Name, node.Spec.PodCIDRs)
 		for idx, cidr := range data.allocatedCIDRs {
-			if releaseErr := r.cidrSets[idx].Release(cidr); releaseErr != nil {
-				klog.Errorf("Error when releasing CIDR idx:%v value: %v err:%v", idx, cidr, releaseErr)
-			}
+			r.releaseCIDR(idx, cidr)
 		}
 		return nil
 	}
@@ -394,9 +541,7 @@ func (r *rangeAllocator) updateCIDRsAllocation(data nodeReservedCIDRs) error {
 	if !apierrors.IsServerTimeout(err) {
 		klog.Errorf("CIDR assignment for node %v failed: %v. Releasing allocated CIDR", node.Name, err)
 		for idx, cidr := range data.allocatedCIDRs {
-			if releaseErr := r.cidrSets[idx].Release(cidr); releaseErr != nil {
-				klog.Errorf("Error releasing allocated CIDR for node %v: %v", node.Name, releaseErr)
-			}
+			r.releaseCIDR(idx, cidr)
 		}
 	}
 	return err
@@ -35,11 +35,14 @@ Modifies:
 - Remove cloud argument from NewNodeIpamController()
 - Remove cloud member from Controler struct
 - Remove unused constants
+- Add expansionCIDRs argument to NewNodeIpamController()
+- Add AddClusterCIDRs()
 */
 
 package nodeipam
 
 import (
+	"fmt"
 	"net"
 
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -83,6 +86,7 @@ func NewNodeIpamController(
 	serviceCIDR *net.IPNet,
 	secondaryServiceCIDR *net.IPNet,
 	nodeCIDRMaskSizes []int,
+	expansionCIDRs []*net.IPNet,
 	allocatorType ipam.CIDRAllocatorType) (*Controller, error) {
 
 	if kubeClient == nil {
@@ -132,6 +136,7 @@ func NewNodeIpamController(
 			ServiceCIDR:          ic.serviceCIDR,
 			SecondaryServiceCIDR: ic.secondaryServiceCIDR,
 			NodeCIDRMaskSizes:    nodeCIDRMaskSizes,
+			ExpansionCIDRs:       expansionCIDRs,
 		}
 
 		ic.cidrAllocator, err = ipam.New(kubeClient, nodeInformer, ic.allocatorType, allocatorParams)
@@ -163,3 +168,13 @@ func (nc *Controller) Run(stopCh <-chan struct{}) {
 
 	<-stopCh
 }
+
+// AddClusterCIDRs adds cidrs which will be used to allocate Node CIDRs once the
+// cluster CIDRs of the same IP family are exhausted. Node CIDRs which have been
+// allocated are not changed.
+func (nc *Controller) AddClusterCIDRs(cidrs []*net.IPNet) error {
+	if nc.cidrAllocator == nil {
+		return fmt.Errorf("cluster CIDRs cannot be added with allocator type %v", nc.allocatorType)
+	}
+	return nc.cidrAllocator.AddClusterCIDRs(cidrs)
+}